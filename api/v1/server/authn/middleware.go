@@ -11,7 +11,9 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
 
@@ -42,6 +44,11 @@ func (a *AuthN) Middleware(r *middleware.RouteInfo) echo.HandlerFunc {
 	}
 }
 
+// logger returns the logger of the request, which includes the request ID.
+func (a *AuthN) logger(c echo.Context) *zerolog.Logger {
+	return serverutils.RequestLogger(c.Request().Context(), a.l)
+}
+
 func (a *AuthN) authenticate(c echo.Context, r *middleware.RouteInfo) error {
 	// if security is optional, continue
 	if r.Security.IsOptional() {
@@ -70,7 +77,7 @@ func (a *AuthN) authenticate(c echo.Context, r *middleware.RouteInfo) error {
 	var bearerErr error
 
 	if r.Security.BearerAuth() {
-		bearerErr = a.handleBearerAuth(c)
+		bearerErr = a.handleBearerAuth(c, r)
 		c.Set("auth_strategy", "bearer")
 
 		if bearerErr == nil {
@@ -87,13 +94,13 @@ func (a *AuthN) handleNoAuth(c echo.Context) error {
 	session, err := store.Get(c.Request(), store.GetName())
 
 	if err != nil {
-		a.l.Debug().Err(err).Msg("error getting session")
+		a.logger(c).Debug().Err(err).Msg("error getting session")
 
 		return redirect.GetRedirectWithError(c, a.l, err, "Could not log in. Please try again and make sure cookies are enabled.")
 	}
 
 	if auth, ok := session.Values["authenticated"].(bool); ok && auth {
-		a.l.Debug().Msgf("user was authenticated when no security schemes permit auth")
+		a.logger(c).Debug().Msgf("user was authenticated when no security schemes permit auth")
 
 		return redirect.GetRedirectNoError(c, a.config.Runtime.ServerURL)
 	}
@@ -114,7 +121,7 @@ func (a *AuthN) handleCookieAuth(c echo.Context) error {
 		err = a.helpers.SaveUnauthenticated(c)
 
 		if err != nil {
-			a.l.Error().Err(err).Msg("error saving unauthenticated session")
+			a.logger(c).Error().Err(err).Msg("error saving unauthenticated session")
 			return fmt.Errorf("error saving unauthenticated session")
 		}
 
@@ -125,7 +132,7 @@ func (a *AuthN) handleCookieAuth(c echo.Context) error {
 		// if the session is new, make sure we write a Set-Cookie header to the response
 		if session.IsNew {
 			if err := saveNewSession(c, session); err != nil {
-				a.l.Error().Err(err).Msg("error saving unauthenticated session")
+				a.logger(c).Error().Err(err).Msg("error saving unauthenticated session")
 				return fmt.Errorf("error saving unauthenticated session")
 			}
 
@@ -139,14 +146,14 @@ func (a *AuthN) handleCookieAuth(c echo.Context) error {
 	userID, ok := session.Values["user_id"].(string)
 
 	if !ok {
-		a.l.Debug().Msgf("could not cast user_id to string")
+		a.logger(c).Debug().Msgf("could not cast user_id to string")
 
 		return forbidden
 	}
 
 	user, err := a.config.APIRepository.User().GetUserByID(userID)
 	if err != nil {
-		a.l.Debug().Err(err).Msg("error getting user by id")
+		a.logger(c).Debug().Err(err).Msg("error getting user by id")
 
 		if errors.Is(err, db.ErrNotFound) {
 			return forbidden
@@ -159,10 +166,15 @@ func (a *AuthN) handleCookieAuth(c echo.Context) error {
 	c.Set("user", user)
 	c.Set("session", session)
 
+	setActor(c, &repository.Actor{
+		Type: repository.ActorTypeUser,
+		Id:   user.ID,
+	})
+
 	return nil
 }
 
-func (a *AuthN) handleBearerAuth(c echo.Context) error {
+func (a *AuthN) handleBearerAuth(c echo.Context, r *middleware.RouteInfo) error {
 	forbidden := echo.NewHTTPError(http.StatusForbidden, "Please provide valid credentials")
 
 	// a tenant id must exist in the context in order for the bearer auth to succeed, since
@@ -170,7 +182,7 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 	queriedTenant, ok := c.Get("tenant").(*db.TenantModel)
 
 	if !ok {
-		a.l.Debug().Msgf("tenant not found in context")
+		a.logger(c).Debug().Msgf("tenant not found in context")
 
 		return fmt.Errorf("tenant not found in context")
 	}
@@ -178,16 +190,16 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 	token, err := getBearerTokenFromRequest(c.Request())
 
 	if err != nil {
-		a.l.Debug().Err(err).Msg("error getting bearer token from request")
+		a.logger(c).Debug().Err(err).Msg("error getting bearer token from request")
 
 		return forbidden
 	}
 
 	// Validate the token.
-	tenantId, _, err := a.config.Auth.JWTManager.ValidateTenantToken(c.Request().Context(), token)
+	tenantId, tokenId, err := a.config.Auth.JWTManager.ValidateTenantToken(c.Request().Context(), token)
 
 	if err != nil {
-		a.l.Debug().Err(err).Msg("error validating tenant token")
+		a.logger(c).Debug().Err(err).Msg("error validating tenant token")
 
 		return forbidden
 	}
@@ -195,14 +207,40 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 	// Verify that the tenant id which exists in the context is the same as the tenant id
 	// in the token.
 	if queriedTenant.ID != tenantId {
-		a.l.Debug().Msgf("tenant id in token does not match tenant id in context")
+		a.logger(c).Debug().Msgf("tenant id in token does not match tenant id in context")
 
 		return forbidden
 	}
 
+	apiToken, err := a.config.EngineRepository.APIToken().GetAPITokenById(c.Request().Context(), tokenId)
+
+	if err != nil {
+		a.logger(c).Debug().Err(err).Msg("error getting api token")
+
+		return forbidden
+	}
+
+	if !repository.APITokenHasScope(apiToken.Scopes, requiredScope(r.OperationID)) {
+		a.logger(c).Debug().Msgf("api token does not have the scope for operation %s", r.OperationID)
+
+		return echo.NewHTTPError(http.StatusForbidden, "The API token does not have the scope for this operation")
+	}
+
+	setActor(c, &repository.Actor{
+		Type:      repository.ActorTypeAPIToken,
+		Id:        tokenId,
+		Namespace: apiToken.Namespace.String,
+	})
+
 	return nil
 }
 
+// setActor stores the authenticated actor on the request context, so that it is available to
+// anything which is passed the request context, such as the ingestor.
+func setActor(c echo.Context, actor *repository.Actor) {
+	c.SetRequest(c.Request().WithContext(repository.ContextWithActor(c.Request().Context(), actor)))
+}
+
 var errInvalidAuthHeader = fmt.Errorf("invalid authorization header in request")
 
 func getBearerTokenFromRequest(r *http.Request) (string, error) {
@@ -0,0 +1,32 @@
+package authn
+
+import "github.com/hatchet-dev/hatchet/pkg/repository"
+
+// operationScopes are the scopes which allow API tokens to perform operations of the REST API. All other
+// operations require the admin scope.
+var operationScopes = map[string]string{
+	"EventCreate":       repository.APITokenScopeEventsWrite,
+	"EventCreateBulk":   repository.APITokenScopeEventsWrite,
+	"EventUpdateReplay": repository.APITokenScopeEventsWrite,
+
+	"EventWorkflowRunList":         repository.APITokenScopeRunsRead,
+	"WorkflowRunList":              repository.APITokenScopeRunsRead,
+	"WorkflowRunGet":               repository.APITokenScopeRunsRead,
+	"WorkflowRunGetInput":          repository.APITokenScopeRunsRead,
+	"WorkflowRunGetShape":          repository.APITokenScopeRunsRead,
+	"WorkflowRunGetMetrics":        repository.APITokenScopeRunsRead,
+	"WorkflowRunListStepRunEvents": repository.APITokenScopeRunsRead,
+	"StepRunGet":                   repository.APITokenScopeRunsRead,
+	"StepRunGetSchema":             repository.APITokenScopeRunsRead,
+	"StepRunListEvents":            repository.APITokenScopeRunsRead,
+	"StepRunListArchives":          repository.APITokenScopeRunsRead,
+	"LogLineList":                  repository.APITokenScopeRunsRead,
+}
+
+func requiredScope(operationId string) string {
+	if scope, ok := operationScopes[operationId]; ok {
+		return scope
+	}
+
+	return repository.APITokenScopeAdmin
+}
@@ -34,6 +34,24 @@ func (s *SessionHelpers) SaveAuthenticated(c echo.Context, user *db.UserModel) e
 	return session.Save(c.Request(), c.Response())
 }
 
+// GetAuthenticatedUserId returns the id of the user who is logged in with the session of the request,
+// or an empty string if the request is not authenticated.
+func (s *SessionHelpers) GetAuthenticatedUserId(c echo.Context) (string, error) {
+	session, err := s.config.SessionStore.Get(c.Request(), s.config.SessionStore.GetName())
+
+	if err != nil {
+		return "", err
+	}
+
+	if auth, ok := session.Values["authenticated"].(bool); !ok || !auth {
+		return "", nil
+	}
+
+	userId, _ := session.Values["user_id"].(string)
+
+	return userId, nil
+}
+
 func (s *SessionHelpers) SaveUnauthenticated(c echo.Context) error {
 	session, err := s.config.SessionStore.Get(c.Request(), s.config.SessionStore.GetName())
 
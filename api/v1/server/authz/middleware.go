@@ -1,27 +1,42 @@
 package authz
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
+	"github.com/hatchet-dev/hatchet/pkg/auth/authorizer"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
 
 type AuthZ struct {
 	config *server.ServerConfig
 
+	authorizer authorizer.Authorizer
+
 	l *zerolog.Logger
 }
 
 func NewAuthZ(config *server.ServerConfig) *AuthZ {
+	a := config.Auth.Authorizer
+
+	if a == nil {
+		a = authorizer.NewPermissionAuthorizer()
+	}
+
 	return &AuthZ{
-		config: config,
-		l:      config.Logger,
+		config:     config,
+		authorizer: a,
+		l:          config.Logger,
 	}
 }
 
@@ -36,6 +51,11 @@ func (a *AuthZ) Middleware(r *middleware.RouteInfo) echo.HandlerFunc {
 	}
 }
 
+// logger returns the logger of the request, which includes the request ID.
+func (a *AuthZ) logger(c echo.Context) *zerolog.Logger {
+	return serverutils.RequestLogger(c.Request().Context(), a.l)
+}
+
 func (a *AuthZ) authorize(c echo.Context, r *middleware.RouteInfo) error {
 	if r.Security.IsOptional() || r.Security.NoAuth() {
 		return nil
@@ -59,7 +79,7 @@ func (a *AuthZ) handleCookieAuth(c echo.Context, r *middleware.RouteInfo) error
 	unauthorized := echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to view this resource")
 
 	if err := a.ensureVerifiedEmail(c, r); err != nil {
-		a.l.Debug().Err(err).Msgf("error ensuring verified email")
+		a.logger(c).Debug().Err(err).Msgf("error ensuring verified email")
 		return echo.NewHTTPError(http.StatusUnauthorized, "Please verify your email before continuing")
 	}
 
@@ -68,7 +88,7 @@ func (a *AuthZ) handleCookieAuth(c echo.Context, r *middleware.RouteInfo) error
 		user, ok := c.Get("user").(*db.UserModel)
 
 		if !ok {
-			a.l.Debug().Msgf("user not found in context")
+			a.logger(c).Debug().Msgf("user not found in context")
 
 			return unauthorized
 		}
@@ -77,13 +97,13 @@ func (a *AuthZ) handleCookieAuth(c echo.Context, r *middleware.RouteInfo) error
 		tenantMember, err := a.config.APIRepository.Tenant().GetTenantMemberByUserID(tenant.ID, user.ID)
 
 		if err != nil {
-			a.l.Debug().Err(err).Msgf("error getting tenant member")
+			a.logger(c).Debug().Err(err).Msgf("error getting tenant member")
 
 			return unauthorized
 		}
 
 		if tenantMember == nil {
-			a.l.Debug().Msgf("user is not a member of the tenant")
+			a.logger(c).Debug().Msgf("user is not a member of the tenant")
 
 			return unauthorized
 		}
@@ -92,8 +112,29 @@ func (a *AuthZ) handleCookieAuth(c echo.Context, r *middleware.RouteInfo) error
 		c.Set("tenant-member", tenantMember)
 
 		// authorize tenant operations
-		if err := a.authorizeTenantOperations(tenant, tenantMember, r); err != nil {
-			a.l.Debug().Err(err).Msgf("error authorizing tenant operations")
+		actor := &authorizer.Actor{
+			Type: repository.ActorTypeUser,
+			Id:   user.ID,
+			Role: string(tenantMember.Role),
+		}
+
+		// custom roles replace the permissions of the member's role
+		customRole, err := a.config.APIRepository.TenantRole().GetTenantMemberCustomRole(c.Request().Context(), tenantMember.ID)
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			a.logger(c).Debug().Err(err).Msgf("error getting custom role of tenant member")
+
+			return unauthorized
+		}
+
+		if customRole != nil {
+			// a custom role without permissions grants nothing, so it mustn't fall back to the member's role
+			actor.Permissions = make([]string, 0, len(customRole.Permissions))
+			actor.Permissions = append(actor.Permissions, customRole.Permissions...)
+		}
+
+		if err := a.authorizeTenantOperation(c, r, actor, tenant); err != nil {
+			a.logger(c).Debug().Err(err).Msgf("error authorizing tenant operations")
 
 			return unauthorized
 		}
@@ -102,18 +143,32 @@ func (a *AuthZ) handleCookieAuth(c echo.Context, r *middleware.RouteInfo) error
 	return nil
 }
 
-var restrictedWithBearerToken = []string{
-	// bearer tokens cannot read, list, or write other bearer tokens
-	"ApiTokenList",
-	"ApiTokenCreate",
-	"ApiTokenUpdateRevoke",
-}
-
-// At the moment, there's no further bearer auth because bearer tokens are admin-scoped
+// handleBearerAuth authorizes the API token which authenticated the request. Bearer tokens are tenant-scoped,
 // and we check that the bearer token has access to the tenant in the authn step.
 func (a *AuthZ) handleBearerAuth(c echo.Context, r *middleware.RouteInfo) error {
-	if operationIn(r.OperationID, restrictedWithBearerToken) {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
+	unauthorized := echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
+
+	tenant, ok := c.Get("tenant").(*db.TenantModel)
+
+	if !ok {
+		a.logger(c).Debug().Msgf("tenant not found in context")
+
+		return unauthorized
+	}
+
+	var actor *authorizer.Actor
+
+	if authnActor := repository.ActorFromContext(c.Request().Context()); authnActor != nil {
+		actor = &authorizer.Actor{
+			Type: authnActor.Type,
+			Id:   authnActor.Id,
+		}
+	}
+
+	if err := a.authorizeTenantOperation(c, r, actor, tenant); err != nil {
+		a.logger(c).Debug().Err(err).Msgf("error authorizing tenant operations")
+
+		return unauthorized
 	}
 
 	return nil
@@ -142,37 +197,29 @@ func (a *AuthZ) ensureVerifiedEmail(c echo.Context, r *middleware.RouteInfo) err
 	return nil
 }
 
-var adminAndOwnerOnly = []string{
-	"TenantInviteList",
-	"TenantInviteCreate",
-	"TenantInviteUpdate",
-	"TenantInviteDelete",
-	"TenantMemberList",
-	// members cannot create API tokens for a tenant, because they have admin permissions
-	"ApiTokenList",
-	"ApiTokenCreate",
-	"ApiTokenUpdateRevoke",
-}
+// authorizeTenantOperation asks the authorizer whether the actor may perform the operation of the route on
+// its resource. Operations are denied unless the authorizer explicitly allows them.
+func (a *AuthZ) authorizeTenantOperation(c echo.Context, r *middleware.RouteInfo, actor *authorizer.Actor, tenant *db.TenantModel) error {
+	resource := &authorizer.Resource{
+		TenantId: tenant.ID,
+		Type:     "tenant",
+	}
 
-func (a *AuthZ) authorizeTenantOperations(tenant *db.TenantModel, tenantMember *db.TenantMemberModel, r *middleware.RouteInfo) error {
-	// if the user is an owner, they can do anything
-	if tenantMember.Role == db.TenantMemberRoleOwner {
-		return nil
+	if len(r.Resources) > 0 {
+		resource.Type = r.Resources[len(r.Resources)-1]
+		resource.Id = c.Param(resource.Type)
 	}
 
-	// if the user is an admin, they can do anything at the moment. Some downstream handlers will case on
-	// admin roles, for example admins cannot mark users as owners.
-	if tenantMember.Role == db.TenantMemberRoleAdmin {
-		return nil
+	allowed, err := a.authorizer.Authorize(c.Request().Context(), actor, r.OperationID, resource)
+
+	if err != nil {
+		return fmt.Errorf("could not authorize operation %s: %w", r.OperationID, err)
 	}
 
-	// at the moment, tenant members are only restricted from creating other tenant users.
-	if operationIn(r.OperationID, adminAndOwnerOnly) {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
+	if !allowed {
+		return fmt.Errorf("operation %s is not allowed", r.OperationID)
 	}
 
-	// NOTE(abelanger5): this should be default-deny, but there's not a strong use-case for restricting member
-	// operations at the moment. If there is, we should modify this logic.
 	return nil
 }
 
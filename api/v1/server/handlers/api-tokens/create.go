@@ -1,6 +1,7 @@
 package apitokens
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -34,7 +35,19 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		expiresAt = &e
 	}
 
-	token, err := a.config.Auth.JWTManager.GenerateTenantToken(ctx.Request().Context(), tenant.ID, request.Body.Name, false, expiresAt)
+	var scopes []string
+
+	if request.Body.Scopes != nil {
+		for _, scope := range *request.Body.Scopes {
+			if !validScope(scope) {
+				return gen.ApiTokenCreate400JSONResponse(apierrors.NewAPIErrors(fmt.Sprintf("invalid scope %s", scope))), nil
+			}
+
+			scopes = append(scopes, string(scope))
+		}
+	}
+
+	token, err := a.config.Auth.JWTManager.GenerateTenantToken(ctx.Request().Context(), tenant.ID, request.Body.Name, false, expiresAt, scopes, request.Body.Namespace)
 
 	if err != nil {
 		return nil, err
@@ -45,3 +58,12 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		Token: token.Token,
 	}, nil
 }
+
+func validScope(scope gen.APITokenScope) bool {
+	switch scope {
+	case gen.EventsWrite, gen.RunsRead, gen.Admin:
+		return true
+	default:
+		return false
+	}
+}
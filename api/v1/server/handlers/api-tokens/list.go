@@ -11,7 +11,7 @@ import (
 func (a *APITokenService) ApiTokenList(ctx echo.Context, request gen.ApiTokenListRequestObject) (gen.ApiTokenListResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
-	tokens, err := a.config.APIRepository.APIToken().ListAPITokensByTenant(tenant.ID)
+	tokens, err := a.config.APIRepository.APIToken().ListAPITokensByTenant(ctx.Request().Context(), tenant.ID)
 
 	if err != nil {
 		return nil, err
@@ -20,7 +20,7 @@ func (a *APITokenService) ApiTokenList(ctx echo.Context, request gen.ApiTokenLis
 	rows := make([]gen.APIToken, len(tokens))
 
 	for i := range tokens {
-		rows[i] = *transformers.ToAPIToken(&tokens[i])
+		rows[i] = *transformers.ToAPIToken(tokens[i])
 	}
 
 	return gen.ApiTokenList200JSONResponse(
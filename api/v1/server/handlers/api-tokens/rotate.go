@@ -0,0 +1,99 @@
+package apitokens
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (a *APITokenService) ApiTokenUpdateRotate(ctx echo.Context, request gen.ApiTokenUpdateRotateRequestObject) (gen.ApiTokenUpdateRotateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	apiToken := ctx.Get("api-token").(*db.APITokenModel)
+
+	if apiToken.Internal {
+		return gen.ApiTokenUpdateRotate403JSONResponse(
+			apierrors.NewAPIErrors("Cannot rotate internal API tokens"),
+		), nil
+	}
+
+	if apiToken.Revoked {
+		return gen.ApiTokenUpdateRotate400JSONResponse(
+			apierrors.NewAPIErrors("Cannot rotate revoked API tokens"),
+		), nil
+	}
+
+	// validate the request
+	if apiErrors, err := a.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.ApiTokenUpdateRotate400JSONResponse(*apiErrors), nil
+	}
+
+	var gracePeriod time.Duration
+
+	if request.Body.GracePeriod != nil {
+		var err error
+
+		gracePeriod, err = time.ParseDuration(*request.Body.GracePeriod)
+
+		if err != nil || gracePeriod < 0 {
+			return gen.ApiTokenUpdateRotate400JSONResponse(apierrors.NewAPIErrors("invalid grace period")), nil
+		}
+	}
+
+	// the new token keeps the scopes and namespace of the rotated token
+	rotated, err := a.config.EngineRepository.APIToken().GetAPITokenById(ctx.Request().Context(), apiToken.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get api token: %w", err)
+	}
+
+	var namespace *string
+
+	if rotated.Namespace.Valid {
+		namespace = &rotated.Namespace.String
+	}
+
+	name, _ := apiToken.Name()
+
+	now := time.Now().UTC()
+
+	var expiresAt *time.Time
+
+	// the new token is valid for as long as the rotated token was
+	if e, ok := apiToken.ExpiresAt(); ok {
+		e = now.Add(e.Sub(apiToken.CreatedAt))
+		expiresAt = &e
+	}
+
+	token, err := a.config.Auth.JWTManager.GenerateTenantToken(ctx.Request().Context(), tenant.ID, name, false, expiresAt, rotated.Scopes, namespace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if gracePeriod > 0 {
+		err = a.config.APIRepository.APIToken().ExpireAPIToken(ctx.Request().Context(), apiToken.ID, now.Add(gracePeriod))
+	} else {
+		err = a.config.APIRepository.APIToken().RevokeAPIToken(apiToken.ID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not expire rotated api token: %w", err)
+	}
+
+	audit.AddDetails(ctx, map[string]any{
+		"newApiTokenId": token.TokenId,
+	})
+
+	// This is the only time the token is sent over the API
+	return gen.ApiTokenUpdateRotate200JSONResponse{
+		Token: token.Token,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package auditlogs
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (a *AuditLogService) AuditLogGetSettings(ctx echo.Context, request gen.AuditLogGetSettingsRequestObject) (gen.AuditLogGetSettingsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	retentionPeriod, err := a.config.APIRepository.AuditLog().GetAuditLogRetentionPeriod(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AuditLogGetSettings200JSONResponse(
+		gen.AuditLogSettings{
+			RetentionPeriod: retentionPeriod,
+		},
+	), nil
+}
@@ -0,0 +1,33 @@
+package auditlogs
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (a *AuditLogService) AuditLogUpdateSettings(ctx echo.Context, request gen.AuditLogUpdateSettingsRequestObject) (gen.AuditLogUpdateSettingsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := a.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.AuditLogUpdateSettings400JSONResponse(*apiErrors), nil
+	}
+
+	settings, err := a.config.APIRepository.AuditLog().UpdateAuditLogSettings(ctx.Request().Context(), tenant.ID, &repository.UpdateAuditLogSettingsOpts{
+		RetentionPeriod: request.Body.RetentionPeriod,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AuditLogUpdateSettings200JSONResponse(
+		*transformers.ToAuditLogSettings(settings),
+	), nil
+}
@@ -2,12 +2,14 @@ package events
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
@@ -19,6 +21,12 @@ func (t *EventService) EventCreateBulk(ctx echo.Context, request gen.EventCreate
 	eventOpts := make([]*repository.CreateEventOpts, len(request.Body.Events))
 
 	for i, event := range request.Body.Events {
+		if event.Priority != nil && (*event.Priority < 1 || *event.Priority > 3) {
+			return gen.EventCreateBulk400JSONResponse(
+				apierrors.NewAPIErrors("priority must be between 1 and 3"),
+			), nil
+		}
+
 		dataBytes, err := json.Marshal(event.Data)
 
 		if err != nil {
@@ -40,18 +48,35 @@ func (t *EventService) EventCreateBulk(ctx echo.Context, request gen.EventCreate
 			Key:                event.Key,
 			Data:               dataBytes,
 			AdditionalMetadata: additionalMetadata,
+			Priority:           event.Priority,
 		}
 	}
 	events, err := t.config.Ingestor.BulkIngestEvent(ctx.Request().Context(), tenant.ID, eventOpts)
 
 	if err != nil {
 
+		if errors.Is(err, repository.ErrNotInNamespace) {
+			return gen.EventCreateBulk403JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
 		if err == metered.ErrResourceExhausted {
 			return gen.EventCreateBulk429JSONResponse(
 				apierrors.NewAPIErrors("Event limit exceeded"),
 			), nil
 		}
 
+		var backpressureErr *backpressure.ResourceExhaustedError
+
+		if errors.As(err, &backpressureErr) {
+			ctx.Response().Header().Set("Retry-After", backpressureErr.RetryAfterHeader())
+
+			return gen.EventCreateBulk429JSONResponse(
+				apierrors.NewAPIErrors(backpressureErr.Error()),
+			), nil
+		}
+
 		return gen.EventCreateBulk400JSONResponse{}, err
 
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
@@ -49,6 +50,10 @@ func (t *EventService) EventUpdateCancel(ctx echo.Context, request gen.EventUpda
 	var cancelledWorkflowRunIds []uuid.UUID
 	var returnErr error
 
+	audit.AddDetails(ctx, map[string]any{
+		"cancelledWorkflowRunIds": runIds,
+	})
+
 	for _, runId := range runIds {
 		runIdCp := runId
 		wg.Add(1)
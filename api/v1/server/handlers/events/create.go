@@ -2,12 +2,15 @@ package events
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -16,6 +19,13 @@ import (
 func (t *EventService) EventCreate(ctx echo.Context, request gen.EventCreateRequestObject) (gen.EventCreateResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventCreate400JSONResponse(*apiErrors), nil
+	}
+
 	// marshal the data object to bytes
 	dataBytes, err := json.Marshal(request.Body.Data)
 
@@ -33,15 +43,37 @@ func (t *EventService) EventCreate(ctx echo.Context, request gen.EventCreateRequ
 		}
 	}
 
-	newEvent, err := t.config.Ingestor.IngestEvent(ctx.Request().Context(), tenant.ID, request.Body.Key, dataBytes, additionalMetadata)
+	newEvent, err := t.config.Ingestor.IngestEventWithOpts(ctx.Request().Context(), &repository.CreateEventOpts{
+		TenantId:           tenant.ID,
+		Key:                request.Body.Key,
+		Data:               dataBytes,
+		AdditionalMetadata: additionalMetadata,
+		Priority:           request.Body.Priority,
+	})
 
 	if err != nil {
+		if errors.Is(err, repository.ErrNotInNamespace) {
+			return gen.EventCreate403JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
 		if err == metered.ErrResourceExhausted {
 			return gen.EventCreate429JSONResponse(
 				apierrors.NewAPIErrors("Event limit exceeded"),
 			), nil
 		}
 
+		var backpressureErr *backpressure.ResourceExhaustedError
+
+		if errors.As(err, &backpressureErr) {
+			ctx.Response().Header().Set("Retry-After", backpressureErr.RetryAfterHeader())
+
+			return gen.EventCreate429JSONResponse(
+				apierrors.NewAPIErrors(backpressureErr.Error()),
+			), nil
+		}
+
 		return nil, err
 	}
 
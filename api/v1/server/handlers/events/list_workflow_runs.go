@@ -0,0 +1,67 @@
+package events
+
+import (
+	"math"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *EventService) EventWorkflowRunList(ctx echo.Context, request gen.EventWorkflowRunListRequestObject) (gen.EventWorkflowRunListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	event := ctx.Get("event").(*db.EventModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListEventWorkflowRunsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	listRes, err := t.config.APIRepository.Event().ListEventWorkflowRuns(ctx.Request().Context(), tenant.ID, event.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.EventWorkflowRun, len(listRes.Rows))
+
+	for i, run := range listRes.Rows {
+		rows[i] = transformers.ToEventWorkflowRun(event.CreatedAt, run)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.EventWorkflowRunList200JSONResponse(
+		gen.EventWorkflowRunList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
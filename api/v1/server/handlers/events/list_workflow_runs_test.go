@@ -0,0 +1,156 @@
+package events
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type fakeAPIRepository struct {
+	repository.APIRepository
+
+	events *fakeEventRepository
+}
+
+func (r *fakeAPIRepository) Event() repository.EventAPIRepository {
+	return r.events
+}
+
+// fakeEventRepository pages through the workflow runs of an event like the database does.
+type fakeEventRepository struct {
+	repository.EventAPIRepository
+
+	runs []*dbsqlc.ListEventWorkflowRunsRow
+
+	opts *repository.ListEventWorkflowRunsOpts
+}
+
+func (r *fakeEventRepository) ListEventWorkflowRuns(ctx context.Context, tenantId, eventId string, opts *repository.ListEventWorkflowRunsOpts) (*repository.ListEventWorkflowRunsResult, error) {
+	r.opts = opts
+
+	start := min(*opts.Offset, len(r.runs))
+	end := min(start+*opts.Limit, len(r.runs))
+
+	return &repository.ListEventWorkflowRunsResult{
+		Rows:  r.runs[start:end],
+		Count: len(r.runs),
+	}, nil
+}
+
+func newTestEventService(runs int, eventCreatedAt time.Time) (*EventService, *fakeEventRepository) {
+	events := &fakeEventRepository{}
+
+	for i := range runs {
+		createdAt := eventCreatedAt.Add(time.Duration(i+1) * time.Second)
+
+		events.runs = append(events.runs, &dbsqlc.ListEventWorkflowRunsRow{
+			WorkflowRunId:     sqlchelpers.UUIDFromStr(uuid.NewString()),
+			Status:            dbsqlc.WorkflowRunStatusRUNNING,
+			CreatedAt:         sqlchelpers.TimestampFromTime(createdAt),
+			WorkflowVersionId: sqlchelpers.UUIDFromStr(uuid.NewString()),
+			WorkflowId:        sqlchelpers.UUIDFromStr(uuid.NewString()),
+			WorkflowName:      "workflow",
+		})
+	}
+
+	return NewEventService(&server.ServerConfig{
+		Config: &database.Config{
+			APIRepository: &fakeAPIRepository{events: events},
+		},
+	}), events
+}
+
+func listEventWorkflowRuns(t *testing.T, svc *EventService, eventCreatedAt time.Time, params gen.EventWorkflowRunListParams) gen.EventWorkflowRunList {
+	t.Helper()
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	c.Set("tenant", &db.TenantModel{InnerTenant: db.InnerTenant{ID: uuid.NewString()}})
+	c.Set("event", &db.EventModel{InnerEvent: db.InnerEvent{ID: uuid.NewString(), CreatedAt: eventCreatedAt}})
+
+	res, err := svc.EventWorkflowRunList(c, gen.EventWorkflowRunListRequestObject{
+		Params: params,
+	})
+
+	require.NoError(t, err)
+	require.IsType(t, gen.EventWorkflowRunList200JSONResponse{}, res)
+
+	return gen.EventWorkflowRunList(res.(gen.EventWorkflowRunList200JSONResponse))
+}
+
+func TestEventWorkflowRunList(t *testing.T) {
+	eventCreatedAt := time.Date(2025, 2, 18, 9, 12, 4, 0, time.UTC)
+
+	svc, events := newTestEventService(3, eventCreatedAt)
+
+	list := listEventWorkflowRuns(t, svc, eventCreatedAt, gen.EventWorkflowRunListParams{})
+
+	// the first page of runs is listed by default
+	assert.Equal(t, 50, *events.opts.Limit)
+	assert.Equal(t, 0, *events.opts.Offset)
+
+	require.Len(t, *list.Rows, 3)
+	assert.Equal(t, int64(1), *list.Pagination.NumPages)
+	assert.Equal(t, int64(1), *list.Pagination.CurrentPage)
+	assert.Equal(t, int64(1), *list.Pagination.NextPage)
+
+	for i, run := range *list.Rows {
+		assert.Equal(t, sqlchelpers.UUIDToStr(events.runs[i].WorkflowRunId), run.WorkflowRunId.String())
+		assert.Equal(t, int64(i+1)*1000, run.DispatchLatency)
+	}
+}
+
+func TestEventWorkflowRunListPagination(t *testing.T) {
+	eventCreatedAt := time.Date(2025, 2, 18, 9, 12, 4, 0, time.UTC)
+
+	svc, events := newTestEventService(3, eventCreatedAt)
+
+	limit := int64(2)
+	offset := int64(0)
+
+	list := listEventWorkflowRuns(t, svc, eventCreatedAt, gen.EventWorkflowRunListParams{Limit: &limit, Offset: &offset})
+
+	require.Len(t, *list.Rows, 2)
+	assert.Equal(t, sqlchelpers.UUIDToStr(events.runs[0].WorkflowRunId), (*list.Rows)[0].WorkflowRunId.String())
+	assert.Equal(t, int64(2), *list.Pagination.NumPages)
+	assert.Equal(t, int64(1), *list.Pagination.CurrentPage)
+	assert.Equal(t, int64(2), *list.Pagination.NextPage)
+
+	offset = 2
+
+	list = listEventWorkflowRuns(t, svc, eventCreatedAt, gen.EventWorkflowRunListParams{Limit: &limit, Offset: &offset})
+
+	// the last page has the remaining run, and no next page
+	require.Len(t, *list.Rows, 1)
+	assert.Equal(t, sqlchelpers.UUIDToStr(events.runs[2].WorkflowRunId), (*list.Rows)[0].WorkflowRunId.String())
+	assert.Equal(t, int64(2), *list.Pagination.NumPages)
+	assert.Equal(t, int64(2), *list.Pagination.CurrentPage)
+	assert.Equal(t, int64(2), *list.Pagination.NextPage)
+}
+
+func TestEventWorkflowRunListWithoutRuns(t *testing.T) {
+	eventCreatedAt := time.Date(2025, 2, 18, 9, 12, 4, 0, time.UTC)
+
+	svc, _ := newTestEventService(0, eventCreatedAt)
+
+	list := listEventWorkflowRuns(t, svc, eventCreatedAt, gen.EventWorkflowRunListParams{})
+
+	// an event which triggered no runs has an empty list rather than a null one
+	require.NotNil(t, list.Rows)
+	assert.Empty(t, *list.Rows)
+	assert.Equal(t, int64(0), *list.Pagination.NumPages)
+}
@@ -1,12 +1,16 @@
 package events
 
 import (
+	"errors"
+
 	"github.com/hashicorp/go-multierror"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -36,6 +40,12 @@ func (t *EventService) EventUpdateReplay(ctx echo.Context, request gen.EventUpda
 
 		newEvent, err := t.config.Ingestor.IngestReplayedEvent(ctx.Request().Context(), tenant.ID, event)
 
+		if errors.Is(err, repository.ErrNotInNamespace) {
+			return gen.EventUpdateReplay403JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
 		if err == metered.ErrResourceExhausted {
 			return gen.EventUpdateReplay429JSONResponse(
 				apierrors.NewAPIErrors("Event limit exceeded"),
@@ -53,6 +63,10 @@ func (t *EventService) EventUpdateReplay(ctx echo.Context, request gen.EventUpda
 		return nil, allErrs
 	}
 
+	audit.AddDetails(ctx, map[string]any{
+		"newEventIds": newEventIds,
+	})
+
 	newEvents, err := t.config.APIRepository.Event().ListEventsById(tenant.ID, newEventIds)
 
 	if err != nil {
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *EventService) EventUpdateReplayWithPayload(ctx echo.Context, request gen.EventUpdateReplayWithPayloadRequestObject) (gen.EventUpdateReplayWithPayloadResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	event := ctx.Get("event").(*db.EventModel)
+
+	replayedEvent, err := t.config.EngineRepository.Event().GetEventForEngine(ctx.Request().Context(), tenant.ID, event.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the replayed event keeps the id of the original event, so the new event is linked to it
+	if request.Body.Data != nil {
+		replayedEvent.Data, err = json.Marshal(request.Body.Data)
+
+		if err != nil {
+			return gen.EventUpdateReplayWithPayload400JSONResponse(
+				apierrors.NewAPIErrors("Invalid event data"),
+			), nil
+		}
+	}
+
+	if request.Body.AdditionalMetadata != nil {
+		replayedEvent.AdditionalMetadata, err = json.Marshal(request.Body.AdditionalMetadata)
+
+		if err != nil {
+			return gen.EventUpdateReplayWithPayload400JSONResponse(
+				apierrors.NewAPIErrors("Invalid additional metadata"),
+			), nil
+		}
+	}
+
+	newEvent, err := t.config.Ingestor.IngestReplayedEvent(ctx.Request().Context(), tenant.ID, replayedEvent)
+
+	if errors.Is(err, repository.ErrNotInNamespace) {
+		return gen.EventUpdateReplayWithPayload403JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	if err == metered.ErrResourceExhausted {
+		return gen.EventUpdateReplayWithPayload429JSONResponse(
+			apierrors.NewAPIErrors("Event limit exceeded"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	audit.AddDetails(ctx, map[string]any{
+		"newEventId": sqlchelpers.UUIDToStr(newEvent.ID),
+	})
+
+	newEventModel, err := t.config.APIRepository.Event().GetEventById(sqlchelpers.UUIDToStr(newEvent.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventUpdateReplayWithPayload200JSONResponse(
+		*transformers.ToEvent(newEventModel),
+	), nil
+}
@@ -21,6 +21,30 @@ func (u *MetadataService) MetadataGet(ctx echo.Context, request gen.MetadataGetR
 		authTypes = append(authTypes, "github")
 	}
 
+	if u.config.Auth.SAML != nil {
+		authTypes = append(authTypes, "saml")
+	}
+
+	// the additional providers are listed in the order in which they're configured
+	oauthProviders := make([]gen.APIMetaOAuthProvider, 0, len(u.config.Auth.ConfigFile.OAuthProviders))
+
+	for _, p := range u.config.Auth.ConfigFile.OAuthProviders {
+		displayName := p.DisplayName
+
+		if displayName == "" {
+			displayName = p.Name
+		}
+
+		oauthProviders = append(oauthProviders, gen.APIMetaOAuthProvider{
+			Name:        p.Name,
+			DisplayName: displayName,
+		})
+	}
+
+	if len(oauthProviders) > 0 {
+		authTypes = append(authTypes, "oauth")
+	}
+
 	pylonAppID := u.config.Pylon.AppID
 
 	var posthogConfig *gen.APIMetaPosthog
@@ -34,7 +58,8 @@ func (u *MetadataService) MetadataGet(ctx echo.Context, request gen.MetadataGetR
 
 	meta := gen.APIMeta{
 		Auth: &gen.APIMetaAuth{
-			Schemes: &authTypes,
+			Schemes:        &authTypes,
+			OauthProviders: &oauthProviders,
 		},
 		PylonAppId:          &pylonAppID,
 		Posthog:             posthogConfig,
@@ -9,6 +9,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 )
 
@@ -32,7 +33,7 @@ func (g *SlackAppService) UserUpdateSlackOauthCallback(ctx echo.Context, _ gen.U
 		err := sh.RemoveKey(ctx, "tenant")
 
 		if err != nil {
-			g.config.Logger.Error().Msgf("Could not remove tenant key: %v", err)
+			serverutils.RequestLogger(ctx.Request().Context(), g.config.Logger).Error().Msgf("Could not remove tenant key: %v", err)
 		}
 	}()
 
@@ -55,7 +56,13 @@ func (g *SlackAppService) UserUpdateSlackOauthCallback(ctx echo.Context, _ gen.U
 		return nil, redirect.GetRedirectWithError(ctx, g.config.Logger, err, "Forbidden")
 	}
 
-	webhookURLEncrypted, err := g.config.Encryption.Encrypt([]byte(resp.IncomingWebhook.URL), "incoming_webhook_url")
+	enc, err := g.config.Encryption.ForTenant(tenantId)
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, g.config.Logger, err, "Could not link Slack account. An internal error occurred.")
+	}
+
+	webhookURLEncrypted, err := enc.Encrypt([]byte(resp.IncomingWebhook.URL), "incoming_webhook_url")
 
 	if err != nil {
 		return nil, redirect.GetRedirectWithError(ctx, g.config.Logger, err, "Could not link Slack account. An internal error occurred.")
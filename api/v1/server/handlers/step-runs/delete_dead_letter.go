@@ -0,0 +1,34 @@
+package stepruns
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *StepRunService) StepRunDeleteDeadLetter(ctx echo.Context, request gen.StepRunDeleteDeadLetterRequestObject) (gen.StepRunDeleteDeadLetterResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	stepRun := ctx.Get("step-run").(*repository.GetStepRunFull)
+
+	deleted, err := t.config.APIRepository.StepRun().DeleteStepRunDeadLetter(
+		ctx.Request().Context(),
+		tenant.ID,
+		sqlchelpers.UUIDToStr(stepRun.ID),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !deleted {
+		return gen.StepRunDeleteDeadLetter404JSONResponse(
+			apierrors.NewAPIErrors("Step run is not dead-lettered."),
+		), nil
+	}
+
+	return gen.StepRunDeleteDeadLetter204Response{}, nil
+}
@@ -0,0 +1,70 @@
+package stepruns
+
+import (
+	"math"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *StepRunService) StepRunListDeadLetters(ctx echo.Context, request gen.StepRunListDeadLettersRequestObject) (gen.StepRunListDeadLettersResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListStepRunDeadLettersOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	listRes, err := t.config.APIRepository.StepRun().ListStepRunDeadLetters(
+		ctx.Request().Context(),
+		tenant.ID,
+		listOpts,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.StepRunDeadLetter, len(listRes.Rows))
+
+	for i := range listRes.Rows {
+		rows[i] = *transformers.ToStepRunDeadLetter(listRes.Rows[i])
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.StepRunListDeadLetters200JSONResponse(
+		gen.StepRunDeadLetterList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
@@ -0,0 +1,87 @@
+package stepruns
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *StepRunService) StepRunUpdateRequeue(ctx echo.Context, request gen.StepRunUpdateRequeueRequestObject) (gen.StepRunUpdateRequeueResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	stepRun := ctx.Get("step-run").(*repository.GetStepRunFull)
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.ID)
+
+	_, err := t.config.APIRepository.StepRun().GetStepRunDeadLetter(ctx.Request().Context(), tenant.ID, stepRunId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.StepRunUpdateRequeue404JSONResponse(
+				apierrors.NewAPIErrors("Step run is not dead-lettered."),
+			), nil
+		}
+
+		return nil, fmt.Errorf("could not get step run dead letter: %w", err)
+	}
+
+	err = t.config.EngineRepository.StepRun().PreflightCheckReplayStepRun(
+		ctx.Request().Context(),
+		tenant.ID,
+		stepRunId,
+	)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNoWorkerAvailable) {
+			return gen.StepRunUpdateRequeue400JSONResponse(
+				apierrors.NewAPIErrors("There are no workers available to execute this step run."),
+			), nil
+		}
+
+		if errors.Is(err, repository.ErrPreflightReplayStepRunNotInFinalState) {
+			return gen.StepRunUpdateRequeue400JSONResponse(
+				apierrors.NewAPIErrors("Step run cannot be requeued because it is not finished running yet."),
+			), nil
+		}
+
+		if errors.Is(err, repository.ErrPreflightReplayChildStepRunNotInFinalState) {
+			return gen.StepRunUpdateRequeue400JSONResponse(
+				apierrors.NewAPIErrors("Step run cannot be requeued because it has child step runs that are not finished running yet."),
+			), nil
+		}
+
+		return nil, fmt.Errorf("could not preflight check step run: %w", err)
+	}
+
+	engineStepRun, err := t.config.EngineRepository.StepRun().GetStepRunForEngine(
+		ctx.Request().Context(),
+		tenant.ID,
+		stepRunId,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get step run for engine: %w", err)
+	}
+
+	// the step run is replayed with its previous input. The replay removes it from the dead letters in the same
+	// transaction which resets the step run, so it stays dead-lettered if the replay fails.
+	err = t.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.StepRunReplayToTask(engineStepRun, nil),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add step run replay task to task queue: %w", err)
+	}
+
+	return gen.StepRunUpdateRequeue204Response{}, nil
+}
@@ -0,0 +1,64 @@
+package tenants
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) AlertMuteRuleCreate(ctx echo.Context, request gen.AlertMuteRuleCreateRequestObject) (gen.AlertMuteRuleCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.AlertMuteRuleCreate400JSONResponse(*apiErrors), nil
+	}
+
+	workflowId := request.Body.WorkflowId.String()
+
+	// make sure the workflow belongs to the tenant
+	workflow, err := t.config.APIRepository.Workflow().GetWorkflowById(ctx.Request().Context(), workflowId)
+
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && sqlchelpers.UUIDToStr(workflow.Workflow.TenantId) != tenant.ID) {
+		return gen.AlertMuteRuleCreate400JSONResponse(
+			apierrors.NewAPIErrors("The workflow does not exist.", "workflowId"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// construct the database query
+	createOpts := &repository.CreateTenantAlertMuteRuleOpts{
+		WorkflowId: workflowId,
+		MutedUntil: request.Body.MutedUntil,
+		Reason:     request.Body.Reason,
+	}
+
+	rule, err := t.config.APIRepository.TenantAlertingSettings().CreateTenantAlertMuteRule(ctx.Request().Context(), tenant.ID, createOpts)
+
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return gen.AlertMuteRuleCreate400JSONResponse(
+			apierrors.NewAPIErrors("The workflow is already muted.", "workflowId"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AlertMuteRuleCreate201JSONResponse(
+		*transformers.ToTenantAlertMuteRule(rule),
+	), nil
+}
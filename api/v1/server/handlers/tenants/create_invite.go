@@ -9,6 +9,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
@@ -61,6 +62,8 @@ func (t *TenantService) TenantInviteCreate(ctx echo.Context, request gen.TenantI
 		return nil, err
 	}
 
+	l := serverutils.RequestLogger(ctx.Request().Context(), t.config.Logger)
+
 	// send an email
 	go func() {
 		emailCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -77,7 +80,7 @@ func (t *TenantService) TenantInviteCreate(ctx echo.Context, request gen.TenantI
 			TenantName:       tenant.Name,
 			ActionURL:        t.config.Runtime.ServerURL,
 		}); err != nil {
-			t.config.Logger.Err(err).Msg("could not send tenant invite email")
+			l.Err(err).Msg("could not send tenant invite email")
 		}
 	}()
 
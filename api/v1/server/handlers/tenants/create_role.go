@@ -0,0 +1,52 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *TenantService) TenantRoleCreate(ctx echo.Context, request gen.TenantRoleCreateRequestObject) (gen.TenantRoleCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantRoleCreate400JSONResponse(*apiErrors), nil
+	}
+
+	permissions, err := toPermissions(request.Body.Permissions)
+
+	if err != nil {
+		return gen.TenantRoleCreate400JSONResponse(apierrors.NewAPIErrors(err.Error(), "permissions")), nil
+	}
+
+	taken, err := t.roleNameTaken(ctx.Request().Context(), tenant.ID, request.Body.Name, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if taken {
+		return gen.TenantRoleCreate400JSONResponse(apierrors.NewAPIErrors("a role with this name already exists", "name")), nil
+	}
+
+	role, err := t.config.APIRepository.TenantRole().CreateTenantRole(ctx.Request().Context(), tenant.ID, &repository.CreateTenantRoleOpts{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Permissions: permissions,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantRoleCreate200JSONResponse(
+		*transformers.ToTenantRole(role),
+	), nil
+}
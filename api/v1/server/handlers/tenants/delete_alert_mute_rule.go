@@ -0,0 +1,21 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) AlertMuteRuleDelete(ctx echo.Context, request gen.AlertMuteRuleDeleteRequestObject) (gen.AlertMuteRuleDeleteResponseObject, error) {
+	rule := ctx.Get("alert-mute-rule").(*dbsqlc.TenantAlertMuteRule)
+
+	err := t.config.APIRepository.TenantAlertingSettings().DeleteTenantAlertMuteRule(ctx.Request().Context(), sqlchelpers.UUIDToStr(rule.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AlertMuteRuleDelete204Response{}, nil
+}
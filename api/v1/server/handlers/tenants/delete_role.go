@@ -0,0 +1,21 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) TenantRoleDelete(ctx echo.Context, request gen.TenantRoleDeleteRequestObject) (gen.TenantRoleDeleteResponseObject, error) {
+	role := ctx.Get("tenant-role").(*dbsqlc.TenantRole)
+
+	err := t.config.APIRepository.TenantRole().DeleteTenantRole(ctx.Request().Context(), sqlchelpers.UUIDToStr(role.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantRoleDelete204Response{}, nil
+}
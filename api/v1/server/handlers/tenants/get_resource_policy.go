@@ -20,6 +20,6 @@ func (t *TenantService) TenantResourcePolicyGet(ctx echo.Context, request gen.Te
 	}
 
 	return gen.TenantResourcePolicyGet200JSONResponse(
-		*transformers.ToTenantResourcePolicy(limits),
+		*transformers.ToTenantResourcePolicy(limits, tenant.DataRetentionPeriod),
 	), nil
 }
@@ -0,0 +1,29 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *TenantService) AlertMuteRuleList(ctx echo.Context, request gen.AlertMuteRuleListRequestObject) (gen.AlertMuteRuleListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	rules, err := t.config.APIRepository.TenantAlertingSettings().ListTenantAlertMuteRules(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.TenantAlertMuteRule, len(rules))
+
+	for i := range rules {
+		rows[i] = *transformers.ToTenantAlertMuteRule(rules[i])
+	}
+
+	return gen.AlertMuteRuleList200JSONResponse{
+		Rows: &rows,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package tenants
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/auth/authorizer"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// toPermissions checks that the requested permissions exist, and removes duplicates.
+func toPermissions(requested []gen.TenantPermission) ([]string, error) {
+	permissions := make([]string, 0, len(requested))
+	seen := make(map[string]bool, len(requested))
+
+	for _, p := range requested {
+		permission := string(p)
+
+		if !authorizer.IsPermission(permission) {
+			return nil, fmt.Errorf("unknown permission %s", permission)
+		}
+
+		if seen[permission] {
+			continue
+		}
+
+		seen[permission] = true
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
+
+// roleNameTaken returns true if another custom role of the tenant has the name.
+func (t *TenantService) roleNameTaken(ctx context.Context, tenantId, name, exceptId string) (bool, error) {
+	roles, err := t.config.APIRepository.TenantRole().ListTenantRoles(ctx, tenantId)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range roles {
+		if role.Name == name && sqlchelpers.UUIDToStr(role.ID) != exceptId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -44,7 +44,9 @@ func (t *TenantService) TenantUpdate(ctx echo.Context, request gen.TenantUpdateR
 	if request.Body.MaxAlertingFrequency != nil ||
 		request.Body.EnableExpiringTokenAlerts != nil ||
 		request.Body.EnableTenantResourceLimitAlerts != nil ||
-		request.Body.EnableWorkflowRunFailureAlerts != nil {
+		request.Body.EnableWorkflowRunFailureAlerts != nil ||
+		request.Body.WorkflowRunFailureThreshold != nil ||
+		request.Body.EnableWorkerOfflineAlerts != nil {
 
 		_, err = t.config.APIRepository.TenantAlertingSettings().UpsertTenantAlertingSettings(
 			tenant.ID,
@@ -53,6 +55,8 @@ func (t *TenantService) TenantUpdate(ctx echo.Context, request gen.TenantUpdateR
 				EnableExpiringTokenAlerts:       request.Body.EnableExpiringTokenAlerts,
 				EnableWorkflowRunFailureAlerts:  request.Body.EnableWorkflowRunFailureAlerts,
 				EnableTenantResourceLimitAlerts: request.Body.EnableTenantResourceLimitAlerts,
+				WorkflowRunFailureThreshold:     request.Body.WorkflowRunFailureThreshold,
+				EnableWorkerOfflineAlerts:       request.Body.EnableWorkerOfflineAlerts,
 			},
 		)
 
@@ -61,7 +65,23 @@ func (t *TenantService) TenantUpdate(ctx echo.Context, request gen.TenantUpdateR
 		}
 	}
 
+	res := transformers.ToTenant(tenant)
+
+	if request.Body.IsPaused != nil {
+		engineTenant, err := t.config.EngineRepository.Tenant().UpdateTenantPaused(
+			ctx.Request().Context(),
+			tenant.ID,
+			*request.Body.IsPaused,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.IsPaused = &engineTenant.IsPaused
+	}
+
 	return gen.TenantUpdate200JSONResponse(
-		*transformers.ToTenant(tenant),
+		*res,
 	), nil
 }
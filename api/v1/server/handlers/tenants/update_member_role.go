@@ -0,0 +1,66 @@
+package tenants
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) TenantMemberUpdateRole(ctx echo.Context, request gen.TenantMemberUpdateRoleRequestObject) (gen.TenantMemberUpdateRoleResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	memberToUpdate, err := t.config.APIRepository.Tenant().GetTenantMemberByID(request.Member.String())
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, err
+	}
+
+	if memberToUpdate == nil || memberToUpdate.TenantID != tenant.ID {
+		return gen.TenantMemberUpdateRole404JSONResponse(
+			apierrors.NewAPIErrors("Member not found"),
+		), nil
+	}
+
+	var roleId *string
+
+	if request.Body.CustomRoleId != nil {
+		// owners always have all permissions
+		if memberToUpdate.Role == db.TenantMemberRoleOwner {
+			return gen.TenantMemberUpdateRole400JSONResponse(
+				apierrors.NewAPIErrors("Owners cannot have a custom role", "customRoleId"),
+			), nil
+		}
+
+		role, err := t.config.APIRepository.TenantRole().GetTenantRoleById(ctx.Request().Context(), request.Body.CustomRoleId.String())
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		if role == nil || sqlchelpers.UUIDToStr(role.TenantId) != tenant.ID {
+			return gen.TenantMemberUpdateRole404JSONResponse(
+				apierrors.NewAPIErrors("Role not found"),
+			), nil
+		}
+
+		id := sqlchelpers.UUIDToStr(role.ID)
+		roleId = &id
+	}
+
+	err = t.config.APIRepository.TenantRole().SetTenantMemberCustomRole(ctx.Request().Context(), memberToUpdate.ID, roleId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantMemberUpdateRole200JSONResponse(
+		*transformers.ToTenantMember(memberToUpdate),
+	), nil
+}
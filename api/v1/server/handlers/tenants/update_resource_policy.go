@@ -0,0 +1,103 @@
+package tenants
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func (t *TenantService) TenantResourcePolicyUpdate(ctx echo.Context, request gen.TenantResourcePolicyUpdateRequestObject) (gen.TenantResourcePolicyUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantResourcePolicyUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	if request.Body.Limits != nil && len(*request.Body.Limits) > 0 && !t.config.Runtime.EnforceLimits {
+		return gen.TenantResourcePolicyUpdate400JSONResponse(
+			apierrors.NewAPIErrors("resource limits are not enforced by this Hatchet instance", "limits"),
+		), nil
+	}
+
+	if request.Body.Limits != nil {
+		// validate all limits before updating any of them, so that invalid requests don't change limits
+		for _, limit := range *request.Body.Limits {
+			if apiErrors := validateResourceLimit(limit); apiErrors != nil {
+				return gen.TenantResourcePolicyUpdate400JSONResponse(*apiErrors), nil
+			}
+		}
+
+		for _, limit := range *request.Body.Limits {
+			opts := &repository.UpdateTenantLimitOpts{
+				Resource: dbsqlc.LimitResource(limit.Resource),
+				Limit:    int32(limit.LimitValue), // nolint: gosec
+				Window:   limit.Window,
+			}
+
+			if limit.AlarmValue != nil {
+				alarm := int32(*limit.AlarmValue) // nolint: gosec
+				opts.Alarm = &alarm
+			}
+
+			_, err := t.config.EntitlementRepository.TenantLimit().UpdateLimit(ctx.Request().Context(), tenant.ID, opts)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if request.Body.DataRetentionPeriod != nil {
+		var err error
+
+		tenant, err = t.config.APIRepository.Tenant().UpdateTenant(tenant.ID, &repository.UpdateTenantOpts{
+			DataRetentionPeriod: request.Body.DataRetentionPeriod,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limits, err := t.config.EntitlementRepository.TenantLimit().GetLimits(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantResourcePolicyUpdate200JSONResponse(
+		*transformers.ToTenantResourcePolicy(limits, tenant.DataRetentionPeriod),
+	), nil
+}
+
+func validateResourceLimit(limit gen.UpdateTenantResourceLimit) *gen.APIErrors {
+	switch limit.Resource {
+	case gen.CRON, gen.EVENT, gen.QUEUEDRUN, gen.SCHEDULE, gen.WORKER, gen.WORKFLOWRUN:
+	default:
+		apiErrors := apierrors.NewAPIErrors(fmt.Sprintf("invalid resource %s", limit.Resource), "resource")
+		return &apiErrors
+	}
+
+	// the window of counted resources is ignored
+	if limit.Window == nil || repository.IsCountedResource(dbsqlc.LimitResource(limit.Resource)) {
+		return nil
+	}
+
+	if window, err := time.ParseDuration(*limit.Window); err != nil || window < repository.MinLimitWindow {
+		apiErrors := apierrors.NewAPIErrors(fmt.Sprintf("window must be a duration of at least %s, like 1m or 24h", repository.MinLimitWindow), "window")
+		return &apiErrors
+	}
+
+	return nil
+}
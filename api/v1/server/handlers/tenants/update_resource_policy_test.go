@@ -0,0 +1,136 @@
+package tenants
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type fakeEntitlementsRepository struct {
+	limits *fakeTenantLimitRepository
+}
+
+func (r *fakeEntitlementsRepository) TenantLimit() repository.TenantLimitRepository {
+	return r.limits
+}
+
+type fakeTenantLimitRepository struct {
+	repository.TenantLimitRepository
+
+	updated []*repository.UpdateTenantLimitOpts
+}
+
+func (r *fakeTenantLimitRepository) UpdateLimit(ctx context.Context, tenantId string, opts *repository.UpdateTenantLimitOpts) (*dbsqlc.TenantResourceLimit, error) {
+	r.updated = append(r.updated, opts)
+	return &dbsqlc.TenantResourceLimit{Resource: opts.Resource, LimitValue: opts.Limit}, nil
+}
+
+func (r *fakeTenantLimitRepository) GetLimits(ctx context.Context, tenantId string) ([]*dbsqlc.TenantResourceLimit, error) {
+	limits := make([]*dbsqlc.TenantResourceLimit, 0, len(r.updated))
+
+	for _, opts := range r.updated {
+		limits = append(limits, &dbsqlc.TenantResourceLimit{Resource: opts.Resource, LimitValue: opts.Limit})
+	}
+
+	return limits, nil
+}
+
+func newTestResourcePolicyService() (*TenantService, *fakeTenantLimitRepository) {
+	limits := &fakeTenantLimitRepository{}
+
+	return NewTenantService(&server.ServerConfig{
+		Config: &database.Config{
+			EntitlementRepository: &fakeEntitlementsRepository{limits: limits},
+		},
+		Runtime: server.ConfigFileRuntime{
+			EnforceLimits: true,
+		},
+		Validator: validator.NewDefaultValidator(),
+	}), limits
+}
+
+func updateResourcePolicy(t *testing.T, svc *TenantService, limits ...gen.UpdateTenantResourceLimit) gen.TenantResourcePolicyUpdateResponseObject {
+	t.Helper()
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodPatch, "/", nil), httptest.NewRecorder())
+	c.Set("tenant", &db.TenantModel{InnerTenant: db.InnerTenant{ID: uuid.NewString()}})
+
+	res, err := svc.TenantResourcePolicyUpdate(c, gen.TenantResourcePolicyUpdateRequestObject{
+		Body: &gen.UpdateTenantResourcePolicyRequest{
+			Limits: &limits,
+		},
+	})
+
+	require.NoError(t, err)
+
+	return res
+}
+
+func TestTenantResourcePolicyUpdate(t *testing.T) {
+	svc, limits := newTestResourcePolicyService()
+
+	window := "1m"
+
+	res := updateResourcePolicy(t, svc,
+		gen.UpdateTenantResourceLimit{Resource: gen.EVENT, LimitValue: 600, Window: &window},
+		gen.UpdateTenantResourceLimit{Resource: gen.QUEUEDRUN, LimitValue: 50},
+	)
+
+	policy, ok := res.(gen.TenantResourcePolicyUpdate200JSONResponse)
+	require.True(t, ok, "unexpected response %T", res)
+	assert.Len(t, policy.Limits, 2)
+
+	require.Len(t, limits.updated, 2)
+	assert.Equal(t, dbsqlc.LimitResourceEVENT, limits.updated[0].Resource)
+	assert.Equal(t, &window, limits.updated[0].Window)
+}
+
+func TestTenantResourcePolicyUpdateInvalidLimits(t *testing.T) {
+	for name, limit := range map[string]gen.UpdateTenantResourceLimit{
+		"unparseable window":    {Resource: gen.EVENT, LimitValue: 600, Window: repository.StringPtr("every minute")},
+		"zero window":           {Resource: gen.EVENT, LimitValue: 600, Window: repository.StringPtr("0s")},
+		"negative window":       {Resource: gen.WORKFLOWRUN, LimitValue: 600, Window: repository.StringPtr("-1h")},
+		"sub-second window":     {Resource: gen.EVENT, LimitValue: 600, Window: repository.StringPtr("500us")},
+		"unknown resource":      {Resource: gen.TenantResource("GPU"), LimitValue: 1},
+		"negative limit":        {Resource: gen.EVENT, LimitValue: -1},
+		"window without a unit": {Resource: gen.EVENT, LimitValue: 600, Window: repository.StringPtr("1")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, limits := newTestResourcePolicyService()
+
+			res := updateResourcePolicy(t, svc,
+				gen.UpdateTenantResourceLimit{Resource: gen.WORKER, LimitValue: 10},
+				limit,
+			)
+
+			_, ok := res.(gen.TenantResourcePolicyUpdate400JSONResponse)
+			assert.True(t, ok, "unexpected response %T", res)
+
+			// no limit is changed, including the valid ones of the request
+			assert.Empty(t, limits.updated)
+		})
+	}
+
+	// the window of counted resources is ignored
+	svc, limits := newTestResourcePolicyService()
+
+	res := updateResourcePolicy(t, svc, gen.UpdateTenantResourceLimit{Resource: gen.WORKER, LimitValue: 10, Window: repository.StringPtr("0s")})
+
+	_, ok := res.(gen.TenantResourcePolicyUpdate200JSONResponse)
+	assert.True(t, ok, "unexpected response %T", res)
+	assert.Len(t, limits.updated, 1)
+}
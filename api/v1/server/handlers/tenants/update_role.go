@@ -0,0 +1,61 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) TenantRoleUpdate(ctx echo.Context, request gen.TenantRoleUpdateRequestObject) (gen.TenantRoleUpdateResponseObject, error) {
+	role := ctx.Get("tenant-role").(*dbsqlc.TenantRole)
+	roleId := sqlchelpers.UUIDToStr(role.ID)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantRoleUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	updateOpts := &repository.UpdateTenantRoleOpts{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+	}
+
+	if request.Body.Permissions != nil {
+		permissions, err := toPermissions(*request.Body.Permissions)
+
+		if err != nil {
+			return gen.TenantRoleUpdate400JSONResponse(apierrors.NewAPIErrors(err.Error(), "permissions")), nil
+		}
+
+		updateOpts.Permissions = permissions
+	}
+
+	if request.Body.Name != nil {
+		taken, err := t.roleNameTaken(ctx.Request().Context(), sqlchelpers.UUIDToStr(role.TenantId), *request.Body.Name, roleId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if taken {
+			return gen.TenantRoleUpdate400JSONResponse(apierrors.NewAPIErrors("a role with this name already exists", "name")), nil
+		}
+	}
+
+	role, err := t.config.APIRepository.TenantRole().UpdateTenantRole(ctx.Request().Context(), roleId, updateOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantRoleUpdate200JSONResponse(
+		*transformers.ToTenantRole(role),
+	), nil
+}
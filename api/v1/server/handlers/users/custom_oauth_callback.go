@@ -0,0 +1,265 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOauthCallback(ctx echo.Context, request gen.UserUpdateOauthCallbackRequestObject) (gen.UserUpdateOauthCallbackResponseObject, error) {
+	provider, ok := u.config.Auth.OAuthProviders[request.Provider]
+
+	if !ok {
+		return nil, u.oauthRedirectWithError(ctx, fmt.Errorf("unknown oauth provider %q", request.Provider), oauthErrUnknownProvider, "Unknown login provider.")
+	}
+
+	isValid, _, err := authn.NewSessionHelpers(u.config).ValidateOAuthState(ctx, provider.Name)
+
+	if err != nil || !isValid {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInvalidState, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	var nonce string
+
+	if provider.IDTokenVerifier != nil {
+		nonce, err = u.popOAuthNonce(ctx, provider.Name)
+
+		if err != nil {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrInvalidState, "Could not log in. Please try again and make sure cookies are enabled.")
+		}
+	}
+
+	token, err := provider.Config.Exchange(context.Background(), ctx.Request().URL.Query().Get("code"))
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrForbidden, "Forbidden")
+	}
+
+	if !token.Valid() {
+		return nil, u.oauthRedirectWithError(ctx, fmt.Errorf("invalid token"), oauthErrForbidden, "Forbidden")
+	}
+
+	// a logged in user links the provider to their account
+	currentUserId, err := authn.NewSessionHelpers(u.config).GetAuthenticatedUserId(ctx)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrCookie, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	user, err := u.upsertCustomUserFromToken(u.config, provider, token, nonce, currentUserId)
+
+	if err != nil {
+		if errors.Is(err, ErrOAuthLinkRequired) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrLinkRequired, fmt.Sprintf("A user with this email already exists. Log in to that account, then log in with %s again to link it.", provider.Name))
+		}
+
+		if errors.Is(err, ErrOAuthLinkedToOtherUser) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrLinkedToOther, fmt.Sprintf("This %s account is already linked to another user.", provider.Name))
+		}
+
+		if errors.Is(err, ErrNotInRestrictedDomain) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrRestrictedDomain, "Email is not in the restricted domain group.")
+		}
+
+		if errors.Is(err, ErrOAuthNoEmail) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrEmailMissing, fmt.Sprintf("%s user must have an email.", provider.Name))
+		}
+
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
+	}
+
+	err = authn.NewSessionHelpers(u.config).SaveAuthenticated(ctx, user)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
+	}
+
+	return gen.UserUpdateOauthCallback302Response{
+		Headers: gen.UserUpdateOauthCallback302ResponseHeaders{
+			Location: u.popOAuthReturnTo(ctx, provider.Name),
+		},
+	}, nil
+}
+
+// upsertCustomUserFromToken logs in with the account of a configured provider. The name of the provider
+// is recorded as the provider of the OAuth account which is linked to the user.
+func (u *UserService) upsertCustomUserFromToken(config *server.ServerConfig, provider *server.OAuthProvider, tok *oauth2.Token, nonce, currentUserId string) (*db.UserModel, error) {
+	info, err := getCustomUserInfoFromToken(provider, tok, nonce)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.checkUserRestrictionsForEmail(config, info.Email); err != nil {
+		return nil, err
+	}
+
+	oauthOpts, err := newOAuthOpts(config, provider.Name, info.Subject, tok)
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.upsertUserFromOAuthClaims(currentUserId, &info.oauthUserClaims, oauthOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.assignTenantRolesFromGroups(provider, user.ID, info.Groups); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+var ErrOAuthNoEmail = errors.New("oauth user must have an email")
+
+type customUserInfo struct {
+	oauthUserClaims
+
+	Subject string
+
+	Groups []string
+}
+
+// getCustomUserInfoFromToken returns the claims of the user, which are read from the ID token for OpenID
+// Connect providers, and from the user info URL with the access token otherwise.
+func getCustomUserInfoFromToken(provider *server.OAuthProvider, tok *oauth2.Token, nonce string) (*customUserInfo, error) {
+	var (
+		contents []byte
+		err      error
+	)
+
+	if provider.IDTokenVerifier != nil {
+		contents, err = getIDTokenClaims(provider, tok, nonce)
+	} else {
+		contents, err = getUserInfoClaims(provider, tok)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// decode numbers as json.Number, so that numeric subjects are not formatted as floats
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.UseNumber()
+
+	claims := map[string]interface{}{}
+
+	if err := dec.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("failed parsing claims: %s", err.Error())
+	}
+
+	return customUserInfoFromClaims(provider, claims)
+}
+
+// getUserInfoClaims calls the user info URL of the provider with the access token.
+func getUserInfoClaims(provider *server.OAuthProvider, tok *oauth2.Token) ([]byte, error) {
+	response, err := provider.Config.Client(context.Background(), tok).Get(provider.UserInfoURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed getting user info: %s", err.Error())
+	}
+
+	defer response.Body.Close()
+
+	contents, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed reading response body: %s", err.Error())
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed getting user info: status %d", response.StatusCode)
+	}
+
+	return contents, nil
+}
+
+// getIDTokenClaims verifies the signature, issuer, audience and expiry of the ID token which was returned
+// with the access token, and checks that it contains the nonce of the login.
+func getIDTokenClaims(provider *server.OAuthProvider, tok *oauth2.Token, nonce string) ([]byte, error) {
+	rawIDToken, ok := tok.Extra("id_token").(string)
+
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("%s did not return an id token", provider.Name)
+	}
+
+	idToken, err := provider.IDTokenVerifier.Verify(context.Background(), rawIDToken)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed verifying id token: %s", err.Error())
+	}
+
+	if nonce == "" || idToken.Nonce != nonce {
+		return nil, fmt.Errorf("id token nonce does not match")
+	}
+
+	var claims json.RawMessage
+
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed reading id token claims: %s", err.Error())
+	}
+
+	return claims, nil
+}
+
+// customUserInfoFromClaims maps the claims of the user with the claim keys of the provider.
+func customUserInfoFromClaims(provider *server.OAuthProvider, claims map[string]interface{}) (*customUserInfo, error) {
+	info := &customUserInfo{}
+
+	info.Subject = stringClaim(claims[provider.Claims.Subject])
+
+	if info.Subject == "" {
+		return nil, fmt.Errorf("%s user has no %s claim", provider.Name, provider.Claims.Subject)
+	}
+
+	info.Email = stringClaim(claims[provider.Claims.Email])
+
+	if info.Email == "" {
+		return nil, ErrOAuthNoEmail
+	}
+
+	info.Name = optionalClaim(stringClaim(claims[provider.Claims.Name]))
+
+	info.Groups = stringsClaim(claims[provider.Claims.Groups])
+
+	// some providers return the email verified claim as a string
+	switch verified := claims[provider.Claims.EmailVerified].(type) {
+	case bool:
+		info.EmailVerified = &verified
+	case string:
+		if b, err := strconv.ParseBool(verified); err == nil {
+			info.EmailVerified = &b
+		}
+	}
+
+	return info, nil
+}
+
+// stringClaim returns a string or numeric claim as a string, and an empty string for other claims.
+func stringClaim(claim interface{}) string {
+	switch val := claim.(type) {
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,181 @@
+package users
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+func newTestOAuthProvider(t *testing.T, userInfo string, claims server.ConfigFileAuthOAuthClaims) *server.OAuthProvider {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(userInfo))
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return &server.OAuthProvider{
+		Name:        "okta",
+		Config:      &oauth2.Config{},
+		UserInfoURL: srv.URL,
+		Claims:      claims,
+	}
+}
+
+func TestGetCustomUserInfoFromToken(t *testing.T) {
+	tok := &oauth2.Token{AccessToken: "token"}
+
+	defaultClaims := server.ConfigFileAuthOAuthClaims{
+		Subject:       "sub",
+		Email:         "email",
+		EmailVerified: "email_verified",
+		Name:          "name",
+	}
+
+	provider := newTestOAuthProvider(t, `{"sub":"user-1","email":"user@example.com","email_verified":true,"name":"User"}`, defaultClaims)
+
+	info, err := getCustomUserInfoFromToken(provider, tok, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-1", info.Subject)
+	assert.Equal(t, "user@example.com", info.Email)
+	require.NotNil(t, info.EmailVerified)
+	assert.True(t, *info.EmailVerified)
+	require.NotNil(t, info.Name)
+	assert.Equal(t, "User", *info.Name)
+
+	// claims are mapped with the claim keys of the provider
+	provider = newTestOAuthProvider(t, `{"id":12345678901,"mail":"user@example.com","verified":"false"}`, server.ConfigFileAuthOAuthClaims{
+		Subject:       "id",
+		Email:         "mail",
+		EmailVerified: "verified",
+		Name:          "display_name",
+	})
+
+	info, err = getCustomUserInfoFromToken(provider, tok, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "12345678901", info.Subject)
+	assert.Equal(t, "user@example.com", info.Email)
+	require.NotNil(t, info.EmailVerified)
+	assert.False(t, *info.EmailVerified)
+	assert.Nil(t, info.Name)
+
+	provider = newTestOAuthProvider(t, `{"sub":"user-1"}`, defaultClaims)
+
+	_, err = getCustomUserInfoFromToken(provider, tok, "")
+	assert.ErrorIs(t, err, ErrOAuthNoEmail)
+
+	provider = newTestOAuthProvider(t, `{"email":"user@example.com"}`, defaultClaims)
+
+	_, err = getCustomUserInfoFromToken(provider, tok, "")
+	assert.ErrorContains(t, err, "no sub claim")
+
+	_, err = getCustomUserInfoFromToken(provider, &oauth2.Token{AccessToken: "other"}, "")
+	assert.ErrorContains(t, err, "status 401")
+}
+
+func TestGetCustomUserInfoFromIDToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+	require.NoError(t, err)
+
+	const issuer = "https://idp.example.com"
+
+	provider := &server.OAuthProvider{
+		Name:   "okta",
+		Config: &oauth2.Config{},
+		IDTokenVerifier: oidc.NewVerifier(issuer, &oidc.StaticKeySet{PublicKeys: []crypto.PublicKey{key.Public()}}, &oidc.Config{
+			ClientID: "client-id",
+		}),
+		Claims: server.ConfigFileAuthOAuthClaims{
+			Subject:       "sub",
+			Email:         "email",
+			EmailVerified: "email_verified",
+			Name:          "preferred_username",
+			Groups:        "groups",
+		},
+	}
+
+	newToken := func(claims map[string]any) *oauth2.Token {
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+
+		jws, err := signer.Sign(payload)
+		require.NoError(t, err)
+
+		raw, err := jws.CompactSerialize()
+		require.NoError(t, err)
+
+		return (&oauth2.Token{AccessToken: "token"}).WithExtra(map[string]any{"id_token": raw})
+	}
+
+	claims := map[string]any{
+		"iss":                issuer,
+		"aud":                "client-id",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"nonce":              "nonce",
+		"sub":                "user-1",
+		"email":              "user@example.com",
+		"email_verified":     true,
+		"preferred_username": "User",
+		"groups":             []string{"engineering", "platform"},
+	}
+
+	// claims are read from the verified id token and mapped with the claim keys of the provider
+	info, err := getCustomUserInfoFromToken(provider, newToken(claims), "nonce")
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-1", info.Subject)
+	assert.Equal(t, "user@example.com", info.Email)
+	require.NotNil(t, info.EmailVerified)
+	assert.True(t, *info.EmailVerified)
+	require.NotNil(t, info.Name)
+	assert.Equal(t, "User", *info.Name)
+	assert.Equal(t, []string{"engineering", "platform"}, info.Groups)
+
+	_, err = getCustomUserInfoFromToken(provider, newToken(claims), "other")
+	assert.ErrorContains(t, err, "nonce does not match")
+
+	_, err = getCustomUserInfoFromToken(provider, newToken(claims), "")
+	assert.ErrorContains(t, err, "nonce does not match")
+
+	claims["aud"] = "other-client-id"
+
+	_, err = getCustomUserInfoFromToken(provider, newToken(claims), "nonce")
+	assert.ErrorContains(t, err, "failed verifying id token")
+
+	_, err = getCustomUserInfoFromToken(provider, &oauth2.Token{AccessToken: "token"}, "nonce")
+	assert.ErrorContains(t, err, "did not return an id token")
+
+	// tokens signed with another key are rejected
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signer, err = jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: otherKey}, nil)
+	require.NoError(t, err)
+
+	claims["aud"] = "client-id"
+
+	_, err = getCustomUserInfoFromToken(provider, newToken(claims), "nonce")
+	assert.ErrorContains(t, err, "failed verifying id token")
+}
@@ -0,0 +1,61 @@
+package users
+
+import (
+	"fmt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOauthStart(ctx echo.Context, request gen.UserUpdateOauthStartRequestObject) (gen.UserUpdateOauthStartResponseObject, error) {
+	provider, ok := u.config.Auth.OAuthProviders[request.Provider]
+
+	if !ok {
+		return nil, u.oauthRedirectWithError(ctx, fmt.Errorf("unknown oauth provider %q", request.Provider), oauthErrUnknownProvider, "Unknown login provider.")
+	}
+
+	if !u.config.Runtime.AllowSignup {
+		return nil, u.oauthRedirectWithError(ctx, nil, oauthErrSignupDisabled, "User signup is disabled.")
+	}
+
+	returnTo, err := u.getOAuthReturnTo(ctx)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInvalidReturnTo, "Invalid redirect destination.")
+	}
+
+	if err := u.saveOAuthReturnTo(ctx, provider.Name, returnTo); err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrCookie, "Could not get cookie. Please make sure cookies are enabled.")
+	}
+
+	state, err := authn.NewSessionHelpers(u.config).SaveOAuthState(ctx, provider.Name)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrCookie, "Could not get cookie. Please make sure cookies are enabled.")
+	}
+
+	var opts []oauth2.AuthCodeOption
+
+	if provider.IDTokenVerifier != nil {
+		nonce, err := u.saveOAuthNonce(ctx, provider.Name)
+
+		if err != nil {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrCookie, "Could not get cookie. Please make sure cookies are enabled.")
+		}
+
+		opts = append(opts, oidc.Nonce(nonce))
+	}
+
+	url := provider.Config.AuthCodeURL(state, opts...)
+
+	return gen.UserUpdateOauthStart302Response{
+		Headers: gen.UserUpdateOauthStart302ResponseHeaders{
+			Location: url,
+		},
+	}, nil
+}
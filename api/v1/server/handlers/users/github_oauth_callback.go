@@ -10,7 +10,6 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
-	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -22,51 +21,66 @@ func (u *UserService) UserUpdateGithubOauthCallback(ctx echo.Context, _ gen.User
 	isValid, _, err := authn.NewSessionHelpers(u.config).ValidateOAuthState(ctx, "github")
 
 	if err != nil || !isValid {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not log in. Please try again and make sure cookies are enabled.")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInvalidState, "Could not log in. Please try again and make sure cookies are enabled.")
 	}
 
 	token, err := u.config.Auth.GithubOAuthConfig.Exchange(context.Background(), ctx.Request().URL.Query().Get("code"))
 
 	if err != nil {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Forbidden")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrForbidden, "Forbidden")
 	}
 
 	if !token.Valid() {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, fmt.Errorf("invalid token"), "Forbidden")
+		return nil, u.oauthRedirectWithError(ctx, fmt.Errorf("invalid token"), oauthErrForbidden, "Forbidden")
 	}
 
-	user, err := u.upsertGithubUserFromToken(u.config, token)
+	// a logged in user links the provider to their account
+	currentUserId, err := authn.NewSessionHelpers(u.config).GetAuthenticatedUserId(ctx)
 
 	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrCookie, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	user, err := u.upsertGithubUserFromToken(u.config, token, currentUserId)
+
+	if err != nil {
+		if errors.Is(err, ErrOAuthLinkRequired) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrLinkRequired, "A user with this email already exists. Log in to that account, then log in with Github again to link it.")
+		}
+
+		if errors.Is(err, ErrOAuthLinkedToOtherUser) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrLinkedToOther, "This Github account is already linked to another user.")
+		}
+
 		if errors.Is(err, ErrNotInRestrictedDomain) {
-			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Email is not in the restricted domain group.")
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrRestrictedDomain, "Email is not in the restricted domain group.")
 		}
 
 		if errors.Is(err, ErrGithubNotVerified) {
-			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Please verify your email on Github.")
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrEmailNotVerified, "Please verify your email on Github.")
 		}
 
 		if errors.Is(err, ErrGithubNoEmail) {
-			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Github user must have an email.")
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrEmailMissing, "Github user must have an email.")
 		}
 
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
 	}
 
 	err = authn.NewSessionHelpers(u.config).SaveAuthenticated(ctx, user)
 
 	if err != nil {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
 	}
 
 	return gen.UserUpdateGithubOauthCallback302Response{
 		Headers: gen.UserUpdateGithubOauthCallback302ResponseHeaders{
-			Location: u.config.Runtime.ServerURL,
+			Location: u.popOAuthReturnTo(ctx, "github"),
 		},
 	}, nil
 }
 
-func (u *UserService) upsertGithubUserFromToken(config *server.ServerConfig, tok *oauth2.Token) (*db.UserModel, error) {
+func (u *UserService) upsertGithubUserFromToken(config *server.ServerConfig, tok *oauth2.Token, currentUserId string) (*db.UserModel, error) {
 	gInfo, err := u.getGithubEmailFromToken(tok)
 
 	if err != nil {
@@ -77,58 +91,17 @@ func (u *UserService) upsertGithubUserFromToken(config *server.ServerConfig, tok
 		return nil, err
 	}
 
-	expiresAt := tok.Expiry
-
-	// use the encryption service to encrypt the access and refresh token
-	accessTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.AccessToken), "github_access_token")
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt access token: %s", err.Error())
-	}
-
-	refreshTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.RefreshToken), "github_refresh_token")
+	oauthOpts, err := newOAuthOpts(config, "github", gInfo.ID, tok)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt refresh token: %s", err.Error())
-	}
-
-	oauthOpts := &repository.OAuthOpts{
-		Provider:       "github",
-		ProviderUserId: gInfo.ID,
-		AccessToken:    accessTokenEncrypted,
-		RefreshToken:   &refreshTokenEncrypted,
-		ExpiresAt:      &expiresAt,
-	}
-
-	user, err := u.config.APIRepository.User().GetUserByEmail(gInfo.Email)
-
-	switch err {
-	case nil:
-		user, err = u.config.APIRepository.User().UpdateUser(user.ID, &repository.UpdateUserOpts{
-			EmailVerified: repository.BoolPtr(gInfo.EmailVerified),
-			Name:          repository.StringPtr(gInfo.Name),
-			OAuth:         oauthOpts,
-		})
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to update user: %s", err.Error())
-		}
-	case db.ErrNotFound:
-		user, err = u.config.APIRepository.User().CreateUser(&repository.CreateUserOpts{
-			Email:         gInfo.Email,
-			EmailVerified: repository.BoolPtr(gInfo.EmailVerified),
-			Name:          repository.StringPtr(gInfo.Name),
-			OAuth:         oauthOpts,
-		})
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to create user: %s", err.Error())
-		}
-	default:
-		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+		return nil, err
 	}
 
-	return user, nil
+	return u.upsertUserFromOAuthClaims(currentUserId, &oauthUserClaims{
+		Email:         gInfo.Email,
+		EmailVerified: repository.BoolPtr(gInfo.EmailVerified),
+		Name:          optionalClaim(gInfo.Name),
+	}, oauthOpts)
 }
 
 var ErrGithubNotVerified = fmt.Errorf("Please verify your email on Github")
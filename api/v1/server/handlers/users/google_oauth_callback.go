@@ -12,10 +12,8 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
-	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
-	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
 
@@ -24,43 +22,58 @@ func (u *UserService) UserUpdateGoogleOauthCallback(ctx echo.Context, _ gen.User
 	isValid, _, err := authn.NewSessionHelpers(u.config).ValidateOAuthState(ctx, "google")
 
 	if err != nil || !isValid {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not log in. Please try again and make sure cookies are enabled.")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInvalidState, "Could not log in. Please try again and make sure cookies are enabled.")
 	}
 
 	token, err := u.config.Auth.GoogleOAuthConfig.Exchange(context.Background(), ctx.Request().URL.Query().Get("code"))
 
 	if err != nil {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Forbidden")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrForbidden, "Forbidden")
 	}
 
 	if !token.Valid() {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, fmt.Errorf("invalid token"), "Forbidden")
+		return nil, u.oauthRedirectWithError(ctx, fmt.Errorf("invalid token"), oauthErrForbidden, "Forbidden")
 	}
 
-	user, err := u.upsertGoogleUserFromToken(u.config, token)
+	// a logged in user links the provider to their account
+	currentUserId, err := authn.NewSessionHelpers(u.config).GetAuthenticatedUserId(ctx)
 
 	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrCookie, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	user, err := u.upsertGoogleUserFromToken(u.config, token, currentUserId)
+
+	if err != nil {
+		if errors.Is(err, ErrOAuthLinkRequired) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrLinkRequired, "A user with this email already exists. Log in to that account, then log in with Google again to link it.")
+		}
+
+		if errors.Is(err, ErrOAuthLinkedToOtherUser) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrLinkedToOther, "This Google account is already linked to another user.")
+		}
+
 		if errors.Is(err, ErrNotInRestrictedDomain) {
-			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Email is not in the restricted domain group.")
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrRestrictedDomain, "Email is not in the restricted domain group.")
 		}
 
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
 	}
 
 	err = authn.NewSessionHelpers(u.config).SaveAuthenticated(ctx, user)
 
 	if err != nil {
-		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
 	}
 
 	return gen.UserUpdateGoogleOauthCallback302Response{
 		Headers: gen.UserUpdateGoogleOauthCallback302ResponseHeaders{
-			Location: u.config.Runtime.ServerURL,
+			Location: u.popOAuthReturnTo(ctx, "google"),
 		},
 	}, nil
 }
 
-func (u *UserService) upsertGoogleUserFromToken(config *server.ServerConfig, tok *oauth2.Token) (*db.UserModel, error) {
+func (u *UserService) upsertGoogleUserFromToken(config *server.ServerConfig, tok *oauth2.Token, currentUserId string) (*db.UserModel, error) {
 	gInfo, err := getGoogleUserInfoFromToken(tok)
 	if err != nil {
 		return nil, err
@@ -70,63 +83,22 @@ func (u *UserService) upsertGoogleUserFromToken(config *server.ServerConfig, tok
 		return nil, err
 	}
 
-	expiresAt := tok.Expiry
-
-	// use the encryption service to encrypt the access and refresh token
-	accessTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.AccessToken), "google_access_token")
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt access token: %s", err.Error())
-	}
-
-	refreshTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.RefreshToken), "google_refresh_token")
+	oauthOpts, err := newOAuthOpts(config, "google", gInfo.Sub, tok)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt refresh token: %s", err.Error())
-	}
-
-	oauthOpts := &repository.OAuthOpts{
-		Provider:       "google",
-		ProviderUserId: gInfo.Sub,
-		AccessToken:    accessTokenEncrypted,
-		RefreshToken:   &refreshTokenEncrypted,
-		ExpiresAt:      &expiresAt,
-	}
-
-	user, err := u.config.APIRepository.User().GetUserByEmail(gInfo.Email)
-
-	switch err {
-	case nil:
-		user, err = u.config.APIRepository.User().UpdateUser(user.ID, &repository.UpdateUserOpts{
-			EmailVerified: repository.BoolPtr(gInfo.EmailVerified),
-			Name:          repository.StringPtr(gInfo.Name),
-			OAuth:         oauthOpts,
-		})
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to update user: %s", err.Error())
-		}
-	case db.ErrNotFound:
-		user, err = u.config.APIRepository.User().CreateUser(&repository.CreateUserOpts{
-			Email:         gInfo.Email,
-			EmailVerified: repository.BoolPtr(gInfo.EmailVerified),
-			Name:          repository.StringPtr(gInfo.Name),
-			OAuth:         oauthOpts,
-		})
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to create user: %s", err.Error())
-		}
-	default:
-		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+		return nil, err
 	}
 
-	return user, nil
+	return u.upsertUserFromOAuthClaims(currentUserId, &oauthUserClaims{
+		Email:         gInfo.Email,
+		EmailVerified: gInfo.EmailVerified,
+		Name:          optionalClaim(gInfo.Name),
+	}, oauthOpts)
 }
 
 type googleUserInfo struct {
 	Email         string `json:"email"`
-	EmailVerified bool   `json:"email_verified"`
+	EmailVerified *bool  `json:"email_verified"`
 	HD            string `json:"hd"`
 	Sub           string `json:"sub"`
 	Name          string `json:"name"`
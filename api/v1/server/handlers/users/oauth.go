@@ -0,0 +1,272 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// error codes which are passed to the error redirect page during the OAuth login flow
+const (
+	oauthErrSignupDisabled   = "signup_disabled"
+	oauthErrCookie           = "cookie_error"
+	oauthErrInvalidReturnTo  = "invalid_return_to"
+	oauthErrInvalidState     = "invalid_state"
+	oauthErrForbidden        = "forbidden"
+	oauthErrRestrictedDomain = "restricted_domain"
+	oauthErrEmailNotVerified = "email_not_verified"
+	oauthErrEmailMissing     = "email_missing"
+	oauthErrInternal         = "internal_error"
+	oauthErrLinkRequired     = "link_required"
+	oauthErrLinkedToOther    = "linked_to_other_user"
+	oauthErrUnknownProvider  = "unknown_provider"
+)
+
+const oauthReturnToKeyFormatter = "oauth_return_to_%s"
+
+const oauthNonceKeyFormatter = "oauth_nonce_%s"
+
+// oauthRedirectWithError redirects to the configured error page with the given error code.
+func (u *UserService) oauthRedirectWithError(ctx echo.Context, internalErr error, code, userErr string) error {
+	return redirect.GetRedirectWithErrorCode(ctx, u.config.Logger, u.config.Auth.ConfigFile.ErrorRedirectURL, internalErr, code, userErr)
+}
+
+// getOAuthReturnTo validates the optional return_to query parameter. An empty string is returned
+// if the parameter is not set.
+func (u *UserService) getOAuthReturnTo(ctx echo.Context) (string, error) {
+	returnTo := ctx.QueryParam("return_to")
+
+	if returnTo == "" {
+		return "", nil
+	}
+
+	return redirect.ValidateReturnTo(u.config.Runtime.ServerURL, u.config.Auth.ConfigFile.AllowedRedirectURLs, returnTo)
+}
+
+// saveOAuthReturnTo stores the return_to destination in the session so that the callback can
+// redirect back to it.
+func (u *UserService) saveOAuthReturnTo(ctx echo.Context, integration, returnTo string) error {
+	sh := authn.NewSessionHelpers(u.config)
+	key := fmt.Sprintf(oauthReturnToKeyFormatter, integration)
+
+	if returnTo == "" {
+		return sh.RemoveKey(ctx, key)
+	}
+
+	return sh.SaveKV(ctx, key, returnTo)
+}
+
+// popOAuthReturnTo returns the return_to destination stored at the start of the flow, falling back
+// to the server url.
+func (u *UserService) popOAuthReturnTo(ctx echo.Context, integration string) string {
+	sh := authn.NewSessionHelpers(u.config)
+	key := fmt.Sprintf(oauthReturnToKeyFormatter, integration)
+
+	returnTo, err := sh.GetKey(ctx, key)
+
+	if err != nil || returnTo == "" {
+		return u.config.Runtime.ServerURL
+	}
+
+	if err := sh.RemoveKey(ctx, key); err != nil {
+		serverutils.RequestLogger(ctx.Request().Context(), u.config.Logger).Error().Msgf("could not remove %s key: %v", key, err)
+	}
+
+	return returnTo
+}
+
+// saveOAuthNonce stores a new nonce in the session, which the ID token of an OpenID Connect provider must
+// contain so that it can't be replayed.
+func (u *UserService) saveOAuthNonce(ctx echo.Context, integration string) (string, error) {
+	nonce, err := random.Generate(32)
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := authn.NewSessionHelpers(u.config).SaveKV(ctx, fmt.Sprintf(oauthNonceKeyFormatter, integration), nonce); err != nil {
+		return "", err
+	}
+
+	return nonce, nil
+}
+
+// popOAuthNonce returns the nonce stored at the start of the flow, and removes it so that it is only used once.
+func (u *UserService) popOAuthNonce(ctx echo.Context, integration string) (string, error) {
+	sh := authn.NewSessionHelpers(u.config)
+	key := fmt.Sprintf(oauthNonceKeyFormatter, integration)
+
+	nonce, err := sh.GetKey(ctx, key)
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := sh.RemoveKey(ctx, key); err != nil {
+		return "", err
+	}
+
+	return nonce, nil
+}
+
+// oauthUserClaims are the identity claims returned by an OAuth provider. Optional claims are nil
+// when the provider did not return them, so that an existing user's data is left untouched.
+type oauthUserClaims struct {
+	Email         string
+	EmailVerified *bool
+	Name          *string
+}
+
+// optionalClaim returns nil for an empty claim value.
+func optionalClaim(val string) *string {
+	if val == "" {
+		return nil
+	}
+
+	return &val
+}
+
+// newOAuthOpts encrypts the tokens returned by an OAuth provider. Providers don't return a refresh
+// token when the user has already granted consent, in which case the refresh token is left unset so
+// that no meaningless ciphertext is stored and an existing refresh token is kept.
+func newOAuthOpts(config *server.ServerConfig, provider, providerUserId string, tok *oauth2.Token) (*repository.OAuthOpts, error) {
+	expiresAt := tok.Expiry
+
+	// use the encryption service to encrypt the access and refresh token
+	accessTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.AccessToken), oauth.AccessTokenDataId(provider))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt access token: %s", err.Error())
+	}
+
+	var refreshTokenEncrypted *[]byte
+
+	if tok.RefreshToken != "" {
+		encrypted, err := config.Encryption.Encrypt([]byte(tok.RefreshToken), oauth.RefreshTokenDataId(provider))
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt refresh token: %s", err.Error())
+		}
+
+		refreshTokenEncrypted = &encrypted
+	}
+
+	return &repository.OAuthOpts{
+		Provider:       provider,
+		ProviderUserId: providerUserId,
+		AccessToken:    accessTokenEncrypted,
+		RefreshToken:   refreshTokenEncrypted,
+		ExpiresAt:      &expiresAt,
+	}, nil
+}
+
+// ErrOAuthLinkRequired is returned when an OAuth login has the email of an existing user which the link
+// policy doesn't allow to be linked automatically.
+var ErrOAuthLinkRequired = errors.New("a user with this email already exists and must link the provider while logged in")
+
+// ErrOAuthLinkedToOtherUser is returned when a logged in user links an OAuth account which is already
+// linked to a different user.
+var ErrOAuthLinkedToOtherUser = errors.New("oauth account is linked to another user")
+
+// upsertUserFromOAuthClaims returns the user to log in with an OAuth account. If a user is logged in,
+// which is the case if currentUserId is set, the account is linked to that user. Otherwise, the user
+// which is already linked to the account is returned, followed by the user with the same email if the
+// link policy allows linking the account to it. A new user is created if there is no such user.
+func (u *UserService) upsertUserFromOAuthClaims(currentUserId string, claims *oauthUserClaims, oauthOpts *repository.OAuthOpts) (*db.UserModel, error) {
+	linked, err := u.config.APIRepository.User().GetUserByOAuth(oauthOpts.Provider, oauthOpts.ProviderUserId)
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get user by oauth account: %s", err.Error())
+	}
+
+	if currentUserId != "" {
+		if linked != nil && linked.ID != currentUserId {
+			return nil, ErrOAuthLinkedToOtherUser
+		}
+
+		// the email of the provider can differ from the email of the user, so only the tokens are updated
+		return u.updateOAuthUser(currentUserId, &repository.UpdateUserOpts{
+			OAuth: oauthOpts,
+		})
+	}
+
+	if linked != nil {
+		opts := &repository.UpdateUserOpts{
+			Name:  claims.Name,
+			OAuth: oauthOpts,
+		}
+
+		if strings.EqualFold(linked.Email, claims.Email) {
+			opts.EmailVerified = claims.EmailVerified
+		}
+
+		return u.updateOAuthUser(linked.ID, opts)
+	}
+
+	user, err := u.config.APIRepository.User().GetUserByEmail(claims.Email)
+
+	switch {
+	case err == nil:
+		if !u.canLinkOAuthByEmail(user, claims) {
+			return nil, ErrOAuthLinkRequired
+		}
+
+		return u.updateOAuthUser(user.ID, &repository.UpdateUserOpts{
+			EmailVerified: claims.EmailVerified,
+			Name:          claims.Name,
+			OAuth:         oauthOpts,
+		})
+	case errors.Is(err, db.ErrNotFound):
+		user, err = u.config.APIRepository.User().CreateUser(&repository.CreateUserOpts{
+			Email:         claims.Email,
+			EmailVerified: claims.EmailVerified,
+			Name:          claims.Name,
+			OAuth:         oauthOpts,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user: %s", err.Error())
+		}
+
+		return user, nil
+	default:
+		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+	}
+}
+
+func (u *UserService) updateOAuthUser(userId string, opts *repository.UpdateUserOpts) (*db.UserModel, error) {
+	user, err := u.config.APIRepository.User().UpdateUser(userId, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user: %s", err.Error())
+	}
+
+	return user, nil
+}
+
+// canLinkOAuthByEmail checks whether the link policy allows linking an OAuth account to an existing
+// user with the same email. By default, both the provider and the user must have verified the email,
+// so that neither an unverified provider email nor an account registered with someone else's email
+// can be used to take over the account.
+func (u *UserService) canLinkOAuthByEmail(user *db.UserModel, claims *oauthUserClaims) bool {
+	switch u.config.Auth.ConfigFile.OAuthLinkPolicy {
+	case server.OAuthLinkPolicyAlways:
+		return true
+	case server.OAuthLinkPolicyNever:
+		return false
+	default:
+		return claims.EmailVerified != nil && *claims.EmailVerified && user.EmailVerified
+	}
+}
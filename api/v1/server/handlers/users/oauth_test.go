@@ -0,0 +1,318 @@
+package users
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+type fakeAPIRepository struct {
+	repository.APIRepository
+
+	users *fakeUserRepository
+}
+
+func (r *fakeAPIRepository) User() repository.UserRepository {
+	return r.users
+}
+
+type fakeUserRepository struct {
+	repository.UserRepository
+
+	users map[string]*db.UserModel
+	opts  []*repository.UpdateUserOpts
+
+	// the ids of the users linked to OAuth accounts, keyed by provider and provider user id
+	oauth map[string]string
+}
+
+func (r *fakeUserRepository) GetUserByOAuth(provider, providerUserId string) (*db.UserModel, error) {
+	if userId, ok := r.oauth[provider+":"+providerUserId]; ok {
+		return r.users[userId], nil
+	}
+
+	return nil, db.ErrNotFound
+}
+
+func (r *fakeUserRepository) GetUserByEmail(email string) (*db.UserModel, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+
+	return nil, db.ErrNotFound
+}
+
+func (r *fakeUserRepository) UpdateUser(id string, opts *repository.UpdateUserOpts) (*db.UserModel, error) {
+	r.opts = append(r.opts, opts)
+
+	user := r.users[id]
+
+	// mirror the repository semantics: only fields which are set are updated
+	if opts.Name != nil {
+		user.InnerUser.Name = opts.Name
+	}
+
+	if opts.EmailVerified != nil {
+		user.EmailVerified = *opts.EmailVerified
+	}
+
+	if opts.OAuth != nil {
+		r.oauth[opts.OAuth.Provider+":"+opts.OAuth.ProviderUserId] = id
+	}
+
+	return user, nil
+}
+
+func newFakeUserService(users ...*db.UserModel) (*UserService, *fakeUserRepository) {
+	userRepo := &fakeUserRepository{
+		users: map[string]*db.UserModel{},
+		oauth: map[string]string{},
+	}
+
+	for _, user := range users {
+		userRepo.users[user.ID] = user
+	}
+
+	return NewUserService(&server.ServerConfig{
+		Config: &database.Config{
+			APIRepository: &fakeAPIRepository{
+				users: userRepo,
+			},
+		},
+	}), userRepo
+}
+
+func TestUpsertUserFromOAuthClaimsMissingName(t *testing.T) {
+	existing := &db.UserModel{
+		InnerUser: db.InnerUser{
+			ID:            "user-1",
+			Email:         "user@example.com",
+			EmailVerified: true,
+			Name:          repository.StringPtr("Existing Name"),
+		},
+	}
+
+	svc, userRepo := newFakeUserService(existing)
+
+	// the provider didn't return whether the email is verified, which requires linking regardless of it
+	svc.config.Auth.ConfigFile.OAuthLinkPolicy = server.OAuthLinkPolicyAlways
+
+	user, err := svc.upsertUserFromOAuthClaims("", &oauthUserClaims{
+		Email: "user@example.com",
+		Name:  optionalClaim(""),
+	}, &repository.OAuthOpts{
+		Provider:       "google",
+		ProviderUserId: "sub",
+		AccessToken:    []byte("token"),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, userRepo.opts, 1)
+
+	assert.Nil(t, userRepo.opts[0].Name)
+	assert.Nil(t, userRepo.opts[0].EmailVerified)
+
+	name, ok := user.Name()
+
+	assert.True(t, ok)
+	assert.Equal(t, "Existing Name", name)
+	assert.True(t, user.EmailVerified)
+}
+
+func TestUpsertUserFromOAuthClaimsWithName(t *testing.T) {
+	existing := &db.UserModel{
+		InnerUser: db.InnerUser{
+			ID:    "user-1",
+			Email: "user@example.com",
+			Name:  repository.StringPtr("Existing Name"),
+		},
+	}
+
+	svc, _ := newFakeUserService(existing)
+	svc.config.Auth.ConfigFile.OAuthLinkPolicy = server.OAuthLinkPolicyAlways
+
+	user, err := svc.upsertUserFromOAuthClaims("", &oauthUserClaims{
+		Email:         "user@example.com",
+		EmailVerified: repository.BoolPtr(true),
+		Name:          optionalClaim("New Name"),
+	}, &repository.OAuthOpts{
+		Provider:       "google",
+		ProviderUserId: "sub",
+		AccessToken:    []byte("token"),
+	})
+
+	require.NoError(t, err)
+
+	name, ok := user.Name()
+
+	assert.True(t, ok)
+	assert.Equal(t, "New Name", name)
+	assert.True(t, user.EmailVerified)
+}
+
+func TestUpsertUserFromOAuthClaimsLinkPolicy(t *testing.T) {
+	newExisting := func(emailVerified bool) *db.UserModel {
+		return &db.UserModel{
+			InnerUser: db.InnerUser{
+				ID:            "user-1",
+				Email:         "user@example.com",
+				EmailVerified: emailVerified,
+			},
+		}
+	}
+
+	oauthOpts := &repository.OAuthOpts{
+		Provider:       "google",
+		ProviderUserId: "sub",
+		AccessToken:    []byte("token"),
+	}
+
+	tests := []struct {
+		name                  string
+		policy                string
+		userVerified          bool
+		providerVerified      *bool
+		wantLinkRequiredError bool
+	}{
+		{name: "verified", policy: server.OAuthLinkPolicyVerified, userVerified: true, providerVerified: repository.BoolPtr(true)},
+		{name: "default policy", policy: "", userVerified: true, providerVerified: repository.BoolPtr(true)},
+		{name: "provider email not verified", policy: server.OAuthLinkPolicyVerified, userVerified: true, providerVerified: repository.BoolPtr(false), wantLinkRequiredError: true},
+		{name: "provider verification unknown", policy: server.OAuthLinkPolicyVerified, userVerified: true, wantLinkRequiredError: true},
+		{name: "user email not verified", policy: server.OAuthLinkPolicyVerified, userVerified: false, providerVerified: repository.BoolPtr(true), wantLinkRequiredError: true},
+		{name: "never", policy: server.OAuthLinkPolicyNever, userVerified: true, providerVerified: repository.BoolPtr(true), wantLinkRequiredError: true},
+		{name: "always", policy: server.OAuthLinkPolicyAlways, userVerified: false, providerVerified: repository.BoolPtr(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, userRepo := newFakeUserService(newExisting(tt.userVerified))
+			svc.config.Auth.ConfigFile.OAuthLinkPolicy = tt.policy
+
+			user, err := svc.upsertUserFromOAuthClaims("", &oauthUserClaims{
+				Email:         "user@example.com",
+				EmailVerified: tt.providerVerified,
+			}, oauthOpts)
+
+			if tt.wantLinkRequiredError {
+				assert.ErrorIs(t, err, ErrOAuthLinkRequired)
+				assert.Empty(t, userRepo.opts)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", user.ID)
+		})
+	}
+}
+
+func TestUpsertUserFromOAuthClaimsExplicitLink(t *testing.T) {
+	existing := &db.UserModel{
+		InnerUser: db.InnerUser{
+			ID:    "user-1",
+			Email: "user@example.com",
+		},
+	}
+
+	other := &db.UserModel{
+		InnerUser: db.InnerUser{
+			ID:    "user-2",
+			Email: "other@example.com",
+		},
+	}
+
+	svc, userRepo := newFakeUserService(existing, other)
+	svc.config.Auth.ConfigFile.OAuthLinkPolicy = server.OAuthLinkPolicyNever
+
+	oauthOpts := &repository.OAuthOpts{
+		Provider:       "github",
+		ProviderUserId: "123",
+		AccessToken:    []byte("token"),
+	}
+
+	claims := &oauthUserClaims{
+		Email:         "user@example.com",
+		EmailVerified: repository.BoolPtr(true),
+		Name:          optionalClaim("New Name"),
+	}
+
+	// a logged in user links the account, regardless of the policy
+	user, err := svc.upsertUserFromOAuthClaims("user-1", claims, oauthOpts)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", user.ID)
+
+	// linking only updates the tokens
+	require.Len(t, userRepo.opts, 1)
+	assert.Nil(t, userRepo.opts[0].Name)
+	assert.Nil(t, userRepo.opts[0].EmailVerified)
+
+	// the linked account logs in to the user without being linked again
+	user, err = svc.upsertUserFromOAuthClaims("", claims, oauthOpts)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", user.ID)
+	assert.True(t, user.EmailVerified)
+
+	// the linked account can't be linked to another user
+	_, err = svc.upsertUserFromOAuthClaims("user-2", claims, oauthOpts)
+	assert.ErrorIs(t, err, ErrOAuthLinkedToOtherUser)
+}
+
+func newTestEncryptionConfig(t *testing.T) *server.ServerConfig {
+	masterKey, privateEc256, publicEc256, err := encryption.GenerateLocalKeys()
+	require.NoError(t, err)
+
+	svc, err := encryption.NewLocalEncryption(masterKey, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	return &server.ServerConfig{
+		Encryption: svc,
+	}
+}
+
+func TestNewOAuthOptsWithoutRefreshToken(t *testing.T) {
+	config := newTestEncryptionConfig(t)
+
+	expiry := time.Now().Add(time.Hour)
+
+	opts, err := newOAuthOpts(config, "google", "sub", &oauth2.Token{
+		AccessToken: "access",
+		Expiry:      expiry,
+	})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "google", opts.Provider)
+	assert.Equal(t, "sub", opts.ProviderUserId)
+	assert.Nil(t, opts.RefreshToken)
+	assert.Equal(t, expiry, *opts.ExpiresAt)
+
+	accessToken, err := config.Encryption.Decrypt(opts.AccessToken, "google_access_token")
+	require.NoError(t, err)
+	assert.Equal(t, "access", string(accessToken))
+}
+
+func TestNewOAuthOptsWithRefreshToken(t *testing.T) {
+	config := newTestEncryptionConfig(t)
+
+	opts, err := newOAuthOpts(config, "github", "id", &oauth2.Token{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, opts.RefreshToken)
+
+	refreshToken, err := config.Encryption.Decrypt(*opts.RefreshToken, "github_refresh_token")
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", string(refreshToken))
+}
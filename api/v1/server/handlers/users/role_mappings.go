@@ -0,0 +1,106 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hatchet-dev/hatchet/pkg/auth/authorizer"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// roleRanks orders the tenant roles, so that users in several mapped groups get the highest role.
+var roleRanks = map[string]int{
+	authorizer.RoleMember: 1,
+	authorizer.RoleAdmin:  2,
+	authorizer.RoleOwner:  3,
+}
+
+// tenantRolesFromGroups returns the role of the user in each mapped tenant, keyed by the slug of the tenant.
+func tenantRolesFromGroups(mappings []server.ConfigFileAuthOAuthRoleMapping, groups []string) map[string]string {
+	inGroup := make(map[string]bool, len(groups))
+
+	for _, group := range groups {
+		inGroup[group] = true
+	}
+
+	roles := map[string]string{}
+
+	for _, m := range mappings {
+		if !inGroup[m.Group] {
+			continue
+		}
+
+		if roleRanks[m.Role] > roleRanks[roles[m.Tenant]] {
+			roles[m.Tenant] = m.Role
+		}
+	}
+
+	return roles
+}
+
+// assignTenantRolesFromGroups adds the user to the tenants which their groups are mapped to. Existing
+// members get the mapped role, so that the identity provider stays the source of truth for the roles in
+// mapped tenants. Memberships of tenants which aren't mapped are left untouched.
+func (u *UserService) assignTenantRolesFromGroups(provider *server.OAuthProvider, userId string, groups []string) error {
+	for slug, role := range tenantRolesFromGroups(provider.RoleMappings, groups) {
+		tenant, err := u.config.APIRepository.Tenant().GetTenantBySlug(slug)
+
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				u.config.Logger.Warn().Msgf("%s role mapping references unknown tenant %q", provider.Name, slug)
+				continue
+			}
+
+			return fmt.Errorf("failed to get tenant %s: %w", slug, err)
+		}
+
+		member, err := u.config.APIRepository.Tenant().GetTenantMemberByUserID(tenant.ID, userId)
+
+		switch {
+		case errors.Is(err, db.ErrNotFound):
+			_, err = u.config.APIRepository.Tenant().CreateTenantMember(tenant.ID, &repository.CreateTenantMemberOpts{
+				UserId: userId,
+				Role:   role,
+			})
+
+			if err != nil {
+				return fmt.Errorf("failed to add user to tenant %s: %w", slug, err)
+			}
+		case err != nil:
+			return fmt.Errorf("failed to get tenant member of tenant %s: %w", slug, err)
+		case string(member.Role) != role:
+			_, err = u.config.APIRepository.Tenant().UpdateTenantMember(member.ID, &repository.UpdateTenantMemberOpts{
+				Role: &role,
+			})
+
+			if err != nil {
+				return fmt.Errorf("failed to update role in tenant %s: %w", slug, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// stringsClaim returns a list of strings or a single space-separated string claim as a list.
+func stringsClaim(claim interface{}) []string {
+	switch val := claim.(type) {
+	case []interface{}:
+		res := make([]string, 0, len(val))
+
+		for _, v := range val {
+			if s := stringClaim(v); s != "" {
+				res = append(res, s)
+			}
+		}
+
+		return res
+	case string:
+		return strings.Fields(val)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,39 @@
+package users
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+func TestTenantRolesFromGroups(t *testing.T) {
+	mappings := []server.ConfigFileAuthOAuthRoleMapping{
+		{Group: "engineering", Tenant: "default", Role: "MEMBER"},
+		{Group: "platform", Tenant: "default", Role: "ADMIN"},
+		{Group: "platform", Tenant: "infra", Role: "OWNER"},
+		{Group: "support", Tenant: "infra", Role: "MEMBER"},
+	}
+
+	assert.Equal(t, map[string]string{
+		"default": "MEMBER",
+	}, tenantRolesFromGroups(mappings, []string{"engineering", "sales"}))
+
+	// the highest role of the mapped groups of a tenant is used, regardless of the order of the mappings
+	assert.Equal(t, map[string]string{
+		"default": "ADMIN",
+		"infra":   "OWNER",
+	}, tenantRolesFromGroups(mappings, []string{"support", "platform", "engineering"}))
+
+	assert.Empty(t, tenantRolesFromGroups(mappings, nil))
+	assert.Empty(t, tenantRolesFromGroups(nil, []string{"engineering"}))
+}
+
+func TestStringsClaim(t *testing.T) {
+	assert.Equal(t, []string{"engineering", "12"}, stringsClaim([]interface{}{"engineering", json.Number("12"), true, ""}))
+	assert.Equal(t, []string{"engineering", "platform"}, stringsClaim("engineering platform"))
+	assert.Nil(t, stringsClaim(nil))
+	assert.Nil(t, stringsClaim(true))
+}
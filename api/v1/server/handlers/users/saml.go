@@ -0,0 +1,164 @@
+package users
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// samlProvider is recorded as the provider of the accounts of users who log in with SAML.
+const samlProvider = "saml"
+
+// samlRequestMaxAge is how long a user has to log in with the identity provider.
+const samlRequestMaxAge = 10 * time.Minute
+
+// samlRequestCookieName returns the name of the cookie which tracks the ID of the authentication request.
+// The identity provider posts the assertion cross-site, so the session cookie, which is SameSite=Lax,
+// isn't sent with it.
+func (u *UserService) samlRequestCookieName() string {
+	return fmt.Sprintf("%s_saml_request", u.config.Auth.ConfigFile.Cookie.Name)
+}
+
+func (u *UserService) setSAMLRequestCookie(ctx echo.Context, requestId string, maxAge time.Duration) {
+	cookie := &http.Cookie{
+		Name:     u.samlRequestCookieName(),
+		Value:    requestId,
+		Path:     "/api/v1/users/saml",
+		Domain:   u.config.Auth.ConfigFile.Cookie.Domain,
+		MaxAge:   int(maxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   !u.config.Auth.ConfigFile.Cookie.Insecure,
+		SameSite: http.SameSiteNoneMode,
+	}
+
+	// browsers reject SameSite=None cookies which aren't secure
+	if u.config.Auth.ConfigFile.Cookie.Insecure {
+		cookie.SameSite = http.SameSiteLaxMode
+	}
+
+	if maxAge <= 0 {
+		cookie.MaxAge = -1
+	}
+
+	ctx.SetCookie(cookie)
+}
+
+// popSAMLRequestId returns the ID of the authentication request which was started by the user, which is
+// empty for logins which were initiated by the identity provider.
+func (u *UserService) popSAMLRequestId(ctx echo.Context) string {
+	cookie, err := ctx.Cookie(u.samlRequestCookieName())
+
+	if err != nil {
+		return ""
+	}
+
+	u.setSAMLRequestCookie(ctx, "", 0)
+
+	return cookie.Value
+}
+
+type samlUserInfo struct {
+	oauthUserClaims
+
+	Subject string
+}
+
+// getSAMLUserInfo maps a verified assertion to a user with the attribute names of the configuration.
+func getSAMLUserInfo(cf *server.ConfigFileAuthSAML, assertion *saml.Assertion) (*samlUserInfo, error) {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value == "" {
+		return nil, fmt.Errorf("saml assertion has no name id")
+	}
+
+	nameId := assertion.Subject.NameID
+
+	info := &samlUserInfo{
+		Subject: nameId.Value,
+	}
+
+	info.Email = samlAttribute(assertion, cf.Attributes.Email)
+
+	if info.Email == "" && (nameId.Format == string(saml.EmailAddressNameIDFormat) || strings.Contains(nameId.Value, "@")) {
+		info.Email = nameId.Value
+	}
+
+	if info.Email == "" {
+		return nil, ErrOAuthNoEmail
+	}
+
+	info.Name = optionalClaim(samlAttribute(assertion, cf.Attributes.Name))
+
+	emailVerified := cf.EmailVerified
+	info.EmailVerified = &emailVerified
+
+	return info, nil
+}
+
+// samlAttribute returns the first value of the attribute with the given name or friendly name.
+func samlAttribute(assertion *saml.Assertion, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			if attr.Name != name && attr.FriendlyName != name {
+				continue
+			}
+
+			for _, value := range attr.Values {
+				if value.Value != "" {
+					return value.Value
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// upsertUserFromSAMLAssertion logs in with the account of the identity provider. The session index of the
+// assertion is stored in place of an access token, and expires with the session of the identity provider.
+func (u *UserService) upsertUserFromSAMLAssertion(config *server.ServerConfig, assertion *saml.Assertion) (*db.UserModel, error) {
+	info, err := getSAMLUserInfo(&config.Auth.ConfigFile.SAML, assertion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.checkUserRestrictionsForEmail(config, info.Email); err != nil {
+		return nil, err
+	}
+
+	sessionIndex := assertion.ID
+	var expiresAt *time.Time
+
+	if len(assertion.AuthnStatements) > 0 {
+		if assertion.AuthnStatements[0].SessionIndex != "" {
+			sessionIndex = assertion.AuthnStatements[0].SessionIndex
+		}
+
+		expiresAt = assertion.AuthnStatements[0].SessionNotOnOrAfter
+	}
+
+	sessionIndexEncrypted, err := config.Encryption.Encrypt([]byte(sessionIndex), fmt.Sprintf("%s_session_index", samlProvider))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt session index: %s", err.Error())
+	}
+
+	// the session cookie isn't sent with the assertion, so the account is never linked to a logged in user
+	return u.upsertUserFromOAuthClaims("", &info.oauthUserClaims, &repository.OAuthOpts{
+		Provider:       samlProvider,
+		ProviderUserId: info.Subject,
+		AccessToken:    sessionIndexEncrypted,
+		ExpiresAt:      expiresAt,
+	})
+}
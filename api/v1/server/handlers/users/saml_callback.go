@@ -0,0 +1,75 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateSamlCallback(ctx echo.Context, request gen.UserUpdateSamlCallbackRequestObject) (gen.UserUpdateSamlCallbackResponseObject, error) {
+	sp := u.config.Auth.SAML
+
+	if sp == nil {
+		return nil, u.oauthRedirectWithError(ctx, fmt.Errorf("saml is not enabled"), oauthErrUnknownProvider, "Unknown login provider.")
+	}
+
+	// logins which were initiated by the identity provider don't have a request ID, and are only accepted
+	// if the service provider allows them
+	var possibleRequestIds []string
+
+	if requestId := u.popSAMLRequestId(ctx); requestId != "" {
+		possibleRequestIds = append(possibleRequestIds, requestId)
+	}
+
+	assertion, err := sp.ParseResponse(ctx.Request(), possibleRequestIds)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrForbidden, "Forbidden")
+	}
+
+	user, err := u.upsertUserFromSAMLAssertion(u.config, assertion)
+
+	if err != nil {
+		if errors.Is(err, ErrOAuthLinkRequired) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrLinkRequired, "A user with this email already exists. Log in to that account with its existing login method.")
+		}
+
+		if errors.Is(err, ErrNotInRestrictedDomain) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrRestrictedDomain, "Email is not in the restricted domain group.")
+		}
+
+		if errors.Is(err, ErrOAuthNoEmail) {
+			return nil, u.oauthRedirectWithError(ctx, err, oauthErrEmailMissing, "SAML user must have an email.")
+		}
+
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
+	}
+
+	err = authn.NewSessionHelpers(u.config).SaveAuthenticated(ctx, user)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
+	}
+
+	location := u.config.Runtime.ServerURL
+
+	// the relay state is set by the identity provider for logins which it initiated, so it is only used
+	// if it is an allowed destination
+	if request.Body != nil && request.Body.RelayState != nil && *request.Body.RelayState != "" {
+		if returnTo, err := redirect.ValidateReturnTo(u.config.Runtime.ServerURL, u.config.Auth.ConfigFile.AllowedRedirectURLs, *request.Body.RelayState); err == nil {
+			location = returnTo
+		}
+	}
+
+	return gen.UserUpdateSamlCallback302Response{
+		Headers: gen.UserUpdateSamlCallback302ResponseHeaders{
+			Location: location,
+		},
+	}, nil
+}
@@ -0,0 +1,33 @@
+package users
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+func (u *UserService) UserGetSamlMetadata(ctx echo.Context, _ gen.UserGetSamlMetadataRequestObject) (gen.UserGetSamlMetadataResponseObject, error) {
+	sp := u.config.Auth.SAML
+
+	if sp == nil {
+		return gen.UserGetSamlMetadata404JSONResponse(
+			apierrors.NewAPIErrors("SAML is not enabled"),
+		), nil
+	}
+
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal saml metadata: %w", err)
+	}
+
+	return gen.UserGetSamlMetadata200ApplicationsamlmetadataXmlResponse{
+		Body:          bytes.NewReader(metadata),
+		ContentLength: int64(len(metadata)),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package users
+
+import (
+	"fmt"
+
+	"github.com/crewjam/saml"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateSamlStart(ctx echo.Context, _ gen.UserUpdateSamlStartRequestObject) (gen.UserUpdateSamlStartResponseObject, error) {
+	sp := u.config.Auth.SAML
+
+	if sp == nil {
+		return nil, u.oauthRedirectWithError(ctx, fmt.Errorf("saml is not enabled"), oauthErrUnknownProvider, "Unknown login provider.")
+	}
+
+	if !u.config.Runtime.AllowSignup {
+		return nil, u.oauthRedirectWithError(ctx, nil, oauthErrSignupDisabled, "User signup is disabled.")
+	}
+
+	// the return_to destination is passed through the identity provider as the relay state, and validated
+	// again in the callback
+	returnTo, err := u.getOAuthReturnTo(ctx)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInvalidReturnTo, "Invalid redirect destination.")
+	}
+
+	req, err := sp.MakeAuthenticationRequest(sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
+	}
+
+	url, err := req.Redirect(returnTo, sp)
+
+	if err != nil {
+		return nil, u.oauthRedirectWithError(ctx, err, oauthErrInternal, "Internal error.")
+	}
+
+	u.setSAMLRequestCookie(ctx, req.ID, samlRequestMaxAge)
+
+	return gen.UserUpdateSamlStart302Response{
+		Headers: gen.UserUpdateSamlStart302ResponseHeaders{
+			Location: url.String(),
+		},
+	}, nil
+}
@@ -0,0 +1,75 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+func newTestAssertion(nameId *saml.NameID, attrs ...saml.Attribute) *saml.Assertion {
+	return &saml.Assertion{
+		Subject: &saml.Subject{
+			NameID: nameId,
+		},
+		AttributeStatements: []saml.AttributeStatement{
+			{Attributes: attrs},
+		},
+	}
+}
+
+func newTestAttribute(name, friendlyName, value string) saml.Attribute {
+	return saml.Attribute{
+		Name:         name,
+		FriendlyName: friendlyName,
+		Values:       []saml.AttributeValue{{Value: value}},
+	}
+}
+
+func TestGetSAMLUserInfo(t *testing.T) {
+	cf := &server.ConfigFileAuthSAML{
+		Attributes: server.ConfigFileAuthSAMLAttributes{
+			Email: "email",
+			Name:  "name",
+		},
+	}
+
+	// attributes are matched by name or friendly name
+	info, err := getSAMLUserInfo(cf, newTestAssertion(
+		&saml.NameID{Value: "user-1"},
+		newTestAttribute("urn:oid:0.9.2342.19200300.100.1.3", "email", "user@example.com"),
+		newTestAttribute("name", "", "User"),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-1", info.Subject)
+	assert.Equal(t, "user@example.com", info.Email)
+	require.NotNil(t, info.Name)
+	assert.Equal(t, "User", *info.Name)
+	require.NotNil(t, info.EmailVerified)
+	assert.False(t, *info.EmailVerified)
+
+	// the name id is used as the email if the assertion has no email attribute
+	cf.EmailVerified = true
+
+	info, err = getSAMLUserInfo(cf, newTestAssertion(&saml.NameID{
+		Format: string(saml.EmailAddressNameIDFormat),
+		Value:  "user@example.com",
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, "user@example.com", info.Subject)
+	assert.Equal(t, "user@example.com", info.Email)
+	assert.Nil(t, info.Name)
+	require.NotNil(t, info.EmailVerified)
+	assert.True(t, *info.EmailVerified)
+
+	_, err = getSAMLUserInfo(cf, newTestAssertion(&saml.NameID{Value: "user-1"}))
+	assert.ErrorIs(t, err, ErrOAuthNoEmail)
+
+	_, err = getSAMLUserInfo(cf, &saml.Assertion{})
+	assert.Error(t, err)
+}
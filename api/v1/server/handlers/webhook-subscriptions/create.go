@@ -0,0 +1,86 @@
+package webhooksubscriptions
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (w *WebhookSubscriptionService) WebhookSubscriptionCreate(ctx echo.Context, request gen.WebhookSubscriptionCreateRequestObject) (gen.WebhookSubscriptionCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := w.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WebhookSubscriptionCreate400JSONResponse(*apiErrors), nil
+	}
+
+	var secret string
+
+	if request.Body.Secret == nil {
+		s, err := random.GenerateWebhookSecret()
+
+		if err != nil {
+			return nil, err
+		}
+
+		secret = s
+	} else {
+		secret = *request.Body.Secret
+	}
+
+	enc, err := w.config.Encryption.ForTenant(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	encSecret, err := enc.EncryptString(secret, tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := w.config.APIRepository.WebhookSubscription().CreateWebhookSubscription(ctx.Request().Context(), tenant.ID, &repository.CreateWebhookSubscriptionOpts{
+		Name:       request.Body.Name,
+		URL:        request.Body.Url,
+		Secret:     encSecret,
+		EventTypes: toEventTypes(request.Body.EventTypes),
+		Enabled:    request.Body.Enabled,
+	})
+
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return gen.WebhookSubscriptionCreate400JSONResponse(
+			apierrors.NewAPIErrors("A webhook subscription with the same name already exists.", "name"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the secret is only returned once, when the subscription is created
+	subscription.Secret = secret
+
+	return gen.WebhookSubscriptionCreate200JSONResponse(
+		*transformers.ToWebhookSubscriptionCreated(subscription),
+	), nil
+}
+
+func toEventTypes(eventTypes []gen.WebhookEventType) []string {
+	res := make([]string, len(eventTypes))
+
+	for i, eventType := range eventTypes {
+		res[i] = string(eventType)
+	}
+
+	return res
+}
@@ -0,0 +1,31 @@
+package webhooksubscriptions
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (w *WebhookSubscriptionService) WebhookSubscriptionList(ctx echo.Context, request gen.WebhookSubscriptionListRequestObject) (gen.WebhookSubscriptionListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	subscriptions, err := w.config.APIRepository.WebhookSubscription().ListWebhookSubscriptions(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WebhookSubscription, len(subscriptions))
+
+	for i, subscription := range subscriptions {
+		rows[i] = *transformers.ToWebhookSubscription(subscription)
+	}
+
+	return gen.WebhookSubscriptionList200JSONResponse(
+		gen.WebhookSubscriptionList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,79 @@
+package webhooksubscriptions
+
+import (
+	"math"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (w *WebhookSubscriptionService) WebhookDeliveryList(ctx echo.Context, request gen.WebhookDeliveryListRequestObject) (gen.WebhookDeliveryListResponseObject, error) {
+	subscription := ctx.Get("webhook-subscription").(*dbsqlc.WebhookSubscription)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListWebhookDeliveriesOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+
+		if limit < 1 || limit > 1000 {
+			return gen.WebhookDeliveryList400JSONResponse(apierrors.NewAPIErrors("limit must be between 1 and 1000", "limit")), nil
+		}
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+
+		if offset < 0 {
+			return gen.WebhookDeliveryList400JSONResponse(apierrors.NewAPIErrors("offset must not be negative", "offset")), nil
+		}
+	}
+
+	if request.Params.Status != nil {
+		status := dbsqlc.WebhookDeliveryStatus(*request.Params.Status)
+		listOpts.Status = &status
+	}
+
+	listRes, err := w.config.APIRepository.WebhookSubscription().ListWebhookDeliveries(ctx.Request().Context(), sqlchelpers.UUIDToStr(subscription.ID), listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WebhookDelivery, len(listRes.Rows))
+
+	for i, delivery := range listRes.Rows {
+		rows[i] = *transformers.ToWebhookDelivery(delivery)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.WebhookDeliveryList200JSONResponse(
+		gen.WebhookDeliveryList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
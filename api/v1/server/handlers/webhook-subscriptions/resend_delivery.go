@@ -0,0 +1,24 @@
+package webhooksubscriptions
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (w *WebhookSubscriptionService) WebhookDeliveryResend(ctx echo.Context, request gen.WebhookDeliveryResendRequestObject) (gen.WebhookDeliveryResendResponseObject, error) {
+	delivery := ctx.Get("webhook-delivery").(*dbsqlc.WebhookDelivery)
+
+	resent, err := w.config.APIRepository.WebhookSubscription().ResendWebhookDelivery(ctx.Request().Context(), sqlchelpers.UUIDToStr(delivery.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WebhookDeliveryResend200JSONResponse(
+		*transformers.ToWebhookDelivery(resent),
+	), nil
+}
@@ -0,0 +1,15 @@
+package webhooksubscriptions
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type WebhookSubscriptionService struct {
+	config *server.ServerConfig
+}
+
+func NewWebhookSubscriptionService(config *server.ServerConfig) *WebhookSubscriptionService {
+	return &WebhookSubscriptionService{
+		config: config,
+	}
+}
@@ -0,0 +1,45 @@
+package webhooksubscriptions
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (w *WebhookSubscriptionService) WebhookSubscriptionUpdate(ctx echo.Context, request gen.WebhookSubscriptionUpdateRequestObject) (gen.WebhookSubscriptionUpdateResponseObject, error) {
+	subscription := ctx.Get("webhook-subscription").(*dbsqlc.WebhookSubscription)
+
+	// validate the request
+	if apiErrors, err := w.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WebhookSubscriptionUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	updateOpts := &repository.UpdateWebhookSubscriptionOpts{
+		URL:     request.Body.Url,
+		Enabled: request.Body.Enabled,
+	}
+
+	if request.Body.EventTypes != nil {
+		updateOpts.EventTypes = toEventTypes(*request.Body.EventTypes)
+	}
+
+	updated, err := w.config.APIRepository.WebhookSubscription().UpdateWebhookSubscription(
+		ctx.Request().Context(),
+		sqlchelpers.UUIDToStr(subscription.ID),
+		updateOpts,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WebhookSubscriptionUpdate200JSONResponse(
+		*transformers.ToWebhookSubscription(updated),
+	), nil
+}
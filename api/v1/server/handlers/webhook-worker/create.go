@@ -27,7 +27,12 @@ func (i *WebhookWorkersService) WebhookCreate(ctx echo.Context, request gen.Webh
 		secret = *request.Body.Secret
 	}
 
-	encSecret, err := i.config.Encryption.EncryptString(secret, tenant.ID)
+	enc, err := i.config.Encryption.ForTenant(tenant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	encSecret, err := enc.EncryptString(secret, tenant.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,123 @@
+package workflowruns
+
+import (
+	"context"
+	"fmt"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// maxBulkWorkflowRunIds is the maximum number of workflow run ids which can be passed to a bulk cancel or
+// replay.
+const maxBulkWorkflowRunIds = 500
+
+// maxBulkWorkflowRunsFilter is the maximum number of workflow runs which the filter of a bulk cancel or
+// replay can select. Filters which select more runs are rejected, so that a bulk operation never acts on
+// only a part of the runs which the caller selected.
+const maxBulkWorkflowRunsFilter = 10000
+
+// listBulkWorkflowRunIds returns the ids of the workflow runs of a bulk cancel or replay, which are either
+// the given ids of runs of the tenant or the runs which match the filter. The runs of filters without
+// statuses are limited to defaultStatuses. It returns API errors if the selection is invalid.
+func (t *WorkflowRunsService) listBulkWorkflowRunIds(
+	ctx context.Context,
+	tenantId string,
+	ids *[]openapi_types.UUID,
+	filter *gen.WorkflowRunsFilter,
+	defaultStatuses []db.WorkflowRunStatus,
+) ([]string, *gen.APIErrors, error) {
+	if (ids == nil) == (filter == nil) {
+		apiErrors := apierrors.NewAPIErrors("Either workflowRunIds or filter must be set.")
+		return nil, &apiErrors, nil
+	}
+
+	var opts *repository.ListWorkflowRunsOpts
+
+	if ids != nil {
+		if len(*ids) > maxBulkWorkflowRunIds {
+			apiErrors := apierrors.NewAPIErrors(fmt.Sprintf("At most %d workflow run ids can be passed at once.", maxBulkWorkflowRunIds))
+			return nil, &apiErrors, nil
+		}
+
+		if len(*ids) == 0 {
+			return []string{}, nil, nil
+		}
+
+		runIds := make([]string, len(*ids))
+
+		for i, id := range *ids {
+			runIds[i] = id.String()
+		}
+
+		limit := len(runIds)
+
+		// make sure all workflow runs belong to the tenant
+		opts = &repository.ListWorkflowRunsOpts{
+			Ids:   runIds,
+			Limit: &limit,
+		}
+	} else {
+		if filter.WorkflowId == nil && filter.Statuses == nil && filter.CreatedAfter == nil && filter.CreatedBefore == nil && filter.EventKey == nil {
+			apiErrors := apierrors.NewAPIErrors("The filter must have at least one field.")
+			return nil, &apiErrors, nil
+		}
+
+		// select one more run than the maximum to find out if the filter selects too many runs
+		limit := maxBulkWorkflowRunsFilter + 1
+
+		opts = &repository.ListWorkflowRunsOpts{
+			CreatedAfter:   filter.CreatedAfter,
+			CreatedBefore:  filter.CreatedBefore,
+			EventKey:       filter.EventKey,
+			Limit:          &limit,
+			OrderBy:        repository.StringPtr("createdAt"),
+			OrderDirection: repository.StringPtr("ASC"),
+		}
+
+		if filter.WorkflowId != nil {
+			workflowId := filter.WorkflowId.String()
+			opts.WorkflowId = &workflowId
+		}
+
+		statuses := defaultStatuses
+
+		if filter.Statuses != nil {
+			statuses = make([]db.WorkflowRunStatus, len(*filter.Statuses))
+
+			for i, status := range *filter.Statuses {
+				statuses[i] = db.WorkflowRunStatus(status)
+			}
+		}
+
+		opts.Statuses = &statuses
+	}
+
+	workflowRuns, err := t.config.EngineRepository.WorkflowRun().ListWorkflowRuns(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not list workflow runs: %w", err)
+	}
+
+	if len(workflowRuns.Rows) > maxBulkWorkflowRunsFilter {
+		apiErrors := apierrors.NewAPIErrors(fmt.Sprintf(
+			"The filter selects more than %d workflow runs. Narrow it down, for example with a shorter time window.",
+			maxBulkWorkflowRunsFilter,
+		))
+
+		return nil, &apiErrors, nil
+	}
+
+	runIds := make([]string, len(workflowRuns.Rows))
+
+	for i, row := range workflowRuns.Rows {
+		runIds[i] = sqlchelpers.UUIDToStr(row.WorkflowRun.ID)
+	}
+
+	return runIds, nil, nil
+}
@@ -0,0 +1,48 @@
+package workflowruns
+
+import (
+	"context"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+func TestListBulkWorkflowRunIdsValidation(t *testing.T) {
+	// the selection is validated before the workflow runs are listed, so no repository is needed
+	svc := &WorkflowRunsService{}
+
+	tooManyIds := make([]openapi_types.UUID, maxBulkWorkflowRunIds+1)
+	eventKey := "user:create"
+
+	tests := []struct {
+		name   string
+		ids    *[]openapi_types.UUID
+		filter *gen.WorkflowRunsFilter
+	}{
+		{name: "neither ids nor filter"},
+		{name: "ids and filter", ids: &[]openapi_types.UUID{}, filter: &gen.WorkflowRunsFilter{EventKey: &eventKey}},
+		{name: "too many ids", ids: &tooManyIds},
+		{name: "empty filter", filter: &gen.WorkflowRunsFilter{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, apiErrors, err := svc.listBulkWorkflowRunIds(context.Background(), "tenant", tt.ids, tt.filter, nil)
+
+			require.NoError(t, err)
+			require.NotNil(t, apiErrors)
+			assert.Len(t, apiErrors.Errors, 1)
+			assert.Nil(t, ids)
+		})
+	}
+
+	ids, apiErrors, err := svc.listBulkWorkflowRunIds(context.Background(), "tenant", &[]openapi_types.UUID{}, nil, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, apiErrors)
+	assert.Empty(t, ids)
+}
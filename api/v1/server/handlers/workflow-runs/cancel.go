@@ -0,0 +1,96 @@
+package workflowruns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// cancelConcurrency is the number of workflow runs whose job runs are looked up at the same time.
+const cancelConcurrency = 10
+
+func (t *WorkflowRunsService) WorkflowRunUpdateCancel(ctx echo.Context, request gen.WorkflowRunUpdateCancelRequestObject) (gen.WorkflowRunUpdateCancelResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	workflowRunIds, apiErrors, err := t.listBulkWorkflowRunIds(
+		ctx.Request().Context(),
+		tenant.ID,
+		request.Body.WorkflowRunIds,
+		request.Body.Filter,
+		[]db.WorkflowRunStatus{db.WorkflowRunStatusPending, db.WorkflowRunStatusQueued, db.WorkflowRunStatusRunning},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if apiErrors != nil {
+		return gen.WorkflowRunUpdateCancel400JSONResponse(*apiErrors), nil
+	}
+
+	// the runs can be selected with a filter, so the audit log records which runs were cancelled
+	audit.AddDetails(ctx, map[string]any{
+		"cancelledWorkflowRunIds": workflowRunIds,
+	})
+
+	eg, egCtx := errgroup.WithContext(ctx.Request().Context())
+	eg.SetLimit(cancelConcurrency)
+
+	for _, workflowRunId := range workflowRunIds {
+		eg.Go(func() error {
+			return t.cancelWorkflowRun(egCtx, tenant.ID, workflowRunId)
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	res := gen.CancelWorkflowRunsResponse{
+		WorkflowRunIds: make([]uuid.UUID, len(workflowRunIds)),
+	}
+
+	for i, workflowRunId := range workflowRunIds {
+		res.WorkflowRunIds[i] = uuid.MustParse(workflowRunId)
+	}
+
+	return gen.WorkflowRunUpdateCancel200JSONResponse(res), nil
+}
+
+// cancelWorkflowRun sends a task to cancel each job run of the workflow run. Job runs which are already
+// finished aren't changed.
+func (t *WorkflowRunsService) cancelWorkflowRun(ctx context.Context, tenantId, workflowRunId string) error {
+	jobRuns, err := t.config.EngineRepository.JobRun().ListJobRunsForWorkflowRun(ctx, tenantId, workflowRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not list job runs for workflow run %s: %w", workflowRunId, err)
+	}
+
+	reason := "CANCELLED_BY_USER"
+
+	for _, jobRun := range jobRuns {
+		jobRunId := sqlchelpers.UUIDToStr(jobRun.ID)
+
+		err = t.config.MessageQueue.AddMessage(
+			ctx,
+			msgqueue.JOB_PROCESSING_QUEUE,
+			tasktypes.JobRunCancelledToTask(tenantId, jobRunId, &reason),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not send cancel task for job run %s: %w", jobRunId, err)
+		}
+	}
+
+	return nil
+}
@@ -7,44 +7,48 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
-	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
 func (t *WorkflowRunsService) WorkflowRunUpdateReplay(ctx echo.Context, request gen.WorkflowRunUpdateReplayRequestObject) (gen.WorkflowRunUpdateReplayResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
-	workflowRunIds := make([]string, len(request.Body.WorkflowRunIds))
-
-	for i := range request.Body.WorkflowRunIds {
-		workflowRunIds[i] = request.Body.WorkflowRunIds[i].String()
-	}
-
-	limit := 500
-
-	// make sure all workflow runs belong to the tenant
-	filteredWorkflowRuns, err := t.config.EngineRepository.WorkflowRun().ListWorkflowRuns(ctx.Request().Context(), tenant.ID, &repository.ListWorkflowRunsOpts{
-		Ids:   workflowRunIds,
-		Limit: &limit,
-	})
+	workflowRunIds, apiErrors, err := t.listBulkWorkflowRunIds(
+		ctx.Request().Context(),
+		tenant.ID,
+		request.Body.WorkflowRunIds,
+		request.Body.Filter,
+		[]db.WorkflowRunStatus{db.WorkflowRunStatusSucceeded, db.WorkflowRunStatusFailed, db.WorkflowRunStatus(dbsqlc.WorkflowRunStatusCANCELLED)},
+	)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if apiErrors != nil {
+		return gen.WorkflowRunUpdateReplay400JSONResponse(*apiErrors), nil
+	}
+
 	var allErrs error
 
-	for i := range filteredWorkflowRuns.Rows {
+	// the runs can be selected with a filter, so the audit log records which runs were replayed
+	audit.AddDetails(ctx, map[string]any{
+		"replayedWorkflowRunIds": workflowRunIds,
+	})
+
+	for _, workflowRunId := range workflowRunIds {
 		// push to task queue
 		err = t.config.MessageQueue.AddMessage(
 			ctx.Request().Context(),
 			msgqueue.WORKFLOW_PROCESSING_QUEUE,
-			tasktypes.WorkflowRunReplayToTask(tenant.ID, sqlchelpers.UUIDToStr(filteredWorkflowRuns.Rows[i].WorkflowRun.ID)),
+			tasktypes.WorkflowRunReplayToTask(tenant.ID, workflowRunId),
 		)
 
 		if err != nil {
@@ -56,6 +60,16 @@ func (t *WorkflowRunsService) WorkflowRunUpdateReplay(ctx echo.Context, request
 		return nil, allErrs
 	}
 
+	if len(workflowRunIds) == 0 {
+		return gen.WorkflowRunUpdateReplay200JSONResponse(
+			gen.ReplayWorkflowRunsResponse{
+				WorkflowRuns: []gen.WorkflowRun{},
+			},
+		), nil
+	}
+
+	limit := len(workflowRunIds)
+
 	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 60*time.Second)
 	defer cancel()
 
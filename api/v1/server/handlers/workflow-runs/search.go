@@ -0,0 +1,190 @@
+package workflowruns
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 1000
+)
+
+func (t *WorkflowRunsService) WorkflowRunSearch(ctx echo.Context, request gen.WorkflowRunSearchRequestObject) (gen.WorkflowRunSearchResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	opts, apiErrors := searchOpts(request.Params)
+
+	if apiErrors != nil {
+		return gen.WorkflowRunSearch400JSONResponse(*apiErrors), nil
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	workflowRuns, err := t.config.APIRepository.WorkflowRun().ListWorkflowRuns(dbCtx, tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := gen.WorkflowRunSearchResult{
+		Rows: make([]gen.WorkflowRun, len(workflowRuns.Rows)),
+	}
+
+	for i, workflowRun := range workflowRuns.Rows {
+		res.Rows[i] = *transformers.ToWorkflowRunFromSQLC(workflowRun)
+	}
+
+	// a full page may be followed by more workflow runs, the next page is empty otherwise
+	if len(workflowRuns.Rows) == *opts.Limit {
+		last := workflowRuns.Rows[len(workflowRuns.Rows)-1].WorkflowRun
+
+		nextCursor := encodeCursor(&repository.WorkflowRunCursor{
+			CreatedAt: last.CreatedAt.Time,
+			Id:        sqlchelpers.UUIDToStr(last.ID),
+		})
+
+		res.NextCursor = &nextCursor
+	}
+
+	return gen.WorkflowRunSearch200JSONResponse(res), nil
+}
+
+// searchOpts returns the list options of a search, or API errors if the parameters are invalid.
+func searchOpts(params gen.WorkflowRunSearchParams) (*repository.ListWorkflowRunsOpts, *gen.APIErrors) {
+	limit := defaultSearchLimit
+
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > maxSearchLimit {
+			apiErrors := apierrors.NewAPIErrors(fmt.Sprintf("The limit must be between 1 and %d.", maxSearchLimit))
+			return nil, &apiErrors
+		}
+
+		limit = int(*params.Limit)
+	}
+
+	orderDirection := "DESC"
+
+	if params.OrderByDirection != nil {
+		orderDirection = string(*params.OrderByDirection)
+	}
+
+	opts := &repository.ListWorkflowRunsOpts{
+		Limit:          &limit,
+		OrderBy:        repository.StringPtr("createdAt"),
+		OrderDirection: &orderDirection,
+		CreatedAfter:   params.CreatedAfter,
+		CreatedBefore:  params.CreatedBefore,
+		FinishedAfter:  params.FinishedAfter,
+		FinishedBefore: params.FinishedBefore,
+		Search:         params.Search,
+	}
+
+	if params.Cursor != nil {
+		cursor, err := decodeCursor(*params.Cursor)
+
+		if err != nil {
+			apiErrors := apierrors.NewAPIErrors("The cursor is invalid.")
+			return nil, &apiErrors
+		}
+
+		opts.Cursor = cursor
+	}
+
+	if params.Statuses != nil {
+		statuses := make([]db.WorkflowRunStatus, len(*params.Statuses))
+
+		for i, status := range *params.Statuses {
+			statuses[i] = db.WorkflowRunStatus(status)
+		}
+
+		opts.Statuses = &statuses
+	}
+
+	if params.WorkflowIds != nil {
+		opts.WorkflowIds = make([]string, len(*params.WorkflowIds))
+
+		for i, workflowId := range *params.WorkflowIds {
+			opts.WorkflowIds[i] = workflowId.String()
+		}
+	}
+
+	if params.EventKeys != nil {
+		opts.EventKeys = *params.EventKeys
+	}
+
+	if params.ParentWorkflowRunId != nil {
+		parentId := params.ParentWorkflowRunId.String()
+		opts.ParentId = &parentId
+	}
+
+	if params.AdditionalMetadata != nil {
+		additionalMetadata := make(map[string]interface{}, len(*params.AdditionalMetadata))
+
+		for _, v := range *params.AdditionalMetadata {
+			key, value, found := strings.Cut(v, ":")
+
+			if !found {
+				apiErrors := apierrors.NewAPIErrors("Additional metadata filters must be in the format key:value.")
+				return nil, &apiErrors
+			}
+
+			additionalMetadata[key] = value
+		}
+
+		opts.AdditionalMetadata = additionalMetadata
+	}
+
+	return opts, nil
+}
+
+// encodeCursor encodes the position of a workflow run as an opaque cursor.
+func encodeCursor(cursor *repository.WorkflowRunCursor) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(strconv.FormatInt(cursor.CreatedAt.UnixMicro(), 10) + ":" + cursor.Id),
+	)
+}
+
+func decodeCursor(cursor string) (*repository.WorkflowRunCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	createdAtStr, id, found := strings.Cut(string(decoded), ":")
+
+	if !found {
+		return nil, fmt.Errorf("cursor has no id")
+	}
+
+	createdAt, err := strconv.ParseInt(createdAtStr, 10, 64)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse cursor time: %w", err)
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("could not parse cursor id: %w", err)
+	}
+
+	return &repository.WorkflowRunCursor{
+		CreatedAt: time.UnixMicro(createdAt).UTC(),
+		Id:        id,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package workflowruns
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestSearchCursorRoundTrip(t *testing.T) {
+	cursor := &repository.WorkflowRunCursor{
+		CreatedAt: time.Date(2025, 2, 7, 10, 32, 18, 123000000, time.UTC),
+		Id:        "bb214807-246e-43a5-a25d-41761d1cff9e",
+	}
+
+	decoded, err := decodeCursor(encodeCursor(cursor))
+
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	for _, cursor := range []string{
+		"not base64!",
+		base64.RawURLEncoding.EncodeToString([]byte("1738924338123000")),
+		base64.RawURLEncoding.EncodeToString([]byte("yesterday:bb214807-246e-43a5-a25d-41761d1cff9e")),
+		base64.RawURLEncoding.EncodeToString([]byte("1738924338123000:not-a-uuid")),
+	} {
+		_, err := decodeCursor(cursor)
+		assert.Error(t, err, cursor)
+	}
+}
+
+func TestSearchOpts(t *testing.T) {
+	workflowId := openapi_types.UUID{1}
+	statuses := gen.WorkflowRunStatusList{gen.FAILED}
+	search := "timeout"
+	cursor := encodeCursor(&repository.WorkflowRunCursor{
+		CreatedAt: time.Unix(1738924338, 0).UTC(),
+		Id:        "bb214807-246e-43a5-a25d-41761d1cff9e",
+	})
+
+	opts, apiErrors := searchOpts(gen.WorkflowRunSearchParams{
+		Statuses:           &statuses,
+		WorkflowIds:        &[]openapi_types.UUID{workflowId},
+		EventKeys:          &[]string{"order:created", "order:updated"},
+		AdditionalMetadata: &[]string{"url:https://example.com"},
+		Search:             &search,
+		Cursor:             &cursor,
+	})
+
+	require.Nil(t, apiErrors)
+
+	assert.Equal(t, defaultSearchLimit, *opts.Limit)
+	assert.Equal(t, "createdAt", *opts.OrderBy)
+	assert.Equal(t, "DESC", *opts.OrderDirection)
+	assert.Equal(t, []string{workflowId.String()}, opts.WorkflowIds)
+	assert.Equal(t, []string{"order:created", "order:updated"}, opts.EventKeys)
+	assert.Equal(t, map[string]interface{}{"url": "https://example.com"}, opts.AdditionalMetadata)
+	assert.Equal(t, &search, opts.Search)
+	assert.Equal(t, "bb214807-246e-43a5-a25d-41761d1cff9e", opts.Cursor.Id)
+	assert.Len(t, *opts.Statuses, 1)
+}
+
+func TestSearchOptsValidation(t *testing.T) {
+	zero := int64(0)
+	tooMany := int64(maxSearchLimit + 1)
+	invalidCursor := "invalid"
+
+	tests := []struct {
+		name   string
+		params gen.WorkflowRunSearchParams
+	}{
+		{name: "zero limit", params: gen.WorkflowRunSearchParams{Limit: &zero}},
+		{name: "limit too high", params: gen.WorkflowRunSearchParams{Limit: &tooMany}},
+		{name: "invalid cursor", params: gen.WorkflowRunSearchParams{Cursor: &invalidCursor}},
+		{name: "metadata without value", params: gen.WorkflowRunSearchParams{AdditionalMetadata: &[]string{"key"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, apiErrors := searchOpts(tt.params)
+
+			require.NotNil(t, apiErrors)
+			assert.Len(t, apiErrors.Errors, 1)
+			assert.Nil(t, opts)
+		})
+	}
+}
@@ -20,6 +20,10 @@ func (t *WorkflowService) CronWorkflowTriggerCreate(ctx echo.Context, request ge
 		return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("cron name is required")), nil
 	}
 
+	if err := repository.ActorFromContext(ctx.Request().Context()).CheckNamespace(request.Workflow); err != nil {
+		return gen.CronWorkflowTriggerCreate403JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
 	workflow, err := t.config.EngineRepository.Workflow().GetWorkflowByName(ctx.Request().Context(), tenant.ID, request.Workflow)
 
 	if err != nil {
@@ -14,6 +14,10 @@ import (
 func (t *WorkflowService) ScheduledWorkflowRunCreate(ctx echo.Context, request gen.ScheduledWorkflowRunCreateRequestObject) (gen.ScheduledWorkflowRunCreateResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
+	if err := repository.ActorFromContext(ctx.Request().Context()).CheckNamespace(request.Workflow); err != nil {
+		return gen.ScheduledWorkflowRunCreate403JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
 	workflow, err := t.config.EngineRepository.Workflow().GetWorkflowByName(ctx.Request().Context(), tenant.ID, request.Workflow)
 
 	if err != nil {
@@ -23,6 +23,17 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
 
+	if err := repository.ActorFromContext(ctx.Request().Context()).CheckNamespace(workflow.Workflow.Name); err != nil {
+		return gen.WorkflowRunCreate403JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowRunCreate400JSONResponse(*apiErrors), nil
+	}
+
 	var workflowVersionId string
 
 	if request.Params.Version != nil {
@@ -50,6 +61,15 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 		return nil, err
 	}
 
+	// runs which don't request a version are split between the versions of the workflow by its version weights
+	if request.Params.Version == nil {
+		workflowVersion, err = t.config.EngineRepository.Workflow().RouteWorkflowVersion(ctx.Request().Context(), tenant.ID, workflowVersion)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// make sure input can be marshalled and unmarshalled to input type
 	inputBytes, err := json.Marshal(request.Body.Input)
 
@@ -78,7 +98,13 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 		}
 	}
 
-	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, inputBytes, additionalMetadata)
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(
+		workflowVersion,
+		inputBytes,
+		additionalMetadata,
+		repository.WithActor(repository.ActorFromContext(ctx.Request().Context())),
+		repository.WithPriority(request.Body.Priority),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +113,7 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 
 	if err == metered.ErrResourceExhausted {
 		return gen.WorkflowRunCreate429JSONResponse(
-			apierrors.NewAPIErrors("Workflow Run limit exceeded"),
+			apierrors.NewAPIErrors("Workflow Run or Queued Run limit exceeded"),
 		), nil
 	}
 
@@ -0,0 +1,108 @@
+package workflows
+
+import (
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// defaultVersionStatsWindow is the time range of the run statistics of each version if none is requested.
+const defaultVersionStatsWindow = 24 * time.Hour
+
+func (t *WorkflowService) WorkflowVersionWeightsGet(ctx echo.Context, request gen.WorkflowVersionWeightsGetRequestObject) (gen.WorkflowVersionWeightsGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
+	workflowId := sqlchelpers.UUIDToStr(workflow.Workflow.ID)
+
+	weights, err := t.config.APIRepository.Workflow().ListWorkflowVersionWeights(ctx.Request().Context(), tenant.ID, workflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().Add(-defaultVersionStatsWindow)
+
+	if request.Params.Since != nil {
+		since = *request.Params.Since
+	}
+
+	resp, err := t.getVersionWeights(ctx, tenant.ID, workflowId, weights, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowVersionWeightsGet200JSONResponse(*resp), nil
+}
+
+func (t *WorkflowService) WorkflowVersionWeightsUpdate(ctx echo.Context, request gen.WorkflowVersionWeightsUpdateRequestObject) (gen.WorkflowVersionWeightsUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
+	workflowId := sqlchelpers.UUIDToStr(workflow.Workflow.ID)
+
+	opts := &repository.SetWorkflowVersionWeightsOpts{
+		Weights: make([]repository.WorkflowVersionWeightOpts, len(request.Body.Weights)),
+	}
+
+	if request.Body.LatestWeight != nil {
+		if *request.Body.LatestWeight < 0 {
+			return gen.WorkflowVersionWeightsUpdate400JSONResponse(
+				apierrors.NewAPIErrors("weights must not be negative"),
+			), nil
+		}
+
+		opts.LatestWeight = *request.Body.LatestWeight
+	}
+
+	for i, w := range request.Body.Weights {
+		if w.Weight < 0 {
+			return gen.WorkflowVersionWeightsUpdate400JSONResponse(
+				apierrors.NewAPIErrors("weights must not be negative"),
+			), nil
+		}
+
+		opts.Weights[i] = repository.WorkflowVersionWeightOpts{
+			WorkflowVersionId: w.WorkflowVersionId.String(),
+			Weight:            w.Weight,
+		}
+	}
+
+	weights, err := t.config.APIRepository.Workflow().SetWorkflowVersionWeights(ctx.Request().Context(), tenant.ID, workflowId, opts)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidVersionWeights) {
+			return gen.WorkflowVersionWeightsUpdate400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	resp, err := t.getVersionWeights(ctx, tenant.ID, workflowId, weights, time.Now().Add(-defaultVersionStatsWindow))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowVersionWeightsUpdate200JSONResponse(*resp), nil
+}
+
+func (t *WorkflowService) getVersionWeights(ctx echo.Context, tenantId, workflowId string, weights *repository.WorkflowVersionWeights, since time.Time) (*gen.WorkflowVersionWeights, error) {
+	stats, err := t.config.APIRepository.Workflow().CountWorkflowRunsByVersion(ctx.Request().Context(), tenantId, workflowId, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return transformers.ToWorkflowVersionWeights(weights, stats), nil
+}
@@ -0,0 +1,309 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// maxPayloadSize is the largest request body which is stored with an audit log. The payloads of larger
+// requests, like events with large data, aren't stored.
+const maxPayloadSize = 64 * 1024
+
+// redacted replaces the values of sensitive fields in the stored payloads.
+const redacted = "[REDACTED]"
+
+// auditLogKey is the context key of the audit log which is recorded once the request succeeds.
+const auditLogKey = "audit-log"
+
+type pendingAuditLog struct {
+	tenantId string
+	opts     *repository.CreateAuditLogOpts
+
+	// details are the fields which the handler added to the payload
+	details map[string]any
+}
+
+// AddDetails adds fields to the payload of the audit log of the request, for the effects of the action which
+// aren't part of the request body, like the ids of the runs which were cancelled for an event. It does nothing
+// for requests which aren't audited.
+func AddDetails(c echo.Context, details map[string]any) {
+	pending, ok := c.Get(auditLogKey).(*pendingAuditLog)
+
+	if !ok {
+		return
+	}
+
+	if pending.details == nil {
+		pending.details = make(map[string]any, len(details))
+	}
+
+	for key, value := range details {
+		pending.details[key] = value
+	}
+}
+
+// AuditLogger records the mutating requests of the REST API in the audit logs of their tenants.
+type AuditLogger struct {
+	config *server.ServerConfig
+
+	l *zerolog.Logger
+}
+
+func NewAuditLogger(config *server.ServerConfig) *AuditLogger {
+	return &AuditLogger{
+		config: config,
+		l:      config.Logger,
+	}
+}
+
+// Middleware prepares the audit log of mutating, tenant-scoped requests. It must run after the request
+// has been authenticated and authorized.
+func (a *AuditLogger) Middleware(r *middleware.RouteInfo) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !isMutating(c.Request().Method) || len(r.Resources) == 0 || r.Resources[0] != "tenant" {
+			return nil
+		}
+
+		tenant, ok := c.Get("tenant").(*db.TenantModel)
+
+		if !ok {
+			return nil
+		}
+
+		actor := actorFromContext(c)
+
+		if actor == nil {
+			return nil
+		}
+
+		opts := &repository.CreateAuditLogOpts{
+			Actor:  actor,
+			Action: r.OperationID,
+		}
+
+		if ip := c.RealIP(); ip != "" {
+			opts.IpAddress = &ip
+		}
+
+		// the audited resource is the most specific resource of the route
+		for i := len(r.Resources) - 1; i >= 0; i-- {
+			resourceType := r.Resources[i]
+			resourceId := c.Param(resourceType)
+
+			if resourceType == "tenant" && resourceId == "" {
+				resourceId = tenant.ID
+			}
+
+			if _, err := uuid.Parse(resourceId); err == nil {
+				opts.ResourceType = &resourceType
+				opts.ResourceId = &resourceId
+				break
+			}
+		}
+
+		payload, err := readPayload(c.Request())
+
+		if err != nil {
+			return err
+		}
+
+		opts.Payload = payload
+
+		// the prior state is the resource which was loaded for the request, except for requests which create
+		// resources in the tenant
+		if opts.ResourceType != nil && (*opts.ResourceType != "tenant" || c.Request().Method != http.MethodPost) {
+			opts.PriorState = readPriorState(c.Get(*opts.ResourceType))
+		}
+
+		c.Set(auditLogKey, &pendingAuditLog{
+			tenantId: tenant.ID,
+			opts:     opts,
+		})
+
+		return nil
+	}
+}
+
+// Record writes the audit log which was prepared by Middleware once the request has succeeded. Failing to
+// write it doesn't fail the request, as the change has already been made.
+func (a *AuditLogger) Record(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if err := next(c); err != nil {
+			return err
+		}
+
+		pending, ok := c.Get(auditLogKey).(*pendingAuditLog)
+
+		if !ok || c.Response().Status >= http.StatusBadRequest {
+			return nil
+		}
+
+		if len(pending.details) > 0 {
+			pending.opts.Payload = withDetails(pending.opts.Payload, pending.details)
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request().Context()), 10*time.Second)
+		defer cancel()
+
+		if _, err := a.config.APIRepository.AuditLog().CreateAuditLog(ctx, pending.tenantId, pending.opts); err != nil {
+			serverutils.RequestLogger(c.Request().Context(), a.l).Error().Err(err).Msgf("could not record audit log of %s", pending.opts.Action)
+		}
+
+		return nil
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func actorFromContext(c echo.Context) *repository.Actor {
+	if actor := repository.ActorFromContext(c.Request().Context()); actor != nil {
+		return actor
+	}
+
+	if user, ok := c.Get("user").(*db.UserModel); ok {
+		return &repository.Actor{
+			Type: repository.ActorTypeUser,
+			Id:   user.ID,
+		}
+	}
+
+	return nil
+}
+
+// readPayload returns the JSON body of the request with sensitive fields redacted, and restores the body
+// for the handler.
+func readPayload(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(body) == 0 || len(body) > maxPayloadSize {
+		return nil, nil
+	}
+
+	var payload any
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, nil
+	}
+
+	return json.Marshal(redact(payload))
+}
+
+// withDetails adds the details which the handler recorded to the redacted request body. Details hold ids rather
+// than credentials, so they aren't redacted.
+func withDetails(payload []byte, details map[string]any) []byte {
+	fields := make(map[string]any, len(details))
+
+	if payload != nil {
+		if err := json.Unmarshal(payload, &fields); err != nil {
+			fields = map[string]any{"body": json.RawMessage(payload)}
+		}
+	}
+
+	if fields == nil {
+		fields = make(map[string]any, len(details))
+	}
+
+	for key, value := range details {
+		fields[key] = value
+	}
+
+	res, err := json.Marshal(fields)
+
+	if err != nil {
+		return payload
+	}
+
+	return res
+}
+
+// readPriorState returns the resource as JSON with sensitive fields redacted, or nil if it's too large to be
+// stored.
+func readPriorState(resource any) []byte {
+	if resource == nil {
+		return nil
+	}
+
+	state, err := json.Marshal(resource)
+
+	if err != nil || len(state) > maxPayloadSize {
+		return nil
+	}
+
+	var fields any
+
+	if err := json.Unmarshal(state, &fields); err != nil {
+		return nil
+	}
+
+	priorState, err := json.Marshal(redact(fields))
+
+	if err != nil {
+		return nil
+	}
+
+	return priorState
+}
+
+// redact replaces the values of fields which hold credentials, like passwords and tokens.
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSensitive(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redact(value)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+
+	return v
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, sensitive := range []string{"password", "secret", "token"} {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
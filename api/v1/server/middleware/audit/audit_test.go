@@ -0,0 +1,213 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type fakeAPIRepository struct {
+	repository.APIRepository
+
+	auditLogs *fakeAuditLogRepository
+}
+
+func (r *fakeAPIRepository) AuditLog() repository.AuditLogAPIRepository {
+	return r.auditLogs
+}
+
+type fakeAuditLogRepository struct {
+	repository.AuditLogAPIRepository
+
+	tenantIds []string
+	logs      []*repository.CreateAuditLogOpts
+}
+
+func (r *fakeAuditLogRepository) CreateAuditLog(ctx context.Context, tenantId string, opts *repository.CreateAuditLogOpts) (*dbsqlc.AuditLog, error) {
+	r.tenantIds = append(r.tenantIds, tenantId)
+	r.logs = append(r.logs, opts)
+	return &dbsqlc.AuditLog{}, nil
+}
+
+func newTestAuditLogger() (*AuditLogger, *fakeAuditLogRepository) {
+	auditLogs := &fakeAuditLogRepository{}
+	l := zerolog.Nop()
+
+	return NewAuditLogger(&server.ServerConfig{
+		Config: &database.Config{
+			APIRepository: &fakeAPIRepository{auditLogs: auditLogs},
+		},
+		Logger: &l,
+	}), auditLogs
+}
+
+// serve runs a request through the audit logger, with the given handler in place of the API handler. The
+// workflow run is the resource which the populator would have loaded, if it's set.
+func serve(a *AuditLogger, r *middleware.RouteInfo, req *http.Request, tenant *db.TenantModel, workflowRun any, handler echo.HandlerFunc) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	c.SetParamNames("tenant", "workflow-run")
+	c.SetParamValues(tenant.ID, uuid.NewString())
+	c.Set("tenant", tenant)
+	c.Set("user", &db.UserModel{InnerUser: db.InnerUser{ID: uuid.NewString()}})
+
+	if workflowRun != nil {
+		c.Set("workflow-run", workflowRun)
+	}
+
+	_ = a.Record(func(c echo.Context) error {
+		if err := a.Middleware(r)(c); err != nil {
+			return err
+		}
+
+		return handler(c)
+	})(c)
+}
+
+func TestAuditLoggerRecordsMutatingRequests(t *testing.T) {
+	a, auditLogs := newTestAuditLogger()
+
+	tenant := &db.TenantModel{InnerTenant: db.InnerTenant{ID: uuid.NewString()}}
+	route := &middleware.RouteInfo{
+		OperationID: "WorkflowRunUpdateReplay",
+		Resources:   []string{"tenant", "workflow-run"},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"reason":"retry","apiToken":"secret-value"}`))
+	req.Header.Set("X-Real-IP", "203.0.113.7")
+
+	serve(a, route, req, tenant, nil, func(c echo.Context) error {
+		// the handler can still read the body
+		body, err := io.ReadAll(c.Request().Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "secret-value")
+
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	require.Len(t, auditLogs.logs, 1)
+
+	log := auditLogs.logs[0]
+
+	assert.Equal(t, tenant.ID, auditLogs.tenantIds[0])
+	assert.Equal(t, "WorkflowRunUpdateReplay", log.Action)
+	assert.Equal(t, repository.ActorTypeUser, log.Actor.Type)
+	assert.Equal(t, "workflow-run", *log.ResourceType)
+	assert.Equal(t, "203.0.113.7", *log.IpAddress)
+	assert.JSONEq(t, `{"reason":"retry","apiToken":"[REDACTED]"}`, string(log.Payload))
+}
+
+func TestAuditLoggerSkipsReadsAndFailures(t *testing.T) {
+	a, auditLogs := newTestAuditLogger()
+
+	tenant := &db.TenantModel{InnerTenant: db.InnerTenant{ID: uuid.NewString()}}
+	route := &middleware.RouteInfo{
+		OperationID: "WorkflowRunGet",
+		Resources:   []string{"tenant", "workflow-run"},
+	}
+
+	serve(a, route, httptest.NewRequest(http.MethodGet, "/", nil), tenant, nil, func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	route.OperationID = "WorkflowRunCancel"
+
+	serve(a, route, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`)), tenant, nil, func(c echo.Context) error {
+		return c.NoContent(http.StatusBadRequest)
+	})
+
+	serve(a, route, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`)), tenant, nil, func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	})
+
+	assert.Empty(t, auditLogs.logs)
+}
+
+func TestAuditLoggerRecordsPriorState(t *testing.T) {
+	a, auditLogs := newTestAuditLogger()
+
+	tenant := &db.TenantModel{InnerTenant: db.InnerTenant{ID: uuid.NewString()}}
+	route := &middleware.RouteInfo{
+		OperationID: "WorkflowRunDelete",
+		Resources:   []string{"tenant", "workflow-run"},
+	}
+
+	workflowRun := struct {
+		Status string `json:"status"`
+		Secret string `json:"secret"`
+	}{
+		Status: "RUNNING",
+		Secret: "secret-value",
+	}
+
+	serve(a, route, httptest.NewRequest(http.MethodDelete, "/", nil), tenant, workflowRun, func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	require.Len(t, auditLogs.logs, 1)
+	assert.JSONEq(t, `{"status":"RUNNING","secret":"[REDACTED]"}`, string(auditLogs.logs[0].PriorState))
+
+	// requests which create resources in the tenant have no prior state
+	route = &middleware.RouteInfo{
+		OperationID: "TenantInviteCreate",
+		Resources:   []string{"tenant"},
+	}
+
+	serve(a, route, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`)), tenant, nil, func(c echo.Context) error {
+		return c.NoContent(http.StatusCreated)
+	})
+
+	require.Len(t, auditLogs.logs, 2)
+	assert.Nil(t, auditLogs.logs[1].PriorState)
+}
+
+func TestAuditLoggerRecordsDetails(t *testing.T) {
+	a, auditLogs := newTestAuditLogger()
+
+	tenant := &db.TenantModel{InnerTenant: db.InnerTenant{ID: uuid.NewString()}}
+	route := &middleware.RouteInfo{
+		OperationID: "EventUpdateCancel",
+		Resources:   []string{"tenant"},
+	}
+
+	workflowRunId := uuid.NewString()
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"eventIds":["1"]}`))
+
+	serve(a, route, req, tenant, nil, func(c echo.Context) error {
+		AddDetails(c, map[string]any{"cancelledWorkflowRunIds": []string{workflowRunId}})
+
+		return c.NoContent(http.StatusOK)
+	})
+
+	require.Len(t, auditLogs.logs, 1)
+	assert.JSONEq(t, `{"eventIds":["1"],"cancelledWorkflowRunIds":["`+workflowRunId+`"]}`, string(auditLogs.logs[0].Payload))
+
+	// requests without a body only have the details
+	serve(a, route, httptest.NewRequest(http.MethodPost, "/", nil), tenant, nil, func(c echo.Context) error {
+		AddDetails(c, map[string]any{"cancelledWorkflowRunIds": []string{}})
+
+		return c.NoContent(http.StatusOK)
+	})
+
+	require.Len(t, auditLogs.logs, 2)
+	assert.JSONEq(t, `{"cancelledWorkflowRunIds":[]}`, string(auditLogs.logs[1].Payload))
+}
@@ -2,16 +2,59 @@ package redirect
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 )
 
 var ErrRedirect = errors.New("redirecting")
 
+// DefaultErrorURL is the page which errors are redirected to when no error page is configured.
+const DefaultErrorURL = "/auth/login"
+
 func GetRedirectWithError(ctx echo.Context, l *zerolog.Logger, internalErr error, userErr string) error {
-	l.Err(internalErr).Msgf("redirecting with error")
-	err := ctx.Redirect(302, "/auth/login?error="+userErr)
+	return GetRedirectWithErrorCode(ctx, l, DefaultErrorURL, internalErr, "", userErr)
+}
+
+// GetRedirectWithErrorCode redirects to the given error page, passing the user-facing error, an
+// optional machine-readable code and the request ID as query parameters, so that users can quote the
+// request ID when reporting the error. If errorURL is empty, DefaultErrorURL is used.
+func GetRedirectWithErrorCode(ctx echo.Context, l *zerolog.Logger, errorURL string, internalErr error, code, userErr string) error {
+	l = serverutils.RequestLogger(ctx.Request().Context(), l)
+
+	l.Err(internalErr).Str("code", code).Msgf("redirecting with error")
+
+	if errorURL == "" {
+		errorURL = DefaultErrorURL
+	}
+
+	redirectURL, err := url.Parse(errorURL)
+
+	if err != nil {
+		l.Err(err).Msgf("invalid error redirect url %s, falling back to %s", errorURL, DefaultErrorURL)
+
+		redirectURL = &url.URL{Path: DefaultErrorURL}
+	}
+
+	q := redirectURL.Query()
+	q.Set("error", userErr)
+
+	if code != "" {
+		q.Set("code", code)
+	}
+
+	if requestId := serverutils.RequestIDFromContext(ctx.Request().Context()); requestId != "" {
+		q.Set("request_id", requestId)
+	}
+
+	redirectURL.RawQuery = q.Encode()
+
+	err = ctx.Redirect(302, redirectURL.String())
 
 	if err != nil {
 		return err
@@ -29,3 +72,56 @@ func GetRedirectNoError(ctx echo.Context, serverURL string) error {
 
 	return ErrRedirect
 }
+
+// ValidateReturnTo checks that returnTo is either a path on the server, an absolute URL with the same
+// origin as serverURL, or an absolute URL with the same origin as one of the allowed URLs. It returns
+// the absolute URL to redirect to.
+func ValidateReturnTo(serverURL string, allowedURLs []string, returnTo string) (string, error) {
+	base, err := url.Parse(serverURL)
+
+	if err != nil {
+		return "", fmt.Errorf("could not parse server url: %w", err)
+	}
+
+	// backslashes are treated as forward slashes by some browsers, so "/\evil.com" would be
+	// protocol-relative
+	if strings.Contains(returnTo, "\\") {
+		return "", fmt.Errorf("return_to must not contain backslashes")
+	}
+
+	target, err := url.Parse(returnTo)
+
+	if err != nil {
+		return "", fmt.Errorf("could not parse return_to: %w", err)
+	}
+
+	if target.Scheme == "" && target.Host == "" {
+		if !strings.HasPrefix(target.Path, "/") {
+			return "", fmt.Errorf("relative return_to must start with /")
+		}
+
+		return base.ResolveReference(target).String(), nil
+	}
+
+	if sameOrigin(base, target) {
+		return target.String(), nil
+	}
+
+	for _, allowed := range allowedURLs {
+		allowedURL, err := url.Parse(allowed)
+
+		if err != nil {
+			continue
+		}
+
+		if sameOrigin(allowedURL, target) {
+			return target.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("return_to %s is not an allowed destination", returnTo)
+}
+
+func sameOrigin(a, b *url.URL) bool {
+	return a.Scheme != "" && strings.EqualFold(a.Scheme, b.Scheme) && strings.EqualFold(a.Host, b.Host)
+}
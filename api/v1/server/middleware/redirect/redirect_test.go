@@ -0,0 +1,93 @@
+package redirect
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
+)
+
+func TestValidateReturnTo(t *testing.T) {
+	serverURL := "https://app.example.com"
+	allowed := []string{"https://spa.example.com"}
+
+	tests := []struct {
+		name     string
+		returnTo string
+		want     string
+		wantErr  bool
+	}{
+		{name: "relative path", returnTo: "/tenants/123?tab=runs", want: "https://app.example.com/tenants/123?tab=runs"},
+		{name: "same origin", returnTo: "https://app.example.com/workflows", want: "https://app.example.com/workflows"},
+		{name: "allowed origin", returnTo: "https://spa.example.com/callback", want: "https://spa.example.com/callback"},
+		{name: "other origin", returnTo: "https://evil.com/callback", wantErr: true},
+		{name: "scheme mismatch", returnTo: "http://app.example.com/workflows", wantErr: true},
+		{name: "protocol relative", returnTo: "//evil.com", wantErr: true},
+		{name: "backslash", returnTo: "/\\evil.com", wantErr: true},
+		{name: "relative without slash", returnTo: "evil.com", wantErr: true},
+		{name: "javascript scheme", returnTo: "javascript:alert(1)", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateReturnTo(serverURL, allowed, tt.returnTo)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetRedirectWithErrorCodeRequestID(t *testing.T) {
+	l := zerolog.Nop()
+
+	e := echo.New()
+	e.Use(serverutils.RequestIDMiddleware())
+	e.GET("/callback", func(c echo.Context) error {
+		err := GetRedirectWithErrorCode(c, &l, "/auth/login", nil, "forbidden", "Forbidden")
+		assert.ErrorIs(t, err, ErrRedirect)
+
+		return nil
+	})
+
+	// a request ID sent by the client is kept
+	req := httptest.NewRequest(http.MethodGet, "/callback", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "req-123", rec.Header().Get(echo.HeaderXRequestID))
+
+	location, err := url.Parse(rec.Header().Get(echo.HeaderLocation))
+	require.NoError(t, err)
+	assert.Equal(t, "req-123", location.Query().Get("request_id"))
+	assert.Equal(t, "forbidden", location.Query().Get("code"))
+
+	// invalid request IDs are replaced
+	req = httptest.NewRequest(http.MethodGet, "/callback", nil)
+	req.Header.Set(echo.HeaderXRequestID, "has spaces")
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	requestId := rec.Header().Get(echo.HeaderXRequestID)
+	assert.NotEmpty(t, requestId)
+	assert.NotEqual(t, "has spaces", requestId)
+
+	location, err = url.Parse(rec.Header().Get(echo.HeaderLocation))
+	require.NoError(t, err)
+	assert.Equal(t, requestId, location.Query().Get("request_id"))
+}
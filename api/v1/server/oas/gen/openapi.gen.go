@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -26,6 +27,13 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for APITokenScope.
+const (
+	Admin       APITokenScope = "admin"
+	EventsWrite APITokenScope = "events:write"
+	RunsRead    APITokenScope = "runs:read"
+)
+
 // Defines values for ConcurrencyLimitStrategy.
 const (
 	CANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
@@ -168,15 +176,46 @@ const (
 	OWNER  TenantMemberRole = "OWNER"
 )
 
+// Defines values for TenantPermission.
+const (
+	ApiTokensManage TenantPermission = "api-tokens:manage"
+	AuditLogsManage TenantPermission = "audit-logs:manage"
+	EventsPush      TenantPermission = "events:push"
+	LimitsManage    TenantPermission = "limits:manage"
+	MembersManage   TenantPermission = "members:manage"
+	SettingsWrite   TenantPermission = "settings:write"
+	TenantRead      TenantPermission = "tenant:read"
+	WorkersManage   TenantPermission = "workers:manage"
+	WorkflowsRun    TenantPermission = "workflows:run"
+	WorkflowsWrite  TenantPermission = "workflows:write"
+)
+
 // Defines values for TenantResource.
 const (
 	CRON        TenantResource = "CRON"
 	EVENT       TenantResource = "EVENT"
+	QUEUEDRUN   TenantResource = "QUEUED_RUN"
 	SCHEDULE    TenantResource = "SCHEDULE"
 	WORKER      TenantResource = "WORKER"
 	WORKFLOWRUN TenantResource = "WORKFLOW_RUN"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCEEDED WebhookDeliveryStatus = "SUCCEEDED"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeRunFailed    WebhookEventType = "run.failed"
+	WebhookEventTypeRunStarted   WebhookEventType = "run.started"
+	WebhookEventTypeRunSucceeded WebhookEventType = "run.succeeded"
+	WebhookEventTypeStepFailed   WebhookEventType = "step.failed"
+	WebhookEventTypeWorkerDown   WebhookEventType = "worker.down"
+)
+
 // Defines values for WorkerStatus.
 const (
 	ACTIVE   WorkerStatus = "ACTIVE"
@@ -205,6 +244,12 @@ const (
 	FUNCTION WorkflowKind = "FUNCTION"
 )
 
+// Defines values for WorkflowRunActorType.
+const (
+	APITOKEN WorkflowRunActorType = "API_TOKEN"
+	USER     WorkflowRunActorType = "USER"
+)
+
 // Defines values for WorkflowRunOrderByDirection.
 const (
 	ASC  WorkflowRunOrderByDirection = "ASC"
@@ -271,6 +316,9 @@ type APIMeta struct {
 
 // APIMetaAuth defines model for APIMetaAuth.
 type APIMetaAuth struct {
+	// OauthProviders the additional OAuth providers which users can log in with
+	OauthProviders *[]APIMetaOAuthProvider `json:"oauthProviders,omitempty"`
+
 	// Schemes the supported types of authentication
 	Schemes *[]string `json:"schemes,omitempty"`
 }
@@ -284,6 +332,15 @@ type APIMetaIntegration struct {
 	Name string `json:"name"`
 }
 
+// APIMetaOAuthProvider defines model for APIMetaOAuthProvider.
+type APIMetaOAuthProvider struct {
+	// DisplayName the name of the provider which is shown to users
+	DisplayName string `json:"displayName"`
+
+	// Name the name of the provider, which identifies its login routes
+	Name string `json:"name"`
+}
+
 // APIMetaPosthog defines model for APIMetaPosthog.
 type APIMetaPosthog struct {
 	// ApiHost the PostHog API host
@@ -313,13 +370,62 @@ type APIToken struct {
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// Namespace The namespace of the API token. A namespaced token can only trigger workflows and push events whose names start with the namespace.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Scopes The scopes of the API token. A token without scopes can be used for everything.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
+// APITokenScope A scope which restricts what an API token can be used for. The admin scope allows everything.
+type APITokenScope string
+
 // AcceptInviteRequest defines model for AcceptInviteRequest.
 type AcceptInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Action The action, which is the operation id of the REST API or the method of the gRPC API.
+	Action string `json:"action"`
+
+	// ActorId The id of the user or API token which performed the action.
+	ActorId *openapi_types.UUID `json:"actorId,omitempty"`
+
+	// ActorType The type of the actor which performed the action, which is USER or API_TOKEN.
+	ActorType string `json:"actorType"`
+
+	// IpAddress The IP address of the actor.
+	IpAddress *string         `json:"ipAddress,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Payload The payload of the action, with credentials redacted.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// PriorState The state of the resource before the action, with credentials redacted.
+	PriorState *map[string]interface{} `json:"priorState,omitempty"`
+
+	// ResourceId The id of the resource which the action was performed on.
+	ResourceId *openapi_types.UUID `json:"resourceId,omitempty"`
+
+	// ResourceType The type of the resource which the action was performed on.
+	ResourceType *string `json:"resourceType,omitempty"`
+}
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]AuditLog         `json:"rows,omitempty"`
+}
+
+// AuditLogSettings defines model for AuditLogSettings.
+type AuditLogSettings struct {
+	// RetentionPeriod How long audit logs are kept, as a duration like 8760h.
+	RetentionPeriod string `json:"retentionPeriod"`
+}
+
 // BulkCreateEventRequest defines model for BulkCreateEventRequest.
 type BulkCreateEventRequest struct {
 	Events []CreateEventRequest `json:"events"`
@@ -330,6 +436,21 @@ type CancelEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
 }
 
+// CancelWorkflowRunsRequest defines model for CancelWorkflowRunsRequest.
+type CancelWorkflowRunsRequest struct {
+	// Filter Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set.
+	Filter *WorkflowRunsFilter `json:"filter,omitempty"`
+
+	// WorkflowRunIds The ids of the workflow runs to cancel. Either the ids or a filter must be set.
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+}
+
+// CancelWorkflowRunsResponse defines model for CancelWorkflowRunsResponse.
+type CancelWorkflowRunsResponse struct {
+	// WorkflowRunIds The ids of the workflow runs which are being cancelled.
+	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
+}
+
 // ConcurrencyLimitStrategy defines model for ConcurrencyLimitStrategy.
 type ConcurrencyLimitStrategy string
 
@@ -340,6 +461,12 @@ type CreateAPITokenRequest struct {
 
 	// Name A name for the API token.
 	Name string `json:"name"`
+
+	// Namespace The namespace of the API token. If set, the token can only trigger workflows and push events whose names start with the namespace.
+	Namespace *string `json:"namespace,omitempty" validate:"omitnil,hatchetName,max=64"`
+
+	// Scopes The scopes of the API token. If not set, the token can be used for everything.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -366,6 +493,9 @@ type CreateEventRequest struct {
 
 	// Key The key for the event.
 	Key string `json:"key"`
+
+	// Priority The priority of the workflow runs triggered by the event, from 1 (lowest) to 3 (highest). Defaults to the default priority of each workflow.
+	Priority *int32 `json:"priority,omitempty" validate:"omitnil,min=1,max=3"`
 }
 
 // CreateSNSIntegrationRequest defines model for CreateSNSIntegrationRequest.
@@ -380,6 +510,18 @@ type CreateTenantAlertEmailGroupRequest struct {
 	Emails []string `json:"emails" validate:"required,dive,email"`
 }
 
+// CreateTenantAlertMuteRuleRequest defines model for CreateTenantAlertMuteRuleRequest.
+type CreateTenantAlertMuteRuleRequest struct {
+	// MutedUntil The time until which the workflow is muted. The workflow is muted indefinitely if not set.
+	MutedUntil *time.Time `json:"mutedUntil,omitempty"`
+
+	// Reason Why the workflow is muted.
+	Reason *string `json:"reason,omitempty" validate:"omitnil,max=255"`
+
+	// WorkflowId The id of the workflow to mute.
+	WorkflowId openapi_types.UUID `json:"workflowId" validate:"required,uuid"`
+}
+
 // CreateTenantInviteRequest defines model for CreateTenantInviteRequest.
 type CreateTenantInviteRequest struct {
 	// Email The email of the user to invite.
@@ -396,6 +538,36 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CreateTenantRoleRequest defines model for CreateTenantRoleRequest.
+type CreateTenantRoleRequest struct {
+	// Description The description of the role.
+	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
+
+	// Name The name of the role.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Permissions The permissions of the members with the role.
+	Permissions []TenantPermission `json:"permissions" validate:"required,min=1"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered, defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes []WebhookEventType `json:"eventTypes" validate:"required,min=1"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Secret The secret which deliveries are signed with. A secret is generated if it's not set.
+	Secret *string `json:"secret,omitempty" validate:"omitnil,min=16,max=255"`
+
+	// Url The url which events are delivered to.
+	Url string `json:"url" validate:"required,url"`
+}
+
 // CronWorkflows defines model for CronWorkflows.
 type CronWorkflows struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
@@ -431,7 +603,10 @@ type Event struct {
 	// Key The key for the event.
 	Key      string          `json:"key"`
 	Metadata APIResourceMeta `json:"metadata"`
-	Tenant   *Tenant         `json:"tenant,omitempty"`
+
+	// ReplayedFromId The id of the event which this event is a replay of.
+	ReplayedFromId *openapi_types.UUID `json:"replayedFromId,omitempty"`
+	Tenant         *Tenant             `json:"tenant,omitempty"`
 
 	// TenantId The ID of the tenant associated with this event.
 	TenantId           string                   `json:"tenantId"`
@@ -468,6 +643,43 @@ type EventOrderByField string
 // EventSearch defines model for EventSearch.
 type EventSearch = string
 
+// EventWorkflowRun defines model for EventWorkflowRun.
+type EventWorkflowRun struct {
+	// CreatedAt When the workflow run was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DispatchLatency The time in milliseconds between the creation of the event and the creation of the workflow run.
+	DispatchLatency int64 `json:"dispatchLatency"`
+
+	// DisplayName The display name of the workflow run.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// FinishedAt When the workflow run finished.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// StartedAt When the workflow run started.
+	StartedAt *time.Time        `json:"startedAt,omitempty"`
+	Status    WorkflowRunStatus `json:"status"`
+
+	// WorkflowId The id of the workflow.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+
+	// WorkflowRunId The id of the workflow run.
+	WorkflowRunId openapi_types.UUID `json:"workflowRunId"`
+
+	// WorkflowVersionId The id of the workflow version which was triggered.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// EventWorkflowRunList defines model for EventWorkflowRunList.
+type EventWorkflowRunList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]EventWorkflowRun `json:"rows,omitempty"`
+}
+
 // EventWorkflowRunSummary defines model for EventWorkflowRunSummary.
 type EventWorkflowRunSummary struct {
 	// Failed The number of failed runs.
@@ -554,7 +766,8 @@ type ListSlackWebhooks struct {
 // LogLine defines model for LogLine.
 type LogLine struct {
 	// CreatedAt The creation date of the log line.
-	CreatedAt time.Time `json:"createdAt"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Level     LogLineLevel `json:"level"`
 
 	// Message The log message.
 	Message string `json:"message"`
@@ -663,9 +876,22 @@ type ReplayEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
 }
 
+// ReplayEventWithPayloadRequest defines model for ReplayEventWithPayloadRequest.
+type ReplayEventWithPayloadRequest struct {
+	// AdditionalMetadata The additional metadata of the replayed event. Defaults to the additional metadata of the original event.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	// Data The data of the replayed event. Defaults to the data of the original event.
+	Data *map[string]interface{} `json:"data,omitempty"`
+}
+
 // ReplayWorkflowRunsRequest defines model for ReplayWorkflowRunsRequest.
 type ReplayWorkflowRunsRequest struct {
-	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
+	// Filter Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set.
+	Filter *WorkflowRunsFilter `json:"filter,omitempty"`
+
+	// WorkflowRunIds The ids of the workflow runs to replay. Either the ids or a filter must be set.
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
 }
 
 // ReplayWorkflowRunsResponse defines model for ReplayWorkflowRunsResponse.
@@ -678,6 +904,12 @@ type RerunStepRunRequest struct {
 	Input map[string]interface{} `json:"input"`
 }
 
+// RotateAPITokenRequest defines model for RotateAPITokenRequest.
+type RotateAPITokenRequest struct {
+	// GracePeriod The duration for which the rotated token stays valid. If not set, the rotated token is revoked immediately.
+	GracePeriod *string `json:"gracePeriod,omitempty" validate:"omitnil,duration"`
+}
+
 // SNSIntegration defines model for SNSIntegration.
 type SNSIntegration struct {
 	// IngestUrl The URL to send SNS messages to.
@@ -841,6 +1073,42 @@ type StepRunArchiveList struct {
 	Rows       *[]StepRunArchive   `json:"rows,omitempty"`
 }
 
+// StepRunDeadLetter defines model for StepRunDeadLetter.
+type StepRunDeadLetter struct {
+	// ActionId The action id of the step.
+	ActionId string `json:"actionId"`
+
+	// DeadLetteredAt When the step run was dead-lettered.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+
+	// Error The error of the last attempt of the step run.
+	Error *string `json:"error,omitempty"`
+
+	// JobRunId The id of the job run of the step run.
+	JobRunId openapi_types.UUID `json:"jobRunId"`
+
+	// RetryCount The number of times the step run was retried before it was dead-lettered.
+	RetryCount int `json:"retryCount"`
+
+	// StepReadableId The readable id of the step.
+	StepReadableId *string `json:"stepReadableId,omitempty"`
+
+	// StepRunId The id of the step run.
+	StepRunId openapi_types.UUID `json:"stepRunId"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+
+	// WorkflowRunId The id of the workflow run of the step run.
+	WorkflowRunId openapi_types.UUID `json:"workflowRunId"`
+}
+
+// StepRunDeadLetterList defines model for StepRunDeadLetterList.
+type StepRunDeadLetterList struct {
+	Pagination *PaginationResponse  `json:"pagination,omitempty"`
+	Rows       *[]StepRunDeadLetter `json:"rows,omitempty"`
+}
+
 // StepRunEvent defines model for StepRunEvent.
 type StepRunEvent struct {
 	Count         int                     `json:"count"`
@@ -876,8 +1144,11 @@ type Tenant struct {
 	AlertMemberEmails *bool `json:"alertMemberEmails,omitempty"`
 
 	// AnalyticsOptOut Whether the tenant has opted out of analytics.
-	AnalyticsOptOut *bool           `json:"analyticsOptOut,omitempty"`
-	Metadata        APIResourceMeta `json:"metadata"`
+	AnalyticsOptOut *bool `json:"analyticsOptOut,omitempty"`
+
+	// IsPaused Whether the tenant is paused. While a tenant is paused, its new workflow runs are queued but not scheduled.
+	IsPaused *bool           `json:"isPaused,omitempty"`
+	Metadata APIResourceMeta `json:"metadata"`
 
 	// Name The name of the tenant.
 	Name string `json:"name"`
@@ -899,6 +1170,25 @@ type TenantAlertEmailGroupList struct {
 	Rows       *[]TenantAlertEmailGroup `json:"rows,omitempty"`
 }
 
+// TenantAlertMuteRule defines model for TenantAlertMuteRule.
+type TenantAlertMuteRule struct {
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// MutedUntil The time until which the workflow is muted. The workflow is muted indefinitely if not set.
+	MutedUntil *time.Time `json:"mutedUntil,omitempty"`
+
+	// Reason Why the workflow is muted.
+	Reason *string `json:"reason,omitempty"`
+
+	// WorkflowId The id of the muted workflow.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// TenantAlertMuteRuleList defines model for TenantAlertMuteRuleList.
+type TenantAlertMuteRuleList struct {
+	Rows *[]TenantAlertMuteRule `json:"rows,omitempty"`
+}
+
 // TenantAlertingSettings defines model for TenantAlertingSettings.
 type TenantAlertingSettings struct {
 	// AlertMemberEmails Whether to alert tenant members.
@@ -910,6 +1200,9 @@ type TenantAlertingSettings struct {
 	// EnableTenantResourceLimitAlerts Whether to enable alerts when tenant resources are approaching limits.
 	EnableTenantResourceLimitAlerts *bool `json:"enableTenantResourceLimitAlerts,omitempty"`
 
+	// EnableWorkerOfflineAlerts Whether to send alerts when workers go offline.
+	EnableWorkerOfflineAlerts *bool `json:"enableWorkerOfflineAlerts,omitempty"`
+
 	// EnableWorkflowRunFailureAlerts Whether to send alerts when workflow runs fail.
 	EnableWorkflowRunFailureAlerts *bool `json:"enableWorkflowRunFailureAlerts,omitempty"`
 
@@ -919,6 +1212,9 @@ type TenantAlertingSettings struct {
 	// MaxAlertingFrequency The max frequency at which to alert.
 	MaxAlertingFrequency string          `json:"maxAlertingFrequency"`
 	Metadata             APIResourceMeta `json:"metadata"`
+
+	// WorkflowRunFailureThreshold The number of failed workflow runs within the alerting frequency which triggers an alert.
+	WorkflowRunFailureThreshold *int `json:"workflowRunFailureThreshold,omitempty"`
 }
 
 // TenantInvite defines model for TenantInvite.
@@ -961,6 +1257,9 @@ type TenantMemberList struct {
 // TenantMemberRole defines model for TenantMemberRole.
 type TenantMemberRole string
 
+// TenantPermission defines model for TenantPermission.
+type TenantPermission string
+
 // TenantQueueMetrics defines model for TenantQueueMetrics.
 type TenantQueueMetrics struct {
 	Queues   *map[string]int          `json:"queues,omitempty"`
@@ -993,10 +1292,31 @@ type TenantResourceLimit struct {
 
 // TenantResourcePolicy defines model for TenantResourcePolicy.
 type TenantResourcePolicy struct {
+	// DataRetentionPeriod How long workflow runs and events of the tenant are retained, as a duration like 720h.
+	DataRetentionPeriod *string `json:"dataRetentionPeriod,omitempty"`
+
 	// Limits A list of resource limits for the tenant.
 	Limits []TenantResourceLimit `json:"limits"`
 }
 
+// TenantRole defines model for TenantRole.
+type TenantRole struct {
+	// Description The description of the role.
+	Description *string         `json:"description,omitempty"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the role.
+	Name string `json:"name"`
+
+	// Permissions The permissions of the members with the role.
+	Permissions []TenantPermission `json:"permissions"`
+}
+
+// TenantRoleList defines model for TenantRoleList.
+type TenantRoleList struct {
+	Rows *[]TenantRole `json:"rows,omitempty"`
+}
+
 // TenantStepRunQueueMetrics defines model for TenantStepRunQueueMetrics.
 type TenantStepRunQueueMetrics struct {
 	Queues *map[string]int `json:"queues,omitempty"`
@@ -1006,6 +1326,15 @@ type TenantStepRunQueueMetrics struct {
 type TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 	Input              map[string]interface{}  `json:"input"`
+
+	// Priority The priority of the workflow run, from 1 (lowest) to 3 (highest). Defaults to the default priority of the workflow.
+	Priority *int32 `json:"priority,omitempty" validate:"omitnil,min=1,max=3"`
+}
+
+// UpdateAuditLogSettingsRequest defines model for UpdateAuditLogSettingsRequest.
+type UpdateAuditLogSettingsRequest struct {
+	// RetentionPeriod How long audit logs are kept, as a duration like 8760h.
+	RetentionPeriod string `json:"retentionPeriod" validate:"required,duration"`
 }
 
 // UpdateTenantAlertEmailGroupRequest defines model for UpdateTenantAlertEmailGroupRequest.
@@ -1019,6 +1348,12 @@ type UpdateTenantInviteRequest struct {
 	Role TenantMemberRole `json:"role"`
 }
 
+// UpdateTenantMemberRoleRequest defines model for UpdateTenantMemberRoleRequest.
+type UpdateTenantMemberRoleRequest struct {
+	// CustomRoleId The id of the custom role of the member, which replaces the permissions of their role. The member gets the permissions of their role again if it's not set.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+}
+
 // UpdateTenantRequest defines model for UpdateTenantRequest.
 type UpdateTenantRequest struct {
 	// AlertMemberEmails Whether to alert tenant members.
@@ -1033,14 +1368,69 @@ type UpdateTenantRequest struct {
 	// EnableTenantResourceLimitAlerts Whether to enable alerts when tenant resources are approaching limits.
 	EnableTenantResourceLimitAlerts *bool `json:"enableTenantResourceLimitAlerts,omitempty"`
 
+	// EnableWorkerOfflineAlerts Whether to send alerts when workers go offline.
+	EnableWorkerOfflineAlerts *bool `json:"enableWorkerOfflineAlerts,omitempty"`
+
 	// EnableWorkflowRunFailureAlerts Whether to send alerts when workflow runs fail.
 	EnableWorkflowRunFailureAlerts *bool `json:"enableWorkflowRunFailureAlerts,omitempty"`
 
+	// IsPaused Whether to pause the tenant. When the tenant is resumed, its queued workflow runs are scheduled in the order in which they were triggered.
+	IsPaused *bool `json:"isPaused,omitempty"`
+
 	// MaxAlertingFrequency The max frequency at which to alert.
 	MaxAlertingFrequency *string `json:"maxAlertingFrequency,omitempty" validate:"omitnil,duration"`
 
 	// Name The name of the tenant.
 	Name *string `json:"name,omitempty"`
+
+	// WorkflowRunFailureThreshold The number of failed workflow runs within the alerting frequency which triggers an alert.
+	WorkflowRunFailureThreshold *int `json:"workflowRunFailureThreshold,omitempty" validate:"omitnil,min=1"`
+}
+
+// UpdateTenantResourceLimit defines model for UpdateTenantResourceLimit.
+type UpdateTenantResourceLimit struct {
+	// AlarmValue The value at which the tenant is alerted that it's approaching the limit.
+	AlarmValue *int `json:"alarmValue,omitempty" validate:"omitnil,min=0"`
+
+	// LimitValue The limit of the resource.
+	LimitValue int            `json:"limitValue" validate:"min=0"`
+	Resource   TenantResource `json:"resource"`
+
+	// Window The meter window of the limit, like 1m or 24h. It's ignored for workers and queued runs, which are counted.
+	Window *string `json:"window,omitempty" validate:"omitnil,duration"`
+}
+
+// UpdateTenantResourcePolicyRequest defines model for UpdateTenantResourcePolicyRequest.
+type UpdateTenantResourcePolicyRequest struct {
+	// DataRetentionPeriod How long workflow runs and events of the tenant are retained, as a duration like 720h.
+	DataRetentionPeriod *string `json:"dataRetentionPeriod,omitempty" validate:"omitnil,duration"`
+
+	// Limits The resource limits to set. Limits of other resources are kept.
+	Limits *[]UpdateTenantResourceLimit `json:"limits,omitempty" validate:"omitnil,dive"`
+}
+
+// UpdateTenantRoleRequest defines model for UpdateTenantRoleRequest.
+type UpdateTenantRoleRequest struct {
+	// Description The description of the role.
+	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
+
+	// Name The name of the role.
+	Name *string `json:"name,omitempty" validate:"omitempty,hatchetName"`
+
+	// Permissions The permissions of the members with the role.
+	Permissions *[]TenantPermission `json:"permissions,omitempty" validate:"omitempty,min=1"`
+}
+
+// UpdateWebhookSubscriptionRequest defines model for UpdateWebhookSubscriptionRequest.
+type UpdateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes *[]WebhookEventType `json:"eventTypes,omitempty" validate:"omitnil,min=1"`
+
+	// Url The url which events are delivered to.
+	Url *string `json:"url,omitempty" validate:"omitnil,url"`
 }
 
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
@@ -1049,6 +1439,15 @@ type UpdateWorkerRequest struct {
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
 
+// UpdateWorkflowVersionWeightsRequest defines model for UpdateWorkflowVersionWeightsRequest.
+type UpdateWorkflowVersionWeightsRequest struct {
+	// LatestWeight The weight of the latest version of the workflow, whichever version that is. Unlike the weights of specific versions, it moves on to versions which are registered later, so each new version is canaried against the listed versions. Ignored if no weights are listed.
+	LatestWeight *int32 `json:"latestWeight,omitempty"`
+
+	// Weights The version weights of the workflow. Versions which aren't listed receive no new runs.
+	Weights []WorkflowVersionWeight `json:"weights"`
+}
+
 // User defines model for User.
 type User struct {
 	// Email The email address of the user.
@@ -1113,6 +1512,85 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts The number of attempts to deliver the event.
+	Attempts int `json:"attempts"`
+
+	// Error Why the last attempt failed.
+	Error     *string          `json:"error,omitempty"`
+	EventType WebhookEventType `json:"eventType"`
+
+	// LastAttemptAt When the event was last attempted to be delivered.
+	LastAttemptAt *time.Time      `json:"lastAttemptAt,omitempty"`
+	Metadata      APIResourceMeta `json:"metadata"`
+
+	// NextAttemptAt When the event is delivered next, if the delivery is pending.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Payload The data of the event.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// ResponseStatusCode The status code of the response to the last attempt.
+	ResponseStatusCode *int                  `json:"responseStatusCode,omitempty"`
+	Status             WebhookDeliveryStatus `json:"status"`
+
+	// SubscriptionId The id of the webhook subscription.
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]WebhookDelivery  `json:"rows,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	// Enabled Whether events are delivered.
+	Enabled bool `json:"enabled"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Metadata   APIResourceMeta    `json:"metadata"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name"`
+
+	// Url The url which events are delivered to.
+	Url string `json:"url"`
+}
+
+// WebhookSubscriptionCreated defines model for WebhookSubscriptionCreated.
+type WebhookSubscriptionCreated struct {
+	// Enabled Whether events are delivered.
+	Enabled bool `json:"enabled"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Metadata   APIResourceMeta    `json:"metadata"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name"`
+
+	// Secret The secret which deliveries are signed with. It's only returned when the subscription is created.
+	Secret string `json:"secret"`
+
+	// Url The url which events are delivered to.
+	Url string `json:"url"`
+}
+
+// WebhookSubscriptionList defines model for WebhookSubscriptionList.
+type WebhookSubscriptionList struct {
+	Rows *[]WebhookSubscription `json:"rows,omitempty"`
+}
+
 // WebhookWorker defines model for WebhookWorker.
 type WebhookWorker struct {
 	Metadata APIResourceMeta `json:"metadata"`
@@ -1329,6 +1807,9 @@ type WorkflowRun struct {
 	WorkflowVersionId  string                  `json:"workflowVersionId"`
 }
 
+// WorkflowRunActorType The type of actor which triggered a workflow run.
+type WorkflowRunActorType string
+
 // WorkflowRunList defines model for WorkflowRunList.
 type WorkflowRunList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
@@ -1341,6 +1822,13 @@ type WorkflowRunOrderByDirection string
 // WorkflowRunOrderByField defines model for WorkflowRunOrderByField.
 type WorkflowRunOrderByField string
 
+// WorkflowRunSearchResult defines model for WorkflowRunSearchResult.
+type WorkflowRunSearchResult struct {
+	// NextCursor The cursor of the next page, which is not set on the last page.
+	NextCursor *string       `json:"nextCursor,omitempty"`
+	Rows       []WorkflowRun `json:"rows"`
+}
+
 // WorkflowRunShape defines model for WorkflowRunShape.
 type WorkflowRunShape struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
@@ -1370,11 +1858,16 @@ type WorkflowRunStatusList = []WorkflowRunStatus
 
 // WorkflowRunTriggeredBy defines model for WorkflowRunTriggeredBy.
 type WorkflowRunTriggeredBy struct {
-	CronParentId        *string         `json:"cronParentId,omitempty"`
-	CronSchedule        *string         `json:"cronSchedule,omitempty"`
-	EventId             *string         `json:"eventId,omitempty"`
-	Metadata            APIResourceMeta `json:"metadata"`
-	ParentWorkflowRunId *string         `json:"parentWorkflowRunId,omitempty"`
+	// ActorId The id of the user or API token which triggered the workflow run.
+	ActorId *string `json:"actorId,omitempty"`
+
+	// ActorType The type of actor which triggered a workflow run.
+	ActorType           *WorkflowRunActorType `json:"actorType,omitempty"`
+	CronParentId        *string               `json:"cronParentId,omitempty"`
+	CronSchedule        *string               `json:"cronSchedule,omitempty"`
+	EventId             *string               `json:"eventId,omitempty"`
+	Metadata            APIResourceMeta       `json:"metadata"`
+	ParentWorkflowRunId *string               `json:"parentWorkflowRunId,omitempty"`
 }
 
 // WorkflowRunsCancelRequest defines model for WorkflowRunsCancelRequest.
@@ -1382,6 +1875,24 @@ type WorkflowRunsCancelRequest struct {
 	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
 }
 
+// WorkflowRunsFilter Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set.
+type WorkflowRunsFilter struct {
+	// CreatedAfter The time after which the runs were created.
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+
+	// CreatedBefore The time before which the runs were created.
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+
+	// EventKey The key of the event which triggered the runs.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// Statuses The statuses of the runs. By default, the runs which aren't finished are cancelled and the finished runs are replayed.
+	Statuses *[]WorkflowRunStatus `json:"statuses,omitempty"`
+
+	// WorkflowId The id of the workflow of the runs.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
 // WorkflowRunsMetrics defines model for WorkflowRunsMetrics.
 type WorkflowRunsMetrics struct {
 	Counts *WorkflowRunsMetricsCounts `json:"counts,omitempty"`
@@ -1464,6 +1975,51 @@ type WorkflowVersionMeta struct {
 	WorkflowId string    `json:"workflowId"`
 }
 
+// WorkflowVersionRunStats defines model for WorkflowVersionRunStats.
+type WorkflowVersionRunStats struct {
+	// Cancelled The number of cancelled runs of the version.
+	Cancelled int64 `json:"cancelled"`
+
+	// ErrorRate The share of the finished runs of the version which failed, between 0 and 1. Not set if no run has finished.
+	ErrorRate *float64 `json:"errorRate,omitempty"`
+
+	// Failed The number of failed runs of the version.
+	Failed int64 `json:"failed"`
+
+	// Succeeded The number of succeeded runs of the version.
+	Succeeded int64 `json:"succeeded"`
+
+	// SuccessRate The share of the finished runs of the version which succeeded, between 0 and 1. Not set if no run has finished.
+	SuccessRate *float64 `json:"successRate,omitempty"`
+
+	// Total The number of runs of the version.
+	Total int64 `json:"total"`
+
+	// WorkflowVersionId The id of the workflow version.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// WorkflowVersionWeight defines model for WorkflowVersionWeight.
+type WorkflowVersionWeight struct {
+	// Weight The weight of the version. The version receives its weight divided by the sum of all weights of the new runs of the workflow.
+	Weight int32 `json:"weight"`
+
+	// WorkflowVersionId The id of the workflow version.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// WorkflowVersionWeights defines model for WorkflowVersionWeights.
+type WorkflowVersionWeights struct {
+	// LatestWeight The weight of the latest version of the workflow, whichever version that is. Not set if the latest version only receives the weight it is listed with.
+	LatestWeight *int32 `json:"latestWeight,omitempty"`
+
+	// Stats The statistics of the runs of each version which were created in the requested time range.
+	Stats []WorkflowVersionRunStats `json:"stats"`
+
+	// Weights The version weights of the workflow. If empty, new runs use the latest version.
+	Weights []WorkflowVersionWeight `json:"weights"`
+}
+
 // WorkflowWorkersCount defines model for WorkflowWorkersCount.
 type WorkflowWorkersCount struct {
 	FreeSlotCount *int    `json:"freeSlotCount,omitempty"`
@@ -1471,6 +2027,15 @@ type WorkflowWorkersCount struct {
 	WorkflowRunId *string `json:"workflowRunId,omitempty"`
 }
 
+// EventWorkflowRunListParams defines parameters for EventWorkflowRunList.
+type EventWorkflowRunListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // StepRunListArchivesParams defines parameters for StepRunListArchives.
 type StepRunListArchivesParams struct {
 	// Offset The number to skip
@@ -1510,6 +2075,39 @@ type LogLineListParams struct {
 	OrderByDirection *LogLineOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// AuditLogListParams defines parameters for AuditLogList.
+type AuditLogListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Action The action to filter by
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// ActorId The id of the user or API token to filter by
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// ResourceId The id of the resource to filter by
+	ResourceId *openapi_types.UUID `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// Since Only return audit logs created at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return audit logs created before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// StepRunListDeadLettersParams defines parameters for StepRunListDeadLetters.
+type StepRunListDeadLettersParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // EventListParams defines parameters for EventList.
 type EventListParams struct {
 	// Offset The number to skip
@@ -1570,23 +2168,65 @@ type RateLimitListParams struct {
 	OrderByDirection *RateLimitOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
-// WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
-type WorkflowRunListStepRunEventsParams struct {
-	// LastId Last ID of the last event
-	LastId *int32 `form:"lastId,omitempty" json:"lastId,omitempty"`
-}
-
-// WorkflowListParams defines parameters for WorkflowList.
-type WorkflowListParams struct {
-	// Offset The number to skip
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+// WorkflowRunSearchParams defines parameters for WorkflowRunSearch.
+type WorkflowRunSearchParams struct {
+	// Statuses A list of workflow run statuses to filter by
+	Statuses *WorkflowRunStatusList `form:"statuses,omitempty" json:"statuses,omitempty"`
 
-	// Limit The number to limit by
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// WorkflowIds A list of workflow ids to filter by
+	WorkflowIds *[]openapi_types.UUID `form:"workflowIds,omitempty" json:"workflowIds,omitempty"`
 
-	// Name Search by name
-	Name *string `form:"name,omitempty" json:"name,omitempty"`
-}
+	// EventKeys A list of keys of the events which triggered the workflow runs
+	EventKeys *[]string `form:"eventKeys,omitempty" json:"eventKeys,omitempty"`
+
+	// AdditionalMetadata A list of metadata key value pairs to filter by
+	AdditionalMetadata *[]string `form:"additionalMetadata,omitempty" json:"additionalMetadata,omitempty"`
+
+	// ParentWorkflowRunId The parent workflow run id
+	ParentWorkflowRunId *openapi_types.UUID `form:"parentWorkflowRunId,omitempty" json:"parentWorkflowRunId,omitempty"`
+
+	// Search A string which the error of the workflow runs contains, ignoring case
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// CreatedAfter The time after the workflow run was created
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore The time before the workflow run was created
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// FinishedAfter The time after the workflow run was finished
+	FinishedAfter *time.Time `form:"finishedAfter,omitempty" json:"finishedAfter,omitempty"`
+
+	// FinishedBefore The time before the workflow run was finished
+	FinishedBefore *time.Time `form:"finishedBefore,omitempty" json:"finishedBefore,omitempty"`
+
+	// OrderByDirection The order of the creation time of the workflow runs, defaults to DESC
+	OrderByDirection *WorkflowRunOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
+
+	// Cursor The cursor of the page to get, which is the nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of workflow runs to return, at most 1000
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
+type WorkflowRunListStepRunEventsParams struct {
+	// LastId Last ID of the last event
+	LastId *int32 `form:"lastId,omitempty" json:"lastId,omitempty"`
+}
+
+// WorkflowListParams defines parameters for WorkflowList.
+type WorkflowListParams struct {
+	// Offset The number to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Name Search by name
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
 
 // CronWorkflowListParams defines parameters for CronWorkflowList.
 type CronWorkflowListParams struct {
@@ -1711,6 +2351,27 @@ type WorkflowScheduledListParams struct {
 	Statuses *[]ScheduledRunStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 }
 
+// UserUpdateSamlCallbackFormdataBody defines parameters for UserUpdateSamlCallback.
+type UserUpdateSamlCallbackFormdataBody struct {
+	// RelayState The relay state of the login flow
+	RelayState *string `form:"RelayState,omitempty" json:"RelayState,omitempty"`
+
+	// SAMLResponse The base64-encoded SAML response
+	SAMLResponse string `form:"SAMLResponse" json:"SAMLResponse"`
+}
+
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Status The status to filter by
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // WorkflowGetMetricsParams defines parameters for WorkflowGetMetrics.
 type WorkflowGetMetricsParams struct {
 	// Status A status of workflow run statuses to filter by
@@ -1726,6 +2387,12 @@ type WorkflowRunCreateParams struct {
 	Version *openapi_types.UUID `form:"version,omitempty" json:"version,omitempty"`
 }
 
+// WorkflowVersionWeightsGetParams defines parameters for WorkflowVersionWeightsGet.
+type WorkflowVersionWeightsGetParams struct {
+	// Since The start of the time range of the run statistics, defaults to 24 hours ago
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
 // WorkflowVersionGetParams defines parameters for WorkflowVersionGet.
 type WorkflowVersionGetParams struct {
 	// Version The workflow version. If not supplied, the latest version is fetched.
@@ -1735,6 +2402,12 @@ type WorkflowVersionGetParams struct {
 // AlertEmailGroupUpdateJSONRequestBody defines body for AlertEmailGroupUpdate for application/json ContentType.
 type AlertEmailGroupUpdateJSONRequestBody = UpdateTenantAlertEmailGroupRequest
 
+// ApiTokenUpdateRotateJSONRequestBody defines body for ApiTokenUpdateRotate for application/json ContentType.
+type ApiTokenUpdateRotateJSONRequestBody = RotateAPITokenRequest
+
+// EventUpdateReplayWithPayloadJSONRequestBody defines body for EventUpdateReplayWithPayload for application/json ContentType.
+type EventUpdateReplayWithPayloadJSONRequestBody = ReplayEventWithPayloadRequest
+
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
 
@@ -1744,9 +2417,15 @@ type TenantUpdateJSONRequestBody = UpdateTenantRequest
 // AlertEmailGroupCreateJSONRequestBody defines body for AlertEmailGroupCreate for application/json ContentType.
 type AlertEmailGroupCreateJSONRequestBody = CreateTenantAlertEmailGroupRequest
 
+// AlertMuteRuleCreateJSONRequestBody defines body for AlertMuteRuleCreate for application/json ContentType.
+type AlertMuteRuleCreateJSONRequestBody = CreateTenantAlertMuteRuleRequest
+
 // ApiTokenCreateJSONRequestBody defines body for ApiTokenCreate for application/json ContentType.
 type ApiTokenCreateJSONRequestBody = CreateAPITokenRequest
 
+// AuditLogUpdateSettingsJSONRequestBody defines body for AuditLogUpdateSettings for application/json ContentType.
+type AuditLogUpdateSettingsJSONRequestBody = UpdateAuditLogSettingsRequest
+
 // EventCreateJSONRequestBody defines body for EventCreate for application/json ContentType.
 type EventCreateJSONRequestBody = CreateEventRequest
 
@@ -1765,15 +2444,33 @@ type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 // TenantInviteUpdateJSONRequestBody defines body for TenantInviteUpdate for application/json ContentType.
 type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 
+// TenantMemberUpdateRoleJSONRequestBody defines body for TenantMemberUpdateRole for application/json ContentType.
+type TenantMemberUpdateRoleJSONRequestBody = UpdateTenantMemberRoleRequest
+
+// TenantResourcePolicyUpdateJSONRequestBody defines body for TenantResourcePolicyUpdate for application/json ContentType.
+type TenantResourcePolicyUpdateJSONRequestBody = UpdateTenantResourcePolicyRequest
+
+// TenantRoleCreateJSONRequestBody defines body for TenantRoleCreate for application/json ContentType.
+type TenantRoleCreateJSONRequestBody = CreateTenantRoleRequest
+
+// TenantRoleUpdateJSONRequestBody defines body for TenantRoleUpdate for application/json ContentType.
+type TenantRoleUpdateJSONRequestBody = UpdateTenantRoleRequest
+
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
+// WebhookSubscriptionCreateJSONRequestBody defines body for WebhookSubscriptionCreate for application/json ContentType.
+type WebhookSubscriptionCreateJSONRequestBody = CreateWebhookSubscriptionRequest
+
 // WebhookCreateJSONRequestBody defines body for WebhookCreate for application/json ContentType.
 type WebhookCreateJSONRequestBody = WebhookWorkerCreateRequest
 
+// WorkflowRunUpdateCancelJSONRequestBody defines body for WorkflowRunUpdateCancel for application/json ContentType.
+type WorkflowRunUpdateCancelJSONRequestBody = CancelWorkflowRunsRequest
+
 // WorkflowRunUpdateReplayJSONRequestBody defines body for WorkflowRunUpdateReplay for application/json ContentType.
 type WorkflowRunUpdateReplayJSONRequestBody = ReplayWorkflowRunsRequest
 
@@ -1801,6 +2498,12 @@ type UserUpdatePasswordJSONRequestBody = UserChangePasswordRequest
 // UserCreateJSONRequestBody defines body for UserCreate for application/json ContentType.
 type UserCreateJSONRequestBody = UserRegisterRequest
 
+// UserUpdateSamlCallbackFormdataRequestBody defines body for UserUpdateSamlCallback for application/x-www-form-urlencoded ContentType.
+type UserUpdateSamlCallbackFormdataRequestBody UserUpdateSamlCallbackFormdataBody
+
+// WebhookSubscriptionUpdateJSONRequestBody defines body for WebhookSubscriptionUpdate for application/json ContentType.
+type WebhookSubscriptionUpdateJSONRequestBody = UpdateWebhookSubscriptionRequest
+
 // WorkerUpdateJSONRequestBody defines body for WorkerUpdate for application/json ContentType.
 type WorkerUpdateJSONRequestBody = UpdateWorkerRequest
 
@@ -1810,6 +2513,9 @@ type WorkflowUpdateJSONRequestBody = WorkflowUpdateRequest
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
+// WorkflowVersionWeightsUpdateJSONRequestBody defines body for WorkflowVersionWeightsUpdate for application/json ContentType.
+type WorkflowVersionWeightsUpdateJSONRequestBody = UpdateWorkflowVersionWeightsRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get liveness
@@ -1824,9 +2530,15 @@ type ServerInterface interface {
 	// Update tenant alert email group
 	// (PATCH /api/v1/alerting-email-groups/{alert-email-group})
 	AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup openapi_types.UUID) error
+	// Delete tenant alert mute rule
+	// (DELETE /api/v1/alerting-mute-rules/{alert-mute-rule})
+	AlertMuteRuleDelete(ctx echo.Context, alertMuteRule openapi_types.UUID) error
 	// Revoke API Token
 	// (POST /api/v1/api-tokens/{api-token})
 	ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error
+	// Rotate API Token
+	// (POST /api/v1/api-tokens/{api-token}/rotate)
+	ApiTokenUpdateRotate(ctx echo.Context, apiToken openapi_types.UUID) error
 	// Get cloud metadata
 	// (GET /api/v1/cloud/metadata)
 	CloudMetadataGet(ctx echo.Context) error
@@ -1836,6 +2548,12 @@ type ServerInterface interface {
 	// Get event data
 	// (GET /api/v1/events/{event}/data)
 	EventDataGet(ctx echo.Context, event openapi_types.UUID) error
+	// Replay event with payload
+	// (POST /api/v1/events/{event}/replay)
+	EventUpdateReplayWithPayload(ctx echo.Context, event openapi_types.UUID) error
+	// List event workflow runs
+	// (GET /api/v1/events/{event}/runs)
+	EventWorkflowRunList(ctx echo.Context, event openapi_types.UUID, params EventWorkflowRunListParams) error
 	// Get metadata
 	// (GET /api/v1/meta)
 	MetadataGet(ctx echo.Context) error
@@ -1875,6 +2593,12 @@ type ServerInterface interface {
 	// Create tenant alert email group
 	// (POST /api/v1/tenants/{tenant}/alerting-email-groups)
 	AlertEmailGroupCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List tenant alert mute rules
+	// (GET /api/v1/tenants/{tenant}/alerting-mute-rules)
+	AlertMuteRuleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create tenant alert mute rule
+	// (POST /api/v1/tenants/{tenant}/alerting-mute-rules)
+	AlertMuteRuleCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// Get tenant alerting settings
 	// (GET /api/v1/tenants/{tenant}/alerting/settings)
 	TenantAlertingSettingsGet(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1884,6 +2608,24 @@ type ServerInterface interface {
 	// Create API Token
 	// (POST /api/v1/tenants/{tenant}/api-tokens)
 	ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List audit logs
+	// (GET /api/v1/tenants/{tenant}/audit-logs)
+	AuditLogList(ctx echo.Context, tenant openapi_types.UUID, params AuditLogListParams) error
+	// Get audit log settings
+	// (GET /api/v1/tenants/{tenant}/audit-logs/settings)
+	AuditLogGetSettings(ctx echo.Context, tenant openapi_types.UUID) error
+	// Update audit log settings
+	// (PATCH /api/v1/tenants/{tenant}/audit-logs/settings)
+	AuditLogUpdateSettings(ctx echo.Context, tenant openapi_types.UUID) error
+	// List dead-lettered step runs
+	// (GET /api/v1/tenants/{tenant}/dlq)
+	StepRunListDeadLetters(ctx echo.Context, tenant openapi_types.UUID, params StepRunListDeadLettersParams) error
+	// Discard dead-lettered step run
+	// (DELETE /api/v1/tenants/{tenant}/dlq/{step-run})
+	StepRunDeleteDeadLetter(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
+	// Requeue dead-lettered step run
+	// (POST /api/v1/tenants/{tenant}/dlq/{step-run}/requeue)
+	StepRunUpdateRequeue(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
 	// List events
 	// (GET /api/v1/tenants/{tenant}/events)
 	EventList(ctx echo.Context, tenant openapi_types.UUID, params EventListParams) error
@@ -1920,6 +2662,9 @@ type ServerInterface interface {
 	// Delete a tenant member
 	// (DELETE /api/v1/tenants/{tenant}/members/{member})
 	TenantMemberDelete(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error
+	// Update the custom role of a tenant member
+	// (PUT /api/v1/tenants/{tenant}/members/{member}/role)
+	TenantMemberUpdateRole(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error
 	// Get workflow metrics
 	// (GET /api/v1/tenants/{tenant}/queue-metrics)
 	TenantGetQueueMetrics(ctx echo.Context, tenant openapi_types.UUID, params TenantGetQueueMetricsParams) error
@@ -1929,6 +2674,21 @@ type ServerInterface interface {
 	// Create tenant alert email group
 	// (GET /api/v1/tenants/{tenant}/resource-policy)
 	TenantResourcePolicyGet(ctx echo.Context, tenant openapi_types.UUID) error
+	// Update tenant resource policy
+	// (PATCH /api/v1/tenants/{tenant}/resource-policy)
+	TenantResourcePolicyUpdate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List custom roles
+	// (GET /api/v1/tenants/{tenant}/roles)
+	TenantRoleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create custom role
+	// (POST /api/v1/tenants/{tenant}/roles)
+	TenantRoleCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete custom role
+	// (DELETE /api/v1/tenants/{tenant}/roles/{tenant-role})
+	TenantRoleDelete(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error
+	// Update custom role
+	// (PATCH /api/v1/tenants/{tenant}/roles/{tenant-role})
+	TenantRoleUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error
 	// List Slack integrations
 	// (GET /api/v1/tenants/{tenant}/slack)
 	SlackWebhookList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1956,6 +2716,12 @@ type ServerInterface interface {
 	// Get step run schema
 	// (GET /api/v1/tenants/{tenant}/step-runs/{step-run}/schema)
 	StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
+	// List webhook subscriptions
+	// (GET /api/v1/tenants/{tenant}/webhook-subscriptions)
+	WebhookSubscriptionList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create a webhook subscription
+	// (POST /api/v1/tenants/{tenant}/webhook-subscriptions)
+	WebhookSubscriptionCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List webhooks
 	// (GET /api/v1/tenants/{tenant}/webhook-workers)
 	WebhookList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1965,6 +2731,12 @@ type ServerInterface interface {
 	// Get workers
 	// (GET /api/v1/tenants/{tenant}/worker)
 	WorkerList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Search workflow runs
+	// (GET /api/v1/tenants/{tenant}/workflow-runs)
+	WorkflowRunSearch(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunSearchParams) error
+	// Cancel workflow runs
+	// (POST /api/v1/tenants/{tenant}/workflow-runs/cancel)
+	WorkflowRunUpdateCancel(ctx echo.Context, tenant openapi_types.UUID) error
 	// Replay workflow runs
 	// (POST /api/v1/tenants/{tenant}/workflow-runs/replay)
 	WorkflowRunUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error
@@ -2052,15 +2824,42 @@ type ServerInterface interface {
 	// List tenant memberships
 	// (GET /api/v1/users/memberships)
 	TenantMembershipsList(ctx echo.Context) error
+	// Complete OAuth flow
+	// (GET /api/v1/users/oauth/{provider}/callback)
+	UserUpdateOauthCallback(ctx echo.Context, provider string) error
+	// Start OAuth flow
+	// (GET /api/v1/users/oauth/{provider}/start)
+	UserUpdateOauthStart(ctx echo.Context, provider string) error
 	// Change user password
 	// (POST /api/v1/users/password)
 	UserUpdatePassword(ctx echo.Context) error
 	// Register user
 	// (POST /api/v1/users/register)
 	UserCreate(ctx echo.Context) error
+	// Complete SAML login flow
+	// (POST /api/v1/users/saml/acs)
+	UserUpdateSamlCallback(ctx echo.Context) error
+	// Get SAML metadata
+	// (GET /api/v1/users/saml/metadata)
+	UserGetSamlMetadata(ctx echo.Context) error
+	// Start SAML login flow
+	// (GET /api/v1/users/saml/start)
+	UserUpdateSamlStart(ctx echo.Context) error
 	// Complete OAuth flow
 	// (GET /api/v1/users/slack/callback)
 	UserUpdateSlackOauthCallback(ctx echo.Context) error
+	// Resend a webhook delivery
+	// (POST /api/v1/webhook-deliveries/{webhook-delivery}/resend)
+	WebhookDeliveryResend(ctx echo.Context, webhookDelivery openapi_types.UUID) error
+	// Delete a webhook subscription
+	// (DELETE /api/v1/webhook-subscriptions/{webhook-subscription})
+	WebhookSubscriptionDelete(ctx echo.Context, webhookSubscription openapi_types.UUID) error
+	// Update a webhook subscription
+	// (PATCH /api/v1/webhook-subscriptions/{webhook-subscription})
+	WebhookSubscriptionUpdate(ctx echo.Context, webhookSubscription openapi_types.UUID) error
+	// List webhook deliveries
+	// (GET /api/v1/webhook-subscriptions/{webhook-subscription}/deliveries)
+	WebhookDeliveryList(ctx echo.Context, webhookSubscription openapi_types.UUID, params WebhookDeliveryListParams) error
 	// Delete a webhook
 	// (DELETE /api/v1/webhook-workers/{webhook})
 	WebhookDelete(ctx echo.Context, webhook openapi_types.UUID) error
@@ -2088,6 +2887,12 @@ type ServerInterface interface {
 	// Trigger workflow run
 	// (POST /api/v1/workflows/{workflow}/trigger)
 	WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error
+	// Get workflow version weights
+	// (GET /api/v1/workflows/{workflow}/version-weights)
+	WorkflowVersionWeightsGet(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionWeightsGetParams) error
+	// Update workflow version weights
+	// (PUT /api/v1/workflows/{workflow}/version-weights)
+	WorkflowVersionWeightsUpdate(ctx echo.Context, workflow openapi_types.UUID) error
 	// Get workflow version
 	// (GET /api/v1/workflows/{workflow}/versions)
 	WorkflowVersionGet(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionGetParams) error
@@ -2156,6 +2961,26 @@ func (w *ServerInterfaceWrapper) AlertEmailGroupUpdate(ctx echo.Context) error {
 	return err
 }
 
+// AlertMuteRuleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertMuteRuleDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "alert-mute-rule" -------------
+	var alertMuteRule openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "alert-mute-rule", runtime.ParamLocationPath, ctx.Param("alert-mute-rule"), &alertMuteRule)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alert-mute-rule: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertMuteRuleDelete(ctx, alertMuteRule)
+	return err
+}
+
 // ApiTokenUpdateRevoke converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiTokenUpdateRevoke(ctx echo.Context) error {
 	var err error
@@ -2176,6 +3001,26 @@ func (w *ServerInterfaceWrapper) ApiTokenUpdateRevoke(ctx echo.Context) error {
 	return err
 }
 
+// ApiTokenUpdateRotate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenUpdateRotate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "api-token" -------------
+	var apiToken openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "api-token", runtime.ParamLocationPath, ctx.Param("api-token"), &apiToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter api-token: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiTokenUpdateRotate(ctx, apiToken)
+	return err
+}
+
 // CloudMetadataGet converts echo context to params.
 func (w *ServerInterfaceWrapper) CloudMetadataGet(ctx echo.Context) error {
 	var err error
@@ -2225,6 +3070,62 @@ func (w *ServerInterfaceWrapper) EventDataGet(ctx echo.Context) error {
 	return err
 }
 
+// EventUpdateReplayWithPayload converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateReplayWithPayload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event" -------------
+	var event openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventUpdateReplayWithPayload(ctx, event)
+	return err
+}
+
+// EventWorkflowRunList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventWorkflowRunList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event" -------------
+	var event openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EventWorkflowRunListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventWorkflowRunList(ctx, event, params)
+	return err
+}
+
 // MetadataGet converts echo context to params.
 func (w *ServerInterfaceWrapper) MetadataGet(ctx echo.Context) error {
 	var err error
@@ -2540,6 +3441,46 @@ func (w *ServerInterfaceWrapper) AlertEmailGroupCreate(ctx echo.Context) error {
 	return err
 }
 
+// AlertMuteRuleList converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertMuteRuleList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertMuteRuleList(ctx, tenant)
+	return err
+}
+
+// AlertMuteRuleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertMuteRuleCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AlertMuteRuleCreate(ctx, tenant)
+	return err
+}
+
 // TenantAlertingSettingsGet converts echo context to params.
 func (w *ServerInterfaceWrapper) TenantAlertingSettingsGet(ctx echo.Context) error {
 	var err error
@@ -2600,8 +3541,8 @@ func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
 	return err
 }
 
-// EventList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
+// AuditLogList converts echo context to params.
+func (w *ServerInterfaceWrapper) AuditLogList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2616,7 +3557,7 @@ func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params EventListParams
+	var params AuditLogListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -2631,69 +3572,48 @@ func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "keys" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
-	}
-
-	// ------------- Optional query parameter "workflows" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
-	}
-
-	// ------------- Optional query parameter "statuses" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
-	}
-
-	// ------------- Optional query parameter "search" -------------
+	// ------------- Optional query parameter "action" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
+	// ------------- Optional query parameter "actorId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindQueryParameter("form", true, false, "actorId", ctx.QueryParams(), &params.ActorId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actorId: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	// ------------- Optional query parameter "resourceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	err = runtime.BindQueryParameter("form", true, false, "resourceId", ctx.QueryParams(), &params.ResourceId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	// ------------- Optional query parameter "since" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
 	}
 
-	// ------------- Optional query parameter "eventIds" -------------
+	// ------------- Optional query parameter "until" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventList(ctx, tenant, params)
+	err = w.Handler.AuditLogList(ctx, tenant, params)
 	return err
 }
 
-// EventCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
+// AuditLogGetSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) AuditLogGetSettings(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2708,12 +3628,12 @@ func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventCreate(ctx, tenant)
+	err = w.Handler.AuditLogGetSettings(ctx, tenant)
 	return err
 }
 
-// EventCreateBulk converts echo context to params.
-func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
+// AuditLogUpdateSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) AuditLogUpdateSettings(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2728,12 +3648,12 @@ func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventCreateBulk(ctx, tenant)
+	err = w.Handler.AuditLogUpdateSettings(ctx, tenant)
 	return err
 }
 
-// EventUpdateCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
+// StepRunListDeadLetters converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListDeadLetters(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2747,13 +3667,29 @@ func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListDeadLettersParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventUpdateCancel(ctx, tenant)
+	err = w.Handler.StepRunListDeadLetters(ctx, tenant, params)
 	return err
 }
 
-// EventKeyList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
+// StepRunDeleteDeadLetter converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunDeleteDeadLetter(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2763,9 +3699,217 @@ func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunDeleteDeadLetter(ctx, tenant, stepRun)
+	return err
+}
+
+// StepRunUpdateRequeue converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateRequeue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunUpdateRequeue(ctx, tenant, stepRun)
+	return err
+}
+
+// EventList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EventListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "keys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "eventIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventList(ctx, tenant, params)
+	return err
+}
+
+// EventCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventCreate(ctx, tenant)
+	return err
+}
+
+// EventCreateBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventCreateBulk(ctx, tenant)
+	return err
+}
+
+// EventUpdateCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventUpdateCancel(ctx, tenant)
+	return err
+}
+
+// EventKeyList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.EventKeyList(ctx, tenant)
@@ -2936,6 +4080,34 @@ func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 	return err
 }
 
+// TenantMemberUpdateRole converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberUpdateRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "member" -------------
+	var member openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantMemberUpdateRole(ctx, tenant, member)
+	return err
+}
+
 // TenantGetQueueMetrics converts echo context to params.
 func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 	var err error
@@ -3049,8 +4221,8 @@ func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error
 	return err
 }
 
-// SlackWebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+// TenantResourcePolicyUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantResourcePolicyUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3065,12 +4237,12 @@ func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SlackWebhookList(ctx, tenant)
+	err = w.Handler.TenantResourcePolicyUpdate(ctx, tenant)
 	return err
 }
 
-// UserUpdateSlackOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
+// TenantRoleList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3080,15 +4252,17 @@ func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	err = w.Handler.TenantRoleList(ctx, tenant)
 	return err
 }
 
-// SnsList converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+// TenantRoleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3103,12 +4277,12 @@ func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsList(ctx, tenant)
+	err = w.Handler.TenantRoleCreate(ctx, tenant)
 	return err
 }
 
-// SnsCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
+// TenantRoleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3118,17 +4292,25 @@ func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "tenant-role" -------------
+	var tenantRole openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, ctx.Param("tenant-role"), &tenantRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-role: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsCreate(ctx, tenant)
+	err = w.Handler.TenantRoleDelete(ctx, tenant, tenantRole)
 	return err
 }
 
-// TenantGetStepRunQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
+// TenantRoleUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3138,17 +4320,123 @@ func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "tenant-role" -------------
+	var tenantRole openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, ctx.Param("tenant-role"), &tenantRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-role: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
+	err = w.Handler.TenantRoleUpdate(ctx, tenant, tenantRole)
 	return err
 }
 
-// StepRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+// SlackWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SlackWebhookList(ctx, tenant)
+	return err
+}
+
+// UserUpdateSlackOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	return err
+}
+
+// SnsList converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SnsList(ctx, tenant)
+	return err
+}
+
+// SnsCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SnsCreate(ctx, tenant)
+	return err
+}
+
+// TenantGetStepRunQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
+	return err
+}
+
+// StepRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3259,6 +4547,46 @@ func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 	return err
 }
 
+// WebhookSubscriptionList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookSubscriptionList(ctx, tenant)
+	return err
+}
+
+// WebhookSubscriptionCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookSubscriptionCreate(ctx, tenant)
+	return err
+}
+
 // WebhookList converts echo context to params.
 func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
 	var err error
@@ -3319,6 +4647,139 @@ func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
 	return err
 }
 
+// WorkflowRunSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunSearch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunSearchParams
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
+	}
+
+	// ------------- Optional query parameter "eventKeys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventKeys", ctx.QueryParams(), &params.EventKeys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventKeys: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "finishedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finishedAfter", ctx.QueryParams(), &params.FinishedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "finishedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finishedBefore", ctx.QueryParams(), &params.FinishedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunSearch(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunUpdateCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunUpdateCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunUpdateCancel(ctx, tenant)
+	return err
+}
+
 // WorkflowRunUpdateReplay converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error {
 	var err error
@@ -4181,11 +5642,43 @@ func (w *ServerInterfaceWrapper) TenantMembershipsList(ctx echo.Context) error {
 	return err
 }
 
-// UserUpdatePassword converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdatePassword(ctx echo.Context) error {
+// UserUpdateOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOauthCallback(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "provider", runtime.ParamLocationPath, ctx.Param("provider"), &provider)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOauthCallback(ctx, provider)
+	return err
+}
+
+// UserUpdateOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOauthStart(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "provider", runtime.ParamLocationPath, ctx.Param("provider"), &provider)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOauthStart(ctx, provider)
+	return err
+}
+
+// UserUpdatePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdatePassword(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UserUpdatePassword(ctx)
@@ -4201,6 +5694,33 @@ func (w *ServerInterfaceWrapper) UserCreate(ctx echo.Context) error {
 	return err
 }
 
+// UserUpdateSamlCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSamlCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateSamlCallback(ctx)
+	return err
+}
+
+// UserGetSamlMetadata converts echo context to params.
+func (w *ServerInterfaceWrapper) UserGetSamlMetadata(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserGetSamlMetadata(ctx)
+	return err
+}
+
+// UserUpdateSamlStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSamlStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateSamlStart(ctx)
+	return err
+}
+
 // UserUpdateSlackOauthCallback converts echo context to params.
 func (w *ServerInterfaceWrapper) UserUpdateSlackOauthCallback(ctx echo.Context) error {
 	var err error
@@ -4212,6 +5732,109 @@ func (w *ServerInterfaceWrapper) UserUpdateSlackOauthCallback(ctx echo.Context)
 	return err
 }
 
+// WebhookDeliveryResend converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDeliveryResend(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhook-delivery" -------------
+	var webhookDelivery openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook-delivery", runtime.ParamLocationPath, ctx.Param("webhook-delivery"), &webhookDelivery)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook-delivery: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookDeliveryResend(ctx, webhookDelivery)
+	return err
+}
+
+// WebhookSubscriptionDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhook-subscription" -------------
+	var webhookSubscription openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, ctx.Param("webhook-subscription"), &webhookSubscription)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook-subscription: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookSubscriptionDelete(ctx, webhookSubscription)
+	return err
+}
+
+// WebhookSubscriptionUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhook-subscription" -------------
+	var webhookSubscription openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, ctx.Param("webhook-subscription"), &webhookSubscription)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook-subscription: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookSubscriptionUpdate(ctx, webhookSubscription)
+	return err
+}
+
+// WebhookDeliveryList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDeliveryList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhook-subscription" -------------
+	var webhookSubscription openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, ctx.Param("webhook-subscription"), &webhookSubscription)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook-subscription: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhookDeliveryListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookDeliveryList(ctx, webhookSubscription, params)
+	return err
+}
+
 // WebhookDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) WebhookDelete(ctx echo.Context) error {
 	var err error
@@ -4417,6 +6040,55 @@ func (w *ServerInterfaceWrapper) WorkflowRunCreate(ctx echo.Context) error {
 	return err
 }
 
+// WorkflowVersionWeightsGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowVersionWeightsGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowVersionWeightsGetParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowVersionWeightsGet(ctx, workflow, params)
+	return err
+}
+
+// WorkflowVersionWeightsUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowVersionWeightsUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowVersionWeightsUpdate(ctx, workflow)
+	return err
+}
+
 // WorkflowVersionGet converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkflowVersionGet(ctx echo.Context) error {
 	var err error
@@ -4478,10 +6150,14 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/ready", wrapper.ReadinessGet)
 	router.DELETE(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupDelete)
 	router.PATCH(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupUpdate)
+	router.DELETE(baseURL+"/api/v1/alerting-mute-rules/:alert-mute-rule", wrapper.AlertMuteRuleDelete)
 	router.POST(baseURL+"/api/v1/api-tokens/:api-token", wrapper.ApiTokenUpdateRevoke)
+	router.POST(baseURL+"/api/v1/api-tokens/:api-token/rotate", wrapper.ApiTokenUpdateRotate)
 	router.GET(baseURL+"/api/v1/cloud/metadata", wrapper.CloudMetadataGet)
 	router.GET(baseURL+"/api/v1/events/:event", wrapper.EventGet)
 	router.GET(baseURL+"/api/v1/events/:event/data", wrapper.EventDataGet)
+	router.POST(baseURL+"/api/v1/events/:event/replay", wrapper.EventUpdateReplayWithPayload)
+	router.GET(baseURL+"/api/v1/events/:event/runs", wrapper.EventWorkflowRunList)
 	router.GET(baseURL+"/api/v1/meta", wrapper.MetadataGet)
 	router.GET(baseURL+"/api/v1/meta/integrations", wrapper.MetadataListIntegrations)
 	router.POST(baseURL+"/api/v1/monitoring/:tenant/probe", wrapper.MonitoringPostRunProbe)
@@ -4495,9 +6171,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/api/v1/tenants/:tenant", wrapper.TenantUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting-mute-rules", wrapper.AlertMuteRuleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/alerting-mute-rules", wrapper.AlertMuteRuleCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting/settings", wrapper.TenantAlertingSettingsGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/audit-logs", wrapper.AuditLogList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/audit-logs/settings", wrapper.AuditLogGetSettings)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/audit-logs/settings", wrapper.AuditLogUpdateSettings)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/dlq", wrapper.StepRunListDeadLetters)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/dlq/:step-run", wrapper.StepRunDeleteDeadLetter)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/dlq/:step-run/requeue", wrapper.StepRunUpdateRequeue)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventCreate)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/bulk", wrapper.EventCreateBulk)
@@ -4510,9 +6194,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/members", wrapper.TenantMemberList)
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/members/:member", wrapper.TenantMemberDelete)
+	router.PUT(baseURL+"/api/v1/tenants/:tenant/members/:member/role", wrapper.TenantMemberUpdateRole)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/queue-metrics", wrapper.TenantGetQueueMetrics)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/rate-limits", wrapper.RateLimitList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/resource-policy", wrapper.TenantResourcePolicyGet)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/resource-policy", wrapper.TenantResourcePolicyUpdate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/roles", wrapper.TenantRoleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/roles", wrapper.TenantRoleCreate)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/roles/:tenant-role", wrapper.TenantRoleDelete)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/roles/:tenant-role", wrapper.TenantRoleUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack", wrapper.SlackWebhookList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack/start", wrapper.UserUpdateSlackOauthStart)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsList)
@@ -4522,9 +6212,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/cancel", wrapper.StepRunUpdateCancel)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/rerun", wrapper.StepRunUpdateRerun)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/schema", wrapper.StepRunGetSchema)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/webhook-subscriptions", wrapper.WebhookSubscriptionList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/webhook-subscriptions", wrapper.WebhookSubscriptionCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/webhook-workers", wrapper.WebhookList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/webhook-workers", wrapper.WebhookCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/worker", wrapper.WorkerList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs", wrapper.WorkflowRunSearch)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/cancel", wrapper.WorkflowRunUpdateCancel)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/replay", wrapper.WorkflowRunUpdateReplay)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run", wrapper.WorkflowRunGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/input", wrapper.WorkflowRunGetInput)
@@ -4554,9 +6248,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v1/users/login", wrapper.UserUpdateLogin)
 	router.POST(baseURL+"/api/v1/users/logout", wrapper.UserUpdateLogout)
 	router.GET(baseURL+"/api/v1/users/memberships", wrapper.TenantMembershipsList)
+	router.GET(baseURL+"/api/v1/users/oauth/:provider/callback", wrapper.UserUpdateOauthCallback)
+	router.GET(baseURL+"/api/v1/users/oauth/:provider/start", wrapper.UserUpdateOauthStart)
 	router.POST(baseURL+"/api/v1/users/password", wrapper.UserUpdatePassword)
 	router.POST(baseURL+"/api/v1/users/register", wrapper.UserCreate)
+	router.POST(baseURL+"/api/v1/users/saml/acs", wrapper.UserUpdateSamlCallback)
+	router.GET(baseURL+"/api/v1/users/saml/metadata", wrapper.UserGetSamlMetadata)
+	router.GET(baseURL+"/api/v1/users/saml/start", wrapper.UserUpdateSamlStart)
 	router.GET(baseURL+"/api/v1/users/slack/callback", wrapper.UserUpdateSlackOauthCallback)
+	router.POST(baseURL+"/api/v1/webhook-deliveries/:webhook-delivery/resend", wrapper.WebhookDeliveryResend)
+	router.DELETE(baseURL+"/api/v1/webhook-subscriptions/:webhook-subscription", wrapper.WebhookSubscriptionDelete)
+	router.PATCH(baseURL+"/api/v1/webhook-subscriptions/:webhook-subscription", wrapper.WebhookSubscriptionUpdate)
+	router.GET(baseURL+"/api/v1/webhook-subscriptions/:webhook-subscription/deliveries", wrapper.WebhookDeliveryList)
 	router.DELETE(baseURL+"/api/v1/webhook-workers/:webhook", wrapper.WebhookDelete)
 	router.GET(baseURL+"/api/v1/webhook-workers/:webhook/requests", wrapper.WebhookRequestsList)
 	router.GET(baseURL+"/api/v1/workers/:worker", wrapper.WorkerGet)
@@ -4566,6 +6269,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowUpdate)
 	router.GET(baseURL+"/api/v1/workflows/:workflow/metrics", wrapper.WorkflowGetMetrics)
 	router.POST(baseURL+"/api/v1/workflows/:workflow/trigger", wrapper.WorkflowRunCreate)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/version-weights", wrapper.WorkflowVersionWeightsGet)
+	router.PUT(baseURL+"/api/v1/workflows/:workflow/version-weights", wrapper.WorkflowVersionWeightsUpdate)
 	router.GET(baseURL+"/api/v1/workflows/:workflow/versions", wrapper.WorkflowVersionGet)
 
 }
@@ -4686,6 +6391,40 @@ func (response AlertEmailGroupUpdate403JSONResponse) VisitAlertEmailGroupUpdateR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type AlertMuteRuleDeleteRequestObject struct {
+	AlertMuteRule openapi_types.UUID `json:"alert-mute-rule"`
+}
+
+type AlertMuteRuleDeleteResponseObject interface {
+	VisitAlertMuteRuleDeleteResponse(w http.ResponseWriter) error
+}
+
+type AlertMuteRuleDelete204Response struct {
+}
+
+func (response AlertMuteRuleDelete204Response) VisitAlertMuteRuleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AlertMuteRuleDelete400JSONResponse APIErrors
+
+func (response AlertMuteRuleDelete400JSONResponse) VisitAlertMuteRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertMuteRuleDelete403JSONResponse APIError
+
+func (response AlertMuteRuleDelete403JSONResponse) VisitAlertMuteRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type ApiTokenUpdateRevokeRequestObject struct {
 	ApiToken openapi_types.UUID `json:"api-token"`
 }
@@ -4720,6 +6459,42 @@ func (response ApiTokenUpdateRevoke403JSONResponse) VisitApiTokenUpdateRevokeRes
 	return json.NewEncoder(w).Encode(response)
 }
 
+type ApiTokenUpdateRotateRequestObject struct {
+	ApiToken openapi_types.UUID `json:"api-token"`
+	Body     *ApiTokenUpdateRotateJSONRequestBody
+}
+
+type ApiTokenUpdateRotateResponseObject interface {
+	VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenUpdateRotate200JSONResponse CreateAPITokenResponse
+
+func (response ApiTokenUpdateRotate200JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotate400JSONResponse APIErrors
+
+func (response ApiTokenUpdateRotate400JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotate403JSONResponse APIErrors
+
+func (response ApiTokenUpdateRotate403JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type CloudMetadataGetRequestObject struct {
 }
 
@@ -4815,98 +6590,179 @@ func (response EventDataGet403JSONResponse) VisitEventDataGetResponse(w http.Res
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataGetRequestObject struct {
+type EventUpdateReplayWithPayloadRequestObject struct {
+	Event openapi_types.UUID `json:"event"`
+	Body  *EventUpdateReplayWithPayloadJSONRequestBody
 }
 
-type MetadataGetResponseObject interface {
-	VisitMetadataGetResponse(w http.ResponseWriter) error
+type EventUpdateReplayWithPayloadResponseObject interface {
+	VisitEventUpdateReplayWithPayloadResponse(w http.ResponseWriter) error
 }
 
-type MetadataGet200JSONResponse APIMeta
+type EventUpdateReplayWithPayload200JSONResponse Event
 
-func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplayWithPayload200JSONResponse) VisitEventUpdateReplayWithPayloadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataGet400JSONResponse APIErrors
+type EventUpdateReplayWithPayload400JSONResponse APIErrors
 
-func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplayWithPayload400JSONResponse) VisitEventUpdateReplayWithPayloadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataListIntegrationsRequestObject struct {
-}
-
-type MetadataListIntegrationsResponseObject interface {
-	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
-}
-
-type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+type EventUpdateReplayWithPayload403JSONResponse APIErrors
 
-func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplayWithPayload403JSONResponse) VisitEventUpdateReplayWithPayloadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataListIntegrations400JSONResponse APIErrors
+type EventUpdateReplayWithPayload429JSONResponse APIErrors
 
-func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+func (response EventUpdateReplayWithPayload429JSONResponse) VisitEventUpdateReplayWithPayloadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MonitoringPostRunProbeRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type EventWorkflowRunListRequestObject struct {
+	Event  openapi_types.UUID `json:"event"`
+	Params EventWorkflowRunListParams
 }
 
-type MonitoringPostRunProbeResponseObject interface {
-	VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error
+type EventWorkflowRunListResponseObject interface {
+	VisitEventWorkflowRunListResponse(w http.ResponseWriter) error
 }
 
-type MonitoringPostRunProbe200Response struct {
-}
+type EventWorkflowRunList200JSONResponse EventWorkflowRunList
 
-func (response MonitoringPostRunProbe200Response) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
+func (response EventWorkflowRunList200JSONResponse) VisitEventWorkflowRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MonitoringPostRunProbe403JSONResponse APIErrors
+type EventWorkflowRunList400JSONResponse APIErrors
 
-func (response MonitoringPostRunProbe403JSONResponse) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
+func (response EventWorkflowRunList400JSONResponse) VisitEventWorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDeleteRequestObject struct {
-	Slack openapi_types.UUID `json:"slack"`
-}
+type EventWorkflowRunList403JSONResponse APIErrors
 
-type SlackWebhookDeleteResponseObject interface {
-	VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error
+func (response EventWorkflowRunList403JSONResponse) VisitEventWorkflowRunListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDelete204Response struct {
+type MetadataGetRequestObject struct {
 }
 
-func (response SlackWebhookDelete204Response) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type MetadataGetResponseObject interface {
+	VisitMetadataGetResponse(w http.ResponseWriter) error
 }
 
-type SlackWebhookDelete400JSONResponse APIErrors
+type MetadataGet200JSONResponse APIMeta
+
+func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataGet400JSONResponse APIErrors
+
+func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataListIntegrationsRequestObject struct {
+}
+
+type MetadataListIntegrationsResponseObject interface {
+	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
+}
+
+type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+
+func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataListIntegrations400JSONResponse APIErrors
+
+func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MonitoringPostRunProbeRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type MonitoringPostRunProbeResponseObject interface {
+	VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error
+}
+
+type MonitoringPostRunProbe200Response struct {
+}
+
+func (response MonitoringPostRunProbe200Response) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type MonitoringPostRunProbe403JSONResponse APIErrors
+
+func (response MonitoringPostRunProbe403JSONResponse) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SlackWebhookDeleteRequestObject struct {
+	Slack openapi_types.UUID `json:"slack"`
+}
+
+type SlackWebhookDeleteResponseObject interface {
+	VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error
+}
+
+type SlackWebhookDelete204Response struct {
+}
+
+func (response SlackWebhookDelete204Response) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SlackWebhookDelete400JSONResponse APIErrors
 
 func (response SlackWebhookDelete400JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
@@ -5288,6 +7144,77 @@ func (response AlertEmailGroupCreate403JSONResponse) VisitAlertEmailGroupCreateR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type AlertMuteRuleListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type AlertMuteRuleListResponseObject interface {
+	VisitAlertMuteRuleListResponse(w http.ResponseWriter) error
+}
+
+type AlertMuteRuleList200JSONResponse TenantAlertMuteRuleList
+
+func (response AlertMuteRuleList200JSONResponse) VisitAlertMuteRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertMuteRuleList400JSONResponse APIErrors
+
+func (response AlertMuteRuleList400JSONResponse) VisitAlertMuteRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertMuteRuleList403JSONResponse APIError
+
+func (response AlertMuteRuleList403JSONResponse) VisitAlertMuteRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertMuteRuleCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *AlertMuteRuleCreateJSONRequestBody
+}
+
+type AlertMuteRuleCreateResponseObject interface {
+	VisitAlertMuteRuleCreateResponse(w http.ResponseWriter) error
+}
+
+type AlertMuteRuleCreate201JSONResponse TenantAlertMuteRule
+
+func (response AlertMuteRuleCreate201JSONResponse) VisitAlertMuteRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertMuteRuleCreate400JSONResponse APIErrors
+
+func (response AlertMuteRuleCreate400JSONResponse) VisitAlertMuteRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertMuteRuleCreate403JSONResponse APIError
+
+func (response AlertMuteRuleCreate403JSONResponse) VisitAlertMuteRuleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type TenantAlertingSettingsGetRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -5394,268 +7321,499 @@ func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventListRequestObject struct {
+type AuditLogListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params EventListParams
+	Params AuditLogListParams
 }
 
-type EventListResponseObject interface {
-	VisitEventListResponse(w http.ResponseWriter) error
+type AuditLogListResponseObject interface {
+	VisitAuditLogListResponse(w http.ResponseWriter) error
 }
 
-type EventList200JSONResponse EventList
+type AuditLogList200JSONResponse AuditLogList
 
-func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response AuditLogList200JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventList400JSONResponse APIErrors
+type AuditLogList400JSONResponse APIErrors
 
-func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response AuditLogList400JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventList403JSONResponse APIErrors
+type AuditLogList403JSONResponse APIErrors
 
-func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response AuditLogList403JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateRequestObject struct {
+type AuditLogGetSettingsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventCreateJSONRequestBody
 }
 
-type EventCreateResponseObject interface {
-	VisitEventCreateResponse(w http.ResponseWriter) error
+type AuditLogGetSettingsResponseObject interface {
+	VisitAuditLogGetSettingsResponse(w http.ResponseWriter) error
 }
 
-type EventCreate200JSONResponse Event
+type AuditLogGetSettings200JSONResponse AuditLogSettings
 
-func (response EventCreate200JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response AuditLogGetSettings200JSONResponse) VisitAuditLogGetSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate400JSONResponse APIErrors
+type AuditLogGetSettings400JSONResponse APIErrors
 
-func (response EventCreate400JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response AuditLogGetSettings400JSONResponse) VisitAuditLogGetSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate403JSONResponse APIErrors
+type AuditLogGetSettings403JSONResponse APIErrors
 
-func (response EventCreate403JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response AuditLogGetSettings403JSONResponse) VisitAuditLogGetSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate429JSONResponse APIErrors
-
-func (response EventCreate429JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type EventCreateBulkRequestObject struct {
+type AuditLogUpdateSettingsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventCreateBulkJSONRequestBody
+	Body   *AuditLogUpdateSettingsJSONRequestBody
 }
 
-type EventCreateBulkResponseObject interface {
-	VisitEventCreateBulkResponse(w http.ResponseWriter) error
+type AuditLogUpdateSettingsResponseObject interface {
+	VisitAuditLogUpdateSettingsResponse(w http.ResponseWriter) error
 }
 
-type EventCreateBulk200JSONResponse Events
+type AuditLogUpdateSettings200JSONResponse AuditLogSettings
 
-func (response EventCreateBulk200JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response AuditLogUpdateSettings200JSONResponse) VisitAuditLogUpdateSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk400JSONResponse APIErrors
+type AuditLogUpdateSettings400JSONResponse APIErrors
 
-func (response EventCreateBulk400JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response AuditLogUpdateSettings400JSONResponse) VisitAuditLogUpdateSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk403JSONResponse APIErrors
+type AuditLogUpdateSettings403JSONResponse APIErrors
 
-func (response EventCreateBulk403JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response AuditLogUpdateSettings403JSONResponse) VisitAuditLogUpdateSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk429JSONResponse APIErrors
-
-func (response EventCreateBulk429JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type EventUpdateCancelRequestObject struct {
+type StepRunListDeadLettersRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventUpdateCancelJSONRequestBody
+	Params StepRunListDeadLettersParams
 }
 
-type EventUpdateCancelResponseObject interface {
-	VisitEventUpdateCancelResponse(w http.ResponseWriter) error
+type StepRunListDeadLettersResponseObject interface {
+	VisitStepRunListDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type EventUpdateCancel200JSONResponse struct {
-	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-}
+type StepRunListDeadLetters200JSONResponse StepRunDeadLetterList
 
-func (response EventUpdateCancel200JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response StepRunListDeadLetters200JSONResponse) VisitStepRunListDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel400JSONResponse APIErrors
+type StepRunListDeadLetters400JSONResponse APIErrors
 
-func (response EventUpdateCancel400JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response StepRunListDeadLetters400JSONResponse) VisitStepRunListDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel403JSONResponse APIErrors
+type StepRunListDeadLetters403JSONResponse APIErrors
 
-func (response EventUpdateCancel403JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response StepRunListDeadLetters403JSONResponse) VisitStepRunListDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel429JSONResponse APIErrors
-
-func (response EventUpdateCancel429JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+type StepRunDeleteDeadLetterRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type StepRunDeleteDeadLetterResponseObject interface {
+	VisitStepRunDeleteDeadLetterResponse(w http.ResponseWriter) error
 }
 
-type EventKeyListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type StepRunDeleteDeadLetter204Response struct {
 }
 
-type EventKeyListResponseObject interface {
-	VisitEventKeyListResponse(w http.ResponseWriter) error
+func (response StepRunDeleteDeadLetter204Response) VisitStepRunDeleteDeadLetterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type EventKeyList200JSONResponse EventKeyList
+type StepRunDeleteDeadLetter400JSONResponse APIErrors
 
-func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response StepRunDeleteDeadLetter400JSONResponse) VisitStepRunDeleteDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyList400JSONResponse APIErrors
+type StepRunDeleteDeadLetter403JSONResponse APIErrors
 
-func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response StepRunDeleteDeadLetter403JSONResponse) VisitStepRunDeleteDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyList403JSONResponse APIErrors
+type StepRunDeleteDeadLetter404JSONResponse APIErrors
 
-func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response StepRunDeleteDeadLetter404JSONResponse) VisitStepRunDeleteDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplayRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventUpdateReplayJSONRequestBody
+type StepRunUpdateRequeueRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
 }
 
-type EventUpdateReplayResponseObject interface {
-	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
+type StepRunUpdateRequeueResponseObject interface {
+	VisitStepRunUpdateRequeueResponse(w http.ResponseWriter) error
 }
 
-type EventUpdateReplay200JSONResponse EventList
-
-func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type StepRunUpdateRequeue204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response StepRunUpdateRequeue204Response) VisitStepRunUpdateRequeueResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type EventUpdateReplay400JSONResponse APIErrors
+type StepRunUpdateRequeue400JSONResponse APIErrors
 
-func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRequeue400JSONResponse) VisitStepRunUpdateRequeueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay403JSONResponse APIErrors
+type StepRunUpdateRequeue403JSONResponse APIErrors
 
-func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRequeue403JSONResponse) VisitStepRunUpdateRequeueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay429JSONResponse APIErrors
+type StepRunUpdateRequeue404JSONResponse APIErrors
 
-func (response EventUpdateReplay429JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRequeue404JSONResponse) VisitStepRunUpdateRequeueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteListRequestObject struct {
+type EventListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Params EventListParams
 }
 
-type TenantInviteListResponseObject interface {
-	VisitTenantInviteListResponse(w http.ResponseWriter) error
+type EventListResponseObject interface {
+	VisitEventListResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteList200JSONResponse TenantInviteList
+type EventList200JSONResponse EventList
+
+func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventList400JSONResponse APIErrors
+
+func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventList403JSONResponse APIErrors
+
+func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventCreateJSONRequestBody
+}
+
+type EventCreateResponseObject interface {
+	VisitEventCreateResponse(w http.ResponseWriter) error
+}
+
+type EventCreate200JSONResponse Event
+
+func (response EventCreate200JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreate400JSONResponse APIErrors
+
+func (response EventCreate400JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreate403JSONResponse APIErrors
+
+func (response EventCreate403JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreate429JSONResponse APIErrors
+
+func (response EventCreate429JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBulkRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventCreateBulkJSONRequestBody
+}
+
+type EventCreateBulkResponseObject interface {
+	VisitEventCreateBulkResponse(w http.ResponseWriter) error
+}
+
+type EventCreateBulk200JSONResponse Events
+
+func (response EventCreateBulk200JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBulk400JSONResponse APIErrors
+
+func (response EventCreateBulk400JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBulk403JSONResponse APIErrors
+
+func (response EventCreateBulk403JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBulk429JSONResponse APIErrors
+
+func (response EventCreateBulk429JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateCancelJSONRequestBody
+}
+
+type EventUpdateCancelResponseObject interface {
+	VisitEventUpdateCancelResponse(w http.ResponseWriter) error
+}
+
+type EventUpdateCancel200JSONResponse struct {
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+}
+
+func (response EventUpdateCancel200JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateCancel400JSONResponse APIErrors
+
+func (response EventUpdateCancel400JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateCancel403JSONResponse APIErrors
+
+func (response EventUpdateCancel403JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateCancel429JSONResponse APIErrors
+
+func (response EventUpdateCancel429JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventKeyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type EventKeyListResponseObject interface {
+	VisitEventKeyListResponse(w http.ResponseWriter) error
+}
+
+type EventKeyList200JSONResponse EventKeyList
+
+func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventKeyList400JSONResponse APIErrors
+
+func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventKeyList403JSONResponse APIErrors
+
+func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateReplayJSONRequestBody
+}
+
+type EventUpdateReplayResponseObject interface {
+	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
+}
+
+type EventUpdateReplay200JSONResponse EventList
+
+func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay400JSONResponse APIErrors
+
+func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay403JSONResponse APIErrors
+
+func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay429JSONResponse APIErrors
+
+func (response EventUpdateReplay429JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantInviteListResponseObject interface {
+	VisitTenantInviteListResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteList200JSONResponse TenantInviteList
 
 func (response TenantInviteList200JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
@@ -5677,298 +7835,541 @@ type TenantInviteList403JSONResponse APIError
 
 func (response TenantInviteList403JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantInviteCreateJSONRequestBody
+}
+
+type TenantInviteCreateResponseObject interface {
+	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteCreate201JSONResponse TenantInvite
+
+func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteCreate400JSONResponse APIErrors
+
+func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteCreate403JSONResponse APIError
+
+func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+}
+
+type TenantInviteDeleteResponseObject interface {
+	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteDelete200JSONResponse TenantInvite
+
+func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteDelete400JSONResponse APIErrors
+
+func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteUpdateRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+	Body         *TenantInviteUpdateJSONRequestBody
+}
+
+type TenantInviteUpdateResponseObject interface {
+	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteUpdate200JSONResponse TenantInvite
+
+func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteUpdate400JSONResponse APIErrors
+
+func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantMemberListResponseObject interface {
+	VisitTenantMemberListResponse(w http.ResponseWriter) error
+}
+
+type TenantMemberList200JSONResponse TenantMemberList
+
+func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberList400JSONResponse APIErrors
+
+func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberList403JSONResponse APIError
+
+func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Member openapi_types.UUID `json:"member"`
+}
+
+type TenantMemberDeleteResponseObject interface {
+	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
+}
+
+type TenantMemberDelete204JSONResponse TenantMember
+
+func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(204)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberDelete400JSONResponse APIErrors
+
+func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberDelete403JSONResponse APIErrors
+
+func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberDelete404JSONResponse APIErrors
+
+func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberUpdateRoleRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Member openapi_types.UUID `json:"member"`
+	Body   *TenantMemberUpdateRoleJSONRequestBody
+}
+
+type TenantMemberUpdateRoleResponseObject interface {
+	VisitTenantMemberUpdateRoleResponse(w http.ResponseWriter) error
+}
+
+type TenantMemberUpdateRole200JSONResponse TenantMember
+
+func (response TenantMemberUpdateRole200JSONResponse) VisitTenantMemberUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberUpdateRole400JSONResponse APIErrors
+
+func (response TenantMemberUpdateRole400JSONResponse) VisitTenantMemberUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberUpdateRole403JSONResponse APIErrors
+
+func (response TenantMemberUpdateRole403JSONResponse) VisitTenantMemberUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberUpdateRole404JSONResponse APIErrors
+
+func (response TenantMemberUpdateRole404JSONResponse) VisitTenantMemberUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params TenantGetQueueMetricsParams
+}
+
+type TenantGetQueueMetricsResponseObject interface {
+	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+}
+
+type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+
+func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics400JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics403JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics404JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreateRequestObject struct {
+type RateLimitListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *TenantInviteCreateJSONRequestBody
+	Params RateLimitListParams
 }
 
-type TenantInviteCreateResponseObject interface {
-	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
+type RateLimitListResponseObject interface {
+	VisitRateLimitListResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteCreate201JSONResponse TenantInvite
+type RateLimitList200JSONResponse RateLimitList
 
-func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate400JSONResponse APIErrors
+type RateLimitList400JSONResponse APIErrors
 
-func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate403JSONResponse APIError
+type RateLimitList403JSONResponse APIErrors
 
-func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteDeleteRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+type TenantResourcePolicyGetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type TenantInviteDeleteResponseObject interface {
-	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
+type TenantResourcePolicyGetResponseObject interface {
+	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteDelete200JSONResponse TenantInvite
+type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
 
-func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteDelete400JSONResponse APIErrors
+type TenantResourcePolicyGet400JSONResponse APIErrors
 
-func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteUpdateRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
-	Body         *TenantInviteUpdateJSONRequestBody
-}
-
-type TenantInviteUpdateResponseObject interface {
-	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
-}
-
-type TenantInviteUpdate200JSONResponse TenantInvite
-
-func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type TenantInviteUpdate400JSONResponse APIErrors
+type TenantResourcePolicyGet403JSONResponse APIError
 
-func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberListRequestObject struct {
+type TenantResourcePolicyUpdateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantResourcePolicyUpdateJSONRequestBody
 }
 
-type TenantMemberListResponseObject interface {
-	VisitTenantMemberListResponse(w http.ResponseWriter) error
+type TenantResourcePolicyUpdateResponseObject interface {
+	VisitTenantResourcePolicyUpdateResponse(w http.ResponseWriter) error
 }
 
-type TenantMemberList200JSONResponse TenantMemberList
+type TenantResourcePolicyUpdate200JSONResponse TenantResourcePolicy
 
-func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyUpdate200JSONResponse) VisitTenantResourcePolicyUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberList400JSONResponse APIErrors
+type TenantResourcePolicyUpdate400JSONResponse APIErrors
 
-func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyUpdate400JSONResponse) VisitTenantResourcePolicyUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberList403JSONResponse APIError
+type TenantResourcePolicyUpdate403JSONResponse APIError
 
-func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyUpdate403JSONResponse) VisitTenantResourcePolicyUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDeleteRequestObject struct {
+type TenantRoleListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Member openapi_types.UUID `json:"member"`
 }
 
-type TenantMemberDeleteResponseObject interface {
-	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
+type TenantRoleListResponseObject interface {
+	VisitTenantRoleListResponse(w http.ResponseWriter) error
 }
 
-type TenantMemberDelete204JSONResponse TenantMember
+type TenantRoleList200JSONResponse TenantRoleList
 
-func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response TenantRoleList200JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(204)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete400JSONResponse APIErrors
+type TenantRoleList400JSONResponse APIErrors
 
-func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response TenantRoleList400JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete403JSONResponse APIErrors
+type TenantRoleList403JSONResponse APIErrors
 
-func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response TenantRoleList403JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete404JSONResponse APIErrors
-
-func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type TenantGetQueueMetricsRequestObject struct {
+type TenantRoleCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Params TenantGetQueueMetricsParams
+	Body   *TenantRoleCreateJSONRequestBody
 }
 
-type TenantGetQueueMetricsResponseObject interface {
-	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+type TenantRoleCreateResponseObject interface {
+	VisitTenantRoleCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+type TenantRoleCreate200JSONResponse TenantRole
 
-func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantRoleCreate200JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics400JSONResponse APIErrors
+type TenantRoleCreate400JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantRoleCreate400JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics403JSONResponse APIErrors
+type TenantRoleCreate403JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantRoleCreate403JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics404JSONResponse APIErrors
-
-func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type TenantRoleDeleteRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	TenantRole openapi_types.UUID `json:"tenant-role"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type TenantRoleDeleteResponseObject interface {
+	VisitTenantRoleDeleteResponse(w http.ResponseWriter) error
 }
 
-type RateLimitListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params RateLimitListParams
+type TenantRoleDelete204Response struct {
 }
 
-type RateLimitListResponseObject interface {
-	VisitRateLimitListResponse(w http.ResponseWriter) error
+func (response TenantRoleDelete204Response) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type RateLimitList200JSONResponse RateLimitList
+type TenantRoleDelete400JSONResponse APIErrors
 
-func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response TenantRoleDelete400JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList400JSONResponse APIErrors
+type TenantRoleDelete403JSONResponse APIErrors
 
-func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response TenantRoleDelete403JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList403JSONResponse APIErrors
+type TenantRoleDelete404JSONResponse APIErrors
 
-func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response TenantRoleDelete404JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGetRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type TenantRoleUpdateRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	TenantRole openapi_types.UUID `json:"tenant-role"`
+	Body       *TenantRoleUpdateJSONRequestBody
 }
 
-type TenantResourcePolicyGetResponseObject interface {
-	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
+type TenantRoleUpdateResponseObject interface {
+	VisitTenantRoleUpdateResponse(w http.ResponseWriter) error
 }
 
-type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
+type TenantRoleUpdate200JSONResponse TenantRole
 
-func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response TenantRoleUpdate200JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet400JSONResponse APIErrors
+type TenantRoleUpdate400JSONResponse APIErrors
 
-func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response TenantRoleUpdate400JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet403JSONResponse APIError
+type TenantRoleUpdate403JSONResponse APIErrors
 
-func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response TenantRoleUpdate403JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
+type TenantRoleUpdate404JSONResponse APIErrors
+
+func (response TenantRoleUpdate404JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type SlackWebhookListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -6331,6 +8732,77 @@ func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WebhookSubscriptionListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type WebhookSubscriptionListResponseObject interface {
+	VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionList200JSONResponse WebhookSubscriptionList
+
+func (response WebhookSubscriptionList200JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionList400JSONResponse APIErrors
+
+func (response WebhookSubscriptionList400JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionList403JSONResponse APIErrors
+
+func (response WebhookSubscriptionList403JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WebhookSubscriptionCreateJSONRequestBody
+}
+
+type WebhookSubscriptionCreateResponseObject interface {
+	VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionCreate200JSONResponse WebhookSubscriptionCreated
+
+func (response WebhookSubscriptionCreate200JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreate400JSONResponse APIErrors
+
+func (response WebhookSubscriptionCreate400JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreate403JSONResponse APIErrors
+
+func (response WebhookSubscriptionCreate403JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type WebhookListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -6439,16 +8911,88 @@ func (response WorkerList200JSONResponse) VisitWorkerListResponse(w http.Respons
 
 type WorkerList400JSONResponse APIErrors
 
-func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerList403JSONResponse APIErrors
+
+func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunSearchRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunSearchParams
+}
+
+type WorkflowRunSearchResponseObject interface {
+	VisitWorkflowRunSearchResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunSearch200JSONResponse WorkflowRunSearchResult
+
+func (response WorkflowRunSearch200JSONResponse) VisitWorkflowRunSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunSearch400JSONResponse APIErrors
+
+func (response WorkflowRunSearch400JSONResponse) VisitWorkflowRunSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunSearch403JSONResponse APIErrors
+
+func (response WorkflowRunSearch403JSONResponse) VisitWorkflowRunSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunUpdateCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowRunUpdateCancelJSONRequestBody
+}
+
+type WorkflowRunUpdateCancelResponseObject interface {
+	VisitWorkflowRunUpdateCancelResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunUpdateCancel200JSONResponse CancelWorkflowRunsResponse
+
+func (response WorkflowRunUpdateCancel200JSONResponse) VisitWorkflowRunUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunUpdateCancel400JSONResponse APIErrors
+
+func (response WorkflowRunUpdateCancel400JSONResponse) VisitWorkflowRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerList403JSONResponse APIErrors
+type WorkflowRunUpdateCancel403JSONResponse APIErrors
 
-func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateCancel403JSONResponse) VisitWorkflowRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
@@ -7424,228 +9968,528 @@ type UserUpdateLoginResponseObject interface {
 	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateLogin200JSONResponse User
+type UserUpdateLogin200JSONResponse User
+
+func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin400JSONResponse APIErrors
+
+func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin401JSONResponse APIErrors
+
+func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin405JSONResponse APIErrors
+
+func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogoutRequestObject struct {
+}
+
+type UserUpdateLogoutResponseObject interface {
+	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateLogout200JSONResponse User
+
+func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout400JSONResponse APIErrors
+
+func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout401JSONResponse APIErrors
+
+func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout405JSONResponse APIErrors
+
+func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsListRequestObject struct {
+}
+
+type TenantMembershipsListResponseObject interface {
+	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+}
+
+type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+
+func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList400JSONResponse APIErrors
+
+func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList403JSONResponse APIErrors
+
+func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateOauthCallbackRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type UserUpdateOauthCallbackResponseObject interface {
+	VisitUserUpdateOauthCallbackResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateOauthCallback302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateOauthCallback302Response struct {
+	Headers UserUpdateOauthCallback302ResponseHeaders
+}
+
+func (response UserUpdateOauthCallback302Response) VisitUserUpdateOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateOauthStartRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type UserUpdateOauthStartResponseObject interface {
+	VisitUserUpdateOauthStartResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateOauthStart302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateOauthStart302Response struct {
+	Headers UserUpdateOauthStart302ResponseHeaders
+}
+
+func (response UserUpdateOauthStart302Response) VisitUserUpdateOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdatePasswordRequestObject struct {
+	Body *UserUpdatePasswordJSONRequestBody
+}
+
+type UserUpdatePasswordResponseObject interface {
+	VisitUserUpdatePasswordResponse(w http.ResponseWriter) error
+}
+
+type UserUpdatePassword200JSONResponse User
+
+func (response UserUpdatePassword200JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdatePassword400JSONResponse APIErrors
+
+func (response UserUpdatePassword400JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdatePassword401JSONResponse APIErrors
+
+func (response UserUpdatePassword401JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdatePassword405JSONResponse APIErrors
+
+func (response UserUpdatePassword405JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreateRequestObject struct {
+	Body *UserCreateJSONRequestBody
+}
+
+type UserCreateResponseObject interface {
+	VisitUserCreateResponse(w http.ResponseWriter) error
+}
+
+type UserCreate200JSONResponse User
+
+func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate400JSONResponse APIErrors
+
+func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate401JSONResponse APIErrors
+
+func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate405JSONResponse APIErrors
+
+func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateSamlCallbackRequestObject struct {
+	Body *UserUpdateSamlCallbackFormdataRequestBody
+}
+
+type UserUpdateSamlCallbackResponseObject interface {
+	VisitUserUpdateSamlCallbackResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateSamlCallback302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateSamlCallback302Response struct {
+	Headers UserUpdateSamlCallback302ResponseHeaders
+}
+
+func (response UserUpdateSamlCallback302Response) VisitUserUpdateSamlCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserGetSamlMetadataRequestObject struct {
+}
+
+type UserGetSamlMetadataResponseObject interface {
+	VisitUserGetSamlMetadataResponse(w http.ResponseWriter) error
+}
+
+type UserGetSamlMetadata200ApplicationsamlmetadataXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response UserGetSamlMetadata200ApplicationsamlmetadataXmlResponse) VisitUserGetSamlMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type UserGetSamlMetadata404JSONResponse APIErrors
 
-func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response UserGetSamlMetadata404JSONResponse) VisitUserGetSamlMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogin400JSONResponse APIErrors
+type UserUpdateSamlStartRequestObject struct {
+}
 
-func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type UserUpdateSamlStartResponseObject interface {
+	VisitUserUpdateSamlStartResponse(w http.ResponseWriter) error
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateSamlStart302ResponseHeaders struct {
+	Location string
 }
 
-type UserUpdateLogin401JSONResponse APIErrors
+type UserUpdateSamlStart302Response struct {
+	Headers UserUpdateSamlStart302ResponseHeaders
+}
 
-func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+func (response UserUpdateSamlStart302Response) VisitUserUpdateSamlStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateSlackOauthCallbackRequestObject struct {
 }
 
-type UserUpdateLogin405JSONResponse APIErrors
+type UserUpdateSlackOauthCallbackResponseObject interface {
+	VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error
+}
 
-func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+type UserUpdateSlackOauthCallback302ResponseHeaders struct {
+	Location string
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateSlackOauthCallback302Response struct {
+	Headers UserUpdateSlackOauthCallback302ResponseHeaders
 }
 
-type UserUpdateLogoutRequestObject struct {
+func (response UserUpdateSlackOauthCallback302Response) VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
 }
 
-type UserUpdateLogoutResponseObject interface {
-	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+type WebhookDeliveryResendRequestObject struct {
+	WebhookDelivery openapi_types.UUID `json:"webhook-delivery"`
 }
 
-type UserUpdateLogout200JSONResponse User
+type WebhookDeliveryResendResponseObject interface {
+	VisitWebhookDeliveryResendResponse(w http.ResponseWriter) error
+}
 
-func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+type WebhookDeliveryResend200JSONResponse WebhookDelivery
+
+func (response WebhookDeliveryResend200JSONResponse) VisitWebhookDeliveryResendResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout400JSONResponse APIErrors
+type WebhookDeliveryResend400JSONResponse APIErrors
 
-func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryResend400JSONResponse) VisitWebhookDeliveryResendResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout401JSONResponse APIErrors
+type WebhookDeliveryResend403JSONResponse APIErrors
 
-func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryResend403JSONResponse) VisitWebhookDeliveryResendResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout405JSONResponse APIErrors
+type WebhookDeliveryResend404JSONResponse APIErrors
 
-func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryResend404JSONResponse) VisitWebhookDeliveryResendResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsListRequestObject struct {
+type WebhookSubscriptionDeleteRequestObject struct {
+	WebhookSubscription openapi_types.UUID `json:"webhook-subscription"`
 }
 
-type TenantMembershipsListResponseObject interface {
-	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+type WebhookSubscriptionDeleteResponseObject interface {
+	VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error
 }
 
-type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+type WebhookSubscriptionDelete200JSONResponse WebhookSubscription
 
-func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WebhookSubscriptionDelete200JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsList400JSONResponse APIErrors
+type WebhookSubscriptionDelete400JSONResponse APIErrors
 
-func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WebhookSubscriptionDelete400JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsList403JSONResponse APIErrors
+type WebhookSubscriptionDelete403JSONResponse APIErrors
 
-func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WebhookSubscriptionDelete403JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePasswordRequestObject struct {
-	Body *UserUpdatePasswordJSONRequestBody
+type WebhookSubscriptionDelete404JSONResponse APIErrors
+
+func (response WebhookSubscriptionDelete404JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePasswordResponseObject interface {
-	VisitUserUpdatePasswordResponse(w http.ResponseWriter) error
+type WebhookSubscriptionUpdateRequestObject struct {
+	WebhookSubscription openapi_types.UUID `json:"webhook-subscription"`
+	Body                *WebhookSubscriptionUpdateJSONRequestBody
 }
 
-type UserUpdatePassword200JSONResponse User
+type WebhookSubscriptionUpdateResponseObject interface {
+	VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error
+}
 
-func (response UserUpdatePassword200JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+type WebhookSubscriptionUpdate200JSONResponse WebhookSubscription
+
+func (response WebhookSubscriptionUpdate200JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword400JSONResponse APIErrors
+type WebhookSubscriptionUpdate400JSONResponse APIErrors
 
-func (response UserUpdatePassword400JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WebhookSubscriptionUpdate400JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword401JSONResponse APIErrors
+type WebhookSubscriptionUpdate403JSONResponse APIErrors
 
-func (response UserUpdatePassword401JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WebhookSubscriptionUpdate403JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword405JSONResponse APIErrors
+type WebhookSubscriptionUpdate404JSONResponse APIErrors
 
-func (response UserUpdatePassword405JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WebhookSubscriptionUpdate404JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreateRequestObject struct {
-	Body *UserCreateJSONRequestBody
+type WebhookDeliveryListRequestObject struct {
+	WebhookSubscription openapi_types.UUID `json:"webhook-subscription"`
+	Params              WebhookDeliveryListParams
 }
 
-type UserCreateResponseObject interface {
-	VisitUserCreateResponse(w http.ResponseWriter) error
+type WebhookDeliveryListResponseObject interface {
+	VisitWebhookDeliveryListResponse(w http.ResponseWriter) error
 }
 
-type UserCreate200JSONResponse User
+type WebhookDeliveryList200JSONResponse WebhookDeliveryList
 
-func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList200JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate400JSONResponse APIErrors
+type WebhookDeliveryList400JSONResponse APIErrors
 
-func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList400JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate401JSONResponse APIErrors
+type WebhookDeliveryList403JSONResponse APIErrors
 
-func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList403JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate405JSONResponse APIErrors
+type WebhookDeliveryList404JSONResponse APIErrors
 
-func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList404JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateSlackOauthCallbackRequestObject struct {
-}
-
-type UserUpdateSlackOauthCallbackResponseObject interface {
-	VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error
-}
-
-type UserUpdateSlackOauthCallback302ResponseHeaders struct {
-	Location string
-}
-
-type UserUpdateSlackOauthCallback302Response struct {
-	Headers UserUpdateSlackOauthCallback302ResponseHeaders
-}
-
-func (response UserUpdateSlackOauthCallback302Response) VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
-}
-
 type WebhookDeleteRequestObject struct {
 	Webhook openapi_types.UUID `json:"webhook"`
 }
@@ -8027,6 +10871,78 @@ func (response WorkflowRunCreate429JSONResponse) VisitWorkflowRunCreateResponse(
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WorkflowVersionWeightsGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowVersionWeightsGetParams
+}
+
+type WorkflowVersionWeightsGetResponseObject interface {
+	VisitWorkflowVersionWeightsGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowVersionWeightsGet200JSONResponse WorkflowVersionWeights
+
+func (response WorkflowVersionWeightsGet200JSONResponse) VisitWorkflowVersionWeightsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionWeightsGet400JSONResponse APIErrors
+
+func (response WorkflowVersionWeightsGet400JSONResponse) VisitWorkflowVersionWeightsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionWeightsGet403JSONResponse APIErrors
+
+func (response WorkflowVersionWeightsGet403JSONResponse) VisitWorkflowVersionWeightsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionWeightsUpdateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowVersionWeightsUpdateJSONRequestBody
+}
+
+type WorkflowVersionWeightsUpdateResponseObject interface {
+	VisitWorkflowVersionWeightsUpdateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowVersionWeightsUpdate200JSONResponse WorkflowVersionWeights
+
+func (response WorkflowVersionWeightsUpdate200JSONResponse) VisitWorkflowVersionWeightsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionWeightsUpdate400JSONResponse APIErrors
+
+func (response WorkflowVersionWeightsUpdate400JSONResponse) VisitWorkflowVersionWeightsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionWeightsUpdate403JSONResponse APIErrors
+
+func (response WorkflowVersionWeightsUpdate403JSONResponse) VisitWorkflowVersionWeightsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type WorkflowVersionGetRequestObject struct {
 	Workflow openapi_types.UUID `json:"workflow"`
 	Params   WorkflowVersionGetParams
@@ -8081,14 +10997,22 @@ type StrictServerInterface interface {
 
 	AlertEmailGroupUpdate(ctx echo.Context, request AlertEmailGroupUpdateRequestObject) (AlertEmailGroupUpdateResponseObject, error)
 
+	AlertMuteRuleDelete(ctx echo.Context, request AlertMuteRuleDeleteRequestObject) (AlertMuteRuleDeleteResponseObject, error)
+
 	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
 
+	ApiTokenUpdateRotate(ctx echo.Context, request ApiTokenUpdateRotateRequestObject) (ApiTokenUpdateRotateResponseObject, error)
+
 	CloudMetadataGet(ctx echo.Context, request CloudMetadataGetRequestObject) (CloudMetadataGetResponseObject, error)
 
 	EventGet(ctx echo.Context, request EventGetRequestObject) (EventGetResponseObject, error)
 
 	EventDataGet(ctx echo.Context, request EventDataGetRequestObject) (EventDataGetResponseObject, error)
 
+	EventUpdateReplayWithPayload(ctx echo.Context, request EventUpdateReplayWithPayloadRequestObject) (EventUpdateReplayWithPayloadResponseObject, error)
+
+	EventWorkflowRunList(ctx echo.Context, request EventWorkflowRunListRequestObject) (EventWorkflowRunListResponseObject, error)
+
 	MetadataGet(ctx echo.Context, request MetadataGetRequestObject) (MetadataGetResponseObject, error)
 
 	MetadataListIntegrations(ctx echo.Context, request MetadataListIntegrationsRequestObject) (MetadataListIntegrationsResponseObject, error)
@@ -8115,12 +11039,28 @@ type StrictServerInterface interface {
 
 	AlertEmailGroupCreate(ctx echo.Context, request AlertEmailGroupCreateRequestObject) (AlertEmailGroupCreateResponseObject, error)
 
+	AlertMuteRuleList(ctx echo.Context, request AlertMuteRuleListRequestObject) (AlertMuteRuleListResponseObject, error)
+
+	AlertMuteRuleCreate(ctx echo.Context, request AlertMuteRuleCreateRequestObject) (AlertMuteRuleCreateResponseObject, error)
+
 	TenantAlertingSettingsGet(ctx echo.Context, request TenantAlertingSettingsGetRequestObject) (TenantAlertingSettingsGetResponseObject, error)
 
 	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
 
 	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
 
+	AuditLogList(ctx echo.Context, request AuditLogListRequestObject) (AuditLogListResponseObject, error)
+
+	AuditLogGetSettings(ctx echo.Context, request AuditLogGetSettingsRequestObject) (AuditLogGetSettingsResponseObject, error)
+
+	AuditLogUpdateSettings(ctx echo.Context, request AuditLogUpdateSettingsRequestObject) (AuditLogUpdateSettingsResponseObject, error)
+
+	StepRunListDeadLetters(ctx echo.Context, request StepRunListDeadLettersRequestObject) (StepRunListDeadLettersResponseObject, error)
+
+	StepRunDeleteDeadLetter(ctx echo.Context, request StepRunDeleteDeadLetterRequestObject) (StepRunDeleteDeadLetterResponseObject, error)
+
+	StepRunUpdateRequeue(ctx echo.Context, request StepRunUpdateRequeueRequestObject) (StepRunUpdateRequeueResponseObject, error)
+
 	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
 
 	EventCreate(ctx echo.Context, request EventCreateRequestObject) (EventCreateResponseObject, error)
@@ -8145,12 +11085,24 @@ type StrictServerInterface interface {
 
 	TenantMemberDelete(ctx echo.Context, request TenantMemberDeleteRequestObject) (TenantMemberDeleteResponseObject, error)
 
+	TenantMemberUpdateRole(ctx echo.Context, request TenantMemberUpdateRoleRequestObject) (TenantMemberUpdateRoleResponseObject, error)
+
 	TenantGetQueueMetrics(ctx echo.Context, request TenantGetQueueMetricsRequestObject) (TenantGetQueueMetricsResponseObject, error)
 
 	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
 
 	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
 
+	TenantResourcePolicyUpdate(ctx echo.Context, request TenantResourcePolicyUpdateRequestObject) (TenantResourcePolicyUpdateResponseObject, error)
+
+	TenantRoleList(ctx echo.Context, request TenantRoleListRequestObject) (TenantRoleListResponseObject, error)
+
+	TenantRoleCreate(ctx echo.Context, request TenantRoleCreateRequestObject) (TenantRoleCreateResponseObject, error)
+
+	TenantRoleDelete(ctx echo.Context, request TenantRoleDeleteRequestObject) (TenantRoleDeleteResponseObject, error)
+
+	TenantRoleUpdate(ctx echo.Context, request TenantRoleUpdateRequestObject) (TenantRoleUpdateResponseObject, error)
+
 	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
 
 	UserUpdateSlackOauthStart(ctx echo.Context, request UserUpdateSlackOauthStartRequestObject) (UserUpdateSlackOauthStartResponseObject, error)
@@ -8169,12 +11121,20 @@ type StrictServerInterface interface {
 
 	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
 
+	WebhookSubscriptionList(ctx echo.Context, request WebhookSubscriptionListRequestObject) (WebhookSubscriptionListResponseObject, error)
+
+	WebhookSubscriptionCreate(ctx echo.Context, request WebhookSubscriptionCreateRequestObject) (WebhookSubscriptionCreateResponseObject, error)
+
 	WebhookList(ctx echo.Context, request WebhookListRequestObject) (WebhookListResponseObject, error)
 
 	WebhookCreate(ctx echo.Context, request WebhookCreateRequestObject) (WebhookCreateResponseObject, error)
 
 	WorkerList(ctx echo.Context, request WorkerListRequestObject) (WorkerListResponseObject, error)
 
+	WorkflowRunSearch(ctx echo.Context, request WorkflowRunSearchRequestObject) (WorkflowRunSearchResponseObject, error)
+
+	WorkflowRunUpdateCancel(ctx echo.Context, request WorkflowRunUpdateCancelRequestObject) (WorkflowRunUpdateCancelResponseObject, error)
+
 	WorkflowRunUpdateReplay(ctx echo.Context, request WorkflowRunUpdateReplayRequestObject) (WorkflowRunUpdateReplayResponseObject, error)
 
 	WorkflowRunGet(ctx echo.Context, request WorkflowRunGetRequestObject) (WorkflowRunGetResponseObject, error)
@@ -8233,12 +11193,30 @@ type StrictServerInterface interface {
 
 	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
 
+	UserUpdateOauthCallback(ctx echo.Context, request UserUpdateOauthCallbackRequestObject) (UserUpdateOauthCallbackResponseObject, error)
+
+	UserUpdateOauthStart(ctx echo.Context, request UserUpdateOauthStartRequestObject) (UserUpdateOauthStartResponseObject, error)
+
 	UserUpdatePassword(ctx echo.Context, request UserUpdatePasswordRequestObject) (UserUpdatePasswordResponseObject, error)
 
 	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
 
+	UserUpdateSamlCallback(ctx echo.Context, request UserUpdateSamlCallbackRequestObject) (UserUpdateSamlCallbackResponseObject, error)
+
+	UserGetSamlMetadata(ctx echo.Context, request UserGetSamlMetadataRequestObject) (UserGetSamlMetadataResponseObject, error)
+
+	UserUpdateSamlStart(ctx echo.Context, request UserUpdateSamlStartRequestObject) (UserUpdateSamlStartResponseObject, error)
+
 	UserUpdateSlackOauthCallback(ctx echo.Context, request UserUpdateSlackOauthCallbackRequestObject) (UserUpdateSlackOauthCallbackResponseObject, error)
 
+	WebhookDeliveryResend(ctx echo.Context, request WebhookDeliveryResendRequestObject) (WebhookDeliveryResendResponseObject, error)
+
+	WebhookSubscriptionDelete(ctx echo.Context, request WebhookSubscriptionDeleteRequestObject) (WebhookSubscriptionDeleteResponseObject, error)
+
+	WebhookSubscriptionUpdate(ctx echo.Context, request WebhookSubscriptionUpdateRequestObject) (WebhookSubscriptionUpdateResponseObject, error)
+
+	WebhookDeliveryList(ctx echo.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error)
+
 	WebhookDelete(ctx echo.Context, request WebhookDeleteRequestObject) (WebhookDeleteResponseObject, error)
 
 	WebhookRequestsList(ctx echo.Context, request WebhookRequestsListRequestObject) (WebhookRequestsListResponseObject, error)
@@ -8257,6 +11235,10 @@ type StrictServerInterface interface {
 
 	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
 
+	WorkflowVersionWeightsGet(ctx echo.Context, request WorkflowVersionWeightsGetRequestObject) (WorkflowVersionWeightsGetResponseObject, error)
+
+	WorkflowVersionWeightsUpdate(ctx echo.Context, request WorkflowVersionWeightsUpdateRequestObject) (WorkflowVersionWeightsUpdateResponseObject, error)
+
 	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
 }
 type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
@@ -8373,6 +11355,31 @@ func (sh *strictHandler) AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup
 	return nil
 }
 
+// AlertMuteRuleDelete operation middleware
+func (sh *strictHandler) AlertMuteRuleDelete(ctx echo.Context, alertMuteRule openapi_types.UUID) error {
+	var request AlertMuteRuleDeleteRequestObject
+
+	request.AlertMuteRule = alertMuteRule
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertMuteRuleDelete(ctx, request.(AlertMuteRuleDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AlertMuteRuleDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertMuteRuleDeleteResponseObject); ok {
+		return validResponse.VisitAlertMuteRuleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ApiTokenUpdateRevoke operation middleware
 func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
 	var request ApiTokenUpdateRevokeRequestObject
@@ -8380,18 +11387,49 @@ func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi
 	request.ApiToken = apiToken
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenUpdateRevoke")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
+		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ApiTokenUpdateRotate operation middleware
+func (sh *strictHandler) ApiTokenUpdateRotate(ctx echo.Context, apiToken openapi_types.UUID) error {
+	var request ApiTokenUpdateRotateRequestObject
+
+	request.ApiToken = apiToken
+
+	var body ApiTokenUpdateRotateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenUpdateRotate(ctx, request.(ApiTokenUpdateRotateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenUpdateRevoke")
+		handler = middleware(handler, "ApiTokenUpdateRotate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
-		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if validResponse, ok := response.(ApiTokenUpdateRotateResponseObject); ok {
+		return validResponse.VisitApiTokenUpdateRotateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -8471,6 +11509,63 @@ func (sh *strictHandler) EventDataGet(ctx echo.Context, event openapi_types.UUID
 	return nil
 }
 
+// EventUpdateReplayWithPayload operation middleware
+func (sh *strictHandler) EventUpdateReplayWithPayload(ctx echo.Context, event openapi_types.UUID) error {
+	var request EventUpdateReplayWithPayloadRequestObject
+
+	request.Event = event
+
+	var body EventUpdateReplayWithPayloadJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventUpdateReplayWithPayload(ctx, request.(EventUpdateReplayWithPayloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventUpdateReplayWithPayload")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventUpdateReplayWithPayloadResponseObject); ok {
+		return validResponse.VisitEventUpdateReplayWithPayloadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EventWorkflowRunList operation middleware
+func (sh *strictHandler) EventWorkflowRunList(ctx echo.Context, event openapi_types.UUID, params EventWorkflowRunListParams) error {
+	var request EventWorkflowRunListRequestObject
+
+	request.Event = event
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventWorkflowRunList(ctx, request.(EventWorkflowRunListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventWorkflowRunList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventWorkflowRunListResponseObject); ok {
+		return validResponse.VisitEventWorkflowRunListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // MetadataGet operation middleware
 func (sh *strictHandler) MetadataGet(ctx echo.Context) error {
 	var request MetadataGetRequestObject
@@ -8674,219 +11769,435 @@ func (sh *strictHandler) StepRunListEvents(ctx echo.Context, stepRun openapi_typ
 func (sh *strictHandler) LogLineList(ctx echo.Context, stepRun openapi_types.UUID, params LogLineListParams) error {
 	var request LogLineListRequestObject
 
-	request.StepRun = stepRun
-	request.Params = params
+	request.StepRun = stepRun
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogLineList(ctx, request.(LogLineListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogLineList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogLineListResponseObject); ok {
+		return validResponse.VisitLogLineListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantCreate operation middleware
+func (sh *strictHandler) TenantCreate(ctx echo.Context) error {
+	var request TenantCreateRequestObject
+
+	var body TenantCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantCreate(ctx, request.(TenantCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantCreateResponseObject); ok {
+		return validResponse.VisitTenantCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantUpdate operation middleware
+func (sh *strictHandler) TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantUpdateRequestObject
+
+	request.Tenant = tenant
+
+	var body TenantUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantUpdate(ctx, request.(TenantUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantUpdateResponseObject); ok {
+		return validResponse.VisitTenantUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertEmailGroupList operation middleware
+func (sh *strictHandler) AlertEmailGroupList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertEmailGroupListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertEmailGroupList(ctx, request.(AlertEmailGroupListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AlertEmailGroupList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertEmailGroupListResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertEmailGroupCreate operation middleware
+func (sh *strictHandler) AlertEmailGroupCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertEmailGroupCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body AlertEmailGroupCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertEmailGroupCreate(ctx, request.(AlertEmailGroupCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AlertEmailGroupCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertEmailGroupCreateResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertMuteRuleList operation middleware
+func (sh *strictHandler) AlertMuteRuleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertMuteRuleListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertMuteRuleList(ctx, request.(AlertMuteRuleListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AlertMuteRuleList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertMuteRuleListResponseObject); ok {
+		return validResponse.VisitAlertMuteRuleListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AlertMuteRuleCreate operation middleware
+func (sh *strictHandler) AlertMuteRuleCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertMuteRuleCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body AlertMuteRuleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertMuteRuleCreate(ctx, request.(AlertMuteRuleCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AlertMuteRuleCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertMuteRuleCreateResponseObject); ok {
+		return validResponse.VisitAlertMuteRuleCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantAlertingSettingsGet operation middleware
+func (sh *strictHandler) TenantAlertingSettingsGet(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantAlertingSettingsGetRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantAlertingSettingsGet(ctx, request.(TenantAlertingSettingsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantAlertingSettingsGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantAlertingSettingsGetResponseObject); ok {
+		return validResponse.VisitTenantAlertingSettingsGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ApiTokenList operation middleware
+func (sh *strictHandler) ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ApiTokenListRequestObject
+
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LogLineList(ctx, request.(LogLineListRequestObject))
+		return sh.ssi.ApiTokenList(ctx, request.(ApiTokenListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LogLineList")
+		handler = middleware(handler, "ApiTokenList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LogLineListResponseObject); ok {
-		return validResponse.VisitLogLineListResponse(ctx.Response())
+	} else if validResponse, ok := response.(ApiTokenListResponseObject); ok {
+		return validResponse.VisitApiTokenListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantCreate operation middleware
-func (sh *strictHandler) TenantCreate(ctx echo.Context) error {
-	var request TenantCreateRequestObject
+// ApiTokenCreate operation middleware
+func (sh *strictHandler) ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ApiTokenCreateRequestObject
 
-	var body TenantCreateJSONRequestBody
+	request.Tenant = tenant
+
+	var body ApiTokenCreateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantCreate(ctx, request.(TenantCreateRequestObject))
+		return sh.ssi.ApiTokenCreate(ctx, request.(ApiTokenCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantCreate")
+		handler = middleware(handler, "ApiTokenCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantCreateResponseObject); ok {
-		return validResponse.VisitTenantCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(ApiTokenCreateResponseObject); ok {
+		return validResponse.VisitApiTokenCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantUpdate operation middleware
-func (sh *strictHandler) TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantUpdateRequestObject
+// AuditLogList operation middleware
+func (sh *strictHandler) AuditLogList(ctx echo.Context, tenant openapi_types.UUID, params AuditLogListParams) error {
+	var request AuditLogListRequestObject
 
 	request.Tenant = tenant
-
-	var body TenantUpdateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantUpdate(ctx, request.(TenantUpdateRequestObject))
+		return sh.ssi.AuditLogList(ctx, request.(AuditLogListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantUpdate")
+		handler = middleware(handler, "AuditLogList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantUpdateResponseObject); ok {
-		return validResponse.VisitTenantUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(AuditLogListResponseObject); ok {
+		return validResponse.VisitAuditLogListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupList operation middleware
-func (sh *strictHandler) AlertEmailGroupList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request AlertEmailGroupListRequestObject
+// AuditLogGetSettings operation middleware
+func (sh *strictHandler) AuditLogGetSettings(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AuditLogGetSettingsRequestObject
 
 	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupList(ctx, request.(AlertEmailGroupListRequestObject))
+		return sh.ssi.AuditLogGetSettings(ctx, request.(AuditLogGetSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AlertEmailGroupList")
+		handler = middleware(handler, "AuditLogGetSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupListResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupListResponse(ctx.Response())
+	} else if validResponse, ok := response.(AuditLogGetSettingsResponseObject); ok {
+		return validResponse.VisitAuditLogGetSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupCreate operation middleware
-func (sh *strictHandler) AlertEmailGroupCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request AlertEmailGroupCreateRequestObject
+// AuditLogUpdateSettings operation middleware
+func (sh *strictHandler) AuditLogUpdateSettings(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AuditLogUpdateSettingsRequestObject
 
 	request.Tenant = tenant
 
-	var body AlertEmailGroupCreateJSONRequestBody
+	var body AuditLogUpdateSettingsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupCreate(ctx, request.(AlertEmailGroupCreateRequestObject))
+		return sh.ssi.AuditLogUpdateSettings(ctx, request.(AuditLogUpdateSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AlertEmailGroupCreate")
+		handler = middleware(handler, "AuditLogUpdateSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupCreateResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(AuditLogUpdateSettingsResponseObject); ok {
+		return validResponse.VisitAuditLogUpdateSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantAlertingSettingsGet operation middleware
-func (sh *strictHandler) TenantAlertingSettingsGet(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantAlertingSettingsGetRequestObject
+// StepRunListDeadLetters operation middleware
+func (sh *strictHandler) StepRunListDeadLetters(ctx echo.Context, tenant openapi_types.UUID, params StepRunListDeadLettersParams) error {
+	var request StepRunListDeadLettersRequestObject
 
 	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantAlertingSettingsGet(ctx, request.(TenantAlertingSettingsGetRequestObject))
+		return sh.ssi.StepRunListDeadLetters(ctx, request.(StepRunListDeadLettersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantAlertingSettingsGet")
+		handler = middleware(handler, "StepRunListDeadLetters")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantAlertingSettingsGetResponseObject); ok {
-		return validResponse.VisitTenantAlertingSettingsGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(StepRunListDeadLettersResponseObject); ok {
+		return validResponse.VisitStepRunListDeadLettersResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenList operation middleware
-func (sh *strictHandler) ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request ApiTokenListRequestObject
+// StepRunDeleteDeadLetter operation middleware
+func (sh *strictHandler) StepRunDeleteDeadLetter(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunDeleteDeadLetterRequestObject
 
 	request.Tenant = tenant
+	request.StepRun = stepRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenList(ctx, request.(ApiTokenListRequestObject))
+		return sh.ssi.StepRunDeleteDeadLetter(ctx, request.(StepRunDeleteDeadLetterRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenList")
+		handler = middleware(handler, "StepRunDeleteDeadLetter")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenListResponseObject); ok {
-		return validResponse.VisitApiTokenListResponse(ctx.Response())
+	} else if validResponse, ok := response.(StepRunDeleteDeadLetterResponseObject); ok {
+		return validResponse.VisitStepRunDeleteDeadLetterResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenCreate operation middleware
-func (sh *strictHandler) ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request ApiTokenCreateRequestObject
+// StepRunUpdateRequeue operation middleware
+func (sh *strictHandler) StepRunUpdateRequeue(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunUpdateRequeueRequestObject
 
 	request.Tenant = tenant
-
-	var body ApiTokenCreateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.StepRun = stepRun
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenCreate(ctx, request.(ApiTokenCreateRequestObject))
+		return sh.ssi.StepRunUpdateRequeue(ctx, request.(StepRunUpdateRequeueRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenCreate")
+		handler = middleware(handler, "StepRunUpdateRequeue")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenCreateResponseObject); ok {
-		return validResponse.VisitApiTokenCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(StepRunUpdateRequeueResponseObject); ok {
+		return validResponse.VisitStepRunUpdateRequeueResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -9233,6 +12544,38 @@ func (sh *strictHandler) TenantMemberDelete(ctx echo.Context, tenant openapi_typ
 	return nil
 }
 
+// TenantMemberUpdateRole operation middleware
+func (sh *strictHandler) TenantMemberUpdateRole(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error {
+	var request TenantMemberUpdateRoleRequestObject
+
+	request.Tenant = tenant
+	request.Member = member
+
+	var body TenantMemberUpdateRoleJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantMemberUpdateRole(ctx, request.(TenantMemberUpdateRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantMemberUpdateRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantMemberUpdateRoleResponseObject); ok {
+		return validResponse.VisitTenantMemberUpdateRoleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // TenantGetQueueMetrics operation middleware
 func (sh *strictHandler) TenantGetQueueMetrics(ctx echo.Context, tenant openapi_types.UUID, params TenantGetQueueMetricsParams) error {
 	var request TenantGetQueueMetricsRequestObject
@@ -9310,6 +12653,151 @@ func (sh *strictHandler) TenantResourcePolicyGet(ctx echo.Context, tenant openap
 	return nil
 }
 
+// TenantResourcePolicyUpdate operation middleware
+func (sh *strictHandler) TenantResourcePolicyUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantResourcePolicyUpdateRequestObject
+
+	request.Tenant = tenant
+
+	var body TenantResourcePolicyUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantResourcePolicyUpdate(ctx, request.(TenantResourcePolicyUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantResourcePolicyUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantResourcePolicyUpdateResponseObject); ok {
+		return validResponse.VisitTenantResourcePolicyUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantRoleList operation middleware
+func (sh *strictHandler) TenantRoleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantRoleListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleList(ctx, request.(TenantRoleListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantRoleList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleListResponseObject); ok {
+		return validResponse.VisitTenantRoleListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantRoleCreate operation middleware
+func (sh *strictHandler) TenantRoleCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantRoleCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body TenantRoleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleCreate(ctx, request.(TenantRoleCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantRoleCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleCreateResponseObject); ok {
+		return validResponse.VisitTenantRoleCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantRoleDelete operation middleware
+func (sh *strictHandler) TenantRoleDelete(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error {
+	var request TenantRoleDeleteRequestObject
+
+	request.Tenant = tenant
+	request.TenantRole = tenantRole
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleDelete(ctx, request.(TenantRoleDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantRoleDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleDeleteResponseObject); ok {
+		return validResponse.VisitTenantRoleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantRoleUpdate operation middleware
+func (sh *strictHandler) TenantRoleUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error {
+	var request TenantRoleUpdateRequestObject
+
+	request.Tenant = tenant
+	request.TenantRole = tenantRole
+
+	var body TenantRoleUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantRoleUpdate(ctx, request.(TenantRoleUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantRoleUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantRoleUpdateResponseObject); ok {
+		return validResponse.VisitTenantRoleUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // SlackWebhookList operation middleware
 func (sh *strictHandler) SlackWebhookList(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request SlackWebhookListRequestObject
@@ -9530,21 +13018,77 @@ func (sh *strictHandler) StepRunGetSchema(ctx echo.Context, tenant openapi_types
 	var request StepRunGetSchemaRequestObject
 
 	request.Tenant = tenant
-	request.StepRun = stepRun
+	request.StepRun = stepRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunGetSchema")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
+		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookSubscriptionList operation middleware
+func (sh *strictHandler) WebhookSubscriptionList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WebhookSubscriptionListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookSubscriptionList(ctx, request.(WebhookSubscriptionListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookSubscriptionList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookSubscriptionListResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookSubscriptionCreate operation middleware
+func (sh *strictHandler) WebhookSubscriptionCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WebhookSubscriptionCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body WebhookSubscriptionCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
+		return sh.ssi.WebhookSubscriptionCreate(ctx, request.(WebhookSubscriptionCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGetSchema")
+		handler = middleware(handler, "WebhookSubscriptionCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
-		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
+	} else if validResponse, ok := response.(WebhookSubscriptionCreateResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -9632,6 +13176,63 @@ func (sh *strictHandler) WorkerList(ctx echo.Context, tenant openapi_types.UUID)
 	return nil
 }
 
+// WorkflowRunSearch operation middleware
+func (sh *strictHandler) WorkflowRunSearch(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunSearchParams) error {
+	var request WorkflowRunSearchRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunSearch(ctx, request.(WorkflowRunSearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunSearch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunSearchResponseObject); ok {
+		return validResponse.VisitWorkflowRunSearchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowRunUpdateCancel operation middleware
+func (sh *strictHandler) WorkflowRunUpdateCancel(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkflowRunUpdateCancelRequestObject
+
+	request.Tenant = tenant
+
+	var body WorkflowRunUpdateCancelJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunUpdateCancel(ctx, request.(WorkflowRunUpdateCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunUpdateCancel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunUpdateCancelResponseObject); ok {
+		return validResponse.VisitWorkflowRunUpdateCancelResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowRunUpdateReplay operation middleware
 func (sh *strictHandler) WorkflowRunUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request WorkflowRunUpdateReplayRequestObject
@@ -10394,6 +13995,56 @@ func (sh *strictHandler) TenantMembershipsList(ctx echo.Context) error {
 	return nil
 }
 
+// UserUpdateOauthCallback operation middleware
+func (sh *strictHandler) UserUpdateOauthCallback(ctx echo.Context, provider string) error {
+	var request UserUpdateOauthCallbackRequestObject
+
+	request.Provider = provider
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOauthCallback(ctx, request.(UserUpdateOauthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateOauthCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOauthCallbackResponseObject); ok {
+		return validResponse.VisitUserUpdateOauthCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UserUpdateOauthStart operation middleware
+func (sh *strictHandler) UserUpdateOauthStart(ctx echo.Context, provider string) error {
+	var request UserUpdateOauthStartRequestObject
+
+	request.Provider = provider
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOauthStart(ctx, request.(UserUpdateOauthStartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateOauthStart")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOauthStartResponseObject); ok {
+		return validResponse.VisitUserUpdateOauthStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // UserUpdatePassword operation middleware
 func (sh *strictHandler) UserUpdatePassword(ctx echo.Context) error {
 	var request UserUpdatePasswordRequestObject
@@ -10452,6 +14103,85 @@ func (sh *strictHandler) UserCreate(ctx echo.Context) error {
 	return nil
 }
 
+// UserUpdateSamlCallback operation middleware
+func (sh *strictHandler) UserUpdateSamlCallback(ctx echo.Context) error {
+	var request UserUpdateSamlCallbackRequestObject
+
+	if form, err := ctx.FormParams(); err == nil {
+		var body UserUpdateSamlCallbackFormdataRequestBody
+		if err := runtime.BindForm(&body, form, nil, nil); err != nil {
+			return err
+		}
+		request.Body = &body
+	} else {
+		return err
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateSamlCallback(ctx, request.(UserUpdateSamlCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateSamlCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateSamlCallbackResponseObject); ok {
+		return validResponse.VisitUserUpdateSamlCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UserGetSamlMetadata operation middleware
+func (sh *strictHandler) UserGetSamlMetadata(ctx echo.Context) error {
+	var request UserGetSamlMetadataRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserGetSamlMetadata(ctx, request.(UserGetSamlMetadataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserGetSamlMetadata")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserGetSamlMetadataResponseObject); ok {
+		return validResponse.VisitUserGetSamlMetadataResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UserUpdateSamlStart operation middleware
+func (sh *strictHandler) UserUpdateSamlStart(ctx echo.Context) error {
+	var request UserUpdateSamlStartRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateSamlStart(ctx, request.(UserUpdateSamlStartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateSamlStart")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateSamlStartResponseObject); ok {
+		return validResponse.VisitUserUpdateSamlStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // UserUpdateSlackOauthCallback operation middleware
 func (sh *strictHandler) UserUpdateSlackOauthCallback(ctx echo.Context) error {
 	var request UserUpdateSlackOauthCallbackRequestObject
@@ -10475,6 +14205,113 @@ func (sh *strictHandler) UserUpdateSlackOauthCallback(ctx echo.Context) error {
 	return nil
 }
 
+// WebhookDeliveryResend operation middleware
+func (sh *strictHandler) WebhookDeliveryResend(ctx echo.Context, webhookDelivery openapi_types.UUID) error {
+	var request WebhookDeliveryResendRequestObject
+
+	request.WebhookDelivery = webhookDelivery
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookDeliveryResend(ctx, request.(WebhookDeliveryResendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookDeliveryResend")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookDeliveryResendResponseObject); ok {
+		return validResponse.VisitWebhookDeliveryResendResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookSubscriptionDelete operation middleware
+func (sh *strictHandler) WebhookSubscriptionDelete(ctx echo.Context, webhookSubscription openapi_types.UUID) error {
+	var request WebhookSubscriptionDeleteRequestObject
+
+	request.WebhookSubscription = webhookSubscription
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookSubscriptionDelete(ctx, request.(WebhookSubscriptionDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookSubscriptionDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookSubscriptionDeleteResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookSubscriptionUpdate operation middleware
+func (sh *strictHandler) WebhookSubscriptionUpdate(ctx echo.Context, webhookSubscription openapi_types.UUID) error {
+	var request WebhookSubscriptionUpdateRequestObject
+
+	request.WebhookSubscription = webhookSubscription
+
+	var body WebhookSubscriptionUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookSubscriptionUpdate(ctx, request.(WebhookSubscriptionUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookSubscriptionUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookSubscriptionUpdateResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookDeliveryList operation middleware
+func (sh *strictHandler) WebhookDeliveryList(ctx echo.Context, webhookSubscription openapi_types.UUID, params WebhookDeliveryListParams) error {
+	var request WebhookDeliveryListRequestObject
+
+	request.WebhookSubscription = webhookSubscription
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookDeliveryList(ctx, request.(WebhookDeliveryListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookDeliveryList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookDeliveryListResponseObject); ok {
+		return validResponse.VisitWebhookDeliveryListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WebhookDelete operation middleware
 func (sh *strictHandler) WebhookDelete(ctx echo.Context, webhook openapi_types.UUID) error {
 	var request WebhookDeleteRequestObject
@@ -10720,6 +14557,63 @@ func (sh *strictHandler) WorkflowRunCreate(ctx echo.Context, workflow openapi_ty
 	return nil
 }
 
+// WorkflowVersionWeightsGet operation middleware
+func (sh *strictHandler) WorkflowVersionWeightsGet(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionWeightsGetParams) error {
+	var request WorkflowVersionWeightsGetRequestObject
+
+	request.Workflow = workflow
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowVersionWeightsGet(ctx, request.(WorkflowVersionWeightsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowVersionWeightsGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowVersionWeightsGetResponseObject); ok {
+		return validResponse.VisitWorkflowVersionWeightsGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowVersionWeightsUpdate operation middleware
+func (sh *strictHandler) WorkflowVersionWeightsUpdate(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowVersionWeightsUpdateRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowVersionWeightsUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowVersionWeightsUpdate(ctx, request.(WorkflowVersionWeightsUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowVersionWeightsUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowVersionWeightsUpdateResponseObject); ok {
+		return validResponse.VisitWorkflowVersionWeightsUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowVersionGet operation middleware
 func (sh *strictHandler) WorkflowVersionGet(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionGetParams) error {
 	var request WorkflowVersionGetRequestObject
@@ -10749,203 +14643,307 @@ func (sh *strictHandler) WorkflowVersionGet(ctx echo.Context, workflow openapi_t
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9eW/jOPIw/FUEvS/w7ALO2d2zswF+f7gTd7e300nWTiaYZxAEtETbnMiSRqRy/Br5",
-	"7g94SZRFSpSv2BMBi520xaNYrCoWi3X8dL1oFkchDAl2T3662JvCGWB/dq/6vSSJEvp3nEQxTAiC7IsX",
-	"+ZD+14fYS1BMUBS6Jy5wvBSTaOZ8A8SbQuJA2tthjTsufAazOIDuydHHw8OOO46SGSDuiZuikPzy0e24",
-	"5CWG7omLQgInMHFfO8Xhy7Mp/3bGUeKQKcJ8TnU6t5s3fIQCphnEGExgPismCQonbNLIw/cBCh90U9Lf",
-	"HRI5ZAodP/LSGQwJ0ADQcdDYQcSBzwgTXABngsg0He170exgyvG058NH+bcOojGCgV+GhsLAPjlkCogy",
-	"uYOwAzCOPAQI9J0nRKYMHhDHAfLAKChshxuCmQYRrx03gX+lKIG+e/JHYeq7rHE0+hN6hMIoaQWXiQVm",
-	"vyMCZ+yP/z+BY/fE/f8Octo7EIR3kFHdazYNSBLwUgJJjGuA5gckoAwLCILo6XQKwgm8Ahg/RYkGsU9T",
-	"SKYwcaLECSPipBgm2PFA6HisI918lDix7K/gkiQpzMAZRVEAQUjh4dMmEBB4DUMQkiaTsm5OCJ8cwvpi",
-	"6xn74SMifOGWkyHWw4nYV/4zo3aEHRRiAkIPWs8+RJMwjRtMjtEkdNI4Z6VGU6ZkakFalCy6tOlrx40j",
-	"TKbRxLLXlWhNO74EUdiN476BK6/od8puTv+MrSbFkPWhXE+piDg4jeMoIQVGPDr+8PHTL//6dY/+Mfd/",
-	"9Pd/Hx4daxnVRP9dgZMiD7B16aiCgi7ggr5DB8VONHYoZmFIkMcEnQrxH+4IYOS5HXcSRZMAUl7MeLwk",
-	"xkrMbAK7T0+ABEixPydNQirAKrhWUE42BJWGopMThUxyK3RVJiQmDrW4oV8oQvgQOYxl6V4rToXMlYup",
-	"kGFXOZHOibIYfYswMVBghMm3aOJ0r/rOlLZSYZwSEuOTgwNB//viCyVO3fEDYvQdvtTP8wBfCtPE04f7",
-	"nHTByPPh2Jp8BxBHaeJBvRjnMtHvGlZP0Awqh2IixnKeABbitCC13ePD4+O9o+O9ow/XR59ODn85+fjr",
-	"/q+//vrh0697h59ODg9dRV3xAYF7dAIdqpBBICCf040CTMdBoXNzwwUEHVoFaDQ6Pvr46+G/9o4//gL3",
-	"Pn4An/bA8Sd/7+PRv3458o+88fjfdP4ZeD6H4YQy+YdfNOCksb8omgKAiSP6rwNXc/yA6CT5rqqgG3jj",
-	"OnqAOvHwHKMEYt2Sb6eQsz8lVkK7O6L1vvUGzyABPuAkWXNmFCjYKFeu5+RKBtt+cX+PP32qw2EGWycT",
-	"LxkytEj0PBgTriMM4F8p5MKkiE+uEHDMLkedMxSaibXjPu9FIEZ79LIwgeEefCYJ2CNgwqB4BAGi++Ke",
-	"ZCvupCny3dcSIXF4dev9nAYPXAfrPcKQGJcMH+VdyEpf1QxZq7nyGe5eO+4pPYcCC4D6fhGkxtuRX7hS",
-	"xm1NtsdqQRRCtqQo9NIkgaH3co5miAxJAgicvPDTO53RDqfdi9Pe+X3/4v5qcPl10BsO3Y57Nri8ur/o",
-	"3faG127H/e9N76aX//Pr4PLm6n5weXNxdj+4/Ny/UPY4h5JvhhQPZoxyxuiHeob00yS/1D1NkTdlvMll",
-	"BsIOI8d9d3EijmaIhCjoyIkYQvUCosvFA9eJl5IPbHwdY8wjDcdRiGEZa0SK3DLGCmBVg8FHMcNxmkTh",
-	"bZQ8jIPo6TpBkwlMjPsIfB9RKEDwQxHMpYG9JAp7z3ECMRY6ZYlwaJMLsQHlYz2MU6IZuSR7aLOODipl",
-	"ghI4d9nSq8WAfrFz1JK1ceRxkJEOY1Jlf3L86MdinGA3wINOP6T9H+CLsbuBPrgayUDKMTO8GCq3AiOK",
-	"SBQjr5uYiHQG/jcKHXkwO3Q7nH90Bxf/lKfv8GLosDGWYe7shJqh8H+OOjPw/D/Hn34pH1UZsGZe4MaC",
-	"bgAT0psBFHxNojQ2SzXaBOtESIAwoWvkLeSVNMGu9X1tgeX76BF22IzltQtQ61Zeo5zwwbV7zT7JbaVr",
-	"dUgkrBsr2Vu5ro6bRAGs0xH4an7A2QgmA9peiw9XDFaHFSM+7FRMbkVaBRbYMnCQTvST0i+rn7QjLKVM",
-	"mL4aLtYMKD0e89MF28rY/NcrpXXBElU8bLT8pFguylaH7IhpNNcS15EZJNPIr1duFXT94F0UVaUsMzjb",
-	"+tqPT2Kgms/GY1g2+A0m9ODUDmO+E2Wg6Qaam70Aq9jSfAMz5NUS2DnSsWkMJijMzFtV6L/KWmZaGZM4",
-	"T02uJyrBW5nhdJuu6O5nvS/dm3Oqk3ev+gYtXBngMvFh8vnli3zEkMOEUhmCpYt+PhLTiDapCi2lySzF",
-	"kCR7GKg/SeZZrQxu/6woeecfhMRzkXEhkv4HaThMZzOQvNRBxrbqttytgiW5qpct5E5u+BnQGf2aaKnO",
-	"P/4zvLxwRi8E4n/W65yZtsmm/74cDcgxtoD5s+WU+V4Cui1QVoAoJMgZSqAnQZJSBGDP5Q/FZvlhkkAW",
-	"omcIQeJNtaeRid5LuBwDpH2wYHpZSlVCyqq8lZOkYdEcaX4dj2HoU1hqBhbNmoz8VwrTeoh5qybjJmkY",
-	"WkAsmjUZGaeeB6FfD3TW0H70jA5xla1QcwVh3/bVO9YCXLDEmWIWvIoB8j/RSCNqqxwvmMRVXC/EOfNn",
-	"NNpfk8m8NCYmMLaXL0MCYx1iK5VVgmYwSol++eJj3dIfl1VUHxUFVd5s2NJ1mud/otEg1TyJeMzEHMh3",
-	"ILuHjqxT5gFkbjKAABvuPGMUIjxtNvWfnCKrdpQSLW9p2L0liC6BOA2I1o6ICUhIs8VgAkiKLdZDTxDe",
-	"VtD3IA2bkTjd/OZU7j3ApJoFmixXURvrQFaOzrmey1/s+CCSQLJdMHPNMNsmqRxc9S7O+hdf3Y47uLm4",
-	"4H8Nb05Pe72z3pnbcb90++fsD/6UQf/WaRFUvdK7Ndg6Q8131WyxmISZ77HZfr9RpS57otXqdRTiok0X",
-	"vzG8RWhqX7wU2MREOuJiywyA93ALR9MoenjzRSqwrGqJ0eQchbCRjwY9Qtlnqj5QeSIP0iCaOAEKYZMH",
-	"ee7IqZ2DDica1Kompt68hcZWMIct1Xkh9y7NZrjLUXUOH2FQNKh8vqHipX/x5dLtuLfdwYXbcXuDweVA",
-	"L1OUcbJLjdX+FyDQCRLx/e3vhJKs9NKDf1ziXlgcoeHNUHSuuBtqEKA+2f90+QM5uY8Z7R533BA+y399",
-	"6LhhOmP/wO7J0SG96hU5q9BZ59kjWjgxp8Js4mOry5QCi9YNDj6XR/5gN3K+Lq1DUkRAoF5daVNmcQkQ",
-	"Jvy5IHcjP7S5u2kk1n/pvfUHJAnyNPI4TGdXdhdrRsfyer1vWu9/re7SfCzE/ZPYxdo44MDuEs1HFFfp",
-	"fT1qCg8nGaiFWToqQnTyfwAIZG4eZVRa2VITKv4DOoBWRAcAkwEco8Dwzsf81IQjmzoYc2JLWEfIXDXW",
-	"4O3HJvoNBKnh+JmBZzRLZ6plg7/cYYc5SAtTrNj1JxT60ZN+21dh661B9KN5HVKaaNYxAz60XQT/pp+C",
-	"f2PLoHuJQsXtJkczd+UdR4kHfVtHAuV2oOyXXG8GVYHS7lS63oLDMOcx7XGYfV7iQJwfo3QkcmxKrCmo",
-	"1I4GPRiSoXKLnXu/YeCZ6Jl/dXQuVqrZocm9dBE7xBI2hLUZCgRKc0tB6do87+ZXzSPZRnTUG7WAZX50",
-	"rfiH9K/340Q6gHEAXv5W/pp8SYo5BhtXVqCHt12f0vzT4WHNeufgNq3aZDhRutsL7Tn7li18ErqEcjlj",
-	"9gq2auCWSEeds3FoBpxATG4Sg651Mzh3SORgGPrMU05cc7FDovU8hpsOiDREf1FtwIchQWMEk0ybFAqQ",
-	"CGrgDn1qLNAIBlE4kRDXyMrOOv0J7QyalT6CQ28K/TSACqUt6ylrIqmOS7grrv2R1sQ5Nh/8TlmXvyrD",
-	"rHAmp38MT7/1zm5M1tps5vW6iG2ps1d59bnHV/UrQlPaWJ0v2CANT1VDY+NnCg7Apk8vBQCbJQ6tlMPb",
-	"Uoe3dJrLiaLSX65MdFtw4dLIASvPOSMHNXKfK49iupSpOK62WQ7hDMTTKIHDICIrvpEVbjv6x3JugsBB",
-	"xA0zooe9mX/B25F4RzUti352klQurF4dUB9E6xeKgkB6CtivtCSaNMYS0cQe9DkGz9HSUW+A86+n8tWU",
-	"ko/6cFR+6pmCMISBCV7x2UG+3jKF6eDOEx9df+fnI1wY/dvlFMzPfcFJllJXwcy0evptiaXT7uZ1s8GX",
-	"WfRWKNp2qrBERIbuIl10FDLUHjQExia5p/dvmaLAT2Dxsb7mnr0mn5QYJKWY1FpIEgh8MAqgaXPl9yw6",
-	"nQvEWjJZylXKMIOZApRVFMhBunaIDeSvVhVbvwbXqC7pxVHhBVCxdq/IgYoR4a3J/lBLA4Xu+DRKQ6IH",
-	"FxqhXMR0mvepwND8XbPgAWbhQCT83bL2q2e7KCUmEBfkSPa01x0TmNgjc+UOabxLxc4soW3Z+mLStiZx",
-	"YiFrmqw461KxYqr6GPzgrA6njAKzlVU6nQnUdRNvih7hTsql5pfurRIxEb1R6TtVcH0CSfJSIUXXxo/K",
-	"NWYzLFFxY1CQIPGov32a6H0bLvhFBtQ+q4o2hhA0z0wFZuuqr++gOLFpSE7yoMV6xLsU60HpBj7CBJGX",
-	"Jr2Hso8V3X1BCSZDyJVke9o7B017NXQP5reMAoBzM2eYVdCkeu7x/a0g5m2JniqQaS0h5yJd2pAGPW4c",
-	"v7+4vL+9HHzvDdxO/uOge927P+//6F/nxvP+xdf76/6P3tn95Q2zYw2H/a8X3Lx+3R1cs7+6p98vLm/P",
-	"e2dfuVW+f9Effisa6Ae968Hv3ICv2urp0Jc31/eD3pdBT/QZ9JRJ1LmH55e05XmvO8zG7PfO7j//fn8z",
-	"ZEuha/pyfnl7P7i5uOdZZL73fr9XnwwMTQSgWnOajmMUpCqunGKBg/51/7R7XjVa1VuH+Oueo+FH72IO",
-	"8Q3eQsTftLUOmDxB5XzqTJiIFAY9Q6KJW5mCL3JYa2klmLFeeF+bbw+EIHghyMOXMblMScWoudlhCrAT",
-	"xQT6jrhaZoPo51h72i5TeoOl8yPUJ/kypjrQJg/ZbNaQNUWvmZOHaNe8BUJavxe6JCuTaI+TnDtgzw6v",
-	"xVWhcDKEhP4Hb45FeeKD3nOM6C6zsA4GTPX4vBefBjtPLPsei1BxQAIdEMdJBLwpCic8DR9DcNX8MvkJ",
-	"JxLmrLYgFHzJMt9hGR7m3VaJC8Ui8wWgIE2gBSjMcUIFRDXkYxYBrJ8zAJgv1fzIkvvBglDsLHtoEUHq",
-	"lh5v4FkS2Rdmqwi9F6NrqzOWTRxApLumoKrV2tfNkkALsFku9DM/tPXkEXrNUi5WPhDJhJsi2fImk1Au",
-	"lqyo7plAMJTpkUN+NmONt6h65mAjFDLhLXBiFrIs5XulpqCooZ2tOUoEKTc7QfieluF/M4Kyz3ZCWa+u",
-	"9Q2GCe9xlY4C5FWRAhuvIt+WCvPWbLrYv0U2fSD2Sd4sLm8v2O2oe/ajf+F23B+9H597g4oLQXXUDLNr",
-	"Y7NLk87qUXYxiwgI6jBRgEMxDFTN3WS8eV/KDAGS8lUsZvfl3m/8RqbeJNmt7/JCcTqrQG9BrdFpdiCZ",
-	"VYSasO8O887Xy2AeFEMi5wkkLGVDSd/hvfWhG82icPQBOKuJqeFjm5eoh3+5dADZttdzaEYkdhE1dRvW",
-	"PJBmBglMZDiNPCr5WM4/0D7cd44cH7x0nCPnCcIH+t9ZFJLpPxd8lc/Qow2vMUtWiairKECeJmkOV8Gr",
-	"bqVZunLeVKMXNJCsRfarc9cWwJlXJww6a5eZTDpxH7ANOAEb/cpvWLL295isVF15TRDMSvKEGvUVFRDz",
-	"/u+wCa+1QbytDWKNtoG15E23ttC+GrnpljkFmMNv8BVIsS62WyV37lngIOzErLUDQt/xQBhGxAGsAgMr",
-	"7SSzkc0jXgsd1l3iao0YwPcTiLFqzCjoZfJ2XLZp0A/fAJ7qpPUU4Kk65P/Bc9MJ+c1VG14ZaciLDDmn",
-	"U0CME/4GEzRGdehlJhkqSx5Fc1GdqwCDnqKnAJtrgGnnAFnRLwdDssGnBh/hOAAvBYKW+9fY+lHE7p2B",
-	"wIpF0swpp+GTGYmMB+FTjjWpo+lhX+DYzoqwvTKfrCpAMiAq8bccDKUMOlmJOBVPJpSfRxMULp7sfDH+",
-	"Xir3+dZhXK4xrsP1AE4QJhXSfRvRbXfSGQTDFu6WLFNku2mqeoynKMa7apkrWSo3eJqv45Thk+m2TcSM",
-	"cFVqpZZnO2YQsQ9CDdOyRWqKd5Z90yRY5GGejluLEh68uGRFB4tFYugl0PB2yL9lWXkED9ObkNMfsxqd",
-	"cRI9Ih/6HQc4CQj9aCY7sSCnEXQmMIQJEDFdavTj8dow3hzN/nYS4GJ7s2lSzuCsRTaVyluShbIofqxi",
-	"OAtdjIwp/F3vATGmpIfsqpfnpuJDqUUoGz35WgRw60DPQ7i5P/qptnQ3Bfnb9fWVwxuxmt2SghOBfIsk",
-	"YgpWMpgLE99ZIryahGT6KdMTAbcfSpqXra1NwloKWJh2yhHAX3vXbse9umR17a5urpkN1XRC8vgmXBWX",
-	"i/mLgbA0eCB0YphQutpv5KkFHgEKwCiAMsyoJkd7eVr4DL2UQMeTFQBJ8KJ/wqCqBivjk/RrSqay5xE0",
-	"CaHv5J1WUTx1yQj+AIxggKufd1gbxlL5cZAdA9ZJZGByTsfRbVkAMPkGQUJGEFiEJYutYq91mAIInKns",
-	"va4ceYAzM1UPepiAUcCiNrYQ0hl4NhO+JpXfcgywfr3DrG8kpexsuuBQ2iaLkM+f1xoS8FwmOF1QXBrS",
-	"LemH48iOGwZKB+ZfG5lOAiyTHvCAfM6ICy5kLoGCZiF51Jwu0wA7Vkt7I4+E7ul1/7ceywGc/XnVvRka",
-	"3M/5DzbIuqYtXzuuOAyNKQXEWSnq1ReBrM2LIHrf1GmfN4NzzfBNlVHWXqtIKMKyWTJSmX6adl11boAK",
-	"NwD+/F8zeXX5pAo8vL1txKh2Z0AOisw/5wMAwkkq4qKsxcLw7DvmBw/vLNLV6IMA9YqRkEi9Z5IAffkR",
-	"/8E8bGlxDCJV/bs87/KYjt+vvzH/oOvfr3rD00H/6lrL7QonK8MMe+dfvl0OebTNj+5Flwfa3PY+f7u8",
-	"/G4cSPpKLV/wRb4cahnG/nGMPT5mz2P6R5U/o5FBsNIvOoCs6FOUEVlZyEKTs9mIOWlK1ahHYLL4WrOS",
-	"ykCr/ItKM83TGwpGyDKhVPnNzMtyk/Ci4ypVxMvkOoFE+Z4Ftsy9TYYybxF/gJ5AghnuvLyrM6F9s0NJ",
-	"eVLfN3qnqfXMKysvmuqgN1c2c32y+F4/X7/qw3H9HV1OPb+ajharVVvUP9M9CGcA9s+0OJS9v6OwcCv+",
-	"cnNxet1n8vDsZtD9fE51oLPu10pJRgeRB10jsmWza/hAftefnkulcNnwwcsEvZ3VQrQ2eqoxJvkO88h3",
-	"jWyaS91f5rEH+IL1dyE5PCXLiinm7l6UZ4GDY+ihMfLySZx/xABj6DuPCDhjFBCY/NOyMsBtsXrRyvM+",
-	"igcWY8a/zK1FzUh4dKikt11bxpbFUlLytBf2dJmnbFnhmctTsbxNHkc+91CNk980CGvLNa5NJ2mTBxT6",
-	"n18aDH6t9ConrGyoh6w95WWWG11d7F21MNmSq1hVNuoq8KvKCnSHp/SY7g1PK8/pfJSKWjsqLRekmCIZ",
-	"ayYZTkEMW9ndyu5Wdr+l7K7J6vw3Eu2rzU9eJ93YZAvdd4qEYLj0zG2o5tE7Cq8UjtVkBYtCmb1Y20AU",
-	"nlhPfszbBUuI1mwxPmX50BYpirHOGh7zNS1qFmG83LFER03oSA51yjvWaQ9zzUvzC37QhjtJXtJ+FDyj",
-	"/SZZT/sx50Z94jPjaq7BRIe/gJ/lyxtNl7Ye6j2kOIRVBCK4/jShGuZYz/gVaTDvkYHd6iYUKanGhho6",
-	"9+LRZtXTYv0Km2vTc3jTiNa8QPwiA2f4Wa3Wxc9BPfryo/Fe2ISbo5mHz6wgcKb+baAKDEXNmGfZgm3Z",
-	"ZkNUczTV9uEYpAG5SlAkU3/p2J81cmLRSsfAtdbb/PHjjZ40skyZFqBicfZf5xmhNQos8h5eTM/k9JuD",
-	"hU3a7r1E4ekGrIWVVw/D6yz/aAWEmoXA1jBbqSyblVgJc557Uxnorp4d2L6u0rLdhEDeFcL5u21u0i5i",
-	"fJxA5ktSkU52Bp5rWjRMi2lKasmdkFMqpKj6PuMQjiBIYNJNCQv8Yxhlspf9nG/KlBCWysyLogcEZXNE",
-	"d5X/JJ/7Ttwpc99TYv5AjL5D4RGAhBOAxjOVd3O6V32WxpOwK3rx14yy3KP9w/1DRpgxDEGM3BP3w/7R",
-	"/iGLMCFTtrQDEKODQORenuicr7/K10LaKoQYO9n1kO4ikOVS3HPx/Stbl3SWZbMcHx6WB/4GQUCmTCp/",
-	"0n2/iEg2Z2Fn3JM/7jouTmczkLxwCPOG8t34DzG+N4Xeg3tH+7O1JhD4L/WLpc1Q1WoHssEql8uAYwHC",
-	"PCCWJGA8Fvl7qlafQVu7/MejAyCil/dYsMoeey/CBz/Zz+pvrxzGABKNLn7GfscOyErtsiB5HpLDupcw",
-	"NpcQgY/AaDEBLFsHBbsi6VVpBoddJRl/UXrOuau0FFflfm4G5HJx6bvp611p7z+WsTVMPQ9iPE6D4MXh",
-	"KPULdYpLyHvtuB85lXhRSETiZRDHAfIYRg/+FNlr83XUnFYszbkIu5p/qp6BgGIB+k6UOCPgS1dxDsaH",
-	"lYOhg+JLlIyQ70Ouy+b0zemkiswkxYskWXcd93kvyyfAqkPxDx0NYdyxSxTxNCHdXHlfhsT5CH8PEmf0",
-	"8DnisnMlxGCRLEVDJpXYIpGTSpwXsfGqF9ErWYghp2kZ9oIY4IC2YsBSDHBqWZ8YUA/IGO3x5CgHP7O/",
-	"2WkYR1ijNAzgY/TA8o12r/o8rYpwyshmnBMTMWJ5W6R5gHa3kRLZ8AaZIGHdquMuYcsTdM6g+3sTNW5C",
-	"1YJ06MZei52TZJz/VkXJ2ZYXKNgLotQ/UK+yZm1Xtsp8/+R1gg3ioBATELK8ZkUiPqWf5SuyWQleP24Z",
-	"IE4aZmFbW0NgNVo7R7D6LCe2/ofyIPO8J4fYi2L+pi1ONGW/uXH14Cf772vVflMpxVrtlzaU2Vj5RtZK",
-	"IjaEUTlhXzcqhFa32aJ8RM3hnUCSIPgoxBrHBtuxVrYVSFzBTE7eHMUVUo3Tz52Zwg/qxBrblkyq1dD8",
-	"WSbA3jvdnzESbml/u2h/Bhc+w42n9+YObpG6vglNZUfijhzkqzjC6RgHSuFYbNzxc4TpBShwCq1NG0xb",
-	"94sN17bbdC6x48qUDTdfZi0orG6bCCHberYRc5tQ3v/CJkchIhGV5gc/Oce/HsRJNILmy6V8pXNA/hBM",
-	"IofZdUUhWTWi1szw2dRXESaDNLxi89rbpkyHXia5NnzqVRCUiD7n9MTwu7/RU+EiIg5IyTRK0P9SKCKZ",
-	"h4LHyYtKvfNmTgJQAH2H2+0dtj3OFyHP+/m26g+OApmxatcHP9l/LKz4zlCtjl2iHLXkub3RvjCmkXgY",
-	"iFtpnS/iZJtUm6PNgHET5iTMJ/60mYl5nhiWbgsEQfQEff2LwDzVStHLfq9SsTjRFTkmxAc/cYituKVY",
-	"4b3MLyFuwCZz5eKNjCJO7q1jkzlktIyyhYxSItiMVS6GlYwSYg2bSMVFsTbpVRc6r7wSl1ik8dvYm+kf",
-	"HbMh4AG+LGoJUGA4/vSpAMTRKnSgOInoP6DfnmFbxJqmSyQi03TkgDiW1F4+1nibOX4kMN5LUnZ4iT9f",
-	"DwCv5Vx3gRStZPiwyG9UZlUeFsSudnJgC6aV45kPNAHvphlXBE+TyMEPKJaw/ZXC5CUHLhqPMTOMaEBB",
-	"IfnlozaOuno6XrBn9GKYkn1uOOM67YGaSuULGAbxOzcK0lk/bmbWAtc9AcyEzzhKQ19ntiiwv8L8mWZA",
-	"fxqklY+PGQvXy6Tc+98skXibBvKoxwdtpdG7kUZ5qflWFv19ZJHC+OuXREE0qZZD2AmiiROgsKQblZ8P",
-	"z6PJOQr56diKoe0QQx1zIbUAPsIA03l5OpyKiVnLwsyVDx+CDmgvntfBsHIM6cHrsNkUOMZRYgCEd2gK",
-	"yJD30gBxy4ouRw6L4DCvP1JzVDScvJDfwoAHPr2fJdKohOJMabYIJHn/9R5SqjSoO58oSbaHk+H1nJ0K",
-	"mRRWzoLzaNL8GOCfsdlOxWsiYAewGk0Gn03uVcqbuutxiOaDF0sXVntAk0hk0H8Df+daEhf5bBQH59ad",
-	"OSNxvtc5sdU5L+soOjPF8iRKFUEMzAPqGWGCwkk1ge+OWXYDUQl2TJhHM75p/EHLjysLL2gQTFDJl/pQ",
-	"u2pXLpBpq6ZQB1wXdmR7HdlSx471xeQsYDkwb0LLOwV1rYpa7Zmp00BFax6Pl2lv7/VwUzXM1YXcWaug",
-	"R28cclc+AduQO1sddamQO7tT8gBDQv+L68PzZRdHdqkOuFPIBYWToehj6fP/To5JBTFLnJHqnrSsVPAS",
-	"N6JpZXyUxa1WP7RlYaTYLky11Scz13aGD5wnH27EJ9J/u7X1zSuPWawrbhYAW6cwLhCT3eqIDAGS1hW1",
-	"cJ0mjPlJW/5aFX8JRlgwwrz6wLHw6sAsUqng2sF7G2Ixd+Wsec/PqA/wxeoRlbYrzGqVuJGRAcuFVs77",
-	"a4ZJKbZjBVsuKxoDqFT9WQzEJA1F1Ba0glW2tX7+1GfKfqMnabafb/MgzabegudoFQ71MbqCWLKI3gf4",
-	"IsojxgAlJXrJEvX/Qdnt6IQ1PeI1EY/5v46peNetR1MMQssMtem4zcuQ8fJWdC5yohtYcrUpxNceSt96",
-	"AazkZgClj6dlAL2tCbkqH0R7BWAIEDm3K83CnL/fxg3BLlOLavPlUTnv3gv0+N+bmVXmRxbqKXz2IPRL",
-	"QWrigiIjpqz5vP5icjBKgwez28/nNHgQ5IFzmYArhQLt844FA11+Q+GA31I64ObiofUS3zL5wNhUFRJ4",
-	"xVLCY1VtKtwD2XduyFAKbhZUXJPU4G4lfIT3rFAwBNgrFOLCkMA4AC8rFxtvVrVoPtl8jWhiSIN+TnSt",
-	"kNpWITVglLoe+cTMaJY2Vm6bs7Czfocv7bNebmxc6LbOkN3e2HU3dkfYflfJB+I0qEjDTL/jZkfzQB4x",
-	"7/Vo5gjYlqN5NWY1Dlyr1b+3AxOFj4jApg7WspfeaazPvrZnpfQVU/CxkJeYxHbrG6Zzn85pcU0+03yC",
-	"Slpvzd+KlzRHiZ1zNMftm3pEc3AXcYQWhNGypd77OeOb1bhqCj6XP+zxfzeruGXByo1rbG2XP02Rr6ph",
-	"28vQsetnay33agqIbRn36rIQZvtjit4u7mOTwlwWnLDj6Qa3kBPWG3q72Ln7ZsG3lpyrqfm1zZwrgmIb",
-	"c27VyTeDs5EoUtzgjiZ76Vn8B/va3tEkNSr4WOiOJrHdKoO6O1pOi6vRBcV4Bz/5HzYpqIEAwhkn0awu",
-	"7I1Tw99DFRTLNsHGP28+UfbKeXcRHfB9cO0WZbm7MCS1y5i0sDErkxd/pTCFezMquD1cWwSLtXZE6+wV",
-	"uVJgfIXkv7TXDzHFLsqMnYoM2CVn7/VrLwXaWywCzBFF8CXdtzLxrWUiFUfZ7swywSIlouScRWViAgjc",
-	"Yw9ONq4StDV/nqrzlRgAAs9pwzYubVvj0lYVw1SLyXVGKmV0tgXRSvOwbCp9ZpHXGjjjKOzceuPM3VlV",
-	"3OTilqLaOee/LipxRY+9OAqQ91KfskV2cHgHm4Qt0pXgivVo07Uc6NCymIlnbjdaU8/Gsx7xKmSViVoK",
-	"Fc5wZWG+1vjJc7SoOGlye5hDdVsraYvKmCm8YKi2WlPyz4IRDzABCTGy45B+5efYZTclU4ddVuYZ8gbD",
-	"hL+ZMIAuKUJZz13kzA+HxzUlxhjKxLFSwMoUAl+88QQRJ5girczP/TpXHIuSXfSAIB2UJT8uVMtiKC3O",
-	"KAmB7sDCdFCXN2uujh7WlbVr5bCQwxfDQtXpBpJ4HsutLN46WVxmBKuKkrXpuixKq7beiQwBRf6qzNK1",
-	"OpotTmrtZdjWiN1ihjZyniVHV56ooh7H3iaerESJsF17uVq/uUCHmGY2g6xuVWFn2keVbXhUyfam/Kiy",
-	"pH1CUz2tknXzQmnO6IUzlLZ0447Y8TrbWsFtA3UWF5QPrUTYugKLqohYSVFFKzlRm1OjSwicxSI5DGtr",
-	"UfN115JptBKkyoENYebeL0QIJ4Jg+y4Ib/yIV8com2LoBNKOFbH3LEmJLQ+z5i0Lb2M2gCQNxVbVBF+g",
-	"ME6ZPwR/3NUt93UrNJU2F0CFfGEb/hYCJV9TpS2AN7MsCv8VkiEfthUtb6cdNMtyZbA0iOHaC8U2Xyjk",
-	"Lq1Faoi3+L2nKHmoChjL3TqNjhKtj0Tuos5RccuQShFSVWuDIiNzo+cdHbkdrRF/217lFPJfPFWIGMTE",
-	"Qu/+9a3APxwbGyqRo5nZb5ToQ25ty7nb9/ymMt4ixnoulavN8/SE5MK72vc2Pxve/WGZY6KtRLX0VVOG",
-	"ABVjpzmOF32kkojm18vmGSLVmjyaRJFKIZ02XaSSLlLBC64xExWqHr1d8kgd3NZF5hQLUoFg2uvpViaV",
-	"LO5ROciw+oLaROD8VP9Z9zpe4ITaE1iQ6S4/ls+xvh40FYM7rCaI7Vo0Xrl9PDdHCxft0vWRwp0iTS3O",
-	"zwfsiaPWRM0fQjhDq0Dv1/B1n43eMvfbM3eeG+FKKQ3BYVzGml3EEdvu1qC9IYP2rYr70CYrQb5JTVWG",
-	"1UkcPAUxXJMeMWRjt/JmZ5QJvmGtRvE30igyj3iL0tmFqtlBkL26YY2uUcX6LByLP5D3ZLr9VgasHMBz",
-	"gInTP2NJK6fQCYDcQVPyE4BJ3zdmP/lwrMt+sgHPvSZlNlTJ0/rWbOmL/QKyxP45304WYquXCdbSTqN5",
-	"l+mYfDgGaUDck8NOQVRsIjFTNvenRSbn5d+d0YvDJtBPKj6Zo8Q3oXa1jz2r17dWmegtG9OybKcDnBEg",
-	"3rT02FOlMb37ep3qOwlHhq0zsPBRLz+VvOsinkH7elSTdImTzSZebvCBl0RhvUZCWzl/RqMcKJKgyaTW",
-	"feI0icJ3rabsTNbIbGORT6edQJKpxPs1yYFNF7dVJy/epczAFbkqRy/OWOTDXFnKTJXPsH3azNHL+jJn",
-	"KsfmhnNnFpCxhA7bHkwaPbZ0EqxJoaXH0sFP+p89+atdMYjyUWX9NEAJZ8dLQ2SrN4FVwOjmi0NYVnHQ",
-	"bmKbl3O+qoIeTc2s+UWCuHvtVD23Lclcu+zAs8Wctaajsz02d8H03eiwXoF8sDu/GQ3Y2rlV43v96317",
-	"j9zme6QsjG97iWTt13uD3OrrLQUuBglFmuFFdw4s3vhWtfFtCD5NPLYWNvF2uimzQAFtmACSYmhV3Ei2",
-	"XeRKO2R9xeXSBrgHFPpWULGGjUH6jkK/Hpqdt6AQNIMOGFNASz6FTwDLED91Ce7x4fHR3iH93/Xh4Qn7",
-	"3/814F5079IJ9MTrAwL3KBSuba0+CvEIjqMErhPkz2yGVcJcgeUxChGeLg6z7L9RPK8K6JVien0WwbL5",
-	"7d3aA+d1x/ZasxYvwvUYApnjoE2yXOAI0OhBV2R/NXuupX/wLpd7bNXwVg3fvBre6patbvkmkQF4yfKo",
-	"TAC1abzrz/c1lCrNz3kKqp8G9HissRpmLRexHw5l59aKuM1WxPXdizIC2Cl3iVaZapWpnVGm8mXkonol",
-	"tlmruvMZg2dW2g0Xbi9LmNbqsFqtxKABrFcvOfiZ/blXynRS65WkB7mhzrLjvkkaHBgz+2pRvbXuSvrd",
-	"bf2V5v2VDHhq5pBgoI0az6WVMOBOV+vZKe5b53HcHsW77te0XjlipxhkyQxe8xiaynqewAnhkzmSxj6Q",
-	"5pp32J30w9W3VzUKVp+9oBK0jVYa1WxDk8ogxs3faPrHZk6eatZkM/ytWNx8+cOtSzkpBF0Vla8niFGR",
-	"xQU7sl4eS41ASGR7fbCkSgzSsJXCm5TCcgeUDWgif416wwZLNTVXR1UJ/C5vmq34tRK/QiGp04lXLnJ5",
-	"HvM9L0pDUuOiw9rIrFAyAT94BCgAowAy6auIG/1t/CskPE86PmUz7rzorUvetePJ+wqbteDVm5MKJ5/W",
-	"Gm54oy8gabGUfkX2TzFM8IGXJgms5mzMbwe8oUO7lbj3BsPkKySnYrA10h2dqSGdMYjbUjBvXwoGemmC",
-	"yAsT414UPSDYTans+uOOiqq54LYiuUlyZ9uvIeMJItN0dOCBIBgB78FIzqfRLA4ggZymL+n8jvY8ohPx",
-	"Qhhf2dCXFJencvg5Av9weFzznuCJef3yvFMIfFH1LYj4ZmirDGZi/XUOmQXcyQUW57BEHyYgMYuCIf26",
-	"GOJY1+ZYY/CsH2cMuoYIi6JJANdDb2zovzm9cfStmN5yxP3t6A2Fj4hAm9KQUhvmHZjSbXV80xGuWd++",
-	"mGuNp7g6kZX/RICw3JjiAlt90fpYZdlR57CXU9615oZYoL0D4HkwJmbLW5d9x5mFTUxSojZ183kfdz32",
-	"JD44n6i+dGEF9fGV6+iv9QLI6/czJJX23p6+EsjyDFbUNKPfm9EX7+Ouq0IYHXwF9MVX3tJXTf12iqQF",
-	"6CuIJig0k9V5NMEOCh3Azsb9CgXjnA20HlpiRzAdf0M1Vq3u0UE0mUDfQWF7fd6q63PxWKdUY3tPDqJJ",
-	"lJIaZohSYscNUfr2th5Bo9GWVRxqibRGGWXUY0u2MzgbwQRPUdzgCqR0srsG8SPkR95NhBGtlcD1kza/",
-	"D6koau9Ei9yJVAzWk2QMMH6KkgpPBC4mhSR1ZPsqkXolx1yfjnE6BeEkm2iblA2PQeZniGrF+Q6Jc05W",
-	"RUq3YKIETqggS6oufbwFrtRIMj+ddbGNBGObGEYir33m2gk9XZKQrc6DA+A9rOWFYUhH3uIHhhpR0/DF",
-	"4QmOplH0sCccUg5+ih8sQruo0BGtyw4r/Hf7qC0xkNkhJJtow/4glmFQEr5WxLy9iJkPvVLJ1OgFIlrY",
-	"MceBwLPNfUs2lRXWqjlGHKHYNkfD1vLNavyoOPTcjUqghmJmICY0eb5mKSgFdrLtatlzi9iTXS9LW9SU",
-	"RzPeZH+8WhRN1hg3OIVZxjgKZ7Mq30VNWMvueC429iETK24NKyXnxFLgB9W/qn0RmYZGqZB40wqzSSUh",
-	"81Y7Q8truJUyBBTODdNZITCQSpRtLh7Cktc4ZC2n6TlNMMQyzDZ3msw7+Vslucg8ka2i6hvci7bSU75J",
-	"gogMwDZQZ/OBOrrrkEIxC/rJd+o0LHtOaKByvYeAkQWDRFreemveUqNRlmEsG7XPnrua6YFbwWDrK2LM",
-	"kWEbM8u1riKXbVo5tJII8+phKw+MCuJyzFmjJlplaqebVEzJnjHeI0wwz5ppPCkbZGbfBn7WZEfkuQ1X",
-	"ULpm8cI1esAmSZTGLOVkDoLcKCMorNN3+OLWpgNYs5BYMg20IL02E/Q2ahMLpZ5uJLhkihKjm4GMrm+a",
-	"NGShXCFbKbmuNeyy7/THzLqNU0od0O8wrgoAgZhkPIWwM4bEm0LflJg4F/xbrkgJMlgwAcmbpR1R4G2U",
-	"b6TNMtJmGVlDlpFGolnIBmzxqlU4ya3E8m+88Q6ZYP4OcnnNUk5s6pKqYCvvtkoFzElxURVw3odsBEEC",
-	"k8yHrKP1KoPJo5QHaRK4J677evf6/wIAAP//MiYyXyABAgA=",
+	"H4sIAAAAAAAA/+19aW/kOLLgXxG8C7wZbPqqa3oa2A8u29XtVy7bk2mPMTsoFORMOlNtpZSjwy6/Rv33",
+	"ZQQPkRIpUXk5syyg0WVbPILBuBgMRvy5M4ynszgiUZbu/PrnTjqckKmPPx5dnZ0mSZzAz7MknpEkCwh+",
+	"GcYjAv+OSDpMglkWxNHOrzu+N8zTLJ56v/sZHSXzCPT2sHFvh3z3p7OQdjt8d3DQ27mPk6mf0V55EGUf",
+	"3tEG2fOMft2hv5IxSXZ+9PThq7Mpv3t0OC+bBCmbU51u56ho+Eg4TFOSpv6YFLOmWRJEY5w0HqbfwiB6",
+	"ME0Jf/eymE5FPNown1K0+QYAel5w7wUUA9+DlOJVBWccZJP8bo9ifX/C8LQ7Io/iZxNE9wEJR1VoAAb8",
+	"ROf1M2Vyj/7gp2k8DPyMjLwnOiHC489mYTD070JtO3Yif2pABJ03If/Jg4TQqf+tTf1VNo7v/iDDDGAU",
+	"tJJWiYXIvwcZmeIP/zsh97T7/9ovaG+fE96+pLofcho/SfznCkh8XAs0X0jmV2HxwzB+Op740ZhcURQ9",
+	"xYkBsU90HyYk8Sgmozjz8pQkqTf0I2+IHWHzg8Sbif4KLrMkJxKcuzgOiR8BPGzahND9uCaRH2VtJsVu",
+	"XkSevAz7ps4znkWPFOVpi8kC7OHF+JX9GamdUlQQpZkfDYnz7INgHOWzFpOntIOXzwpWajVlnk0cSAvI",
+	"4gia0i6zOM0m8dix1xVvDR2fwzg6ms3OLFx5Bd+B3byzE1wNXSP2Aa4HKsq8NJ/N4iTTGPHwzdt37z/8",
+	"7Zdd+KH0P/j73w8O3xgZ1Ub/RxwnOg/EgKqrJH4MRiRJzSvwR6MAfvVD7xJG8Waivfc0CYYTZdfCeEw3",
+	"CqUMBc6VxQE8HFkAUmX3HtNFxAIixyAVcNAt9WIq4Oh4dCIq47Cdgtt/79z5aTCkfxrH8RgFIKIBpIcE",
+	"uSJ4K+LHhugz0FmJLxRVSf5FIHJr5AyndTkEyG/eyaO/wWIVTqiSPgpwI47gCyCGDVHAWNVHjQqAawmx",
+	"mBqpq29rBR2jIJ2F/vOFE9CC7DjVUcSkk/gpAqGEFKit5PKBinyD/oxaTdUTc42AlKiCpXuTpUDmlMqT",
+	"OAeBqs4aG2c1Y09dew0GrwrBVFJfs+B3+s0ideiX3ykz0kG8CbRSoZxk2Sz9dX+fy7w9/gUEkglldKLP",
+	"5Ll5ngfaSJ1mNnn4Vogr/244olLAVWT1SRrnyZCYVTfTg6Mjy+qzYEoUQyjhY3lPfspVqKapd94cvHlD",
+	"Jevu4dvrw/e/Hnz49d0ve7/88svb97/sHtDfD3YUE3VEe+/CBCZUBRYlEIwYZSnA9EBQ3twwpQBDqwDd",
+	"3b05fPfLwd9237z7QHbfvfXf7/pv3o923x3+7cPh6HB4f/93mH/qfz8n0RgE+9sPBnDy2WheNIV+StUx",
+	"678KXJV4IoBJil1VQbfwxnX8QEwC9vuMjpmalnxL9QFyNxBrBt093nrPeYOnlBxpA99Bq2kUbJU81yXJ",
+	"I2Hb0/f3zfv3FmmWzvxhzcD4uTq6d1R8HXFsgAaPo/DZo+OPxyBo4+Thnlpv9AgRjbxZnk488gjrpGIx",
+	"Tvn4HtVGSYY638vUSfdMCEyHdLdSM7jsmxFWBiDMQYWuaAjw3hEQ/iO0rChsyTMl5Wi818L6QEIawIiN",
+	"pwy5+z2pAiW51ZEpG72y5iO2EK5j6DAUS0NELmVJuraCTksr3fOu0S6bUhHChkAjOy1hgET5FA9HuGe/",
+	"PiUUJfTPSR6lv1JWA5bDMRTYi406Gg7JLGOnhj7FAWGqRuc2dkRgfLeY7KJg2EVZb+f7bkzV0C64D8Yk",
+	"2iXfs8TfzfwxQvHohwFwLe0gdquX51Sk/KiIGQavca9yauWeGxXt0Ox0wD3Ab73CJAHKhc7ceBsJau6f",
+	"Dq5xQ/E8QzxKSpNYfh33r47hq5Fl6BxxYjpeXCuaBYkDz1EF1TCgKDAg24DLJcCawENU2ea9fp5ZhAu0",
+	"F3Nj05r5FATdDE77HMxv15efTy+Maw5mR6MR5QiLpDi7glMJfNcg2FuyyJ759KDmWzDPPyoAsIWCHKSa",
+	"DC1GPwS1OqLfyEgBrqC6WRLEySDzMwuSU/gkppAK+o5QDJMFphUjNVNVYRTgBhZTopVQ7LUjTYnh3Miq",
+	"3eT1FoYiuwvC7gnmrpMI54FJ9M18egaQp7w6wrqSLSl90Y8pKpqEymt3P5gQTlUNpQA6IFlGl25wuyUk",
+	"A7qIoytCCc6w6b/HT/RUE43psZmOBQccqvMpiT1QHdDzKLJ9b5RzoRYGD8T75W8fDibNWC9PbMLzxzx8",
+	"YP6wU1BUVmXD1JgzzgxDNnoR2Qxf6Z+P4YQdOgB0NtJBaq0IyzzTRjE6LQggLJZ0y226PrUCrCu7D8KM",
+	"ndfrMKwO9Yn1oNM8FX/luDHJFym4RXMPzBI4yw8RzD3vNOAOEd48oTTI4PKmOT2cUHsoJZlm6S2ISqX5",
+	"e7iWaHb5mFDKWbyC04XwwgQgcOQdodByJIVMvC9r/bWkVALfxMjHcTTMk4REw+fzYBpkA2qkZWT8zBxf",
+	"zBY9Pro4Pj3/dnbx7ap/+Ru1igYUzpP+5dW3i9NbaiPR3/5xc3pzWvz6W//y5uob/d/FCf3/x7MLo7nK",
+	"uF0Y3HaWZfb6mcWckzLuXpozeFBGc4oaL2hpVqWeu30aU7REQdgTEyGazSdDdkDjDvA1HgzP7oGvesrC",
+	"V3AuVBbw4d3i6OQXZuBL69Gh/++Hd4jYuQ6bdP1wFWHAwVrPmpHNL1imdJu8yYSDpLp2jZbqFTgbxQ7H",
+	"cRJHQv5dM/KwMl9xkfBFsckrAw/pkKffZ2Ddc+uqQt7QRDiNq2eHaJZnhpErZ0Fo1jNBpUxQAeerXHq9",
+	"cWBebInFi5sVYZ5KfkeGMhrv5rFQfLkN8GDy5kJ/+sHavcAvnlmCzDKG+GrR74xEKBPdPRfT9Lz7JJ56",
+	"h95faEOKzb+CHfDW+8skGE/g1z3vhNz7eZil8r6d/a7NRnwqrsV02mkkiLK3b5jUCaagh96iMmQ/H1ai",
+	"DdrLHzrY/z1EyfO26nRgfnHctYJ4BhcD5aLISkVZPAuGR4mNj6f+/1BlJY6tHlCs95ej/sVfBfbpNB6O",
+	"sYjSkk6VYplv3n+oLlQCaxcX7Mb7KKQrPJ36QfhbEuczu7aGJqlJNYb0TIZ7ji3EvSreATle4c2x/FHw",
+	"SHo4Y3XtHFSnlX/JM9LPQ7tbbUobjG7ouSm0nJHBcZ/Dd8VIkYxG7RQcgHkJK3/2gohyD6X+jFCVHkht",
+	"5+4Ep+tJTQ6x28mzBZIlWEyM6t6jGBcTNLsvJChUbgAoewseuJbmiVSW0EQzDT5YRpBGPOAnzT9I0cB8",
+	"oEuRB4IXwJsRkib7h63mC5neURsB2ht5aIcP1oQVKz7c7llY+MwysMAszTAfW+xM+mX5k6oWb5W4+OUE",
+	"AtWIx7hGENUG3aHFoYTdCacdHXBRhifTWfassbzbri46twW91OYhyTRAA9BynFAaCGCmSOlpcQIS0Dmd",
+	"FtjuXMlhl6HAUH9byUVdo51qbsndJI4fBvmdRIFdNNmiXW55tAs/NYJfY0RCql4BSmHcMWMvydUdVSJd",
+	"sC94cS07gt95PFDhPpHTOG8EX/CpmG6ZG+FK108MBi9VsL4SOk/JMCGZRZLhN45LjkeIiAG0QrgeDy+F",
+	"61relOp/CgZchoHZAUGw/5Wq5sbCNgGg8YMmJ/LEogvpBw66iegoqS0FnzC9jb/gm0a2ZiYrztOp66my",
+	"+OuV0lqLjtSP10bzWOHWKrfJQ3WruRa4e2MXpM1OfgVdX1gXhauqRwBmUY2MH3Wz0vrZ6ngQDf5JpT7F",
+	"kHEY+62UBM00UGl2DVa+pcUGSuQ1EtgGXG3pBO8UaGnadMXFfHL66ejmHFzHlKwszmJlgMtkRJKPz59E",
+	"YL0YJhLuH1IJRCpGQq2wTufPgr6bBRgyIRCuSEafknjafPBi2lecToOU/wFeI3hsJNpybwnXXzKEvtmY",
+	"KgsAQ1zBiW6ql59OKEsxole5JBnk06mfPDdBhgR0W+1WIyiYP0ku5KsgwxPfFCrZxlvo/eW/B5cX3t1z",
+	"RtK/NvuGpUsLp/+8GGWKMTZAJMnlGG/b8eumQFkDIpdrJ3S3ZACTkG1+CuHvsFV2qWaTiw4CcUD8ZDgx",
+	"6sgyvbeK7ZURnKpTWY3qdfdhQfQ12L7ntFE0fK7xswWRNw3CMKA2bRyNUu+OZE+Ew4HTKmdfxkVwKWb6",
+	"qkJd9k/bXr3VBcgjE7MG+mmhNI/hEVkUpJM2OBY93BGMt39tpuAdWs2Q5WmLIIUB6zCXA3EJqqpsPzYc",
+	"+ZSJ6/RMCx9omewWW4Zm5TrN/8h6cKsA2FZeBy0MV120wo6klZLdbLGpVbNbjUkvy4yvBnm2KYpBFbF2",
+	"HXFrtFdKsUB+YPThIMHm4OKCjWat8JLPUbTNSDSCrWsYmDdrM/J/cpI3Q8xatRmXNo0cIObN2oyc5sMh",
+	"IaNmoGVD99HlZqd1IXUW/1nq7CazmCMLHDnsFrASp/ff8d1y3NZ/xHd7K3rxYdBcZObOzwPa2oTYWl8G",
+	"KM04z+x2DbyjaFj646J+jEdFkIo7CVy6yTFBd9JsD4pQN2ZKuNkGspNMWmBv0pfXmQ2GktvUfzCKrNtR",
+	"IFrW0rJ7Cx3T0zzMjIE1mkm2TBuLbV1hXsEmQzBkKxI3aqpGKh8+kKSeBdos90k/mDjalUaLbBG/n7Ba",
+	"GIHIXbBzzUBukzilXZ1enJxd/EY7928uLthPg5vj49PTk9MT+vOno7Nz/IEFZMLPpuMcmDPmd81tHner",
+	"XQ1bzCfBeLaaANr1RtyLF4ZG4wkg1iN40heGV4emMbpQgY1PZCIuXGboDx/4HdiLL1KBZVlLhPcdEWnl",
+	"hrhWz/Yj5YEOpD8I6WjuZ9iQmjJh07I5jOfYFrUDS1hjBAxg4A0a7Rlbb9bC4H8uoVg9HBVZdNialJm+",
+	"Fng+F+stnPUfb0A2nV18uqT/3B71L+g/p/3+Zd8skJRxpGvKiXjKWKxIIf795Q9wgibNood9XMC7p4/Q",
+	"0r/HO9d4+AwIUJ/FUM7CNwLZtxnS8BtqGpLv4re39Ld8ir9QNB0eYLipxpZaZ9Ordt7CmzFqlBO/cTqJ",
+	"KbAYk0TQz5WR37qNXKzL+Bg/zvxQPfdCU/SbQ6wju44u0mYduBz8DOLuH3DopSo5CYYGYU5nv3I7lSMd",
+	"i7P5nm29/3A6iLOxAuYSxFO5dcC+2wmcjcjP4Xtm1GgX9BJUbZaeihCT8uhTRsGXLlVUOt3TQWAE3V46",
+	"gFFUQw6GPrkPQktIA+Zo4Ekc1MHQtZZgR+ZZW0GmC5zon36YW9QQD7VW3SIsSihlb9X5hRrf9acgGmmu",
+	"TmXbl3Fj14DoR/s6hDQxrGPqj4jrItg38xTsGy6D3zcUQb0FmlkiILo5Q1M8rTnmXDlaKPsl1iuh0ijt",
+	"q0rXG6AMCx4zqkP5eQGFWB6johIZNgXWFFQaRyNDuAJTjsCmdAI2eubvm02vzFSfRZtD7TxOjAUcECvz",
+	"MnCUVm9x5Jm74fl5iUfkRvTU43jlpoCNbhT/BH56PSky+hi38VO9iVaWdEsl+RXL6bDQo65rPWWejO2R",
+	"OQ1YGA0Phai8bKrpGicBiNNw/rdhjjC0mvSHROPGPy1n657rafm6SbjhLboF5w5vz901b/0VovW+VTBW",
+	"AqIaJXaNbGzxUhNHjTOXV97jxB8SW6qLmnfeCQ4v0mFRTfAsnnxXXgXrTTF72iP9ceQF0ykZgf0ZPi/5",
+	"objpPFdy+xkwTE+R2Y0tKPqmfw58kdIzDj4V5E6c1BgOvZhZUG/G51HwH7BxRZ7FRJ6RuFnP09SxF41q",
+	"fsw7AnlLBMSNCWhW+KDSzcdf+0hyQPE3ykOisN6ir6ltPEZnZ8EX7oZamwfUxeBflXWNlnVXwbNEwA+D",
+	"499PT25sFxhy5tUG1W9oeHx19UWMfP3FWlvaWF70PCWRY9X33vrmjgGwboWtAOCyxMHiwWtrf2ZQEEXt",
+	"C4Mq0W2AG8EgB5zeGlg5qNWDg+ooNleDiuN6T/yArms2iRMyCONsyX6GmuDN6yJxLGRgpnOju3FF0ZuV",
+	"Mz8PLbAtCz5jNGkwcjMH1BiB5oUGYSiCZ9xX6hCsqQXCOoFeYvACLT3Vr2EJg0SVrN6lVm8/J34UkdAG",
+	"L/8MQZ5Gf2sKg4tXi2ZPFhvBHgsrpsCY2DknWchc9a2vXODbAkuH7vZ14+CLLHojDG03U1ggQqJbp4ue",
+	"QoZGRQOBcTXpWg1EF4SjhOjxKw3eoxWFac38pJLNsBESSNoLT/xsmyu+K8HXIBgayWSh6EHLDHYKUFah",
+	"kYOIduIbyO5ia7Z+BdGCR9npLNbutZU7nCXFFCIR3tocMo00oHVPj+M8yszgEiuU81wIFH1qMFQ+a2pB",
+	"kQ4xdTwEVLZfPttRurWBOCdH4oX10T33abom0FlyjCbrUrMzC1hbruHJ0NYmThxkTZsVyy41KwbTxxIa",
+	"6qScJAXKldXGYXLUHSWUPx/JVsql9ofujRIxccJLzFQ71XB9QrLkuUaKrowflWPMelii5sSgIEHg0Xz6",
+	"tNH7JhzwdQY0BgvwNifUADknWWaqSOR4am40r0ZyjoY3kPLECqdo6LUb8m7uJ0zJh4ZXPFgEUMSnQsCQ",
+	"n2F6JXUF1oeiqhque+JH2+EaDGMu6FbT+bMu6AuQk1ZRCgMEkG+S5czn0VEVRFtYdIkGd4PnQh9hyW81",
+	"X/LJ6dKXVSPIFKVddn2UHJuKk0STfiW+/WqSGpsj7RRJVifwLFlKhna1Z79OGpk7KDHpNfkiHZbEw0uw",
+	"ByakgixT2XOb3gPRx0nRfgqSlHZhXgF3ZXvut+3V8okQc6toAJZmlphV0KQG4rP9rdHem5LKQiPTRkIu",
+	"bFjhNO+fstvAbxeX324v+59P+3CXKP7YP7o+/XZ+9uXsurgtPLv47dv12Rf69fIGHfeDwdlvF+w+8fqo",
+	"f40/HR1/vri8PT89+Y1dQ55dnA1+128k+6fX/X+xG0v1chKGpgN/659+6p/yPv1TZRJ17sH5JbQ8p9/l",
+	"mGf068d/fYNiOfAqgq7p0/nl7bf+zcU3lg//8+m/vql3pJYmHFDj/YGJYxSkKi8y+AL7Z9dnx0fndaPV",
+	"Xe7yn74xNHw5vSghvsXlL/8ZWpuAKerqliv+QvZdTMt4akktLDITZrGHrYVblCdzNKci9CM/fM6CYXo5",
+	"yy7zrGbUws86oVZIPAPnLvelyUHMcwTplQ/Z550GD1Jvhq33vNtJQO0Tv/Klh5UsoZKwHiYFafH40/g7",
+	"ChgGvYjbKzNkK6+JZ0ubunDe1eb6btYUqsZE1uvNYL2it/X2RNbGNW+A+jDvhSlN5zjeZSS308cb4B/6",
+	"qkRibkNG7gUiNX76ZN6tEuowsJeWVsdOyA0Ztg3bbibleUlREtMchEiXZi/mtSotxtJHnkKFHDoxRlgi",
+	"MPXjs15sGki0C24NfMiNmsSfUdj9IdRJYcVW/VLq2sr8Irs3YyJ8ljEnFGzJooZcFR58x1GLi1v0IV/e",
+	"38OjXgcoMI5ShYE5oVNvHFPyvxdPg+um4weET3Rf82TuOQt1Dnl5zHOCMwjHt8d5FA/M/IgTEsZ68EBw",
+	"x6ck/ndB05/wusSabo229O5FE8+X6SM5ES/7iv+pgu7rCaWWSRy6Zjsq1egqnoL5fMHKcvhaWHhPKhHq",
+	"8DpRkWlGXNql25l8e7KakgE/ZAHc2vAZUWCaDbPWosvz1SVoCqIQtrQlBER8tmONtagLAsERtAJgcxix",
+	"WkGFYq/U5KENtLMx1h0n5Xa6lO3pUq25xQjKPU8tsF5T6xvahpcJyO/CYFhHCjheTWkNFeaN2XS+f/Ns",
+	"ep/vk3BDXN5eoCvl6OTLGWSY+HL65eNpv8Z7oFReUMMz8Zuoni3r4P1KFYD2u6i1zUtvQ4m8HXEnnf46",
+	"pWd99NBxe6z4Q8qtPjkAPBZh9lTRCGu07kKN1uJvzJgRv9uXVZ8AAA/+qT2O3eT5rb4rgEwGTRuswaEo",
+	"47q524xneKSmW5bqrkqf4ek/mVdK9aah5+vyQnlpIF8f4Gc7rjUj1mTH+8m05gk9fvfw1bFZz7DH/lQ/",
+	"P/kJXrNUrFvW23y/1S67gDmxwHJyBbCx7Us0w79YjjRJA81SSFKMW6aApg1rnyCArhSKbrI0AcIcYGN5",
+	"fwn2yJ536I385x7954mQB/h3GkfZ5K9zxmVK9BjTBti1h0DUVUyV0bM5FXnfuRJ1yS0ZjUThjlK+hwSu",
+	"YTOfnq1GxkrVf3tjLFQtRGadb07WH2dNDaZYC2WmS4OmB5UcuBpkxyY/1fIKNq3DsWudeQPLLTX7ipvq",
+	"JxX7tjRHk7Qu3c0jflGzcjsAZ2Sn3bW8Zpy/Kulyqo9ak4W/bPHR4u30zQz6HYHteB5L96J1QxJnQY3m",
+	"KGSOYy62BzLLjIL4l799MEnieQpxao+i1eWWoTbxIUPEa6xEqq68IW3JUgo6Wk+bKiBFfyswwzzN4ik0",
+	"ab5fYG1R+OuaocddcJgEYshDxap6JEiY4vCuZU9vTLKG5p4/phaIqcbZorcbtbizi9Mtvunu7iFe1T2E",
+	"Q2BDzGIWVNvbkwG0RWADPKuYisgGHsNQDW6Q0Qwi5SAGPGtp157pOYq21KpTGOIeVnexsVCekCWEVWzq",
+	"1cicxpEleYouSRfy1fBDf6Zc4Rd06bNbNnYTgvpBZf/iML+s9R4gEbh5XWRGKLb8hWAo5p7fweLsBxEh",
+	"7bCKHjMwD6eQyunNu8medwZYDsZRDLU9MckPl4DgR1Aqf/SU0rAYLrmcYuV1BqrJt/LVkTqZZ8VernmD",
+	"HCwLijCbc+ZaIVbhlkHtQ1XCOfuVgh+j3tBVLpxLnN0BdtEwv/Uu14nvU5olUleY2zT3T1WZW0Fpo55a",
+	"eeHtn6bMdln3v1RVaAGHLApt21nUTfYseU6Rv0zBFfG9KNCHfgRHUX84pMIPg31F2ajyRtdDp2QJuiXB",
+	"eJLZ/UYh7ZJmrJUl2Ql+K96kQXtZG67kSeP6GcLTZRNmRqV73k2E+ieTYyKDpzMyDO6DoWifwnnAm8aP",
+	"lEChdyw/KPSakHGQ4qMfBCjpeSk9zFELDZEmZqbIpRj18T0ZHvjTjBshKdh3YlxqfnDLAwMyJXAwEWtq",
+	"dhAKp+CB8YKIDWIxP0VlvQINmj/S+2dlydF/ZQLuhAwJJXgAVSWRVkkiNepoThfJF2O0e1JTyEZjyJI/",
+	"GlF9n6qhSxqWRSxMNYIJPvzupxOTd29C/64OSa1KfTru72Oq5OqZGlbeIJ/N4iTzjimdWiek+IL0Kw1M",
+	"jQFY4D955M25y0mDwSy3aa8rP00pCbjO4VPJwToI39WaYv1NNUzF/rWOddKxayMwujfRmAgEWYUZZQc7",
+	"EtHGofwisSauB82wz+HmFSMzQ6cWEAlELf4Wg6FSYId/6Wl4sqH8PB4HUb2Dffn8PceChVt9AzEu1jhr",
+	"wnWfq7OtQrfbScIiGDZwt/i1sPOmqdch6SSYpdsah1eJS1yjNl+FlmGTmbaNH1pO2IHBEPLCky2kTV5T",
+	"0Q7MU378KAqKmyOGLEkfxLsdLdcDc8qaA7jlgWuOAxq+IWBz1Ca5YIdEiB5TwcIDFiRb106LK48Jh7Ri",
+	"7kAHqXIehK49sOpZFADbdTxzFZWQ3OCfsUoDzbn67bn+E87U7M3tMZVYtpyY8N0Dkaa4e7GrCGhQd8WW",
+	"DcMpX63OD0oiKcVr4ZA+gg3jqb0WSjpYml6leqXih2RWB1bfANlcFj5O+WzNO2R8r214hf3VpDCh5+6j",
+	"n4BgTTGc1jRHMa7xs/aW3dRAQFCs4VSVXAJ8SDDCEzDt9NhvsrY2+53XM2epIYrfmP9kbxQ/Re2WKcFg",
+	"z9/5zKaPCiCGz58EJOVvELFl/ch8RycIdYEa1Un4OryD6whXtImlinBfpruxOe4QZtN2oid3uEaQqSTC",
+	"8613lLJ2SknJMCEWPyn7xpHBERHwy6w0GEc8tJxfdsZR+Az3dHmCH2R6MQUC9F+yrd5sspV4caTfJcTT",
+	"mgRnG4XK5PBSn5q1IzWuv1rtq+hLG8y7g1+bUMJki93DtqxFOnCSKL3Jj/HAk6KuDwXqMRjhBbeX+NEo",
+	"nkr2g5zv9IwyJhFJBOuoUXtvVobx9mgebSYBzrc36yZlF6nDkA3yZkPq1Ovip73EssfcMoL65mfWMyrB",
+	"O8aiAC0bCo/5ippxPs871LMxgV5UtEkbDsK/X19f1Z2GHd7iK1iRMGsTf3VEeD0JiRqztsgtFnIuaF60",
+	"bmsj6RQwN+1UC6L8dgqPKK8uB/jPzTU7mlg0JMvEmNYlXE3Z8zl+xT30IwjqALraa5UtyX+kpyiwMETW",
+	"9TqPHKsZWJqWfCfDPINQsYg/99OqvKkJFIN0hpEqicndkWnuDj/l5lzRqQfxqDc3ZyceZ5/e2gsaUUyR",
+	"MK1/64htkKW0JKJMDTjfH1OBCuOYtgwcU78Teqi+o3zXXKWFbxW6syBLCdXmE9F7VYWwfcbMYB6cUkzc",
+	"hZjEegMhpftvJ3xDve7FGGD1dofd3kgqJZhNQYTQRmalLR53tiTgUrlnU40ASO41JWfRfezGDX2lA+a4",
+	"i22aIBU1oFh9IsaIcy6kVE/KsJDCB2x1Mlf2RqiEo+Prs3/Cy/mzC/nj1dHNwJKcMnO5kMBJxBmfK0Nr",
+	"hSWuK5lELQHZWCaK975psj6hnmZ1+LbGKLY3GhKKsKzoUShSbgQOTGsZbEW7Lvut72NTWHzD5HZ8wJJq",
+	"8LABLnib2S2B7OvMX46Vi8Y5z5rsLBYGJ59TpnhYZx55Za6JYDaMuEQ6hcttY4N09GAftrI4hEg1/y7P",
+	"j1jG139d/46ZM67/dXU6OO6fXV0buV3hZGWYwen5p9+pDYl3Al+OLo5YGt7b04+/X15+tg4ksogsHjBd",
+	"mw/dPSpTZE/kGVmN3tE/4juLYIUvJoCc6PO/47uX8X/WYU5EUxjMI/pl7rWKvb/2jca/CM5sXf6aM4Is",
+	"DNcmKYBNeMG4x8KEMqXKGJNM+S6Ty5bCEyNRkII5Z+Uz1WHR1RtDX6mUlKd99pwYgwwcXePGnOsKhOda",
+	"v/bGZmFP6s9SjIGyTfny+NTl1fSMWK3borMTU0yoBPDsxIhD0ftzEGmn4k83F9TyQXl4ctM/+oiJhE6O",
+	"fquVZDCIUHStyBZnN/CB+G7WngtVtFuz4kVB7+a14K2t+S6QST6TukIjmNXKRLGSx6i1kprPQmJ4IEun",
+	"WibyQOIX4exyEu8vEEoG8eaB790HYUaSv5q5wooIY+m8JZTB5jFW1gLI8omXWqD58ODgoLfyAnbzVehm",
+	"BUXc6bKoYLdEncsq071MWWs290CtorFuEOYrwTVvdW2Xsuhk9PG5xeDXSq9q/e6WdsjKK4DLcCh1sV/r",
+	"hcnRMIul/W6QnfQLRjlCM/25N7yk0VS+6jTg5S8oS3y7vvx8elGrKSkYG3IiFBK2lW6iHXhN8BO6Z7KG",
+	"rfSfDI7BWqCnqCYk2CqLF/XcVJbShKkioBsmGRA/GU76so5l+dnE9+w4T1JbUbIhfhOWPrSmh6MxEY+x",
+	"A5lABR9sifhEaGJ29y1zg/T8NU9pE+kPJv6MdMq0U6adMn1JZWqZ4yfUtXUhuy1KKLEMto1yHieb6wCq",
+	"E4LlFFraUNPlcJw0B4zjG0aqUSi/s6xPFSOjnPTPqEh81YxxXGNh+mAN3Ti6UgSMochuHA14AiJjA4yH",
+	"W1W5+dt2FejkfA0UmR5jeWFrKIlW+E4X/wtKs/pHxvq0TYv4hCfpKqUNSEhbpxUKQk+l793l4YPH6isD",
+	"BWJ2uec970ivgRlA1QwYhz0KhwfpeNEPyYFCeointpoa9srf3xojcmStccv1sn+PWXJkHiKWgAnyWbUO",
+	"yuEdPmLp1JopeW3VpcyJDPDZ4S6Lvyky8Hkpx0FZk5HaK0wiPdA4jPfxWaT77ClLU1/RCwOKJRESlbZZ",
+	"bBTtID/LDGSMRtrENLuIVPcSTJIy1YWuIlWhyl5W3xumXWqDAjHUMevoOvOxnEefn2tHY05boVmNH7kG",
+	"NX4Titj4sdDN5jLd1tXA1YYBf6HtnNX2Tmvhyx1zACuDsE7+chvgOIGT970pGNFywcn02rfAos2aJuT1",
+	"RA0zonT5xu/Ulz1tal5h+0NsCW8GqcAC9ucdWOJnuWcwZhWb0VcIsm/8yq49mlnimiUk1Gm+uq0DQzl0",
+	"lFlWu/pz2RD1thDLy6NKuqpNej1XmuqquBJ30y9044zpOTVb0Q6qyO15TY2LWEsUrhoBwfDh2WYCwDf6",
+	"D7sydLvOVni6BWulyqV0fVKfNtXZ29yb1R6d7UdaAbPYmcZKi6aL9GVePLYhkNeIcG41mpSOMFab7iML",
+	"q1YcfLICX2Ux8uGdPV9B389sr9QnYBrzkXWbWZ+OG9/s4W6Pnj2yJ0IP/AdocB/ueRfcdcxyb8HhC5Ib",
+	"iRH1g0ic34XKKYQtGJ2i7L2tW67b+XFSvE1umEk2XHSyNF3eFkigVrULspJU4135XAgxuvacDk6meZZQ",
+	"vdbkImQ4UElFUmdPYWAHOVDk5Cu5Z5xz9Ylle6q05NnjUsy6zduPAnxN590980eoU3SWhGE5Q53IN+dW",
+	"QaM+Qd427CbHtfNupS+aYFFhYtNI7MUx3/0iCyN4t7DYVSqqX82xm6nQWGY/TQCWmuapwRockLBRl1Gq",
+	"E0okfOfvpQh/u5ZAKrh5Ex5K5WryyCyUsvEMaopAatiCSUQOfH0j1pSrUexJHe2yQN4ixkmn3PuE4OMC",
+	"+dnwasT/3tDiqZ0PG71LBpjZq9QcjkXgj58yCO8I1YDJUZ5hMkjEG5728M+FDplkGdaXH8bxQ0BE8wC2",
+	"lv1JxH/SpixdcdHXnwXg3cQo6oBHhRueKrJucKEBXYMMrwj1v0pbdudw72DvAE3hGT1ZzwL6p7d79I+Y",
+	"dSyb4NL26d/34cU+Dy+tzvubCB+FVhFk35LXU7CLvsjds3POv/+G6xKvJ3GWNwcHhozjxA+zCbLEe9N3",
+	"EDNiTm1n6AZ+Bc03nfqQXAsgLBqKQOJ/8/EpZoYPO1+hP64VanQ+Ny8WmgV1q+2LBstcLgKHBSBYal56",
+	"4Ly/5xVc61YvoW1c/uPhvii1sIsJzHYxgDDd/xP/rP7tB4MxJCbD8AT/DtlBRT54LBbD0rRh9wrGSkWV",
+	"2AhIi4mPOfwB7Jqyx5UZPNTFyF9AzwV3VZayo3I/C0Ng0m/hy6YfXyt7/85wWcRs7Ps8DOHeABY+0pLp",
+	"V5BH9+sdo5JhHEHqfrz2nM3CYIgY3f8jZefVYh0N5+NTOGjxVHzl2OWpHwIWoBZP4t35I6ELGRhvlw6G",
+	"CYpPcXIXjEaEec8K+mZ0UkdmguJ5meSvkJZJJvkvyvNCKq8KYXxFty2Vn9VNY+7CRUicjfBzkDjSw8eY",
+	"yc6lEINDwTUDmdRii0rOXOBcx8YPs4heykKMSzDBrokBBmgnBhzFAKOW1YkBk4Kc5hnZTfKQSPUo/zKP",
+	"coTOHnTGNPLoTWFVlESBrPIJlN35Q3/IKm+WNl/ooH065pzqVMLUIGnkwrdDlcpldRxUq0gLPLXnn4Ik",
+	"dO6Rpekp04ifkV1mcWowufvkkbaA8l5FmBZ74yLnK5H9LMDqf+I6D7q70L0c3kLnAtaNovAEl8cpHKH7",
+	"uQk6bUPRnHRgY6/5zgkiLv5WR8dyyx0oeD+JM+4jtxAyfrcTMkR/gc+GfSmS7hX1hoASqW4YxpDtEDzm",
+	"YXBP0Bkl6yVlzGaAIURcPBauhA4Y4QXNxok/xApHQTzqwcYFU7qDUHaeUhRzvatNWBgahpbVchpb/xZx",
+	"2vJtVoYDuj7Ei2KmrtK+ZEnciknFG5QGA1MSSyc6DKKDMeuyRccwjPPRvnprbXcziVbyFbbw4+EgHpQR",
+	"gnucClcew2fxfMTufVo9bhEQL49kAq2NIbAGdxlDsBqPz7f+ixLa/H1XDLEbz9hjFn6UVPabxVHt/4n/",
+	"/qjbb9ALMmm7vqEYTsU2slG08uzzFlsdv67VflneZiMWmoUaRG3SZY6UYF/csU62aSSuYKYgb4biGqnG",
+	"6OerncL3m8QaK4EgpFoDzZ9IAfba6f4ESbij/Y2mfRagX3eShe+ppPqeJxQH3Tw08n1vGo9YxTZe6YMF",
+	"TQiPj/rYo3i8wMMl2LIgZgbdQT35lEA8HChKk4RB9MDKqLBK5gE8YA5reVGcpmGoWwrrFa9Esh28uQJL",
+	"HzGBqFHQ0eCY5ptaZJBVN2atPmlXbcoBlPT1ymUJnfXN39cza18rV01P8TyOq+ziwOpU/FUTyJCZZMxl",
+	"ijb+ANuo1uGdp+GdmxJJo8mqBqVfTsyw8QKmVxNoCDXGH4KZAIzSbvJcQBbf36do3hhAscUfNk3HqOXu",
+	"2TIlfm4548qtm/KWN4klHiNWIbnO0CkEAzIlFwsajhaTC1Myt9vC6rBYn6+Cxf63MqOlF2BLfBfL8FrA",
+	"GPvI/2yXGkQ/BOhqrW0bDK3P9IYr222Yi++4MmXLzRcp87XVbRIh6Oxe2oTq/mubHEdBFoMO2/+TcfyP",
+	"/VkS39XcYIg3SGruJapyMIaMRWxr6ZztDC+nvqLzUKl/hfO6303bVL2UXGs+6NcQFE99zugJ8bu3Vv0A",
+	"YYN+nk0ouv+HHfl4EQSWpJ1lAq3cBGfsiQqLEfRwe7xPXJ6fFdtqVhwamaWhP3zY/xP/cQiK8AbQUGTG",
+	"rlAOfi0K/TlGNGhjWokHQdzI8AUdJ5tk5ByuB4ybqCBhNvH79UzMipRgXjGq5eKnyvnLQrVC9OLf60ws",
+	"RnQ6x8C9Mv2fE7dcDFSpX+WXKG3BJvpgdkbhmnvj2KSEjI5RNpBRKgQrWeViUMsolOiqbCIMF+WCzWy6",
+	"wLzCEVBhkdZxuC9mf/Ts7g9IOjGn/0OB4c379xoQh8uwgajZA79AKEunwzaGNW2HyCCb5HceBUZQe1Wt",
+	"sTYlfszIbBc8DFR58R9/7EO6T3jn13CA5K1E7mpeXKfKqiwFIh7txMAOTCvGsys0Dm/nJNxMJyHfd77n",
+	"Ti5Cw11o2t1dMFNq9bNqXAeFHUH43Md5NDK5LTT2V5hfWgbwJ0jpWmceCBZulklFbiO7ROIFi93l0Skb",
+	"tJNGr0Ya4Y53sugnk0UK469eEoXxuF4OpR5tAtEaFduoenl6Ho/PaUPXO9NODK1BDPWqZYDElUJIKS1M",
+	"YV5Wi6VmYmypzVx78cHpAHqxbP6WlaeYjN/D2RQ46KosgLAObQFhOf9NQNxCwhA6Meansq8/VisTtJxc",
+	"q2pgwQObfiTLJ9RCcaI0mweSov9qlZQqDVpcp3fKyXiPLqWwogsohturAfY5tfup2FsOuGHDp0DmF27s",
+	"BR5rurOa8DY2OJvI7bU1XASqEK3zbXUjiYunVMVD0O7hpyRxttcFsTU99DRRtHTFstI5NQkTMP7rO2Ri",
+	"isb1BL49btk1ZEBwY8Iic9KL5jro+HFpqQxaPLyu5UtzWp/6UC5fWqu2tAppU4oT1+PIhgZ2rC7/xxye",
+	"A/smdLyjmWt11OrOTL0WJlr73D/Senutyk21MJeX3sfZBD184fQ+VQ3YpfdxtVEXSu/jqCWL3D5z6UiZ",
+	"OCWtT8vT6UeNgTS0LKAdFfR3PGTXjRqVLq4ZYfdSS+KqImS4niE6vVjWiwIzbbRikbbrxXWiAH9+jdgl",
+	"63LTh/Mk63LThvspyeDftDkxrujiiS71yboUQqGNB7yP46P/V6IUFcQsoBPVPekYSXszZUXT0vhIZgyr",
+	"DzuRibFStxR3nfUoH3ohPlL35Fcan8j8Ct3NV8lclMmu0nYZsJrcJ3Pkc+wsw1Ket41OLtfxl6MJN2eK",
+	"uQaFk4+CbNchvghNNmgMd9z8oMYGwQIiUC/kPkhSA1dCJ4wy2A4V9PpijWBG9rzTJcrIr0a1OKGwrpq6",
+	"27RYqX3FGx0oOXd4WhUH4ETb1cJ3yQoiZXkSqawojsN+BkgVyVuD1OMlsI0RWgF7l2uAtaZ6dmuQeOHu",
+	"JmjyKAvC9tCs0ljUpFaLuKgCCZ0GK0fvF6hRFBj+sTZEylWBtfE9CFAK54Oi0KwqjPYfFCe+V32YEiiZ",
+	"099Q3YCOXXRXgwFDLbmmsR7NApzAhtg6ZlhV5FWZGxo88Aakv0wQVmsuVmvNdDzsEJu1OBvXKb9R+B+H",
+	"Y5t4waGxtlbmmFuN5PvEz3m85YQECRfaac+bxmmGtTijjFIB74Tnvb26x24nxB+d03WDWOiOfq/itVux",
+	"5W1N5xHtuRtiV/oXSbSdUCnZ0TY8tXl91ihWlNdntdllgnToJyOIbjGDxdISyzdkaQZ5jUVtdcg7HMA7",
+	"OyogI0qhghp4HV0Y0WMjplYxw9KFFFS3tXJmE97ZzZFShxFALQt3T1hf5AlrwF6wantSzrXDds+2b8t4",
+	"z9ogXPZxc/K6akisgV3EsGzGUB1+lpDHIM5TKkBmecbkS0KmMase790n8dRdsIg85gy8Tqqst1gZYr0T",
+	"KtsoVDjLrFWoOKTqSDH9rJavg5dTM6cX7+6rNv9t/AN5dnoZD+20WYOMTFOnlOOfCVZa51D5SeI/18Mk",
+	"E96enTjBVlx5twZQpEM/O5kTRG6SZ3lKnGAVbZ3ftCsJ2wfYlx8KXyTPAO7ny2QZwKk3IMeACoeaYaCG",
+	"WGSadspE3qMf5lCdJEgq9EK++9NZSEB605aHv2LTQ/qB/vaG/fYGJL3xcnc0CliO8S9FVnIDM5RkXxua",
+	"F6UfnOgcG5+NLCy5kLyuwLzyogldaofllUhoURXB9V1gXYmTLpINEYC4aLhTYfz9Mrkl3Gokqc8WuhJJ",
+	"G1giicfZiTS4znzefDDZv8vDB7uL4yP9yskjLWRCWisUoM8rFgyw/JbCIX1J6ZC2Fw9d6r8Nkw/IpqqQ",
+	"SJcsJYZQLSOsyfmE35kjA+9zmRtDM3HT2rKMbITXbFAgAtwNCn5g4BU7ly02iiw88NtTcViGs8fqjhzy",
+	"D/HdH/QI2CyaEGlUMEii64TUNtR5XLZ8Qjeao4+V+eYc/KyfyXP3Oq1wNs51Wkdkdyd2Y1FD7vtdJh84",
+	"129uo5r7QsW8VtWsFEreANW8HLdatS5ypzBfg8IMokdqu7XNCCR6mXMfnOHXTleKlAcKPuZKdiCw3aU4",
+	"MOX9KWhxRYnw2AS1tN65v5UUPwwlbrl9GG5fNKUPA3eeXD6cMDq2NKfwkXyznIwjnM/FH3bZ7w4lJdPi",
+	"KYEDK7sXl9zIeBqdr+ph25Xo2Hbd2si9oqDm5nKvqbSk3B9b0Jm+jw4v6dpwwpbXkNxATlhtPvX59O6L",
+	"ZVR35Fz1Id8WcC5/Tdeac+s035RA0GLbM5roZWbxL/i1O6MJalTwMdcZTWC7MwZNZ7SCFpdjC/Lx9v9k",
+	"P7jUFfc5EOxxRUP2RkYNP4cpyJdtg419Xv+jiqXz7jw24Ovg2g16onFhqVQomVTbmJXJi/0kDtlLrtyg",
+	"T4/SNBhHoFKHeZpRaQGtwVYqgdeD/RPPtoCq1OY8OZNciF3M8FuVOOxEzeYb2WzLYLMaDO06Wli3qe0o",
+	"IFVT2w5+JytfWFaKYkrVXVqV+MRncrtTsHuHtccQBIo9quOtZRBOrb1Fu/4Den3hU2yjHNyqh1Xb9FZm",
+	"9Yc/jfbmywPrPVJShcSUgks6MfnCYhLEkdydqRQsQiIKzplXJiaQ7xHv610izaA1u91vCjXr+3BVTBt2",
+	"z3o3OQ3tMp6ANmJylQ89JZ1twGPPMizrKimt81qLWEaFnbtgxpLLT8VNIW4B1d45++u8Epf32J3FdFHP",
+	"zclTZWJk1sGlbIuIxLrCHl3Rln0TWubzkJd2o/OUr6QSoFMu1USLN0wx/RAmsIETAd08uiIwZSl/BPGo",
+	"Ns2qiTy6ItdakWsVNQ0+o7LAesnr2ZYsb7im7RjeqRx2BU/L8tqAV8ilWIbiREpdmD3uanwqbBI7lvZU",
+	"rEcV4Z35WDIfNeQsN6ZX9ZYGkQudd3G9Slxvy0uPl3nDXoDaKqJXAbzjyIplqmJnqdpJBvPCb46hvJY7",
+	"jz2P3XKlLMsmmrnQZMxvJSiHT4MUXLQpv9CCtOHQwh/7QbRXIwW2PA5EE3v1YZB8hzcoa68Ss9Hx6OYF",
+	"bMwnGXoavTmFLVu4vserAwwnfjTmp9sSp4P7fWoSDTUcv+WhzxvG8Ss+Ybc2S17uTO1illiiMDqRtyFx",
+	"F8sReXWmURr6w4f6ssoDaOI9kbtJHD9UY7zx8y372p3VWUVlFSdtbvlLqN4kNjxcDxg3kZ9nkzgJ/gfe",
+	"pMPE79cz8RdCpx1hHm+qxeOnypN4hRfwvpaxgFZhBHlpzjMKMuJ+mvlJZmXHAXxlhsflEUWTh0EFZYa8",
+	"SUWcJwJ0CQjFntvImW8P3jSY7YgyrsM0rEyIP+JPWcKYEYxOK+W5kSpSMsyTIHtG/AwpGwYEBqW/fgXg",
+	"CnpAlOozCkKAHZibDpqq3A8uBmUCLAnkKO3kMJfDF4MzFVUtJHEZy50s3jhZXGUEKYkvBvO7b8sDmxis",
+	"c9YiAnT+Uk5Gq0yloE/q7Hot72rH0BvE0FbOc+ToWo3Kq6XsriO0nNdJ2rYI89VfXpoQ0y62R5bb0Xam",
+	"81VsQvCz3Jtq8PNiVzeCedNS7UUr6/oFLHfPjKGMlcy2JN5ui+qXLb1q6pzyoZMIL1IE7clnVdCaRMRq",
+	"ap2Z5ERj6vCjLCPTGc+Bj20V8VFfAnF7coZ3EqS+UiteB4o7ENzVcPMOCC8cm9HEKOti6IRAx5oUw5iL",
+	"3ZWHsXnHwpuY9DiB0ni4VQ3XrVjUFsiSxZqblvtjIyyVLuVxjXzBDX8JgVKsqdYXwJrxRz1NwgW8AGzY",
+	"TrS8nHXQrpiHxdPAh+sOFJt8oBC7tBKpwe/id9P8TgLq8tCB9/O0frUvHni4wEDp0F3jpfs2tLR4A2Hc",
+	"i079lq7TzFhSkhjw7+pOLPZGwjSjCLIckTCANBfI4GFwT4bPw1DWrONZgjjdY7asPAldWKq7uEMEGDCj",
+	"Gdrrs6CtezRq9ajCREsdi1cu2MxMtwCXu+hOyIxSl1O2SF1iDTLs4gvLDHOLSAWE9PlMNoNKporiWyu2",
+	"o7sA37SIFoX8F9aqNhZ69QpQ4x+GjdrAlYNVzjyXkus4dwNDV1TGm0tZIlXUX22DhmTCuz6/TKEbXr2y",
+	"LDAxX6697pxoSHOnp1dnOJ7bSOSIRtesPfIdM5rxR3cy0x7e9mjvcq8rn/2EpxSjKL175m9xUahC2pks",
+	"mBJMSTPzx0GEghbf7Q3zJKXI6XlpDJ/oxGnmY6z5HT2F0iMq/T+U7arOxeX1npEpeSHjgcjP9lNkHmWu",
+	"ez/LU+KUglS0dU7ZpmIO+3J+dgGO18N0TYsKJaaNiUOXXGvaDjlWCOb+De7tYB4ROtZ4jGRc4QHLogiv",
+	"npvOlwv1J0nparzhoIwH1VI1Gi6YrAQJa3yrFiLfWSmLsW9i34EQQFQLqtBlDmgDP4ioqArGUYz9hn5K",
+	"lpgZEuUQysl72NgyCOin51JP3XKqx98c7h7Af9cHB7/if//PAhbvfgQTmFEL9/W7AMVOrwXEd4QOQFYJ",
+	"8kecYZkw12D5PoiCdDI/zKL/WvG8LKCXimmWZJQzlG4NmLis543IvZ+HLALm5HRwvOy0pIp0MSQmtby8",
+	"BxtFwAtWCgBHzSfhQw+YuRSR79mx3jYhj0Gcp9jJRt/Yo72k4OlxSwYCL0qd5UnU8/zMm8ZUkRweULt6",
+	"eblzV32O0Iy3PkkpOTQeKpi8Ners7lxRPKVkCY7LNk05fXb9lW6LY0ZjYCiL7lQLnZXEAUViPgOaBho+",
+	"KJE6474pxBXSEZg9VHse2LaQ0lVdTSECFLykDcFfJfMt9fgjHW6CGu0lGcG41jgx09LsXnvdAchiUjsZ",
+	"0nDBxcJS1ydDWEhfXTAqfF+zDGGTvmIZwhCwehmSCESvT4aYluYoQ7Tw006EaLFtb/6+nln7WiJsj3wf",
+	"EjKq3CawTV6jGPtT/bXpaZ3GLI1XEJxMt/mlncVBpIOmYnCL70n4ds1blKh7eWcvCaQHtTeXA+rpNDU/",
+	"P+/j+4jG+Hb2ioIxtAr0XgNfn+HoHXO/PHMX3vKrBHYsC2AcBuMiofA6jnC7u2j4NUXD36q4j1xKjxWb",
+	"1NZkWJ7ESSf+jKzIjhjg2J282Rpjgm1YZ1H8RBaFTKfDnzHWJqvjN9jI4mEon+ykBlujjvUxlxt7XXfK",
+	"Zu1kwAoAPPfplp2dCKdH6IsdtN3S0Aa2u/Agyt6+Wfc1jUojcwR9dQ9zN/S53xyyxP0toJssTJ1CM7Gl",
+	"m0XzKmuu8mv0nV8PepqoWEf1VTn3+3km53eUd88eTmCelH+yX5mvw+zqol2Xb28ts5qzHNPxGpqKkju8",
+	"BypfIdVZTK/+Mlm9J2HIcM0kwhPcmKIsl3vZM1M8NX9Kow/jC9NVBp+2dAh1F9CbdgFNJUdSl4ZAWCTQ",
+	"yvsjviuA4kHEDSbKMe33qs2UrSkNr8S58+A/aRLvNYa676zlncDWx4qzWFFq+N3zovc1gZ+feBPXt/gF",
+	"n6WX6ghNoIyUANM1xqGu0nzVkLGADdspJoMdW9EEKzJoQS3t/wn/7Iq/OpRahJprFVXlfDUAhLPtZRPF",
+	"6m1gaRjd3KqJpk3sanFXChka0dTOm68TBOQFqLluW5C5tjmAZ4M5a0Wqs1Ob2+D6bqWslyAf3PR37Rvs",
+	"sp9bdb43395358hNPkfi3UqLQyS2X+0JcqOPt5v+hliBz5DM1Qgbvztdl1tgO9IHPASRWwIBbNgapM+0",
+	"VzM0W+9B6V6Pd6/Hf77X46vwCFbdb6/WH1i2HbtjzUqiCFfjCMTAQZdKe77HQQNFp7O/WnrPMT54iyru",
+	"dWZ4Z4ZvgBne2ZadbfkiLwPS+YqA6s6nrgZos343lORcnp4HUEd5COqxwWsoW87jPxyIzp0XcZO9iKs7",
+	"F0kC2Kpwic6Y6oyprTGmimUUonopvlkJkhODSy+tAeaVPh2qSJjO67Bcq8RiAazWLtn/U/64W8l00hiV",
+	"ZAa5pc2y5bFJBhxYywIaUb2x4Urm3e3ilcrxShY8tQtIsNBGQ+TSUhhwm+OXtov7VqmOO1W87XFNq5Uj",
+	"boaBTGbwo3hDU1dRiYoZqPNgfUnj/pDmmnXYnvpL9adX9RWsOXtBLWhrrXZo2IY2ZcWtm7/eFLKtgjzV",
+	"slF2+DuxuCaxeFEkNti4lJNc0NVR+WoeMSqyWPMjm+WxsAi4RHa3ByumBDyP7qTwGqWw2AFlA9rIX6vd",
+	"sD7hO4c5qkrgV3nS7MSvk/jlBkmTTbx0kcsKue0OKVqyhhAdbKOmwoYX5P6jH4RYDg2kryJuzKdxOhIr",
+	"FJce44xbL3qbkndtefI+bbPmPHozUmHk03nDLXf0GpLmS+mns3+e0n3bH+ZJQuo5m5UH4g096Fbh3hv6",
+	"R9rymA+2QrqDmVrSGULc1cJ9+Vq4hNJQkD2jGB/G8UNAjnKQXf/+CqKq9LhNJzdB7rj9BjIeB9kkv9sf",
+	"0vnu/OGDlZyPY7hRzXiF0EuY3zPqI5iI1cr4DYe+BFwei+FLBP724E3DfcKQzzuqzjsh/oiXvQ9jthn6",
+	"PpTF+o8SMjXciQXqc+joA0kh+u/GM3ZNzI1jG2bTzE/sUmIAX+fDKXZtj1CEZ/XoROiWh8s4HodkNVSK",
+	"Q79eKmWYXTKVFjh9TVQaRI9BRuoz9qYYrCcsb9YBDXwnUwFGuMa+Z3yuFVoM6kROsRoQ38L3TF9gZ5s6",
+	"q3DMxFrCXkGU14bTqEZ7+z7dj1lm9/Id4fdUevP4JBVqUzef9dlZje+KDc4mUpxWFmdTDfWxlZvor4s4",
+	"kOTFsF3Ze3f6SgjmNKypygbf29EX67OzqoJlMPgS6IutvKOvWvpi2J6DvsJ4HER2sjqPxykdjpIVNN+r",
+	"sT3OcaDV0BKqYBi/mZDWd2anmBtTWgii7qi+UUd1Xa0D1bieyemOxnnWwAy0hRs3xPnL+5U4jcYbVt2o",
+	"I9IGYxSpx5VspwTew6STYNbiCKR0cjsGMRXypejGnyytlMDNk7Y/D6ko6s5E85yJVAw2k2QMjLf/5yyJ",
+	"H4MRSX7M70HynoJsgld10X0wzhOKSPZRjF0jhMvOpcaLObjpEteBlVkMV2LKV/uVmHIF9uGddgV22HwD",
+	"9jO7wCpEMoczbGHyEH6yn5I2ttKbN/PT9ClOaiKm2PZxK8wT7evMsSsx5urOJ8cTPxrLiTbpoDJEyEYS",
+	"UZ0puEWmICMrndIdFHBCxmAEJXUOI9YirT3NyHjCVbGNAGOTGEYgr7uO34ozviAh1/NS6k/DfX9Y90RC",
+	"M0YHR1/OPfSTKSYHfKDsCGVu4kjYBVTfRxmFUJoGe971JEi9IC21pzik8FOQ6R8egyE3LKBlRHV2NCR1",
+	"ymxA4deuTF048/vu09PTLhDVbp6EJBrGIxaUbCvb0yeh/wwvlg3vSMEeSuA7PqKWZlGBox1DtR5AY5/z",
+	"tXnIOz8lH97tcuAY3oUkMCWhUQyrf+vDfzXUAvqxoGldIoPV2dfViRawppDYxfv95qApnFs+9y9TZc97",
+	"mgTDCdCzIiMlP2DnCg/YYq+AjJWn/i1EPqxJwPh/vk/DBqTXyvpxnFUXvtYYXoY1ViOSnrXvwgZxB8FG",
+	"OrSLE4jzycsqC5UDmBsFFLJsCaEKq+ZNdsJpYkwDckMqo1cSPjOAkTc4eqbBqnXwJajYfCJ3Ezrc7oiE",
+	"wSNJqIra/7P0t+cf1OhNSVRzbjxhLcHi5Z090dnzxz5cc6VeGsf4Lx0iDSgz9kDU+ckopGgDgRhQTmDp",
+	"QKoh4WxQPs1zn4Hj4FuoQGONwS6teVtjsXVENQppauDkIga7hKrurckLvDUpXT0DmRt4Sg365p8G+V0x",
+	"ZF0AeJnOjdIgVUZTBIL6Z4d0KKo4ULt6Pl0VcHshdGwcry7LPSuKcdImzlcbbzf3a7TQJAHU9CYmvHVi",
+	"4KXFgMwtZNyexUWBNhxkV5lB7WCbczi1A9LIwWyEn4KDV+CzQ+QYsKY9fF3fA9Z5hEmOa+iEyeYKE3nD",
+	"sx5hMqdtsa9YBvWBF0BpRWM4RpiX1oMUAHTrvfsgSbOmA4Zr0thNFlOvM6EsO0C6Z550z9uqU4jIOLnO",
+	"01zbEJ3SsSEgXVarF5e/GPlj2Jh1SF7+Dl/K3HZHuBqZ2fJY1iAg13/4anc86m4sN+DG0no62mnkFEfm",
+	"2Od4dgn9FE15WqEGjuEGfdrWytg4vlmmkmPZIzhqADPyytGS8EdW3uPYkdvVsecGsaem7+QWteVRyZv4",
+	"w4+G5DOslTGvDN6POvEcy7FRl7KlIQJxsxO2tE6dwVfcxXhXcrJU8t2Jm2J7Cha8hWtwtDURcgtn2ibQ",
+	"8socZqresOkKjoFcoGyNXjQ3XtMcZx2nmZ1WizBbSZuUc5s55faXCZickom3OBdtZIKwNnnxJYCdf2Ez",
+	"LosUipkzPVivycJy54QWJtdryJM3Z268jrdemrfUJHyLMJaL2efOXe3swI1gsOXbgjoyXFMFM6tL57J1",
+	"G4dOEqFsHnbywGogLsacDWaiU4Fq2CS9ErVkPIiRNMZKFJqyRUHqTeBnQ1E4fgUHrjk143r7qnDz1FSU",
+	"93IGwMZJnM+w0l4BgtgoKyjY6TN53mnMgr5iIbFg9VtOel0B3E20JuaquNtKcInKDNYQbpFUvG2thLlK",
+	"JGyk5Lo2sMued3aP3u00B+ogox57jwWBcJnkqYAKepJBxn5bPdZC8G+4IcXJYM66Cy9WbUGBt1WZha64",
+	"QldcYQXFFVqJZi4bdp9IMJ5kzbalkDq8PY9548OJh4QpRWGGohxLRd+R7ImQCKPuef+0h3H4MKIwz4I0",
+	"A1uIDkh8OoaQgVaZ/0/W4JYBskVuHlvoWCJrVmTBlOIFMwTwv+hI6nkjcu/nYYb27Jt33oQSQer549hm",
+	"0gbRkJjlP5xddmHCnZdxSOnb2NLALFFjdyq1WHhlPC3iP8qNWSdmoT8kzRJiz7sQUsFPCBcUQj5kPLCC",
+	"IlSICUhSCQ/YY/bAnmn6IBGDMyniRx6ZzjIWfkh35YGe86Twoac+k9WEDwNdhUvn5dJuPKsIajDTquS3",
+	"fuOspZxRnV6dlHH1fS1P0DjaLalDNI4GmNNxktPKttsUW3aeXI8AWNCF1Z3TNsp1VZDivHKmnN/gjlDD",
+	"JJH5DXrGjAckeRTyIE9CCtTOj68//j8rBYHQGuECAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
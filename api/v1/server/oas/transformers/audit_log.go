@@ -0,0 +1,61 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func ToAuditLog(auditLog *dbsqlc.AuditLog) *gen.AuditLog {
+	res := &gen.AuditLog{
+		Metadata:  *toAPIMetadata(sqlchelpers.UUIDToStr(auditLog.ID), auditLog.CreatedAt.Time, auditLog.CreatedAt.Time),
+		ActorType: auditLog.ActorType,
+		Action:    auditLog.Action,
+	}
+
+	if auditLog.ActorId.Valid {
+		actorId := uuid.UUID(auditLog.ActorId.Bytes)
+		res.ActorId = &actorId
+	}
+
+	if auditLog.ResourceType.Valid {
+		res.ResourceType = &auditLog.ResourceType.String
+	}
+
+	if auditLog.ResourceId.Valid {
+		resourceId := uuid.UUID(auditLog.ResourceId.Bytes)
+		res.ResourceId = &resourceId
+	}
+
+	if auditLog.IpAddress.Valid {
+		res.IpAddress = &auditLog.IpAddress.String
+	}
+
+	if auditLog.Payload != nil {
+		payload := map[string]interface{}{}
+
+		if err := json.Unmarshal(auditLog.Payload, &payload); err == nil {
+			res.Payload = &payload
+		}
+	}
+
+	if auditLog.PriorState != nil {
+		priorState := map[string]interface{}{}
+
+		if err := json.Unmarshal(auditLog.PriorState, &priorState); err == nil {
+			res.PriorState = &priorState
+		}
+	}
+
+	return res
+}
+
+func ToAuditLogSettings(settings *dbsqlc.TenantAuditLogSettings) *gen.AuditLogSettings {
+	return &gen.AuditLogSettings{
+		RetentionPeriod: settings.RetentionPeriod,
+	}
+}
@@ -3,6 +3,9 @@ package transformers
 import (
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
@@ -19,6 +22,11 @@ func ToEvent(event *db.EventModel) *gen.Event {
 		TenantId: event.TenantID,
 	}
 
+	if replayedFromId, ok := event.ReplayedFromID(); ok {
+		id := uuid.MustParse(replayedFromId)
+		res.ReplayedFromId = &id
+	}
+
 	return res
 }
 
@@ -34,10 +42,21 @@ func ToEventList(events []*dbsqlc.Event) []gen.Event {
 
 func dbslqEventToEvent(event *dbsqlc.Event) gen.Event {
 	return gen.Event{
-		Metadata: *toAPIMetadata(sqlchelpers.UUIDToStr(event.ID), event.CreatedAt.Time, event.UpdatedAt.Time),
-		Key:      event.Key,
-		TenantId: pgUUIDToStr(event.TenantId),
+		Metadata:       *toAPIMetadata(sqlchelpers.UUIDToStr(event.ID), event.CreatedAt.Time, event.UpdatedAt.Time),
+		Key:            event.Key,
+		TenantId:       pgUUIDToStr(event.TenantId),
+		ReplayedFromId: replayedFromId(event),
+	}
+}
+
+func replayedFromId(event *dbsqlc.Event) *uuid.UUID {
+	if !event.ReplayedFromId.Valid {
+		return nil
 	}
+
+	id := uuid.UUID(event.ReplayedFromId.Bytes)
+
+	return &id
 }
 
 func ToEventFromSQLC(eventRow *dbsqlc.ListEventsRow) (*gen.Event, error) {
@@ -57,6 +76,7 @@ func ToEventFromSQLC(eventRow *dbsqlc.ListEventsRow) (*gen.Event, error) {
 		Key:                event.Key,
 		TenantId:           pgUUIDToStr(event.TenantId),
 		AdditionalMetadata: &metadata,
+		ReplayedFromId:     replayedFromId(&event),
 	}
 
 	res.WorkflowRunSummary = &gen.EventWorkflowRunSummary{
@@ -70,6 +90,34 @@ func ToEventFromSQLC(eventRow *dbsqlc.ListEventsRow) (*gen.Event, error) {
 	return res, nil
 }
 
+// ToEventWorkflowRun returns a workflow run which was triggered by an event. The dispatch latency is the
+// time between the creation of the event and the creation of the workflow run.
+func ToEventWorkflowRun(eventCreatedAt time.Time, run *dbsqlc.ListEventWorkflowRunsRow) gen.EventWorkflowRun {
+	res := gen.EventWorkflowRun{
+		WorkflowRunId:     uuid.MustParse(sqlchelpers.UUIDToStr(run.WorkflowRunId)),
+		Status:            gen.WorkflowRunStatus(run.Status),
+		WorkflowId:        uuid.MustParse(sqlchelpers.UUIDToStr(run.WorkflowId)),
+		WorkflowName:      run.WorkflowName,
+		WorkflowVersionId: uuid.MustParse(sqlchelpers.UUIDToStr(run.WorkflowVersionId)),
+		CreatedAt:         run.CreatedAt.Time,
+		DispatchLatency:   run.CreatedAt.Time.Sub(eventCreatedAt).Milliseconds(),
+	}
+
+	if run.DisplayName.Valid {
+		res.DisplayName = &run.DisplayName.String
+	}
+
+	if run.StartedAt.Valid {
+		res.StartedAt = &run.StartedAt.Time
+	}
+
+	if run.FinishedAt.Valid {
+		res.FinishedAt = &run.FinishedAt.Time
+	}
+
+	return res
+}
+
 func pgUUIDToStr(uuid pgtype.UUID) string {
 	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid.Bytes[0:4], uuid.Bytes[4:6], uuid.Bytes[6:8], uuid.Bytes[8:10], uuid.Bytes[10:16])
 }
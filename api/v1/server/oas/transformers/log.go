@@ -11,6 +11,7 @@ func ToLogFromSQLC(log *dbsqlc.LogLine) *gen.LogLine {
 	res := &gen.LogLine{
 		CreatedAt: log.CreatedAt.Time,
 		Message:   log.Message,
+		Level:     gen.LogLineLevel(log.Level),
 	}
 
 	if log.Metadata != nil {
@@ -3,6 +3,8 @@ package transformers
 import (
 	"strings"
 
+	"github.com/google/uuid"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
@@ -29,17 +31,21 @@ func ToTenantSqlc(tenant *dbsqlc.Tenant) *gen.Tenant {
 	}
 }
 
-func ToTenantAlertingSettings(alerting *db.TenantAlertingSettingsModel) *gen.TenantAlertingSettings {
+func ToTenantAlertingSettings(alerting *dbsqlc.TenantAlertingSettings) *gen.TenantAlertingSettings {
+	workflowRunFailureThreshold := int(alerting.WorkflowRunFailureThreshold)
+
 	res := &gen.TenantAlertingSettings{
-		Metadata:                        *toAPIMetadata(alerting.ID, alerting.CreatedAt, alerting.UpdatedAt),
+		Metadata:                        *toAPIMetadata(sqlchelpers.UUIDToStr(alerting.ID), alerting.CreatedAt.Time, alerting.UpdatedAt.Time),
 		MaxAlertingFrequency:            alerting.MaxFrequency,
 		EnableExpiringTokenAlerts:       &alerting.EnableExpiringTokenAlerts,
 		EnableWorkflowRunFailureAlerts:  &alerting.EnableWorkflowRunFailureAlerts,
 		EnableTenantResourceLimitAlerts: &alerting.EnableTenantResourceLimitAlerts,
+		EnableWorkerOfflineAlerts:       &alerting.EnableWorkerOfflineAlerts,
+		WorkflowRunFailureThreshold:     &workflowRunFailureThreshold,
 	}
 
-	if lastAlertedAt, ok := alerting.LastAlertedAt(); ok {
-		res.LastAlertedAt = &lastAlertedAt
+	if alerting.LastAlertedAt.Valid {
+		res.LastAlertedAt = &alerting.LastAlertedAt.Time
 	}
 
 	return res
@@ -54,7 +60,24 @@ func ToTenantAlertEmailGroup(group *db.TenantAlertEmailGroupModel) *gen.TenantAl
 	}
 }
 
-func ToTenantResourcePolicy(_limits []*dbsqlc.TenantResourceLimit) *gen.TenantResourcePolicy {
+func ToTenantAlertMuteRule(rule *dbsqlc.TenantAlertMuteRule) *gen.TenantAlertMuteRule {
+	res := &gen.TenantAlertMuteRule{
+		Metadata:   *toAPIMetadata(sqlchelpers.UUIDToStr(rule.ID), rule.CreatedAt.Time, rule.CreatedAt.Time),
+		WorkflowId: uuid.UUID(rule.WorkflowId.Bytes),
+	}
+
+	if rule.MutedUntil.Valid {
+		res.MutedUntil = &rule.MutedUntil.Time
+	}
+
+	if rule.Reason.Valid {
+		res.Reason = &rule.Reason.String
+	}
+
+	return res
+}
+
+func ToTenantResourcePolicy(_limits []*dbsqlc.TenantResourceLimit, dataRetentionPeriod string) *gen.TenantResourcePolicy {
 
 	limits := make([]gen.TenantResourceLimit, len(_limits))
 
@@ -82,6 +105,27 @@ func ToTenantResourcePolicy(_limits []*dbsqlc.TenantResourceLimit) *gen.TenantRe
 	}
 
 	return &gen.TenantResourcePolicy{
-		Limits: limits,
+		Limits:              limits,
+		DataRetentionPeriod: &dataRetentionPeriod,
 	}
 }
+
+func ToTenantRole(role *dbsqlc.TenantRole) *gen.TenantRole {
+	permissions := make([]gen.TenantPermission, len(role.Permissions))
+
+	for i, permission := range role.Permissions {
+		permissions[i] = gen.TenantPermission(permission)
+	}
+
+	res := &gen.TenantRole{
+		Metadata:    *toAPIMetadata(sqlchelpers.UUIDToStr(role.ID), role.CreatedAt.Time, role.UpdatedAt.Time),
+		Name:        role.Name,
+		Permissions: permissions,
+	}
+
+	if role.Description.Valid {
+		res.Description = &role.Description.String
+	}
+
+	return res
+}
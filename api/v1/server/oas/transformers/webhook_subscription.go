@@ -0,0 +1,79 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func ToWebhookSubscription(subscription *dbsqlc.WebhookSubscription) *gen.WebhookSubscription {
+	return &gen.WebhookSubscription{
+		Metadata:   *toAPIMetadata(sqlchelpers.UUIDToStr(subscription.ID), subscription.CreatedAt.Time, subscription.UpdatedAt.Time),
+		Name:       subscription.Name,
+		Url:        subscription.Url,
+		EventTypes: toWebhookEventTypes(subscription.EventTypes),
+		Enabled:    subscription.Enabled,
+	}
+}
+
+func ToWebhookSubscriptionCreated(subscription *dbsqlc.WebhookSubscription) *gen.WebhookSubscriptionCreated {
+	return &gen.WebhookSubscriptionCreated{
+		Metadata:   *toAPIMetadata(sqlchelpers.UUIDToStr(subscription.ID), subscription.CreatedAt.Time, subscription.UpdatedAt.Time),
+		Name:       subscription.Name,
+		Url:        subscription.Url,
+		EventTypes: toWebhookEventTypes(subscription.EventTypes),
+		Enabled:    subscription.Enabled,
+		Secret:     subscription.Secret,
+	}
+}
+
+func ToWebhookDelivery(delivery *dbsqlc.WebhookDelivery) *gen.WebhookDelivery {
+	res := &gen.WebhookDelivery{
+		Metadata:       *toAPIMetadata(sqlchelpers.UUIDToStr(delivery.ID), delivery.CreatedAt.Time, delivery.CreatedAt.Time),
+		SubscriptionId: uuid.UUID(delivery.SubscriptionId.Bytes),
+		EventType:      gen.WebhookEventType(delivery.EventType),
+		Status:         gen.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       int(delivery.Attempts),
+	}
+
+	if delivery.Status == dbsqlc.WebhookDeliveryStatusPENDING {
+		res.NextAttemptAt = &delivery.NextAttemptAt.Time
+	}
+
+	if delivery.LastAttemptAt.Valid {
+		res.LastAttemptAt = &delivery.LastAttemptAt.Time
+	}
+
+	if delivery.ResponseStatusCode.Valid {
+		responseStatusCode := int(delivery.ResponseStatusCode.Int32)
+		res.ResponseStatusCode = &responseStatusCode
+	}
+
+	if delivery.Error.Valid {
+		res.Error = &delivery.Error.String
+	}
+
+	if delivery.Payload != nil {
+		payload := map[string]interface{}{}
+
+		if err := json.Unmarshal(delivery.Payload, &payload); err == nil {
+			res.Payload = &payload
+		}
+	}
+
+	return res
+}
+
+func toWebhookEventTypes(eventTypes []string) []gen.WebhookEventType {
+	res := make([]gen.WebhookEventType, len(eventTypes))
+
+	for i, eventType := range eventTypes {
+		res[i] = gen.WebhookEventType(eventType)
+	}
+
+	return res
+}
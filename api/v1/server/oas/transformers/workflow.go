@@ -3,10 +3,12 @@ package transformers
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -309,3 +311,42 @@ func ToWorkflowVersionFromSQLC(row *dbsqlc.WorkflowVersion, workflow *gen.Workfl
 
 	return res
 }
+
+func ToWorkflowVersionWeights(weights *repository.WorkflowVersionWeights, stats []*dbsqlc.CountWorkflowRunsByVersionRow) *gen.WorkflowVersionWeights {
+	res := &gen.WorkflowVersionWeights{
+		Weights: make([]gen.WorkflowVersionWeight, len(weights.Weights)),
+		Stats:   make([]gen.WorkflowVersionRunStats, len(stats)),
+	}
+
+	if weights.LatestWeight > 0 {
+		res.LatestWeight = &weights.LatestWeight
+	}
+
+	for i, w := range weights.Weights {
+		res.Weights[i] = gen.WorkflowVersionWeight{
+			WorkflowVersionId: uuid.MustParse(sqlchelpers.UUIDToStr(w.WorkflowVersionId)),
+			Weight:            w.Weight,
+		}
+	}
+
+	for i, s := range stats {
+		res.Stats[i] = gen.WorkflowVersionRunStats{
+			WorkflowVersionId: uuid.MustParse(sqlchelpers.UUIDToStr(s.WorkflowVersionId)),
+			Total:             s.Total,
+			Succeeded:         s.Succeeded,
+			Failed:            s.Failed,
+			Cancelled:         s.Cancelled,
+		}
+
+		// the rates are relative to the runs which have finished
+		if finished := s.Succeeded + s.Failed + s.Cancelled; finished > 0 {
+			successRate := float64(s.Succeeded) / float64(finished)
+			errorRate := float64(s.Failed) / float64(finished)
+
+			res.Stats[i].SuccessRate = &successRate
+			res.Stats[i].ErrorRate = &errorRate
+		}
+	}
+
+	return res
+}
@@ -353,6 +353,28 @@ func ToStepRunEvent(stepRunEvent *dbsqlc.StepRunEvent) *gen.StepRunEvent {
 	return res
 }
 
+func ToStepRunDeadLetter(deadLetter *dbsqlc.ListStepRunDeadLettersRow) *gen.StepRunDeadLetter {
+	res := &gen.StepRunDeadLetter{
+		StepRunId:      uuid.MustParse(sqlchelpers.UUIDToStr(deadLetter.StepRunId)),
+		JobRunId:       uuid.MustParse(sqlchelpers.UUIDToStr(deadLetter.JobRunId)),
+		WorkflowRunId:  uuid.MustParse(sqlchelpers.UUIDToStr(deadLetter.WorkflowRunId)),
+		WorkflowName:   deadLetter.WorkflowName,
+		ActionId:       deadLetter.ActionId,
+		RetryCount:     int(deadLetter.RetryCount),
+		DeadLetteredAt: deadLetter.CreatedAt.Time,
+	}
+
+	if deadLetter.StepReadableId.Valid {
+		res.StepReadableId = &deadLetter.StepReadableId.String
+	}
+
+	if deadLetter.Error.Valid {
+		res.Error = &deadLetter.Error.String
+	}
+
+	return res
+}
+
 func ToStepRunArchive(stepRunArchive *dbsqlc.StepRunResultArchive) *gen.StepRunArchive {
 	res := &gen.StepRunArchive{
 		CreatedAt:  stepRunArchive.CreatedAt.Time,
@@ -439,9 +461,21 @@ func ToWorkflowRunTriggeredBy(parentWorkflowRunId pgtype.UUID, triggeredBy *dbsq
 		res.CronSchedule = &triggeredBy.CronSchedule.String
 	}
 
+	setWorkflowRunTriggeredByActor(res, triggeredBy)
+
 	return res
 }
 
+func setWorkflowRunTriggeredByActor(res *gen.WorkflowRunTriggeredBy, triggeredBy *dbsqlc.WorkflowRunTriggeredBy) {
+	if triggeredBy.ActorType.Valid && triggeredBy.ActorId.Valid {
+		actorType := gen.WorkflowRunActorType(triggeredBy.ActorType.String)
+		actorId := sqlchelpers.UUIDToStr(triggeredBy.ActorId)
+
+		res.ActorType = &actorType
+		res.ActorId = &actorId
+	}
+}
+
 func ToWorkflowRunFromSQLC(row *dbsqlc.ListWorkflowRunsRow) *gen.WorkflowRun {
 	run := row.WorkflowRun
 	runTriggeredBy := row.WorkflowRunTriggeredBy
@@ -469,6 +503,8 @@ func ToWorkflowRunFromSQLC(row *dbsqlc.ListWorkflowRunsRow) *gen.WorkflowRun {
 		triggeredBy.ParentWorkflowRunId = &parentId
 	}
 
+	setWorkflowRunTriggeredByActor(triggeredBy, &runTriggeredBy)
+
 	workflowRunId := sqlchelpers.UUIDToStr(run.ID)
 
 	var additionalMetadata map[string]interface{}
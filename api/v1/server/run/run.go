@@ -14,6 +14,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
 	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
 	apitokens "github.com/hatchet-dev/hatchet/api/v1/server/handlers/api-tokens"
+	auditlogs "github.com/hatchet-dev/hatchet/api/v1/server/handlers/audit-logs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/events"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/ingestors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/logs"
@@ -24,18 +25,22 @@ import (
 	stepruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/step-runs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/tenants"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/users"
+	webhooksubscriptions "github.com/hatchet-dev/hatchet/api/v1/server/handlers/webhook-subscriptions"
 	webhookworker "github.com/hatchet-dev/hatchet/api/v1/server/handlers/webhook-worker"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workers"
 	workflowruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/workflow-runs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workflows"
 	hatchetmiddleware "github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/populator"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 type apiService struct {
+	*auditlogs.AuditLogService
 	*users.UserService
 	*tenants.TenantService
 	*events.EventService
@@ -49,27 +54,30 @@ type apiService struct {
 	*ingestors.IngestorsService
 	*slackapp.SlackAppService
 	*webhookworker.WebhookWorkersService
+	*webhooksubscriptions.WebhookSubscriptionService
 	*workflowruns.WorkflowRunsService
 	*monitoring.MonitoringService
 }
 
 func newAPIService(config *server.ServerConfig) *apiService {
 	return &apiService{
-		UserService:           users.NewUserService(config),
-		TenantService:         tenants.NewTenantService(config),
-		EventService:          events.NewEventService(config),
-		RateLimitService:      rate_limits.NewRateLimitService(config),
-		LogService:            logs.NewLogService(config),
-		WorkflowService:       workflows.NewWorkflowService(config),
-		WorkflowRunsService:   workflowruns.NewWorkflowRunsService(config),
-		WorkerService:         workers.NewWorkerService(config),
-		MetadataService:       metadata.NewMetadataService(config),
-		APITokenService:       apitokens.NewAPITokenService(config),
-		StepRunService:        stepruns.NewStepRunService(config),
-		IngestorsService:      ingestors.NewIngestorsService(config),
-		SlackAppService:       slackapp.NewSlackAppService(config),
-		WebhookWorkersService: webhookworker.NewWebhookWorkersService(config),
-		MonitoringService:     monitoring.NewMonitoringService(config),
+		AuditLogService:            auditlogs.NewAuditLogService(config),
+		UserService:                users.NewUserService(config),
+		TenantService:              tenants.NewTenantService(config),
+		EventService:               events.NewEventService(config),
+		RateLimitService:           rate_limits.NewRateLimitService(config),
+		LogService:                 logs.NewLogService(config),
+		WorkflowService:            workflows.NewWorkflowService(config),
+		WorkflowRunsService:        workflowruns.NewWorkflowRunsService(config),
+		WorkerService:              workers.NewWorkerService(config),
+		MetadataService:            metadata.NewMetadataService(config),
+		APITokenService:            apitokens.NewAPITokenService(config),
+		StepRunService:             stepruns.NewStepRunService(config),
+		IngestorsService:           ingestors.NewIngestorsService(config),
+		SlackAppService:            slackapp.NewSlackAppService(config),
+		WebhookWorkersService:      webhookworker.NewWebhookWorkersService(config),
+		WebhookSubscriptionService: webhooksubscriptions.NewWebhookSubscriptionService(config),
+		MonitoringService:          monitoring.NewMonitoringService(config),
 	}
 }
 
@@ -205,6 +213,16 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return tenantInvite, tenantInvite.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("tenant-role", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		role, err := config.APIRepository.TenantRole().GetTenantRoleById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return role, sqlchelpers.UUIDToStr(role.TenantId), nil
+	})
+
 	populatorMW.RegisterGetter("slack", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		slackWebhook, err := config.APIRepository.Slack().GetSlackWebhookById(id)
 
@@ -225,6 +243,16 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return emailGroup, emailGroup.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("alert-mute-rule", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		rule, err := config.APIRepository.TenantAlertingSettings().GetTenantAlertMuteRuleById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return rule, sqlchelpers.UUIDToStr(rule.TenantId), nil
+	})
+
 	populatorMW.RegisterGetter("sns", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		snsIntegration, err := config.APIRepository.SNS().GetSNSIntegrationById(id)
 
@@ -327,8 +355,29 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return webhookWorker, webhookWorker.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("webhook-subscription", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		subscription, err := config.APIRepository.WebhookSubscription().GetWebhookSubscriptionById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return subscription, sqlchelpers.UUIDToStr(subscription.TenantId), nil
+	})
+
+	populatorMW.RegisterGetter("webhook-delivery", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		delivery, err := config.APIRepository.WebhookSubscription().GetWebhookDeliveryById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return delivery, sqlchelpers.UUIDToStr(delivery.TenantId), nil
+	})
+
 	authnMW := authn.NewAuthN(t.config)
 	authzMW := authz.NewAuthZ(t.config)
+	auditMW := audit.NewAuditLogger(t.config)
 
 	mw, err := hatchetmiddleware.NewMiddlewareHandler(spec)
 
@@ -339,6 +388,7 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 	mw.Use(populatorMW.Middleware)
 	mw.Use(authnMW.Middleware)
 	mw.Use(authzMW.Middleware)
+	mw.Use(auditMW.Middleware)
 
 	allHatchetMiddleware, err := mw.Middleware()
 
@@ -365,15 +415,17 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 				statusCode = 500
 			}
 
+			l := serverutils.RequestLogger(c.Request().Context(), t.config.Logger)
+
 			var e *zerolog.Event
 
 			switch {
 			case statusCode >= 500:
-				e = t.config.Logger.Error().Err(v.Error)
+				e = l.Error().Err(v.Error)
 			case statusCode >= 400:
-				e = t.config.Logger.Warn()
+				e = l.Warn()
 			default:
-				e = t.config.Logger.Info()
+				e = l.Info()
 			}
 
 			e.
@@ -392,8 +444,11 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 
 	// register echo middleware
 	g.Use(
+		serverutils.RequestIDMiddleware(),
+		serverutils.MetricsMiddleware(),
 		loggerMiddleware,
 		middleware.Recover(),
+		auditMW.Record,
 		allHatchetMiddleware,
 	)
 
@@ -0,0 +1,54 @@
+package serverutils
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
+)
+
+// MetricsMiddleware counts the requests of the API and records how long they take. Requests are labeled
+// with the route they matched rather than their path, so that IDs in paths don't create a label value
+// per resource.
+func MetricsMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			err := next(c)
+
+			route := c.Path()
+
+			if route == "" {
+				route = "unmatched"
+			}
+
+			method := c.Request().Method
+
+			metrics.APIRequests.WithLabelValues(method, route, strconv.Itoa(responseStatus(c, err))).Inc()
+			metrics.APIRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
+
+// responseStatus returns the status code of the response. Errors are only written to the response by
+// the error handler of echo, after the middleware has returned, so their status is derived from the error.
+func responseStatus(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+
+	var httpErr *echo.HTTPError
+
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,74 @@
+package serverutils
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+// maxRequestIDLength is the maximum length of a request ID which is accepted from a client.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDMiddleware assigns an ID to each request, which is taken from the X-Request-ID header if the
+// client sent a valid one. The ID is returned in the X-Request-ID response header and stored in the
+// context of the request, so that RequestLogger includes it in log lines.
+func RequestIDMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			id := req.Header.Get(echo.HeaderXRequestID)
+
+			if !isValidRequestID(id) {
+				id = uuid.New().String()
+			}
+
+			c.Response().Header().Set(echo.HeaderXRequestID, id)
+			c.SetRequest(req.WithContext(context.WithValue(req.Context(), requestIDKey{}, id)))
+
+			return next(c)
+		}
+	}
+}
+
+// RequestIDFromContext returns the ID of the request, or an empty string if the context doesn't belong
+// to a request.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+
+	return id
+}
+
+// RequestLogger returns a logger which includes the ID of the request in its log lines, or l if the
+// context doesn't belong to a request.
+func RequestLogger(ctx context.Context, l *zerolog.Logger) *zerolog.Logger {
+	id := RequestIDFromContext(ctx)
+
+	if id == "" {
+		return l
+	}
+
+	reqLogger := l.With().Str("request_id", id).Logger()
+
+	return &reqLogger
+}
+
+// isValidRequestID checks that a client-provided request ID is short and printable, so that it can be
+// written to logs and response headers as-is.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
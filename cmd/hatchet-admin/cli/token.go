@@ -96,7 +96,7 @@ func runCreateAPIToken(expiresIn time.Duration) error {
 		tenantId = serverConf.Seed.DefaultTenantID
 	}
 
-	defaultTok, err := serverConf.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, tokenName, false, &expiresAt)
+	defaultTok, err := serverConf.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, tokenName, false, &expiresAt, nil, nil)
 
 	if err != nil {
 		return err
@@ -3,7 +3,10 @@ package api
 import (
 	"fmt"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/run"
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
 	"github.com/hatchet-dev/hatchet/pkg/config/loader"
 )
 
@@ -28,6 +31,23 @@ func Start(cf *loader.ConfigLoader, interruptCh <-chan interface{}, version stri
 	}
 
 	teardown = append(teardown, apiCleanup)
+
+	if sc.Prometheus.Enabled {
+		metricsCleanup, err := metrics.Start(sc.Prometheus.Address, sc.Prometheus.Path)
+		if err != nil {
+			return fmt.Errorf("error starting metrics server: %w", err)
+		}
+
+		err = metrics.RegisterPoolCollector(map[string]*pgxpool.Pool{
+			"default":   sc.Pool,
+			"essential": sc.EssentialPool,
+		})
+		if err != nil {
+			return fmt.Errorf("error registering database pool metrics: %w", err)
+		}
+
+		teardown = append(teardown, metricsCleanup)
+	}
 	teardown = append(teardown, configCleanup)
 
 	sc.Logger.Debug().Msgf("api started successfully")
@@ -7,6 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/runwebhooks"
 	"github.com/hatchet-dev/hatchet/internal/services/admin"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/events"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/jobs"
@@ -16,11 +19,13 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/grpc"
 	"github.com/hatchet-dev/hatchet/internal/services/health"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor/kafka"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
 	"github.com/hatchet-dev/hatchet/internal/services/scheduler"
 	"github.com/hatchet-dev/hatchet/internal/services/ticker"
 	"github.com/hatchet-dev/hatchet/internal/services/webhooks"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
 	"github.com/hatchet-dev/hatchet/pkg/config/loader"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
@@ -117,6 +122,83 @@ func RunWithConfig(ctx context.Context, sc *server.ServerConfig) ([]Teardown, er
 	return runV0Config(ctx, sc)
 }
 
+// startMetrics serves the Prometheus metrics of the engine, including the connection statistics of the
+// database pools and the queue depths and worker slots of all tenants.
+func startMetrics(sc *server.ServerConfig) (func() error, error) {
+	cleanup, err := metrics.Start(sc.Prometheus.Address, sc.Prometheus.Path)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not start metrics server: %w", err)
+	}
+
+	err = metrics.RegisterPoolCollector(map[string]*pgxpool.Pool{
+		"default":   sc.Pool,
+		"essential": sc.EssentialPool,
+	})
+
+	if err != nil {
+		_ = cleanup()
+		return nil, fmt.Errorf("could not register database pool metrics: %w", err)
+	}
+
+	if err := metrics.RegisterEngineCollector(sc.EngineRepository.Metrics(), sc.Logger); err != nil {
+		_ = cleanup()
+		return nil, fmt.Errorf("could not register engine metrics: %w", err)
+	}
+
+	return cleanup, nil
+}
+
+// startKafkaBridge consumes the configured Kafka topics and pushes their records as events.
+func startKafkaBridge(sc *server.ServerConfig) (func() error, error) {
+	topics := make([]kafka.Topic, 0, len(sc.Kafka.Topics))
+
+	for _, t := range sc.Kafka.Topics {
+		headers := make(map[string]string, len(t.Headers))
+
+		for _, h := range t.Headers {
+			metadataKey := h.MetadataKey
+
+			if metadataKey == "" {
+				metadataKey = h.Header
+			}
+
+			headers[h.Header] = metadataKey
+		}
+
+		topics = append(topics, kafka.Topic{
+			Name:     t.Topic,
+			TenantId: t.TenantId,
+			EventKey: t.EventKey,
+			Headers:  headers,
+		})
+	}
+
+	b, err := kafka.New(
+		kafka.WithIngestor(sc.Ingestor),
+		kafka.WithLogger(sc.Logger),
+		kafka.WithBrokers(sc.Kafka.Brokers...),
+		kafka.WithGroupID(sc.Kafka.GroupID),
+		kafka.WithStartOffset(sc.Kafka.StartOffset),
+		kafka.WithDeduplication(sc.Kafka.Deduplicate),
+		kafka.WithTopics(topics...),
+		kafka.WithTLS(sc.KafkaTLSConfig),
+		kafka.WithSASL(sc.Kafka.SASL.Mechanism, sc.Kafka.SASL.Username, sc.Kafka.SASL.Password),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create kafka bridge: %w", err)
+	}
+
+	cleanup, err := b.Start()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not start kafka bridge: %w", err)
+	}
+
+	return cleanup, nil
+}
+
 func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, error) {
 	var l = sc.Logger
 
@@ -158,12 +240,26 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		})
 	}
 
+	if sc.Prometheus.Enabled {
+		cleanup, err := startMetrics(sc)
+
+		if err != nil {
+			return nil, err
+		}
+
+		teardown = append(teardown, Teardown{
+			Name: "metrics",
+			Fn:   cleanup,
+		})
+	}
+
 	if sc.HasService("eventscontroller") {
 		ec, err := events.New(
 			events.WithMessageQueue(sc.MessageQueue),
 			events.WithRepository(sc.EngineRepository),
 			events.WithLogger(sc.Logger),
 			events.WithEntitlementsRepository(sc.EntitlementRepository),
+			events.WithMaxBufferedOrderedEvents(sc.Runtime.MaxBufferedOrderedEvents),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("could not create events controller: %w", err)
@@ -238,6 +334,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			ticker.WithTenantAlerter(sc.TenantAlerter),
 			ticker.WithEntitlementsRepository(sc.EntitlementRepository),
 			ticker.WithPartition(p),
+			ticker.WithOAuthTokenRefresh(sc.APIRepository.User(), sc.Auth.OAuthTokenRefresher.Refresh),
 		)
 
 		if err != nil {
@@ -309,6 +406,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			retention.WithPartition(p),
 			retention.WithDataRetention(sc.EnableDataRetention),
 			retention.WithWorkerRetention(sc.EnableWorkerRetention),
+			retention.WithDataPurge(sc.EnableDataPurge, sc.DataPurgeDelay),
 		)
 
 		if err != nil {
@@ -336,6 +434,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			dispatcher.WithLogger(sc.Logger),
 			dispatcher.WithEntitlementsRepository(sc.EntitlementRepository),
 			dispatcher.WithCache(cacheInstance),
+			dispatcher.WithHeartbeatInterval(sc.Runtime.WorkerHeartbeatInterval),
 		)
 
 		if err != nil {
@@ -360,6 +459,8 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			),
 			ingestor.WithMessageQueue(sc.MessageQueue),
 			ingestor.WithEntitlementsRepository(sc.EntitlementRepository),
+			ingestor.WithStepRunRepository(sc.EngineRepository.StepRun()),
+			ingestor.WithMaxQueueDepth(sc.Runtime.MaxEventQueueDepth, sc.Runtime.EventQueueDepthRetryAfter),
 		)
 
 		if err != nil {
@@ -370,6 +471,8 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			admin.WithRepository(sc.EngineRepository),
 			admin.WithMessageQueue(sc.MessageQueue),
 			admin.WithEntitlementsRepository(sc.EntitlementRepository),
+			admin.WithLogger(sc.Logger),
+			admin.WithVersion(sc.Version),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("could not create admin service: %w", err)
@@ -436,6 +539,19 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			Name: "grpc",
 			Fn:   cleanup,
 		})
+
+		if sc.Kafka.Enabled {
+			cleanupKafka, err := startKafkaBridge(sc)
+
+			if err != nil {
+				return nil, err
+			}
+
+			teardown = append(teardown, Teardown{
+				Name: "kafka bridge",
+				Fn:   cleanupKafka,
+			})
+		}
 	}
 
 	if sc.HasService("webhookscontroller") {
@@ -461,6 +577,16 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			Name: "webhook worker",
 			Fn:   cleanup2,
 		})
+
+		cleanupRunWebhooks, err := runwebhooks.NewDeliverer(sc).Start()
+		if err != nil {
+			return nil, fmt.Errorf("could not start run webhooks deliverer: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name: "run webhooks deliverer",
+			Fn:   cleanupRunWebhooks,
+		})
 	}
 
 	teardown = append(teardown, Teardown{
@@ -529,6 +655,19 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		})
 	}
 
+	if sc.Prometheus.Enabled {
+		cleanup, err := startMetrics(sc)
+
+		if err != nil {
+			return nil, err
+		}
+
+		teardown = append(teardown, Teardown{
+			Name: "metrics",
+			Fn:   cleanup,
+		})
+	}
+
 	if sc.HasService("all") || sc.HasService("controllers") {
 		partitionCleanup, err := p.StartControllerPartition(ctx)
 
@@ -546,6 +685,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			events.WithRepository(sc.EngineRepository),
 			events.WithLogger(sc.Logger),
 			events.WithEntitlementsRepository(sc.EntitlementRepository),
+			events.WithMaxBufferedOrderedEvents(sc.Runtime.MaxBufferedOrderedEvents),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("could not create events controller: %w", err)
@@ -568,6 +708,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			ticker.WithTenantAlerter(sc.TenantAlerter),
 			ticker.WithEntitlementsRepository(sc.EntitlementRepository),
 			ticker.WithPartition(p),
+			ticker.WithOAuthTokenRefresh(sc.APIRepository.User(), sc.Auth.OAuthTokenRefresher.Refresh),
 		)
 
 		if err != nil {
@@ -642,6 +783,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			retention.WithPartition(p),
 			retention.WithDataRetention(sc.EnableDataRetention),
 			retention.WithWorkerRetention(sc.EnableWorkerRetention),
+			retention.WithDataPurge(sc.EnableDataPurge, sc.DataPurgeDelay),
 		)
 
 		if err != nil {
@@ -682,6 +824,17 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			Name: "webhook worker",
 			Fn:   cleanup2,
 		})
+
+		cleanupRunWebhooks, err := runwebhooks.NewDeliverer(sc).Start()
+
+		if err != nil {
+			return nil, fmt.Errorf("could not start run webhooks deliverer: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name: "run webhooks deliverer",
+			Fn:   cleanupRunWebhooks,
+		})
 	}
 
 	if sc.HasService("all") || sc.HasService("grpc-api") {
@@ -695,6 +848,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			dispatcher.WithLogger(sc.Logger),
 			dispatcher.WithEntitlementsRepository(sc.EntitlementRepository),
 			dispatcher.WithCache(cacheInstance),
+			dispatcher.WithHeartbeatInterval(sc.Runtime.WorkerHeartbeatInterval),
 		)
 
 		if err != nil {
@@ -720,6 +874,8 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			),
 			ingestor.WithMessageQueue(sc.MessageQueue),
 			ingestor.WithEntitlementsRepository(sc.EntitlementRepository),
+			ingestor.WithStepRunRepository(sc.EngineRepository.StepRun()),
+			ingestor.WithMaxQueueDepth(sc.Runtime.MaxEventQueueDepth, sc.Runtime.EventQueueDepthRetryAfter),
 		)
 
 		if err != nil {
@@ -730,6 +886,8 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			admin.WithRepository(sc.EngineRepository),
 			admin.WithMessageQueue(sc.MessageQueue),
 			admin.WithEntitlementsRepository(sc.EntitlementRepository),
+			admin.WithLogger(sc.Logger),
+			admin.WithVersion(sc.Version),
 		)
 
 		if err != nil {
@@ -797,6 +955,19 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			Name: "grpc",
 			Fn:   cleanup,
 		})
+
+		if sc.Kafka.Enabled {
+			cleanupKafka, err := startKafkaBridge(sc)
+
+			if err != nil {
+				return nil, err
+			}
+
+			teardown = append(teardown, Teardown{
+				Name: "kafka bridge",
+				Fn:   cleanupKafka,
+			})
+		}
 	}
 
 	if sc.HasService("all") || sc.HasService("scheduler") {
@@ -39,8 +39,7 @@ func run(events chan<- string) (func() error, error) {
 		// time the function duration
 		start := time.Now()
 		err := next(ctx)
-		duration := time.Since(start)
-		fmt.Printf("step function took %s\n", duration)
+		logStepDuration(ctx.GetContext(), time.Since(start))
 		return err
 	})
 
@@ -131,3 +130,14 @@ func run(events chan<- string) (func() error, error) {
 
 	return cleanup, nil
 }
+
+// logStepDuration only receives a context.Context, like most logging and metrics libraries, and labels
+// the duration with the name of the step which the context belongs to.
+func logStepDuration(ctx context.Context, duration time.Duration) {
+	if stepCtx, ok := worker.GetStepRunContext(ctx); ok {
+		fmt.Printf("step %s took %s\n", stepCtx.StepName(), duration)
+		return
+	}
+
+	fmt.Printf("step function took %s\n", duration)
+}
@@ -106,7 +106,7 @@ func run(events chan<- string) (func() error, error) {
 						for i, childWorkflow := range childWorkflows {
 							eg.Go(func(i int, childWorkflow *client.Workflow) func() error {
 								return func() error {
-									childResult, err := childWorkflow.Result()
+									childResult, err := childWorkflow.ResultWithContext(ctx)
 
 									if err != nil {
 										return err
@@ -14,16 +14,23 @@ import {
   APIErrors,
   APIMeta,
   AcceptInviteRequest,
+  AuditLogList,
+  AuditLogSettings,
   BulkCreateEventRequest,
   CancelEventRequest,
+  CancelWorkflowRunsRequest,
+  CancelWorkflowRunsResponse,
   CreateAPITokenRequest,
   CreateAPITokenResponse,
   CreateCronWorkflowTriggerRequest,
   CreateEventRequest,
   CreateSNSIntegrationRequest,
   CreateTenantAlertEmailGroupRequest,
+  CreateTenantAlertMuteRuleRequest,
   CreateTenantInviteRequest,
   CreateTenantRequest,
+  CreateTenantRoleRequest,
+  CreateWebhookSubscriptionRequest,
   CronWorkflows,
   CronWorkflowsList,
   CronWorkflowsOrderByField,
@@ -36,6 +43,7 @@ import {
   EventOrderByField,
   EventSearch,
   Events,
+  EventWorkflowRunList,
   ListAPIMetaIntegration,
   ListAPITokensResponse,
   ListSNSIntegrations,
@@ -50,9 +58,11 @@ import {
   RateLimitOrderByField,
   RejectInviteRequest,
   ReplayEventRequest,
+  ReplayEventWithPayloadRequest,
   ReplayWorkflowRunsRequest,
   ReplayWorkflowRunsResponse,
   RerunStepRunRequest,
+  RotateAPITokenRequest,
   SNSIntegration,
   ScheduleWorkflowRunRequest,
   ScheduledRunStatus,
@@ -61,10 +71,13 @@ import {
   ScheduledWorkflowsOrderByField,
   StepRun,
   StepRunArchiveList,
+  StepRunDeadLetterList,
   StepRunEventList,
   Tenant,
   TenantAlertEmailGroup,
   TenantAlertEmailGroupList,
+  TenantAlertMuteRule,
+  TenantAlertMuteRuleList,
   TenantAlertingSettings,
   TenantInvite,
   TenantInviteList,
@@ -72,17 +85,31 @@ import {
   TenantMemberList,
   TenantQueueMetrics,
   TenantResourcePolicy,
+  TenantRole,
+  TenantRoleList,
   TenantStepRunQueueMetrics,
   TriggerWorkflowRunRequest,
+  UpdateAuditLogSettingsRequest,
   UpdateTenantAlertEmailGroupRequest,
   UpdateTenantInviteRequest,
+  UpdateTenantMemberRoleRequest,
   UpdateTenantRequest,
+  UpdateTenantResourcePolicyRequest,
+  UpdateTenantRoleRequest,
+  UpdateWebhookSubscriptionRequest,
   UpdateWorkerRequest,
+  UpdateWorkflowVersionWeightsRequest,
   User,
   UserChangePasswordRequest,
   UserLoginRequest,
   UserRegisterRequest,
   UserTenantMembershipsList,
+  WebhookDelivery,
+  WebhookDeliveryList,
+  WebhookDeliveryStatus,
+  WebhookSubscription,
+  WebhookSubscriptionCreated,
+  WebhookSubscriptionList,
   WebhookWorkerCreateRequest,
   WebhookWorkerCreated,
   WebhookWorkerListResponse,
@@ -98,6 +125,7 @@ import {
   WorkflowRunList,
   WorkflowRunOrderByDirection,
   WorkflowRunOrderByField,
+  WorkflowRunSearchResult,
   WorkflowRunShape,
   WorkflowRunStatus,
   WorkflowRunStatusList,
@@ -105,6 +133,7 @@ import {
   WorkflowRunsMetrics,
   WorkflowUpdateRequest,
   WorkflowVersion,
+  WorkflowVersionWeights,
   WorkflowWorkersCount,
 } from './data-contracts';
 import { ContentType, HttpClient, RequestParams } from './http-client';
@@ -258,6 +287,86 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       method: 'GET',
       ...params,
     });
+  /**
+   * @description Starts the OAuth flow with a configured OAuth provider
+   *
+   * @tags User
+   * @name UserUpdateOauthStart
+   * @summary Start OAuth flow
+   * @request GET:/api/v1/users/oauth/{provider}/start
+   */
+  userUpdateOauthStart = (provider: string, params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oauth/${provider}/start`,
+      method: 'GET',
+      ...params,
+    });
+  /**
+   * @description Completes the OAuth flow with a configured OAuth provider
+   *
+   * @tags User
+   * @name UserUpdateOauthCallback
+   * @summary Complete OAuth flow
+   * @request GET:/api/v1/users/oauth/{provider}/callback
+   */
+  userUpdateOauthCallback = (provider: string, params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oauth/${provider}/callback`,
+      method: 'GET',
+      ...params,
+    });
+  /**
+   * @description Gets the SAML metadata of this instance, which is registered with the SAML identity provider
+   *
+   * @tags User
+   * @name UserGetSamlMetadata
+   * @summary Get SAML metadata
+   * @request GET:/api/v1/users/saml/metadata
+   */
+  userGetSamlMetadata = (params: RequestParams = {}) =>
+    this.request<string, APIErrors>({
+      path: `/api/v1/users/saml/metadata`,
+      method: 'GET',
+      ...params,
+    });
+  /**
+   * @description Starts the SAML login flow with the configured identity provider
+   *
+   * @tags User
+   * @name UserUpdateSamlStart
+   * @summary Start SAML login flow
+   * @request GET:/api/v1/users/saml/start
+   */
+  userUpdateSamlStart = (params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/saml/start`,
+      method: 'GET',
+      ...params,
+    });
+  /**
+   * @description Completes the SAML login flow with the assertion of the identity provider. This is the assertion consumer service of this instance.
+   *
+   * @tags User
+   * @name UserUpdateSamlCallback
+   * @summary Complete SAML login flow
+   * @request POST:/api/v1/users/saml/acs
+   */
+  userUpdateSamlCallback = (
+    data: {
+      /** The base64-encoded SAML response */
+      SAMLResponse: string;
+      /** The relay state of the login flow */
+      RelayState?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<any, void>({
+      path: `/api/v1/users/saml/acs`,
+      method: 'POST',
+      body: data,
+      type: ContentType.UrlEncoded,
+      ...params,
+    });
   /**
    * @description Starts the OAuth flow
    *
@@ -393,6 +502,29 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Updates the resource limits and the data retention period of a tenant
+   *
+   * @tags Tenant
+   * @name TenantResourcePolicyUpdate
+   * @summary Update tenant resource policy
+   * @request PATCH:/api/v1/tenants/{tenant}/resource-policy
+   * @secure
+   */
+  tenantResourcePolicyUpdate = (
+    tenant: string,
+    data: UpdateTenantResourcePolicyRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantResourcePolicy, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/resource-policy`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Updates a tenant alert email group
    *
@@ -432,6 +564,58 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       secure: true,
       ...params,
     });
+  /**
+   * @description Mutes the failure alerts of a workflow
+   *
+   * @tags Tenant
+   * @name AlertMuteRuleCreate
+   * @summary Create tenant alert mute rule
+   * @request POST:/api/v1/tenants/{tenant}/alerting-mute-rules
+   * @secure
+   */
+  alertMuteRuleCreate = (tenant: string, data: CreateTenantAlertMuteRuleRequest, params: RequestParams = {}) =>
+    this.request<TenantAlertMuteRule, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/alerting-mute-rules`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Gets a list of tenant alert mute rules
+   *
+   * @tags Tenant
+   * @name AlertMuteRuleList
+   * @summary List tenant alert mute rules
+   * @request GET:/api/v1/tenants/{tenant}/alerting-mute-rules
+   * @secure
+   */
+  alertMuteRuleList = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantAlertMuteRuleList, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/alerting-mute-rules`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes a tenant alert mute rule, so the failure alerts of the workflow are sent again
+   *
+   * @tags Tenant
+   * @name AlertMuteRuleDelete
+   * @summary Delete tenant alert mute rule
+   * @request DELETE:/api/v1/alerting-mute-rules/{alert-mute-rule}
+   * @secure
+   */
+  alertMuteRuleDelete = (alertMuteRule: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors | APIError>({
+      path: `/api/v1/alerting-mute-rules/${alertMuteRule}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
   /**
    * @description Delete SNS integration
    *
@@ -803,6 +987,29 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       secure: true,
       ...params,
     });
+  /**
+   * @description Rotate an API token for a tenant. A new token is created with the name, scopes and lifetime of the rotated token, which expires after the grace period, or immediately if no grace period is set.
+   *
+   * @tags API Token
+   * @name ApiTokenUpdateRotate
+   * @summary Rotate API Token
+   * @request POST:/api/v1/api-tokens/{api-token}/rotate
+   * @secure
+   */
+  apiTokenUpdateRotate = (
+    apiToken: string,
+    data: RotateAPITokenRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<CreateAPITokenResponse, APIErrors>({
+      path: `/api/v1/api-tokens/${apiToken}/rotate`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get the queue metrics for the tenant
    *
@@ -1056,6 +1263,329 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Assigns a custom role to a tenant member, or removes the custom role of the member
+   *
+   * @tags Tenant
+   * @name TenantMemberUpdateRole
+   * @summary Update the custom role of a tenant member
+   * @request PUT:/api/v1/tenants/{tenant}/members/{member}/role
+   * @secure
+   */
+  tenantMemberUpdateRole = (
+    tenant: string,
+    member: string,
+    data: UpdateTenantMemberRoleRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantMember, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/members/${member}/role`,
+      method: 'PUT',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the custom roles of a tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleList
+   * @summary List custom roles
+   * @request GET:/api/v1/tenants/{tenant}/roles
+   * @secure
+   */
+  tenantRoleList = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantRoleList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates a custom role in a tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleCreate
+   * @summary Create custom role
+   * @request POST:/api/v1/tenants/{tenant}/roles
+   * @secure
+   */
+  tenantRoleCreate = (tenant: string, data: CreateTenantRoleRequest, params: RequestParams = {}) =>
+    this.request<TenantRole, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Updates a custom role of a tenant, which changes the permissions of all members with the role
+   *
+   * @tags Tenant
+   * @name TenantRoleUpdate
+   * @summary Update custom role
+   * @request PATCH:/api/v1/tenants/{tenant}/roles/{tenant-role}
+   * @secure
+   */
+  tenantRoleUpdate = (
+    tenant: string,
+    tenantRole: string,
+    data: UpdateTenantRoleRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantRole, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles/${tenantRole}`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes a custom role of a tenant. Members with the role get the permissions of their role again.
+   *
+   * @tags Tenant
+   * @name TenantRoleDelete
+   * @summary Delete custom role
+   * @request DELETE:/api/v1/tenants/{tenant}/roles/{tenant-role}
+   * @secure
+   */
+  tenantRoleDelete = (tenant: string, tenantRole: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles/${tenantRole}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Lists the audit logs of a tenant, newest first
+   *
+   * @tags Audit Log
+   * @name AuditLogList
+   * @summary List audit logs
+   * @request GET:/api/v1/tenants/{tenant}/audit-logs
+   * @secure
+   */
+  auditLogList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** The action to filter by */
+      action?: string;
+      /**
+       * The id of the user or API token to filter by
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      actorId?: string;
+      /**
+       * The id of the resource to filter by
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      resourceId?: string;
+      /**
+       * Only return audit logs created at or after this time
+       * @format date-time
+       */
+      since?: string;
+      /**
+       * Only return audit logs created before this time
+       * @format date-time
+       */
+      until?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<AuditLogList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/audit-logs`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Gets the audit log settings of a tenant
+   *
+   * @tags Audit Log
+   * @name AuditLogGetSettings
+   * @summary Get audit log settings
+   * @request GET:/api/v1/tenants/{tenant}/audit-logs/settings
+   * @secure
+   */
+  auditLogGetSettings = (tenant: string, params: RequestParams = {}) =>
+    this.request<AuditLogSettings, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/audit-logs/settings`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Updates the audit log settings of a tenant
+   *
+   * @tags Audit Log
+   * @name AuditLogUpdateSettings
+   * @summary Update audit log settings
+   * @request PATCH:/api/v1/tenants/{tenant}/audit-logs/settings
+   * @secure
+   */
+  auditLogUpdateSettings = (tenant: string, data: UpdateAuditLogSettingsRequest, params: RequestParams = {}) =>
+    this.request<AuditLogSettings, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/audit-logs/settings`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the webhook subscriptions of a tenant
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionList
+   * @summary List webhook subscriptions
+   * @request GET:/api/v1/tenants/{tenant}/webhook-subscriptions
+   * @secure
+   */
+  webhookSubscriptionList = (tenant: string, params: RequestParams = {}) =>
+    this.request<WebhookSubscriptionList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-subscriptions`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates a webhook subscription, which delivers run lifecycle events of the tenant to a url
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionCreate
+   * @summary Create a webhook subscription
+   * @request POST:/api/v1/tenants/{tenant}/webhook-subscriptions
+   * @secure
+   */
+  webhookSubscriptionCreate = (tenant: string, data: CreateWebhookSubscriptionRequest, params: RequestParams = {}) =>
+    this.request<WebhookSubscriptionCreated, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-subscriptions`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Updates a webhook subscription
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionUpdate
+   * @summary Update a webhook subscription
+   * @request PATCH:/api/v1/webhook-subscriptions/{webhook-subscription}
+   * @secure
+   */
+  webhookSubscriptionUpdate = (
+    webhookSubscription: string,
+    data: UpdateWebhookSubscriptionRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<WebhookSubscription, APIErrors>({
+      path: `/api/v1/webhook-subscriptions/${webhookSubscription}`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes a webhook subscription and its deliveries
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionDelete
+   * @summary Delete a webhook subscription
+   * @request DELETE:/api/v1/webhook-subscriptions/{webhook-subscription}
+   * @secure
+   */
+  webhookSubscriptionDelete = (webhookSubscription: string, params: RequestParams = {}) =>
+    this.request<WebhookSubscription, APIErrors>({
+      path: `/api/v1/webhook-subscriptions/${webhookSubscription}`,
+      method: 'DELETE',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the deliveries of a webhook subscription, newest first
+   *
+   * @tags Webhook Subscription
+   * @name WebhookDeliveryList
+   * @summary List webhook deliveries
+   * @request GET:/api/v1/webhook-subscriptions/{webhook-subscription}/deliveries
+   * @secure
+   */
+  webhookDeliveryList = (
+    webhookSubscription: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** The status to filter by */
+      status?: WebhookDeliveryStatus;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WebhookDeliveryList, APIErrors>({
+      path: `/api/v1/webhook-subscriptions/${webhookSubscription}/deliveries`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Delivers a webhook delivery again as soon as possible, regardless of its status
+   *
+   * @tags Webhook Subscription
+   * @name WebhookDeliveryResend
+   * @summary Resend a webhook delivery
+   * @request POST:/api/v1/webhook-deliveries/{webhook-delivery}/resend
+   * @secure
+   */
+  webhookDeliveryResend = (webhookDelivery: string, params: RequestParams = {}) =>
+    this.request<WebhookDelivery, APIErrors>({
+      path: `/api/v1/webhook-deliveries/${webhookDelivery}/resend`,
+      method: 'POST',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get an event.
    *
@@ -1090,6 +1620,58 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description List the workflow runs which were triggered by an event.
+   *
+   * @tags Event
+   * @name EventWorkflowRunList
+   * @summary List event workflow runs
+   * @request GET:/api/v1/events/{event}/runs
+   * @secure
+   */
+  eventWorkflowRunList = (
+    event: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<EventWorkflowRunList, APIErrors>({
+      path: `/api/v1/events/${event}/runs`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replays an event, optionally with a modified payload. The workflows which are triggered by the event run again, and the replayed event is linked to the original event.
+   *
+   * @tags Event
+   * @name EventUpdateReplayWithPayload
+   * @summary Replay event with payload
+   * @request POST:/api/v1/events/{event}/replay
+   * @secure
+   */
+  eventUpdateReplayWithPayload = (event: string, data: ReplayEventWithPayloadRequest, params: RequestParams = {}) =>
+    this.request<Event, APIErrors>({
+      path: `/api/v1/events/${event}/replay`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Lists all event keys for a tenant.
    *
@@ -1538,6 +2120,57 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Get the version weights of a workflow, which split new runs between its versions, and the run statistics of each version.
+   *
+   * @tags Workflow
+   * @name WorkflowVersionWeightsGet
+   * @summary Get workflow version weights
+   * @request GET:/api/v1/workflows/{workflow}/version-weights
+   * @secure
+   */
+  workflowVersionWeightsGet = (
+    workflow: string,
+    query?: {
+      /**
+       * The start of the time range of the run statistics, defaults to 24 hours ago
+       * @format date-time
+       */
+      since?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowVersionWeights, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/version-weights`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replace the version weights of a workflow. New runs are split between the listed versions in proportion to their weights, and an empty list makes new runs use the latest version again.
+   *
+   * @tags Workflow
+   * @name WorkflowVersionWeightsUpdate
+   * @summary Update workflow version weights
+   * @request PUT:/api/v1/workflows/{workflow}/version-weights
+   * @secure
+   */
+  workflowVersionWeightsUpdate = (
+    workflow: string,
+    data: UpdateWorkflowVersionWeightsRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowVersionWeights, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/version-weights`,
+      method: 'PUT',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Lists log lines for a step run.
    *
@@ -1789,6 +2422,101 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Searches the workflow runs of a tenant. The workflow runs are ordered by their creation time and paginated with cursors, so pages stay stable while new workflow runs are created.
+   *
+   * @tags Workflow Run
+   * @name WorkflowRunSearch
+   * @summary Search workflow runs
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs
+   * @secure
+   */
+  workflowRunSearch = (
+    tenant: string,
+    query?: {
+      /** A list of workflow run statuses to filter by */
+      statuses?: WorkflowRunStatusList;
+      /** A list of workflow ids to filter by */
+      workflowIds?: string[];
+      /** A list of keys of the events which triggered the workflow runs */
+      eventKeys?: string[];
+      /**
+       * A list of metadata key value pairs to filter by
+       * @example ["key1:value1","key2:value2"]
+       */
+      additionalMetadata?: string[];
+      /**
+       * The parent workflow run id
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      parentWorkflowRunId?: string;
+      /** A string which the error of the workflow runs contains, ignoring case */
+      search?: string;
+      /**
+       * The time after the workflow run was created
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      createdAfter?: string;
+      /**
+       * The time before the workflow run was created
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      createdBefore?: string;
+      /**
+       * The time after the workflow run was finished
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      finishedAfter?: string;
+      /**
+       * The time before the workflow run was finished
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      finishedBefore?: string;
+      /** The order of the creation time of the workflow runs, defaults to DESC */
+      orderByDirection?: WorkflowRunOrderByDirection;
+      /** The cursor of the page to get, which is the nextCursor of the previous page */
+      cursor?: string;
+      /**
+       * The number of workflow runs to return, at most 1000
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRunSearchResult, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Cancels a list of workflow runs, or up to 10000 workflow runs which match a filter.
+   *
+   * @tags Workflow Run
+   * @name WorkflowRunUpdateCancel
+   * @summary Cancel workflow runs
+   * @request POST:/api/v1/tenants/{tenant}/workflow-runs/cancel
+   * @secure
+   */
+  workflowRunUpdateCancel = (tenant: string, data: CancelWorkflowRunsRequest, params: RequestParams = {}) =>
+    this.request<CancelWorkflowRunsResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/cancel`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Replays a list of workflow runs.
    *
@@ -1980,6 +2708,71 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Lists the step runs of a tenant which failed after exhausting their retries, most recently failed first.
+   *
+   * @tags Step Run
+   * @name StepRunListDeadLetters
+   * @summary List dead-lettered step runs
+   * @request GET:/api/v1/tenants/{tenant}/dlq
+   * @secure
+   */
+  stepRunListDeadLetters = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<StepRunDeadLetterList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/dlq`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Discards a dead-lettered step run. The step run stays failed, and is no longer listed in the dead letters.
+   *
+   * @tags Step Run
+   * @name StepRunDeleteDeadLetter
+   * @summary Discard dead-lettered step run
+   * @request DELETE:/api/v1/tenants/{tenant}/dlq/{step-run}
+   * @secure
+   */
+  stepRunDeleteDeadLetter = (tenant: string, stepRun: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/dlq/${stepRun}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Requeues a dead-lettered step run with its previous input, and removes it from the dead letters.
+   *
+   * @tags Step Run
+   * @name StepRunUpdateRequeue
+   * @summary Requeue dead-lettered step run
+   * @request POST:/api/v1/tenants/{tenant}/dlq/{step-run}/requeue
+   * @secure
+   */
+  stepRunUpdateRequeue = (tenant: string, stepRun: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/dlq/${stepRun}/requeue`,
+      method: 'POST',
+      secure: true,
+      ...params,
+    });
   /**
    * @description Get all workers for a tenant
    *
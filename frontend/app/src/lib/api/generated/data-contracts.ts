@@ -12,9 +12,24 @@
 export interface APIMetaAuth {
   /**
    * the supported types of authentication
-   * @example ["basic","google"]
+   * @example ["basic","google","oauth"]
    */
   schemes?: string[];
+  /** the additional OAuth providers which users can log in with */
+  oauthProviders?: APIMetaOAuthProvider[];
+}
+
+export interface APIMetaOAuthProvider {
+  /**
+   * the name of the provider, which identifies its login routes
+   * @example "okta"
+   */
+  name: string;
+  /**
+   * the name of the provider which is shown to users
+   * @example "Okta"
+   */
+  displayName: string;
 }
 
 export interface APIMetaPosthog {
@@ -216,6 +231,7 @@ export enum TenantResource {
   WORKFLOW_RUN = 'WORKFLOW_RUN',
   CRON = 'CRON',
   SCHEDULE = 'SCHEDULE',
+  QUEUED_RUN = 'QUEUED_RUN',
 }
 
 export interface TenantResourceLimit {
@@ -240,6 +256,26 @@ export interface TenantResourceLimit {
 export interface TenantResourcePolicy {
   /** A list of resource limits for the tenant. */
   limits: TenantResourceLimit[];
+  /** How long workflow runs and events of the tenant are retained, as a duration like 720h. */
+  dataRetentionPeriod?: string;
+}
+
+export interface UpdateTenantResourceLimit {
+  /** The resource to limit. */
+  resource: TenantResource;
+  /** The limit of the resource. */
+  limitValue: number;
+  /** The value at which the tenant is alerted that it's approaching the limit. */
+  alarmValue?: number;
+  /** The meter window of the limit, like 1m or 24h. It's ignored for workers and queued runs, which are counted. */
+  window?: string;
+}
+
+export interface UpdateTenantResourcePolicyRequest {
+  /** The resource limits to set. Limits of other resources are kept. */
+  limits?: UpdateTenantResourceLimit[];
+  /** How long workflow runs and events of the tenant are retained, as a duration like 720h. */
+  dataRetentionPeriod?: string;
 }
 
 export interface UpdateTenantAlertEmailGroupRequest {
@@ -247,6 +283,45 @@ export interface UpdateTenantAlertEmailGroupRequest {
   emails: string[];
 }
 
+export interface TenantAlertMuteRule {
+  metadata: APIResourceMeta;
+  /**
+   * The id of the muted workflow.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /**
+   * The time until which the workflow is muted. The workflow is muted indefinitely if not set.
+   * @format date-time
+   */
+  mutedUntil?: string;
+  /** Why the workflow is muted. */
+  reason?: string;
+}
+
+export interface TenantAlertMuteRuleList {
+  rows?: TenantAlertMuteRule[];
+}
+
+export interface CreateTenantAlertMuteRuleRequest {
+  /**
+   * The id of the workflow to mute.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /**
+   * The time until which the workflow is muted. The workflow is muted indefinitely if not set.
+   * @format date-time
+   */
+  mutedUntil?: string;
+  /** Why the workflow is muted. */
+  reason?: string;
+}
+
 export interface SlackWebhook {
   metadata: APIResourceMeta;
   /**
@@ -314,6 +389,8 @@ export interface Tenant {
   analyticsOptOut?: boolean;
   /** Whether to alert tenant members. */
   alertMemberEmails?: boolean;
+  /** Whether the tenant is paused. While a tenant is paused, its new workflow runs are queued but not scheduled. */
+  isPaused?: boolean;
 }
 
 export interface TenantMember {
@@ -393,6 +470,12 @@ export interface UpdateTenantRequest {
   enableTenantResourceLimitAlerts?: boolean;
   /** The max frequency at which to alert. */
   maxAlertingFrequency?: string;
+  /** The number of failed workflow runs within the alerting frequency which triggers an alert. */
+  workflowRunFailureThreshold?: number;
+  /** Whether to send alerts when workers go offline. */
+  enableWorkerOfflineAlerts?: boolean;
+  /** Whether to pause the tenant. When the tenant is resumed, its queued workflow runs are scheduled in the order in which they were triggered. */
+  isPaused?: boolean;
 }
 
 export interface TenantAlertingSettings {
@@ -412,6 +495,10 @@ export interface TenantAlertingSettings {
    * @format date-time
    */
   lastAlertedAt?: string;
+  /** The number of failed workflow runs within the alerting frequency which triggers an alert. */
+  workflowRunFailureThreshold?: number;
+  /** Whether to send alerts when workers go offline. */
+  enableWorkerOfflineAlerts?: boolean;
 }
 
 export interface CreateTenantInviteRequest {
@@ -421,6 +508,61 @@ export interface CreateTenantInviteRequest {
   role: TenantMemberRole;
 }
 
+export enum TenantPermission {
+  TenantRead = 'tenant:read',
+  WorkflowsRun = 'workflows:run',
+  WorkflowsWrite = 'workflows:write',
+  EventsPush = 'events:push',
+  WorkersManage = 'workers:manage',
+  MembersManage = 'members:manage',
+  SettingsWrite = 'settings:write',
+  ApiTokensManage = 'api-tokens:manage',
+  AuditLogsManage = 'audit-logs:manage',
+  LimitsManage = 'limits:manage',
+}
+
+export interface TenantRole {
+  metadata: APIResourceMeta;
+  /** The name of the role. */
+  name: string;
+  /** The description of the role. */
+  description?: string;
+  /** The permissions of the members with the role. */
+  permissions: TenantPermission[];
+}
+
+export interface TenantRoleList {
+  rows?: TenantRole[];
+}
+
+export interface CreateTenantRoleRequest {
+  /** The name of the role. */
+  name: string;
+  /** The description of the role. */
+  description?: string;
+  /** The permissions of the members with the role. */
+  permissions: TenantPermission[];
+}
+
+export interface UpdateTenantRoleRequest {
+  /** The name of the role. */
+  name?: string;
+  /** The description of the role. */
+  description?: string;
+  /** The permissions of the members with the role. */
+  permissions?: TenantPermission[];
+}
+
+export interface UpdateTenantMemberRoleRequest {
+  /**
+   * The id of the custom role of the member, which replaces the permissions of their role. The member gets the permissions of their role again if it's not set.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  customRoleId?: string;
+}
+
 export interface UpdateTenantInviteRequest {
   /** The role of the user in the tenant. */
   role: TenantMemberRole;
@@ -438,6 +580,10 @@ export interface APIToken {
    * @format date-time
    */
   expiresAt: string;
+  /** The scopes of the API token. A token without scopes can be used for everything. */
+  scopes?: APITokenScope[];
+  /** The namespace of the API token. A namespaced token can only trigger workflows and push events whose names start with the namespace. */
+  namespace?: string;
 }
 
 export interface ListAPITokensResponse {
@@ -453,6 +599,13 @@ export interface CreateAPITokenRequest {
   name: string;
   /** The duration for which the token is valid. */
   expiresIn?: string;
+  /** The scopes of the API token. If not set, the token can be used for everything. */
+  scopes?: APITokenScope[];
+  /**
+   * The namespace of the API token. If set, the token can only trigger workflows and push events whose names start with the namespace.
+   * @maxLength 64
+   */
+  namespace?: string;
 }
 
 export interface CreateAPITokenResponse {
@@ -460,6 +613,18 @@ export interface CreateAPITokenResponse {
   token: string;
 }
 
+/** A scope which restricts what an API token can be used for. The admin scope allows everything. */
+export enum APITokenScope {
+  EventsWrite = 'events:write',
+  RunsRead = 'runs:read',
+  Admin = 'admin',
+}
+
+export interface RotateAPITokenRequest {
+  /** The duration for which the rotated token stays valid. If not set, the rotated token is revoked immediately. */
+  gracePeriod?: string;
+}
+
 /** A workflow ID. */
 export type WorkflowID = string;
 
@@ -548,6 +713,13 @@ export interface Event {
   workflowRunSummary?: EventWorkflowRunSummary;
   /** Additional metadata for the event. */
   additionalMetadata?: object;
+  /**
+   * The id of the event which this event is a replay of.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  replayedFromId?: string;
 }
 
 export interface EventList {
@@ -562,6 +734,13 @@ export interface CreateEventRequest {
   data: object;
   /** Additional metadata for the event. */
   additionalMetadata?: object;
+  /**
+   * The priority of the workflow runs triggered by the event, from 1 (lowest) to 3 (highest). Defaults to the default priority of each workflow.
+   * @format int32
+   * @min 1
+   * @max 3
+   */
+  priority?: number;
 }
 
 export interface BulkCreateEventRequest {
@@ -578,6 +757,13 @@ export interface ReplayEventRequest {
   eventIds: string[];
 }
 
+export interface ReplayEventWithPayloadRequest {
+  /** The data of the replayed event. Defaults to the data of the original event. */
+  data?: object;
+  /** The additional metadata of the replayed event. Defaults to the additional metadata of the original event. */
+  additionalMetadata?: object;
+}
+
 export interface CancelEventRequest {
   eventIds: string[];
 }
@@ -632,6 +818,60 @@ export interface EventKeyList {
   rows?: EventKey[];
 }
 
+export interface EventWorkflowRun {
+  /**
+   * The id of the workflow run.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowRunId: string;
+  /** The display name of the workflow run. */
+  displayName?: string;
+  status: WorkflowRunStatus;
+  /**
+   * The id of the workflow.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /** The name of the workflow. */
+  workflowName: string;
+  /**
+   * The id of the workflow version which was triggered.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowVersionId: string;
+  /**
+   * When the workflow run was created.
+   * @format date-time
+   */
+  createdAt: string;
+  /**
+   * When the workflow run started.
+   * @format date-time
+   */
+  startedAt?: string;
+  /**
+   * When the workflow run finished.
+   * @format date-time
+   */
+  finishedAt?: string;
+  /**
+   * The time in milliseconds between the creation of the event and the creation of the workflow run.
+   * @format int64
+   */
+  dispatchLatency: number;
+}
+
+export interface EventWorkflowRunList {
+  pagination?: PaginationResponse;
+  rows?: EventWorkflowRun[];
+}
+
 export interface Workflow {
   metadata: APIResourceMeta;
   /** The name of the workflow. */
@@ -862,6 +1102,13 @@ export interface WorkflowVersion {
 export interface TriggerWorkflowRunRequest {
   input: object;
   additionalMetadata?: object;
+  /**
+   * The priority of the workflow run, from 1 (lowest) to 3 (highest). Defaults to the default priority of the workflow.
+   * @format int32
+   * @min 1
+   * @max 3
+   */
+  priority?: number;
 }
 
 export interface WorkflowRun {
@@ -980,6 +1227,96 @@ export interface WorkflowRunTriggeredBy {
   eventId?: string;
   cronParentId?: string;
   cronSchedule?: string;
+  /** The type of actor which triggered a workflow run. */
+  actorType?: WorkflowRunActorType;
+  /** The id of the user or API token which triggered the workflow run. */
+  actorId?: string;
+}
+
+/** The type of actor which triggered a workflow run. */
+export enum WorkflowRunActorType {
+  USER = 'USER',
+  API_TOKEN = 'API_TOKEN',
+}
+
+export interface WorkflowVersionWeight {
+  /**
+   * The id of the workflow version.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowVersionId: string;
+  /**
+   * The weight of the version. The version receives its weight divided by the sum of all weights of the new runs of the workflow.
+   * @format int32
+   * @min 0
+   */
+  weight: number;
+}
+
+export interface WorkflowVersionRunStats {
+  /**
+   * The id of the workflow version.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowVersionId: string;
+  /**
+   * The number of runs of the version.
+   * @format int64
+   */
+  total: number;
+  /**
+   * The number of succeeded runs of the version.
+   * @format int64
+   */
+  succeeded: number;
+  /**
+   * The number of failed runs of the version.
+   * @format int64
+   */
+  failed: number;
+  /**
+   * The number of cancelled runs of the version.
+   * @format int64
+   */
+  cancelled: number;
+  /**
+   * The share of the finished runs of the version which succeeded, between 0 and 1. Not set if no run has finished.
+   * @format double
+   */
+  successRate?: number;
+  /**
+   * The share of the finished runs of the version which failed, between 0 and 1. Not set if no run has finished.
+   * @format double
+   */
+  errorRate?: number;
+}
+
+export interface WorkflowVersionWeights {
+  /** The version weights of the workflow. If empty, new runs use the latest version. */
+  weights: WorkflowVersionWeight[];
+  /**
+   * The weight of the latest version of the workflow, whichever version that is. Not set if the latest version only receives the weight it is listed with.
+   * @format int32
+   * @min 0
+   */
+  latestWeight?: number;
+  /** The statistics of the runs of each version which were created in the requested time range. */
+  stats: WorkflowVersionRunStats[];
+}
+
+export interface UpdateWorkflowVersionWeightsRequest {
+  /** The version weights of the workflow. Versions which aren't listed receive no new runs. */
+  weights: WorkflowVersionWeight[];
+  /**
+   * The weight of the latest version of the workflow, whichever version that is. Unlike the weights of specific versions, it moves on to versions which are registered later, so each new version is canaried against the listed versions. Ignored if no weights are listed.
+   * @format int32
+   * @min 0
+   */
+  latestWeight?: number;
 }
 
 export interface WorkflowMetrics {
@@ -1017,6 +1354,8 @@ export interface LogLine {
   createdAt: string;
   /** The log message. */
   message: string;
+  /** The level of the log line. */
+  level: LogLineLevel;
   /** The log metadata. */
   metadata: object;
 }
@@ -1026,6 +1365,147 @@ export interface LogLineList {
   rows?: LogLine[];
 }
 
+export interface AuditLog {
+  metadata: APIResourceMeta;
+  /** The type of the actor which performed the action, which is USER or API_TOKEN. */
+  actorType: string;
+  /**
+   * The id of the user or API token which performed the action.
+   * @format uuid
+   */
+  actorId?: string;
+  /** The action, which is the operation id of the REST API or the method of the gRPC API. */
+  action: string;
+  /** The type of the resource which the action was performed on. */
+  resourceType?: string;
+  /**
+   * The id of the resource which the action was performed on.
+   * @format uuid
+   */
+  resourceId?: string;
+  /** The IP address of the actor. */
+  ipAddress?: string;
+  /** The payload of the action, with credentials redacted. */
+  payload?: object;
+  /** The state of the resource before the action, with credentials redacted. */
+  priorState?: object;
+}
+
+export interface AuditLogList {
+  pagination?: PaginationResponse;
+  rows?: AuditLog[];
+}
+
+export interface AuditLogSettings {
+  /** How long audit logs are kept, as a duration like 8760h. */
+  retentionPeriod: string;
+}
+
+export interface UpdateAuditLogSettingsRequest {
+  /** How long audit logs are kept, as a duration like 8760h. */
+  retentionPeriod: string;
+}
+
+export enum WebhookEventType {
+  WebhookEventTypeRunStarted = 'run.started',
+  WebhookEventTypeRunSucceeded = 'run.succeeded',
+  WebhookEventTypeRunFailed = 'run.failed',
+  WebhookEventTypeStepFailed = 'step.failed',
+  WebhookEventTypeWorkerDown = 'worker.down',
+}
+
+export interface WebhookSubscription {
+  metadata: APIResourceMeta;
+  /** The name of the webhook subscription. */
+  name: string;
+  /** The url which events are delivered to. */
+  url: string;
+  /** The event types which are delivered. */
+  eventTypes: WebhookEventType[];
+  /** Whether events are delivered. */
+  enabled: boolean;
+}
+
+export interface WebhookSubscriptionCreated {
+  metadata: APIResourceMeta;
+  /** The name of the webhook subscription. */
+  name: string;
+  /** The url which events are delivered to. */
+  url: string;
+  /** The event types which are delivered. */
+  eventTypes: WebhookEventType[];
+  /** Whether events are delivered. */
+  enabled: boolean;
+  /** The secret which deliveries are signed with. It's only returned when the subscription is created. */
+  secret: string;
+}
+
+export interface WebhookSubscriptionList {
+  rows?: WebhookSubscription[];
+}
+
+export interface CreateWebhookSubscriptionRequest {
+  /** The name of the webhook subscription. */
+  name: string;
+  /** The url which events are delivered to. */
+  url: string;
+  /** The secret which deliveries are signed with. A secret is generated if it's not set. */
+  secret?: string;
+  /** The event types which are delivered. */
+  eventTypes: WebhookEventType[];
+  /** Whether events are delivered, defaults to true. */
+  enabled?: boolean;
+}
+
+export interface UpdateWebhookSubscriptionRequest {
+  /** The url which events are delivered to. */
+  url?: string;
+  /** The event types which are delivered. */
+  eventTypes?: WebhookEventType[];
+  /** Whether events are delivered. */
+  enabled?: boolean;
+}
+
+export enum WebhookDeliveryStatus {
+  WebhookDeliveryStatusPENDING = 'PENDING',
+  WebhookDeliveryStatusSUCCEEDED = 'SUCCEEDED',
+  WebhookDeliveryStatusFAILED = 'FAILED',
+}
+
+export interface WebhookDelivery {
+  metadata: APIResourceMeta;
+  /**
+   * The id of the webhook subscription.
+   * @format uuid
+   */
+  subscriptionId: string;
+  eventType: WebhookEventType;
+  status: WebhookDeliveryStatus;
+  /** The number of attempts to deliver the event. */
+  attempts: number;
+  /**
+   * When the event is delivered next, if the delivery is pending.
+   * @format date-time
+   */
+  nextAttemptAt?: string;
+  /**
+   * When the event was last attempted to be delivered.
+   * @format date-time
+   */
+  lastAttemptAt?: string;
+  /** The status code of the response to the last attempt. */
+  responseStatusCode?: number;
+  /** Why the last attempt failed. */
+  error?: string;
+  /** The data of the event. */
+  payload?: object;
+}
+
+export interface WebhookDeliveryList {
+  pagination?: PaginationResponse;
+  rows?: WebhookDelivery[];
+}
+
 export enum StepRunEventReason {
   REQUEUED_NO_WORKER = 'REQUEUED_NO_WORKER',
   REQUEUED_RATE_LIMIT = 'REQUEUED_RATE_LIMIT',
@@ -1102,6 +1582,50 @@ export interface StepRunArchiveList {
   rows?: StepRunArchive[];
 }
 
+export interface StepRunDeadLetter {
+  /**
+   * The id of the step run.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  stepRunId: string;
+  /**
+   * The id of the job run of the step run.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  jobRunId: string;
+  /**
+   * The id of the workflow run of the step run.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowRunId: string;
+  /** The name of the workflow. */
+  workflowName: string;
+  /** The readable id of the step. */
+  stepReadableId?: string;
+  /** The action id of the step. */
+  actionId: string;
+  /** The error of the last attempt of the step run. */
+  error?: string;
+  /** The number of times the step run was retried before it was dead-lettered. */
+  retryCount: number;
+  /**
+   * When the step run was dead-lettered.
+   * @format date-time
+   */
+  deadLetteredAt: string;
+}
+
+export interface StepRunDeadLetterList {
+  pagination?: PaginationResponse;
+  rows?: StepRunDeadLetter[];
+}
+
 export interface WorkflowWorkersCount {
   freeSlotCount?: number;
   maxSlotCount?: number;
@@ -1128,15 +1652,66 @@ export interface WorkflowRunList {
   pagination?: PaginationResponse;
 }
 
+export interface WorkflowRunSearchResult {
+  rows: WorkflowRun[];
+  /** The cursor of the next page, which is not set on the last page. */
+  nextCursor?: string;
+}
+
 export interface ReplayWorkflowRunsRequest {
-  /** @maxLength 500 */
-  workflowRunIds: string[];
+  /**
+   * The ids of the workflow runs to replay. Either the ids or a filter must be set.
+   * @maxLength 500
+   */
+  workflowRunIds?: string[];
+  /** Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set. */
+  filter?: WorkflowRunsFilter;
 }
 
 export interface ReplayWorkflowRunsResponse {
   workflowRuns: WorkflowRun[];
 }
 
+/** Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set. */
+export interface WorkflowRunsFilter {
+  /**
+   * The id of the workflow of the runs.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId?: string;
+  /** The statuses of the runs. By default, the runs which aren't finished are cancelled and the finished runs are replayed. */
+  statuses?: WorkflowRunStatus[];
+  /**
+   * The time after which the runs were created.
+   * @format date-time
+   */
+  createdAfter?: string;
+  /**
+   * The time before which the runs were created.
+   * @format date-time
+   */
+  createdBefore?: string;
+  /** The key of the event which triggered the runs. */
+  eventKey?: string;
+}
+
+export interface CancelWorkflowRunsRequest {
+  /**
+   * The ids of the workflow runs to cancel. Either the ids or a filter must be set.
+   * @maxLength 500
+   */
+  workflowRunIds?: string[];
+  /** Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set. */
+  filter?: WorkflowRunsFilter;
+}
+
+export interface CancelWorkflowRunsResponse {
+  /** The ids of the cancelled workflow runs. */
+  workflowRunIds: string[];
+}
+
 export interface WorkflowRunsMetricsCounts {
   PENDING?: number;
   RUNNING?: number;
@@ -8,15 +8,20 @@ export default function useErrorParam() {
 
   useEffect(() => {
     if (searchParams.get('error') && searchParams.get('error') !== '') {
+      const requestId = searchParams.get('request_id');
+
       toast({
         title: 'Error',
-        description: searchParams.get('error') || '',
+        description: requestId
+          ? `${searchParams.get('error')} (request ID: ${requestId})`
+          : searchParams.get('error') || '',
         duration: 5000,
       });
 
       // remove from search params
       const newSearchParams = new URLSearchParams(searchParams);
       newSearchParams.delete('error');
+      newSearchParams.delete('request_id');
       setSearchParams(newSearchParams);
     }
   }, [toast, searchParams, setSearchParams]);
@@ -2,7 +2,7 @@ import { Link, useNavigate } from 'react-router-dom';
 import { UserLoginForm } from './components/user-login-form';
 import { Button } from '@/components/ui/button';
 import { useMutation } from '@tanstack/react-query';
-import api, { UserLoginRequest } from '@/lib/api';
+import api, { APIMetaOAuthProvider, UserLoginRequest } from '@/lib/api';
 import { useState } from 'react';
 import { useApiError } from '@/lib/hooks';
 import useApiMeta from '../hooks/use-api-meta';
@@ -23,13 +23,20 @@ export default function Login() {
   const basicEnabled = schemes.includes('basic');
   const googleEnabled = schemes.includes('google');
   const githubEnabled = schemes.includes('github');
+  const samlEnabled = schemes.includes('saml');
+  const oauthProviders = meta.data?.auth?.oauthProviders || [];
+  const providerEnabled =
+    googleEnabled ||
+    githubEnabled ||
+    samlEnabled ||
+    oauthProviders.length > 0;
 
   let prompt = 'Enter your email and password below.';
 
-  if (basicEnabled && (googleEnabled || githubEnabled)) {
+  if (basicEnabled && providerEnabled) {
     prompt =
       'Enter your email and password below, or continue with a supported provider.';
-  } else if (googleEnabled || githubEnabled) {
+  } else if (providerEnabled) {
     prompt = 'Continue with a supported provider.';
   } else if (basicEnabled) {
     prompt = 'Enter your email and password below.';
@@ -41,6 +48,10 @@ export default function Login() {
     basicEnabled && <BasicLogin />,
     googleEnabled && <GoogleLogin />,
     githubEnabled && <GithubLogin />,
+    samlEnabled && <SAMLLogin />,
+    ...oauthProviders.map((provider) => (
+      <OAuthProviderLogin key={provider.name} provider={provider} />
+    )),
   ].filter(Boolean);
 
   return (
@@ -59,7 +70,7 @@ export default function Login() {
             {forms.map((form, index) => (
               <React.Fragment key={index}>
                 {form}
-                {index < schemes.length - 1 && <OrContinueWith />}
+                {index < forms.length - 1 && <OrContinueWith />}
               </React.Fragment>
             ))}
 
@@ -166,3 +177,30 @@ export function GithubLogin() {
     </a>
   );
 }
+
+export function OAuthProviderLogin({
+  provider,
+}: {
+  provider: APIMetaOAuthProvider;
+}) {
+  return (
+    <a
+      href={`/api/v1/users/oauth/${encodeURIComponent(provider.name)}/start`}
+      className="w-full"
+    >
+      <Button variant="outline" type="button" className="w-full py-2">
+        {provider.displayName}
+      </Button>
+    </a>
+  );
+}
+
+export function SAMLLogin() {
+  return (
+    <a href="/api/v1/users/saml/start" className="w-full">
+      <Button variant="outline" type="button" className="w-full py-2">
+        Single Sign-On
+      </Button>
+    </a>
+  );
+}
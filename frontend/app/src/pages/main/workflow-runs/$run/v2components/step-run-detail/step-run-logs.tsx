@@ -1,4 +1,6 @@
 import {
+  LogLine,
+  LogLineLevel,
   LogLineOrderByDirection,
   StepRun,
   StepRunStatus,
@@ -6,6 +8,27 @@ import {
 } from '@/lib/api';
 import { useQuery } from '@tanstack/react-query';
 import LoggingComponent from '@/components/cloud/logging/logs';
+import { Badge } from '@/components/ui/badge';
+
+const levelVariant = (level: LogLineLevel) => {
+  switch (level) {
+    case LogLineLevel.ERROR:
+      return 'failed';
+    case LogLineLevel.WARN:
+      return 'inProgress';
+    default:
+      return 'outline';
+  }
+};
+
+const formatLine = (row: LogLine) => {
+  const fields = Object.entries(row.metadata || {}).map(
+    ([key, value]) =>
+      `${key}=${typeof value === 'string' ? value : JSON.stringify(value)}`,
+  );
+
+  return [row.message, ...fields].join(' ');
+};
 
 export function StepRunLogs({
   stepRun,
@@ -34,8 +57,13 @@ export function StepRunLogs({
         logs={
           getLogsQuery.data?.rows?.map((row) => ({
             timestamp: row.createdAt,
-            line: row.message,
+            line: formatLine(row),
             instance: readableId,
+            badge: row.level && row.level !== LogLineLevel.INFO && (
+              <Badge className="mr-2" variant={levelVariant(row.level)}>
+                {row.level}
+              </Badge>
+            ),
           })) || []
         }
         onTopReached={() => {}}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/cel-go/checker/decls"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
+	lru "github.com/hashicorp/golang-lru/v2"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 
@@ -17,8 +18,16 @@ import (
 type CELParser struct {
 	workflowStrEnv *cel.Env
 	stepRunEnv     *cel.Env
+	eventFilterEnv *cel.Env
+
+	// eventFilters caches the compiled event filter programs by expression, since the same filters are
+	// evaluated for every event with the key
+	eventFilters *lru.Cache[string, cel.Program]
 }
 
+// eventFilterCacheSize is the number of compiled event filter programs which are cached.
+const eventFilterCacheSize = 1000
+
 var checksumDecl = decls.NewFunction("checksum",
 	decls.NewOverload("checksum_string",
 		[]*expr.Type{decls.String},
@@ -66,9 +75,23 @@ func NewCELParser() *CELParser {
 		checksum,
 	)
 
+	eventFilterEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("event_key", decls.String),
+			checksumDecl,
+		),
+		checksum,
+	)
+
+	eventFilters, _ := lru.New[string, cel.Program](eventFilterCacheSize) // nolint: errcheck - this only returns an error if the size is less than 0
+
 	return &CELParser{
 		workflowStrEnv: workflowStrEnv,
 		stepRunEnv:     stepRunEnv,
+		eventFilterEnv: eventFilterEnv,
+		eventFilters:   eventFilters,
 	}
 }
 
@@ -94,6 +117,12 @@ func WithAdditionalMetadata(metadata map[string]interface{}) InputOpts {
 	}
 }
 
+func WithEventKey(eventKey string) InputOpts {
+	return func(w Input) {
+		w["event_key"] = eventKey
+	}
+}
+
 func WithWorkflowRunID(workflowRunID string) InputOpts {
 	return func(w Input) {
 		w["workflow_run_id"] = workflowRunID
@@ -142,6 +171,54 @@ func (p *CELParser) ParseAndEvalWorkflowString(workflowExp string, in Input) (st
 	}
 }
 
+// ParseEventFilter parses an event filter expression, which can reference the event payload as input,
+// the additional metadata of the event as additional_metadata and the event key as event_key. The
+// expression must evaluate to a bool.
+func (p *CELParser) ParseEventFilter(eventFilterExpr string) (cel.Program, error) {
+	ast, issues := p.eventFilterEnv.Compile(eventFilterExpr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outType := ast.OutputType(); outType != cel.BoolType && outType != cel.DynType {
+		return nil, fmt.Errorf("event filter must evaluate to a bool: got %s", outType)
+	}
+
+	return p.eventFilterEnv.Program(ast)
+}
+
+// ParseAndEvalEventFilter returns whether the event matches the event filter expression. The expression
+// is only compiled the first time it's evaluated.
+func (p *CELParser) ParseAndEvalEventFilter(eventFilterExpr string, in Input) (bool, error) {
+	prg, ok := p.eventFilters.Get(eventFilterExpr)
+
+	if !ok {
+		var err error
+
+		prg, err = p.ParseEventFilter(eventFilterExpr)
+		if err != nil {
+			return false, err
+		}
+
+		p.eventFilters.Add(eventFilterExpr, prg)
+	}
+
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return false, err
+	}
+
+	switch out.Type() {
+	case types.BoolType:
+		return out.Value().(bool), nil
+	default:
+		return false, fmt.Errorf("output must evaluate to a bool: got %s", out.Type().TypeName())
+	}
+}
+
 type StepRunOutType string
 
 const (
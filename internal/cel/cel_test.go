@@ -91,3 +91,113 @@ func TestCELParser(t *testing.T) {
 		})
 	}
 }
+
+func TestCELParserEventFilter(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"plan": "pro",
+		},
+	}
+
+	tests := []struct {
+		expression  string
+		input       cel.Input
+		expected    bool
+		expectError bool
+	}{
+		{
+			expression: `input.data.plan == 'pro'`,
+			input:      cel.NewInput(cel.WithInput(payload)),
+			expected:   true,
+		},
+		{
+			expression: `input.data.plan == 'free'`,
+			input:      cel.NewInput(cel.WithInput(payload)),
+			expected:   false,
+		},
+		{
+			expression: `event_key == 'user:create' && additional_metadata.source == 'api'`,
+			input: cel.NewInput(
+				cel.WithInput(payload),
+				cel.WithEventKey("user:create"),
+				cel.WithAdditionalMetadata(map[string]interface{}{
+					"source": "api",
+				}),
+			),
+			expected: true,
+		},
+		{
+			expression: `has(input.data.team) && input.data.team == 'a'`,
+			input:      cel.NewInput(cel.WithInput(payload)),
+			expected:   false,
+		},
+		{
+			expression:  `input.data.team == 'a'`, // missing key
+			input:       cel.NewInput(cel.WithInput(payload)),
+			expectError: true,
+		},
+		{
+			expression:  `input.data.plan`, // does not evaluate to a bool
+			input:       cel.NewInput(cel.WithInput(payload)),
+			expectError: true,
+		},
+		{
+			expression:  `event_key + 1`, // does not evaluate to a bool
+			input:       cel.NewInput(),
+			expectError: true,
+		},
+		{
+			expression:  `input.data.plan ==`, // invalid syntax
+			input:       cel.NewInput(),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := parser.ParseAndEvalEventFilter(tt.expression, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+				assert.Equal(t, tt.expected, result, "Unexpected result")
+			}
+		})
+	}
+}
+
+func TestCELParserEventFilterIsReused(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	// the second evaluation uses the cached program with a different input
+	for _, plan := range []string{"pro", "free"} {
+		result, err := parser.ParseAndEvalEventFilter(`input.plan == 'pro'`, cel.NewInput(
+			cel.WithInput(map[string]interface{}{"plan": plan}),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, plan == "pro", result)
+	}
+
+	// invalid expressions are not cached, and fail every time
+	for i := 0; i < 2; i++ {
+		_, err := parser.ParseAndEvalEventFilter(`input.plan ==`, cel.NewInput())
+		assert.Error(t, err)
+	}
+}
+
+func TestCELParserParseEventFilter(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	_, err := parser.ParseEventFilter(`input.data.plan == 'pro'`)
+	assert.NoError(t, err)
+
+	_, err = parser.ParseEventFilter(`event_key`)
+	assert.Error(t, err, "string expressions are not valid filters")
+
+	_, err = parser.ParseEventFilter(`workflow_run_id == '1234'`)
+	assert.Error(t, err, "workflow_run_id is not declared for event filters")
+}
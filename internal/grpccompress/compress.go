@@ -0,0 +1,112 @@
+// Package grpccompress registers the gRPC compressors which the engine and the clients support. Importing
+// the package registers gzip and zstd.
+package grpccompress
+
+import (
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	Gzip = gzip.Name
+	Zstd = "zstd"
+)
+
+func init() {
+	encoding.RegisterCompressor(&zstdCompressor{})
+}
+
+// IsSupported reports whether name is the name of a supported compressor.
+func IsSupported(name string) bool {
+	return (name == Gzip || name == Zstd) && encoding.GetCompressor(name) != nil
+}
+
+// zstdCompressor compresses messages with zstd. Encoders and decoders are pooled, as they allocate large
+// buffers.
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func (c *zstdCompressor) Name() string {
+	return Zstd
+}
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	enc, ok := c.encoders.Get().(*zstd.Encoder)
+
+	if ok {
+		enc.Reset(w)
+	} else {
+		var err error
+
+		enc, err = zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &zstdWriter{Encoder: enc, pool: &c.encoders}, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	dec, ok := c.decoders.Get().(*zstd.Decoder)
+
+	if ok {
+		if err := dec.Reset(r); err != nil {
+			c.decoders.Put(dec)
+			return nil, err
+		}
+	} else {
+		var err error
+
+		// a decoder with a concurrency of 1 decodes synchronously, so decoders which are never returned to
+		// the pool don't leak goroutines
+		dec, err = zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &zstdReader{dec: dec, pool: &c.decoders}, nil
+}
+
+type zstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *zstdWriter) Close() error {
+	err := w.Encoder.Close()
+	w.pool.Put(w.Encoder)
+
+	return err
+}
+
+// zstdReader doesn't embed the decoder, so the decoder's WriteTo method can't bypass Read.
+type zstdReader struct {
+	dec  *zstd.Decoder
+	pool *sync.Pool
+}
+
+// Read returns the decoder to the pool once the message is fully read.
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.dec == nil {
+		return 0, io.EOF
+	}
+
+	n, err := r.dec.Read(p)
+
+	if err == io.EOF {
+		r.pool.Put(r.dec)
+		r.dec = nil
+	}
+
+	return n, err
+}
@@ -0,0 +1,48 @@
+package grpccompress
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestIsSupported(t *testing.T) {
+	assert.True(t, IsSupported("gzip"))
+	assert.True(t, IsSupported("zstd"))
+	assert.False(t, IsSupported("snappy"))
+	assert.False(t, IsSupported(""))
+}
+
+func TestZstdRoundTrip(t *testing.T) {
+	compressor := encoding.GetCompressor(Zstd)
+	require.NotNil(t, compressor)
+
+	// run several times so pooled encoders and decoders are reused
+	for i := 0; i < 3; i++ {
+		msg := []byte(strings.Repeat("hatchet", 10000+i))
+
+		var buf bytes.Buffer
+
+		w, err := compressor.Compress(&buf)
+		require.NoError(t, err)
+
+		_, err = w.Write(msg)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		assert.Less(t, buf.Len(), len(msg))
+
+		r, err := compressor.Decompress(&buf)
+		require.NoError(t, err)
+
+		decompressed, err := io.ReadAll(r)
+		require.NoError(t, err)
+
+		assert.Equal(t, msg, decompressed)
+	}
+}
@@ -40,6 +40,10 @@ func (t *TenantAlertManager) HandleAlert(tenantId string) error {
 		return err
 	}
 
+	if !tenantAlerting.Settings.EnableWorkflowRunFailureAlerts {
+		return nil
+	}
+
 	lastAlertedAt := tenantAlerting.Settings.LastAlertedAt.Time.UTC()
 	maxFrequency, err := time.ParseDuration(tenantAlerting.Settings.MaxFrequency)
 
@@ -47,31 +51,37 @@ func (t *TenantAlertManager) HandleAlert(tenantId string) error {
 		return err
 	}
 
-	isZero := lastAlertedAt.IsZero()
+	now := time.Now().UTC()
 
-	if isZero || time.Since(lastAlertedAt) > maxFrequency {
-		// update the lastAlertedAt
-		now := time.Now().UTC()
+	if !lastAlertedAt.IsZero() && now.Sub(lastAlertedAt) <= maxFrequency {
+		return nil
+	}
 
-		// if we're in the zero state, we don't want to alert since the very beginning of the interval
-		if isZero {
-			lastAlertedAt = now.Add(-1 * maxFrequency)
-		}
+	failedWorkflowRuns, err := t.listFailedWorkflowRuns(ctx, tenantAlerting, alertWindowStart(lastAlertedAt, maxFrequency, now))
 
-		err = t.repo.TenantAlertingSettings().UpdateTenantAlertingSettings(ctx, tenantId, &repository.UpdateTenantAlertingSettingsOpts{
-			LastAlertedAt: &now,
-		})
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			return err
-		}
+	// don't update the lastAlertedAt until the threshold is reached, so the failures below the threshold
+	// are counted towards the next alert
+	if !reachesFailureThreshold(tenantAlerting.Settings, failedWorkflowRuns.Count) {
+		return nil
+	}
+
+	err = t.repo.TenantAlertingSettings().UpdateTenantAlertingSettings(ctx, tenantId, &repository.UpdateTenantAlertingSettingsOpts{
+		LastAlertedAt: &now,
+	})
 
-		return t.sendWorkflowRunAlert(ctx, tenantAlerting, lastAlertedAt)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return t.sendWorkflowRunAlert(tenantAlerting, failedWorkflowRuns)
 }
 
+// SendWorkflowRunAlert sends an alert for the failed workflow runs since the previous alert. It's called by
+// the ticker after it has checked the failure threshold and updated the lastAlertedAt.
 func (t *TenantAlertManager) SendWorkflowRunAlert(tenantId string, prevLastAlertedAt time.Time) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -83,16 +93,50 @@ func (t *TenantAlertManager) SendWorkflowRunAlert(tenantId string, prevLastAlert
 		return err
 	}
 
-	return t.sendWorkflowRunAlert(ctx, tenantAlerting, prevLastAlertedAt)
-}
+	if !tenantAlerting.Settings.EnableWorkflowRunFailureAlerts {
+		return nil
+	}
+
+	maxFrequency, err := time.ParseDuration(tenantAlerting.Settings.MaxFrequency)
+
+	if err != nil {
+		return err
+	}
 
-func (t *TenantAlertManager) sendWorkflowRunAlert(ctx context.Context, tenantAlerting *repository.GetTenantAlertingSettingsResponse, prevLastAlertedAt time.Time) error {
+	failedWorkflowRuns, err := t.listFailedWorkflowRuns(ctx, tenantAlerting, alertWindowStart(prevLastAlertedAt.UTC(), maxFrequency, time.Now().UTC()))
 
-	if !tenantAlerting.Settings.EnableWorkflowRunFailureAlerts {
+	if err != nil {
+		return err
+	}
+
+	if !reachesFailureThreshold(tenantAlerting.Settings, failedWorkflowRuns.Count) {
 		return nil
 	}
 
-	// read in all failed workflow runs since the last alerted time, ordered by the most recent runs first
+	return t.sendWorkflowRunAlert(tenantAlerting, failedWorkflowRuns)
+}
+
+// alertWindowStart returns the time from which failed workflow runs are part of an alert: the last alert,
+// but no earlier than the alerting frequency, so the first alert doesn't include every failure since the
+// very beginning.
+func alertWindowStart(lastAlertedAt time.Time, maxFrequency time.Duration, now time.Time) time.Time {
+	windowStart := now.Add(-1 * maxFrequency)
+
+	if lastAlertedAt.After(windowStart) {
+		return lastAlertedAt
+	}
+
+	return windowStart
+}
+
+// reachesFailureThreshold returns whether the number of failed workflow runs is enough to alert.
+func reachesFailureThreshold(settings *dbsqlc.TenantAlertingSettings, numFailed int) bool {
+	return numFailed > 0 && numFailed >= int(settings.WorkflowRunFailureThreshold)
+}
+
+// listFailedWorkflowRuns lists the failed workflow runs since the given time, ordered by the most recent runs
+// first. Runs of muted workflows are excluded.
+func (t *TenantAlertManager) listFailedWorkflowRuns(ctx context.Context, tenantAlerting *repository.GetTenantAlertingSettingsResponse, since time.Time) (*repository.ListWorkflowRunsResult, error) {
 	statuses := []db.WorkflowRunStatus{
 		db.WorkflowRunStatusFailed,
 	}
@@ -101,35 +145,38 @@ func (t *TenantAlertManager) sendWorkflowRunAlert(ctx context.Context, tenantAle
 
 	tenantId := sqlchelpers.UUIDToStr(tenantAlerting.Settings.TenantId)
 
-	failedWorkflowRuns, err := t.repo.WorkflowRun().ListWorkflowRuns(
+	return t.repo.WorkflowRun().ListWorkflowRuns(
 		ctx,
 		tenantId,
 		&repository.ListWorkflowRunsOpts{
-			Statuses:       &statuses,
-			Limit:          &limit,
-			OrderBy:        repository.StringPtr("createdAt"),
-			OrderDirection: repository.StringPtr("DESC"),
-			FinishedAfter:  &prevLastAlertedAt,
+			Statuses:            &statuses,
+			Limit:               &limit,
+			OrderBy:             repository.StringPtr("createdAt"),
+			OrderDirection:      repository.StringPtr("DESC"),
+			FinishedAfter:       &since,
+			ExcludedWorkflowIds: tenantAlerting.MutedWorkflowIds,
 		},
 	)
+}
 
-	if err != nil {
-		return err
-	}
-
-	if failedWorkflowRuns.Count == 0 {
-		return nil
-	}
-
+func (t *TenantAlertManager) sendWorkflowRunAlert(tenantAlerting *repository.GetTenantAlertingSettingsResponse, failedWorkflowRuns *repository.ListWorkflowRunsResult) error {
 	failedItems := t.getFailedItems(failedWorkflowRuns)
 
 	if len(failedItems) == 0 {
 		return nil
 	}
 
+	var err error
+
 	// iterate through possible alerters
-	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
-		if innerErr := t.sendSlackWorkflowRunAlert(slackWebhook, failedWorkflowRuns.Count, failedItems); innerErr != nil {
+	slackWebhookURLs, innerErr := t.decryptSlackWebhookURLs(sqlchelpers.UUIDToStr(tenantAlerting.Settings.TenantId), tenantAlerting.SlackWebhooks)
+
+	if innerErr != nil {
+		err = multierror.Append(err, innerErr)
+	}
+
+	for _, slackWebhookURL := range slackWebhookURLs {
+		if innerErr := t.sendSlackWorkflowRunAlert(slackWebhookURL, failedWorkflowRuns.Count, failedItems); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
@@ -198,8 +245,14 @@ func (t *TenantAlertManager) sendExpiringTokenAlert(ctx context.Context, tenantA
 	var err error
 
 	// iterate through possible alerters
-	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
-		if innerErr := t.sendSlackExpiringTokenAlert(slackWebhook, payload); innerErr != nil {
+	slackWebhookURLs, innerErr := t.decryptSlackWebhookURLs(sqlchelpers.UUIDToStr(tenantAlerting.Settings.TenantId), tenantAlerting.SlackWebhooks)
+
+	if innerErr != nil {
+		err = multierror.Append(err, innerErr)
+	}
+
+	for _, slackWebhookURL := range slackWebhookURLs {
+		if innerErr := t.sendSlackExpiringTokenAlert(slackWebhookURL, payload); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
@@ -269,8 +322,14 @@ func (t *TenantAlertManager) sendTenantResourceLimitAlert(ctx context.Context, t
 	var err error
 
 	// iterate through possible alerters
-	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
-		if innerErr := t.sendSlackTenantResourceLimitAlert(slackWebhook, payload); innerErr != nil {
+	slackWebhookURLs, innerErr := t.decryptSlackWebhookURLs(sqlchelpers.UUIDToStr(tenantAlerting.Settings.TenantId), tenantAlerting.SlackWebhooks)
+
+	if innerErr != nil {
+		err = multierror.Append(err, innerErr)
+	}
+
+	for _, slackWebhookURL := range slackWebhookURLs {
+		if innerErr := t.sendSlackTenantResourceLimitAlert(slackWebhookURL, payload); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
@@ -283,3 +342,48 @@ func (t *TenantAlertManager) sendTenantResourceLimitAlert(ctx context.Context, t
 
 	return nil
 }
+
+// SendWorkerOfflineAlert sends an alert for workers of the tenant which stopped sending heartbeats.
+func (t *TenantAlertManager) SendWorkerOfflineAlert(tenantId string, workers []*dbsqlc.PollWorkerOfflineAlertsRow) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// read in the tenant alerting settings and determine if we should alert
+	tenantAlerting, err := t.repo.TenantAlertingSettings().GetTenantAlertingSettings(ctx, tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	if !tenantAlerting.Settings.EnableWorkerOfflineAlerts || len(workers) == 0 {
+		return nil
+	}
+
+	items := make([]alerttypes.WorkerOfflineItem, 0, len(workers))
+
+	for _, worker := range workers {
+		items = append(items, alerttypes.WorkerOfflineItem{
+			Link:         fmt.Sprintf("%s/workers/%s?tenant=%s", t.serverURL, sqlchelpers.UUIDToStr(worker.ID), tenantId),
+			WorkerName:   worker.Name,
+			RelativeDate: timediff.TimeDiff(worker.LastHeartbeatAt.Time),
+			AbsoluteDate: worker.LastHeartbeatAt.Time.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	// iterate through possible alerters
+	slackWebhookURLs, err := t.decryptSlackWebhookURLs(tenantId, tenantAlerting.SlackWebhooks)
+
+	for _, slackWebhookURL := range slackWebhookURLs {
+		if innerErr := t.sendSlackWorkerOfflineAlert(slackWebhookURL, items); innerErr != nil {
+			err = multierror.Append(err, innerErr)
+		}
+	}
+
+	for _, emailGroup := range tenantAlerting.EmailGroups {
+		if innerErr := t.sendEmailWorkerOfflineAlert(tenantAlerting.Tenant, emailGroup, items); innerErr != nil {
+			err = multierror.Append(err, innerErr)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,44 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestAlertWindowStart(t *testing.T) {
+	now := time.Date(2025, 2, 4, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now.Add(-time.Hour), alertWindowStart(time.Time{}, time.Hour, now), "the first alert should start at the alerting frequency")
+	assert.Equal(t, now.Add(-3*time.Hour), alertWindowStart(now.Add(-5*time.Hour), 3*time.Hour, now), "failures before the alerting frequency should not be part of an alert")
+	assert.Equal(t, now.Add(-10*time.Minute), alertWindowStart(now.Add(-10*time.Minute), time.Hour, now), "failures of the last alert should not be part of an alert")
+}
+
+func TestReachesFailureThreshold(t *testing.T) {
+	settings := &dbsqlc.TenantAlertingSettings{WorkflowRunFailureThreshold: 3}
+
+	assert.False(t, reachesFailureThreshold(settings, 2))
+	assert.True(t, reachesFailureThreshold(settings, 3))
+	assert.True(t, reachesFailureThreshold(settings, 4))
+
+	assert.False(t, reachesFailureThreshold(&dbsqlc.TenantAlertingSettings{}, 0), "there should be no alert without failures")
+}
+
+func TestGetSlackWorkerOfflineTextAndBlocks(t *testing.T) {
+	manager := &TenantAlertManager{}
+
+	workers := make([]alerttypes.WorkerOfflineItem, 7)
+
+	headerText, blocks := manager.getSlackWorkerOfflineTextAndBlocks(workers)
+
+	assert.Equal(t, "7 Hatchet workers went offline:", headerText)
+	assert.Len(t, blocks.BlockSet, 6, "the header and no more than 5 workers should be listed")
+
+	headerText, _ = manager.getSlackWorkerOfflineTextAndBlocks(workers[:1])
+
+	assert.Equal(t, "1 Hatchet worker went offline:", headerText)
+}
@@ -0,0 +1,8 @@
+package alerttypes
+
+type WorkerOfflineItem struct {
+	Link         string `json:"link"`
+	WorkerName   string `json:"worker_name"`
+	RelativeDate string `json:"relative_date"`
+	AbsoluteDate string `json:"absolute_date"`
+}
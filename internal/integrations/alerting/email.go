@@ -105,3 +105,26 @@ func (t *TenantAlertManager) sendEmailTenantResourceLimitAlert(tenant *dbsqlc.Te
 		},
 	)
 }
+
+func (t *TenantAlertManager) sendEmailWorkerOfflineAlert(tenant *dbsqlc.Tenant, emailGroup *repository.TenantAlertEmailGroupForSend, offlineWorkers []alerttypes.WorkerOfflineItem) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	subject := fmt.Sprintf("%d Hatchet workers went offline", len(offlineWorkers))
+
+	if len(offlineWorkers) <= 1 {
+		subject = fmt.Sprintf("%d Hatchet worker went offline", len(offlineWorkers))
+	}
+
+	return t.email.SendWorkerOfflineAlert(
+		ctx,
+		emailGroup.Emails,
+		email.WorkerOfflineEmailData{
+			TenantName:   tenant.Name,
+			Items:        offlineWorkers,
+			Subject:      subject,
+			Summary:      subject,
+			SettingsLink: fmt.Sprintf("%s/tenant-settings/alerting?tenant=%s", t.serverURL, sqlchelpers.UUIDToStr(tenant.ID)),
+		},
+	)
+}
@@ -6,20 +6,54 @@ import (
 	"github.com/slack-go/slack"
 
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
-func (t *TenantAlertManager) sendSlackWorkflowRunAlert(slackWebhook *dbsqlc.SlackAppWebhook, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error {
-	headerText, blocks := t.getSlackWorkflowRunTextAndBlocks(numFailed, failedRuns)
+// decryptSlackWebhookURLs decrypts the incoming webhook urls of the slack webhooks in a single batch. Webhooks
+// which cannot be decrypted are skipped, and their errors are returned alongside the decrypted urls.
+func (t *TenantAlertManager) decryptSlackWebhookURLs(tenantId string, slackWebhooks []*dbsqlc.SlackAppWebhook) ([]string, error) {
+	if len(slackWebhooks) == 0 {
+		return nil, nil
+	}
 
-	// decrypt the webhook url
-	whDecrypted, err := t.enc.Decrypt(slackWebhook.WebhookURL, "incoming_webhook_url")
+	enc, err := t.enc.ForTenant(tenantId)
 
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("could not get encryption service for tenant: %w", err)
 	}
 
-	err = slack.PostWebhook(string(whDecrypted), &slack.WebhookMessage{
+	items := make([]encryption.EncryptedItem, len(slackWebhooks))
+
+	for i, slackWebhook := range slackWebhooks {
+		items[i] = encryption.EncryptedItem{
+			Ciphertext: slackWebhook.WebhookURL,
+			DataId:     "incoming_webhook_url",
+		}
+	}
+
+	decrypted, err := enc.DecryptBatch(items)
+
+	res := make([]string, 0, len(decrypted))
+
+	for _, whDecrypted := range decrypted {
+		// items which failed to decrypt are nil
+		if whDecrypted != nil {
+			res = append(res, string(whDecrypted))
+		}
+	}
+
+	if err != nil {
+		return res, fmt.Errorf("could not decrypt slack webhook urls: %w", err)
+	}
+
+	return res, nil
+}
+
+func (t *TenantAlertManager) sendSlackWorkflowRunAlert(slackWebhookURL string, numFailed int, failedRuns []alerttypes.WorkflowRunFailedItem) error {
+	headerText, blocks := t.getSlackWorkflowRunTextAndBlocks(numFailed, failedRuns)
+
+	err := slack.PostWebhook(slackWebhookURL, &slack.WebhookMessage{
 		Text:   headerText,
 		Blocks: blocks,
 	})
@@ -80,17 +114,10 @@ func (t *TenantAlertManager) getSlackWorkflowRunTextAndBlocks(numFailed int, fai
 	}
 }
 
-func (t *TenantAlertManager) sendSlackExpiringTokenAlert(slackWebhook *dbsqlc.SlackAppWebhook, payload *alerttypes.ExpiringTokenItem) error {
+func (t *TenantAlertManager) sendSlackExpiringTokenAlert(slackWebhookURL string, payload *alerttypes.ExpiringTokenItem) error {
 	headerText, blocks := t.getSlackExpiringTokenTextAndBlocks(payload)
 
-	// decrypt the webhook url
-	whDecrypted, err := t.enc.Decrypt(slackWebhook.WebhookURL, "incoming_webhook_url")
-
-	if err != nil {
-		return err
-	}
-
-	err = slack.PostWebhook(string(whDecrypted), &slack.WebhookMessage{
+	err := slack.PostWebhook(slackWebhookURL, &slack.WebhookMessage{
 		Text:   headerText,
 		Blocks: blocks,
 	})
@@ -140,17 +167,10 @@ func (t *TenantAlertManager) getSlackExpiringTokenTextAndBlocks(payload *alertty
 	}
 }
 
-func (t *TenantAlertManager) sendSlackTenantResourceLimitAlert(slackWebhook *dbsqlc.SlackAppWebhook, payload *alerttypes.ResourceLimitAlert) error {
+func (t *TenantAlertManager) sendSlackTenantResourceLimitAlert(slackWebhookURL string, payload *alerttypes.ResourceLimitAlert) error {
 	headerText, blocks := t.getSlackTenantResourceLimitTextAndBlocks(payload)
 
-	// decrypt the webhook url
-	whDecrypted, err := t.enc.Decrypt(slackWebhook.WebhookURL, "incoming_webhook_url")
-
-	if err != nil {
-		return err
-	}
-
-	err = slack.PostWebhook(string(whDecrypted), &slack.WebhookMessage{
+	err := slack.PostWebhook(slackWebhookURL, &slack.WebhookMessage{
 		Text:   headerText,
 		Blocks: blocks,
 	})
@@ -221,3 +241,67 @@ func (t *TenantAlertManager) getSlackTenantResourceLimitTextAndBlocks(payload *a
 		BlockSet: res,
 	}
 }
+
+func (t *TenantAlertManager) sendSlackWorkerOfflineAlert(slackWebhookURL string, offlineWorkers []alerttypes.WorkerOfflineItem) error {
+	headerText, blocks := t.getSlackWorkerOfflineTextAndBlocks(offlineWorkers)
+
+	err := slack.PostWebhook(slackWebhookURL, &slack.WebhookMessage{
+		Text:   headerText,
+		Blocks: blocks,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *TenantAlertManager) getSlackWorkerOfflineTextAndBlocks(offlineWorkers []alerttypes.WorkerOfflineItem) (string, *slack.Blocks) {
+	res := make([]slack.Block, 0)
+
+	headerText := fmt.Sprintf("%d Hatchet workers went offline:", len(offlineWorkers))
+
+	if len(offlineWorkers) <= 1 {
+		headerText = fmt.Sprintf("%d Hatchet worker went offline:", len(offlineWorkers))
+	}
+
+	res = append(res, slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, headerText, false, false),
+		nil,
+		nil,
+	))
+
+	for i, worker := range offlineWorkers {
+		// don't add more than 5 offline workers
+		if i >= 5 {
+			break
+		}
+
+		buttonAccessory := slack.NewAccessory(
+			slack.NewButtonBlockElement(
+				"View",
+				worker.WorkerName,
+				slack.NewTextBlockObject(slack.PlainTextType, "View", true, false),
+			),
+		)
+
+		buttonAccessory.ButtonElement.URL = worker.Link
+		buttonAccessory.ButtonElement.ActionID = "button-action"
+
+		res = append(res, slack.NewSectionBlock(
+			slack.NewTextBlockObject(
+				slack.MarkdownType,
+				fmt.Sprintf(":red_circle: `%s` sent its last heartbeat %s", worker.WorkerName, worker.RelativeDate),
+				false,
+				false,
+			),
+			nil,
+			buttonAccessory,
+		))
+	}
+
+	return headerText, &slack.Blocks{
+		BlockSet: res,
+	}
+}
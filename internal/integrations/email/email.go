@@ -44,6 +44,14 @@ type ResourceLimitAlertData struct {
 	SettingsLink string `json:"settings_link"`
 }
 
+type WorkerOfflineEmailData struct {
+	Items        []alerttypes.WorkerOfflineItem `json:"items"`
+	Subject      string                         `json:"subject"`
+	Summary      string                         `json:"summary"`
+	TenantName   string                         `json:"tenant_name"`
+	SettingsLink string                         `json:"settings_link"`
+}
+
 type EmailService interface {
 	// for clients to show email settings
 	IsValid() bool
@@ -52,6 +60,7 @@ type EmailService interface {
 	SendWorkflowRunFailedAlerts(ctx context.Context, emails []string, data WorkflowRunsFailedEmailData) error
 	SendExpiringTokenEmail(ctx context.Context, emails []string, data ExpiringTokenEmailData) error
 	SendTenantResourceLimitAlert(ctx context.Context, emails []string, data ResourceLimitAlertData) error
+	SendWorkerOfflineAlert(ctx context.Context, emails []string, data WorkerOfflineEmailData) error
 }
 
 type NoOpService struct{}
@@ -75,3 +84,7 @@ func (s *NoOpService) SendExpiringTokenEmail(ctx context.Context, emails []strin
 func (s *NoOpService) SendTenantResourceLimitAlert(ctx context.Context, emails []string, data ResourceLimitAlertData) error {
 	return nil
 }
+
+func (s *NoOpService) SendWorkerOfflineAlert(ctx context.Context, emails []string, data WorkerOfflineEmailData) error {
+	return nil
+}
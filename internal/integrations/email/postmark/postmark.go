@@ -37,6 +37,7 @@ const (
 	workflowRunsFailedTemplate = "workflow-runs-failed"
 	tokenAlertExpiringTemplate = "token-expiring" // nolint: gosec
 	resourceLimitAlertTemplate = "resource-limit-alert"
+	workerOfflineTemplate      = "worker-offline"
 )
 
 type sendEmailFromTemplateRequest struct {
@@ -71,6 +72,10 @@ func (c *PostmarkClient) SendTenantResourceLimitAlert(ctx context.Context, email
 	return c.sendTemplateEmailBCC(ctx, strings.Join(emails, ","), resourceLimitAlertTemplate, data, true)
 }
 
+func (c *PostmarkClient) SendWorkerOfflineAlert(ctx context.Context, emails []string, data email.WorkerOfflineEmailData) error {
+	return c.sendTemplateEmailBCC(ctx, strings.Join(emails, ","), workerOfflineTemplate, data, false)
+}
+
 func (c *PostmarkClient) sendTemplateEmail(ctx context.Context, to, templateAlias string, templateModelData interface{}, bccSupport bool) error {
 	var bcc string
 
@@ -0,0 +1,114 @@
+package email
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+)
+
+// maxRateLimiters is the number of recipient lists which rate limits are kept for before the limiters which
+// are full again are dropped.
+const maxRateLimiters = 10000
+
+// RateLimitedService limits the number of alerts which are sent to the same recipients per hour, so a
+// failure storm doesn't send thousands of emails. Alerts over the limit are dropped. Invites aren't limited,
+// since they are sent by users.
+//
+// Limits are kept in memory, so each engine instance has its own limits.
+type RateLimitedService struct {
+	EmailService
+
+	perHour int
+	l       *zerolog.Logger
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func NewRateLimitedService(svc EmailService, perHour int, l *zerolog.Logger) *RateLimitedService {
+	return &RateLimitedService{
+		EmailService: svc,
+		perHour:      perHour,
+		l:            l,
+		limiters:     make(map[string]*rate.Limiter),
+	}
+}
+
+func (s *RateLimitedService) SendWorkflowRunFailedAlerts(ctx context.Context, emails []string, data WorkflowRunsFailedEmailData) error {
+	if !s.allow(emails, data.Subject) {
+		return nil
+	}
+
+	return s.EmailService.SendWorkflowRunFailedAlerts(ctx, emails, data)
+}
+
+func (s *RateLimitedService) SendExpiringTokenEmail(ctx context.Context, emails []string, data ExpiringTokenEmailData) error {
+	if !s.allow(emails, data.Subject) {
+		return nil
+	}
+
+	return s.EmailService.SendExpiringTokenEmail(ctx, emails, data)
+}
+
+func (s *RateLimitedService) SendTenantResourceLimitAlert(ctx context.Context, emails []string, data ResourceLimitAlertData) error {
+	if !s.allow(emails, data.Subject) {
+		return nil
+	}
+
+	return s.EmailService.SendTenantResourceLimitAlert(ctx, emails, data)
+}
+
+func (s *RateLimitedService) SendWorkerOfflineAlert(ctx context.Context, emails []string, data WorkerOfflineEmailData) error {
+	if !s.allow(emails, data.Subject) {
+		return nil
+	}
+
+	return s.EmailService.SendWorkerOfflineAlert(ctx, emails, data)
+}
+
+func (s *RateLimitedService) allow(emails []string, subject string) bool {
+	if s.perHour <= 0 {
+		return true
+	}
+
+	sorted := slices.Clone(emails)
+	slices.Sort(sorted)
+
+	key := strings.Join(sorted, ",")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limiter, ok := s.limiters[key]
+
+	if !ok {
+		if len(s.limiters) >= maxRateLimiters {
+			s.dropFullLimiters()
+		}
+
+		limiter = rate.NewLimiter(rate.Every(time.Hour/time.Duration(s.perHour)), s.perHour)
+		s.limiters[key] = limiter
+	}
+
+	if !limiter.Allow() {
+		s.l.Warn().Msgf("dropping alert email %q to %d recipients, the limit of %d alerts per hour is reached", subject, len(emails), s.perHour)
+		return false
+	}
+
+	return true
+}
+
+// dropFullLimiters drops the limiters which have all tokens, since new limiters behave the same. It must be
+// called with the lock held.
+func (s *RateLimitedService) dropFullLimiters() {
+	for key, limiter := range s.limiters {
+		if limiter.Tokens() >= float64(s.perHour) {
+			delete(s.limiters, key)
+		}
+	}
+}
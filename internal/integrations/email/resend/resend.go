@@ -0,0 +1,89 @@
+package resend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/email"
+)
+
+const resendAPIURL = "https://api.resend.com"
+
+type ResendSender struct {
+	apiKey  string
+	baseURL string
+
+	httpClient *http.Client
+}
+
+// NewResendSender creates a new sender which sends emails through Resend
+func NewResendSender(apiKey string) *ResendSender {
+	return &ResendSender{
+		apiKey:  apiKey,
+		baseURL: resendAPIURL,
+		httpClient: &http.Client{
+			Timeout: time.Minute,
+		},
+	}
+}
+
+type sendEmailRequest struct {
+	From    string   `json:"from"`
+	To      []string `json:"to"`
+	Bcc     []string `json:"bcc,omitempty"`
+	Subject string   `json:"subject"`
+	HTML    string   `json:"html"`
+	Text    string   `json:"text"`
+}
+
+func (s *ResendSender) Send(ctx context.Context, msg *email.Message) error {
+	to := msg.To
+
+	// resend requires a recipient, so messages to bcc recipients only are addressed to the sender
+	if len(to) == 0 {
+		to = []string{msg.From.Address}
+	}
+
+	body, err := json.Marshal(&sendEmailRequest{
+		From:    msg.From.String(),
+		To:      to,
+		Bcc:     msg.Bcc,
+		Subject: msg.Subject,
+		HTML:    msg.HTML,
+		Text:    msg.Text,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/emails", bytes.NewReader(body))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	res, err := s.httpClient.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		resBytes, _ := io.ReadAll(io.LimitReader(res.Body, 1024)) // nolint: errcheck
+
+		return fmt.Errorf("request failed with status code %d: %s", res.StatusCode, string(resBytes))
+	}
+
+	return nil
+}
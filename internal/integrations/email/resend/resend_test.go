@@ -0,0 +1,61 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/email"
+)
+
+func TestSend(t *testing.T) {
+	var got sendEmailRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/emails", r.URL.Path)
+		assert.Equal(t, "Bearer re_123", r.Header.Get("Authorization"))
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s := NewResendSender("re_123")
+	s.baseURL = server.URL
+
+	err := s.Send(context.Background(), &email.Message{
+		From:    mail.Address{Name: "Hatchet", Address: "alerts@example.com"},
+		Bcc:     []string{"oncall@example.com"},
+		Subject: "1 Hatchet workflow failed",
+		HTML:    "<p>1 Hatchet workflow failed</p>",
+		Text:    "1 Hatchet workflow failed",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Hatchet" <alerts@example.com>`, got.From)
+	assert.Equal(t, []string{"alerts@example.com"}, got.To, "messages to bcc recipients only should be addressed to the sender")
+	assert.Equal(t, []string{"oncall@example.com"}, got.Bcc)
+}
+
+func TestSendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message": "invalid from address"}`))
+	}))
+	defer server.Close()
+
+	s := NewResendSender("re_123")
+	s.baseURL = server.URL
+
+	err := s.Send(context.Background(), &email.Message{To: []string{"user@example.com"}})
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "invalid from address")
+}
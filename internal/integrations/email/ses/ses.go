@@ -0,0 +1,84 @@
+package ses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/email"
+)
+
+type SESSender struct {
+	client           *sesv2.Client
+	configurationSet string
+}
+
+// NewSESSender creates a new sender which sends emails through Amazon SES. If no access key is given, the
+// credentials are loaded from the environment, for example from the role of the instance.
+func NewSESSender(ctx context.Context, region, accessKeyID, secretAccessKey, configurationSet string) (*SESSender, error) {
+	opts := []func(*config.LoadOptions) error{
+		config.WithRegion(region),
+	}
+
+	if accessKeyID != "" {
+		opts = append(opts, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, ""),
+		))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load aws config: %w", err)
+	}
+
+	return &SESSender{
+		client:           sesv2.NewFromConfig(cfg),
+		configurationSet: configurationSet,
+	}, nil
+}
+
+func (s *SESSender) Send(ctx context.Context, msg *email.Message) error {
+	input := &sesv2.SendEmailInput{
+		FromEmailAddress: aws.String(msg.From.String()),
+		Destination: &types.Destination{
+			ToAddresses:  msg.To,
+			BccAddresses: msg.Bcc,
+		},
+		Content: &types.EmailContent{
+			Simple: &types.Message{
+				Subject: &types.Content{
+					Data:    aws.String(msg.Subject),
+					Charset: aws.String("UTF-8"),
+				},
+				Body: &types.Body{
+					Html: &types.Content{
+						Data:    aws.String(msg.HTML),
+						Charset: aws.String("UTF-8"),
+					},
+					Text: &types.Content{
+						Data:    aws.String(msg.Text),
+						Charset: aws.String("UTF-8"),
+					},
+				},
+			},
+		},
+	}
+
+	if s.configurationSet != "" {
+		input.ConfigurationSetName = aws.String(s.configurationSet)
+	}
+
+	_, err := s.client.SendEmail(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("could not send email through ses: %w", err)
+	}
+
+	return nil
+}
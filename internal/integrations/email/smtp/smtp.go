@@ -0,0 +1,210 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/email"
+)
+
+// The ways in which connections to the SMTP server are secured.
+const (
+	TLSModeStartTLS = "starttls"
+	TLSModeTLS      = "tls"
+	TLSModeNone     = "none"
+)
+
+type SMTPSender struct {
+	host     string
+	port     int
+	username string
+	password string
+	tlsMode  string
+}
+
+// NewSMTPSender creates a new sender which sends emails through an SMTP server. Credentials are only sent
+// over encrypted connections, or to a server on localhost.
+func NewSMTPSender(host string, port int, username, password, tlsMode string) *SMTPSender {
+	return &SMTPSender{host, port, username, password, tlsMode}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg *email.Message) error {
+	data, err := buildMessage(msg, time.Now())
+
+	if err != nil {
+		return err
+	}
+
+	conn, err := s.dial(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not connect to smtp server: %w", err)
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("could not create smtp client: %w", err)
+	}
+
+	defer c.Close()
+
+	if s.tlsMode == TLSModeStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("smtp server does not support STARTTLS")
+		}
+
+		if err := c.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("could not start tls: %w", err)
+		}
+	}
+
+	if s.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("could not authenticate with smtp server: %w", err)
+		}
+	}
+
+	if err := c.Mail(msg.From.Address); err != nil {
+		return fmt.Errorf("smtp server rejected sender: %w", err)
+	}
+
+	for _, rcpt := range append(msg.To, msg.Bcc...) {
+		if err := c.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("smtp server rejected recipient: %w", err)
+		}
+	}
+
+	w, err := c.Data()
+
+	if err != nil {
+		return fmt.Errorf("could not send message: %w", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("could not send message: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("could not send message: %w", err)
+	}
+
+	return c.Quit()
+}
+
+func (s *SMTPSender) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(s.host, strconv.Itoa(s.port))
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var conn net.Conn
+	var err error
+
+	if s.tlsMode == TLSModeTLS {
+		dialer := &tls.Dialer{
+			Config: &tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12},
+		}
+
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		dialer := &net.Dialer{}
+
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the smtp client doesn't take a context, so the deadline applies to the whole conversation
+	deadline := time.Now().Add(time.Minute)
+
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// buildMessage builds a multipart message with a plain text and an html part. Bcc recipients are not part
+// of the headers.
+func buildMessage(msg *email.Message, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	to := "undisclosed-recipients:;"
+
+	if len(msg.To) > 0 {
+		to = strings.Join(msg.To, ", ")
+	}
+
+	mw := multipart.NewWriter(&buf)
+
+	headers := []string{
+		"From: " + msg.From.String(),
+		"To: " + to,
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + now.Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q", mw.Boundary()),
+	}
+
+	// the boundary of the parts is part of the headers, so the parts are written to a separate buffer
+	var head bytes.Buffer
+
+	for _, header := range headers {
+		head.WriteString(header + "\r\n")
+	}
+
+	head.WriteString("\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		qw := quotedprintable.NewWriter(pw)
+
+		if _, err := qw.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return append(head.Bytes(), buf.Bytes()...), nil
+}
@@ -0,0 +1,43 @@
+package smtp
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/email"
+)
+
+func TestBuildMessage(t *testing.T) {
+	data, err := buildMessage(&email.Message{
+		From:    mail.Address{Name: "Hatchet Alerts", Address: "alerts@example.com"},
+		Bcc:     []string{"oncall@example.com"},
+		Subject: "2 Hatchet workflows failed – täglich",
+		HTML:    "<p>2 Hatchet workflows failed</p>",
+		Text:    "2 Hatchet workflows failed",
+	}, time.Date(2025, 2, 4, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Hatchet Alerts" <alerts@example.com>`, msg.Header.Get("From"))
+	assert.Equal(t, "undisclosed-recipients:;", msg.Header.Get("To"), "bcc recipients should not be in the headers")
+	assert.NotContains(t, string(data), "oncall@example.com")
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "2 Hatchet workflows failed – täglich", subject)
+
+	body, err := io.ReadAll(msg.Body)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(body), "text/plain; charset=utf-8")
+	assert.Contains(t, string(body), "text/html; charset=utf-8")
+}
@@ -0,0 +1,160 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"net/mail"
+	"slices"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templatesFS embed.FS
+
+// The names of the templates in the templates directory. Each template has an html and a txt file.
+const (
+	tenantInviteTemplate       = "tenant_invite"
+	workflowRunsFailedTemplate = "workflow_runs_failed"
+	tokenExpiringTemplate      = "token_expiring"
+	resourceLimitAlertTemplate = "resource_limit_alert"
+	workerOfflineTemplate      = "worker_offline"
+)
+
+// Message is an email which is sent by a Sender. Recipients in Bcc are not visible to the other recipients.
+type Message struct {
+	From    mail.Address
+	To      []string
+	Bcc     []string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Sender sends messages through an email provider.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+type emailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// TemplatedService renders emails from the templates which are bundled with Hatchet and sends them with a
+// Sender, for providers which don't host templates themselves.
+type TemplatedService struct {
+	sender       Sender
+	from         mail.Address
+	supportEmail string
+	templates    map[string]*emailTemplate
+}
+
+func NewTemplatedService(sender Sender, fromEmail, fromName, supportEmail string) (*TemplatedService, error) {
+	templates := make(map[string]*emailTemplate)
+
+	for _, name := range []string{
+		tenantInviteTemplate,
+		workflowRunsFailedTemplate,
+		tokenExpiringTemplate,
+		resourceLimitAlertTemplate,
+		workerOfflineTemplate,
+	} {
+		html, err := htmltemplate.ParseFS(templatesFS, "templates/layout.html", fmt.Sprintf("templates/%s.html", name))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s html template: %w", name, err)
+		}
+
+		text, err := texttemplate.ParseFS(templatesFS, fmt.Sprintf("templates/%s.txt", name))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s text template: %w", name, err)
+		}
+
+		templates[name] = &emailTemplate{
+			html: html,
+			text: text,
+		}
+	}
+
+	return &TemplatedService{
+		sender: sender,
+		from: mail.Address{
+			Name:    fromName,
+			Address: fromEmail,
+		},
+		supportEmail: supportEmail,
+		templates:    templates,
+	}, nil
+}
+
+func (s *TemplatedService) IsValid() bool {
+	return true
+}
+
+func (s *TemplatedService) SendTenantInviteEmail(ctx context.Context, email string, data TenantInviteEmailData) error {
+	subject := fmt.Sprintf("%s invited you to join %s on Hatchet", data.InviteSenderName, data.TenantName)
+
+	return s.send(ctx, []string{email}, nil, subject, tenantInviteTemplate, data)
+}
+
+func (s *TemplatedService) SendWorkflowRunFailedAlerts(ctx context.Context, emails []string, data WorkflowRunsFailedEmailData) error {
+	return s.send(ctx, nil, emails, data.Subject, workflowRunsFailedTemplate, data)
+}
+
+func (s *TemplatedService) SendExpiringTokenEmail(ctx context.Context, emails []string, data ExpiringTokenEmailData) error {
+	return s.send(ctx, nil, emails, data.Subject, tokenExpiringTemplate, data)
+}
+
+func (s *TemplatedService) SendTenantResourceLimitAlert(ctx context.Context, emails []string, data ResourceLimitAlertData) error {
+	if s.supportEmail != "" {
+		emails = append(slices.Clone(emails), s.supportEmail)
+	}
+
+	return s.send(ctx, nil, emails, data.Subject, resourceLimitAlertTemplate, data)
+}
+
+func (s *TemplatedService) SendWorkerOfflineAlert(ctx context.Context, emails []string, data WorkerOfflineEmailData) error {
+	return s.send(ctx, nil, emails, data.Subject, workerOfflineTemplate, data)
+}
+
+func (s *TemplatedService) send(ctx context.Context, to, bcc []string, subject, templateName string, data any) error {
+	msg, err := s.render(templateName, data)
+
+	if err != nil {
+		return err
+	}
+
+	msg.To = to
+	msg.Bcc = bcc
+	msg.Subject = subject
+
+	return s.sender.Send(ctx, msg)
+}
+
+func (s *TemplatedService) render(templateName string, data any) (*Message, error) {
+	t, ok := s.templates[templateName]
+
+	if !ok {
+		return nil, fmt.Errorf("unknown email template %s", templateName)
+	}
+
+	var html, text bytes.Buffer
+
+	if err := t.html.ExecuteTemplate(&html, "layout", data); err != nil {
+		return nil, fmt.Errorf("could not render %s html template: %w", templateName, err)
+	}
+
+	if err := t.text.Execute(&text, data); err != nil {
+		return nil, fmt.Errorf("could not render %s text template: %w", templateName, err)
+	}
+
+	return &Message{
+		From: s.from,
+		HTML: html.String(),
+		Text: text.String(),
+	}, nil
+}
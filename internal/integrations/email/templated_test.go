@@ -0,0 +1,103 @@
+package email
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/alerting/alerttypes"
+)
+
+type fakeSender struct {
+	msgs []*Message
+}
+
+func (f *fakeSender) Send(ctx context.Context, msg *Message) error {
+	f.msgs = append(f.msgs, msg)
+	return nil
+}
+
+func TestTemplatedService(t *testing.T) {
+	sender := &fakeSender{}
+
+	svc, err := NewTemplatedService(sender, "alerts@example.com", "Hatchet", "support@example.com")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, svc.SendTenantInviteEmail(ctx, "user@example.com", TenantInviteEmailData{
+		InviteSenderName: "Alex",
+		TenantName:       "Acme <Prod>",
+		ActionURL:        "https://hatchet.example.com/invites",
+	}))
+
+	require.NoError(t, svc.SendWorkflowRunFailedAlerts(ctx, []string{"oncall@example.com"}, WorkflowRunsFailedEmailData{
+		Items: []alerttypes.WorkflowRunFailedItem{
+			{Link: "https://hatchet.example.com/workflow-runs/1", WorkflowName: "process-order", WorkflowRunReadableId: "process-order-1"},
+		},
+		Subject:    "1 Hatchet workflow failed",
+		Summary:    "1 Hatchet workflow failed",
+		TenantName: "Acme",
+	}))
+
+	require.NoError(t, svc.SendExpiringTokenEmail(ctx, []string{"oncall@example.com"}, ExpiringTokenEmailData{TokenName: "ci", Subject: "Hatchet token expiring in 2 days"}))
+	require.NoError(t, svc.SendTenantResourceLimitAlert(ctx, []string{"oncall@example.com"}, ResourceLimitAlertData{Subject: "Worker has exhausted 80% of its limit"}))
+	require.NoError(t, svc.SendWorkerOfflineAlert(ctx, []string{"oncall@example.com"}, WorkerOfflineEmailData{
+		Items:   []alerttypes.WorkerOfflineItem{{WorkerName: "worker-1"}},
+		Subject: "1 Hatchet worker went offline",
+	}))
+
+	require.Len(t, sender.msgs, 5)
+
+	invite := sender.msgs[0]
+
+	assert.Equal(t, "Alex invited you to join Acme <Prod> on Hatchet", invite.Subject)
+	assert.Equal(t, []string{"user@example.com"}, invite.To)
+	assert.Equal(t, "alerts@example.com", invite.From.Address)
+	assert.Contains(t, invite.HTML, "Acme &lt;Prod&gt;", "html should be escaped")
+	assert.Contains(t, invite.Text, "Acme <Prod>", "text should not be escaped")
+
+	failed := sender.msgs[1]
+
+	assert.Empty(t, failed.To)
+	assert.Equal(t, []string{"oncall@example.com"}, failed.Bcc, "alerts should be sent to bcc recipients")
+	assert.Contains(t, failed.HTML, "https://hatchet.example.com/workflow-runs/1")
+	assert.Contains(t, failed.Text, "process-order-1")
+
+	assert.Equal(t, []string{"oncall@example.com", "support@example.com"}, sender.msgs[3].Bcc, "resource limit alerts should be sent to support")
+	assert.Contains(t, sender.msgs[4].Text, "worker-1")
+}
+
+func TestRateLimitedService(t *testing.T) {
+	sender := &fakeSender{}
+
+	templated, err := NewTemplatedService(sender, "alerts@example.com", "Hatchet", "")
+	require.NoError(t, err)
+
+	l := zerolog.Nop()
+	svc := NewRateLimitedService(templated, 2, &l)
+
+	ctx := context.Background()
+	data := WorkerOfflineEmailData{Subject: "1 Hatchet worker went offline"}
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, svc.SendWorkerOfflineAlert(ctx, []string{"a@example.com", "b@example.com"}, data))
+	}
+
+	assert.Len(t, sender.msgs, 2, "alerts over the limit should be dropped")
+
+	require.NoError(t, svc.SendWorkerOfflineAlert(ctx, []string{"b@example.com", "a@example.com"}, data))
+	assert.Len(t, sender.msgs, 2, "the order of the recipients should not matter")
+
+	require.NoError(t, svc.SendWorkerOfflineAlert(ctx, []string{"c@example.com"}, data))
+	assert.Len(t, sender.msgs, 3, "other recipients should have their own limit")
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, svc.SendTenantInviteEmail(ctx, "a@example.com", TenantInviteEmailData{}))
+	}
+
+	assert.Len(t, sender.msgs, 6, "invites should not be limited")
+}
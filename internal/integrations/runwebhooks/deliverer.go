@@ -0,0 +1,373 @@
+package runwebhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/hatchet-dev/hatchet/internal/signature"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// deliveryBatchSize is the number of deliveries which are claimed at once
+	deliveryBatchSize = 100
+
+	// deliveryClaimTimeout is how long claimed deliveries are not claimed again, in case the engine stops
+	// before the attempt is recorded
+	deliveryClaimTimeout = time.Minute
+
+	// deliveryTimeout is how long the receiver has to respond
+	deliveryTimeout = 10 * time.Second
+
+	// maxConcurrentDeliveries is the number of deliveries which are sent at once
+	maxConcurrentDeliveries = 10
+
+	// MaxDeliveryAttempts is the number of attempts after which a delivery fails
+	MaxDeliveryAttempts = 8
+
+	// maxRetryBackoff is the longest time between two attempts of a delivery
+	maxRetryBackoff = time.Hour
+
+	// maxErrorLength is the number of bytes of an error which are stored
+	maxErrorLength = 1024
+)
+
+// errAddressNotAllowed is returned for deliveries to addresses which aren't public, so subscriptions can't be
+// used to send requests to the network of the engine.
+var errAddressNotAllowed = errors.New("address is not public")
+
+// nonPublicPrefixes are the ranges of unicast addresses which aren't covered by the checks of netip.Addr, but
+// aren't reachable on the internet either.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// isPublicAddress returns whether the address is a unicast address which is reachable on the internet, so not a
+// loopback, private, link-local or shared address.
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkDialAddress refuses connections to addresses which aren't public. It's called with the resolved address
+// of every connection, so host names which resolve to internal addresses are refused as well.
+func checkDialAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+
+	if err != nil {
+		return fmt.Errorf("could not parse address %s: %w", address, err)
+	}
+
+	if !isPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errAddressNotAllowed, addrPort.Addr())
+	}
+
+	return nil
+}
+
+// checkRedirect doesn't follow redirects, so a delivery can't be sent anywhere other than the url of the
+// subscription. The redirect response is returned as a failed attempt.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// newDeliveryClient returns the client which sends deliveries. It doesn't use a proxy, so the addresses it
+// connects to are the addresses of the receivers.
+func newDeliveryClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: checkDialAddress,
+	}
+
+	return &http.Client{
+		Timeout: deliveryTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: deliveryTimeout,
+			MaxIdleConns:        maxConcurrentDeliveries,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: checkRedirect,
+	}
+}
+
+// The headers of deliveries.
+const (
+	HeaderWebhookId        = "X-Hatchet-Webhook-Id"
+	HeaderWebhookEvent     = "X-Hatchet-Webhook-Event"
+	HeaderWebhookTimestamp = "X-Hatchet-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Hatchet-Webhook-Signature"
+)
+
+// Event is the body of a delivery. The id is the id of the delivery, which stays the same when the
+// delivery is retried, so receivers can use it to ignore duplicates.
+type Event struct {
+	Id        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	TenantId  string          `json:"tenantId"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Sign returns the signature of a delivery, which is the hex encoded HMAC-SHA256 of the timestamp and the
+// body, separated by a dot, with the secret of the subscription as the key.
+func Sign(secret string, timestamp int64, body []byte) (string, error) {
+	sig, err := signature.Sign(strconv.FormatInt(timestamp, 10)+"."+string(body), secret)
+
+	if err != nil {
+		return "", err
+	}
+
+	return "sha256=" + sig, nil
+}
+
+// retryBackoff returns how long to wait before the next attempt of a delivery which failed the given
+// number of times.
+func retryBackoff(attempts int32) time.Duration {
+	backoff := 30 * time.Second
+
+	for i := int32(1); i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxRetryBackoff)
+}
+
+// Deliverer sends the queued deliveries of all tenants. Deliveries are claimed with row locks, so it can
+// run in multiple engines at once.
+type Deliverer struct {
+	sc     *server.ServerConfig
+	client *http.Client
+}
+
+func NewDeliverer(sc *server.ServerConfig) *Deliverer {
+	return &Deliverer{
+		sc:     sc,
+		client: newDeliveryClient(),
+	}
+}
+
+func (d *Deliverer) Start() (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				// keep delivering while there are full batches, so a backlog is sent without waiting
+				for {
+					claimed, err := d.deliverBatch(ctx)
+
+					if err != nil {
+						d.sc.Logger.Err(err).Msg("could not deliver run webhooks")
+					}
+
+					if err != nil || claimed < deliveryBatchSize {
+						break
+					}
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() error {
+		cancel()
+		<-done
+
+		return nil
+	}, nil
+}
+
+func (d *Deliverer) deliverBatch(ctx context.Context) (int, error) {
+	deliveries, err := d.sc.EngineRepository.WebhookSubscription().ClaimWebhookDeliveries(ctx, deliveryBatchSize, deliveryClaimTimeout)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not claim webhook deliveries: %w", err)
+	}
+
+	g := new(errgroup.Group)
+	g.SetLimit(maxConcurrentDeliveries)
+
+	for _, delivery := range deliveries {
+		g.Go(func() error {
+			d.deliver(ctx, delivery)
+			return nil
+		})
+	}
+
+	_ = g.Wait() // nolint: errcheck
+
+	return len(deliveries), nil
+}
+
+func (d *Deliverer) deliver(ctx context.Context, delivery *dbsqlc.ClaimWebhookDeliveriesRow) {
+	deliveryId := sqlchelpers.UUIDToStr(delivery.ID)
+
+	opts := d.attempt(ctx, delivery)
+
+	// attempts which were interrupted by the engine stopping are not recorded, the delivery is claimed again
+	// after the claim timeout
+	if ctx.Err() != nil {
+		return
+	}
+
+	err := d.sc.EngineRepository.WebhookSubscription().UpdateWebhookDeliveryAttempt(ctx, deliveryId, opts)
+
+	if err != nil {
+		d.sc.Logger.Err(err).Msgf("could not update webhook delivery %s", deliveryId)
+	}
+}
+
+func (d *Deliverer) attempt(ctx context.Context, delivery *dbsqlc.ClaimWebhookDeliveriesRow) *repository.UpdateWebhookDeliveryAttemptOpts {
+	if !delivery.Enabled {
+		return &repository.UpdateWebhookDeliveryAttemptOpts{
+			Status: dbsqlc.WebhookDeliveryStatusFAILED,
+			Error:  repository.StringPtr("the webhook subscription is disabled"),
+		}
+	}
+
+	statusCode, err := d.send(ctx, delivery)
+
+	return attemptResult(delivery.Attempts+1, statusCode, err, time.Now())
+}
+
+// attemptResult returns the update of a delivery after the given attempt.
+func attemptResult(attempts int32, statusCode *int, err error, now time.Time) *repository.UpdateWebhookDeliveryAttemptOpts {
+	if err == nil {
+		return &repository.UpdateWebhookDeliveryAttemptOpts{
+			Status:             dbsqlc.WebhookDeliveryStatusSUCCEEDED,
+			ResponseStatusCode: statusCode,
+		}
+	}
+
+	errStr := err.Error()
+
+	if len(errStr) > maxErrorLength {
+		errStr = errStr[:maxErrorLength]
+	}
+
+	opts := &repository.UpdateWebhookDeliveryAttemptOpts{
+		Status:             dbsqlc.WebhookDeliveryStatusFAILED,
+		ResponseStatusCode: statusCode,
+		Error:              &errStr,
+	}
+
+	if attempts < MaxDeliveryAttempts {
+		nextAttemptAt := now.Add(retryBackoff(attempts))
+
+		opts.Status = dbsqlc.WebhookDeliveryStatusPENDING
+		opts.NextAttemptAt = &nextAttemptAt
+	}
+
+	return opts
+}
+
+func (d *Deliverer) send(ctx context.Context, delivery *dbsqlc.ClaimWebhookDeliveriesRow) (*int, error) {
+	tenantId := sqlchelpers.UUIDToStr(delivery.TenantId)
+	deliveryId := sqlchelpers.UUIDToStr(delivery.ID)
+
+	enc, err := d.sc.Encryption.ForTenant(tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get encryption service for tenant: %w", err)
+	}
+
+	secret, err := enc.DecryptString(delivery.Secret, tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt webhook secret: %w", err)
+	}
+
+	body, err := json.Marshal(&Event{
+		Id:        deliveryId,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.CreatedAt.Time.UTC(),
+		TenantId:  tenantId,
+		Data:      delivery.Payload,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal webhook event: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+
+	sig, err := Sign(secret, timestamp, body)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not sign webhook event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookId, deliveryId)
+	req.Header.Set(HeaderWebhookEvent, delivery.EventType)
+	req.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderWebhookSignature, sig)
+
+	return d.do(req)
+}
+
+// do sends the request and returns the status code of the response. The body of the response is never read,
+// because the errors of attempts are stored and shown to the users of the tenant.
+func (d *Deliverer) do(req *http.Request) (*int, error) {
+	// nolint:gosec
+	resp, err := d.client.Do(req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	statusCode := resp.StatusCode
+
+	if statusCode < 200 || statusCode >= 300 {
+		return &statusCode, fmt.Errorf("request failed with status code %d", statusCode)
+	}
+
+	return &statusCode, nil
+}
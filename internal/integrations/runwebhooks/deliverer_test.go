@@ -0,0 +1,158 @@
+package runwebhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1","type":"run.failed"}`)
+
+	sig, err := Sign("secret", 1738577712, body)
+	require.NoError(t, err)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(`1738577712.{"id":"1","type":"run.failed"}`))
+
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), sig)
+
+	other, err := Sign("secret", 1738577713, body)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, sig, other, "the signature should depend on the timestamp")
+}
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, retryBackoff(1))
+	assert.Equal(t, time.Minute, retryBackoff(2))
+	assert.Equal(t, 4*time.Minute, retryBackoff(4))
+	assert.Equal(t, maxRetryBackoff, retryBackoff(8))
+	assert.Equal(t, maxRetryBackoff, retryBackoff(100))
+}
+
+func TestAttemptResult(t *testing.T) {
+	now := time.Date(2025, 2, 3, 10, 15, 12, 0, time.UTC)
+	ok := 200
+	unavailable := 503
+
+	t.Run("succeeded", func(t *testing.T) {
+		opts := attemptResult(1, &ok, nil, now)
+
+		assert.Equal(t, dbsqlc.WebhookDeliveryStatusSUCCEEDED, opts.Status)
+		assert.Equal(t, &ok, opts.ResponseStatusCode)
+		assert.Nil(t, opts.NextAttemptAt)
+		assert.Nil(t, opts.Error)
+	})
+
+	t.Run("retried", func(t *testing.T) {
+		opts := attemptResult(2, &unavailable, errors.New("request failed with status code 503"), now)
+
+		assert.Equal(t, dbsqlc.WebhookDeliveryStatusPENDING, opts.Status)
+		assert.Equal(t, &unavailable, opts.ResponseStatusCode)
+		require.NotNil(t, opts.NextAttemptAt)
+		assert.Equal(t, now.Add(time.Minute), *opts.NextAttemptAt)
+		require.NotNil(t, opts.Error)
+		assert.Equal(t, "request failed with status code 503", *opts.Error)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		opts := attemptResult(MaxDeliveryAttempts, nil, errors.New(strings.Repeat("e", 2*maxErrorLength)), now)
+
+		assert.Equal(t, dbsqlc.WebhookDeliveryStatusFAILED, opts.Status, "deliveries should fail after the last attempt")
+		assert.Nil(t, opts.NextAttemptAt)
+		require.NotNil(t, opts.Error)
+		assert.Len(t, *opts.Error, maxErrorLength, "errors should be truncated")
+	})
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for _, addr := range []string{"93.184.215.14", "2606:2800:21f:cb07:6820:80da:af6b:8b2c"} {
+		assert.True(t, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+
+	for _, addr := range []string{
+		"127.0.0.1",
+		"10.0.0.1",
+		"172.16.0.1",
+		"192.168.1.1",
+		"169.254.169.254",
+		"100.100.100.200",
+		"0.0.0.0",
+		"224.0.0.1",
+		"::1",
+		"::",
+		"fd00::1",
+		"fe80::1",
+		"::ffff:127.0.0.1",
+		"64:ff9b::a9fe:a9fe",
+	} {
+		assert.False(t, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestDeliveryClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+	require.NoError(t, err)
+
+	_, err = (&Deliverer{client: newDeliveryClient()}).do(req)
+
+	assert.ErrorIs(t, err, errAddressNotAllowed, "deliveries to the loopback address should be refused")
+}
+
+func TestDeliveryResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redirect":
+			http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+		case "/internal":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("internal secret")) // nolint: errcheck
+		}
+	}))
+	defer srv.Close()
+
+	// the test server listens on the loopback address, so the address check is left out
+	client := srv.Client()
+	client.CheckRedirect = checkRedirect
+
+	d := &Deliverer{client: client}
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/fail", nil)
+	require.NoError(t, err)
+
+	statusCode, err := d.do(req)
+
+	require.NotNil(t, statusCode)
+	assert.Equal(t, http.StatusInternalServerError, *statusCode)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "internal secret", "the body of the response should not be stored")
+
+	req, err = http.NewRequest(http.MethodPost, srv.URL+"/redirect", nil)
+	require.NoError(t, err)
+
+	statusCode, err = d.do(req)
+
+	require.NotNil(t, statusCode)
+	assert.Equal(t, http.StatusTemporaryRedirect, *statusCode, "redirects should not be followed")
+	assert.Error(t, err)
+}
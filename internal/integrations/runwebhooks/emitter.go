@@ -0,0 +1,237 @@
+// Package runwebhooks delivers run lifecycle and worker events to the webhook subscriptions of tenants.
+//
+// The controllers queue a delivery for each subscription to an event with the Emitter, and the Deliverer
+// sends the queued deliveries, retrying failed deliveries with an exponential backoff.
+package runwebhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// RunEventData is the data of run.started, run.succeeded and run.failed events.
+type RunEventData struct {
+	WorkflowRunId          string          `json:"workflowRunId"`
+	WorkflowRunDisplayName string          `json:"workflowRunDisplayName,omitempty"`
+	WorkflowId             string          `json:"workflowId"`
+	WorkflowName           string          `json:"workflowName"`
+	WorkflowVersionId      string          `json:"workflowVersionId"`
+	Status                 string          `json:"status"`
+	StartedAt              *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt             *time.Time      `json:"finishedAt,omitempty"`
+	Error                  string          `json:"error,omitempty"`
+	AdditionalMetadata     json.RawMessage `json:"additionalMetadata,omitempty"`
+}
+
+// StepEventData is the data of step.failed events.
+type StepEventData struct {
+	WorkflowRunId  string    `json:"workflowRunId"`
+	StepRunId      string    `json:"stepRunId"`
+	StepId         string    `json:"stepId"`
+	StepReadableId string    `json:"stepReadableId"`
+	ActionId       string    `json:"actionId"`
+	JobName        string    `json:"jobName"`
+	RetryCount     int32     `json:"retryCount"`
+	Error          string    `json:"error"`
+	FailedAt       time.Time `json:"failedAt"`
+}
+
+// WorkerEventData is the data of worker.down events.
+type WorkerEventData struct {
+	WorkerId        string     `json:"workerId"`
+	WorkerName      string     `json:"workerName"`
+	LastHeartbeatAt *time.Time `json:"lastHeartbeatAt,omitempty"`
+	DownAt          time.Time  `json:"downAt"`
+}
+
+// Emitter queues deliveries of run lifecycle events. Tenants without a subscription to an event type are
+// skipped without loading the data of the event.
+type Emitter struct {
+	repo repository.EngineRepository
+	l    *zerolog.Logger
+}
+
+func NewEmitter(repo repository.EngineRepository, l *zerolog.Logger) *Emitter {
+	return &Emitter{
+		repo: repo,
+		l:    l,
+	}
+}
+
+// RunStarted queues run.started events. It's called whenever a step run of the workflow run starts, and
+// the event is only delivered for the first step run.
+func (e *Emitter) RunStarted(ctx context.Context, tenantId, workflowRunId string) error {
+	subscribed, err := e.repo.WebhookSubscription().HasWebhookSubscriptions(ctx, tenantId, repository.WebhookEventRunStarted)
+
+	if err != nil || !subscribed {
+		return err
+	}
+
+	workflowRun, err := e.repo.WorkflowRun().GetWorkflowRunById(ctx, tenantId, workflowRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	return e.emit(
+		ctx,
+		tenantId,
+		repository.WebhookEventRunStarted,
+		fmt.Sprintf("%s:%s", repository.WebhookEventRunStarted, workflowRunId),
+		toRunEventData(workflowRun),
+	)
+}
+
+// RunFinished queues run.succeeded events for succeeded workflow runs, and run.failed events for failed
+// workflow runs if isFailed is set. Failed runs which were superseded by a newer run are not failures, so
+// the caller decides.
+func (e *Emitter) RunFinished(ctx context.Context, tenantId string, workflowRun *dbsqlc.GetWorkflowRunRow, isFailed bool) error {
+	var eventType string
+
+	switch {
+	case workflowRun.WorkflowRun.Status == dbsqlc.WorkflowRunStatusSUCCEEDED:
+		eventType = repository.WebhookEventRunSucceeded
+	case workflowRun.WorkflowRun.Status == dbsqlc.WorkflowRunStatusFAILED && isFailed:
+		eventType = repository.WebhookEventRunFailed
+	default:
+		return nil
+	}
+
+	subscribed, err := e.repo.WebhookSubscription().HasWebhookSubscriptions(ctx, tenantId, eventType)
+
+	if err != nil || !subscribed {
+		return err
+	}
+
+	// replayed workflow runs finish again, so the finish time is part of the key
+	return e.emit(
+		ctx,
+		tenantId,
+		eventType,
+		fmt.Sprintf("%s:%s:%d", eventType, sqlchelpers.UUIDToStr(workflowRun.WorkflowRun.ID), workflowRun.WorkflowRun.FinishedAt.Time.UnixMilli()),
+		toRunEventData(workflowRun),
+	)
+}
+
+// StepFailed queues step.failed events for step runs which failed and won't be retried.
+func (e *Emitter) StepFailed(ctx context.Context, tenantId string, stepRun *dbsqlc.GetStepRunForEngineRow, errorReason string, failedAt time.Time) error {
+	subscribed, err := e.repo.WebhookSubscription().HasWebhookSubscriptions(ctx, tenantId, repository.WebhookEventStepFailed)
+
+	if err != nil || !subscribed {
+		return err
+	}
+
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+
+	return e.emit(
+		ctx,
+		tenantId,
+		repository.WebhookEventStepFailed,
+		fmt.Sprintf("%s:%s:%d", repository.WebhookEventStepFailed, stepRunId, stepRun.SRRetryCount),
+		&StepEventData{
+			WorkflowRunId:  sqlchelpers.UUIDToStr(stepRun.WorkflowRunId),
+			StepRunId:      stepRunId,
+			StepId:         sqlchelpers.UUIDToStr(stepRun.StepId),
+			StepReadableId: stepRun.StepReadableId.String,
+			ActionId:       stepRun.ActionId,
+			JobName:        stepRun.JobName,
+			RetryCount:     stepRun.SRRetryCount,
+			Error:          errorReason,
+			FailedAt:       failedAt.UTC(),
+		},
+	)
+}
+
+// WorkerDown queues worker.down events for workers which were marked as inactive after they stopped sending
+// heartbeats.
+func (e *Emitter) WorkerDown(ctx context.Context, tenantId string, worker *dbsqlc.Worker, downAt time.Time) error {
+	subscribed, err := e.repo.WebhookSubscription().HasWebhookSubscriptions(ctx, tenantId, repository.WebhookEventWorkerDown)
+
+	if err != nil || !subscribed {
+		return err
+	}
+
+	workerId := sqlchelpers.UUIDToStr(worker.ID)
+
+	data := &WorkerEventData{
+		WorkerId:   workerId,
+		WorkerName: worker.Name,
+		DownAt:     downAt.UTC(),
+	}
+
+	if worker.LastHeartbeatAt.Valid {
+		lastHeartbeatAt := worker.LastHeartbeatAt.Time.UTC()
+		data.LastHeartbeatAt = &lastHeartbeatAt
+	}
+
+	// workers which reconnect can go down again, so the last heartbeat is part of the key
+	return e.emit(
+		ctx,
+		tenantId,
+		repository.WebhookEventWorkerDown,
+		fmt.Sprintf("%s:%s:%d", repository.WebhookEventWorkerDown, workerId, worker.LastHeartbeatAt.Time.UnixMilli()),
+		data,
+	)
+}
+
+func (e *Emitter) emit(ctx context.Context, tenantId, eventType, dedupeKey string, data any) error {
+	payload, err := json.Marshal(data)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal %s event: %w", eventType, err)
+	}
+
+	created, err := e.repo.WebhookSubscription().CreateWebhookDeliveries(ctx, tenantId, &repository.CreateWebhookDeliveriesOpts{
+		EventType: eventType,
+		DedupeKey: dedupeKey,
+		Payload:   payload,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if created > 0 {
+		e.l.Debug().Msgf("queued %d webhook deliveries of %s event for tenant %s", created, eventType, tenantId)
+	}
+
+	return nil
+}
+
+func toRunEventData(workflowRun *dbsqlc.GetWorkflowRunRow) *RunEventData {
+	run := workflowRun.WorkflowRun
+
+	data := &RunEventData{
+		WorkflowRunId:          sqlchelpers.UUIDToStr(run.ID),
+		WorkflowRunDisplayName: run.DisplayName.String,
+		WorkflowId:             sqlchelpers.UUIDToStr(workflowRun.WorkflowVersion.WorkflowId),
+		WorkflowName:           workflowRun.WorkflowName.String,
+		WorkflowVersionId:      sqlchelpers.UUIDToStr(run.WorkflowVersionId),
+		Status:                 string(run.Status),
+		Error:                  run.Error.String,
+	}
+
+	if run.StartedAt.Valid {
+		startedAt := run.StartedAt.Time.UTC()
+		data.StartedAt = &startedAt
+	}
+
+	if run.FinishedAt.Valid {
+		finishedAt := run.FinishedAt.Time.UTC()
+		data.FinishedAt = &finishedAt
+	}
+
+	if json.Valid(run.AdditionalMetadata) {
+		data.AdditionalMetadata = run.AdditionalMetadata
+	}
+
+	return data
+}
@@ -0,0 +1,481 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+)
+
+// RETRY_INTERVAL is the delay before a message which failed in the pre-ack hook is redelivered, which matches
+// the time rejected messages spend in the dead letter queues of the RabbitMQ implementation.
+const RETRY_INTERVAL = 5 * time.Second
+
+// MessageQueueImpl implements the MessageQueue interface using NATS.
+//
+// Durable queues are stored in a JetStream work queue stream. Each durable queue has a single durable
+// consumer which all engine replicas share, so every message is processed by one replica. Other queues,
+// which belong to a single engine instance, and the tenant pub/sub queues use core NATS subjects, so their
+// messages are dropped if nobody is subscribed, like the auto-deleted queues of the RabbitMQ implementation.
+type MessageQueueImpl struct {
+	nc       *natsgo.Conn
+	js       jetstream.JetStream
+	configFs []MessageQueueImplOpt
+
+	stream string
+	qos    int
+
+	l *zerolog.Logger
+
+	disableTenantPubs bool
+}
+
+type MessageQueueImplOpt func(*MessageQueueImplOpts)
+
+type MessageQueueImplOpts struct {
+	l                 *zerolog.Logger
+	url               string
+	qos               int
+	stream            string
+	streamReplicas    int
+	disableTenantPubs bool
+}
+
+func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
+	l := logger.NewDefaultLogger("nats")
+
+	return &MessageQueueImplOpts{
+		l:              &l,
+		url:            natsgo.DefaultURL,
+		qos:            100,
+		stream:         "hatchet",
+		streamReplicas: 1,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.l = l
+	}
+}
+
+func WithURL(url string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.url = url
+	}
+}
+
+func WithQos(qos int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.qos = qos
+	}
+}
+
+// WithStream sets the name of the JetStream stream, which is also the prefix of all subjects. Engines which
+// share a NATS cluster but not a database need different streams.
+func WithStream(stream string, replicas int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.stream = stream
+		opts.streamReplicas = replicas
+	}
+}
+
+func WithDisableTenantPubs(disable bool) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.disableTenantPubs = disable
+	}
+}
+
+// New connects to NATS and creates the stream of the durable queues if it doesn't exist.
+func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl, error) {
+	opts := defaultMessageQueueImplOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	l := opts.l
+
+	nc, err := natsgo.Connect(
+		opts.url,
+		natsgo.MaxReconnects(-1),
+		natsgo.DisconnectErrHandler(func(_ *natsgo.Conn, err error) {
+			if err != nil {
+				l.Error().Err(err).Msg("disconnected from nats")
+			}
+		}),
+		natsgo.ReconnectHandler(func(nc *natsgo.Conn) {
+			l.Info().Msgf("reconnected to nats at %s", nc.ConnectedUrl())
+		}),
+	)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+
+	if err != nil {
+		nc.Close()
+		return nil, nil, fmt.Errorf("could not create jetstream context: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      opts.stream,
+		Subjects:  []string{fmt.Sprintf("%s.durable.>", opts.stream)},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+		Replicas:  opts.streamReplicas,
+	})
+
+	if err != nil {
+		nc.Close()
+		return nil, nil, fmt.Errorf("could not create stream %s: %w", opts.stream, err)
+	}
+
+	t := &MessageQueueImpl{
+		nc:                nc,
+		js:                js,
+		configFs:          fs,
+		stream:            opts.stream,
+		qos:               opts.qos,
+		l:                 opts.l,
+		disableTenantPubs: opts.disableTenantPubs,
+	}
+
+	cleanup := func() error {
+		return nc.Drain()
+	}
+
+	return cleanup, t, nil
+}
+
+// Clone creates a message queue with a new connection. If the connection fails, the message queue is shared
+// instead.
+func (t *MessageQueueImpl) Clone() (func() error, msgqueue.MessageQueue) {
+	cleanup, mq, err := New(t.configFs...)
+
+	if err != nil {
+		t.l.Error().Err(err).Msg("could not clone message queue, sharing the connection instead")
+
+		return func() error { return nil }, t
+	}
+
+	return cleanup, mq
+}
+
+func (t *MessageQueueImpl) SetQOS(prefetchCount int) {
+	t.qos = prefetchCount
+}
+
+func (t *MessageQueueImpl) IsReady() bool {
+	return t.nc.IsConnected()
+}
+
+// RegisterTenant is a no-op, as tenant subjects don't need to be declared.
+func (t *MessageQueueImpl) RegisterTenant(ctx context.Context, tenantId string) error {
+	return nil
+}
+
+// AddMessage adds a message to the queue, and publishes it to the tenant's subscribers if it belongs to a
+// tenant. Messages to durable queues are only acknowledged once the stream stored them.
+func (t *MessageQueueImpl) AddMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "add-message")
+	defer span.End()
+
+	// inject otel carrier into the message
+	if msg.OtelCarrier == nil {
+		msg.OtelCarrier = telemetry.GetCarrier(ctx)
+	}
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		return fmt.Errorf("error marshaling message: %w", err)
+	}
+
+	t.l.Debug().Msgf("publishing msg %s to queue %s", msg.ID, q.Name())
+
+	if q.Durable() {
+		// durable queues don't drop messages without consumers, so messages which should immediately expire
+		// are delivered like any other message
+		if _, err := t.js.Publish(ctx, t.durableSubject(q), body); err != nil {
+			return fmt.Errorf("error publishing msg %s to queue %s: %w", msg.ID, q.Name(), err)
+		}
+	} else if err := t.nc.Publish(t.queueSubject(q), body); err != nil {
+		return fmt.Errorf("error publishing msg %s to queue %s: %w", msg.ID, q.Name(), err)
+	}
+
+	if !t.disableTenantPubs && msg.TenantID() != "" {
+		t.l.Debug().Msgf("publishing tenant msg %s to tenant %s", msg.ID, msg.TenantID())
+
+		if err := t.nc.Publish(t.tenantSubject(msg.TenantID()), body); err != nil {
+			return fmt.Errorf("error publishing tenant msg %s: %w", msg.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe subscribes to the queue. Messages are processed concurrently, up to the QOS of the message queue
+// for durable queues.
+func (t *MessageQueueImpl) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	t.l.Debug().Msgf("subscribing to queue: %s", q.Name())
+
+	s := &subscriber{}
+
+	if q.Durable() && q.FanoutExchangeKey() == "" {
+		return t.subscribeDurable(s, q, preAck, postAck)
+	}
+
+	return t.subscribeCore(s, q, preAck, postAck)
+}
+
+func (t *MessageQueueImpl) subscribeDurable(
+	s *subscriber,
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cfg := jetstream.ConsumerConfig{
+		Durable:       q.Name(),
+		FilterSubject: t.durableSubject(q),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       30 * time.Second,
+	}
+
+	if t.qos > 0 {
+		cfg.MaxAckPending = t.qos
+	}
+
+	// all subscribers of the queue share the durable consumer, so each message is delivered to one of them
+	consumer, err := t.js.CreateOrUpdateConsumer(ctx, t.stream, cfg)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create consumer for queue %s: %w", q.Name(), err)
+	}
+
+	consumeOpts := []jetstream.PullConsumeOpt{
+		jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+			t.l.Error().Err(err).Msgf("error consuming from queue %s", q.Name())
+		}),
+	}
+
+	if t.qos > 0 {
+		consumeOpts = append(consumeOpts, jetstream.PullMaxMessages(t.qos))
+	}
+
+	consumeCtx, err := consumer.Consume(func(m jetstream.Msg) {
+		if !s.start() {
+			// the subscriber is shutting down, so the message is redelivered to another subscriber
+			if err := m.Nak(); err != nil {
+				t.l.Error().Err(err).Msg("error nacking message")
+			}
+
+			return
+		}
+
+		go func() {
+			defer s.done()
+			t.handleDurableMsg(q, m, preAck, postAck)
+		}()
+	}, consumeOpts...)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not consume from queue %s: %w", q.Name(), err)
+	}
+
+	cleanup := func() error {
+		consumeCtx.Stop()
+		s.stop()
+
+		t.l.Debug().Msgf("successfully shut down subscriber for queue: %s", q.Name())
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (t *MessageQueueImpl) handleDurableMsg(q msgqueue.Queue, m jetstream.Msg, preAck, postAck msgqueue.AckHook) {
+	msg := &msgqueue.Message{}
+
+	if err := json.Unmarshal(m.Data(), msg); err != nil {
+		t.l.Error().Err(err).Msgf("error unmarshalling message from queue %s", q.Name())
+
+		// the message can never be processed, so it's not redelivered
+		if err := m.Term(); err != nil {
+			t.l.Error().Err(err).Msg("error terminating message")
+		}
+
+		return
+	}
+
+	if err := preAck(msg); err != nil {
+		t.l.Error().Err(err).Msgf("error in pre-ack of message %s", msg.ID)
+
+		meta, metaErr := m.Metadata()
+
+		// a message is delivered at most Retries + 1 times
+		if metaErr == nil && meta.NumDelivered > uint64(msg.Retries) { // nolint: gosec
+			t.l.Debug().Msgf("message %s has been delivered %d times, not requeuing", msg.ID, meta.NumDelivered)
+
+			if err := m.Term(); err != nil {
+				t.l.Error().Err(err).Msg("error terminating message")
+			}
+
+			return
+		}
+
+		if err := m.NakWithDelay(RETRY_INTERVAL); err != nil {
+			t.l.Error().Err(err).Msg("error nacking message")
+		}
+
+		return
+	}
+
+	if err := m.Ack(); err != nil {
+		t.l.Error().Err(err).Msg("error acknowledging message")
+		return
+	}
+
+	if err := postAck(msg); err != nil {
+		t.l.Error().Err(err).Msgf("error in post-ack of message %s", msg.ID)
+	}
+}
+
+func (t *MessageQueueImpl) subscribeCore(
+	s *subscriber,
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	handler := func(m *natsgo.Msg) {
+		if !s.start() {
+			return
+		}
+
+		go func() {
+			defer s.done()
+			t.handleCoreMsg(q, m, preAck, postAck)
+		}()
+	}
+
+	var sub *natsgo.Subscription
+	var err error
+
+	switch {
+	case q.FanoutExchangeKey() != "":
+		// every subscriber of a tenant receives all of its messages
+		sub, err = t.nc.Subscribe(t.tenantSubject(q.FanoutExchangeKey()), handler)
+	case q.Exclusive():
+		sub, err = t.nc.Subscribe(t.queueSubject(q), handler)
+	default:
+		// subscribers of a shared queue form a queue group, so each message is delivered to one of them
+		sub, err = t.nc.QueueSubscribe(t.queueSubject(q), q.Name(), handler)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe to queue %s: %w", q.Name(), err)
+	}
+
+	cleanup := func() error {
+		if err := sub.Unsubscribe(); err != nil && err != natsgo.ErrConnectionClosed {
+			return fmt.Errorf("could not unsubscribe from queue %s: %w", q.Name(), err)
+		}
+
+		s.stop()
+
+		t.l.Debug().Msgf("successfully shut down subscriber for queue: %s", q.Name())
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// handleCoreMsg processes a message of a non-durable queue. Like in the auto-deleted queues of the RabbitMQ
+// implementation, messages which fail in the pre-ack hook are dropped.
+func (t *MessageQueueImpl) handleCoreMsg(q msgqueue.Queue, m *natsgo.Msg, preAck, postAck msgqueue.AckHook) {
+	msg := &msgqueue.Message{}
+
+	if err := json.Unmarshal(m.Data, msg); err != nil {
+		t.l.Error().Err(err).Msgf("error unmarshalling message from queue %s", q.Name())
+		return
+	}
+
+	if err := preAck(msg); err != nil {
+		t.l.Error().Err(err).Msgf("error in pre-ack of message %s", msg.ID)
+		return
+	}
+
+	if err := postAck(msg); err != nil {
+		t.l.Error().Err(err).Msgf("error in post-ack of message %s", msg.ID)
+	}
+}
+
+func (t *MessageQueueImpl) durableSubject(q msgqueue.Queue) string {
+	return fmt.Sprintf("%s.durable.%s", t.stream, q.Name())
+}
+
+func (t *MessageQueueImpl) queueSubject(q msgqueue.Queue) string {
+	return fmt.Sprintf("%s.queue.%s", t.stream, q.Name())
+}
+
+func (t *MessageQueueImpl) tenantSubject(tenantId string) string {
+	return fmt.Sprintf("%s.tenant.%s", t.stream, tenantId)
+}
+
+// subscriber tracks the messages which a subscription is processing, so the subscription can wait for them
+// when it shuts down.
+type subscriber struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	stopped bool
+}
+
+// start returns false if the subscriber was stopped, and otherwise tracks a new message.
+func (s *subscriber) start() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return false
+	}
+
+	s.wg.Add(1)
+
+	return true
+}
+
+func (s *subscriber) done() {
+	s.wg.Done()
+}
+
+// stop stops tracking new messages and waits for the messages which are being processed.
+func (s *subscriber) stop() {
+	s.mu.Lock()
+	s.stopped = true
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
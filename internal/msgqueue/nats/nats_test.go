@@ -0,0 +1,191 @@
+//go:build integration
+
+package nats_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	natsmq "github.com/hatchet-dev/hatchet/internal/msgqueue/nats"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+)
+
+const url = "nats://localhost:4222"
+
+func newMessageQueue(t *testing.T) *natsmq.MessageQueueImpl {
+	stream, _ := random.Generate(8) // nolint: errcheck
+
+	cleanup, tq, err := natsmq.New(
+		natsmq.WithURL(url),
+		natsmq.WithQos(100),
+		natsmq.WithStream("hatchet_test_"+stream, 1),
+	)
+	require.NoError(t, err, "creating the message queue should not error")
+
+	t.Cleanup(func() {
+		_ = cleanup() // nolint: errcheck
+	})
+
+	return tq
+}
+
+func newTask() *msgqueue.Message {
+	id, _ := random.Generate(8) // nolint: errcheck
+
+	return &msgqueue.Message{
+		ID:         id,
+		Payload:    map[string]interface{}{"key": "value"},
+		Metadata:   map[string]interface{}{"tenant_id": "test-tenant"},
+		Retries:    2,
+		RetryDelay: 5,
+	}
+}
+
+func TestMessageQueueIntegration(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tq := newMessageQueue(t)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2) // we wait for 2 messages here
+
+	task := newTask()
+
+	// messages to durable queues are stored until a subscriber consumes them
+	err := tq.AddMessage(ctx, msgqueue.EVENT_PROCESSING_QUEUE, task)
+	require.NoError(t, err, "adding task to static queue should not error")
+
+	cleanupQueue, err := tq.Subscribe(msgqueue.EVENT_PROCESSING_QUEUE, func(receivedMessage *msgqueue.Message) error {
+		defer wg.Done()
+		assert.Equal(t, task.ID, receivedMessage.ID, "received task ID should match sent task ID")
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to static queue should not error")
+
+	tenantQueue := msgqueue.TenantEventConsumerQueue("test-tenant")
+
+	cleanupTenantQueue, err := tq.Subscribe(tenantQueue, func(receivedMessage *msgqueue.Message) error {
+		defer wg.Done()
+		assert.Equal(t, task.ID, receivedMessage.ID, "received tenant task ID should match sent task ID")
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to tenant queue should not error")
+
+	err = tq.AddMessage(ctx, tenantQueue, task)
+	require.NoError(t, err, "adding task to tenant queue should not error")
+
+	wg.Wait()
+
+	require.NoError(t, cleanupQueue())
+	require.NoError(t, cleanupTenantQueue())
+}
+
+func TestSharedDurableConsumer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tq := newMessageQueue(t)
+
+	const numMessages = 50
+
+	var received atomic.Int64
+	wg := &sync.WaitGroup{}
+	wg.Add(numMessages)
+
+	preAck := func(receivedMessage *msgqueue.Message) error {
+		received.Add(1)
+		wg.Done()
+		return nil
+	}
+
+	// two subscribers of the same durable queue, like two engine replicas, share its messages
+	for i := 0; i < 2; i++ {
+		cleanupQueue, err := tq.Subscribe(msgqueue.JOB_PROCESSING_QUEUE, preAck, msgqueue.NoOpHook)
+		require.NoError(t, err, "subscribing to static queue should not error")
+
+		defer cleanupQueue() // nolint: errcheck
+	}
+
+	for i := 0; i < numMessages; i++ {
+		err := tq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, newTask())
+		require.NoError(t, err, "adding task to static queue should not error")
+	}
+
+	wg.Wait()
+
+	// give duplicate deliveries time to arrive
+	time.Sleep(500 * time.Millisecond)
+
+	assert.Equal(t, int64(numMessages), received.Load(), "each message should be delivered once")
+}
+
+func TestRetriesSuccess(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	tq := newMessageQueue(t)
+
+	var attempts atomic.Int64
+	done := make(chan struct{})
+
+	task := newTask()
+
+	err := tq.AddMessage(ctx, msgqueue.EVENT_PROCESSING_QUEUE, task)
+	require.NoError(t, err, "adding task to static queue should not error")
+
+	cleanupQueue, err := tq.Subscribe(msgqueue.EVENT_PROCESSING_QUEUE, func(receivedMessage *msgqueue.Message) error {
+		attempt := attempts.Add(1)
+
+		if attempt <= 2 {
+			return fmt.Errorf("intentional error on attempt %d", attempt)
+		}
+
+		close(done)
+		return nil
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to static queue should not error")
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("message was not processed after retries")
+	}
+
+	require.NoError(t, cleanupQueue())
+}
+
+func TestRetriesExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	tq := newMessageQueue(t)
+
+	var attempts atomic.Int64
+
+	task := newTask()
+
+	err := tq.AddMessage(ctx, msgqueue.EVENT_PROCESSING_QUEUE, task)
+	require.NoError(t, err, "adding task to static queue should not error")
+
+	cleanupQueue, err := tq.Subscribe(msgqueue.EVENT_PROCESSING_QUEUE, func(receivedMessage *msgqueue.Message) error {
+		attempt := attempts.Add(1)
+		return fmt.Errorf("intentional error on attempt %d", attempt)
+	}, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to static queue should not error")
+
+	<-ctx.Done()
+
+	require.NoError(t, cleanupQueue())
+
+	// the message is delivered once and retried twice
+	assert.Equal(t, int64(3), attempts.Load())
+}
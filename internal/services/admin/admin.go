@@ -3,8 +3,11 @@ package admin
 import (
 	"fmt"
 
+	"github.com/rs/zerolog"
+
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
@@ -20,6 +23,8 @@ type AdminServiceImpl struct {
 	repo         repository.EngineRepository
 	mq           msgqueue.MessageQueue
 	v            validator.Validator
+	l            *zerolog.Logger
+	version      string
 }
 
 type AdminServiceOpt func(*AdminServiceOpts)
@@ -29,13 +34,17 @@ type AdminServiceOpts struct {
 	repo         repository.EngineRepository
 	mq           msgqueue.MessageQueue
 	v            validator.Validator
+	l            *zerolog.Logger
+	version      string
 }
 
 func defaultAdminServiceOpts() *AdminServiceOpts {
 	v := validator.NewDefaultValidator()
+	logger := logger.NewDefaultLogger("admin")
 
 	return &AdminServiceOpts{
 		v: v,
+		l: &logger,
 	}
 }
 
@@ -63,6 +72,19 @@ func WithValidator(v validator.Validator) AdminServiceOpt {
 	}
 }
 
+func WithLogger(l *zerolog.Logger) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.l = l
+	}
+}
+
+// WithVersion sets the version of the engine, which is returned by the Health RPC.
+func WithVersion(version string) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.version = version
+	}
+}
+
 func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 	opts := defaultAdminServiceOpts()
 
@@ -83,5 +105,7 @@ func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 		entitlements: opts.entitlements,
 		mq:           opts.mq,
 		v:            opts.v,
+		l:            opts.l,
+		version:      opts.version,
 	}, nil
 }
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// audit records a change which was made through the gRPC API in the audit log of the tenant. Failing to
+// record it doesn't fail the request, as the change has already been made.
+func (a *AdminServiceImpl) audit(ctx context.Context, tenantId, action, resourceType string, resourceId *string, payload map[string]any) {
+	actor := repository.ActorFromContext(ctx)
+
+	if actor == nil {
+		return
+	}
+
+	opts := &repository.CreateAuditLogOpts{
+		Actor:        actor,
+		Action:       action,
+		ResourceType: &resourceType,
+		ResourceId:   resourceId,
+	}
+
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+
+		if err != nil {
+			a.l.Err(err).Msgf("could not marshal audit log payload of %s", action)
+			return
+		}
+
+		opts.Payload = payloadBytes
+	}
+
+	if _, err := a.repo.AuditLog().CreateAuditLog(context.WithoutCancel(ctx), tenantId, opts); err != nil {
+		a.l.Err(err).Msgf("could not record audit log of %s", action)
+	}
+}
@@ -26,7 +26,16 @@ type WorkflowServiceClient interface {
 	ScheduleWorkflow(ctx context.Context, in *ScheduleWorkflowRequest, opts ...grpc.CallOption) (*WorkflowVersion, error)
 	TriggerWorkflow(ctx context.Context, in *TriggerWorkflowRequest, opts ...grpc.CallOption) (*TriggerWorkflowResponse, error)
 	BulkTriggerWorkflow(ctx context.Context, in *BulkTriggerWorkflowRequest, opts ...grpc.CallOption) (*BulkTriggerWorkflowResponse, error)
+	RunStep(ctx context.Context, in *RunStepRequest, opts ...grpc.CallOption) (*TriggerWorkflowResponse, error)
 	PutRateLimit(ctx context.Context, in *PutRateLimitRequest, opts ...grpc.CallOption) (*PutRateLimitResponse, error)
+	ResetRateLimit(ctx context.Context, in *ResetRateLimitRequest, opts ...grpc.CallOption) (*ResetRateLimitResponse, error)
+	GetConcurrencyState(ctx context.Context, in *GetConcurrencyStateRequest, opts ...grpc.CallOption) (*GetConcurrencyStateResponse, error)
+	ReleaseConcurrencySlot(ctx context.Context, in *ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*ReleaseConcurrencySlotResponse, error)
+	ReplayWorkflowRun(ctx context.Context, in *ReplayWorkflowRunRequest, opts ...grpc.CallOption) (*ReplayWorkflowRunResponse, error)
+	GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*GetWorkflowResponse, error)
+	SetWorkflowPaused(ctx context.Context, in *SetWorkflowPausedRequest, opts ...grpc.CallOption) (*SetWorkflowPausedResponse, error)
+	SetTenantPaused(ctx context.Context, in *SetTenantPausedRequest, opts ...grpc.CallOption) (*SetTenantPausedResponse, error)
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
 type workflowServiceClient struct {
@@ -73,6 +82,15 @@ func (c *workflowServiceClient) BulkTriggerWorkflow(ctx context.Context, in *Bul
 	return out, nil
 }
 
+func (c *workflowServiceClient) RunStep(ctx context.Context, in *RunStepRequest, opts ...grpc.CallOption) (*TriggerWorkflowResponse, error) {
+	out := new(TriggerWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/RunStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) PutRateLimit(ctx context.Context, in *PutRateLimitRequest, opts ...grpc.CallOption) (*PutRateLimitResponse, error) {
 	out := new(PutRateLimitResponse)
 	err := c.cc.Invoke(ctx, "/WorkflowService/PutRateLimit", in, out, opts...)
@@ -82,6 +100,78 @@ func (c *workflowServiceClient) PutRateLimit(ctx context.Context, in *PutRateLim
 	return out, nil
 }
 
+func (c *workflowServiceClient) ResetRateLimit(ctx context.Context, in *ResetRateLimitRequest, opts ...grpc.CallOption) (*ResetRateLimitResponse, error) {
+	out := new(ResetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/ResetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) GetConcurrencyState(ctx context.Context, in *GetConcurrencyStateRequest, opts ...grpc.CallOption) (*GetConcurrencyStateResponse, error) {
+	out := new(GetConcurrencyStateResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/GetConcurrencyState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ReleaseConcurrencySlot(ctx context.Context, in *ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*ReleaseConcurrencySlotResponse, error) {
+	out := new(ReleaseConcurrencySlotResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/ReleaseConcurrencySlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ReplayWorkflowRun(ctx context.Context, in *ReplayWorkflowRunRequest, opts ...grpc.CallOption) (*ReplayWorkflowRunResponse, error) {
+	out := new(ReplayWorkflowRunResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/ReplayWorkflowRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*GetWorkflowResponse, error) {
+	out := new(GetWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/GetWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) SetWorkflowPaused(ctx context.Context, in *SetWorkflowPausedRequest, opts ...grpc.CallOption) (*SetWorkflowPausedResponse, error) {
+	out := new(SetWorkflowPausedResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/SetWorkflowPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) SetTenantPaused(ctx context.Context, in *SetTenantPausedRequest, opts ...grpc.CallOption) (*SetTenantPausedResponse, error) {
+	out := new(SetTenantPausedResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/SetTenantPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowServiceServer is the server API for WorkflowService service.
 // All implementations must embed UnimplementedWorkflowServiceServer
 // for forward compatibility
@@ -90,7 +180,16 @@ type WorkflowServiceServer interface {
 	ScheduleWorkflow(context.Context, *ScheduleWorkflowRequest) (*WorkflowVersion, error)
 	TriggerWorkflow(context.Context, *TriggerWorkflowRequest) (*TriggerWorkflowResponse, error)
 	BulkTriggerWorkflow(context.Context, *BulkTriggerWorkflowRequest) (*BulkTriggerWorkflowResponse, error)
+	RunStep(context.Context, *RunStepRequest) (*TriggerWorkflowResponse, error)
 	PutRateLimit(context.Context, *PutRateLimitRequest) (*PutRateLimitResponse, error)
+	ResetRateLimit(context.Context, *ResetRateLimitRequest) (*ResetRateLimitResponse, error)
+	GetConcurrencyState(context.Context, *GetConcurrencyStateRequest) (*GetConcurrencyStateResponse, error)
+	ReleaseConcurrencySlot(context.Context, *ReleaseConcurrencySlotRequest) (*ReleaseConcurrencySlotResponse, error)
+	ReplayWorkflowRun(context.Context, *ReplayWorkflowRunRequest) (*ReplayWorkflowRunResponse, error)
+	GetWorkflow(context.Context, *GetWorkflowRequest) (*GetWorkflowResponse, error)
+	SetWorkflowPaused(context.Context, *SetWorkflowPausedRequest) (*SetWorkflowPausedResponse, error)
+	SetTenantPaused(context.Context, *SetTenantPausedRequest) (*SetTenantPausedResponse, error)
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
 	mustEmbedUnimplementedWorkflowServiceServer()
 }
 
@@ -110,9 +209,36 @@ func (UnimplementedWorkflowServiceServer) TriggerWorkflow(context.Context, *Trig
 func (UnimplementedWorkflowServiceServer) BulkTriggerWorkflow(context.Context, *BulkTriggerWorkflowRequest) (*BulkTriggerWorkflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkTriggerWorkflow not implemented")
 }
+func (UnimplementedWorkflowServiceServer) RunStep(context.Context, *RunStepRequest) (*TriggerWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunStep not implemented")
+}
 func (UnimplementedWorkflowServiceServer) PutRateLimit(context.Context, *PutRateLimitRequest) (*PutRateLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutRateLimit not implemented")
 }
+func (UnimplementedWorkflowServiceServer) ResetRateLimit(context.Context, *ResetRateLimitRequest) (*ResetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetRateLimit not implemented")
+}
+func (UnimplementedWorkflowServiceServer) GetConcurrencyState(context.Context, *GetConcurrencyStateRequest) (*GetConcurrencyStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConcurrencyState not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ReleaseConcurrencySlot(context.Context, *ReleaseConcurrencySlotRequest) (*ReleaseConcurrencySlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseConcurrencySlot not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ReplayWorkflowRun(context.Context, *ReplayWorkflowRunRequest) (*ReplayWorkflowRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWorkflowRun not implemented")
+}
+func (UnimplementedWorkflowServiceServer) GetWorkflow(context.Context, *GetWorkflowRequest) (*GetWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
+}
+func (UnimplementedWorkflowServiceServer) SetWorkflowPaused(context.Context, *SetWorkflowPausedRequest) (*SetWorkflowPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflowPaused not implemented")
+}
+func (UnimplementedWorkflowServiceServer) SetTenantPaused(context.Context, *SetTenantPausedRequest) (*SetTenantPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTenantPaused not implemented")
+}
+func (UnimplementedWorkflowServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 func (UnimplementedWorkflowServiceServer) mustEmbedUnimplementedWorkflowServiceServer() {}
 
 // UnsafeWorkflowServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -198,6 +324,24 @@ func _WorkflowService_BulkTriggerWorkflow_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_RunStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RunStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/RunStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RunStep(ctx, req.(*RunStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_PutRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutRateLimitRequest)
 	if err := dec(in); err != nil {
@@ -216,6 +360,150 @@ func _WorkflowService_PutRateLimit_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_ResetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/ResetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResetRateLimit(ctx, req.(*ResetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_GetConcurrencyState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConcurrencyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetConcurrencyState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/GetConcurrencyState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetConcurrencyState(ctx, req.(*GetConcurrencyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ReleaseConcurrencySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseConcurrencySlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ReleaseConcurrencySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/ReleaseConcurrencySlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ReleaseConcurrencySlot(ctx, req.(*ReleaseConcurrencySlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ReplayWorkflowRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWorkflowRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ReplayWorkflowRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/ReplayWorkflowRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ReplayWorkflowRun(ctx, req.(*ReplayWorkflowRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/GetWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflow(ctx, req.(*GetWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_SetWorkflowPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkflowPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SetWorkflowPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/SetWorkflowPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SetWorkflowPaused(ctx, req.(*SetWorkflowPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_SetTenantPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTenantPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SetTenantPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/SetTenantPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SetTenantPaused(ctx, req.(*SetTenantPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkflowService_ServiceDesc is the grpc.ServiceDesc for WorkflowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,10 +527,46 @@ var WorkflowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkTriggerWorkflow",
 			Handler:    _WorkflowService_BulkTriggerWorkflow_Handler,
 		},
+		{
+			MethodName: "RunStep",
+			Handler:    _WorkflowService_RunStep_Handler,
+		},
 		{
 			MethodName: "PutRateLimit",
 			Handler:    _WorkflowService_PutRateLimit_Handler,
 		},
+		{
+			MethodName: "ResetRateLimit",
+			Handler:    _WorkflowService_ResetRateLimit_Handler,
+		},
+		{
+			MethodName: "GetConcurrencyState",
+			Handler:    _WorkflowService_GetConcurrencyState_Handler,
+		},
+		{
+			MethodName: "ReleaseConcurrencySlot",
+			Handler:    _WorkflowService_ReleaseConcurrencySlot_Handler,
+		},
+		{
+			MethodName: "ReplayWorkflowRun",
+			Handler:    _WorkflowService_ReplayWorkflowRun_Handler,
+		},
+		{
+			MethodName: "GetWorkflow",
+			Handler:    _WorkflowService_GetWorkflow_Handler,
+		},
+		{
+			MethodName: "SetWorkflowPaused",
+			Handler:    _WorkflowService_SetWorkflowPaused_Handler,
+		},
+		{
+			MethodName: "SetTenantPaused",
+			Handler:    _WorkflowService_SetTenantPaused_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _WorkflowService_Health_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workflows.proto",
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,6 +53,23 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 
 	workflowRun, err := a.repo.WorkflowRun().CreateNewWorkflowRun(createContext, tenantId, createOpt)
 
+	idempotencyKeyTarget := repository.ErrIdempotencyKeyExists{}
+
+	// a duplicate trigger request returns the workflow run of the original request
+	if errors.As(err, &idempotencyKeyTarget) {
+		if idempotencyKeyTarget.OtherWorkflow {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				"idempotency key %s was already used for a different workflow",
+				idempotencyKeyTarget.Key,
+			)
+		}
+
+		return &contracts.TriggerWorkflowResponse{
+			WorkflowRunId: idempotencyKeyTarget.WorkflowRunId,
+		}, nil
+	}
+
 	dedupeTarget := repository.ErrDedupeValueExists{}
 
 	if errors.As(err, &dedupeTarget) {
@@ -61,7 +80,7 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 	}
 
 	if err == metered.ErrResourceExhausted {
-		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: workflow run limit exceeded for tenant")
+		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: workflow run or queued run limit exceeded for tenant")
 	}
 
 	if err != nil {
@@ -103,6 +122,12 @@ func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contrac
 		return nil, status.Error(codes.InvalidArgument, "maximum of 1000 workflows can be triggered at once")
 	}
 
+	for _, w := range req.Workflows {
+		if w.IdempotencyKey != nil {
+			return nil, status.Error(codes.InvalidArgument, "idempotency keys are not supported when triggering workflows in bulk")
+		}
+	}
+
 	opts, existingWorkflows, err := getOpts(ctx, req.Workflows, a)
 	if err != nil {
 		return nil, err
@@ -119,7 +144,7 @@ func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contrac
 	workflowRuns, err := a.repo.WorkflowRun().CreateNewWorkflowRuns(createContext, tenantId, opts)
 
 	if err == metered.ErrResourceExhausted {
-		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: workflow run limit exceeded for tenant")
+		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: workflow run or queued run limit exceeded for tenant")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("could not create workflow runs: %w", err)
@@ -154,10 +179,131 @@ func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contrac
 
 }
 
+// RunStep triggers a partial workflow run, which only runs a single step of the latest version of the
+// workflow. The step runs with the given parent outputs, and the rest of the workflow's DAG is skipped.
+func (a *AdminServiceImpl) RunStep(ctx context.Context, req *contracts.RunStepRequest) (*contracts.TriggerWorkflowResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if req.Step == "" {
+		return nil, status.Error(codes.InvalidArgument, "step is required")
+	}
+
+	if err := checkWorkflowNamespace(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	workflow, err := a.repo.Workflow().GetWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "workflow %s not found", req.Name)
+		}
+
+		return nil, fmt.Errorf("could not get workflow by name: %w", err)
+	}
+
+	workflowVersion, err := a.repo.Workflow().GetLatestWorkflowVersion(ctx, tenantId, sqlchelpers.UUIDToStr(workflow.ID))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest workflow version: %w", err)
+	}
+
+	step, err := a.repo.Workflow().GetStepForWorkflowVersion(ctx, tenantId, sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID), req.Step)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "step %s not found in workflow %s", req.Step, req.Name)
+		}
+
+		return nil, fmt.Errorf("could not get step: %w", err)
+	}
+
+	var parentOutputs []byte
+
+	if req.Parents != nil {
+		// step outputs are always objects, so we validate the shape of the parent outputs here
+		parents := map[string]map[string]interface{}{}
+
+		if err := json.Unmarshal([]byte(*req.Parents), &parents); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parents must be a JSON object of step outputs: %v", err)
+		}
+
+		parentOutputs = []byte(*req.Parents)
+	}
+
+	var additionalMetadata map[string]interface{}
+
+	if req.AdditionalMetadata != nil {
+		if err := json.Unmarshal([]byte(*req.AdditionalMetadata), &additionalMetadata); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not unmarshal additional metadata: %v", err)
+		}
+	}
+
+	var input []byte
+
+	if req.Input != "" {
+		input = []byte(req.Input)
+	}
+
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(
+		workflowVersion,
+		input,
+		additionalMetadata,
+		repository.WithPartialRun(step, parentOutputs),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create workflow run opts: %w", err)
+	}
+
+	createOpts.TriggeringActor = repository.ActorFromContext(ctx)
+
+	createContext, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	workflowRun, err := a.repo.WorkflowRun().CreateNewWorkflowRun(createContext, tenantId, createOpts)
+
+	if err == metered.ErrResourceExhausted {
+		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: workflow run or queued run limit exceeded for tenant")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Run Step - could not create workflow run: %w", err)
+	}
+
+	workflowRunId := sqlchelpers.UUIDToStr(workflowRun.ID)
+
+	err = a.mq.AddMessage(
+		context.Background(),
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunQueuedToTask(tenantId, workflowRunId),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not queue workflow run: %w", err)
+	}
+
+	return &contracts.TriggerWorkflowResponse{
+		WorkflowRunId: workflowRunId,
+	}, nil
+}
+
 func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWorkflowRequest) (*contracts.WorkflowVersion, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
+	if err := checkWorkflowNamespace(ctx, req.Opts.Name); err != nil {
+		return nil, err
+	}
+
+	// a namespaced worker can't be triggered by the events of other namespaces
+	for _, eventKey := range req.Opts.EventTriggers {
+		if err := repository.ActorFromContext(ctx).CheckNamespace(eventKey); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied: event trigger %s", err)
+		}
+	}
+
 	createOpts, err := getCreateWorkflowOpts(req)
 
 	if err != nil {
@@ -249,6 +395,16 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 		}
 	}
 
+	// registering an unchanged workflow is a no-op, which isn't audited
+	if workflowVersion != oldWorkflowVersion {
+		workflowId := sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.WorkflowId)
+
+		a.audit(ctx, tenantId, "PutWorkflow", "workflow", &workflowId, map[string]any{
+			"name":    req.Opts.Name,
+			"version": workflowVersion.WorkflowVersion.Version.String,
+		})
+	}
+
 	resp := toWorkflowVersion(workflowVersion, nil)
 
 	return resp, nil
@@ -258,6 +414,10 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
+	if err := checkWorkflowNamespace(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
 	workflow, err := a.repo.Workflow().GetWorkflowByName(
 		ctx,
 		tenantId,
@@ -356,6 +516,10 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 		return nil, err
 	}
 
+	a.audit(ctx, tenantId, "ScheduleWorkflow", "workflow", &workflowId, map[string]any{
+		"schedules": dbSchedules,
+	})
+
 	resp := toWorkflowVersion(currWorkflow, scheduledRef)
 
 	return resp, nil
@@ -386,9 +550,473 @@ func (a *AdminServiceImpl) PutRateLimit(ctx context.Context, req *contracts.PutR
 		return nil, err
 	}
 
+	a.audit(ctx, tenantId, "PutRateLimit", "rate-limit", nil, map[string]any{
+		"key":      req.Key,
+		"limit":    limit,
+		"duration": duration,
+	})
+
 	return &contracts.PutRateLimitResponse{}, nil
 }
 
+// ResetRateLimit refills a rate limit to its limit. The schedulers pick up the reset value when they
+// next update the rate limit.
+func (a *AdminServiceImpl) ResetRateLimit(ctx context.Context, req *contracts.ResetRateLimitRequest) (*contracts.ResetRateLimitResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	_, err := a.repo.RateLimit().ResetRateLimit(ctx, tenantId, req.Key)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "rate limit %s not found", req.Key)
+		}
+
+		return nil, err
+	}
+
+	a.audit(ctx, tenantId, "ResetRateLimit", "rate-limit", nil, map[string]any{
+		"key": req.Key,
+	})
+
+	return &contracts.ResetRateLimitResponse{}, nil
+}
+
+// GetConcurrencyState returns the concurrency keys of a workflow which have running or queued workflow
+// runs, along with the running workflow runs which hold the slots of each key.
+func (a *AdminServiceImpl) GetConcurrencyState(ctx context.Context, req *contracts.GetConcurrencyStateRequest) (*contracts.GetConcurrencyStateResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	workflow, err := a.getWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowId := sqlchelpers.UUIDToStr(workflow.ID)
+
+	workflowVersion, err := a.repo.Workflow().GetLatestWorkflowVersion(ctx, tenantId, workflowId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest workflow version: %w", err)
+	}
+
+	keys, err := a.repo.WorkflowRun().ListConcurrencyKeys(ctx, tenantId, workflowId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list concurrency keys: %w", err)
+	}
+
+	holders, err := a.repo.WorkflowRun().ListConcurrencySlotHolders(ctx, tenantId, workflowId, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list concurrency slot holders: %w", err)
+	}
+
+	holdersByKey := make(map[string][]*contracts.ConcurrencySlotHolder)
+
+	for _, holder := range holders {
+		h := &contracts.ConcurrencySlotHolder{
+			WorkflowRunId: sqlchelpers.UUIDToStr(holder.ID),
+		}
+
+		if holder.StartedAt.Valid {
+			h.StartedAt = timestamppb.New(holder.StartedAt.Time)
+		}
+
+		holdersByKey[holder.Key] = append(holdersByKey[holder.Key], h)
+	}
+
+	res := &contracts.GetConcurrencyStateResponse{
+		Keys: make([]*contracts.ConcurrencyKeyState, 0, len(keys)),
+	}
+
+	if workflowVersion.ConcurrencyMaxRuns.Valid {
+		res.MaxRuns = workflowVersion.ConcurrencyMaxRuns.Int32
+	}
+
+	if workflowVersion.ConcurrencyLimitStrategy.Valid {
+		res.LimitStrategy = string(workflowVersion.ConcurrencyLimitStrategy.ConcurrencyLimitStrategy)
+	}
+
+	for _, key := range keys {
+		res.Keys = append(res.Keys, &contracts.ConcurrencyKeyState{
+			Key:     key.Key,
+			Holders: holdersByKey[key.Key],
+			Queued:  int32(key.Queued), // nolint: gosec
+		})
+	}
+
+	return res, nil
+}
+
+// ReleaseConcurrencySlot releases the concurrency slots of a key by cancelling the workflow runs which
+// hold them. Since a cancelled run may still be executing when the next run of the key starts, the
+// release must be confirmed, and the requested workflow runs must hold a slot of the key.
+func (a *AdminServiceImpl) ReleaseConcurrencySlot(ctx context.Context, req *contracts.ReleaseConcurrencySlotRequest) (*contracts.ReleaseConcurrencySlotResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	workflow, err := a.getWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	holders, err := a.repo.WorkflowRun().ListConcurrencySlotHolders(ctx, tenantId, sqlchelpers.UUIDToStr(workflow.ID), &req.Key)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list concurrency slot holders: %w", err)
+	}
+
+	holderIds := make([]string, 0, len(holders))
+
+	for _, holder := range holders {
+		holderIds = append(holderIds, sqlchelpers.UUIDToStr(holder.ID))
+	}
+
+	releaseIds := holderIds
+
+	if len(req.WorkflowRunIds) > 0 {
+		for _, id := range req.WorkflowRunIds {
+			if !slices.Contains(holderIds, id) {
+				return nil, status.Errorf(codes.FailedPrecondition, "workflow run %s does not hold a slot of key %s", id, req.Key)
+			}
+		}
+
+		releaseIds = req.WorkflowRunIds
+	}
+
+	if len(releaseIds) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no workflow runs hold a slot of key %s", req.Key)
+	}
+
+	if !req.Confirm {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"releasing the slots of key %s cancels workflow runs %s, which may run at the same time as the next runs of the key if they are still executing. confirm the release to cancel them",
+			req.Key,
+			strings.Join(releaseIds, ", "),
+		)
+	}
+
+	reason := "CANCELLED_BY_USER"
+
+	for _, workflowRunId := range releaseIds {
+		jobRuns, err := a.repo.JobRun().ListJobRunsForWorkflowRun(ctx, tenantId, workflowRunId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list job runs for workflow run %s: %w", workflowRunId, err)
+		}
+
+		for _, jobRun := range jobRuns {
+			err = a.mq.AddMessage(
+				ctx,
+				msgqueue.JOB_PROCESSING_QUEUE,
+				tasktypes.JobRunCancelledToTask(tenantId, sqlchelpers.UUIDToStr(jobRun.ID), &reason),
+			)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not send cancel task for job run %s: %w", sqlchelpers.UUIDToStr(jobRun.ID), err)
+			}
+		}
+	}
+
+	workflowId := sqlchelpers.UUIDToStr(workflow.ID)
+
+	a.audit(ctx, tenantId, "ReleaseConcurrencySlot", "workflow", &workflowId, map[string]any{
+		"key":            req.Key,
+		"workflowRunIds": releaseIds,
+	})
+
+	return &contracts.ReleaseConcurrencySlotResponse{
+		WorkflowRunIds: releaseIds,
+	}, nil
+}
+
+// ReplayWorkflowRun resumes a finished workflow run by replaying the step runs which failed or timed out,
+// or the step runs of the requested step. Like replaying a single step run, the replayed step runs reuse
+// their input and the outputs of their parents, and the step runs which depend on them are reset.
+func (a *AdminServiceImpl) ReplayWorkflowRun(ctx context.Context, req *contracts.ReplayWorkflowRunRequest) (*contracts.ReplayWorkflowRunResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if _, err := uuid.Parse(req.WorkflowRunId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "workflow run id must be a uuid")
+	}
+
+	workflowRun, err := a.repo.WorkflowRun().GetWorkflowRunById(ctx, tenantId, req.WorkflowRunId)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrWorkflowRunNotFound) {
+			return nil, status.Errorf(codes.NotFound, "workflow run %s not found", req.WorkflowRunId)
+		}
+
+		return nil, fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	if !repository.IsFinalWorkflowRunStatus(workflowRun.WorkflowRun.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "workflow run %s is not finished running yet", req.WorkflowRunId)
+	}
+
+	stepRuns, err := a.repo.StepRun().ListStepRuns(ctx, tenantId, &repository.ListStepRunsOpts{
+		WorkflowRunIds: []string{req.WorkflowRunId},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step runs: %w", err)
+	}
+
+	toReplay := stepRunsToReplay(stepRuns, req.FromStep)
+
+	if len(toReplay) == 0 {
+		if req.FromStep != nil {
+			return nil, status.Errorf(codes.NotFound, "workflow run %s has no step %s", req.WorkflowRunId, *req.FromStep)
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition, "workflow run %s has no failed steps to replay", req.WorkflowRunId)
+	}
+
+	stepRunIds := make([]string, 0, len(toReplay))
+
+	for _, stepRun := range toReplay {
+		stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+
+		err = a.repo.StepRun().PreflightCheckReplayStepRun(ctx, tenantId, stepRunId)
+
+		switch {
+		case errors.Is(err, repository.ErrNoWorkerAvailable):
+			return nil, status.Errorf(codes.FailedPrecondition, "there are no workers available to execute step %s", stepRun.StepReadableId.String)
+		case errors.Is(err, repository.ErrPreflightReplayStepRunNotInFinalState):
+			return nil, status.Errorf(codes.FailedPrecondition, "step %s is not finished running yet", stepRun.StepReadableId.String)
+		case errors.Is(err, repository.ErrPreflightReplayChildStepRunNotInFinalState):
+			return nil, status.Errorf(codes.FailedPrecondition, "step %s has child step runs which are not finished running yet", stepRun.StepReadableId.String)
+		case err != nil:
+			return nil, fmt.Errorf("could not preflight check step run %s: %w", stepRunId, err)
+		}
+
+		stepRunIds = append(stepRunIds, stepRunId)
+	}
+
+	for _, stepRun := range toReplay {
+		// without input data, the replayed step run keeps its input
+		err = a.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, tasktypes.StepRunReplayToTask(stepRun, nil))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not send replay task for step run %s: %w", sqlchelpers.UUIDToStr(stepRun.SRID), err)
+		}
+	}
+
+	payload := map[string]any{
+		"stepRunIds": stepRunIds,
+	}
+
+	if req.FromStep != nil {
+		payload["fromStep"] = *req.FromStep
+	}
+
+	a.audit(ctx, tenantId, "ReplayWorkflowRun", "workflow_run", &req.WorkflowRunId, payload)
+
+	return &contracts.ReplayWorkflowRunResponse{
+		StepRunIds: stepRunIds,
+	}, nil
+}
+
+// stepRunsToReplay returns the step runs of a workflow run which are replayed to resume it. These are the
+// step runs of fromStep if it's set, and otherwise the step runs which failed or timed out. The step runs
+// which were cancelled because a parent failed are reset when their parent is replayed, and the on-failure
+// job isn't replayed.
+func stepRunsToReplay(stepRuns []*dbsqlc.GetStepRunForEngineRow, fromStep *string) []*dbsqlc.GetStepRunForEngineRow {
+	res := make([]*dbsqlc.GetStepRunForEngineRow, 0)
+
+	for _, stepRun := range stepRuns {
+		if stepRun.JobKind == dbsqlc.JobKindONFAILURE {
+			continue
+		}
+
+		if fromStep != nil {
+			if stepRun.StepReadableId.String == *fromStep {
+				res = append(res, stepRun)
+			}
+
+			continue
+		}
+
+		switch {
+		case stepRun.SRStatus == dbsqlc.StepRunStatusFAILED:
+			res = append(res, stepRun)
+		case stepRun.SRStatus == dbsqlc.StepRunStatusCANCELLED:
+			if reason := stepRun.SRCancelledReason.String; reason == "TIMED_OUT" || reason == "SCHEDULING_TIMED_OUT" {
+				res = append(res, stepRun)
+			}
+		}
+	}
+
+	return res
+}
+
+// GetWorkflow returns the definition of the latest version of a workflow.
+func (a *AdminServiceImpl) GetWorkflow(ctx context.Context, req *contracts.GetWorkflowRequest) (*contracts.GetWorkflowResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	workflow, err := a.getWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowVersion, err := a.repo.Workflow().GetLatestWorkflowVersion(ctx, tenantId, sqlchelpers.UUIDToStr(workflow.ID))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "workflow %s has no versions", req.Name)
+		}
+
+		return nil, fmt.Errorf("could not get latest workflow version: %w", err)
+	}
+
+	workflowVersionId := sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID)
+
+	definition, err := a.repo.Workflow().GetWorkflowVersionDefinition(ctx, tenantId, workflowVersionId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version definition: %w", err)
+	}
+
+	res := &contracts.GetWorkflowResponse{
+		Id:            sqlchelpers.UUIDToStr(workflow.ID),
+		Name:          workflow.Name,
+		Description:   workflow.Description.String,
+		IsPaused:      workflow.IsPaused.Valid && workflow.IsPaused.Bool,
+		VersionId:     workflowVersionId,
+		Version:       workflowVersion.WorkflowVersion.Version.String,
+		EventTriggers: definition.EventTriggers,
+		CronTriggers:  definition.CronTriggers,
+		Steps:         make([]*contracts.WorkflowStepDefinition, 0, len(definition.Steps)),
+	}
+
+	if workflowVersion.ConcurrencyLimitStrategy.Valid {
+		strategy := string(workflowVersion.ConcurrencyLimitStrategy.ConcurrencyLimitStrategy)
+		res.ConcurrencyLimitStrategy = &strategy
+	}
+
+	if workflowVersion.ConcurrencyMaxRuns.Valid {
+		res.ConcurrencyMaxRuns = &workflowVersion.ConcurrencyMaxRuns.Int32
+	}
+
+	for _, step := range definition.Steps {
+		res.Steps = append(res.Steps, &contracts.WorkflowStepDefinition{
+			ReadableId: step.Step.ReadableId.String,
+			Action:     step.Step.ActionId,
+			Parents:    step.Parents,
+			Timeout:    step.Step.Timeout.String,
+			Retries:    step.Step.Retries,
+			OnFailure:  step.JobKind == dbsqlc.JobKindONFAILURE,
+		})
+	}
+
+	return res, nil
+}
+
+// SetWorkflowPaused pauses or resumes a workflow. New runs of a paused workflow are queued but not
+// scheduled, and when the workflow is resumed they're scheduled in the order in which they were triggered.
+func (a *AdminServiceImpl) SetWorkflowPaused(ctx context.Context, req *contracts.SetWorkflowPausedRequest) (*contracts.SetWorkflowPausedResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	workflow, err := a.getWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workflowId := sqlchelpers.UUIDToStr(workflow.ID)
+
+	workflow, err = a.repo.Workflow().UpdateWorkflow(ctx, tenantId, workflowId, &repository.UpdateWorkflowOpts{
+		IsPaused: &req.IsPaused,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update workflow: %w", err)
+	}
+
+	a.audit(ctx, tenantId, "SetWorkflowPaused", "workflow", &workflowId, map[string]any{
+		"isPaused": req.IsPaused,
+	})
+
+	return &contracts.SetWorkflowPausedResponse{
+		IsPaused: workflow.IsPaused.Valid && workflow.IsPaused.Bool,
+	}, nil
+}
+
+// SetTenantPaused pauses or resumes the tenant, for example during a maintenance window. New runs of the
+// tenant's workflows are queued but not scheduled while it is paused, and step runs which were already
+// scheduled keep running.
+func (a *AdminServiceImpl) SetTenantPaused(ctx context.Context, req *contracts.SetTenantPausedRequest) (*contracts.SetTenantPausedResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	tenant, err := a.repo.Tenant().UpdateTenantPaused(ctx, tenantId, req.IsPaused)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update tenant: %w", err)
+	}
+
+	a.audit(ctx, tenantId, "SetTenantPaused", "tenant", &tenantId, map[string]any{
+		"isPaused": req.IsPaused,
+	})
+
+	return &contracts.SetTenantPausedResponse{
+		IsPaused: tenant.IsPaused,
+	}, nil
+}
+
+// Health returns the version of the engine. Since requests are authenticated, a successful response
+// also means that the token of the client is valid.
+func (a *AdminServiceImpl) Health(ctx context.Context, req *contracts.HealthRequest) (*contracts.HealthResponse, error) {
+	return &contracts.HealthResponse{
+		Version: a.version,
+	}, nil
+}
+
+// checkWorkflowNamespace rejects workflows outside of the namespace of the API token which makes the
+// request.
+func checkWorkflowNamespace(ctx context.Context, name string) error {
+	if err := repository.ActorFromContext(ctx).CheckNamespace(name); err != nil {
+		return status.Errorf(codes.PermissionDenied, "Permission denied: workflow %s", err)
+	}
+
+	return nil
+}
+
+func (a *AdminServiceImpl) getWorkflowByName(ctx context.Context, tenantId, name string) (*dbsqlc.Workflow, error) {
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	workflow, err := a.repo.Workflow().GetWorkflowByName(ctx, tenantId, name)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "workflow %s not found", name)
+		}
+
+		return nil, fmt.Errorf("could not get workflow by name: %w", err)
+	}
+
+	return workflow, nil
+}
+
 func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.CreateWorkflowVersionOpts, error) {
 	jobs := make([]repository.CreateWorkflowJobOpts, len(req.Opts.Jobs))
 
@@ -398,7 +1026,7 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 
 		if err != nil {
 
-			if errors.Is(err, repository.ErrDagParentNotFound) {
+			if errors.Is(err, repository.ErrDagParentNotFound) || errors.Is(err, repository.ErrDagInvalidJoin) {
 				// Extract the additional error information
 				return nil, status.Error(
 					codes.InvalidArgument,
@@ -466,6 +1094,39 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 		cronInput = []byte(*req.Opts.CronInput)
 	}
 
+	for eventKey := range req.Opts.EventTriggerFilters {
+		if !slices.Contains(req.Opts.EventTriggers, eventKey) {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"event trigger filter for %s does not match an event trigger",
+				eventKey,
+			)
+		}
+	}
+
+	var cronCatchUpPolicies map[string]string
+
+	for cron, policy := range req.Opts.CronCatchUpPolicies {
+		if !slices.Contains(req.Opts.CronTriggers, cron) {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"cron catch-up policy for %s does not match a cron trigger",
+				cron,
+			)
+		}
+
+		// skipping missed runs is the default, so only other policies are stored
+		if policy == contracts.CronCatchUpPolicy_SKIP {
+			continue
+		}
+
+		if cronCatchUpPolicies == nil {
+			cronCatchUpPolicies = map[string]string{}
+		}
+
+		cronCatchUpPolicies[cron] = policy.String()
+	}
+
 	var kind *string
 
 	if req.Opts.Kind != nil {
@@ -473,20 +1134,23 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 	}
 
 	return &repository.CreateWorkflowVersionOpts{
-		Name:              req.Opts.Name,
-		Concurrency:       concurrency,
-		Description:       &req.Opts.Description,
-		Version:           &req.Opts.Version,
-		EventTriggers:     req.Opts.EventTriggers,
-		CronTriggers:      req.Opts.CronTriggers,
-		CronInput:         cronInput,
-		ScheduledTriggers: scheduledTriggers,
-		Jobs:              jobs,
-		OnFailureJob:      onFailureJob,
-		ScheduleTimeout:   req.Opts.ScheduleTimeout,
-		Sticky:            sticky,
-		Kind:              kind,
-		DefaultPriority:   req.Opts.DefaultPriority,
+		Name:                req.Opts.Name,
+		Concurrency:         concurrency,
+		Description:         &req.Opts.Description,
+		Version:             &req.Opts.Version,
+		EventTriggers:       req.Opts.EventTriggers,
+		EventTriggerFilters: req.Opts.EventTriggerFilters,
+		CronTriggers:        req.Opts.CronTriggers,
+		CronCatchUpPolicies: cronCatchUpPolicies,
+		CronInput:           cronInput,
+		ScheduledTriggers:   scheduledTriggers,
+		Jobs:                jobs,
+		OnFailureJob:        onFailureJob,
+		ScheduleTimeout:     req.Opts.ScheduleTimeout,
+		Sticky:              sticky,
+		Kind:                kind,
+		DefaultPriority:     req.Opts.DefaultPriority,
+		RunTimeout:          req.Opts.RunTimeout,
 	}, nil
 }
 
@@ -551,6 +1215,21 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 				maxInt := 24 * 60 * 60
 				steps[j].RetryBackoffMaxSeconds = &maxInt
 			}
+
+			if stepCp.BackoffBaseMs != nil {
+				baseMs := int(*stepCp.BackoffBaseMs)
+				steps[j].RetryBackoffBaseMs = &baseMs
+			}
+
+			if stepCp.BackoffJitter != nil {
+				jitter := float64(*stepCp.BackoffJitter)
+				steps[j].RetryBackoffJitter = &jitter
+			}
+		}
+
+		if stepCp.JoinCount != nil {
+			joinCount := int(*stepCp.JoinCount)
+			steps[j].JoinCount = &joinCount
 		}
 
 		if stepCp.Timeout != "" {
@@ -590,6 +1269,10 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 				return nil, fmt.Errorf("%w: parent step '%s' not found for step '%s'", repository.ErrDagParentNotFound, parent, step.ReadableId)
 			}
 		}
+
+		if step.JoinCount != nil && (*step.JoinCount < 1 || int(*step.JoinCount) > len(step.Parents)) {
+			return nil, fmt.Errorf("%w: join count of step '%s' must be between 1 and its number of parents (%d)", repository.ErrDagInvalidJoin, step.ReadableId, len(step.Parents))
+		}
 	}
 
 	return &repository.CreateWorkflowJobOpts{
@@ -626,6 +1309,10 @@ func toWorkflowVersion(workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow, s
 	return version
 }
 
+// defaultIdempotencyWindow is how long the idempotency key of a trigger request is kept if the request
+// doesn't set a window.
+const defaultIdempotencyWindow = 24 * time.Hour
+
 func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest, a *AdminServiceImpl) ([]*repository.CreateWorkflowRunOpts, []string, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -642,6 +1329,10 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 	childWorkflowMap := make(map[string]*dbsqlc.WorkflowRun)
 
 	for _, req := range requests {
+		if err := checkWorkflowNamespace(ctx, req.Name); err != nil {
+			return nil, nil, err
+		}
+
 		isParentTriggered := req.ParentId != nil
 
 		if isParentTriggered {
@@ -763,7 +1454,12 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 			return nil, nil, status.Errorf(codes.NotFound, "workflow %s not found", req.Name)
 		}
 
-		latestVersion := workflowVersionMap[sqlchelpers.UUIDToStr(workflow.ID)]
+		// if version weights are configured, new runs are split between the versions of the workflow
+		workflowVersion, err := a.repo.Workflow().RouteWorkflowVersion(createContext, tenantId, workflowVersionMap[sqlchelpers.UUIDToStr(workflow.ID)])
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not route workflow version: %w", err)
+		}
 
 		var createOpts *repository.CreateWorkflowRunOpts
 
@@ -777,6 +1473,8 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 		}
 		isParentTriggered := req.ParentId != nil
 
+		var parentTimeoutAt *time.Time
+
 		if isParentTriggered {
 
 			parent := parentTriggeredWorkflowRuns[sqlchelpers.UUIDToStr(sqlchelpers.UUIDFromStr(*req.ParentId))]
@@ -795,7 +1493,7 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 			}
 
 			createOpts, err = repository.GetCreateWorkflowRunOptsFromParent(
-				latestVersion,
+				workflowVersion,
 				[]byte(req.Input),
 				*req.ParentId,
 				*req.ParentStepRunId,
@@ -808,29 +1506,78 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 			if err != nil {
 				return nil, nil, fmt.Errorf("Trigger Workflow could not create workflow run opts: %w", err)
 			}
+
+			createOpts.Detached = req.GetDetached()
+
+			if parent.WorkflowRun.TimeoutAt.Valid {
+				parentTimeoutAt = &parent.WorkflowRun.TimeoutAt.Time
+			}
 		} else {
-			createOpts, err = repository.GetCreateWorkflowRunOptsFromManual(latestVersion, []byte(req.Input), additionalMetadata)
+			createOpts, err = repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, []byte(req.Input), additionalMetadata)
 			if err != nil {
 				return nil, nil, fmt.Errorf("Trigger Workflow not after parent triggered check could not create workflow run opts: %w", err)
 			}
 		}
 
 		if req.DesiredWorkerId != nil {
-			if !latestVersion.WorkflowVersion.Sticky.Valid {
-				return nil, nil, status.Errorf(codes.Canceled, "workflow version %s does not have sticky enabled", latestVersion.WorkflowName)
+			if !workflowVersion.WorkflowVersion.Sticky.Valid {
+				return nil, nil, status.Errorf(codes.Canceled, "workflow version %s does not have sticky enabled", workflowVersion.WorkflowName)
 			}
 
 			createOpts.DesiredWorkerId = req.DesiredWorkerId
 		}
 
-		if latestVersion.WorkflowVersion.DefaultPriority.Valid {
-			createOpts.Priority = &latestVersion.WorkflowVersion.DefaultPriority.Int32
+		if req.Priority != nil && (*req.Priority < 1 || *req.Priority > 3) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid priority %d for workflow %s, must be between 1 and 3", *req.Priority, req.Name)
+		}
+
+		repository.WithPriority(req.Priority)(createOpts)
+
+		if req.Timeout != nil {
+			timeout, err := time.ParseDuration(*req.Timeout)
+
+			if err != nil || timeout <= 0 {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "invalid timeout %s for workflow %s", *req.Timeout, req.Name)
+			}
+
+			timeoutAt := time.Now().UTC().Add(timeout)
+			createOpts.TimeoutAt = &timeoutAt
+		}
+
+		if req.ExpiresAt != nil {
+			expiresAt := req.ExpiresAt.AsTime().UTC()
+
+			if !expiresAt.After(time.Now().UTC()) {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "expiry %s for workflow %s is in the past", expiresAt.Format(time.RFC3339), req.Name)
+			}
+
+			createOpts.ExpiresAt = &expiresAt
+		}
+
+		if req.IdempotencyKey != nil {
+			window := defaultIdempotencyWindow
+
+			if req.IdempotencyWindow != nil {
+				window, err = time.ParseDuration(*req.IdempotencyWindow)
+
+				if err != nil || window <= 0 {
+					return nil, nil, status.Errorf(codes.InvalidArgument, "invalid idempotency window %s for workflow %s", *req.IdempotencyWindow, req.Name)
+				}
+			}
+
+			idempotencyKeyExpiresAt := time.Now().UTC().Add(window)
+
+			createOpts.IdempotencyKey = req.IdempotencyKey
+			createOpts.IdempotencyKeyExpiresAt = &idempotencyKeyExpiresAt
 		}
 
-		if req.Priority != nil {
-			createOpts.Priority = req.Priority
+		// a child workflow run never runs past the timeout of its parent
+		if parentTimeoutAt != nil && (createOpts.TimeoutAt == nil || parentTimeoutAt.Before(*createOpts.TimeoutAt)) {
+			createOpts.TimeoutAt = parentTimeoutAt
 		}
 
+		createOpts.TriggeringActor = repository.ActorFromContext(ctx)
+
 		results = append(results, createOpts)
 
 	}
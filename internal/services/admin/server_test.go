@@ -0,0 +1,887 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type fakeEngineRepository struct {
+	repository.EngineRepository
+
+	workflowRuns *fakeWorkflowRunRepository
+	stepRuns     *fakeStepRunRepository
+	auditLogs    *fakeAuditLogRepository
+}
+
+func (r *fakeEngineRepository) StepRun() repository.StepRunEngineRepository {
+	return r.stepRuns
+}
+
+func (r *fakeEngineRepository) AuditLog() repository.AuditLogEngineRepository {
+	return r.auditLogs
+}
+
+func (r *fakeEngineRepository) Workflow() repository.WorkflowEngineRepository {
+	return &fakeWorkflowRepository{}
+}
+
+func (r *fakeEngineRepository) WorkflowRun() repository.WorkflowRunEngineRepository {
+	return r.workflowRuns
+}
+
+func (r *fakeEngineRepository) JobRun() repository.JobRunEngineRepository {
+	return &fakeJobRunRepository{}
+}
+
+func (r *fakeEngineRepository) Tenant() repository.TenantEngineRepository {
+	return &fakeTenantRepository{}
+}
+
+type fakeTenantRepository struct {
+	repository.TenantEngineRepository
+}
+
+func (r *fakeTenantRepository) UpdateTenantPaused(ctx context.Context, tenantId string, isPaused bool) (*dbsqlc.Tenant, error) {
+	return &dbsqlc.Tenant{
+		ID:       sqlchelpers.UUIDFromStr(tenantId),
+		IsPaused: isPaused,
+	}, nil
+}
+
+type fakeWorkflowRepository struct {
+	repository.WorkflowEngineRepository
+}
+
+// GetWorkflowByName returns the same id for the same name, and GetLatestWorkflowVersion the same version
+// for the same workflow.
+func (r *fakeWorkflowRepository) GetWorkflowByName(ctx context.Context, tenantId, workflowName string) (*dbsqlc.Workflow, error) {
+	return &dbsqlc.Workflow{
+		ID:   sqlchelpers.UUIDFromStr(uuid.NewSHA1(uuid.NameSpaceOID, []byte(workflowName)).String()),
+		Name: workflowName,
+	}, nil
+}
+
+func (r *fakeWorkflowRepository) UpdateWorkflow(ctx context.Context, tenantId, workflowId string, opts *repository.UpdateWorkflowOpts) (*dbsqlc.Workflow, error) {
+	return &dbsqlc.Workflow{
+		ID:       sqlchelpers.UUIDFromStr(workflowId),
+		IsPaused: pgtype.Bool{Bool: *opts.IsPaused, Valid: true},
+	}, nil
+}
+
+func (r *fakeWorkflowRepository) GetLatestWorkflowVersion(ctx context.Context, tenantId, workflowId string) (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	return &dbsqlc.GetWorkflowVersionForEngineRow{
+		WorkflowVersion: dbsqlc.WorkflowVersion{
+			ID:         sqlchelpers.UUIDFromStr(uuid.NewSHA1(uuid.NameSpaceOID, []byte(workflowId)).String()),
+			WorkflowId: sqlchelpers.UUIDFromStr(workflowId),
+			Version:    pgtype.Text{String: "v2", Valid: true},
+		},
+		ConcurrencyLimitStrategy: dbsqlc.NullConcurrencyLimitStrategy{
+			ConcurrencyLimitStrategy: dbsqlc.ConcurrencyLimitStrategyGROUPROUNDROBIN,
+			Valid:                    true,
+		},
+		ConcurrencyMaxRuns: pgtype.Int4{Int32: 5, Valid: true},
+	}, nil
+}
+
+// GetStepForWorkflowVersion returns the charge step of every workflow version, with an id derived from
+// the workflow version.
+func (r *fakeWorkflowRepository) GetStepForWorkflowVersion(ctx context.Context, tenantId, workflowVersionId, stepReadableId string) (*dbsqlc.Step, error) {
+	if stepReadableId != "charge" {
+		return nil, pgx.ErrNoRows
+	}
+
+	return &dbsqlc.Step{
+		ID:         sqlchelpers.UUIDFromStr(uuid.NewSHA1(uuid.NameSpaceOID, []byte(workflowVersionId+stepReadableId)).String()),
+		ReadableId: pgtype.Text{String: stepReadableId, Valid: true},
+		ActionId:   "checkout:charge",
+	}, nil
+}
+
+func (r *fakeWorkflowRepository) GetWorkflowsByNames(ctx context.Context, tenantId string, workflowNames []string) ([]*dbsqlc.Workflow, error) {
+	workflows := make([]*dbsqlc.Workflow, 0, len(workflowNames))
+
+	for _, name := range workflowNames {
+		workflow, _ := r.GetWorkflowByName(ctx, tenantId, name)
+		workflows = append(workflows, workflow)
+	}
+
+	return workflows, nil
+}
+
+func (r *fakeWorkflowRepository) GetLatestWorkflowVersions(ctx context.Context, tenantId string, workflowIds []string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	versions := make([]*dbsqlc.GetWorkflowVersionForEngineRow, 0, len(workflowIds))
+
+	for _, workflowId := range workflowIds {
+		version, _ := r.GetLatestWorkflowVersion(ctx, tenantId, workflowId)
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func (r *fakeWorkflowRepository) RouteWorkflowVersion(ctx context.Context, tenantId string, latest *dbsqlc.GetWorkflowVersionForEngineRow) (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	return latest, nil
+}
+
+func (r *fakeWorkflowRepository) GetWorkflowVersionDefinition(ctx context.Context, tenantId, workflowVersionId string) (*repository.WorkflowVersionDefinition, error) {
+	return &repository.WorkflowVersionDefinition{
+		EventTriggers: []string{"order:created"},
+		CronTriggers:  []string{},
+		Steps: []*dbsqlc.ListStepsForWorkflowVersionRow{
+			{
+				JobKind: dbsqlc.JobKindDEFAULT,
+				Step: dbsqlc.Step{
+					ReadableId: pgtype.Text{String: "charge", Valid: true},
+					ActionId:   "checkout:charge",
+					Retries:    3,
+				},
+				Parents: []string{},
+			},
+			{
+				JobKind: dbsqlc.JobKindONFAILURE,
+				Step: dbsqlc.Step{
+					ReadableId: pgtype.Text{String: "refund", Valid: true},
+					ActionId:   "checkout:refund",
+					Timeout:    pgtype.Text{String: "30s", Valid: true},
+				},
+				Parents: []string{},
+			},
+		},
+	}, nil
+}
+
+type fakeWorkflowRunRepository struct {
+	repository.WorkflowRunEngineRepository
+
+	// the ids of the workflow runs which hold the slots of the key
+	holders []string
+
+	// the status of the workflow runs which are returned by GetWorkflowRunById
+	runStatus dbsqlc.WorkflowRunStatus
+
+	// the ids of the created workflow runs by idempotency key
+	idempotencyKeys map[string]string
+
+	// the workflow versions of the created workflow runs by idempotency key
+	idempotencyKeyVersions map[string]string
+
+	created []*repository.CreateWorkflowRunOpts
+}
+
+func (r *fakeWorkflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
+	id := uuid.New().String()
+
+	if opts.IdempotencyKey != nil {
+		if existing, ok := r.idempotencyKeys[*opts.IdempotencyKey]; ok {
+			return nil, repository.ErrIdempotencyKeyExists{
+				Key:           *opts.IdempotencyKey,
+				WorkflowRunId: existing,
+				OtherWorkflow: r.idempotencyKeyVersions[*opts.IdempotencyKey] != opts.WorkflowVersionId,
+			}
+		}
+
+		if r.idempotencyKeys == nil {
+			r.idempotencyKeys = map[string]string{}
+			r.idempotencyKeyVersions = map[string]string{}
+		}
+
+		r.idempotencyKeys[*opts.IdempotencyKey] = id
+		r.idempotencyKeyVersions[*opts.IdempotencyKey] = opts.WorkflowVersionId
+	}
+
+	r.created = append(r.created, opts)
+
+	return &dbsqlc.WorkflowRun{
+		ID: sqlchelpers.UUIDFromStr(id),
+	}, nil
+}
+
+func (r *fakeWorkflowRunRepository) GetWorkflowRunById(ctx context.Context, tenantId, runId string) (*dbsqlc.GetWorkflowRunRow, error) {
+	return &dbsqlc.GetWorkflowRunRow{
+		WorkflowRun: dbsqlc.WorkflowRun{
+			ID:     sqlchelpers.UUIDFromStr(runId),
+			Status: r.runStatus,
+		},
+	}, nil
+}
+
+func (r *fakeWorkflowRunRepository) ListConcurrencySlotHolders(ctx context.Context, tenantId, workflowId string, key *string) ([]*dbsqlc.ListConcurrencySlotHoldersRow, error) {
+	rows := make([]*dbsqlc.ListConcurrencySlotHoldersRow, 0, len(r.holders))
+
+	for _, id := range r.holders {
+		rows = append(rows, &dbsqlc.ListConcurrencySlotHoldersRow{
+			ID:  sqlchelpers.UUIDFromStr(id),
+			Key: *key,
+		})
+	}
+
+	return rows, nil
+}
+
+// GetWorkflowRunByIds returns a workflow run for each id, so that every parent workflow run exists.
+func (r *fakeWorkflowRunRepository) GetWorkflowRunByIds(ctx context.Context, tenantId string, runIds []string) ([]*dbsqlc.GetWorkflowRunRow, error) {
+	rows := make([]*dbsqlc.GetWorkflowRunRow, 0, len(runIds))
+
+	for _, id := range runIds {
+		rows = append(rows, &dbsqlc.GetWorkflowRunRow{
+			WorkflowRun: dbsqlc.WorkflowRun{
+				ID: sqlchelpers.UUIDFromStr(id),
+			},
+		})
+	}
+
+	return rows, nil
+}
+
+func (r *fakeWorkflowRunRepository) GetChildWorkflowRuns(ctx context.Context, childWorkflowRuns []repository.ChildWorkflowRun) ([]*dbsqlc.WorkflowRun, error) {
+	return []*dbsqlc.WorkflowRun{}, nil
+}
+
+type fakeStepRunRepository struct {
+	repository.StepRunEngineRepository
+
+	stepRuns []*dbsqlc.GetStepRunForEngineRow
+}
+
+func (r *fakeStepRunRepository) ListStepRuns(ctx context.Context, tenantId string, opts *repository.ListStepRunsOpts) ([]*dbsqlc.GetStepRunForEngineRow, error) {
+	return r.stepRuns, nil
+}
+
+func (r *fakeStepRunRepository) PreflightCheckReplayStepRun(ctx context.Context, tenantId, stepRunId string) error {
+	return nil
+}
+
+type fakeJobRunRepository struct {
+	repository.JobRunEngineRepository
+}
+
+func (r *fakeJobRunRepository) ListJobRunsForWorkflowRun(ctx context.Context, tenantId, workflowRunId string) ([]*dbsqlc.ListJobRunsForWorkflowRunRow, error) {
+	return []*dbsqlc.ListJobRunsForWorkflowRunRow{
+		{ID: sqlchelpers.UUIDFromStr(uuid.New().String())},
+	}, nil
+}
+
+type fakeAuditLogRepository struct {
+	repository.AuditLogEngineRepository
+
+	logs []*repository.CreateAuditLogOpts
+}
+
+func (r *fakeAuditLogRepository) CreateAuditLog(ctx context.Context, tenantId string, opts *repository.CreateAuditLogOpts) (*dbsqlc.AuditLog, error) {
+	r.logs = append(r.logs, opts)
+	return &dbsqlc.AuditLog{}, nil
+}
+
+type fakeMessageQueue struct {
+	msgqueue.MessageQueue
+
+	messages []*msgqueue.Message
+}
+
+func (mq *fakeMessageQueue) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	mq.messages = append(mq.messages, task)
+	return nil
+}
+
+func TestReleaseConcurrencySlot(t *testing.T) {
+	holders := []string{uuid.New().String(), uuid.New().String()}
+
+	mq := &fakeMessageQueue{}
+	auditLogs := &fakeAuditLogRepository{}
+
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			workflowRuns: &fakeWorkflowRunRepository{holders: holders},
+			auditLogs:    auditLogs,
+		},
+		mq: mq,
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	ctx = repository.ContextWithActor(ctx, &repository.Actor{
+		Type: repository.ActorTypeAPIToken,
+		Id:   uuid.New().String(),
+	})
+
+	// the release must be confirmed
+	_, err := a.ReleaseConcurrencySlot(ctx, &contracts.ReleaseConcurrencySlotRequest{
+		Name: "workflow",
+		Key:  "key",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), holders[0])
+	assert.Empty(t, mq.messages)
+
+	// runs which don't hold a slot of the key can't be released
+	_, err = a.ReleaseConcurrencySlot(ctx, &contracts.ReleaseConcurrencySlotRequest{
+		Name:           "workflow",
+		Key:            "key",
+		WorkflowRunIds: []string{uuid.New().String()},
+		Confirm:        true,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, mq.messages)
+
+	res, err := a.ReleaseConcurrencySlot(ctx, &contracts.ReleaseConcurrencySlotRequest{
+		Name:           "workflow",
+		Key:            "key",
+		WorkflowRunIds: holders[1:],
+		Confirm:        true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, holders[1:], res.WorkflowRunIds)
+	require.Len(t, mq.messages, 1)
+	assert.Equal(t, "job-run-cancelled", mq.messages[0].ID)
+
+	res, err = a.ReleaseConcurrencySlot(ctx, &contracts.ReleaseConcurrencySlotRequest{
+		Name:    "workflow",
+		Key:     "key",
+		Confirm: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, holders, res.WorkflowRunIds)
+	assert.Len(t, mq.messages, 3)
+
+	// only the releases which cancelled runs are audited
+	require.Len(t, auditLogs.logs, 2)
+	assert.Equal(t, "ReleaseConcurrencySlot", auditLogs.logs[0].Action)
+	assert.JSONEq(t, `{"key":"key","workflowRunIds":["`+holders[1]+`"]}`, string(auditLogs.logs[0].Payload))
+}
+
+func newTestStepRun(readableId string, status dbsqlc.StepRunStatus, cancelledReason string) *dbsqlc.GetStepRunForEngineRow {
+	return &dbsqlc.GetStepRunForEngineRow{
+		SRID:              sqlchelpers.UUIDFromStr(uuid.New().String()),
+		SRTenantId:        sqlchelpers.UUIDFromStr(uuid.New().String()),
+		SRStatus:          status,
+		SRCancelledReason: pgtype.Text{String: cancelledReason, Valid: cancelledReason != ""},
+		StepReadableId:    pgtype.Text{String: readableId, Valid: true},
+		JobKind:           dbsqlc.JobKindDEFAULT,
+	}
+}
+
+func TestReplayWorkflowRun(t *testing.T) {
+	validate := newTestStepRun("validate", dbsqlc.StepRunStatusSUCCEEDED, "")
+	charge := newTestStepRun("charge", dbsqlc.StepRunStatusFAILED, "")
+	ship := newTestStepRun("ship", dbsqlc.StepRunStatusCANCELLED, "PREVIOUS_STEP_FAILED")
+	notify := newTestStepRun("notify", dbsqlc.StepRunStatusCANCELLED, "TIMED_OUT")
+
+	refund := newTestStepRun("refund", dbsqlc.StepRunStatusFAILED, "")
+	refund.JobKind = dbsqlc.JobKindONFAILURE
+
+	mq := &fakeMessageQueue{}
+	auditLogs := &fakeAuditLogRepository{}
+	workflowRuns := &fakeWorkflowRunRepository{runStatus: dbsqlc.WorkflowRunStatusRUNNING}
+
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			workflowRuns: workflowRuns,
+			stepRuns: &fakeStepRunRepository{
+				stepRuns: []*dbsqlc.GetStepRunForEngineRow{validate, charge, ship, notify, refund},
+			},
+			auditLogs: auditLogs,
+		},
+		mq: mq,
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	ctx = repository.ContextWithActor(ctx, &repository.Actor{
+		Type: repository.ActorTypeAPIToken,
+		Id:   uuid.New().String(),
+	})
+
+	workflowRunId := uuid.New().String()
+
+	// runs which are still running can't be replayed
+	_, err := a.ReplayWorkflowRun(ctx, &contracts.ReplayWorkflowRunRequest{
+		WorkflowRunId: workflowRunId,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	workflowRuns.runStatus = dbsqlc.WorkflowRunStatusFAILED
+
+	_, err = a.ReplayWorkflowRun(ctx, &contracts.ReplayWorkflowRunRequest{
+		WorkflowRunId: "not-a-uuid",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// by default, the steps which failed or timed out are replayed
+	res, err := a.ReplayWorkflowRun(ctx, &contracts.ReplayWorkflowRunRequest{
+		WorkflowRunId: workflowRunId,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{sqlchelpers.UUIDToStr(charge.SRID), sqlchelpers.UUIDToStr(notify.SRID)}, res.StepRunIds)
+	require.Len(t, mq.messages, 2)
+	assert.Equal(t, "step-run-replay", mq.messages[0].ID)
+	assert.Equal(t, sqlchelpers.UUIDToStr(charge.SRID), mq.messages[0].Payload["step_run_id"])
+
+	fromStep := "validate"
+
+	res, err = a.ReplayWorkflowRun(ctx, &contracts.ReplayWorkflowRunRequest{
+		WorkflowRunId: workflowRunId,
+		FromStep:      &fromStep,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{sqlchelpers.UUIDToStr(validate.SRID)}, res.StepRunIds)
+	assert.Len(t, mq.messages, 3)
+
+	unknownStep := "unknown"
+
+	_, err = a.ReplayWorkflowRun(ctx, &contracts.ReplayWorkflowRunRequest{
+		WorkflowRunId: workflowRunId,
+		FromStep:      &unknownStep,
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.Len(t, auditLogs.logs, 2)
+	assert.Equal(t, "ReplayWorkflowRun", auditLogs.logs[1].Action)
+	assert.JSONEq(t, `{"fromStep":"validate","stepRunIds":["`+sqlchelpers.UUIDToStr(validate.SRID)+`"]}`, string(auditLogs.logs[1].Payload))
+}
+
+func TestGetWorkflow(t *testing.T) {
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{},
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	_, err := a.GetWorkflow(ctx, &contracts.GetWorkflowRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := a.GetWorkflow(ctx, &contracts.GetWorkflowRequest{Name: "checkout"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "checkout", res.Name)
+	assert.Equal(t, "v2", res.Version)
+	assert.Equal(t, []string{"order:created"}, res.EventTriggers)
+	assert.Equal(t, "GROUP_ROUND_ROBIN", res.GetConcurrencyLimitStrategy())
+	assert.Equal(t, int32(5), res.GetConcurrencyMaxRuns())
+
+	require.Len(t, res.Steps, 2)
+	assert.Equal(t, "charge", res.Steps[0].ReadableId)
+	assert.Equal(t, int32(3), res.Steps[0].Retries)
+	assert.False(t, res.Steps[0].OnFailure)
+	assert.Equal(t, "refund", res.Steps[1].ReadableId)
+	assert.Equal(t, "30s", res.Steps[1].Timeout)
+	assert.True(t, res.Steps[1].OnFailure)
+}
+
+func TestSetPaused(t *testing.T) {
+	auditLogs := &fakeAuditLogRepository{}
+
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			auditLogs: auditLogs,
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	ctx = repository.ContextWithActor(ctx, &repository.Actor{
+		Type: repository.ActorTypeAPIToken,
+		Id:   uuid.New().String(),
+	})
+
+	_, err := a.SetWorkflowPaused(ctx, &contracts.SetWorkflowPausedRequest{IsPaused: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	workflowRes, err := a.SetWorkflowPaused(ctx, &contracts.SetWorkflowPausedRequest{Name: "checkout", IsPaused: true})
+	require.NoError(t, err)
+	assert.True(t, workflowRes.IsPaused)
+
+	tenantRes, err := a.SetTenantPaused(ctx, &contracts.SetTenantPausedRequest{IsPaused: false})
+	require.NoError(t, err)
+	assert.False(t, tenantRes.IsPaused)
+
+	require.Len(t, auditLogs.logs, 2)
+	assert.Equal(t, "SetWorkflowPaused", auditLogs.logs[0].Action)
+	assert.JSONEq(t, `{"isPaused":true}`, string(auditLogs.logs[0].Payload))
+	assert.Equal(t, "SetTenantPaused", auditLogs.logs[1].Action)
+	assert.JSONEq(t, `{"isPaused":false}`, string(auditLogs.logs[1].Payload))
+}
+
+func TestHealth(t *testing.T) {
+	a := &AdminServiceImpl{
+		version: "v0.53.7",
+	}
+
+	res, err := a.Health(context.Background(), &contracts.HealthRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "v0.53.7", res.Version)
+}
+
+func TestGetOptsExpiry(t *testing.T) {
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			workflowRuns: &fakeWorkflowRunRepository{},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	opts, _, err := getOpts(ctx, []*contracts.TriggerWorkflowRequest{
+		{Name: "notify", Input: "{}", ExpiresAt: timestamppb.New(expiresAt)},
+		{Name: "report", Input: "{}"},
+	}, a)
+	require.NoError(t, err)
+	require.Len(t, opts, 2)
+
+	require.NotNil(t, opts[0].ExpiresAt)
+	assert.True(t, expiresAt.Equal(*opts[0].ExpiresAt))
+	assert.Nil(t, opts[1].ExpiresAt)
+
+	// runs can't be triggered with an expiry in the past
+	_, _, err = getOpts(ctx, []*contracts.TriggerWorkflowRequest{
+		{Name: "notify", Input: "{}", ExpiresAt: timestamppb.New(time.Now().Add(-time.Minute))},
+	}, a)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetOptsNamespace(t *testing.T) {
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			workflowRuns: &fakeWorkflowRunRepository{},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	ctx = repository.ContextWithActor(ctx, &repository.Actor{
+		Type:      repository.ActorTypeAPIToken,
+		Id:        uuid.New().String(),
+		Namespace: "orders",
+	})
+
+	opts, _, err := getOpts(ctx, []*contracts.TriggerWorkflowRequest{
+		{Name: "orders_notify", Input: "{}"},
+	}, a)
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+
+	// a namespaced token can't trigger the workflows of other namespaces
+	_, _, err = getOpts(ctx, []*contracts.TriggerWorkflowRequest{
+		{Name: "orders_notify", Input: "{}"},
+		{Name: "billing_notify", Input: "{}"},
+	}, a)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetOptsDetached(t *testing.T) {
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			workflowRuns: &fakeWorkflowRunRepository{},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	parentId := uuid.New().String()
+	parentStepRunId := uuid.New().String()
+
+	child := func(childIndex int32, detached *bool) *contracts.TriggerWorkflowRequest {
+		return &contracts.TriggerWorkflowRequest{
+			Name:            "notify",
+			Input:           "{}",
+			ParentId:        &parentId,
+			ParentStepRunId: &parentStepRunId,
+			ChildIndex:      &childIndex,
+			Detached:        detached,
+		}
+	}
+
+	detached := true
+
+	opts, _, err := getOpts(ctx, []*contracts.TriggerWorkflowRequest{
+		child(0, nil),
+		child(1, &detached),
+	}, a)
+	require.NoError(t, err)
+	require.Len(t, opts, 2)
+
+	// children are cancelled with their parent step run unless they are spawned detached
+	require.NotNil(t, opts[0].ParentStepRunId)
+	assert.Equal(t, parentStepRunId, *opts[0].ParentStepRunId)
+	assert.False(t, opts[0].Detached)
+	assert.True(t, opts[1].Detached)
+}
+
+func TestGetCreateWorkflowOptsJoin(t *testing.T) {
+	joinOpts := func(joinCount int32) *contracts.PutWorkflowRequest {
+		return &contracts.PutWorkflowRequest{
+			Opts: &contracts.CreateWorkflowVersionOpts{
+				Name: "hedged",
+				Jobs: []*contracts.CreateWorkflowJobOpts{
+					{
+						Name: "hedged",
+						Steps: []*contracts.CreateWorkflowStepOpts{
+							{ReadableId: "primary", Action: "hedged:primary"},
+							{ReadableId: "fallback", Action: "hedged:fallback"},
+							{ReadableId: "first", Action: "hedged:first", Parents: []string{"primary", "fallback"}, JoinCount: &joinCount},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	opts, err := getCreateWorkflowOpts(joinOpts(1))
+	require.NoError(t, err)
+
+	step := opts.Jobs[0].Steps[2]
+	require.NotNil(t, step.JoinCount)
+	assert.Equal(t, 1, *step.JoinCount)
+
+	_, err = getCreateWorkflowOpts(joinOpts(3))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = getCreateWorkflowOpts(joinOpts(0))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetCreateWorkflowOptsBackoff(t *testing.T) {
+	factor := float32(2)
+	baseMs := int32(250)
+	jitter := float32(0.5)
+
+	opts, err := getCreateWorkflowOpts(&contracts.PutWorkflowRequest{
+		Opts: &contracts.CreateWorkflowVersionOpts{
+			Name: "charge",
+			Jobs: []*contracts.CreateWorkflowJobOpts{
+				{
+					Name: "charge",
+					Steps: []*contracts.CreateWorkflowStepOpts{
+						{ReadableId: "charge", Action: "charge:charge", Retries: 5, BackoffFactor: &factor, BackoffBaseMs: &baseMs, BackoffJitter: &jitter},
+						// base and jitter have no effect without a backoff factor
+						{ReadableId: "notify", Action: "charge:notify", Retries: 5, BackoffBaseMs: &baseMs, BackoffJitter: &jitter},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	step := opts.Jobs[0].Steps[0]
+	require.NotNil(t, step.RetryBackoffBaseMs)
+	assert.Equal(t, 250, *step.RetryBackoffBaseMs)
+	require.NotNil(t, step.RetryBackoffJitter)
+	assert.Equal(t, 0.5, *step.RetryBackoffJitter)
+	require.NotNil(t, step.RetryBackoffMaxSeconds)
+	assert.Equal(t, 24*60*60, *step.RetryBackoffMaxSeconds)
+
+	step = opts.Jobs[0].Steps[1]
+	assert.Nil(t, step.RetryBackoffBaseMs)
+	assert.Nil(t, step.RetryBackoffJitter)
+}
+
+func TestGetCreateWorkflowOptsCronCatchUp(t *testing.T) {
+	cronOpts := func(policies map[string]contracts.CronCatchUpPolicy) *contracts.PutWorkflowRequest {
+		return &contracts.PutWorkflowRequest{
+			Opts: &contracts.CreateWorkflowVersionOpts{
+				Name:                "report",
+				CronTriggers:        []string{"0 9 * * *", "CRON_TZ=Europe/Berlin 0 18 * * *"},
+				CronCatchUpPolicies: policies,
+				Jobs: []*contracts.CreateWorkflowJobOpts{
+					{
+						Name: "report",
+						Steps: []*contracts.CreateWorkflowStepOpts{
+							{ReadableId: "send", Action: "report:send"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	opts, err := getCreateWorkflowOpts(cronOpts(map[string]contracts.CronCatchUpPolicy{
+		"0 9 * * *":                        contracts.CronCatchUpPolicy_SKIP,
+		"CRON_TZ=Europe/Berlin 0 18 * * *": contracts.CronCatchUpPolicy_FIRE_ONCE,
+	}))
+	require.NoError(t, err)
+
+	// skipping is the default, so it is not stored and doesn't change the checksum
+	assert.Equal(t, map[string]string{"CRON_TZ=Europe/Berlin 0 18 * * *": "FIRE_ONCE"}, opts.CronCatchUpPolicies)
+
+	opts, err = getCreateWorkflowOpts(cronOpts(nil))
+	require.NoError(t, err)
+	assert.Nil(t, opts.CronCatchUpPolicies)
+
+	_, err = getCreateWorkflowOpts(cronOpts(map[string]contracts.CronCatchUpPolicy{
+		"*/5 * * * *": contracts.CronCatchUpPolicy_FIRE_ONCE,
+	}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRunStep(t *testing.T) {
+	mq := &fakeMessageQueue{}
+	workflowRuns := &fakeWorkflowRunRepository{}
+
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			workflowRuns: workflowRuns,
+		},
+		mq: mq,
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	parents := `{"validate":{"amount":1999}}`
+	metadata := `{"backfill":"2024-12"}`
+
+	res, err := a.RunStep(ctx, &contracts.RunStepRequest{
+		Name:               "checkout",
+		Step:               "charge",
+		Input:              `{"order":"o-1"}`,
+		Parents:            &parents,
+		AdditionalMetadata: &metadata,
+	})
+	require.NoError(t, err)
+	require.Len(t, workflowRuns.created, 1)
+	assert.Len(t, mq.messages, 1)
+
+	// the run only gets a step run for the requested step, which runs with the given parent outputs
+	created := workflowRuns.created[0]
+	require.NotNil(t, created.PartialRun)
+	assert.NotEmpty(t, res.WorkflowRunId)
+
+	step, err := a.repo.Workflow().GetStepForWorkflowVersion(ctx, "", created.WorkflowVersionId, "charge")
+	require.NoError(t, err)
+
+	assert.Equal(t, sqlchelpers.UUIDToStr(step.ID), created.PartialRun.StepId)
+	assert.JSONEq(t, parents, string(created.PartialRun.ParentOutputs))
+	assert.JSONEq(t, `{"order":"o-1"}`, string(created.InputData))
+
+	// and is marked as partial in its metadata
+	assert.Equal(t, map[string]interface{}{
+		"backfill":                       "2024-12",
+		repository.PartialRunMetadataKey: "charge",
+	}, created.AdditionalMetadata)
+
+	_, err = a.RunStep(ctx, &contracts.RunStepRequest{
+		Name: "checkout",
+		Step: "refund",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	invalid := `[1, 2]`
+
+	_, err = a.RunStep(ctx, &contracts.RunStepRequest{
+		Name:    "checkout",
+		Step:    "charge",
+		Parents: &invalid,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTriggerWorkflowIdempotencyKey(t *testing.T) {
+	mq := &fakeMessageQueue{}
+	workflowRuns := &fakeWorkflowRunRepository{}
+
+	a := &AdminServiceImpl{
+		repo: &fakeEngineRepository{
+			workflowRuns: workflowRuns,
+		},
+		mq: mq,
+	}
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+
+	key := "order-1"
+	window := "1h"
+
+	res, err := a.TriggerWorkflow(ctx, &contracts.TriggerWorkflowRequest{
+		Name:              "workflow",
+		Input:             "{}",
+		IdempotencyKey:    &key,
+		IdempotencyWindow: &window,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, workflowRuns.created, 1)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *workflowRuns.created[0].IdempotencyKeyExpiresAt, time.Minute)
+
+	// a retry returns the original workflow run without queueing it again
+	retry, err := a.TriggerWorkflow(ctx, &contracts.TriggerWorkflowRequest{
+		Name:           "workflow",
+		Input:          "{}",
+		IdempotencyKey: &key,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, res.WorkflowRunId, retry.WorkflowRunId)
+	assert.Len(t, mq.messages, 1)
+
+	// the key doesn't return the run of a different workflow
+	_, err = a.TriggerWorkflow(ctx, &contracts.TriggerWorkflowRequest{
+		Name:           "other-workflow",
+		Input:          "{}",
+		IdempotencyKey: &key,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Len(t, mq.messages, 1)
+
+	invalidWindow := "forever"
+
+	_, err = a.TriggerWorkflow(ctx, &contracts.TriggerWorkflowRequest{
+		Name:              "workflow",
+		Input:             "{}",
+		IdempotencyKey:    &key,
+		IdempotencyWindow: &invalidWindow,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = a.BulkTriggerWorkflow(ctx, &contracts.BulkTriggerWorkflowRequest{
+		Workflows: []*contracts.TriggerWorkflowRequest{
+			{Name: "workflow", Input: "{}", IdempotencyKey: &key},
+		},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
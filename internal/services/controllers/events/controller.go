@@ -10,10 +10,12 @@ import (
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -29,8 +31,11 @@ type EventsControllerImpl struct {
 
 	entitlements repository.EntitlementsRepository
 
-	repo repository.EngineRepository
-	dv   datautils.DataDecoderValidator
+	repo      repository.EngineRepository
+	dv        datautils.DataDecoderValidator
+	celParser *cel.CELParser
+
+	maxBufferedOrderedEvents int
 }
 
 type EventsControllerOpt func(*EventsControllerOpts)
@@ -41,13 +46,20 @@ type EventsControllerOpts struct {
 	entitlements repository.EntitlementsRepository
 	repo         repository.EngineRepository
 	dv           datautils.DataDecoderValidator
+
+	maxBufferedOrderedEvents int
 }
 
+// defaultMaxBufferedOrderedEvents is the default number of pending events of an ordering key after which
+// a gap in the sequence numbers is skipped.
+const defaultMaxBufferedOrderedEvents = 1000
+
 func defaultEventsControllerOpts() *EventsControllerOpts {
 	logger := logger.NewDefaultLogger("events-controller")
 	return &EventsControllerOpts{
-		l:  &logger,
-		dv: datautils.NewDataDecoderValidator(),
+		l:                        &logger,
+		dv:                       datautils.NewDataDecoderValidator(),
+		maxBufferedOrderedEvents: defaultMaxBufferedOrderedEvents,
 	}
 }
 
@@ -81,6 +93,16 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) EventsControlle
 	}
 }
 
+// WithMaxBufferedOrderedEvents sets the number of pending events of an ordering key which are buffered while
+// waiting for a missing sequence number. Once the limit is reached, the missing sequence numbers are skipped.
+func WithMaxBufferedOrderedEvents(n int) EventsControllerOpt {
+	return func(opts *EventsControllerOpts) {
+		if n > 0 {
+			opts.maxBufferedOrderedEvents = n
+		}
+	}
+}
+
 func New(fs ...EventsControllerOpt) (*EventsControllerImpl, error) {
 	opts := defaultEventsControllerOpts()
 
@@ -109,6 +131,9 @@ func New(fs ...EventsControllerOpt) (*EventsControllerImpl, error) {
 		repo:         opts.repo,
 		entitlements: opts.entitlements,
 		dv:           opts.dv,
+		celParser:    cel.NewCELParser(),
+
+		maxBufferedOrderedEvents: opts.maxBufferedOrderedEvents,
 	}, nil
 }
 
@@ -149,6 +174,14 @@ func (ec *EventsControllerImpl) Start() (func() error, error) {
 }
 
 func (ec *EventsControllerImpl) handleTask(ctx context.Context, task *msgqueue.Message) error {
+	if task.ID == "ordered-event-release" {
+		return ec.handleOrderedEventRelease(ctx, task)
+	}
+
+	return ec.handleEvent(ctx, task)
+}
+
+func (ec *EventsControllerImpl) handleEvent(ctx context.Context, task *msgqueue.Message) error {
 	ctx, span := telemetry.NewSpanWithCarrier(ctx, "process-event", task.OtelCarrier)
 	defer span.End()
 
@@ -177,7 +210,115 @@ func (ec *EventsControllerImpl) handleTask(ctx context.Context, task *msgqueue.M
 		}
 	}
 
-	return ec.processEvent(ctx, metadata.TenantId, payload.EventId, payload.EventKey, []byte(payload.EventData), additionalMetadata)
+	var producer *repository.Actor
+
+	if payload.ProducerType != "" && payload.ProducerId != "" {
+		producer = &repository.Actor{
+			Type: repository.ActorType(payload.ProducerType),
+			Id:   payload.ProducerId,
+		}
+	}
+
+	// ordered events are buffered until all earlier events of their ordering key have been processed, so
+	// this may process a different event of the same key, or none at all. Releasing an ordered event only
+	// happens once, so redelivered ordered events don't trigger their workflow runs again.
+	if payload.OrderingKey != "" {
+		return ec.processOrderedEvents(ctx, metadata.TenantId, payload.OrderingKey, nil, payload.EventId, producer, payload.Priority)
+	}
+
+	if payload.Redelivered {
+		hasRuns, err := ec.repo.Event().HasWorkflowRuns(ctx, metadata.TenantId, payload.EventId)
+
+		if err != nil {
+			return fmt.Errorf("could not check workflow runs of redelivered event: %w", err)
+		}
+
+		if hasRuns {
+			ec.l.Debug().Msgf("skipping redelivered event %s, which has already triggered workflow runs", payload.EventId)
+			return nil
+		}
+	}
+
+	return ec.processEvent(ctx, metadata.TenantId, payload.EventId, payload.EventKey, []byte(payload.EventData), additionalMetadata, producer, payload.Priority)
+}
+
+func (ec *EventsControllerImpl) handleOrderedEventRelease(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "release-ordered-event", task.OtelCarrier)
+	defer span.End()
+
+	payload := tasktypes.OrderedEventReleaseTaskPayload{}
+	metadata := tasktypes.OrderedEventReleaseTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode task metadata: %w", err)
+	}
+
+	return ec.processOrderedEvents(ctx, metadata.TenantId, payload.OrderingKey, payload.ReleaseSequence, "", nil, nil)
+}
+
+// processOrderedEvents releases and processes the next event of the ordering key. Events which don't trigger
+// any workflow runs, or whose workflow runs have already finished, are complete right away, so the following
+// events are processed as well. If releaseSequence is set, the first release only happens if no other event of
+// the key was released in the meantime. The producer and the priority are only known for the event of the task,
+// given by producerEventId.
+func (ec *EventsControllerImpl) processOrderedEvents(ctx context.Context, tenantId, orderingKey string, releaseSequence *int64, producerEventId string, producer *repository.Actor, priority *int32) error {
+	for {
+		event, err := ec.repo.Event().ReleaseOrderedEvent(ctx, tenantId, orderingKey, releaseSequence, ec.maxBufferedOrderedEvents)
+
+		if err != nil {
+			return fmt.Errorf("could not release ordered event: %w", err)
+		}
+
+		if event == nil {
+			return nil
+		}
+
+		eventId := sqlchelpers.UUIDToStr(event.ID)
+
+		var additionalMetadata map[string]interface{}
+
+		if len(event.AdditionalMetadata) > 0 {
+			err = json.Unmarshal(event.AdditionalMetadata, &additionalMetadata)
+
+			if err != nil {
+				return fmt.Errorf("could not unmarshal additional metadata: %w", err)
+			}
+		}
+
+		var eventProducer *repository.Actor
+		var eventPriority *int32
+
+		if eventId == producerEventId {
+			eventProducer = producer
+			eventPriority = priority
+		}
+
+		err = ec.processEvent(ctx, tenantId, eventId, event.Key, event.Data, additionalMetadata, eventProducer, eventPriority)
+
+		if err != nil {
+			return err
+		}
+
+		next, err := ec.repo.Event().MarkOrderedEventTriggered(ctx, tenantId, eventId)
+
+		if err != nil {
+			return err
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		releaseSequence = &next.ReleaseSequence
+	}
 }
 
 func cleanAdditionalMetadata(additionalMetadata map[string]interface{}) map[string]interface{} {
@@ -193,7 +334,7 @@ func cleanAdditionalMetadata(additionalMetadata map[string]interface{}) map[stri
 	return additionalMetadata
 }
 
-func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, eventId, eventKey string, data []byte, additionalMetadata map[string]interface{}) error {
+func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, eventId, eventKey string, data []byte, additionalMetadata map[string]interface{}, producer *repository.Actor, priority *int32) error {
 	additionalMetadata = cleanAdditionalMetadata(additionalMetadata)
 
 	additionalMetadata["hatchet__event_id"] = eventId
@@ -207,16 +348,53 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, even
 		return fmt.Errorf("could not query workflows for event: %w", err)
 	}
 
+	// the event payload is only decoded if a trigger has a filter expression
+	var filterInput cel.Input
+
 	// create a new workflow run in the database
 	var g = new(errgroup.Group)
 
 	for _, workflowVersion := range workflowVersions {
 		workflowCp := workflowVersion
 
+		if workflowCp.FilterExpression != nil {
+			if filterInput == nil {
+				filterInput = getFilterInput(eventKey, data, additionalMetadata)
+			}
+
+			matches, err := ec.celParser.ParseAndEvalEventFilter(*workflowCp.FilterExpression, filterInput)
+
+			if err != nil {
+				// the expression compiled when the workflow was registered, so this is usually a field which
+				// is missing on the event, which the filter should check with has()
+				ec.l.Warn().Err(err).Str("tenant_id", tenantId).Msgf("could not evaluate filter of workflow %s for event %s, skipping", workflowCp.WorkflowName, eventId)
+				metrics.EventFilterErrors.WithLabelValues(tenantId, workflowCp.WorkflowName).Inc()
+				continue
+			}
+
+			if !matches {
+				continue
+			}
+		}
+
 		g.Go(func() error {
+			// the triggers of the latest version decide whether the event starts a run, and the version weights
+			// of the workflow decide which version the run uses
+			workflowVersion, err := ec.repo.Workflow().RouteWorkflowVersion(ctx, tenantId, workflowCp.GetWorkflowVersionForEngineRow)
+
+			if err != nil {
+				return fmt.Errorf("could not route workflow version: %w", err)
+			}
 
 			// create a new workflow run in the database
-			createOpts, err := repository.GetCreateWorkflowRunOptsFromEvent(eventId, workflowCp, data, additionalMetadata)
+			createOpts, err := repository.GetCreateWorkflowRunOptsFromEvent(
+				eventId,
+				workflowVersion,
+				data,
+				additionalMetadata,
+				repository.WithActor(producer),
+				repository.WithPriority(priority),
+			)
 
 			if err != nil {
 				return fmt.Errorf("could not get create workflow run opts: %w", err)
@@ -254,3 +432,19 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, even
 
 	return nil
 }
+
+// getFilterInput returns the input for event filter expressions. If the payload is not a JSON object, input
+// is empty, so any expression which references a field of the payload will not match.
+func getFilterInput(eventKey string, data []byte, additionalMetadata map[string]interface{}) cel.Input {
+	payload := map[string]interface{}{}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		payload = map[string]interface{}{}
+	}
+
+	return cel.NewInput(
+		cel.WithInput(payload),
+		cel.WithAdditionalMetadata(additionalMetadata),
+		cel.WithEventKey(eventKey),
+	)
+}
@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// retryBackoff returns the delay before the given retry of the step run, where the first retry is 1, and
+// whether the step retries with a backoff at all.
+func retryBackoff(stepRun *dbsqlc.GetStepRunForEngineRow, retryCount int) (time.Duration, bool) {
+	if !stepRun.StepRetryMaxBackoff.Valid || !stepRun.StepRetryBackoffFactor.Valid {
+		return 0, false
+	}
+
+	var baseMs *int32
+
+	if stepRun.StepRetryBackoffBase.Valid {
+		baseMs = &stepRun.StepRetryBackoffBase.Int32
+	}
+
+	dur := backoffDuration(
+		stepRun.StepRetryBackoffFactor.Float64,
+		int(stepRun.StepRetryMaxBackoff.Int32),
+		baseMs,
+		retryCount,
+	)
+
+	// jitter spreads out the retries of step runs which failed at the same time
+	if stepRun.StepRetryBackoffJitter.Valid && stepRun.StepRetryBackoffJitter.Float64 > 0 {
+		dur = withJitter(dur, stepRun.StepRetryBackoffJitter.Float64, rand.Float64()) // nolint: gosec
+	}
+
+	return dur, true
+}
+
+// backoffDuration returns min(maxSeconds, factor^retryCount) seconds if there is no base delay, and
+// min(maxSeconds, base * factor^(retryCount - 1)) otherwise.
+func backoffDuration(factor float64, maxSeconds int, baseMs *int32, retryCount int) time.Duration {
+	maxMilliseconds := 1000 * float64(maxSeconds)
+
+	durationMilliseconds := 1000 * math.Pow(factor, float64(retryCount))
+
+	if baseMs != nil {
+		durationMilliseconds = float64(*baseMs) * math.Pow(factor, float64(retryCount-1))
+	}
+
+	return time.Duration(int(min(maxMilliseconds, durationMilliseconds))) * time.Millisecond
+}
+
+// withJitter shortens the delay by up to the jitter fraction of it, where r is a random number in [0, 1).
+func withJitter(dur time.Duration, jitter float64, r float64) time.Duration {
+	return dur - time.Duration(float64(dur)*min(jitter, 1)*r)
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffDuration(t *testing.T) {
+	// without a base delay, the delay is factor^retryCount seconds
+	assert.Equal(t, 2*time.Second, backoffDuration(2, 60, nil, 1))
+	assert.Equal(t, 8*time.Second, backoffDuration(2, 60, nil, 3))
+	assert.Equal(t, 60*time.Second, backoffDuration(2, 60, nil, 10))
+
+	// with a base delay, the first retry waits for the base delay
+	base := int32(200)
+
+	assert.Equal(t, 200*time.Millisecond, backoffDuration(2, 60, &base, 1))
+	assert.Equal(t, 800*time.Millisecond, backoffDuration(2, 60, &base, 3))
+	assert.Equal(t, 60*time.Second, backoffDuration(2, 60, &base, 20))
+}
+
+func TestWithJitter(t *testing.T) {
+	assert.Equal(t, 10*time.Second, withJitter(10*time.Second, 0.5, 0))
+	assert.Equal(t, 7500*time.Millisecond, withJitter(10*time.Second, 0.5, 0.5))
+	assert.Equal(t, 2*time.Second, withJitter(10*time.Second, 1, 0.8))
+}
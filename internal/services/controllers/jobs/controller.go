@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"sync"
 	"time"
 
@@ -12,17 +11,20 @@ import (
 	"github.com/goccy/go-json"
 	"github.com/hashicorp/go-multierror"
 	"github.com/rs/zerolog"
+	telemetry_codes "go.opentelemetry.io/otel/codes"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/datautils/merge"
+	"github.com/hatchet-dev/hatchet/internal/integrations/runwebhooks"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/recoveryutils"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
 	"github.com/hatchet-dev/hatchet/internal/telemetry/servertel"
 	"github.com/hatchet-dev/hatchet/pkg/config/shared"
 	hatcheterrors "github.com/hatchet-dev/hatchet/pkg/errors"
@@ -47,6 +49,7 @@ type JobsControllerImpl struct {
 	a              *hatcheterrors.Wrapped
 	p              *partition.Partition
 	celParser      *cel.CELParser
+	runWebhooks    *runwebhooks.Emitter
 
 	reassignMutexes sync.Map
 }
@@ -172,6 +175,7 @@ func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 		a:              a,
 		p:              opts.p,
 		celParser:      cel.NewCELParser(),
+		runWebhooks:    runwebhooks.NewEmitter(opts.repo, opts.l),
 	}, nil
 }
 
@@ -295,6 +299,8 @@ func (ec *JobsControllerImpl) handleTask(ctx context.Context, task *msgqueue.Mes
 		return ec.handleStepRunFinished(ctx, task)
 	case "step-run-failed":
 		return ec.handleStepRunFailed(ctx, task)
+	case "step-run-suspended":
+		return ec.handleStepRunSuspended(ctx, task)
 	case "step-run-cancel":
 		return ec.handleStepRunCancel(ctx, task)
 	case "step-run-timed-out":
@@ -450,13 +456,7 @@ func (ec *JobsControllerImpl) handleStepRunRetry(ctx context.Context, task *msgq
 	eventMessage := fmt.Sprintf("Retrying step run. This is retry %d / %d", retryCount, stepRun.StepRetries)
 	var retryAfter *time.Time
 
-	if stepRun.StepRetryMaxBackoff.Valid && stepRun.StepRetryBackoffFactor.Valid {
-		maxBackoffSeconds := int(stepRun.StepRetryMaxBackoff.Int32)
-		backoffFactor := stepRun.StepRetryBackoffFactor.Float64
-
-		// compute the backoff duration
-		durationMilliseconds := 1000 * min(float64(maxBackoffSeconds), math.Pow(backoffFactor, float64(retryCount)))
-		retryDur := time.Duration(int(durationMilliseconds)) * time.Millisecond
+	if retryDur, ok := retryBackoff(stepRun, retryCount); ok {
 		retryTime := time.Now().Add(retryDur)
 		retryAfter = &retryTime
 
@@ -556,6 +556,17 @@ func (ec *JobsControllerImpl) handleStepRunReplay(ctx context.Context, task *msg
 					return fmt.Errorf("could not marshal merged input: %w", err)
 				}
 
+				inputBytes = mergedInputBytes
+			} else if ok1 {
+				// keep the values recorded by the step run if the new input has no overrides
+				inputMap["overrides"] = currentInputOverridesMap
+
+				mergedInputBytes, err := json.Marshal(inputMap)
+
+				if err != nil {
+					return fmt.Errorf("could not marshal merged input: %w", err)
+				}
+
 				inputBytes = mergedInputBytes
 			}
 		}
@@ -669,8 +680,9 @@ func (jc *JobsControllerImpl) runStepRunReassign(ctx context.Context, startedAt
 	}
 }
 
-// runStepRunReassignTenant looks for step runs that have been assigned to a worker but have not started,
-// or have been running but the worker has become inactive.
+// runStepRunReassignTenant marks workers which stopped sending heartbeats as inactive, and looks for step runs
+// that have been assigned to a worker but have not started, or have been running but the worker has become
+// inactive.
 func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tenantId string) error {
 	// we want only one requeue running at a time for a tenant
 	if _, ok := ec.reassignMutexes.Load(tenantId); !ok {
@@ -689,6 +701,11 @@ func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tena
 	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-reassign")
 	defer span.End()
 
+	// the step runs are reassigned regardless, since they only depend on the heartbeats of the workers
+	if err := ec.deactivateStaleWorkers(ctx, tenantId); err != nil {
+		ec.l.Err(err).Msgf("could not deactivate stale workers for tenant %s", tenantId)
+	}
+
 	_, stepRunsToFail, err := ec.repo.StepRun().ListStepRunsToReassign(ctx, tenantId)
 
 	if err != nil {
@@ -704,7 +721,9 @@ func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tena
 				tenantId,
 				sqlchelpers.UUIDToStr(stepRun.SRID),
 				"Worker has become inactive, and we exhausted all retries.",
+				nil,
 				time.Now(),
+				false,
 			)
 
 			if err != nil {
@@ -716,6 +735,30 @@ func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tena
 	})
 }
 
+// deactivateStaleWorkers marks the workers which haven't sent a heartbeat within the heartbeat timeout as
+// inactive, so they aren't assigned new step runs until they reconnect, and queues worker.down webhooks.
+func (ec *JobsControllerImpl) deactivateStaleWorkers(ctx context.Context, tenantId string) error {
+	workers, err := ec.repo.Worker().DeactivateStaleWorkers(ctx, tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	downAt := time.Now().UTC()
+
+	for _, worker := range workers {
+		workerId := sqlchelpers.UUIDToStr(worker.ID)
+
+		ec.l.Warn().Msgf("worker %s (%s) of tenant %s missed its heartbeats since %s, marking it as inactive", workerId, worker.Name, tenantId, worker.LastHeartbeatAt.Time.Format(time.RFC3339))
+
+		if err := ec.runWebhooks.WorkerDown(ctx, tenantId, worker, downAt); err != nil {
+			ec.l.Err(err).Msgf("could not queue worker.down webhooks for worker %s", workerId)
+		}
+	}
+
+	return nil
+}
+
 func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId, stepRunId string, isRetry bool) error {
 	ctx, span := telemetry.NewSpan(ctx, "queue-step-run")
 	defer span.End()
@@ -833,11 +876,11 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 
 			// if we encounter an error here, the step run should fail with this error
 			if err != nil {
-				return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse step expression: %s", err.Error()), time.Now())
+				return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse step expression: %s", err.Error()), nil, time.Now(), false)
 			}
 
 			if err := ec.celParser.CheckStepRunOutAgainstKnown(res, expression.Kind); err != nil {
-				return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse step expression: %s", err.Error()), time.Now())
+				return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse step expression: %s", err.Error()), nil, time.Now(), false)
 			}
 
 			// set the evaluated expression in queueOpts
@@ -850,18 +893,29 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 		}
 	}
 
+	// the enqueue span is part of the trace of the client which triggered the workflow run
+	_, enqueueSpan := telemetry.NewSpanWithAdditionalMetadata(ctx, "step-run-enqueued", data.AdditionalMetadata)
+	servertel.WithStepRunModel(enqueueSpan, stepRun)
+
 	// indicate that the step run is pending assignment
 	sr, err := ec.repo.StepRun().QueueStepRun(ctx, tenantId, stepRunId, queueOpts)
 
 	if err != nil {
 		if errors.Is(err, repository.ErrAlreadyQueued) {
+			enqueueSpan.End()
 			ec.l.Debug().Msgf("step run %s is already queued, skipping scheduling", stepRunId)
 			return nil
 		}
 
+		enqueueSpan.RecordError(err)
+		enqueueSpan.SetStatus(telemetry_codes.Error, "could not queue step run")
+		enqueueSpan.End()
+
 		return ec.a.WrapErr(fmt.Errorf("could not update step run: %w", err), errData)
 	}
 
+	enqueueSpan.End()
+
 	defer ec.checkTenantQueue(ctx, tenantId, sr.SRQueue, true, false)
 
 	return nil
@@ -933,6 +987,13 @@ func (ec *JobsControllerImpl) handleStepRunStarted(ctx context.Context, task *ms
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
+	err = ec.runWebhooks.RunStarted(ctx, metadata.TenantId, payload.WorkflowRunId)
+
+	if err != nil {
+		// this is not a fatal error
+		ec.l.Err(err).Msgf("could not queue run.started webhooks for workflow run %s", payload.WorkflowRunId)
+	}
+
 	return nil
 }
 
@@ -1034,6 +1095,8 @@ func (ec *JobsControllerImpl) handleStepRunFinished(ctx context.Context, task *m
 		return fmt.Errorf("could not get step run: %w", err)
 	}
 
+	metrics.ObserveStepRunDuration(metadata.TenantId, "succeeded", sr.SRStartedAt, finishedAt)
+
 	ec.checkTenantQueue(ctx, metadata.TenantId, sr.SRQueue, false, true)
 
 	return nil
@@ -1063,10 +1126,68 @@ func (ec *JobsControllerImpl) handleStepRunFailed(ctx context.Context, task *msg
 		return fmt.Errorf("could not parse failed at: %w", err)
 	}
 
-	return ec.failStepRun(ctx, metadata.TenantId, payload.StepRunId, payload.Error, failedAt)
+	shouldNotRetry := payload.ShouldNotRetry != nil && *payload.ShouldNotRetry
+
+	var errDetails *repository.StepRunErrorDetails
+
+	if payload.ErrorCode != nil {
+		errDetails = &repository.StepRunErrorDetails{
+			Code:    *payload.ErrorCode,
+			Details: payload.ErrorDetails,
+		}
+	}
+
+	return ec.failStepRun(ctx, metadata.TenantId, payload.StepRunId, payload.Error, errDetails, failedAt, shouldNotRetry)
 }
 
-func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRunId, errorReason string, failedAt time.Time) error {
+// handleStepRunSuspended queues a step run which was suspended by its worker, for example because it is
+// sleeping, to be started again once it should resume. The worker already released the slot of the step run.
+func (ec *JobsControllerImpl) handleStepRunSuspended(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-suspended", task.OtelCarrier)
+	defer span.End()
+
+	payload := tasktypes.StepRunSuspendedTaskPayload{}
+	metadata := tasktypes.StepRunSuspendedTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run suspended task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run suspended task metadata: %w", err)
+	}
+
+	resumeAt, err := time.Parse(time.RFC3339Nano, payload.ResumeAt)
+
+	if err != nil {
+		return fmt.Errorf("could not parse resume at: %w", err)
+	}
+
+	stepRun, err := ec.repo.StepRun().GetStepRunForEngine(ctx, metadata.TenantId, payload.StepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run: %w", err)
+	}
+
+	// the released slot may be used by another step run
+	defer ec.checkTenantQueue(ctx, metadata.TenantId, stepRun.SRQueue, false, true)
+
+	defer ec.repo.StepRun().DeferredStepRunEvent(metadata.TenantId, repository.CreateStepRunEventOpts{
+		StepRunId:   payload.StepRunId,
+		EventReason: repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSLOTRELEASED),
+		EventMessage: repository.StringPtr(
+			fmt.Sprintf("Step run is sleeping until %s.", resumeAt.Format(time.RFC1123)),
+		),
+	})
+
+	return ec.repo.StepRun().StepRunSuspended(ctx, metadata.TenantId, payload.StepRunId, resumeAt)
+}
+
+func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRunId, errorReason string, errDetails *repository.StepRunErrorDetails, failedAt time.Time, shouldNotRetry bool) error {
 	oldStepRun, err := ec.repo.StepRun().GetStepRunForEngine(ctx, tenantId, stepRunId)
 
 	if err != nil {
@@ -1076,8 +1197,11 @@ func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRun
 	// check the queue on failure
 	defer ec.checkTenantQueue(ctx, tenantId, oldStepRun.SRQueue, false, true)
 
-	// determine if step run should be retried or not
-	shouldRetry := oldStepRun.SRRetryCount < oldStepRun.StepRetries
+	metrics.ObserveStepRunDuration(tenantId, "failed", oldStepRun.SRStartedAt, failedAt)
+
+	// determine if step run should be retried or not. The worker may opt out of retries, for example when
+	// the next retry would start after the step's deadline.
+	shouldRetry := !shouldNotRetry && oldStepRun.SRRetryCount < oldStepRun.StepRetries
 
 	if shouldRetry {
 		eventMessage := fmt.Sprintf("Step run failed on %s", failedAt.Format(time.RFC1123))
@@ -1091,14 +1215,15 @@ func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRun
 
 		eventMessage += ", and will be retried."
 
+		eventData := errDetails.EventData()
+		eventData["retry_count"] = oldStepRun.SRRetryCount
+
 		defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
 			StepRunId:     stepRunId,
 			EventReason:   repository.StepRunEventReasonPtr(eventReason),
 			EventMessage:  repository.StringPtr(eventMessage),
 			EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityCRITICAL),
-			EventData: map[string]interface{}{
-				"retry_count": oldStepRun.SRRetryCount,
-			},
+			EventData:     eventData,
 		})
 
 		// send a task to the taskqueue
@@ -1110,16 +1235,30 @@ func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRun
 	}
 
 	// fail step run
-	err = ec.repo.StepRun().StepRunFailed(ctx, tenantId, sqlchelpers.UUIDToStr(oldStepRun.WorkflowRunId), stepRunId, failedAt, errorReason, int(oldStepRun.SRRetryCount))
+	err = ec.repo.StepRun().StepRunFailed(ctx, tenantId, sqlchelpers.UUIDToStr(oldStepRun.WorkflowRunId), stepRunId, failedAt, errorReason, errDetails, int(oldStepRun.SRRetryCount))
 
 	if err != nil {
 		return fmt.Errorf("could not fail step run: %w", err)
 	}
 
+	err = ec.runWebhooks.StepFailed(ctx, tenantId, oldStepRun, errorReason, failedAt)
+
+	if err != nil {
+		// this is not a fatal error
+		ec.l.Err(err).Msgf("[failStepRun] could not queue step.failed webhooks for step run %s", stepRunId)
+	}
+
 	attemptCancel := false
 
 	if errorReason == "TIMED_OUT" {
 		attemptCancel = true
+
+		err = ec.cancelChildWorkflowRuns(ctx, tenantId, sqlchelpers.UUIDToStr(oldStepRun.WorkflowRunId), stepRunId)
+
+		if err != nil {
+			// this is not a fatal error
+			ec.l.Err(err).Msgf("[failStepRun] could not cancel child workflow runs of step run %s", stepRunId)
+		}
 	}
 
 	if !oldStepRun.SRWorkerId.Valid {
@@ -1186,7 +1325,7 @@ func (ec *JobsControllerImpl) handleStepRunTimedOut(ctx context.Context, task *m
 		return fmt.Errorf("could not decode step run timed out task metadata: %w", err)
 	}
 
-	return ec.failStepRun(ctx, metadata.TenantId, payload.StepRunId, "TIMED_OUT", time.Now().UTC())
+	return ec.failStepRun(ctx, metadata.TenantId, payload.StepRunId, "TIMED_OUT", nil, time.Now().UTC(), false)
 }
 
 func (ec *JobsControllerImpl) handleStepRunCancel(ctx context.Context, task *msgqueue.Message) error {
@@ -1231,6 +1370,13 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 		return fmt.Errorf("could not cancel step run: %w", err)
 	}
 
+	err = ec.cancelChildWorkflowRuns(ctx, tenantId, sqlchelpers.UUIDToStr(oldStepRun.WorkflowRunId), stepRunId)
+
+	if err != nil {
+		// this is not a fatal error
+		ec.l.Err(err).Msgf("[cancelStepRun] could not cancel child workflow runs of step run %s", stepRunId)
+	}
+
 	if !oldStepRun.SRWorkerId.Valid {
 		// this is not a fatal error
 		ec.l.Debug().Msgf("[cancelStepRun] step run %s has no worker id, skipping send of cancellation", stepRunId)
@@ -1267,6 +1413,66 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 	return nil
 }
 
+// parentCancelledReason is the reason set on step runs of child workflow runs which were cancelled
+// because their parent step run was cancelled or timed out.
+const parentCancelledReason = "PARENT_CANCELLED"
+
+// cancelChildWorkflowRuns cancels the child workflow runs spawned by the step run which were not spawned
+// as detached. The step runs of each child cancel their own children in turn, so the cancellation
+// propagates down the tree of child workflow runs. A parent is always created before its children and
+// the parent links never change, so the tree cannot contain cycles.
+func (ec *JobsControllerImpl) cancelChildWorkflowRuns(ctx context.Context, tenantId, workflowRunId, stepRunId string) error {
+	childWorkflowRunIds, err := ec.repo.WorkflowRun().ListChildWorkflowRunsToCancel(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not list child workflow runs: %w", err)
+	}
+
+	var returnErr error
+
+	for _, childWorkflowRunId := range childWorkflowRunIds {
+		// guard against a run which references itself as its parent
+		if childWorkflowRunId == workflowRunId {
+			continue
+		}
+
+		childWorkflowRun, err := ec.repo.WorkflowRun().GetWorkflowRunById(ctx, tenantId, childWorkflowRunId)
+
+		if err != nil {
+			returnErr = multierror.Append(returnErr, fmt.Errorf("could not get child workflow run %s: %w", childWorkflowRunId, err))
+			continue
+		}
+
+		jobRuns, err := ec.repo.JobRun().GetJobRunsByWorkflowRunId(ctx, tenantId, childWorkflowRunId)
+
+		if err != nil {
+			returnErr = multierror.Append(returnErr, fmt.Errorf("could not get job runs of child workflow run %s: %w", childWorkflowRunId, err))
+			continue
+		}
+
+		reason := parentCancelledReason
+
+		for _, jobRun := range jobRuns {
+			// don't cancel the on failure job of the child
+			if childWorkflowRun.WorkflowVersion.OnFailureJobId.Valid && jobRun.JobId == childWorkflowRun.WorkflowVersion.OnFailureJobId {
+				continue
+			}
+
+			err := ec.mq.AddMessage(
+				ctx,
+				msgqueue.JOB_PROCESSING_QUEUE,
+				tasktypes.JobRunCancelledToTask(tenantId, sqlchelpers.UUIDToStr(jobRun.ID), &reason),
+			)
+
+			if err != nil {
+				returnErr = multierror.Append(returnErr, fmt.Errorf("could not add job run cancelled task to task queue: %w", err))
+			}
+		}
+	}
+
+	return returnErr
+}
+
 func stepRunCancelledTask(tenantId, stepRunId, workerId, dispatcherId, cancelledReason string, runId string, retries *int32, retryCount *int32) *msgqueue.Message {
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunCancelledTaskPayload{
 		WorkflowRunId:   runId,
@@ -0,0 +1,282 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/runwebhooks"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type fakeEngineRepository struct {
+	repository.EngineRepository
+
+	workflowRuns *fakeWorkflowRunRepository
+	jobRuns      *fakeJobRunRepository
+	stepRuns     *fakeStepRunRepository
+}
+
+func (r *fakeEngineRepository) WorkflowRun() repository.WorkflowRunEngineRepository {
+	return r.workflowRuns
+}
+
+func (r *fakeEngineRepository) JobRun() repository.JobRunEngineRepository {
+	return r.jobRuns
+}
+
+func (r *fakeEngineRepository) StepRun() repository.StepRunEngineRepository {
+	return r.stepRuns
+}
+
+func (r *fakeEngineRepository) Tenant() repository.TenantEngineRepository {
+	return &fakeTenantRepository{}
+}
+
+func (r *fakeEngineRepository) WebhookSubscription() repository.WebhookSubscriptionEngineRepository {
+	return &fakeWebhookSubscriptionRepository{}
+}
+
+// fakeTenantRepository returns tenants without partitions, so checking the queue of a tenant sends no messages.
+type fakeTenantRepository struct {
+	repository.TenantEngineRepository
+}
+
+func (r *fakeTenantRepository) GetTenantByID(ctx context.Context, tenantId string) (*dbsqlc.Tenant, error) {
+	return &dbsqlc.Tenant{ID: sqlchelpers.UUIDFromStr(tenantId)}, nil
+}
+
+type fakeWebhookSubscriptionRepository struct {
+	repository.WebhookSubscriptionEngineRepository
+}
+
+func (r *fakeWebhookSubscriptionRepository) HasWebhookSubscriptions(ctx context.Context, tenantId string, eventType string) (bool, error) {
+	return false, nil
+}
+
+type fakeStepRunRepository struct {
+	repository.StepRunEngineRepository
+
+	stepRun *dbsqlc.GetStepRunForEngineRow
+
+	// the retry counts which the step run failed with for good, which dead-letters it
+	failedRetryCounts []int
+}
+
+func (r *fakeStepRunRepository) GetStepRunForEngine(ctx context.Context, tenantId, stepRunId string) (*dbsqlc.GetStepRunForEngineRow, error) {
+	return r.stepRun, nil
+}
+
+func (r *fakeStepRunRepository) StepRunFailed(ctx context.Context, tenantId, workflowRunId, stepRunId string, failedAt time.Time, errStr string, errDetails *repository.StepRunErrorDetails, retryCount int) error {
+	r.failedRetryCounts = append(r.failedRetryCounts, retryCount)
+	return nil
+}
+
+func (r *fakeStepRunRepository) DeferredStepRunEvent(tenantId string, opts repository.CreateStepRunEventOpts) {
+}
+
+type fakeChildWorkflowRun struct {
+	id       string
+	detached bool
+}
+
+type fakeWorkflowRunRepository struct {
+	repository.WorkflowRunEngineRepository
+
+	// the child workflow runs spawned by each step run
+	children map[string][]fakeChildWorkflowRun
+
+	// the on-failure job of the workflow runs, if any
+	onFailureJobId string
+}
+
+// ListChildWorkflowRunsToCancel skips detached children, like the query of the repository.
+func (r *fakeWorkflowRunRepository) ListChildWorkflowRunsToCancel(ctx context.Context, tenantId, parentStepRunId string) ([]string, error) {
+	ids := []string{}
+
+	for _, child := range r.children[parentStepRunId] {
+		if !child.detached {
+			ids = append(ids, child.id)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *fakeWorkflowRunRepository) GetWorkflowRunById(ctx context.Context, tenantId, runId string) (*dbsqlc.GetWorkflowRunRow, error) {
+	row := &dbsqlc.GetWorkflowRunRow{
+		WorkflowRun: dbsqlc.WorkflowRun{
+			ID: sqlchelpers.UUIDFromStr(runId),
+		},
+	}
+
+	if r.onFailureJobId != "" {
+		row.WorkflowVersion.OnFailureJobId = sqlchelpers.UUIDFromStr(r.onFailureJobId)
+	}
+
+	return row, nil
+}
+
+type fakeJobRunRepository struct {
+	repository.JobRunEngineRepository
+
+	// the ids of the jobs which have a job run in every workflow run
+	jobIds []string
+}
+
+// GetJobRunsByWorkflowRunId returns a job run of each job, whose id is derived from the workflow run id.
+func (r *fakeJobRunRepository) GetJobRunsByWorkflowRunId(ctx context.Context, tenantId, workflowRunId string) ([]*dbsqlc.GetJobRunsByWorkflowRunIdRow, error) {
+	rows := make([]*dbsqlc.GetJobRunsByWorkflowRunIdRow, 0, len(r.jobIds))
+
+	for _, jobId := range r.jobIds {
+		rows = append(rows, &dbsqlc.GetJobRunsByWorkflowRunIdRow{
+			ID:     sqlchelpers.UUIDFromStr(testJobRunId(workflowRunId, jobId)),
+			JobId:  sqlchelpers.UUIDFromStr(jobId),
+			Status: dbsqlc.JobRunStatusRUNNING,
+		})
+	}
+
+	return rows, nil
+}
+
+func testJobRunId(workflowRunId, jobId string) string {
+	return uuid.NewSHA1(uuid.MustParse(workflowRunId), []byte(jobId)).String()
+}
+
+type fakeMessageQueue struct {
+	msgqueue.MessageQueue
+
+	messages []*msgqueue.Message
+}
+
+func (mq *fakeMessageQueue) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	mq.messages = append(mq.messages, task)
+	return nil
+}
+
+func TestCancelChildWorkflowRuns(t *testing.T) {
+	l := zerolog.Nop()
+
+	tenantId := uuid.New().String()
+	workflowRunId := uuid.New().String()
+	stepRunId := uuid.New().String()
+
+	jobId := uuid.New().String()
+	onFailureJobId := uuid.New().String()
+
+	attached := uuid.New().String()
+	detached := uuid.New().String()
+
+	mq := &fakeMessageQueue{}
+
+	ec := &JobsControllerImpl{
+		mq: mq,
+		l:  &l,
+		repo: &fakeEngineRepository{
+			workflowRuns: &fakeWorkflowRunRepository{
+				children: map[string][]fakeChildWorkflowRun{
+					stepRunId: {
+						{id: attached},
+						{id: detached, detached: true},
+						// a run which references itself as its parent is never cancelled by itself
+						{id: workflowRunId},
+					},
+					uuid.New().String(): {
+						{id: uuid.New().String()},
+					},
+				},
+				onFailureJobId: onFailureJobId,
+			},
+			jobRuns: &fakeJobRunRepository{
+				jobIds: []string{jobId, onFailureJobId},
+			},
+		},
+	}
+
+	err := ec.cancelChildWorkflowRuns(context.Background(), tenantId, workflowRunId, stepRunId)
+	require.NoError(t, err)
+
+	// only the job run of the attached child is cancelled, and its on-failure job still runs
+	require.Len(t, mq.messages, 1)
+
+	msg := mq.messages[0]
+
+	assert.Equal(t, "job-run-cancelled", msg.ID)
+	assert.Equal(t, testJobRunId(attached, jobId), msg.Payload["job_run_id"])
+	assert.Equal(t, parentCancelledReason, msg.Payload["reason"])
+	assert.Equal(t, tenantId, msg.Metadata["tenant_id"])
+}
+
+func TestFailStepRun(t *testing.T) {
+	l := zerolog.Nop()
+
+	tenantId := uuid.New().String()
+	stepRunId := uuid.New().String()
+
+	for _, tc := range []struct {
+		name           string
+		retryCount     int32
+		shouldNotRetry bool
+		deadLettered   bool
+	}{
+		{
+			name:       "retries left",
+			retryCount: 1,
+		},
+		{
+			name:         "retries exhausted",
+			retryCount:   2,
+			deadLettered: true,
+		},
+		{
+			name:           "retries skipped by the worker",
+			retryCount:     0,
+			shouldNotRetry: true,
+			deadLettered:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mq := &fakeMessageQueue{}
+
+			stepRuns := &fakeStepRunRepository{
+				stepRun: &dbsqlc.GetStepRunForEngineRow{
+					SRID:          sqlchelpers.UUIDFromStr(stepRunId),
+					WorkflowRunId: sqlchelpers.UUIDFromStr(uuid.New().String()),
+					SRRetryCount:  tc.retryCount,
+					StepRetries:   2,
+				},
+			}
+
+			repo := &fakeEngineRepository{stepRuns: stepRuns}
+
+			ec := &JobsControllerImpl{
+				mq:          mq,
+				l:           &l,
+				repo:        repo,
+				runWebhooks: runwebhooks.NewEmitter(repo, &l),
+			}
+
+			err := ec.failStepRun(context.Background(), tenantId, stepRunId, "boom", nil, time.Now().UTC(), tc.shouldNotRetry)
+			require.NoError(t, err)
+
+			if tc.deadLettered {
+				// the step run fails for good, which dead-letters it
+				assert.Equal(t, []int{int(tc.retryCount)}, stepRuns.failedRetryCounts)
+				assert.Empty(t, mq.messages)
+			} else {
+				// the step run is retried rather than dead-lettered
+				assert.Empty(t, stepRuns.failedRetryCounts)
+				require.Len(t, mq.messages, 1)
+				assert.Equal(t, "step-run-retry", mq.messages[0].ID)
+			}
+		})
+	}
+}
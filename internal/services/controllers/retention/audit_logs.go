@@ -0,0 +1,65 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runDeleteExpiredAuditLogs(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: deleting expired audit logs")
+
+		err := rc.ForTenants(ctx, rc.runDeleteExpiredAuditLogsTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run delete expired audit logs")
+		}
+	}
+}
+
+func (wc *RetentionControllerImpl) runDeleteExpiredAuditLogsTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-expired-audit-logs")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	// audit logs have their own retention period, which is usually longer than the data retention period
+	retentionPeriod, err := wc.repo.AuditLog().GetAuditLogRetentionPeriod(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not get audit log retention period: %w", err)
+	}
+
+	createdBefore, err := GetDataRetentionExpiredTime(retentionPeriod)
+
+	if err != nil {
+		return fmt.Errorf("could not get audit log retention expired time: %w", err)
+	}
+
+	// keep deleting until the context is done
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		hasMore, err := wc.repo.AuditLog().DeleteExpiredAuditLogs(ctx, tenantId, createdBefore)
+
+		if err != nil {
+			return fmt.Errorf("could not delete expired audit logs: %w", err)
+		}
+
+		if !hasMore {
+			return nil
+		}
+	}
+}
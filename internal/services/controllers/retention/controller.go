@@ -31,6 +31,8 @@ type RetentionControllerImpl struct {
 	dataRetention   bool
 	workerRetention bool
 	queueRetention  bool
+	dataPurge       bool
+	dataPurgeDelay  time.Duration
 }
 
 type RetentionControllerOpt func(*RetentionControllerOpts)
@@ -45,6 +47,8 @@ type RetentionControllerOpts struct {
 	dataRetention   bool
 	workerRetention bool
 	queueRetention  bool
+	dataPurge       bool
+	dataPurgeDelay  time.Duration
 }
 
 func defaultRetentionControllerOpts() *RetentionControllerOpts {
@@ -58,6 +62,8 @@ func defaultRetentionControllerOpts() *RetentionControllerOpts {
 		dataRetention:   true,
 		queueRetention:  true,
 		workerRetention: false,
+		dataPurge:       true,
+		dataPurgeDelay:  24 * time.Hour,
 	}
 }
 
@@ -109,6 +115,15 @@ func WithWorkerRetention(b bool) RetentionControllerOpt {
 	}
 }
 
+// WithDataPurge permanently deletes expired workflow runs and events once they were soft-deleted for at least
+// the given delay, and drops the table partitions which are empty afterwards. Requires data retention.
+func WithDataPurge(b bool, delay time.Duration) RetentionControllerOpt {
+	return func(opts *RetentionControllerOpts) {
+		opts.dataPurge = b
+		opts.dataPurgeDelay = delay
+	}
+}
+
 func New(fs ...RetentionControllerOpt) (*RetentionControllerImpl, error) {
 	opts := defaultRetentionControllerOpts()
 
@@ -151,6 +166,8 @@ func New(fs ...RetentionControllerOpt) (*RetentionControllerImpl, error) {
 		dataRetention:   opts.dataRetention,
 		workerRetention: opts.workerRetention,
 		queueRetention:  opts.queueRetention,
+		dataPurge:       opts.dataPurge,
+		dataPurgeDelay:  opts.dataPurgeDelay,
 	}, nil
 }
 
@@ -159,6 +176,21 @@ func (rc *RetentionControllerImpl) Start() (func() error, error) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	// rows can only be written to the partitioned tables when they have a partition, so partitions are always
+	// created, starting at startup
+	_, err := rc.s.NewJob(
+		gocron.DurationJob(time.Hour),
+		gocron.NewTask(
+			rc.runCreateTablePartitions(ctx),
+		),
+		gocron.WithStartAt(gocron.WithStartImmediately()),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not set up runCreateTablePartitions: %w", err)
+	}
+
 	if rc.dataRetention {
 		dataInterval := time.Second * 60 // run every 60 seconds
 
@@ -209,6 +241,80 @@ func (rc *RetentionControllerImpl) Start() (func() error, error) {
 			cancel()
 			return nil, fmt.Errorf("could not set up runDeleteExpiredJobRuns: %w", err)
 		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(time.Hour),
+			gocron.NewTask(
+				rc.runDeleteExpiredAuditLogs(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredAuditLogs: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(dataInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredWebhookDeliveries(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredWebhookDeliveries: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(dataInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredIdempotencyKeys(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredIdempotencyKeys: %w", err)
+		}
+
+		if rc.dataPurge {
+			_, err = rc.s.NewJob(
+				gocron.DurationJob(time.Hour),
+				gocron.NewTask(
+					rc.runDropEmptyTablePartitions(ctx),
+				),
+			)
+
+			if err != nil {
+				cancel()
+				return nil, fmt.Errorf("could not set up runDropEmptyTablePartitions: %w", err)
+			}
+
+			_, err = rc.s.NewJob(
+				gocron.DurationJob(dataInterval),
+				gocron.NewTask(
+					rc.runPurgeDeletedWorkflowRuns(ctx),
+				),
+			)
+
+			if err != nil {
+				cancel()
+				return nil, fmt.Errorf("could not set up runPurgeDeletedWorkflowRuns: %w", err)
+			}
+
+			_, err = rc.s.NewJob(
+				gocron.DurationJob(dataInterval),
+				gocron.NewTask(
+					rc.runPurgeDeletedEvents(ctx),
+				),
+			)
+
+			if err != nil {
+				cancel()
+				return nil, fmt.Errorf("could not set up runPurgeDeletedEvents: %w", err)
+			}
+		}
 	}
 
 	if rc.workerRetention {
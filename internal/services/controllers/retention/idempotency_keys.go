@@ -0,0 +1,72 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runDeleteExpiredIdempotencyKeys(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: deleting expired idempotency keys")
+
+		err := rc.ForTenants(ctx, rc.runDeleteExpiredIdempotencyKeysTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run delete expired idempotency keys")
+		}
+	}
+}
+
+// runDeleteExpiredIdempotencyKeysTenant deletes the workflow run idempotency keys and event external ids of
+// the tenant whose window has passed. Expired keys are already accepted again, so this only frees up space.
+func (rc *RetentionControllerImpl) runDeleteExpiredIdempotencyKeysTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-expired-idempotency-keys")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	// keep deleting until the context is done
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		hasMore, err := rc.repo.WorkflowRun().DeleteExpiredIdempotencyKeys(ctx, tenantId)
+
+		if err != nil {
+			return fmt.Errorf("could not delete expired workflow run idempotency keys: %w", err)
+		}
+
+		if !hasMore {
+			break
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		hasMore, err := rc.repo.Event().DeleteExpiredExternalIds(ctx, tenantId)
+
+		if err != nil {
+			return fmt.Errorf("could not delete expired event external ids: %w", err)
+		}
+
+		if !hasMore {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// Expired workflow runs and events are first soft-deleted and their payloads are cleared. Once the purge delay
+// has passed, the soft-deleted rows are permanently deleted, so they don't bloat the tables.
+
+func (rc *RetentionControllerImpl) runPurgeDeletedWorkflowRuns(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: purging deleted workflow runs")
+
+		err := rc.ForTenants(ctx, rc.runPurgeDeletedWorkflowRunsTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run purge deleted workflow runs")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runPurgeDeletedWorkflowRunsTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "purge-deleted-workflow-runs")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	deletedBefore := time.Now().UTC().Add(-rc.dataPurgeDelay)
+
+	// keep purging until the context is done
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		hasMore, err := rc.repo.WorkflowRun().PurgeDeletedWorkflowRuns(ctx, tenantId, deletedBefore)
+
+		if err != nil {
+			return fmt.Errorf("could not purge deleted workflow runs: %w", err)
+		}
+
+		if !hasMore {
+			return nil
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runPurgeDeletedEvents(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: purging deleted events")
+
+		err := rc.ForTenants(ctx, rc.runPurgeDeletedEventsTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run purge deleted events")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runPurgeDeletedEventsTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "purge-deleted-events")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	deletedBefore := time.Now().UTC().Add(-rc.dataPurgeDelay)
+
+	// keep purging until the context is done
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		hasMore, err := rc.repo.Event().PurgeDeletedEvents(ctx, tenantId, deletedBefore)
+
+		if err != nil {
+			return fmt.Errorf("could not purge deleted events: %w", err)
+		}
+
+		if !hasMore {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type fakeEngineRepository struct {
+	repository.EngineRepository
+
+	workflowRuns   *fakeWorkflowRunRepository
+	events         *fakeEventRepository
+	tablePartition *fakeTablePartitionRepository
+}
+
+func (r *fakeEngineRepository) WorkflowRun() repository.WorkflowRunEngineRepository {
+	return r.workflowRuns
+}
+
+func (r *fakeEngineRepository) Event() repository.EventEngineRepository {
+	return r.events
+}
+
+func (r *fakeEngineRepository) TablePartition() repository.TablePartitionEngineRepository {
+	return r.tablePartition
+}
+
+// fakePurge purges a batch on every call, until the batches run out or the purge fails.
+type fakePurge struct {
+	batches int
+	err     error
+
+	calls         int
+	deletedBefore time.Time
+}
+
+func (p *fakePurge) purge(deletedBefore time.Time) (bool, error) {
+	p.calls++
+	p.deletedBefore = deletedBefore
+
+	if p.err != nil {
+		return false, p.err
+	}
+
+	return p.calls < p.batches, nil
+}
+
+type fakeWorkflowRunRepository struct {
+	repository.WorkflowRunEngineRepository
+
+	fakePurge
+}
+
+func (r *fakeWorkflowRunRepository) PurgeDeletedWorkflowRuns(ctx context.Context, tenantId string, deletedBefore time.Time) (bool, error) {
+	return r.purge(deletedBefore)
+}
+
+type fakeEventRepository struct {
+	repository.EventEngineRepository
+
+	fakePurge
+}
+
+func (r *fakeEventRepository) PurgeDeletedEvents(ctx context.Context, tenantId string, deletedBefore time.Time) (bool, error) {
+	return r.purge(deletedBefore)
+}
+
+type fakeTablePartitionRepository struct {
+	repository.TablePartitionEngineRepository
+
+	until       time.Time
+	endedBefore time.Time
+}
+
+func (r *fakeTablePartitionRepository) CreateTablePartitions(ctx context.Context, until time.Time) error {
+	r.until = until
+	return nil
+}
+
+func (r *fakeTablePartitionRepository) DropEmptyTablePartitions(ctx context.Context, endedBefore time.Time) ([]string, error) {
+	r.endedBefore = endedBefore
+	return []string{"WorkflowRun_20250101"}, nil
+}
+
+func newTestController() (*RetentionControllerImpl, *fakeEngineRepository) {
+	l := zerolog.Nop()
+
+	repo := &fakeEngineRepository{
+		workflowRuns:   &fakeWorkflowRunRepository{},
+		events:         &fakeEventRepository{},
+		tablePartition: &fakeTablePartitionRepository{},
+	}
+
+	return &RetentionControllerImpl{
+		l:              &l,
+		repo:           repo,
+		dataPurge:      true,
+		dataPurgeDelay: 24 * time.Hour,
+	}, repo
+}
+
+func TestPurgeDeletedTenant(t *testing.T) {
+	tenant := dbsqlc.Tenant{ID: sqlchelpers.UUIDFromStr(uuid.New().String())}
+
+	for _, tc := range []struct {
+		name  string
+		purge func(rc *RetentionControllerImpl) error
+		fake  func(repo *fakeEngineRepository) *fakePurge
+	}{
+		{
+			name: "workflow runs",
+			purge: func(rc *RetentionControllerImpl) error {
+				return rc.runPurgeDeletedWorkflowRunsTenant(context.Background(), tenant)
+			},
+			fake: func(repo *fakeEngineRepository) *fakePurge {
+				return &repo.workflowRuns.fakePurge
+			},
+		},
+		{
+			name: "events",
+			purge: func(rc *RetentionControllerImpl) error {
+				return rc.runPurgeDeletedEventsTenant(context.Background(), tenant)
+			},
+			fake: func(repo *fakeEngineRepository) *fakePurge {
+				return &repo.events.fakePurge
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rc, repo := newTestController()
+
+			fake := tc.fake(repo)
+			fake.batches = 3
+
+			require.NoError(t, tc.purge(rc))
+
+			// batches are purged until there are no more, and only the rows deleted before the purge delay
+			assert.Equal(t, 3, fake.calls)
+			assert.WithinDuration(t, time.Now().UTC().Add(-24*time.Hour), fake.deletedBefore, time.Minute)
+
+			// a failed purge stops the loop
+			rc, repo = newTestController()
+
+			fake = tc.fake(repo)
+			fake.batches = 3
+			fake.err = errors.New("purge failed")
+
+			assert.ErrorIs(t, tc.purge(rc), fake.err)
+			assert.Equal(t, 1, fake.calls)
+		})
+	}
+}
+
+func TestTablePartitions(t *testing.T) {
+	rc, repo := newTestController()
+
+	rc.runCreateTablePartitions(context.Background())()
+
+	// partitions are created ahead, and only dropped a day after their range ended
+	assert.WithinDuration(t, time.Now().UTC().Add(14*24*time.Hour), repo.tablePartition.until, time.Minute)
+
+	rc.runDropEmptyTablePartitions(context.Background())()
+
+	assert.WithinDuration(t, time.Now().UTC().Add(-24*time.Hour), repo.tablePartition.endedBefore, time.Minute)
+}
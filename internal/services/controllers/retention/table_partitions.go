@@ -0,0 +1,53 @@
+package retention
+
+import (
+	"context"
+	"time"
+)
+
+// Workflow runs, step runs and events are partitioned by day on their creation time. The partitions are created
+// two weeks ahead, so rows can be written while the engine is down, and a partition is dropped once its day is
+// over and all of its rows were purged.
+
+const (
+	// tablePartitionsAhead is how far ahead of the current time partitions are created
+	tablePartitionsAhead = 14 * 24 * time.Hour
+
+	// tablePartitionDropDelay is how long after the end of its range a partition can be dropped, so a clock skew
+	// between the engine and the database never drops the partition of the current day
+	tablePartitionDropDelay = 24 * time.Hour
+)
+
+func (rc *RetentionControllerImpl) runCreateTablePartitions(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: creating table partitions")
+
+		err := rc.repo.TablePartition().CreateTablePartitions(ctx, time.Now().UTC().Add(tablePartitionsAhead))
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not create table partitions")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runDropEmptyTablePartitions(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: dropping empty table partitions")
+
+		dropped, err := rc.repo.TablePartition().DropEmptyTablePartitions(ctx, time.Now().UTC().Add(-tablePartitionDropDelay))
+
+		for _, partition := range dropped {
+			rc.l.Info().Msgf("retention controller: dropped empty table partition %s", partition)
+		}
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not drop empty table partitions")
+		}
+	}
+}
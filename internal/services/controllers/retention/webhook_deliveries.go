@@ -0,0 +1,58 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runDeleteExpiredWebhookDeliveries(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: deleting expired webhook deliveries")
+
+		err := rc.ForTenants(ctx, rc.runDeleteExpiredWebhookDeliveriesTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run delete expired webhook deliveries")
+		}
+	}
+}
+
+func (wc *RetentionControllerImpl) runDeleteExpiredWebhookDeliveriesTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-expired-webhook-deliveries")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	createdBefore, err := GetDataRetentionExpiredTime(tenant.DataRetentionPeriod)
+
+	if err != nil {
+		return fmt.Errorf("could not get data retention expired time: %w", err)
+	}
+
+	// keep deleting until the context is done
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		hasMore, err := wc.repo.WebhookSubscription().DeleteExpiredWebhookDeliveries(ctx, tenantId, createdBefore)
+
+		if err != nil {
+			return fmt.Errorf("could not delete expired webhook deliveries: %w", err)
+		}
+
+		if !hasMore {
+			return nil
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
+	"github.com/hatchet-dev/hatchet/internal/integrations/runwebhooks"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
@@ -40,12 +41,14 @@ type WorkflowsControllerImpl struct {
 	dv                       datautils.DataDecoderValidator
 	s                        gocron.Scheduler
 	tenantAlerter            *alerting.TenantAlertManager
+	runWebhooks              *runwebhooks.Emitter
 	a                        *hatcheterrors.Wrapped
 	p                        *partition.Partition
 	celParser                *cel.CELParser
 	processWorkflowEventsOps *queueutils.OperationPool
 	unpausedWorkflowRunsOps  *queueutils.OperationPool
 	bumpQueueOps             *queueutils.OperationPool
+	expireWorkflowRunsOps    *queueutils.OperationPool
 
 	workflowVersionCache *cache.Cache
 }
@@ -157,6 +160,7 @@ func New(fs ...WorkflowsControllerOpt) (*WorkflowsControllerImpl, error) {
 		dv:            opts.dv,
 		s:             s,
 		tenantAlerter: opts.ta,
+		runWebhooks:   runwebhooks.NewEmitter(opts.repo, opts.l),
 		a:             a,
 		p:             opts.p,
 		celParser:     cel.NewCELParser(),
@@ -165,6 +169,7 @@ func New(fs ...WorkflowsControllerOpt) (*WorkflowsControllerImpl, error) {
 	w.processWorkflowEventsOps = queueutils.NewOperationPool(w.l, time.Second*5, "process workflow events", w.processWorkflowEvents)
 	w.unpausedWorkflowRunsOps = queueutils.NewOperationPool(w.l, time.Second*5, "unpause workflow runs", w.unpauseWorkflowRuns)
 	w.bumpQueueOps = queueutils.NewOperationPool(w.l, time.Second*5, "bump queue", w.runPollActiveQueuesTenant)
+	w.expireWorkflowRunsOps = queueutils.NewOperationPool(w.l, time.Second*5, "expire workflow runs", w.expireWorkflowRuns)
 
 	return w, nil
 }
@@ -240,6 +245,18 @@ func (wc *WorkflowsControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule unpause workflow runs: %w", err)
 	}
 
+	_, err = wc.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			wc.runTenantExpireWorkflowRuns(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule expire workflow runs: %w", err)
+	}
+
 	wc.s.Start()
 
 	f := func(task *msgqueue.Message) error {
@@ -749,6 +766,26 @@ func (wc *WorkflowsControllerImpl) runTenantUnpauseWorkflowRuns(ctx context.Cont
 	}
 }
 
+func (wc *WorkflowsControllerImpl) runTenantExpireWorkflowRuns(ctx context.Context) func() {
+	return func() {
+		wc.l.Debug().Msgf("partition: expiring workflow runs")
+
+		// list all tenants
+		tenants, err := wc.repo.Tenant().ListTenantsByControllerPartition(ctx, wc.p.GetControllerPartitionId())
+
+		if err != nil {
+			wc.l.Err(err).Msg("could not list tenants")
+			return
+		}
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			wc.expireWorkflowRunsOps.RunOrContinue(tenantId)
+		}
+	}
+}
+
 func (wc *WorkflowsControllerImpl) processWorkflowEvents(ctx context.Context, tenantId string) (bool, error) {
 	ctx, span := telemetry.NewSpan(ctx, "process-workflow-events")
 	defer span.End()
@@ -777,43 +814,86 @@ func (wc *WorkflowsControllerImpl) unpauseWorkflowRuns(ctx context.Context, tena
 	if err != nil {
 		return false, fmt.Errorf("could not process unpaused workflow runs: %w", err)
 	}
-	if toQueue != nil {
-		errGroup := new(errgroup.Group)
+	// the workflow runs are queued one by one, so their step runs are scheduled in the order in which the
+	// workflow runs were triggered
+	for _, row := range toQueue {
+		workflowRunId := sqlchelpers.UUIDToStr(row.WorkflowRun.ID)
+
+		wc.l.Info().Msgf("popped workflow run %s", workflowRunId)
+
+		ssr, err := wc.repo.WorkflowRun().QueueWorkflowRunJobs(ctx, tenantId, workflowRunId)
+
+		if err != nil {
+			return false, fmt.Errorf("unpauseWorkflows could not queue workflow run jobs: %w", err)
+		}
+
+		for _, stepRunCp := range ssr {
+			err = wc.mq.AddMessage(
+				ctx,
+				msgqueue.JOB_PROCESSING_QUEUE,
+				tasktypes.StepRunQueuedToTask(stepRunCp),
+			)
+
+			if err != nil {
+				return false, fmt.Errorf("unpauseWorkflows could not queue step run: %w", err)
+			}
+		}
+	}
 
-		for i := range toQueue {
-			row := toQueue[i]
+	return res, nil
+}
 
-			errGroup.Go(func() error {
-				workflowRunId := sqlchelpers.UUIDToStr(row.WorkflowRun.ID)
+// expireWorkflowRunsBatchSize is the maximum number of expired workflow runs which are cancelled in one
+// run of expireWorkflowRuns.
+const expireWorkflowRunsBatchSize = 100
 
-				wc.l.Info().Msgf("popped workflow run %s", workflowRunId)
+func (wc *WorkflowsControllerImpl) expireWorkflowRuns(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "expire-workflow-runs")
+	defer span.End()
 
-				ssr, err := wc.repo.WorkflowRun().QueueWorkflowRunJobs(ctx, tenantId, workflowRunId)
+	dbCtx, cancel := context.WithTimeout(ctx, 300*time.Second)
+	defer cancel()
 
-				if err != nil {
-					return fmt.Errorf("could not queue workflow run jobs: %w", err)
-				}
+	running, finished, err := wc.repo.WorkflowRun().ListExpiredWorkflowRuns(dbCtx, tenantId, expireWorkflowRunsBatchSize)
 
-				for _, stepRunCp := range ssr {
-					err = wc.mq.AddMessage(
-						ctx,
-						msgqueue.JOB_PROCESSING_QUEUE,
-						tasktypes.StepRunQueuedToTask(stepRunCp),
-					)
-					if err != nil {
-						return fmt.Errorf("could not queue step run: %w", err)
-					}
-				}
+	if err != nil {
+		return false, fmt.Errorf("could not list expired workflow runs: %w", err)
+	}
 
-				return nil
-			})
-		}
+	// runs are only marked as expired once their cancellation was enqueued, so that the runs whose
+	// cancellation failed are cancelled again on the next run
+	expired := finished
+	var expiredMu sync.Mutex
 
-		if err := errGroup.Wait(); err != nil {
-			return false, fmt.Errorf("unpauseWorkflows could not start step runs: %w", err)
-		}
+	errGroup := new(errgroup.Group)
+
+	for i := range running {
+		workflowRunId := running[i]
+
+		errGroup.Go(func() error {
+			wc.l.Info().Msgf("cancelling expired workflow run %s", workflowRunId)
+
+			if err := wc.cancelWorkflowRun(ctx, tenantId, workflowRunId, tasktypes.StepRunCancelledReasonExpired); err != nil {
+				return fmt.Errorf("could not cancel expired workflow run %s: %w", workflowRunId, err)
+			}
+
+			expiredMu.Lock()
+			expired = append(expired, workflowRunId)
+			expiredMu.Unlock()
 
+			return nil
+		})
 	}
 
-	return res, nil
+	cancelErr := errGroup.Wait()
+
+	if err := wc.repo.WorkflowRun().MarkWorkflowRunsExpired(dbCtx, tenantId, expired); err != nil {
+		return false, fmt.Errorf("could not mark workflow runs as expired: %w", err)
+	}
+
+	if cancelErr != nil {
+		return false, cancelErr
+	}
+
+	return len(running)+len(finished) == expireWorkflowRunsBatchSize, nil
 }
@@ -305,7 +305,21 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 
 	wc.l.Info().Msgf("finishing workflow run %s", workflowRunId)
 
-	shouldAlertFailure := workflowRun.WorkflowRun.Status == dbsqlc.WorkflowRunStatusFAILED
+	isFailed := workflowRun.WorkflowRun.Status == dbsqlc.WorkflowRunStatusFAILED
+
+	// workflow runs which were cancelled by the CANCEL_IN_PROGRESS strategy have been superseded by a newer run, and
+	// expired runs are no longer wanted, so we don't treat them as failures
+	if isFailed {
+		superseded, err := wc.isSuperseded(ctx, metadata.TenantId, workflowRunId)
+
+		if err != nil {
+			return fmt.Errorf("could not check if workflow run was superseded: %w", err)
+		}
+
+		isFailed = !superseded
+	}
+
+	shouldAlertFailure := isFailed
 
 	// if there's an onFailure job, start that job
 	if workflowRun.WorkflowVersion.OnFailureJobId.Valid {
@@ -321,7 +335,7 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 		}
 
 		if !repository.IsFinalJobRunStatus(jobRun.Status) {
-			if workflowRun.WorkflowRun.Status == dbsqlc.WorkflowRunStatusFAILED {
+			if isFailed {
 
 				startableJobRuns, err := wc.repo.JobRun().StartJobRun(ctx, metadata.TenantId, sqlchelpers.UUIDToStr(jobRun.ID))
 
@@ -366,6 +380,12 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 		}
 	}
 
+	err = wc.runWebhooks.RunFinished(ctx, metadata.TenantId, workflowRun, isFailed)
+
+	if err != nil {
+		wc.l.Err(err).Msgf("could not queue webhooks for finished workflow run %s", workflowRunId)
+	}
+
 	if shouldAlertFailure {
 		err := wc.tenantAlerter.HandleAlert(
 			sqlchelpers.UUIDToStr(workflowRun.WorkflowRun.TenantId),
@@ -376,11 +396,46 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 		}
 	}
 
+	if workflowRun.WorkflowRunTriggeredBy.EventId.Valid {
+		err := wc.completeOrderedEvent(ctx, metadata.TenantId, sqlchelpers.UUIDToStr(workflowRun.WorkflowRunTriggeredBy.EventId))
+
+		// the task is retried, as the next event of the ordering key isn't released otherwise
+		if err != nil {
+			return fmt.Errorf("could not complete ordered event: %w", err)
+		}
+	}
+
 	wc.checkTenantQueue(ctx, metadata.TenantId)
 
 	return nil
 }
 
+// completeOrderedEvent completes the event which triggered a workflow run if the event has an ordering key and
+// all of its workflow runs have finished, and releases the next event of the ordering key.
+func (wc *WorkflowsControllerImpl) completeOrderedEvent(ctx context.Context, tenantId, eventId string) error {
+	release, err := wc.repo.Event().CompleteOrderedEvent(ctx, tenantId, eventId)
+
+	if err != nil {
+		return err
+	}
+
+	if release == nil {
+		return nil
+	}
+
+	err = wc.mq.AddMessage(
+		ctx,
+		msgqueue.EVENT_PROCESSING_QUEUE,
+		tasktypes.OrderedEventReleaseToTask(tenantId, release.OrderingKey, release.ReleaseSequence),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not add ordered event release task: %w", err)
+	}
+
+	return nil
+}
+
 func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 	ctx context.Context,
 	getGroupKeyRun *dbsqlc.GetGroupKeyRunForEngineRow,
@@ -615,7 +670,7 @@ func (wc *WorkflowsControllerImpl) queueByCancelInProgress(ctx context.Context,
 		row := toCancel[i]
 		workflowRunId := sqlchelpers.UUIDToStr(row.ID)
 
-		err = wc.cancelWorkflowRun(ctx, tenantId, workflowRunId)
+		err = wc.cancelWorkflowRun(ctx, tenantId, workflowRunId, tasktypes.StepRunCancelledReasonSuperseded)
 
 		if err != nil {
 			return fmt.Errorf("could not cancel workflow run: %w", err)
@@ -672,7 +727,7 @@ func (wc *WorkflowsControllerImpl) queueByCancelNewest(ctx context.Context, tena
 		row := toCancel[i]
 		workflowRunId := sqlchelpers.UUIDToStr(row.ID)
 
-		err = wc.cancelWorkflowRun(ctx, tenantId, workflowRunId)
+		err = wc.cancelWorkflowRun(ctx, tenantId, workflowRunId, cancelledByConcurrencyLimitReason)
 
 		if err != nil {
 			return fmt.Errorf("could not cancel workflow run: %w", err)
@@ -749,7 +804,11 @@ func (wc *WorkflowsControllerImpl) queueByGroupRoundRobin(ctx context.Context, t
 	return nil
 }
 
-func (wc *WorkflowsControllerImpl) cancelWorkflowRun(ctx context.Context, tenantId, workflowRunId string) error {
+// cancelledByConcurrencyLimitReason is the reason set on step runs of new workflow runs which were cancelled
+// by the CANCEL_NEWEST concurrency strategy because they exceeded the limit.
+const cancelledByConcurrencyLimitReason = "CANCELLED_BY_CONCURRENCY_LIMIT"
+
+func (wc *WorkflowsControllerImpl) cancelWorkflowRun(ctx context.Context, tenantId, workflowRunId, reason string) error {
 	// cancel all running step runs
 	stepRuns, err := wc.repo.StepRun().ListStepRuns(ctx, tenantId, &repository.ListStepRunsOpts{
 		WorkflowRunIds: []string{
@@ -771,7 +830,7 @@ func (wc *WorkflowsControllerImpl) cancelWorkflowRun(ctx context.Context, tenant
 			return wc.mq.AddMessage(
 				context.Background(),
 				msgqueue.JOB_PROCESSING_QUEUE,
-				getStepRunCancelTask(tenantId, stepRunId, "CANCELLED_BY_CONCURRENCY_LIMIT"),
+				getStepRunCancelTask(tenantId, stepRunId, reason),
 			)
 		})
 	}
@@ -779,6 +838,35 @@ func (wc *WorkflowsControllerImpl) cancelWorkflowRun(ctx context.Context, tenant
 	return errGroup.Wait()
 }
 
+// isSuperseded returns true if the workflow run was cancelled by the CANCEL_IN_PROGRESS concurrency strategy
+// or because it expired. These runs are not treated as failures, so their on-failure job does not run. Runs
+// cancelled by the CANCEL_NEWEST strategy never ran the work they were triggered for, so they are still
+// treated as failures.
+func (wc *WorkflowsControllerImpl) isSuperseded(ctx context.Context, tenantId, workflowRunId string) (bool, error) {
+	stepRuns, err := wc.repo.StepRun().ListStepRuns(ctx, tenantId, &repository.ListStepRunsOpts{
+		WorkflowRunIds: []string{
+			workflowRunId,
+		},
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not list step runs: %w", err)
+	}
+
+	for _, stepRun := range stepRuns {
+		if !stepRun.SRCancelledReason.Valid {
+			continue
+		}
+
+		switch stepRun.SRCancelledReason.String {
+		case tasktypes.StepRunCancelledReasonSuperseded, tasktypes.StepRunCancelledReasonExpired:
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func getGroupActionTask(tenantId, workflowRunId, workerId, dispatcherId string) *msgqueue.Message {
 	payload, _ := datautils.ToJSONMap(tasktypes.GroupKeyActionAssignedTaskPayload{
 		WorkflowRunId: workflowRunId,
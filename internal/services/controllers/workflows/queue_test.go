@@ -0,0 +1,215 @@
+package workflows
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type fakeEngineRepository struct {
+	repository.EngineRepository
+
+	workflowRuns *fakeWorkflowRunRepository
+	stepRuns     *fakeStepRunRepository
+}
+
+func (r *fakeEngineRepository) WorkflowRun() repository.WorkflowRunEngineRepository {
+	return r.workflowRuns
+}
+
+func (r *fakeEngineRepository) StepRun() repository.StepRunEngineRepository {
+	return r.stepRuns
+}
+
+type fakeWorkflowRunRepository struct {
+	repository.WorkflowRunEngineRepository
+
+	// the workflow runs which are popped to be cancelled
+	toCancel []*dbsqlc.WorkflowRun
+}
+
+func (r *fakeWorkflowRunRepository) PopWorkflowRunsCancelInProgress(ctx context.Context, tenantId, workflowVersionId string, maxRuns int) ([]*dbsqlc.WorkflowRun, []*dbsqlc.WorkflowRun, error) {
+	return r.toCancel, nil, nil
+}
+
+func (r *fakeWorkflowRunRepository) PopWorkflowRunsCancelNewest(ctx context.Context, tenantId, workflowVersionId string, maxRuns int) ([]*dbsqlc.WorkflowRun, []*dbsqlc.WorkflowRun, error) {
+	return r.toCancel, nil, nil
+}
+
+type fakeStepRunRepository struct {
+	repository.StepRunEngineRepository
+
+	mu sync.Mutex
+
+	// the step runs of each workflow run
+	stepRuns map[string][]*dbsqlc.GetStepRunForEngineRow
+}
+
+func (r *fakeStepRunRepository) ListStepRuns(ctx context.Context, tenantId string, opts *repository.ListStepRunsOpts) ([]*dbsqlc.GetStepRunForEngineRow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := []*dbsqlc.GetStepRunForEngineRow{}
+
+	for _, workflowRunId := range opts.WorkflowRunIds {
+		res = append(res, r.stepRuns[workflowRunId]...)
+	}
+
+	return res, nil
+}
+
+// cancel sets the cancelled reason of the step run, like the step run controller does when it processes
+// a cancel task.
+func (r *fakeStepRunRepository) cancel(stepRunId, reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, stepRuns := range r.stepRuns {
+		for _, stepRun := range stepRuns {
+			if sqlchelpers.UUIDToStr(stepRun.SRID) == stepRunId {
+				stepRun.SRStatus = dbsqlc.StepRunStatusCANCELLED
+				stepRun.SRCancelledReason = pgtype.Text{String: reason, Valid: true}
+			}
+		}
+	}
+}
+
+type fakeMessageQueue struct {
+	msgqueue.MessageQueue
+
+	mu       sync.Mutex
+	messages []*msgqueue.Message
+}
+
+func (mq *fakeMessageQueue) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+
+	mq.messages = append(mq.messages, task)
+	return nil
+}
+
+func newTestController(t *testing.T) (*WorkflowsControllerImpl, *fakeEngineRepository, *fakeMessageQueue, string) {
+	t.Helper()
+
+	l := zerolog.Nop()
+
+	workflowRunId := uuid.New().String()
+
+	repo := &fakeEngineRepository{
+		workflowRuns: &fakeWorkflowRunRepository{
+			toCancel: []*dbsqlc.WorkflowRun{
+				{ID: sqlchelpers.UUIDFromStr(workflowRunId)},
+			},
+		},
+		stepRuns: &fakeStepRunRepository{
+			stepRuns: map[string][]*dbsqlc.GetStepRunForEngineRow{
+				workflowRunId: {
+					{SRID: sqlchelpers.UUIDFromStr(uuid.New().String()), SRStatus: dbsqlc.StepRunStatusRUNNING},
+					{SRID: sqlchelpers.UUIDFromStr(uuid.New().String()), SRStatus: dbsqlc.StepRunStatusPENDING},
+				},
+			},
+		},
+	}
+
+	mq := &fakeMessageQueue{}
+
+	wc := &WorkflowsControllerImpl{
+		mq:   mq,
+		l:    &l,
+		repo: repo,
+	}
+
+	return wc, repo, mq, workflowRunId
+}
+
+func TestQueueByCancelStrategies(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	workflowVersion := &dbsqlc.GetWorkflowVersionForEngineRow{
+		WorkflowVersion: dbsqlc.WorkflowVersion{
+			ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+		},
+		ConcurrencyMaxRuns: pgtype.Int4{Int32: 1, Valid: true},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		queue      func(wc *WorkflowsControllerImpl) error
+		reason     string
+		superseded bool
+	}{
+		{
+			name: "cancel in progress",
+			queue: func(wc *WorkflowsControllerImpl) error {
+				return wc.queueByCancelInProgress(context.Background(), tenantId, workflowVersion)
+			},
+			reason:     tasktypes.StepRunCancelledReasonSuperseded,
+			superseded: true,
+		},
+		{
+			name: "cancel newest",
+			queue: func(wc *WorkflowsControllerImpl) error {
+				return wc.queueByCancelNewest(context.Background(), tenantId, workflowVersion)
+			},
+			reason:     cancelledByConcurrencyLimitReason,
+			superseded: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			wc, repo, mq, workflowRunId := newTestController(t)
+
+			require.NoError(t, tc.queue(wc))
+
+			// every step run of the popped workflow run is cancelled with the reason of the strategy
+			require.Len(t, mq.messages, 2)
+
+			for _, msg := range mq.messages {
+				assert.Equal(t, "step-run-cancel", msg.ID)
+				assert.Equal(t, tc.reason, msg.Payload["cancelled_reason"])
+
+				repo.stepRuns.cancel(msg.Payload["step_run_id"].(string), msg.Payload["cancelled_reason"].(string))
+			}
+
+			// only runs cancelled by the cancel in progress strategy skip their on-failure job
+			superseded, err := wc.isSuperseded(context.Background(), tenantId, workflowRunId)
+			require.NoError(t, err)
+			assert.Equal(t, tc.superseded, superseded)
+		})
+	}
+}
+
+func TestIsSuperseded(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	for reason, want := range map[string]bool{
+		tasktypes.StepRunCancelledReasonSuperseded: true,
+		tasktypes.StepRunCancelledReasonExpired:    true,
+		cancelledByConcurrencyLimitReason:          false,
+		"CANCELLED_BY_USER":                        false,
+		"TIMED_OUT":                                false,
+	} {
+		t.Run(reason, func(t *testing.T) {
+			wc, repo, _, workflowRunId := newTestController(t)
+
+			stepRuns := repo.stepRuns.stepRuns[workflowRunId]
+			repo.stepRuns.cancel(sqlchelpers.UUIDToStr(stepRuns[0].SRID), reason)
+
+			superseded, err := wc.isSuperseded(context.Background(), tenantId, workflowRunId)
+			require.NoError(t, err)
+			assert.Equal(t, want, superseded)
+		})
+	}
+}
@@ -32,12 +32,21 @@ type DispatcherClient interface {
 	SubscribeToWorkflowEvents(ctx context.Context, in *SubscribeToWorkflowEventsRequest, opts ...grpc.CallOption) (Dispatcher_SubscribeToWorkflowEventsClient, error)
 	SubscribeToWorkflowRuns(ctx context.Context, opts ...grpc.CallOption) (Dispatcher_SubscribeToWorkflowRunsClient, error)
 	SendStepActionEvent(ctx context.Context, in *StepActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error)
+	// SendStepActionEvents sends a batch of step action events, which are processed in order. Each event
+	// is processed independently, except that an event is skipped if an earlier event of the same step run
+	// in the batch failed.
+	SendStepActionEvents(ctx context.Context, in *StepActionEventBatch, opts ...grpc.CallOption) (*StepActionEventBatchResponse, error)
 	SendGroupKeyActionEvent(ctx context.Context, in *GroupKeyActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error)
 	PutOverridesData(ctx context.Context, in *OverridesData, opts ...grpc.CallOption) (*OverridesDataResponse, error)
 	Unsubscribe(ctx context.Context, in *WorkerUnsubscribeRequest, opts ...grpc.CallOption) (*WorkerUnsubscribeResponse, error)
 	RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(ctx context.Context, in *ReleaseSlotRequest, opts ...grpc.CallOption) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(ctx context.Context, in *UpsertWorkerLabelsRequest, opts ...grpc.CallOption) (*UpsertWorkerLabelsResponse, error)
+	// UpdateWorkerActions replaces the actions which a registered worker can run
+	UpdateWorkerActions(ctx context.Context, in *UpdateWorkerActionsRequest, opts ...grpc.CallOption) (*UpdateWorkerActionsResponse, error)
+	// DrainWorker stops assigning new step runs to a worker which is shutting down, and optionally
+	// releases the step runs which are still assigned to it back to the queue
+	DrainWorker(ctx context.Context, in *DrainWorkerRequest, opts ...grpc.CallOption) (*DrainWorkerResponse, error)
 }
 
 type dispatcherClient struct {
@@ -202,6 +211,15 @@ func (c *dispatcherClient) SendStepActionEvent(ctx context.Context, in *StepActi
 	return out, nil
 }
 
+func (c *dispatcherClient) SendStepActionEvents(ctx context.Context, in *StepActionEventBatch, opts ...grpc.CallOption) (*StepActionEventBatchResponse, error) {
+	out := new(StepActionEventBatchResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/SendStepActionEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dispatcherClient) SendGroupKeyActionEvent(ctx context.Context, in *GroupKeyActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error) {
 	out := new(ActionEventResponse)
 	err := c.cc.Invoke(ctx, "/Dispatcher/SendGroupKeyActionEvent", in, out, opts...)
@@ -256,6 +274,24 @@ func (c *dispatcherClient) UpsertWorkerLabels(ctx context.Context, in *UpsertWor
 	return out, nil
 }
 
+func (c *dispatcherClient) UpdateWorkerActions(ctx context.Context, in *UpdateWorkerActionsRequest, opts ...grpc.CallOption) (*UpdateWorkerActionsResponse, error) {
+	out := new(UpdateWorkerActionsResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/UpdateWorkerActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) DrainWorker(ctx context.Context, in *DrainWorkerRequest, opts ...grpc.CallOption) (*DrainWorkerResponse, error) {
+	out := new(DrainWorkerResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/DrainWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DispatcherServer is the server API for Dispatcher service.
 // All implementations must embed UnimplementedDispatcherServer
 // for forward compatibility
@@ -270,12 +306,21 @@ type DispatcherServer interface {
 	SubscribeToWorkflowEvents(*SubscribeToWorkflowEventsRequest, Dispatcher_SubscribeToWorkflowEventsServer) error
 	SubscribeToWorkflowRuns(Dispatcher_SubscribeToWorkflowRunsServer) error
 	SendStepActionEvent(context.Context, *StepActionEvent) (*ActionEventResponse, error)
+	// SendStepActionEvents sends a batch of step action events, which are processed in order. Each event
+	// is processed independently, except that an event is skipped if an earlier event of the same step run
+	// in the batch failed.
+	SendStepActionEvents(context.Context, *StepActionEventBatch) (*StepActionEventBatchResponse, error)
 	SendGroupKeyActionEvent(context.Context, *GroupKeyActionEvent) (*ActionEventResponse, error)
 	PutOverridesData(context.Context, *OverridesData) (*OverridesDataResponse, error)
 	Unsubscribe(context.Context, *WorkerUnsubscribeRequest) (*WorkerUnsubscribeResponse, error)
 	RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(context.Context, *ReleaseSlotRequest) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error)
+	// UpdateWorkerActions replaces the actions which a registered worker can run
+	UpdateWorkerActions(context.Context, *UpdateWorkerActionsRequest) (*UpdateWorkerActionsResponse, error)
+	// DrainWorker stops assigning new step runs to a worker which is shutting down, and optionally
+	// releases the step runs which are still assigned to it back to the queue
+	DrainWorker(context.Context, *DrainWorkerRequest) (*DrainWorkerResponse, error)
 	mustEmbedUnimplementedDispatcherServer()
 }
 
@@ -304,6 +349,9 @@ func (UnimplementedDispatcherServer) SubscribeToWorkflowRuns(Dispatcher_Subscrib
 func (UnimplementedDispatcherServer) SendStepActionEvent(context.Context, *StepActionEvent) (*ActionEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendStepActionEvent not implemented")
 }
+func (UnimplementedDispatcherServer) SendStepActionEvents(context.Context, *StepActionEventBatch) (*StepActionEventBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendStepActionEvents not implemented")
+}
 func (UnimplementedDispatcherServer) SendGroupKeyActionEvent(context.Context, *GroupKeyActionEvent) (*ActionEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendGroupKeyActionEvent not implemented")
 }
@@ -322,6 +370,12 @@ func (UnimplementedDispatcherServer) ReleaseSlot(context.Context, *ReleaseSlotRe
 func (UnimplementedDispatcherServer) UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkerLabels not implemented")
 }
+func (UnimplementedDispatcherServer) UpdateWorkerActions(context.Context, *UpdateWorkerActionsRequest) (*UpdateWorkerActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerActions not implemented")
+}
+func (UnimplementedDispatcherServer) DrainWorker(context.Context, *DrainWorkerRequest) (*DrainWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainWorker not implemented")
+}
 func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
 
 // UnsafeDispatcherServer may be embedded to opt out of forward compatibility for this service.
@@ -478,6 +532,24 @@ func _Dispatcher_SendStepActionEvent_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_SendStepActionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepActionEventBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).SendStepActionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/SendStepActionEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).SendStepActionEvents(ctx, req.(*StepActionEventBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dispatcher_SendGroupKeyActionEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GroupKeyActionEvent)
 	if err := dec(in); err != nil {
@@ -586,6 +658,42 @@ func _Dispatcher_UpsertWorkerLabels_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_UpdateWorkerActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).UpdateWorkerActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/UpdateWorkerActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).UpdateWorkerActions(ctx, req.(*UpdateWorkerActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_DrainWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).DrainWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/DrainWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).DrainWorker(ctx, req.(*DrainWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -605,6 +713,10 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendStepActionEvent",
 			Handler:    _Dispatcher_SendStepActionEvent_Handler,
 		},
+		{
+			MethodName: "SendStepActionEvents",
+			Handler:    _Dispatcher_SendStepActionEvents_Handler,
+		},
 		{
 			MethodName: "SendGroupKeyActionEvent",
 			Handler:    _Dispatcher_SendGroupKeyActionEvent_Handler,
@@ -629,6 +741,14 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertWorkerLabels",
 			Handler:    _Dispatcher_UpsertWorkerLabels_Handler,
 		},
+		{
+			MethodName: "UpdateWorkerActions",
+			Handler:    _Dispatcher_UpdateWorkerActions_Handler,
+		},
+		{
+			MethodName: "DrainWorker",
+			Handler:    _Dispatcher_DrainWorker_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
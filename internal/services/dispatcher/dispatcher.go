@@ -10,6 +10,7 @@ import (
 	"github.com/go-co-op/gocron/v2"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
@@ -52,6 +53,8 @@ type DispatcherImpl struct {
 	dispatcherId string
 	workers      *workers
 	a            *hatcheterrors.Wrapped
+
+	heartbeatInterval time.Duration
 }
 
 var ErrWorkerNotFound = fmt.Errorf("worker not found")
@@ -126,6 +129,8 @@ type DispatcherOpts struct {
 	dispatcherId string
 	alerter      hatcheterrors.Alerter
 	cache        cache.Cacheable
+
+	heartbeatInterval time.Duration
 }
 
 func defaultDispatcherOpts() *DispatcherOpts {
@@ -137,6 +142,8 @@ func defaultDispatcherOpts() *DispatcherOpts {
 		dv:           datautils.NewDataDecoderValidator(),
 		dispatcherId: uuid.New().String(),
 		alerter:      alerter,
+
+		heartbeatInterval: DefaultHeartbeatInterval,
 	}
 }
 
@@ -188,6 +195,16 @@ func WithCache(cache cache.Cacheable) DispatcherOpt {
 	}
 }
 
+// WithHeartbeatInterval sets the interval at which workers are expected to send heartbeats, and at which the
+// dispatcher refreshes the heartbeats of workers which are connected to it.
+func WithHeartbeatInterval(d time.Duration) DispatcherOpt {
+	return func(opts *DispatcherOpts) {
+		if d > 0 {
+			opts.heartbeatInterval = d
+		}
+	}
+}
+
 func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 	opts := defaultDispatcherOpts()
 
@@ -236,6 +253,8 @@ func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 		s:            s,
 		a:            a,
 		cache:        opts.cache,
+
+		heartbeatInterval: opts.heartbeatInterval,
 	}, nil
 }
 
@@ -485,6 +504,11 @@ func (d *DispatcherImpl) handleStepRunAssignedTask(ctx context.Context, task *ms
 		return fmt.Errorf("could not get step run data: %w", err)
 	}
 
+	// runs which expired while they were queued are never sent to the worker
+	if isExpired(data.ExpiresAt) {
+		return d.expireStepRun(ctx, metadata.TenantId, payload.StepRunId)
+	}
+
 	servertel.WithStepRunModel(span, stepRun)
 
 	var multiErr error
@@ -650,6 +674,11 @@ func (d *DispatcherImpl) handleStepRunBulkAssignedTask(ctx context.Context, task
 						return d.repo.StepRun().ReleaseStepRunSemaphore(ctx, metadata.TenantId, stepRunId, false)
 					}
 
+					// runs which expired while they were queued are never sent to the worker
+					if isExpired(stepRun.ExpiresAt) {
+						return d.expireStepRun(ctx, metadata.TenantId, stepRunId)
+					}
+
 					var multiErr error
 					var success bool
 
@@ -709,6 +738,39 @@ func (d *DispatcherImpl) handleStepRunBulkAssignedTask(ctx context.Context, task
 	return outerEg.Wait()
 }
 
+func isExpired(expiresAt pgtype.Timestamp) bool {
+	return expiresAt.Valid && !expiresAt.Time.After(time.Now().UTC())
+}
+
+// expireStepRun releases the slot of a step run whose workflow run has expired and cancels the step run
+// instead of sending it to the worker.
+func (d *DispatcherImpl) expireStepRun(ctx context.Context, tenantId, stepRunId string) error {
+	d.l.Info().Msgf("step run %s expired before it was sent to the worker, cancelling", stepRunId)
+
+	err := d.repo.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, stepRunId, false)
+
+	if err != nil {
+		return fmt.Errorf("could not release semaphore of expired step run: %w", err)
+	}
+
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunCancelTaskPayload{
+		StepRunId:           stepRunId,
+		CancelledReason:     tasktypes.StepRunCancelledReasonExpired,
+		PropagateToChildren: true,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunCancelTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return d.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:       "step-run-cancel",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	})
+}
+
 func (d *DispatcherImpl) handleStepRunCancelled(ctx context.Context, task *msgqueue.Message) error {
 	ctx, span := telemetry.NewSpanWithCarrier(ctx, "step-run-cancelled", task.OtelCarrier)
 	defer span.End()
@@ -752,7 +814,7 @@ func (d *DispatcherImpl) handleStepRunCancelled(ctx context.Context, task *msgqu
 	var success bool
 
 	for _, w := range workers {
-		err = w.CancelStepRun(ctx, metadata.TenantId, stepRun)
+		err = w.CancelStepRun(ctx, metadata.TenantId, stepRun, payload.CancelledReason)
 
 		if err != nil {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("could not send job to worker: %w", err))
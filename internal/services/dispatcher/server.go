@@ -26,6 +26,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/internal/telemetry/servertel"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
@@ -49,9 +50,11 @@ func (worker *subscribedWorker) StartStepRun(
 	stepRun *dbsqlc.GetStepRunForEngineRow,
 	stepRunData *dbsqlc.GetStepRunDataForEngineRow,
 ) error {
-	ctx, span := telemetry.NewSpan(ctx, "start-step-run") // nolint:ineffassign
+	ctx, span := telemetry.NewSpanWithAdditionalMetadata(ctx, "start-step-run", stepRunData.AdditionalMetadata) // nolint:ineffassign
 	defer span.End()
 
+	servertel.WithStepRunModel(span, stepRun)
+
 	inputBytes := []byte{}
 
 	if stepRunData.Input != nil {
@@ -93,6 +96,10 @@ func (worker *subscribedWorker) StartStepRun(
 		action.ParentWorkflowRunId = &parentId
 	}
 
+	if stepRunData.WorkflowRunTimeoutAt.Valid {
+		action.WorkflowRunTimeoutAt = timestamppb.New(stepRunData.WorkflowRunTimeoutAt.Time)
+	}
+
 	worker.sendMu.Lock()
 	defer worker.sendMu.Unlock()
 
@@ -104,9 +111,17 @@ func (worker *subscribedWorker) StartStepRunFromBulk(
 	tenantId string,
 	stepRun *dbsqlc.GetStepRunBulkDataForEngineRow,
 ) error {
-	ctx, span := telemetry.NewSpan(ctx, "start-step-run-from-bulk") // nolint:ineffassign
+	ctx, span := telemetry.NewSpanWithAdditionalMetadata(ctx, "start-step-run-from-bulk", stepRun.AdditionalMetadata) // nolint:ineffassign
 	defer span.End()
 
+	telemetry.WithAttributes(
+		span,
+		telemetry.AttributeKV{Key: "tenantId", Value: tenantId},
+		servertel.StepRunId(stepRun.SRID),
+		servertel.Step(stepRun.StepId),
+		servertel.JobRunId(stepRun.JobRunId),
+	)
+
 	inputBytes := []byte{}
 
 	if stepRun.Input != nil {
@@ -148,6 +163,10 @@ func (worker *subscribedWorker) StartStepRunFromBulk(
 		action.ParentWorkflowRunId = &parentId
 	}
 
+	if stepRun.WorkflowRunTimeoutAt.Valid {
+		action.WorkflowRunTimeoutAt = timestamppb.New(stepRun.WorkflowRunTimeoutAt.Time)
+	}
+
 	worker.sendMu.Lock()
 	defer worker.sendMu.Unlock()
 
@@ -183,6 +202,7 @@ func (worker *subscribedWorker) CancelStepRun(
 	ctx context.Context,
 	tenantId string,
 	stepRun *dbsqlc.GetStepRunForEngineRow,
+	cancelledReason string,
 ) error {
 	ctx, span := telemetry.NewSpan(ctx, "cancel-step-run") // nolint:ineffassign
 	defer span.End()
@@ -191,16 +211,17 @@ func (worker *subscribedWorker) CancelStepRun(
 	defer worker.sendMu.Unlock()
 
 	return worker.stream.Send(&contracts.AssignedAction{
-		TenantId:      tenantId,
-		JobId:         sqlchelpers.UUIDToStr(stepRun.JobId),
-		JobName:       stepRun.JobName,
-		JobRunId:      sqlchelpers.UUIDToStr(stepRun.JobRunId),
-		StepId:        sqlchelpers.UUIDToStr(stepRun.StepId),
-		StepRunId:     sqlchelpers.UUIDToStr(stepRun.SRID),
-		ActionType:    contracts.ActionType_CANCEL_STEP_RUN,
-		StepName:      stepRun.StepReadableId.String,
-		WorkflowRunId: sqlchelpers.UUIDToStr(stepRun.WorkflowRunId),
-		RetryCount:    stepRun.SRRetryCount,
+		TenantId:        tenantId,
+		JobId:           sqlchelpers.UUIDToStr(stepRun.JobId),
+		JobName:         stepRun.JobName,
+		JobRunId:        sqlchelpers.UUIDToStr(stepRun.JobRunId),
+		StepId:          sqlchelpers.UUIDToStr(stepRun.StepId),
+		StepRunId:       sqlchelpers.UUIDToStr(stepRun.SRID),
+		ActionType:      contracts.ActionType_CANCEL_STEP_RUN,
+		StepName:        stepRun.StepReadableId.String,
+		WorkflowRunId:   sqlchelpers.UUIDToStr(stepRun.WorkflowRunId),
+		RetryCount:      stepRun.SRRetryCount,
+		CancelledReason: &cancelledReason,
 	})
 }
 
@@ -222,6 +243,7 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		Actions:      request.Actions,
 		Services:     svcs,
 		WebhookId:    request.WebhookId,
+		SlotGroups:   toSlotGroupOpts(request.SlotGroups),
 	}
 
 	if request.RuntimeInfo != nil {
@@ -290,6 +312,119 @@ func (s *DispatcherImpl) UpsertWorkerLabels(ctx context.Context, request *contra
 	}, nil
 }
 
+func (s *DispatcherImpl) UpdateWorkerActions(ctx context.Context, request *contracts.UpdateWorkerActionsRequest) (*contracts.UpdateWorkerActionsResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	s.l.Debug().Msgf("Received update actions request from ID %s with actions %v", request.WorkerId, request.Actions)
+
+	// make sure the worker belongs to the tenant
+	_, err := s.repo.Worker().GetWorkerForEngine(ctx, tenantId, request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "worker %s not found", request.WorkerId)
+		}
+
+		return nil, err
+	}
+
+	opts := &repository.UpdateWorkerOpts{}
+
+	// an empty list of actions leaves the actions of the worker unchanged, unless they are cleared
+	// explicitly. The slot groups are sent with the actions, so they are replaced along with them.
+	switch {
+	case len(request.Actions) > 0:
+		opts.Actions = request.Actions
+		opts.SlotGroups = toSlotGroupOpts(request.SlotGroups)
+	case request.ClearActions:
+		opts.Actions = []string{}
+		opts.SlotGroups = toSlotGroupOpts(request.SlotGroups)
+	}
+
+	if apiErrors, err := s.v.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
+	}
+
+	_, err = s.repo.Worker().UpdateWorker(ctx, tenantId, request.WorkerId, opts)
+
+	if err != nil {
+		s.l.Error().Err(err).Msgf("could not update actions for worker %s", request.WorkerId)
+		return nil, err
+	}
+
+	return &contracts.UpdateWorkerActionsResponse{
+		TenantId: tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+func toSlotGroupOpts(slotGroups []*contracts.WorkerSlotGroup) []repository.WorkerSlotGroupOpts {
+	res := make([]repository.WorkerSlotGroupOpts, 0, len(slotGroups))
+
+	for _, slotGroup := range slotGroups {
+		res = append(res, repository.WorkerSlotGroupOpts{
+			Name:    slotGroup.Name,
+			MaxRuns: int(slotGroup.MaxRuns),
+			Actions: slotGroup.Actions,
+		})
+	}
+
+	return res
+}
+
+// releasedWorkerHeartbeatAge is how old the last heartbeat of a worker is set to be when it releases its
+// step runs. The jobs controller reassigns the step runs of workers without a heartbeat within the worker
+// heartbeat timeout (30 seconds by default), so they are reassigned on its next check instead of once the
+// heartbeat of the worker expires.
+const releasedWorkerHeartbeatAge = time.Minute
+
+func (s *DispatcherImpl) DrainWorker(ctx context.Context, request *contracts.DrainWorkerRequest) (*contracts.DrainWorkerResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	s.l.Debug().Msgf("Received drain request from ID %s, releasing step runs: %t", request.WorkerId, request.ReleaseStepRuns)
+
+	// make sure the worker belongs to the tenant
+	_, err := s.repo.Worker().GetWorkerForEngine(ctx, tenantId, request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "worker %s not found", request.WorkerId)
+		}
+
+		return nil, err
+	}
+
+	isPaused := true
+
+	opts := &repository.UpdateWorkerOpts{
+		IsPaused: &isPaused,
+	}
+
+	if request.ReleaseStepRuns {
+		isActive := false
+		lastHeartbeatAt := time.Now().UTC().Add(-releasedWorkerHeartbeatAge)
+
+		opts.IsActive = &isActive
+		opts.LastHeartbeatAt = &lastHeartbeatAt
+	}
+
+	_, err = s.repo.Worker().UpdateWorker(ctx, tenantId, request.WorkerId, opts)
+
+	if err != nil {
+		s.l.Error().Err(err).Msgf("could not drain worker %s", request.WorkerId)
+		return nil, err
+	}
+
+	return &contracts.DrainWorkerResponse{
+		TenantId: tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
 func (s *DispatcherImpl) upsertLabels(ctx context.Context, workerId pgtype.UUID, request map[string]*contracts.WorkerLabels) ([]*dbsqlc.WorkerLabel, error) {
 	affinities := make([]repository.UpsertWorkerLabelOpts, 0, len(request))
 
@@ -367,12 +502,12 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 		s.workers.DeleteForSession(request.WorkerId, sessionId)
 	}()
 
-	// update the worker with a last heartbeat time every 5 seconds as long as the worker is connected
+	// update the worker with a last heartbeat time every heartbeat interval as long as the worker is connected
 	go func() {
 		timer := time.NewTicker(100 * time.Millisecond)
 
-		// set the last heartbeat to 6 seconds ago so the first heartbeat is sent immediately
-		lastHeartbeat := time.Now().UTC().Add(-6 * time.Second)
+		// set the last heartbeat to one interval ago so the first heartbeat is sent immediately
+		lastHeartbeat := time.Now().UTC().Add(-s.heartbeatInterval)
 		defer timer.Stop()
 
 		for {
@@ -384,7 +519,7 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 				s.l.Debug().Msgf("closing stream for worker id: %s", request.WorkerId)
 				return
 			case <-timer.C:
-				if now := time.Now().UTC(); lastHeartbeat.Add(4 * time.Second).Before(now) {
+				if now := time.Now().UTC(); lastHeartbeat.Add(s.heartbeatInterval).Before(now) {
 					s.l.Debug().Msgf("updating worker %s heartbeat", request.WorkerId)
 
 					_, err := s.repo.Worker().UpdateWorker(ctx, tenantId, request.WorkerId, &repository.UpdateWorkerOpts{
@@ -521,7 +656,9 @@ func (s *DispatcherImpl) ListenV2(request *contracts.WorkerListenRequest, stream
 	}
 }
 
-const HeartbeatInterval = 4 * time.Second
+// DefaultHeartbeatInterval is the interval at which workers are expected to send heartbeats, unless it's set
+// with WithHeartbeatInterval.
+const DefaultHeartbeatInterval = 4 * time.Second
 
 // Heartbeat is used to update the last heartbeat time for a worker
 func (s *DispatcherImpl) Heartbeat(ctx context.Context, req *contracts.HeartbeatRequest) (*contracts.HeartbeatResponse, error) {
@@ -536,7 +673,7 @@ func (s *DispatcherImpl) Heartbeat(ctx context.Context, req *contracts.Heartbeat
 	s.l.Debug().Msgf("Received heartbeat request from ID: %s", req.WorkerId)
 
 	// if heartbeat time is greater than expected heartbeat interval, show a warning
-	if req.HeartbeatAt.AsTime().Before(heartbeatAt.Add(-1 * HeartbeatInterval)) {
+	if req.HeartbeatAt.AsTime().Before(heartbeatAt.Add(-1 * s.heartbeatInterval)) {
 		s.l.Warn().Msgf("heartbeat time is greater than expected heartbeat interval")
 	}
 
@@ -1070,11 +1207,59 @@ func (s *DispatcherImpl) SendStepActionEvent(ctx context.Context, request *contr
 		return s.handleStepRunCompleted(ctx, request)
 	case contracts.StepActionEventType_STEP_EVENT_TYPE_FAILED:
 		return s.handleStepRunFailed(ctx, request)
+	case contracts.StepActionEventType_STEP_EVENT_TYPE_SUSPENDED:
+		return s.handleStepRunSuspended(ctx, request)
+	case contracts.StepActionEventType_STEP_EVENT_TYPE_CANCELLED:
+		return s.handleStepRunCancelAcked(ctx, request)
 	}
 
 	return nil, fmt.Errorf("unknown event type %s", request.EventType)
 }
 
+// maxStepActionEventBatchSize is the maximum number of events in a batch sent with SendStepActionEvents.
+const maxStepActionEventBatchSize = 1000
+
+func (s *DispatcherImpl) SendStepActionEvents(ctx context.Context, request *contracts.StepActionEventBatch) (*contracts.StepActionEventBatchResponse, error) {
+	if len(request.Events) > maxStepActionEventBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch has %d events, the maximum is %d", len(request.Events), maxStepActionEventBatchSize)
+	}
+
+	res := &contracts.StepActionEventBatchResponse{
+		Responses: make([]*contracts.ActionEventResponse, len(request.Events)),
+		Errors:    make([]string, len(request.Events)),
+	}
+
+	// the step runs with an event which could not be processed
+	failedStepRuns := make(map[string]bool)
+
+	// events are processed in order, so that the started event of a step run is processed before its
+	// completed event. a failed event only fails the following events of its own step run, which would
+	// otherwise be processed out of order.
+	for i, event := range request.Events {
+		if failedStepRuns[event.StepRunId] {
+			res.Responses[i] = &contracts.ActionEventResponse{}
+			res.Errors[i] = fmt.Sprintf("an earlier event of step run %s could not be processed", event.StepRunId)
+			continue
+		}
+
+		eventRes, err := s.SendStepActionEvent(ctx, event)
+
+		if err != nil {
+			s.l.Err(err).Msgf("could not process %s event of step run %s", event.EventType, event.StepRunId)
+
+			failedStepRuns[event.StepRunId] = true
+
+			res.Responses[i] = &contracts.ActionEventResponse{}
+			res.Errors[i] = err.Error()
+			continue
+		}
+
+		res.Responses[i] = eventRes
+	}
+
+	return res, nil
+}
+
 func (s *DispatcherImpl) SendGroupKeyActionEvent(ctx context.Context, request *contracts.GroupKeyActionEvent) (*contracts.ActionEventResponse, error) {
 	switch request.EventType {
 	case contracts.GroupKeyActionEventType_GROUP_KEY_EVENT_TYPE_STARTED:
@@ -1355,6 +1540,8 @@ func (s *DispatcherImpl) handleStepRunFailed(inputCtx context.Context, request *
 
 	failedAt := request.EventTimestamp.AsTime()
 
+	errorCode, errorDetails := s.stepRunErrorDetails(request)
+
 	meta, err := s.repo.StepRun().GetStepRunMetaForEngine(ctx, tenantId, request.StepRunId)
 
 	if err != nil {
@@ -1362,12 +1549,15 @@ func (s *DispatcherImpl) handleStepRunFailed(inputCtx context.Context, request *
 	}
 
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskPayload{
-		WorkflowRunId: sqlchelpers.UUIDToStr(meta.WorkflowRunId),
-		StepRunId:     request.StepRunId,
-		FailedAt:      failedAt.Format(time.RFC3339),
-		Error:         request.EventPayload,
-		StepRetries:   &meta.Retries,
-		RetryCount:    &meta.RetryCount,
+		WorkflowRunId:  sqlchelpers.UUIDToStr(meta.WorkflowRunId),
+		StepRunId:      request.StepRunId,
+		FailedAt:       failedAt.Format(time.RFC3339),
+		Error:          request.EventPayload,
+		StepRetries:    &meta.Retries,
+		RetryCount:     &meta.RetryCount,
+		ShouldNotRetry: request.ShouldNotRetry,
+		ErrorCode:      errorCode,
+		ErrorDetails:   errorDetails,
 	})
 
 	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskMetadata{
@@ -1392,6 +1582,128 @@ func (s *DispatcherImpl) handleStepRunFailed(inputCtx context.Context, request *
 	}, nil
 }
 
+func (s *DispatcherImpl) handleStepRunSuspended(inputCtx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
+	tenant := inputCtx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if request.ResumeAt == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "suspended step run %s has no resume time", request.StepRunId)
+	}
+
+	// run the rest on a separate context to always send to job controller
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// the step run does not hold a slot on the worker while it is suspended
+	err := s.repo.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, request.StepRunId, false)
+
+	if err != nil {
+		s.l.Error().Err(err).Msgf("could not release semaphore for step run %s", request.StepRunId)
+		return nil, err
+	}
+
+	s.l.Debug().Msgf("Received step suspended event for step run %s", request.StepRunId)
+
+	meta, err := s.repo.StepRun().GetStepRunMetaForEngine(ctx, tenantId, request.StepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunSuspendedTaskPayload{
+		WorkflowRunId: sqlchelpers.UUIDToStr(meta.WorkflowRunId),
+		StepRunId:     request.StepRunId,
+		SuspendedAt:   request.EventTimestamp.AsTime().Format(time.RFC3339),
+		ResumeAt:      request.ResumeAt.AsTime().Format(time.RFC3339Nano),
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunSuspendedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	// send the event to the jobs queue
+	err = s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:       "step-run-suspended",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.ActionEventResponse{
+		TenantId: tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+// handleStepRunCancelAcked records that the worker stopped running a step run after it was cancelled. The
+// step run was already cancelled and its slot released when the cancellation was sent to the worker, so
+// the acknowledgement only shows up in the events of the step run.
+func (s *DispatcherImpl) handleStepRunCancelAcked(inputCtx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
+	tenant := inputCtx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	s.l.Debug().Msgf("Received step cancelled event for step run %s", request.StepRunId)
+
+	stoppedAt := request.EventTimestamp.AsTime()
+
+	s.repo.StepRun().DeferredStepRunEvent(
+		tenantId,
+		repository.CreateStepRunEventOpts{
+			StepRunId:     request.StepRunId,
+			EventMessage:  repository.StringPtr("Worker stopped the cancelled step run"),
+			EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonCANCELLED),
+			EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+			Timestamp:     &stoppedAt,
+			EventData:     map[string]interface{}{"worker_id": request.WorkerId},
+		},
+	)
+
+	return &contracts.ActionEventResponse{
+		TenantId: tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+const (
+	maxErrorCodeLength  = 256
+	maxErrorDetailsSize = 16 * 1024
+)
+
+// stepRunErrorDetails returns the structured error details of a failed step run event. Details which
+// are too large or not valid JSON are dropped, so that the step run still fails with its error message.
+func (s *DispatcherImpl) stepRunErrorDetails(request *contracts.StepActionEvent) (*string, json.RawMessage) {
+	if request.ErrorCode == nil || *request.ErrorCode == "" {
+		return nil, nil
+	}
+
+	if len(*request.ErrorCode) > maxErrorCodeLength {
+		s.l.Warn().Msgf("dropping error details of step run %s: error code exceeds %d bytes", request.StepRunId, maxErrorCodeLength)
+		return nil, nil
+	}
+
+	if request.ErrorDetails == nil || *request.ErrorDetails == "" {
+		return request.ErrorCode, nil
+	}
+
+	details := []byte(*request.ErrorDetails)
+
+	if len(details) > maxErrorDetailsSize {
+		s.l.Warn().Msgf("dropping error details of step run %s: details exceed %d bytes", request.StepRunId, maxErrorDetailsSize)
+		return request.ErrorCode, nil
+	}
+
+	if !json.Valid(details) {
+		s.l.Warn().Msgf("dropping error details of step run %s: details are not valid JSON", request.StepRunId)
+		return request.ErrorCode, nil
+	}
+
+	return request.ErrorCode, details
+}
+
 func (s *DispatcherImpl) handleGetGroupKeyRunStarted(inputCtx context.Context, request *contracts.GroupKeyActionEvent) (*contracts.ActionEventResponse, error) {
 	tenant := inputCtx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -5,10 +5,12 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 )
 
 type GRPCAuthN struct {
@@ -41,8 +43,25 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 		return nil, forbidden
 	}
 
+	apiToken, err := a.config.EngineRepository.APIToken().GetAPITokenById(ctx, tokenUUID)
+
+	if err != nil {
+		a.l.Debug().Err(err).Msgf("error getting api token: %s", err)
+		return nil, forbidden
+	}
+
+	if method, ok := grpc.Method(ctx); ok && !hasMethodScope(apiToken.Scopes, method) {
+		return nil, status.Errorf(codes.PermissionDenied, "api token does not have the scope for %s", method)
+	}
+
 	ctx = context.WithValue(ctx, "rate_limit_token", tokenUUID)
 
+	ctx = repository.ContextWithActor(ctx, &repository.Actor{
+		Type:      repository.ActorTypeAPIToken,
+		Id:        tokenUUID,
+		Namespace: apiToken.Namespace.String,
+	})
+
 	// get the tenant id
 	queriedTenant, err := a.config.EngineRepository.Tenant().GetTenantByID(ctx, tenantId)
 
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
+)
+
+// MetricsUnaryServerInterceptor counts unary requests by method and status code.
+func MetricsUnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	res, err := handler(ctx, req)
+
+	metrics.GRPCRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+	return res, err
+}
+
+// MetricsStreamServerInterceptor counts streams by method and the status code they ended with.
+func MetricsStreamServerInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, stream)
+
+	metrics.GRPCRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+	return err
+}
@@ -2,8 +2,10 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
@@ -11,6 +13,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	"golang.org/x/time/rate"
+
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
 )
 
 type HatchetApiTokenRateLimiter struct {
@@ -66,31 +70,61 @@ func (r *HatchetRateLimiter) Limit(ctx context.Context) error {
 		return status.Errorf(codes.Unauthenticated, "no rate limit token found")
 	}
 
+	var limiter *rate.Limiter
+	var name string
+
 	switch matchServiceName(serviceName) {
 	case "dispatcher":
+		limiter, name = r.GetOrCreateTenantRateLimiter(rateLimitToken).dispatcherLimiter, "dispatcher"
+	case "events":
+		limiter, name = r.GetOrCreateTenantRateLimiter(rateLimitToken).eventsLimiter, "ingest"
+	case "workflow":
+		limiter, name = r.GetOrCreateTenantRateLimiter(rateLimitToken).workflowLimiter, "admin"
+	default:
+		return status.Errorf(codes.Internal, "service %s not recognized", serviceName)
+	}
 
-		if !r.GetOrCreateTenantRateLimiter(rateLimitToken).dispatcherLimiter.Allow() {
-			r.l.Info().Msgf("dispatcher rate limit (%v per second) exceeded", r.GetOrCreateTenantRateLimiter(rateLimitToken).dispatcherLimiter.Limit())
-			return status.Errorf(codes.ResourceExhausted, "dispatcher rate limit exceeded")
-		}
+	if !limiter.Allow() {
+		r.l.Info().Msgf("%s rate limit (%v per second) exceeded", name, limiter.Limit())
 
-	case "events":
-		if !r.GetOrCreateTenantRateLimiter(rateLimitToken).eventsLimiter.Allow() {
-			r.l.Info().Msgf("ingest rate limit (%v per second) exceeded", r.GetOrCreateTenantRateLimiter(rateLimitToken).eventsLimiter.Limit())
-			return status.Errorf(codes.ResourceExhausted, "ingest rate limit exceeded")
+		return &backpressure.ResourceExhaustedError{
+			Reason:     fmt.Sprintf("%s rate limit exceeded", name),
+			RetryAfter: retryAfter(limiter),
 		}
+	}
 
-	case "workflow":
-		if !r.GetOrCreateTenantRateLimiter(rateLimitToken).workflowLimiter.Allow() {
-			r.l.Info().Msgf("workflow rate limit (%v per second) exceeded", r.GetOrCreateTenantRateLimiter(rateLimitToken).workflowLimiter.Limit())
-			return status.Errorf(codes.ResourceExhausted, "admin rate limit exceeded")
+	return nil
+}
+
+// retryAfter returns the time until the limiter allows the next request.
+func retryAfter(limiter *rate.Limiter) time.Duration {
+	reservation := limiter.Reserve()
+	defer reservation.Cancel()
+
+	return reservation.Delay()
+}
+
+// RateLimitUnaryServerInterceptor rejects the requests which exceed the rate limit. Unlike the interceptor of
+// go-grpc-middleware, it returns the error of the limiter as is, so clients receive its retry hint.
+func RateLimitUnaryServerInterceptor(limiter *HatchetRateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := limiter.Limit(ctx); err != nil {
+			return nil, err
 		}
 
-	default:
-		return status.Errorf(codes.Internal, "service %s not recognized", serviceName)
+		return handler(ctx, req)
 	}
+}
 
-	return nil
+// RateLimitStreamServerInterceptor rejects the streams which exceed the rate limit.
+func RateLimitStreamServerInterceptor(limiter *HatchetRateLimiter) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := limiter.Limit(stream.Context()); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
 }
 
 type contextKey string
@@ -0,0 +1,28 @@
+package middleware
+
+import "github.com/hatchet-dev/hatchet/pkg/repository"
+
+// methodScopes are the scopes which allow API tokens to call methods of the gRPC API. Methods which
+// map to an empty scope can be called with any token, and all other methods require the admin scope.
+var methodScopes = map[string]string{
+	"/WorkflowService/Health": "",
+
+	"/EventsService/Push":              repository.APITokenScopeEventsWrite,
+	"/EventsService/BulkPush":          repository.APITokenScopeEventsWrite,
+	"/EventsService/ReplaySingleEvent": repository.APITokenScopeEventsWrite,
+
+	"/Dispatcher/SubscribeToWorkflowEvents": repository.APITokenScopeRunsRead,
+	"/Dispatcher/SubscribeToWorkflowRuns":   repository.APITokenScopeRunsRead,
+}
+
+func hasMethodScope(scopes []string, fullMethod string) bool {
+	scope, ok := methodScopes[fullMethod]
+
+	if !ok {
+		scope = repository.APITokenScopeAdmin
+	} else if scope == "" {
+		return true
+	}
+
+	return repository.APITokenHasScope(scopes, scope)
+}
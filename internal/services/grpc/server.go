@@ -10,7 +10,6 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
-	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/ratelimit"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/rs/zerolog"
 	"golang.org/x/time/rate"
@@ -21,6 +20,8 @@ import (
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 
+	// registers the gzip and zstd compressors, so clients can compress their messages
+	_ "github.com/hatchet-dev/hatchet/internal/grpccompress"
 	"github.com/hatchet-dev/hatchet/internal/services/admin"
 	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher"
@@ -240,19 +241,21 @@ func (s *Server) startGRPC() (func() error, error) {
 	}
 
 	serverOpts = append(serverOpts, grpc.ChainStreamInterceptor(
+		middleware.MetricsStreamServerInterceptor,
 		logging.StreamServerInterceptor(middleware.InterceptorLogger(s.l), opts...),
 		auth.StreamServerInterceptor(authMiddleware.Middleware),
 		middleware.ServerNameStreamingInterceptor,
-		ratelimit.StreamServerInterceptor(limiter),
+		middleware.RateLimitStreamServerInterceptor(limiter),
 		errorInterceptor.ErrorStreamServerInterceptor(),
 		recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
 	))
 
 	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(
+		middleware.MetricsUnaryServerInterceptor,
 		logging.UnaryServerInterceptor(middleware.InterceptorLogger(s.l), opts...),
 		auth.UnaryServerInterceptor(authMiddleware.Middleware),
 		middleware.AttachServerNameInterceptor,
-		ratelimit.UnaryServerInterceptor(limiter),
+		middleware.RateLimitUnaryServerInterceptor(limiter),
 		errorInterceptor.ErrorUnaryServerInterceptor(),
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
 	))
@@ -2,14 +2,21 @@ package ingestor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -19,6 +26,11 @@ import (
 type Ingestor interface {
 	contracts.EventsServiceServer
 	IngestEvent(ctx context.Context, tenantId, eventName string, data []byte, metadata []byte) (*dbsqlc.Event, error)
+
+	// IngestEventWithOpts ingests an event which may have an ordering key and an external id. If the external id
+	// was already accepted for the tenant, it returns repository.ErrEventExternalIdExists with the original event,
+	// which is enqueued again unless it has already triggered workflow runs.
+	IngestEventWithOpts(ctx context.Context, opts *repository.CreateEventOpts) (*dbsqlc.Event, error)
 	BulkIngestEvent(ctx context.Context, tenantID string, eventOpts []*repository.CreateEventOpts) ([]*dbsqlc.Event, error)
 	IngestReplayedEvent(ctx context.Context, tenantId string, replayedEvent *dbsqlc.Event) (*dbsqlc.Event, error)
 }
@@ -30,7 +42,11 @@ type IngestorOpts struct {
 	streamEventRepository  repository.StreamEventsEngineRepository
 	logRepository          repository.LogsEngineRepository
 	entitlementsRepository repository.EntitlementsRepository
+	stepRunRepository      repository.StepRunEngineRepository
 	mq                     msgqueue.MessageQueue
+
+	maxQueueDepth        int
+	queueDepthRetryAfter time.Duration
 }
 
 func WithEventRepository(r repository.EventEngineRepository) IngestorOptFunc {
@@ -57,6 +73,21 @@ func WithEntitlementsRepository(r repository.EntitlementsRepository) IngestorOpt
 	}
 }
 
+func WithStepRunRepository(r repository.StepRunEngineRepository) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.stepRunRepository = r
+	}
+}
+
+// WithMaxQueueDepth rejects the events of tenants which have more than maxDepth queued step runs with a
+// resource-exhausted error, which tells clients to retry after retryAfter. A maxDepth of 0 disables the check.
+func WithMaxQueueDepth(maxDepth int, retryAfter time.Duration) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.maxQueueDepth = maxDepth
+		opts.queueDepthRetryAfter = retryAfter
+	}
+}
+
 func WithMessageQueue(mq msgqueue.MessageQueue) IngestorOptFunc {
 	return func(opts *IngestorOpts) {
 		opts.mq = mq
@@ -64,9 +95,15 @@ func WithMessageQueue(mq msgqueue.MessageQueue) IngestorOptFunc {
 }
 
 func defaultIngestorOpts() *IngestorOpts {
-	return &IngestorOpts{}
+	return &IngestorOpts{
+		queueDepthRetryAfter: 10 * time.Second,
+	}
 }
 
+// queueDepthCacheDuration is how long the queue depth of a tenant is cached, so that bursts of events don't count
+// the queued step runs on every request.
+const queueDepthCacheDuration = 5 * time.Second
+
 type IngestorImpl struct {
 	contracts.UnimplementedEventsServiceServer
 
@@ -74,6 +111,11 @@ type IngestorImpl struct {
 	logRepository          repository.LogsEngineRepository
 	streamEventRepository  repository.StreamEventsEngineRepository
 	entitlementsRepository repository.EntitlementsRepository
+	stepRunRepository      repository.StepRunEngineRepository
+
+	maxQueueDepth        int
+	queueDepthRetryAfter time.Duration
+	queueDepthCache      cache.Cacheable
 
 	mq msgqueue.MessageQueue
 	v  validator.Validator
@@ -102,10 +144,18 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 		return nil, fmt.Errorf("task queue is required. use WithMessageQueue")
 	}
 
+	if opts.maxQueueDepth > 0 && opts.stepRunRepository == nil {
+		return nil, fmt.Errorf("step run repository is required when the queue depth is limited. use WithStepRunRepository")
+	}
+
 	return &IngestorImpl{
 		eventRepository:        opts.eventRepository,
 		streamEventRepository:  opts.streamEventRepository,
 		entitlementsRepository: opts.entitlementsRepository,
+		stepRunRepository:      opts.stepRunRepository,
+		maxQueueDepth:          opts.maxQueueDepth,
+		queueDepthRetryAfter:   opts.queueDepthRetryAfter,
+		queueDepthCache:        cache.New(queueDepthCacheDuration),
 
 		logRepository: opts.logRepository,
 		mq:            opts.mq,
@@ -114,22 +164,54 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 }
 
 func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, data []byte, metadata []byte) (*dbsqlc.Event, error) {
-	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
-	defer span.End()
-
-	event, err := i.eventRepository.CreateEvent(ctx, &repository.CreateEventOpts{
+	return i.ingestEvent(ctx, &repository.CreateEventOpts{
 		TenantId:           tenantId,
 		Key:                key,
 		Data:               data,
 		AdditionalMetadata: metadata,
 	})
+}
+
+func (i *IngestorImpl) IngestEventWithOpts(ctx context.Context, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
+	if err := i.v.Validate(opts); err != nil {
+		return nil, fmt.Errorf("invalid event: %w", err)
+	}
+
+	return i.ingestEvent(ctx, opts)
+}
+
+func (i *IngestorImpl) ingestEvent(ctx context.Context, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
+	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
+	defer span.End()
+
+	if err := repository.ActorFromContext(ctx).CheckNamespace(opts.Key); err != nil {
+		return nil, err
+	}
+
+	if err := i.checkQueueDepth(ctx, opts.TenantId); err != nil {
+		return nil, err
+	}
+
+	event, err := i.eventRepository.CreateEvent(ctx, opts)
 
 	if err == metered.ErrResourceExhausted {
 		return nil, metered.ErrResourceExhausted
 	}
 
+	existsErr := repository.ErrEventExternalIdExists{}
+
+	if errors.As(err, &existsErr) {
+		if existsErr.Event != nil && len(existsErr.WorkflowRunIds) == 0 {
+			if err := i.redeliverEvent(ctx, existsErr.Event, opts); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, existsErr
+	}
+
 	if err != nil {
-		return nil, fmt.Errorf("could not create events: %w", err)
+		return nil, toBackpressureError(fmt.Errorf("could not create events: %w", err))
 	}
 
 	telemetry.WithAttributes(span, telemetry.AttributeKV{
@@ -137,7 +219,7 @@ func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, da
 		Value: event.ID,
 	})
 
-	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
+	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event, repository.ActorFromContext(ctx), opts))
 	if err != nil {
 		return nil, fmt.Errorf("could not add event to task queue: %w", err)
 
@@ -146,10 +228,39 @@ func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, da
 	return event, nil
 }
 
+// redeliverEvent enqueues an event again which was pushed with an external id that already exists, and which
+// hasn't triggered any workflow runs. The event is committed before it is enqueued, so the original push may have
+// stored the event but failed to enqueue it, in which case the producer's retry is the only chance to process it.
+// The events controller skips redelivered events whose runs were created in the meantime.
+func (i *IngestorImpl) redeliverEvent(ctx context.Context, event *dbsqlc.Event, opts *repository.CreateEventOpts) error {
+	payload := eventToTaskPayload(event, repository.ActorFromContext(ctx), opts)
+	payload.Redelivered = true
+
+	err := i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventTaskFromPayload(event, payload))
+
+	if err != nil {
+		return fmt.Errorf("could not add event to task queue: %w", err)
+	}
+
+	return nil
+}
+
 func (i *IngestorImpl) BulkIngestEvent(ctx context.Context, tenantId string, eventOpts []*repository.CreateEventOpts) ([]*dbsqlc.Event, error) {
 	ctx, span := telemetry.NewSpan(ctx, "bulk-ingest-event")
 	defer span.End()
 
+	actor := repository.ActorFromContext(ctx)
+
+	for _, opts := range eventOpts {
+		if err := actor.CheckNamespace(opts.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := i.checkQueueDepth(ctx, tenantId); err != nil {
+		return nil, err
+	}
+
 	events, err := i.eventRepository.BulkCreateEvent(ctx, &repository.BulkCreateEventOpts{
 		Events:   eventOpts,
 		TenantId: tenantId,
@@ -160,7 +271,7 @@ func (i *IngestorImpl) BulkIngestEvent(ctx context.Context, tenantId string, eve
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("could not create events: %w", err)
+		return nil, toBackpressureError(fmt.Errorf("could not create events: %w", err))
 	}
 
 	// TODO any attributes we want to add here? could jam in all the event ids? but could be a lot
@@ -170,8 +281,9 @@ func (i *IngestorImpl) BulkIngestEvent(ctx context.Context, tenantId string, eve
 	// 	Value: event.ID,
 	// })
 
-	for _, event := range events.Events {
-		err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
+	// events are returned in the order of eventOpts
+	for j, event := range events.Events {
+		err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event, repository.ActorFromContext(ctx), eventOpts[j]))
 		if err != nil {
 			return nil, fmt.Errorf("could not add event to task queue: %w", err)
 		}
@@ -184,6 +296,10 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 	ctx, span := telemetry.NewSpan(ctx, "ingest-replayed-event")
 	defer span.End()
 
+	if err := repository.ActorFromContext(ctx).CheckNamespace(replayedEvent.Key); err != nil {
+		return nil, err
+	}
+
 	replayedId := sqlchelpers.UUIDToStr(replayedEvent.ID)
 
 	event, err := i.eventRepository.CreateEvent(ctx, &repository.CreateEventOpts{
@@ -202,7 +318,7 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 		return nil, fmt.Errorf("could not create event: %w", err)
 	}
 
-	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
+	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event, repository.ActorFromContext(ctx), nil))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not add event to task queue: %w", err)
@@ -211,9 +327,67 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 	return event, nil
 }
 
-func eventToTask(e *dbsqlc.Event) *msgqueue.Message {
+// checkQueueDepth returns a *backpressure.ResourceExhaustedError if the tenant has more queued step runs than the
+// ingestor accepts.
+func (i *IngestorImpl) checkQueueDepth(ctx context.Context, tenantId string) error {
+	if i.maxQueueDepth <= 0 {
+		return nil
+	}
+
+	depth, err := cache.MakeCacheable[int](i.queueDepthCache, tenantId, func() (*int, error) {
+		counts, err := i.stepRunRepository.GetQueueCounts(ctx, tenantId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		depth := 0
+
+		for _, count := range counts {
+			depth += count
+		}
+
+		return &depth, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not get queue depth: %w", err)
+	}
+
+	if *depth > i.maxQueueDepth {
+		return &backpressure.ResourceExhaustedError{
+			Reason:     fmt.Sprintf("tenant has more than %d queued step runs", i.maxQueueDepth),
+			RetryAfter: i.queueDepthRetryAfter,
+		}
+	}
+
+	return nil
+}
+
+// bufferFullRetryAfter is the retry hint for events which were rejected because the ingest buffer is full.
+const bufferFullRetryAfter = time.Second
+
+// toBackpressureError converts the resource-exhausted errors of a full ingest buffer to a
+// *backpressure.ResourceExhaustedError, and returns other errors as is.
+func toBackpressureError(err error) error {
+	var statusErr interface{ GRPCStatus() *status.Status }
+
+	if errors.As(err, &statusErr) && statusErr.GRPCStatus().Code() == codes.ResourceExhausted {
+		return &backpressure.ResourceExhaustedError{
+			Reason:     statusErr.GRPCStatus().Message(),
+			RetryAfter: bufferFullRetryAfter,
+		}
+	}
+
+	return err
+}
+
+func eventToTask(e *dbsqlc.Event, producer *repository.Actor, opts *repository.CreateEventOpts) *msgqueue.Message {
+	return eventTaskFromPayload(e, eventToTaskPayload(e, producer, opts))
+}
+
+func eventToTaskPayload(e *dbsqlc.Event, producer *repository.Actor, opts *repository.CreateEventOpts) tasktypes.EventTaskPayload {
 	eventId := sqlchelpers.UUIDToStr(e.ID)
-	tenantId := sqlchelpers.UUIDToStr(e.TenantId)
 
 	payloadTyped := tasktypes.EventTaskPayload{
 		EventId:                 eventId,
@@ -222,11 +396,28 @@ func eventToTask(e *dbsqlc.Event) *msgqueue.Message {
 		EventAdditionalMetadata: string(e.AdditionalMetadata),
 	}
 
+	if producer != nil {
+		payloadTyped.ProducerType = string(producer.Type)
+		payloadTyped.ProducerId = producer.Id
+	}
+
+	if opts != nil {
+		if opts.OrderingKey != nil {
+			payloadTyped.OrderingKey = *opts.OrderingKey
+		}
+
+		payloadTyped.Priority = opts.Priority
+	}
+
+	return payloadTyped
+}
+
+func eventTaskFromPayload(e *dbsqlc.Event, payloadTyped tasktypes.EventTaskPayload) *msgqueue.Message {
 	payload, _ := datautils.ToJSONMap(payloadTyped)
 
 	metadata, _ := datautils.ToJSONMap(tasktypes.EventTaskMetadata{
 		EventKey: e.Key,
-		TenantId: tenantId,
+		TenantId: sqlchelpers.UUIDToStr(e.TenantId),
 	})
 
 	return &msgqueue.Message{
@@ -0,0 +1,438 @@
+package kafka
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = 30 * time.Second
+
+	// ingestTimeout bounds the ingestion of a single record, which is not cancelled when the bridge is
+	// stopped so that the record in flight is committed.
+	ingestTimeout = 30 * time.Second
+)
+
+// Topic maps the records of a Kafka topic to the events of a tenant.
+type Topic struct {
+	// Name is the name of the topic.
+	Name string
+
+	// TenantId is the tenant which the events are pushed to.
+	TenantId string
+
+	// EventKey is the key of the events, which defaults to the name of the topic.
+	EventKey string
+
+	// Headers maps the names of record headers to keys of the additional metadata of the events. Headers
+	// which are not mapped are dropped.
+	Headers map[string]string
+}
+
+type BridgeOpt func(*BridgeOpts)
+
+type BridgeOpts struct {
+	ingestor      ingestor.Ingestor
+	l             *zerolog.Logger
+	brokers       []string
+	groupId       string
+	topics        []Topic
+	startOffset   int64
+	deduplicate   bool
+	tlsConfig     *tls.Config
+	saslMechanism string
+	saslUsername  string
+	saslPassword  string
+}
+
+func defaultBridgeOpts() *BridgeOpts {
+	l := logger.NewDefaultLogger("kafka-bridge")
+
+	return &BridgeOpts{
+		l:           &l,
+		groupId:     "hatchet",
+		startOffset: kafkago.LastOffset,
+		deduplicate: true,
+	}
+}
+
+func WithIngestor(i ingestor.Ingestor) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.ingestor = i
+	}
+}
+
+func WithLogger(l *zerolog.Logger) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.l = l
+	}
+}
+
+func WithBrokers(brokers ...string) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.brokers = brokers
+	}
+}
+
+// WithGroupID sets the consumer group of the bridge. Engine instances with the same group id share the
+// partitions of the topics.
+func WithGroupID(groupId string) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.groupId = groupId
+	}
+}
+
+func WithTopics(topics ...Topic) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.topics = append(opts.topics, topics...)
+	}
+}
+
+// WithStartOffset sets where a consumer group without committed offsets starts to consume, which is
+// "earliest" or "latest".
+func WithStartOffset(startOffset string) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		switch startOffset {
+		case "earliest":
+			opts.startOffset = kafkago.FirstOffset
+		case "latest":
+			opts.startOffset = kafkago.LastOffset
+		default:
+			opts.startOffset = 0
+		}
+	}
+}
+
+// WithDeduplication sets whether records are pushed with an external id which is derived from their topic,
+// partition and offset, so that records which are consumed again after a crash don't create duplicate events.
+func WithDeduplication(deduplicate bool) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.deduplicate = deduplicate
+	}
+}
+
+func WithTLS(tlsConfig *tls.Config) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.tlsConfig = tlsConfig
+	}
+}
+
+// WithSASL authenticates with the brokers. The mechanism is "plain", "scram-sha-256" or "scram-sha-512".
+func WithSASL(mechanism, username, password string) BridgeOpt {
+	return func(opts *BridgeOpts) {
+		opts.saslMechanism = mechanism
+		opts.saslUsername = username
+		opts.saslPassword = password
+	}
+}
+
+// Bridge consumes records from Kafka topics and pushes each record as an event. Offsets are committed after
+// the event of a record was ingested, so every record is pushed at least once.
+type Bridge struct {
+	ingestor    ingestor.Ingestor
+	l           *zerolog.Logger
+	brokers     []string
+	groupId     string
+	topics      []Topic
+	startOffset int64
+	deduplicate bool
+	dialer      *kafkago.Dialer
+}
+
+func New(fs ...BridgeOpt) (*Bridge, error) {
+	opts := defaultBridgeOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.ingestor == nil {
+		return nil, fmt.Errorf("ingestor is required. use WithIngestor")
+	}
+
+	if len(opts.brokers) == 0 {
+		return nil, fmt.Errorf("at least one broker is required. use WithBrokers")
+	}
+
+	if opts.groupId == "" {
+		return nil, fmt.Errorf("group id is required")
+	}
+
+	if len(opts.topics) == 0 {
+		return nil, fmt.Errorf("at least one topic is required. use WithTopics")
+	}
+
+	if opts.startOffset == 0 {
+		return nil, fmt.Errorf("start offset must be earliest or latest")
+	}
+
+	topics := make([]Topic, 0, len(opts.topics))
+	seen := make(map[string]bool, len(opts.topics))
+
+	for _, topic := range opts.topics {
+		if topic.Name == "" {
+			return nil, fmt.Errorf("topic name is required")
+		}
+
+		if seen[topic.Name] {
+			return nil, fmt.Errorf("topic %s is configured more than once", topic.Name)
+		}
+
+		seen[topic.Name] = true
+
+		if _, err := uuid.Parse(topic.TenantId); err != nil {
+			return nil, fmt.Errorf("topic %s has an invalid tenant id %q", topic.Name, topic.TenantId)
+		}
+
+		if topic.EventKey == "" {
+			topic.EventKey = topic.Name
+		}
+
+		topics = append(topics, topic)
+	}
+
+	mechanism, err := saslMechanism(opts.saslMechanism, opts.saslUsername, opts.saslPassword)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bridge{
+		ingestor:    opts.ingestor,
+		l:           opts.l,
+		brokers:     opts.brokers,
+		groupId:     opts.groupId,
+		topics:      topics,
+		startOffset: opts.startOffset,
+		deduplicate: opts.deduplicate,
+		dialer: &kafkago.Dialer{
+			Timeout:       10 * time.Second,
+			DualStack:     true,
+			TLS:           opts.tlsConfig,
+			SASLMechanism: mechanism,
+		},
+	}, nil
+}
+
+func saslMechanism(mechanism, username, password string) (sasl.Mechanism, error) {
+	switch mechanism {
+	case "":
+		return nil, nil
+	case "plain":
+		return plain.Mechanism{
+			Username: username,
+			Password: password,
+		}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, username, password)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, username, password)
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism %q", mechanism)
+	}
+}
+
+// Start consumes the topics until the returned cleanup function is called, which waits for the records in
+// flight to be ingested and commits their offsets.
+func (b *Bridge) Start() (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	wg := sync.WaitGroup{}
+	readers := make([]*kafkago.Reader, 0, len(b.topics))
+
+	for _, topic := range b.topics {
+		r := kafkago.NewReader(kafkago.ReaderConfig{
+			Brokers:        b.brokers,
+			GroupID:        b.groupId,
+			Topic:          topic.Name,
+			Dialer:         b.dialer,
+			StartOffset:    b.startOffset,
+			CommitInterval: time.Second,
+			ErrorLogger: kafkago.LoggerFunc(func(msg string, args ...interface{}) {
+				b.l.Error().Msgf("kafka: "+msg, args...)
+			}),
+		})
+
+		readers = append(readers, r)
+
+		wg.Add(1)
+
+		go func(topic Topic) {
+			defer wg.Done()
+
+			b.consume(ctx, r, topic)
+		}(topic)
+	}
+
+	cleanup := func() error {
+		cancel()
+		wg.Wait()
+
+		var errs []error
+
+		for _, r := range readers {
+			if err := r.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("could not close reader of topic %s: %w", r.Config().Topic, err))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+
+	return cleanup, nil
+}
+
+func (b *Bridge) consume(ctx context.Context, r *kafkago.Reader, topic Topic) {
+	for {
+		msg, err := r.FetchMessage(ctx)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			b.l.Error().Err(err).Msgf("could not fetch record from topic %s", topic.Name)
+
+			if !sleep(ctx, minRetryDelay) {
+				return
+			}
+
+			continue
+		}
+
+		if !b.ingest(ctx, topic, msg) {
+			return
+		}
+
+		// commits are sent to the commit loop of the reader, which keeps running until the reader is closed
+		if err := r.CommitMessages(context.Background(), msg); err != nil {
+			b.l.Error().Err(err).Msgf("could not commit offset %d of partition %d of topic %s", msg.Offset, msg.Partition, topic.Name)
+		}
+	}
+}
+
+// ingest pushes the record as an event, retrying until the event was ingested. It returns false if the bridge
+// was stopped before the event was ingested. Records which can't be converted to events are skipped.
+func (b *Bridge) ingest(ctx context.Context, topic Topic, msg kafkago.Message) bool {
+	opts, err := b.toCreateEventOpts(topic, msg)
+
+	if err != nil {
+		b.l.Error().Err(err).Msgf("skipping record at offset %d of partition %d of topic %s", msg.Offset, msg.Partition, topic.Name)
+		return true
+	}
+
+	delay := minRetryDelay
+
+	for {
+		ingestCtx, cancel := context.WithTimeout(context.Background(), ingestTimeout)
+		_, err := b.ingestor.IngestEventWithOpts(ingestCtx, opts)
+		cancel()
+
+		existsErr := repository.ErrEventExternalIdExists{}
+
+		if err == nil || errors.As(err, &existsErr) {
+			return true
+		}
+
+		b.l.Warn().Err(err).Msgf("could not ingest record at offset %d of partition %d of topic %s, retrying in %s", msg.Offset, msg.Partition, topic.Name, delay)
+
+		if !sleep(ctx, delay) {
+			return false
+		}
+
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+func (b *Bridge) toCreateEventOpts(topic Topic, msg kafkago.Message) (*repository.CreateEventOpts, error) {
+	data := msg.Value
+
+	// tombstones and other records without a value are pushed as events without data
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+
+	var obj map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("record value is not a JSON object: %w", err)
+	}
+
+	metadata := map[string]interface{}{
+		"kafka_topic":     msg.Topic,
+		"kafka_partition": msg.Partition,
+		"kafka_offset":    msg.Offset,
+	}
+
+	if len(msg.Key) != 0 {
+		metadata["kafka_key"] = string(msg.Key)
+	}
+
+	for _, header := range msg.Headers {
+		if key, ok := topic.Headers[header.Key]; ok {
+			metadata[key] = string(header.Value)
+		}
+	}
+
+	metadataBytes, err := json.Marshal(metadata)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal additional metadata: %w", err)
+	}
+
+	opts := &repository.CreateEventOpts{
+		TenantId:           topic.TenantId,
+		Key:                topic.EventKey,
+		Data:               data,
+		AdditionalMetadata: metadataBytes,
+	}
+
+	if b.deduplicate {
+		externalId := recordExternalId(msg)
+		opts.ExternalId = &externalId
+	}
+
+	return opts, nil
+}
+
+// recordExternalId identifies the record by its topic, partition and offset. External ids are limited to 255
+// characters, so the ids of records of topics with long names are hashed.
+func recordExternalId(msg kafkago.Message) string {
+	id := fmt.Sprintf("kafka:%s:%d:%d", msg.Topic, msg.Partition, msg.Offset)
+
+	if len(id) > 255 {
+		sum := sha256.Sum256([]byte(id))
+		id = "kafka:" + hex.EncodeToString(sum[:])
+	}
+
+	return id
+}
+
+// sleep waits for the delay and returns false if the context was cancelled first.
+func sleep(ctx context.Context, delay time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(delay):
+		return true
+	}
+}
@@ -0,0 +1,186 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+const tenantId = "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+
+type fakeIngestor struct {
+	ingestor.Ingestor
+
+	calls int
+	errs  []error
+	opts  []*repository.CreateEventOpts
+}
+
+func (f *fakeIngestor) IngestEventWithOpts(ctx context.Context, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
+	f.calls++
+	f.opts = append(f.opts, opts)
+
+	if len(f.errs) != 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+
+		return nil, err
+	}
+
+	return &dbsqlc.Event{}, nil
+}
+
+func newBridge(t *testing.T, i ingestor.Ingestor, fs ...BridgeOpt) *Bridge {
+	b, err := New(append([]BridgeOpt{
+		WithIngestor(i),
+		WithBrokers("localhost:9092"),
+		WithTopics(Topic{
+			Name:     "orders",
+			TenantId: tenantId,
+			Headers: map[string]string{
+				"source": "order_source",
+			},
+		}),
+	}, fs...)...)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestToCreateEventOpts(t *testing.T) {
+	b := newBridge(t, &fakeIngestor{})
+
+	opts, err := b.toCreateEventOpts(b.topics[0], kafkago.Message{
+		Topic:     "orders",
+		Partition: 2,
+		Offset:    42,
+		Key:       []byte("order-1"),
+		Value:     []byte(`{"amount": 10}`),
+		Headers: []kafkago.Header{
+			{Key: "source", Value: []byte("checkout")},
+			{Key: "unmapped", Value: []byte("dropped")},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, tenantId, opts.TenantId)
+	assert.Equal(t, "orders", opts.Key, "the event key should default to the topic")
+	assert.JSONEq(t, `{"amount": 10}`, string(opts.Data))
+	assert.JSONEq(t, `{
+		"kafka_topic": "orders",
+		"kafka_partition": 2,
+		"kafka_offset": 42,
+		"kafka_key": "order-1",
+		"order_source": "checkout"
+	}`, string(opts.AdditionalMetadata))
+
+	require.NotNil(t, opts.ExternalId)
+	assert.Equal(t, "kafka:orders:2:42", *opts.ExternalId)
+}
+
+func TestToCreateEventOptsValues(t *testing.T) {
+	b := newBridge(t, &fakeIngestor{}, WithDeduplication(false))
+
+	opts, err := b.toCreateEventOpts(b.topics[0], kafkago.Message{Topic: "orders"})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{}`, string(opts.Data), "records without a value should have empty data")
+	assert.Nil(t, opts.ExternalId, "records should not have an external id without deduplication")
+
+	_, err = b.toCreateEventOpts(b.topics[0], kafkago.Message{Topic: "orders", Value: []byte("not json")})
+	assert.Error(t, err)
+
+	_, err = b.toCreateEventOpts(b.topics[0], kafkago.Message{Topic: "orders", Value: []byte(`[1, 2]`)})
+	assert.Error(t, err, "values which are not JSON objects should be rejected")
+}
+
+func TestRecordExternalIdLongTopic(t *testing.T) {
+	id := recordExternalId(kafkago.Message{Topic: strings.Repeat("t", 249), Partition: 1, Offset: 1})
+
+	assert.LessOrEqual(t, len(id), 255)
+	assert.True(t, strings.HasPrefix(id, "kafka:"))
+}
+
+func TestIngest(t *testing.T) {
+	msg := kafkago.Message{Topic: "orders", Value: []byte(`{}`)}
+
+	t.Run("duplicate", func(t *testing.T) {
+		i := &fakeIngestor{errs: []error{
+			repository.ErrEventExternalIdExists{ExternalId: "kafka:orders:0:0"},
+		}}
+		b := newBridge(t, i)
+
+		assert.True(t, b.ingest(context.Background(), b.topics[0], msg), "duplicate records should count as ingested")
+		assert.Equal(t, 1, i.calls)
+	})
+
+	t.Run("invalid record", func(t *testing.T) {
+		i := &fakeIngestor{}
+		b := newBridge(t, i)
+
+		assert.True(t, b.ingest(context.Background(), b.topics[0], kafkago.Message{Topic: "orders", Value: []byte("{")}), "invalid records should be skipped")
+		assert.Equal(t, 0, i.calls)
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		i := &fakeIngestor{errs: []error{errors.New("database unavailable")}}
+		b := newBridge(t, i)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.False(t, b.ingest(ctx, b.topics[0], msg), "records should not be committed if the bridge stops before they are ingested")
+		assert.Equal(t, 1, i.calls)
+	})
+}
+
+func TestNewValidation(t *testing.T) {
+	tests := map[string][]BridgeOpt{
+		"invalid tenant id": {
+			WithTopics(Topic{Name: "payments", TenantId: "tenant"}),
+		},
+		"duplicate topic": {
+			WithTopics(Topic{Name: "orders", TenantId: tenantId}),
+		},
+		"invalid start offset": {
+			WithStartOffset("newest"),
+		},
+		"unsupported sasl mechanism": {
+			WithSASL("gssapi", "user", "password"),
+		},
+	}
+
+	for name, fs := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(append([]BridgeOpt{
+				WithIngestor(&fakeIngestor{}),
+				WithBrokers("localhost:9092"),
+				WithTopics(Topic{Name: "orders", TenantId: tenantId}),
+			}, fs...)...)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestToCreateEventOptsWithoutKey(t *testing.T) {
+	b := newBridge(t, &fakeIngestor{})
+
+	opts, err := b.toCreateEventOpts(b.topics[0], kafkago.Message{Topic: "orders"})
+	require.NoError(t, err)
+
+	var metadata map[string]interface{}
+	require.NoError(t, json.Unmarshal(opts.AdditionalMetadata, &metadata))
+
+	assert.NotContains(t, metadata, "kafka_key", "records without a key should not have a key in the metadata")
+}
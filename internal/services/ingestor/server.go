@@ -2,6 +2,8 @@ package ingestor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"strconv"
 	"time"
 
@@ -29,12 +31,75 @@ func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest
 	if req.AdditionalMetadata != nil {
 		additionalMeta = []byte(*req.AdditionalMetadata)
 	}
-	event, err := i.IngestEvent(ctx, tenantId, req.Key, []byte(req.Payload), additionalMeta)
+
+	opts := &repository.CreateEventOpts{
+		TenantId:           tenantId,
+		Key:                req.Key,
+		Data:               []byte(req.Payload),
+		AdditionalMetadata: additionalMeta,
+		OrderingKey:        req.OrderingKey,
+		OrderingSequence:   req.Sequence,
+		ExternalId:         req.ExternalId,
+		Priority:           req.Priority,
+	}
+
+	if err := validateEventNamespace(ctx, opts.Key); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventOrdering(opts); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventPriority(opts); err != nil {
+		return nil, err
+	}
+
+	if req.ExternalId != nil && (*req.ExternalId == "" || len(*req.ExternalId) > 255) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: external id must be between 1 and 255 characters")
+	}
+
+	if req.ExternalIdWindow != nil {
+		if req.ExternalId == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: external id window requires an external id")
+		}
+
+		window, err := time.ParseDuration(*req.ExternalIdWindow)
+
+		if err != nil || window <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: invalid external id window %s", *req.ExternalIdWindow)
+		}
+
+		expiresAt := time.Now().UTC().Add(window)
+		opts.ExternalIdExpiresAt = &expiresAt
+	}
+
+	event, err := i.ingestEvent(ctx, opts)
 
 	if err == metered.ErrResourceExhausted {
 		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: event limit exceeded for tenant")
 	}
 
+	externalIdTarget := repository.ErrEventExternalIdExists{}
+
+	if errors.As(err, &externalIdTarget) {
+		// the original event was deleted in the meantime
+		if externalIdTarget.Event == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "event with external id %s already exists", externalIdTarget.ExternalId)
+		}
+
+		e, err := toEvent(externalIdTarget.Event)
+
+		if err != nil {
+			return nil, err
+		}
+
+		e.Duplicate = true
+		e.WorkflowRunIds = externalIdTarget.WorkflowRunIds
+
+		return e, nil
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -62,35 +127,48 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: too many events - %d is over maximum (1000)", len(req.Events))
 	}
 
+	partial := req.Partial != nil && *req.Partial
+
 	events := make([]*repository.CreateEventOpts, 0)
 
-	for _, e := range req.Events {
-		var additionalMeta []byte
-		if e.AdditionalMetadata != nil {
-			additionalMeta = []byte(*e.AdditionalMetadata)
+	// the errors of the events in the order of the request, only used for partial pushes
+	eventErrs := make([]string, len(req.Events))
+
+	for j, e := range req.Events {
+		opts, err := i.toBulkCreateEventOpts(ctx, tenantId, e)
+
+		if err != nil {
+			if !partial {
+				return nil, err
+			}
+
+			eventErrs[j] = status.Convert(err).Message()
+			continue
 		}
-		events = append(events, &repository.CreateEventOpts{
-			TenantId:           tenantId,
-			Key:                e.Key,
-			Data:               []byte(e.Payload),
-			AdditionalMetadata: additionalMeta,
-		})
-	}
 
-	opts := &repository.BulkCreateEventOpts{
-		TenantId: tenantId,
-		Events:   events,
+		events = append(events, opts)
 	}
 
-	if err := i.v.Validate(opts); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
+	if !partial {
+		opts := &repository.BulkCreateEventOpts{
+			TenantId: tenantId,
+			Events:   events,
+		}
+
+		if err := i.v.Validate(opts); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
+		}
 	}
 
-	for _, e := range opts.Events {
+	res := &contracts.Events{}
 
-		if err := i.v.Validate(e); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: events failing validation %s", err)
-		}
+	if partial {
+		res.Errors = eventErrs
+	}
+
+	// every event of a partial push was rejected
+	if len(events) == 0 {
+		return res, nil
 	}
 
 	createdEvents, err := i.BulkIngestEvent(ctx, tenantId, events)
@@ -102,7 +180,6 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 		return nil, err
 	}
 
-	var contractEvents []*contracts.Event
 	for _, e := range createdEvents {
 
 		contractEvent, err := toEvent(e)
@@ -111,11 +188,83 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 			return nil, err
 		}
 
-		contractEvents = append(contractEvents, contractEvent)
+		res.Events = append(res.Events, contractEvent)
+
+	}
+
+	return res, nil
+}
+
+func (i *IngestorImpl) toBulkCreateEventOpts(ctx context.Context, tenantId string, e *contracts.PushEventRequest) (*repository.CreateEventOpts, error) {
+	var additionalMeta []byte
+	if e.AdditionalMetadata != nil {
+		additionalMeta = []byte(*e.AdditionalMetadata)
+	}
+	opts := &repository.CreateEventOpts{
+		TenantId:           tenantId,
+		Key:                e.Key,
+		Data:               []byte(e.Payload),
+		AdditionalMetadata: additionalMeta,
+		OrderingKey:        e.OrderingKey,
+		OrderingSequence:   e.Sequence,
+		Priority:           e.Priority,
+	}
+
+	if err := validateEventNamespace(ctx, opts.Key); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventOrdering(opts); err != nil {
+		return nil, err
+	}
+
+	if err := validateEventPriority(opts); err != nil {
+		return nil, err
+	}
+
+	if e.ExternalId != nil || e.ExternalIdWindow != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: external ids are not supported for bulk pushes")
+	}
 
+	if err := i.v.Validate(opts); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: events failing validation %s", err)
+	}
+
+	return opts, nil
+}
+
+// validateEventNamespace rejects events whose key is outside of the namespace of the API token which
+// pushes them.
+func validateEventNamespace(ctx context.Context, key string) error {
+	if err := repository.ActorFromContext(ctx).CheckNamespace(key); err != nil {
+		return status.Errorf(codes.PermissionDenied, "Permission denied: event key %s", err)
 	}
 
-	return &contracts.Events{Events: contractEvents}, nil
+	return nil
+}
+
+func validateEventOrdering(opts *repository.CreateEventOpts) error {
+	if opts.OrderingKey != nil && *opts.OrderingKey == "" {
+		return status.Errorf(codes.InvalidArgument, "Invalid request: ordering key must not be empty")
+	}
+
+	if opts.OrderingSequence != nil && opts.OrderingKey == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request: sequence requires an ordering key")
+	}
+
+	if opts.OrderingSequence != nil && *opts.OrderingSequence < 1 {
+		return status.Errorf(codes.InvalidArgument, "Invalid request: sequence must be at least 1")
+	}
+
+	return nil
+}
+
+func validateEventPriority(opts *repository.CreateEventOpts) error {
+	if opts.Priority != nil && (*opts.Priority < 1 || *opts.Priority > 3) {
+		return status.Errorf(codes.InvalidArgument, "Invalid request: priority must be between 1 and 3")
+	}
+
+	return nil
 }
 
 func (i *IngestorImpl) ReplaySingleEvent(ctx context.Context, req *contracts.ReplayEventRequest) (*contracts.Event, error) {
@@ -129,6 +278,27 @@ func (i *IngestorImpl) ReplaySingleEvent(ctx context.Context, req *contracts.Rep
 		return nil, err
 	}
 
+	if err := validateEventNamespace(ctx, oldEvent.Key); err != nil {
+		return nil, err
+	}
+
+	// the replayed event keeps the id of the original event, so the new event is linked to it
+	if req.Payload != nil {
+		if !json.Valid([]byte(*req.Payload)) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: payload must be valid JSON")
+		}
+
+		oldEvent.Data = []byte(*req.Payload)
+	}
+
+	if req.AdditionalMetadata != nil {
+		if !json.Valid([]byte(*req.AdditionalMetadata)) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: additional metadata must be valid JSON")
+		}
+
+		oldEvent.AdditionalMetadata = []byte(*req.AdditionalMetadata)
+	}
+
 	newEvent, err := i.IngestReplayedEvent(ctx, tenantId, oldEvent)
 
 	if err != nil {
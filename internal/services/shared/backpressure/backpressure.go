@@ -0,0 +1,68 @@
+// Package backpressure describes requests which were rejected because a tenant is overloaded, along with a hint
+// for when clients should retry them.
+package backpressure
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ResourceExhaustedError is returned when a request is rejected because the tenant exceeded a quota, such as the
+// depth of its queue or its request rate. The request may succeed once RetryAfter has passed.
+type ResourceExhaustedError struct {
+	// Reason describes the quota which was exceeded.
+	Reason string
+
+	// RetryAfter is the time after which the request should be retried.
+	RetryAfter time.Duration
+}
+
+func (e *ResourceExhaustedError) Error() string {
+	return fmt.Sprintf("resource exhausted: %s, retry after %s", e.Reason, e.RetryAfter)
+}
+
+// GRPCStatus converts the error to a ResourceExhausted status with a RetryInfo detail, so gRPC handlers can return
+// the error as is.
+func (e *ResourceExhaustedError) GRPCStatus() *status.Status {
+	st := status.New(codes.ResourceExhausted, e.Error())
+
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(e.RetryAfter),
+	})
+
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// RetryAfterHeader returns the value of the Retry-After HTTP header, which is the hint in whole seconds, rounded up.
+func (e *ResourceExhaustedError) RetryAfterHeader() string {
+	return strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+// RetryAfter returns the retry hint of a gRPC error which was returned by the engine, and false if the error has no
+// hint.
+func RetryAfter(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok && retryInfo.RetryDelay != nil {
+			return retryInfo.RetryDelay.AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
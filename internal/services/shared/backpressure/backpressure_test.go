@@ -0,0 +1,41 @@
+package backpressure
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryAfter(t *testing.T) {
+	err := &ResourceExhaustedError{
+		Reason:     "queue depth exceeded",
+		RetryAfter: 1500 * time.Millisecond,
+	}
+
+	st, ok := status.FromError(err)
+
+	assert.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+	// the hint survives the conversion of the status to a client error
+	retryAfter, ok := RetryAfter(st.Err())
+
+	assert.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, retryAfter)
+	assert.Equal(t, "2", err.RetryAfterHeader())
+}
+
+func TestRetryAfterWithoutHint(t *testing.T) {
+	for _, err := range []error{
+		errors.New("connection reset"),
+		status.Error(codes.ResourceExhausted, "daily event limit exceeded"),
+		status.Error(codes.Unavailable, "engine is restarting"),
+	} {
+		_, ok := RetryAfter(err)
+		assert.False(t, ok, err.Error())
+	}
+}
@@ -1,13 +1,63 @@
 package tasktypes
 
+import (
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
 type EventTaskPayload struct {
 	EventId                 string `json:"event_id" validate:"required,uuid"`
 	EventKey                string `json:"event_key" validate:"required"`
 	EventData               string `json:"event_data" validate:"required"`
 	EventAdditionalMetadata string `json:"event_additional_metadata"`
+
+	// the user or API token which pushed the event, if known
+	ProducerType string `json:"producer_type,omitempty"`
+	ProducerId   string `json:"producer_id,omitempty"`
+
+	// the ordering key of the event, if it has one
+	OrderingKey string `json:"ordering_key,omitempty"`
+
+	// the priority of the workflow runs triggered by the event, if it has one
+	Priority *int32 `json:"priority,omitempty" validate:"omitnil,min=1,max=3"`
+
+	// whether the event was enqueued again by a duplicate push, in which case it may already have been processed
+	Redelivered bool `json:"redelivered,omitempty"`
 }
 
 type EventTaskMetadata struct {
 	EventKey string `json:"event_key" validate:"required"`
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
+
+type OrderedEventReleaseTaskPayload struct {
+	OrderingKey string `json:"ordering_key" validate:"required"`
+
+	// the release sequence of the ordering key when the task was created, so that a redelivered task doesn't
+	// release an event again
+	ReleaseSequence *int64 `json:"release_sequence,omitempty"`
+}
+
+type OrderedEventReleaseTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+// OrderedEventReleaseToTask returns a task which releases the next event of the ordering key, unless another event
+// of the key was released after the given release sequence.
+func OrderedEventReleaseToTask(tenantId, orderingKey string, releaseSequence int64) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(OrderedEventReleaseTaskPayload{
+		OrderingKey:     orderingKey,
+		ReleaseSequence: &releaseSequence,
+	})
+
+	metadata, _ := datautils.ToJSONMap(OrderedEventReleaseTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "ordered-event-release",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
@@ -1,6 +1,7 @@
 package tasktypes
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
@@ -67,6 +68,14 @@ type StepRunRequeueTaskMetadata struct {
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
 
+// StepRunCancelledReasonExpired is the cancelled reason of step runs whose workflow run expired before it
+// finished, either before they were sent to a worker or while they were running.
+const StepRunCancelledReasonExpired = "EXPIRED"
+
+// StepRunCancelledReasonSuperseded is the cancelled reason of step runs whose workflow run was cancelled by
+// the CANCEL_IN_PROGRESS concurrency strategy to make room for a newer run of the same concurrency key.
+const StepRunCancelledReasonSuperseded = "SUPERSEDED"
+
 type StepRunCancelTaskPayload struct {
 	StepRunId           string `json:"step_run_id" validate:"required,uuid"`
 	CancelledReason     string `json:"cancelled_reason" validate:"required"`
@@ -125,12 +134,30 @@ type StepRunFailedTaskPayload struct {
 	Error         string `json:"error" validate:"required"`
 	StepRetries   *int32 `json:"step_retries,omitempty"`
 	RetryCount    *int32 `json:"retry_count,omitempty"`
+
+	// ShouldNotRetry is set when the worker has determined that the step run should not be retried
+	ShouldNotRetry *bool `json:"should_not_retry,omitempty"`
+
+	// ErrorCode and ErrorDetails are set when the worker sent structured details about the error
+	ErrorCode    *string         `json:"error_code,omitempty"`
+	ErrorDetails json.RawMessage `json:"error_details,omitempty"`
 }
 
 type StepRunFailedTaskMetadata struct {
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
 
+type StepRunSuspendedTaskPayload struct {
+	WorkflowRunId string `json:"workflow_run_id" validate:"required,uuid"`
+	StepRunId     string `json:"step_run_id" validate:"required,uuid"`
+	SuspendedAt   string `json:"suspended_at" validate:"required"`
+	ResumeAt      string `json:"resume_at" validate:"required"`
+}
+
+type StepRunSuspendedTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
 type StepRunTimedOutTaskPayload struct {
 	WorkflowRunId string `json:"workflow_run_id" validate:"required,uuid"`
 	StepRunId     string `json:"step_run_id" validate:"required,uuid"`
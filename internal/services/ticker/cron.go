@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
+	cronparser "github.com/robfig/cron/v3"
 
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
@@ -70,7 +71,7 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCr
 	t.l.Debug().Msg("ticker: scheduling cron")
 
 	// create a new scheduler
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	s, err := newScheduler(t.clock)
 
 	if err != nil {
 		return fmt.Errorf("could not create scheduler: %w", err)
@@ -79,6 +80,7 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCr
 	tenantId := sqlchelpers.UUIDToStr(cron.TenantId)
 	workflowVersionId := sqlchelpers.UUIDToStr(cron.WorkflowVersionId)
 	cronParentId := sqlchelpers.UUIDToStr(cron.ParentId)
+	cronId := sqlchelpers.UUIDToStr(cron.ID)
 
 	var additionalMetadata map[string]interface{}
 
@@ -88,18 +90,39 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCr
 		}
 	}
 
+	run := t.runCronWorkflow(tenantId, workflowVersionId, cron.Cron, cronId, cronParentId, &cron.Name.String, cron.Input, additionalMetadata, cron.CatchUp)
+
 	// schedule the cron
 	_, err = s.NewJob(
 		gocron.CronJob(cron.Cron, false),
-		gocron.NewTask(
-			t.runCronWorkflow(tenantId, workflowVersionId, cron.Cron, cronParentId, &cron.Name.String, cron.Input, additionalMetadata),
-		),
+		gocron.NewTask(run),
 	)
 
 	if err != nil {
 		return fmt.Errorf("could not schedule cron: %w", err)
 	}
 
+	// crons which catch up start a single run if they missed any runs, for example while no ticker was running
+	if cron.CatchUp {
+		since := cron.CreatedAt.Time
+
+		if cron.LastFiredAt.Valid {
+			since = cron.LastFiredAt.Time
+		}
+
+		missed, err := missedCronRun(cron.Cron, since, t.clock.Now())
+
+		if err != nil {
+			return fmt.Errorf("could not check for missed cron runs: %w", err)
+		}
+
+		if missed {
+			t.l.Info().Msgf("ticker: cron %s missed a run since %s, running it once", cronId, since.Format(time.RFC3339))
+
+			go run()
+		}
+	}
+
 	// store the schedule in the cron map
 	t.crons.Store(getCronKey(cron), s)
 
@@ -108,11 +131,13 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCr
 	return nil
 }
 
-func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}) func() {
+func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId, cron, cronId, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}, catchUp bool) func() {
 	return func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
+		firedAt := t.clock.Now()
+
 		t.l.Debug().Msgf("ticker: running workflow %s", workflowVersionId)
 
 		workflowVersion, err := t.repo.Workflow().GetWorkflowVersionById(ctx, tenantId, workflowVersionId)
@@ -149,7 +174,24 @@ func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId, cron, cronPare
 			return
 		}
 
+		// the last run is only needed to find missed runs
+		if catchUp {
+			if err := t.repo.Ticker().UpdateCronLastFiredAt(ctx, cronId, firedAt); err != nil {
+				t.l.Err(err).Msg("could not update last fired at of cron")
+			}
+		}
+	}
+}
+
+// missedCronRun returns whether the cron expression has a scheduled time after since which is not after now.
+func missedCronRun(expression string, since, now time.Time) (bool, error) {
+	schedule, err := cronparser.ParseStandard(expression)
+
+	if err != nil {
+		return false, err
 	}
+
+	return !schedule.Next(since).After(now), nil
 }
 
 func (t *TickerImpl) handleCancelCron(ctx context.Context, key string) error {
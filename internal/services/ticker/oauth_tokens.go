@@ -0,0 +1,40 @@
+package ticker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TickerImpl) runRefreshOAuthTokens(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 4*time.Minute)
+		defer cancel()
+
+		t.l.Debug().Msg("ticker: refreshing expiring oauth tokens")
+
+		expiring, err := t.users.ListExpiringOAuthTokens(ctx, time.Now().Add(repository.OAuthTokenRefreshWindow), 100)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not list expiring oauth tokens")
+			return
+		}
+
+		for _, userOAuth := range expiring {
+			userId := sqlchelpers.UUIDToStr(userOAuth.UserId)
+
+			// other tickers may refresh the same token, which GetFreshOAuthToken doesn't refresh again
+			_, err := t.users.GetFreshOAuthToken(ctx, userId, userOAuth.Provider, t.refreshOAuth)
+
+			if errors.Is(err, oauth.ErrReauthRequired) {
+				t.l.Warn().Err(err).Str("user", userId).Str("provider", userOAuth.Provider).Msg("oauth account must be linked again")
+			} else if err != nil {
+				t.l.Err(err).Str("user", userId).Str("provider", userOAuth.Provider).Msg("could not refresh oauth token")
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package ticker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+// runReleaseStuckOrderedEvents re-releases ordering keys whose events were not triggered or released within the
+// ordered event lease, e.g. because the engine which released an event stopped, or a release task was lost.
+func (t *TickerImpl) runReleaseStuckOrderedEvents(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+
+		t.l.Debug().Msg("ticker: releasing stuck ordered events")
+
+		keys, err := t.repo.Event().ListStuckOrderingKeys(ctx, 100)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not list stuck ordering keys")
+			return
+		}
+
+		for _, key := range keys {
+			// other tickers may enqueue the same release, which only releases the key once
+			err := t.mq.AddMessage(
+				ctx,
+				msgqueue.EVENT_PROCESSING_QUEUE,
+				tasktypes.OrderedEventReleaseToTask(key.TenantId, key.OrderingKey, key.ReleaseSequence),
+			)
+
+			if err != nil {
+				t.l.Err(err).Str("tenant", key.TenantId).Str("key", key.OrderingKey).Msg("could not add ordered event release task")
+			}
+		}
+	}
+}
@@ -71,7 +71,7 @@ func (t *TickerImpl) handleScheduleWorkflow(ctx context.Context, scheduledWorkfl
 	t.l.Debug().Msg("ticker: scheduling workflow")
 
 	// create a new scheduler
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	s, err := newScheduler(t.clock)
 
 	if err != nil {
 		return fmt.Errorf("could not create scheduler: %w", err)
@@ -84,7 +84,7 @@ func (t *TickerImpl) handleScheduleWorkflow(ctx context.Context, scheduledWorkfl
 	triggerAt := scheduledWorkflow.TriggerAt.Time
 
 	// if start is in the past, run now
-	if triggerAt.Before(time.Now()) {
+	if triggerAt.Before(t.clock.Now()) {
 		t.l.Debug().Msg("ticker: trigger time is in the past, running now")
 
 		t.runScheduledWorkflow(tenantId, workflowVersionId, scheduledWorkflowId, scheduledWorkflow)()
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
@@ -41,6 +42,50 @@ func (t *TickerImpl) runPollTenantAlerts(ctx context.Context) func() {
 	}
 }
 
+func (t *TickerImpl) runPollWorkerOfflineAlerts(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 45*time.Second)
+		defer cancel()
+
+		t.l.Debug().Msgf("ticker: polling worker offline alerts")
+
+		workers, err := t.repo.Ticker().PollWorkerOfflineAlerts(ctx)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not poll worker offline alerts")
+			return
+		}
+
+		// send a single alert per tenant, workers are ordered by tenant
+		workersByTenant := make(map[string][]*dbsqlc.PollWorkerOfflineAlertsRow)
+		tenantIds := make([]string, 0)
+
+		for _, worker := range workers {
+			tenantId := sqlchelpers.UUIDToStr(worker.TenantId)
+
+			if _, ok := workersByTenant[tenantId]; !ok {
+				tenantIds = append(tenantIds, tenantId)
+			}
+
+			workersByTenant[tenantId] = append(workersByTenant[tenantId], worker)
+		}
+
+		for _, tenantId := range tenantIds {
+			t.l.Debug().Msgf("ticker: handling worker offline alert for tenant %s", tenantId)
+
+			innerErr := t.ta.SendWorkerOfflineAlert(tenantId, workersByTenant[tenantId])
+
+			if innerErr != nil {
+				err = multierror.Append(err, innerErr)
+			}
+		}
+
+		if err != nil {
+			t.l.Err(err).Msg("could not handle worker offline alerts")
+		}
+	}
+}
+
 func (t *TickerImpl) runExpiringTokenAlerts(ctx context.Context) func() {
 	return func() {
 		ctx, cancel := context.WithTimeout(ctx, 300*time.Second) // only runs once per day, so long context timeout
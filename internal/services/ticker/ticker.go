@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-co-op/gocron/v2"
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
@@ -40,6 +41,11 @@ type TickerImpl struct {
 	tickerId string
 
 	p *partition.Partition
+
+	clock clockwork.Clock
+
+	users        repository.UserRepository
+	refreshOAuth repository.RefreshOAuthTokenFunc
 }
 
 type TickerOpt func(*TickerOpts)
@@ -56,6 +62,11 @@ type TickerOpts struct {
 	dv datautils.DataDecoderValidator
 
 	p *partition.Partition
+
+	clock clockwork.Clock
+
+	users        repository.UserRepository
+	refreshOAuth repository.RefreshOAuthTokenFunc
 }
 
 func defaultTickerOpts() *TickerOpts {
@@ -64,6 +75,7 @@ func defaultTickerOpts() *TickerOpts {
 		l:        &logger,
 		tickerId: uuid.New().String(),
 		dv:       datautils.NewDataDecoderValidator(),
+		clock:    clockwork.NewRealClock(),
 	}
 }
 
@@ -103,6 +115,23 @@ func WithPartition(p *partition.Partition) TickerOpt {
 	}
 }
 
+// WithClock sets the clock which the ticker uses to fire cron and scheduled workflows, so that tests
+// can advance time with a fake clock instead of sleeping. Defaults to the real clock.
+func WithClock(clock clockwork.Clock) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithOAuthTokenRefresh has the ticker refresh the stored tokens of the accounts of OAuth providers before
+// they expire. The tokens aren't refreshed in the background if this option isn't set.
+func WithOAuthTokenRefresh(users repository.UserRepository, refresh repository.RefreshOAuthTokenFunc) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.users = users
+		opts.refreshOAuth = refresh
+	}
+}
+
 func New(fs ...TickerOpt) (*TickerImpl, error) {
 	opts := defaultTickerOpts()
 
@@ -133,7 +162,7 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 	newLogger := opts.l.With().Str("service", "ticker").Logger()
 	opts.l = &newLogger
 
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	s, err := newScheduler(opts.clock)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create scheduler: %w", err)
@@ -149,9 +178,17 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		tickerId:     opts.tickerId,
 		ta:           opts.ta,
 		p:            opts.p,
+		clock:        opts.clock,
+		users:        opts.users,
+		refreshOAuth: opts.refreshOAuth,
 	}, nil
 }
 
+// newScheduler returns a scheduler in UTC which uses the given clock.
+func newScheduler(clock clockwork.Clock) (gocron.Scheduler, error) {
+	return gocron.NewScheduler(gocron.WithLocation(time.UTC), gocron.WithClock(clock))
+}
+
 func (t *TickerImpl) Start() (func() error, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -242,6 +279,19 @@ func (t *TickerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule tenant alert polling: %w", err)
 	}
 
+	// poll for offline workers every minute
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Minute*1),
+		gocron.NewTask(
+			t.runPollWorkerOfflineAlerts(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule worker offline alert polling: %w", err)
+	}
+
 	// poll for expiring tokens every 15 minutes
 	_, err = t.s.NewJob(
 		gocron.DurationJob(time.Minute*15),
@@ -268,6 +318,34 @@ func (t *TickerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule tenant resource limit alert polling: %w", err)
 	}
 
+	// release ordered events which are stuck past their lease every minute
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Minute*1),
+		gocron.NewTask(
+			t.runReleaseStuckOrderedEvents(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule stuck ordered event release: %w", err)
+	}
+
+	if t.users != nil && t.refreshOAuth != nil {
+		// refresh oauth tokens every 5 minutes, which is half of the refresh window
+		_, err = t.s.NewJob(
+			gocron.DurationJob(time.Minute*5),
+			gocron.NewTask(
+				t.runRefreshOAuthTokens(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not schedule oauth token refresh: %w", err)
+		}
+	}
+
 	t.s.Start()
 
 	cleanup := func() error {
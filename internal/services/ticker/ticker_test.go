@@ -0,0 +1,73 @@
+package ticker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerUsesClock(t *testing.T) {
+	// 2024-03-10 is the start of daylight saving time in New York, so 02:30 local time doesn't exist
+	clock := clockwork.NewFakeClockAt(time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC))
+
+	s, err := newScheduler(clock)
+	require.NoError(t, err)
+
+	fired := make(chan time.Time, 10)
+
+	_, err = s.NewJob(
+		gocron.CronJob("CRON_TZ=America/New_York 0 9 * * *", false),
+		gocron.NewTask(func() {
+			fired <- clock.Now()
+		}),
+	)
+	require.NoError(t, err)
+
+	s.Start()
+	t.Cleanup(func() { _ = s.Shutdown() })
+
+	// 09:00 EST on 2024-03-09 is 14:00 UTC, 09:00 EDT on 2024-03-10 is 13:00 UTC
+	for _, want := range []time.Time{
+		time.Date(2024, 3, 9, 14, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC),
+	} {
+		clock.BlockUntil(1)
+		clock.Advance(want.Sub(clock.Now()))
+
+		select {
+		case at := <-fired:
+			assert.True(t, want.Equal(at), "fired at %s, want %s", at, want)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("cron did not fire at %s", want)
+		}
+	}
+}
+
+func TestMissedCronRun(t *testing.T) {
+	lastFiredAt := time.Date(2024, 3, 9, 9, 0, 0, 0, time.UTC)
+
+	// the next run is at 09:00 on the next day
+	missed, err := missedCronRun("0 9 * * *", lastFiredAt, lastFiredAt.Add(23*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, missed)
+
+	missed, err = missedCronRun("0 9 * * *", lastFiredAt, lastFiredAt.Add(3*24*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, missed)
+
+	// 09:00 in New York is 14:00 UTC
+	missed, err = missedCronRun("CRON_TZ=America/New_York 0 9 * * *", lastFiredAt, time.Date(2024, 3, 9, 13, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, missed)
+
+	missed, err = missedCronRun("CRON_TZ=America/New_York 0 9 * * *", lastFiredAt, time.Date(2024, 3, 9, 14, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, missed)
+
+	_, err = missedCronRun("CRON_TZ=Not/AZone 0 9 * * *", lastFiredAt, lastFiredAt)
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -14,6 +15,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
 	"github.com/hatchet-dev/hatchet/internal/whrequest"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -172,25 +174,37 @@ func (c *WebhooksController) cleanupDeletedWorker(id, tenantId string) {
 }
 
 func (c *WebhooksController) getOrCreateToken(ww *dbsqlc.WebhookWorker, tenantId string) (string, error) {
+	enc, err := c.sc.Encryption.ForTenant(tenantId)
+	if err != nil {
+		return "", fmt.Errorf("could not get encryption service for tenant: %w", err)
+	}
+
 	if ww.TokenValue.Valid {
 		tokenBytes, err := base64.StdEncoding.DecodeString(ww.TokenValue.String)
 		if err != nil {
 			return "", fmt.Errorf("failed to decode access token: %w", err)
 		}
-		decTok, err := c.sc.Encryption.Decrypt(tokenBytes, "engine_webhook_worker_token")
-		if err != nil {
+		decTok, err := decryptToken(enc, tokenBytes)
+
+		switch {
+		case errors.Is(err, encryption.ErrDecryptionFailed):
+			// the token was encrypted with a key which is no longer available, so a new token is created.
+			// Failures of the KMS are returned instead, since the token can be decrypted once it recovers.
+			c.sc.Logger.Warn().Err(err).Msgf("could not decrypt token of webhook worker %s, creating a new token", sqlchelpers.UUIDToStr(ww.ID))
+		case err != nil:
 			return "", fmt.Errorf("failed to decrypt access token: %w", err)
+		default:
+			return string(decTok), nil
 		}
-		return string(decTok), nil
 	}
 
 	expiresAt := time.Now().Add(100 * 365 * 24 * time.Hour) // 100 years
-	tok, err := c.sc.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, "webhook-worker", true, &expiresAt)
+	tok, err := c.sc.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, "webhook-worker", true, &expiresAt, nil, nil)
 	if err != nil {
 		return "", fmt.Errorf("could not generate token for webhook worker: %w", err)
 	}
 
-	encTok, err := c.sc.Encryption.Encrypt([]byte(tok.Token), "engine_webhook_worker_token")
+	encTok, err := enc.Encrypt([]byte(tok.Token), "engine_webhook_worker_token")
 	if err != nil {
 		return "", fmt.Errorf("failed to encrypt access token: %w", err)
 	}
@@ -213,12 +227,48 @@ func (c *WebhooksController) getOrCreateToken(ww *dbsqlc.WebhookWorker, tenantId
 	return tok.Token, nil
 }
 
+// tokenDecryptAttempts is the number of times the token of a webhook worker is decrypted while the KMS
+// fails, before giving up.
+const tokenDecryptAttempts = 3
+
+// decryptToken decrypts the token of a webhook worker, and retries failures of the KMS.
+func decryptToken(enc encryption.EncryptionService, tokenBytes []byte) ([]byte, error) {
+	var err error
+
+	for attempt := 1; attempt <= tokenDecryptAttempts; attempt++ {
+		var decTok []byte
+
+		decTok, err = enc.Decrypt(tokenBytes, "engine_webhook_worker_token")
+
+		if !errors.Is(err, encryption.ErrKMSUnavailable) {
+			return decTok, err
+		}
+
+		if attempt < tokenDecryptAttempts {
+			time.Sleep(time.Duration(attempt) * 500 * time.Millisecond)
+		}
+	}
+
+	return nil, err
+}
+
+func (c *WebhooksController) decryptSecret(ww *dbsqlc.WebhookWorker) (string, error) {
+	tenantId := sqlchelpers.UUIDToStr(ww.TenantId)
+
+	enc, err := c.sc.Encryption.ForTenant(tenantId)
+	if err != nil {
+		return "", fmt.Errorf("could not get encryption service for tenant: %w", err)
+	}
+
+	return enc.DecryptString(ww.Secret, tenantId)
+}
+
 type HealthCheckResponse struct {
 	Actions []string `json:"actions"`
 }
 
 func (c *WebhooksController) healthcheck(ww *dbsqlc.WebhookWorker) (*HealthCheckResponse, error) {
-	secret, err := c.sc.Encryption.DecryptString(ww.Secret, sqlchelpers.UUIDToStr(ww.TenantId))
+	secret, err := c.decryptSecret(ww)
 	if err != nil {
 		return nil, err
 	}
@@ -252,7 +302,7 @@ func (c *WebhooksController) healthcheck(ww *dbsqlc.WebhookWorker) (*HealthCheck
 func (c *WebhooksController) run(tenantId string, webhookWorker *dbsqlc.WebhookWorker, token string, h *HealthCheckResponse) (func() error, error) {
 	id := sqlchelpers.UUIDToStr(webhookWorker.ID)
 
-	secret, err := c.sc.Encryption.DecryptString(webhookWorker.Secret, sqlchelpers.UUIDToStr(webhookWorker.TenantId))
+	secret, err := c.decryptSecret(webhookWorker)
 	if err != nil {
 		return nil, fmt.Errorf("could not decrypt webhook secret: %w", err)
 	}
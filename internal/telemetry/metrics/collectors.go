@@ -0,0 +1,192 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+var (
+	poolTotalConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "total_connections"),
+		"The number of connections which are open in the database pool.",
+		[]string{"pool"}, nil,
+	)
+
+	poolAcquiredConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "acquired_connections"),
+		"The number of connections which are in use in the database pool.",
+		[]string{"pool"}, nil,
+	)
+
+	poolIdleConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "idle_connections"),
+		"The number of idle connections in the database pool.",
+		[]string{"pool"}, nil,
+	)
+
+	poolMaxConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "max_connections"),
+		"The maximum number of connections of the database pool.",
+		[]string{"pool"}, nil,
+	)
+
+	poolEmptyAcquireDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "empty_acquire_total"),
+		"The number of acquires which waited for a connection because the database pool was empty.",
+		[]string{"pool"}, nil,
+	)
+
+	queueDepthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "queue", "depth"),
+		"The number of step runs which are waiting to be assigned to a worker.",
+		[]string{"tenant_id", "workflow_name"}, nil,
+	)
+
+	workerSlotsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "worker", "slots"),
+		"The number of slots of active workers.",
+		[]string{"tenant_id", "worker_id"}, nil,
+	)
+
+	workerUsedSlotsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "worker", "used_slots"),
+		"The number of slots of active workers which are running step runs.",
+		[]string{"tenant_id", "worker_id"}, nil,
+	)
+)
+
+type poolCollector struct {
+	pools map[string]*pgxpool.Pool
+}
+
+// RegisterPoolCollector exports the connection statistics of the database pools, keyed by the name
+// which is used as the pool label. Pools which are the same object are only exported once.
+func RegisterPoolCollector(pools map[string]*pgxpool.Pool) error {
+	distinct := make(map[string]*pgxpool.Pool, len(pools))
+	seen := make(map[*pgxpool.Pool]bool, len(pools))
+
+	for name, pool := range pools {
+		if pool == nil || seen[pool] {
+			continue
+		}
+
+		seen[pool] = true
+		distinct[name] = pool
+	}
+
+	return register(&poolCollector{pools: distinct})
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolTotalConnsDesc
+	ch <- poolAcquiredConnsDesc
+	ch <- poolIdleConnsDesc
+	ch <- poolMaxConnsDesc
+	ch <- poolEmptyAcquireDesc
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, pool := range c.pools {
+		stat := pool.Stat()
+
+		ch <- prometheus.MustNewConstMetric(poolTotalConnsDesc, prometheus.GaugeValue, float64(stat.TotalConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolAcquiredConnsDesc, prometheus.GaugeValue, float64(stat.AcquiredConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolIdleConnsDesc, prometheus.GaugeValue, float64(stat.IdleConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolMaxConnsDesc, prometheus.GaugeValue, float64(stat.MaxConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolEmptyAcquireDesc, prometheus.CounterValue, float64(stat.EmptyAcquireCount()), name)
+	}
+}
+
+// engineStateTTL is how long the queue depths and worker slots are cached for, so that frequent
+// scrapes, or scrapes of several engine instances, don't each query the database.
+const engineStateTTL = 15 * time.Second
+
+type engineCollector struct {
+	repo repository.MetricsEngineRepository
+	l    *zerolog.Logger
+
+	mu          sync.Mutex
+	lastRead    time.Time
+	queueDepths []*dbsqlc.ListQueueDepthsRow
+	workerSlots []*dbsqlc.ListWorkerSlotsRow
+}
+
+// RegisterEngineCollector exports the queue depths of workflows and the slots of active workers of
+// all tenants.
+func RegisterEngineCollector(repo repository.MetricsEngineRepository, l *zerolog.Logger) error {
+	return register(&engineCollector{
+		repo: repo,
+		l:    l,
+	})
+}
+
+func (c *engineCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueDepthDesc
+	ch <- workerSlotsDesc
+	ch <- workerUsedSlotsDesc
+}
+
+func (c *engineCollector) Collect(ch chan<- prometheus.Metric) {
+	queueDepths, workerSlots := c.read()
+
+	for _, row := range queueDepths {
+		ch <- prometheus.MustNewConstMetric(
+			queueDepthDesc,
+			prometheus.GaugeValue,
+			float64(row.Count),
+			sqlchelpers.UUIDToStr(row.TenantId),
+			row.WorkflowName,
+		)
+	}
+
+	for _, row := range workerSlots {
+		tenantId := sqlchelpers.UUIDToStr(row.TenantId)
+		workerId := sqlchelpers.UUIDToStr(row.WorkerId)
+
+		ch <- prometheus.MustNewConstMetric(workerSlotsDesc, prometheus.GaugeValue, float64(row.MaxRuns), tenantId, workerId)
+		ch <- prometheus.MustNewConstMetric(workerUsedSlotsDesc, prometheus.GaugeValue, float64(row.UsedSlots), tenantId, workerId)
+	}
+}
+
+// read returns the cached state, and refreshes it once it's older than engineStateTTL. If refreshing
+// fails, the previous state is returned.
+func (c *engineCollector) read() ([]*dbsqlc.ListQueueDepthsRow, []*dbsqlc.ListWorkerSlotsRow) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.lastRead) < engineStateTTL {
+		return c.queueDepths, c.workerSlots
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	queueDepths, err := c.repo.ListQueueDepths(ctx)
+
+	if err != nil {
+		c.l.Err(err).Msg("could not list queue depths for metrics")
+		return c.queueDepths, c.workerSlots
+	}
+
+	workerSlots, err := c.repo.ListWorkerSlots(ctx)
+
+	if err != nil {
+		c.l.Err(err).Msg("could not list worker slots for metrics")
+		return c.queueDepths, c.workerSlots
+	}
+
+	c.lastRead = time.Now()
+	c.queueDepths = queueDepths
+	c.workerSlots = workerSlots
+
+	return queueDepths, workerSlots
+}
@@ -0,0 +1,80 @@
+// Package metrics holds the Prometheus metrics which the engine and the API server export when
+// prometheus.enabled is set.
+package metrics
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "hatchet"
+
+var (
+	// StepRunDuration is the time between a step run starting on a worker and finishing.
+	StepRunDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "step_run_duration_seconds",
+		Help:      "The duration of step runs, from starting on a worker to succeeding or failing.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600},
+	}, []string{"tenant_id", "status"})
+
+	// StepRunAssignmentLatency is the time between the scheduler picking up a queued step run and
+	// assigning it to a worker.
+	StepRunAssignmentLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "step_run_assignment_latency_seconds",
+		Help:      "The time between a step run being read from the queue and being assigned to a worker.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"tenant_id"})
+
+	// GRPCRequests counts the requests to the gRPC server of the engine by method and status code.
+	GRPCRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "The number of gRPC requests which were handled, by method and status code.",
+	}, []string{"method", "code"})
+
+	// APIRequests counts the requests to the REST API by method, route and status code.
+	APIRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_requests_total",
+		Help:      "The number of REST API requests which were handled, by method, route and status code.",
+	}, []string{"method", "route", "code"})
+
+	// APIRequestDuration is the time which the REST API takes to handle requests.
+	APIRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "api_request_duration_seconds",
+		Help:      "The time taken to handle REST API requests, by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	// EventFilterErrors counts the events whose filter expression of a workflow trigger could not be
+	// evaluated, in which case the event does not trigger the workflow.
+	EventFilterErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "event_filter_errors_total",
+		Help:      "The number of events whose trigger filter of a workflow failed to evaluate, so that the event did not trigger the workflow.",
+	}, []string{"tenant_id", "workflow_name"})
+
+	// EncryptionDuration is the time which the encryption service takes to encrypt and decrypt data.
+	EncryptionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "encryption_duration_seconds",
+		Help:      "The duration of encrypt and decrypt calls of the encryption service, by backend, operation, purpose and whether the call failed.",
+		Buckets:   []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	}, []string{"backend", "operation", "purpose", "error"})
+)
+
+// ObserveStepRunDuration records the duration of a step run which finished with the status. Step runs
+// which never started on a worker, for example because they timed out while queued, are skipped.
+func ObserveStepRunDuration(tenantId, status string, startedAt pgtype.Timestamp, finishedAt time.Time) {
+	if !startedAt.Valid || finishedAt.Before(startedAt.Time) {
+		return
+	}
+
+	StepRunDuration.WithLabelValues(tenantId, status).Observe(finishedAt.Sub(startedAt.Time).Seconds())
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	serverMu   sync.Mutex
+	server     *http.Server
+	serverRefs int
+)
+
+// Start serves the metrics of the default registry on the address and path. The API server and the
+// engine share the listener when they run in the same process, so it's only closed once every caller
+// has called the returned cleanup function.
+func Start(address, path string) (func() error, error) {
+	serverMu.Lock()
+	defer serverMu.Unlock()
+
+	if server == nil {
+		ln, err := net.Listen("tcp", address)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not listen for metrics on %s: %w", address, err)
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle(path, promhttp.Handler())
+
+		server = &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+
+		go func(srv *http.Server) {
+			_ = srv.Serve(ln)
+		}(server)
+	}
+
+	serverRefs++
+
+	var once sync.Once
+
+	cleanup := func() error {
+		var err error
+
+		once.Do(func() {
+			err = release()
+		})
+
+		return err
+	}
+
+	return cleanup, nil
+}
+
+func release() error {
+	serverMu.Lock()
+	defer serverMu.Unlock()
+
+	serverRefs--
+
+	if serverRefs > 0 || server == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := server.Shutdown(ctx)
+	server = nil
+
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("could not shut down metrics server: %w", err)
+	}
+
+	return nil
+}
+
+// register registers the collector with the default registry. Collectors are registered once per
+// process, so registering an identical collector again is not an error.
+func register(c prometheus.Collector) error {
+	err := prometheus.Register(c)
+
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+
+	if errors.As(err, &alreadyRegistered) {
+		return nil
+	}
+
+	return err
+}
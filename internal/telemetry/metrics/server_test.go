@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func freeAddress(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	return addr
+}
+
+func scrape(addr string) (string, error) {
+	resp, err := http.Get("http://" + addr + "/metrics") // nolint:noctx
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	return string(body), err
+}
+
+func TestStartSharesListener(t *testing.T) {
+	addr := freeAddress(t)
+
+	cleanup1, err := Start(addr, "/metrics")
+	require.NoError(t, err)
+
+	// a second caller in the same process, like the engine next to the API server, shares the listener
+	cleanup2, err := Start(addr, "/metrics")
+	require.NoError(t, err)
+
+	GRPCRequests.WithLabelValues("/Dispatcher/Listen", "OK").Inc()
+
+	body, err := scrape(addr)
+	require.NoError(t, err)
+	assert.Contains(t, body, `hatchet_grpc_requests_total{code="OK",method="/Dispatcher/Listen"}`)
+
+	require.NoError(t, cleanup1())
+	require.NoError(t, cleanup1())
+
+	_, err = scrape(addr)
+	assert.NoError(t, err, "listener is closed while it's still in use")
+
+	require.NoError(t, cleanup2())
+
+	_, err = scrape(addr)
+	assert.Error(t, err)
+}
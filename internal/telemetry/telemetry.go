@@ -2,6 +2,7 @@ package telemetry
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -106,6 +107,67 @@ func NewSpanWithCarrier(ctx context.Context, name string, carrier map[string]str
 	return ctx, span
 }
 
+// NewSpanWithAdditionalMetadata starts a span in the trace of a workflow run. Clients store the W3C trace
+// context of the span which triggered the run in the traceparent and tracestate keys of its additional
+// metadata, which is how the trace survives the queueing of the run in the database. The span is linked
+// to the span of ctx, if any. If the metadata has no trace context, it's the same as NewSpan.
+func NewSpanWithAdditionalMetadata(ctx context.Context, name string, additionalMetadata []byte) (context.Context, trace.Span) {
+	carrier := traceCarrierFromMetadata(additionalMetadata)
+
+	if carrier == nil {
+		return NewSpan(ctx, name)
+	}
+
+	parentCtx := propagation.TraceContext{}.Extract(ctx, carrier)
+
+	if !trace.SpanContextFromContext(parentCtx).IsValid() {
+		return NewSpan(ctx, name)
+	}
+
+	var opts []trace.SpanStartOption
+
+	if current := trace.SpanContextFromContext(ctx); current.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: current}))
+	}
+
+	return otel.Tracer("").Start(parentCtx, prefixSpanKey(name), opts...)
+}
+
+// traceCarrierFromMetadata returns the W3C trace context of the additional metadata of a workflow run, or
+// nil if it has none.
+func traceCarrierFromMetadata(additionalMetadata []byte) propagation.MapCarrier {
+	if len(additionalMetadata) == 0 {
+		return nil
+	}
+
+	metadata := map[string]any{}
+
+	if err := json.Unmarshal(additionalMetadata, &metadata); err != nil {
+		return nil
+	}
+
+	// the Python SDK nests the trace context in the __otel_carrier key
+	fields := metadata
+
+	if nested, ok := metadata["__otel_carrier"].(map[string]any); ok {
+		fields = nested
+	}
+
+	carrier := propagation.MapCarrier{}
+
+	for _, key := range (propagation.TraceContext{}).Fields() {
+		if value, ok := fields[key].(string); ok {
+			carrier[key] = value
+		}
+	}
+
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
+
 func GetCarrier(ctx context.Context) map[string]string {
 	propgator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
 
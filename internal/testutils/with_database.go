@@ -1,7 +1,9 @@
 package testutils
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
 	"github.com/hatchet-dev/hatchet/pkg/config/loader"
@@ -19,6 +21,12 @@ func RunTestWithDatabase(t *testing.T, test func(config *database.Config) error)
 	}
 	defer conf.Disconnect() // nolint: errcheck
 
+	// the database may have been migrated long before the tests run, so it might not have a partition for today
+	err = conf.EngineRepository.TablePartition().CreateTablePartitions(context.Background(), time.Now().UTC().Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create table partitions: %v\n", err)
+	}
+
 	err = test(conf)
 
 	if err != nil {
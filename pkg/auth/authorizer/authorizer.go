@@ -0,0 +1,163 @@
+package authorizer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// The roles of tenant members, which match the TenantMemberRole enum of the database.
+const (
+	RoleOwner  = "OWNER"
+	RoleAdmin  = "ADMIN"
+	RoleMember = "MEMBER"
+)
+
+// Actor is the user or API token which performs an action. It is populated from the actor which the
+// authentication layer stores on the request context.
+type Actor struct {
+	Type repository.ActorType
+
+	// Id is the id of the user or API token.
+	Id string
+
+	// Role is the role of the user in the tenant of the resource. It is empty for API tokens.
+	Role string
+
+	// Permissions are the permissions of the custom role of the user in the tenant of the resource. They're
+	// nil if the user has no custom role.
+	Permissions []string
+}
+
+// Resource is the resource which an action is performed on.
+type Resource struct {
+	// TenantId is the id of the tenant which the resource belongs to.
+	TenantId string
+
+	// Type is the type of the resource, which is the last entry of the x-resources of the operation, for
+	// example "tenant", "workflow-run" or "webhook".
+	Type string
+
+	// Id is the id of the resource. It is empty for operations on the tenant's collection of resources, for
+	// example when listing or creating resources.
+	Id string
+}
+
+// Authorizer decides whether an actor may perform an action on a resource. Actions are the operation ids
+// of the REST API, for example "WorkflowRunCancel" or "ApiTokenCreate". Authorize returns true if the
+// action is allowed. Actions are denied if Authorize returns false or an error, so implementations should
+// only return true for actions they explicitly allow.
+type Authorizer interface {
+	Authorize(ctx context.Context, actor *Actor, action string, resource *Resource) (bool, error)
+}
+
+// AuthorizerFunc is a function which implements Authorizer.
+type AuthorizerFunc func(ctx context.Context, actor *Actor, action string, resource *Resource) (bool, error)
+
+func (f AuthorizerFunc) Authorize(ctx context.Context, actor *Actor, action string, resource *Resource) (bool, error) {
+	return f(ctx, actor, action, resource)
+}
+
+// Policy lists the actions which are allowed and denied. Actions are matched case-insensitively, and "*"
+// matches all actions. Denied actions take precedence over allowed actions, and actions which are not
+// allowed are denied.
+type Policy struct {
+	Allow []string
+
+	Deny []string
+}
+
+func (p Policy) allows(action string) bool {
+	return !actionIn(action, p.Deny) && actionIn(action, p.Allow)
+}
+
+func actionIn(action string, actions []string) bool {
+	for _, a := range actions {
+		if a == "*" || strings.EqualFold(a, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RoleAuthorizer is an Authorizer which allows actions based on the role of the user in the tenant, or
+// on a separate policy for API tokens. Users with a role which has no policy are denied.
+type RoleAuthorizer struct {
+	roles map[string]Policy
+
+	apiTokens Policy
+}
+
+// NewRoleAuthorizer returns a RoleAuthorizer with the given policies for each tenant member role and for
+// API tokens.
+func NewRoleAuthorizer(roles map[string]Policy, apiTokens Policy) *RoleAuthorizer {
+	return &RoleAuthorizer{
+		roles:     roles,
+		apiTokens: apiTokens,
+	}
+}
+
+// apiTokenOperations are the operations on API tokens, which members and API tokens cannot perform
+// because API tokens have admin permissions.
+var apiTokenOperations = []string{
+	"ApiTokenList",
+	"ApiTokenCreate",
+	"ApiTokenUpdateRevoke",
+	"ApiTokenUpdateRotate",
+}
+
+// NewDefaultRoleAuthorizer returns a RoleAuthorizer which only considers the built-in roles, and ignores
+// custom roles:
+//   - owners and admins can perform all actions. Some handlers further restrict admins, for example admins
+//     cannot make other members owners.
+//   - members can perform all actions except managing invites, listing members and managing API tokens.
+//   - API tokens can perform all actions except managing API tokens.
+func NewDefaultRoleAuthorizer() *RoleAuthorizer {
+	return NewRoleAuthorizer(
+		map[string]Policy{
+			RoleOwner: {
+				Allow: []string{"*"},
+			},
+			RoleAdmin: {
+				Allow: []string{"*"},
+			},
+			RoleMember: {
+				Allow: []string{"*"},
+				Deny: append([]string{
+					"TenantInviteList",
+					"TenantInviteCreate",
+					"TenantInviteUpdate",
+					"TenantInviteDelete",
+					"TenantMemberList",
+				}, apiTokenOperations...),
+			},
+		},
+		Policy{
+			Allow: []string{"*"},
+			Deny:  apiTokenOperations,
+		},
+	)
+}
+
+func (r *RoleAuthorizer) Authorize(ctx context.Context, actor *Actor, action string, resource *Resource) (bool, error) {
+	if actor == nil {
+		return false, nil
+	}
+
+	switch actor.Type {
+	case repository.ActorTypeAPIToken:
+		return r.apiTokens.allows(action), nil
+	case repository.ActorTypeUser:
+		policy, ok := r.roles[actor.Role]
+
+		if !ok {
+			return false, nil
+		}
+
+		return policy.allows(action), nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,78 @@
+package authorizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestDefaultRoleAuthorizer(t *testing.T) {
+	a := NewDefaultRoleAuthorizer()
+
+	resource := &Resource{
+		TenantId: "707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		Type:     "tenant",
+	}
+
+	user := func(role string) *Actor {
+		return &Actor{
+			Type: repository.ActorTypeUser,
+			Id:   "a7f1c3a4-0f7e-4a8b-9f0a-5a2c3e1d6b7c",
+			Role: role,
+		}
+	}
+
+	apiToken := &Actor{
+		Type: repository.ActorTypeAPIToken,
+		Id:   "0d6e7f3a-1b2c-4d5e-8f9a-0b1c2d3e4f5a",
+	}
+
+	for _, tc := range []struct {
+		name    string
+		actor   *Actor
+		action  string
+		allowed bool
+	}{
+		{"owner creates api token", user(RoleOwner), "ApiTokenCreate", true},
+		{"admin creates api token", user(RoleAdmin), "ApiTokenCreate", true},
+		{"member cancels workflow run", user(RoleMember), "WorkflowRunCancel", true},
+		{"member creates api token", user(RoleMember), "ApiTokenCreate", false},
+		{"member lists invites case-insensitively", user(RoleMember), "tenantinvitelist", false},
+		{"api token cancels workflow run", apiToken, "WorkflowRunCancel", true},
+		{"api token lists api tokens", apiToken, "ApiTokenList", false},
+		{"unknown role", user("VIEWER"), "WorkflowRunCancel", false},
+		{"no actor", nil, "WorkflowRunCancel", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, err := a.Authorize(context.Background(), tc.actor, tc.action, resource)
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
+func TestRoleAuthorizerDeniesByDefault(t *testing.T) {
+	a := NewRoleAuthorizer(map[string]Policy{
+		RoleMember: {
+			Allow: []string{"WorkflowRunList"},
+		},
+	}, Policy{})
+
+	member := &Actor{Type: repository.ActorTypeUser, Role: RoleMember}
+
+	allowed, err := a.Authorize(context.Background(), member, "WorkflowRunList", &Resource{})
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = a.Authorize(context.Background(), member, "WorkflowRunCancel", &Resource{})
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = a.Authorize(context.Background(), &Actor{Type: repository.ActorTypeAPIToken}, "WorkflowRunList", &Resource{})
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
@@ -0,0 +1,273 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// The permissions which tenant roles are made of. Each tenant-scoped operation of the REST API requires
+// exactly one permission, see OperationPermission.
+const (
+	// PermissionTenantRead allows viewing the resources of the tenant, like workflows, runs, events, workers
+	// and settings.
+	PermissionTenantRead = "tenant:read"
+
+	// PermissionWorkflowsRun allows triggering, scheduling, cancelling and replaying workflow runs.
+	PermissionWorkflowsRun = "workflows:run"
+
+	// PermissionWorkflowsWrite allows changing and deleting workflows, their crons and their schedules.
+	PermissionWorkflowsWrite = "workflows:write"
+
+	// PermissionEventsPush allows pushing, replaying and cancelling events.
+	PermissionEventsPush = "events:push"
+
+	// PermissionWorkersManage allows pausing workers and managing webhook workers.
+	PermissionWorkersManage = "workers:manage"
+
+	// PermissionMembersManage allows managing the members, invites and custom roles of the tenant.
+	PermissionMembersManage = "members:manage"
+
+	// PermissionSettingsWrite allows changing the settings, alerting and integrations of the tenant.
+	PermissionSettingsWrite = "settings:write"
+
+	// PermissionAPITokensManage allows listing, creating, revoking and rotating API tokens.
+	PermissionAPITokensManage = "api-tokens:manage"
+
+	// PermissionAuditLogsManage allows viewing the audit log and changing how long it's retained.
+	PermissionAuditLogsManage = "audit-logs:manage"
+
+	// PermissionLimitsManage allows changing the resource limits and the data retention period of the tenant.
+	PermissionLimitsManage = "limits:manage"
+)
+
+// Permissions are all permissions, in the order in which they're documented.
+var Permissions = []string{
+	PermissionTenantRead,
+	PermissionWorkflowsRun,
+	PermissionWorkflowsWrite,
+	PermissionEventsPush,
+	PermissionWorkersManage,
+	PermissionMembersManage,
+	PermissionSettingsWrite,
+	PermissionAPITokensManage,
+	PermissionAuditLogsManage,
+	PermissionLimitsManage,
+}
+
+// IsPermission returns true if the permission is one of Permissions.
+func IsPermission(permission string) bool {
+	for _, p := range Permissions {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// operationPermissions maps the tenant-scoped operations of the REST API to the permission they require.
+var operationPermissions = map[string]string{
+	// tenant
+	"TenantUpdate":                 PermissionSettingsWrite,
+	"TenantGetQueueMetrics":        PermissionTenantRead,
+	"TenantGetStepRunQueueMetrics": PermissionTenantRead,
+	"TenantResourcePolicyGet":      PermissionTenantRead,
+	"TenantResourcePolicyUpdate":   PermissionLimitsManage,
+	"TenantAlertingSettingsGet":    PermissionTenantRead,
+	"MonitoringPostRunProbe":       PermissionWorkflowsRun,
+
+	// members, invites and roles
+	"TenantMemberList":       PermissionMembersManage,
+	"TenantMemberUpdateRole": PermissionMembersManage,
+	"TenantMemberDelete":     PermissionMembersManage,
+	"TenantInviteList":       PermissionMembersManage,
+	"TenantInviteCreate":     PermissionMembersManage,
+	"TenantInviteUpdate":     PermissionMembersManage,
+	"TenantInviteDelete":     PermissionMembersManage,
+	"TenantRoleList":         PermissionMembersManage,
+	"TenantRoleCreate":       PermissionMembersManage,
+	"TenantRoleUpdate":       PermissionMembersManage,
+	"TenantRoleDelete":       PermissionMembersManage,
+
+	// api tokens
+	"ApiTokenList":         PermissionAPITokensManage,
+	"ApiTokenCreate":       PermissionAPITokensManage,
+	"ApiTokenUpdateRevoke": PermissionAPITokensManage,
+	"ApiTokenUpdateRotate": PermissionAPITokensManage,
+
+	// audit logs
+	"AuditLogList":           PermissionAuditLogsManage,
+	"AuditLogGetSettings":    PermissionAuditLogsManage,
+	"AuditLogUpdateSettings": PermissionAuditLogsManage,
+
+	// alerting and integrations
+	"AlertEmailGroupList":       PermissionTenantRead,
+	"AlertEmailGroupCreate":     PermissionSettingsWrite,
+	"AlertEmailGroupUpdate":     PermissionSettingsWrite,
+	"AlertEmailGroupDelete":     PermissionSettingsWrite,
+	"AlertMuteRuleList":         PermissionTenantRead,
+	"AlertMuteRuleCreate":       PermissionSettingsWrite,
+	"AlertMuteRuleDelete":       PermissionSettingsWrite,
+	"SlackWebhookList":          PermissionTenantRead,
+	"SlackWebhookDelete":        PermissionSettingsWrite,
+	"UserUpdateSlackOauthStart": PermissionSettingsWrite,
+	"SnsList":                   PermissionTenantRead,
+	"SnsCreate":                 PermissionSettingsWrite,
+	"SnsDelete":                 PermissionSettingsWrite,
+	"WebhookSubscriptionList":   PermissionTenantRead,
+	"WebhookSubscriptionCreate": PermissionSettingsWrite,
+	"WebhookSubscriptionUpdate": PermissionSettingsWrite,
+	"WebhookSubscriptionDelete": PermissionSettingsWrite,
+	"WebhookDeliveryList":       PermissionTenantRead,
+	"WebhookDeliveryResend":     PermissionSettingsWrite,
+
+	// events
+	"EventList":                    PermissionTenantRead,
+	"EventKeyList":                 PermissionTenantRead,
+	"EventGet":                     PermissionTenantRead,
+	"EventDataGet":                 PermissionTenantRead,
+	"EventWorkflowRunList":         PermissionTenantRead,
+	"EventCreate":                  PermissionEventsPush,
+	"EventCreateBulk":              PermissionEventsPush,
+	"EventUpdateReplay":            PermissionEventsPush,
+	"EventUpdateReplayWithPayload": PermissionEventsPush,
+	"EventUpdateCancel":            PermissionEventsPush,
+
+	// workflows
+	"WorkflowList":                 PermissionTenantRead,
+	"WorkflowGet":                  PermissionTenantRead,
+	"WorkflowGetMetrics":           PermissionTenantRead,
+	"WorkflowGetWorkersCount":      PermissionTenantRead,
+	"WorkflowVersionGet":           PermissionTenantRead,
+	"WorkflowVersionWeightsGet":    PermissionTenantRead,
+	"WorkflowUpdate":               PermissionWorkflowsWrite,
+	"WorkflowDelete":               PermissionWorkflowsWrite,
+	"WorkflowVersionWeightsUpdate": PermissionWorkflowsWrite,
+	"CronWorkflowList":             PermissionTenantRead,
+	"WorkflowCronGet":              PermissionTenantRead,
+	"CronWorkflowTriggerCreate":    PermissionWorkflowsRun,
+	"WorkflowCronDelete":           PermissionWorkflowsWrite,
+	"WorkflowScheduledList":        PermissionTenantRead,
+	"WorkflowScheduledGet":         PermissionTenantRead,
+	"ScheduledWorkflowRunCreate":   PermissionWorkflowsRun,
+	"WorkflowScheduledDelete":      PermissionWorkflowsWrite,
+
+	// workflow runs and step runs
+	"WorkflowRunList":              PermissionTenantRead,
+	"WorkflowRunSearch":            PermissionTenantRead,
+	"WorkflowRunGet":               PermissionTenantRead,
+	"WorkflowRunGetInput":          PermissionTenantRead,
+	"WorkflowRunGetShape":          PermissionTenantRead,
+	"WorkflowRunGetMetrics":        PermissionTenantRead,
+	"WorkflowRunListStepRunEvents": PermissionTenantRead,
+	"WorkflowRunCreate":            PermissionWorkflowsRun,
+	"WorkflowRunCancel":            PermissionWorkflowsRun,
+	"WorkflowRunUpdateCancel":      PermissionWorkflowsRun,
+	"WorkflowRunUpdateReplay":      PermissionWorkflowsRun,
+	"StepRunGet":                   PermissionTenantRead,
+	"StepRunGetSchema":             PermissionTenantRead,
+	"StepRunListEvents":            PermissionTenantRead,
+	"StepRunListArchives":          PermissionTenantRead,
+	"LogLineList":                  PermissionTenantRead,
+	"StepRunUpdateCancel":          PermissionWorkflowsRun,
+	"StepRunUpdateRerun":           PermissionWorkflowsRun,
+	"StepRunListDeadLetters":       PermissionTenantRead,
+	"StepRunUpdateRequeue":         PermissionWorkflowsRun,
+	"StepRunDeleteDeadLetter":      PermissionWorkflowsRun,
+	"RateLimitList":                PermissionTenantRead,
+
+	// workers
+	"WorkerList":          PermissionTenantRead,
+	"WorkerGet":           PermissionTenantRead,
+	"WorkerUpdate":        PermissionWorkersManage,
+	"WebhookList":         PermissionTenantRead,
+	"WebhookRequestsList": PermissionTenantRead,
+	"WebhookCreate":       PermissionWorkersManage,
+	"WebhookDelete":       PermissionWorkersManage,
+}
+
+// OperationPermission returns the permission which the tenant-scoped operation requires. It returns false
+// for unknown operations, which are denied.
+func OperationPermission(operationId string) (string, bool) {
+	permission, ok := operationPermissions[operationId]
+	return permission, ok
+}
+
+// rolePermissions are the permissions of the built-in roles.
+var rolePermissions = map[string][]string{
+	RoleOwner: Permissions,
+	RoleAdmin: Permissions,
+	RoleMember: {
+		PermissionTenantRead,
+		PermissionWorkflowsRun,
+		PermissionWorkflowsWrite,
+		PermissionEventsPush,
+		PermissionWorkersManage,
+		PermissionSettingsWrite,
+	},
+}
+
+// apiTokenPermissions are the permissions of API tokens, which have all permissions except managing API
+// tokens and limits.
+var apiTokenPermissions = []string{
+	PermissionTenantRead,
+	PermissionWorkflowsRun,
+	PermissionWorkflowsWrite,
+	PermissionEventsPush,
+	PermissionWorkersManage,
+	PermissionMembersManage,
+	PermissionSettingsWrite,
+	PermissionAuditLogsManage,
+}
+
+// RolePermissions returns the permissions of a built-in role.
+func RolePermissions(role string) []string {
+	return rolePermissions[role]
+}
+
+// PermissionAuthorizer is an Authorizer which allows the operations whose permission the actor has. Users
+// have the permissions of their custom role if they have one, and otherwise the permissions of their
+// built-in role. Owners always have all permissions, so that a tenant can't lose its last member who can
+// manage roles. API tokens have all permissions except managing API tokens and limits.
+type PermissionAuthorizer struct{}
+
+// NewPermissionAuthorizer returns the PermissionAuthorizer, which the server uses by default.
+func NewPermissionAuthorizer() *PermissionAuthorizer {
+	return &PermissionAuthorizer{}
+}
+
+func (p *PermissionAuthorizer) Authorize(ctx context.Context, actor *Actor, action string, resource *Resource) (bool, error) {
+	if actor == nil {
+		return false, nil
+	}
+
+	required, ok := OperationPermission(action)
+
+	if !ok {
+		return false, nil
+	}
+
+	var granted []string
+
+	switch actor.Type {
+	case repository.ActorTypeAPIToken:
+		granted = apiTokenPermissions
+	case repository.ActorTypeUser:
+		granted = RolePermissions(actor.Role)
+
+		if actor.Permissions != nil && actor.Role != RoleOwner {
+			granted = actor.Permissions
+		}
+	default:
+		return false, nil
+	}
+
+	for _, permission := range granted {
+		if permission == required {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
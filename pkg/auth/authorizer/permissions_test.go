@@ -0,0 +1,106 @@
+package authorizer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestPermissionAuthorizer(t *testing.T) {
+	a := NewPermissionAuthorizer()
+
+	resource := &Resource{
+		TenantId: "707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		Type:     "tenant",
+	}
+
+	user := func(role string, permissions ...string) *Actor {
+		return &Actor{
+			Type:        repository.ActorTypeUser,
+			Id:          "a7f1c3a4-0f7e-4a8b-9f0a-5a2c3e1d6b7c",
+			Role:        role,
+			Permissions: permissions,
+		}
+	}
+
+	apiToken := &Actor{
+		Type: repository.ActorTypeAPIToken,
+		Id:   "0d6e7f3a-1b2c-4d5e-8f9a-0b1c2d3e4f5a",
+	}
+
+	for _, tc := range []struct {
+		name    string
+		actor   *Actor
+		action  string
+		allowed bool
+	}{
+		{"admin creates api token", user(RoleAdmin), "ApiTokenCreate", true},
+		{"member cancels workflow run", user(RoleMember), "WorkflowRunCancel", true},
+		{"member creates api token", user(RoleMember), "ApiTokenCreate", false},
+		{"member creates role", user(RoleMember), "TenantRoleCreate", false},
+		{"viewer lists workflow runs", user(RoleMember, PermissionTenantRead), "WorkflowRunList", true},
+		{"viewer cancels workflow run", user(RoleMember, PermissionTenantRead), "WorkflowRunCancel", false},
+		{"custom role creates api token", user(RoleMember, PermissionAPITokensManage), "ApiTokenCreate", true},
+		{"custom role without permissions", user(RoleAdmin, []string{}...), "WorkflowRunList", false},
+		{"owner ignores custom role", user(RoleOwner, PermissionTenantRead), "TenantRoleCreate", true},
+		{"api token cancels workflow run", apiToken, "WorkflowRunCancel", true},
+		{"api token lists api tokens", apiToken, "ApiTokenList", false},
+		{"unknown operation", user(RoleOwner), "TenantDestroy", false},
+		{"unknown role", user("VIEWER"), "WorkflowRunList", false},
+		{"no actor", nil, "WorkflowRunList", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, err := a.Authorize(context.Background(), tc.actor, tc.action, resource)
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
+func TestOperationPermissionsCoverTenantOperations(t *testing.T) {
+	swagger, err := gen.GetSwagger()
+	require.NoError(t, err)
+
+	checked := 0
+
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			raw, ok := op.Extensions["x-resources"]
+
+			if !ok {
+				continue
+			}
+
+			var resources []string
+
+			switch v := raw.(type) {
+			case json.RawMessage:
+				require.NoError(t, json.Unmarshal(v, &resources))
+			case []interface{}:
+				for _, r := range v {
+					resources = append(resources, r.(string))
+				}
+			}
+
+			if len(resources) == 0 || resources[0] != "tenant" {
+				continue
+			}
+
+			checked++
+
+			permission, ok := OperationPermission(op.OperationID)
+
+			if assert.True(t, ok, "%s %s (%s) has no permission", method, path, op.OperationID) {
+				assert.True(t, IsPermission(permission))
+			}
+		}
+	}
+
+	assert.NotZero(t, checked)
+}
@@ -58,3 +58,15 @@ func NewSlackClient(cfg *Config) *oauth2.Config {
 		Scopes:      cfg.Scopes,
 	}
 }
+
+// NewProviderClient returns the client of an OAuth provider which is configured by name. Its callback
+// is the /api/v1/users/oauth/{name}/callback route.
+func NewProviderClient(cfg *Config, name string, endpoint oauth2.Endpoint) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		Endpoint:     endpoint,
+		RedirectURL:  cfg.BaseURL + "/api/v1/users/oauth/" + name + "/callback",
+		Scopes:       cfg.Scopes,
+	}
+}
@@ -0,0 +1,132 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// ErrReauthRequired is returned when the stored tokens of an account can't be used anymore, for example
+// because they were encrypted with a key which is no longer configured. The user has to link the account
+// again.
+var ErrReauthRequired = errors.New("the account must be linked again")
+
+// TokenRefresher renews the tokens which are stored, encrypted, for the accounts of OAuth providers.
+type TokenRefresher struct {
+	enc encryption.EncryptionService
+
+	// clients are the clients of the configured providers, keyed by the provider name which is stored
+	// with the accounts
+	clients map[string]*oauth2.Config
+}
+
+func NewTokenRefresher(enc encryption.EncryptionService, clients map[string]*oauth2.Config) *TokenRefresher {
+	return &TokenRefresher{
+		enc:     enc,
+		clients: clients,
+	}
+}
+
+// AccessTokenDataId returns the data id which the access tokens of the provider are encrypted with.
+func AccessTokenDataId(provider string) string {
+	return fmt.Sprintf("%s_access_token", provider)
+}
+
+// RefreshTokenDataId returns the data id which the refresh tokens of the provider are encrypted with.
+func RefreshTokenDataId(provider string) string {
+	return fmt.Sprintf("%s_refresh_token", provider)
+}
+
+// Refresh exchanges the stored refresh token for new tokens, and has the signature of
+// repository.RefreshOAuthTokenFunc.
+func (r *TokenRefresher) Refresh(ctx context.Context, provider string, tokens *repository.OAuthTokens) (*repository.OAuthTokens, error) {
+	client, ok := r.clients[provider]
+
+	if !ok {
+		return nil, fmt.Errorf("oauth provider %s is not configured", provider)
+	}
+
+	if len(tokens.RefreshToken) == 0 {
+		return nil, fmt.Errorf("no refresh token is stored for oauth provider %s", provider)
+	}
+
+	refreshToken, err := r.enc.Decrypt(tokens.RefreshToken, RefreshTokenDataId(provider))
+
+	if err != nil {
+		return nil, decryptTokenErr("refresh", err)
+	}
+
+	tok, err := client.TokenSource(ctx, &oauth2.Token{
+		RefreshToken: string(refreshToken),
+	}).Token()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	refreshed := &repository.OAuthTokens{}
+
+	refreshed.AccessToken, err = r.enc.Encrypt([]byte(tok.AccessToken), AccessTokenDataId(provider))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt access token: %w", err)
+	}
+
+	// providers which rotate refresh tokens return a new one, which replaces the stored refresh token
+	if tok.RefreshToken != "" && tok.RefreshToken != string(refreshToken) {
+		refreshed.RefreshToken, err = r.enc.Encrypt([]byte(tok.RefreshToken), RefreshTokenDataId(provider))
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt refresh token: %w", err)
+		}
+	}
+
+	if !tok.Expiry.IsZero() {
+		expiresAt := tok.Expiry
+		refreshed.ExpiresAt = &expiresAt
+	}
+
+	return refreshed, nil
+}
+
+// GetFreshToken returns the decrypted access token of the user's account with the given provider, which is
+// refreshed first if it's about to expire.
+func (r *TokenRefresher) GetFreshToken(ctx context.Context, users repository.UserRepository, userId, provider string) (*oauth2.Token, error) {
+	tokens, err := users.GetFreshOAuthToken(ctx, userId, provider, r.Refresh)
+
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := r.enc.Decrypt(tokens.AccessToken, AccessTokenDataId(provider))
+
+	if err != nil {
+		return nil, decryptTokenErr("access", err)
+	}
+
+	tok := &oauth2.Token{
+		AccessToken: string(accessToken),
+	}
+
+	if tokens.ExpiresAt != nil {
+		tok.Expiry = *tokens.ExpiresAt
+	}
+
+	return tok, nil
+}
+
+// decryptTokenErr returns the error for a stored token which can't be decrypted. Tokens which can never be
+// decrypted again require the user to link the account again, while failures of the KMS are returned as
+// they are, so that the caller can retry.
+func decryptTokenErr(kind string, err error) error {
+	if errors.Is(err, encryption.ErrDecryptionFailed) {
+		return fmt.Errorf("%w: failed to decrypt %s token: %w", ErrReauthRequired, kind, err)
+	}
+
+	return fmt.Errorf("failed to decrypt %s token: %w", kind, err)
+}
@@ -0,0 +1,132 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func newTestEncryption(t *testing.T) encryption.EncryptionService {
+	masterKey, privateEc256, publicEc256, err := encryption.GenerateLocalKeys()
+	require.NoError(t, err)
+
+	svc, err := encryption.NewLocalEncryption(masterKey, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	return svc
+}
+
+func newTestTokenServer(t *testing.T, response map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+		assert.Equal(t, "old-refresh-token", r.Form.Get("refresh_token"))
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+}
+
+func TestTokenRefresherRefresh(t *testing.T) {
+	enc := newTestEncryption(t)
+
+	srv := newTestTokenServer(t, map[string]any{
+		"access_token":  "new-access-token",
+		"refresh_token": "new-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+	})
+	defer srv.Close()
+
+	refresher := NewTokenRefresher(enc, map[string]*oauth2.Config{
+		"okta": {
+			ClientID: "client-id",
+			Endpoint: oauth2.Endpoint{TokenURL: srv.URL},
+		},
+	})
+
+	refreshToken, err := enc.Encrypt([]byte("old-refresh-token"), "okta_refresh_token")
+	require.NoError(t, err)
+
+	refreshed, err := refresher.Refresh(context.Background(), "okta", &repository.OAuthTokens{
+		RefreshToken: refreshToken,
+	})
+	require.NoError(t, err)
+
+	accessToken, err := enc.Decrypt(refreshed.AccessToken, "okta_access_token")
+	require.NoError(t, err)
+	assert.Equal(t, "new-access-token", string(accessToken))
+
+	newRefreshToken, err := enc.Decrypt(refreshed.RefreshToken, "okta_refresh_token")
+	require.NoError(t, err)
+	assert.Equal(t, "new-refresh-token", string(newRefreshToken))
+
+	require.NotNil(t, refreshed.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *refreshed.ExpiresAt, time.Minute)
+}
+
+func TestTokenRefresherRefreshKeepsRefreshToken(t *testing.T) {
+	enc := newTestEncryption(t)
+
+	// providers which don't rotate refresh tokens only return an access token
+	srv := newTestTokenServer(t, map[string]any{
+		"access_token": "new-access-token",
+		"token_type":   "Bearer",
+	})
+	defer srv.Close()
+
+	refresher := NewTokenRefresher(enc, map[string]*oauth2.Config{
+		"google": {
+			ClientID: "client-id",
+			Endpoint: oauth2.Endpoint{TokenURL: srv.URL},
+		},
+	})
+
+	refreshToken, err := enc.Encrypt([]byte("old-refresh-token"), "google_refresh_token")
+	require.NoError(t, err)
+
+	refreshed, err := refresher.Refresh(context.Background(), "google", &repository.OAuthTokens{
+		RefreshToken: refreshToken,
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, refreshed.RefreshToken)
+	assert.Nil(t, refreshed.ExpiresAt)
+}
+
+func TestTokenRefresherRefreshUnknownProvider(t *testing.T) {
+	refresher := NewTokenRefresher(newTestEncryption(t), map[string]*oauth2.Config{})
+
+	_, err := refresher.Refresh(context.Background(), "github", &repository.OAuthTokens{
+		RefreshToken: []byte("refresh-token"),
+	})
+
+	assert.ErrorContains(t, err, "oauth provider github is not configured")
+}
+
+func TestTokenRefresherRefreshUndecryptableToken(t *testing.T) {
+	refresher := NewTokenRefresher(newTestEncryption(t), map[string]*oauth2.Config{
+		"okta": {ClientID: "client-id"},
+	})
+
+	// the refresh token was encrypted with a key which is no longer configured
+	refreshToken, err := newTestEncryption(t).Encrypt([]byte("old-refresh-token"), "okta_refresh_token")
+	require.NoError(t, err)
+
+	_, err = refresher.Refresh(context.Background(), "okta", &repository.OAuthTokens{
+		RefreshToken: refreshToken,
+	})
+
+	assert.ErrorIs(t, err, ErrReauthRequired)
+	assert.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+}
@@ -14,7 +14,7 @@ import (
 )
 
 type JWTManager interface {
-	GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time) (*Token, error)
+	GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time, scopes []string, namespace *string) (*Token, error)
 	UpsertTenantToken(ctx context.Context, tenantId, name, id string, internal bool, expires *time.Time) (string, error)
 	ValidateTenantToken(ctx context.Context, token string) (string, string, error)
 }
@@ -83,7 +83,7 @@ func (j *jwtManagerImpl) createToken(ctx context.Context, tenantId, name string,
 	}, nil
 }
 
-func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time) (*Token, error) {
+func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time, scopes []string, namespace *string) (*Token, error) {
 	token, err := j.createToken(ctx, tenantId, name, nil, expires)
 	if err != nil {
 		return nil, err
@@ -96,6 +96,8 @@ func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name
 		TenantId:  &tenantId,
 		Name:      &name,
 		Internal:  internal,
+		Scopes:    scopes,
+		Namespace: namespace,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to write token to database: %v", err)
@@ -17,6 +17,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/random"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 func TestCreateTenantToken(t *testing.T) { // make sure no cache is used for tests
@@ -42,7 +43,7 @@ func TestCreateTenantToken(t *testing.T) { // make sure no cache is used for tes
 			t.Fatal(err.Error())
 		}
 
-		token, err := jwtManager.GenerateTenantToken(context.Background(), tenantId, "test token", false, nil)
+		token, err := jwtManager.GenerateTenantToken(context.Background(), tenantId, "test token", false, nil, nil, nil)
 
 		if err != nil {
 			t.Fatal(err.Error())
@@ -83,7 +84,7 @@ func TestRevokeTenantToken(t *testing.T) {
 			t.Fatal(err.Error())
 		}
 
-		token, err := jwtManager.GenerateTenantToken(context.Background(), tenantId, "test token", false, nil)
+		token, err := jwtManager.GenerateTenantToken(context.Background(), tenantId, "test token", false, nil, nil, nil)
 
 		if err != nil {
 			t.Fatal(err.Error())
@@ -95,14 +96,14 @@ func TestRevokeTenantToken(t *testing.T) {
 		assert.NoError(t, err)
 
 		// revoke the token
-		apiTokens, err := conf.APIRepository.APIToken().ListAPITokensByTenant(tenantId)
+		apiTokens, err := conf.APIRepository.APIToken().ListAPITokensByTenant(context.Background(), tenantId)
 
 		if err != nil {
 			t.Fatal(err.Error())
 		}
 
 		assert.Len(t, apiTokens, 1)
-		err = conf.APIRepository.APIToken().RevokeAPIToken(apiTokens[0].ID)
+		err = conf.APIRepository.APIToken().RevokeAPIToken(sqlchelpers.UUIDToStr(apiTokens[0].ID))
 
 		if err != nil {
 			t.Fatal(err.Error())
@@ -143,7 +144,7 @@ func TestRevokeTenantTokenCache(t *testing.T) {
 			t.Fatal(err.Error())
 		}
 
-		token, err := jwtManager.GenerateTenantToken(context.Background(), tenantId, "test token", false, nil)
+		token, err := jwtManager.GenerateTenantToken(context.Background(), tenantId, "test token", false, nil, nil, nil)
 
 		if err != nil {
 			t.Fatal(err.Error())
@@ -155,14 +156,14 @@ func TestRevokeTenantTokenCache(t *testing.T) {
 		assert.NoError(t, err)
 
 		// revoke the token
-		apiTokens, err := conf.APIRepository.APIToken().ListAPITokensByTenant(tenantId)
+		apiTokens, err := conf.APIRepository.APIToken().ListAPITokensByTenant(context.Background(), tenantId)
 
 		if err != nil {
 			t.Fatal(err.Error())
 		}
 
 		assert.Len(t, apiTokens, 1)
-		err = conf.APIRepository.APIToken().RevokeAPIToken(apiTokens[0].ID)
+		err = conf.APIRepository.APIToken().RevokeAPIToken(sqlchelpers.UUIDToStr(apiTokens[0].ID))
 
 		if err != nil {
 			t.Fatal(err.Error())
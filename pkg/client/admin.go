@@ -3,12 +3,15 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,6 +29,16 @@ type ChildWorkflowOpts struct {
 	ChildKey           *string
 	DesiredWorkerId    *string
 	AdditionalMetadata *map[string]string
+
+	// Detached child workflows are not cancelled when the parent step run is cancelled or times out
+	Detached bool
+
+	// Timeout is the time after which the step runs of the child workflow time out. The child never runs
+	// past the timeout of its parent workflow run.
+	Timeout *time.Duration
+
+	// Priority overrides the priority of the child workflow's steps
+	Priority *int32
 }
 
 type WorkflowRun struct {
@@ -47,8 +60,58 @@ type AdminClient interface {
 	RunChildWorkflows(workflows []*RunChildWorkflowsOpts) ([]string, error)
 
 	PutRateLimit(key string, opts *types.RateLimitOpts) error
+
+	// ResetRateLimit refills a rate limit to its limit, for example when steps consumed units of the rate
+	// limit which they didn't use.
+	ResetRateLimit(key string) error
+
+	// GetConcurrencyState returns the concurrency keys of a workflow which have running or queued runs, and
+	// the runs which hold the slots of each key.
+	GetConcurrencyState(workflowName string) (*ConcurrencyState, error)
+
+	// ReleaseConcurrencySlot releases the slots of a concurrency key, such as a slot which is held by a run
+	// whose worker crashed, by cancelling the runs which hold them. If a run is still executing, the next
+	// run of the key may run at the same time, so the release is rejected unless it is confirmed with
+	// WithReleaseConfirmed. It returns the ids of the cancelled runs.
+	ReleaseConcurrencySlot(workflowName, key string, opts ...ReleaseConcurrencySlotOptFunc) ([]string, error)
+
+	// ReplayWorkflowRun resumes a finished workflow run from a step instead of re-running it from the start.
+	// The step runs of fromStep, or the step runs which failed or timed out if fromStep is empty, are
+	// replayed with their previous input and the outputs of their parents, and the step runs which depend
+	// on them run again afterwards. It returns the ids of the replayed step runs.
+	ReplayWorkflowRun(workflowRunId, fromStep string) ([]string, error)
+
+	// GetWorkflow returns the definition of the latest version of a registered workflow. It returns an
+	// error which matches ErrWorkflowNotFound if no workflow with the name is registered.
+	GetWorkflow(ctx context.Context, workflowName string) (*WorkflowDefinition, error)
+
+	// PauseWorkflow pauses a workflow. New runs of the workflow are queued but not scheduled until it is
+	// resumed, and runs which were already scheduled keep running.
+	PauseWorkflow(workflowName string) error
+
+	// ResumeWorkflow resumes a paused workflow. Its queued runs are scheduled in the order in which they
+	// were triggered.
+	ResumeWorkflow(workflowName string) error
+
+	// PauseTenant pauses all workflows of the tenant, for example during a maintenance window. New runs are
+	// queued but not scheduled until the tenant is resumed, and runs which were already scheduled keep
+	// running.
+	PauseTenant() error
+
+	// ResumeTenant resumes a paused tenant. The queued runs are scheduled in the order in which they were
+	// triggered, except for the runs of workflows which are still paused themselves.
+	ResumeTenant() error
+
+	// RunStep runs a single step of the latest version of a workflow with the given workflow input and waits
+	// for the result. The other steps of the workflow are skipped, so the outputs of the step's parents must
+	// be passed with WithParentOutput. The workflow run is marked as a partial run in the run history.
+	RunStep(ctx context.Context, workflowName, stepName string, input interface{}, opts ...RunStepOptFunc) (*WorkflowResult, error)
 }
 
+// ErrWorkflowNotFound is returned by GetWorkflow, PauseWorkflow and ResumeWorkflow when no workflow with
+// the name is registered.
+var ErrWorkflowNotFound = errors.New("workflow not found")
+
 type DedupeViolationErr struct {
 	details string
 }
@@ -71,6 +134,29 @@ type adminClientImpl struct {
 	subscriber SubscribeClient
 
 	sharedMeta map[string]string
+
+	tracer trace.Tracer
+
+	// payloads is nil if inputs are not offloaded
+	payloads *payloadOffloader
+}
+
+// marshalInput encodes the input of a workflow run, and offloads it to the payload store if it's larger
+// than the offload threshold.
+func (a *adminClientImpl) marshalInput(ctx context.Context, input interface{}) (string, error) {
+	inputBytes, err := json.Marshal(input)
+
+	if err != nil {
+		return "", fmt.Errorf("could not marshal input: %w", err)
+	}
+
+	inputBytes, err = a.payloads.offload(ctx, inputBytes)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(inputBytes), nil
 }
 
 func newAdmin(conn *grpc.ClientConn, opts *sharedClientOpts, subscriber SubscribeClient) AdminClient {
@@ -82,6 +168,8 @@ func newAdmin(conn *grpc.ClientConn, opts *sharedClientOpts, subscriber Subscrib
 		namespace:  opts.namespace,
 		subscriber: subscriber,
 		sharedMeta: opts.sharedMeta,
+		tracer:     opts.tracer,
+		payloads:   opts.payloads,
 	}
 }
 
@@ -156,7 +244,7 @@ func (a *adminClientImpl) ScheduleWorkflow(workflowName string, fs ...ScheduleOp
 		pbSchedules[i] = timestamppb.New(scheduled)
 	}
 
-	inputBytes, err := json.Marshal(opts.input)
+	inputJSON, err := a.marshalInput(context.Background(), opts.input)
 
 	if err != nil {
 		return err
@@ -165,7 +253,7 @@ func (a *adminClientImpl) ScheduleWorkflow(workflowName string, fs ...ScheduleOp
 	_, err = a.client.ScheduleWorkflow(a.ctx.newContext(context.Background()), &admincontracts.ScheduleWorkflowRequest{
 		Name:      workflowName,
 		Schedules: pbSchedules,
-		Input:     string(inputBytes),
+		Input:     inputJSON,
 	})
 
 	if err != nil {
@@ -192,11 +280,79 @@ func WithRunMetadata(metadata interface{}) RunOptFunc {
 	}
 }
 
-func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, options ...RunOptFunc) (*Workflow, error) {
-	inputBytes, err := json.Marshal(input)
+// WithRunExpiry sets the time after which the workflow run is cancelled if it has not finished. A run
+// which is still queued when it expires is never dispatched to a worker, and its on-failure job does not
+// run.
+func WithRunExpiry(t time.Time) RunOptFunc {
+	return func(r *admincontracts.TriggerWorkflowRequest) error {
+		r.ExpiresAt = timestamppb.New(t)
+
+		return nil
+	}
+}
+
+// WithRunPriority sets the priority of the workflow run, from 1 (lowest) to 3 (highest), overriding the
+// default priority of the workflow. Within a tenant, the step runs of runs with a higher priority are
+// assigned to workers before the queued step runs of runs with a lower priority.
+func WithRunPriority(priority int32) RunOptFunc {
+	return func(r *admincontracts.TriggerWorkflowRequest) error {
+		if priority < 1 || priority > 3 {
+			return fmt.Errorf("priority must be between 1 and 3")
+		}
+
+		r.Priority = &priority
+
+		return nil
+	}
+}
+
+// WithRunIdempotencyKey sets a key which identifies the trigger request, so that callers can safely retry
+// RunWorkflow: triggering a workflow with a key which was used within the window returns the original
+// workflow run instead of creating a new one. A window of 0 keeps the key for 24 hours. Not supported by
+// BulkRunWorkflow.
+func WithRunIdempotencyKey(key string, window time.Duration) RunOptFunc {
+	return func(r *admincontracts.TriggerWorkflowRequest) error {
+		if key == "" || len(key) > 255 {
+			return fmt.Errorf("idempotency key must be between 1 and 255 characters")
+		}
+
+		if window < 0 {
+			return fmt.Errorf("idempotency window must not be negative")
+		}
+
+		r.IdempotencyKey = &key
+
+		if window > 0 {
+			windowStr := window.String()
+			r.IdempotencyWindow = &windowStr
+		}
+
+		return nil
+	}
+}
+
+// WithRunTraceContext stores the W3C trace context of the span of ctx in the additional metadata of the
+// workflow run, so that the span of RunWorkflow, and the spans of the run's step runs, are part of the
+// trace of ctx. Options are applied in order, so it must be passed after WithRunMetadata.
+func WithRunTraceContext(ctx context.Context) RunOptFunc {
+	return func(r *admincontracts.TriggerWorkflowRequest) error {
+		metadata, err := injectTraceContextJSON(ctx, r.AdditionalMetadata)
+
+		if err != nil {
+			return err
+		}
+
+		r.AdditionalMetadata = metadata
+
+		return nil
+	}
+}
+
+func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, options ...RunOptFunc) (_ *Workflow, err error) {
+	inputJSON, err := a.marshalInput(context.Background(), input)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not marshal input: %w", err)
+		return nil, err
 	}
 
 	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
@@ -205,7 +361,7 @@ func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, op
 
 	request := admincontracts.TriggerWorkflowRequest{
 		Name:  workflowName,
-		Input: string(inputBytes),
+		Input: inputJSON,
 	}
 
 	for _, optionFunc := range options {
@@ -215,7 +371,26 @@ func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, op
 		}
 	}
 
-	res, err := a.client.TriggerWorkflow(a.ctx.newContext(context.Background()), &request)
+	// the span is a child of the trace context which was passed with WithRunTraceContext, if any, and the
+	// step runs of the workflow run continue its trace
+	ctx, span := startSpan(
+		extractTraceContextJSON(context.Background(), request.AdditionalMetadata),
+		a.tracer,
+		"hatchet.run_workflow",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.String("hatchet.workflow_name", workflowName)),
+	)
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	request.AdditionalMetadata, err = injectTraceContextJSON(ctx, request.AdditionalMetadata)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add trace context to additional metadata: %w", err)
+	}
+
+	res, err := a.client.TriggerWorkflow(a.ctx.newContext(ctx), &request)
 
 	if err != nil {
 		if status.Code(err) == codes.AlreadyExists {
@@ -239,19 +414,134 @@ func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, op
 	}, nil
 }
 
+type RunStepOptFunc func(*runStepOpts) error
+
+type runStepOpts struct {
+	parents  map[string]interface{}
+	metadata *string
+}
+
+// WithParentOutput sets the output of a parent of the step, which the step reads instead of the output
+// of a run of the parent.
+func WithParentOutput(parentStepName string, output interface{}) RunStepOptFunc {
+	return func(o *runStepOpts) error {
+		o.parents[parentStepName] = output
+		return nil
+	}
+}
+
+// WithRunStepMetadata sets the additional metadata of the partial workflow run.
+func WithRunStepMetadata(metadata interface{}) RunStepOptFunc {
+	return func(o *runStepOpts) error {
+		metadataBytes, err := json.Marshal(metadata)
+
+		if err != nil {
+			return err
+		}
+
+		metadataString := string(metadataBytes)
+
+		o.metadata = &metadataString
+
+		return nil
+	}
+}
+
+func (a *adminClientImpl) RunStep(ctx context.Context, workflowName, stepName string, input interface{}, opts ...RunStepOptFunc) (*WorkflowResult, error) {
+	inputJSON, err := a.marshalInput(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	o := &runStepOpts{
+		parents: map[string]interface{}{},
+	}
+
+	for _, f := range opts {
+		if err := f(o); err != nil {
+			return nil, fmt.Errorf("could not apply run step option: %w", err)
+		}
+	}
+
+	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
+		workflowName = fmt.Sprintf("%s%s", a.namespace, workflowName)
+	}
+
+	request := admincontracts.RunStepRequest{
+		Name:               workflowName,
+		Step:               stepName,
+		Input:              inputJSON,
+		AdditionalMetadata: o.metadata,
+	}
+
+	if len(o.parents) > 0 {
+		parentsBytes, err := json.Marshal(o.parents)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal parent outputs: %w", err)
+		}
+
+		parents := string(parentsBytes)
+		request.Parents = &parents
+	}
+
+	res, err := a.client.RunStep(a.ctx.newContext(ctx), &request)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not run step: %w", err)
+	}
+
+	// the listener is closed once the result was received
+	listenCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	listener, err := a.subscriber.SubscribeToWorkflowRunEvents(listenCtx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to workflow run events: %w", err)
+	}
+
+	resChan := make(chan *WorkflowResult, 1)
+
+	err = listener.AddWorkflowRun(res.WorkflowRunId, func(event WorkflowRunEvent) error {
+		// non-blocking send
+		select {
+		case resChan <- &WorkflowResult{
+			workflowRun:  event,
+			payloadStore: a.payloads.getStore(),
+		}:
+		default:
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for workflow events: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-resChan:
+		return result, nil
+	}
+}
+
 func (a *adminClientImpl) BulkRunWorkflow(workflows []*WorkflowRun) ([]string, error) {
 
 	triggerWorkflowRequests := make([]*admincontracts.TriggerWorkflowRequest, len(workflows))
 
 	for i, workflow := range workflows {
-		inputBytes, err := json.Marshal(workflow.Input)
+		inputJSON, err := a.marshalInput(context.Background(), workflow.Input)
 		if err != nil {
-			return nil, fmt.Errorf("could not marshal input: %w", err)
+			return nil, err
 		}
 
 		triggerWorkflowRequests[i] = &admincontracts.TriggerWorkflowRequest{
 			Name:  workflow.Name,
-			Input: string(inputBytes),
+			Input: inputJSON,
 		}
 
 		for _, optionFunc := range workflow.Options {
@@ -277,10 +567,10 @@ func (a *adminClientImpl) BulkRunWorkflow(workflows []*WorkflowRun) ([]string, e
 }
 
 func (a *adminClientImpl) RunChildWorkflow(workflowName string, input interface{}, opts *ChildWorkflowOpts) (string, error) {
-	inputBytes, err := json.Marshal(input)
+	inputJSON, err := a.marshalInput(context.Background(), input)
 
 	if err != nil {
-		return "", fmt.Errorf("could not marshal input: %w", err)
+		return "", err
 	}
 
 	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
@@ -299,13 +589,16 @@ func (a *adminClientImpl) RunChildWorkflow(workflowName string, input interface{
 
 	res, err := a.client.TriggerWorkflow(a.ctx.newContext(context.Background()), &admincontracts.TriggerWorkflowRequest{
 		Name:               workflowName,
-		Input:              string(inputBytes),
+		Input:              inputJSON,
 		ParentId:           &opts.ParentId,
 		ParentStepRunId:    &opts.ParentStepRunId,
 		ChildIndex:         &childIndex,
 		ChildKey:           opts.ChildKey,
 		DesiredWorkerId:    opts.DesiredWorkerId,
 		AdditionalMetadata: &metadata,
+		Detached:           &opts.Detached,
+		Timeout:            durationToStr(opts.Timeout),
+		Priority:           opts.Priority,
 	})
 
 	if err != nil {
@@ -337,10 +630,10 @@ func (a *adminClientImpl) RunChildWorkflows(workflows []*RunChildWorkflowsOpts)
 			workflow.Opts = &ChildWorkflowOpts{}
 		}
 
-		inputBytes, err := json.Marshal(workflow.Input)
+		inputJSON, err := a.marshalInput(context.Background(), workflow.Input)
 
 		if err != nil {
-			return nil, fmt.Errorf("could not marshal input: %w", err)
+			return nil, err
 		}
 
 		var workflowName = workflow.WorkflowName
@@ -364,13 +657,16 @@ func (a *adminClientImpl) RunChildWorkflows(workflows []*RunChildWorkflowsOpts)
 
 		triggerWorkflowRequests[i] = &admincontracts.TriggerWorkflowRequest{
 			Name:               workflowName,
-			Input:              string(inputBytes),
+			Input:              inputJSON,
 			ParentId:           &workflow.Opts.ParentId,
 			ParentStepRunId:    &workflow.Opts.ParentStepRunId,
 			ChildIndex:         &childIndex,
 			ChildKey:           workflow.Opts.ChildKey,
 			DesiredWorkerId:    workflow.Opts.DesiredWorkerId,
 			AdditionalMetadata: &metadata,
+			Detached:           &workflow.Opts.Detached,
+			Timeout:            durationToStr(workflow.Opts.Timeout),
+			Priority:           workflow.Opts.Priority,
 		}
 
 	}
@@ -417,13 +713,303 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 	return nil
 }
 
+func (a *adminClientImpl) ResetRateLimit(key string) error {
+	_, err := a.client.ResetRateLimit(a.ctx.newContext(context.Background()), &admincontracts.ResetRateLimitRequest{
+		Key: key,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not reset rate limit: %w", err)
+	}
+
+	return nil
+}
+
+// ConcurrencyState is the concurrency state of a workflow.
+type ConcurrencyState struct {
+	// MaxRuns and LimitStrategy are the concurrency settings of the latest version of the workflow.
+	MaxRuns       int
+	LimitStrategy string
+
+	// Keys are the concurrency keys which have running or queued runs.
+	Keys []ConcurrencyKeyState
+}
+
+type ConcurrencyKeyState struct {
+	Key string
+
+	// Holders are the running workflow runs which hold the slots of the key.
+	Holders []ConcurrencySlotHolder
+
+	// Queued is the number of workflow runs which are waiting for a slot of the key.
+	Queued int
+}
+
+type ConcurrencySlotHolder struct {
+	WorkflowRunId string
+
+	// StartedAt is nil if the workflow run hasn't started yet.
+	StartedAt *time.Time
+}
+
+func (a *adminClientImpl) GetConcurrencyState(workflowName string) (*ConcurrencyState, error) {
+	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
+		workflowName = fmt.Sprintf("%s%s", a.namespace, workflowName)
+	}
+
+	res, err := a.client.GetConcurrencyState(a.ctx.newContext(context.Background()), &admincontracts.GetConcurrencyStateRequest{
+		Name: workflowName,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get concurrency state: %w", err)
+	}
+
+	state := &ConcurrencyState{
+		MaxRuns:       int(res.MaxRuns),
+		LimitStrategy: res.LimitStrategy,
+		Keys:          make([]ConcurrencyKeyState, 0, len(res.Keys)),
+	}
+
+	for _, key := range res.Keys {
+		keyState := ConcurrencyKeyState{
+			Key:     key.Key,
+			Holders: make([]ConcurrencySlotHolder, 0, len(key.Holders)),
+			Queued:  int(key.Queued),
+		}
+
+		for _, holder := range key.Holders {
+			h := ConcurrencySlotHolder{
+				WorkflowRunId: holder.WorkflowRunId,
+			}
+
+			if holder.StartedAt != nil {
+				startedAt := holder.StartedAt.AsTime()
+				h.StartedAt = &startedAt
+			}
+
+			keyState.Holders = append(keyState.Holders, h)
+		}
+
+		state.Keys = append(state.Keys, keyState)
+	}
+
+	return state, nil
+}
+
+// WorkflowDefinition is the definition of the latest version of a registered workflow.
+type WorkflowDefinition struct {
+	Id          string
+	Name        string
+	Description string
+	IsPaused    bool
+
+	// VersionId and Version identify the latest version of the workflow.
+	VersionId string
+	Version   string
+
+	// EventTriggers and CronTriggers are the triggers of the workflow's definition. Crons which were
+	// created through the API are not included.
+	EventTriggers []string
+	CronTriggers  []string
+
+	// ConcurrencyLimitStrategy and ConcurrencyMaxRuns are the concurrency settings of the workflow, if it
+	// has a concurrency limit.
+	ConcurrencyLimitStrategy *string
+	ConcurrencyMaxRuns       *int32
+
+	// Steps are the steps of the workflow, including the steps of its on-failure job.
+	Steps []WorkflowStepDefinition
+}
+
+type WorkflowStepDefinition struct {
+	ReadableId string
+	Action     string
+	Parents    []string
+	Timeout    string
+	Retries    int
+
+	// OnFailure is true if the step belongs to the on-failure job of the workflow.
+	OnFailure bool
+}
+
+func (a *adminClientImpl) GetWorkflow(ctx context.Context, workflowName string) (*WorkflowDefinition, error) {
+	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
+		workflowName = fmt.Sprintf("%s%s", a.namespace, workflowName)
+	}
+
+	res, err := a.client.GetWorkflow(a.ctx.newContext(ctx), &admincontracts.GetWorkflowRequest{
+		Name: workflowName,
+	})
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w: %s", ErrWorkflowNotFound, status.Convert(err).Message())
+		}
+
+		return nil, fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	def := &WorkflowDefinition{
+		Id:                       res.Id,
+		Name:                     res.Name,
+		Description:              res.Description,
+		IsPaused:                 res.IsPaused,
+		VersionId:                res.VersionId,
+		Version:                  res.Version,
+		EventTriggers:            res.EventTriggers,
+		CronTriggers:             res.CronTriggers,
+		ConcurrencyLimitStrategy: res.ConcurrencyLimitStrategy,
+		ConcurrencyMaxRuns:       res.ConcurrencyMaxRuns,
+		Steps:                    make([]WorkflowStepDefinition, 0, len(res.Steps)),
+	}
+
+	for _, step := range res.Steps {
+		def.Steps = append(def.Steps, WorkflowStepDefinition{
+			ReadableId: step.ReadableId,
+			Action:     step.Action,
+			Parents:    step.Parents,
+			Timeout:    step.Timeout,
+			Retries:    int(step.Retries),
+			OnFailure:  step.OnFailure,
+		})
+	}
+
+	return def, nil
+}
+
+type releaseConcurrencySlotOpts struct {
+	workflowRunIds []string
+	confirmed      bool
+}
+
+type ReleaseConcurrencySlotOptFunc func(*releaseConcurrencySlotOpts)
+
+// WithReleaseWorkflowRunIds only releases the slots which are held by the given workflow runs. The
+// release fails if one of the runs doesn't hold a slot of the key, for example because it finished
+// since the concurrency state was read.
+func WithReleaseWorkflowRunIds(workflowRunIds ...string) ReleaseConcurrencySlotOptFunc {
+	return func(opts *releaseConcurrencySlotOpts) {
+		opts.workflowRunIds = append(opts.workflowRunIds, workflowRunIds...)
+	}
+}
+
+// WithReleaseConfirmed confirms that the runs which hold the released slots may be cancelled while they
+// are still executing.
+func WithReleaseConfirmed() ReleaseConcurrencySlotOptFunc {
+	return func(opts *releaseConcurrencySlotOpts) {
+		opts.confirmed = true
+	}
+}
+
+func (a *adminClientImpl) ReleaseConcurrencySlot(workflowName, key string, opts ...ReleaseConcurrencySlotOptFunc) ([]string, error) {
+	releaseOpts := &releaseConcurrencySlotOpts{}
+
+	for _, f := range opts {
+		f(releaseOpts)
+	}
+
+	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
+		workflowName = fmt.Sprintf("%s%s", a.namespace, workflowName)
+	}
+
+	res, err := a.client.ReleaseConcurrencySlot(a.ctx.newContext(context.Background()), &admincontracts.ReleaseConcurrencySlotRequest{
+		Name:           workflowName,
+		Key:            key,
+		WorkflowRunIds: releaseOpts.workflowRunIds,
+		Confirm:        releaseOpts.confirmed,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not release concurrency slot: %w", err)
+	}
+
+	return res.WorkflowRunIds, nil
+}
+
+func (a *adminClientImpl) ReplayWorkflowRun(workflowRunId, fromStep string) ([]string, error) {
+	req := &admincontracts.ReplayWorkflowRunRequest{
+		WorkflowRunId: workflowRunId,
+	}
+
+	if fromStep != "" {
+		req.FromStep = &fromStep
+	}
+
+	res, err := a.client.ReplayWorkflowRun(a.ctx.newContext(context.Background()), req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not replay workflow run: %w", err)
+	}
+
+	return res.StepRunIds, nil
+}
+
+func (a *adminClientImpl) PauseWorkflow(workflowName string) error {
+	return a.setWorkflowPaused(workflowName, true)
+}
+
+func (a *adminClientImpl) ResumeWorkflow(workflowName string) error {
+	return a.setWorkflowPaused(workflowName, false)
+}
+
+func (a *adminClientImpl) setWorkflowPaused(workflowName string, isPaused bool) error {
+	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
+		workflowName = fmt.Sprintf("%s%s", a.namespace, workflowName)
+	}
+
+	_, err := a.client.SetWorkflowPaused(a.ctx.newContext(context.Background()), &admincontracts.SetWorkflowPausedRequest{
+		Name:     workflowName,
+		IsPaused: isPaused,
+	})
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("%w: %s", ErrWorkflowNotFound, status.Convert(err).Message())
+		}
+
+		return fmt.Errorf("could not update workflow: %w", err)
+	}
+
+	return nil
+}
+
+func (a *adminClientImpl) PauseTenant() error {
+	return a.setTenantPaused(true)
+}
+
+func (a *adminClientImpl) ResumeTenant() error {
+	return a.setTenantPaused(false)
+}
+
+func (a *adminClientImpl) setTenantPaused(isPaused bool) error {
+	_, err := a.client.SetTenantPaused(a.ctx.newContext(context.Background()), &admincontracts.SetTenantPausedRequest{
+		IsPaused: isPaused,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update tenant: %w", err)
+	}
+
+	return nil
+}
+
 func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontracts.PutWorkflowRequest, error) {
 	opts := &admincontracts.CreateWorkflowVersionOpts{
-		Name:          workflow.Name,
-		Version:       workflow.Version,
-		Description:   workflow.Description,
-		EventTriggers: workflow.Triggers.Events,
-		CronTriggers:  workflow.Triggers.Cron,
+		Name:                workflow.Name,
+		Version:             workflow.Version,
+		Description:         workflow.Description,
+		EventTriggers:       workflow.Triggers.Events,
+		EventTriggerFilters: workflow.Triggers.EventFilters,
+		CronTriggers:        workflow.Triggers.Cron,
+	}
+
+	for cron, policy := range workflow.Triggers.CronCatchUpPolicies {
+		if opts.CronCatchUpPolicies == nil {
+			opts.CronCatchUpPolicies = map[string]admincontracts.CronCatchUpPolicy{}
+		}
+
+		opts.CronCatchUpPolicies[cron] = admincontracts.CronCatchUpPolicy(admincontracts.CronCatchUpPolicy_value[policy])
 	}
 
 	if workflow.StickyStrategy != nil {
@@ -463,6 +1049,10 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 		opts.ScheduleTimeout = &workflow.ScheduleTimeout
 	}
 
+	if workflow.RunTimeout != "" {
+		opts.RunTimeout = &workflow.RunTimeout
+	}
+
 	if workflow.OnFailureJob != nil {
 		onFailureJob, err := a.getJobOpts("on-failure", workflow.OnFailureJob)
 
@@ -531,6 +1121,9 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 			Retries:           int32(step.Retries), // nolint: gosec
 			BackoffFactor:     step.RetryBackoffFactor,
 			BackoffMaxSeconds: step.RetryMaxBackoffSeconds,
+			JoinCount:         step.JoinCount,
+			BackoffBaseMs:     step.RetryBackoffBaseMs,
+			BackoffJitter:     step.RetryBackoffJitter,
 		}
 
 		for _, rateLimit := range step.RateLimits {
@@ -612,3 +1205,13 @@ func (a *adminClientImpl) getAdditionalMetaBytes(opt *map[string]string) ([]byte
 
 	return metadataBytes, nil
 }
+
+func durationToStr(d *time.Duration) *string {
+	if d == nil {
+		return nil
+	}
+
+	res := d.String()
+
+	return &res
+}
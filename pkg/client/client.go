@@ -9,12 +9,15 @@ import (
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
+	"github.com/hatchet-dev/hatchet/internal/grpccompress"
+	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
 	"github.com/hatchet-dev/hatchet/pkg/client/loader"
 	"github.com/hatchet-dev/hatchet/pkg/client/rest"
 
@@ -39,6 +42,16 @@ type Client interface {
 	Namespace() string
 	CloudRegisterID() *string
 	RunnableActions() []string
+
+	// Health checks that the engine is reachable and accepts the token of the client, and returns the
+	// version of the engine. The client connects lazily, so New succeeds even if the engine is down, and
+	// Health can be used to wait for the engine before sending requests. It returns an error if the engine
+	// is not reachable or rejects the token.
+	Health(ctx context.Context) (*Health, error)
+
+	// PayloadStore returns the store to which large payloads are offloaded, or nil if payloads are not
+	// offloaded, see WithPayloadStore.
+	PayloadStore() PayloadStore
 }
 
 type clientImpl struct {
@@ -53,6 +66,10 @@ type clientImpl struct {
 	rest       *rest.ClientWithResponses
 	cloudrest  *cloudrest.ClientWithResponses
 
+	// health is used for health checks, which are part of the admin service
+	health    admincontracts.WorkflowServiceClient
+	ctxLoader *contextLoader
+
 	// the tenant id
 	tenantId string
 
@@ -64,6 +81,8 @@ type clientImpl struct {
 	l *zerolog.Logger
 
 	v validator.Validator
+
+	payloadStore PayloadStore
 }
 
 type ClientOpt func(*ClientOpts)
@@ -82,11 +101,34 @@ type ClientOpts struct {
 	noGrpcRetry bool
 	sharedMeta  map[string]string
 
+	// grpcMaxMsgSize is 0 if the gRPC default is used
+	grpcMaxMsgSize int
+
+	// grpcCompression is empty if messages are not compressed
+	grpcCompression string
+
 	cloudRegisterID *string
 	runnableActions []string
 
 	filesLoader   filesLoaderFunc
 	initWorkflows bool
+
+	// pushBuffer is nil if events are not buffered
+	pushBuffer    *pushBufferOpts
+	pushBufferDir string
+
+	// durableBufferDir is empty if pushed events are not spooled
+	durableBufferDir string
+
+	// retryPolicy is nil if rejected calls are retried with the default gRPC retry options
+	retryPolicy *RetryPolicy
+
+	// tracerProvider is nil if the global tracer provider is used
+	tracerProvider trace.TracerProvider
+
+	// payloadStore is nil if payloads are not offloaded
+	payloadStore            PayloadStore
+	payloadOffloadThreshold int
 }
 
 func defaultClientOpts(token *string, cf *client.ClientConfigFile) *ClientOpts {
@@ -117,6 +159,25 @@ func defaultClientOpts(token *string, cf *client.ClientConfigFile) *ClientOpts {
 
 	logger := logger.NewDefaultLogger("client")
 
+	var payloadStore PayloadStore
+
+	if s3Conf := clientConfig.PayloadStore.S3; s3Conf.Bucket != "" {
+		payloadStore, err = NewS3PayloadStore(S3PayloadStoreOpts{
+			Endpoint:        s3Conf.Endpoint,
+			Region:          s3Conf.Region,
+			Bucket:          s3Conf.Bucket,
+			Prefix:          s3Conf.Prefix,
+			AccessKeyId:     s3Conf.AccessKeyId,
+			SecretAccessKey: s3Conf.SecretAccessKey,
+			SessionToken:    s3Conf.SessionToken,
+			PathStyle:       s3Conf.PathStyle,
+		})
+
+		if err != nil {
+			panic(fmt.Errorf("could not create payload store: %w", err))
+		}
+	}
+
 	return &ClientOpts{
 		tenantId:        clientConfig.TenantId,
 		token:           clientConfig.Token,
@@ -131,6 +192,11 @@ func defaultClientOpts(token *string, cf *client.ClientConfigFile) *ClientOpts {
 		runnableActions: clientConfig.RunnableActions,
 		noGrpcRetry:     clientConfig.NoGrpcRetry,
 		sharedMeta:      make(map[string]string),
+		grpcMaxMsgSize:  clientConfig.GRPCMaxMsgSize,
+		grpcCompression: clientConfig.GRPCCompression,
+
+		payloadStore:            payloadStore,
+		payloadOffloadThreshold: clientConfig.PayloadStore.OffloadThreshold,
 	}
 }
 
@@ -183,6 +249,87 @@ func WithSharedMeta(meta map[string]string) ClientOpt {
 	}
 }
 
+// WithPushBuffer buffers events which can't be pushed because the engine is unreachable, and pushes them
+// in the background according to the retry policy. Push only returns an error for an unreachable engine
+// once maxSize events are buffered. A maxSize of 0 doesn't limit the number of buffered events. Combined
+// with WithDurableBuffer, maxSize limits the number of events in the directory and the retry policy is
+// used for the spooled events.
+func WithPushBuffer(maxSize int, retry RetryPolicy) ClientOpt {
+	return func(opts *ClientOpts) {
+		if opts.pushBuffer == nil {
+			opts.pushBuffer = &pushBufferOpts{}
+		}
+
+		opts.pushBuffer.maxSize = maxSize
+		opts.pushBuffer.retry = retry
+	}
+}
+
+// WithPushBufferDir persists the events in the push buffer to the given directory, so events which were
+// not delivered when the process exited are pushed by the next client using the directory. Requires
+// WithPushBuffer.
+func WithPushBufferDir(dir string) ClientOpt {
+	return func(opts *ClientOpts) {
+		opts.pushBufferDir = dir
+	}
+}
+
+// WithDurableBuffer writes every pushed event to the directory before it is sent, and delivers the events in the
+// background in the order they were pushed, retrying them until the engine accepts them. Push returns once the event
+// is written, and returns ErrPushBufferFull if the events in the directory would exceed maxBytes bytes. Events which
+// were not delivered when the process exited are delivered by the next client using the directory. A maxBytes of 0
+// doesn't limit the size of the directory. It can be combined with WithPushBuffer, and dir replaces the directory of
+// WithPushBufferDir.
+func WithDurableBuffer(dir string, maxBytes int) ClientOpt {
+	return func(opts *ClientOpts) {
+		if opts.pushBuffer == nil {
+			opts.pushBuffer = &pushBufferOpts{}
+		}
+
+		opts.pushBuffer.maxBytes = maxBytes
+		opts.pushBuffer.spool = true
+		opts.durableBufferDir = dir
+	}
+}
+
+// WithRetryPolicy retries the calls which the engine rejects because the tenant is overloaded according to the
+// policy, instead of the default of 5 attempts. Retries wait at least as long as the retry hint of the engine,
+// which can be read with RetryAfter. If MaxAttempts is 0, calls are retried until their context is done. The policy
+// also applies to the events of WithDurableBuffer.
+func WithRetryPolicy(policy RetryPolicy) ClientOpt {
+	return func(opts *ClientOpts) {
+		opts.retryPolicy = &policy
+	}
+}
+
+// WithPayloadStore offloads workflow inputs and step outputs which are larger than threshold bytes to the
+// store, and sends a reference to the stored payload to the engine instead. Workers rehydrate offloaded
+// payloads when they are read, so the workers which read them need a client with the same store. A
+// threshold of 0 uses DefaultPayloadOffloadThreshold.
+func WithPayloadStore(store PayloadStore, threshold int) ClientOpt {
+	return func(opts *ClientOpts) {
+		opts.payloadStore = store
+		opts.payloadOffloadThreshold = threshold
+	}
+}
+
+// WithMaxMsgSize sets the maximum size in bytes of the gRPC messages which the client sends and receives,
+// which defaults to 4MB. The engine must accept messages of the same size, which is configured with
+// SERVER_GRPC_MAX_MSG_SIZE.
+func WithMaxMsgSize(size int) ClientOpt {
+	return func(opts *ClientOpts) {
+		opts.grpcMaxMsgSize = size
+	}
+}
+
+// WithCompression compresses the gRPC messages which the client sends with the given compressor, which
+// must be "gzip" or "zstd". The engine compresses its responses with the same compressor.
+func WithCompression(name string) ClientOpt {
+	return func(opts *ClientOpts) {
+		opts.grpcCompression = name
+	}
+}
+
 func InitWorkflows() ClientOpt {
 	return func(opts *ClientOpts) {
 		opts.initWorkflows = true
@@ -206,6 +353,10 @@ type sharedClientOpts struct {
 	v          validator.Validator
 	ctxLoader  *contextLoader
 	sharedMeta map[string]string
+	tracer     trace.Tracer
+
+	// payloads is nil if payloads are not offloaded
+	payloads *payloadOffloader
 }
 
 // New creates a new client instance.
@@ -238,6 +389,29 @@ func NewFromConfigFile(cf *client.ClientConfigFile, fs ...ClientOpt) (Client, er
 	return newFromOpts(opts)
 }
 
+// grpcCallOptions returns the default call options for the max message size and compression.
+func grpcCallOptions(opts *ClientOpts) ([]grpc.CallOption, error) {
+	var callOpts []grpc.CallOption
+
+	if opts.grpcMaxMsgSize < 0 {
+		return nil, fmt.Errorf("invalid gRPC max message size %d", opts.grpcMaxMsgSize)
+	}
+
+	if opts.grpcMaxMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(opts.grpcMaxMsgSize), grpc.MaxCallSendMsgSize(opts.grpcMaxMsgSize))
+	}
+
+	if opts.grpcCompression != "" {
+		if !grpccompress.IsSupported(opts.grpcCompression) {
+			return nil, fmt.Errorf("unsupported gRPC compression %q, must be %s or %s", opts.grpcCompression, grpccompress.Gzip, grpccompress.Zstd)
+		}
+
+		callOpts = append(callOpts, grpc.UseCompressor(opts.grpcCompression))
+	}
+
+	return callOpts, nil
+}
+
 func newFromOpts(opts *ClientOpts) (Client, error) {
 	if opts.token == "" {
 		return nil, fmt.Errorf("token is required")
@@ -259,6 +433,16 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 		grpc.WithTransportCredentials(transportCreds),
 	}
 
+	callOpts, err := grpcCallOptions(opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(callOpts) > 0 {
+		grpcOpts = append(grpcOpts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+
 	if !opts.noGrpcRetry {
 		retryOnCodes := []codes.Code{
 			codes.ResourceExhausted,
@@ -277,7 +461,14 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 			})),
 		}
 		grpcOpts = append(grpcOpts, grpc.WithChainStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...)))
-		grpcOpts = append(grpcOpts, grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...)))
+
+		if opts.retryPolicy == nil {
+			grpcOpts = append(grpcOpts, grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...)))
+		}
+	}
+
+	if opts.retryPolicy != nil {
+		grpcOpts = append(grpcOpts, grpc.WithChainUnaryInterceptor(retryUnaryInterceptor(*opts.retryPolicy, opts.l)))
 	}
 
 	conn, err := grpc.NewClient(
@@ -296,12 +487,45 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 		v:          opts.v,
 		ctxLoader:  newContextLoader(opts.token),
 		sharedMeta: opts.sharedMeta,
+		tracer:     newTracer(opts.tracerProvider),
+	}
+
+	if opts.payloadStore != nil {
+		threshold := opts.payloadOffloadThreshold
+
+		if threshold <= 0 {
+			threshold = DefaultPayloadOffloadThreshold
+		}
+
+		shared.payloads = &payloadOffloader{
+			store:     opts.payloadStore,
+			threshold: threshold,
+			tenantId:  opts.tenantId,
+		}
 	}
 
 	subscribe := newSubscribe(conn, shared)
 	admin := newAdmin(conn, shared, subscribe)
 	dispatcher := newDispatcher(conn, shared)
-	event := newEvent(conn, shared)
+
+	if opts.pushBuffer != nil {
+		opts.pushBuffer.dir = opts.pushBufferDir
+
+		if opts.pushBuffer.spool {
+			opts.pushBuffer.dir = opts.durableBufferDir
+		}
+
+		// the durable buffer has no retry policy of its own, unless it is combined with WithPushBuffer
+		if opts.pushBuffer.spool && opts.retryPolicy != nil && opts.pushBuffer.retry == (RetryPolicy{}) {
+			opts.pushBuffer.retry = *opts.retryPolicy
+		}
+	}
+
+	event, err := newEvent(conn, shared, opts.pushBuffer)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create event client: %w", err)
+	}
 
 	rest, err := rest.NewClientWithResponses(opts.serverURL, rest.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", opts.token))
@@ -342,6 +566,8 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 
 	return &clientImpl{
 		conn:            conn,
+		health:          admincontracts.NewWorkflowServiceClient(conn),
+		ctxLoader:       shared.ctxLoader,
 		tenantId:        opts.tenantId,
 		l:               opts.l,
 		admin:           admin,
@@ -356,6 +582,7 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 		namespace:       opts.namespace,
 		cloudRegisterID: opts.cloudRegisterID,
 		runnableActions: opts.runnableActions,
+		payloadStore:    opts.payloadStore,
 	}, nil
 }
 
@@ -407,6 +634,10 @@ func (c *clientImpl) RunnableActions() []string {
 	return c.runnableActions
 }
 
+func (c *clientImpl) PayloadStore() PayloadStore {
+	return c.payloadStore
+}
+
 func initWorkflows(fl filesLoaderFunc, adminClient AdminClient) error {
 	files := fl()
 
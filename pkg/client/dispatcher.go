@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"runtime"
 	"runtime/debug"
@@ -23,18 +24,37 @@ type DispatcherClient interface {
 
 	SendStepActionEvent(ctx context.Context, in *ActionEvent) (*ActionEventResponse, error)
 
+	// SendStepActionEvents sends the events in one request. The events are processed in order, and each
+	// event is processed independently, except that an event fails if an earlier event of the same step run
+	// failed. It returns the result of each event in the order of the events, and an error if the request
+	// failed, in which case no event was processed.
+	SendStepActionEvents(ctx context.Context, in []*ActionEvent) ([]ActionEventResult, error)
+
 	SendGroupKeyActionEvent(ctx context.Context, in *ActionEvent) (*ActionEventResponse, error)
 
 	ReleaseSlot(ctx context.Context, stepRunId string) error
 
 	RefreshTimeout(ctx context.Context, stepRunId string, incrementTimeoutBy string) error
 
+	PutOverridesData(ctx context.Context, stepRunId string, path string, value []byte) error
+
 	UpsertWorkerLabels(ctx context.Context, workerId string, labels map[string]interface{}) error
+
+	// UpdateWorkerActions replaces the actions and the slot groups of the worker. A nil list of actions
+	// leaves the actions and slot groups unchanged, and an empty list removes all actions of the worker.
+	UpdateWorkerActions(ctx context.Context, workerId string, actions []string, slotGroups []WorkerSlotGroup) error
+
+	// DrainWorker stops the engine from assigning new step runs to the worker. If releaseStepRuns is set,
+	// the step runs which are still assigned to the worker are released to be reassigned to other workers.
+	DrainWorker(ctx context.Context, workerId string, releaseStepRuns bool) error
 }
 
 const (
 	DefaultActionListenerRetryInterval = 5 * time.Second
 	DefaultActionListenerRetryCount    = 5
+
+	// DefaultHeartbeatInterval is the interval at which action listeners send heartbeats.
+	DefaultHeartbeatInterval = 4 * time.Second
 )
 
 // TODO: add validator to client side
@@ -45,6 +65,47 @@ type GetActionListenerRequest struct {
 	MaxRuns    *int
 	Labels     map[string]interface{}
 	WebhookId  *string
+
+	// SlotGroups are groups of actions which share a part of the slots of the worker.
+	SlotGroups []WorkerSlotGroup
+
+	// OnConnectionEvent is called when the listener loses its connection to the dispatcher and while it
+	// reconnects. It is called from the listener goroutine and must not block.
+	OnConnectionEvent func(evt ListenerConnectionEvent)
+
+	// RetryInterval is the time the listener waits before each attempt to reconnect to the dispatcher.
+	// Defaults to DefaultActionListenerRetryInterval.
+	RetryInterval time.Duration
+
+	// HeartbeatInterval is the interval at which the listener sends heartbeats to the dispatcher. Defaults
+	// to DefaultHeartbeatInterval.
+	HeartbeatInterval time.Duration
+}
+
+// WorkerSlotGroup caps the number of runs of a group of actions which the worker runs at a time. The
+// runs of the group still count towards the max runs of the worker.
+type WorkerSlotGroup struct {
+	Name    string
+	MaxRuns int
+	Actions []string
+}
+
+type ListenerConnectionEventType string
+
+const (
+	ListenerConnectionEventDisconnected ListenerConnectionEventType = "disconnected"
+	ListenerConnectionEventReconnecting ListenerConnectionEventType = "reconnecting"
+	ListenerConnectionEventReconnected  ListenerConnectionEventType = "reconnected"
+)
+
+type ListenerConnectionEvent struct {
+	Type ListenerConnectionEventType
+
+	// Attempt is the reconnect attempt, starting at 1. It is only set for reconnecting events.
+	Attempt int
+
+	// Err is the error which caused the disconnect. It is only set for disconnected events.
+	Err error
 }
 
 // ActionPayload unmarshals the action payload into the target. It also validates the resulting target.
@@ -112,6 +173,13 @@ type Action struct {
 
 	// the parent workflow run id
 	ParentWorkflowRunId *string
+
+	// the reason the step run was cancelled, only set for cancel actions
+	CancelledReason *string
+
+	// the time at which the workflow run times out, only set for start step run actions of
+	// workflow runs with a timeout
+	WorkflowRunTimeoutAt *time.Time
 }
 
 type WorkerActionListener interface {
@@ -127,6 +195,8 @@ const (
 	ActionEventTypeStarted   ActionEventType = "STEP_EVENT_TYPE_STARTED"
 	ActionEventTypeCompleted ActionEventType = "STEP_EVENT_TYPE_COMPLETED"
 	ActionEventTypeFailed    ActionEventType = "STEP_EVENT_TYPE_FAILED"
+	ActionEventTypeSuspended ActionEventType = "STEP_EVENT_TYPE_SUSPENDED"
+	ActionEventTypeCancelled ActionEventType = "STEP_EVENT_TYPE_CANCELLED"
 )
 
 type ActionEvent struct {
@@ -140,6 +210,18 @@ type ActionEvent struct {
 
 	// The event payload. This must be JSON-compatible as it gets marshalled to a JSON string.
 	EventPayload interface{}
+
+	// (optional) whether a failed step run should not be retried
+	ShouldNotRetry *bool
+
+	// (optional) a code which identifies the error of a failed step run
+	ErrorCode *string
+
+	// (optional) structured data about the error of a failed step run, which must be valid JSON
+	ErrorDetails []byte
+
+	// (optional) when a suspended step run should be started again
+	ResumeAt *time.Time
 }
 
 type ActionEventResponse struct {
@@ -150,6 +232,15 @@ type ActionEventResponse struct {
 	WorkerId string
 }
 
+// ActionEventResult is the result of an event sent with SendStepActionEvents.
+type ActionEventResult struct {
+	// Response is the response of the event, nil if the event was not processed.
+	Response *ActionEventResponse
+
+	// Err is the reason the event was not processed, or nil if it was processed.
+	Err error
+}
+
 type dispatcherClientImpl struct {
 	client dispatchercontracts.DispatcherClient
 
@@ -160,6 +251,9 @@ type dispatcherClientImpl struct {
 	v validator.Validator
 
 	ctx *contextLoader
+
+	// payloads is nil if outputs are not offloaded
+	payloads *payloadOffloader
 }
 
 func newDispatcher(conn *grpc.ClientConn, opts *sharedClientOpts) DispatcherClient {
@@ -169,6 +263,7 @@ func newDispatcher(conn *grpc.ClientConn, opts *sharedClientOpts) DispatcherClie
 		l:        opts.l,
 		v:        opts.v,
 		ctx:      opts.ctxLoader,
+		payloads: opts.payloads,
 	}
 }
 
@@ -195,6 +290,12 @@ type actionListenerImpl struct {
 	ctx *contextLoader
 
 	listenerStrategy ListenerStrategy
+
+	onConnectionEvent func(evt ListenerConnectionEvent)
+
+	retryInterval time.Duration
+
+	heartbeatInterval time.Duration
 }
 
 func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetActionListenerRequest) (*actionListenerImpl, *string, error) {
@@ -226,6 +327,7 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		Actions:    req.Actions,
 		Services:   req.Services,
 		WebhookId:  req.WebhookId,
+		SlotGroups: mapSlotGroups(req.SlotGroups),
 		RuntimeInfo: &dispatchercontracts.RuntimeInfo{
 			Language:        dispatchercontracts.SDKS_GO.Enum(),
 			LanguageVersion: &goVersion,
@@ -262,15 +364,30 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		return nil, nil, fmt.Errorf("could not subscribe to the worker: %w", err)
 	}
 
+	retryInterval := req.RetryInterval
+
+	if retryInterval <= 0 {
+		retryInterval = DefaultActionListenerRetryInterval
+	}
+
+	heartbeatInterval := req.HeartbeatInterval
+
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = DefaultHeartbeatInterval
+	}
+
 	return &actionListenerImpl{
-		client:           d.client,
-		listenClient:     listener,
-		workerId:         resp.WorkerId,
-		l:                d.l,
-		v:                d.v,
-		tenantId:         d.tenantId,
-		ctx:              d.ctx,
-		listenerStrategy: ListenerStrategyV2,
+		client:            d.client,
+		listenClient:      listener,
+		workerId:          resp.WorkerId,
+		l:                 d.l,
+		v:                 d.v,
+		tenantId:          d.tenantId,
+		ctx:               d.ctx,
+		listenerStrategy:  ListenerStrategyV2,
+		onConnectionEvent: req.OnConnectionEvent,
+		retryInterval:     retryInterval,
+		heartbeatInterval: heartbeatInterval,
 	}, &resp.WorkerId, nil
 }
 
@@ -280,20 +397,20 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, <-cha
 
 	a.l.Debug().Msgf("Starting to listen for actions")
 
-	// update the worker with a last heartbeat time every 4 seconds as long as the worker is connected
+	// update the worker with a last heartbeat time every heartbeat interval as long as the worker is connected
 	go func() {
 		timer := time.NewTicker(100 * time.Millisecond)
 		defer timer.Stop()
 
-		// set last heartbeat to 5 seconds ago so that the first heartbeat is sent immediately
-		lastHeartbeat := time.Now().Add(-5 * time.Second)
+		// set last heartbeat to one interval ago so that the first heartbeat is sent immediately
+		lastHeartbeat := time.Now().Add(-a.heartbeatInterval)
 
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-timer.C:
-				if now := time.Now().UTC(); lastHeartbeat.Add(4 * time.Second).Before(now) {
+				if now := time.Now().UTC(); lastHeartbeat.Add(a.heartbeatInterval).Before(now) {
 					a.l.Debug().Msgf("updating worker %s heartbeat", a.workerId)
 
 					_, err := a.client.Heartbeat(a.ctx.newContext(ctx), &dispatchercontracts.HeartbeatRequest{
@@ -341,6 +458,11 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, <-cha
 
 				retries++
 
+				a.emitConnectionEvent(ListenerConnectionEvent{
+					Type: ListenerConnectionEventDisconnected,
+					Err:  err,
+				})
+
 				// if this is an unimplemented error, default to v1
 				if a.listenerStrategy == ListenerStrategyV2 && status.Code(err) == codes.Unimplemented {
 					a.l.Debug().Msgf("Falling back to v1 listener strategy")
@@ -354,7 +476,7 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, <-cha
 					errCh <- fmt.Errorf("failed to resubscribe: %w", err)
 				}
 
-				time.Sleep(DefaultActionListenerRetryInterval)
+				time.Sleep(a.retryInterval)
 
 				continue
 			}
@@ -390,25 +512,34 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, <-cha
 				}
 			}
 
+			var workflowRunTimeoutAt *time.Time
+
+			if assignedAction.WorkflowRunTimeoutAt != nil {
+				timeoutAt := assignedAction.WorkflowRunTimeoutAt.AsTime()
+				workflowRunTimeoutAt = &timeoutAt
+			}
+
 			ch <- &Action{
-				TenantId:            assignedAction.TenantId,
-				WorkflowRunId:       assignedAction.WorkflowRunId,
-				GetGroupKeyRunId:    assignedAction.GetGroupKeyRunId,
-				WorkerId:            a.workerId,
-				JobId:               assignedAction.JobId,
-				JobName:             assignedAction.JobName,
-				JobRunId:            assignedAction.JobRunId,
-				StepId:              assignedAction.StepId,
-				StepName:            assignedAction.StepName,
-				StepRunId:           assignedAction.StepRunId,
-				ActionId:            assignedAction.ActionId,
-				ActionType:          actionType,
-				ActionPayload:       []byte(unquoted),
-				RetryCount:          assignedAction.RetryCount,
-				AdditionalMetadata:  additionalMetadata,
-				ChildIndex:          assignedAction.ChildWorkflowIndex,
-				ChildKey:            assignedAction.ChildWorkflowKey,
-				ParentWorkflowRunId: assignedAction.ParentWorkflowRunId,
+				TenantId:             assignedAction.TenantId,
+				WorkflowRunId:        assignedAction.WorkflowRunId,
+				GetGroupKeyRunId:     assignedAction.GetGroupKeyRunId,
+				WorkerId:             a.workerId,
+				JobId:                assignedAction.JobId,
+				JobName:              assignedAction.JobName,
+				JobRunId:             assignedAction.JobRunId,
+				StepId:               assignedAction.StepId,
+				StepName:             assignedAction.StepName,
+				StepRunId:            assignedAction.StepRunId,
+				ActionId:             assignedAction.ActionId,
+				ActionType:           actionType,
+				ActionPayload:        []byte(unquoted),
+				RetryCount:           assignedAction.RetryCount,
+				AdditionalMetadata:   additionalMetadata,
+				ChildIndex:           assignedAction.ChildWorkflowIndex,
+				ChildKey:             assignedAction.ChildWorkflowKey,
+				ParentWorkflowRunId:  assignedAction.ParentWorkflowRunId,
+				CancelledReason:      assignedAction.CancelledReason,
+				WorkflowRunTimeoutAt: workflowRunTimeoutAt,
 			}
 		}
 
@@ -431,7 +562,12 @@ func (a *actionListenerImpl) retrySubscribe(ctx context.Context) error {
 	retries := 0
 
 	for retries < DefaultActionListenerRetryCount {
-		time.Sleep(DefaultActionListenerRetryInterval)
+		time.Sleep(a.retryInterval)
+
+		a.emitConnectionEvent(ListenerConnectionEvent{
+			Type:    ListenerConnectionEventReconnecting,
+			Attempt: retries + 1,
+		})
 
 		var err error
 		var listenClient dispatchercontracts.Dispatcher_ListenClient
@@ -454,12 +590,22 @@ func (a *actionListenerImpl) retrySubscribe(ctx context.Context) error {
 
 		a.listenClient = listenClient
 
+		a.emitConnectionEvent(ListenerConnectionEvent{
+			Type: ListenerConnectionEventReconnected,
+		})
+
 		return nil
 	}
 
 	return fmt.Errorf("could not subscribe to the worker after %d retries", retries)
 }
 
+func (a *actionListenerImpl) emitConnectionEvent(evt ListenerConnectionEvent) {
+	if a.onConnectionEvent != nil {
+		a.onConnectionEvent(evt)
+	}
+}
+
 func (a *actionListenerImpl) Unregister() error {
 	_, err := a.client.Unsubscribe(
 		a.ctx.newContext(context.Background()),
@@ -480,6 +626,67 @@ func (d *dispatcherClientImpl) GetActionListener(ctx context.Context, req *GetAc
 }
 
 func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *ActionEvent) (*ActionEventResponse, error) {
+	event, err := d.toStepActionEvent(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.SendStepActionEvent(d.ctx.newContext(ctx), event)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ActionEventResponse{
+		TenantId: resp.TenantId,
+		WorkerId: resp.WorkerId,
+	}, nil
+}
+
+func (d *dispatcherClientImpl) SendStepActionEvents(ctx context.Context, in []*ActionEvent) ([]ActionEventResult, error) {
+	events := make([]*dispatchercontracts.StepActionEvent, 0, len(in))
+
+	for _, e := range in {
+		event, err := d.toStepActionEvent(ctx, e)
+
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	resp, err := d.client.SendStepActionEvents(d.ctx.newContext(ctx), &dispatchercontracts.StepActionEventBatch{
+		Events: events,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Responses) != len(in) || len(resp.Errors) != len(in) {
+		return nil, fmt.Errorf("engine returned %d responses for %d events", len(resp.Responses), len(in))
+	}
+
+	res := make([]ActionEventResult, len(in))
+
+	for i, r := range resp.Responses {
+		if resp.Errors[i] != "" {
+			res[i].Err = errors.New(resp.Errors[i])
+			continue
+		}
+
+		res[i].Response = &ActionEventResponse{
+			TenantId: r.TenantId,
+			WorkerId: r.WorkerId,
+		}
+	}
+
+	return res, nil
+}
+
+func (d *dispatcherClientImpl) toStepActionEvent(ctx context.Context, in *ActionEvent) (*dispatchercontracts.StepActionEvent, error) {
 	// validate the request
 	if err := d.v.Validate(in); err != nil {
 		return nil, err
@@ -491,6 +698,22 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		return nil, err
 	}
 
+	// the payload of a completed event is the output of the step
+	if in.EventType == ActionEventTypeCompleted {
+		payloadBytes, err = d.payloads.offload(ctx, payloadBytes)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var errorDetails *string
+
+	if len(in.ErrorDetails) > 0 {
+		details := string(in.ErrorDetails)
+		errorDetails = &details
+	}
+
 	var actionEventType dispatchercontracts.StepActionEventType
 
 	switch in.EventType {
@@ -500,11 +723,21 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		actionEventType = dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED
 	case ActionEventTypeFailed:
 		actionEventType = dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_FAILED
+	case ActionEventTypeSuspended:
+		actionEventType = dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_SUSPENDED
+	case ActionEventTypeCancelled:
+		actionEventType = dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_CANCELLED
 	default:
 		actionEventType = dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_UNKNOWN
 	}
 
-	resp, err := d.client.SendStepActionEvent(d.ctx.newContext(ctx), &dispatchercontracts.StepActionEvent{
+	var resumeAt *timestamppb.Timestamp
+
+	if in.ResumeAt != nil {
+		resumeAt = timestamppb.New(*in.ResumeAt)
+	}
+
+	return &dispatchercontracts.StepActionEvent{
 		WorkerId:       in.WorkerId,
 		JobId:          in.JobId,
 		JobRunId:       in.JobRunId,
@@ -514,15 +747,10 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		EventTimestamp: timestamppb.New(*in.EventTimestamp),
 		EventType:      actionEventType,
 		EventPayload:   string(payloadBytes),
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &ActionEventResponse{
-		TenantId: resp.TenantId,
-		WorkerId: resp.WorkerId,
+		ShouldNotRetry: in.ShouldNotRetry,
+		ErrorCode:      in.ErrorCode,
+		ErrorDetails:   errorDetails,
+		ResumeAt:       resumeAt,
 	}, nil
 }
 
@@ -596,6 +824,20 @@ func (a *dispatcherClientImpl) RefreshTimeout(ctx context.Context, stepRunId str
 	return nil
 }
 
+func (a *dispatcherClientImpl) PutOverridesData(ctx context.Context, stepRunId string, path string, value []byte) error {
+	_, err := a.client.PutOverridesData(a.ctx.newContext(ctx), &dispatchercontracts.OverridesData{
+		StepRunId: stepRunId,
+		Path:      path,
+		Value:     string(value),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (a *dispatcherClientImpl) UpsertWorkerLabels(ctx context.Context, workerId string, req map[string]interface{}) error {
 	labels := mapLabels(req)
 
@@ -611,6 +853,48 @@ func (a *dispatcherClientImpl) UpsertWorkerLabels(ctx context.Context, workerId
 	return nil
 }
 
+func (a *dispatcherClientImpl) UpdateWorkerActions(ctx context.Context, workerId string, actions []string, slotGroups []WorkerSlotGroup) error {
+	_, err := a.client.UpdateWorkerActions(a.ctx.newContext(ctx), &dispatchercontracts.UpdateWorkerActionsRequest{
+		WorkerId:     workerId,
+		Actions:      actions,
+		SlotGroups:   mapSlotGroups(slotGroups),
+		ClearActions: actions != nil && len(actions) == 0,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *dispatcherClientImpl) DrainWorker(ctx context.Context, workerId string, releaseStepRuns bool) error {
+	_, err := a.client.DrainWorker(a.ctx.newContext(ctx), &dispatchercontracts.DrainWorkerRequest{
+		WorkerId:        workerId,
+		ReleaseStepRuns: releaseStepRuns,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func mapSlotGroups(slotGroups []WorkerSlotGroup) []*dispatchercontracts.WorkerSlotGroup {
+	res := make([]*dispatchercontracts.WorkerSlotGroup, 0, len(slotGroups))
+
+	for _, slotGroup := range slotGroups {
+		res = append(res, &dispatchercontracts.WorkerSlotGroup{
+			Name:    slotGroup.Name,
+			MaxRuns: int32(slotGroup.MaxRuns), // nolint: gosec
+			Actions: slotGroup.Actions,
+		})
+	}
+
+	return res
+}
+
 func mapLabels(req map[string]interface{}) map[string]*dispatchercontracts.WorkerLabels {
 	labels := map[string]*dispatchercontracts.WorkerLabels{}
 
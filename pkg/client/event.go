@@ -4,9 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
@@ -15,26 +21,80 @@ import (
 
 type pushOpt struct {
 	additionalMetadata map[string]string
+	orderingKey        *string
+	sequence           *int64
+	eventId            *string
+	eventIdWindow      *time.Duration
+	priority           *int32
 }
 
 type PushOpFunc func(*pushOpt) error
 
 type BulkPushOpFunc func(*eventcontracts.BulkPushEventRequest) error
 
+type PutLogOpFunc func(*eventcontracts.PutLogRequest) error
+
+type ReplayOpFunc func(*eventcontracts.ReplayEventRequest) error
+
 type EventClient interface {
 	Push(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) error
 
+	// PushWithResult pushes an event like Push, and returns the id of the event. If an event with the id
+	// set by WithEventID was already accepted, it returns the original event and the workflow runs which
+	// it has triggered so far. It doesn't use the push buffer, since the result is only known once the
+	// engine has accepted the event.
+	PushWithResult(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) (*PushResult, error)
+
 	BulkPush(ctx context.Context, payloads []EventWithAdditionalMetadata, options ...BulkPushOpFunc) error
 
-	PutLog(ctx context.Context, stepRunId, msg string) error
+	// BulkPushWithResults pushes any number of events in batches of at most MaxBulkPushEvents events, with
+	// one request per batch. Unlike BulkPush, an invalid event doesn't fail the other events of its
+	// batch. It returns the result of each event in the order of the events, and an error if a batch
+	// could not be pushed, in which case the events of that and the following batches have the error as
+	// their result.
+	BulkPushWithResults(ctx context.Context, events []EventWithAdditionalMetadata, options ...BulkPushOpFunc) ([]BulkPushResult, error)
+
+	// Replay pushes the event with the given id again, so the workflows which it triggers run again. The
+	// payload and the additional metadata can be replaced with WithReplayPayload and
+	// WithReplayAdditionalMetadata. It returns the id of the new event, which is linked to the original
+	// event.
+	Replay(ctx context.Context, eventId string, options ...ReplayOpFunc) (string, error)
+
+	PutLog(ctx context.Context, stepRunId, msg string, options ...PutLogOpFunc) error
 
 	PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error
+
+	// Close stops the push buffer or durable buffer of the client, and does nothing for clients without a
+	// buffer. It waits until the buffered events are delivered or ctx is done, and returns an error if events
+	// were left undelivered. Those events are delivered by the next client using the directory of the buffer,
+	// and are lost if the buffer is only kept in memory. Close should be called once the producers of events,
+	// such as workers, have stopped and before the process exits. Push returns ErrPushBufferClosed afterwards.
+	Close(ctx context.Context) error
 }
 
 type EventWithAdditionalMetadata struct {
 	Event              interface{}       `json:"event"`
 	AdditionalMetadata map[string]string `json:"metadata"`
 	Key                string            `json:"key"`
+
+	// OrderingKey and Sequence are optional, see WithEventOrderingKey and WithEventSequence
+	OrderingKey string `json:"orderingKey,omitempty"`
+	Sequence    int64  `json:"sequence,omitempty"`
+
+	// Priority is optional, see WithEventPriority
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// MaxBulkPushEvents is the maximum number of events which the engine accepts in a single bulk push.
+const MaxBulkPushEvents = 1000
+
+// BulkPushResult is the result of pushing an event with BulkPushWithResults.
+type BulkPushResult struct {
+	// EventId is the id of the created event, empty if the event was not created.
+	EventId string
+
+	// Err is the reason the event was not created, or nil if it was created.
+	Err error
 }
 
 type eventClientImpl struct {
@@ -51,18 +111,39 @@ type eventClientImpl struct {
 	ctx *contextLoader
 
 	sharedMeta map[string]string
+
+	// buffer is nil if events are not buffered
+	buffer *pushBuffer
+
+	tracer trace.Tracer
 }
 
-func newEvent(conn *grpc.ClientConn, opts *sharedClientOpts) EventClient {
+func newEvent(conn *grpc.ClientConn, opts *sharedClientOpts, bufferOpts *pushBufferOpts) (EventClient, error) {
+	client := eventcontracts.NewEventsServiceClient(conn)
+
+	var buffer *pushBuffer
+
+	if bufferOpts != nil {
+		var err error
+
+		buffer, err = newPushBuffer(client, opts.ctxLoader, opts.l, *bufferOpts)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &eventClientImpl{
-		client:     eventcontracts.NewEventsServiceClient(conn),
+		client:     client,
 		tenantId:   opts.tenantId,
 		namespace:  opts.namespace,
 		l:          opts.l,
 		v:          opts.v,
 		ctx:        opts.ctxLoader,
 		sharedMeta: opts.sharedMeta,
-	}
+		buffer:     buffer,
+		tracer:     opts.tracer,
+	}, nil
 }
 
 func WithEventMetadata(metadata map[string]string) PushOpFunc {
@@ -73,7 +154,112 @@ func WithEventMetadata(metadata map[string]string) PushOpFunc {
 	}
 }
 
+// WithEventOrderingKey sets the ordering key of the event. Events with the same ordering key trigger their
+// workflow runs one at a time: the workflow runs of an event start once all workflow runs of the previous
+// event of the key have finished.
+func WithEventOrderingKey(key string) PushOpFunc {
+	return func(r *pushOpt) error {
+		r.orderingKey = &key
+
+		return nil
+	}
+}
+
+// WithEventSequence sets the sequence number of the event within its ordering key. Sequence numbers start
+// at 1, and events which arrive out of order are processed in sequence order. Requires WithEventOrderingKey.
+func WithEventSequence(sequence int64) PushOpFunc {
+	return func(r *pushOpt) error {
+		if sequence < 1 {
+			return fmt.Errorf("sequence must be at least 1")
+		}
+
+		r.sequence = &sequence
+
+		return nil
+	}
+}
+
+// WithEventID sets the id which the producer assigns to the event. The engine accepts each id at most once per
+// tenant, so producers can safely retry a push: Push returns nil both if the event was created and if an event
+// with the id was already accepted, in which case no new workflow runs are triggered. PushWithResult reports
+// duplicates along with the original event.
+// Ids are released when the event retention deletes their event, after which the id is accepted again.
+//
+// Buffered pushes return nil once the event is buffered, and duplicates of buffered events are dropped.
+func WithEventID(id string) PushOpFunc {
+	return func(r *pushOpt) error {
+		if id == "" || len(id) > 255 {
+			return fmt.Errorf("event id must be between 1 and 255 characters")
+		}
+
+		r.eventId = &id
+
+		return nil
+	}
+}
+
+// WithEventDedupeWindow sets how long the engine keeps the id set by WithEventID. Once the window has passed,
+// an event with the same id is accepted again, even if the original event hasn't been deleted yet. Requires
+// WithEventID.
+func WithEventDedupeWindow(window time.Duration) PushOpFunc {
+	return func(r *pushOpt) error {
+		if window <= 0 {
+			return fmt.Errorf("dedupe window must be positive")
+		}
+
+		r.eventIdWindow = &window
+
+		return nil
+	}
+}
+
+// WithEventPriority sets the priority of the workflow runs which the event triggers, from 1 (lowest) to 3
+// (highest), overriding the default priority of the workflows. See WithRunPriority.
+func WithEventPriority(priority int32) PushOpFunc {
+	return func(r *pushOpt) error {
+		if priority < 1 || priority > 3 {
+			return fmt.Errorf("priority must be between 1 and 3")
+		}
+
+		r.priority = &priority
+
+		return nil
+	}
+}
+
+// PushResult is the result of pushing an event with PushWithResult.
+type PushResult struct {
+	// EventId is the id of the event. For duplicates, it is the id of the original event, and it is empty
+	// if the engine doesn't return the original event.
+	EventId string
+
+	// Duplicate is set if an event with the id set by WithEventID was already accepted, in which case no
+	// new event was created.
+	Duplicate bool
+
+	// WorkflowRunIds are the ids of the workflow runs which the original event has triggered so far, for
+	// duplicates. The workflow runs of a new event are created after the push returns.
+	WorkflowRunIds []string
+}
+
 func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) error {
+	_, err := a.push(ctx, eventKey, payload, true, options...)
+	return err
+}
+
+func (a *eventClientImpl) PushWithResult(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) (*PushResult, error) {
+	return a.push(ctx, eventKey, payload, false, options...)
+}
+
+// push pushes the event, through the push buffer if useBuffer is set and the client has one, in which case
+// the result is nil.
+func (a *eventClientImpl) push(ctx context.Context, eventKey string, payload interface{}, useBuffer bool, options ...PushOpFunc) (res *PushResult, err error) {
+	ctx, span := startSpan(ctx, a.tracer, "hatchet.push_event", trace.WithSpanKind(trace.SpanKindProducer), trace.WithAttributes(
+		attribute.String("hatchet.event_key", eventKey),
+	))
+	defer func() {
+		endSpan(span, err)
+	}()
 
 	request := eventcontracts.PushEventRequest{
 		Key:            a.namespace + eventKey,
@@ -83,7 +269,7 @@ func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload int
 	payloadBytes, err := json.Marshal(payload)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	request.Payload = string(payloadBytes)
@@ -93,27 +279,65 @@ func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload int
 	for _, optionFunc := range options {
 		err = optionFunc(opts)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
+	if opts.eventIdWindow != nil && opts.eventId == nil {
+		return nil, fmt.Errorf("dedupe window requires an event id")
+	}
+
 	additionalMetaBytes, err := a.getAdditionalMetaBytes(&opts.additionalMetadata)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	additionalMetaString := string(additionalMetaBytes)
 
-	request.AdditionalMetadata = &additionalMetaString
+	// the workflow runs of the event continue the trace of the push
+	request.AdditionalMetadata, err = injectTraceContextJSON(ctx, &additionalMetaString)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.orderingKey != nil {
+		orderingKey := a.namespace + *opts.orderingKey
+		request.OrderingKey = &orderingKey
+	}
+
+	request.Sequence = opts.sequence
+	request.ExternalId = opts.eventId
+	request.Priority = opts.priority
 
-	_, err = a.client.Push(a.ctx.newContext(ctx), &request)
+	if opts.eventIdWindow != nil {
+		window := opts.eventIdWindow.String()
+		request.ExternalIdWindow = &window
+	}
+
+	if useBuffer && a.buffer != nil {
+		return nil, a.buffer.push(ctx, &request)
+	}
+
+	resp, err := a.client.Push(a.ctx.newContext(ctx), &request)
+
+	// engines which don't return the original event reject duplicates
+	if opts.eventId != nil && status.Code(err) == codes.AlreadyExists {
+		return &PushResult{
+			Duplicate: true,
+		}, nil
+	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &PushResult{
+		EventId:        resp.EventId,
+		Duplicate:      resp.Duplicate,
+		WorkflowRunIds: resp.WorkflowRunIds,
+	}, nil
 }
 
 func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAdditionalMetadata, options ...BulkPushOpFunc) error {
@@ -123,27 +347,23 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 	var events []*eventcontracts.PushEventRequest
 
 	for _, p := range payload {
+		event, err := a.toPushEventRequest(p)
 
-		ePayload, err := json.Marshal(p.Event)
-		if err != nil {
-			return err
-		}
-		eMetadata, err := json.Marshal(p.AdditionalMetadata)
 		if err != nil {
 			return err
 		}
-		eMetadataString := string(eMetadata)
 
-		events = append(events, &eventcontracts.PushEventRequest{
-			Key:                a.namespace + p.Key,
-			EventTimestamp:     timestamppb.Now(),
-			Payload:            string(ePayload),
-			AdditionalMetadata: &eMetadataString,
-		})
+		events = append(events, event)
 	}
 
 	request.Events = events
 
+	for _, optionFunc := range options {
+		if err := optionFunc(&request); err != nil {
+			return err
+		}
+	}
+
 	_, err := a.client.BulkPush(a.ctx.newContext(ctx), &request)
 
 	if err != nil {
@@ -153,16 +373,235 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 	return nil
 }
 
-func (a *eventClientImpl) PutLog(ctx context.Context, stepRunId, msg string) error {
-	_, err := a.client.PutLog(a.ctx.newContext(ctx), &eventcontracts.PutLogRequest{
+func (a *eventClientImpl) BulkPushWithResults(ctx context.Context, events []EventWithAdditionalMetadata, options ...BulkPushOpFunc) ([]BulkPushResult, error) {
+	results := make([]BulkPushResult, len(events))
+
+	for start := 0; start < len(events); start += MaxBulkPushEvents {
+		end := min(start+MaxBulkPushEvents, len(events))
+
+		err := a.bulkPushBatch(ctx, events[start:end], results[start:end], options...)
+
+		if err != nil {
+			for j := start; j < len(events); j++ {
+				results[j] = BulkPushResult{Err: err}
+			}
+
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+// bulkPushBatch pushes a batch of at most MaxBulkPushEvents events, and writes the result of each event
+// to results.
+func (a *eventClientImpl) bulkPushBatch(ctx context.Context, batch []EventWithAdditionalMetadata, results []BulkPushResult, options ...BulkPushOpFunc) error {
+	partial := true
+
+	request := eventcontracts.BulkPushEventRequest{
+		Partial: &partial,
+	}
+
+	// the indexes of the events in the request, as events which can't be encoded are not sent
+	sent := make([]int, 0, len(batch))
+
+	for j, p := range batch {
+		event, err := a.toPushEventRequest(p)
+
+		if err != nil {
+			results[j].Err = err
+			continue
+		}
+
+		request.Events = append(request.Events, event)
+		sent = append(sent, j)
+	}
+
+	if len(request.Events) == 0 {
+		return nil
+	}
+
+	for _, optionFunc := range options {
+		if err := optionFunc(&request); err != nil {
+			return err
+		}
+	}
+
+	resp, err := a.client.BulkPush(a.ctx.newContext(ctx), &request)
+
+	if err != nil {
+		return err
+	}
+
+	// events are returned in the order of the request, without the rejected events. Engines which don't
+	// support partial pushes return no errors, and fail the request if any event is invalid.
+	created := resp.Events
+
+	for k, j := range sent {
+		if k < len(resp.Errors) && resp.Errors[k] != "" {
+			results[j].Err = fmt.Errorf("event was rejected: %s", resp.Errors[k])
+			continue
+		}
+
+		if len(created) == 0 {
+			results[j].Err = fmt.Errorf("event was not returned by the engine")
+			continue
+		}
+
+		results[j].EventId = created[0].EventId
+		created = created[1:]
+	}
+
+	return nil
+}
+
+func (a *eventClientImpl) toPushEventRequest(p EventWithAdditionalMetadata) (*eventcontracts.PushEventRequest, error) {
+	ePayload, err := json.Marshal(p.Event)
+	if err != nil {
+		return nil, err
+	}
+	eMetadata, err := json.Marshal(p.AdditionalMetadata)
+	if err != nil {
+		return nil, err
+	}
+	eMetadataString := string(eMetadata)
+
+	event := &eventcontracts.PushEventRequest{
+		Key:                a.namespace + p.Key,
+		EventTimestamp:     timestamppb.Now(),
+		Payload:            string(ePayload),
+		AdditionalMetadata: &eMetadataString,
+	}
+
+	if p.OrderingKey != "" {
+		orderingKey := a.namespace + p.OrderingKey
+		event.OrderingKey = &orderingKey
+	}
+
+	if p.Sequence != 0 {
+		sequence := p.Sequence
+		event.Sequence = &sequence
+	}
+
+	if p.Priority != 0 {
+		priority := p.Priority
+		event.Priority = &priority
+	}
+
+	return event, nil
+}
+
+// WithLogLineLevel sets the level of the log line, which is one of DEBUG, INFO, WARN and ERROR. Log lines
+// are INFO by default.
+func WithLogLineLevel(level string) PutLogOpFunc {
+	return func(r *eventcontracts.PutLogRequest) error {
+		level = strings.ToUpper(level)
+
+		switch level {
+		case "DEBUG", "INFO", "WARN", "ERROR":
+		default:
+			return fmt.Errorf("invalid log level %s, must be one of DEBUG, INFO, WARN or ERROR", level)
+		}
+
+		r.Level = &level
+
+		return nil
+	}
+}
+
+// WithLogLineMetadata sets the fields of the log line, which are stored as JSON with the log line.
+func WithLogLineMetadata(metadata map[string]interface{}) PutLogOpFunc {
+	return func(r *eventcontracts.PutLogRequest) error {
+		metadataBytes, err := json.Marshal(metadata)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal log line metadata: %w", err)
+		}
+
+		r.Metadata = string(metadataBytes)
+
+		return nil
+	}
+}
+
+func (a *eventClientImpl) PutLog(ctx context.Context, stepRunId, msg string, options ...PutLogOpFunc) error {
+	request := &eventcontracts.PutLogRequest{
 		CreatedAt: timestamppb.Now(),
 		StepRunId: stepRunId,
 		Message:   msg,
-	})
+	}
+
+	for _, optionFunc := range options {
+		if err := optionFunc(request); err != nil {
+			return err
+		}
+	}
+
+	_, err := a.client.PutLog(a.ctx.newContext(ctx), request)
 
 	return err
 }
 
+// WithReplayPayload replaces the payload of the replayed event.
+func WithReplayPayload(payload interface{}) ReplayOpFunc {
+	return func(r *eventcontracts.ReplayEventRequest) error {
+		payloadBytes, err := json.Marshal(payload)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal replay payload: %w", err)
+		}
+
+		payloadString := string(payloadBytes)
+		r.Payload = &payloadString
+
+		return nil
+	}
+}
+
+// WithReplayAdditionalMetadata replaces the additional metadata of the replayed event.
+func WithReplayAdditionalMetadata(additionalMetadata map[string]string) ReplayOpFunc {
+	return func(r *eventcontracts.ReplayEventRequest) error {
+		metadataBytes, err := json.Marshal(additionalMetadata)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal replay additional metadata: %w", err)
+		}
+
+		metadataString := string(metadataBytes)
+		r.AdditionalMetadata = &metadataString
+
+		return nil
+	}
+}
+
+func (a *eventClientImpl) Replay(ctx context.Context, eventId string, options ...ReplayOpFunc) (string, error) {
+	request := &eventcontracts.ReplayEventRequest{
+		EventId: eventId,
+	}
+
+	for _, optionFunc := range options {
+		if err := optionFunc(request); err != nil {
+			return "", err
+		}
+	}
+
+	event, err := a.client.ReplaySingleEvent(a.ctx.newContext(ctx), request)
+
+	if err != nil {
+		return "", err
+	}
+
+	return event.EventId, nil
+}
+
+func (a *eventClientImpl) Close(ctx context.Context) error {
+	if a.buffer == nil {
+		return nil
+	}
+
+	return a.buffer.close(ctx)
+}
+
 func (a *eventClientImpl) PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error {
 	_, err := a.client.PutStreamEvent(a.ctx.newContext(ctx), &eventcontracts.PutStreamEventRequest{
 		CreatedAt: timestamppb.Now(),
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+)
+
+func TestPushWithEventID(t *testing.T) {
+	l := zerolog.Nop()
+	fake := &fakeEventsClient{}
+
+	events := &eventClientImpl{
+		client: fake,
+		l:      &l,
+		ctx:    newContextLoader(""),
+	}
+
+	ctx := context.Background()
+
+	res, err := events.PushWithResult(ctx, "order:created", map[string]string{"id": "1"}, WithEventID("order-1"))
+	require.NoError(t, err)
+	assert.False(t, res.Duplicate)
+	assert.Equal(t, "event-0", res.EventId)
+
+	// a retry of the same event returns the original event and its workflow runs
+	res, err = events.PushWithResult(ctx, "order:created", map[string]string{"id": "1"}, WithEventID("order-1"))
+	require.NoError(t, err)
+	assert.True(t, res.Duplicate)
+	assert.Equal(t, "event-0", res.EventId)
+	assert.Equal(t, []string{"run-of-event-0"}, res.WorkflowRunIds)
+
+	// Push doesn't report duplicates
+	require.NoError(t, events.Push(ctx, "order:created", map[string]string{"id": "1"}, WithEventID("order-1")))
+
+	require.NoError(t, events.Push(ctx, "order:created", map[string]string{"id": "2"}, WithEventID("order-2")))
+
+	assert.Equal(t, []string{"order:created", "order:created"}, fake.pushedKeys())
+
+	assert.Error(t, events.Push(ctx, "order:created", nil, WithEventID("")))
+
+	// engines which don't return the original event reject duplicates
+	fake.rejectDuplicates = true
+
+	res, err = events.PushWithResult(ctx, "order:created", map[string]string{"id": "2"}, WithEventID("order-2"))
+	require.NoError(t, err)
+	assert.True(t, res.Duplicate)
+	assert.Empty(t, res.EventId)
+
+	require.NoError(t, events.Push(ctx, "order:created", map[string]string{"id": "2"}, WithEventID("order-2")))
+}
+
+func TestPushWithEventDedupeWindow(t *testing.T) {
+	l := zerolog.Nop()
+	fake := &fakeEventsClient{}
+
+	events := &eventClientImpl{
+		client: fake,
+		l:      &l,
+		ctx:    newContextLoader(""),
+	}
+
+	ctx := context.Background()
+
+	require.NoError(t, events.Push(ctx, "order:created", nil, WithEventDedupeWindow(time.Hour), WithEventID("order-1")))
+
+	assert.Equal(t, []string{"1h0m0s"}, fake.externalIdWindows)
+
+	// the window requires an event id
+	assert.Error(t, events.Push(ctx, "order:created", nil, WithEventDedupeWindow(time.Hour)))
+	assert.Error(t, events.Push(ctx, "order:created", nil, WithEventID("order-2"), WithEventDedupeWindow(0)))
+}
+
+func TestPushWithEventPriority(t *testing.T) {
+	l := zerolog.Nop()
+	fake := &fakeEventsClient{}
+
+	events := &eventClientImpl{
+		client: fake,
+		l:      &l,
+		ctx:    newContextLoader(""),
+	}
+
+	ctx := context.Background()
+
+	require.NoError(t, events.Push(ctx, "order:created", nil, WithEventPriority(3)))
+	require.NoError(t, events.Push(ctx, "order:created", nil))
+
+	assert.Equal(t, []int32{3}, fake.priorities)
+
+	assert.Error(t, events.Push(ctx, "order:created", nil, WithEventPriority(0)))
+	assert.Error(t, events.Push(ctx, "order:created", nil, WithEventPriority(4)))
+}
+
+// BulkPush rejects events with an empty payload object, like the engine rejects invalid events
+func (f *fakeEventsClient) BulkPush(ctx context.Context, in *eventcontracts.BulkPushEventRequest, opts ...grpc.CallOption) (*eventcontracts.Events, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.unavailable {
+		return nil, status.Error(codes.Unavailable, "engine is down")
+	}
+
+	f.batchSizes = append(f.batchSizes, len(in.Events))
+
+	res := &eventcontracts.Events{}
+
+	for _, e := range in.Events {
+		if e.Payload == "{}" {
+			res.Errors = append(res.Errors, "Invalid request: events failing validation")
+			continue
+		}
+
+		res.Errors = append(res.Errors, "")
+		res.Events = append(res.Events, &eventcontracts.Event{EventId: fmt.Sprintf("event-%d", len(f.pushed))})
+
+		f.pushed = append(f.pushed, e.Key)
+	}
+
+	return res, nil
+}
+
+func TestBulkPushWithResults(t *testing.T) {
+	l := zerolog.Nop()
+	fake := &fakeEventsClient{}
+
+	events := &eventClientImpl{
+		client: fake,
+		l:      &l,
+		ctx:    newContextLoader(""),
+	}
+
+	payloads := make([]EventWithAdditionalMetadata, MaxBulkPushEvents+2)
+
+	for j := range payloads {
+		payloads[j] = EventWithAdditionalMetadata{
+			Key:   "order:created",
+			Event: map[string]int{"id": j},
+		}
+	}
+
+	// one invalid event in each batch
+	payloads[1].Event = map[string]int{}
+	payloads[MaxBulkPushEvents].Event = map[string]int{}
+
+	results, err := events.BulkPushWithResults(context.Background(), payloads)
+	require.NoError(t, err)
+	require.Len(t, results, len(payloads))
+
+	assert.Equal(t, []int{MaxBulkPushEvents, 2}, fake.batchSizes)
+
+	assert.Equal(t, "event-0", results[0].EventId)
+	assert.NoError(t, results[0].Err)
+
+	assert.Empty(t, results[1].EventId)
+	assert.ErrorContains(t, results[1].Err, "events failing validation")
+
+	// the invalid event doesn't shift the ids of the following events
+	assert.Equal(t, "event-1", results[2].EventId)
+
+	assert.Error(t, results[MaxBulkPushEvents].Err)
+	assert.Equal(t, fmt.Sprintf("event-%d", MaxBulkPushEvents-1), results[MaxBulkPushEvents+1].EventId)
+
+	// if the engine can't be reached, every event has the error
+	fake.setUnavailable(true)
+
+	results, err = events.BulkPushWithResults(context.Background(), payloads[:2])
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(results[0].Err))
+	assert.Equal(t, codes.Unavailable, status.Code(results[1].Err))
+}
+
+func (f *fakeEventsClient) PutLog(ctx context.Context, in *eventcontracts.PutLogRequest, opts ...grpc.CallOption) (*eventcontracts.PutLogResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.logs = append(f.logs, in)
+
+	return &eventcontracts.PutLogResponse{}, nil
+}
+
+func TestPutLog(t *testing.T) {
+	l := zerolog.Nop()
+	fake := &fakeEventsClient{}
+
+	events := &eventClientImpl{
+		client: fake,
+		l:      &l,
+		ctx:    newContextLoader(""),
+	}
+
+	ctx := context.Background()
+
+	require.NoError(t, events.PutLog(ctx, "step-run-1", "starting"))
+
+	require.NoError(t, events.PutLog(ctx, "step-run-1", "card declined",
+		WithLogLineLevel("warn"),
+		WithLogLineMetadata(map[string]interface{}{"attempt": 2}),
+	))
+
+	require.Len(t, fake.logs, 2)
+
+	assert.Equal(t, "starting", fake.logs[0].Message)
+	assert.Nil(t, fake.logs[0].Level)
+	assert.Empty(t, fake.logs[0].Metadata)
+
+	require.NotNil(t, fake.logs[1].Level)
+	assert.Equal(t, "WARN", *fake.logs[1].Level)
+	assert.JSONEq(t, `{"attempt": 2}`, fake.logs[1].Metadata)
+
+	assert.Error(t, events.PutLog(ctx, "step-run-1", "unknown level", WithLogLineLevel("fatal")))
+	assert.Len(t, fake.logs, 2)
+}
+
+func (f *fakeEventsClient) ReplaySingleEvent(ctx context.Context, in *eventcontracts.ReplayEventRequest, opts ...grpc.CallOption) (*eventcontracts.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.replays = append(f.replays, in)
+
+	return &eventcontracts.Event{EventId: fmt.Sprintf("replay-%d", len(f.replays))}, nil
+}
+
+func TestReplay(t *testing.T) {
+	l := zerolog.Nop()
+	fake := &fakeEventsClient{}
+
+	events := &eventClientImpl{
+		client: fake,
+		l:      &l,
+		ctx:    newContextLoader(""),
+	}
+
+	ctx := context.Background()
+
+	eventId, err := events.Replay(ctx, "event-1")
+	require.NoError(t, err)
+	assert.Equal(t, "replay-1", eventId)
+
+	eventId, err = events.Replay(ctx, "event-2",
+		WithReplayPayload(map[string]interface{}{"userId": "fixed"}),
+		WithReplayAdditionalMetadata(map[string]string{"source": "backfill"}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "replay-2", eventId)
+
+	require.Len(t, fake.replays, 2)
+
+	// without options, the payload of the original event is kept
+	assert.Equal(t, "event-1", fake.replays[0].EventId)
+	assert.Nil(t, fake.replays[0].Payload)
+	assert.Nil(t, fake.replays[0].AdditionalMetadata)
+
+	assert.Equal(t, "event-2", fake.replays[1].EventId)
+	require.NotNil(t, fake.replays[1].Payload)
+	assert.JSONEq(t, `{"userId": "fixed"}`, *fake.replays[1].Payload)
+	require.NotNil(t, fake.replays[1].AdditionalMetadata)
+	assert.JSONEq(t, `{"source": "backfill"}`, *fake.replays[1].AdditionalMetadata)
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+)
+
+type largeWorkflowServer struct {
+	admincontracts.UnimplementedWorkflowServiceServer
+}
+
+// GetWorkflow echoes the name of the requested workflow, so the response is as large as the request.
+func (s *largeWorkflowServer) GetWorkflow(ctx context.Context, in *admincontracts.GetWorkflowRequest) (*admincontracts.GetWorkflowResponse, error) {
+	return &admincontracts.GetWorkflowResponse{Name: in.Name}, nil
+}
+
+func TestGrpcCallOptionsValidation(t *testing.T) {
+	callOpts, err := grpcCallOptions(&ClientOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, callOpts)
+
+	_, err = grpcCallOptions(&ClientOpts{grpcMaxMsgSize: -1})
+	assert.Error(t, err)
+
+	_, err = grpcCallOptions(&ClientOpts{grpcCompression: "snappy"})
+	assert.ErrorContains(t, err, "unsupported gRPC compression")
+}
+
+func TestGrpcCallOptionsLargeMessages(t *testing.T) {
+	const maxMsgSize = 8 << 20
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(maxMsgSize), grpc.MaxSendMsgSize(maxMsgSize))
+	admincontracts.RegisterWorkflowServiceServer(server, &largeWorkflowServer{})
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	defer server.Stop()
+
+	// a 5MB message exceeds the gRPC default of 4MB
+	name := strings.Repeat("a", 5<<20)
+
+	tests := []struct {
+		name     string
+		opts     *ClientOpts
+		wantCode codes.Code
+	}{
+		{name: "default", opts: &ClientOpts{}, wantCode: codes.ResourceExhausted},
+		{name: "max msg size", opts: &ClientOpts{grpcMaxMsgSize: maxMsgSize}, wantCode: codes.OK},
+		{name: "gzip", opts: &ClientOpts{grpcMaxMsgSize: maxMsgSize, grpcCompression: "gzip"}, wantCode: codes.OK},
+		{name: "zstd", opts: &ClientOpts{grpcMaxMsgSize: maxMsgSize, grpcCompression: "zstd"}, wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callOpts, err := grpcCallOptions(tt.opts)
+			require.NoError(t, err)
+
+			dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+			if len(callOpts) > 0 {
+				dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(callOpts...))
+			}
+
+			conn, err := grpc.NewClient(lis.Addr().String(), dialOpts...)
+			require.NoError(t, err)
+
+			defer conn.Close()
+
+			res, err := admincontracts.NewWorkflowServiceClient(conn).GetWorkflow(context.Background(), &admincontracts.GetWorkflowRequest{Name: name})
+
+			require.Equal(t, tt.wantCode, status.Code(err), "unexpected error: %v", err)
+
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, name, res.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+)
+
+// Health is the status of the connection to the engine, which is returned by Client.Health.
+type Health struct {
+	// Reachable is true if the engine responded to the health check.
+	Reachable bool
+
+	// Version is the version of the engine. It is empty if the engine is not reachable or does not report
+	// its version.
+	Version string
+}
+
+func (c *clientImpl) Health(ctx context.Context) (*Health, error) {
+	return checkHealth(c.ctxLoader.newContext(ctx), c.health)
+}
+
+func checkHealth(ctx context.Context, client admincontracts.WorkflowServiceClient) (*Health, error) {
+	res, err := client.Health(ctx, &admincontracts.HealthRequest{})
+
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unimplemented:
+			// engines which predate the health check are reachable, but don't report their version
+			return &Health{Reachable: true}, nil
+		case codes.Unauthenticated, codes.PermissionDenied:
+			return &Health{Reachable: true}, fmt.Errorf("engine rejected the token: %w", err)
+		default:
+			return &Health{}, fmt.Errorf("engine is not reachable: %w", err)
+		}
+	}
+
+	return &Health{
+		Reachable: true,
+		Version:   res.Version,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+)
+
+type fakeWorkflowServiceClient struct {
+	admincontracts.WorkflowServiceClient
+
+	healthErr error
+
+	workflows map[string]*admincontracts.GetWorkflowResponse
+}
+
+func (c *fakeWorkflowServiceClient) Health(ctx context.Context, in *admincontracts.HealthRequest, opts ...grpc.CallOption) (*admincontracts.HealthResponse, error) {
+	if c.healthErr != nil {
+		return nil, c.healthErr
+	}
+
+	return &admincontracts.HealthResponse{Version: "v0.53.7"}, nil
+}
+
+func (c *fakeWorkflowServiceClient) GetWorkflow(ctx context.Context, in *admincontracts.GetWorkflowRequest, opts ...grpc.CallOption) (*admincontracts.GetWorkflowResponse, error) {
+	workflow, ok := c.workflows[in.Name]
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "workflow %s not found", in.Name)
+	}
+
+	return workflow, nil
+}
+
+func TestHealth(t *testing.T) {
+	health, err := checkHealth(context.Background(), &fakeWorkflowServiceClient{})
+	require.NoError(t, err)
+	assert.Equal(t, &Health{Reachable: true, Version: "v0.53.7"}, health)
+
+	// older engines don't implement the health check
+	health, err = checkHealth(context.Background(), &fakeWorkflowServiceClient{
+		healthErr: status.Error(codes.Unimplemented, "unknown method Health"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &Health{Reachable: true}, health)
+
+	health, err = checkHealth(context.Background(), &fakeWorkflowServiceClient{
+		healthErr: status.Error(codes.Unauthenticated, "invalid token"),
+	})
+	require.Error(t, err)
+	assert.True(t, health.Reachable)
+
+	health, err = checkHealth(context.Background(), &fakeWorkflowServiceClient{
+		healthErr: status.Error(codes.Unavailable, "connection refused"),
+	})
+	require.Error(t, err)
+	assert.False(t, health.Reachable)
+}
+
+func TestGetWorkflow(t *testing.T) {
+	admin := &adminClientImpl{
+		client: &fakeWorkflowServiceClient{
+			workflows: map[string]*admincontracts.GetWorkflowResponse{
+				"ns-checkout": {
+					Name:          "ns-checkout",
+					Version:       "v2",
+					EventTriggers: []string{"ns-order:created"},
+					Steps: []*admincontracts.WorkflowStepDefinition{
+						{ReadableId: "charge", Action: "checkout:charge", Retries: 3},
+						{ReadableId: "ship", Action: "checkout:ship", Parents: []string{"charge"}},
+					},
+				},
+			},
+		},
+		ctx:       newContextLoader(""),
+		namespace: "ns-",
+	}
+
+	workflow, err := admin.GetWorkflow(context.Background(), "checkout")
+	require.NoError(t, err)
+
+	assert.Equal(t, "v2", workflow.Version)
+	assert.Equal(t, []string{"ns-order:created"}, workflow.EventTriggers)
+	assert.Equal(t, []WorkflowStepDefinition{
+		{ReadableId: "charge", Action: "checkout:charge", Retries: 3},
+		{ReadableId: "ship", Action: "checkout:ship", Parents: []string{"charge"}},
+	}, workflow.Steps)
+
+	_, err = admin.GetWorkflow(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrWorkflowNotFound)
+}
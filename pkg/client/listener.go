@@ -40,6 +40,9 @@ type WorkflowRunsListener struct {
 
 	// map of workflow run ids to a list of handlers
 	handlers sync.Map
+
+	// payloadStore is used to rehydrate offloaded outputs, nil if the client has no payload store
+	payloadStore PayloadStore
 }
 
 func (r *subscribeClientImpl) newWorkflowRunsListener(
@@ -50,8 +53,9 @@ func (r *subscribeClientImpl) newWorkflowRunsListener(
 	}
 
 	w := &WorkflowRunsListener{
-		constructor: constructor,
-		l:           r.l,
+		constructor:  constructor,
+		l:            r.l,
+		payloadStore: r.payloadStore,
 	}
 
 	err := w.retrySubscribe(ctx)
@@ -246,14 +250,17 @@ type subscribeClientImpl struct {
 	v validator.Validator
 
 	ctx *contextLoader
+
+	payloadStore PayloadStore
 }
 
 func newSubscribe(conn *grpc.ClientConn, opts *sharedClientOpts) SubscribeClient {
 	return &subscribeClientImpl{
-		client: dispatchercontracts.NewDispatcherClient(conn),
-		l:      opts.l,
-		v:      opts.v,
-		ctx:    opts.ctxLoader,
+		client:       dispatchercontracts.NewDispatcherClient(conn),
+		l:            opts.l,
+		v:            opts.v,
+		ctx:          opts.ctxLoader,
+		payloadStore: opts.payloads.getStore(),
 	}
 }
 
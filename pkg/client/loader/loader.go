@@ -129,6 +129,9 @@ func GetClientConfigFromConfigFile(cf *client.ClientConfigFile) (res *client.Cli
 		CloudRegisterID:      cf.CloudRegisterID,
 		RunnableActions:      rawRunnableActions,
 		NoGrpcRetry:          cf.NoGrpcRetry,
+		GRPCMaxMsgSize:       cf.GRPCMaxMsgSize,
+		GRPCCompression:      cf.GRPCCompression,
+		PayloadStore:         cf.PayloadStore,
 	}, nil
 }
 
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// PayloadStore stores the workflow inputs and step outputs which are too large to be sent to the engine,
+// see WithPayloadStore.
+type PayloadStore interface {
+	// Put stores the payload under the key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns the payload which is stored under the key.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// DefaultPayloadOffloadThreshold is the size in bytes above which payloads are offloaded to the payload
+// store by default.
+const DefaultPayloadOffloadThreshold = 1 << 20
+
+// ErrNoPayloadStore is returned when a payload references a payload which was offloaded to a payload store,
+// but the client has no payload store.
+var ErrNoPayloadStore = errors.New("payload was offloaded to a payload store, but the client has no payload store")
+
+// payloadRefKey is the only key of the JSON object which replaces an offloaded payload.
+const payloadRefKey = "__hatchet_payload_ref"
+
+// payloadRef references a payload which was offloaded to a payload store.
+type payloadRef struct {
+	Key    string `json:"key"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+type payloadOffloader struct {
+	store     PayloadStore
+	threshold int
+	tenantId  string
+}
+
+// offload stores payloads which are larger than the threshold in the payload store and returns a reference
+// to the stored payload. Other payloads, and all payloads if p is nil, are returned unchanged.
+func (p *payloadOffloader) offload(ctx context.Context, data []byte) ([]byte, error) {
+	if p == nil || len(data) <= p.threshold {
+		return data, nil
+	}
+
+	sum := sha256.Sum256(data)
+
+	ref := payloadRef{
+		Key:    fmt.Sprintf("%s/%s", p.tenantId, uuid.New().String()),
+		Size:   len(data),
+		SHA256: hex.EncodeToString(sum[:]),
+	}
+
+	if err := p.store.Put(ctx, ref.Key, data); err != nil {
+		return nil, fmt.Errorf("could not offload payload: %w", err)
+	}
+
+	return json.Marshal(map[string]payloadRef{
+		payloadRefKey: ref,
+	})
+}
+
+func (p *payloadOffloader) getStore() PayloadStore {
+	if p == nil {
+		return nil
+	}
+
+	return p.store
+}
+
+// parsePayloadRef returns the reference if data is a reference to an offloaded payload.
+func parsePayloadRef(data []byte) (*payloadRef, bool) {
+	// references are small, so larger payloads and payloads without the key are not decoded
+	if len(data) > 1024 || !bytes.Contains(data, []byte(payloadRefKey)) {
+		return nil, false
+	}
+
+	var wrapper map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &wrapper); err != nil || len(wrapper) != 1 {
+		return nil, false
+	}
+
+	rawRef, ok := wrapper[payloadRefKey]
+
+	if !ok {
+		return nil, false
+	}
+
+	ref := &payloadRef{}
+
+	if err := json.Unmarshal(rawRef, ref); err != nil || ref.Key == "" {
+		return nil, false
+	}
+
+	return ref, true
+}
+
+// IsPayloadRef reports whether data is a reference to a payload which was offloaded to a payload store.
+func IsPayloadRef(data []byte) bool {
+	_, ok := parsePayloadRef(data)
+	return ok
+}
+
+// RehydratePayload returns the payload which data references if data is a reference to a payload which was
+// offloaded to a payload store, and data otherwise. It returns ErrNoPayloadStore if data is a reference
+// and store is nil, and an error if the stored payload doesn't match the checksum of the reference.
+func RehydratePayload(ctx context.Context, store PayloadStore, data []byte) ([]byte, error) {
+	ref, ok := parsePayloadRef(data)
+
+	if !ok {
+		return data, nil
+	}
+
+	if store == nil {
+		return nil, ErrNoPayloadStore
+	}
+
+	payload, err := store.Get(ctx, ref.Key)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get offloaded payload %s: %w", ref.Key, err)
+	}
+
+	sum := sha256.Sum256(payload)
+
+	if hex.EncodeToString(sum[:]) != ref.SHA256 {
+		return nil, fmt.Errorf("offloaded payload %s does not match its checksum", ref.Key)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryPayloadStore struct {
+	mu       sync.Mutex
+	payloads map[string][]byte
+}
+
+func (s *memoryPayloadStore) Put(ctx context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.payloads == nil {
+		s.payloads = map[string][]byte{}
+	}
+
+	s.payloads[key] = data
+
+	return nil
+}
+
+func (s *memoryPayloadStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, ok := s.payloads[key]
+
+	if !ok {
+		return nil, fmt.Errorf("payload %s not found", key)
+	}
+
+	return data, nil
+}
+
+func TestPayloadOffloading(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryPayloadStore{}
+
+	p := &payloadOffloader{
+		store:     store,
+		threshold: 64,
+		tenantId:  "tenant",
+	}
+
+	small := []byte(`{"message":"hello"}`)
+
+	offloaded, err := p.offload(ctx, small)
+	require.NoError(t, err)
+	assert.Equal(t, small, offloaded)
+	assert.Empty(t, store.payloads)
+
+	large := []byte(fmt.Sprintf(`{"message":"%s"}`, strings.Repeat("a", 1000)))
+
+	offloaded, err = p.offload(ctx, large)
+	require.NoError(t, err)
+	require.Len(t, store.payloads, 1)
+	assert.True(t, IsPayloadRef(offloaded))
+	assert.Less(t, len(offloaded), len(large))
+
+	for key := range store.payloads {
+		assert.True(t, strings.HasPrefix(key, "tenant/"))
+	}
+
+	rehydrated, err := RehydratePayload(ctx, store, offloaded)
+	require.NoError(t, err)
+	assert.Equal(t, large, rehydrated)
+
+	// payloads which aren't references are returned unchanged, even without a store
+	rehydrated, err = RehydratePayload(ctx, nil, small)
+	require.NoError(t, err)
+	assert.Equal(t, small, rehydrated)
+
+	_, err = RehydratePayload(ctx, nil, offloaded)
+	assert.ErrorIs(t, err, ErrNoPayloadStore)
+
+	// a nil offloader doesn't offload
+	offloaded, err = (*payloadOffloader)(nil).offload(ctx, large)
+	require.NoError(t, err)
+	assert.Equal(t, large, offloaded)
+}
+
+func TestRehydratePayloadChecksum(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryPayloadStore{}
+
+	p := &payloadOffloader{
+		store:    store,
+		tenantId: "tenant",
+	}
+
+	offloaded, err := p.offload(ctx, []byte(`{"message":"hello"}`))
+	require.NoError(t, err)
+
+	for key := range store.payloads {
+		store.payloads[key] = []byte(`{"message":"tampered"}`)
+	}
+
+	_, err = RehydratePayload(ctx, store, offloaded)
+	assert.ErrorContains(t, err, "checksum")
+}
+
+func TestIsPayloadRef(t *testing.T) {
+	assert.True(t, IsPayloadRef([]byte(`{"__hatchet_payload_ref":{"key":"tenant/1","size":10,"sha256":"abc"}}`)))
+
+	// objects with other keys are payloads of the user
+	assert.False(t, IsPayloadRef([]byte(`{"__hatchet_payload_ref":{"key":"tenant/1"},"other":true}`)))
+	assert.False(t, IsPayloadRef([]byte(`{"__hatchet_payload_ref":{}}`)))
+	assert.False(t, IsPayloadRef([]byte(`"__hatchet_payload_ref"`)))
+	assert.False(t, IsPayloadRef([]byte(`{"message":"hello"}`)))
+	assert.False(t, IsPayloadRef(nil))
+}
@@ -0,0 +1,513 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+)
+
+// ErrPushBufferFull is returned by Push when the engine is unreachable and the push buffer is full, or when the
+// durable buffer is full.
+var ErrPushBufferFull = errors.New("push buffer is full")
+
+// ErrPushBufferClosed is returned by Push when the event client of a client with a push buffer or durable
+// buffer was closed.
+var ErrPushBufferClosed = errors.New("push buffer is closed")
+
+const (
+	pushBufferFileExt        = ".event"
+	pushBufferAttemptTimeout = 30 * time.Second
+)
+
+// RetryPolicy configures how buffered events and the calls rejected by an overloaded engine are retried.
+// Retries back off exponentially from InitialInterval to MaxInterval.
+type RetryPolicy struct {
+	// InitialInterval is the delay after the first failed attempt. Defaults to 1 second.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum delay between attempts. Defaults to 1 minute.
+	MaxInterval time.Duration
+
+	// MaxAttempts is the number of attempts after which a buffered event is dropped or a call fails. If 0,
+	// events are retried until they are delivered.
+	MaxAttempts int
+}
+
+func (r RetryPolicy) backoff(attempt int) time.Duration {
+	delay := r.InitialInterval
+
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	maxDelay := r.MaxInterval
+
+	if maxDelay <= 0 {
+		maxDelay = time.Minute
+	}
+
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+type pushBufferOpts struct {
+	// maxSize is 0 if the number of buffered events is not limited
+	maxSize int
+
+	// maxBytes is 0 if the size of the buffered events is not limited
+	maxBytes int
+
+	retry RetryPolicy
+
+	// spool is set if every event is buffered and pushed in the background, instead of only the events
+	// which could not be pushed
+	spool bool
+
+	// dir is empty if buffered events are only kept in memory
+	dir string
+}
+
+// pushBuffer queues the events which could not be pushed because the engine was unreachable, and pushes
+// them in the background in the order they were buffered.
+type pushBuffer struct {
+	client eventcontracts.EventsServiceClient
+	ctx    *contextLoader
+	l      *zerolog.Logger
+
+	pushBufferOpts
+
+	mu       sync.Mutex
+	events   []*bufferedEvent
+	bytes    int
+	nextId   uint64
+	draining bool
+	closed   bool
+
+	// resumeAt is the time before which the engine isn't retried, from the retry hint of the last push
+	// which the engine rejected
+	resumeAt time.Time
+
+	// stopCtx is cancelled when the buffer stops pushing events, and draining is done once the goroutine
+	// which pushes them exited
+	stopCtx     context.Context
+	stop        context.CancelFunc
+	drainExited sync.WaitGroup
+}
+
+type bufferedEvent struct {
+	// id is the name of the file of the event if the buffer is persisted
+	id uint64
+
+	request *eventcontracts.PushEventRequest
+
+	// size is the encoded size of the request in bytes
+	size int
+}
+
+func newPushBuffer(client eventcontracts.EventsServiceClient, ctxLoader *contextLoader, l *zerolog.Logger, opts pushBufferOpts) (*pushBuffer, error) {
+	stopCtx, stop := context.WithCancel(context.Background())
+
+	b := &pushBuffer{
+		client:         client,
+		ctx:            ctxLoader,
+		l:              l,
+		pushBufferOpts: opts,
+		stopCtx:        stopCtx,
+		stop:           stop,
+	}
+
+	if b.spool && b.dir == "" {
+		return nil, fmt.Errorf("durable buffer requires a directory")
+	}
+
+	if b.dir != "" {
+		if err := b.load(); err != nil {
+			return nil, fmt.Errorf("could not load buffered events from %s: %w", b.dir, err)
+		}
+	}
+
+	_, err := otel.Meter("github.com/hatchet-dev/hatchet/pkg/client").Int64ObservableGauge(
+		"hatchet.client.push_buffer.depth",
+		metric.WithDescription("The number of events waiting in the push buffer."),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(b.depth()))
+			return nil
+		}),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not register push buffer metric: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.startDrain()
+
+	return b, nil
+}
+
+func (b *pushBuffer) depth() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.events)
+}
+
+// push pushes the event, and buffers it if the engine is unreachable, timed out or is overloaded. Events are
+// buffered without an attempt while the buffer is not empty, so they are delivered in order. Spooled events
+// are always buffered.
+func (b *pushBuffer) push(ctx context.Context, request *eventcontracts.PushEventRequest) error {
+	if !b.spool && b.depth() == 0 && !b.isClosed() {
+		_, err := b.client.Push(b.ctx.newContext(ctx), request)
+
+		if !isRetryable(err) {
+			return err
+		}
+
+		b.l.Warn().Err(err).Msgf("engine did not accept event %s, buffering it", request.Key)
+
+		b.backoff(err)
+	}
+
+	return b.add(request)
+}
+
+// backoff delays the next push until the time after which the engine asked to be retried, if the error
+// has a retry hint.
+func (b *pushBuffer) backoff(err error) {
+	retryAfter, ok := RetryAfter(err)
+
+	if !ok {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.resumeAt = time.Now().Add(retryAfter)
+}
+
+func (b *pushBuffer) isClosed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.closed
+}
+
+func (b *pushBuffer) add(request *eventcontracts.PushEventRequest) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrPushBufferClosed
+	}
+
+	e := &bufferedEvent{
+		id:      b.nextId,
+		request: request,
+		size:    proto.Size(request),
+	}
+
+	if (b.maxSize > 0 && len(b.events) >= b.maxSize) || (b.maxBytes > 0 && b.bytes+e.size > b.maxBytes) {
+		return ErrPushBufferFull
+	}
+
+	if b.dir != "" {
+		if err := b.persist(e); err != nil {
+			return fmt.Errorf("could not persist buffered event: %w", err)
+		}
+	}
+
+	b.nextId++
+	b.events = append(b.events, e)
+	b.bytes += e.size
+
+	b.startDrain()
+
+	return nil
+}
+
+// startDrain starts pushing the buffered events if they are not already being pushed. It must be called
+// with the lock held.
+func (b *pushBuffer) startDrain() {
+	if b.draining || len(b.events) == 0 {
+		return
+	}
+
+	b.draining = true
+	b.drainExited.Add(1)
+
+	go b.drain()
+}
+
+func (b *pushBuffer) drain() {
+	defer b.drainExited.Done()
+
+	attempt := 0
+
+	for {
+		b.mu.Lock()
+
+		if len(b.events) == 0 || b.stopCtx.Err() != nil {
+			b.draining = false
+			b.mu.Unlock()
+			return
+		}
+
+		e := b.events[0]
+		wait := time.Until(b.resumeAt)
+
+		b.mu.Unlock()
+
+		if wait > 0 {
+			b.sleep(wait)
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(b.stopCtx, pushBufferAttemptTimeout)
+		_, err := b.client.Push(b.ctx.newContext(ctx), e.request)
+		cancel()
+
+		// the attempt was interrupted by Close, so the event is kept
+		if err != nil && b.stopCtx.Err() != nil {
+			continue
+		}
+
+		attempt++
+
+		// events which were rejected because the engine is overloaded are accepted once it has caught up
+		if isRetryable(err) {
+			if b.retry.MaxAttempts == 0 || attempt < b.retry.MaxAttempts {
+				b.sleep(b.retry.delay(attempt, err))
+				continue
+			}
+
+			b.l.Error().Err(err).Msgf("dropping buffered event %s after %d attempts", e.request.Key, attempt)
+		} else if status.Code(err) == codes.AlreadyExists {
+			// an earlier attempt was accepted, for events with an external id
+			b.l.Debug().Msgf("buffered event %s was already accepted", e.request.Key)
+		} else if err != nil {
+			b.l.Error().Err(err).Msgf("dropping buffered event %s", e.request.Key)
+		}
+
+		b.remove(e)
+
+		attempt = 0
+	}
+}
+
+// sleep waits for the given duration, or until the buffer is closed.
+func (b *pushBuffer) sleep(d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-b.stopCtx.Done():
+	}
+}
+
+// close stops accepting events and waits until the buffered events are delivered or ctx is done, after
+// which it stops pushing the remaining events.
+func (b *pushBuffer) close(ctx context.Context) error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+
+	drained := make(chan struct{})
+
+	go func() {
+		b.drainExited.Wait()
+		close(drained)
+	}()
+
+	var err error
+
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = fmt.Errorf("could not deliver %d buffered events: %w", b.depth(), ctx.Err())
+	}
+
+	b.stop()
+	b.drainExited.Wait()
+
+	return err
+}
+
+func (b *pushBuffer) remove(e *bufferedEvent) {
+	b.mu.Lock()
+	b.events = b.events[1:]
+	b.bytes -= e.size
+	b.mu.Unlock()
+
+	if b.dir == "" {
+		return
+	}
+
+	if err := os.Remove(b.path(e.id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		b.l.Error().Err(err).Msgf("could not remove buffered event %s", e.request.Key)
+	}
+}
+
+func (b *pushBuffer) path(id uint64) string {
+	// ids are zero-padded so the files sort in the order the events were buffered
+	return filepath.Join(b.dir, fmt.Sprintf("%020d%s", id, pushBufferFileExt))
+}
+
+func (b *pushBuffer) persist(e *bufferedEvent) error {
+	data, err := proto.Marshal(e.request)
+
+	if err != nil {
+		return err
+	}
+
+	path := b.path(e.id)
+	tmp := path + ".tmp"
+
+	// the event is synced before it is renamed, and the directory after, so that an event which was
+	// accepted by Push survives a crash of the machine
+	if err := writeFileSync(tmp, data); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+
+	return syncDir(b.dir)
+}
+
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// syncDir persists the entries of a directory, such as a renamed file. Directories can't be synced on
+// Windows, where renames are persisted with the file.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	d, err := os.Open(dir)
+
+	if err != nil {
+		return err
+	}
+
+	if err := d.Sync(); err != nil {
+		_ = d.Close()
+		return err
+	}
+
+	return d.Close()
+}
+
+// load reads the events which were buffered but not delivered by an earlier client.
+func (b *pushBuffer) load() error {
+	if err := os.MkdirAll(b.dir, 0700); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(b.dir)
+
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), pushBufferFileExt) {
+			names = append(names, entry.Name())
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := filepath.Join(b.dir, name)
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, pushBufferFileExt), 10, 64)
+
+		if err != nil {
+			b.l.Warn().Msgf("skipping unknown file %s in push buffer directory", path)
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+
+		if err != nil {
+			return err
+		}
+
+		request := &eventcontracts.PushEventRequest{}
+
+		if err := proto.Unmarshal(data, request); err != nil {
+			b.l.Error().Err(err).Msgf("dropping unreadable buffered event %s", path)
+			_ = os.Remove(path)
+			continue
+		}
+
+		// events loaded from disk are kept even if they exceed the size of the buffer
+		b.events = append(b.events, &bufferedEvent{
+			id:      id,
+			request: request,
+			size:    len(data),
+		})
+
+		b.bytes += len(data)
+
+		b.nextId = id + 1
+	}
+
+	return nil
+}
+
+// isRetryable returns whether a push failed because the engine is unreachable, timed out or is overloaded, in
+// which case the event is buffered and pushed again.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,386 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+)
+
+// fakeEventsClient records the keys of pushed events, and fails pushes while unavailable is set. Like
+// the engine, it accepts each external id once.
+type fakeEventsClient struct {
+	eventcontracts.EventsServiceClient
+
+	mu          sync.Mutex
+	unavailable bool
+	pushed      []string
+
+	// the error of pushes which fail for another reason than an unreachable engine
+	failWith error
+	// the event ids of the external ids which were pushed
+	externalIds map[string]string
+
+	// rejects duplicate external ids, like engines which don't return the original event
+	rejectDuplicates bool
+
+	// the windows of the external ids which were pushed
+	externalIdWindows []string
+
+	// the priorities of the events which were pushed with a priority
+	priorities []int32
+
+	// the number of events of each bulk push
+	batchSizes []int
+
+	logs []*eventcontracts.PutLogRequest
+
+	replays []*eventcontracts.ReplayEventRequest
+}
+
+func (f *fakeEventsClient) Push(ctx context.Context, in *eventcontracts.PushEventRequest, opts ...grpc.CallOption) (*eventcontracts.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.unavailable {
+		return nil, status.Error(codes.Unavailable, "engine is down")
+	}
+
+	if f.failWith != nil {
+		return nil, f.failWith
+	}
+
+	if in.ExternalId != nil {
+		if eventId, ok := f.externalIds[*in.ExternalId]; ok {
+			if f.rejectDuplicates {
+				return nil, status.Errorf(codes.AlreadyExists, "event with external id %s already exists", *in.ExternalId)
+			}
+
+			return &eventcontracts.Event{
+				EventId:        eventId,
+				Key:            in.Key,
+				Duplicate:      true,
+				WorkflowRunIds: []string{"run-of-" + eventId},
+			}, nil
+		}
+
+		if f.externalIds == nil {
+			f.externalIds = map[string]string{}
+		}
+
+		f.externalIds[*in.ExternalId] = fmt.Sprintf("event-%d", len(f.pushed))
+	}
+
+	if in.ExternalIdWindow != nil {
+		f.externalIdWindows = append(f.externalIdWindows, *in.ExternalIdWindow)
+	}
+
+	if in.Priority != nil {
+		f.priorities = append(f.priorities, *in.Priority)
+	}
+
+	f.pushed = append(f.pushed, in.Key)
+
+	return &eventcontracts.Event{EventId: fmt.Sprintf("event-%d", len(f.pushed)-1), Key: in.Key}, nil
+}
+
+func (f *fakeEventsClient) setUnavailable(unavailable bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.unavailable = unavailable
+}
+
+func (f *fakeEventsClient) setFailWith(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failWith = err
+}
+
+func (f *fakeEventsClient) pushedKeys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string{}, f.pushed...)
+}
+
+func newTestPushBuffer(t *testing.T, client *fakeEventsClient, opts pushBufferOpts) *pushBuffer {
+	l := zerolog.Nop()
+
+	b, err := newPushBuffer(client, newContextLoader(""), &l, opts)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestPushBuffer(t *testing.T) {
+	client := &fakeEventsClient{unavailable: true}
+
+	b := newTestPushBuffer(t, client, pushBufferOpts{
+		maxSize: 2,
+		retry:   RetryPolicy{InitialInterval: 10 * time.Millisecond},
+	})
+
+	ctx := context.Background()
+
+	assert.NoError(t, b.push(ctx, &eventcontracts.PushEventRequest{Key: "one"}))
+	assert.NoError(t, b.push(ctx, &eventcontracts.PushEventRequest{Key: "two"}))
+	assert.ErrorIs(t, b.push(ctx, &eventcontracts.PushEventRequest{Key: "three"}), ErrPushBufferFull)
+	assert.Equal(t, 2, b.depth())
+
+	client.setUnavailable(false)
+
+	assert.Eventually(t, func() bool {
+		return b.depth() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"one", "two"}, client.pushedKeys())
+
+	// events are pushed directly once the buffer is empty
+	assert.NoError(t, b.push(ctx, &eventcontracts.PushEventRequest{Key: "four"}))
+	assert.Equal(t, []string{"one", "two", "four"}, client.pushedKeys())
+}
+
+func TestPushBufferRetryableErrors(t *testing.T) {
+	for name, err := range map[string]error{
+		"deadline exceeded":  status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+		"resource exhausted": status.Error(codes.ResourceExhausted, "event limit exceeded"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeEventsClient{failWith: err}
+
+			b := newTestPushBuffer(t, client, pushBufferOpts{
+				retry: RetryPolicy{InitialInterval: 10 * time.Millisecond},
+			})
+
+			assert.NoError(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "one"}))
+			assert.Equal(t, 1, b.depth())
+
+			client.setFailWith(nil)
+
+			assert.Eventually(t, func() bool {
+				return b.depth() == 0
+			}, time.Second, 10*time.Millisecond)
+
+			assert.Equal(t, []string{"one"}, client.pushedKeys())
+		})
+	}
+
+	// other errors are returned without buffering the event
+	client := &fakeEventsClient{failWith: status.Error(codes.InvalidArgument, "invalid event")}
+	b := newTestPushBuffer(t, client, pushBufferOpts{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "one"})))
+	assert.Zero(t, b.depth())
+}
+
+func TestPushBufferRetryHint(t *testing.T) {
+	client := &fakeEventsClient{failWith: status.Convert(&backpressure.ResourceExhaustedError{
+		Reason:     "queue depth exceeded",
+		RetryAfter: 300 * time.Millisecond,
+	}).Err()}
+
+	b := newTestPushBuffer(t, client, pushBufferOpts{
+		retry: RetryPolicy{InitialInterval: time.Millisecond},
+	})
+
+	assert.NoError(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "one"}))
+
+	client.setFailWith(nil)
+
+	// the event isn't pushed again before the time of the retry hint
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, b.depth())
+
+	assert.Eventually(t, func() bool {
+		return b.depth() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"one"}, client.pushedKeys())
+}
+
+func TestPushBufferClose(t *testing.T) {
+	t.Run("delivers buffered events", func(t *testing.T) {
+		client := &fakeEventsClient{unavailable: true}
+
+		b := newTestPushBuffer(t, client, pushBufferOpts{
+			retry: RetryPolicy{InitialInterval: 10 * time.Millisecond},
+		})
+
+		assert.NoError(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "one"}))
+
+		client.setUnavailable(false)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		assert.NoError(t, b.close(ctx))
+		assert.Equal(t, []string{"one"}, client.pushedKeys())
+
+		assert.ErrorIs(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "two"}), ErrPushBufferClosed)
+		assert.Equal(t, []string{"one"}, client.pushedKeys())
+	})
+
+	t.Run("keeps undelivered events in the directory", func(t *testing.T) {
+		dir := t.TempDir()
+
+		client := &fakeEventsClient{unavailable: true}
+
+		b := newTestPushBuffer(t, client, pushBufferOpts{
+			retry: RetryPolicy{InitialInterval: 10 * time.Millisecond},
+			dir:   dir,
+		})
+
+		assert.NoError(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "one"}))
+		assert.NoError(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "two"}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, b.close(ctx), context.DeadlineExceeded)
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, files, 2)
+	})
+}
+
+func TestPushBufferDir(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := pushBufferOpts{
+		maxSize: 10,
+		retry:   RetryPolicy{InitialInterval: 10 * time.Millisecond},
+		dir:     dir,
+	}
+
+	down := &fakeEventsClient{unavailable: true}
+	b := newTestPushBuffer(t, down, opts)
+
+	assert.NoError(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "one"}))
+	assert.NoError(t, b.push(context.Background(), &eventcontracts.PushEventRequest{Key: "two"}))
+
+	// a new buffer using the same directory pushes the events which were not delivered
+	up := &fakeEventsClient{}
+	restarted := newTestPushBuffer(t, up, opts)
+
+	assert.Eventually(t, func() bool {
+		return restarted.depth() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"one", "two"}, up.pushedKeys())
+}
+
+func TestDurableBuffer(t *testing.T) {
+	dir := t.TempDir()
+
+	request := &eventcontracts.PushEventRequest{Key: "one"}
+
+	client := &fakeEventsClient{unavailable: true}
+
+	b := newTestPushBuffer(t, client, pushBufferOpts{
+		maxBytes: 2 * proto.Size(request),
+		retry:    RetryPolicy{InitialInterval: 10 * time.Millisecond},
+		spool:    true,
+		dir:      dir,
+	})
+
+	ctx := context.Background()
+
+	assert.NoError(t, b.push(ctx, request))
+	assert.NoError(t, b.push(ctx, &eventcontracts.PushEventRequest{Key: "two"}))
+	assert.ErrorIs(t, b.push(ctx, &eventcontracts.PushEventRequest{Key: "six"}), ErrPushBufferFull)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	client.setUnavailable(false)
+
+	assert.Eventually(t, func() bool {
+		return b.depth() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"one", "two"}, client.pushedKeys())
+
+	// events are spooled even while the engine is reachable
+	assert.NoError(t, b.push(ctx, &eventcontracts.PushEventRequest{Key: "four"}))
+
+	assert.Eventually(t, func() bool {
+		return b.depth() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"one", "two", "four"}, client.pushedKeys())
+
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestPushBufferOptionsCompose(t *testing.T) {
+	retry := RetryPolicy{InitialInterval: time.Second, MaxAttempts: 3}
+
+	for name, fs := range map[string][]ClientOpt{
+		"push buffer first":    {WithPushBuffer(5, retry), WithDurableBuffer("spool", 1024)},
+		"durable buffer first": {WithDurableBuffer("spool", 1024), WithPushBuffer(5, retry)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := &ClientOpts{}
+
+			for _, f := range fs {
+				f(opts)
+			}
+
+			assert.Equal(t, &pushBufferOpts{
+				maxSize:  5,
+				maxBytes: 1024,
+				retry:    retry,
+				spool:    true,
+			}, opts.pushBuffer)
+			assert.Equal(t, "spool", opts.durableBufferDir)
+		})
+	}
+
+	// a maxSize of 0 keeps the durable buffer unbounded
+	opts := &ClientOpts{}
+
+	WithDurableBuffer("spool", 0)(opts)
+	WithPushBuffer(0, retry)(opts)
+
+	assert.Zero(t, opts.pushBuffer.maxSize)
+	assert.Zero(t, opts.pushBuffer.maxBytes)
+}
+
+func TestDurableBufferRequiresDir(t *testing.T) {
+	l := zerolog.Nop()
+
+	_, err := newPushBuffer(&fakeEventsClient{}, newContextLoader(""), &l, pushBufferOpts{spool: true})
+
+	assert.Error(t, err)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	r := RetryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     5 * time.Second,
+	}
+
+	assert.Equal(t, time.Second, r.backoff(1))
+	assert.Equal(t, 2*time.Second, r.backoff(2))
+	assert.Equal(t, 4*time.Second, r.backoff(3))
+	assert.Equal(t, 5*time.Second, r.backoff(4))
+}
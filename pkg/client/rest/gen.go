@@ -23,6 +23,13 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for APITokenScope.
+const (
+	Admin       APITokenScope = "admin"
+	EventsWrite APITokenScope = "events:write"
+	RunsRead    APITokenScope = "runs:read"
+)
+
 // Defines values for ConcurrencyLimitStrategy.
 const (
 	CANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
@@ -165,15 +172,46 @@ const (
 	OWNER  TenantMemberRole = "OWNER"
 )
 
+// Defines values for TenantPermission.
+const (
+	ApiTokensManage TenantPermission = "api-tokens:manage"
+	AuditLogsManage TenantPermission = "audit-logs:manage"
+	EventsPush      TenantPermission = "events:push"
+	LimitsManage    TenantPermission = "limits:manage"
+	MembersManage   TenantPermission = "members:manage"
+	SettingsWrite   TenantPermission = "settings:write"
+	TenantRead      TenantPermission = "tenant:read"
+	WorkersManage   TenantPermission = "workers:manage"
+	WorkflowsRun    TenantPermission = "workflows:run"
+	WorkflowsWrite  TenantPermission = "workflows:write"
+)
+
 // Defines values for TenantResource.
 const (
 	CRON        TenantResource = "CRON"
 	EVENT       TenantResource = "EVENT"
+	QUEUEDRUN   TenantResource = "QUEUED_RUN"
 	SCHEDULE    TenantResource = "SCHEDULE"
 	WORKER      TenantResource = "WORKER"
 	WORKFLOWRUN TenantResource = "WORKFLOW_RUN"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCEEDED WebhookDeliveryStatus = "SUCCEEDED"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeRunFailed    WebhookEventType = "run.failed"
+	WebhookEventTypeRunStarted   WebhookEventType = "run.started"
+	WebhookEventTypeRunSucceeded WebhookEventType = "run.succeeded"
+	WebhookEventTypeStepFailed   WebhookEventType = "step.failed"
+	WebhookEventTypeWorkerDown   WebhookEventType = "worker.down"
+)
+
 // Defines values for WorkerStatus.
 const (
 	ACTIVE   WorkerStatus = "ACTIVE"
@@ -202,6 +240,12 @@ const (
 	FUNCTION WorkflowKind = "FUNCTION"
 )
 
+// Defines values for WorkflowRunActorType.
+const (
+	APITOKEN WorkflowRunActorType = "API_TOKEN"
+	USER     WorkflowRunActorType = "USER"
+)
+
 // Defines values for WorkflowRunOrderByDirection.
 const (
 	ASC  WorkflowRunOrderByDirection = "ASC"
@@ -268,6 +312,9 @@ type APIMeta struct {
 
 // APIMetaAuth defines model for APIMetaAuth.
 type APIMetaAuth struct {
+	// OauthProviders the additional OAuth providers which users can log in with
+	OauthProviders *[]APIMetaOAuthProvider `json:"oauthProviders,omitempty"`
+
 	// Schemes the supported types of authentication
 	Schemes *[]string `json:"schemes,omitempty"`
 }
@@ -281,6 +328,15 @@ type APIMetaIntegration struct {
 	Name string `json:"name"`
 }
 
+// APIMetaOAuthProvider defines model for APIMetaOAuthProvider.
+type APIMetaOAuthProvider struct {
+	// DisplayName the name of the provider which is shown to users
+	DisplayName string `json:"displayName"`
+
+	// Name the name of the provider, which identifies its login routes
+	Name string `json:"name"`
+}
+
 // APIMetaPosthog defines model for APIMetaPosthog.
 type APIMetaPosthog struct {
 	// ApiHost the PostHog API host
@@ -310,13 +366,62 @@ type APIToken struct {
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// Namespace The namespace of the API token. A namespaced token can only trigger workflows and push events whose names start with the namespace.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Scopes The scopes of the API token. A token without scopes can be used for everything.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
+// APITokenScope A scope which restricts what an API token can be used for. The admin scope allows everything.
+type APITokenScope string
+
 // AcceptInviteRequest defines model for AcceptInviteRequest.
 type AcceptInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Action The action, which is the operation id of the REST API or the method of the gRPC API.
+	Action string `json:"action"`
+
+	// ActorId The id of the user or API token which performed the action.
+	ActorId *openapi_types.UUID `json:"actorId,omitempty"`
+
+	// ActorType The type of the actor which performed the action, which is USER or API_TOKEN.
+	ActorType string `json:"actorType"`
+
+	// IpAddress The IP address of the actor.
+	IpAddress *string         `json:"ipAddress,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Payload The payload of the action, with credentials redacted.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// PriorState The state of the resource before the action, with credentials redacted.
+	PriorState *map[string]interface{} `json:"priorState,omitempty"`
+
+	// ResourceId The id of the resource which the action was performed on.
+	ResourceId *openapi_types.UUID `json:"resourceId,omitempty"`
+
+	// ResourceType The type of the resource which the action was performed on.
+	ResourceType *string `json:"resourceType,omitempty"`
+}
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]AuditLog         `json:"rows,omitempty"`
+}
+
+// AuditLogSettings defines model for AuditLogSettings.
+type AuditLogSettings struct {
+	// RetentionPeriod How long audit logs are kept, as a duration like 8760h.
+	RetentionPeriod string `json:"retentionPeriod"`
+}
+
 // BulkCreateEventRequest defines model for BulkCreateEventRequest.
 type BulkCreateEventRequest struct {
 	Events []CreateEventRequest `json:"events"`
@@ -327,6 +432,21 @@ type CancelEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
 }
 
+// CancelWorkflowRunsRequest defines model for CancelWorkflowRunsRequest.
+type CancelWorkflowRunsRequest struct {
+	// Filter Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set.
+	Filter *WorkflowRunsFilter `json:"filter,omitempty"`
+
+	// WorkflowRunIds The ids of the workflow runs to cancel. Either the ids or a filter must be set.
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+}
+
+// CancelWorkflowRunsResponse defines model for CancelWorkflowRunsResponse.
+type CancelWorkflowRunsResponse struct {
+	// WorkflowRunIds The ids of the workflow runs which are being cancelled.
+	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
+}
+
 // ConcurrencyLimitStrategy defines model for ConcurrencyLimitStrategy.
 type ConcurrencyLimitStrategy string
 
@@ -337,6 +457,12 @@ type CreateAPITokenRequest struct {
 
 	// Name A name for the API token.
 	Name string `json:"name"`
+
+	// Namespace The namespace of the API token. If set, the token can only trigger workflows and push events whose names start with the namespace.
+	Namespace *string `json:"namespace,omitempty" validate:"omitnil,hatchetName,max=64"`
+
+	// Scopes The scopes of the API token. If not set, the token can be used for everything.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -363,6 +489,9 @@ type CreateEventRequest struct {
 
 	// Key The key for the event.
 	Key string `json:"key"`
+
+	// Priority The priority of the workflow runs triggered by the event, from 1 (lowest) to 3 (highest). Defaults to the default priority of each workflow.
+	Priority *int32 `json:"priority,omitempty" validate:"omitnil,min=1,max=3"`
 }
 
 // CreateSNSIntegrationRequest defines model for CreateSNSIntegrationRequest.
@@ -377,6 +506,18 @@ type CreateTenantAlertEmailGroupRequest struct {
 	Emails []string `json:"emails" validate:"required,dive,email"`
 }
 
+// CreateTenantAlertMuteRuleRequest defines model for CreateTenantAlertMuteRuleRequest.
+type CreateTenantAlertMuteRuleRequest struct {
+	// MutedUntil The time until which the workflow is muted. The workflow is muted indefinitely if not set.
+	MutedUntil *time.Time `json:"mutedUntil,omitempty"`
+
+	// Reason Why the workflow is muted.
+	Reason *string `json:"reason,omitempty" validate:"omitnil,max=255"`
+
+	// WorkflowId The id of the workflow to mute.
+	WorkflowId openapi_types.UUID `json:"workflowId" validate:"required,uuid"`
+}
+
 // CreateTenantInviteRequest defines model for CreateTenantInviteRequest.
 type CreateTenantInviteRequest struct {
 	// Email The email of the user to invite.
@@ -393,6 +534,36 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CreateTenantRoleRequest defines model for CreateTenantRoleRequest.
+type CreateTenantRoleRequest struct {
+	// Description The description of the role.
+	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
+
+	// Name The name of the role.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Permissions The permissions of the members with the role.
+	Permissions []TenantPermission `json:"permissions" validate:"required,min=1"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered, defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes []WebhookEventType `json:"eventTypes" validate:"required,min=1"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Secret The secret which deliveries are signed with. A secret is generated if it's not set.
+	Secret *string `json:"secret,omitempty" validate:"omitnil,min=16,max=255"`
+
+	// Url The url which events are delivered to.
+	Url string `json:"url" validate:"required,url"`
+}
+
 // CronWorkflows defines model for CronWorkflows.
 type CronWorkflows struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
@@ -428,7 +599,10 @@ type Event struct {
 	// Key The key for the event.
 	Key      string          `json:"key"`
 	Metadata APIResourceMeta `json:"metadata"`
-	Tenant   *Tenant         `json:"tenant,omitempty"`
+
+	// ReplayedFromId The id of the event which this event is a replay of.
+	ReplayedFromId *openapi_types.UUID `json:"replayedFromId,omitempty"`
+	Tenant         *Tenant             `json:"tenant,omitempty"`
 
 	// TenantId The ID of the tenant associated with this event.
 	TenantId           string                   `json:"tenantId"`
@@ -465,6 +639,43 @@ type EventOrderByField string
 // EventSearch defines model for EventSearch.
 type EventSearch = string
 
+// EventWorkflowRun defines model for EventWorkflowRun.
+type EventWorkflowRun struct {
+	// CreatedAt When the workflow run was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DispatchLatency The time in milliseconds between the creation of the event and the creation of the workflow run.
+	DispatchLatency int64 `json:"dispatchLatency"`
+
+	// DisplayName The display name of the workflow run.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// FinishedAt When the workflow run finished.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// StartedAt When the workflow run started.
+	StartedAt *time.Time        `json:"startedAt,omitempty"`
+	Status    WorkflowRunStatus `json:"status"`
+
+	// WorkflowId The id of the workflow.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+
+	// WorkflowRunId The id of the workflow run.
+	WorkflowRunId openapi_types.UUID `json:"workflowRunId"`
+
+	// WorkflowVersionId The id of the workflow version which was triggered.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// EventWorkflowRunList defines model for EventWorkflowRunList.
+type EventWorkflowRunList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]EventWorkflowRun `json:"rows,omitempty"`
+}
+
 // EventWorkflowRunSummary defines model for EventWorkflowRunSummary.
 type EventWorkflowRunSummary struct {
 	// Failed The number of failed runs.
@@ -551,7 +762,8 @@ type ListSlackWebhooks struct {
 // LogLine defines model for LogLine.
 type LogLine struct {
 	// CreatedAt The creation date of the log line.
-	CreatedAt time.Time `json:"createdAt"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Level     LogLineLevel `json:"level"`
 
 	// Message The log message.
 	Message string `json:"message"`
@@ -660,9 +872,22 @@ type ReplayEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
 }
 
+// ReplayEventWithPayloadRequest defines model for ReplayEventWithPayloadRequest.
+type ReplayEventWithPayloadRequest struct {
+	// AdditionalMetadata The additional metadata of the replayed event. Defaults to the additional metadata of the original event.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	// Data The data of the replayed event. Defaults to the data of the original event.
+	Data *map[string]interface{} `json:"data,omitempty"`
+}
+
 // ReplayWorkflowRunsRequest defines model for ReplayWorkflowRunsRequest.
 type ReplayWorkflowRunsRequest struct {
-	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
+	// Filter Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set.
+	Filter *WorkflowRunsFilter `json:"filter,omitempty"`
+
+	// WorkflowRunIds The ids of the workflow runs to replay. Either the ids or a filter must be set.
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
 }
 
 // ReplayWorkflowRunsResponse defines model for ReplayWorkflowRunsResponse.
@@ -675,6 +900,12 @@ type RerunStepRunRequest struct {
 	Input map[string]interface{} `json:"input"`
 }
 
+// RotateAPITokenRequest defines model for RotateAPITokenRequest.
+type RotateAPITokenRequest struct {
+	// GracePeriod The duration for which the rotated token stays valid. If not set, the rotated token is revoked immediately.
+	GracePeriod *string `json:"gracePeriod,omitempty" validate:"omitnil,duration"`
+}
+
 // SNSIntegration defines model for SNSIntegration.
 type SNSIntegration struct {
 	// IngestUrl The URL to send SNS messages to.
@@ -838,6 +1069,42 @@ type StepRunArchiveList struct {
 	Rows       *[]StepRunArchive   `json:"rows,omitempty"`
 }
 
+// StepRunDeadLetter defines model for StepRunDeadLetter.
+type StepRunDeadLetter struct {
+	// ActionId The action id of the step.
+	ActionId string `json:"actionId"`
+
+	// DeadLetteredAt When the step run was dead-lettered.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+
+	// Error The error of the last attempt of the step run.
+	Error *string `json:"error,omitempty"`
+
+	// JobRunId The id of the job run of the step run.
+	JobRunId openapi_types.UUID `json:"jobRunId"`
+
+	// RetryCount The number of times the step run was retried before it was dead-lettered.
+	RetryCount int `json:"retryCount"`
+
+	// StepReadableId The readable id of the step.
+	StepReadableId *string `json:"stepReadableId,omitempty"`
+
+	// StepRunId The id of the step run.
+	StepRunId openapi_types.UUID `json:"stepRunId"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+
+	// WorkflowRunId The id of the workflow run of the step run.
+	WorkflowRunId openapi_types.UUID `json:"workflowRunId"`
+}
+
+// StepRunDeadLetterList defines model for StepRunDeadLetterList.
+type StepRunDeadLetterList struct {
+	Pagination *PaginationResponse  `json:"pagination,omitempty"`
+	Rows       *[]StepRunDeadLetter `json:"rows,omitempty"`
+}
+
 // StepRunEvent defines model for StepRunEvent.
 type StepRunEvent struct {
 	Count         int                     `json:"count"`
@@ -873,8 +1140,11 @@ type Tenant struct {
 	AlertMemberEmails *bool `json:"alertMemberEmails,omitempty"`
 
 	// AnalyticsOptOut Whether the tenant has opted out of analytics.
-	AnalyticsOptOut *bool           `json:"analyticsOptOut,omitempty"`
-	Metadata        APIResourceMeta `json:"metadata"`
+	AnalyticsOptOut *bool `json:"analyticsOptOut,omitempty"`
+
+	// IsPaused Whether the tenant is paused. While a tenant is paused, its new workflow runs are queued but not scheduled.
+	IsPaused *bool           `json:"isPaused,omitempty"`
+	Metadata APIResourceMeta `json:"metadata"`
 
 	// Name The name of the tenant.
 	Name string `json:"name"`
@@ -896,6 +1166,25 @@ type TenantAlertEmailGroupList struct {
 	Rows       *[]TenantAlertEmailGroup `json:"rows,omitempty"`
 }
 
+// TenantAlertMuteRule defines model for TenantAlertMuteRule.
+type TenantAlertMuteRule struct {
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// MutedUntil The time until which the workflow is muted. The workflow is muted indefinitely if not set.
+	MutedUntil *time.Time `json:"mutedUntil,omitempty"`
+
+	// Reason Why the workflow is muted.
+	Reason *string `json:"reason,omitempty"`
+
+	// WorkflowId The id of the muted workflow.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// TenantAlertMuteRuleList defines model for TenantAlertMuteRuleList.
+type TenantAlertMuteRuleList struct {
+	Rows *[]TenantAlertMuteRule `json:"rows,omitempty"`
+}
+
 // TenantAlertingSettings defines model for TenantAlertingSettings.
 type TenantAlertingSettings struct {
 	// AlertMemberEmails Whether to alert tenant members.
@@ -907,6 +1196,9 @@ type TenantAlertingSettings struct {
 	// EnableTenantResourceLimitAlerts Whether to enable alerts when tenant resources are approaching limits.
 	EnableTenantResourceLimitAlerts *bool `json:"enableTenantResourceLimitAlerts,omitempty"`
 
+	// EnableWorkerOfflineAlerts Whether to send alerts when workers go offline.
+	EnableWorkerOfflineAlerts *bool `json:"enableWorkerOfflineAlerts,omitempty"`
+
 	// EnableWorkflowRunFailureAlerts Whether to send alerts when workflow runs fail.
 	EnableWorkflowRunFailureAlerts *bool `json:"enableWorkflowRunFailureAlerts,omitempty"`
 
@@ -916,6 +1208,9 @@ type TenantAlertingSettings struct {
 	// MaxAlertingFrequency The max frequency at which to alert.
 	MaxAlertingFrequency string          `json:"maxAlertingFrequency"`
 	Metadata             APIResourceMeta `json:"metadata"`
+
+	// WorkflowRunFailureThreshold The number of failed workflow runs within the alerting frequency which triggers an alert.
+	WorkflowRunFailureThreshold *int `json:"workflowRunFailureThreshold,omitempty"`
 }
 
 // TenantInvite defines model for TenantInvite.
@@ -958,6 +1253,9 @@ type TenantMemberList struct {
 // TenantMemberRole defines model for TenantMemberRole.
 type TenantMemberRole string
 
+// TenantPermission defines model for TenantPermission.
+type TenantPermission string
+
 // TenantQueueMetrics defines model for TenantQueueMetrics.
 type TenantQueueMetrics struct {
 	Queues   *map[string]int          `json:"queues,omitempty"`
@@ -990,10 +1288,31 @@ type TenantResourceLimit struct {
 
 // TenantResourcePolicy defines model for TenantResourcePolicy.
 type TenantResourcePolicy struct {
+	// DataRetentionPeriod How long workflow runs and events of the tenant are retained, as a duration like 720h.
+	DataRetentionPeriod *string `json:"dataRetentionPeriod,omitempty"`
+
 	// Limits A list of resource limits for the tenant.
 	Limits []TenantResourceLimit `json:"limits"`
 }
 
+// TenantRole defines model for TenantRole.
+type TenantRole struct {
+	// Description The description of the role.
+	Description *string         `json:"description,omitempty"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the role.
+	Name string `json:"name"`
+
+	// Permissions The permissions of the members with the role.
+	Permissions []TenantPermission `json:"permissions"`
+}
+
+// TenantRoleList defines model for TenantRoleList.
+type TenantRoleList struct {
+	Rows *[]TenantRole `json:"rows,omitempty"`
+}
+
 // TenantStepRunQueueMetrics defines model for TenantStepRunQueueMetrics.
 type TenantStepRunQueueMetrics struct {
 	Queues *map[string]int `json:"queues,omitempty"`
@@ -1003,6 +1322,15 @@ type TenantStepRunQueueMetrics struct {
 type TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 	Input              map[string]interface{}  `json:"input"`
+
+	// Priority The priority of the workflow run, from 1 (lowest) to 3 (highest). Defaults to the default priority of the workflow.
+	Priority *int32 `json:"priority,omitempty" validate:"omitnil,min=1,max=3"`
+}
+
+// UpdateAuditLogSettingsRequest defines model for UpdateAuditLogSettingsRequest.
+type UpdateAuditLogSettingsRequest struct {
+	// RetentionPeriod How long audit logs are kept, as a duration like 8760h.
+	RetentionPeriod string `json:"retentionPeriod" validate:"required,duration"`
 }
 
 // UpdateTenantAlertEmailGroupRequest defines model for UpdateTenantAlertEmailGroupRequest.
@@ -1016,6 +1344,12 @@ type UpdateTenantInviteRequest struct {
 	Role TenantMemberRole `json:"role"`
 }
 
+// UpdateTenantMemberRoleRequest defines model for UpdateTenantMemberRoleRequest.
+type UpdateTenantMemberRoleRequest struct {
+	// CustomRoleId The id of the custom role of the member, which replaces the permissions of their role. The member gets the permissions of their role again if it's not set.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+}
+
 // UpdateTenantRequest defines model for UpdateTenantRequest.
 type UpdateTenantRequest struct {
 	// AlertMemberEmails Whether to alert tenant members.
@@ -1030,14 +1364,69 @@ type UpdateTenantRequest struct {
 	// EnableTenantResourceLimitAlerts Whether to enable alerts when tenant resources are approaching limits.
 	EnableTenantResourceLimitAlerts *bool `json:"enableTenantResourceLimitAlerts,omitempty"`
 
+	// EnableWorkerOfflineAlerts Whether to send alerts when workers go offline.
+	EnableWorkerOfflineAlerts *bool `json:"enableWorkerOfflineAlerts,omitempty"`
+
 	// EnableWorkflowRunFailureAlerts Whether to send alerts when workflow runs fail.
 	EnableWorkflowRunFailureAlerts *bool `json:"enableWorkflowRunFailureAlerts,omitempty"`
 
+	// IsPaused Whether to pause the tenant. When the tenant is resumed, its queued workflow runs are scheduled in the order in which they were triggered.
+	IsPaused *bool `json:"isPaused,omitempty"`
+
 	// MaxAlertingFrequency The max frequency at which to alert.
 	MaxAlertingFrequency *string `json:"maxAlertingFrequency,omitempty" validate:"omitnil,duration"`
 
 	// Name The name of the tenant.
 	Name *string `json:"name,omitempty"`
+
+	// WorkflowRunFailureThreshold The number of failed workflow runs within the alerting frequency which triggers an alert.
+	WorkflowRunFailureThreshold *int `json:"workflowRunFailureThreshold,omitempty" validate:"omitnil,min=1"`
+}
+
+// UpdateTenantResourceLimit defines model for UpdateTenantResourceLimit.
+type UpdateTenantResourceLimit struct {
+	// AlarmValue The value at which the tenant is alerted that it's approaching the limit.
+	AlarmValue *int `json:"alarmValue,omitempty" validate:"omitnil,min=0"`
+
+	// LimitValue The limit of the resource.
+	LimitValue int            `json:"limitValue" validate:"min=0"`
+	Resource   TenantResource `json:"resource"`
+
+	// Window The meter window of the limit, like 1m or 24h. It's ignored for workers and queued runs, which are counted.
+	Window *string `json:"window,omitempty" validate:"omitnil,duration"`
+}
+
+// UpdateTenantResourcePolicyRequest defines model for UpdateTenantResourcePolicyRequest.
+type UpdateTenantResourcePolicyRequest struct {
+	// DataRetentionPeriod How long workflow runs and events of the tenant are retained, as a duration like 720h.
+	DataRetentionPeriod *string `json:"dataRetentionPeriod,omitempty" validate:"omitnil,duration"`
+
+	// Limits The resource limits to set. Limits of other resources are kept.
+	Limits *[]UpdateTenantResourceLimit `json:"limits,omitempty" validate:"omitnil,dive"`
+}
+
+// UpdateTenantRoleRequest defines model for UpdateTenantRoleRequest.
+type UpdateTenantRoleRequest struct {
+	// Description The description of the role.
+	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
+
+	// Name The name of the role.
+	Name *string `json:"name,omitempty" validate:"omitempty,hatchetName"`
+
+	// Permissions The permissions of the members with the role.
+	Permissions *[]TenantPermission `json:"permissions,omitempty" validate:"omitempty,min=1"`
+}
+
+// UpdateWebhookSubscriptionRequest defines model for UpdateWebhookSubscriptionRequest.
+type UpdateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes *[]WebhookEventType `json:"eventTypes,omitempty" validate:"omitnil,min=1"`
+
+	// Url The url which events are delivered to.
+	Url *string `json:"url,omitempty" validate:"omitnil,url"`
 }
 
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
@@ -1046,6 +1435,15 @@ type UpdateWorkerRequest struct {
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
 
+// UpdateWorkflowVersionWeightsRequest defines model for UpdateWorkflowVersionWeightsRequest.
+type UpdateWorkflowVersionWeightsRequest struct {
+	// LatestWeight The weight of the latest version of the workflow, whichever version that is. Unlike the weights of specific versions, it moves on to versions which are registered later, so each new version is canaried against the listed versions. Ignored if no weights are listed.
+	LatestWeight *int32 `json:"latestWeight,omitempty"`
+
+	// Weights The version weights of the workflow. Versions which aren't listed receive no new runs.
+	Weights []WorkflowVersionWeight `json:"weights"`
+}
+
 // User defines model for User.
 type User struct {
 	// Email The email address of the user.
@@ -1110,6 +1508,85 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts The number of attempts to deliver the event.
+	Attempts int `json:"attempts"`
+
+	// Error Why the last attempt failed.
+	Error     *string          `json:"error,omitempty"`
+	EventType WebhookEventType `json:"eventType"`
+
+	// LastAttemptAt When the event was last attempted to be delivered.
+	LastAttemptAt *time.Time      `json:"lastAttemptAt,omitempty"`
+	Metadata      APIResourceMeta `json:"metadata"`
+
+	// NextAttemptAt When the event is delivered next, if the delivery is pending.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Payload The data of the event.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// ResponseStatusCode The status code of the response to the last attempt.
+	ResponseStatusCode *int                  `json:"responseStatusCode,omitempty"`
+	Status             WebhookDeliveryStatus `json:"status"`
+
+	// SubscriptionId The id of the webhook subscription.
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]WebhookDelivery  `json:"rows,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	// Enabled Whether events are delivered.
+	Enabled bool `json:"enabled"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Metadata   APIResourceMeta    `json:"metadata"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name"`
+
+	// Url The url which events are delivered to.
+	Url string `json:"url"`
+}
+
+// WebhookSubscriptionCreated defines model for WebhookSubscriptionCreated.
+type WebhookSubscriptionCreated struct {
+	// Enabled Whether events are delivered.
+	Enabled bool `json:"enabled"`
+
+	// EventTypes The event types which are delivered.
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Metadata   APIResourceMeta    `json:"metadata"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name"`
+
+	// Secret The secret which deliveries are signed with. It's only returned when the subscription is created.
+	Secret string `json:"secret"`
+
+	// Url The url which events are delivered to.
+	Url string `json:"url"`
+}
+
+// WebhookSubscriptionList defines model for WebhookSubscriptionList.
+type WebhookSubscriptionList struct {
+	Rows *[]WebhookSubscription `json:"rows,omitempty"`
+}
+
 // WebhookWorker defines model for WebhookWorker.
 type WebhookWorker struct {
 	Metadata APIResourceMeta `json:"metadata"`
@@ -1326,6 +1803,9 @@ type WorkflowRun struct {
 	WorkflowVersionId  string                  `json:"workflowVersionId"`
 }
 
+// WorkflowRunActorType The type of actor which triggered a workflow run.
+type WorkflowRunActorType string
+
 // WorkflowRunList defines model for WorkflowRunList.
 type WorkflowRunList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
@@ -1338,6 +1818,13 @@ type WorkflowRunOrderByDirection string
 // WorkflowRunOrderByField defines model for WorkflowRunOrderByField.
 type WorkflowRunOrderByField string
 
+// WorkflowRunSearchResult defines model for WorkflowRunSearchResult.
+type WorkflowRunSearchResult struct {
+	// NextCursor The cursor of the next page, which is not set on the last page.
+	NextCursor *string       `json:"nextCursor,omitempty"`
+	Rows       []WorkflowRun `json:"rows"`
+}
+
 // WorkflowRunShape defines model for WorkflowRunShape.
 type WorkflowRunShape struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
@@ -1367,11 +1854,16 @@ type WorkflowRunStatusList = []WorkflowRunStatus
 
 // WorkflowRunTriggeredBy defines model for WorkflowRunTriggeredBy.
 type WorkflowRunTriggeredBy struct {
-	CronParentId        *string         `json:"cronParentId,omitempty"`
-	CronSchedule        *string         `json:"cronSchedule,omitempty"`
-	EventId             *string         `json:"eventId,omitempty"`
-	Metadata            APIResourceMeta `json:"metadata"`
-	ParentWorkflowRunId *string         `json:"parentWorkflowRunId,omitempty"`
+	// ActorId The id of the user or API token which triggered the workflow run.
+	ActorId *string `json:"actorId,omitempty"`
+
+	// ActorType The type of actor which triggered a workflow run.
+	ActorType           *WorkflowRunActorType `json:"actorType,omitempty"`
+	CronParentId        *string               `json:"cronParentId,omitempty"`
+	CronSchedule        *string               `json:"cronSchedule,omitempty"`
+	EventId             *string               `json:"eventId,omitempty"`
+	Metadata            APIResourceMeta       `json:"metadata"`
+	ParentWorkflowRunId *string               `json:"parentWorkflowRunId,omitempty"`
 }
 
 // WorkflowRunsCancelRequest defines model for WorkflowRunsCancelRequest.
@@ -1379,6 +1871,24 @@ type WorkflowRunsCancelRequest struct {
 	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
 }
 
+// WorkflowRunsFilter Selects the workflow runs of a bulk cancel or replay. A workflow run is selected if it matches all fields which are set.
+type WorkflowRunsFilter struct {
+	// CreatedAfter The time after which the runs were created.
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+
+	// CreatedBefore The time before which the runs were created.
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+
+	// EventKey The key of the event which triggered the runs.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// Statuses The statuses of the runs. By default, the runs which aren't finished are cancelled and the finished runs are replayed.
+	Statuses *[]WorkflowRunStatus `json:"statuses,omitempty"`
+
+	// WorkflowId The id of the workflow of the runs.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
 // WorkflowRunsMetrics defines model for WorkflowRunsMetrics.
 type WorkflowRunsMetrics struct {
 	Counts *WorkflowRunsMetricsCounts `json:"counts,omitempty"`
@@ -1461,6 +1971,51 @@ type WorkflowVersionMeta struct {
 	WorkflowId string    `json:"workflowId"`
 }
 
+// WorkflowVersionRunStats defines model for WorkflowVersionRunStats.
+type WorkflowVersionRunStats struct {
+	// Cancelled The number of cancelled runs of the version.
+	Cancelled int64 `json:"cancelled"`
+
+	// ErrorRate The share of the finished runs of the version which failed, between 0 and 1. Not set if no run has finished.
+	ErrorRate *float64 `json:"errorRate,omitempty"`
+
+	// Failed The number of failed runs of the version.
+	Failed int64 `json:"failed"`
+
+	// Succeeded The number of succeeded runs of the version.
+	Succeeded int64 `json:"succeeded"`
+
+	// SuccessRate The share of the finished runs of the version which succeeded, between 0 and 1. Not set if no run has finished.
+	SuccessRate *float64 `json:"successRate,omitempty"`
+
+	// Total The number of runs of the version.
+	Total int64 `json:"total"`
+
+	// WorkflowVersionId The id of the workflow version.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// WorkflowVersionWeight defines model for WorkflowVersionWeight.
+type WorkflowVersionWeight struct {
+	// Weight The weight of the version. The version receives its weight divided by the sum of all weights of the new runs of the workflow.
+	Weight int32 `json:"weight"`
+
+	// WorkflowVersionId The id of the workflow version.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// WorkflowVersionWeights defines model for WorkflowVersionWeights.
+type WorkflowVersionWeights struct {
+	// LatestWeight The weight of the latest version of the workflow, whichever version that is. Not set if the latest version only receives the weight it is listed with.
+	LatestWeight *int32 `json:"latestWeight,omitempty"`
+
+	// Stats The statistics of the runs of each version which were created in the requested time range.
+	Stats []WorkflowVersionRunStats `json:"stats"`
+
+	// Weights The version weights of the workflow. If empty, new runs use the latest version.
+	Weights []WorkflowVersionWeight `json:"weights"`
+}
+
 // WorkflowWorkersCount defines model for WorkflowWorkersCount.
 type WorkflowWorkersCount struct {
 	FreeSlotCount *int    `json:"freeSlotCount,omitempty"`
@@ -1468,6 +2023,15 @@ type WorkflowWorkersCount struct {
 	WorkflowRunId *string `json:"workflowRunId,omitempty"`
 }
 
+// EventWorkflowRunListParams defines parameters for EventWorkflowRunList.
+type EventWorkflowRunListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // StepRunListArchivesParams defines parameters for StepRunListArchives.
 type StepRunListArchivesParams struct {
 	// Offset The number to skip
@@ -1507,6 +2071,39 @@ type LogLineListParams struct {
 	OrderByDirection *LogLineOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// AuditLogListParams defines parameters for AuditLogList.
+type AuditLogListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Action The action to filter by
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// ActorId The id of the user or API token to filter by
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// ResourceId The id of the resource to filter by
+	ResourceId *openapi_types.UUID `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// Since Only return audit logs created at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return audit logs created before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// StepRunListDeadLettersParams defines parameters for StepRunListDeadLetters.
+type StepRunListDeadLettersParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // EventListParams defines parameters for EventList.
 type EventListParams struct {
 	// Offset The number to skip
@@ -1567,25 +2164,67 @@ type RateLimitListParams struct {
 	OrderByDirection *RateLimitOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
-// WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
-type WorkflowRunListStepRunEventsParams struct {
-	// LastId Last ID of the last event
-	LastId *int32 `form:"lastId,omitempty" json:"lastId,omitempty"`
-}
+// WorkflowRunSearchParams defines parameters for WorkflowRunSearch.
+type WorkflowRunSearchParams struct {
+	// Statuses A list of workflow run statuses to filter by
+	Statuses *WorkflowRunStatusList `form:"statuses,omitempty" json:"statuses,omitempty"`
 
-// WorkflowListParams defines parameters for WorkflowList.
-type WorkflowListParams struct {
-	// Offset The number to skip
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// WorkflowIds A list of workflow ids to filter by
+	WorkflowIds *[]openapi_types.UUID `form:"workflowIds,omitempty" json:"workflowIds,omitempty"`
 
-	// Limit The number to limit by
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// EventKeys A list of keys of the events which triggered the workflow runs
+	EventKeys *[]string `form:"eventKeys,omitempty" json:"eventKeys,omitempty"`
 
-	// Name Search by name
-	Name *string `form:"name,omitempty" json:"name,omitempty"`
-}
+	// AdditionalMetadata A list of metadata key value pairs to filter by
+	AdditionalMetadata *[]string `form:"additionalMetadata,omitempty" json:"additionalMetadata,omitempty"`
 
-// CronWorkflowListParams defines parameters for CronWorkflowList.
+	// ParentWorkflowRunId The parent workflow run id
+	ParentWorkflowRunId *openapi_types.UUID `form:"parentWorkflowRunId,omitempty" json:"parentWorkflowRunId,omitempty"`
+
+	// Search A string which the error of the workflow runs contains, ignoring case
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// CreatedAfter The time after the workflow run was created
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore The time before the workflow run was created
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// FinishedAfter The time after the workflow run was finished
+	FinishedAfter *time.Time `form:"finishedAfter,omitempty" json:"finishedAfter,omitempty"`
+
+	// FinishedBefore The time before the workflow run was finished
+	FinishedBefore *time.Time `form:"finishedBefore,omitempty" json:"finishedBefore,omitempty"`
+
+	// OrderByDirection The order of the creation time of the workflow runs, defaults to DESC
+	OrderByDirection *WorkflowRunOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
+
+	// Cursor The cursor of the page to get, which is the nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of workflow runs to return, at most 1000
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
+type WorkflowRunListStepRunEventsParams struct {
+	// LastId Last ID of the last event
+	LastId *int32 `form:"lastId,omitempty" json:"lastId,omitempty"`
+}
+
+// WorkflowListParams defines parameters for WorkflowList.
+type WorkflowListParams struct {
+	// Offset The number to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Name Search by name
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// CronWorkflowListParams defines parameters for CronWorkflowList.
 type CronWorkflowListParams struct {
 	// Offset The number to skip
 	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
@@ -1708,6 +2347,27 @@ type WorkflowScheduledListParams struct {
 	Statuses *[]ScheduledRunStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 }
 
+// UserUpdateSamlCallbackFormdataBody defines parameters for UserUpdateSamlCallback.
+type UserUpdateSamlCallbackFormdataBody struct {
+	// RelayState The relay state of the login flow
+	RelayState *string `form:"RelayState,omitempty" json:"RelayState,omitempty"`
+
+	// SAMLResponse The base64-encoded SAML response
+	SAMLResponse string `form:"SAMLResponse" json:"SAMLResponse"`
+}
+
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Status The status to filter by
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // WorkflowGetMetricsParams defines parameters for WorkflowGetMetrics.
 type WorkflowGetMetricsParams struct {
 	// Status A status of workflow run statuses to filter by
@@ -1723,6 +2383,12 @@ type WorkflowRunCreateParams struct {
 	Version *openapi_types.UUID `form:"version,omitempty" json:"version,omitempty"`
 }
 
+// WorkflowVersionWeightsGetParams defines parameters for WorkflowVersionWeightsGet.
+type WorkflowVersionWeightsGetParams struct {
+	// Since The start of the time range of the run statistics, defaults to 24 hours ago
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
 // WorkflowVersionGetParams defines parameters for WorkflowVersionGet.
 type WorkflowVersionGetParams struct {
 	// Version The workflow version. If not supplied, the latest version is fetched.
@@ -1732,6 +2398,12 @@ type WorkflowVersionGetParams struct {
 // AlertEmailGroupUpdateJSONRequestBody defines body for AlertEmailGroupUpdate for application/json ContentType.
 type AlertEmailGroupUpdateJSONRequestBody = UpdateTenantAlertEmailGroupRequest
 
+// ApiTokenUpdateRotateJSONRequestBody defines body for ApiTokenUpdateRotate for application/json ContentType.
+type ApiTokenUpdateRotateJSONRequestBody = RotateAPITokenRequest
+
+// EventUpdateReplayWithPayloadJSONRequestBody defines body for EventUpdateReplayWithPayload for application/json ContentType.
+type EventUpdateReplayWithPayloadJSONRequestBody = ReplayEventWithPayloadRequest
+
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
 
@@ -1741,9 +2413,15 @@ type TenantUpdateJSONRequestBody = UpdateTenantRequest
 // AlertEmailGroupCreateJSONRequestBody defines body for AlertEmailGroupCreate for application/json ContentType.
 type AlertEmailGroupCreateJSONRequestBody = CreateTenantAlertEmailGroupRequest
 
+// AlertMuteRuleCreateJSONRequestBody defines body for AlertMuteRuleCreate for application/json ContentType.
+type AlertMuteRuleCreateJSONRequestBody = CreateTenantAlertMuteRuleRequest
+
 // ApiTokenCreateJSONRequestBody defines body for ApiTokenCreate for application/json ContentType.
 type ApiTokenCreateJSONRequestBody = CreateAPITokenRequest
 
+// AuditLogUpdateSettingsJSONRequestBody defines body for AuditLogUpdateSettings for application/json ContentType.
+type AuditLogUpdateSettingsJSONRequestBody = UpdateAuditLogSettingsRequest
+
 // EventCreateJSONRequestBody defines body for EventCreate for application/json ContentType.
 type EventCreateJSONRequestBody = CreateEventRequest
 
@@ -1762,15 +2440,33 @@ type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 // TenantInviteUpdateJSONRequestBody defines body for TenantInviteUpdate for application/json ContentType.
 type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 
+// TenantMemberUpdateRoleJSONRequestBody defines body for TenantMemberUpdateRole for application/json ContentType.
+type TenantMemberUpdateRoleJSONRequestBody = UpdateTenantMemberRoleRequest
+
+// TenantResourcePolicyUpdateJSONRequestBody defines body for TenantResourcePolicyUpdate for application/json ContentType.
+type TenantResourcePolicyUpdateJSONRequestBody = UpdateTenantResourcePolicyRequest
+
+// TenantRoleCreateJSONRequestBody defines body for TenantRoleCreate for application/json ContentType.
+type TenantRoleCreateJSONRequestBody = CreateTenantRoleRequest
+
+// TenantRoleUpdateJSONRequestBody defines body for TenantRoleUpdate for application/json ContentType.
+type TenantRoleUpdateJSONRequestBody = UpdateTenantRoleRequest
+
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
+// WebhookSubscriptionCreateJSONRequestBody defines body for WebhookSubscriptionCreate for application/json ContentType.
+type WebhookSubscriptionCreateJSONRequestBody = CreateWebhookSubscriptionRequest
+
 // WebhookCreateJSONRequestBody defines body for WebhookCreate for application/json ContentType.
 type WebhookCreateJSONRequestBody = WebhookWorkerCreateRequest
 
+// WorkflowRunUpdateCancelJSONRequestBody defines body for WorkflowRunUpdateCancel for application/json ContentType.
+type WorkflowRunUpdateCancelJSONRequestBody = CancelWorkflowRunsRequest
+
 // WorkflowRunUpdateReplayJSONRequestBody defines body for WorkflowRunUpdateReplay for application/json ContentType.
 type WorkflowRunUpdateReplayJSONRequestBody = ReplayWorkflowRunsRequest
 
@@ -1798,6 +2494,12 @@ type UserUpdatePasswordJSONRequestBody = UserChangePasswordRequest
 // UserCreateJSONRequestBody defines body for UserCreate for application/json ContentType.
 type UserCreateJSONRequestBody = UserRegisterRequest
 
+// UserUpdateSamlCallbackFormdataRequestBody defines body for UserUpdateSamlCallback for application/x-www-form-urlencoded ContentType.
+type UserUpdateSamlCallbackFormdataRequestBody UserUpdateSamlCallbackFormdataBody
+
+// WebhookSubscriptionUpdateJSONRequestBody defines body for WebhookSubscriptionUpdate for application/json ContentType.
+type WebhookSubscriptionUpdateJSONRequestBody = UpdateWebhookSubscriptionRequest
+
 // WorkerUpdateJSONRequestBody defines body for WorkerUpdate for application/json ContentType.
 type WorkerUpdateJSONRequestBody = UpdateWorkerRequest
 
@@ -1807,6 +2509,9 @@ type WorkflowUpdateJSONRequestBody = WorkflowUpdateRequest
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
+// WorkflowVersionWeightsUpdateJSONRequestBody defines body for WorkflowVersionWeightsUpdate for application/json ContentType.
+type WorkflowVersionWeightsUpdateJSONRequestBody = UpdateWorkflowVersionWeightsRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -1894,9 +2599,17 @@ type ClientInterface interface {
 
 	AlertEmailGroupUpdate(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AlertMuteRuleDelete request
+	AlertMuteRuleDelete(ctx context.Context, alertMuteRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiTokenUpdateRevoke request
 	ApiTokenUpdateRevoke(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiTokenUpdateRotateWithBody request with any body
+	ApiTokenUpdateRotateWithBody(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiTokenUpdateRotate(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CloudMetadataGet request
 	CloudMetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1906,6 +2619,14 @@ type ClientInterface interface {
 	// EventDataGet request
 	EventDataGet(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EventUpdateReplayWithPayloadWithBody request with any body
+	EventUpdateReplayWithPayloadWithBody(ctx context.Context, event openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EventUpdateReplayWithPayload(ctx context.Context, event openapi_types.UUID, body EventUpdateReplayWithPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventWorkflowRunList request
+	EventWorkflowRunList(ctx context.Context, event openapi_types.UUID, params *EventWorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MetadataGet request
 	MetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1951,6 +2672,14 @@ type ClientInterface interface {
 
 	AlertEmailGroupCreate(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AlertMuteRuleList request
+	AlertMuteRuleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AlertMuteRuleCreateWithBody request with any body
+	AlertMuteRuleCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AlertMuteRuleCreate(ctx context.Context, tenant openapi_types.UUID, body AlertMuteRuleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantAlertingSettingsGet request
 	TenantAlertingSettingsGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1962,6 +2691,26 @@ type ClientInterface interface {
 
 	ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AuditLogList request
+	AuditLogList(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuditLogGetSettings request
+	AuditLogGetSettings(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuditLogUpdateSettingsWithBody request with any body
+	AuditLogUpdateSettingsWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuditLogUpdateSettings(ctx context.Context, tenant openapi_types.UUID, body AuditLogUpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StepRunListDeadLetters request
+	StepRunListDeadLetters(ctx context.Context, tenant openapi_types.UUID, params *StepRunListDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StepRunDeleteDeadLetter request
+	StepRunDeleteDeadLetter(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StepRunUpdateRequeue request
+	StepRunUpdateRequeue(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EventList request
 	EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2010,6 +2759,11 @@ type ClientInterface interface {
 	// TenantMemberDelete request
 	TenantMemberDelete(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TenantMemberUpdateRoleWithBody request with any body
+	TenantMemberUpdateRoleWithBody(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantMemberUpdateRole(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantGetQueueMetrics request
 	TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2019,6 +2773,27 @@ type ClientInterface interface {
 	// TenantResourcePolicyGet request
 	TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TenantResourcePolicyUpdateWithBody request with any body
+	TenantResourcePolicyUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantResourcePolicyUpdate(ctx context.Context, tenant openapi_types.UUID, body TenantResourcePolicyUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantRoleList request
+	TenantRoleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantRoleCreateWithBody request with any body
+	TenantRoleCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantRoleCreate(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantRoleDelete request
+	TenantRoleDelete(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantRoleUpdateWithBody request with any body
+	TenantRoleUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantRoleUpdate(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SlackWebhookList request
 	SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2050,6 +2825,14 @@ type ClientInterface interface {
 	// StepRunGetSchema request
 	StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WebhookSubscriptionList request
+	WebhookSubscriptionList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionCreateWithBody request with any body
+	WebhookSubscriptionCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WebhookSubscriptionCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WebhookList request
 	WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2061,6 +2844,14 @@ type ClientInterface interface {
 	// WorkerList request
 	WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowRunSearch request
+	WorkflowRunSearch(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WorkflowRunUpdateCancelWithBody request with any body
+	WorkflowRunUpdateCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WorkflowRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowRunUpdateReplayWithBody request with any body
 	WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2162,6 +2953,12 @@ type ClientInterface interface {
 	// TenantMembershipsList request
 	TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserUpdateOauthCallback request
+	UserUpdateOauthCallback(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserUpdateOauthStart request
+	UserUpdateOauthStart(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserUpdatePasswordWithBody request with any body
 	UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2172,9 +2969,34 @@ type ClientInterface interface {
 
 	UserCreate(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserUpdateSamlCallbackWithBody request with any body
+	UserUpdateSamlCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserUpdateSamlCallbackWithFormdataBody(ctx context.Context, body UserUpdateSamlCallbackFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetSamlMetadata request
+	UserGetSamlMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserUpdateSamlStart request
+	UserUpdateSamlStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserUpdateSlackOauthCallback request
 	UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WebhookDeliveryResend request
+	WebhookDeliveryResend(ctx context.Context, webhookDelivery openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionDelete request
+	WebhookSubscriptionDelete(ctx context.Context, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionUpdateWithBody request with any body
+	WebhookSubscriptionUpdateWithBody(ctx context.Context, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WebhookSubscriptionUpdate(ctx context.Context, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookDeliveryList request
+	WebhookDeliveryList(ctx context.Context, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WebhookDelete request
 	WebhookDelete(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2208,6 +3030,14 @@ type ClientInterface interface {
 
 	WorkflowRunCreate(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowVersionWeightsGet request
+	WorkflowVersionWeightsGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionWeightsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WorkflowVersionWeightsUpdateWithBody request with any body
+	WorkflowVersionWeightsUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WorkflowVersionWeightsUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowVersionWeightsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowVersionGet request
 	WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -2272,6 +3102,18 @@ func (c *Client) AlertEmailGroupUpdate(ctx context.Context, alertEmailGroup open
 	return c.Client.Do(req)
 }
 
+func (c *Client) AlertMuteRuleDelete(ctx context.Context, alertMuteRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertMuteRuleDeleteRequest(c.Server, alertMuteRule)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ApiTokenUpdateRevoke(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewApiTokenUpdateRevokeRequest(c.Server, apiToken)
 	if err != nil {
@@ -2284,6 +3126,30 @@ func (c *Client) ApiTokenUpdateRevoke(ctx context.Context, apiToken openapi_type
 	return c.Client.Do(req)
 }
 
+func (c *Client) ApiTokenUpdateRotateWithBody(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenUpdateRotateRequestWithBody(c.Server, apiToken, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiTokenUpdateRotate(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenUpdateRotateRequest(c.Server, apiToken, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CloudMetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCloudMetadataGetRequest(c.Server)
 	if err != nil {
@@ -2320,6 +3186,42 @@ func (c *Client) EventDataGet(ctx context.Context, event openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
+func (c *Client) EventUpdateReplayWithPayloadWithBody(ctx context.Context, event openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayWithPayloadRequestWithBody(c.Server, event, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventUpdateReplayWithPayload(ctx context.Context, event openapi_types.UUID, body EventUpdateReplayWithPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayWithPayloadRequest(c.Server, event, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventWorkflowRunList(ctx context.Context, event openapi_types.UUID, params *EventWorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventWorkflowRunListRequest(c.Server, event, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) MetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewMetadataGetRequest(c.Server)
 	if err != nil {
@@ -2512,8 +3414,8 @@ func (c *Client) AlertEmailGroupCreate(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantAlertingSettingsGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantAlertingSettingsGetRequest(c.Server, tenant)
+func (c *Client) AlertMuteRuleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertMuteRuleListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2524,8 +3426,8 @@ func (c *Client) TenantAlertingSettingsGet(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiTokenList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiTokenListRequest(c.Server, tenant)
+func (c *Client) AlertMuteRuleCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertMuteRuleCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2536,8 +3438,8 @@ func (c *Client) ApiTokenList(ctx context.Context, tenant openapi_types.UUID, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiTokenCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiTokenCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) AlertMuteRuleCreate(ctx context.Context, tenant openapi_types.UUID, body AlertMuteRuleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertMuteRuleCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2548,8 +3450,8 @@ func (c *Client) ApiTokenCreateWithBody(ctx context.Context, tenant openapi_type
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiTokenCreateRequest(c.Server, tenant, body)
+func (c *Client) TenantAlertingSettingsGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantAlertingSettingsGetRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2560,8 +3462,8 @@ func (c *Client) ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventListRequest(c.Server, tenant, params)
+func (c *Client) ApiTokenList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2572,8 +3474,8 @@ func (c *Client) EventList(ctx context.Context, tenant openapi_types.UUID, param
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) ApiTokenCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2584,8 +3486,8 @@ func (c *Client) EventCreateWithBody(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreate(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateRequest(c.Server, tenant, body)
+func (c *Client) ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2596,8 +3498,8 @@ func (c *Client) EventCreate(ctx context.Context, tenant openapi_types.UUID, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreateBulkWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateBulkRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) AuditLogList(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditLogListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2608,8 +3510,8 @@ func (c *Client) EventCreateBulkWithBody(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreateBulk(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateBulkRequest(c.Server, tenant, body)
+func (c *Client) AuditLogGetSettings(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditLogGetSettingsRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2620,8 +3522,8 @@ func (c *Client) EventCreateBulk(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateCancelRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) AuditLogUpdateSettingsWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditLogUpdateSettingsRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2632,8 +3534,8 @@ func (c *Client) EventUpdateCancelWithBody(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateCancel(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateCancelRequest(c.Server, tenant, body)
+func (c *Client) AuditLogUpdateSettings(ctx context.Context, tenant openapi_types.UUID, body AuditLogUpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditLogUpdateSettingsRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2644,8 +3546,8 @@ func (c *Client) EventUpdateCancel(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventKeyList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventKeyListRequest(c.Server, tenant)
+func (c *Client) StepRunListDeadLetters(ctx context.Context, tenant openapi_types.UUID, params *StepRunListDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunListDeadLettersRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2656,8 +3558,8 @@ func (c *Client) EventKeyList(ctx context.Context, tenant openapi_types.UUID, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) StepRunDeleteDeadLetter(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunDeleteDeadLetterRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -2668,8 +3570,8 @@ func (c *Client) EventUpdateReplayWithBody(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateReplayRequest(c.Server, tenant, body)
+func (c *Client) StepRunUpdateRequeue(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateRequeueRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -2680,8 +3582,8 @@ func (c *Client) EventUpdateReplay(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteListRequest(c.Server, tenant)
+func (c *Client) EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2692,8 +3594,8 @@ func (c *Client) TenantInviteList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) EventCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2704,8 +3606,8 @@ func (c *Client) TenantInviteCreateWithBody(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteCreate(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteCreateRequest(c.Server, tenant, body)
+func (c *Client) EventCreate(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2716,8 +3618,8 @@ func (c *Client) TenantInviteCreate(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteDelete(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteDeleteRequest(c.Server, tenant, tenantInvite)
+func (c *Client) EventCreateBulkWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateBulkRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2728,8 +3630,8 @@ func (c *Client) TenantInviteDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteUpdateRequestWithBody(c.Server, tenant, tenantInvite, contentType, body)
+func (c *Client) EventCreateBulk(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateBulkRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2740,8 +3642,8 @@ func (c *Client) TenantInviteUpdateWithBody(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteUpdate(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteUpdateRequest(c.Server, tenant, tenantInvite, body)
+func (c *Client) EventUpdateCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateCancelRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2752,8 +3654,8 @@ func (c *Client) TenantInviteUpdate(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantMemberList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantMemberListRequest(c.Server, tenant)
+func (c *Client) EventUpdateCancel(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateCancelRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2764,8 +3666,8 @@ func (c *Client) TenantMemberList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantMemberDelete(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantMemberDeleteRequest(c.Server, tenant, member)
+func (c *Client) EventKeyList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventKeyListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2776,8 +3678,8 @@ func (c *Client) TenantMemberDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantGetQueueMetricsRequest(c.Server, tenant, params)
+func (c *Client) EventUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2788,8 +3690,8 @@ func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRateLimitListRequest(c.Server, tenant, params)
+func (c *Client) EventUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2800,8 +3702,8 @@ func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantResourcePolicyGetRequest(c.Server, tenant)
+func (c *Client) TenantInviteList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2812,8 +3714,8 @@ func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlackWebhookListRequest(c.Server, tenant)
+func (c *Client) TenantInviteCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2824,8 +3726,8 @@ func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateSlackOauthStartRequest(c.Server, tenant)
+func (c *Client) TenantInviteCreate(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2836,8 +3738,8 @@ func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsListRequest(c.Server, tenant)
+func (c *Client) TenantInviteDelete(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteDeleteRequest(c.Server, tenant, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -2848,8 +3750,8 @@ func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) TenantInviteUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteUpdateRequestWithBody(c.Server, tenant, tenantInvite, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2860,8 +3762,8 @@ func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsCreateRequest(c.Server, tenant, body)
+func (c *Client) TenantInviteUpdate(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteUpdateRequest(c.Server, tenant, tenantInvite, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2872,8 +3774,8 @@ func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantGetStepRunQueueMetricsRequest(c.Server, tenant)
+func (c *Client) TenantMemberList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2884,8 +3786,8 @@ func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openap
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunGetRequest(c.Server, tenant, stepRun)
+func (c *Client) TenantMemberDelete(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberDeleteRequest(c.Server, tenant, member)
 	if err != nil {
 		return nil, err
 	}
@@ -2896,8 +3798,8 @@ func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, step
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateCancelRequest(c.Server, tenant, stepRun)
+func (c *Client) TenantMemberUpdateRoleWithBody(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberUpdateRoleRequestWithBody(c.Server, tenant, member, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2908,8 +3810,8 @@ func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateRerunRequestWithBody(c.Server, tenant, stepRun, contentType, body)
+func (c *Client) TenantMemberUpdateRole(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberUpdateRoleRequest(c.Server, tenant, member, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2920,8 +3822,8 @@ func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateRerunRequest(c.Server, tenant, stepRun, body)
+func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantGetQueueMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2932,8 +3834,8 @@ func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunGetSchemaRequest(c.Server, tenant, stepRun)
+func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateLimitListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2944,8 +3846,8 @@ func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookListRequest(c.Server, tenant)
+func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantResourcePolicyGetRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2956,8 +3858,8 @@ func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) TenantResourcePolicyUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantResourcePolicyUpdateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2968,8 +3870,8 @@ func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookCreateRequest(c.Server, tenant, body)
+func (c *Client) TenantResourcePolicyUpdate(ctx context.Context, tenant openapi_types.UUID, body TenantResourcePolicyUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantResourcePolicyUpdateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2980,8 +3882,8 @@ func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerListRequest(c.Server, tenant)
+func (c *Client) TenantRoleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2992,8 +3894,8 @@ func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) TenantRoleCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3004,8 +3906,8 @@ func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant ope
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunUpdateReplayRequest(c.Server, tenant, body)
+func (c *Client) TenantRoleCreate(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3016,8 +3918,8 @@ func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetRequest(c.Server, tenant, workflowRun)
+func (c *Client) TenantRoleDelete(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleDeleteRequest(c.Server, tenant, tenantRole)
 	if err != nil {
 		return nil, err
 	}
@@ -3028,8 +3930,8 @@ func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetInputRequest(c.Server, tenant, workflowRun)
+func (c *Client) TenantRoleUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleUpdateRequestWithBody(c.Server, tenant, tenantRole, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3040,8 +3942,8 @@ func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetShapeRequest(c.Server, tenant, workflowRun)
+func (c *Client) TenantRoleUpdate(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleUpdateRequest(c.Server, tenant, tenantRole, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3052,8 +3954,8 @@ func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunListStepRunEventsRequest(c.Server, tenant, workflowRun, params)
+func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSlackWebhookListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3064,8 +3966,8 @@ func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openap
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowListRequest(c.Server, tenant, params)
+func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSlackOauthStartRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3076,8 +3978,8 @@ func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCancelRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3088,8 +3990,8 @@ func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCancelRequest(c.Server, tenant, body)
+func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3100,8 +4002,8 @@ func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowListRequest(c.Server, tenant, params)
+func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3112,8 +4014,8 @@ func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowCronDeleteRequest(c.Server, tenant, cronWorkflow)
+func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantGetStepRunQueueMetricsRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3124,8 +4026,8 @@ func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowCronGetRequest(c.Server, tenant, cronWorkflow)
+func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunGetRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3136,8 +4038,8 @@ func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunListRequest(c.Server, tenant, params)
+func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateCancelRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3148,8 +4050,8 @@ func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetMetricsRequest(c.Server, tenant, params)
+func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateRerunRequestWithBody(c.Server, tenant, stepRun, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3160,8 +4062,8 @@ func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledListRequest(c.Server, tenant, params)
+func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateRerunRequest(c.Server, tenant, stepRun, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3172,8 +4074,8 @@ func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledDeleteRequest(c.Server, tenant, scheduledWorkflowRun)
+func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunGetSchemaRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3184,8 +4086,8 @@ func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledGetRequest(c.Server, tenant, scheduledWorkflowRun)
+func (c *Client) WebhookSubscriptionList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3196,8 +4098,8 @@ func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowTriggerCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
+func (c *Client) WebhookSubscriptionCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3208,8 +4110,8 @@ func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant o
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowTriggerCreateRequest(c.Server, tenant, workflow, body)
+func (c *Client) WebhookSubscriptionCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3220,8 +4122,8 @@ func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewScheduledWorkflowRunCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
+func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3232,8 +4134,8 @@ func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant
 	return c.Client.Do(req)
 }
 
-func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewScheduledWorkflowRunCreateRequest(c.Server, tenant, workflow, body)
+func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3244,8 +4146,8 @@ func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetWorkersCountRequest(c.Server, tenant, workflow)
+func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3256,8 +4158,8 @@ func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetCurrentRequest(c.Server)
+func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3268,8 +4170,8 @@ func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGithubOauthCallbackRequest(c.Server)
+func (c *Client) WorkflowRunSearch(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunSearchRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3280,8 +4182,8 @@ func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGithubOauthStartRequest(c.Server)
+func (c *Client) WorkflowRunUpdateCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateCancelRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3292,8 +4194,8 @@ func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGoogleOauthCallbackRequest(c.Server)
+func (c *Client) WorkflowRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateCancelRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3304,8 +4206,8 @@ func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGoogleOauthStartRequest(c.Server)
+func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3316,8 +4218,8 @@ func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListTenantInvitesRequest(c.Server)
+func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3328,8 +4230,8 @@ func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteAcceptRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3340,8 +4242,8 @@ func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteAcceptRequest(c.Server, body)
+func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetInputRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3352,8 +4254,8 @@ func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAccept
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteRejectRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetShapeRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3364,8 +4266,8 @@ func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteRejectRequest(c.Server, body)
+func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunListStepRunEventsRequest(c.Server, tenant, workflowRun, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3376,8 +4278,8 @@ func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteReject
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3388,8 +4290,8 @@ func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLoginRequest(c.Server, body)
+func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCancelRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3400,8 +4302,8 @@ func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLogoutRequest(c.Server)
+func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCancelRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3412,8 +4314,8 @@ func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantMembershipsListRequest(c.Server)
+func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3424,8 +4326,8 @@ func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdatePasswordRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronDeleteRequest(c.Server, tenant, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -3436,8 +4338,8 @@ func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdatePasswordRequest(c.Server, body)
+func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronGetRequest(c.Server, tenant, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -3448,8 +4350,8 @@ func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePassword
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3460,8 +4362,8 @@ func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequest(c.Server, body)
+func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3472,8 +4374,8 @@ func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateSlackOauthCallbackRequest(c.Server)
+func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3484,8 +4386,8 @@ func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookDeleteRequest(c.Server, webhook)
+func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledDeleteRequest(c.Server, tenant, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3496,8 +4398,8 @@ func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookRequestsListRequest(c.Server, webhook)
+func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledGetRequest(c.Server, tenant, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3508,8 +4410,8 @@ func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerGetRequest(c.Server, worker)
+func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowTriggerCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3520,8 +4422,8 @@ func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerUpdateRequestWithBody(c.Server, worker, contentType, body)
+func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowTriggerCreateRequest(c.Server, tenant, workflow, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3532,8 +4434,8 @@ func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerUpdateRequest(c.Server, worker, body)
+func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScheduledWorkflowRunCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3544,8 +4446,8 @@ func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowDeleteRequest(c.Server, workflow)
+func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScheduledWorkflowRunCreateRequest(c.Server, tenant, workflow, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3556,8 +4458,8 @@ func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetRequest(c.Server, workflow)
+func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetWorkersCountRequest(c.Server, tenant, workflow)
 	if err != nil {
 		return nil, err
 	}
@@ -3568,8 +4470,8 @@ func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowUpdateRequestWithBody(c.Server, workflow, contentType, body)
+func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetCurrentRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3580,8 +4482,8 @@ func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_ty
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowUpdateRequest(c.Server, workflow, body)
+func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGithubOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3592,8 +4494,8 @@ func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetMetricsRequest(c.Server, workflow, params)
+func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGithubOauthStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3604,8 +4506,8 @@ func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCreateRequestWithBody(c.Server, workflow, params, contentType, body)
+func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGoogleOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3616,8 +4518,8 @@ func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCreateRequest(c.Server, workflow, params, body)
+func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGoogleOauthStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3628,8 +4530,8 @@ func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowVersionGetRequest(c.Server, workflow, params)
+func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListTenantInvitesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3640,375 +4542,496 @@ func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.
 	return c.Client.Do(req)
 }
 
-// NewLivenessGetRequest generates requests for LivenessGet
-func NewLivenessGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteAcceptRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/live")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteAcceptRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewReadinessGetRequest generates requests for ReadinessGet
-func NewReadinessGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteRejectRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/ready")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteRejectRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupDeleteRequest generates requests for AlertEmailGroupDelete
-func NewAlertEmailGroupDeleteRequest(server string, alertEmailGroup openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
+func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupUpdateRequest calls the generic AlertEmailGroupUpdate builder with application/json body
-func NewAlertEmailGroupUpdateRequest(server string, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLogoutRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAlertEmailGroupUpdateRequestWithBody(server, alertEmailGroup, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupUpdateRequestWithBody generates requests for AlertEmailGroupUpdate with any type of body
-func NewAlertEmailGroupUpdateRequestWithBody(server string, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
+func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMembershipsListRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserUpdateOauthCallback(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateOauthCallbackRequest(c.Server, provider)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserUpdateOauthStart(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateOauthStartRequest(c.Server, provider)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewApiTokenUpdateRevokeRequest generates requests for ApiTokenUpdateRevoke
-func NewApiTokenUpdateRevokeRequest(server string, apiToken openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
+func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdatePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdatePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCloudMetadataGetRequest generates requests for CloudMetadataGet
-func NewCloudMetadataGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/cloud/metadata")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserUpdateSamlCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSamlCallbackRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewEventGetRequest generates requests for EventGet
-func NewEventGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+func (c *Client) UserUpdateSamlCallbackWithFormdataBody(ctx context.Context, body UserUpdateSamlCallbackFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSamlCallbackRequestWithFormdataBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserGetSamlMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetSamlMetadataRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/events/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserUpdateSamlStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSamlStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewEventDataGetRequest generates requests for EventDataGet
-func NewEventDataGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSlackOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WebhookDeliveryResend(ctx context.Context, webhookDelivery openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookDeliveryResendRequest(c.Server, webhookDelivery)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/events/%s/data", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WebhookSubscriptionDelete(ctx context.Context, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionDeleteRequest(c.Server, webhookSubscription)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewMetadataGetRequest generates requests for MetadataGet
-func NewMetadataGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WebhookSubscriptionUpdateWithBody(ctx context.Context, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionUpdateRequestWithBody(c.Server, webhookSubscription, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/meta")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WebhookSubscriptionUpdate(ctx context.Context, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionUpdateRequest(c.Server, webhookSubscription, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) WebhookDeliveryList(ctx context.Context, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookDeliveryListRequest(c.Server, webhookSubscription, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewMetadataListIntegrationsRequest generates requests for MetadataListIntegrations
-func NewMetadataListIntegrationsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookDeleteRequest(c.Server, webhook)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/meta/integrations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookRequestsListRequest(c.Server, webhook)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerGetRequest(c.Server, worker)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerUpdateRequestWithBody(c.Server, worker, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerUpdateRequest(c.Server, worker, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewMonitoringPostRunProbeRequest generates requests for MonitoringPostRunProbe
-func NewMonitoringPostRunProbeRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
-	var err error
+func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowDeleteRequest(c.Server, workflow)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetRequest(c.Server, workflow)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRequestWithBody(c.Server, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRequest(c.Server, workflow, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/monitoring/%s/probe", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetMetricsRequest(c.Server, workflow, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCreateRequestWithBody(c.Server, workflow, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCreateRequest(c.Server, workflow, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) WorkflowVersionWeightsGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionWeightsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowVersionWeightsGetRequest(c.Server, workflow, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewSlackWebhookDeleteRequest generates requests for SlackWebhookDelete
-func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*http.Request, error) {
-	var err error
+func (c *Client) WorkflowVersionWeightsUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowVersionWeightsUpdateRequestWithBody(c.Server, workflow, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) WorkflowVersionWeightsUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowVersionWeightsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowVersionWeightsUpdateRequest(c.Server, workflow, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slack", runtime.ParamLocationPath, slack)
+func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowVersionGetRequest(c.Server, workflow, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewLivenessGetRequest generates requests for LivenessGet
+func NewLivenessGetRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/slack/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/live")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4018,7 +5041,7 @@ func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4026,23 +5049,16 @@ func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*htt
 	return req, nil
 }
 
-// NewSnsDeleteRequest generates requests for SnsDelete
-func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request, error) {
+// NewReadinessGetRequest generates requests for ReadinessGet
+func NewReadinessGetRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sns", runtime.ParamLocationPath, sns)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sns/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/ready")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4052,7 +5068,7 @@ func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4060,20 +5076,13 @@ func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request,
 	return req, nil
 }
 
-// NewSnsUpdateRequest generates requests for SnsUpdate
-func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string) (*http.Request, error) {
+// NewAlertEmailGroupDeleteRequest generates requests for AlertEmailGroupDelete
+func NewAlertEmailGroupDeleteRequest(server string, alertEmailGroup openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
 	if err != nil {
 		return nil, err
 	}
@@ -4083,7 +5092,7 @@ func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sns/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4093,7 +5102,7 @@ func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4101,13 +5110,24 @@ func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string)
 	return req, nil
 }
 
-// NewStepRunListArchivesRequest generates requests for StepRunListArchives
-func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, params *StepRunListArchivesParams) (*http.Request, error) {
+// NewAlertEmailGroupUpdateRequest calls the generic AlertEmailGroupUpdate builder with application/json body
+func NewAlertEmailGroupUpdateRequest(server string, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAlertEmailGroupUpdateRequestWithBody(server, alertEmailGroup, "application/json", bodyReader)
+}
+
+// NewAlertEmailGroupUpdateRequestWithBody generates requests for AlertEmailGroupUpdate with any type of body
+func NewAlertEmailGroupUpdateRequestWithBody(server string, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
 	if err != nil {
 		return nil, err
 	}
@@ -4117,7 +5137,7 @@ func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/archives", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4127,59 +5147,23 @@ func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, pa
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewStepRunListEventsRequest generates requests for StepRunListEvents
-func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, params *StepRunListEventsParams) (*http.Request, error) {
+// NewAlertMuteRuleDeleteRequest generates requests for AlertMuteRuleDelete
+func NewAlertMuteRuleDeleteRequest(server string, alertMuteRule openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-mute-rule", runtime.ParamLocationPath, alertMuteRule)
 	if err != nil {
 		return nil, err
 	}
@@ -4189,7 +5173,7 @@ func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, para
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/alerting-mute-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4199,45 +5183,7 @@ func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, para
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4245,13 +5191,13 @@ func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, para
 	return req, nil
 }
 
-// NewLogLineListRequest generates requests for LogLineList
-func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *LogLineListParams) (*http.Request, error) {
+// NewApiTokenUpdateRevokeRequest generates requests for ApiTokenUpdateRevoke
+func NewApiTokenUpdateRevokeRequest(server string, apiToken openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
 	if err != nil {
 		return nil, err
 	}
@@ -4261,7 +5207,7 @@ func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *Lo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4271,109 +5217,7 @@ func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *Lo
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Levels != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4381,27 +5225,34 @@ func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *Lo
 	return req, nil
 }
 
-// NewTenantCreateRequest calls the generic TenantCreate builder with application/json body
-func NewTenantCreateRequest(server string, body TenantCreateJSONRequestBody) (*http.Request, error) {
+// NewApiTokenUpdateRotateRequest calls the generic ApiTokenUpdateRotate builder with application/json body
+func NewApiTokenUpdateRotateRequest(server string, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantCreateRequestWithBody(server, "application/json", bodyReader)
+	return NewApiTokenUpdateRotateRequestWithBody(server, apiToken, "application/json", bodyReader)
 }
 
-// NewTenantCreateRequestWithBody generates requests for TenantCreate with any type of body
-func NewTenantCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiTokenUpdateRotateRequestWithBody generates requests for ApiTokenUpdateRotate with any type of body
+func NewApiTokenUpdateRotateRequestWithBody(server string, apiToken openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants")
+	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s/rotate", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4421,24 +5272,40 @@ func NewTenantCreateRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewTenantUpdateRequest calls the generic TenantUpdate builder with application/json body
-func NewTenantUpdateRequest(server string, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewCloudMetadataGetRequest generates requests for CloudMetadataGet
+func NewCloudMetadataGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantUpdateRequestWithBody(server, tenant, "application/json", bodyReader)
+
+	operationPath := fmt.Sprintf("/api/v1/cloud/metadata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewTenantUpdateRequestWithBody generates requests for TenantUpdate with any type of body
-func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventGetRequest generates requests for EventGet
+func NewEventGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -4448,7 +5315,7 @@ func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/events/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4458,23 +5325,21 @@ func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAlertEmailGroupListRequest generates requests for AlertEmailGroupList
-func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventDataGetRequest generates requests for EventDataGet
+func NewEventDataGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -4484,7 +5349,7 @@ func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/events/%s/data", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4502,24 +5367,24 @@ func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*h
 	return req, nil
 }
 
-// NewAlertEmailGroupCreateRequest calls the generic AlertEmailGroupCreate builder with application/json body
-func NewAlertEmailGroupCreateRequest(server string, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody) (*http.Request, error) {
+// NewEventUpdateReplayWithPayloadRequest calls the generic EventUpdateReplayWithPayload builder with application/json body
+func NewEventUpdateReplayWithPayloadRequest(server string, event openapi_types.UUID, body EventUpdateReplayWithPayloadJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAlertEmailGroupCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewEventUpdateReplayWithPayloadRequestWithBody(server, event, "application/json", bodyReader)
 }
 
-// NewAlertEmailGroupCreateRequestWithBody generates requests for AlertEmailGroupCreate with any type of body
-func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventUpdateReplayWithPayloadRequestWithBody generates requests for EventUpdateReplayWithPayload with any type of body
+func NewEventUpdateReplayWithPayloadRequestWithBody(server string, event openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -4529,7 +5394,7 @@ func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/events/%s/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4549,13 +5414,13 @@ func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types
 	return req, nil
 }
 
-// NewTenantAlertingSettingsGetRequest generates requests for TenantAlertingSettingsGet
-func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventWorkflowRunListRequest generates requests for EventWorkflowRunList
+func NewEventWorkflowRunListRequest(server string, event openapi_types.UUID, params *EventWorkflowRunListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -4565,7 +5430,7 @@ func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting/settings", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/events/%s/runs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4575,6 +5440,44 @@ func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -4583,23 +5486,43 @@ func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewApiTokenListRequest generates requests for ApiTokenList
-func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewMetadataGetRequest generates requests for MetadataGet
+func NewMetadataGetRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	operationPath := fmt.Sprintf("/api/v1/meta")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewMetadataListIntegrationsRequest generates requests for MetadataListIntegrations
+func NewMetadataListIntegrationsRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/meta/integrations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4617,24 +5540,47 @@ func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Req
 	return req, nil
 }
 
-// NewApiTokenCreateRequest calls the generic ApiTokenCreate builder with application/json body
-func NewApiTokenCreateRequest(server string, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewMonitoringPostRunProbeRequest generates requests for MonitoringPostRunProbe
+func NewMonitoringPostRunProbeRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApiTokenCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/monitoring/%s/probe", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewApiTokenCreateRequestWithBody generates requests for ApiTokenCreate with any type of body
-func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewSlackWebhookDeleteRequest generates requests for SlackWebhookDelete
+func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slack", runtime.ParamLocationPath, slack)
 	if err != nil {
 		return nil, err
 	}
@@ -4644,7 +5590,7 @@ func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/slack/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4654,18 +5600,50 @@ func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewSnsDeleteRequest generates requests for SnsDelete
+func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sns", runtime.ParamLocationPath, sns)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sns/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewEventListRequest generates requests for EventList
-func NewEventListRequest(server string, tenant openapi_types.UUID, params *EventListParams) (*http.Request, error) {
+// NewSnsUpdateRequest generates requests for SnsUpdate
+func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4675,12 +5653,53 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sns/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStepRunListArchivesRequest generates requests for StepRunListArchives
+func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, params *StepRunListArchivesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/archives", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4725,9 +5744,49 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.Keys != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStepRunListEventsRequest generates requests for StepRunListEvents
+func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, params *StepRunListEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4741,9 +5800,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.Workflows != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4757,9 +5816,49 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.Statuses != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLogLineListRequest generates requests for LogLineList
+func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *LogLineListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4773,9 +5872,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.Search != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4789,9 +5888,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.OrderByField != nil {
+		if params.Levels != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4805,9 +5904,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.OrderByDirection != nil {
+		if params.Search != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4821,9 +5920,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.AdditionalMetadata != nil {
+		if params.OrderByField != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4837,9 +5936,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.EventIds != nil {
+		if params.OrderByDirection != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventIds", runtime.ParamLocationQuery, *params.EventIds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4864,81 +5963,27 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 	return req, nil
 }
 
-// NewEventCreateRequest calls the generic EventCreate builder with application/json body
-func NewEventCreateRequest(server string, tenant openapi_types.UUID, body EventCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEventCreateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewEventCreateRequestWithBody generates requests for EventCreate with any type of body
-func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewEventCreateBulkRequest calls the generic EventCreateBulk builder with application/json body
-func NewEventCreateBulkRequest(server string, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody) (*http.Request, error) {
+// NewTenantCreateRequest calls the generic TenantCreate builder with application/json body
+func NewTenantCreateRequest(server string, body TenantCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewEventCreateBulkRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewTenantCreateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewEventCreateBulkRequestWithBody generates requests for EventCreateBulk with any type of body
-func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantCreateRequestWithBody generates requests for TenantCreate with any type of body
+func NewTenantCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/bulk", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4958,19 +6003,19 @@ func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewEventUpdateCancelRequest calls the generic EventUpdateCancel builder with application/json body
-func NewEventUpdateCancelRequest(server string, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody) (*http.Request, error) {
+// NewTenantUpdateRequest calls the generic TenantUpdate builder with application/json body
+func NewTenantUpdateRequest(server string, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewEventUpdateCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewTenantUpdateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewEventUpdateCancelRequestWithBody generates requests for EventUpdateCancel with any type of body
-func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantUpdateRequestWithBody generates requests for TenantUpdate with any type of body
+func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4985,7 +6030,7 @@ func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/cancel", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4995,7 +6040,7 @@ func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5005,8 +6050,8 @@ func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewEventKeyListRequest generates requests for EventKeyList
-func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewAlertEmailGroupListRequest generates requests for AlertEmailGroupList
+func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5021,7 +6066,7 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/keys", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5039,19 +6084,19 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 	return req, nil
 }
 
-// NewEventUpdateReplayRequest calls the generic EventUpdateReplay builder with application/json body
-func NewEventUpdateReplayRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody) (*http.Request, error) {
+// NewAlertEmailGroupCreateRequest calls the generic AlertEmailGroupCreate builder with application/json body
+func NewAlertEmailGroupCreateRequest(server string, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewEventUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewAlertEmailGroupCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewEventUpdateReplayRequestWithBody generates requests for EventUpdateReplay with any type of body
-func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewAlertEmailGroupCreateRequestWithBody generates requests for AlertEmailGroupCreate with any type of body
+func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5066,7 +6111,7 @@ func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/replay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5086,8 +6131,8 @@ func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewTenantInviteListRequest generates requests for TenantInviteList
-func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewAlertMuteRuleListRequest generates requests for AlertMuteRuleList
+func NewAlertMuteRuleListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5102,7 +6147,7 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-mute-rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5120,19 +6165,19 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
-func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
+// NewAlertMuteRuleCreateRequest calls the generic AlertMuteRuleCreate builder with application/json body
+func NewAlertMuteRuleCreateRequest(server string, tenant openapi_types.UUID, body AlertMuteRuleCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewAlertMuteRuleCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
-func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewAlertMuteRuleCreateRequestWithBody generates requests for AlertMuteRuleCreate with any type of body
+func NewAlertMuteRuleCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5147,7 +6192,7 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-mute-rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5167,8 +6212,8 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 	return req, nil
 }
 
-// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
-func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
+// NewTenantAlertingSettingsGetRequest generates requests for TenantAlertingSettingsGet
+func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5178,19 +6223,12 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting/settings", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5200,7 +6238,7 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5208,19 +6246,8 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 	return req, nil
 }
 
-// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
-func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
-}
-
-// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
-func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiTokenListRequest generates requests for ApiTokenList
+func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5230,19 +6257,12 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5252,18 +6272,27 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantMemberListRequest generates requests for TenantMemberList
-func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewApiTokenCreateRequest calls the generic ApiTokenCreate builder with application/json body
+func NewApiTokenCreateRequest(server string, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiTokenCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewApiTokenCreateRequestWithBody generates requests for ApiTokenCreate with any type of body
+func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5278,7 +6307,7 @@ func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5288,16 +6317,18 @@ func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
-func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
+// NewAuditLogListRequest generates requests for AuditLogList
+func NewAuditLogListRequest(server string, tenant openapi_types.UUID, params *AuditLogListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5307,19 +6338,12 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/audit-logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5329,46 +6353,12 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
-func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queue-metrics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Workflows != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5382,9 +6372,9 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 
 		}
 
-		if params.AdditionalMetadata != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5398,49 +6388,9 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewRateLimitListRequest generates requests for RateLimitList
-func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *RateLimitListParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
+		if params.Action != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5454,9 +6404,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.Limit != nil {
+		if params.ActorId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actorId", runtime.ParamLocationQuery, *params.ActorId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5470,9 +6420,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.Search != nil {
+		if params.ResourceId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceId", runtime.ParamLocationQuery, *params.ResourceId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5486,9 +6436,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.OrderByField != nil {
+		if params.Since != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5502,9 +6452,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.OrderByDirection != nil {
+		if params.Until != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5529,8 +6479,8 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 	return req, nil
 }
 
-// NewTenantResourcePolicyGetRequest generates requests for TenantResourcePolicyGet
-func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewAuditLogGetSettingsRequest generates requests for AuditLogGetSettings
+func NewAuditLogGetSettingsRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5545,7 +6495,7 @@ func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/resource-policy", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/audit-logs/settings", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5563,8 +6513,19 @@ func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID)
 	return req, nil
 }
 
-// NewSlackWebhookListRequest generates requests for SlackWebhookList
-func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewAuditLogUpdateSettingsRequest calls the generic AuditLogUpdateSettings builder with application/json body
+func NewAuditLogUpdateSettingsRequest(server string, tenant openapi_types.UUID, body AuditLogUpdateSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuditLogUpdateSettingsRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewAuditLogUpdateSettingsRequestWithBody generates requests for AuditLogUpdateSettings with any type of body
+func NewAuditLogUpdateSettingsRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5579,7 +6540,7 @@ func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/audit-logs/settings", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5589,16 +6550,18 @@ func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUserUpdateSlackOauthStartRequest generates requests for UserUpdateSlackOauthStart
-func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewStepRunListDeadLettersRequest generates requests for StepRunListDeadLetters
+func NewStepRunListDeadLettersRequest(server string, tenant openapi_types.UUID, params *StepRunListDeadLettersParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5613,7 +6576,7 @@ func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack/start", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/dlq", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5623,38 +6586,42 @@ func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewSnsListRequest generates requests for SnsList
-func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -5665,19 +6632,8 @@ func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request,
 	return req, nil
 }
 
-// NewSnsCreateRequest calls the generic SnsCreate builder with application/json body
-func NewSnsCreateRequest(server string, tenant openapi_types.UUID, body SnsCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSnsCreateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewSnsCreateRequestWithBody generates requests for SnsCreate with any type of body
-func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewStepRunDeleteDeadLetterRequest generates requests for StepRunDeleteDeadLetter
+func NewStepRunDeleteDeadLetterRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5687,38 +6643,9 @@ func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, conte
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewTenantGetStepRunQueueMetricsRequest generates requests for TenantGetStepRunQueueMetrics
-func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5728,7 +6655,7 @@ func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-run-queue-metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/dlq/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5738,7 +6665,7 @@ func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5746,8 +6673,8 @@ func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.
 	return req, nil
 }
 
-// NewStepRunGetRequest generates requests for StepRunGet
-func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+// NewStepRunUpdateRequeueRequest generates requests for StepRunUpdateRequeue
+func NewStepRunUpdateRequeueRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5769,7 +6696,7 @@ func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun open
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/dlq/%s/requeue", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5779,7 +6706,7 @@ func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun open
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5787,8 +6714,8 @@ func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun open
 	return req, nil
 }
 
-// NewStepRunUpdateCancelRequest generates requests for StepRunUpdateCancel
-func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+// NewEventListRequest generates requests for EventList
+func NewEventListRequest(server string, tenant openapi_types.UUID, params *EventListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5798,19 +6725,12 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/cancel", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5820,70 +6740,193 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewStepRunUpdateRerunRequest calls the generic StepRunUpdateRerun builder with application/json body
-func NewStepRunUpdateRerunRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewStepRunUpdateRerunRequestWithBody(server, tenant, stepRun, "application/json", bodyReader)
-}
-
-// NewStepRunUpdateRerunRequestWithBody generates requests for StepRunUpdateRerun with any type of body
-func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	var pathParam0 string
+		if params.Offset != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/rerun", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+		}
+
+		if params.Keys != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Workflows != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventIds", runtime.ParamLocationQuery, *params.EventIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	return req, nil
+}
+
+// NewEventCreateRequest calls the generic EventCreate builder with application/json body
+func NewEventCreateRequest(server string, tenant openapi_types.UUID, body EventCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewEventCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewStepRunGetSchemaRequest generates requests for StepRunGetSchema
-func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+// NewEventCreateRequestWithBody generates requests for EventCreate with any type of body
+func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5893,19 +6936,12 @@ func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRu
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/schema", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5915,16 +6951,29 @@ func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRu
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWebhookListRequest generates requests for WebhookList
-func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventCreateBulkRequest calls the generic EventCreateBulk builder with application/json body
+func NewEventCreateBulkRequest(server string, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEventCreateBulkRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewEventCreateBulkRequestWithBody generates requests for EventCreateBulk with any type of body
+func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5939,7 +6988,7 @@ func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/bulk", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5949,27 +6998,29 @@ func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWebhookCreateRequest calls the generic WebhookCreate builder with application/json body
-func NewWebhookCreateRequest(server string, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody) (*http.Request, error) {
+// NewEventUpdateCancelRequest calls the generic EventUpdateCancel builder with application/json body
+func NewEventUpdateCancelRequest(server string, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWebhookCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewEventUpdateCancelRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewWebhookCreateRequestWithBody generates requests for WebhookCreate with any type of body
-func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventUpdateCancelRequestWithBody generates requests for EventUpdateCancel with any type of body
+func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5984,7 +7035,7 @@ func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, c
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6004,8 +7055,8 @@ func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, c
 	return req, nil
 }
 
-// NewWorkerListRequest generates requests for WorkerList
-func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventKeyListRequest generates requests for EventKeyList
+func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6020,7 +7071,7 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/worker", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6038,19 +7089,19 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 	return req, nil
 }
 
-// NewWorkflowRunUpdateReplayRequest calls the generic WorkflowRunUpdateReplay builder with application/json body
-func NewWorkflowRunUpdateReplayRequest(server string, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody) (*http.Request, error) {
+// NewEventUpdateReplayRequest calls the generic EventUpdateReplay builder with application/json body
+func NewEventUpdateReplayRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewEventUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewWorkflowRunUpdateReplayRequestWithBody generates requests for WorkflowRunUpdateReplay with any type of body
-func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventUpdateReplayRequestWithBody generates requests for EventUpdateReplay with any type of body
+func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6065,7 +7116,7 @@ func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_typ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/replay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6085,8 +7136,8 @@ func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_typ
 	return req, nil
 }
 
-// NewWorkflowRunGetRequest generates requests for WorkflowRunGet
-func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteListRequest generates requests for TenantInviteList
+func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6096,19 +7147,12 @@ func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflow
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6126,20 +7170,24 @@ func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflow
 	return req, nil
 }
 
-// NewWorkflowRunGetInputRequest generates requests for WorkflowRunGetInput
-func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
+func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
+func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -6149,7 +7197,7 @@ func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/input", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6159,16 +7207,18 @@ func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowRunGetShapeRequest generates requests for WorkflowRunGetShape
-func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
+func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6180,7 +7230,7 @@ func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, wor
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -6190,7 +7240,7 @@ func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/shape", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6200,7 +7250,7 @@ func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6208,8 +7258,19 @@ func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, wor
 	return req, nil
 }
 
-// NewWorkflowRunListStepRunEventsRequest generates requests for WorkflowRunListStepRunEvents
-func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams) (*http.Request, error) {
+// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
+func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
+}
+
+// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
+func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6221,7 +7282,7 @@ func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -6231,7 +7292,7 @@ func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/step-run-events", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6241,38 +7302,18 @@ func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.LastId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastId", runtime.ParamLocationQuery, *params.LastId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowListRequest generates requests for WorkflowList
-func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *WorkflowListParams) (*http.Request, error) {
+// NewTenantMemberListRequest generates requests for TenantMemberList
+func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6287,7 +7328,7 @@ func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *Wo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6297,61 +7338,48 @@ func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *Wo
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
+func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.Limit != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.Name != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6359,19 +7387,19 @@ func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *Wo
 	return req, nil
 }
 
-// NewWorkflowRunCancelRequest calls the generic WorkflowRunCancel builder with application/json body
-func NewWorkflowRunCancelRequest(server string, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody) (*http.Request, error) {
+// NewTenantMemberUpdateRoleRequest calls the generic TenantMemberUpdateRole builder with application/json body
+func NewTenantMemberUpdateRoleRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateRoleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewTenantMemberUpdateRoleRequestWithBody(server, tenant, member, "application/json", bodyReader)
 }
 
-// NewWorkflowRunCancelRequestWithBody generates requests for WorkflowRunCancel with any type of body
-func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantMemberUpdateRoleRequestWithBody generates requests for TenantMemberUpdateRole with any type of body
+func NewTenantMemberUpdateRoleRequestWithBody(server string, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6381,12 +7409,19 @@ func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/cancel", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s/role", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6396,7 +7431,7 @@ func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6406,8 +7441,8 @@ func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewCronWorkflowListRequest generates requests for CronWorkflowList
-func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params *CronWorkflowListParams) (*http.Request, error) {
+// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
+func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6422,7 +7457,7 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queue-metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6435,9 +7470,9 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Offset != nil {
+		if params.Workflows != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6451,9 +7486,9 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.Limit != nil {
+		if params.AdditionalMetadata != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6467,9 +7502,49 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.WorkflowId != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRateLimitListRequest generates requests for RateLimitList
+func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *RateLimitListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6483,9 +7558,9 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.AdditionalMetadata != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6499,9 +7574,9 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.OrderByField != nil {
+		if params.Search != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6515,9 +7590,25 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.OrderByDirection != nil {
+		if params.OrderByField != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6542,8 +7633,8 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 	return req, nil
 }
 
-// NewWorkflowCronDeleteRequest generates requests for WorkflowCronDelete
-func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+// NewTenantResourcePolicyGetRequest generates requests for TenantResourcePolicyGet
+func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6553,19 +7644,12 @@ func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cron
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/resource-policy", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6575,7 +7659,7 @@ func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cron
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6583,8 +7667,19 @@ func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cron
 	return req, nil
 }
 
-// NewWorkflowCronGetRequest generates requests for WorkflowCronGet
-func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+// NewTenantResourcePolicyUpdateRequest calls the generic TenantResourcePolicyUpdate builder with application/json body
+func NewTenantResourcePolicyUpdateRequest(server string, tenant openapi_types.UUID, body TenantResourcePolicyUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantResourcePolicyUpdateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewTenantResourcePolicyUpdateRequestWithBody generates requests for TenantResourcePolicyUpdate with any type of body
+func NewTenantResourcePolicyUpdateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6594,9 +7689,38 @@ func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWor
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/resource-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantRoleListRequest generates requests for TenantRoleList
+func NewTenantRoleListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -6606,7 +7730,7 @@ func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6624,8 +7748,19 @@ func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWor
 	return req, nil
 }
 
-// NewWorkflowRunListRequest generates requests for WorkflowRunList
-func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params *WorkflowRunListParams) (*http.Request, error) {
+// NewTenantRoleCreateRequest calls the generic TenantRoleCreate builder with application/json body
+func NewTenantRoleCreateRequest(server string, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantRoleCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewTenantRoleCreateRequestWithBody generates requests for TenantRoleCreate with any type of body
+func NewTenantRoleCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6640,7 +7775,7 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6650,250 +7785,203 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Offset != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewTenantRoleDeleteRequest generates requests for TenantRoleDelete
+func NewTenantRoleDeleteRequest(server string, tenant openapi_types.UUID, tenantRole openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.Limit != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.EventId != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, tenantRole)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.WorkflowId != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.ParentWorkflowRunId != nil {
+// NewTenantRoleUpdateRequest calls the generic TenantRoleUpdate builder with application/json body
+func NewTenantRoleUpdateRequest(server string, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantRoleUpdateRequestWithBody(server, tenant, tenantRole, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewTenantRoleUpdateRequestWithBody generates requests for TenantRoleUpdate with any type of body
+func NewTenantRoleUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.ParentStepRunId != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam1 string
 
-		}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, tenantRole)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Statuses != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Kinds != nil {
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req.Header.Add("Content-Type", contentType)
 
-		}
+	return req, nil
+}
 
-		if params.AdditionalMetadata != nil {
+// NewSlackWebhookListRequest generates requests for SlackWebhookList
+func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CreatedAfter != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CreatedBefore != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewUserUpdateSlackOauthStartRequest generates requests for UserUpdateSlackOauthStart
+func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.FinishedAfter != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedAfter", runtime.ParamLocationQuery, *params.FinishedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.FinishedBefore != nil {
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.OrderByField != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewSnsListRequest generates requests for SnsList
+func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.OrderByDirection != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -6904,8 +7992,19 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 	return req, nil
 }
 
-// NewWorkflowRunGetMetricsRequest generates requests for WorkflowRunGetMetrics
-func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams) (*http.Request, error) {
+// NewSnsCreateRequest calls the generic SnsCreate builder with application/json body
+func NewSnsCreateRequest(server string, tenant openapi_types.UUID, body SnsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSnsCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewSnsCreateRequestWithBody generates requests for SnsCreate with any type of body
+func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6920,7 +8019,7 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6930,134 +8029,52 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.EventId != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewTenantGetStepRunQueueMetricsRequest generates requests for TenantGetStepRunQueueMetrics
+func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.WorkflowId != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.ParentWorkflowRunId != nil {
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-run-queue-metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
-
-		if params.ParentStepRunId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AdditionalMetadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CreatedAfter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CreatedBefore != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewWorkflowScheduledListRequest generates requests for WorkflowScheduledList
-func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, params *WorkflowScheduledListParams) (*http.Request, error) {
+// NewStepRunGetRequest generates requests for StepRunGet
+func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7067,12 +8084,19 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7082,156 +8106,6 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.WorkflowId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ParentWorkflowRunId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ParentStepRunId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AdditionalMetadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Statuses != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -7240,8 +8114,8 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 	return req, nil
 }
 
-// NewWorkflowScheduledDeleteRequest generates requests for WorkflowScheduledDelete
-func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
+// NewStepRunUpdateCancelRequest generates requests for StepRunUpdateCancel
+func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7253,7 +8127,7 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7263,7 +8137,7 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/cancel", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7273,7 +8147,7 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7281,60 +8155,19 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewWorkflowScheduledGetRequest generates requests for WorkflowScheduledGet
-func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewCronWorkflowTriggerCreateRequest calls the generic CronWorkflowTriggerCreate builder with application/json body
-func NewCronWorkflowTriggerCreateRequest(server string, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewStepRunUpdateRerunRequest calls the generic StepRunUpdateRerun builder with application/json body
+func NewStepRunUpdateRerunRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCronWorkflowTriggerCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+	return NewStepRunUpdateRerunRequestWithBody(server, tenant, stepRun, "application/json", bodyReader)
 }
 
-// NewCronWorkflowTriggerCreateRequestWithBody generates requests for CronWorkflowTriggerCreate with any type of body
-func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+// NewStepRunUpdateRerunRequestWithBody generates requests for StepRunUpdateRerun with any type of body
+func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7346,7 +8179,7 @@ func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_t
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7356,7 +8189,7 @@ func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_t
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/crons", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/rerun", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7376,19 +8209,8 @@ func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_t
 	return req, nil
 }
 
-// NewScheduledWorkflowRunCreateRequest calls the generic ScheduledWorkflowRunCreate builder with application/json body
-func NewScheduledWorkflowRunCreateRequest(server string, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewScheduledWorkflowRunCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
-}
-
-// NewScheduledWorkflowRunCreateRequestWithBody generates requests for ScheduledWorkflowRunCreate with any type of body
-func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+// NewStepRunGetSchemaRequest generates requests for StepRunGetSchema
+func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7400,7 +8222,7 @@ func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7410,7 +8232,7 @@ func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/scheduled", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/schema", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7420,18 +8242,16 @@ func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWorkflowGetWorkersCountRequest generates requests for WorkflowGetWorkersCount
-func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID, workflow openapi_types.UUID) (*http.Request, error) {
+// NewWebhookSubscriptionListRequest generates requests for WebhookSubscriptionList
+func NewWebhookSubscriptionListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7441,19 +8261,12 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/worker-count", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-subscriptions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7471,70 +8284,34 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewUserGetCurrentRequest generates requests for UserGetCurrent
-func NewUserGetCurrentRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/users/current")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewWebhookSubscriptionCreateRequest calls the generic WebhookSubscriptionCreate builder with application/json body
+func NewWebhookSubscriptionCreateRequest(server string, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewWebhookSubscriptionCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewUserUpdateGithubOauthCallbackRequest generates requests for UserUpdateGithubOauthCallback
-func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, error) {
+// NewWebhookSubscriptionCreateRequestWithBody generates requests for WebhookSubscriptionCreate with any type of body
+func NewWebhookSubscriptionCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/users/github/callback")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewUserUpdateGithubOauthStartRequest generates requests for UserUpdateGithubOauthStart
-func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/github/start")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-subscriptions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7544,78 +8321,33 @@ func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewUserUpdateGoogleOauthCallbackRequest generates requests for UserUpdateGoogleOauthCallback
-func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/users/google/callback")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	req.Header.Add("Content-Type", contentType)
 
 	return req, nil
 }
 
-// NewUserUpdateGoogleOauthStartRequest generates requests for UserUpdateGoogleOauthStart
-func NewUserUpdateGoogleOauthStartRequest(server string) (*http.Request, error) {
+// NewWebhookListRequest generates requests for WebhookList
+func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/users/google/start")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewUserListTenantInvitesRequest generates requests for UserListTenantInvites
-func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7633,27 +8365,34 @@ func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTenantInviteAcceptRequest calls the generic TenantInviteAccept builder with application/json body
-func NewTenantInviteAcceptRequest(server string, body TenantInviteAcceptJSONRequestBody) (*http.Request, error) {
+// NewWebhookCreateRequest calls the generic WebhookCreate builder with application/json body
+func NewWebhookCreateRequest(server string, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteAcceptRequestWithBody(server, "application/json", bodyReader)
+	return NewWebhookCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewTenantInviteAcceptRequestWithBody generates requests for TenantInviteAccept with any type of body
-func NewTenantInviteAcceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWebhookCreateRequestWithBody generates requests for WebhookCreate with any type of body
+func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites/accept")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7673,27 +8412,23 @@ func NewTenantInviteAcceptRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewTenantInviteRejectRequest calls the generic TenantInviteReject builder with application/json body
-func NewTenantInviteRejectRequest(server string, body TenantInviteRejectJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewWorkerListRequest generates requests for WorkerList
+func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteRejectRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewTenantInviteRejectRequestWithBody generates requests for TenantInviteReject with any type of body
-func NewTenantInviteRejectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites/reject")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/worker", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7703,37 +8438,31 @@ func NewTenantInviteRejectRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUserUpdateLoginRequest calls the generic UserUpdateLogin builder with application/json body
-func NewUserUpdateLoginRequest(server string, body UserUpdateLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewWorkflowRunSearchRequest generates requests for WorkflowRunSearch
+func NewWorkflowRunSearchRequest(server string, tenant openapi_types.UUID, params *WorkflowRunSearchParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUserUpdateLoginRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewUserUpdateLoginRequestWithBody generates requests for UserUpdateLogin with any type of body
-func NewUserUpdateLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/login")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7743,60 +8472,218 @@ func NewUserUpdateLoginRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Statuses != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewUserUpdateLogoutRequest generates requests for UserUpdateLogout
-func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.WorkflowIds != nil {
 
-	operationPath := fmt.Sprintf("/api/v1/users/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowIds", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.EventKeys != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventKeys", runtime.ParamLocationQuery, *params.EventKeys); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewTenantMembershipsListRequest generates requests for TenantMembershipsList
-func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.AdditionalMetadata != nil {
 
-	operationPath := fmt.Sprintf("/api/v1/users/memberships")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		}
+
+		if params.ParentWorkflowRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FinishedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedAfter", runtime.ParamLocationQuery, *params.FinishedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FinishedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -7807,27 +8694,34 @@ func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserUpdatePasswordRequest calls the generic UserUpdatePassword builder with application/json body
-func NewUserUpdatePasswordRequest(server string, body UserUpdatePasswordJSONRequestBody) (*http.Request, error) {
+// NewWorkflowRunUpdateCancelRequest calls the generic WorkflowRunUpdateCancel builder with application/json body
+func NewWorkflowRunUpdateCancelRequest(server string, tenant openapi_types.UUID, body WorkflowRunUpdateCancelJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserUpdatePasswordRequestWithBody(server, "application/json", bodyReader)
+	return NewWorkflowRunUpdateCancelRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewUserUpdatePasswordRequestWithBody generates requests for UserUpdatePassword with any type of body
-func NewUserUpdatePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowRunUpdateCancelRequestWithBody generates requests for WorkflowRunUpdateCancel with any type of body
+func NewWorkflowRunUpdateCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/password")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7847,27 +8741,34 @@ func NewUserUpdatePasswordRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewUserCreateRequest calls the generic UserCreate builder with application/json body
-func NewUserCreateRequest(server string, body UserCreateJSONRequestBody) (*http.Request, error) {
+// NewWorkflowRunUpdateReplayRequest calls the generic WorkflowRunUpdateReplay builder with application/json body
+func NewWorkflowRunUpdateReplayRequest(server string, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserCreateRequestWithBody(server, "application/json", bodyReader)
+	return NewWorkflowRunUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewUserCreateRequestWithBody generates requests for UserCreate with any type of body
-func NewUserCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowRunUpdateReplayRequestWithBody generates requests for WorkflowRunUpdateReplay with any type of body
+func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/register")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7887,40 +8788,20 @@ func NewUserCreateRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewUserUpdateSlackOauthCallbackRequest generates requests for UserUpdateSlackOauthCallback
-func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error) {
+// NewWorkflowRunGetRequest generates requests for WorkflowRunGet
+func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/users/slack/callback")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewWebhookDeleteRequest generates requests for WebhookDelete
-func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7930,7 +8811,7 @@ func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7940,7 +8821,7 @@ func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7948,13 +8829,20 @@ func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.R
 	return req, nil
 }
 
-// NewWebhookRequestsListRequest generates requests for WebhookRequestsList
-func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+// NewWorkflowRunGetInputRequest generates requests for WorkflowRunGetInput
+func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7964,7 +8852,7 @@ func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s/requests", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/input", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7982,13 +8870,20 @@ func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*
 	return req, nil
 }
 
-// NewWorkerGetRequest generates requests for WorkerGet
-func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Request, error) {
+// NewWorkflowRunGetShapeRequest generates requests for WorkflowRunGetShape
+func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7998,7 +8893,7 @@ func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/shape", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8016,24 +8911,20 @@ func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Reques
 	return req, nil
 }
 
-// NewWorkerUpdateRequest calls the generic WorkerUpdate builder with application/json body
-func NewWorkerUpdateRequest(server string, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewWorkflowRunListStepRunEventsRequest generates requests for WorkflowRunListStepRunEvents
+func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkerUpdateRequestWithBody(server, worker, "application/json", bodyReader)
-}
-
-// NewWorkerUpdateRequestWithBody generates requests for WorkerUpdate with any type of body
-func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -8043,7 +8934,7 @@ func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/step-run-events", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8053,23 +8944,43 @@ func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LastId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastId", runtime.ParamLocationQuery, *params.LastId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWorkflowDeleteRequest generates requests for WorkflowDelete
-func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+// NewWorkflowListRequest generates requests for WorkflowList
+func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *WorkflowListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8079,7 +8990,7 @@ func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8089,38 +9000,58 @@ func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewWorkflowGetRequest generates requests for WorkflowGet
-func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -8131,24 +9062,24 @@ func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Re
 	return req, nil
 }
 
-// NewWorkflowUpdateRequest calls the generic WorkflowUpdate builder with application/json body
-func NewWorkflowUpdateRequest(server string, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody) (*http.Request, error) {
+// NewWorkflowRunCancelRequest calls the generic WorkflowRunCancel builder with application/json body
+func NewWorkflowRunCancelRequest(server string, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowUpdateRequestWithBody(server, workflow, "application/json", bodyReader)
+	return NewWorkflowRunCancelRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewWorkflowUpdateRequestWithBody generates requests for WorkflowUpdate with any type of body
-func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowRunCancelRequestWithBody generates requests for WorkflowRunCancel with any type of body
+func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8158,7 +9089,7 @@ func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8168,7 +9099,7 @@ func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -8178,13 +9109,13 @@ func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID
 	return req, nil
 }
 
-// NewWorkflowGetMetricsRequest generates requests for WorkflowGetMetrics
-func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, params *WorkflowGetMetricsParams) (*http.Request, error) {
+// NewCronWorkflowListRequest generates requests for CronWorkflowList
+func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params *CronWorkflowListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8194,7 +9125,7 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8207,9 +9138,9 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Status != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8223,9 +9154,9 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 
 		}
 
-		if params.GroupKey != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupKey", runtime.ParamLocationQuery, *params.GroupKey); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8239,35 +9170,95 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.WorkflowId != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewWorkflowRunCreateRequest calls the generic WorkflowRunCreate builder with application/json body
-func NewWorkflowRunCreateRequest(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunCreateRequestWithBody(server, workflow, params, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewWorkflowRunCreateRequestWithBody generates requests for WorkflowRunCreate with any type of body
-func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowCronDeleteRequest generates requests for WorkflowCronDelete
+func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -8277,7 +9268,7 @@ func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.U
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/trigger", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8287,45 +9278,62 @@ func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.U
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Version != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewWorkflowCronGetRequest generates requests for WorkflowCronGet
+func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewWorkflowVersionGetRequest generates requests for WorkflowVersionGet
-func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, params *WorkflowVersionGetParams) (*http.Request, error) {
+// NewWorkflowRunListRequest generates requests for WorkflowRunList
+func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params *WorkflowRunListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8335,7 +9343,7 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/versions", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8348,9 +9356,9 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Version != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8364,399 +9372,4420 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		if params.EventId != nil {
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
 		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LivenessGetWithResponse request
-	LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error)
 
-	// ReadinessGetWithResponse request
-	ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error)
+		if params.WorkflowId != nil {
 
-	// AlertEmailGroupDeleteWithResponse request
-	AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// AlertEmailGroupUpdateWithBodyWithResponse request with any body
-	AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+		}
 
-	AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+		if params.ParentWorkflowRunId != nil {
 
-	// ApiTokenUpdateRevokeWithResponse request
-	ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CloudMetadataGetWithResponse request
-	CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error)
+		}
 
-	// EventGetWithResponse request
-	EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error)
+		if params.ParentStepRunId != nil {
 
-	// EventDataGetWithResponse request
-	EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error)
-
-	// MetadataGetWithResponse request
-	MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error)
-
-	// MetadataListIntegrationsWithResponse request
-	MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error)
-
-	// MonitoringPostRunProbeWithResponse request
-	MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// SlackWebhookDeleteWithResponse request
-	SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error)
+		}
 
-	// SnsDeleteWithResponse request
-	SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error)
+		if params.Statuses != nil {
 
-	// SnsUpdateWithResponse request
-	SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// StepRunListArchivesWithResponse request
-	StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error)
+		}
 
-	// StepRunListEventsWithResponse request
-	StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error)
+		if params.Kinds != nil {
 
-	// LogLineListWithResponse request
-	LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// TenantCreateWithBodyWithResponse request with any body
-	TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+		}
 
-	TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+		if params.AdditionalMetadata != nil {
 
-	// TenantUpdateWithBodyWithResponse request with any body
-	TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+		}
 
-	// AlertEmailGroupListWithResponse request
-	AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error)
+		if params.CreatedAfter != nil {
 
-	// AlertEmailGroupCreateWithBodyWithResponse request with any body
-	AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+		}
 
-	// TenantAlertingSettingsGetWithResponse request
-	TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error)
+		if params.CreatedBefore != nil {
 
-	// ApiTokenListWithResponse request
-	ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// ApiTokenCreateWithBodyWithResponse request with any body
-	ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+		}
 
-	ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+		if params.FinishedAfter != nil {
 
-	// EventListWithResponse request
-	EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedAfter", runtime.ParamLocationQuery, *params.FinishedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// EventCreateWithBodyWithResponse request with any body
-	EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+		}
 
-	EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+		if params.FinishedBefore != nil {
 
-	// EventCreateBulkWithBodyWithResponse request with any body
-	EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+		}
 
-	// EventUpdateCancelWithBodyWithResponse request with any body
-	EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+		if params.OrderByField != nil {
 
-	EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// EventKeyListWithResponse request
-	EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error)
+		}
 
-	// EventUpdateReplayWithBodyWithResponse request with any body
-	EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+		if params.OrderByDirection != nil {
 
-	EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// TenantInviteListWithResponse request
-	TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error)
+		}
 
-	// TenantInviteCreateWithBodyWithResponse request with any body
-	TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantInviteDeleteWithResponse request
-	TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error)
+	return req, nil
+}
 
-	// TenantInviteUpdateWithBodyWithResponse request with any body
-	TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+// NewWorkflowRunGetMetricsRequest generates requests for WorkflowRunGetMetrics
+func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams) (*http.Request, error) {
+	var err error
 
-	TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+	var pathParam0 string
 
-	// TenantMemberListWithResponse request
-	TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantMemberDeleteWithResponse request
-	TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantGetQueueMetricsWithResponse request
-	TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs/metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// RateLimitListWithResponse request
-	RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantResourcePolicyGetWithResponse request
-	TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// SlackWebhookListWithResponse request
-	SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error)
+		if params.EventId != nil {
 
-	// UserUpdateSlackOauthStartWithResponse request
-	UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// SnsListWithResponse request
-	SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error)
+		}
 
-	// SnsCreateWithBodyWithResponse request with any body
-	SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+		if params.WorkflowId != nil {
 
-	SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// TenantGetStepRunQueueMetricsWithResponse request
-	TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error)
+		}
 
-	// StepRunGetWithResponse request
-	StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error)
+		if params.ParentWorkflowRunId != nil {
 
-	// StepRunUpdateCancelWithResponse request
-	StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// StepRunUpdateRerunWithBodyWithResponse request with any body
-	StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+		}
 
-	StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+		if params.ParentStepRunId != nil {
 
-	// StepRunGetSchemaWithResponse request
-	StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// WebhookListWithResponse request
-	WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error)
+		}
 
-	// WebhookCreateWithBodyWithResponse request with any body
-	WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+		if params.AdditionalMetadata != nil {
 
-	WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// WorkerListWithResponse request
-	WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error)
+		}
 
-	// WorkflowRunUpdateReplayWithBodyWithResponse request with any body
-	WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+		if params.CreatedAfter != nil {
 
-	WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// WorkflowRunGetWithResponse request
-	WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error)
+		}
 
-	// WorkflowRunGetInputWithResponse request
-	WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error)
+		if params.CreatedBefore != nil {
 
-	// WorkflowRunGetShapeWithResponse request
-	WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// WorkflowRunListStepRunEventsWithResponse request
-	WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error)
+		}
 
-	// WorkflowListWithResponse request
-	WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// WorkflowRunCancelWithBodyWithResponse request with any body
-	WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+	return req, nil
+}
 
-	// CronWorkflowListWithResponse request
-	CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error)
+// NewWorkflowScheduledListRequest generates requests for WorkflowScheduledList
+func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, params *WorkflowScheduledListParams) (*http.Request, error) {
+	var err error
 
-	// WorkflowCronDeleteWithResponse request
-	WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error)
+	var pathParam0 string
 
-	// WorkflowCronGetWithResponse request
-	WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunListWithResponse request
-	WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunGetMetricsWithResponse request
-	WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WorkflowScheduledListWithResponse request
-	WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowScheduledDeleteWithResponse request
-	WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// WorkflowScheduledGetWithResponse request
-	WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error)
+		if params.Offset != nil {
 
-	// CronWorkflowTriggerCreateWithBodyWithResponse request with any body
-	CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+		}
 
-	// ScheduledWorkflowRunCreateWithBodyWithResponse request with any body
-	ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+		if params.Limit != nil {
 
-	ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// WorkflowGetWorkersCountWithResponse request
-	WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error)
+		}
 
-	// UserGetCurrentWithResponse request
-	UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error)
+		if params.OrderByField != nil {
 
-	// UserUpdateGithubOauthCallbackWithResponse request
-	UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UserUpdateGithubOauthStartWithResponse request
-	UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error)
+		}
 
-	// UserUpdateGoogleOauthCallbackWithResponse request
-	UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error)
+		if params.OrderByDirection != nil {
 
-	// UserUpdateGoogleOauthStartWithResponse request
-	UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UserListTenantInvitesWithResponse request
-	UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error)
+		}
 
-	// TenantInviteAcceptWithBodyWithResponse request with any body
-	TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+		if params.WorkflowId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentWorkflowRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentStepRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowScheduledDeleteRequest generates requests for WorkflowScheduledDelete
+func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowScheduledGetRequest generates requests for WorkflowScheduledGet
+func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCronWorkflowTriggerCreateRequest calls the generic CronWorkflowTriggerCreate builder with application/json body
+func NewCronWorkflowTriggerCreateRequest(server string, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCronWorkflowTriggerCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+}
+
+// NewCronWorkflowTriggerCreateRequestWithBody generates requests for CronWorkflowTriggerCreate with any type of body
+func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/crons", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewScheduledWorkflowRunCreateRequest calls the generic ScheduledWorkflowRunCreate builder with application/json body
+func NewScheduledWorkflowRunCreateRequest(server string, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewScheduledWorkflowRunCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+}
+
+// NewScheduledWorkflowRunCreateRequestWithBody generates requests for ScheduledWorkflowRunCreate with any type of body
+func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/scheduled", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowGetWorkersCountRequest generates requests for WorkflowGetWorkersCount
+func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/worker-count", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserGetCurrentRequest generates requests for UserGetCurrent
+func NewUserGetCurrentRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/current")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGithubOauthCallbackRequest generates requests for UserUpdateGithubOauthCallback
+func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/github/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGithubOauthStartRequest generates requests for UserUpdateGithubOauthStart
+func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/github/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGoogleOauthCallbackRequest generates requests for UserUpdateGoogleOauthCallback
+func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/google/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGoogleOauthStartRequest generates requests for UserUpdateGoogleOauthStart
+func NewUserUpdateGoogleOauthStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/google/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserListTenantInvitesRequest generates requests for UserListTenantInvites
+func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantInviteAcceptRequest calls the generic TenantInviteAccept builder with application/json body
+func NewTenantInviteAcceptRequest(server string, body TenantInviteAcceptJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteAcceptRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTenantInviteAcceptRequestWithBody generates requests for TenantInviteAccept with any type of body
+func NewTenantInviteAcceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/invites/accept")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantInviteRejectRequest calls the generic TenantInviteReject builder with application/json body
+func NewTenantInviteRejectRequest(server string, body TenantInviteRejectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteRejectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTenantInviteRejectRequestWithBody generates requests for TenantInviteReject with any type of body
+func NewTenantInviteRejectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/invites/reject")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateLoginRequest calls the generic UserUpdateLogin builder with application/json body
+func NewUserUpdateLoginRequest(server string, body UserUpdateLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserUpdateLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserUpdateLoginRequestWithBody generates requests for UserUpdateLogin with any type of body
+func NewUserUpdateLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateLogoutRequest generates requests for UserUpdateLogout
+func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantMembershipsListRequest generates requests for TenantMembershipsList
+func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/memberships")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateOauthCallbackRequest generates requests for UserUpdateOauthCallback
+func NewUserUpdateOauthCallbackRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/oauth/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateOauthStartRequest generates requests for UserUpdateOauthStart
+func NewUserUpdateOauthStartRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/oauth/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdatePasswordRequest calls the generic UserUpdatePassword builder with application/json body
+func NewUserUpdatePasswordRequest(server string, body UserUpdatePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserUpdatePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserUpdatePasswordRequestWithBody generates requests for UserUpdatePassword with any type of body
+func NewUserUpdatePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserCreateRequest calls the generic UserCreate builder with application/json body
+func NewUserCreateRequest(server string, body UserCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCreateRequestWithBody generates requests for UserCreate with any type of body
+func NewUserCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateSamlCallbackRequestWithFormdataBody calls the generic UserUpdateSamlCallback builder with application/x-www-form-urlencoded body
+func NewUserUpdateSamlCallbackRequestWithFormdataBody(server string, body UserUpdateSamlCallbackFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewUserUpdateSamlCallbackRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewUserUpdateSamlCallbackRequestWithBody generates requests for UserUpdateSamlCallback with any type of body
+func NewUserUpdateSamlCallbackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/saml/acs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserGetSamlMetadataRequest generates requests for UserGetSamlMetadata
+func NewUserGetSamlMetadataRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/saml/metadata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateSamlStartRequest generates requests for UserUpdateSamlStart
+func NewUserUpdateSamlStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/saml/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateSlackOauthCallbackRequest generates requests for UserUpdateSlackOauthCallback
+func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/slack/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookDeliveryResendRequest generates requests for WebhookDeliveryResend
+func NewWebhookDeliveryResendRequest(server string, webhookDelivery openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook-delivery", runtime.ParamLocationPath, webhookDelivery)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-deliveries/%s/resend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionDeleteRequest generates requests for WebhookSubscriptionDelete
+func NewWebhookSubscriptionDeleteRequest(server string, webhookSubscription openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, webhookSubscription)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionUpdateRequest calls the generic WebhookSubscriptionUpdate builder with application/json body
+func NewWebhookSubscriptionUpdateRequest(server string, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWebhookSubscriptionUpdateRequestWithBody(server, webhookSubscription, "application/json", bodyReader)
+}
+
+// NewWebhookSubscriptionUpdateRequestWithBody generates requests for WebhookSubscriptionUpdate with any type of body
+func NewWebhookSubscriptionUpdateRequestWithBody(server string, webhookSubscription openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, webhookSubscription)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWebhookDeliveryListRequest generates requests for WebhookDeliveryList
+func NewWebhookDeliveryListRequest(server string, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, webhookSubscription)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-subscriptions/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookDeleteRequest generates requests for WebhookDelete
+func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookRequestsListRequest generates requests for WebhookRequestsList
+func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s/requests", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerGetRequest generates requests for WorkerGet
+func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerUpdateRequest calls the generic WorkerUpdate builder with application/json body
+func NewWorkerUpdateRequest(server string, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkerUpdateRequestWithBody(server, worker, "application/json", bodyReader)
+}
+
+// NewWorkerUpdateRequestWithBody generates requests for WorkerUpdate with any type of body
+func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowDeleteRequest generates requests for WorkflowDelete
+func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowGetRequest generates requests for WorkflowGet
+func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowUpdateRequest calls the generic WorkflowUpdate builder with application/json body
+func NewWorkflowUpdateRequest(server string, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowUpdateRequestWithBody(server, workflow, "application/json", bodyReader)
+}
+
+// NewWorkflowUpdateRequestWithBody generates requests for WorkflowUpdate with any type of body
+func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowGetMetricsRequest generates requests for WorkflowGetMetrics
+func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, params *WorkflowGetMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupKey", runtime.ParamLocationQuery, *params.GroupKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunCreateRequest calls the generic WorkflowRunCreate builder with application/json body
+func NewWorkflowRunCreateRequest(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowRunCreateRequestWithBody(server, workflow, params, "application/json", bodyReader)
+}
+
+// NewWorkflowRunCreateRequestWithBody generates requests for WorkflowRunCreate with any type of body
+func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/trigger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowVersionWeightsGetRequest generates requests for WorkflowVersionWeightsGet
+func NewWorkflowVersionWeightsGetRequest(server string, workflow openapi_types.UUID, params *WorkflowVersionWeightsGetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/version-weights", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowVersionWeightsUpdateRequest calls the generic WorkflowVersionWeightsUpdate builder with application/json body
+func NewWorkflowVersionWeightsUpdateRequest(server string, workflow openapi_types.UUID, body WorkflowVersionWeightsUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowVersionWeightsUpdateRequestWithBody(server, workflow, "application/json", bodyReader)
+}
+
+// NewWorkflowVersionWeightsUpdateRequestWithBody generates requests for WorkflowVersionWeightsUpdate with any type of body
+func NewWorkflowVersionWeightsUpdateRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/version-weights", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowVersionGetRequest generates requests for WorkflowVersionGet
+func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, params *WorkflowVersionGetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LivenessGetWithResponse request
+	LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error)
+
+	// ReadinessGetWithResponse request
+	ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error)
+
+	// AlertEmailGroupDeleteWithResponse request
+	AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error)
+
+	// AlertEmailGroupUpdateWithBodyWithResponse request with any body
+	AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+
+	AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+
+	// AlertMuteRuleDeleteWithResponse request
+	AlertMuteRuleDeleteWithResponse(ctx context.Context, alertMuteRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertMuteRuleDeleteResponse, error)
+
+	// ApiTokenUpdateRevokeWithResponse request
+	ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error)
+
+	// ApiTokenUpdateRotateWithBodyWithResponse request with any body
+	ApiTokenUpdateRotateWithBodyWithResponse(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error)
+
+	ApiTokenUpdateRotateWithResponse(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error)
+
+	// CloudMetadataGetWithResponse request
+	CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error)
+
+	// EventGetWithResponse request
+	EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error)
+
+	// EventDataGetWithResponse request
+	EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error)
+
+	// EventUpdateReplayWithPayloadWithBodyWithResponse request with any body
+	EventUpdateReplayWithPayloadWithBodyWithResponse(ctx context.Context, event openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayWithPayloadResponse, error)
+
+	EventUpdateReplayWithPayloadWithResponse(ctx context.Context, event openapi_types.UUID, body EventUpdateReplayWithPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayWithPayloadResponse, error)
+
+	// EventWorkflowRunListWithResponse request
+	EventWorkflowRunListWithResponse(ctx context.Context, event openapi_types.UUID, params *EventWorkflowRunListParams, reqEditors ...RequestEditorFn) (*EventWorkflowRunListResponse, error)
+
+	// MetadataGetWithResponse request
+	MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error)
+
+	// MetadataListIntegrationsWithResponse request
+	MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error)
+
+	// MonitoringPostRunProbeWithResponse request
+	MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error)
+
+	// SlackWebhookDeleteWithResponse request
+	SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error)
+
+	// SnsDeleteWithResponse request
+	SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error)
+
+	// SnsUpdateWithResponse request
+	SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error)
+
+	// StepRunListArchivesWithResponse request
+	StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error)
+
+	// StepRunListEventsWithResponse request
+	StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error)
+
+	// LogLineListWithResponse request
+	LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error)
+
+	// TenantCreateWithBodyWithResponse request with any body
+	TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+
+	TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+
+	// TenantUpdateWithBodyWithResponse request with any body
+	TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+
+	TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+
+	// AlertEmailGroupListWithResponse request
+	AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error)
+
+	// AlertEmailGroupCreateWithBodyWithResponse request with any body
+	AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+
+	AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+
+	// AlertMuteRuleListWithResponse request
+	AlertMuteRuleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertMuteRuleListResponse, error)
+
+	// AlertMuteRuleCreateWithBodyWithResponse request with any body
+	AlertMuteRuleCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertMuteRuleCreateResponse, error)
+
+	AlertMuteRuleCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertMuteRuleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertMuteRuleCreateResponse, error)
+
+	// TenantAlertingSettingsGetWithResponse request
+	TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error)
+
+	// ApiTokenListWithResponse request
+	ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error)
+
+	// ApiTokenCreateWithBodyWithResponse request with any body
+	ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+
+	ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+
+	// AuditLogListWithResponse request
+	AuditLogListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*AuditLogListResponse, error)
+
+	// AuditLogGetSettingsWithResponse request
+	AuditLogGetSettingsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AuditLogGetSettingsResponse, error)
+
+	// AuditLogUpdateSettingsWithBodyWithResponse request with any body
+	AuditLogUpdateSettingsWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuditLogUpdateSettingsResponse, error)
+
+	AuditLogUpdateSettingsWithResponse(ctx context.Context, tenant openapi_types.UUID, body AuditLogUpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*AuditLogUpdateSettingsResponse, error)
+
+	// StepRunListDeadLettersWithResponse request
+	StepRunListDeadLettersWithResponse(ctx context.Context, tenant openapi_types.UUID, params *StepRunListDeadLettersParams, reqEditors ...RequestEditorFn) (*StepRunListDeadLettersResponse, error)
+
+	// StepRunDeleteDeadLetterWithResponse request
+	StepRunDeleteDeadLetterWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunDeleteDeadLetterResponse, error)
+
+	// StepRunUpdateRequeueWithResponse request
+	StepRunUpdateRequeueWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateRequeueResponse, error)
+
+	// EventListWithResponse request
+	EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error)
+
+	// EventCreateWithBodyWithResponse request with any body
+	EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+
+	EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+
+	// EventCreateBulkWithBodyWithResponse request with any body
+	EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+
+	EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+
+	// EventUpdateCancelWithBodyWithResponse request with any body
+	EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+
+	EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+
+	// EventKeyListWithResponse request
+	EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error)
+
+	// EventUpdateReplayWithBodyWithResponse request with any body
+	EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+
+	EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+
+	// TenantInviteListWithResponse request
+	TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error)
+
+	// TenantInviteCreateWithBodyWithResponse request with any body
+	TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+
+	TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+
+	// TenantInviteDeleteWithResponse request
+	TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error)
+
+	// TenantInviteUpdateWithBodyWithResponse request with any body
+	TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+
+	TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+
+	// TenantMemberListWithResponse request
+	TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error)
+
+	// TenantMemberDeleteWithResponse request
+	TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error)
+
+	// TenantMemberUpdateRoleWithBodyWithResponse request with any body
+	TenantMemberUpdateRoleWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantMemberUpdateRoleResponse, error)
+
+	TenantMemberUpdateRoleWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantMemberUpdateRoleResponse, error)
+
+	// TenantGetQueueMetricsWithResponse request
+	TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error)
+
+	// RateLimitListWithResponse request
+	RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error)
+
+	// TenantResourcePolicyGetWithResponse request
+	TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error)
+
+	// TenantResourcePolicyUpdateWithBodyWithResponse request with any body
+	TenantResourcePolicyUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantResourcePolicyUpdateResponse, error)
+
+	TenantResourcePolicyUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantResourcePolicyUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantResourcePolicyUpdateResponse, error)
+
+	// TenantRoleListWithResponse request
+	TenantRoleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleListResponse, error)
+
+	// TenantRoleCreateWithBodyWithResponse request with any body
+	TenantRoleCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error)
+
+	TenantRoleCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error)
+
+	// TenantRoleDeleteWithResponse request
+	TenantRoleDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleDeleteResponse, error)
+
+	// TenantRoleUpdateWithBodyWithResponse request with any body
+	TenantRoleUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error)
+
+	TenantRoleUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error)
+
+	// SlackWebhookListWithResponse request
+	SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error)
+
+	// UserUpdateSlackOauthStartWithResponse request
+	UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error)
+
+	// SnsListWithResponse request
+	SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error)
+
+	// SnsCreateWithBodyWithResponse request with any body
+	SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+
+	SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+
+	// TenantGetStepRunQueueMetricsWithResponse request
+	TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error)
+
+	// StepRunGetWithResponse request
+	StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error)
+
+	// StepRunUpdateCancelWithResponse request
+	StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error)
+
+	// StepRunUpdateRerunWithBodyWithResponse request with any body
+	StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+
+	StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+
+	// StepRunGetSchemaWithResponse request
+	StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error)
+
+	// WebhookSubscriptionListWithResponse request
+	WebhookSubscriptionListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionListResponse, error)
+
+	// WebhookSubscriptionCreateWithBodyWithResponse request with any body
+	WebhookSubscriptionCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error)
+
+	WebhookSubscriptionCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error)
+
+	// WebhookListWithResponse request
+	WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error)
+
+	// WebhookCreateWithBodyWithResponse request with any body
+	WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+
+	WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+
+	// WorkerListWithResponse request
+	WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error)
+
+	// WorkflowRunSearchWithResponse request
+	WorkflowRunSearchWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunSearchParams, reqEditors ...RequestEditorFn) (*WorkflowRunSearchResponse, error)
+
+	// WorkflowRunUpdateCancelWithBodyWithResponse request with any body
+	WorkflowRunUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateCancelResponse, error)
+
+	WorkflowRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateCancelResponse, error)
+
+	// WorkflowRunUpdateReplayWithBodyWithResponse request with any body
+	WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+
+	WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+
+	// WorkflowRunGetWithResponse request
+	WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error)
+
+	// WorkflowRunGetInputWithResponse request
+	WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error)
+
+	// WorkflowRunGetShapeWithResponse request
+	WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error)
+
+	// WorkflowRunListStepRunEventsWithResponse request
+	WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error)
+
+	// WorkflowListWithResponse request
+	WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error)
+
+	// WorkflowRunCancelWithBodyWithResponse request with any body
+	WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+
+	WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+
+	// CronWorkflowListWithResponse request
+	CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error)
+
+	// WorkflowCronDeleteWithResponse request
+	WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error)
+
+	// WorkflowCronGetWithResponse request
+	WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error)
+
+	// WorkflowRunListWithResponse request
+	WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error)
+
+	// WorkflowRunGetMetricsWithResponse request
+	WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error)
+
+	// WorkflowScheduledListWithResponse request
+	WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error)
+
+	// WorkflowScheduledDeleteWithResponse request
+	WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error)
+
+	// WorkflowScheduledGetWithResponse request
+	WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error)
+
+	// CronWorkflowTriggerCreateWithBodyWithResponse request with any body
+	CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+
+	CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+
+	// ScheduledWorkflowRunCreateWithBodyWithResponse request with any body
+	ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+
+	ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+
+	// WorkflowGetWorkersCountWithResponse request
+	WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error)
+
+	// UserGetCurrentWithResponse request
+	UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error)
+
+	// UserUpdateGithubOauthCallbackWithResponse request
+	UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error)
+
+	// UserUpdateGithubOauthStartWithResponse request
+	UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error)
+
+	// UserUpdateGoogleOauthCallbackWithResponse request
+	UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error)
+
+	// UserUpdateGoogleOauthStartWithResponse request
+	UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error)
+
+	// UserListTenantInvitesWithResponse request
+	UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error)
+
+	// TenantInviteAcceptWithBodyWithResponse request with any body
+	TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+
+	TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+
+	// TenantInviteRejectWithBodyWithResponse request with any body
+	TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+
+	TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+
+	// UserUpdateLoginWithBodyWithResponse request with any body
+	UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+
+	UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+
+	// UserUpdateLogoutWithResponse request
+	UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error)
+
+	// TenantMembershipsListWithResponse request
+	TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error)
+
+	// UserUpdateOauthCallbackWithResponse request
+	UserUpdateOauthCallbackWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*UserUpdateOauthCallbackResponse, error)
+
+	// UserUpdateOauthStartWithResponse request
+	UserUpdateOauthStartWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*UserUpdateOauthStartResponse, error)
+
+	// UserUpdatePasswordWithBodyWithResponse request with any body
+	UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+
+	UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+
+	// UserCreateWithBodyWithResponse request with any body
+	UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+
+	UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+
+	// UserUpdateSamlCallbackWithBodyWithResponse request with any body
+	UserUpdateSamlCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateSamlCallbackResponse, error)
+
+	UserUpdateSamlCallbackWithFormdataBodyWithResponse(ctx context.Context, body UserUpdateSamlCallbackFormdataRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateSamlCallbackResponse, error)
+
+	// UserGetSamlMetadataWithResponse request
+	UserGetSamlMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetSamlMetadataResponse, error)
+
+	// UserUpdateSamlStartWithResponse request
+	UserUpdateSamlStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSamlStartResponse, error)
+
+	// UserUpdateSlackOauthCallbackWithResponse request
+	UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error)
+
+	// WebhookDeliveryResendWithResponse request
+	WebhookDeliveryResendWithResponse(ctx context.Context, webhookDelivery openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeliveryResendResponse, error)
+
+	// WebhookSubscriptionDeleteWithResponse request
+	WebhookSubscriptionDeleteWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionDeleteResponse, error)
+
+	// WebhookSubscriptionUpdateWithBodyWithResponse request with any body
+	WebhookSubscriptionUpdateWithBodyWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error)
+
+	WebhookSubscriptionUpdateWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error)
+
+	// WebhookDeliveryListWithResponse request
+	WebhookDeliveryListWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*WebhookDeliveryListResponse, error)
+
+	// WebhookDeleteWithResponse request
+	WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error)
+
+	// WebhookRequestsListWithResponse request
+	WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error)
+
+	// WorkerGetWithResponse request
+	WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error)
+
+	// WorkerUpdateWithBodyWithResponse request with any body
+	WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+
+	WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+
+	// WorkflowDeleteWithResponse request
+	WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error)
+
+	// WorkflowGetWithResponse request
+	WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error)
+
+	// WorkflowUpdateWithBodyWithResponse request with any body
+	WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+
+	WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+
+	// WorkflowGetMetricsWithResponse request
+	WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error)
+
+	// WorkflowRunCreateWithBodyWithResponse request with any body
+	WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+
+	WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+
+	// WorkflowVersionWeightsGetWithResponse request
+	WorkflowVersionWeightsGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionWeightsGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionWeightsGetResponse, error)
+
+	// WorkflowVersionWeightsUpdateWithBodyWithResponse request with any body
+	WorkflowVersionWeightsUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowVersionWeightsUpdateResponse, error)
+
+	WorkflowVersionWeightsUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowVersionWeightsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowVersionWeightsUpdateResponse, error)
+
+	// WorkflowVersionGetWithResponse request
+	WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error)
+}
+
+type LivenessGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LivenessGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LivenessGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadinessGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadinessGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadinessGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertEmailGroup
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertMuteRuleDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertMuteRuleDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertMuteRuleDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenUpdateRevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenUpdateRevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenUpdateRevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenUpdateRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateAPITokenResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenUpdateRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenUpdateRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloudMetadataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIErrors
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r CloudMetadataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloudMetadataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventDataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventData
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventDataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventDataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateReplayWithPayloadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateReplayWithPayloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateReplayWithPayloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventWorkflowRunListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventWorkflowRunList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventWorkflowRunListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventWorkflowRunListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIMeta
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataListIntegrationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAPIMetaIntegration
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataListIntegrationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataListIntegrationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MonitoringPostRunProbeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MonitoringPostRunProbeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MonitoringPostRunProbeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SlackWebhookDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SlackWebhookDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SlackWebhookDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListArchivesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunArchiveList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListArchivesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListArchivesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunEventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogLineListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogLineList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r LogLineListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogLineListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tenant
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tenant
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertEmailGroupList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantAlertEmailGroup
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertMuteRuleListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertMuteRuleList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertMuteRuleListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertMuteRuleListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertMuteRuleCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantAlertMuteRule
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertMuteRuleCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertMuteRuleCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantAlertingSettingsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertingSettings
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantAlertingSettingsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantAlertingSettingsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAPITokensResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateAPITokenResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuditLogListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r AuditLogListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuditLogListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuditLogGetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogSettings
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r AuditLogGetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuditLogGetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuditLogUpdateSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogSettings
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r AuditLogUpdateSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuditLogUpdateSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListDeadLettersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunDeadLetterList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListDeadLettersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListDeadLettersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunDeleteDeadLetterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunDeleteDeadLetterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunDeleteDeadLetterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunUpdateRequeueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunUpdateRequeueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunUpdateRequeueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateBulkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Events
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+	}
+	JSON400 *APIErrors
+	JSON403 *APIErrors
+	JSON429 *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventKeyListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventKeyList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventKeyListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventKeyListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInviteList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantInvite
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInvite
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInvite
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantMemberListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantMemberList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantMemberListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantMemberDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON204      *TenantMember
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantMemberDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantMemberUpdateRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantMember
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantMemberUpdateRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberUpdateRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantGetQueueMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantQueueMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantGetQueueMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantGetQueueMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RateLimitListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateLimitList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r RateLimitListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateLimitListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantResourcePolicyGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantResourcePolicy
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantResourcePolicyGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantResourcePolicyGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantResourcePolicyUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantResourcePolicy
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantResourcePolicyUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantResourcePolicyUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantRoleListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantRoleList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantRoleListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantRoleCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantRole
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantRoleCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantRoleDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantRoleDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantRoleUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantRole
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantRoleUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SlackWebhookListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSlackWebhooks
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SlackWebhookListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SlackWebhookListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserUpdateSlackOauthStartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UserUpdateSlackOauthStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserUpdateSlackOauthStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSNSIntegrations
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SNSIntegration
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantGetStepRunQueueMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantStepRunQueueMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantGetStepRunQueueMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantGetStepRunQueueMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunUpdateCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunUpdateCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunUpdateCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// TenantInviteRejectWithBodyWithResponse request with any body
-	TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+type StepRunUpdateRerunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
 
-	TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+// Status returns HTTPResponse.Status
+func (r StepRunUpdateRerunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UserUpdateLoginWithBodyWithResponse request with any body
-	UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunUpdateRerunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+type StepRunGetSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
 
-	// UserUpdateLogoutWithResponse request
-	UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error)
+// Status returns HTTPResponse.Status
+func (r StepRunGetSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// TenantMembershipsListWithResponse request
-	TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunGetSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UserUpdatePasswordWithBodyWithResponse request with any body
-	UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+type WebhookSubscriptionListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscriptionList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
 
-	UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UserCreateWithBodyWithResponse request with any body
-	UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+type WebhookSubscriptionCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscriptionCreated
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
 
-	// UserUpdateSlackOauthCallbackWithResponse request
-	UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error)
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WebhookDeleteWithResponse request
-	WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// WebhookRequestsListWithResponse request
-	WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error)
+type WebhookListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookWorkerListResponse
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
 
-	// WorkerGetWithResponse request
-	WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error)
+// Status returns HTTPResponse.Status
+func (r WebhookListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkerUpdateWithBodyWithResponse request with any body
-	WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+type WebhookCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookWorkerCreated
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
 
-	// WorkflowDeleteWithResponse request
-	WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error)
+// Status returns HTTPResponse.Status
+func (r WebhookCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkflowGetWithResponse request
-	WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// WorkflowUpdateWithBodyWithResponse request with any body
-	WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+type WorkerListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
 
-	WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+// Status returns HTTPResponse.Status
+func (r WorkerListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkflowGetMetricsWithResponse request
-	WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkerListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// WorkflowRunCreateWithBodyWithResponse request with any body
-	WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+type WorkflowRunSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRunSearchResult
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
 
-	WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+// Status returns HTTPResponse.Status
+func (r WorkflowRunSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkflowVersionGetWithResponse request
-	WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type LivenessGetResponse struct {
+type WorkflowRunUpdateCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *CancelWorkflowRunsResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r LivenessGetResponse) Status() string {
+func (r WorkflowRunUpdateCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8764,20 +13793,24 @@ func (r LivenessGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r LivenessGetResponse) StatusCode() int {
+func (r WorkflowRunUpdateCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ReadinessGetResponse struct {
+type WorkflowRunUpdateReplayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ReplayWorkflowRunsResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ReadinessGetResponse) Status() string {
+func (r WorkflowRunUpdateReplayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8785,22 +13818,23 @@ func (r ReadinessGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ReadinessGetResponse) StatusCode() int {
+func (r WorkflowRunUpdateReplayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupDeleteResponse struct {
+type WorkflowRunGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupDeleteResponse) Status() string {
+func (r WorkflowRunGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8808,23 +13842,24 @@ func (r AlertEmailGroupDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupDeleteResponse) StatusCode() int {
+func (r WorkflowRunGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupUpdateResponse struct {
+type WorkflowRunGetInputResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertEmailGroup
+	JSON200      *map[string]interface{}
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupUpdateResponse) Status() string {
+func (r WorkflowRunGetInputResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8832,22 +13867,23 @@ func (r AlertEmailGroupUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupUpdateResponse) StatusCode() int {
+func (r WorkflowRunGetInputResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenUpdateRevokeResponse struct {
+type WorkflowRunGetShapeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowRunShape
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenUpdateRevokeResponse) Status() string {
+func (r WorkflowRunGetShapeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8855,22 +13891,24 @@ func (r ApiTokenUpdateRevokeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenUpdateRevokeResponse) StatusCode() int {
+func (r WorkflowRunGetShapeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CloudMetadataGetResponse struct {
+type WorkflowRunListStepRunEventsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *APIErrors
+	JSON200      *StepRunEventList
 	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r CloudMetadataGetResponse) Status() string {
+func (r WorkflowRunListStepRunEventsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8878,23 +13916,23 @@ func (r CloudMetadataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CloudMetadataGetResponse) StatusCode() int {
+func (r WorkflowRunListStepRunEventsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventGetResponse struct {
+type WorkflowListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Event
+	JSON200      *WorkflowList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventGetResponse) Status() string {
+func (r WorkflowListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8902,23 +13940,25 @@ func (r EventGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventGetResponse) StatusCode() int {
+func (r WorkflowListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventDataGetResponse struct {
+type WorkflowRunCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventData
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+	JSON200      *struct {
+		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+	}
+	JSON400 *APIErrors
+	JSON403 *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventDataGetResponse) Status() string {
+func (r WorkflowRunCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8926,22 +13966,23 @@ func (r EventDataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventDataGetResponse) StatusCode() int {
+func (r WorkflowRunCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MetadataGetResponse struct {
+type CronWorkflowListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *APIMeta
+	JSON200      *CronWorkflowsList
 	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r MetadataGetResponse) Status() string {
+func (r CronWorkflowListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8949,22 +13990,22 @@ func (r MetadataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MetadataGetResponse) StatusCode() int {
+func (r CronWorkflowListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MetadataListIntegrationsResponse struct {
+type WorkflowCronDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListAPIMetaIntegration
 	JSON400      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r MetadataListIntegrationsResponse) Status() string {
+func (r WorkflowCronDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8972,21 +14013,24 @@ func (r MetadataListIntegrationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MetadataListIntegrationsResponse) StatusCode() int {
+func (r WorkflowCronDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MonitoringPostRunProbeResponse struct {
+type WorkflowCronGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *CronWorkflows
+	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r MonitoringPostRunProbeResponse) Status() string {
+func (r WorkflowCronGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8994,23 +14038,23 @@ func (r MonitoringPostRunProbeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MonitoringPostRunProbeResponse) StatusCode() int {
+func (r WorkflowCronGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SlackWebhookDeleteResponse struct {
+type WorkflowRunListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowRunList
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SlackWebhookDeleteResponse) Status() string {
+func (r WorkflowRunListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9018,23 +14062,23 @@ func (r SlackWebhookDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SlackWebhookDeleteResponse) StatusCode() int {
+func (r WorkflowRunListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsDeleteResponse struct {
+type WorkflowRunGetMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowRunsMetrics
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsDeleteResponse) Status() string {
+func (r WorkflowRunGetMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9042,23 +14086,23 @@ func (r SnsDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsDeleteResponse) StatusCode() int {
+func (r WorkflowRunGetMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsUpdateResponse struct {
+type WorkflowScheduledListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ScheduledWorkflowsList
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsUpdateResponse) Status() string {
+func (r WorkflowScheduledListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9066,24 +14110,22 @@ func (r SnsUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsUpdateResponse) StatusCode() int {
+func (r WorkflowScheduledListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunListArchivesResponse struct {
+type WorkflowScheduledDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRunArchiveList
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunListArchivesResponse) Status() string {
+func (r WorkflowScheduledDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9091,24 +14133,24 @@ func (r StepRunListArchivesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunListArchivesResponse) StatusCode() int {
+func (r WorkflowScheduledDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunListEventsResponse struct {
+type WorkflowScheduledGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRunEventList
+	JSON200      *ScheduledWorkflows
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunListEventsResponse) Status() string {
+func (r WorkflowScheduledGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9116,23 +14158,25 @@ func (r StepRunListEventsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunListEventsResponse) StatusCode() int {
+func (r WorkflowScheduledGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type LogLineListResponse struct {
+type CronWorkflowTriggerCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *LogLineList
+	JSON200      *CronWorkflows
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r LogLineListResponse) Status() string {
+func (r CronWorkflowTriggerCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9140,23 +14184,25 @@ func (r LogLineListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r LogLineListResponse) StatusCode() int {
+func (r CronWorkflowTriggerCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantCreateResponse struct {
+type ScheduledWorkflowRunCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Tenant
+	JSON200      *ScheduledWorkflows
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantCreateResponse) Status() string {
+func (r ScheduledWorkflowRunCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9164,23 +14210,23 @@ func (r TenantCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantCreateResponse) StatusCode() int {
+func (r ScheduledWorkflowRunCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantUpdateResponse struct {
+type WorkflowGetWorkersCountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Tenant
+	JSON200      *WorkflowWorkersCount
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantUpdateResponse) Status() string {
+func (r WorkflowGetWorkersCountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9188,23 +14234,24 @@ func (r TenantUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantUpdateResponse) StatusCode() int {
+func (r WorkflowGetWorkersCountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupListResponse struct {
+type UserGetCurrentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertEmailGroupList
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupListResponse) Status() string {
+func (r UserGetCurrentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9212,23 +14259,20 @@ func (r AlertEmailGroupListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupListResponse) StatusCode() int {
+func (r UserGetCurrentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupCreateResponse struct {
+type UserUpdateGithubOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TenantAlertEmailGroup
-	JSON400      *APIErrors
-	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupCreateResponse) Status() string {
+func (r UserUpdateGithubOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9236,23 +14280,20 @@ func (r AlertEmailGroupCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupCreateResponse) StatusCode() int {
+func (r UserUpdateGithubOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantAlertingSettingsGetResponse struct {
+type UserUpdateGithubOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertingSettings
-	JSON400      *APIErrors
-	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantAlertingSettingsGetResponse) Status() string {
+func (r UserUpdateGithubOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9260,23 +14301,20 @@ func (r TenantAlertingSettingsGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantAlertingSettingsGetResponse) StatusCode() int {
+func (r UserUpdateGithubOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenListResponse struct {
+type UserUpdateGoogleOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListAPITokensResponse
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenListResponse) Status() string {
+func (r UserUpdateGoogleOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9284,23 +14322,20 @@ func (r ApiTokenListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenListResponse) StatusCode() int {
+func (r UserUpdateGoogleOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenCreateResponse struct {
+type UserUpdateGoogleOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CreateAPITokenResponse
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenCreateResponse) Status() string {
+func (r UserUpdateGoogleOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9308,23 +14343,23 @@ func (r ApiTokenCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenCreateResponse) StatusCode() int {
+func (r UserUpdateGoogleOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventListResponse struct {
+type UserListTenantInvitesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventList
+	JSON200      *TenantInviteList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventListResponse) Status() string {
+func (r UserListTenantInvitesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9332,24 +14367,22 @@ func (r EventListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventListResponse) StatusCode() int {
+func (r UserListTenantInvitesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventCreateResponse struct {
+type TenantInviteAcceptResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Event
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r EventCreateResponse) Status() string {
+func (r TenantInviteAcceptResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9357,24 +14390,22 @@ func (r EventCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventCreateResponse) StatusCode() int {
+func (r TenantInviteAcceptResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventCreateBulkResponse struct {
+type TenantInviteRejectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Events
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r EventCreateBulkResponse) Status() string {
+func (r TenantInviteRejectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9382,26 +14413,24 @@ func (r EventCreateBulkResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventCreateBulkResponse) StatusCode() int {
+func (r TenantInviteRejectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventUpdateCancelResponse struct {
+type UserUpdateLoginResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-	}
-	JSON400 *APIErrors
-	JSON403 *APIErrors
-	JSON429 *APIErrors
+	JSON200      *User
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventUpdateCancelResponse) Status() string {
+func (r UserUpdateLoginResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9409,23 +14438,24 @@ func (r EventUpdateCancelResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventUpdateCancelResponse) StatusCode() int {
+func (r UserUpdateLoginResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventKeyListResponse struct {
+type UserUpdateLogoutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventKeyList
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventKeyListResponse) Status() string {
+func (r UserUpdateLogoutResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9433,24 +14463,23 @@ func (r EventKeyListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventKeyListResponse) StatusCode() int {
+func (r UserUpdateLogoutResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventUpdateReplayResponse struct {
+type TenantMembershipsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventList
+	JSON200      *UserTenantMembershipsList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventUpdateReplayResponse) Status() string {
+func (r TenantMembershipsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9458,23 +14487,20 @@ func (r EventUpdateReplayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventUpdateReplayResponse) StatusCode() int {
+func (r TenantMembershipsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteListResponse struct {
+type UserUpdateOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInviteList
-	JSON400      *APIErrors
-	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteListResponse) Status() string {
+func (r UserUpdateOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9482,23 +14508,20 @@ func (r TenantInviteListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteListResponse) StatusCode() int {
+func (r UserUpdateOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteCreateResponse struct {
+type UserUpdateOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TenantInvite
-	JSON400      *APIErrors
-	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteCreateResponse) Status() string {
+func (r UserUpdateOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9506,22 +14529,24 @@ func (r TenantInviteCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteCreateResponse) StatusCode() int {
+func (r UserUpdateOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteDeleteResponse struct {
+type UserUpdatePasswordResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInvite
+	JSON200      *User
 	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteDeleteResponse) Status() string {
+func (r UserUpdatePasswordResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9529,22 +14554,24 @@ func (r TenantInviteDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteDeleteResponse) StatusCode() int {
+func (r UserUpdatePasswordResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteUpdateResponse struct {
+type UserCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInvite
+	JSON200      *User
 	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteUpdateResponse) Status() string {
+func (r UserCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9552,23 +14579,20 @@ func (r TenantInviteUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteUpdateResponse) StatusCode() int {
+func (r UserCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantMemberListResponse struct {
+type UserUpdateSamlCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantMemberList
-	JSON400      *APIErrors
-	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantMemberListResponse) Status() string {
+func (r UserUpdateSamlCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9576,24 +14600,21 @@ func (r TenantMemberListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantMemberListResponse) StatusCode() int {
+func (r UserUpdateSamlCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantMemberDeleteResponse struct {
+type UserGetSamlMetadataResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON204      *TenantMember
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantMemberDeleteResponse) Status() string {
+func (r UserGetSamlMetadataResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9601,24 +14622,20 @@ func (r TenantMemberDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantMemberDeleteResponse) StatusCode() int {
+func (r UserGetSamlMetadataResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantGetQueueMetricsResponse struct {
+type UserUpdateSamlStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantQueueMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantGetQueueMetricsResponse) Status() string {
+func (r UserUpdateSamlStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9626,23 +14643,20 @@ func (r TenantGetQueueMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantGetQueueMetricsResponse) StatusCode() int {
+func (r UserUpdateSamlStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RateLimitListResponse struct {
+type UserUpdateSlackOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RateLimitList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r RateLimitListResponse) Status() string {
+func (r UserUpdateSlackOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9650,23 +14664,24 @@ func (r RateLimitListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RateLimitListResponse) StatusCode() int {
+func (r UserUpdateSlackOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantResourcePolicyGetResponse struct {
+type WebhookDeliveryResendResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantResourcePolicy
+	JSON200      *WebhookDelivery
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantResourcePolicyGetResponse) Status() string {
+func (r WebhookDeliveryResendResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9674,24 +14689,24 @@ func (r TenantResourcePolicyGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantResourcePolicyGetResponse) StatusCode() int {
+func (r WebhookDeliveryResendResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SlackWebhookListResponse struct {
+type WebhookSubscriptionDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListSlackWebhooks
+	JSON200      *WebhookSubscription
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SlackWebhookListResponse) Status() string {
+func (r WebhookSubscriptionDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9699,20 +14714,24 @@ func (r SlackWebhookListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SlackWebhookListResponse) StatusCode() int {
+func (r WebhookSubscriptionDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateSlackOauthStartResponse struct {
+type WebhookSubscriptionUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WebhookSubscription
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateSlackOauthStartResponse) Status() string {
+func (r WebhookSubscriptionUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9720,24 +14739,24 @@ func (r UserUpdateSlackOauthStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateSlackOauthStartResponse) StatusCode() int {
+func (r WebhookSubscriptionUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsListResponse struct {
+type WebhookDeliveryListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListSNSIntegrations
+	JSON200      *WebhookDeliveryList
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsListResponse) Status() string {
+func (r WebhookDeliveryListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9745,24 +14764,23 @@ func (r SnsListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsListResponse) StatusCode() int {
+func (r WebhookDeliveryListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsCreateResponse struct {
+type WebhookDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *SNSIntegration
 	JSON400      *APIErrors
 	JSON401      *APIErrors
 	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsCreateResponse) Status() string {
+func (r WebhookDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9770,24 +14788,24 @@ func (r SnsCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsCreateResponse) StatusCode() int {
+func (r WebhookDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantGetStepRunQueueMetricsResponse struct {
+type WebhookRequestsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantStepRunQueueMetrics
+	JSON200      *WebhookWorkerRequestListResponse
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantGetStepRunQueueMetricsResponse) Status() string {
+func (r WebhookRequestsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9795,24 +14813,23 @@ func (r TenantGetStepRunQueueMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantGetStepRunQueueMetricsResponse) StatusCode() int {
+func (r WebhookRequestsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunGetResponse struct {
+type WorkerGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRun
+	JSON200      *Worker
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunGetResponse) Status() string {
+func (r WorkerGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9820,23 +14837,23 @@ func (r StepRunGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunGetResponse) StatusCode() int {
+func (r WorkerGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunUpdateCancelResponse struct {
+type WorkerUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRun
+	JSON200      *Worker
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunUpdateCancelResponse) Status() string {
+func (r WorkerUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9844,23 +14861,23 @@ func (r StepRunUpdateCancelResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunUpdateCancelResponse) StatusCode() int {
+func (r WorkerUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunUpdateRerunResponse struct {
+type WorkflowDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRun
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunUpdateRerunResponse) Status() string {
+func (r WorkflowDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9868,24 +14885,24 @@ func (r StepRunUpdateRerunResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunUpdateRerunResponse) StatusCode() int {
+func (r WorkflowDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunGetSchemaResponse struct {
+type WorkflowGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
+	JSON200      *Workflow
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunGetSchemaResponse) Status() string {
+func (r WorkflowGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9893,24 +14910,23 @@ func (r StepRunGetSchemaResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunGetSchemaResponse) StatusCode() int {
+func (r WorkflowGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WebhookListResponse struct {
+type WorkflowUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerListResponse
+	JSON200      *Workflow
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WebhookListResponse) Status() string {
+func (r WorkflowUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9918,24 +14934,24 @@ func (r WebhookListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WebhookListResponse) StatusCode() int {
+func (r WorkflowUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WebhookCreateResponse struct {
+type WorkflowGetMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerCreated
+	JSON200      *WorkflowMetrics
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WebhookCreateResponse) Status() string {
+func (r WorkflowGetMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9943,23 +14959,25 @@ func (r WebhookCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WebhookCreateResponse) StatusCode() int {
+func (r WorkflowGetMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkerListResponse struct {
+type WorkflowRunCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkerList
+	JSON200      *WorkflowRun
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkerListResponse) Status() string {
+func (r WorkflowRunCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9967,24 +14985,23 @@ func (r WorkerListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkerListResponse) StatusCode() int {
+func (r WorkflowRunCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunUpdateReplayResponse struct {
+type WorkflowVersionWeightsGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ReplayWorkflowRunsResponse
+	JSON200      *WorkflowVersionWeights
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunUpdateReplayResponse) Status() string {
+func (r WorkflowVersionWeightsGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9992,23 +15009,23 @@ func (r WorkflowRunUpdateReplayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunUpdateReplayResponse) StatusCode() int {
+func (r WorkflowVersionWeightsGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunGetResponse struct {
+type WorkflowVersionWeightsUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkflowRun
+	JSON200      *WorkflowVersionWeights
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunGetResponse) Status() string {
+func (r WorkflowVersionWeightsUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10016,24 +15033,24 @@ func (r WorkflowRunGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetResponse) StatusCode() int {
+func (r WorkflowVersionWeightsUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunGetInputResponse struct {
+type WorkflowVersionGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
+	JSON200      *WorkflowVersion
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunGetInputResponse) Status() string {
+func (r WorkflowVersionGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10041,2041 +15058,2517 @@ func (r WorkflowRunGetInputResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetInputResponse) StatusCode() int {
+func (r WorkflowVersionGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunGetShapeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRunShape
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// LivenessGetWithResponse request returning *LivenessGetResponse
+func (c *ClientWithResponses) LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error) {
+	rsp, err := c.LivenessGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLivenessGetResponse(rsp)
+}
+
+// ReadinessGetWithResponse request returning *ReadinessGetResponse
+func (c *ClientWithResponses) ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error) {
+	rsp, err := c.ReadinessGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadinessGetResponse(rsp)
+}
+
+// AlertEmailGroupDeleteWithResponse request returning *AlertEmailGroupDeleteResponse
+func (c *ClientWithResponses) AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error) {
+	rsp, err := c.AlertEmailGroupDelete(ctx, alertEmailGroup, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupDeleteResponse(rsp)
+}
+
+// AlertEmailGroupUpdateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupUpdateResponse
+func (c *ClientWithResponses) AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
+	rsp, err := c.AlertEmailGroupUpdateWithBody(ctx, alertEmailGroup, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
+	rsp, err := c.AlertEmailGroupUpdate(ctx, alertEmailGroup, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupUpdateResponse(rsp)
+}
+
+// AlertMuteRuleDeleteWithResponse request returning *AlertMuteRuleDeleteResponse
+func (c *ClientWithResponses) AlertMuteRuleDeleteWithResponse(ctx context.Context, alertMuteRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertMuteRuleDeleteResponse, error) {
+	rsp, err := c.AlertMuteRuleDelete(ctx, alertMuteRule, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertMuteRuleDeleteResponse(rsp)
+}
+
+// ApiTokenUpdateRevokeWithResponse request returning *ApiTokenUpdateRevokeResponse
+func (c *ClientWithResponses) ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error) {
+	rsp, err := c.ApiTokenUpdateRevoke(ctx, apiToken, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenUpdateRevokeResponse(rsp)
+}
+
+// ApiTokenUpdateRotateWithBodyWithResponse request with arbitrary body returning *ApiTokenUpdateRotateResponse
+func (c *ClientWithResponses) ApiTokenUpdateRotateWithBodyWithResponse(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error) {
+	rsp, err := c.ApiTokenUpdateRotateWithBody(ctx, apiToken, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenUpdateRotateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiTokenUpdateRotateWithResponse(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error) {
+	rsp, err := c.ApiTokenUpdateRotate(ctx, apiToken, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenUpdateRotateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunGetShapeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CloudMetadataGetWithResponse request returning *CloudMetadataGetResponse
+func (c *ClientWithResponses) CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error) {
+	rsp, err := c.CloudMetadataGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCloudMetadataGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetShapeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EventGetWithResponse request returning *EventGetResponse
+func (c *ClientWithResponses) EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error) {
+	rsp, err := c.EventGet(ctx, event, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventGetResponse(rsp)
 }
 
-type WorkflowRunListStepRunEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StepRunEventList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// EventDataGetWithResponse request returning *EventDataGetResponse
+func (c *ClientWithResponses) EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error) {
+	rsp, err := c.EventDataGet(ctx, event, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventDataGetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunListStepRunEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventUpdateReplayWithPayloadWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayWithPayloadResponse
+func (c *ClientWithResponses) EventUpdateReplayWithPayloadWithBodyWithResponse(ctx context.Context, event openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayWithPayloadResponse, error) {
+	rsp, err := c.EventUpdateReplayWithPayloadWithBody(ctx, event, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventUpdateReplayWithPayloadResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunListStepRunEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventUpdateReplayWithPayloadWithResponse(ctx context.Context, event openapi_types.UUID, body EventUpdateReplayWithPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayWithPayloadResponse, error) {
+	rsp, err := c.EventUpdateReplayWithPayload(ctx, event, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventUpdateReplayWithPayloadResponse(rsp)
 }
 
-type WorkflowListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// EventWorkflowRunListWithResponse request returning *EventWorkflowRunListResponse
+func (c *ClientWithResponses) EventWorkflowRunListWithResponse(ctx context.Context, event openapi_types.UUID, params *EventWorkflowRunListParams, reqEditors ...RequestEditorFn) (*EventWorkflowRunListResponse, error) {
+	rsp, err := c.EventWorkflowRunList(ctx, event, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventWorkflowRunListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// MetadataGetWithResponse request returning *MetadataGetResponse
+func (c *ClientWithResponses) MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error) {
+	rsp, err := c.MetadataGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseMetadataGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MetadataListIntegrationsWithResponse request returning *MetadataListIntegrationsResponse
+func (c *ClientWithResponses) MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error) {
+	rsp, err := c.MetadataListIntegrations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMetadataListIntegrationsResponse(rsp)
 }
 
-type WorkflowRunCancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+// MonitoringPostRunProbeWithResponse request returning *MonitoringPostRunProbeResponse
+func (c *ClientWithResponses) MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error) {
+	rsp, err := c.MonitoringPostRunProbe(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *APIErrors
-	JSON403 *APIErrors
+	return ParseMonitoringPostRunProbeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunCancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SlackWebhookDeleteWithResponse request returning *SlackWebhookDeleteResponse
+func (c *ClientWithResponses) SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error) {
+	rsp, err := c.SlackWebhookDelete(ctx, slack, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSlackWebhookDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunCancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SnsDeleteWithResponse request returning *SnsDeleteResponse
+func (c *ClientWithResponses) SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error) {
+	rsp, err := c.SnsDelete(ctx, sns, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSnsDeleteResponse(rsp)
 }
 
-type CronWorkflowListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CronWorkflowsList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// SnsUpdateWithResponse request returning *SnsUpdateResponse
+func (c *ClientWithResponses) SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error) {
+	rsp, err := c.SnsUpdate(ctx, tenant, event, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnsUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CronWorkflowListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunListArchivesWithResponse request returning *StepRunListArchivesResponse
+func (c *ClientWithResponses) StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error) {
+	rsp, err := c.StepRunListArchives(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunListArchivesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CronWorkflowListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunListEventsWithResponse request returning *StepRunListEventsResponse
+func (c *ClientWithResponses) StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error) {
+	rsp, err := c.StepRunListEvents(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunListEventsResponse(rsp)
 }
 
-type WorkflowCronDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+// LogLineListWithResponse request returning *LogLineListResponse
+func (c *ClientWithResponses) LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error) {
+	rsp, err := c.LogLineList(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogLineListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowCronDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantCreateWithBodyWithResponse request with arbitrary body returning *TenantCreateResponse
+func (c *ClientWithResponses) TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
+	rsp, err := c.TenantCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowCronDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
+	rsp, err := c.TenantCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantCreateResponse(rsp)
 }
 
-type WorkflowCronGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CronWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// TenantUpdateWithBodyWithResponse request with arbitrary body returning *TenantUpdateResponse
+func (c *ClientWithResponses) TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
+	rsp, err := c.TenantUpdateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowCronGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
+	rsp, err := c.TenantUpdate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowCronGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AlertEmailGroupListWithResponse request returning *AlertEmailGroupListResponse
+func (c *ClientWithResponses) AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error) {
+	rsp, err := c.AlertEmailGroupList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAlertEmailGroupListResponse(rsp)
 }
 
-type WorkflowRunListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRunList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// AlertEmailGroupCreateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupCreateResponse
+func (c *ClientWithResponses) AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
+	rsp, err := c.AlertEmailGroupCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
+	rsp, err := c.AlertEmailGroupCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAlertEmailGroupCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AlertMuteRuleListWithResponse request returning *AlertMuteRuleListResponse
+func (c *ClientWithResponses) AlertMuteRuleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertMuteRuleListResponse, error) {
+	rsp, err := c.AlertMuteRuleList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAlertMuteRuleListResponse(rsp)
 }
-
-type WorkflowRunGetMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRunsMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+
+// AlertMuteRuleCreateWithBodyWithResponse request with arbitrary body returning *AlertMuteRuleCreateResponse
+func (c *ClientWithResponses) AlertMuteRuleCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertMuteRuleCreateResponse, error) {
+	rsp, err := c.AlertMuteRuleCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertMuteRuleCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunGetMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) AlertMuteRuleCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertMuteRuleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertMuteRuleCreateResponse, error) {
+	rsp, err := c.AlertMuteRuleCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAlertMuteRuleCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantAlertingSettingsGetWithResponse request returning *TenantAlertingSettingsGetResponse
+func (c *ClientWithResponses) TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error) {
+	rsp, err := c.TenantAlertingSettingsGet(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantAlertingSettingsGetResponse(rsp)
 }
 
-type WorkflowScheduledListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflowsList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// ApiTokenListWithResponse request returning *ApiTokenListResponse
+func (c *ClientWithResponses) ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error) {
+	rsp, err := c.ApiTokenList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowScheduledListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ApiTokenCreateWithBodyWithResponse request with arbitrary body returning *ApiTokenCreateResponse
+func (c *ClientWithResponses) ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
+	rsp, err := c.ApiTokenCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseApiTokenCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
+	rsp, err := c.ApiTokenCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseApiTokenCreateResponse(rsp)
 }
 
-type WorkflowScheduledDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+// AuditLogListWithResponse request returning *AuditLogListResponse
+func (c *ClientWithResponses) AuditLogListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*AuditLogListResponse, error) {
+	rsp, err := c.AuditLogList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuditLogListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowScheduledDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AuditLogGetSettingsWithResponse request returning *AuditLogGetSettingsResponse
+func (c *ClientWithResponses) AuditLogGetSettingsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AuditLogGetSettingsResponse, error) {
+	rsp, err := c.AuditLogGetSettings(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAuditLogGetSettingsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AuditLogUpdateSettingsWithBodyWithResponse request with arbitrary body returning *AuditLogUpdateSettingsResponse
+func (c *ClientWithResponses) AuditLogUpdateSettingsWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuditLogUpdateSettingsResponse, error) {
+	rsp, err := c.AuditLogUpdateSettingsWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAuditLogUpdateSettingsResponse(rsp)
 }
 
-type WorkflowScheduledGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) AuditLogUpdateSettingsWithResponse(ctx context.Context, tenant openapi_types.UUID, body AuditLogUpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*AuditLogUpdateSettingsResponse, error) {
+	rsp, err := c.AuditLogUpdateSettings(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuditLogUpdateSettingsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowScheduledGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunListDeadLettersWithResponse request returning *StepRunListDeadLettersResponse
+func (c *ClientWithResponses) StepRunListDeadLettersWithResponse(ctx context.Context, tenant openapi_types.UUID, params *StepRunListDeadLettersParams, reqEditors ...RequestEditorFn) (*StepRunListDeadLettersResponse, error) {
+	rsp, err := c.StepRunListDeadLetters(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunListDeadLettersResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunDeleteDeadLetterWithResponse request returning *StepRunDeleteDeadLetterResponse
+func (c *ClientWithResponses) StepRunDeleteDeadLetterWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunDeleteDeadLetterResponse, error) {
+	rsp, err := c.StepRunDeleteDeadLetter(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunDeleteDeadLetterResponse(rsp)
 }
 
-type CronWorkflowTriggerCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CronWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
+// StepRunUpdateRequeueWithResponse request returning *StepRunUpdateRequeueResponse
+func (c *ClientWithResponses) StepRunUpdateRequeueWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateRequeueResponse, error) {
+	rsp, err := c.StepRunUpdateRequeue(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStepRunUpdateRequeueResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CronWorkflowTriggerCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventListWithResponse request returning *EventListResponse
+func (c *ClientWithResponses) EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error) {
+	rsp, err := c.EventList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CronWorkflowTriggerCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EventCreateWithBodyWithResponse request with arbitrary body returning *EventCreateResponse
+func (c *ClientWithResponses) EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
+	rsp, err := c.EventCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventCreateResponse(rsp)
 }
 
-type ScheduledWorkflowRunCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
+func (c *ClientWithResponses) EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
+	rsp, err := c.EventCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ScheduledWorkflowRunCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventCreateBulkWithBodyWithResponse request with arbitrary body returning *EventCreateBulkResponse
+func (c *ClientWithResponses) EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
+	rsp, err := c.EventCreateBulkWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventCreateBulkResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ScheduledWorkflowRunCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
+	rsp, err := c.EventCreateBulk(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventCreateBulkResponse(rsp)
 }
 
-type WorkflowGetWorkersCountResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowWorkersCount
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
+func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateCancelResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetWorkersCountResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventUpdateCancelResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetWorkersCountResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EventKeyListWithResponse request returning *EventKeyListResponse
+func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
+	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventKeyListResponse(rsp)
 }
 
-type UserGetCurrentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
+func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateReplayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserGetCurrentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventUpdateReplayResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserGetCurrentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantInviteListWithResponse request returning *TenantInviteListResponse
+func (c *ClientWithResponses) TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error) {
+	rsp, err := c.TenantInviteList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteListResponse(rsp)
 }
 
-type UserUpdateGithubOauthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// TenantInviteCreateWithBodyWithResponse request with arbitrary body returning *TenantInviteCreateResponse
+func (c *ClientWithResponses) TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
+	rsp, err := c.TenantInviteCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantInviteCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGithubOauthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
+	rsp, err := c.TenantInviteCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantInviteCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGithubOauthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantInviteDeleteWithResponse request returning *TenantInviteDeleteResponse
+func (c *ClientWithResponses) TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error) {
+	rsp, err := c.TenantInviteDelete(ctx, tenant, tenantInvite, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type UserUpdateGithubOauthStartResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return ParseTenantInviteDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGithubOauthStartResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantInviteUpdateWithBodyWithResponse request with arbitrary body returning *TenantInviteUpdateResponse
+func (c *ClientWithResponses) TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
+	rsp, err := c.TenantInviteUpdateWithBody(ctx, tenant, tenantInvite, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantInviteUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGithubOauthStartResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
+	rsp, err := c.TenantInviteUpdate(ctx, tenant, tenantInvite, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteUpdateResponse(rsp)
 }
 
-type UserUpdateGoogleOauthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// TenantMemberListWithResponse request returning *TenantMemberListResponse
+func (c *ClientWithResponses) TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error) {
+	rsp, err := c.TenantMemberList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantMemberListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGoogleOauthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantMemberDeleteWithResponse request returning *TenantMemberDeleteResponse
+func (c *ClientWithResponses) TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error) {
+	rsp, err := c.TenantMemberDelete(ctx, tenant, member, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantMemberDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGoogleOauthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantMemberUpdateRoleWithBodyWithResponse request with arbitrary body returning *TenantMemberUpdateRoleResponse
+func (c *ClientWithResponses) TenantMemberUpdateRoleWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantMemberUpdateRoleResponse, error) {
+	rsp, err := c.TenantMemberUpdateRoleWithBody(ctx, tenant, member, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantMemberUpdateRoleResponse(rsp)
 }
 
-type UserUpdateGoogleOauthStartResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) TenantMemberUpdateRoleWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantMemberUpdateRoleResponse, error) {
+	rsp, err := c.TenantMemberUpdateRole(ctx, tenant, member, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantMemberUpdateRoleResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGoogleOauthStartResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantGetQueueMetricsWithResponse request returning *TenantGetQueueMetricsResponse
+func (c *ClientWithResponses) TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error) {
+	rsp, err := c.TenantGetQueueMetrics(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantGetQueueMetricsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGoogleOauthStartResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// RateLimitListWithResponse request returning *RateLimitListResponse
+func (c *ClientWithResponses) RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error) {
+	rsp, err := c.RateLimitList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRateLimitListResponse(rsp)
 }
 
-type UserListTenantInvitesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TenantInviteList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// TenantResourcePolicyGetWithResponse request returning *TenantResourcePolicyGetResponse
+func (c *ClientWithResponses) TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error) {
+	rsp, err := c.TenantResourcePolicyGet(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantResourcePolicyGetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserListTenantInvitesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantResourcePolicyUpdateWithBodyWithResponse request with arbitrary body returning *TenantResourcePolicyUpdateResponse
+func (c *ClientWithResponses) TenantResourcePolicyUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantResourcePolicyUpdateResponse, error) {
+	rsp, err := c.TenantResourcePolicyUpdateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantResourcePolicyUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListTenantInvitesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantResourcePolicyUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantResourcePolicyUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantResourcePolicyUpdateResponse, error) {
+	rsp, err := c.TenantResourcePolicyUpdate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantResourcePolicyUpdateResponse(rsp)
 }
 
-type TenantInviteAcceptResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+// TenantRoleListWithResponse request returning *TenantRoleListResponse
+func (c *ClientWithResponses) TenantRoleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleListResponse, error) {
+	rsp, err := c.TenantRoleList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantRoleListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantInviteAcceptResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantRoleCreateWithBodyWithResponse request with arbitrary body returning *TenantRoleCreateResponse
+func (c *ClientWithResponses) TenantRoleCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error) {
+	rsp, err := c.TenantRoleCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantRoleCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteAcceptResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantRoleCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error) {
+	rsp, err := c.TenantRoleCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantRoleCreateResponse(rsp)
 }
 
-type TenantInviteRejectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+// TenantRoleDeleteWithResponse request returning *TenantRoleDeleteResponse
+func (c *ClientWithResponses) TenantRoleDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleDeleteResponse, error) {
+	rsp, err := c.TenantRoleDelete(ctx, tenant, tenantRole, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantRoleDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantInviteRejectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantRoleUpdateWithBodyWithResponse request with arbitrary body returning *TenantRoleUpdateResponse
+func (c *ClientWithResponses) TenantRoleUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error) {
+	rsp, err := c.TenantRoleUpdateWithBody(ctx, tenant, tenantRole, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantRoleUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteRejectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantRoleUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error) {
+	rsp, err := c.TenantRoleUpdate(ctx, tenant, tenantRole, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantRoleUpdateResponse(rsp)
 }
 
-type UserUpdateLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// SlackWebhookListWithResponse request returning *SlackWebhookListResponse
+func (c *ClientWithResponses) SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error) {
+	rsp, err := c.SlackWebhookList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSlackWebhookListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UserUpdateSlackOauthStartWithResponse request returning *UserUpdateSlackOauthStartResponse
+func (c *ClientWithResponses) UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error) {
+	rsp, err := c.UserUpdateSlackOauthStart(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserUpdateSlackOauthStartResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SnsListWithResponse request returning *SnsListResponse
+func (c *ClientWithResponses) SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error) {
+	rsp, err := c.SnsList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSnsListResponse(rsp)
 }
 
-type UserUpdateLogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// SnsCreateWithBodyWithResponse request with arbitrary body returning *SnsCreateResponse
+func (c *ClientWithResponses) SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
+	rsp, err := c.SnsCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnsCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateLogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
+	rsp, err := c.SnsCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSnsCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateLogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantGetStepRunQueueMetricsWithResponse request returning *TenantGetStepRunQueueMetricsResponse
+func (c *ClientWithResponses) TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error) {
+	rsp, err := c.TenantGetStepRunQueueMetrics(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantGetStepRunQueueMetricsResponse(rsp)
 }
 
-type TenantMembershipsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserTenantMembershipsList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// StepRunGetWithResponse request returning *StepRunGetResponse
+func (c *ClientWithResponses) StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error) {
+	rsp, err := c.StepRunGet(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStepRunGetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantMembershipsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunUpdateCancelWithResponse request returning *StepRunUpdateCancelResponse
+func (c *ClientWithResponses) StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error) {
+	rsp, err := c.StepRunUpdateCancel(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunUpdateCancelResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantMembershipsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunUpdateRerunWithBodyWithResponse request with arbitrary body returning *StepRunUpdateRerunResponse
+func (c *ClientWithResponses) StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
+	rsp, err := c.StepRunUpdateRerunWithBody(ctx, tenant, stepRun, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunUpdateRerunResponse(rsp)
 }
 
-type UserUpdatePasswordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+func (c *ClientWithResponses) StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
+	rsp, err := c.StepRunUpdateRerun(ctx, tenant, stepRun, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStepRunUpdateRerunResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdatePasswordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunGetSchemaWithResponse request returning *StepRunGetSchemaResponse
+func (c *ClientWithResponses) StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error) {
+	rsp, err := c.StepRunGetSchema(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunGetSchemaResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdatePasswordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WebhookSubscriptionListWithResponse request returning *WebhookSubscriptionListResponse
+func (c *ClientWithResponses) WebhookSubscriptionListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionListResponse, error) {
+	rsp, err := c.WebhookSubscriptionList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWebhookSubscriptionListResponse(rsp)
 }
 
-type UserCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// WebhookSubscriptionCreateWithBodyWithResponse request with arbitrary body returning *WebhookSubscriptionCreateResponse
+func (c *ClientWithResponses) WebhookSubscriptionCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error) {
+	rsp, err := c.WebhookSubscriptionCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) WebhookSubscriptionCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error) {
+	rsp, err := c.WebhookSubscriptionCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWebhookSubscriptionCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WebhookListWithResponse request returning *WebhookListResponse
+func (c *ClientWithResponses) WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error) {
+	rsp, err := c.WebhookList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWebhookListResponse(rsp)
 }
 
-type UserUpdateSlackOauthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// WebhookCreateWithBodyWithResponse request with arbitrary body returning *WebhookCreateResponse
+func (c *ClientWithResponses) WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
+	rsp, err := c.WebhookCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateSlackOauthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
+	rsp, err := c.WebhookCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWebhookCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateSlackOauthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkerListWithResponse request returning *WorkerListResponse
+func (c *ClientWithResponses) WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error) {
+	rsp, err := c.WorkerList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkerListResponse(rsp)
 }
 
-type WebhookDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// WorkflowRunSearchWithResponse request returning *WorkflowRunSearchResponse
+func (c *ClientWithResponses) WorkflowRunSearchWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunSearchParams, reqEditors ...RequestEditorFn) (*WorkflowRunSearchResponse, error) {
+	rsp, err := c.WorkflowRunSearch(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunSearchResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunUpdateCancelWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateCancelResponse
+func (c *ClientWithResponses) WorkflowRunUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateCancelResponse, error) {
+	rsp, err := c.WorkflowRunUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunUpdateCancelResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) WorkflowRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateCancelResponse, error) {
+	rsp, err := c.WorkflowRunUpdateCancel(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunUpdateCancelResponse(rsp)
 }
 
-type WebhookRequestsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerRequestListResponse
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// WorkflowRunUpdateReplayWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateReplayResponse
+func (c *ClientWithResponses) WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunUpdateReplayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookRequestsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunUpdateReplayResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookRequestsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunGetWithResponse request returning *WorkflowRunGetResponse
+func (c *ClientWithResponses) WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error) {
+	rsp, err := c.WorkflowRunGet(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunGetResponse(rsp)
 }
 
-type WorkerGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WorkflowRunGetInputWithResponse request returning *WorkflowRunGetInputResponse
+func (c *ClientWithResponses) WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error) {
+	rsp, err := c.WorkflowRunGetInput(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunGetInputResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunGetShapeWithResponse request returning *WorkflowRunGetShapeResponse
+func (c *ClientWithResponses) WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error) {
+	rsp, err := c.WorkflowRunGetShape(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunGetShapeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunListStepRunEventsWithResponse request returning *WorkflowRunListStepRunEventsResponse
+func (c *ClientWithResponses) WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error) {
+	rsp, err := c.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunListStepRunEventsResponse(rsp)
 }
 
-type WorkerUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WorkflowListWithResponse request returning *WorkflowListResponse
+func (c *ClientWithResponses) WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error) {
+	rsp, err := c.WorkflowList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunCancelWithBodyWithResponse request with arbitrary body returning *WorkflowRunCancelResponse
+func (c *ClientWithResponses) WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
+	rsp, err := c.WorkflowRunCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunCancelResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
+	rsp, err := c.WorkflowRunCancel(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunCancelResponse(rsp)
 }
 
-type WorkflowDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// CronWorkflowListWithResponse request returning *CronWorkflowListResponse
+func (c *ClientWithResponses) CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error) {
+	rsp, err := c.CronWorkflowList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCronWorkflowListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowCronDeleteWithResponse request returning *WorkflowCronDeleteResponse
+func (c *ClientWithResponses) WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error) {
+	rsp, err := c.WorkflowCronDelete(ctx, tenant, cronWorkflow, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowCronDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowCronGetWithResponse request returning *WorkflowCronGetResponse
+func (c *ClientWithResponses) WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error) {
+	rsp, err := c.WorkflowCronGet(ctx, tenant, cronWorkflow, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowCronGetResponse(rsp)
 }
 
-type WorkflowGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Workflow
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// WorkflowRunListWithResponse request returning *WorkflowRunListResponse
+func (c *ClientWithResponses) WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error) {
+	rsp, err := c.WorkflowRunList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunGetMetricsWithResponse request returning *WorkflowRunGetMetricsResponse
+func (c *ClientWithResponses) WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error) {
+	rsp, err := c.WorkflowRunGetMetrics(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunGetMetricsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowScheduledListWithResponse request returning *WorkflowScheduledListResponse
+func (c *ClientWithResponses) WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error) {
+	rsp, err := c.WorkflowScheduledList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowScheduledListResponse(rsp)
 }
 
-type WorkflowUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Workflow
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WorkflowScheduledDeleteWithResponse request returning *WorkflowScheduledDeleteResponse
+func (c *ClientWithResponses) WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error) {
+	rsp, err := c.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowScheduledDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowScheduledGetWithResponse request returning *WorkflowScheduledGetResponse
+func (c *ClientWithResponses) WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error) {
+	rsp, err := c.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowScheduledGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CronWorkflowTriggerCreateWithBodyWithResponse request with arbitrary body returning *CronWorkflowTriggerCreateResponse
+func (c *ClientWithResponses) CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
+	rsp, err := c.CronWorkflowTriggerCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCronWorkflowTriggerCreateResponse(rsp)
 }
 
-type WorkflowGetMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
+	rsp, err := c.CronWorkflowTriggerCreate(ctx, tenant, workflow, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCronWorkflowTriggerCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ScheduledWorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *ScheduledWorkflowRunCreateResponse
+func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
+	rsp, err := c.ScheduledWorkflowRunCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseScheduledWorkflowRunCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
+	rsp, err := c.ScheduledWorkflowRunCreate(ctx, tenant, workflow, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseScheduledWorkflowRunCreateResponse(rsp)
 }
 
-type WorkflowRunCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
+// WorkflowGetWorkersCountWithResponse request returning *WorkflowGetWorkersCountResponse
+func (c *ClientWithResponses) WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error) {
+	rsp, err := c.WorkflowGetWorkersCount(ctx, tenant, workflow, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowGetWorkersCountResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UserGetCurrentWithResponse request returning *UserGetCurrentResponse
+func (c *ClientWithResponses) UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error) {
+	rsp, err := c.UserGetCurrent(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserGetCurrentResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserUpdateGithubOauthCallbackWithResponse request returning *UserUpdateGithubOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateGithubOauthCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserUpdateGithubOauthCallbackResponse(rsp)
 }
 
-type WorkflowVersionGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowVersion
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// UserUpdateGithubOauthStartWithResponse request returning *UserUpdateGithubOauthStartResponse
+func (c *ClientWithResponses) UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error) {
+	rsp, err := c.UserUpdateGithubOauthStart(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateGithubOauthStartResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowVersionGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UserUpdateGoogleOauthCallbackWithResponse request returning *UserUpdateGoogleOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateGoogleOauthCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserUpdateGoogleOauthCallbackResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowVersionGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserUpdateGoogleOauthStartWithResponse request returning *UserUpdateGoogleOauthStartResponse
+func (c *ClientWithResponses) UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error) {
+	rsp, err := c.UserUpdateGoogleOauthStart(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserUpdateGoogleOauthStartResponse(rsp)
 }
 
-// LivenessGetWithResponse request returning *LivenessGetResponse
-func (c *ClientWithResponses) LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error) {
-	rsp, err := c.LivenessGet(ctx, reqEditors...)
+// UserListTenantInvitesWithResponse request returning *UserListTenantInvitesResponse
+func (c *ClientWithResponses) UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error) {
+	rsp, err := c.UserListTenantInvites(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLivenessGetResponse(rsp)
+	return ParseUserListTenantInvitesResponse(rsp)
 }
 
-// ReadinessGetWithResponse request returning *ReadinessGetResponse
-func (c *ClientWithResponses) ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error) {
-	rsp, err := c.ReadinessGet(ctx, reqEditors...)
+// TenantInviteAcceptWithBodyWithResponse request with arbitrary body returning *TenantInviteAcceptResponse
+func (c *ClientWithResponses) TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
+	rsp, err := c.TenantInviteAcceptWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseReadinessGetResponse(rsp)
+	return ParseTenantInviteAcceptResponse(rsp)
 }
 
-// AlertEmailGroupDeleteWithResponse request returning *AlertEmailGroupDeleteResponse
-func (c *ClientWithResponses) AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error) {
-	rsp, err := c.AlertEmailGroupDelete(ctx, alertEmailGroup, reqEditors...)
+func (c *ClientWithResponses) TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
+	rsp, err := c.TenantInviteAccept(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupDeleteResponse(rsp)
+	return ParseTenantInviteAcceptResponse(rsp)
 }
 
-// AlertEmailGroupUpdateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupUpdateResponse
-func (c *ClientWithResponses) AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
-	rsp, err := c.AlertEmailGroupUpdateWithBody(ctx, alertEmailGroup, contentType, body, reqEditors...)
+// TenantInviteRejectWithBodyWithResponse request with arbitrary body returning *TenantInviteRejectResponse
+func (c *ClientWithResponses) TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
+	rsp, err := c.TenantInviteRejectWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupUpdateResponse(rsp)
+	return ParseTenantInviteRejectResponse(rsp)
 }
 
-func (c *ClientWithResponses) AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
-	rsp, err := c.AlertEmailGroupUpdate(ctx, alertEmailGroup, body, reqEditors...)
+func (c *ClientWithResponses) TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
+	rsp, err := c.TenantInviteReject(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupUpdateResponse(rsp)
+	return ParseTenantInviteRejectResponse(rsp)
 }
 
-// ApiTokenUpdateRevokeWithResponse request returning *ApiTokenUpdateRevokeResponse
-func (c *ClientWithResponses) ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error) {
-	rsp, err := c.ApiTokenUpdateRevoke(ctx, apiToken, reqEditors...)
+// UserUpdateLoginWithBodyWithResponse request with arbitrary body returning *UserUpdateLoginResponse
+func (c *ClientWithResponses) UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
+	rsp, err := c.UserUpdateLoginWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenUpdateRevokeResponse(rsp)
+	return ParseUserUpdateLoginResponse(rsp)
 }
 
-// CloudMetadataGetWithResponse request returning *CloudMetadataGetResponse
-func (c *ClientWithResponses) CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error) {
-	rsp, err := c.CloudMetadataGet(ctx, reqEditors...)
+func (c *ClientWithResponses) UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
+	rsp, err := c.UserUpdateLogin(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCloudMetadataGetResponse(rsp)
+	return ParseUserUpdateLoginResponse(rsp)
 }
 
-// EventGetWithResponse request returning *EventGetResponse
-func (c *ClientWithResponses) EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error) {
-	rsp, err := c.EventGet(ctx, event, reqEditors...)
+// UserUpdateLogoutWithResponse request returning *UserUpdateLogoutResponse
+func (c *ClientWithResponses) UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error) {
+	rsp, err := c.UserUpdateLogout(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventGetResponse(rsp)
+	return ParseUserUpdateLogoutResponse(rsp)
 }
 
-// EventDataGetWithResponse request returning *EventDataGetResponse
-func (c *ClientWithResponses) EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error) {
-	rsp, err := c.EventDataGet(ctx, event, reqEditors...)
+// TenantMembershipsListWithResponse request returning *TenantMembershipsListResponse
+func (c *ClientWithResponses) TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error) {
+	rsp, err := c.TenantMembershipsList(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventDataGetResponse(rsp)
+	return ParseTenantMembershipsListResponse(rsp)
 }
 
-// MetadataGetWithResponse request returning *MetadataGetResponse
-func (c *ClientWithResponses) MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error) {
-	rsp, err := c.MetadataGet(ctx, reqEditors...)
+// UserUpdateOauthCallbackWithResponse request returning *UserUpdateOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateOauthCallbackWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*UserUpdateOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateOauthCallback(ctx, provider, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataGetResponse(rsp)
+	return ParseUserUpdateOauthCallbackResponse(rsp)
 }
 
-// MetadataListIntegrationsWithResponse request returning *MetadataListIntegrationsResponse
-func (c *ClientWithResponses) MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error) {
-	rsp, err := c.MetadataListIntegrations(ctx, reqEditors...)
+// UserUpdateOauthStartWithResponse request returning *UserUpdateOauthStartResponse
+func (c *ClientWithResponses) UserUpdateOauthStartWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*UserUpdateOauthStartResponse, error) {
+	rsp, err := c.UserUpdateOauthStart(ctx, provider, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataListIntegrationsResponse(rsp)
+	return ParseUserUpdateOauthStartResponse(rsp)
 }
 
-// MonitoringPostRunProbeWithResponse request returning *MonitoringPostRunProbeResponse
-func (c *ClientWithResponses) MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error) {
-	rsp, err := c.MonitoringPostRunProbe(ctx, tenant, reqEditors...)
+// UserUpdatePasswordWithBodyWithResponse request with arbitrary body returning *UserUpdatePasswordResponse
+func (c *ClientWithResponses) UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
+	rsp, err := c.UserUpdatePasswordWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMonitoringPostRunProbeResponse(rsp)
+	return ParseUserUpdatePasswordResponse(rsp)
 }
 
-// SlackWebhookDeleteWithResponse request returning *SlackWebhookDeleteResponse
-func (c *ClientWithResponses) SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error) {
-	rsp, err := c.SlackWebhookDelete(ctx, slack, reqEditors...)
+func (c *ClientWithResponses) UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
+	rsp, err := c.UserUpdatePassword(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSlackWebhookDeleteResponse(rsp)
+	return ParseUserUpdatePasswordResponse(rsp)
 }
 
-// SnsDeleteWithResponse request returning *SnsDeleteResponse
-func (c *ClientWithResponses) SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error) {
-	rsp, err := c.SnsDelete(ctx, sns, reqEditors...)
+// UserCreateWithBodyWithResponse request with arbitrary body returning *UserCreateResponse
+func (c *ClientWithResponses) UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
+	rsp, err := c.UserCreateWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsDeleteResponse(rsp)
+	return ParseUserCreateResponse(rsp)
 }
 
-// SnsUpdateWithResponse request returning *SnsUpdateResponse
-func (c *ClientWithResponses) SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error) {
-	rsp, err := c.SnsUpdate(ctx, tenant, event, reqEditors...)
+func (c *ClientWithResponses) UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
+	rsp, err := c.UserCreate(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsUpdateResponse(rsp)
+	return ParseUserCreateResponse(rsp)
 }
 
-// StepRunListArchivesWithResponse request returning *StepRunListArchivesResponse
-func (c *ClientWithResponses) StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error) {
-	rsp, err := c.StepRunListArchives(ctx, stepRun, params, reqEditors...)
+// UserUpdateSamlCallbackWithBodyWithResponse request with arbitrary body returning *UserUpdateSamlCallbackResponse
+func (c *ClientWithResponses) UserUpdateSamlCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateSamlCallbackResponse, error) {
+	rsp, err := c.UserUpdateSamlCallbackWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunListArchivesResponse(rsp)
+	return ParseUserUpdateSamlCallbackResponse(rsp)
 }
 
-// StepRunListEventsWithResponse request returning *StepRunListEventsResponse
-func (c *ClientWithResponses) StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error) {
-	rsp, err := c.StepRunListEvents(ctx, stepRun, params, reqEditors...)
+func (c *ClientWithResponses) UserUpdateSamlCallbackWithFormdataBodyWithResponse(ctx context.Context, body UserUpdateSamlCallbackFormdataRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateSamlCallbackResponse, error) {
+	rsp, err := c.UserUpdateSamlCallbackWithFormdataBody(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunListEventsResponse(rsp)
+	return ParseUserUpdateSamlCallbackResponse(rsp)
 }
 
-// LogLineListWithResponse request returning *LogLineListResponse
-func (c *ClientWithResponses) LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error) {
-	rsp, err := c.LogLineList(ctx, stepRun, params, reqEditors...)
+// UserGetSamlMetadataWithResponse request returning *UserGetSamlMetadataResponse
+func (c *ClientWithResponses) UserGetSamlMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetSamlMetadataResponse, error) {
+	rsp, err := c.UserGetSamlMetadata(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLogLineListResponse(rsp)
+	return ParseUserGetSamlMetadataResponse(rsp)
 }
 
-// TenantCreateWithBodyWithResponse request with arbitrary body returning *TenantCreateResponse
-func (c *ClientWithResponses) TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
-	rsp, err := c.TenantCreateWithBody(ctx, contentType, body, reqEditors...)
+// UserUpdateSamlStartWithResponse request returning *UserUpdateSamlStartResponse
+func (c *ClientWithResponses) UserUpdateSamlStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSamlStartResponse, error) {
+	rsp, err := c.UserUpdateSamlStart(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantCreateResponse(rsp)
+	return ParseUserUpdateSamlStartResponse(rsp)
 }
 
-func (c *ClientWithResponses) TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
-	rsp, err := c.TenantCreate(ctx, body, reqEditors...)
+// UserUpdateSlackOauthCallbackWithResponse request returning *UserUpdateSlackOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateSlackOauthCallback(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantCreateResponse(rsp)
+	return ParseUserUpdateSlackOauthCallbackResponse(rsp)
 }
 
-// TenantUpdateWithBodyWithResponse request with arbitrary body returning *TenantUpdateResponse
-func (c *ClientWithResponses) TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
-	rsp, err := c.TenantUpdateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// WebhookDeliveryResendWithResponse request returning *WebhookDeliveryResendResponse
+func (c *ClientWithResponses) WebhookDeliveryResendWithResponse(ctx context.Context, webhookDelivery openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeliveryResendResponse, error) {
+	rsp, err := c.WebhookDeliveryResend(ctx, webhookDelivery, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantUpdateResponse(rsp)
+	return ParseWebhookDeliveryResendResponse(rsp)
 }
 
-func (c *ClientWithResponses) TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
-	rsp, err := c.TenantUpdate(ctx, tenant, body, reqEditors...)
+// WebhookSubscriptionDeleteWithResponse request returning *WebhookSubscriptionDeleteResponse
+func (c *ClientWithResponses) WebhookSubscriptionDeleteWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionDeleteResponse, error) {
+	rsp, err := c.WebhookSubscriptionDelete(ctx, webhookSubscription, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantUpdateResponse(rsp)
+	return ParseWebhookSubscriptionDeleteResponse(rsp)
 }
 
-// AlertEmailGroupListWithResponse request returning *AlertEmailGroupListResponse
-func (c *ClientWithResponses) AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error) {
-	rsp, err := c.AlertEmailGroupList(ctx, tenant, reqEditors...)
+// WebhookSubscriptionUpdateWithBodyWithResponse request with arbitrary body returning *WebhookSubscriptionUpdateResponse
+func (c *ClientWithResponses) WebhookSubscriptionUpdateWithBodyWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error) {
+	rsp, err := c.WebhookSubscriptionUpdateWithBody(ctx, webhookSubscription, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupListResponse(rsp)
+	return ParseWebhookSubscriptionUpdateResponse(rsp)
 }
 
-// AlertEmailGroupCreateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupCreateResponse
-func (c *ClientWithResponses) AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
-	rsp, err := c.AlertEmailGroupCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+func (c *ClientWithResponses) WebhookSubscriptionUpdateWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error) {
+	rsp, err := c.WebhookSubscriptionUpdate(ctx, webhookSubscription, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupCreateResponse(rsp)
+	return ParseWebhookSubscriptionUpdateResponse(rsp)
 }
 
-func (c *ClientWithResponses) AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
-	rsp, err := c.AlertEmailGroupCreate(ctx, tenant, body, reqEditors...)
+// WebhookDeliveryListWithResponse request returning *WebhookDeliveryListResponse
+func (c *ClientWithResponses) WebhookDeliveryListWithResponse(ctx context.Context, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*WebhookDeliveryListResponse, error) {
+	rsp, err := c.WebhookDeliveryList(ctx, webhookSubscription, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupCreateResponse(rsp)
+	return ParseWebhookDeliveryListResponse(rsp)
 }
 
-// TenantAlertingSettingsGetWithResponse request returning *TenantAlertingSettingsGetResponse
-func (c *ClientWithResponses) TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error) {
-	rsp, err := c.TenantAlertingSettingsGet(ctx, tenant, reqEditors...)
+// WebhookDeleteWithResponse request returning *WebhookDeleteResponse
+func (c *ClientWithResponses) WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error) {
+	rsp, err := c.WebhookDelete(ctx, webhook, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantAlertingSettingsGetResponse(rsp)
+	return ParseWebhookDeleteResponse(rsp)
 }
 
-// ApiTokenListWithResponse request returning *ApiTokenListResponse
-func (c *ClientWithResponses) ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error) {
-	rsp, err := c.ApiTokenList(ctx, tenant, reqEditors...)
+// WebhookRequestsListWithResponse request returning *WebhookRequestsListResponse
+func (c *ClientWithResponses) WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error) {
+	rsp, err := c.WebhookRequestsList(ctx, webhook, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenListResponse(rsp)
+	return ParseWebhookRequestsListResponse(rsp)
 }
 
-// ApiTokenCreateWithBodyWithResponse request with arbitrary body returning *ApiTokenCreateResponse
-func (c *ClientWithResponses) ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
-	rsp, err := c.ApiTokenCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// WorkerGetWithResponse request returning *WorkerGetResponse
+func (c *ClientWithResponses) WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error) {
+	rsp, err := c.WorkerGet(ctx, worker, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenCreateResponse(rsp)
+	return ParseWorkerGetResponse(rsp)
 }
 
-func (c *ClientWithResponses) ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
-	rsp, err := c.ApiTokenCreate(ctx, tenant, body, reqEditors...)
+// WorkerUpdateWithBodyWithResponse request with arbitrary body returning *WorkerUpdateResponse
+func (c *ClientWithResponses) WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
+	rsp, err := c.WorkerUpdateWithBody(ctx, worker, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenCreateResponse(rsp)
+	return ParseWorkerUpdateResponse(rsp)
 }
 
-// EventListWithResponse request returning *EventListResponse
-func (c *ClientWithResponses) EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error) {
-	rsp, err := c.EventList(ctx, tenant, params, reqEditors...)
+func (c *ClientWithResponses) WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
+	rsp, err := c.WorkerUpdate(ctx, worker, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventListResponse(rsp)
+	return ParseWorkerUpdateResponse(rsp)
 }
 
-// EventCreateWithBodyWithResponse request with arbitrary body returning *EventCreateResponse
-func (c *ClientWithResponses) EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
-	rsp, err := c.EventCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// WorkflowDeleteWithResponse request returning *WorkflowDeleteResponse
+func (c *ClientWithResponses) WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error) {
+	rsp, err := c.WorkflowDelete(ctx, workflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateResponse(rsp)
+	return ParseWorkflowDeleteResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
-	rsp, err := c.EventCreate(ctx, tenant, body, reqEditors...)
+// WorkflowGetWithResponse request returning *WorkflowGetResponse
+func (c *ClientWithResponses) WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error) {
+	rsp, err := c.WorkflowGet(ctx, workflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateResponse(rsp)
+	return ParseWorkflowGetResponse(rsp)
 }
 
-// EventCreateBulkWithBodyWithResponse request with arbitrary body returning *EventCreateBulkResponse
-func (c *ClientWithResponses) EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
-	rsp, err := c.EventCreateBulkWithBody(ctx, tenant, contentType, body, reqEditors...)
+// WorkflowUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowUpdateResponse
+func (c *ClientWithResponses) WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
+	rsp, err := c.WorkflowUpdateWithBody(ctx, workflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateBulkResponse(rsp)
+	return ParseWorkflowUpdateResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
-	rsp, err := c.EventCreateBulk(ctx, tenant, body, reqEditors...)
+func (c *ClientWithResponses) WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
+	rsp, err := c.WorkflowUpdate(ctx, workflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateBulkResponse(rsp)
+	return ParseWorkflowUpdateResponse(rsp)
 }
 
-// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
-func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+// WorkflowGetMetricsWithResponse request returning *WorkflowGetMetricsResponse
+func (c *ClientWithResponses) WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error) {
+	rsp, err := c.WorkflowGetMetrics(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateCancelResponse(rsp)
+	return ParseWorkflowGetMetricsResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
+// WorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *WorkflowRunCreateResponse
+func (c *ClientWithResponses) WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
+	rsp, err := c.WorkflowRunCreateWithBody(ctx, workflow, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateCancelResponse(rsp)
+	return ParseWorkflowRunCreateResponse(rsp)
 }
 
-// EventKeyListWithResponse request returning *EventKeyListResponse
-func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
-	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+func (c *ClientWithResponses) WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
+	rsp, err := c.WorkflowRunCreate(ctx, workflow, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventKeyListResponse(rsp)
+	return ParseWorkflowRunCreateResponse(rsp)
 }
 
-// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
-func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+// WorkflowVersionWeightsGetWithResponse request returning *WorkflowVersionWeightsGetResponse
+func (c *ClientWithResponses) WorkflowVersionWeightsGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionWeightsGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionWeightsGetResponse, error) {
+	rsp, err := c.WorkflowVersionWeightsGet(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateReplayResponse(rsp)
+	return ParseWorkflowVersionWeightsGetResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
+// WorkflowVersionWeightsUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowVersionWeightsUpdateResponse
+func (c *ClientWithResponses) WorkflowVersionWeightsUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowVersionWeightsUpdateResponse, error) {
+	rsp, err := c.WorkflowVersionWeightsUpdateWithBody(ctx, workflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateReplayResponse(rsp)
+	return ParseWorkflowVersionWeightsUpdateResponse(rsp)
 }
 
-// TenantInviteListWithResponse request returning *TenantInviteListResponse
-func (c *ClientWithResponses) TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error) {
-	rsp, err := c.TenantInviteList(ctx, tenant, reqEditors...)
+func (c *ClientWithResponses) WorkflowVersionWeightsUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowVersionWeightsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowVersionWeightsUpdateResponse, error) {
+	rsp, err := c.WorkflowVersionWeightsUpdate(ctx, workflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteListResponse(rsp)
+	return ParseWorkflowVersionWeightsUpdateResponse(rsp)
 }
 
-// TenantInviteCreateWithBodyWithResponse request with arbitrary body returning *TenantInviteCreateResponse
-func (c *ClientWithResponses) TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
-	rsp, err := c.TenantInviteCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// WorkflowVersionGetWithResponse request returning *WorkflowVersionGetResponse
+func (c *ClientWithResponses) WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error) {
+	rsp, err := c.WorkflowVersionGet(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteCreateResponse(rsp)
+	return ParseWorkflowVersionGetResponse(rsp)
 }
 
-func (c *ClientWithResponses) TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
-	rsp, err := c.TenantInviteCreate(ctx, tenant, body, reqEditors...)
+// ParseLivenessGetResponse parses an HTTP response from a LivenessGetWithResponse call
+func ParseLivenessGetResponse(rsp *http.Response) (*LivenessGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteCreateResponse(rsp)
+
+	response := &LivenessGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// TenantInviteDeleteWithResponse request returning *TenantInviteDeleteResponse
-func (c *ClientWithResponses) TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error) {
-	rsp, err := c.TenantInviteDelete(ctx, tenant, tenantInvite, reqEditors...)
+// ParseReadinessGetResponse parses an HTTP response from a ReadinessGetWithResponse call
+func ParseReadinessGetResponse(rsp *http.Response) (*ReadinessGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteDeleteResponse(rsp)
+
+	response := &ReadinessGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// TenantInviteUpdateWithBodyWithResponse request with arbitrary body returning *TenantInviteUpdateResponse
-func (c *ClientWithResponses) TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
-	rsp, err := c.TenantInviteUpdateWithBody(ctx, tenant, tenantInvite, contentType, body, reqEditors...)
+// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
+func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteUpdateResponse(rsp)
+
+	response := &AlertEmailGroupDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
-	rsp, err := c.TenantInviteUpdate(ctx, tenant, tenantInvite, body, reqEditors...)
+// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
+func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteUpdateResponse(rsp)
-}
 
-// TenantMemberListWithResponse request returning *TenantMemberListResponse
-func (c *ClientWithResponses) TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error) {
-	rsp, err := c.TenantMemberList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertEmailGroupUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantMemberListResponse(rsp)
-}
 
-// TenantMemberDeleteWithResponse request returning *TenantMemberDeleteResponse
-func (c *ClientWithResponses) TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error) {
-	rsp, err := c.TenantMemberDelete(ctx, tenant, member, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertEmailGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantMemberDeleteResponse(rsp)
+
+	return response, nil
 }
 
-// TenantGetQueueMetricsWithResponse request returning *TenantGetQueueMetricsResponse
-func (c *ClientWithResponses) TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error) {
-	rsp, err := c.TenantGetQueueMetrics(ctx, tenant, params, reqEditors...)
+// ParseAlertMuteRuleDeleteResponse parses an HTTP response from a AlertMuteRuleDeleteWithResponse call
+func ParseAlertMuteRuleDeleteResponse(rsp *http.Response) (*AlertMuteRuleDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantGetQueueMetricsResponse(rsp)
-}
 
-// RateLimitListWithResponse request returning *RateLimitListResponse
-func (c *ClientWithResponses) RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error) {
-	rsp, err := c.RateLimitList(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertMuteRuleDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRateLimitListResponse(rsp)
-}
 
-// TenantResourcePolicyGetWithResponse request returning *TenantResourcePolicyGetResponse
-func (c *ClientWithResponses) TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error) {
-	rsp, err := c.TenantResourcePolicyGet(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantResourcePolicyGetResponse(rsp)
+
+	return response, nil
 }
 
-// SlackWebhookListWithResponse request returning *SlackWebhookListResponse
-func (c *ClientWithResponses) SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error) {
-	rsp, err := c.SlackWebhookList(ctx, tenant, reqEditors...)
+// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
+func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSlackWebhookListResponse(rsp)
-}
 
-// UserUpdateSlackOauthStartWithResponse request returning *UserUpdateSlackOauthStartResponse
-func (c *ClientWithResponses) UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error) {
-	rsp, err := c.UserUpdateSlackOauthStart(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiTokenUpdateRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdateSlackOauthStartResponse(rsp)
-}
 
-// SnsListWithResponse request returning *SnsListResponse
-func (c *ClientWithResponses) SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error) {
-	rsp, err := c.SnsList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseSnsListResponse(rsp)
+
+	return response, nil
 }
 
-// SnsCreateWithBodyWithResponse request with arbitrary body returning *SnsCreateResponse
-func (c *ClientWithResponses) SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
-	rsp, err := c.SnsCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// ParseApiTokenUpdateRotateResponse parses an HTTP response from a ApiTokenUpdateRotateWithResponse call
+func ParseApiTokenUpdateRotateResponse(rsp *http.Response) (*ApiTokenUpdateRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
-	rsp, err := c.SnsCreate(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiTokenUpdateRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSnsCreateResponse(rsp)
-}
 
-// TenantGetStepRunQueueMetricsWithResponse request returning *TenantGetStepRunQueueMetricsResponse
-func (c *ClientWithResponses) TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error) {
-	rsp, err := c.TenantGetStepRunQueueMetrics(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateAPITokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantGetStepRunQueueMetricsResponse(rsp)
+
+	return response, nil
 }
 
-// StepRunGetWithResponse request returning *StepRunGetResponse
-func (c *ClientWithResponses) StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error) {
-	rsp, err := c.StepRunGet(ctx, tenant, stepRun, reqEditors...)
+// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
+func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunGetResponse(rsp)
-}
 
-// StepRunUpdateCancelWithResponse request returning *StepRunUpdateCancelResponse
-func (c *ClientWithResponses) StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error) {
-	rsp, err := c.StepRunUpdateCancel(ctx, tenant, stepRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CloudMetadataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseStepRunUpdateCancelResponse(rsp)
-}
 
-// StepRunUpdateRerunWithBodyWithResponse request with arbitrary body returning *StepRunUpdateRerunResponse
-func (c *ClientWithResponses) StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
-	rsp, err := c.StepRunUpdateRerunWithBody(ctx, tenant, stepRun, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseStepRunUpdateRerunResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
-	rsp, err := c.StepRunUpdateRerun(ctx, tenant, stepRun, body, reqEditors...)
+// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
+func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunUpdateRerunResponse(rsp)
-}
 
-// StepRunGetSchemaWithResponse request returning *StepRunGetSchemaResponse
-func (c *ClientWithResponses) StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error) {
-	rsp, err := c.StepRunGetSchema(ctx, tenant, stepRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseStepRunGetSchemaResponse(rsp)
-}
 
-// WebhookListWithResponse request returning *WebhookListResponse
-func (c *ClientWithResponses) WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error) {
-	rsp, err := c.WebhookList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWebhookListResponse(rsp)
+
+	return response, nil
 }
 
-// WebhookCreateWithBodyWithResponse request with arbitrary body returning *WebhookCreateResponse
-func (c *ClientWithResponses) WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
-	rsp, err := c.WebhookCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
+func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
-	rsp, err := c.WebhookCreate(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventDataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWebhookCreateResponse(rsp)
-}
 
-// WorkerListWithResponse request returning *WorkerListResponse
-func (c *ClientWithResponses) WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error) {
-	rsp, err := c.WorkerList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkerListResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowRunUpdateReplayWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateReplayResponse
-func (c *ClientWithResponses) WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
-	rsp, err := c.WorkflowRunUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+// ParseEventUpdateReplayWithPayloadResponse parses an HTTP response from a EventUpdateReplayWithPayloadWithResponse call
+func ParseEventUpdateReplayWithPayloadResponse(rsp *http.Response) (*EventUpdateReplayWithPayloadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunUpdateReplayResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
-	rsp, err := c.WorkflowRunUpdateReplay(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventUpdateReplayWithPayloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunUpdateReplayResponse(rsp)
-}
 
-// WorkflowRunGetWithResponse request returning *WorkflowRunGetResponse
-func (c *ClientWithResponses) WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error) {
-	rsp, err := c.WorkflowRunGet(ctx, tenant, workflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
-	return ParseWorkflowRunGetResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowRunGetInputWithResponse request returning *WorkflowRunGetInputResponse
-func (c *ClientWithResponses) WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error) {
-	rsp, err := c.WorkflowRunGetInput(ctx, tenant, workflowRun, reqEditors...)
+// ParseEventWorkflowRunListResponse parses an HTTP response from a EventWorkflowRunListWithResponse call
+func ParseEventWorkflowRunListResponse(rsp *http.Response) (*EventWorkflowRunListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunGetInputResponse(rsp)
-}
 
-// WorkflowRunGetShapeWithResponse request returning *WorkflowRunGetShapeResponse
-func (c *ClientWithResponses) WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error) {
-	rsp, err := c.WorkflowRunGetShape(ctx, tenant, workflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventWorkflowRunListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunGetShapeResponse(rsp)
-}
 
-// WorkflowRunListStepRunEventsWithResponse request returning *WorkflowRunListStepRunEventsResponse
-func (c *ClientWithResponses) WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error) {
-	rsp, err := c.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventWorkflowRunList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowRunListStepRunEventsResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowListWithResponse request returning *WorkflowListResponse
-func (c *ClientWithResponses) WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error) {
-	rsp, err := c.WorkflowList(ctx, tenant, params, reqEditors...)
+// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
+func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowListResponse(rsp)
-}
 
-// WorkflowRunCancelWithBodyWithResponse request with arbitrary body returning *WorkflowRunCancelResponse
-func (c *ClientWithResponses) WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
-	rsp, err := c.WorkflowRunCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunCancelResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
-	rsp, err := c.WorkflowRunCancel(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIMeta
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseWorkflowRunCancelResponse(rsp)
+
+	return response, nil
 }
 
-// CronWorkflowListWithResponse request returning *CronWorkflowListResponse
-func (c *ClientWithResponses) CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error) {
-	rsp, err := c.CronWorkflowList(ctx, tenant, params, reqEditors...)
+// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
+func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCronWorkflowListResponse(rsp)
-}
 
-// WorkflowCronDeleteWithResponse request returning *WorkflowCronDeleteResponse
-func (c *ClientWithResponses) WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error) {
-	rsp, err := c.WorkflowCronDelete(ctx, tenant, cronWorkflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataListIntegrationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowCronDeleteResponse(rsp)
-}
 
-// WorkflowCronGetWithResponse request returning *WorkflowCronGetResponse
-func (c *ClientWithResponses) WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error) {
-	rsp, err := c.WorkflowCronGet(ctx, tenant, cronWorkflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAPIMetaIntegration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseWorkflowCronGetResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowRunListWithResponse request returning *WorkflowRunListResponse
-func (c *ClientWithResponses) WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error) {
-	rsp, err := c.WorkflowRunList(ctx, tenant, params, reqEditors...)
+// ParseMonitoringPostRunProbeResponse parses an HTTP response from a MonitoringPostRunProbeWithResponse call
+func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRunProbeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunListResponse(rsp)
-}
 
-// WorkflowRunGetMetricsWithResponse request returning *WorkflowRunGetMetricsResponse
-func (c *ClientWithResponses) WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error) {
-	rsp, err := c.WorkflowRunGetMetrics(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MonitoringPostRunProbeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunGetMetricsResponse(rsp)
-}
 
-// WorkflowScheduledListWithResponse request returning *WorkflowScheduledListResponse
-func (c *ClientWithResponses) WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error) {
-	rsp, err := c.WorkflowScheduledList(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowScheduledListResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowScheduledDeleteWithResponse request returning *WorkflowScheduledDeleteResponse
-func (c *ClientWithResponses) WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error) {
-	rsp, err := c.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun, reqEditors...)
+// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
+func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowScheduledDeleteResponse(rsp)
-}
 
-// WorkflowScheduledGetWithResponse request returning *WorkflowScheduledGetResponse
-func (c *ClientWithResponses) WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error) {
-	rsp, err := c.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SlackWebhookDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowScheduledGetResponse(rsp)
-}
 
-// CronWorkflowTriggerCreateWithBodyWithResponse request with arbitrary body returning *CronWorkflowTriggerCreateResponse
-func (c *ClientWithResponses) CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
-	rsp, err := c.CronWorkflowTriggerCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseCronWorkflowTriggerCreateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
-	rsp, err := c.CronWorkflowTriggerCreate(ctx, tenant, workflow, body, reqEditors...)
+// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
+func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCronWorkflowTriggerCreateResponse(rsp)
-}
 
-// ScheduledWorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *ScheduledWorkflowRunCreateResponse
-func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
-	rsp, err := c.ScheduledWorkflowRunCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SnsDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseScheduledWorkflowRunCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
-	rsp, err := c.ScheduledWorkflowRunCreate(ctx, tenant, workflow, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseScheduledWorkflowRunCreateResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowGetWorkersCountWithResponse request returning *WorkflowGetWorkersCountResponse
-func (c *ClientWithResponses) WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error) {
-	rsp, err := c.WorkflowGetWorkersCount(ctx, tenant, workflow, reqEditors...)
+// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
+func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowGetWorkersCountResponse(rsp)
-}
 
-// UserGetCurrentWithResponse request returning *UserGetCurrentResponse
-func (c *ClientWithResponses) UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error) {
-	rsp, err := c.UserGetCurrent(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SnsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserGetCurrentResponse(rsp)
-}
 
-// UserUpdateGithubOauthCallbackWithResponse request returning *UserUpdateGithubOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateGithubOauthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseUserUpdateGithubOauthCallbackResponse(rsp)
+
+	return response, nil
 }
 
-// UserUpdateGithubOauthStartWithResponse request returning *UserUpdateGithubOauthStartResponse
-func (c *ClientWithResponses) UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error) {
-	rsp, err := c.UserUpdateGithubOauthStart(ctx, reqEditors...)
+// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
+func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateGithubOauthStartResponse(rsp)
-}
 
-// UserUpdateGoogleOauthCallbackWithResponse request returning *UserUpdateGoogleOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateGoogleOauthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StepRunListArchivesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdateGoogleOauthCallbackResponse(rsp)
-}
 
-// UserUpdateGoogleOauthStartWithResponse request returning *UserUpdateGoogleOauthStartResponse
-func (c *ClientWithResponses) UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error) {
-	rsp, err := c.UserUpdateGoogleOauthStart(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunArchiveList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUserUpdateGoogleOauthStartResponse(rsp)
+
+	return response, nil
 }
 
-// UserListTenantInvitesWithResponse request returning *UserListTenantInvitesResponse
-func (c *ClientWithResponses) UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error) {
-	rsp, err := c.UserListTenantInvites(ctx, reqEditors...)
+// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
+func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListTenantInvitesResponse(rsp)
-}
 
-// TenantInviteAcceptWithBodyWithResponse request with arbitrary body returning *TenantInviteAcceptResponse
-func (c *ClientWithResponses) TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
-	rsp, err := c.TenantInviteAcceptWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StepRunListEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantInviteAcceptResponse(rsp)
-}
 
-func (c *ClientWithResponses) TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
-	rsp, err := c.TenantInviteAccept(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseTenantInviteAcceptResponse(rsp)
-}
 
-// TenantInviteRejectWithBodyWithResponse request with arbitrary body returning *TenantInviteRejectResponse
-func (c *ClientWithResponses) TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
-	rsp, err := c.TenantInviteRejectWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTenantInviteRejectResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
-	rsp, err := c.TenantInviteReject(ctx, body, reqEditors...)
+// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
+func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteRejectResponse(rsp)
-}
 
-// UserUpdateLoginWithBodyWithResponse request with arbitrary body returning *UserUpdateLoginResponse
-func (c *ClientWithResponses) UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
-	rsp, err := c.UserUpdateLoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LogLineListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdateLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
-	rsp, err := c.UserUpdateLogin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserUpdateLoginResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLineList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// UserUpdateLogoutWithResponse request returning *UserUpdateLogoutResponse
-func (c *ClientWithResponses) UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error) {
-	rsp, err := c.UserUpdateLogout(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUserUpdateLogoutResponse(rsp)
+
+	return response, nil
 }
 
-// TenantMembershipsListWithResponse request returning *TenantMembershipsListResponse
-func (c *ClientWithResponses) TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error) {
-	rsp, err := c.TenantMembershipsList(ctx, reqEditors...)
+// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
+func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantMembershipsListResponse(rsp)
-}
 
-// UserUpdatePasswordWithBodyWithResponse request with arbitrary body returning *UserUpdatePasswordResponse
-func (c *ClientWithResponses) UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
-	rsp, err := c.UserUpdatePasswordWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdatePasswordResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
-	rsp, err := c.UserUpdatePassword(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseUserUpdatePasswordResponse(rsp)
+
+	return response, nil
 }
 
-// UserCreateWithBodyWithResponse request with arbitrary body returning *UserCreateResponse
-func (c *ClientWithResponses) UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
-	rsp, err := c.UserCreateWithBody(ctx, contentType, body, reqEditors...)
+// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
+func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
-	rsp, err := c.UserCreate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserCreateResponse(rsp)
-}
 
-// UserUpdateSlackOauthCallbackWithResponse request returning *UserUpdateSlackOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateSlackOauthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseUserUpdateSlackOauthCallbackResponse(rsp)
+
+	return response, nil
 }
 
-// WebhookDeleteWithResponse request returning *WebhookDeleteResponse
-func (c *ClientWithResponses) WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error) {
-	rsp, err := c.WebhookDelete(ctx, webhook, reqEditors...)
+// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
+func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookDeleteResponse(rsp)
-}
 
-// WebhookRequestsListWithResponse request returning *WebhookRequestsListResponse
-func (c *ClientWithResponses) WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error) {
-	rsp, err := c.WebhookRequestsList(ctx, webhook, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertEmailGroupListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWebhookRequestsListResponse(rsp)
-}
 
-// WorkerGetWithResponse request returning *WorkerGetResponse
-func (c *ClientWithResponses) WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error) {
-	rsp, err := c.WorkerGet(ctx, worker, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertEmailGroupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkerGetResponse(rsp)
+
+	return response, nil
 }
 
-// WorkerUpdateWithBodyWithResponse request with arbitrary body returning *WorkerUpdateResponse
-func (c *ClientWithResponses) WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
-	rsp, err := c.WorkerUpdateWithBody(ctx, worker, contentType, body, reqEditors...)
+// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
+func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkerUpdateResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
-	rsp, err := c.WorkerUpdate(ctx, worker, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertEmailGroupCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkerUpdateResponse(rsp)
-}
 
-// WorkflowDeleteWithResponse request returning *WorkflowDeleteResponse
-func (c *ClientWithResponses) WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error) {
-	rsp, err := c.WorkflowDelete(ctx, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantAlertEmailGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowDeleteResponse(rsp)
-}
 
-// WorkflowGetWithResponse request returning *WorkflowGetResponse
-func (c *ClientWithResponses) WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error) {
-	rsp, err := c.WorkflowGet(ctx, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkflowGetResponse(rsp)
+	return response, nil
 }
 
-// WorkflowUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowUpdateResponse
-func (c *ClientWithResponses) WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
-	rsp, err := c.WorkflowUpdateWithBody(ctx, workflow, contentType, body, reqEditors...)
+// ParseAlertMuteRuleListResponse parses an HTTP response from a AlertMuteRuleListWithResponse call
+func ParseAlertMuteRuleListResponse(rsp *http.Response) (*AlertMuteRuleListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowUpdateResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
-	rsp, err := c.WorkflowUpdate(ctx, workflow, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertMuteRuleListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowUpdateResponse(rsp)
-}
 
-// WorkflowGetMetricsWithResponse request returning *WorkflowGetMetricsResponse
-func (c *ClientWithResponses) WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error) {
-	rsp, err := c.WorkflowGetMetrics(ctx, workflow, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkflowGetMetricsResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertMuteRuleList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// WorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *WorkflowRunCreateResponse
-func (c *ClientWithResponses) WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
-	rsp, err := c.WorkflowRunCreateWithBody(ctx, workflow, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkflowRunCreateResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-func (c *ClientWithResponses) WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
-	rsp, err := c.WorkflowRunCreate(ctx, workflow, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkflowRunCreateResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// WorkflowVersionGetWithResponse request returning *WorkflowVersionGetResponse
-func (c *ClientWithResponses) WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error) {
-	rsp, err := c.WorkflowVersionGet(ctx, workflow, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseWorkflowVersionGetResponse(rsp)
+
+	return response, nil
 }
 
-// ParseLivenessGetResponse parses an HTTP response from a LivenessGetWithResponse call
-func ParseLivenessGetResponse(rsp *http.Response) (*LivenessGetResponse, error) {
+// ParseAlertMuteRuleCreateResponse parses an HTTP response from a AlertMuteRuleCreateWithResponse call
+func ParseAlertMuteRuleCreateResponse(rsp *http.Response) (*AlertMuteRuleCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LivenessGetResponse{
+	response := &AlertMuteRuleCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantAlertMuteRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseReadinessGetResponse parses an HTTP response from a ReadinessGetWithResponse call
-func ParseReadinessGetResponse(rsp *http.Response) (*ReadinessGetResponse, error) {
+// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
+func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReadinessGetResponse{
+	response := &TenantAlertingSettingsGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertingSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
-func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
+func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupDeleteResponse{
+	response := &ApiTokenListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAPITokensResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12084,7 +17577,7 @@ func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDel
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12095,22 +17588,22 @@ func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDel
 	return response, nil
 }
 
-// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
-func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
+func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupUpdateResponse{
+	response := &ApiTokenCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroup
+		var dest CreateAPITokenResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12124,7 +17617,7 @@ func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpd
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12135,20 +17628,27 @@ func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpd
 	return response, nil
 }
 
-// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
-func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+// ParseAuditLogListResponse parses an HTTP response from a AuditLogListWithResponse call
+func ParseAuditLogListResponse(rsp *http.Response) (*AuditLogListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenUpdateRevokeResponse{
+	response := &AuditLogListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLogList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12168,22 +17668,22 @@ func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevok
 	return response, nil
 }
 
-// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
-func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+// ParseAuditLogGetSettingsResponse parses an HTTP response from a AuditLogGetSettingsWithResponse call
+func ParseAuditLogGetSettingsResponse(rsp *http.Response) (*AuditLogGetSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CloudMetadataGetResponse{
+	response := &AuditLogGetSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIErrors
+		var dest AuditLogSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12196,27 +17696,34 @@ func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetRespons
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
-func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+// ParseAuditLogUpdateSettingsResponse parses an HTTP response from a AuditLogUpdateSettingsWithResponse call
+func ParseAuditLogUpdateSettingsResponse(rsp *http.Response) (*AuditLogUpdateSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventGetResponse{
+	response := &AuditLogUpdateSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+		var dest AuditLogSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12241,22 +17748,22 @@ func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
 	return response, nil
 }
 
-// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
-func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+// ParseStepRunListDeadLettersResponse parses an HTTP response from a StepRunListDeadLettersWithResponse call
+func ParseStepRunListDeadLettersResponse(rsp *http.Response) (*StepRunListDeadLettersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventDataGetResponse{
+	response := &StepRunListDeadLettersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventData
+		var dest StepRunDeadLetterList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12281,27 +17788,60 @@ func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error
 	return response, nil
 }
 
-// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
-func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
+// ParseStepRunDeleteDeadLetterResponse parses an HTTP response from a StepRunDeleteDeadLetterWithResponse call
+func ParseStepRunDeleteDeadLetterResponse(rsp *http.Response) (*StepRunDeleteDeadLetterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataGetResponse{
+	response := &StepRunDeleteDeadLetterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIMeta
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStepRunUpdateRequeueResponse parses an HTTP response from a StepRunUpdateRequeueWithResponse call
+func ParseStepRunUpdateRequeueResponse(rsp *http.Response) (*StepRunUpdateRequeueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StepRunUpdateRequeueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12309,58 +17849,93 @@ func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
-func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
+// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
+func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataListIntegrationsResponse{
+	response := &EventListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPIMetaIntegration
+		var dest EventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseMonitoringPostRunProbeResponse parses an HTTP response from a MonitoringPostRunProbeWithResponse call
-func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRunProbeResponse, error) {
+// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
+func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MonitoringPostRunProbeResponse{
+	response := &EventCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12368,25 +17943,39 @@ func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRun
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
-func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
+// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
+func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SlackWebhookDeleteResponse{
+	response := &EventCreateBulkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Events
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12394,39 +17983,48 @@ func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteRes
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON429 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
-func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
+// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
+func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsDeleteResponse{
+	response := &EventUpdateCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12434,81 +18032,81 @@ func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON429 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
-func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
+// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
+func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsUpdateResponse{
+	response := &EventKeyListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventKeyList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
-func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
+// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
+func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListArchivesResponse{
+	response := &EventUpdateReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunArchiveList
+		var dest EventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12528,34 +18126,34 @@ func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON429 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
-func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
+// ParseTenantInviteListResponse parses an HTTP response from a TenantInviteListWithResponse call
+func ParseTenantInviteListResponse(rsp *http.Response) (*TenantInviteListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListEventsResponse{
+	response := &TenantInviteListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunEventList
+		var dest TenantInviteList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12569,44 +18167,37 @@ func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsRespo
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
-func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
+// ParseTenantInviteCreateResponse parses an HTTP response from a TenantInviteCreateWithResponse call
+func ParseTenantInviteCreateResponse(rsp *http.Response) (*TenantInviteCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LogLineListResponse{
+	response := &TenantInviteCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogLineList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantInvite
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -12616,7 +18207,7 @@ func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error)
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12627,22 +18218,22 @@ func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error)
 	return response, nil
 }
 
-// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
-func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
+// ParseTenantInviteDeleteResponse parses an HTTP response from a TenantInviteDeleteWithResponse call
+func ParseTenantInviteDeleteResponse(rsp *http.Response) (*TenantInviteDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantCreateResponse{
+	response := &TenantInviteDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest TenantInvite
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12655,34 +18246,60 @@ func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	}
+
+	return response, nil
+}
+
+// ParseTenantInviteUpdateResponse parses an HTTP response from a TenantInviteUpdateWithResponse call
+func ParseTenantInviteUpdateResponse(rsp *http.Response) (*TenantInviteUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantInviteUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantInvite
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
-func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
+// ParseTenantMemberListResponse parses an HTTP response from a TenantMemberListWithResponse call
+func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantUpdateResponse{
+	response := &TenantMemberListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest TenantMemberList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12707,26 +18324,26 @@ func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error
 	return response, nil
 }
 
-// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
-func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
+// ParseTenantMemberDeleteResponse parses an HTTP response from a TenantMemberDeleteWithResponse call
+func ParseTenantMemberDeleteResponse(rsp *http.Response) (*TenantMemberDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupListResponse{
+	response := &TenantMemberDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroupList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
+		var dest TenantMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON204 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -12736,37 +18353,44 @@ func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListR
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
-func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
+// ParseTenantMemberUpdateRoleResponse parses an HTTP response from a TenantMemberUpdateRoleWithResponse call
+func ParseTenantMemberUpdateRoleResponse(rsp *http.Response) (*TenantMemberUpdateRoleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupCreateResponse{
+	response := &TenantMemberUpdateRoleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TenantAlertEmailGroup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -12776,33 +18400,40 @@ func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCre
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
-func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
+// ParseTenantGetQueueMetricsResponse parses an HTTP response from a TenantGetQueueMetricsWithResponse call
+func ParseTenantGetQueueMetricsResponse(rsp *http.Response) (*TenantGetQueueMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantAlertingSettingsGetResponse{
+	response := &TenantGetQueueMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertingSettings
+		var dest TenantQueueMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12816,33 +18447,40 @@ func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlerting
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
-func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
+// ParseRateLimitListResponse parses an HTTP response from a RateLimitListWithResponse call
+func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenListResponse{
+	response := &RateLimitListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPITokensResponse
+		var dest RateLimitList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12867,22 +18505,22 @@ func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error
 	return response, nil
 }
 
-// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
-func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
+// ParseTenantResourcePolicyGetResponse parses an HTTP response from a TenantResourcePolicyGetWithResponse call
+func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePolicyGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenCreateResponse{
+	response := &TenantResourcePolicyGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateAPITokenResponse
+		var dest TenantResourcePolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12896,7 +18534,7 @@ func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, e
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12907,22 +18545,22 @@ func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, e
 	return response, nil
 }
 
-// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
-func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
+// ParseTenantResourcePolicyUpdateResponse parses an HTTP response from a TenantResourcePolicyUpdateWithResponse call
+func ParseTenantResourcePolicyUpdateResponse(rsp *http.Response) (*TenantResourcePolicyUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventListResponse{
+	response := &TenantResourcePolicyUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventList
+		var dest TenantResourcePolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12936,7 +18574,7 @@ func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12947,22 +18585,22 @@ func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 	return response, nil
 }
 
-// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
-func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
+// ParseTenantRoleListResponse parses an HTTP response from a TenantRoleListWithResponse call
+func ParseTenantRoleListResponse(rsp *http.Response) (*TenantRoleListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateResponse{
+	response := &TenantRoleListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+		var dest TenantRoleList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12982,34 +18620,27 @@ func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
-func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
+// ParseTenantRoleCreateResponse parses an HTTP response from a TenantRoleCreateWithResponse call
+func ParseTenantRoleCreateResponse(rsp *http.Response) (*TenantRoleCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateBulkResponse{
+	response := &TenantRoleCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Events
+		var dest TenantRole
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13029,41 +18660,25 @@ func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
-func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
+// ParseTenantRoleDeleteResponse parses an HTTP response from a TenantRoleDeleteWithResponse call
+func ParseTenantRoleDeleteResponse(rsp *http.Response) (*TenantRoleDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateCancelResponse{
+	response := &TenantRoleDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -13078,34 +18693,34 @@ func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
-func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
+// ParseTenantRoleUpdateResponse parses an HTTP response from a TenantRoleUpdateWithResponse call
+func ParseTenantRoleUpdateResponse(rsp *http.Response) (*TenantRoleUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventKeyListResponse{
+	response := &TenantRoleUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventKeyList
+		var dest TenantRole
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13125,27 +18740,34 @@ func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
-func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
+// ParseSlackWebhookListResponse parses an HTTP response from a SlackWebhookListWithResponse call
+func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateReplayResponse{
+	response := &SlackWebhookListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventList
+		var dest ListSlackWebhooks
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13158,41 +18780,57 @@ func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayRespo
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteListResponse parses an HTTP response from a TenantInviteListWithResponse call
-func ParseTenantInviteListResponse(rsp *http.Response) (*TenantInviteListResponse, error) {
+// ParseUserUpdateSlackOauthStartResponse parses an HTTP response from a UserUpdateSlackOauthStartWithResponse call
+func ParseUserUpdateSlackOauthStartResponse(rsp *http.Response) (*UserUpdateSlackOauthStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteListResponse{
+	response := &UserUpdateSlackOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSnsListResponse parses an HTTP response from a SnsListWithResponse call
+func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SnsListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInviteList
+		var dest ListSNSIntegrations
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13205,34 +18843,41 @@ func ParseTenantInviteListResponse(rsp *http.Response) (*TenantInviteListRespons
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteCreateResponse parses an HTTP response from a TenantInviteCreateWithResponse call
-func ParseTenantInviteCreateResponse(rsp *http.Response) (*TenantInviteCreateResponse, error) {
+// ParseSnsCreateResponse parses an HTTP response from a SnsCreateWithResponse call
+func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteCreateResponse{
+	response := &SnsCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TenantInvite
+		var dest SNSIntegration
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13245,34 +18890,41 @@ func ParseTenantInviteCreateResponse(rsp *http.Response) (*TenantInviteCreateRes
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteDeleteResponse parses an HTTP response from a TenantInviteDeleteWithResponse call
-func ParseTenantInviteDeleteResponse(rsp *http.Response) (*TenantInviteDeleteResponse, error) {
+// ParseTenantGetStepRunQueueMetricsResponse parses an HTTP response from a TenantGetStepRunQueueMetricsWithResponse call
+func ParseTenantGetStepRunQueueMetricsResponse(rsp *http.Response) (*TenantGetStepRunQueueMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteDeleteResponse{
+	response := &TenantGetStepRunQueueMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInvite
+		var dest TenantStepRunQueueMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13285,27 +18937,41 @@ func ParseTenantInviteDeleteResponse(rsp *http.Response) (*TenantInviteDeleteRes
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteUpdateResponse parses an HTTP response from a TenantInviteUpdateWithResponse call
-func ParseTenantInviteUpdateResponse(rsp *http.Response) (*TenantInviteUpdateResponse, error) {
+// ParseStepRunGetResponse parses an HTTP response from a StepRunGetWithResponse call
+func ParseStepRunGetResponse(rsp *http.Response) (*StepRunGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteUpdateResponse{
+	response := &StepRunGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInvite
+		var dest StepRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13318,27 +18984,41 @@ func ParseTenantInviteUpdateResponse(rsp *http.Response) (*TenantInviteUpdateRes
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantMemberListResponse parses an HTTP response from a TenantMemberListWithResponse call
-func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListResponse, error) {
+// ParseStepRunUpdateCancelResponse parses an HTTP response from a StepRunUpdateCancelWithResponse call
+func ParseStepRunUpdateCancelResponse(rsp *http.Response) (*StepRunUpdateCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantMemberListResponse{
+	response := &StepRunUpdateCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantMemberList
+		var dest StepRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13352,7 +19032,7 @@ func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListRespons
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13363,26 +19043,26 @@ func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListRespons
 	return response, nil
 }
 
-// ParseTenantMemberDeleteResponse parses an HTTP response from a TenantMemberDeleteWithResponse call
-func ParseTenantMemberDeleteResponse(rsp *http.Response) (*TenantMemberDeleteResponse, error) {
+// ParseStepRunUpdateRerunResponse parses an HTTP response from a StepRunUpdateRerunWithResponse call
+func ParseStepRunUpdateRerunResponse(rsp *http.Response) (*StepRunUpdateRerunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantMemberDeleteResponse{
+	response := &StepRunUpdateRerunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
-		var dest TenantMember
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON204 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -13398,34 +19078,27 @@ func ParseTenantMemberDeleteResponse(rsp *http.Response) (*TenantMemberDeleteRes
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseTenantGetQueueMetricsResponse parses an HTTP response from a TenantGetQueueMetricsWithResponse call
-func ParseTenantGetQueueMetricsResponse(rsp *http.Response) (*TenantGetQueueMetricsResponse, error) {
+// ParseStepRunGetSchemaResponse parses an HTTP response from a StepRunGetSchemaWithResponse call
+func ParseStepRunGetSchemaResponse(rsp *http.Response) (*StepRunGetSchemaResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantGetQueueMetricsResponse{
+	response := &StepRunGetSchemaResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantQueueMetrics
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13457,22 +19130,22 @@ func ParseTenantGetQueueMetricsResponse(rsp *http.Response) (*TenantGetQueueMetr
 	return response, nil
 }
 
-// ParseRateLimitListResponse parses an HTTP response from a RateLimitListWithResponse call
-func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, error) {
+// ParseWebhookSubscriptionListResponse parses an HTTP response from a WebhookSubscriptionListWithResponse call
+func ParseWebhookSubscriptionListResponse(rsp *http.Response) (*WebhookSubscriptionListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RateLimitListResponse{
+	response := &WebhookSubscriptionListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RateLimitList
+		var dest WebhookSubscriptionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13497,22 +19170,22 @@ func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, err
 	return response, nil
 }
 
-// ParseTenantResourcePolicyGetResponse parses an HTTP response from a TenantResourcePolicyGetWithResponse call
-func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePolicyGetResponse, error) {
+// ParseWebhookSubscriptionCreateResponse parses an HTTP response from a WebhookSubscriptionCreateWithResponse call
+func ParseWebhookSubscriptionCreateResponse(rsp *http.Response) (*WebhookSubscriptionCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantResourcePolicyGetResponse{
+	response := &WebhookSubscriptionCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantResourcePolicy
+		var dest WebhookSubscriptionCreated
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13526,7 +19199,7 @@ func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePo
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13537,22 +19210,22 @@ func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePo
 	return response, nil
 }
 
-// ParseSlackWebhookListResponse parses an HTTP response from a SlackWebhookListWithResponse call
-func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListResponse, error) {
+// ParseWebhookListResponse parses an HTTP response from a WebhookListWithResponse call
+func ParseWebhookListResponse(rsp *http.Response) (*WebhookListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SlackWebhookListResponse{
+	response := &WebhookListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListSlackWebhooks
+		var dest WebhookWorkerListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13584,38 +19257,22 @@ func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListRespons
 	return response, nil
 }
 
-// ParseUserUpdateSlackOauthStartResponse parses an HTTP response from a UserUpdateSlackOauthStartWithResponse call
-func ParseUserUpdateSlackOauthStartResponse(rsp *http.Response) (*UserUpdateSlackOauthStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UserUpdateSlackOauthStartResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseSnsListResponse parses an HTTP response from a SnsListWithResponse call
-func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
+// ParseWebhookCreateResponse parses an HTTP response from a WebhookCreateWithResponse call
+func ParseWebhookCreateResponse(rsp *http.Response) (*WebhookCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsListResponse{
+	response := &WebhookCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListSNSIntegrations
+		var dest WebhookWorkerCreated
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13647,26 +19304,26 @@ func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
 	return response, nil
 }
 
-// ParseSnsCreateResponse parses an HTTP response from a SnsCreateWithResponse call
-func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
+// ParseWorkerListResponse parses an HTTP response from a WorkerListWithResponse call
+func ParseWorkerListResponse(rsp *http.Response) (*WorkerListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsCreateResponse{
+	response := &WorkerListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SNSIntegration
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -13675,41 +19332,34 @@ func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantGetStepRunQueueMetricsResponse parses an HTTP response from a TenantGetStepRunQueueMetricsWithResponse call
-func ParseTenantGetStepRunQueueMetricsResponse(rsp *http.Response) (*TenantGetStepRunQueueMetricsResponse, error) {
+// ParseWorkflowRunSearchResponse parses an HTTP response from a WorkflowRunSearchWithResponse call
+func ParseWorkflowRunSearchResponse(rsp *http.Response) (*WorkflowRunSearchResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantGetStepRunQueueMetricsResponse{
+	response := &WorkflowRunSearchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantStepRunQueueMetrics
+		var dest WorkflowRunSearchResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13729,34 +19379,27 @@ func ParseTenantGetStepRunQueueMetricsResponse(rsp *http.Response) (*TenantGetSt
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseStepRunGetResponse parses an HTTP response from a StepRunGetWithResponse call
-func ParseStepRunGetResponse(rsp *http.Response) (*StepRunGetResponse, error) {
+// ParseWorkflowRunUpdateCancelResponse parses an HTTP response from a WorkflowRunUpdateCancelWithResponse call
+func ParseWorkflowRunUpdateCancelResponse(rsp *http.Response) (*WorkflowRunUpdateCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunGetResponse{
+	response := &WorkflowRunUpdateCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRun
+		var dest CancelWorkflowRunsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13776,34 +19419,27 @@ func ParseStepRunGetResponse(rsp *http.Response) (*StepRunGetResponse, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseStepRunUpdateCancelResponse parses an HTTP response from a StepRunUpdateCancelWithResponse call
-func ParseStepRunUpdateCancelResponse(rsp *http.Response) (*StepRunUpdateCancelResponse, error) {
+// ParseWorkflowRunUpdateReplayResponse parses an HTTP response from a WorkflowRunUpdateReplayWithResponse call
+func ParseWorkflowRunUpdateReplayResponse(rsp *http.Response) (*WorkflowRunUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunUpdateCancelResponse{
+	response := &WorkflowRunUpdateReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRun
+		var dest ReplayWorkflowRunsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13823,27 +19459,34 @@ func ParseStepRunUpdateCancelResponse(rsp *http.Response) (*StepRunUpdateCancelR
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseStepRunUpdateRerunResponse parses an HTTP response from a StepRunUpdateRerunWithResponse call
-func ParseStepRunUpdateRerunResponse(rsp *http.Response) (*StepRunUpdateRerunResponse, error) {
+// ParseWorkflowRunGetResponse parses an HTTP response from a WorkflowRunGetWithResponse call
+func ParseWorkflowRunGetResponse(rsp *http.Response) (*WorkflowRunGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunUpdateRerunResponse{
+	response := &WorkflowRunGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRun
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13868,15 +19511,15 @@ func ParseStepRunUpdateRerunResponse(rsp *http.Response) (*StepRunUpdateRerunRes
 	return response, nil
 }
 
-// ParseStepRunGetSchemaResponse parses an HTTP response from a StepRunGetSchemaWithResponse call
-func ParseStepRunGetSchemaResponse(rsp *http.Response) (*StepRunGetSchemaResponse, error) {
+// ParseWorkflowRunGetInputResponse parses an HTTP response from a WorkflowRunGetInputWithResponse call
+func ParseWorkflowRunGetInputResponse(rsp *http.Response) (*WorkflowRunGetInputResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunGetSchemaResponse{
+	response := &WorkflowRunGetInputResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -13915,22 +19558,22 @@ func ParseStepRunGetSchemaResponse(rsp *http.Response) (*StepRunGetSchemaRespons
 	return response, nil
 }
 
-// ParseWebhookListResponse parses an HTTP response from a WebhookListWithResponse call
-func ParseWebhookListResponse(rsp *http.Response) (*WebhookListResponse, error) {
+// ParseWorkflowRunGetShapeResponse parses an HTTP response from a WorkflowRunGetShapeWithResponse call
+func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WebhookListResponse{
+	response := &WorkflowRunGetShapeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WebhookWorkerListResponse
+		var dest WorkflowRunShape
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13943,41 +19586,34 @@ func ParseWebhookListResponse(rsp *http.Response) (*WebhookListResponse, error)
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWebhookCreateResponse parses an HTTP response from a WebhookCreateWithResponse call
-func ParseWebhookCreateResponse(rsp *http.Response) (*WebhookCreateResponse, error) {
+// ParseWorkflowRunListStepRunEventsResponse parses an HTTP response from a WorkflowRunListStepRunEventsWithResponse call
+func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRunListStepRunEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WebhookCreateResponse{
+	response := &WorkflowRunListStepRunEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WebhookWorkerCreated
+		var dest StepRunEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13990,41 +19626,41 @@ func ParseWebhookCreateResponse(rsp *http.Response) (*WebhookCreateResponse, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWorkerListResponse parses an HTTP response from a WorkerListWithResponse call
-func ParseWorkerListResponse(rsp *http.Response) (*WorkerListResponse, error) {
+// ParseWorkflowListResponse parses an HTTP response from a WorkflowListWithResponse call
+func ParseWorkflowListResponse(rsp *http.Response) (*WorkflowListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkerListResponse{
+	response := &WorkflowListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkerList
+		var dest WorkflowList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14049,22 +19685,24 @@ func ParseWorkerListResponse(rsp *http.Response) (*WorkerListResponse, error) {
 	return response, nil
 }
 
-// ParseWorkflowRunUpdateReplayResponse parses an HTTP response from a WorkflowRunUpdateReplayWithResponse call
-func ParseWorkflowRunUpdateReplayResponse(rsp *http.Response) (*WorkflowRunUpdateReplayResponse, error) {
+// ParseWorkflowRunCancelResponse parses an HTTP response from a WorkflowRunCancelWithResponse call
+func ParseWorkflowRunCancelResponse(rsp *http.Response) (*WorkflowRunCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunUpdateReplayResponse{
+	response := &WorkflowRunCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReplayWorkflowRunsResponse
+		var dest struct {
+			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14084,34 +19722,27 @@ func ParseWorkflowRunUpdateReplayResponse(rsp *http.Response) (*WorkflowRunUpdat
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunGetResponse parses an HTTP response from a WorkflowRunGetWithResponse call
-func ParseWorkflowRunGetResponse(rsp *http.Response) (*WorkflowRunGetResponse, error) {
+// ParseCronWorkflowListResponse parses an HTTP response from a CronWorkflowListWithResponse call
+func ParseCronWorkflowListResponse(rsp *http.Response) (*CronWorkflowListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetResponse{
+	response := &CronWorkflowListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRun
+		var dest CronWorkflowsList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14136,27 +19767,20 @@ func ParseWorkflowRunGetResponse(rsp *http.Response) (*WorkflowRunGetResponse, e
 	return response, nil
 }
 
-// ParseWorkflowRunGetInputResponse parses an HTTP response from a WorkflowRunGetInputWithResponse call
-func ParseWorkflowRunGetInputResponse(rsp *http.Response) (*WorkflowRunGetInputResponse, error) {
+// ParseWorkflowCronDeleteResponse parses an HTTP response from a WorkflowCronDeleteWithResponse call
+func ParseWorkflowCronDeleteResponse(rsp *http.Response) (*WorkflowCronDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetInputResponse{
+	response := &WorkflowCronDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14165,40 +19789,33 @@ func ParseWorkflowRunGetInputResponse(rsp *http.Response) (*WorkflowRunGetInputR
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunGetShapeResponse parses an HTTP response from a WorkflowRunGetShapeWithResponse call
-func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeResponse, error) {
+// ParseWorkflowCronGetResponse parses an HTTP response from a WorkflowCronGetWithResponse call
+func ParseWorkflowCronGetResponse(rsp *http.Response) (*WorkflowCronGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetShapeResponse{
+	response := &WorkflowCronGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunShape
+		var dest CronWorkflows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14218,27 +19835,34 @@ func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeR
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunListStepRunEventsResponse parses an HTTP response from a WorkflowRunListStepRunEventsWithResponse call
-func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRunListStepRunEventsResponse, error) {
+// ParseWorkflowRunListResponse parses an HTTP response from a WorkflowRunListWithResponse call
+func ParseWorkflowRunListResponse(rsp *http.Response) (*WorkflowRunListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunListStepRunEventsResponse{
+	response := &WorkflowRunListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunEventList
+		var dest WorkflowRunList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14258,34 +19882,27 @@ func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRun
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowListResponse parses an HTTP response from a WorkflowListWithResponse call
-func ParseWorkflowListResponse(rsp *http.Response) (*WorkflowListResponse, error) {
+// ParseWorkflowRunGetMetricsResponse parses an HTTP response from a WorkflowRunGetMetricsWithResponse call
+func ParseWorkflowRunGetMetricsResponse(rsp *http.Response) (*WorkflowRunGetMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowListResponse{
+	response := &WorkflowRunGetMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowList
+		var dest WorkflowRunsMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14310,24 +19927,22 @@ func ParseWorkflowListResponse(rsp *http.Response) (*WorkflowListResponse, error
 	return response, nil
 }
 
-// ParseWorkflowRunCancelResponse parses an HTTP response from a WorkflowRunCancelWithResponse call
-func ParseWorkflowRunCancelResponse(rsp *http.Response) (*WorkflowRunCancelResponse, error) {
+// ParseWorkflowScheduledListResponse parses an HTTP response from a WorkflowScheduledListWithResponse call
+func ParseWorkflowScheduledListResponse(rsp *http.Response) (*WorkflowScheduledListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunCancelResponse{
+	response := &WorkflowScheduledListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-		}
+		var dest ScheduledWorkflowsList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14352,27 +19967,20 @@ func ParseWorkflowRunCancelResponse(rsp *http.Response) (*WorkflowRunCancelRespo
 	return response, nil
 }
 
-// ParseCronWorkflowListResponse parses an HTTP response from a CronWorkflowListWithResponse call
-func ParseCronWorkflowListResponse(rsp *http.Response) (*CronWorkflowListResponse, error) {
+// ParseWorkflowScheduledDeleteResponse parses an HTTP response from a WorkflowScheduledDeleteWithResponse call
+func ParseWorkflowScheduledDeleteResponse(rsp *http.Response) (*WorkflowScheduledDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CronWorkflowListResponse{
+	response := &WorkflowScheduledDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CronWorkflowsList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14381,7 +19989,7 @@ func ParseCronWorkflowListResponse(rsp *http.Response) (*CronWorkflowListRespons
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14392,20 +20000,27 @@ func ParseCronWorkflowListResponse(rsp *http.Response) (*CronWorkflowListRespons
 	return response, nil
 }
 
-// ParseWorkflowCronDeleteResponse parses an HTTP response from a WorkflowCronDeleteWithResponse call
-func ParseWorkflowCronDeleteResponse(rsp *http.Response) (*WorkflowCronDeleteResponse, error) {
+// ParseWorkflowScheduledGetResponse parses an HTTP response from a WorkflowScheduledGetWithResponse call
+func ParseWorkflowScheduledGetResponse(rsp *http.Response) (*WorkflowScheduledGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowCronDeleteResponse{
+	response := &WorkflowScheduledGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledWorkflows
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14414,26 +20029,33 @@ func ParseWorkflowCronDeleteResponse(rsp *http.Response) (*WorkflowCronDeleteRes
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowCronGetResponse parses an HTTP response from a WorkflowCronGetWithResponse call
-func ParseWorkflowCronGetResponse(rsp *http.Response) (*WorkflowCronGetResponse, error) {
+// ParseCronWorkflowTriggerCreateResponse parses an HTTP response from a CronWorkflowTriggerCreateWithResponse call
+func ParseCronWorkflowTriggerCreateResponse(rsp *http.Response) (*CronWorkflowTriggerCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowCronGetResponse{
+	response := &CronWorkflowTriggerCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -14467,27 +20089,34 @@ func ParseWorkflowCronGetResponse(rsp *http.Response) (*WorkflowCronGetResponse,
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunListResponse parses an HTTP response from a WorkflowRunListWithResponse call
-func ParseWorkflowRunListResponse(rsp *http.Response) (*WorkflowRunListResponse, error) {
+// ParseScheduledWorkflowRunCreateResponse parses an HTTP response from a ScheduledWorkflowRunCreateWithResponse call
+func ParseScheduledWorkflowRunCreateResponse(rsp *http.Response) (*ScheduledWorkflowRunCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunListResponse{
+	response := &ScheduledWorkflowRunCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunList
+		var dest ScheduledWorkflows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14507,27 +20136,41 @@ func ParseWorkflowRunListResponse(rsp *http.Response) (*WorkflowRunListResponse,
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunGetMetricsResponse parses an HTTP response from a WorkflowRunGetMetricsWithResponse call
-func ParseWorkflowRunGetMetricsResponse(rsp *http.Response) (*WorkflowRunGetMetricsResponse, error) {
+// ParseWorkflowGetWorkersCountResponse parses an HTTP response from a WorkflowGetWorkersCountWithResponse call
+func ParseWorkflowGetWorkersCountResponse(rsp *http.Response) (*WorkflowGetWorkersCountResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetMetricsResponse{
+	response := &WorkflowGetWorkersCountResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunsMetrics
+		var dest WorkflowWorkersCount
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14552,22 +20195,22 @@ func ParseWorkflowRunGetMetricsResponse(rsp *http.Response) (*WorkflowRunGetMetr
 	return response, nil
 }
 
-// ParseWorkflowScheduledListResponse parses an HTTP response from a WorkflowScheduledListWithResponse call
-func ParseWorkflowScheduledListResponse(rsp *http.Response) (*WorkflowScheduledListResponse, error) {
+// ParseUserGetCurrentResponse parses an HTTP response from a UserGetCurrentWithResponse call
+func ParseUserGetCurrentResponse(rsp *http.Response) (*UserGetCurrentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowScheduledListResponse{
+	response := &UserGetCurrentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScheduledWorkflowsList
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14580,67 +20223,105 @@ func ParseWorkflowScheduledListResponse(rsp *http.Response) (*WorkflowScheduledL
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowScheduledDeleteResponse parses an HTTP response from a WorkflowScheduledDeleteWithResponse call
-func ParseWorkflowScheduledDeleteResponse(rsp *http.Response) (*WorkflowScheduledDeleteResponse, error) {
+// ParseUserUpdateGithubOauthCallbackResponse parses an HTTP response from a UserUpdateGithubOauthCallbackWithResponse call
+func ParseUserUpdateGithubOauthCallbackResponse(rsp *http.Response) (*UserUpdateGithubOauthCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowScheduledDeleteResponse{
+	response := &UserUpdateGithubOauthCallbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return response, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// ParseUserUpdateGithubOauthStartResponse parses an HTTP response from a UserUpdateGithubOauthStartWithResponse call
+func ParseUserUpdateGithubOauthStartResponse(rsp *http.Response) (*UserUpdateGithubOauthStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &UserUpdateGithubOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowScheduledGetResponse parses an HTTP response from a WorkflowScheduledGetWithResponse call
-func ParseWorkflowScheduledGetResponse(rsp *http.Response) (*WorkflowScheduledGetResponse, error) {
+// ParseUserUpdateGoogleOauthCallbackResponse parses an HTTP response from a UserUpdateGoogleOauthCallbackWithResponse call
+func ParseUserUpdateGoogleOauthCallbackResponse(rsp *http.Response) (*UserUpdateGoogleOauthCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowScheduledGetResponse{
+	response := &UserUpdateGoogleOauthCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserUpdateGoogleOauthStartResponse parses an HTTP response from a UserUpdateGoogleOauthStartWithResponse call
+func ParseUserUpdateGoogleOauthStartResponse(rsp *http.Response) (*UserUpdateGoogleOauthStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserUpdateGoogleOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserListTenantInvitesResponse parses an HTTP response from a UserListTenantInvitesWithResponse call
+func ParseUserListTenantInvitesResponse(rsp *http.Response) (*UserListTenantInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserListTenantInvitesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScheduledWorkflows
+		var dest TenantInviteList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14660,39 +20341,25 @@ func ParseWorkflowScheduledGetResponse(rsp *http.Response) (*WorkflowScheduledGe
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseCronWorkflowTriggerCreateResponse parses an HTTP response from a CronWorkflowTriggerCreateWithResponse call
-func ParseCronWorkflowTriggerCreateResponse(rsp *http.Response) (*CronWorkflowTriggerCreateResponse, error) {
+// ParseTenantInviteAcceptResponse parses an HTTP response from a TenantInviteAcceptWithResponse call
+func ParseTenantInviteAcceptResponse(rsp *http.Response) (*TenantInviteAcceptResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CronWorkflowTriggerCreateResponse{
+	response := &TenantInviteAcceptResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CronWorkflows
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14701,52 +20368,31 @@ func ParseCronWorkflowTriggerCreateResponse(rsp *http.Response) (*CronWorkflowTr
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseScheduledWorkflowRunCreateResponse parses an HTTP response from a ScheduledWorkflowRunCreateWithResponse call
-func ParseScheduledWorkflowRunCreateResponse(rsp *http.Response) (*ScheduledWorkflowRunCreateResponse, error) {
+// ParseTenantInviteRejectResponse parses an HTTP response from a TenantInviteRejectWithResponse call
+func ParseTenantInviteRejectResponse(rsp *http.Response) (*TenantInviteRejectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ScheduledWorkflowRunCreateResponse{
+	response := &TenantInviteRejectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScheduledWorkflows
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14755,47 +20401,33 @@ func ParseScheduledWorkflowRunCreateResponse(rsp *http.Response) (*ScheduledWork
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowGetWorkersCountResponse parses an HTTP response from a WorkflowGetWorkersCountWithResponse call
-func ParseWorkflowGetWorkersCountResponse(rsp *http.Response) (*WorkflowGetWorkersCountResponse, error) {
+// ParseUserUpdateLoginResponse parses an HTTP response from a UserUpdateLoginWithResponse call
+func ParseUserUpdateLoginResponse(rsp *http.Response) (*UserUpdateLoginResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowGetWorkersCountResponse{
+	response := &UserUpdateLoginResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowWorkersCount
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14808,27 +20440,34 @@ func ParseWorkflowGetWorkersCountResponse(rsp *http.Response) (*WorkflowGetWorke
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserGetCurrentResponse parses an HTTP response from a UserGetCurrentWithResponse call
-func ParseUserGetCurrentResponse(rsp *http.Response) (*UserGetCurrentResponse, error) {
+// ParseUserUpdateLogoutResponse parses an HTTP response from a UserUpdateLogoutWithResponse call
+func ParseUserUpdateLogoutResponse(rsp *http.Response) (*UserUpdateLogoutResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetCurrentResponse{
+	response := &UserUpdateLogoutResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -14867,47 +20506,55 @@ func ParseUserGetCurrentResponse(rsp *http.Response) (*UserGetCurrentResponse, e
 	return response, nil
 }
 
-// ParseUserUpdateGithubOauthCallbackResponse parses an HTTP response from a UserUpdateGithubOauthCallbackWithResponse call
-func ParseUserUpdateGithubOauthCallbackResponse(rsp *http.Response) (*UserUpdateGithubOauthCallbackResponse, error) {
+// ParseTenantMembershipsListResponse parses an HTTP response from a TenantMembershipsListWithResponse call
+func ParseTenantMembershipsListResponse(rsp *http.Response) (*TenantMembershipsListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateGithubOauthCallbackResponse{
+	response := &TenantMembershipsListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	return response, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserTenantMembershipsList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// ParseUserUpdateGithubOauthStartResponse parses an HTTP response from a UserUpdateGithubOauthStartWithResponse call
-func ParseUserUpdateGithubOauthStartResponse(rsp *http.Response) (*UserUpdateGithubOauthStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	response := &UserUpdateGithubOauthStartResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseUserUpdateGoogleOauthCallbackResponse parses an HTTP response from a UserUpdateGoogleOauthCallbackWithResponse call
-func ParseUserUpdateGoogleOauthCallbackResponse(rsp *http.Response) (*UserUpdateGoogleOauthCallbackResponse, error) {
+// ParseUserUpdateOauthCallbackResponse parses an HTTP response from a UserUpdateOauthCallbackWithResponse call
+func ParseUserUpdateOauthCallbackResponse(rsp *http.Response) (*UserUpdateOauthCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateGoogleOauthCallbackResponse{
+	response := &UserUpdateOauthCallbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -14915,15 +20562,15 @@ func ParseUserUpdateGoogleOauthCallbackResponse(rsp *http.Response) (*UserUpdate
 	return response, nil
 }
 
-// ParseUserUpdateGoogleOauthStartResponse parses an HTTP response from a UserUpdateGoogleOauthStartWithResponse call
-func ParseUserUpdateGoogleOauthStartResponse(rsp *http.Response) (*UserUpdateGoogleOauthStartResponse, error) {
+// ParseUserUpdateOauthStartResponse parses an HTTP response from a UserUpdateOauthStartWithResponse call
+func ParseUserUpdateOauthStartResponse(rsp *http.Response) (*UserUpdateOauthStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateGoogleOauthStartResponse{
+	response := &UserUpdateOauthStartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -14931,22 +20578,22 @@ func ParseUserUpdateGoogleOauthStartResponse(rsp *http.Response) (*UserUpdateGoo
 	return response, nil
 }
 
-// ParseUserListTenantInvitesResponse parses an HTTP response from a UserListTenantInvitesWithResponse call
-func ParseUserListTenantInvitesResponse(rsp *http.Response) (*UserListTenantInvitesResponse, error) {
+// ParseUserUpdatePasswordResponse parses an HTTP response from a UserUpdatePasswordWithResponse call
+func ParseUserUpdatePasswordResponse(rsp *http.Response) (*UserUpdatePasswordResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserListTenantInvitesResponse{
+	response := &UserUpdatePasswordResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInviteList
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14959,32 +20606,46 @@ func ParseUserListTenantInvitesResponse(rsp *http.Response) (*UserListTenantInvi
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteAcceptResponse parses an HTTP response from a TenantInviteAcceptWithResponse call
-func ParseTenantInviteAcceptResponse(rsp *http.Response) (*TenantInviteAcceptResponse, error) {
+// ParseUserCreateResponse parses an HTTP response from a UserCreateWithResponse call
+func ParseUserCreateResponse(rsp *http.Response) (*UserCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteAcceptResponse{
+	response := &UserCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14992,114 +20653,115 @@ func ParseTenantInviteAcceptResponse(rsp *http.Response) (*TenantInviteAcceptRes
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteRejectResponse parses an HTTP response from a TenantInviteRejectWithResponse call
-func ParseTenantInviteRejectResponse(rsp *http.Response) (*TenantInviteRejectResponse, error) {
+// ParseUserUpdateSamlCallbackResponse parses an HTTP response from a UserUpdateSamlCallbackWithResponse call
+func ParseUserUpdateSamlCallbackResponse(rsp *http.Response) (*UserUpdateSamlCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteRejectResponse{
+	response := &UserUpdateSamlCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserGetSamlMetadataResponse parses an HTTP response from a UserGetSamlMetadataWithResponse call
+func ParseUserGetSamlMetadataResponse(rsp *http.Response) (*UserGetSamlMetadataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGetSamlMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserUpdateLoginResponse parses an HTTP response from a UserUpdateLoginWithResponse call
-func ParseUserUpdateLoginResponse(rsp *http.Response) (*UserUpdateLoginResponse, error) {
+// ParseUserUpdateSamlStartResponse parses an HTTP response from a UserUpdateSamlStartWithResponse call
+func ParseUserUpdateSamlStartResponse(rsp *http.Response) (*UserUpdateSamlStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateLoginResponse{
+	response := &UserUpdateSamlStartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	return response, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON405 = &dest
+// ParseUserUpdateSlackOauthCallbackResponse parses an HTTP response from a UserUpdateSlackOauthCallbackWithResponse call
+func ParseUserUpdateSlackOauthCallbackResponse(rsp *http.Response) (*UserUpdateSlackOauthCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &UserUpdateSlackOauthCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseUserUpdateLogoutResponse parses an HTTP response from a UserUpdateLogoutWithResponse call
-func ParseUserUpdateLogoutResponse(rsp *http.Response) (*UserUpdateLogoutResponse, error) {
+// ParseWebhookDeliveryResendResponse parses an HTTP response from a WebhookDeliveryResendWithResponse call
+func ParseWebhookDeliveryResendResponse(rsp *http.Response) (*WebhookDeliveryResendResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateLogoutResponse{
+	response := &WebhookDeliveryResendResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest WebhookDelivery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15112,41 +20774,41 @@ func ParseUserUpdateLogoutResponse(rsp *http.Response) (*UserUpdateLogoutRespons
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantMembershipsListResponse parses an HTTP response from a TenantMembershipsListWithResponse call
-func ParseTenantMembershipsListResponse(rsp *http.Response) (*TenantMembershipsListResponse, error) {
+// ParseWebhookSubscriptionDeleteResponse parses an HTTP response from a WebhookSubscriptionDeleteWithResponse call
+func ParseWebhookSubscriptionDeleteResponse(rsp *http.Response) (*WebhookSubscriptionDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantMembershipsListResponse{
+	response := &WebhookSubscriptionDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserTenantMembershipsList
+		var dest WebhookSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15166,27 +20828,34 @@ func ParseTenantMembershipsListResponse(rsp *http.Response) (*TenantMembershipsL
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUserUpdatePasswordResponse parses an HTTP response from a UserUpdatePasswordWithResponse call
-func ParseUserUpdatePasswordResponse(rsp *http.Response) (*UserUpdatePasswordResponse, error) {
+// ParseWebhookSubscriptionUpdateResponse parses an HTTP response from a WebhookSubscriptionUpdateWithResponse call
+func ParseWebhookSubscriptionUpdateResponse(rsp *http.Response) (*WebhookSubscriptionUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdatePasswordResponse{
+	response := &WebhookSubscriptionUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest WebhookSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15199,41 +20868,41 @@ func ParseUserUpdatePasswordResponse(rsp *http.Response) (*UserUpdatePasswordRes
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserCreateResponse parses an HTTP response from a UserCreateWithResponse call
-func ParseUserCreateResponse(rsp *http.Response) (*UserCreateResponse, error) {
+// ParseWebhookDeliveryListResponse parses an HTTP response from a WebhookDeliveryListWithResponse call
+func ParseWebhookDeliveryListResponse(rsp *http.Response) (*WebhookDeliveryListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserCreateResponse{
+	response := &WebhookDeliveryListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest WebhookDeliveryList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15246,36 +20915,20 @@ func ParseUserCreateResponse(rsp *http.Response) (*UserCreateResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUserUpdateSlackOauthCallbackResponse parses an HTTP response from a UserUpdateSlackOauthCallbackWithResponse call
-func ParseUserUpdateSlackOauthCallbackResponse(rsp *http.Response) (*UserUpdateSlackOauthCallbackResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON404 = &dest
 
-	response := &UserUpdateSlackOauthCallbackResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
@@ -15676,6 +21329,86 @@ func ParseWorkflowRunCreateResponse(rsp *http.Response) (*WorkflowRunCreateRespo
 	return response, nil
 }
 
+// ParseWorkflowVersionWeightsGetResponse parses an HTTP response from a WorkflowVersionWeightsGetWithResponse call
+func ParseWorkflowVersionWeightsGetResponse(rsp *http.Response) (*WorkflowVersionWeightsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowVersionWeightsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowVersionWeights
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWorkflowVersionWeightsUpdateResponse parses an HTTP response from a WorkflowVersionWeightsUpdateWithResponse call
+func ParseWorkflowVersionWeightsUpdateResponse(rsp *http.Response) (*WorkflowVersionWeightsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowVersionWeightsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowVersionWeights
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseWorkflowVersionGetResponse parses an HTTP response from a WorkflowVersionGetWithResponse call
 func ParseWorkflowVersionGetResponse(rsp *http.Response) (*WorkflowVersionGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
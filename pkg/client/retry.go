@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+)
+
+// RetryAfter returns the time after which the engine accepts a request which it rejected because the tenant is
+// overloaded, for example because the tenant exceeded its rate limit or has too many queued step runs. It returns
+// false if the error has no retry hint.
+func RetryAfter(err error) (time.Duration, bool) {
+	return backpressure.RetryAfter(err)
+}
+
+// delay returns the time to wait before the next attempt, which is at least the retry hint of the error.
+func (r RetryPolicy) delay(attempt int, err error) time.Duration {
+	delay := r.backoff(attempt)
+
+	if retryAfter, ok := RetryAfter(err); ok && retryAfter > delay {
+		return retryAfter
+	}
+
+	return delay
+}
+
+// retryUnaryInterceptor retries the calls which were rejected with a resource-exhausted error according to the
+// retry policy, until the context of the call is done.
+func retryUnaryInterceptor(policy RetryPolicy, l *zerolog.Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+
+			if status.Code(err) != codes.ResourceExhausted || (policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts) {
+				return err
+			}
+
+			delay := policy.delay(attempt, err)
+
+			l.Debug().Err(err).Msgf("%s was rejected, retrying in %s (attempt %d)", method, delay, attempt)
+
+			timer := time.NewTimer(delay)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/shared/backpressure"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	r := RetryPolicy{InitialInterval: time.Second}
+
+	overloaded := &backpressure.ResourceExhaustedError{Reason: "queue depth exceeded", RetryAfter: 10 * time.Second}
+
+	// the retry hint of the engine overrides a shorter backoff
+	assert.Equal(t, 10*time.Second, r.delay(1, status.Convert(overloaded).Err()))
+	assert.Equal(t, time.Second, r.delay(1, status.Error(codes.ResourceExhausted, "event limit exceeded")))
+}
+
+func TestRetryUnaryInterceptor(t *testing.T) {
+	l := zerolog.Nop()
+
+	overloaded := status.Convert(&backpressure.ResourceExhaustedError{
+		Reason:     "ingest rate limit exceeded",
+		RetryAfter: 10 * time.Millisecond,
+	}).Err()
+
+	invoker := func(results ...error) (grpc.UnaryInvoker, *int) {
+		calls := 0
+
+		return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			calls++
+			return results[min(calls, len(results))-1]
+		}, &calls
+	}
+
+	interceptor := retryUnaryInterceptor(RetryPolicy{InitialInterval: time.Millisecond}, &l)
+
+	t.Run("retries until accepted", func(t *testing.T) {
+		accepted, calls := invoker(overloaded, overloaded, nil)
+
+		assert.NoError(t, interceptor(context.Background(), "/Push", nil, nil, nil, accepted))
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		notFound, calls := invoker(status.Error(codes.NotFound, "workflow not found"))
+
+		assert.Equal(t, codes.NotFound, status.Code(interceptor(context.Background(), "/Push", nil, nil, nil, notFound)))
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("stops after max attempts", func(t *testing.T) {
+		rejected, calls := invoker(overloaded)
+
+		limited := retryUnaryInterceptor(RetryPolicy{InitialInterval: time.Millisecond, MaxAttempts: 2}, &l)
+
+		err := limited(context.Background(), "/Push", nil, nil, nil, rejected)
+
+		retryAfter, ok := RetryAfter(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, 10*time.Millisecond, retryAfter)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		rejected, calls := invoker(overloaded)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(interceptor(ctx, "/Push", nil, nil, nil, rejected)))
+		assert.Equal(t, 1, *calls)
+	})
+}
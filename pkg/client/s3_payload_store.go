@@ -0,0 +1,255 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3PayloadStoreOpts configures a payload store which stores payloads in a bucket of an S3-compatible
+// object storage, such as AWS S3, MinIO, or Google Cloud Storage with HMAC keys.
+type S3PayloadStoreOpts struct {
+	// Endpoint is the URL of the object storage, for example https://s3.us-east-1.amazonaws.com,
+	// https://storage.googleapis.com or http://localhost:9000.
+	Endpoint string
+
+	// Region is the region of the bucket, defaults to us-east-1.
+	Region string
+
+	// Bucket is the name of the bucket.
+	Bucket string
+
+	// Prefix is prepended to the keys of the stored payloads.
+	Prefix string
+
+	AccessKeyId     string
+	SecretAccessKey string
+
+	// SessionToken is the session token of temporary credentials, if any.
+	SessionToken string
+
+	// PathStyle addresses the bucket in the path of the URL instead of the host name, which MinIO requires.
+	PathStyle bool
+
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+type s3PayloadStore struct {
+	opts     S3PayloadStoreOpts
+	endpoint *url.URL
+	client   *http.Client
+}
+
+// NewS3PayloadStore returns a payload store which stores payloads in a bucket of an S3-compatible object
+// storage. Requests are signed with AWS Signature Version 4.
+func NewS3PayloadStore(opts S3PayloadStoreOpts) (PayloadStore, error) {
+	if opts.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+
+	if opts.AccessKeyId == "" || opts.SecretAccessKey == "" {
+		return nil, fmt.Errorf("access key id and secret access key are required")
+	}
+
+	endpoint, err := url.Parse(opts.Endpoint)
+
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q", opts.Endpoint)
+	}
+
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+
+	client := opts.HTTPClient
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &s3PayloadStore{
+		opts:     opts,
+		endpoint: endpoint,
+		client:   client,
+	}, nil
+}
+
+func (s *s3PayloadStore) Put(ctx context.Context, key string, data []byte) error {
+	res, err := s.do(ctx, http.MethodPut, key, data)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return s3Error(res)
+	}
+
+	return nil
+}
+
+func (s *s3PayloadStore) Get(ctx context.Context, key string) ([]byte, error) {
+	res, err := s.do(ctx, http.MethodGet, key, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, s3Error(res)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+func (s *s3PayloadStore) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	objectPath := "/" + s.opts.Prefix + key
+
+	if s.opts.PathStyle {
+		objectPath = "/" + s.opts.Bucket + objectPath
+	} else {
+		u.Host = s.opts.Bucket + "." + u.Host
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/") + objectPath
+	u.RawPath = s3EscapePath(u.Path)
+
+	return &u
+}
+
+func (s *s3PayloadStore) do(ctx context.Context, method, key string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key).String(), bytes.NewReader(body))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	if method == http.MethodPut {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	signS3Request(req, body, s.opts.Region, s.opts.AccessKeyId, s.opts.SecretAccessKey, s.opts.SessionToken, time.Now())
+
+	return s.client.Do(req)
+}
+
+func s3Error(res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+	return fmt.Errorf("unexpected status %d from object storage: %s", res.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// signS3Request signs the request with AWS Signature Version 4. All headers which are set on the request
+// are signed.
+func signS3Request(req *http.Request, body []byte, region, accessKeyId, secretAccessKey, sessionToken string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	payloadHash := sha256.Sum256(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+
+	headers := map[string]string{
+		"host": req.URL.Host,
+	}
+
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyId, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
+
+// s3EscapePath escapes each segment of the path as required by AWS Signature Version 4, which only leaves
+// unreserved characters unescaped.
+func s3EscapePath(p string) string {
+	segments := strings.Split(p, "/")
+
+	for i, segment := range segments {
+		var b strings.Builder
+
+		for _, c := range []byte(segment) {
+			if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+				b.WriteByte(c)
+			} else {
+				fmt.Fprintf(&b, "%%%02X", c)
+			}
+		}
+
+		segments[i] = b.String()
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer which the client and the worker create their spans with.
+const TracerName = "github.com/hatchet-dev/hatchet"
+
+// WithTracerProvider sets the OpenTelemetry tracer provider which the client creates spans with. By default,
+// the global tracer provider is used.
+//
+// The client stores the W3C trace context of the spans of Event().Push and Admin().RunWorkflow in the
+// traceparent and tracestate keys of the additional metadata of the event or workflow run, so that the
+// spans of the engine and of the step runs on workers are part of the same trace.
+func WithTracerProvider(tp trace.TracerProvider) ClientOpt {
+	return func(opts *ClientOpts) {
+		opts.tracerProvider = tp
+	}
+}
+
+// newTracer returns the tracer of the tracer provider, or of the global tracer provider if tp is nil.
+func newTracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	return tp.Tracer(TracerName)
+}
+
+// startSpan starts a span with the tracer, or with the tracer of the global tracer provider if it's nil.
+func startSpan(ctx context.Context, tracer trace.Tracer, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if tracer == nil {
+		tracer = newTracer(nil)
+	}
+
+	return tracer.Start(ctx, name, opts...)
+}
+
+// InjectTraceContext stores the W3C trace context of the span of ctx in the additional metadata. It does
+// nothing if ctx has no span.
+func InjectTraceContext(ctx context.Context, metadata map[string]string) {
+	propagation.TraceContext{}.Inject(ctx, propagation.MapCarrier(metadata))
+}
+
+// ExtractTraceContext returns ctx with the span context which is stored in the additional metadata as
+// its remote parent. It returns ctx unchanged if the metadata has no trace context.
+func ExtractTraceContext(ctx context.Context, metadata map[string]string) context.Context {
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier(metadata))
+}
+
+// injectTraceContextJSON stores the W3C trace context of the span of ctx in additional metadata which is
+// encoded as a JSON object. Metadata which isn't a JSON object is returned unchanged.
+func injectTraceContextJSON(ctx context.Context, metadata *string) (*string, error) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return metadata, nil
+	}
+
+	decoded := map[string]any{}
+
+	if metadata != nil && *metadata != "" {
+		if err := json.Unmarshal([]byte(*metadata), &decoded); err != nil {
+			return metadata, nil // nolint:nilerr
+		}
+	}
+
+	carrier := map[string]string{}
+	InjectTraceContext(ctx, carrier)
+
+	for key, value := range carrier {
+		decoded[key] = value
+	}
+
+	encoded, err := json.Marshal(decoded)
+
+	if err != nil {
+		return nil, err
+	}
+
+	encodedStr := string(encoded)
+
+	return &encodedStr, nil
+}
+
+// extractTraceContextJSON returns ctx with the span context which is stored in additional metadata which
+// is encoded as a JSON object as its remote parent.
+func extractTraceContextJSON(ctx context.Context, metadata *string) context.Context {
+	if metadata == nil || *metadata == "" {
+		return ctx
+	}
+
+	decoded := map[string]any{}
+
+	if err := json.Unmarshal([]byte(*metadata), &decoded); err != nil {
+		return ctx
+	}
+
+	carrier := map[string]string{}
+
+	for _, key := range (propagation.TraceContext{}).Fields() {
+		if value, ok := decoded[key].(string); ok {
+			carrier[key] = value
+		}
+	}
+
+	return ExtractTraceContext(ctx, carrier)
+}
+
+// endSpan ends the span, which is marked as failed if err is not nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+
+	span.End()
+}
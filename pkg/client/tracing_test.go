@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	eventcontracts "github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
+)
+
+type recordingEventsClient struct {
+	eventcontracts.EventsServiceClient
+
+	pushed *eventcontracts.PushEventRequest
+}
+
+func (r *recordingEventsClient) Push(ctx context.Context, in *eventcontracts.PushEventRequest, opts ...grpc.CallOption) (*eventcontracts.Event, error) {
+	r.pushed = in
+	return &eventcontracts.Event{}, nil
+}
+
+func TestPushInjectsTraceContext(t *testing.T) {
+	l := zerolog.Nop()
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	fake := &recordingEventsClient{}
+
+	events := &eventClientImpl{
+		client: fake,
+		l:      &l,
+		ctx:    newContextLoader(""),
+		tracer: newTracer(tp),
+	}
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+
+	err := events.Push(ctx, "order:created", map[string]string{"id": "1"}, WithEventMetadata(map[string]string{"source": "test"}))
+	require.NoError(t, err)
+
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	pushSpan := spans[0]
+	assert.Equal(t, "hatchet.push_event", pushSpan.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), pushSpan.Parent().SpanID())
+
+	require.NotNil(t, fake.pushed.AdditionalMetadata)
+
+	metadata := map[string]string{}
+	require.NoError(t, json.Unmarshal([]byte(*fake.pushed.AdditionalMetadata), &metadata))
+
+	assert.Equal(t, "test", metadata["source"])
+
+	// the workflow runs of the event are children of the push span
+	remote := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), metadata))
+	assert.Equal(t, pushSpan.SpanContext().TraceID(), remote.TraceID())
+	assert.Equal(t, pushSpan.SpanContext().SpanID(), remote.SpanID())
+}
+
+func TestTraceContextJSON(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "span")
+	defer span.End()
+
+	// metadata with values which aren't strings keeps them
+	metadata := `{"count":1}`
+
+	injected, err := injectTraceContextJSON(ctx, &metadata)
+	require.NoError(t, err)
+	assert.Contains(t, *injected, `"count":1`)
+	assert.Contains(t, *injected, "traceparent")
+
+	extracted := trace.SpanContextFromContext(extractTraceContextJSON(context.Background(), injected))
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+
+	// without a span, the metadata is unchanged
+	unchanged, err := injectTraceContextJSON(context.Background(), &metadata)
+	require.NoError(t, err)
+	assert.Equal(t, &metadata, unchanged)
+}
@@ -25,6 +25,8 @@ type Workflow struct {
 
 	ScheduleTimeout string `yaml:"scheduleTimeout,omitempty"`
 
+	RunTimeout string `yaml:"runTimeout,omitempty"`
+
 	Concurrency *WorkflowConcurrency `yaml:"concurrency,omitempty"`
 
 	Version string `yaml:"version,omitempty"`
@@ -62,6 +64,14 @@ type WorkflowTriggers struct {
 	Events    []string    `yaml:"events,omitempty"`
 	Cron      []string    `yaml:"crons,omitempty"`
 	Schedules []time.Time `yaml:"schedules,omitempty"`
+
+	// EventFilters are CEL expressions keyed by event key. An event only triggers the workflow if it
+	// matches the expression of its key.
+	EventFilters map[string]string `yaml:"eventFilters,omitempty"`
+
+	// CronCatchUpPolicies are the catch-up policies keyed by cron expression, either SKIP or FIRE_ONCE. A
+	// cron with the FIRE_ONCE policy starts a single run when it missed one or more runs.
+	CronCatchUpPolicies map[string]string `yaml:"cronCatchUpPolicies,omitempty"`
 }
 
 type RandomScheduleOpt string
@@ -124,6 +134,9 @@ type WorkflowStep struct {
 	DesiredLabels          map[string]*DesiredWorkerLabel `yaml:"desiredLabels,omitempty"`
 	RetryBackoffFactor     *float32                       `yaml:"retryBackoffFactor,omitempty"`
 	RetryMaxBackoffSeconds *int32                         `yaml:"retryMaxBackoffSeconds,omitempty"`
+	JoinCount              *int32                         `yaml:"joinCount,omitempty"`
+	RetryBackoffBaseMs     *int32                         `yaml:"retryBackoffBaseMs,omitempty"`
+	RetryBackoffJitter     *float32                       `yaml:"retryBackoffJitter,omitempty"`
 }
 
 type RateLimit struct {
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -28,6 +29,9 @@ func (r *Workflow) WorkflowRunId() string {
 
 type WorkflowResult struct {
 	workflowRun *dispatchercontracts.WorkflowRunEvent
+
+	// payloadStore is used to rehydrate offloaded outputs, nil if the client has no payload store
+	payloadStore PayloadStore
 }
 
 func (r *WorkflowResult) StepOutput(key string, v interface{}) error {
@@ -48,6 +52,12 @@ func (r *WorkflowResult) StepOutput(key string, v interface{}) error {
 		return fmt.Errorf("step output for %s not found", key)
 	}
 
+	outputBytes, err := RehydratePayload(context.Background(), r.payloadStore, outputBytes)
+
+	if err != nil {
+		return fmt.Errorf("could not rehydrate output: %w", err)
+	}
+
 	if err := json.Unmarshal(outputBytes, v); err != nil {
 		return fmt.Errorf("failed to unmarshal output: %w", err)
 	}
@@ -55,8 +65,18 @@ func (r *WorkflowResult) StepOutput(key string, v interface{}) error {
 	return nil
 }
 
+// Result waits for the workflow run to finish and returns its result. It blocks until the workflow run
+// finishes, see ResultWithContext to stop waiting earlier.
 func (c *Workflow) Result() (*WorkflowResult, error) {
-	resChan := make(chan *WorkflowResult)
+	return c.ResultWithContext(context.Background())
+}
+
+// ResultWithContext waits for the workflow run to finish and returns its result, or the error of ctx if
+// ctx is done first. Inside a step, pass the step's context so that the step stops waiting for a child
+// workflow when it is cancelled.
+func (c *Workflow) ResultWithContext(ctx context.Context) (*WorkflowResult, error) {
+	// the channel is buffered so that the result is kept if it arrives before we start waiting
+	resChan := make(chan *WorkflowResult, 1)
 
 	err := c.listener.AddWorkflowRun(
 		c.workflowRunId,
@@ -64,7 +84,8 @@ func (c *Workflow) Result() (*WorkflowResult, error) {
 			// non-blocking send
 			select {
 			case resChan <- &WorkflowResult{
-				workflowRun: event,
+				workflowRun:  event,
+				payloadStore: c.listener.payloadStore,
 			}: // continue
 			default:
 			}
@@ -77,7 +98,10 @@ func (c *Workflow) Result() (*WorkflowResult, error) {
 		return nil, fmt.Errorf("failed to listen for workflow events: %w", err)
 	}
 
-	res := <-resChan
-
-	return res, nil
+	select {
+	case res := <-resChan:
+		return res, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("stopped waiting for workflow run %s: %w", c.workflowRunId, ctx.Err())
+	}
 }
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+)
+
+type fakeWorkflowRunsClient struct {
+	dispatchercontracts.Dispatcher_SubscribeToWorkflowRunsClient
+
+	// called when the listener subscribes to a workflow run
+	onSend func(req *dispatchercontracts.SubscribeToWorkflowRunsRequest)
+}
+
+func (c *fakeWorkflowRunsClient) Send(req *dispatchercontracts.SubscribeToWorkflowRunsRequest) error {
+	if c.onSend != nil {
+		c.onSend(req)
+	}
+
+	return nil
+}
+
+func TestWorkflowResultWithContext(t *testing.T) {
+	l := zerolog.Nop()
+
+	runs := &fakeWorkflowRunsClient{}
+
+	listener := &WorkflowRunsListener{
+		client: runs,
+		l:      &l,
+	}
+
+	output := `{"total":3}`
+
+	// the workflow run finishes as soon as it is subscribed to
+	runs.onSend = func(req *dispatchercontracts.SubscribeToWorkflowRunsRequest) {
+		go func() {
+			_ = listener.handleWorkflowRun(&dispatchercontracts.WorkflowRunEvent{
+				WorkflowRunId: req.WorkflowRunId,
+				Results: []*dispatchercontracts.StepRunResult{
+					{StepReadableId: "sum", Output: &output},
+				},
+			})
+		}()
+	}
+
+	res, err := NewWorkflow("child-run-id", listener).ResultWithContext(context.Background())
+	require.NoError(t, err)
+
+	out := struct {
+		Total int `json:"total"`
+	}{}
+
+	require.NoError(t, res.StepOutput("sum", &out))
+	assert.Equal(t, 3, out.Total)
+
+	// the workflow run never finishes
+	runs.onSend = nil
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = NewWorkflow("other-run-id", listener).ResultWithContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
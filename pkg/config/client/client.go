@@ -21,8 +21,40 @@ type ClientConfigFile struct {
 
 	NoGrpcRetry bool `mapstructure:"noGrpcRetry" json:"noGrpcRetry,omitempty"`
 
+	// GRPCMaxMsgSize is the maximum size in bytes of the gRPC messages which the client sends and receives.
+	// The gRPC default of 4MB is used if it is 0.
+	GRPCMaxMsgSize int `mapstructure:"grpcMaxMsgSize" json:"grpcMaxMsgSize,omitempty"`
+
+	// GRPCCompression is the compression of the gRPC messages which the client sends, either gzip or zstd.
+	// Messages are not compressed if it is empty.
+	GRPCCompression string `mapstructure:"grpcCompression" json:"grpcCompression,omitempty"`
+
 	CloudRegisterID    *string  `mapstructure:"cloudRegisterID" json:"cloudRegisterID,omitempty"`
 	RawRunnableActions []string `mapstructure:"runnableActions" json:"runnableActions,omitempty"`
+
+	PayloadStore ClientPayloadStoreConfigFile `mapstructure:"payloadStore" json:"payloadStore,omitempty"`
+}
+
+// ClientPayloadStoreConfigFile configures the object storage to which large workflow inputs and step
+// outputs are offloaded. Payloads are only offloaded if a bucket is set.
+type ClientPayloadStoreConfigFile struct {
+	// OffloadThreshold is the size in bytes above which payloads are offloaded.
+	OffloadThreshold int `mapstructure:"offloadThreshold" json:"offloadThreshold,omitempty" default:"1048576"`
+
+	S3 ClientS3PayloadStoreConfigFile `mapstructure:"s3" json:"s3,omitempty"`
+}
+
+type ClientS3PayloadStoreConfigFile struct {
+	Endpoint        string `mapstructure:"endpoint" json:"endpoint,omitempty" default:"https://s3.amazonaws.com"`
+	Region          string `mapstructure:"region" json:"region,omitempty" default:"us-east-1"`
+	Bucket          string `mapstructure:"bucket" json:"bucket,omitempty"`
+	Prefix          string `mapstructure:"prefix" json:"prefix,omitempty"`
+	AccessKeyId     string `mapstructure:"accessKeyId" json:"accessKeyId,omitempty"`
+	SecretAccessKey string `mapstructure:"secretAccessKey" json:"secretAccessKey,omitempty"`
+	SessionToken    string `mapstructure:"sessionToken" json:"sessionToken,omitempty"`
+
+	// PathStyle addresses the bucket in the path of the URL instead of the host name, which MinIO requires.
+	PathStyle bool `mapstructure:"pathStyle" json:"pathStyle,omitempty"`
 }
 
 type ClientTLSConfigFile struct {
@@ -36,6 +68,9 @@ type ClientConfig struct {
 	Token       string
 	NoGrpcRetry bool
 
+	GRPCMaxMsgSize  int
+	GRPCCompression string
+
 	ServerURL            string
 	GRPCBroadcastAddress string
 
@@ -46,6 +81,8 @@ type ClientConfig struct {
 
 	CloudRegisterID *string
 	RunnableActions []string
+
+	PayloadStore ClientPayloadStoreConfigFile
 }
 
 func BindAllEnv(v *viper.Viper) {
@@ -57,6 +94,8 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("cloudRegisterID", "HATCHET_CLOUD_REGISTER_ID")
 	_ = v.BindEnv("runnableActions", "HATCHET_CLOUD_ACTIONS")
 	_ = v.BindEnv("noGrpcRetry", "HATCHET_CLIENT_NO_GRPC_RETRY")
+	_ = v.BindEnv("grpcMaxMsgSize", "HATCHET_CLIENT_GRPC_MAX_MSG_SIZE")
+	_ = v.BindEnv("grpcCompression", "HATCHET_CLIENT_GRPC_COMPRESSION")
 
 	// tls options
 	_ = v.BindEnv("tls.base.tlsStrategy", "HATCHET_CLIENT_TLS_STRATEGY")
@@ -67,4 +106,15 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("tls.base.tlsKey", "HATCHET_CLIENT_TLS_KEY")
 	_ = v.BindEnv("tls.base.tlsRootCA", "HATCHET_CLIENT_TLS_ROOT_CA")
 	_ = v.BindEnv("tls.tlsServerName", "HATCHET_CLIENT_TLS_SERVER_NAME")
+
+	// payload store options
+	_ = v.BindEnv("payloadStore.offloadThreshold", "HATCHET_CLIENT_PAYLOAD_OFFLOAD_THRESHOLD")
+	_ = v.BindEnv("payloadStore.s3.endpoint", "HATCHET_CLIENT_PAYLOAD_STORE_S3_ENDPOINT")
+	_ = v.BindEnv("payloadStore.s3.region", "HATCHET_CLIENT_PAYLOAD_STORE_S3_REGION")
+	_ = v.BindEnv("payloadStore.s3.bucket", "HATCHET_CLIENT_PAYLOAD_STORE_S3_BUCKET")
+	_ = v.BindEnv("payloadStore.s3.prefix", "HATCHET_CLIENT_PAYLOAD_STORE_S3_PREFIX")
+	_ = v.BindEnv("payloadStore.s3.accessKeyId", "HATCHET_CLIENT_PAYLOAD_STORE_S3_ACCESS_KEY_ID")
+	_ = v.BindEnv("payloadStore.s3.secretAccessKey", "HATCHET_CLIENT_PAYLOAD_STORE_S3_SECRET_ACCESS_KEY")
+	_ = v.BindEnv("payloadStore.s3.sessionToken", "HATCHET_CLIENT_PAYLOAD_STORE_S3_SESSION_TOKEN")
+	_ = v.BindEnv("payloadStore.s3.pathStyle", "HATCHET_CLIENT_PAYLOAD_STORE_S3_PATH_STYLE")
 }
@@ -4,13 +4,21 @@ package loader
 
 import (
 	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
 	"github.com/exaring/otelpgx"
 	pgxzero "github.com/jackc/pgx-zerolog"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -20,12 +28,17 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email/postmark"
+	"github.com/hatchet-dev/hatchet/internal/integrations/email/resend"
+	"github.com/hatchet-dev/hatchet/internal/integrations/email/ses"
+	"github.com/hatchet-dev/hatchet/internal/integrations/email/smtp"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	natsmq "github.com/hatchet-dev/hatchet/internal/msgqueue/nats"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue/postgres"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue/rabbitmq"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/analytics/posthog"
+	"github.com/hatchet-dev/hatchet/pkg/auth/authorizer"
 	"github.com/hatchet-dev/hatchet/pkg/auth/cookie"
 	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
@@ -250,6 +263,10 @@ func GetDatabaseConfigFromConfigFile(cf *database.ConfigFile, runtime *server.Co
 }
 
 func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigFile, version string) (cleanup func() error, res *server.ServerConfig, err error) {
+	if err := cf.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid server config: %w", err)
+	}
+
 	l := logger.NewStdErr(&cf.Logger, "server")
 	queueLogger := logger.NewStdErr(&cf.AdditionalLoggers.Queue, "queue")
 
@@ -259,6 +276,12 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		return nil, nil, fmt.Errorf("could not load TLS config: %w", err)
 	}
 
+	kafkaTLS, err := loaderutils.LoadKafkaTLSConfig(&cf.Kafka.TLS)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load Kafka TLS config: %w", err)
+	}
+
 	ss, err := cookie.NewUserSessionStore(
 		cookie.WithSessionRepository(dc.APIRepository.UserSession()),
 		cookie.WithCookieAllowInsecure(cf.Auth.Cookie.Insecure),
@@ -295,6 +318,20 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 				rabbitmq.WithQos(cf.MessageQueue.RabbitMQ.Qos),
 				rabbitmq.WithDisableTenantExchangePubs(cf.Runtime.DisableTenantPubs),
 			)
+		case "nats":
+			cleanup1, mq, err = natsmq.New(
+				natsmq.WithURL(cf.MessageQueue.NATS.URL),
+				natsmq.WithLogger(&l),
+				natsmq.WithQos(cf.MessageQueue.NATS.Qos),
+				natsmq.WithStream(cf.MessageQueue.NATS.Stream, cf.MessageQueue.NATS.StreamReplicas),
+				natsmq.WithDisableTenantPubs(cf.Runtime.DisableTenantPubs),
+			)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not create nats message queue: %w", err)
+			}
+		default:
+			return nil, nil, fmt.Errorf("unknown message queue kind %q, must be postgres, rabbitmq or nats", cf.MessageQueue.Kind)
 		}
 
 		ing, err = ingestor.NewIngestor(
@@ -303,6 +340,8 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 			ingestor.WithLogRepository(dc.EngineRepository.Log()),
 			ingestor.WithMessageQueue(mq),
 			ingestor.WithEntitlementsRepository(dc.EntitlementRepository),
+			ingestor.WithStepRunRepository(dc.EngineRepository.StepRun()),
+			ingestor.WithMaxQueueDepth(cf.Runtime.MaxEventQueueDepth, cf.Runtime.EventQueueDepthRetryAfter),
 		)
 
 		if err != nil {
@@ -363,10 +402,6 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 	var pylon server.PylonConfig
 
 	if cf.Pylon.Enabled {
-		if cf.Pylon.AppID == "" {
-			return nil, nil, fmt.Errorf("pylon app id is required")
-		}
-
 		pylon.AppID = cf.Pylon.AppID
 		pylon.Secret = cf.Pylon.Secret
 	}
@@ -374,17 +409,10 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 	auth := server.AuthConfig{
 		RestrictedEmailDomains: getStrArr(cf.Auth.RestrictedEmailDomains),
 		ConfigFile:             cf.Auth,
+		Authorizer:             authorizer.NewPermissionAuthorizer(),
 	}
 
 	if cf.Auth.Google.Enabled {
-		if cf.Auth.Google.ClientID == "" {
-			return nil, nil, fmt.Errorf("google client id is required")
-		}
-
-		if cf.Auth.Google.ClientSecret == "" {
-			return nil, nil, fmt.Errorf("google client secret is required")
-		}
-
 		gClient := oauth.NewGoogleClient(&oauth.Config{
 			ClientID:     cf.Auth.Google.ClientID,
 			ClientSecret: cf.Auth.Google.ClientSecret,
@@ -396,14 +424,6 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 	}
 
 	if cf.Auth.Github.Enabled {
-		if cf.Auth.Github.ClientID == "" {
-			return nil, nil, fmt.Errorf("github client id is required")
-		}
-
-		if cf.Auth.Github.ClientSecret == "" {
-			return nil, nil, fmt.Errorf("github client secret is required")
-		}
-
 		auth.GithubOAuthConfig = oauth.NewGithubClient(&oauth.Config{
 			ClientID:     cf.Auth.Github.ClientID,
 			ClientSecret: cf.Auth.Github.ClientSecret,
@@ -412,6 +432,20 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		})
 	}
 
+	auth.OAuthProviders, err = loadOAuthProviders(cf)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load OAuth providers: %w", err)
+	}
+
+	if cf.Auth.SAML.Enabled {
+		auth.SAML, err = loadSAMLServiceProvider(cf)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not load SAML service provider: %w", err)
+		}
+	}
+
 	encryptionSvc, err := loadEncryptionSvc(cf)
 
 	if err != nil {
@@ -430,15 +464,65 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		return nil, nil, fmt.Errorf("could not create JWT manager: %w", err)
 	}
 
+	auth.OAuthTokenRefresher = oauth.NewTokenRefresher(encryptionSvc, oauthClients(&auth))
+
 	var emailSvc email.EmailService = &email.NoOpService{}
 
-	if cf.Email.Postmark.Enabled {
+	switch {
+	case cf.Email.Postmark.Enabled:
 		emailSvc = postmark.NewPostmarkClient(
 			cf.Email.Postmark.ServerKey,
 			cf.Email.Postmark.FromEmail,
 			cf.Email.Postmark.FromName,
 			cf.Email.Postmark.SupportEmail,
 		)
+	case cf.Email.SMTP.Enabled:
+		emailSvc, err = email.NewTemplatedService(
+			smtp.NewSMTPSender(
+				cf.Email.SMTP.Host,
+				cf.Email.SMTP.Port,
+				cf.Email.SMTP.Username,
+				cf.Email.SMTP.Password,
+				cf.Email.SMTP.TLS,
+			),
+			cf.Email.SMTP.FromEmail,
+			cf.Email.SMTP.FromName,
+			cf.Email.SMTP.SupportEmail,
+		)
+	case cf.Email.SES.Enabled:
+		var sender *ses.SESSender
+
+		sender, err = ses.NewSESSender(
+			context.Background(),
+			cf.Email.SES.Region,
+			cf.Email.SES.AccessKeyID,
+			cf.Email.SES.SecretAccessKey,
+			cf.Email.SES.ConfigurationSet,
+		)
+
+		if err == nil {
+			emailSvc, err = email.NewTemplatedService(
+				sender,
+				cf.Email.SES.FromEmail,
+				cf.Email.SES.FromName,
+				cf.Email.SES.SupportEmail,
+			)
+		}
+	case cf.Email.Resend.Enabled:
+		emailSvc, err = email.NewTemplatedService(
+			resend.NewResendSender(cf.Email.Resend.APIKey),
+			cf.Email.Resend.FromEmail,
+			cf.Email.Resend.FromName,
+			cf.Email.Resend.SupportEmail,
+		)
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create email service: %w", err)
+	}
+
+	if emailSvc.IsValid() && cf.Email.AlertRateLimit > 0 {
+		emailSvc = email.NewRateLimitedService(emailSvc, cf.Email.AlertRateLimit, &l)
 	}
 
 	additionalOAuthConfigs := make(map[string]*oauth2.Config)
@@ -472,7 +556,7 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		}
 
 		if err := cleanup1(); err != nil {
-			return fmt.Errorf("error cleaning up rabbitmq: %w", err)
+			return fmt.Errorf("error cleaning up message queue: %w", err)
 		}
 		return nil
 	}
@@ -489,6 +573,7 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 	}
 
 	return cleanup, &server.ServerConfig{
+		Version:                version,
 		Alerter:                alerter,
 		Analytics:              analyticsEmitter,
 		FePosthog:              feAnalyticsConfig,
@@ -505,13 +590,18 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		Validator:              v,
 		Ingestor:               ing,
 		OpenTelemetry:          cf.OpenTelemetry,
+		Prometheus:             cf.Prometheus,
 		Email:                  emailSvc,
 		TenantAlerter:          alerting.New(dc.EngineRepository, encryptionSvc, cf.Runtime.ServerURL, emailSvc),
 		AdditionalOAuthConfigs: additionalOAuthConfigs,
 		AdditionalLoggers:      cf.AdditionalLoggers,
 		EnableDataRetention:    cf.EnableDataRetention,
 		EnableWorkerRetention:  cf.EnableWorkerRetention,
+		EnableDataPurge:        cf.EnableDataPurge,
+		DataPurgeDelay:         cf.DataPurgeDelay,
 		SchedulingPool:         schedulingPool,
+		Kafka:                  cf.Kafka,
+		KafkaTLSConfig:         kafkaTLS,
 	}, nil
 }
 
@@ -522,24 +612,10 @@ func getStrArr(v string) []string {
 func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionService, error) {
 	var err error
 
+	// the keysets which are required were checked by ServerConfigFile.Validate
 	hasLocalMasterKeyset := cf.Encryption.MasterKeyset != "" || cf.Encryption.MasterKeysetFile != ""
 	isCloudKMSEnabled := cf.Encryption.CloudKMS.Enabled
 
-	if !hasLocalMasterKeyset && !isCloudKMSEnabled {
-		return nil, fmt.Errorf("encryption is required")
-	}
-
-	if hasLocalMasterKeyset && isCloudKMSEnabled {
-		return nil, fmt.Errorf("cannot use both encryption and cloud kms")
-	}
-
-	hasJWTKeys := (cf.Encryption.JWT.PublicJWTKeyset != "" || cf.Encryption.JWT.PublicJWTKeysetFile != "") &&
-		(cf.Encryption.JWT.PrivateJWTKeyset != "" || cf.Encryption.JWT.PrivateJWTKeysetFile != "")
-
-	if !hasJWTKeys {
-		return nil, fmt.Errorf("jwt encryption is required")
-	}
-
 	privateJWT := cf.Encryption.JWT.PrivateJWTKeyset
 
 	if cf.Encryption.JWT.PrivateJWTKeysetFile != "" {
@@ -565,8 +641,11 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 	}
 
 	var encryptionSvc encryption.EncryptionService
+	var backend string
 
 	if hasLocalMasterKeyset {
+		backend = encryption.BackendLocal
+
 		masterKeyset := cf.Encryption.MasterKeyset
 
 		if cf.Encryption.MasterKeysetFile != "" {
@@ -579,10 +658,17 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 			masterKeyset = string(masterKeysetBytes)
 		}
 
+		var opts []encryption.LocalEncryptionOpt
+
+		if cf.Encryption.TenantKeys {
+			opts = append(opts, encryption.WithDerivedTenantKeys())
+		}
+
 		encryptionSvc, err = encryption.NewLocalEncryption(
 			[]byte(masterKeyset),
 			[]byte(privateJWT),
 			[]byte(publicJWT),
+			opts...,
 		)
 
 		if err != nil {
@@ -591,11 +677,14 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 	}
 
 	if isCloudKMSEnabled {
+		backend = encryption.BackendCloudKMS
+
 		encryptionSvc, err = encryption.NewCloudKMSEncryption(
 			cf.Encryption.CloudKMS.KeyURI,
 			[]byte(cf.Encryption.CloudKMS.CredentialsJSON),
 			[]byte(privateJWT),
 			[]byte(publicJWT),
+			encryption.WithTenantKeyURITemplate(cf.Encryption.CloudKMS.TenantKeyURITemplate),
 		)
 
 		if err != nil {
@@ -603,5 +692,166 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 		}
 	}
 
-	return encryptionSvc, nil
+	// fail fast if the backend can't encrypt, instead of failing on the first login
+	if err := encryption.SelfTest(encryptionSvc); err != nil {
+		return nil, fmt.Errorf("encryption self-test with the %s backend failed: %w", backend, err)
+	}
+
+	return encryption.Instrument(encryptionSvc, backend), nil
+}
+
+// oauthClients returns the clients of the configured OAuth providers, keyed by the provider name which is
+// stored with the accounts of users.
+func oauthClients(auth *server.AuthConfig) map[string]*oauth2.Config {
+	clients := make(map[string]*oauth2.Config, len(auth.OAuthProviders)+2)
+
+	if auth.GoogleOAuthConfig != nil {
+		clients["google"] = auth.GoogleOAuthConfig
+	}
+
+	if auth.GithubOAuthConfig != nil {
+		clients["github"] = auth.GithubOAuthConfig
+	}
+
+	for name, provider := range auth.OAuthProviders {
+		clients[name] = provider.Config
+	}
+
+	return clients
+}
+
+func loadOAuthProviders(cf *server.ServerConfigFile) (map[string]*server.OAuthProvider, error) {
+	providers := make(map[string]*server.OAuthProvider, len(cf.Auth.OAuthProviders))
+
+	for _, p := range cf.Auth.OAuthProviders {
+		claims := p.Claims
+
+		if claims.Subject == "" {
+			claims.Subject = "sub"
+		}
+
+		if claims.Email == "" {
+			claims.Email = "email"
+		}
+
+		if claims.EmailVerified == "" {
+			claims.EmailVerified = "email_verified"
+		}
+
+		if claims.Name == "" {
+			claims.Name = "name"
+		}
+
+		if claims.Groups == "" {
+			claims.Groups = "groups"
+		}
+
+		endpoint := oauth2.Endpoint{
+			AuthURL:  p.AuthURL,
+			TokenURL: p.TokenURL,
+		}
+
+		scopes := p.Scopes
+
+		var verifier *oidc.IDTokenVerifier
+
+		if p.IssuerURL != "" {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			oidcProvider, err := oidc.NewProvider(ctx, p.IssuerURL)
+			cancel()
+
+			if err != nil {
+				return nil, fmt.Errorf("could not discover OpenID Connect provider %s: %w", p.Name, err)
+			}
+
+			endpoint = oidcProvider.Endpoint()
+			verifier = oidcProvider.Verifier(&oidc.Config{
+				ClientID: p.ClientID,
+			})
+
+			if len(scopes) == 0 {
+				scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+			}
+		}
+
+		providers[p.Name] = &server.OAuthProvider{
+			Name: p.Name,
+			Config: oauth.NewProviderClient(&oauth.Config{
+				ClientID:     p.ClientID,
+				ClientSecret: p.ClientSecret,
+				BaseURL:      cf.Runtime.ServerURL,
+				Scopes:       scopes,
+			}, p.Name, endpoint),
+			UserInfoURL:     p.UserInfoURL,
+			IDTokenVerifier: verifier,
+			Claims:          claims,
+			RoleMappings:    p.RoleMappings,
+		}
+	}
+
+	return providers, nil
+}
+
+// loadSAMLServiceProvider returns the SAML service provider of this instance, whose metadata and assertion
+// consumer service endpoints are served under /api/v1/users/saml.
+func loadSAMLServiceProvider(cf *server.ServerConfigFile) (*saml.ServiceProvider, error) {
+	keyPair, err := tls.X509KeyPair([]byte(cf.Auth.SAML.Certificate), []byte(cf.Auth.SAML.PrivateKey))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse certificate and private key: %w", err)
+	}
+
+	key, ok := keyPair.PrivateKey.(crypto.Signer)
+
+	if !ok {
+		return nil, fmt.Errorf("private key cannot sign")
+	}
+
+	keyPair.Leaf, err = x509.ParseCertificate(keyPair.Certificate[0])
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse certificate: %w", err)
+	}
+
+	var idpMetadata *saml.EntityDescriptor
+
+	switch {
+	case cf.Auth.SAML.IdPMetadata != "":
+		idpMetadata, err = samlsp.ParseMetadata([]byte(cf.Auth.SAML.IdPMetadata))
+	case cf.Auth.SAML.IdPMetadataURL != "":
+		var metadataURL *url.URL
+
+		metadataURL, err = url.Parse(cf.Auth.SAML.IdPMetadataURL)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse identity provider metadata URL: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		idpMetadata, err = samlsp.FetchMetadata(ctx, http.DefaultClient, *metadataURL)
+	default:
+		return nil, fmt.Errorf("either idpMetadata or idpMetadataURL must be set")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load identity provider metadata: %w", err)
+	}
+
+	serverURL, err := url.Parse(cf.Runtime.ServerURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse server URL: %w", err)
+	}
+
+	return &saml.ServiceProvider{
+		EntityID:          cf.Auth.SAML.EntityID,
+		Key:               key,
+		Certificate:       keyPair.Leaf,
+		MetadataURL:       *serverURL.JoinPath("/api/v1/users/saml/metadata"),
+		AcsURL:            *serverURL.JoinPath("/api/v1/users/saml/acs"),
+		IDPMetadata:       idpMetadata,
+		AllowIDPInitiated: cf.Auth.SAML.AllowIdPInitiated,
+	}, nil
 }
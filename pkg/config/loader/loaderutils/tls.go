@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/hatchet-dev/hatchet/pkg/config/client"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/config/shared"
 )
 
@@ -104,3 +105,40 @@ func LoadBaseTLSConfig(tlsConfig *shared.TLSConfigFile) (*tls.Config, *x509.Cert
 
 	return res, ca, nil
 }
+
+// LoadKafkaTLSConfig returns the TLS config of the connections to the Kafka brokers, or nil if TLS is disabled.
+func LoadKafkaTLSConfig(tlsConfig *server.KafkaTLSConfigFile) (*tls.Config, error) {
+	if !tlsConfig.Enabled {
+		return nil, nil
+	}
+
+	res := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if tlsConfig.RootCAFile != "" {
+		caBytes, err := os.ReadFile(tlsConfig.RootCAFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read root CA file: %w", err)
+		}
+
+		res.RootCAs = x509.NewCertPool()
+
+		if ok := res.RootCAs.AppendCertsFromPEM(caBytes); !ok {
+			return nil, fmt.Errorf("could not append root CA to cert pool")
+		}
+	}
+
+	if tlsConfig.CertFile != "" || tlsConfig.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %w", err)
+		}
+
+		res.Certificates = []tls.Certificate{cert}
+	}
+
+	return res, nil
+}
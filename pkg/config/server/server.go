@@ -4,6 +4,8 @@ import (
 	"crypto/tls"
 	"time"
 
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/crewjam/saml"
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 	"golang.org/x/oauth2"
@@ -13,7 +15,9 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
+	"github.com/hatchet-dev/hatchet/pkg/auth/authorizer"
 	"github.com/hatchet-dev/hatchet/pkg/auth/cookie"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
 	"github.com/hatchet-dev/hatchet/pkg/config/shared"
@@ -48,6 +52,12 @@ type ServerConfigFile struct {
 
 	EnableWorkerRetention bool `mapstructure:"enableWorkerRetention" json:"enableWorkerRetention,omitempty" default:"false"`
 
+	// EnableDataPurge permanently deletes workflow runs and events which were deleted by data retention, once they
+	// were deleted for at least DataPurgeDelay. Requires EnableDataRetention.
+	EnableDataPurge bool `mapstructure:"enableDataPurge" json:"enableDataPurge,omitempty" default:"true"`
+
+	DataPurgeDelay time.Duration `mapstructure:"dataPurgeDelay" json:"dataPurgeDelay,omitempty" default:"24h"`
+
 	TLS shared.TLSConfigFile `mapstructure:"tls" json:"tls,omitempty"`
 
 	Logger shared.LoggerConfigFile `mapstructure:"logger" json:"logger,omitempty"`
@@ -56,6 +66,8 @@ type ServerConfigFile struct {
 
 	OpenTelemetry shared.OpenTelemetryConfigFile `mapstructure:"otel" json:"otel,omitempty"`
 
+	Prometheus shared.PrometheusConfigFile `mapstructure:"prometheus" json:"prometheus,omitempty"`
+
 	SecurityCheck SecurityCheckConfigFile `mapstructure:"securityCheck" json:"securityCheck,omitempty"`
 
 	TenantAlerting ConfigFileTenantAlerting `mapstructure:"tenantAlerting" json:"tenantAlerting,omitempty"`
@@ -63,6 +75,8 @@ type ServerConfigFile struct {
 	Email ConfigFileEmail `mapstructure:"email" json:"email,omitempty"`
 
 	Monitoring ConfigFileMonitoring `mapstructure:"monitoring" json:"monitoring,omitempty"`
+
+	Kafka KafkaConfigFile `mapstructure:"kafka" json:"kafka,omitempty"`
 }
 
 type ConfigFileAdditionalLoggers struct {
@@ -144,6 +158,27 @@ type ConfigFileRuntime struct {
 	// MaxInternalRetryCount is the maximum number of internal retries before a step run is considered failed (default: 3)
 	MaxInternalRetryCount int32 `mapstructure:"maxInternalRetryCount" json:"maxInternalRetryCount,omitempty" default:"3"`
 
+	// WorkerHeartbeatInterval is the interval at which workers are expected to send heartbeats, and at which the engine
+	// refreshes the heartbeats of workers which are connected to it. Must be less than 5 seconds, since step runs are
+	// only assigned to workers with a heartbeat in the last 5 seconds.
+	WorkerHeartbeatInterval time.Duration `mapstructure:"workerHeartbeatInterval" json:"workerHeartbeatInterval,omitempty" default:"4s"`
+
+	// WorkerHeartbeatTimeout is the time since the last heartbeat of a worker after which the worker is marked as
+	// inactive and the step runs which are assigned to it are released back to the queue.
+	WorkerHeartbeatTimeout time.Duration `mapstructure:"workerHeartbeatTimeout" json:"workerHeartbeatTimeout,omitempty" default:"30s"`
+
+	// MaxBufferedOrderedEvents is the number of pending events of an ordering key which are buffered while waiting for a
+	// missing sequence number. Once the limit is reached, the missing sequence numbers are skipped.
+	MaxBufferedOrderedEvents int `mapstructure:"maxBufferedOrderedEvents" json:"maxBufferedOrderedEvents,omitempty" default:"1000"`
+
+	// MaxEventQueueDepth is the number of queued step runs of a tenant above which new events are rejected with a
+	// resource-exhausted error. A value of 0 disables the limit.
+	MaxEventQueueDepth int `mapstructure:"maxEventQueueDepth" json:"maxEventQueueDepth,omitempty" default:"0"`
+
+	// EventQueueDepthRetryAfter is the time after which clients should retry the events which were rejected because of
+	// MaxEventQueueDepth.
+	EventQueueDepthRetryAfter time.Duration `mapstructure:"eventQueueDepthRetryAfter" json:"eventQueueDepthRetryAfter,omitempty" default:"10s"`
+
 	// WaitForFlush is the time to wait for the buffer to flush used for exerting some back pressure on writers
 	WaitForFlush time.Duration `mapstructure:"waitForFlush" json:"waitForFlush,omitempty" default:"1"`
 
@@ -193,6 +228,9 @@ type LimitConfigFile struct {
 	DefaultEventAlarmLimit int           `mapstructure:"defaultEventAlarmLimit" json:"defaultEventAlarmLimit,omitempty" default:"750"`
 	DefaultEventWindow     time.Duration `mapstructure:"defaultEventWindow" json:"defaultEventWindow,omitempty" default:"24h"`
 
+	DefaultQueuedRunLimit      int `mapstructure:"defaultQueuedRunLimit" json:"defaultQueuedRunLimit,omitempty" default:"1000"`
+	DefaultQueuedRunAlarmLimit int `mapstructure:"defaultQueuedRunAlarmLimit" json:"defaultQueuedRunAlarmLimit,omitempty" default:"750"`
+
 	DefaultCronLimit      int `mapstructure:"defaultCronLimit" json:"defaultCronLimit,omitempty" default:"5"`
 	DefaultCronAlarmLimit int `mapstructure:"defaultCronAlarmLimit" json:"defaultCronAlarmLimit,omitempty" default:"2"`
 
@@ -250,6 +288,11 @@ type EncryptionConfigFile struct {
 
 	// CloudKMS is the configuration for Google Cloud KMS. You must set either MasterKeyset or cloudKms.enabled.
 	CloudKMS EncryptionConfigFileCloudKMS `mapstructure:"cloudKms" json:"cloudKms,omitempty"`
+
+	// TenantKeys encrypts the data of each tenant with its own key, derived from the master keyset with HKDF.
+	// Data which was encrypted before tenant keys were enabled can still be decrypted. With Cloud KMS, set
+	// cloudKms.tenantKeyURITemplate instead.
+	TenantKeys bool `mapstructure:"tenantKeys" json:"tenantKeys,omitempty" default:"false"`
 }
 
 type EncryptionConfigFileJWT struct {
@@ -278,6 +321,11 @@ type EncryptionConfigFileCloudKMS struct {
 
 	// CredentialsJSON is the JSON credentials for the Google Cloud KMS service account.
 	CredentialsJSON string `mapstructure:"credentialsJSON" json:"credentialsJSON,omitempty"`
+
+	// TenantKeyURITemplate encrypts the data of each tenant with its own key in Google Cloud KMS. The key URI
+	// of a tenant is this template with {tenant_id} replaced by the tenant id, for example
+	// gcp-kms://projects/p/locations/l/keyRings/hatchet/cryptoKeys/tenant-{tenant_id}.
+	TenantKeyURITemplate string `mapstructure:"tenantKeyURITemplate" json:"tenantKeyURITemplate,omitempty"`
 }
 
 type ConfigFileAuth struct {
@@ -292,12 +340,32 @@ type ConfigFileAuth struct {
 	// SetEmailVerified controls whether the user's email is automatically set to verified
 	SetEmailVerified bool `mapstructure:"setEmailVerified" json:"setEmailVerified,omitempty" default:"false"`
 
+	// AllowedRedirectURLs is a list of URLs whose origins may be used as the return_to destination
+	// after an OAuth login, in addition to the server URL
+	AllowedRedirectURLs []string `mapstructure:"allowedRedirectURLs" json:"allowedRedirectURLs,omitempty"`
+
+	// ErrorRedirectURL is the page which OAuth login errors redirect to, with the error, error code and
+	// request ID set as query parameters
+	ErrorRedirectURL string `mapstructure:"errorRedirectURL" json:"errorRedirectURL,omitempty" default:"/auth/login"`
+
+	// OAuthLinkPolicy controls when an OAuth login is linked to an existing user with the same email. See
+	// the OAuthLinkPolicy constants for the allowed values.
+	OAuthLinkPolicy string `mapstructure:"oauthLinkPolicy" json:"oauthLinkPolicy,omitempty" default:"verified"`
+
 	// Configuration options for the cookie
 	Cookie ConfigFileAuthCookie `mapstructure:"cookie" json:"cookie,omitempty"`
 
 	Google ConfigFileAuthGoogle `mapstructure:"google" json:"google,omitempty"`
 
 	Github ConfigFileAuthGithub `mapstructure:"github" json:"github,omitempty"`
+
+	// OAuthProviders are additional OAuth 2.0 providers which users can log in with. Each provider has its
+	// own start and callback routes which are keyed by the name of the provider.
+	OAuthProviders []ConfigFileAuthOAuthProvider `mapstructure:"oauthProviders" json:"oauthProviders,omitempty"`
+
+	// SAML configures this Hatchet instance as a SAML 2.0 service provider, so that users can log in with
+	// a SAML identity provider.
+	SAML ConfigFileAuthSAML `mapstructure:"saml" json:"saml,omitempty"`
 }
 
 type ConfigFileTenantAlerting struct {
@@ -328,6 +396,99 @@ type ConfigFileAuthGithub struct {
 	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty" default:"[\"read:user\", \"user:email\"]"`
 }
 
+type ConfigFileAuthOAuthProvider struct {
+	// Name identifies the provider in the /api/v1/users/oauth/{name}/start and callback routes, and is
+	// recorded as the provider of the OAuth accounts which are linked to users.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+
+	// DisplayName is the name of the provider on the login page, which defaults to Name.
+	DisplayName string `mapstructure:"displayName" json:"displayName,omitempty"`
+
+	ClientID     string   `mapstructure:"clientID" json:"clientID,omitempty"`
+	ClientSecret string   `mapstructure:"clientSecret" json:"clientSecret,omitempty"`
+	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty"`
+
+	// IssuerURL is the issuer of an OpenID Connect provider. If it is set, the endpoints of the provider are
+	// discovered from its .well-known/openid-configuration document when the server starts, and the claims
+	// of the user are read from the verified ID token instead of the user info URL.
+	IssuerURL string `mapstructure:"issuerURL" json:"issuerURL,omitempty"`
+
+	// AuthURL and TokenURL are the authorization and token endpoints of the provider.
+	AuthURL  string `mapstructure:"authURL" json:"authURL,omitempty"`
+	TokenURL string `mapstructure:"tokenURL" json:"tokenURL,omitempty"`
+
+	// UserInfoURL is the resource which returns the claims of the user as a JSON object when it is called
+	// with the access token, such as the OIDC userinfo endpoint.
+	UserInfoURL string `mapstructure:"userInfoURL" json:"userInfoURL,omitempty"`
+
+	// Claims maps the claims of the user info response to the fields of a user.
+	Claims ConfigFileAuthOAuthClaims `mapstructure:"claims" json:"claims,omitempty"`
+
+	// RoleMappings add users who log in with the provider to tenants, with a role which depends on the
+	// groups claim of the user.
+	RoleMappings []ConfigFileAuthOAuthRoleMapping `mapstructure:"roleMappings" json:"roleMappings,omitempty"`
+}
+
+// ConfigFileAuthOAuthRoleMapping makes the users of a group members of a tenant. Users who are in several
+// mapped groups of a tenant get the highest of their roles.
+type ConfigFileAuthOAuthRoleMapping struct {
+	// Group is a value of the groups claim.
+	Group string `mapstructure:"group" json:"group,omitempty"`
+
+	// Tenant is the slug of the tenant.
+	Tenant string `mapstructure:"tenant" json:"tenant,omitempty"`
+
+	// Role is the role of the users in the tenant, which is one of OWNER, ADMIN or MEMBER.
+	Role string `mapstructure:"role" json:"role,omitempty"`
+}
+
+// ConfigFileAuthOAuthClaims are the keys of the claims in the user info response or ID token of an OAuth
+// provider. Empty keys default to the standard OIDC claims.
+type ConfigFileAuthOAuthClaims struct {
+	Subject       string `mapstructure:"subject" json:"subject,omitempty"`
+	Email         string `mapstructure:"email" json:"email,omitempty"`
+	EmailVerified string `mapstructure:"emailVerified" json:"emailVerified,omitempty"`
+	Name          string `mapstructure:"name" json:"name,omitempty"`
+
+	// Groups is the claim which contains the groups of the user, as a list or a single string.
+	Groups string `mapstructure:"groups" json:"groups,omitempty"`
+}
+
+type ConfigFileAuthSAML struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// IdPMetadataURL is the URL of the metadata of the identity provider, which is fetched when the server
+	// starts. IdPMetadata can be set to the XML metadata instead.
+	IdPMetadataURL string `mapstructure:"idpMetadataURL" json:"idpMetadataURL,omitempty"`
+	IdPMetadata    string `mapstructure:"idpMetadata" json:"idpMetadata,omitempty"`
+
+	// Certificate and PrivateKey are the PEM-encoded certificate and key of the service provider, which
+	// are published in its metadata and used to decrypt encrypted assertions.
+	Certificate string `mapstructure:"certificate" json:"certificate,omitempty"`
+	PrivateKey  string `mapstructure:"privateKey" json:"privateKey,omitempty"`
+
+	// EntityID is the entity ID of the service provider. It defaults to the URL of the metadata endpoint.
+	EntityID string `mapstructure:"entityID" json:"entityID,omitempty"`
+
+	// AllowIdPInitiated controls whether users can log in from the identity provider, without starting
+	// the login from Hatchet.
+	AllowIdPInitiated bool `mapstructure:"allowIdPInitiated" json:"allowIdPInitiated,omitempty" default:"false"`
+
+	// EmailVerified controls whether the emails of the identity provider are considered verified, which
+	// allows linking logins to existing users with the verified OAuth link policy.
+	EmailVerified bool `mapstructure:"emailVerified" json:"emailVerified,omitempty" default:"false"`
+
+	// Attributes maps the attributes of the assertion to the fields of a user.
+	Attributes ConfigFileAuthSAMLAttributes `mapstructure:"attributes" json:"attributes,omitempty"`
+}
+
+// ConfigFileAuthSAMLAttributes are the names or friendly names of the attributes of a SAML assertion. If the
+// assertion has no email attribute, the name ID is used as the email if it is an email address.
+type ConfigFileAuthSAMLAttributes struct {
+	Email string `mapstructure:"email" json:"email,omitempty" default:"email"`
+	Name  string `mapstructure:"name" json:"name,omitempty" default:"name"`
+}
+
 type ConfigFileAuthCookie struct {
 	Name     string `mapstructure:"name" json:"name,omitempty" default:"hatchet"`
 	Domain   string `mapstructure:"domain" json:"domain,omitempty"`
@@ -343,6 +504,8 @@ type MessageQueueConfigFile struct {
 	Postgres PostgresMQConfigFile `mapstructure:"postgres" json:"postgres,omitempty"`
 
 	RabbitMQ RabbitMQConfigFile `mapstructure:"rabbitmq" json:"rabbitmq,omitempty" validate:"required"`
+
+	NATS NATSConfigFile `mapstructure:"nats" json:"nats,omitempty"`
 }
 
 type PostgresMQConfigFile struct {
@@ -354,8 +517,92 @@ type RabbitMQConfigFile struct {
 	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
 }
 
+type NATSConfigFile struct {
+	URL string `mapstructure:"url" json:"url,omitempty" default:"nats://localhost:4222"`
+	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
+
+	// Stream is the name of the JetStream stream of the durable queues, and the prefix of all subjects.
+	Stream string `mapstructure:"stream" json:"stream,omitempty" default:"hatchet"`
+
+	StreamReplicas int `mapstructure:"streamReplicas" json:"streamReplicas,omitempty" default:"1"`
+}
+
+// KafkaConfigFile configures the Kafka bridge, which consumes records from Kafka topics and pushes each
+// record as an event. The bridge runs in the engine instances which run the gRPC API.
+type KafkaConfigFile struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	Brokers []string `mapstructure:"brokers" json:"brokers,omitempty"`
+
+	// GroupID is the consumer group of the bridge, which all engine instances share.
+	GroupID string `mapstructure:"groupID" json:"groupID,omitempty" default:"hatchet"`
+
+	// StartOffset is where the consumer group starts to consume topics without committed offsets, either
+	// "earliest" or "latest".
+	StartOffset string `mapstructure:"startOffset" json:"startOffset,omitempty" default:"latest"`
+
+	// Deduplicate pushes records with an external id which is derived from their topic, partition and offset,
+	// so that records which are consumed again after a crash don't create duplicate events.
+	Deduplicate bool `mapstructure:"deduplicate" json:"deduplicate,omitempty" default:"true"`
+
+	SASL KafkaSASLConfigFile `mapstructure:"sasl" json:"sasl,omitempty"`
+
+	TLS KafkaTLSConfigFile `mapstructure:"tls" json:"tls,omitempty"`
+
+	Topics []KafkaTopicConfigFile `mapstructure:"topics" json:"topics,omitempty"`
+}
+
+type KafkaSASLConfigFile struct {
+	// Mechanism is "plain", "scram-sha-256" or "scram-sha-512", SASL is disabled if it is empty.
+	Mechanism string `mapstructure:"mechanism" json:"mechanism,omitempty"`
+	Username  string `mapstructure:"username" json:"username,omitempty"`
+	Password  string `mapstructure:"password" json:"password,omitempty"`
+}
+
+type KafkaTLSConfigFile struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// RootCAFile is the CA which signed the certificates of the brokers, the system CAs are used if it is empty.
+	RootCAFile string `mapstructure:"rootCAFile" json:"rootCAFile,omitempty"`
+
+	// CertFile and KeyFile are the client certificate, if the brokers require mTLS.
+	CertFile string `mapstructure:"certFile" json:"certFile,omitempty"`
+	KeyFile  string `mapstructure:"keyFile" json:"keyFile,omitempty"`
+}
+
+// KafkaTopicConfigFile maps the records of a topic to the events of a tenant.
+type KafkaTopicConfigFile struct {
+	Topic string `mapstructure:"topic" json:"topic,omitempty"`
+
+	TenantId string `mapstructure:"tenantId" json:"tenantId,omitempty"`
+
+	// EventKey is the key of the events, which defaults to the name of the topic.
+	EventKey string `mapstructure:"eventKey" json:"eventKey,omitempty"`
+
+	// Headers are the record headers which are added to the additional metadata of the events.
+	Headers []KafkaHeaderConfigFile `mapstructure:"headers" json:"headers,omitempty"`
+}
+
+type KafkaHeaderConfigFile struct {
+	Header string `mapstructure:"header" json:"header,omitempty"`
+
+	// MetadataKey is the key of the additional metadata which the header is stored in, which defaults to the
+	// name of the header.
+	MetadataKey string `mapstructure:"metadataKey" json:"metadataKey,omitempty"`
+}
+
 type ConfigFileEmail struct {
 	Postmark PostmarkConfigFile `mapstructure:"postmark" json:"postmark,omitempty"`
+
+	SMTP SMTPConfigFile `mapstructure:"smtp" json:"smtp,omitempty"`
+
+	SES SESConfigFile `mapstructure:"ses" json:"ses,omitempty"`
+
+	Resend ResendConfigFile `mapstructure:"resend" json:"resend,omitempty"`
+
+	// AlertRateLimit is the max number of alert emails which are sent to the same recipients per hour, so a
+	// failure storm doesn't flood inboxes. Alerts over the limit are dropped. A limit of 0 disables it.
+	AlertRateLimit int `mapstructure:"alertRateLimit" json:"alertRateLimit,omitempty" default:"12"`
 }
 
 type ConfigFileMonitoring struct {
@@ -381,6 +628,64 @@ type PostmarkConfigFile struct {
 	SupportEmail string `mapstructure:"supportEmail" json:"supportEmail,omitempty"`
 }
 
+type SMTPConfigFile struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+
+	Host     string `mapstructure:"host" json:"host,omitempty"`
+	Port     int    `mapstructure:"port" json:"port,omitempty" default:"587"`
+	Username string `mapstructure:"username" json:"username,omitempty"`
+	Password string `mapstructure:"password" json:"password,omitempty"`
+
+	// TLS is how connections to the server are secured, one of starttls, tls (implicit TLS, usually on port
+	// 465) or none
+	TLS string `mapstructure:"tls" json:"tls,omitempty" default:"starttls"`
+
+	FromEmail    string `mapstructure:"fromEmail" json:"fromEmail,omitempty"`
+	FromName     string `mapstructure:"fromName" json:"fromName,omitempty" default:"Hatchet Support"`
+	SupportEmail string `mapstructure:"supportEmail" json:"supportEmail,omitempty"`
+}
+
+type SESConfigFile struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+
+	Region string `mapstructure:"region" json:"region,omitempty"`
+
+	// AccessKeyID and SecretAccessKey are optional, the credentials are loaded from the environment if they
+	// are not set
+	AccessKeyID     string `mapstructure:"accessKeyID" json:"accessKeyID,omitempty"`
+	SecretAccessKey string `mapstructure:"secretAccessKey" json:"secretAccessKey,omitempty"`
+
+	ConfigurationSet string `mapstructure:"configurationSet" json:"configurationSet,omitempty"`
+
+	FromEmail    string `mapstructure:"fromEmail" json:"fromEmail,omitempty"`
+	FromName     string `mapstructure:"fromName" json:"fromName,omitempty" default:"Hatchet Support"`
+	SupportEmail string `mapstructure:"supportEmail" json:"supportEmail,omitempty"`
+}
+
+type ResendConfigFile struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+
+	APIKey string `mapstructure:"apiKey" json:"apiKey,omitempty"`
+
+	FromEmail    string `mapstructure:"fromEmail" json:"fromEmail,omitempty"`
+	FromName     string `mapstructure:"fromName" json:"fromName,omitempty" default:"Hatchet Support"`
+	SupportEmail string `mapstructure:"supportEmail" json:"supportEmail,omitempty"`
+}
+
+const (
+	// OAuthLinkPolicyVerified links an OAuth login to an existing user with the same email only if the
+	// OAuth provider verified the email and the existing user has verified it as well.
+	OAuthLinkPolicyVerified = "verified"
+
+	// OAuthLinkPolicyNever never links an OAuth login to an existing user by email. Users link a provider
+	// by logging in with it while they are logged in to their existing account.
+	OAuthLinkPolicyNever = "never"
+
+	// OAuthLinkPolicyAlways links an OAuth login to an existing user with the same email regardless of
+	// whether the email was verified.
+	OAuthLinkPolicyAlways = "always"
+)
+
 type AuthConfig struct {
 	RestrictedEmailDomains []string
 
@@ -390,7 +695,37 @@ type AuthConfig struct {
 
 	GithubOAuthConfig *oauth2.Config
 
+	// OAuthProviders are the providers configured in ConfigFile.OAuthProviders, keyed by name.
+	OAuthProviders map[string]*OAuthProvider
+
+	// SAML is the service provider configured in ConfigFile.SAML, which is nil if SAML is disabled.
+	SAML *saml.ServiceProvider
+
+	// OAuthTokenRefresher renews the stored tokens of the accounts of the configured OAuth providers.
+	OAuthTokenRefresher *oauth.TokenRefresher
+
 	JWTManager token.JWTManager
+
+	// Authorizer decides which tenant-scoped API operations users and API tokens may perform. It defaults
+	// to authorizer.NewPermissionAuthorizer.
+	Authorizer authorizer.Authorizer
+}
+
+type OAuthProvider struct {
+	Name string
+
+	Config *oauth2.Config
+
+	UserInfoURL string
+
+	// IDTokenVerifier verifies the ID tokens of providers which are configured with an issuer URL, and is nil
+	// for other providers.
+	IDTokenVerifier *oidc.IDTokenVerifier
+
+	// Claims are the claim keys of the provider with the defaults applied.
+	Claims ConfigFileAuthOAuthClaims
+
+	RoleMappings []ConfigFileAuthOAuthRoleMapping
 }
 
 type PylonConfig struct {
@@ -427,6 +762,10 @@ type ServerConfig struct {
 
 	EnableWorkerRetention bool
 
+	EnableDataPurge bool
+
+	DataPurgeDelay time.Duration
+
 	Namespaces []string
 
 	MessageQueue msgqueue.MessageQueue
@@ -445,6 +784,8 @@ type ServerConfig struct {
 
 	OpenTelemetry shared.OpenTelemetryConfigFile
 
+	Prometheus shared.PrometheusConfigFile
+
 	Email email.EmailService
 
 	TenantAlerter *alerting.TenantAlertManager
@@ -452,6 +793,15 @@ type ServerConfig struct {
 	AdditionalOAuthConfigs map[string]*oauth2.Config
 
 	SchedulingPool *v2.SchedulingPool
+
+	// Kafka configures the Kafka bridge, and KafkaTLSConfig is the TLS config of its connections to the brokers,
+	// which is nil if TLS is disabled.
+	Kafka KafkaConfigFile
+
+	KafkaTLSConfig *tls.Config
+
+	// Version is the version of the running server
+	Version string
 }
 
 func (c *ServerConfig) HasService(name string) bool {
@@ -479,6 +829,8 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("servicesString", "SERVER_SERVICES")
 	_ = v.BindEnv("enableDataRetention", "SERVER_ENABLE_DATA_RETENTION")
 	_ = v.BindEnv("enableWorkerRetention", "SERVER_ENABLE_WORKER_RETENTION")
+	_ = v.BindEnv("enableDataPurge", "SERVER_ENABLE_DATA_PURGE")
+	_ = v.BindEnv("dataPurgeDelay", "SERVER_DATA_PURGE_DELAY")
 	_ = v.BindEnv("runtime.enforceLimits", "SERVER_ENFORCE_LIMITS")
 	_ = v.BindEnv("runtime.allowSignup", "SERVER_ALLOW_SIGNUP")
 	_ = v.BindEnv("runtime.allowInvites", "SERVER_ALLOW_INVITES")
@@ -487,6 +839,11 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("runtime.bufferCreateWorkflowRuns", "SERVER_BUFFER_CREATE_WORKFLOW_RUNS")
 	_ = v.BindEnv("runtime.disableTenantPubs", "SERVER_DISABLE_TENANT_PUBS")
 	_ = v.BindEnv("runtime.maxInternalRetryCount", "SERVER_MAX_INTERNAL_RETRY_COUNT")
+	_ = v.BindEnv("runtime.workerHeartbeatInterval", "SERVER_WORKER_HEARTBEAT_INTERVAL")
+	_ = v.BindEnv("runtime.workerHeartbeatTimeout", "SERVER_WORKER_HEARTBEAT_TIMEOUT")
+	_ = v.BindEnv("runtime.maxBufferedOrderedEvents", "SERVER_MAX_BUFFERED_ORDERED_EVENTS")
+	_ = v.BindEnv("runtime.maxEventQueueDepth", "SERVER_MAX_EVENT_QUEUE_DEPTH")
+	_ = v.BindEnv("runtime.eventQueueDepthRetryAfter", "SERVER_EVENT_QUEUE_DEPTH_RETRY_AFTER")
 
 	// security check options
 	_ = v.BindEnv("securityCheck.enabled", "SERVER_SECURITY_CHECK_ENABLED")
@@ -506,6 +863,9 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("runtime.limits.defaultEventAlarmLimit", "SERVER_LIMITS_DEFAULT_EVENT_ALARM_LIMIT")
 	_ = v.BindEnv("runtime.limits.defaultEventWindow", "SERVER_LIMITS_DEFAULT_EVENT_WINDOW")
 
+	_ = v.BindEnv("runtime.limits.defaultQueuedRunLimit", "SERVER_LIMITS_DEFAULT_QUEUED_RUN_LIMIT")
+	_ = v.BindEnv("runtime.limits.defaultQueuedRunAlarmLimit", "SERVER_LIMITS_DEFAULT_QUEUED_RUN_ALARM_LIMIT")
+
 	_ = v.BindEnv("runtime.limits.defaultCronLimit", "SERVER_LIMITS_DEFAULT_CRON_LIMIT")
 	_ = v.BindEnv("runtime.limits.defaultCronAlarmLimit", "SERVER_LIMITS_DEFAULT_CRON_ALARM_LIMIT")
 
@@ -571,11 +931,16 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("encryption.cloudKms.enabled", "SERVER_ENCRYPTION_CLOUDKMS_ENABLED")
 	_ = v.BindEnv("encryption.cloudKms.keyURI", "SERVER_ENCRYPTION_CLOUDKMS_KEY_URI")
 	_ = v.BindEnv("encryption.cloudKms.credentialsJSON", "SERVER_ENCRYPTION_CLOUDKMS_CREDENTIALS_JSON")
+	_ = v.BindEnv("encryption.cloudKms.tenantKeyURITemplate", "SERVER_ENCRYPTION_CLOUDKMS_TENANT_KEY_URI_TEMPLATE")
+	_ = v.BindEnv("encryption.tenantKeys", "SERVER_ENCRYPTION_TENANT_KEYS")
 
 	// auth options
 	_ = v.BindEnv("auth.restrictedEmailDomains", "SERVER_AUTH_RESTRICTED_EMAIL_DOMAINS")
 	_ = v.BindEnv("auth.basicAuthEnabled", "SERVER_AUTH_BASIC_AUTH_ENABLED")
 	_ = v.BindEnv("auth.setEmailVerified", "SERVER_AUTH_SET_EMAIL_VERIFIED")
+	_ = v.BindEnv("auth.allowedRedirectURLs", "SERVER_AUTH_ALLOWED_REDIRECT_URLS")
+	_ = v.BindEnv("auth.errorRedirectURL", "SERVER_AUTH_ERROR_REDIRECT_URL")
+	_ = v.BindEnv("auth.oauthLinkPolicy", "SERVER_AUTH_OAUTH_LINK_POLICY")
 	_ = v.BindEnv("auth.cookie.name", "SERVER_AUTH_COOKIE_NAME")
 	_ = v.BindEnv("auth.cookie.domain", "SERVER_AUTH_COOKIE_DOMAIN")
 	_ = v.BindEnv("auth.cookie.secrets", "SERVER_AUTH_COOKIE_SECRETS")
@@ -588,6 +953,16 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("auth.github.clientID", "SERVER_AUTH_GITHUB_CLIENT_ID")
 	_ = v.BindEnv("auth.github.clientSecret", "SERVER_AUTH_GITHUB_CLIENT_SECRET")
 	_ = v.BindEnv("auth.github.scopes", "SERVER_AUTH_GITHUB_SCOPES")
+	_ = v.BindEnv("auth.saml.enabled", "SERVER_AUTH_SAML_ENABLED")
+	_ = v.BindEnv("auth.saml.idpMetadataURL", "SERVER_AUTH_SAML_IDP_METADATA_URL")
+	_ = v.BindEnv("auth.saml.idpMetadata", "SERVER_AUTH_SAML_IDP_METADATA")
+	_ = v.BindEnv("auth.saml.certificate", "SERVER_AUTH_SAML_CERTIFICATE")
+	_ = v.BindEnv("auth.saml.privateKey", "SERVER_AUTH_SAML_PRIVATE_KEY")
+	_ = v.BindEnv("auth.saml.entityID", "SERVER_AUTH_SAML_ENTITY_ID")
+	_ = v.BindEnv("auth.saml.allowIdPInitiated", "SERVER_AUTH_SAML_ALLOW_IDP_INITIATED")
+	_ = v.BindEnv("auth.saml.emailVerified", "SERVER_AUTH_SAML_EMAIL_VERIFIED")
+	_ = v.BindEnv("auth.saml.attributes.email", "SERVER_AUTH_SAML_ATTRIBUTES_EMAIL")
+	_ = v.BindEnv("auth.saml.attributes.name", "SERVER_AUTH_SAML_ATTRIBUTES_NAME")
 
 	// task queue options
 	// legacy options
@@ -596,14 +971,32 @@ func BindAllEnv(v *viper.Viper) {
 
 	_ = v.BindEnv("msgQueue.kind", "SERVER_MSGQUEUE_KIND")
 	_ = v.BindEnv("msgQueue.rabbitmq.url", "SERVER_MSGQUEUE_RABBITMQ_URL")
+	_ = v.BindEnv("msgQueue.nats.url", "SERVER_MSGQUEUE_NATS_URL")
+	_ = v.BindEnv("msgQueue.nats.stream", "SERVER_MSGQUEUE_NATS_STREAM")
+	_ = v.BindEnv("msgQueue.nats.streamReplicas", "SERVER_MSGQUEUE_NATS_STREAM_REPLICAS")
 
 	// throughput options
 	_ = v.BindEnv("msgQueue.rabbitmq.qos", "SERVER_MSGQUEUE_RABBITMQ_QOS")
+	_ = v.BindEnv("msgQueue.nats.qos", "SERVER_MSGQUEUE_NATS_QOS")
 	_ = v.BindEnv("runtime.requeueLimit", "SERVER_REQUEUE_LIMIT")
 	_ = v.BindEnv("runtime.singleQueueLimit", "SERVER_SINGLE_QUEUE_LIMIT")
 	_ = v.BindEnv("runtime.updateHashFactor", "SERVER_UPDATE_HASH_FACTOR")
 	_ = v.BindEnv("runtime.updateConcurrentFactor", "SERVER_UPDATE_CONCURRENT_FACTOR")
 
+	// kafka options, the topics can only be configured in the config file
+	_ = v.BindEnv("kafka.enabled", "SERVER_KAFKA_ENABLED")
+	_ = v.BindEnv("kafka.brokers", "SERVER_KAFKA_BROKERS")
+	_ = v.BindEnv("kafka.groupID", "SERVER_KAFKA_GROUP_ID")
+	_ = v.BindEnv("kafka.startOffset", "SERVER_KAFKA_START_OFFSET")
+	_ = v.BindEnv("kafka.deduplicate", "SERVER_KAFKA_DEDUPLICATE")
+	_ = v.BindEnv("kafka.sasl.mechanism", "SERVER_KAFKA_SASL_MECHANISM")
+	_ = v.BindEnv("kafka.sasl.username", "SERVER_KAFKA_SASL_USERNAME")
+	_ = v.BindEnv("kafka.sasl.password", "SERVER_KAFKA_SASL_PASSWORD")
+	_ = v.BindEnv("kafka.tls.enabled", "SERVER_KAFKA_TLS_ENABLED")
+	_ = v.BindEnv("kafka.tls.rootCAFile", "SERVER_KAFKA_TLS_ROOT_CA_FILE")
+	_ = v.BindEnv("kafka.tls.certFile", "SERVER_KAFKA_TLS_CERT_FILE")
+	_ = v.BindEnv("kafka.tls.keyFile", "SERVER_KAFKA_TLS_KEY_FILE")
+
 	// tls options
 	_ = v.BindEnv("tls.tlsStrategy", "SERVER_TLS_STRATEGY")
 	_ = v.BindEnv("tls.tlsCert", "SERVER_TLS_CERT")
@@ -630,6 +1023,11 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("otel.traceIdRatio", "SERVER_OTEL_TRACE_ID_RATIO")
 	_ = v.BindEnv("otel.insecure", "SERVER_OTEL_INSECURE")
 
+	// prometheus options
+	_ = v.BindEnv("prometheus.enabled", "SERVER_PROMETHEUS_ENABLED")
+	_ = v.BindEnv("prometheus.address", "SERVER_PROMETHEUS_ADDRESS")
+	_ = v.BindEnv("prometheus.path", "SERVER_PROMETHEUS_PATH")
+
 	// tenant alerting options
 	_ = v.BindEnv("tenantAlerting.slack.enabled", "SERVER_TENANT_ALERTING_SLACK_ENABLED")
 	_ = v.BindEnv("tenantAlerting.slack.clientID", "SERVER_TENANT_ALERTING_SLACK_CLIENT_ID")
@@ -642,6 +1040,29 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("email.postmark.fromEmail", "SERVER_EMAIL_POSTMARK_FROM_EMAIL")
 	_ = v.BindEnv("email.postmark.fromName", "SERVER_EMAIL_POSTMARK_FROM_NAME")
 	_ = v.BindEnv("email.postmark.supportEmail", "SERVER_EMAIL_POSTMARK_SUPPORT_EMAIL")
+	_ = v.BindEnv("email.smtp.enabled", "SERVER_EMAIL_SMTP_ENABLED")
+	_ = v.BindEnv("email.smtp.host", "SERVER_EMAIL_SMTP_HOST")
+	_ = v.BindEnv("email.smtp.port", "SERVER_EMAIL_SMTP_PORT")
+	_ = v.BindEnv("email.smtp.username", "SERVER_EMAIL_SMTP_USERNAME")
+	_ = v.BindEnv("email.smtp.password", "SERVER_EMAIL_SMTP_PASSWORD")
+	_ = v.BindEnv("email.smtp.tls", "SERVER_EMAIL_SMTP_TLS")
+	_ = v.BindEnv("email.smtp.fromEmail", "SERVER_EMAIL_SMTP_FROM_EMAIL")
+	_ = v.BindEnv("email.smtp.fromName", "SERVER_EMAIL_SMTP_FROM_NAME")
+	_ = v.BindEnv("email.smtp.supportEmail", "SERVER_EMAIL_SMTP_SUPPORT_EMAIL")
+	_ = v.BindEnv("email.ses.enabled", "SERVER_EMAIL_SES_ENABLED")
+	_ = v.BindEnv("email.ses.region", "SERVER_EMAIL_SES_REGION")
+	_ = v.BindEnv("email.ses.accessKeyID", "SERVER_EMAIL_SES_ACCESS_KEY_ID")
+	_ = v.BindEnv("email.ses.secretAccessKey", "SERVER_EMAIL_SES_SECRET_ACCESS_KEY")
+	_ = v.BindEnv("email.ses.configurationSet", "SERVER_EMAIL_SES_CONFIGURATION_SET")
+	_ = v.BindEnv("email.ses.fromEmail", "SERVER_EMAIL_SES_FROM_EMAIL")
+	_ = v.BindEnv("email.ses.fromName", "SERVER_EMAIL_SES_FROM_NAME")
+	_ = v.BindEnv("email.ses.supportEmail", "SERVER_EMAIL_SES_SUPPORT_EMAIL")
+	_ = v.BindEnv("email.resend.enabled", "SERVER_EMAIL_RESEND_ENABLED")
+	_ = v.BindEnv("email.resend.apiKey", "SERVER_EMAIL_RESEND_API_KEY")
+	_ = v.BindEnv("email.resend.fromEmail", "SERVER_EMAIL_RESEND_FROM_EMAIL")
+	_ = v.BindEnv("email.resend.fromName", "SERVER_EMAIL_RESEND_FROM_NAME")
+	_ = v.BindEnv("email.resend.supportEmail", "SERVER_EMAIL_RESEND_SUPPORT_EMAIL")
+	_ = v.BindEnv("email.alertRateLimit", "SERVER_EMAIL_ALERT_RATE_LIMIT")
 
 	// monitoring options
 	_ = v.BindEnv("runtime.monitoring.enabled", "SERVER_MONITORING_ENABLED")
@@ -0,0 +1,321 @@
+package server
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// oauthProviderNameRegex matches the names of OAuth providers, which are used in URL paths.
+var oauthProviderNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// reservedOAuthProviderNames are the names of the built-in OAuth integrations, which can't be used as the
+// name of a configured provider.
+var reservedOAuthProviderNames = map[string]bool{
+	"google": true,
+	"github": true,
+	"slack":  true,
+}
+
+// Validate checks that the fields which each enabled feature requires are set, so that a misconfigured
+// server fails on startup instead of when the feature is first used, for example on the first login with
+// a half-configured OAuth provider. It returns a *multierror.Error which lists every problem which was
+// found, or nil if the config is valid.
+func (c *ServerConfigFile) Validate() error {
+	var result *multierror.Error
+
+	appendErr := func(format string, args ...any) {
+		result = multierror.Append(result, fmt.Errorf(format, args...))
+	}
+
+	if err := validateAbsoluteURL("runtime.url", c.Runtime.ServerURL); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	// step runs are only assigned to workers with a heartbeat in the last 5 seconds
+	if c.Runtime.WorkerHeartbeatInterval >= 5*time.Second {
+		appendErr("runtime.workerHeartbeatInterval must be less than 5s")
+	}
+
+	// workers send a heartbeat per interval, so a single late heartbeat shouldn't mark them as inactive
+	if c.Runtime.WorkerHeartbeatTimeout < 2*c.Runtime.WorkerHeartbeatInterval {
+		appendErr("runtime.workerHeartbeatTimeout must be at least twice runtime.workerHeartbeatInterval")
+	}
+
+	// auth
+	if c.Auth.Cookie.Domain == "" {
+		appendErr("auth.cookie.domain is required")
+	}
+
+	// the secrets are split on single spaces when the session store is created
+	if c.Auth.Cookie.Secrets == "" || len(strings.Split(c.Auth.Cookie.Secrets, " "))%2 != 0 {
+		appendErr("auth.cookie.secrets must be an even number of space-separated secrets")
+	}
+
+	switch c.Auth.OAuthLinkPolicy {
+	case "", OAuthLinkPolicyVerified, OAuthLinkPolicyNever, OAuthLinkPolicyAlways:
+	default:
+		appendErr("auth.oauthLinkPolicy: invalid policy %q, must be one of %s, %s or %s", c.Auth.OAuthLinkPolicy, OAuthLinkPolicyVerified, OAuthLinkPolicyNever, OAuthLinkPolicyAlways)
+	}
+
+	if c.Auth.Google.Enabled {
+		if c.Auth.Google.ClientID == "" {
+			appendErr("auth.google.clientID is required when google login is enabled")
+		}
+
+		if c.Auth.Google.ClientSecret == "" {
+			appendErr("auth.google.clientSecret is required when google login is enabled")
+		}
+	}
+
+	if c.Auth.Github.Enabled {
+		if c.Auth.Github.ClientID == "" {
+			appendErr("auth.github.clientID is required when github login is enabled")
+		}
+
+		if c.Auth.Github.ClientSecret == "" {
+			appendErr("auth.github.clientSecret is required when github login is enabled")
+		}
+	}
+
+	names := make(map[string]bool, len(c.Auth.OAuthProviders))
+
+	for i, p := range c.Auth.OAuthProviders {
+		field := fmt.Sprintf("auth.oauthProviders[%d]", i)
+
+		switch {
+		case !oauthProviderNameRegex.MatchString(p.Name):
+			appendErr("%s.name: invalid name %q, must be lowercase alphanumeric characters, dashes or underscores", field, p.Name)
+		case reservedOAuthProviderNames[p.Name]:
+			appendErr("%s.name: name %q is reserved", field, p.Name)
+		case names[p.Name]:
+			appendErr("%s.name: duplicate name %q", field, p.Name)
+		}
+
+		names[p.Name] = true
+
+		for j, m := range p.RoleMappings {
+			mappingField := fmt.Sprintf("%s.roleMappings[%d]", field, j)
+
+			if m.Group == "" {
+				appendErr("%s.group is required", mappingField)
+			}
+
+			if m.Tenant == "" {
+				appendErr("%s.tenant is required", mappingField)
+			}
+
+			switch m.Role {
+			case "OWNER", "ADMIN", "MEMBER":
+			default:
+				appendErr("%s.role: invalid role %q, must be one of OWNER, ADMIN or MEMBER", mappingField, m.Role)
+			}
+		}
+
+		if p.ClientID == "" {
+			appendErr("%s.clientID is required", field)
+		}
+
+		if p.ClientSecret == "" {
+			appendErr("%s.clientSecret is required", field)
+		}
+
+		// the endpoints of OpenID Connect providers are discovered from the issuer
+		if p.IssuerURL != "" {
+			if err := validateAbsoluteURL(field+".issuerURL", p.IssuerURL); err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			// the ID token is only returned for the openid scope
+			if len(p.Scopes) > 0 && !slices.Contains(p.Scopes, "openid") {
+				appendErr("%s.scopes must include openid when issuerURL is set", field)
+			}
+
+			continue
+		}
+
+		if err := validateAbsoluteURL(field+".authURL", p.AuthURL); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if err := validateAbsoluteURL(field+".tokenURL", p.TokenURL); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if err := validateAbsoluteURL(field+".userInfoURL", p.UserInfoURL); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.Auth.SAML.Enabled {
+		switch {
+		case c.Auth.SAML.IdPMetadata == "" && c.Auth.SAML.IdPMetadataURL == "":
+			appendErr("auth.saml: one of auth.saml.idpMetadata or auth.saml.idpMetadataURL is required when saml login is enabled")
+		case c.Auth.SAML.IdPMetadata == "":
+			if err := validateAbsoluteURL("auth.saml.idpMetadataURL", c.Auth.SAML.IdPMetadataURL); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+
+		if c.Auth.SAML.Certificate == "" {
+			appendErr("auth.saml.certificate is required when saml login is enabled")
+		}
+
+		if c.Auth.SAML.PrivateKey == "" {
+			appendErr("auth.saml.privateKey is required when saml login is enabled")
+		}
+	}
+
+	// encryption
+	hasLocalMasterKeyset := c.Encryption.MasterKeyset != "" || c.Encryption.MasterKeysetFile != ""
+
+	switch {
+	case !hasLocalMasterKeyset && !c.Encryption.CloudKMS.Enabled:
+		appendErr("encryption: one of encryption.masterKeyset, encryption.masterKeysetFile or encryption.cloudKms.enabled is required")
+	case hasLocalMasterKeyset && c.Encryption.CloudKMS.Enabled:
+		appendErr("encryption: a master keyset can't be used together with encryption.cloudKms.enabled")
+	}
+
+	if c.Encryption.CloudKMS.Enabled {
+		if c.Encryption.CloudKMS.KeyURI == "" {
+			appendErr("encryption.cloudKms.keyURI is required when cloud kms is enabled")
+		}
+
+		if c.Encryption.CloudKMS.CredentialsJSON == "" {
+			appendErr("encryption.cloudKms.credentialsJSON is required when cloud kms is enabled")
+		}
+	}
+
+	if c.Encryption.JWT.PublicJWTKeyset == "" && c.Encryption.JWT.PublicJWTKeysetFile == "" {
+		appendErr("encryption.jwt: one of encryption.jwt.publicJWTKeyset or encryption.jwt.publicJWTKeysetFile is required")
+	}
+
+	if c.Encryption.JWT.PrivateJWTKeyset == "" && c.Encryption.JWT.PrivateJWTKeysetFile == "" {
+		appendErr("encryption.jwt: one of encryption.jwt.privateJWTKeyset or encryption.jwt.privateJWTKeysetFile is required")
+	}
+
+	// message queue
+	if c.MessageQueue.Enabled {
+		switch strings.ToLower(c.MessageQueue.Kind) {
+		case "postgres":
+		case "rabbitmq":
+			if c.MessageQueue.RabbitMQ.URL == "" {
+				appendErr("msgQueue.rabbitmq.url is required when the message queue kind is rabbitmq")
+			}
+		case "nats":
+			if c.MessageQueue.NATS.URL == "" {
+				appendErr("msgQueue.nats.url is required when the message queue kind is nats")
+			}
+		default:
+			appendErr("msgQueue.kind: invalid kind %q, must be postgres, rabbitmq or nats", c.MessageQueue.Kind)
+		}
+	}
+
+	// integrations
+	if c.Alerting.Sentry.Enabled && c.Alerting.Sentry.DSN == "" {
+		appendErr("alerting.sentry.dsn is required when sentry is enabled")
+	}
+
+	if c.Analytics.Posthog.Enabled && c.Analytics.Posthog.ApiKey == "" {
+		appendErr("analytics.posthog.apiKey is required when posthog is enabled")
+	}
+
+	if c.Pylon.Enabled && c.Pylon.AppID == "" {
+		appendErr("pylon.appID is required when pylon is enabled")
+	}
+
+	if c.TenantAlerting.Slack.Enabled {
+		if c.TenantAlerting.Slack.SlackAppClientID == "" {
+			appendErr("tenantAlerting.slack.clientID is required when slack alerting is enabled")
+		}
+
+		if c.TenantAlerting.Slack.SlackAppClientSecret == "" {
+			appendErr("tenantAlerting.slack.clientSecret is required when slack alerting is enabled")
+		}
+	}
+
+	if c.Email.Postmark.Enabled {
+		if c.Email.Postmark.ServerKey == "" {
+			appendErr("email.postmark.serverKey is required when postmark is enabled")
+		}
+
+		if c.Email.Postmark.FromEmail == "" {
+			appendErr("email.postmark.fromEmail is required when postmark is enabled")
+		}
+	}
+
+	if c.Email.SMTP.Enabled {
+		if c.Email.SMTP.Host == "" {
+			appendErr("email.smtp.host is required when smtp is enabled")
+		}
+
+		if c.Email.SMTP.FromEmail == "" {
+			appendErr("email.smtp.fromEmail is required when smtp is enabled")
+		}
+
+		if !slices.Contains([]string{"starttls", "tls", "none"}, c.Email.SMTP.TLS) {
+			appendErr("email.smtp.tls: invalid tls mode %q, must be one of starttls, tls or none", c.Email.SMTP.TLS)
+		}
+	}
+
+	if c.Email.SES.Enabled {
+		if c.Email.SES.Region == "" {
+			appendErr("email.ses.region is required when ses is enabled")
+		}
+
+		if c.Email.SES.FromEmail == "" {
+			appendErr("email.ses.fromEmail is required when ses is enabled")
+		}
+
+		if (c.Email.SES.AccessKeyID == "") != (c.Email.SES.SecretAccessKey == "") {
+			appendErr("email.ses.accessKeyID and email.ses.secretAccessKey must be set together")
+		}
+	}
+
+	if c.Email.Resend.Enabled {
+		if c.Email.Resend.APIKey == "" {
+			appendErr("email.resend.apiKey is required when resend is enabled")
+		}
+
+		if c.Email.Resend.FromEmail == "" {
+			appendErr("email.resend.fromEmail is required when resend is enabled")
+		}
+	}
+
+	enabledEmailProviders := 0
+
+	for _, enabled := range []bool{c.Email.Postmark.Enabled, c.Email.SMTP.Enabled, c.Email.SES.Enabled, c.Email.Resend.Enabled} {
+		if enabled {
+			enabledEmailProviders++
+		}
+	}
+
+	if enabledEmailProviders > 1 {
+		appendErr("email: only one of postmark, smtp, ses and resend can be enabled")
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateAbsoluteURL(field, s string) error {
+	if s == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+
+	u, err := url.Parse(s)
+
+	if err != nil {
+		return fmt.Errorf("%s: invalid url %q: %w", field, s, err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("%s: invalid url %q, must be an absolute url including the scheme", field, s)
+	}
+
+	return nil
+}
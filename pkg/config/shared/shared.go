@@ -25,3 +25,14 @@ type OpenTelemetryConfigFile struct {
 	TraceIdRatio string `mapstructure:"traceIdRatio" json:"traceIdRatio,omitempty" default:"1"`
 	Insecure     bool   `mapstructure:"insecure" json:"insecure,omitempty" default:"false"`
 }
+
+type PrometheusConfigFile struct {
+	// Enabled exposes metrics in the Prometheus text format on a separate listener
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// Address is the address which the metrics listener binds to
+	Address string `mapstructure:"address" json:"address,omitempty" default:":9999"`
+
+	// Path is the path which metrics are served on
+	Path string `mapstructure:"path" json:"path,omitempty" default:"/metrics"`
+}
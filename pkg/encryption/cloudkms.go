@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/tink-crypto/tink-go-gcpkms/integration/gcpkms"
 	"github.com/tink-crypto/tink-go/aead"
 	"github.com/tink-crypto/tink-go/core/registry"
 	"github.com/tink-crypto/tink-go/keyset"
+	"github.com/tink-crypto/tink-go/tink"
 	"google.golang.org/api/option"
 )
 
@@ -16,17 +18,49 @@ type cloudkmsEncryptionService struct {
 	key                *aead.KMSEnvelopeAEAD
 	privateEc256Handle *keyset.Handle
 	publicEc256Handle  *keyset.Handle
+	tenants            *tenantServices
+}
+
+type CloudKMSEncryptionOpt func(*cloudkmsEncryptionOpts)
+
+type cloudkmsEncryptionOpts struct {
+	tenantKeyUriTemplate string
+}
+
+// tenantIdPlaceholder is replaced with the tenant id in the tenant key URI template.
+const tenantIdPlaceholder = "{tenant_id}"
+
+// WithTenantKeyURITemplate encrypts the data of each tenant with its own key in Cloud KMS. The key URI of
+// a tenant is the template with {tenant_id} replaced by the tenant id, and the key must exist in Cloud
+// KMS before data of the tenant is encrypted.
+func WithTenantKeyURITemplate(template string) CloudKMSEncryptionOpt {
+	return func(opts *cloudkmsEncryptionOpts) {
+		opts.tenantKeyUriTemplate = template
+	}
 }
 
 // NewCloudKMSEncryption creates a GCP CloudKMS-backed encryption service.
-func NewCloudKMSEncryption(keyUri string, credentialsJSON, privateEc256, publicEc256 []byte) (*cloudkmsEncryptionService, error) {
-	client, err := gcpkms.NewClientWithOptions(context.Background(), keyUri, option.WithCredentialsJSON(credentialsJSON))
+func NewCloudKMSEncryption(keyUri string, credentialsJSON, privateEc256, publicEc256 []byte, fs ...CloudKMSEncryptionOpt) (*cloudkmsEncryptionService, error) {
+	opts := &cloudkmsEncryptionOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	uriPrefix := keyUri
+
+	// the client must support the keys of all tenants
+	if opts.tenantKeyUriTemplate != "" {
+		uriPrefix = "gcp-kms://"
+	}
+
+	client, err := gcpkms.NewClientWithOptions(context.Background(), uriPrefix, option.WithCredentialsJSON(credentialsJSON))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return newWithClient(client, keyUri, privateEc256, publicEc256)
+	return newWithClient(client, keyUri, privateEc256, publicEc256, fs...)
 }
 
 func GenerateJWTKeysetsFromCloudKMS(keyUri string, credentialsJSON []byte) (privateEc256 []byte, publicEc256 []byte, err error) {
@@ -51,29 +85,25 @@ func generateJWTKeysetsWithClient(keyUri string, client registry.KMSClient) (pri
 	return generateJWTKeysets(remote)
 }
 
-func newWithClient(client registry.KMSClient, keyUri string, privateEc256, publicEc256 []byte) (*cloudkmsEncryptionService, error) {
-	registry.RegisterKMSClient(client)
+func newWithClient(client registry.KMSClient, keyUri string, privateEc256, publicEc256 []byte, fs ...CloudKMSEncryptionOpt) (*cloudkmsEncryptionService, error) {
+	opts := &cloudkmsEncryptionOpts{}
 
-	dek := aead.AES128CTRHMACSHA256KeyTemplate()
-	template, err := aead.CreateKMSEnvelopeAEADKeyTemplate(keyUri, dek)
+	for _, f := range fs {
+		f(opts)
+	}
 
-	if err != nil {
-		return nil, err
+	if opts.tenantKeyUriTemplate != "" && !strings.Contains(opts.tenantKeyUriTemplate, tenantIdPlaceholder) {
+		return nil, fmt.Errorf("tenant key URI template must contain %s", tenantIdPlaceholder)
 	}
 
-	// get the remote KEK from the client
-	remote, err := client.GetAEAD(keyUri)
+	registry.RegisterKMSClient(client)
+
+	envelope, remote, err := newCloudKMSEnvelope(client, keyUri)
 
 	if err != nil {
 		return nil, err
 	}
 
-	envelope := aead.NewKMSEnvelopeAEAD2(template, remote)
-
-	if envelope == nil {
-		return nil, fmt.Errorf("failed to create envelope")
-	}
-
 	privateEc256Handle, err := handleFromBytes(privateEc256, remote)
 
 	if err != nil {
@@ -86,11 +116,51 @@ func newWithClient(client registry.KMSClient, keyUri string, privateEc256, publi
 		return nil, err
 	}
 
-	return &cloudkmsEncryptionService{
+	svc := &cloudkmsEncryptionService{
 		key:                envelope,
 		privateEc256Handle: privateEc256Handle,
 		publicEc256Handle:  publicEc256Handle,
-	}, nil
+	}
+
+	svc.tenants = &tenantServices{
+		root:       svc,
+		defaultKey: envelope,
+	}
+
+	if opts.tenantKeyUriTemplate != "" {
+		svc.tenants.keyFor = func(tenantId string) (tink.AEAD, error) {
+			tenantEnvelope, _, err := newCloudKMSEnvelope(client, strings.ReplaceAll(opts.tenantKeyUriTemplate, tenantIdPlaceholder, tenantId))
+			return tenantEnvelope, err
+		}
+	}
+
+	return svc, nil
+}
+
+// newCloudKMSEnvelope returns an envelope AEAD whose key encryption key is the given key in Cloud KMS,
+// along with the remote key encryption key.
+func newCloudKMSEnvelope(client registry.KMSClient, keyUri string) (*aead.KMSEnvelopeAEAD, tink.AEAD, error) {
+	dek := aead.AES128CTRHMACSHA256KeyTemplate()
+	template, err := aead.CreateKMSEnvelopeAEADKeyTemplate(keyUri, dek)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// get the remote KEK from the client
+	remote, err := client.GetAEAD(keyUri)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	envelope := aead.NewKMSEnvelopeAEAD2(template, remote)
+
+	if envelope == nil {
+		return nil, nil, fmt.Errorf("failed to create envelope")
+	}
+
+	return envelope, remote, nil
 }
 
 func (svc *cloudkmsEncryptionService) Encrypt(plaintext []byte, dataId string) ([]byte, error) {
@@ -101,6 +171,10 @@ func (svc *cloudkmsEncryptionService) Decrypt(ciphertext []byte, dataId string)
 	return decrypt(svc.key, ciphertext, dataId)
 }
 
+func (svc *cloudkmsEncryptionService) DecryptBatch(items []EncryptedItem) ([][]byte, error) {
+	return decryptBatch(svc.key, items)
+}
+
 func (svc *cloudkmsEncryptionService) EncryptString(plaintext string, dataId string) (string, error) {
 	b, err := encrypt(svc.key, []byte(plaintext), dataId)
 	if err != nil {
@@ -110,15 +184,7 @@ func (svc *cloudkmsEncryptionService) EncryptString(plaintext string, dataId str
 }
 
 func (svc *cloudkmsEncryptionService) DecryptString(ciphertext string, dataId string) (string, error) {
-	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
-	if err != nil {
-		return "", err
-	}
-	b, err := decrypt(svc.key, decoded, dataId)
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
+	return decryptString(svc.key, ciphertext, dataId)
 }
 
 func (svc *cloudkmsEncryptionService) GetPrivateJWTHandle() *keyset.Handle {
@@ -128,3 +194,7 @@ func (svc *cloudkmsEncryptionService) GetPrivateJWTHandle() *keyset.Handle {
 func (svc *cloudkmsEncryptionService) GetPublicJWTHandle() *keyset.Handle {
 	return svc.publicEc256Handle
 }
+
+func (svc *cloudkmsEncryptionService) ForTenant(tenantId string) (EncryptionService, error) {
+	return svc.tenants.forTenant(tenantId)
+}
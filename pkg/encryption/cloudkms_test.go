@@ -1,10 +1,17 @@
 package encryption
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tink-crypto/tink-go/core/registry"
 	"github.com/tink-crypto/tink-go/testing/fakekms"
+	"github.com/tink-crypto/tink-go/tink"
+	"google.golang.org/api/googleapi"
 )
 
 var (
@@ -136,3 +143,163 @@ func TestEncryptDecryptCloudKMSWithEmptyDataID(t *testing.T) {
 	_, err = svc.Encrypt(plaintext, emptyDataID)
 	assert.Error(t, err)
 }
+
+func TestNewCloudKMSEncryptionInvalidTenantKeyURITemplate(t *testing.T) {
+	client, err := fakekms.NewClient(fakeKeyURI)
+	assert.NoError(t, err)
+
+	privateEc256, publicEc256, err := generateJWTKeysetsWithClient(fakeKeyURI, client)
+	assert.NoError(t, err)
+
+	_, err = newWithClient(client, fakeKeyURI, privateEc256, publicEc256, WithTenantKeyURITemplate("gcp-kms://projects/p/locations/l/keyRings/r/cryptoKeys/k"))
+	assert.Error(t, err)
+}
+
+// failingAEAD fails to decrypt with the given error, like the remote key of Cloud KMS.
+type failingAEAD struct {
+	err error
+}
+
+func (f *failingAEAD) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	return nil, f.err
+}
+
+func (f *failingAEAD) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	return nil, f.err
+}
+
+func TestDecryptKMSErrors(t *testing.T) {
+	// ciphertexts which Cloud KMS can't decrypt are decryption failures
+	_, err := decrypt(&failingAEAD{err: &googleapi.Error{Code: http.StatusBadRequest}}, []byte("ciphertext"), "123")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+	assert.NotErrorIs(t, err, ErrKMSUnavailable)
+
+	// all other failures of the KMS may succeed when they are retried
+	for _, kmsErr := range []error{
+		&googleapi.Error{Code: http.StatusServiceUnavailable},
+		&googleapi.Error{Code: http.StatusTooManyRequests},
+		&googleapi.Error{Code: http.StatusForbidden},
+		context.DeadlineExceeded,
+	} {
+		_, err := decrypt(&failingAEAD{err: kmsErr}, []byte("ciphertext"), "123")
+		assert.ErrorIs(t, err, ErrKMSUnavailable)
+		assert.NotErrorIs(t, err, ErrDecryptionFailed)
+	}
+
+	_, err = decrypt(&failingAEAD{err: errors.New("aead_factory: decryption failed")}, []byte("ciphertext"), "123")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+}
+
+// flakyAEAD fails with err while it is set, like the remote key of a tenant while Cloud KMS denies access
+// to it.
+type flakyAEAD struct {
+	tink.AEAD
+
+	err error
+}
+
+func (f *flakyAEAD) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.AEAD.Encrypt(plaintext, associatedData)
+}
+
+func (f *flakyAEAD) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.AEAD.Decrypt(ciphertext, associatedData)
+}
+
+// flakyKMSClient returns the same remote key for every key URI.
+type flakyKMSClient struct {
+	registry.KMSClient
+
+	remote *flakyAEAD
+}
+
+func (c *flakyKMSClient) GetAEAD(keyURI string) (tink.AEAD, error) {
+	return c.remote, nil
+}
+
+func TestForTenantFailsWhileTenantKeyIsUnavailable(t *testing.T) {
+	client, err := fakekms.NewClient("fake-kms://")
+	require.NoError(t, err)
+
+	privateEc256, publicEc256, err := generateJWTKeysetsWithClient(fakeKeyURI, client)
+	require.NoError(t, err)
+
+	svc, err := newWithClient(client, fakeKeyURI, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	tenantKeyURI, err := fakekms.NewKeyURI()
+	require.NoError(t, err)
+
+	tenantRemote, err := client.GetAEAD(tenantKeyURI)
+	require.NoError(t, err)
+
+	remote := &flakyAEAD{AEAD: tenantRemote}
+
+	svc.tenants.keyFor = func(tenantId string) (tink.AEAD, error) {
+		envelope, _, err := newCloudKMSEnvelope(&flakyKMSClient{remote: remote}, tenantKeyURI)
+		return envelope, err
+	}
+
+	tenantSvc, err := svc.ForTenant("tenant-a")
+	require.NoError(t, err)
+
+	// while the key of the tenant is unavailable, encryption fails instead of using the default key
+	remote.err = &googleapi.Error{Code: http.StatusForbidden}
+
+	_, err = tenantSvc.Encrypt([]byte("during outage"), "123")
+	assert.ErrorIs(t, err, ErrKMSUnavailable)
+
+	_, err = tenantSvc.EncryptString("during outage", "123")
+	assert.ErrorIs(t, err, ErrKMSUnavailable)
+
+	// once the key is available again, retries succeed with the key of the tenant
+	remote.err = nil
+
+	ciphertext, err := tenantSvc.Encrypt([]byte("after outage"), "123")
+	require.NoError(t, err)
+
+	_, err = svc.Decrypt(ciphertext, "123")
+	assert.Error(t, err, "the data of the tenant must not be readable with the default key")
+
+	decrypted, err := tenantSvc.Decrypt(ciphertext, "123")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("after outage"), decrypted)
+
+	// data which was written with the default key before tenant keys were enabled can still be decrypted
+	legacy, err := svc.Encrypt([]byte("legacy"), "123")
+	require.NoError(t, err)
+
+	decrypted, err = tenantSvc.Decrypt(legacy, "123")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("legacy"), decrypted)
+}
+
+func TestForTenantFailsForInvalidRequests(t *testing.T) {
+	client, err := fakekms.NewClient(fakeKeyURI)
+	require.NoError(t, err)
+
+	privateEc256, publicEc256, err := generateJWTKeysetsWithClient(fakeKeyURI, client)
+	require.NoError(t, err)
+
+	svc, err := newWithClient(client, fakeKeyURI, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	svc.tenants.keyFor = func(tenantId string) (tink.AEAD, error) {
+		return &failingAEAD{err: &googleapi.Error{Code: http.StatusBadRequest}}, nil
+	}
+
+	tenantSvc, err := svc.ForTenant("tenant-a")
+	require.NoError(t, err)
+
+	_, err = tenantSvc.Encrypt([]byte("test message"), "123")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrKMSUnavailable)
+}
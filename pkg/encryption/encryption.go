@@ -1,11 +1,30 @@
 package encryption
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/tink-crypto/tink-go/tink"
+	"google.golang.org/api/googleapi"
 )
 
+// ErrDecryptionFailed is returned when a ciphertext can't be decrypted, for example because it was
+// encrypted with a key which is no longer configured, with a different data id, or is corrupted.
+// Retrying the decryption doesn't help.
+var ErrDecryptionFailed = errors.New("decryption failed")
+
+// ErrKMSUnavailable is returned when data can't be encrypted or decrypted because Cloud KMS failed, for
+// example because it can't be reached, rate limits the engine or denies access to the key. Unlike
+// ErrDecryptionFailed, the call may succeed when it is retried.
+var ErrKMSUnavailable = errors.New("key management service unavailable")
+
 func encrypt(key tink.AEAD, plaintext []byte, dataId string) ([]byte, error) {
 	// validate data id is not empty
 	if len(dataId) == 0 {
@@ -15,7 +34,17 @@ func encrypt(key tink.AEAD, plaintext []byte, dataId string) ([]byte, error) {
 	associatedData := []byte(dataId)
 
 	// encrypt the data
-	return key.Encrypt(plaintext, associatedData)
+	ciphertext, err := key.Encrypt(plaintext, associatedData)
+
+	if err != nil {
+		if isKMSUnavailable(err) {
+			return nil, fmt.Errorf("%w: %w", ErrKMSUnavailable, err)
+		}
+
+		return nil, err
+	}
+
+	return ciphertext, nil
 }
 
 func decrypt(key tink.AEAD, ciphertext []byte, dataId string) ([]byte, error) {
@@ -27,5 +56,136 @@ func decrypt(key tink.AEAD, ciphertext []byte, dataId string) ([]byte, error) {
 	associatedData := []byte(dataId)
 
 	// decrypt the data
-	return key.Decrypt(ciphertext, associatedData)
+	plaintext, err := key.Decrypt(ciphertext, associatedData)
+
+	if err != nil {
+		if isKMSUnavailable(err) {
+			return nil, fmt.Errorf("%w: %w", ErrKMSUnavailable, err)
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrDecryptionFailed, err)
+	}
+
+	return plaintext, nil
+}
+
+// isKMSUnavailable returns true if err is a failure of Cloud KMS rather than of the ciphertext. Cloud KMS
+// rejects ciphertexts which it can't decrypt with 400 Bad Request, so all other errors of its API, and
+// errors reaching it, are failures of the KMS.
+func isKMSUnavailable(err error) bool {
+	var apiErr *googleapi.Error
+
+	if errors.As(err, &apiErr) {
+		return apiErr.Code != http.StatusBadRequest
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
+
+func decryptString(key tink.AEAD, ciphertext string, dataId string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
+
+	if err != nil {
+		return "", fmt.Errorf("%w: could not decode ciphertext: %w", ErrDecryptionFailed, err)
+	}
+
+	b, err := decrypt(key, decoded, dataId)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// maxDecryptBatchConcurrency is the maximum number of concurrent decrypt calls made by DecryptBatch.
+// For KMS-backed services, each decrypt results in a round-trip to the KMS.
+const maxDecryptBatchConcurrency = 10
+
+// EncryptedItem is a ciphertext along with the data id it was encrypted with.
+type EncryptedItem struct {
+	Ciphertext []byte
+	DataId     string
+}
+
+// BatchDecryptError is returned from DecryptBatch when one or more items could not be decrypted.
+type BatchDecryptError struct {
+	// Errors maps the index of each item which failed to decrypt to its error
+	Errors map[int]error
+}
+
+func (e *BatchDecryptError) Error() string {
+	indices := make([]int, 0, len(e.Errors))
+
+	for i := range e.Errors {
+		indices = append(indices, i)
+	}
+
+	sort.Ints(indices)
+
+	msgs := make([]string, 0, len(indices))
+
+	for _, i := range indices {
+		msgs = append(msgs, fmt.Sprintf("item %d: %s", i, e.Errors[i].Error()))
+	}
+
+	return fmt.Sprintf("failed to decrypt %d item(s): %s", len(indices), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the items, so that errors.Is(err, ErrDecryptionFailed) reports whether
+// any item failed to decrypt.
+func (e *BatchDecryptError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func decryptBatch(key tink.AEAD, items []EncryptedItem) ([][]byte, error) {
+	res := make([][]byte, len(items))
+	errs := make(map[int]error)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, maxDecryptBatchConcurrency)
+
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, item EncryptedItem) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			plaintext, err := decrypt(key, item.Ciphertext, item.DataId)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			res[i] = plaintext
+		}(i, item)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return res, &BatchDecryptError{
+			Errors: errs,
+		}
+	}
+
+	return res, nil
 }
@@ -16,11 +16,32 @@ type localEncryptionService struct {
 	key                *aead.KMSEnvelopeAEAD
 	privateEc256Handle *keyset.Handle
 	publicEc256Handle  *keyset.Handle
+	tenants            *tenantServices
+}
+
+type LocalEncryptionOpt func(*localEncryptionOpts)
+
+type localEncryptionOpts struct {
+	tenantKeys bool
+}
+
+// WithDerivedTenantKeys derives a separate key for each tenant from the master key with HKDF, so that
+// the data of each tenant is encrypted with its own key. The master keyset must be an AES-GCM keyset.
+func WithDerivedTenantKeys() LocalEncryptionOpt {
+	return func(opts *localEncryptionOpts) {
+		opts.tenantKeys = true
+	}
 }
 
 // NewLocalEncryption creates a new local encryption service. keysetBytes is the raw keyset in
 // base64-encoded JSON format. This can be generated by calling hatchet-admin keyset create-local.
-func NewLocalEncryption(masterKey []byte, privateEc256 []byte, publicEc256 []byte) (*localEncryptionService, error) {
+func NewLocalEncryption(masterKey []byte, privateEc256 []byte, publicEc256 []byte, fs ...LocalEncryptionOpt) (*localEncryptionService, error) {
+	opts := &localEncryptionOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
 	// get the master keyset handle
 	aes256GcmHandle, err := insecureHandleFromBytes(masterKey)
 
@@ -52,11 +73,26 @@ func NewLocalEncryption(masterKey []byte, privateEc256 []byte, publicEc256 []byt
 		return nil, fmt.Errorf("failed to create envelope")
 	}
 
-	return &localEncryptionService{
+	svc := &localEncryptionService{
 		key:                envelope,
 		privateEc256Handle: privateEc256Handle,
 		publicEc256Handle:  publicEc256Handle,
-	}, nil
+	}
+
+	svc.tenants = &tenantServices{
+		root:       svc,
+		defaultKey: envelope,
+	}
+
+	if opts.tenantKeys {
+		svc.tenants.keyFor, err = derivedTenantKeys(aes256GcmHandle)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return svc, nil
 }
 
 func GenerateLocalKeys() (masterKey []byte, privateEc256 []byte, publicEc256 []byte, err error) {
@@ -212,6 +248,10 @@ func (svc *localEncryptionService) Decrypt(ciphertext []byte, dataId string) ([]
 	return decrypt(svc.key, ciphertext, dataId)
 }
 
+func (svc *localEncryptionService) DecryptBatch(items []EncryptedItem) ([][]byte, error) {
+	return decryptBatch(svc.key, items)
+}
+
 func (svc *localEncryptionService) EncryptString(data string, dataId string) (string, error) {
 	b, err := encrypt(svc.key, []byte(data), dataId)
 	if err != nil {
@@ -221,15 +261,7 @@ func (svc *localEncryptionService) EncryptString(data string, dataId string) (st
 }
 
 func (svc *localEncryptionService) DecryptString(data string, dataId string) (string, error) {
-	plain, err := base64.StdEncoding.DecodeString(data)
-	if err != nil {
-		return "", err
-	}
-	b, err := decrypt(svc.key, plain, dataId)
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
+	return decryptString(svc.key, data, dataId)
 }
 
 func (svc *localEncryptionService) GetPrivateJWTHandle() *keyset.Handle {
@@ -239,3 +271,7 @@ func (svc *localEncryptionService) GetPrivateJWTHandle() *keyset.Handle {
 func (svc *localEncryptionService) GetPublicJWTHandle() *keyset.Handle {
 	return svc.publicEc256Handle
 }
+
+func (svc *localEncryptionService) ForTenant(tenantId string) (EncryptionService, error) {
+	return svc.tenants.forTenant(tenantId)
+}
@@ -1,6 +1,7 @@
 package encryption
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -78,7 +79,35 @@ func TestDecryptWithInvalidKey(t *testing.T) {
 
 	// Attempt to decrypt with a different key
 	_, err := newSvc.Decrypt(ciphertext, dataID)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	// Attempt to decrypt with a different data id
+	_, err = svc.Decrypt(ciphertext, "456")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+}
+
+func TestDecryptStringWithOldKey(t *testing.T) {
+	aes256Gcm, privateEc256, publicEc256, _ := GenerateLocalKeys()
+	oldSvc, _ := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+
+	ciphertext, err := oldSvc.EncryptString("test message", "123")
+	assert.NoError(t, err)
+
+	// the key was rotated away
+	aes256Gcm, privateEc256, publicEc256, _ = GenerateLocalKeys()
+	svc, _ := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+
+	_, err = svc.DecryptString(ciphertext, "123")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	// corrupted ciphertexts are decryption failures as well
+	_, err = svc.DecryptString("not base64!", "123")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	// an empty data id is a programming error rather than a decryption failure
+	_, err = svc.DecryptString(ciphertext, "")
 	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrDecryptionFailed)
 }
 
 func TestEncryptDecryptWithEmptyDataID(t *testing.T) {
@@ -96,3 +125,108 @@ func TestEncryptDecryptWithEmptyDataID(t *testing.T) {
 	_, err = svc.Decrypt(plaintext, emptyDataID)
 	assert.Error(t, err)
 }
+
+func TestDecryptBatch(t *testing.T) {
+	aes256Gcm, privateEc256, publicEc256, _ := GenerateLocalKeys()
+	svc, _ := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+
+	items := make([]EncryptedItem, 0)
+
+	for i := 0; i < 25; i++ {
+		dataID := fmt.Sprintf("%d", i)
+
+		ciphertext, err := svc.Encrypt([]byte(fmt.Sprintf("test message %d", i)), dataID)
+		assert.NoError(t, err)
+
+		items = append(items, EncryptedItem{
+			Ciphertext: ciphertext,
+			DataId:     dataID,
+		})
+	}
+
+	decrypted, err := svc.DecryptBatch(items)
+	assert.NoError(t, err)
+
+	for i, plaintext := range decrypted {
+		assert.Equal(t, []byte(fmt.Sprintf("test message %d", i)), plaintext)
+	}
+}
+
+func TestDecryptBatchWithInvalidItem(t *testing.T) {
+	aes256Gcm, privateEc256, publicEc256, _ := GenerateLocalKeys()
+	svc, _ := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+
+	ciphertext, _ := svc.Encrypt([]byte("test message"), "123")
+
+	decrypted, err := svc.DecryptBatch([]EncryptedItem{
+		{Ciphertext: ciphertext, DataId: "123"},
+		{Ciphertext: []byte("invalid ciphertext"), DataId: "456"},
+		{Ciphertext: ciphertext, DataId: "123"},
+	})
+
+	// the valid items should still be decrypted
+	assert.Equal(t, []byte("test message"), decrypted[0])
+	assert.Nil(t, decrypted[1])
+	assert.Equal(t, []byte("test message"), decrypted[2])
+
+	var batchErr *BatchDecryptError
+	assert.ErrorAs(t, err, &batchErr)
+	assert.Len(t, batchErr.Errors, 1)
+	assert.Contains(t, batchErr.Errors, 1)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+}
+
+func TestForTenantWithDerivedKeys(t *testing.T) {
+	aes256Gcm, privateEc256, publicEc256, _ := GenerateLocalKeys()
+
+	// data written before tenant keys were enabled
+	legacySvc, _ := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+	legacyCiphertext, _ := legacySvc.Encrypt([]byte("legacy message"), "123")
+
+	svc, err := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256, WithDerivedTenantKeys())
+	assert.NoError(t, err)
+
+	tenantA, err := svc.ForTenant("tenant-a")
+	assert.NoError(t, err)
+
+	tenantB, err := svc.ForTenant("tenant-b")
+	assert.NoError(t, err)
+
+	ciphertext, err := tenantA.Encrypt([]byte("test message"), "123")
+	assert.NoError(t, err)
+
+	decrypted, err := tenantA.Decrypt(ciphertext, "123")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test message"), decrypted)
+
+	// the key of a tenant can't decrypt the data of another tenant, or be used without tenant
+	_, err = tenantB.Decrypt(ciphertext, "123")
+	assert.Error(t, err)
+
+	_, err = svc.Decrypt(ciphertext, "123")
+	assert.Error(t, err)
+
+	// keys are derived deterministically from the master key
+	restartedSvc, _ := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256, WithDerivedTenantKeys())
+	restartedTenantA, _ := restartedSvc.ForTenant("tenant-a")
+
+	decrypted, err = restartedTenantA.Decrypt(ciphertext, "123")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test message"), decrypted)
+
+	// data encrypted with the master key can still be decrypted
+	decrypted, err = tenantA.Decrypt(legacyCiphertext, "123")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("legacy message"), decrypted)
+
+	assert.Equal(t, svc.GetPublicJWTHandle(), tenantA.GetPublicJWTHandle())
+}
+
+func TestForTenantWithoutTenantKeys(t *testing.T) {
+	aes256Gcm, privateEc256, publicEc256, _ := GenerateLocalKeys()
+	svc, _ := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+
+	tenantSvc, err := svc.ForTenant("tenant-a")
+	assert.NoError(t, err)
+	assert.Equal(t, svc, tenantSvc)
+}
@@ -0,0 +1,146 @@
+package encryption
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
+)
+
+const (
+	BackendLocal    = "local"
+	BackendCloudKMS = "cloudkms"
+)
+
+// instrumentedEncryptionService records the duration and errors of the encrypt and decrypt calls of an
+// encryption service.
+type instrumentedEncryptionService struct {
+	EncryptionService
+
+	backend string
+}
+
+// Instrument returns an encryption service which records the duration of each encrypt and decrypt call
+// of svc in the hatchet_encryption_duration_seconds Prometheus histogram, which is exported with the
+// other metrics when prometheus.enabled is set. The observations are labeled with the backend, the
+// operation, the purpose of the data and whether the call failed. The purpose is the data id, or
+// "tenant" for data ids which are tenant ids.
+func Instrument(svc EncryptionService, backend string) EncryptionService {
+	return &instrumentedEncryptionService{
+		EncryptionService: svc,
+		backend:           backend,
+	}
+}
+
+func (svc *instrumentedEncryptionService) record(operation, dataId string, start time.Time, err error) {
+	metrics.EncryptionDuration.WithLabelValues(
+		svc.backend,
+		operation,
+		purpose(dataId),
+		strconv.FormatBool(err != nil),
+	).Observe(time.Since(start).Seconds())
+}
+
+func (svc *instrumentedEncryptionService) Encrypt(plaintext []byte, dataId string) ([]byte, error) {
+	start := time.Now()
+	ciphertext, err := svc.EncryptionService.Encrypt(plaintext, dataId)
+	svc.record("encrypt", dataId, start, err)
+
+	return ciphertext, err
+}
+
+func (svc *instrumentedEncryptionService) Decrypt(ciphertext []byte, dataId string) ([]byte, error) {
+	start := time.Now()
+	plaintext, err := svc.EncryptionService.Decrypt(ciphertext, dataId)
+	svc.record("decrypt", dataId, start, err)
+
+	return plaintext, err
+}
+
+func (svc *instrumentedEncryptionService) DecryptBatch(items []EncryptedItem) ([][]byte, error) {
+	dataId := ""
+
+	if len(items) > 0 {
+		dataId = items[0].DataId
+	}
+
+	start := time.Now()
+	plaintexts, err := svc.EncryptionService.DecryptBatch(items)
+	svc.record("decrypt_batch", dataId, start, err)
+
+	return plaintexts, err
+}
+
+func (svc *instrumentedEncryptionService) EncryptString(plaintext string, dataId string) (string, error) {
+	start := time.Now()
+	ciphertext, err := svc.EncryptionService.EncryptString(plaintext, dataId)
+	svc.record("encrypt", dataId, start, err)
+
+	return ciphertext, err
+}
+
+func (svc *instrumentedEncryptionService) DecryptString(ciphertext string, dataId string) (string, error) {
+	start := time.Now()
+	plaintext, err := svc.EncryptionService.DecryptString(ciphertext, dataId)
+	svc.record("decrypt", dataId, start, err)
+
+	return plaintext, err
+}
+
+func (svc *instrumentedEncryptionService) ForTenant(tenantId string) (EncryptionService, error) {
+	tenantSvc, err := svc.EncryptionService.ForTenant(tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &instrumentedEncryptionService{
+		EncryptionService: tenantSvc,
+		backend:           svc.backend,
+	}, nil
+}
+
+// purpose returns the label for a data id. Some data is encrypted with the tenant id as its data id, so
+// these are grouped to keep the number of labels small.
+func purpose(dataId string) string {
+	if _, err := uuid.Parse(dataId); err == nil {
+		return "tenant"
+	}
+
+	return dataId
+}
+
+// selfTestDataId is the data id of the data which is encrypted by SelfTest.
+const selfTestDataId = "hatchet_encryption_self_test"
+
+// SelfTest encrypts and decrypts a random value with svc, so that a misconfigured backend, such as a
+// KMS key which the server is not allowed to use, is detected when the server starts instead of when
+// data is first encrypted.
+func SelfTest(svc EncryptionService) error {
+	plaintext := uuid.New().String()
+
+	ciphertext, err := svc.EncryptString(plaintext, selfTestDataId)
+
+	if err != nil {
+		return fmt.Errorf("could not encrypt: %w", err)
+	}
+
+	decrypted, err := svc.DecryptString(ciphertext, selfTestDataId)
+
+	if err != nil {
+		return fmt.Errorf("could not decrypt: %w", err)
+	}
+
+	if decrypted != plaintext {
+		return fmt.Errorf("decrypted value does not match the encrypted value")
+	}
+
+	if svc.GetPrivateJWTHandle() == nil || svc.GetPublicJWTHandle() == nil {
+		return fmt.Errorf("JWT keysets are not configured")
+	}
+
+	return nil
+}
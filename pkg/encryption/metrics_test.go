@@ -0,0 +1,99 @@
+package encryption
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// observations returns the number of observations of the encryption histogram for the backend, keyed
+// by the operation, purpose and error labels. It reads the default registry, which the metrics listener
+// serves.
+func observations(t *testing.T, backend string) map[string]uint64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	res := map[string]uint64{}
+
+	for _, family := range families {
+		if family.GetName() != "hatchet_encryption_duration_seconds" {
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			labels := map[string]string{}
+
+			for _, label := range m.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["backend"] != backend {
+				continue
+			}
+
+			key := strings.Join([]string{labels["operation"], labels["purpose"], labels["error"]}, "/")
+			res[key] = m.GetHistogram().GetSampleCount()
+		}
+	}
+
+	return res
+}
+
+func TestInstrument(t *testing.T) {
+	aes256Gcm, privateEc256, publicEc256, err := GenerateLocalKeys()
+	require.NoError(t, err)
+
+	local, err := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	// the histogram is shared by the process, so a distinct backend keeps other tests out of the counts
+	backend := "test-" + uuid.New().String()
+	svc := Instrument(local, backend)
+
+	ciphertext, err := svc.Encrypt([]byte("token"), "api_token")
+	require.NoError(t, err)
+
+	_, err = svc.Decrypt(ciphertext, "other")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	tenantSvc, err := svc.ForTenant(uuid.New().String())
+	require.NoError(t, err)
+
+	_, err = tenantSvc.EncryptString("secret", uuid.New().String())
+	require.NoError(t, err)
+
+	_, err = tenantSvc.EncryptString("secret", uuid.New().String())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]uint64{
+		"encrypt/api_token/false": 1,
+		"decrypt/other/true":      1,
+		"encrypt/tenant/false":    2,
+	}, observations(t, backend))
+}
+
+func TestSelfTest(t *testing.T) {
+	aes256Gcm, privateEc256, publicEc256, err := GenerateLocalKeys()
+	require.NoError(t, err)
+
+	svc, err := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	assert.NoError(t, SelfTest(svc))
+
+	// a service which can't decrypt its own ciphertexts fails the self-test
+	assert.ErrorIs(t, SelfTest(&brokenDecryptService{EncryptionService: svc}), ErrDecryptionFailed)
+}
+
+// brokenDecryptService decrypts strings with the wrong data id.
+type brokenDecryptService struct {
+	EncryptionService
+}
+
+func (b *brokenDecryptService) DecryptString(ciphertext string, dataId string) (string, error) {
+	return b.EncryptionService.DecryptString(ciphertext, dataId+"_wrong")
+}
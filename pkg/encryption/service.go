@@ -13,6 +13,11 @@ type EncryptionService interface {
 	// For more information, see: https://developers.google.com/tink/client-side-encryption#kms_envelope_aead
 	Decrypt(ciphertext []byte, dataId string) ([]byte, error)
 
+	// DecryptBatch decrypts the given items concurrently. The returned slice is indexed the same as the
+	// input items. If any items fail to decrypt, the remaining items are still decrypted, and a
+	// *BatchDecryptError is returned which reports the error for each failed item.
+	DecryptBatch(items []EncryptedItem) ([][]byte, error)
+
 	// EncryptString encrypts a string using base64 internally
 	EncryptString(plaintext string, dataId string) (string, error)
 
@@ -24,4 +29,10 @@ type EncryptionService interface {
 
 	// GetPublicJWTHandle returns a public JWT handle. This is used to verify JWTs.
 	GetPublicJWTHandle() *keyset.Handle
+
+	// ForTenant returns the encryption service for data which belongs to the given tenant. If tenant keys
+	// are enabled, each tenant's data is encrypted with its own key, and data which was encrypted before
+	// tenant keys were enabled can still be decrypted. Otherwise, and for an empty tenant id, this returns
+	// the service of the default tenant, which encrypts with the master key.
+	ForTenant(tenantId string) (EncryptionService, error)
 }
@@ -0,0 +1,187 @@
+package encryption
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/tink-crypto/tink-go/aead"
+	"github.com/tink-crypto/tink-go/aead/subtle"
+	"github.com/tink-crypto/tink-go/insecurecleartextkeyset"
+	"github.com/tink-crypto/tink-go/keyset"
+	gcmpb "github.com/tink-crypto/tink-go/proto/aes_gcm_go_proto"
+	"github.com/tink-crypto/tink-go/tink"
+	"golang.org/x/crypto/hkdf"
+	"google.golang.org/protobuf/proto"
+)
+
+// tenantKeyInfoPrefix is the HKDF info prefix used to derive tenant keys from the master key. Changing
+// it changes the keys of all tenants, so existing data can no longer be decrypted.
+const tenantKeyInfoPrefix = "hatchet-tenant-key:"
+
+const aesGcmKeyTypeURL = "type.googleapis.com/google.crypto.tink.AesGcmKey"
+
+// tenantKeyFunc returns the envelope AEAD which encrypts the data of a tenant.
+type tenantKeyFunc func(tenantId string) (tink.AEAD, error)
+
+// tenantServices resolves and caches the encryption services of tenants.
+type tenantServices struct {
+	// the service of the default tenant
+	root EncryptionService
+
+	// the key of the default tenant
+	defaultKey tink.AEAD
+
+	// keyFor is nil if tenant keys are disabled
+	keyFor tenantKeyFunc
+
+	services sync.Map
+}
+
+func (t *tenantServices) forTenant(tenantId string) (EncryptionService, error) {
+	if t.keyFor == nil || tenantId == "" {
+		return t.root, nil
+	}
+
+	if svc, ok := t.services.Load(tenantId); ok {
+		return svc.(EncryptionService), nil
+	}
+
+	key, err := t.keyFor(tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get key for tenant %s: %w", tenantId, err)
+	}
+
+	svc, _ := t.services.LoadOrStore(tenantId, &tenantEncryptionService{
+		EncryptionService: t.root,
+		key: &fallbackAEAD{
+			primary:  key,
+			fallback: t.defaultKey,
+		},
+	})
+
+	return svc.(EncryptionService), nil
+}
+
+// fallbackAEAD encrypts with the primary key. It decrypts with the primary key, and falls back to the
+// fallback key for ciphertexts which were written before tenant keys were enabled.
+//
+// Encryption never falls back. If the primary key is unavailable, for example because the key of the
+// tenant doesn't exist in Cloud KMS yet or Cloud KMS denies access to it, the error is returned so that
+// the caller can retry. Encrypting with the fallback key would store the data of the tenant under a key
+// which revoking the key of the tenant doesn't cover.
+type fallbackAEAD struct {
+	primary  tink.AEAD
+	fallback tink.AEAD
+}
+
+func (f *fallbackAEAD) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	return f.primary.Encrypt(plaintext, associatedData)
+}
+
+func (f *fallbackAEAD) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	plaintext, err := f.primary.Decrypt(ciphertext, associatedData)
+
+	if err == nil {
+		return plaintext, nil
+	}
+
+	plaintext, fallbackErr := f.fallback.Decrypt(ciphertext, associatedData)
+
+	if fallbackErr != nil {
+		return nil, err
+	}
+
+	return plaintext, nil
+}
+
+// tenantEncryptionService encrypts the data of a single tenant. JWT handles are shared by all tenants.
+type tenantEncryptionService struct {
+	EncryptionService
+
+	key tink.AEAD
+}
+
+func (svc *tenantEncryptionService) Encrypt(plaintext []byte, dataId string) ([]byte, error) {
+	return encrypt(svc.key, plaintext, dataId)
+}
+
+func (svc *tenantEncryptionService) Decrypt(ciphertext []byte, dataId string) ([]byte, error) {
+	return decrypt(svc.key, ciphertext, dataId)
+}
+
+func (svc *tenantEncryptionService) DecryptBatch(items []EncryptedItem) ([][]byte, error) {
+	return decryptBatch(svc.key, items)
+}
+
+func (svc *tenantEncryptionService) EncryptString(plaintext string, dataId string) (string, error) {
+	b, err := encrypt(svc.key, []byte(plaintext), dataId)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func (svc *tenantEncryptionService) DecryptString(ciphertext string, dataId string) (string, error) {
+	return decryptString(svc.key, ciphertext, dataId)
+}
+
+// derivedTenantKeys returns a tenantKeyFunc which derives the key of each tenant from the primary key
+// of the master keyset with HKDF-SHA256.
+func derivedTenantKeys(masterHandle *keyset.Handle) (tenantKeyFunc, error) {
+	masterKey, err := aesGcmKeyMaterial(masterHandle)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(tenantId string) (tink.AEAD, error) {
+		derived := make([]byte, len(masterKey))
+
+		if _, err := io.ReadFull(hkdf.New(sha256.New, masterKey, nil, []byte(tenantKeyInfoPrefix+tenantId)), derived); err != nil {
+			return nil, fmt.Errorf("could not derive key: %w", err)
+		}
+
+		kek, err := subtle.NewAESGCM(derived)
+
+		if err != nil {
+			return nil, err
+		}
+
+		envelope := aead.NewKMSEnvelopeAEAD2(aead.AES128GCMKeyTemplate(), kek)
+
+		if envelope == nil {
+			return nil, fmt.Errorf("failed to create envelope")
+		}
+
+		return envelope, nil
+	}, nil
+}
+
+// aesGcmKeyMaterial returns the raw key of the primary key of an AES-GCM keyset.
+func aesGcmKeyMaterial(handle *keyset.Handle) ([]byte, error) {
+	ks := insecurecleartextkeyset.KeysetMaterial(handle)
+
+	for _, key := range ks.GetKey() {
+		if key.GetKeyId() != ks.GetPrimaryKeyId() {
+			continue
+		}
+
+		if key.GetKeyData().GetTypeUrl() != aesGcmKeyTypeURL {
+			return nil, fmt.Errorf("tenant keys require an AES-GCM master key, got %s", key.GetKeyData().GetTypeUrl())
+		}
+
+		gcmKey := &gcmpb.AesGcmKey{}
+
+		if err := proto.Unmarshal(key.GetKeyData().GetValue(), gcmKey); err != nil {
+			return nil, fmt.Errorf("could not read master key: %w", err)
+		}
+
+		return gcmKey.GetKeyValue(), nil
+	}
+
+	return nil, fmt.Errorf("master keyset has no primary key")
+}
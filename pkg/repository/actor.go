@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type ActorType string
+
+const (
+	ActorTypeUser     ActorType = "USER"
+	ActorTypeAPIToken ActorType = "API_TOKEN"
+)
+
+// Actor is the identity which initiated an action, such as triggering a workflow run or pushing an
+// event.
+type Actor struct {
+	Type ActorType `validate:"required,oneof=USER API_TOKEN"`
+
+	// the id of the user or API token
+	Id string `validate:"required,uuid"`
+
+	// the namespace of the API token, which limits the workflows and event keys which the actor can use
+	// to those of the namespace. It's empty for actors which can use all of them.
+	Namespace string `json:"-"`
+}
+
+// InNamespace returns whether the actor can use the workflow or event key with the given name. Names of
+// a namespace start with the namespace followed by an underscore, like the names which the SDKs prefix
+// with the namespace of the client.
+func (a *Actor) InNamespace(name string) bool {
+	if a == nil || a.Namespace == "" {
+		return true
+	}
+
+	return strings.HasPrefix(name, a.Namespace+"_")
+}
+
+// ErrNotInNamespace is returned when an actor uses a workflow or event key outside of its namespace.
+var ErrNotInNamespace = errors.New("not in the namespace of the api token")
+
+// CheckNamespace returns ErrNotInNamespace if the actor can't use the workflow or event key with the
+// given name.
+func (a *Actor) CheckNamespace(name string) error {
+	if !a.InNamespace(name) {
+		return fmt.Errorf("%s is %w", name, ErrNotInNamespace)
+	}
+
+	return nil
+}
+
+func (a *Actor) String() string {
+	if a == nil {
+		return "unknown"
+	}
+
+	return string(a.Type) + ":" + a.Id
+}
+
+type actorCtxKey struct{}
+
+// ContextWithActor returns a copy of ctx which carries the given actor.
+func ContextWithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorCtxKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored in ctx, or nil if the context does not carry an actor.
+func ActorFromContext(ctx context.Context) *Actor {
+	actor, _ := ctx.Value(actorCtxKey{}).(*Actor)
+	return actor
+}
+
+// WithActor records the actor which triggered the workflow run.
+func WithActor(actor *Actor) CreateWorkflowRunOpt {
+	return func(opts *CreateWorkflowRunOpts) {
+		opts.TriggeringActor = actor
+	}
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorInNamespace(t *testing.T) {
+	// actors without a namespace can use every name
+	var unknown *Actor
+	assert.True(t, unknown.InNamespace("orders_checkout"))
+	assert.True(t, (&Actor{Type: ActorTypeUser}).InNamespace("checkout"))
+
+	actor := &Actor{Type: ActorTypeAPIToken, Namespace: "orders"}
+
+	assert.True(t, actor.InNamespace("orders_checkout"))
+	assert.False(t, actor.InNamespace("checkout"))
+	assert.False(t, actor.InNamespace("ordersx_checkout"))
+	assert.False(t, actor.InNamespace("orders"))
+
+	assert.NoError(t, actor.CheckNamespace("orders_checkout"))
+	assert.ErrorIs(t, actor.CheckNamespace("billing_checkout"), ErrNotInNamespace)
+}
+
+func TestActorContext(t *testing.T) {
+	assert.Nil(t, ActorFromContext(context.Background()))
+
+	actor := &Actor{Type: ActorTypeAPIToken, Id: "707d0855-80ab-4e1f-a156-f1c4546cbf52"}
+
+	assert.Same(t, actor, ActorFromContext(ContextWithActor(context.Background(), actor)))
+}
+
+func TestActorString(t *testing.T) {
+	var unknown *Actor
+	assert.Equal(t, "unknown", unknown.String())
+
+	assert.Equal(t, "USER:707d0855-80ab-4e1f-a156-f1c4546cbf52", (&Actor{Type: ActorTypeUser, Id: "707d0855-80ab-4e1f-a156-f1c4546cbf52"}).String())
+}
+
+func TestWithActor(t *testing.T) {
+	actor := &Actor{Type: ActorTypeUser, Id: "707d0855-80ab-4e1f-a156-f1c4546cbf52"}
+
+	opts := &CreateWorkflowRunOpts{}
+	WithActor(actor)(opts)
+
+	assert.Same(t, actor, opts.TriggeringActor)
+}
@@ -22,12 +22,50 @@ type CreateAPITokenOpts struct {
 	Name *string `validate:"omitempty,max=255"`
 
 	Internal bool
+
+	// (optional) The scopes of the token. A token without scopes can be used for everything.
+	Scopes []string `validate:"omitempty,dive,oneof=events:write runs:read admin"`
+
+	// (optional) The namespace of the token. A token with a namespace can only register, trigger and
+	// schedule the workflows of the namespace and push events with keys of the namespace.
+	Namespace *string `validate:"omitempty,hatchetName,max=64"`
+}
+
+// The scopes which restrict what an API token can be used for.
+const (
+	// APITokenScopeEventsWrite allows pushing and replaying events.
+	APITokenScopeEventsWrite = "events:write"
+
+	// APITokenScopeRunsRead allows reading workflow runs and step runs, and subscribing to their results.
+	APITokenScopeRunsRead = "runs:read"
+
+	// APITokenScopeAdmin allows everything, like a token without scopes.
+	APITokenScopeAdmin = "admin"
+)
+
+// APITokenHasScope returns whether a token with the given scopes can be used for something which
+// requires the scope.
+func APITokenHasScope(scopes []string, required string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+
+	for _, scope := range scopes {
+		if scope == APITokenScopeAdmin || scope == required {
+			return true
+		}
+	}
+
+	return false
 }
 
 type APITokenRepository interface {
 	GetAPITokenById(id string) (*db.APITokenModel, error)
 	RevokeAPIToken(id string) error
-	ListAPITokensByTenant(tenantId string) ([]db.APITokenModel, error)
+	ListAPITokensByTenant(ctx context.Context, tenantId string) ([]*dbsqlc.APIToken, error)
+
+	// ExpireAPIToken makes a token expire at the given time, unless it already expires earlier.
+	ExpireAPIToken(ctx context.Context, id string, expiresAt time.Time) error
 }
 
 type EngineTokenRepository interface {
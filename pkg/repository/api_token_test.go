@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITokenHasScope(t *testing.T) {
+	// tokens without scopes can be used for everything
+	assert.True(t, APITokenHasScope(nil, APITokenScopeAdmin))
+	assert.True(t, APITokenHasScope([]string{}, APITokenScopeRunsRead))
+
+	assert.True(t, APITokenHasScope([]string{APITokenScopeEventsWrite}, APITokenScopeEventsWrite))
+	assert.False(t, APITokenHasScope([]string{APITokenScopeEventsWrite}, APITokenScopeRunsRead))
+	assert.False(t, APITokenHasScope([]string{APITokenScopeEventsWrite, APITokenScopeRunsRead}, APITokenScopeAdmin))
+
+	assert.True(t, APITokenHasScope([]string{APITokenScopeAdmin}, APITokenScopeEventsWrite))
+	assert.True(t, APITokenHasScope([]string{APITokenScopeAdmin}, APITokenScopeAdmin))
+}
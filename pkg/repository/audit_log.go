@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// DefaultAuditLogRetentionPeriod is how long audit logs are kept for tenants which haven't set a retention
+// period.
+const DefaultAuditLogRetentionPeriod = "8760h"
+
+type CreateAuditLogOpts struct {
+	// (required) the actor which performed the action
+	Actor *Actor `validate:"required"`
+
+	// (required) the action, which is the operation id of the REST API or the method of the gRPC API
+	Action string `validate:"required,max=255"`
+
+	// (optional) the type of the resource which the action was performed on
+	ResourceType *string
+
+	// (optional) the id of the resource which the action was performed on
+	ResourceId *string `validate:"omitnil,uuid"`
+
+	// (optional) the IP address of the actor
+	IpAddress *string
+
+	// (optional) the payload of the action, as JSON
+	Payload []byte
+
+	// (optional) the state of the resource before the action, as JSON
+	PriorState []byte
+}
+
+type ListAuditLogsOpts struct {
+	// (optional) number of audit logs to skip
+	Offset *int
+
+	// (optional) number of audit logs to return
+	Limit *int `validate:"omitnil,min=1,max=1000"`
+
+	// (optional) the action to filter by
+	Action *string
+
+	// (optional) the id of the actor to filter by
+	ActorId *string `validate:"omitnil,uuid"`
+
+	// (optional) the id of the resource to filter by
+	ResourceId *string `validate:"omitnil,uuid"`
+
+	// (optional) only return audit logs created at or after this time
+	Since *time.Time
+
+	// (optional) only return audit logs created before this time
+	Until *time.Time
+}
+
+type ListAuditLogsResult struct {
+	Rows  []*dbsqlc.AuditLog
+	Count int
+}
+
+type UpdateAuditLogSettingsOpts struct {
+	// (required) how long audit logs are kept
+	RetentionPeriod string `validate:"required,duration"`
+}
+
+// AuditLogAPIRepository reads and writes the audit logs of tenants. Audit logs are append-only, so they
+// can't be changed once they're created.
+type AuditLogAPIRepository interface {
+	// CreateAuditLog records an action which was performed in the tenant.
+	CreateAuditLog(ctx context.Context, tenantId string, opts *CreateAuditLogOpts) (*dbsqlc.AuditLog, error)
+
+	// ListAuditLogs returns the audit logs of the tenant, newest first.
+	ListAuditLogs(ctx context.Context, tenantId string, opts *ListAuditLogsOpts) (*ListAuditLogsResult, error)
+
+	// GetAuditLogRetentionPeriod returns how long the audit logs of the tenant are kept.
+	GetAuditLogRetentionPeriod(ctx context.Context, tenantId string) (string, error)
+
+	// UpdateAuditLogSettings sets how long the audit logs of the tenant are kept.
+	UpdateAuditLogSettings(ctx context.Context, tenantId string, opts *UpdateAuditLogSettingsOpts) (*dbsqlc.TenantAuditLogSettings, error)
+}
+
+type AuditLogEngineRepository interface {
+	// CreateAuditLog records an action which was performed in the tenant.
+	CreateAuditLog(ctx context.Context, tenantId string, opts *CreateAuditLogOpts) (*dbsqlc.AuditLog, error)
+
+	// GetAuditLogRetentionPeriod returns how long the audit logs of the tenant are kept.
+	GetAuditLogRetentionPeriod(ctx context.Context, tenantId string) (string, error)
+
+	// DeleteExpiredAuditLogs deletes a batch of audit logs created before the given time, and returns
+	// true if there are more to delete.
+	DeleteExpiredAuditLogs(ctx context.Context, tenantId string, createdBefore time.Time) (bool, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
@@ -28,6 +29,39 @@ type CreateEventOpts struct {
 
 	// (optional) the event metadata
 	AdditionalMetadata []byte
+
+	// (optional) the ordering key of the event. Events with the same ordering key trigger their workflow
+	// runs one at a time, in order.
+	OrderingKey *string
+
+	// (optional) the sequence number of the event within its ordering key, starting at 1
+	OrderingSequence *int64 `validate:"omitempty,min=1"`
+
+	// (optional) the id assigned to the event by its producer. Each external id is accepted at most once
+	// per tenant, until the event is deleted by the event retention. Only supported by CreateEvent.
+	ExternalId *string `validate:"omitempty,min=1,max=255"`
+
+	// (optional) the time after which the external id is accepted again. By default, the external id is
+	// kept until the event is deleted.
+	ExternalIdExpiresAt *time.Time
+
+	// (optional) the priority of the workflow runs triggered by the event. The priority is not stored with
+	// the event, so it only applies when the event is processed right after it is created.
+	Priority *int32 `validate:"omitnil,min=1,max=3"`
+}
+
+type ErrEventExternalIdExists struct {
+	ExternalId string
+
+	// the event which was created with the external id, if it still exists
+	Event *dbsqlc.Event
+
+	// the ids of the workflow runs which the event has triggered so far
+	WorkflowRunIds []string
+}
+
+func (e ErrEventExternalIdExists) Error() string {
+	return fmt.Sprintf("event with external id %s already exists", e.ExternalId)
 }
 
 type ListEventOpts struct {
@@ -70,10 +104,32 @@ type ListEventResult struct {
 	Count int
 }
 
+type ListEventWorkflowRunsOpts struct {
+	// (optional) number of workflow runs to skip
+	Offset *int
+
+	// (optional) number of workflow runs to return
+	Limit *int
+}
+
+type ListEventWorkflowRunsResult struct {
+	Rows  []*dbsqlc.ListEventWorkflowRunsRow
+	Count int
+}
+
 type BulkCreateEventResult struct {
 	Events []*dbsqlc.Event
 }
 
+// OrderingKeyRelease identifies a release of the next event of an ordering key. The release sequence changes with
+// every release of the key, so a release which is conditional on it does nothing if another event of the key was
+// released in the meantime.
+type OrderingKeyRelease struct {
+	TenantId        string
+	OrderingKey     string
+	ReleaseSequence int64
+}
+
 type EventAPIRepository interface {
 	// ListEvents returns all events for a given tenant.
 	ListEvents(ctx context.Context, tenantId string, opts *ListEventOpts) (*ListEventResult, error)
@@ -86,12 +142,17 @@ type EventAPIRepository interface {
 
 	// ListEventsById returns a list of events by id.
 	ListEventsById(tenantId string, ids []string) ([]db.EventModel, error)
+
+	// ListEventWorkflowRuns returns the workflow runs which were triggered by an event, in the order in
+	// which they were created.
+	ListEventWorkflowRuns(ctx context.Context, tenantId, eventId string, opts *ListEventWorkflowRunsOpts) (*ListEventWorkflowRunsResult, error)
 }
 
 type EventEngineRepository interface {
 	RegisterCreateCallback(callback TenantScopedCallback[*dbsqlc.Event])
 
-	// CreateEvent creates a new event for a given tenant.
+	// CreateEvent creates a new event for a given tenant. If the event has an external id which was already
+	// accepted for the tenant, it returns ErrEventExternalIdExists with the original event.
 	CreateEvent(ctx context.Context, opts *CreateEventOpts) (*dbsqlc.Event, error)
 
 	// CreateEvent creates new events for a given tenant.
@@ -112,4 +173,33 @@ type EventEngineRepository interface {
 	// ClearEventPayloadData removes the potentially large payload data of events that were created before the given time.
 	// It returns the number of events that were updated and the number of events that were not updated.
 	ClearEventPayloadData(ctx context.Context, tenantId string) (bool, error)
+
+	// DeleteExpiredExternalIds deletes a batch of external ids whose window has passed, and returns whether
+	// there are more external ids to delete.
+	DeleteExpiredExternalIds(ctx context.Context, tenantId string) (bool, error)
+
+	// PurgeDeletedEvents permanently deletes a batch of events which were soft-deleted before the given time. It
+	// returns whether there are more events to purge.
+	PurgeDeletedEvents(ctx context.Context, tenantId string, deletedBefore time.Time) (bool, error)
+
+	// ReleaseOrderedEvent releases the next event of the ordering key, if no other event of the key is in progress.
+	// Events with a sequence number are released in sequence order, and are held back while an earlier sequence
+	// number is missing, unless maxBuffered events of the key are pending. If releaseSequence is set, nothing is
+	// released unless it matches the current release sequence of the key. It returns nil if no event was released.
+	ReleaseOrderedEvent(ctx context.Context, tenantId, orderingKey string, releaseSequence *int64, maxBuffered int) (*dbsqlc.Event, error)
+
+	// MarkOrderedEventTriggered marks that the workflow runs of a released event have been created. It returns the
+	// release of the next event if the event is complete, i.e. all of its workflow runs have already finished.
+	MarkOrderedEventTriggered(ctx context.Context, tenantId, eventId string) (*OrderingKeyRelease, error)
+
+	// CompleteOrderedEvent completes a released event if all of its workflow runs have finished, so that the next
+	// event of the ordering key can be released. It returns the release of the next event if it was completed.
+	CompleteOrderedEvent(ctx context.Context, tenantId, eventId string) (*OrderingKeyRelease, error)
+
+	// HasWorkflowRuns returns whether the event has triggered any workflow runs which weren't deleted.
+	HasWorkflowRuns(ctx context.Context, tenantId, eventId string) (bool, error)
+
+	// ListStuckOrderingKeys returns up to limit ordering keys across all tenants whose released event was not
+	// triggered, or whose pending events were not released, within the ordered event lease.
+	ListStuckOrderingKeys(ctx context.Context, limit int) ([]*OrderingKeyRelease, error)
 }
@@ -39,21 +39,26 @@ func MakeMetered[T any](ctx context.Context, m *Metered, resource dbsqlc.LimitRe
 	var canCreate *bool
 	var percent int
 
-	if hit, ok := m.c.Get(key); ok {
+	// counted resources change without being metered, for example when queued runs start, so the result
+	// of their limit check can't be cached
+	cacheable := !repository.IsCountedResource(resource)
+
+	if hit, ok := m.c.Get(key); ok && cacheable {
 		c := hit.(bool)
 		canCreate = &c
 	}
 
 	if canCreate == nil {
-		c, percent, err := m.entitlements.TenantLimit().CanCreate(ctx, resource, tenantId, numberOfResources)
+		c, p, err := m.entitlements.TenantLimit().CanCreate(ctx, resource, tenantId, numberOfResources)
 
 		if err != nil {
 			return nil, fmt.Errorf("could not check tenant limit: %w", err)
 		}
 
 		canCreate = &c
+		percent = p
 
-		if percent <= 50 || percent >= 100 {
+		if cacheable && (percent <= 50 || percent >= 100) {
 			m.c.Set(key, c)
 		}
 
@@ -72,7 +77,7 @@ func MakeMetered[T any](ctx context.Context, m *Metered, resource dbsqlc.LimitRe
 	deferredMeter := func() {
 		limit, err := m.entitlements.TenantLimit().Meter(ctx, resource, tenantId, numberOfResources)
 
-		if limit != nil && (percent <= 50 || percent >= 100) {
+		if limit != nil && cacheable && (percent <= 50 || percent >= 100) {
 			m.c.Set(key, limit.Value < limit.LimitValue)
 		}
 
@@ -87,3 +92,12 @@ func MakeMetered[T any](ctx context.Context, m *Metered, resource dbsqlc.LimitRe
 
 	return res, nil
 }
+
+// MakeMeteredWorkflowRuns meters the creation of workflow runs, which count against both the workflow run
+// limit and the queued run limit of the tenant.
+func MakeMeteredWorkflowRuns[T any](ctx context.Context, m *Metered, tenantId string, numberOfResources int32, f func() (*string, *T, error)) (*T, error) {
+	return MakeMetered(ctx, m, dbsqlc.LimitResourceQUEUEDRUN, tenantId, numberOfResources, func() (*string, *T, error) {
+		res, err := MakeMetered(ctx, m, dbsqlc.LimitResourceWORKFLOWRUN, tenantId, numberOfResources, f)
+		return nil, res, err
+	})
+}
@@ -0,0 +1,113 @@
+package metered
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type fakeEntitlementsRepository struct {
+	limits *fakeTenantLimitRepository
+}
+
+func (r *fakeEntitlementsRepository) TenantLimit() repository.TenantLimitRepository {
+	return r.limits
+}
+
+// fakeTenantLimitRepository checks limits like the tenant limit repository: counted resources are counted
+// on every check, and metering them resets their stored value.
+type fakeTenantLimitRepository struct {
+	repository.TenantLimitRepository
+
+	limits map[dbsqlc.LimitResource]int32
+
+	// the metered value of each resource
+	values map[dbsqlc.LimitResource]int32
+
+	// the current number of queued runs
+	queued int32
+}
+
+func (r *fakeTenantLimitRepository) value(resource dbsqlc.LimitResource) int32 {
+	if resource == dbsqlc.LimitResourceQUEUEDRUN {
+		return r.queued
+	}
+
+	return r.values[resource]
+}
+
+func (r *fakeTenantLimitRepository) CanCreate(ctx context.Context, resource dbsqlc.LimitResource, tenantId string, numberOfResources int32) (bool, int, error) {
+	value := r.value(resource)
+
+	if value+numberOfResources-1 >= r.limits[resource] {
+		return false, 100, nil
+	}
+
+	return true, calcPercent(value+numberOfResources, r.limits[resource]), nil
+}
+
+func (r *fakeTenantLimitRepository) Meter(ctx context.Context, resource dbsqlc.LimitResource, tenantId string, numberOfResources int32) (*dbsqlc.TenantResourceLimit, error) {
+	if repository.IsCountedResource(resource) {
+		r.values[resource] = 0
+	} else {
+		r.values[resource] += numberOfResources
+	}
+
+	return &dbsqlc.TenantResourceLimit{
+		Resource:         resource,
+		Value:            r.values[resource],
+		LimitValue:       r.limits[resource],
+		CustomValueMeter: repository.IsCountedResource(resource),
+	}, nil
+}
+
+func calcPercent(value int32, limit int32) int {
+	return int((float64(value) / float64(limit)) * 100)
+}
+
+func TestMakeMeteredWorkflowRunsQueuedRunLimit(t *testing.T) {
+	l := zerolog.Nop()
+
+	limits := &fakeTenantLimitRepository{
+		limits: map[dbsqlc.LimitResource]int32{
+			dbsqlc.LimitResourceWORKFLOWRUN: 100,
+			dbsqlc.LimitResourceQUEUEDRUN:   3,
+		},
+		values: map[dbsqlc.LimitResource]int32{},
+	}
+
+	m := NewMetered(&fakeEntitlementsRepository{limits: limits}, &l)
+	defer m.Stop()
+
+	createRun := func() (*string, error) {
+		return MakeMeteredWorkflowRuns(context.Background(), m, "tenant-id", 1, func() (*string, *string, error) {
+			limits.queued++
+
+			id := "run"
+
+			return nil, &id, nil
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := createRun()
+		require.NoError(t, err, "run %d is within the queued run limit", i+1)
+	}
+
+	// the queued runs are counted for every run, rather than cached after the first one
+	_, err := createRun()
+	assert.ErrorIs(t, err, ErrResourceExhausted)
+	assert.Equal(t, int32(3), limits.queued)
+
+	// runs can be created again once queued runs have started
+	limits.queued = 1
+
+	_, err = createRun()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// MetricsEngineRepository reads the state of all tenants which the engine exports as metrics.
+type MetricsEngineRepository interface {
+	// ListQueueDepths returns the number of queued step runs per tenant and workflow.
+	ListQueueDepths(ctx context.Context) ([]*dbsqlc.ListQueueDepthsRow, error)
+
+	// ListWorkerSlots returns the total and used slots of all active workers.
+	ListWorkerSlots(ctx context.Context) ([]*dbsqlc.ListWorkerSlotsRow, error)
+}
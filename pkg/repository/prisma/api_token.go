@@ -16,16 +16,22 @@ import (
 )
 
 type apiTokenRepository struct {
-	client *db.PrismaClient
-	v      validator.Validator
-	cache  cache.Cacheable
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	cache   cache.Cacheable
+	queries *dbsqlc.Queries
 }
 
-func NewAPITokenRepository(client *db.PrismaClient, v validator.Validator, cache cache.Cacheable) repository.APITokenRepository {
+func NewAPITokenRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, cache cache.Cacheable) repository.APITokenRepository {
+	queries := dbsqlc.New()
+
 	return &apiTokenRepository{
-		client: client,
-		v:      v,
-		cache:  cache,
+		client:  client,
+		pool:    pool,
+		v:       v,
+		cache:   cache,
+		queries: queries,
 	}
 }
 
@@ -74,12 +80,15 @@ func (a *apiTokenRepository) RevokeAPIToken(id string) error {
 	return err
 }
 
-func (a *apiTokenRepository) ListAPITokensByTenant(tenantId string) ([]db.APITokenModel, error) {
-	return a.client.APIToken.FindMany(
-		db.APIToken.TenantID.Equals(tenantId),
-		db.APIToken.Revoked.Equals(false),
-		db.APIToken.Internal.Equals(false),
-	).Exec(context.Background())
+func (a *apiTokenRepository) ListAPITokensByTenant(ctx context.Context, tenantId string) ([]*dbsqlc.APIToken, error) {
+	return a.queries.ListAPITokensByTenant(ctx, a.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (a *apiTokenRepository) ExpireAPIToken(ctx context.Context, id string, expiresAt time.Time) error {
+	return a.queries.ExpireAPIToken(ctx, a.pool, dbsqlc.ExpireAPITokenParams{
+		ID:        sqlchelpers.UUIDFromStr(id),
+		Expiresat: sqlchelpers.TimestampFromTime(expiresAt.UTC()),
+	})
 }
 
 type engineTokenRepository struct {
@@ -111,6 +120,7 @@ func (a *engineTokenRepository) CreateAPIToken(ctx context.Context, opts *reposi
 		ID:        sqlchelpers.UUIDFromStr(opts.ID),
 		Expiresat: sqlchelpers.TimestampFromTime(opts.ExpiresAt),
 		Internal:  sqlchelpers.BoolFromBoolean(opts.Internal),
+		Scopes:    opts.Scopes,
 	}
 
 	if opts.TenantId != nil {
@@ -121,6 +131,10 @@ func (a *engineTokenRepository) CreateAPIToken(ctx context.Context, opts *reposi
 		createParams.Name = sqlchelpers.TextFromStr(*opts.Name)
 	}
 
+	if opts.Namespace != nil {
+		createParams.Namespace = sqlchelpers.TextFromStr(*opts.Namespace)
+	}
+
 	return a.queries.CreateAPIToken(ctx, a.pool, createParams)
 }
 
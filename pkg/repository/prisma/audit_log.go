@@ -0,0 +1,200 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// deleteExpiredAuditLogsBatchSize is the number of audit logs which are deleted at once.
+const deleteExpiredAuditLogsBatchSize = 1000
+
+type auditLogRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewAuditLogAPIRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.AuditLogAPIRepository {
+	return newAuditLogRepository(pool, v, l)
+}
+
+func NewAuditLogEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.AuditLogEngineRepository {
+	return newAuditLogRepository(pool, v, l)
+}
+
+func newAuditLogRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) *auditLogRepository {
+	queries := dbsqlc.New()
+
+	return &auditLogRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *auditLogRepository) CreateAuditLog(ctx context.Context, tenantId string, opts *repository.CreateAuditLogOpts) (*dbsqlc.AuditLog, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	createParams := dbsqlc.CreateAuditLogParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Actortype:  string(opts.Actor.Type),
+		ActorId:    sqlchelpers.UUIDFromStr(opts.Actor.Id),
+		Action:     opts.Action,
+		Payload:    opts.Payload,
+		PriorState: opts.PriorState,
+	}
+
+	if opts.ResourceType != nil {
+		createParams.ResourceType = sqlchelpers.TextFromStr(*opts.ResourceType)
+	}
+
+	if opts.ResourceId != nil {
+		createParams.ResourceId = sqlchelpers.UUIDFromStr(*opts.ResourceId)
+	}
+
+	if opts.IpAddress != nil {
+		createParams.IpAddress = sqlchelpers.TextFromStr(*opts.IpAddress)
+	}
+
+	auditLog, err := r.queries.CreateAuditLog(ctx, r.pool, createParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create audit log: %w", err)
+	}
+
+	return auditLog, nil
+}
+
+func (r *auditLogRepository) ListAuditLogs(ctx context.Context, tenantId string, opts *repository.ListAuditLogsOpts) (*repository.ListAuditLogsResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	queryParams := dbsqlc.ListAuditLogsParams{
+		Tenantid: pgTenantId,
+	}
+
+	countParams := dbsqlc.CountAuditLogsParams{
+		Tenantid: pgTenantId,
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	if opts.Action != nil {
+		queryParams.Action = sqlchelpers.TextFromStr(*opts.Action)
+		countParams.Action = sqlchelpers.TextFromStr(*opts.Action)
+	}
+
+	if opts.ActorId != nil {
+		queryParams.ActorId = sqlchelpers.UUIDFromStr(*opts.ActorId)
+		countParams.ActorId = sqlchelpers.UUIDFromStr(*opts.ActorId)
+	}
+
+	if opts.ResourceId != nil {
+		queryParams.ResourceId = sqlchelpers.UUIDFromStr(*opts.ResourceId)
+		countParams.ResourceId = sqlchelpers.UUIDFromStr(*opts.ResourceId)
+	}
+
+	if opts.Since != nil {
+		queryParams.Since = sqlchelpers.TimestampFromTime(opts.Since.UTC())
+		countParams.Since = sqlchelpers.TimestampFromTime(opts.Since.UTC())
+	}
+
+	if opts.Until != nil {
+		queryParams.Until = sqlchelpers.TimestampFromTime(opts.Until.UTC())
+		countParams.Until = sqlchelpers.TimestampFromTime(opts.Until.UTC())
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	auditLogs, err := r.queries.ListAuditLogs(ctx, tx, queryParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list audit logs: %w", err)
+	}
+
+	count, err := r.queries.CountAuditLogs(ctx, tx, countParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count audit logs: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return &repository.ListAuditLogsResult{
+		Rows:  auditLogs,
+		Count: int(count),
+	}, nil
+}
+
+func (r *auditLogRepository) GetAuditLogRetentionPeriod(ctx context.Context, tenantId string) (string, error) {
+	settings, err := r.queries.GetTenantAuditLogSettings(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.DefaultAuditLogRetentionPeriod, nil
+		}
+
+		return "", fmt.Errorf("could not get audit log settings: %w", err)
+	}
+
+	return settings.RetentionPeriod, nil
+}
+
+func (r *auditLogRepository) UpdateAuditLogSettings(ctx context.Context, tenantId string, opts *repository.UpdateAuditLogSettingsOpts) (*dbsqlc.TenantAuditLogSettings, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.queries.UpsertTenantAuditLogSettings(ctx, r.pool, dbsqlc.UpsertTenantAuditLogSettingsParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Retentionperiod: opts.RetentionPeriod,
+	})
+}
+
+func (r *auditLogRepository) DeleteExpiredAuditLogs(ctx context.Context, tenantId string, createdBefore time.Time) (bool, error) {
+	deleted, err := r.queries.DeleteExpiredAuditLogs(ctx, r.pool, dbsqlc.DeleteExpiredAuditLogsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Createdbefore: sqlchelpers.TimestampFromTime(createdBefore),
+		Limit:         deleteExpiredAuditLogsBatchSize,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete expired audit logs: %w", err)
+	}
+
+	return deleted == deleteExpiredAuditLogsBatchSize, nil
+}
@@ -78,9 +78,10 @@ func (s *sharedRepository) bulkUpdateStepRunStatuses(ctx context.Context, opts [
 			eventReasons = append(eventReasons, eventReason)
 			eventSeverities = append(eventSeverities, dbsqlc.StepRunEventSeverityCRITICAL)
 			eventMessages = append(eventMessages, eventMessage)
-			eventData = append(eventData, map[string]interface{}{
-				"retry_count": item.RetryCount,
-			})
+			data := item.ErrDetails.EventData()
+			data["retry_count"] = item.RetryCount
+
+			eventData = append(eventData, data)
 		case dbsqlc.StepRunStatusCANCELLED:
 			eventTimeSeen = append(eventTimeSeen, *item.CancelledAt)
 			eventStepRunIds = append(eventStepRunIds, stepRunId)
@@ -0,0 +1,48 @@
+-- name: CreateTenantAlertMuteRule :one
+INSERT INTO "TenantAlertMuteRule" (
+    "id",
+    "tenantId",
+    "workflowId",
+    "mutedUntil",
+    "reason"
+) VALUES (
+    gen_random_uuid(),
+    @tenantId::uuid,
+    @workflowId::uuid,
+    sqlc.narg('mutedUntil')::timestamp,
+    sqlc.narg('reason')::text
+) RETURNING *;
+
+-- name: GetTenantAlertMuteRuleById :one
+SELECT
+    *
+FROM
+    "TenantAlertMuteRule"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListTenantAlertMuteRules :many
+SELECT
+    *
+FROM
+    "TenantAlertMuteRule"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC;
+
+-- name: ListMutedWorkflowIds :many
+-- Returns the workflows of the tenant with a mute rule which hasn't expired
+SELECT
+    "workflowId"
+FROM
+    "TenantAlertMuteRule"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND ("mutedUntil" IS NULL OR "mutedUntil" > NOW());
+
+-- name: DeleteTenantAlertMuteRule :exec
+DELETE FROM
+    "TenantAlertMuteRule"
+WHERE
+    "id" = @id::uuid;
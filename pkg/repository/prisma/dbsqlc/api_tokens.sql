@@ -14,7 +14,9 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scopes",
+    "namespace"
 ) VALUES (
     coalesce(@id::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -22,5 +24,28 @@ INSERT INTO "APIToken" (
     sqlc.narg('tenantId')::uuid,
     sqlc.narg('name')::text,
     @expiresAt::timestamp,
-    COALESCE(sqlc.narg('internal')::boolean, FALSE)
+    COALESCE(sqlc.narg('internal')::boolean, FALSE),
+    COALESCE(sqlc.narg('scopes')::text[], '{}'),
+    sqlc.narg('namespace')::text
 ) RETURNING *;
+
+-- name: ListAPITokensByTenant :many
+SELECT
+    *
+FROM
+    "APIToken"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "revoked" = false
+    AND "internal" = false
+ORDER BY
+    "createdAt" ASC;
+
+-- name: ExpireAPIToken :exec
+UPDATE
+    "APIToken"
+SET
+    "expiresAt" = LEAST("expiresAt", @expiresAt::timestamp),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid;
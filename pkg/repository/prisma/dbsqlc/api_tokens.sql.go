@@ -19,7 +19,9 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scopes",
+    "namespace"
 ) VALUES (
     coalesce($1::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -27,8 +29,10 @@ INSERT INTO "APIToken" (
     $2::uuid,
     $3::text,
     $4::timestamp,
-    COALESCE($5::boolean, FALSE)
-) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    COALESCE($5::boolean, FALSE),
+    COALESCE($6::text[], '{}'),
+    $7::text
+) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes, namespace
 `
 
 type CreateAPITokenParams struct {
@@ -37,6 +41,8 @@ type CreateAPITokenParams struct {
 	Name      pgtype.Text      `json:"name"`
 	Expiresat pgtype.Timestamp `json:"expiresat"`
 	Internal  pgtype.Bool      `json:"internal"`
+	Scopes    []string         `json:"scopes"`
+	Namespace pgtype.Text      `json:"namespace"`
 }
 
 func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPITokenParams) (*APIToken, error) {
@@ -46,6 +52,8 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		arg.Name,
 		arg.Expiresat,
 		arg.Internal,
+		arg.Scopes,
+		arg.Namespace,
 	)
 	var i APIToken
 	err := row.Scan(
@@ -58,13 +66,35 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scopes,
+		&i.Namespace,
 	)
 	return &i, err
 }
 
+const expireAPIToken = `-- name: ExpireAPIToken :exec
+UPDATE
+    "APIToken"
+SET
+    "expiresAt" = LEAST("expiresAt", $1::timestamp),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $2::uuid
+`
+
+type ExpireAPITokenParams struct {
+	Expiresat pgtype.Timestamp `json:"expiresat"`
+	ID        pgtype.UUID      `json:"id"`
+}
+
+func (q *Queries) ExpireAPIToken(ctx context.Context, db DBTX, arg ExpireAPITokenParams) error {
+	_, err := db.Exec(ctx, expireAPIToken, arg.Expiresat, arg.ID)
+	return err
+}
+
 const getAPITokenById = `-- name: GetAPITokenById :one
 SELECT
-    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes, namespace
 FROM
     "APIToken"
 WHERE
@@ -84,6 +114,53 @@ func (q *Queries) GetAPITokenById(ctx context.Context, db DBTX, id pgtype.UUID)
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scopes,
+		&i.Namespace,
 	)
 	return &i, err
 }
+
+const listAPITokensByTenant = `-- name: ListAPITokensByTenant :many
+SELECT
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes, namespace
+FROM
+    "APIToken"
+WHERE
+    "tenantId" = $1::uuid
+    AND "revoked" = false
+    AND "internal" = false
+ORDER BY
+    "createdAt" ASC
+`
+
+func (q *Queries) ListAPITokensByTenant(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*APIToken, error) {
+	rows, err := db.Query(ctx, listAPITokensByTenant, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*APIToken
+	for rows.Next() {
+		var i APIToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+			&i.Revoked,
+			&i.Name,
+			&i.TenantId,
+			&i.NextAlertAt,
+			&i.Internal,
+			&i.Scopes,
+			&i.Namespace,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
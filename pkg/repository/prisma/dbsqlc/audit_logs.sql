@@ -0,0 +1,92 @@
+-- name: CreateAuditLog :one
+INSERT INTO "AuditLog" (
+    "id",
+    "createdAt",
+    "tenantId",
+    "actorType",
+    "actorId",
+    "action",
+    "resourceType",
+    "resourceId",
+    "ipAddress",
+    "payload",
+    "priorState"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @actorType::text,
+    sqlc.narg('actorId')::uuid,
+    @action::text,
+    sqlc.narg('resourceType')::text,
+    sqlc.narg('resourceId')::uuid,
+    sqlc.narg('ipAddress')::text,
+    sqlc.narg('payload')::jsonb,
+    sqlc.narg('priorState')::jsonb
+) RETURNING *;
+
+-- name: ListAuditLogs :many
+SELECT * FROM "AuditLog"
+WHERE
+  "tenantId" = @tenantId::uuid AND
+  (sqlc.narg('action')::text IS NULL OR "action" = sqlc.narg('action')::text) AND
+  (sqlc.narg('actorId')::uuid IS NULL OR "actorId" = sqlc.narg('actorId')::uuid) AND
+  (sqlc.narg('resourceId')::uuid IS NULL OR "resourceId" = sqlc.narg('resourceId')::uuid) AND
+  (sqlc.narg('since')::timestamp IS NULL OR "createdAt" >= sqlc.narg('since')::timestamp) AND
+  (sqlc.narg('until')::timestamp IS NULL OR "createdAt" < sqlc.narg('until')::timestamp)
+ORDER BY
+  "createdAt" DESC,
+  -- add order by id to make sure the order is deterministic
+  "id" DESC
+OFFSET COALESCE(sqlc.narg('offset'), 0)
+LIMIT COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountAuditLogs :one
+SELECT COUNT(*) AS total
+FROM "AuditLog"
+WHERE
+  "tenantId" = @tenantId::uuid AND
+  (sqlc.narg('action')::text IS NULL OR "action" = sqlc.narg('action')::text) AND
+  (sqlc.narg('actorId')::uuid IS NULL OR "actorId" = sqlc.narg('actorId')::uuid) AND
+  (sqlc.narg('resourceId')::uuid IS NULL OR "resourceId" = sqlc.narg('resourceId')::uuid) AND
+  (sqlc.narg('since')::timestamp IS NULL OR "createdAt" >= sqlc.narg('since')::timestamp) AND
+  (sqlc.narg('until')::timestamp IS NULL OR "createdAt" < sqlc.narg('until')::timestamp);
+
+-- name: DeleteExpiredAuditLogs :one
+WITH deleted AS (
+    DELETE FROM "AuditLog"
+    WHERE "id" IN (
+        SELECT "id"
+        FROM "AuditLog"
+        WHERE
+            "tenantId" = @tenantId::uuid AND
+            "createdAt" < @createdBefore::timestamp
+        ORDER BY "createdAt" ASC
+        LIMIT @limit::integer
+    )
+    RETURNING "id"
+)
+SELECT COUNT(*) AS deleted FROM deleted;
+
+-- name: GetTenantAuditLogSettings :one
+SELECT
+    *
+FROM
+    "TenantAuditLogSettings"
+WHERE
+    "tenantId" = @tenantId::uuid;
+
+-- name: UpsertTenantAuditLogSettings :one
+INSERT INTO "TenantAuditLogSettings" (
+    "tenantId",
+    "updatedAt",
+    "retentionPeriod"
+) VALUES (
+    @tenantId::uuid,
+    CURRENT_TIMESTAMP,
+    @retentionPeriod::text
+) ON CONFLICT ("tenantId") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "retentionPeriod" = EXCLUDED."retentionPeriod"
+RETURNING *;
@@ -229,6 +229,8 @@ func (r iteratorForCreateWorkflowRunTriggeredBys) Values() ([]interface{}, error
 		r.rows[0].CronSchedule,
 		r.rows[0].CronName,
 		r.rows[0].ScheduledId,
+		r.rows[0].ActorType,
+		r.rows[0].ActorId,
 	}, nil
 }
 
@@ -237,7 +239,7 @@ func (r iteratorForCreateWorkflowRunTriggeredBys) Err() error {
 }
 
 func (q *Queries) CreateWorkflowRunTriggeredBys(ctx context.Context, db DBTX, arg []CreateWorkflowRunTriggeredBysParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"WorkflowRunTriggeredBy"}, []string{"id", "tenantId", "parentId", "eventId", "cronParentId", "cronSchedule", "cronName", "scheduledId"}, &iteratorForCreateWorkflowRunTriggeredBys{rows: arg})
+	return db.CopyFrom(ctx, []string{"WorkflowRunTriggeredBy"}, []string{"id", "tenantId", "parentId", "eventId", "cronParentId", "cronSchedule", "cronName", "scheduledId", "actorType", "actorId"}, &iteratorForCreateWorkflowRunTriggeredBys{rows: arg})
 }
 
 // iteratorForCreateWorkflowRuns implements pgx.CopyFromSource.
@@ -272,6 +274,8 @@ func (r iteratorForCreateWorkflowRuns) Values() ([]interface{}, error) {
 		r.rows[0].AdditionalMetadata,
 		r.rows[0].Priority,
 		r.rows[0].InsertOrder,
+		r.rows[0].Detached,
+		r.rows[0].TimeoutAt,
 	}, nil
 }
 
@@ -280,5 +284,5 @@ func (r iteratorForCreateWorkflowRuns) Err() error {
 }
 
 func (q *Queries) CreateWorkflowRuns(ctx context.Context, db DBTX, arg []CreateWorkflowRunsParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"WorkflowRun"}, []string{"id", "displayName", "tenantId", "workflowVersionId", "status", "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", "priority", "insertOrder"}, &iteratorForCreateWorkflowRuns{rows: arg})
+	return db.CopyFrom(ctx, []string{"WorkflowRun"}, []string{"id", "displayName", "tenantId", "workflowVersionId", "status", "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", "priority", "insertOrder", "detached", "timeoutAt"}, &iteratorForCreateWorkflowRuns{rows: arg})
 }
@@ -7,6 +7,18 @@ WHERE
     "deletedAt" IS NULL AND
     "id" = @id::uuid;
 
+-- name: GetEventByExternalId :one
+SELECT
+    e.*
+FROM
+    "EventExternalId" x
+JOIN
+    "Event" e ON e."id" = x."eventId"
+WHERE
+    x."tenantId" = @tenantId::uuid AND
+    x."externalId" = @externalId::text AND
+    e."deletedAt" IS NULL;
+
 -- name: CountEvents :one
 WITH events AS (
     SELECT
@@ -101,6 +113,42 @@ INSERT INTO "Event" (
     @additionalMetadata::jsonb
 ) RETURNING *;
 
+-- name: CreateEventExternalId :execrows
+INSERT INTO "EventExternalId" AS existing (
+    "tenantId",
+    "externalId",
+    "eventId",
+    "expiresAt"
+) VALUES (
+    @tenantId::uuid,
+    @externalId::text,
+    @eventId::uuid,
+    sqlc.narg('expiresAt')::timestamp
+) ON CONFLICT ("tenantId", "externalId") DO UPDATE
+SET
+    "eventId" = EXCLUDED."eventId",
+    "createdAt" = CURRENT_TIMESTAMP,
+    "expiresAt" = EXCLUDED."expiresAt"
+-- an external id can only be claimed again once its window has passed
+WHERE existing."expiresAt" IS NOT NULL AND existing."expiresAt" <= CURRENT_TIMESTAMP;
+
+-- name: DeleteExpiredEventExternalIds :one
+WITH deleted AS (
+    DELETE FROM "EventExternalId"
+    WHERE ("tenantId", "externalId") IN (
+        SELECT "tenantId", "externalId"
+        FROM "EventExternalId"
+        WHERE
+            "tenantId" = @tenantId::uuid AND
+            "expiresAt" IS NOT NULL AND
+            "expiresAt" <= CURRENT_TIMESTAMP
+        ORDER BY "expiresAt" ASC
+        LIMIT @limit::integer
+    )
+    RETURNING "externalId"
+)
+SELECT COUNT(*) AS deleted FROM deleted;
+
 -- name: CreateEvents :copyfrom
 INSERT INTO "Event" (
     "id",
@@ -238,6 +286,48 @@ WHERE
     "tenantId" = @tenantId::uuid AND
     "id" = ANY (sqlc.arg('ids')::uuid[]);
 
+-- name: ListEventWorkflowRuns :many
+SELECT
+    runs."id" AS "workflowRunId",
+    runs."displayName",
+    runs."status",
+    runs."createdAt",
+    runs."startedAt",
+    runs."finishedAt",
+    workflowVersion."id" AS "workflowVersionId",
+    workflow."id" AS "workflowId",
+    workflow."name" AS "workflowName"
+FROM
+    "WorkflowRunTriggeredBy" as runTriggers
+JOIN
+    "WorkflowRun" as runs ON runTriggers."parentId" = runs."id"
+JOIN
+    "WorkflowVersion" as workflowVersion ON workflowVersion."id" = runs."workflowVersionId"
+JOIN
+    "Workflow" as workflow ON workflowVersion."workflowId" = workflow."id"
+WHERE
+    runTriggers."eventId" = @eventId::uuid AND
+    runTriggers."tenantId" = @tenantId::uuid AND
+    runs."deletedAt" IS NULL
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountEventWorkflowRuns :one
+SELECT
+    count(*) AS total
+FROM
+    "WorkflowRunTriggeredBy" as runTriggers
+JOIN
+    "WorkflowRun" as runs ON runTriggers."parentId" = runs."id"
+WHERE
+    runTriggers."eventId" = @eventId::uuid AND
+    runTriggers."tenantId" = @tenantId::uuid AND
+    runs."deletedAt" IS NULL;
+
 -- name: SoftDeleteExpiredEvents :one
 WITH for_delete AS (
     SELECT
@@ -262,6 +352,10 @@ WITH for_delete AS (
             ELSE FALSE
         END as has_more
     FROM for_delete
+), released_external_ids AS (
+    -- release the external ids of expired events, so that they can be accepted again
+    DELETE FROM "EventExternalId"
+    WHERE "eventId" IN (SELECT "id" FROM expired_with_limit)
 )
 UPDATE
     "Event"
@@ -306,3 +400,221 @@ WHERE
     "id" IN (SELECT "id" FROM expired_with_limit)
 RETURNING
     (SELECT has_more FROM has_more) as has_more;
+
+-- name: PurgeDeletedEvents :one
+WITH for_purge AS (
+    SELECT
+        "id"
+    FROM "Event" e
+    WHERE
+        e."tenantId" = @tenantId::uuid AND
+        e."deletedAt" IS NOT NULL AND
+        e."deletedAt" < @deletedBefore::timestamp
+    ORDER BY e."deletedAt" ASC
+    LIMIT sqlc.arg('limit') + 1
+    FOR UPDATE SKIP LOCKED
+), purged_with_limit AS (
+    SELECT
+        for_purge."id" as "id"
+    FROM for_purge
+    LIMIT sqlc.arg('limit')
+), has_more AS (
+    SELECT
+        CASE
+            WHEN COUNT(*) > sqlc.arg('limit') THEN TRUE
+            ELSE FALSE
+        END as has_more
+    FROM for_purge
+),
+-- the tables which reference events don't have foreign keys, because events are partitioned, so their rows
+-- are deleted explicitly
+purged_ordered_events AS (
+    DELETE FROM "OrderedEvent"
+    WHERE "eventId" IN (SELECT "id" FROM purged_with_limit)
+), purged_external_ids AS (
+    DELETE FROM "EventExternalId"
+    WHERE "eventId" IN (SELECT "id" FROM purged_with_limit)
+)
+-- replays keep the id of the event they replayed, which is never read
+DELETE FROM
+    "Event"
+WHERE
+    "id" IN (SELECT "id" FROM purged_with_limit)
+RETURNING
+    (SELECT has_more FROM has_more) as has_more;
+
+-- name: CreateOrderedEvents :exec
+WITH input AS (
+    SELECT
+        unnest(@eventIds::uuid[]) AS "eventId",
+        unnest(@orderingKeys::text[]) AS "orderingKey",
+        unnest(@sequences::bigint[]) AS "sequence"
+)
+INSERT INTO "OrderedEvent" (
+    "eventId",
+    "tenantId",
+    "orderingKey",
+    "sequence"
+)
+SELECT
+    input."eventId",
+    @tenantId::uuid,
+    input."orderingKey",
+    -- sequences start at 1, so 0 means the event has no sequence
+    NULLIF(input."sequence", 0)
+FROM
+    input;
+
+-- name: UpsertEventOrderingKey :one
+INSERT INTO "EventOrderingKey" (
+    "tenantId",
+    "key"
+) VALUES (
+    @tenantId::uuid,
+    @key::text
+)
+ON CONFLICT ("tenantId", "key") DO UPDATE
+SET
+    -- a no-op update, which locks the row for the rest of the transaction
+    "key" = EXCLUDED."key"
+RETURNING *;
+
+-- name: GetNextOrderedEvent :one
+SELECT
+    oe.*,
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            "OrderedEvent" pending
+        WHERE
+            pending."tenantId" = @tenantId::uuid AND
+            pending."orderingKey" = @orderingKey::text AND
+            pending."releasedAt" IS NULL
+    ) AS "pendingCount"
+FROM
+    "OrderedEvent" oe
+WHERE
+    oe."tenantId" = @tenantId::uuid AND
+    oe."orderingKey" = @orderingKey::text AND
+    oe."releasedAt" IS NULL
+ORDER BY
+    oe."sequence" ASC NULLS LAST,
+    oe."createdAt" ASC,
+    oe."eventId" ASC
+LIMIT 1;
+
+-- name: ReleaseOrderedEvent :exec
+UPDATE
+    "OrderedEvent"
+SET
+    "releasedAt" = CURRENT_TIMESTAMP
+WHERE
+    "eventId" = @eventId::uuid;
+
+-- name: SetEventOrderingKeyActive :exec
+UPDATE
+    "EventOrderingKey"
+SET
+    "activeEventId" = sqlc.narg('activeEventId')::uuid,
+    "activeTriggered" = false,
+    "lastSequence" = GREATEST("lastSequence", COALESCE(sqlc.narg('sequence')::bigint, "lastSequence")),
+    -- invalidates release tasks which were enqueued before this release
+    "releaseSequence" = "releaseSequence" + 1,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "key" = @key::text;
+
+-- name: SetOrderedEventTriggered :exec
+UPDATE
+    "EventOrderingKey"
+SET
+    "activeTriggered" = true,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "activeEventId" = @eventId::uuid;
+
+-- name: CompleteOrderedEvent :one
+UPDATE
+    "EventOrderingKey" k
+SET
+    "activeEventId" = NULL,
+    "activeTriggered" = false,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    k."tenantId" = @tenantId::uuid AND
+    k."activeEventId" = @eventId::uuid AND
+    k."activeTriggered" = true AND
+    NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "WorkflowRunTriggeredBy" tb
+        JOIN
+            "WorkflowRun" wr ON wr."id" = tb."parentId"
+        WHERE
+            tb."eventId" = @eventId::uuid AND
+            wr."deletedAt" IS NULL AND
+            wr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    )
+RETURNING k."key", k."releaseSequence";
+
+-- name: ListWorkflowRunIdsForEvent :many
+SELECT
+    wr."id"
+FROM
+    "WorkflowRunTriggeredBy" tb
+JOIN
+    "WorkflowRun" wr ON wr."id" = tb."parentId"
+WHERE
+    tb."eventId" = @eventId::uuid AND
+    wr."tenantId" = @tenantId::uuid AND
+    wr."deletedAt" IS NULL
+ORDER BY
+    wr."createdAt" ASC;
+
+-- name: HasWorkflowRunsForEvent :one
+SELECT EXISTS (
+    SELECT
+        1
+    FROM
+        "WorkflowRunTriggeredBy" tb
+    JOIN
+        "WorkflowRun" wr ON wr."id" = tb."parentId"
+    WHERE
+        tb."eventId" = @eventId::uuid AND
+        wr."tenantId" = @tenantId::uuid AND
+        wr."deletedAt" IS NULL
+) AS "exists";
+
+-- name: ListStuckEventOrderingKeys :many
+SELECT
+    k."tenantId",
+    k."key",
+    k."releaseSequence"
+FROM
+    "EventOrderingKey" k
+WHERE
+    k."updatedAt" < @updatedBefore::timestamp AND
+    (
+        -- the released event was never marked as triggered
+        (k."activeEventId" IS NOT NULL AND k."activeTriggered" = false) OR
+        -- no event is in progress, but events of the key have been waiting to be released
+        (k."activeEventId" IS NULL AND EXISTS (
+            SELECT
+                1
+            FROM
+                "OrderedEvent" oe
+            WHERE
+                oe."tenantId" = k."tenantId" AND
+                oe."orderingKey" = k."key" AND
+                oe."releasedAt" IS NULL AND
+                oe."createdAt" < @updatedBefore::timestamp
+        ))
+    )
+ORDER BY
+    k."updatedAt" ASC
+LIMIT
+    @limit::integer;
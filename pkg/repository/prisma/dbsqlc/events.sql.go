@@ -58,6 +58,74 @@ func (q *Queries) ClearEventPayloadData(ctx context.Context, db DBTX, arg ClearE
 	return has_more, err
 }
 
+const completeOrderedEvent = `-- name: CompleteOrderedEvent :one
+UPDATE
+    "EventOrderingKey" k
+SET
+    "activeEventId" = NULL,
+    "activeTriggered" = false,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    k."tenantId" = $1::uuid AND
+    k."activeEventId" = $2::uuid AND
+    k."activeTriggered" = true AND
+    NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "WorkflowRunTriggeredBy" tb
+        JOIN
+            "WorkflowRun" wr ON wr."id" = tb."parentId"
+        WHERE
+            tb."eventId" = $2::uuid AND
+            wr."deletedAt" IS NULL AND
+            wr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    )
+RETURNING k."key", k."releaseSequence"
+`
+
+type CompleteOrderedEventParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Eventid  pgtype.UUID `json:"eventid"`
+}
+
+type CompleteOrderedEventRow struct {
+	Key             string `json:"key"`
+	ReleaseSequence int64  `json:"releaseSequence"`
+}
+
+func (q *Queries) CompleteOrderedEvent(ctx context.Context, db DBTX, arg CompleteOrderedEventParams) (*CompleteOrderedEventRow, error) {
+	row := db.QueryRow(ctx, completeOrderedEvent, arg.Tenantid, arg.Eventid)
+	var i CompleteOrderedEventRow
+	err := row.Scan(&i.Key, &i.ReleaseSequence)
+	return &i, err
+}
+
+const countEventWorkflowRuns = `-- name: CountEventWorkflowRuns :one
+SELECT
+    count(*) AS total
+FROM
+    "WorkflowRunTriggeredBy" as runTriggers
+JOIN
+    "WorkflowRun" as runs ON runTriggers."parentId" = runs."id"
+WHERE
+    runTriggers."eventId" = $1::uuid AND
+    runTriggers."tenantId" = $2::uuid AND
+    runs."deletedAt" IS NULL
+`
+
+type CountEventWorkflowRunsParams struct {
+	Eventid  pgtype.UUID `json:"eventid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) CountEventWorkflowRuns(ctx context.Context, db DBTX, arg CountEventWorkflowRunsParams) (int64, error) {
+	row := db.QueryRow(ctx, countEventWorkflowRuns, arg.Eventid, arg.Tenantid)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const countEvents = `-- name: CountEvents :one
 WITH events AS (
     SELECT
@@ -202,6 +270,46 @@ func (q *Queries) CreateEvent(ctx context.Context, db DBTX, arg CreateEventParam
 	return &i, err
 }
 
+const createEventExternalId = `-- name: CreateEventExternalId :execrows
+INSERT INTO "EventExternalId" AS existing (
+    "tenantId",
+    "externalId",
+    "eventId",
+    "expiresAt"
+) VALUES (
+    $1::uuid,
+    $2::text,
+    $3::uuid,
+    $4::timestamp
+) ON CONFLICT ("tenantId", "externalId") DO UPDATE
+SET
+    "eventId" = EXCLUDED."eventId",
+    "createdAt" = CURRENT_TIMESTAMP,
+    "expiresAt" = EXCLUDED."expiresAt"
+-- an external id can only be claimed again once its window has passed
+WHERE existing."expiresAt" IS NOT NULL AND existing."expiresAt" <= CURRENT_TIMESTAMP
+`
+
+type CreateEventExternalIdParams struct {
+	Tenantid   pgtype.UUID      `json:"tenantid"`
+	Externalid string           `json:"externalid"`
+	Eventid    pgtype.UUID      `json:"eventid"`
+	ExpiresAt  pgtype.Timestamp `json:"expiresAt"`
+}
+
+func (q *Queries) CreateEventExternalId(ctx context.Context, db DBTX, arg CreateEventExternalIdParams) (int64, error) {
+	result, err := db.Exec(ctx, createEventExternalId,
+		arg.Tenantid,
+		arg.Externalid,
+		arg.Eventid,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createEventKeys = `-- name: CreateEventKeys :exec
 INSERT INTO "EventKey" (
     "key",
@@ -233,6 +341,112 @@ type CreateEventsParams struct {
 	InsertOrder        pgtype.Int4 `json:"insertOrder"`
 }
 
+const createOrderedEvents = `-- name: CreateOrderedEvents :exec
+WITH input AS (
+    SELECT
+        unnest($1::uuid[]) AS "eventId",
+        unnest($2::text[]) AS "orderingKey",
+        unnest($3::bigint[]) AS "sequence"
+)
+INSERT INTO "OrderedEvent" (
+    "eventId",
+    "tenantId",
+    "orderingKey",
+    "sequence"
+)
+SELECT
+    input."eventId",
+    $4::uuid,
+    input."orderingKey",
+    -- sequences start at 1, so 0 means the event has no sequence
+    NULLIF(input."sequence", 0)
+FROM
+    input
+`
+
+type CreateOrderedEventsParams struct {
+	Eventids     []pgtype.UUID `json:"eventids"`
+	Orderingkeys []string      `json:"orderingkeys"`
+	Sequences    []int64       `json:"sequences"`
+	Tenantid     pgtype.UUID   `json:"tenantid"`
+}
+
+func (q *Queries) CreateOrderedEvents(ctx context.Context, db DBTX, arg CreateOrderedEventsParams) error {
+	_, err := db.Exec(ctx, createOrderedEvents,
+		arg.Eventids,
+		arg.Orderingkeys,
+		arg.Sequences,
+		arg.Tenantid,
+	)
+	return err
+}
+
+const deleteExpiredEventExternalIds = `-- name: DeleteExpiredEventExternalIds :one
+WITH deleted AS (
+    DELETE FROM "EventExternalId"
+    WHERE ("tenantId", "externalId") IN (
+        SELECT "tenantId", "externalId"
+        FROM "EventExternalId"
+        WHERE
+            "tenantId" = $1::uuid AND
+            "expiresAt" IS NOT NULL AND
+            "expiresAt" <= CURRENT_TIMESTAMP
+        ORDER BY "expiresAt" ASC
+        LIMIT $2::integer
+    )
+    RETURNING "externalId"
+)
+SELECT COUNT(*) AS deleted FROM deleted
+`
+
+type DeleteExpiredEventExternalIdsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    int32       `json:"limit"`
+}
+
+func (q *Queries) DeleteExpiredEventExternalIds(ctx context.Context, db DBTX, arg DeleteExpiredEventExternalIdsParams) (int64, error) {
+	row := db.QueryRow(ctx, deleteExpiredEventExternalIds, arg.Tenantid, arg.Limit)
+	var deleted int64
+	err := row.Scan(&deleted)
+	return deleted, err
+}
+
+const getEventByExternalId = `-- name: GetEventByExternalId :one
+SELECT
+    e.id, e."createdAt", e."updatedAt", e."deletedAt", e.key, e."tenantId", e."replayedFromId", e.data, e."additionalMetadata", e."insertOrder"
+FROM
+    "EventExternalId" x
+JOIN
+    "Event" e ON e."id" = x."eventId"
+WHERE
+    x."tenantId" = $1::uuid AND
+    x."externalId" = $2::text AND
+    e."deletedAt" IS NULL
+`
+
+type GetEventByExternalIdParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Externalid string      `json:"externalid"`
+}
+
+func (q *Queries) GetEventByExternalId(ctx context.Context, db DBTX, arg GetEventByExternalIdParams) (*Event, error) {
+	row := db.QueryRow(ctx, getEventByExternalId, arg.Tenantid, arg.Externalid)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Key,
+		&i.TenantId,
+		&i.ReplayedFromId,
+		&i.Data,
+		&i.AdditionalMetadata,
+		&i.InsertOrder,
+	)
+	return &i, err
+}
+
 const getEventForEngine = `-- name: GetEventForEngine :one
 SELECT
     id, "createdAt", "updatedAt", "deletedAt", key, "tenantId", "replayedFromId", data, "additionalMetadata", "insertOrder"
@@ -338,6 +552,89 @@ func (q *Queries) GetInsertedEvents(ctx context.Context, db DBTX, ids []pgtype.U
 	return items, nil
 }
 
+const getNextOrderedEvent = `-- name: GetNextOrderedEvent :one
+SELECT
+    oe."eventId", oe."createdAt", oe."tenantId", oe."orderingKey", oe."sequence", oe."releasedAt",
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            "OrderedEvent" pending
+        WHERE
+            pending."tenantId" = $1::uuid AND
+            pending."orderingKey" = $2::text AND
+            pending."releasedAt" IS NULL
+    ) AS "pendingCount"
+FROM
+    "OrderedEvent" oe
+WHERE
+    oe."tenantId" = $1::uuid AND
+    oe."orderingKey" = $2::text AND
+    oe."releasedAt" IS NULL
+ORDER BY
+    oe."sequence" ASC NULLS LAST,
+    oe."createdAt" ASC,
+    oe."eventId" ASC
+LIMIT 1
+`
+
+type GetNextOrderedEventParams struct {
+	Tenantid    pgtype.UUID `json:"tenantid"`
+	Orderingkey string      `json:"orderingkey"`
+}
+
+type GetNextOrderedEventRow struct {
+	EventId      pgtype.UUID      `json:"eventId"`
+	CreatedAt    pgtype.Timestamp `json:"createdAt"`
+	TenantId     pgtype.UUID      `json:"tenantId"`
+	OrderingKey  string           `json:"orderingKey"`
+	Sequence     pgtype.Int8      `json:"sequence"`
+	ReleasedAt   pgtype.Timestamp `json:"releasedAt"`
+	PendingCount int64            `json:"pendingCount"`
+}
+
+func (q *Queries) GetNextOrderedEvent(ctx context.Context, db DBTX, arg GetNextOrderedEventParams) (*GetNextOrderedEventRow, error) {
+	row := db.QueryRow(ctx, getNextOrderedEvent, arg.Tenantid, arg.Orderingkey)
+	var i GetNextOrderedEventRow
+	err := row.Scan(
+		&i.EventId,
+		&i.CreatedAt,
+		&i.TenantId,
+		&i.OrderingKey,
+		&i.Sequence,
+		&i.ReleasedAt,
+		&i.PendingCount,
+	)
+	return &i, err
+}
+
+const hasWorkflowRunsForEvent = `-- name: HasWorkflowRunsForEvent :one
+SELECT EXISTS (
+    SELECT
+        1
+    FROM
+        "WorkflowRunTriggeredBy" tb
+    JOIN
+        "WorkflowRun" wr ON wr."id" = tb."parentId"
+    WHERE
+        tb."eventId" = $1::uuid AND
+        wr."tenantId" = $2::uuid AND
+        wr."deletedAt" IS NULL
+) AS "exists"
+`
+
+type HasWorkflowRunsForEventParams struct {
+	Eventid  pgtype.UUID `json:"eventid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) HasWorkflowRunsForEvent(ctx context.Context, db DBTX, arg HasWorkflowRunsForEventParams) (bool, error) {
+	row := db.QueryRow(ctx, hasWorkflowRunsForEvent, arg.Eventid, arg.Tenantid)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const listEventKeys = `-- name: ListEventKeys :many
 SELECT
     "key"
@@ -368,6 +665,91 @@ func (q *Queries) ListEventKeys(ctx context.Context, db DBTX, tenantid pgtype.UU
 	return items, nil
 }
 
+const listEventWorkflowRuns = `-- name: ListEventWorkflowRuns :many
+SELECT
+    runs."id" AS "workflowRunId",
+    runs."displayName",
+    runs."status",
+    runs."createdAt",
+    runs."startedAt",
+    runs."finishedAt",
+    workflowVersion."id" AS "workflowVersionId",
+    workflow."id" AS "workflowId",
+    workflow."name" AS "workflowName"
+FROM
+    "WorkflowRunTriggeredBy" as runTriggers
+JOIN
+    "WorkflowRun" as runs ON runTriggers."parentId" = runs."id"
+JOIN
+    "WorkflowVersion" as workflowVersion ON workflowVersion."id" = runs."workflowVersionId"
+JOIN
+    "Workflow" as workflow ON workflowVersion."workflowId" = workflow."id"
+WHERE
+    runTriggers."eventId" = $1::uuid AND
+    runTriggers."tenantId" = $2::uuid AND
+    runs."deletedAt" IS NULL
+ORDER BY
+    runs."createdAt" ASC, runs."id" ASC
+OFFSET
+    COALESCE($3, 0)
+LIMIT
+    COALESCE($4, 50)
+`
+
+type ListEventWorkflowRunsParams struct {
+	Eventid  pgtype.UUID `json:"eventid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Offset   interface{} `json:"offset"`
+	Limit    interface{} `json:"limit"`
+}
+
+type ListEventWorkflowRunsRow struct {
+	WorkflowRunId     pgtype.UUID       `json:"workflowRunId"`
+	DisplayName       pgtype.Text       `json:"displayName"`
+	Status            WorkflowRunStatus `json:"status"`
+	CreatedAt         pgtype.Timestamp  `json:"createdAt"`
+	StartedAt         pgtype.Timestamp  `json:"startedAt"`
+	FinishedAt        pgtype.Timestamp  `json:"finishedAt"`
+	WorkflowVersionId pgtype.UUID       `json:"workflowVersionId"`
+	WorkflowId        pgtype.UUID       `json:"workflowId"`
+	WorkflowName      string            `json:"workflowName"`
+}
+
+func (q *Queries) ListEventWorkflowRuns(ctx context.Context, db DBTX, arg ListEventWorkflowRunsParams) ([]*ListEventWorkflowRunsRow, error) {
+	rows, err := db.Query(ctx, listEventWorkflowRuns,
+		arg.Eventid,
+		arg.Tenantid,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListEventWorkflowRunsRow
+	for rows.Next() {
+		var i ListEventWorkflowRunsRow
+		if err := rows.Scan(
+			&i.WorkflowRunId,
+			&i.DisplayName,
+			&i.Status,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.WorkflowVersionId,
+			&i.WorkflowId,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEvents = `-- name: ListEvents :many
 WITH filtered_events AS (
     SELECT
@@ -572,6 +954,231 @@ func (q *Queries) ListEventsByIDs(ctx context.Context, db DBTX, arg ListEventsBy
 	return items, nil
 }
 
+const listStuckEventOrderingKeys = `-- name: ListStuckEventOrderingKeys :many
+SELECT
+    k."tenantId",
+    k."key",
+    k."releaseSequence"
+FROM
+    "EventOrderingKey" k
+WHERE
+    k."updatedAt" < $1::timestamp AND
+    (
+        -- the released event was never marked as triggered
+        (k."activeEventId" IS NOT NULL AND k."activeTriggered" = false) OR
+        -- no event is in progress, but events of the key have been waiting to be released
+        (k."activeEventId" IS NULL AND EXISTS (
+            SELECT
+                1
+            FROM
+                "OrderedEvent" oe
+            WHERE
+                oe."tenantId" = k."tenantId" AND
+                oe."orderingKey" = k."key" AND
+                oe."releasedAt" IS NULL AND
+                oe."createdAt" < $1::timestamp
+        ))
+    )
+ORDER BY
+    k."updatedAt" ASC
+LIMIT
+    $2::integer
+`
+
+type ListStuckEventOrderingKeysParams struct {
+	Updatedbefore pgtype.Timestamp `json:"updatedbefore"`
+	Limit         int32            `json:"limit"`
+}
+
+type ListStuckEventOrderingKeysRow struct {
+	TenantId        pgtype.UUID `json:"tenantId"`
+	Key             string      `json:"key"`
+	ReleaseSequence int64       `json:"releaseSequence"`
+}
+
+func (q *Queries) ListStuckEventOrderingKeys(ctx context.Context, db DBTX, arg ListStuckEventOrderingKeysParams) ([]*ListStuckEventOrderingKeysRow, error) {
+	rows, err := db.Query(ctx, listStuckEventOrderingKeys, arg.Updatedbefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStuckEventOrderingKeysRow
+	for rows.Next() {
+		var i ListStuckEventOrderingKeysRow
+		if err := rows.Scan(&i.TenantId, &i.Key, &i.ReleaseSequence); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowRunIdsForEvent = `-- name: ListWorkflowRunIdsForEvent :many
+SELECT
+    wr."id"
+FROM
+    "WorkflowRunTriggeredBy" tb
+JOIN
+    "WorkflowRun" wr ON wr."id" = tb."parentId"
+WHERE
+    tb."eventId" = $1::uuid AND
+    wr."tenantId" = $2::uuid AND
+    wr."deletedAt" IS NULL
+ORDER BY
+    wr."createdAt" ASC
+`
+
+type ListWorkflowRunIdsForEventParams struct {
+	Eventid  pgtype.UUID `json:"eventid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) ListWorkflowRunIdsForEvent(ctx context.Context, db DBTX, arg ListWorkflowRunIdsForEventParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, listWorkflowRunIdsForEvent, arg.Eventid, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedEvents = `-- name: PurgeDeletedEvents :one
+WITH for_purge AS (
+    SELECT
+        "id"
+    FROM "Event" e
+    WHERE
+        e."tenantId" = $1::uuid AND
+        e."deletedAt" IS NOT NULL AND
+        e."deletedAt" < $2::timestamp
+    ORDER BY e."deletedAt" ASC
+    LIMIT $3 + 1
+    FOR UPDATE SKIP LOCKED
+), purged_with_limit AS (
+    SELECT
+        for_purge."id" as "id"
+    FROM for_purge
+    LIMIT $3
+), has_more AS (
+    SELECT
+        CASE
+            WHEN COUNT(*) > $3 THEN TRUE
+            ELSE FALSE
+        END as has_more
+    FROM for_purge
+),
+-- the tables which reference events don't have foreign keys, because events are partitioned, so their rows
+-- are deleted explicitly
+purged_ordered_events AS (
+    DELETE FROM "OrderedEvent"
+    WHERE "eventId" IN (SELECT "id" FROM purged_with_limit)
+), purged_external_ids AS (
+    DELETE FROM "EventExternalId"
+    WHERE "eventId" IN (SELECT "id" FROM purged_with_limit)
+)
+-- replays keep the id of the event they replayed, which is never read
+DELETE FROM
+    "Event"
+WHERE
+    "id" IN (SELECT "id" FROM purged_with_limit)
+RETURNING
+    (SELECT has_more FROM has_more) as has_more
+`
+
+type PurgeDeletedEventsParams struct {
+	Tenantid      pgtype.UUID      `json:"tenantid"`
+	Deletedbefore pgtype.Timestamp `json:"deletedbefore"`
+	Limit         interface{}      `json:"limit"`
+}
+
+func (q *Queries) PurgeDeletedEvents(ctx context.Context, db DBTX, arg PurgeDeletedEventsParams) (bool, error) {
+	row := db.QueryRow(ctx, purgeDeletedEvents, arg.Tenantid, arg.Deletedbefore, arg.Limit)
+	var has_more bool
+	err := row.Scan(&has_more)
+	return has_more, err
+}
+
+const releaseOrderedEvent = `-- name: ReleaseOrderedEvent :exec
+UPDATE
+    "OrderedEvent"
+SET
+    "releasedAt" = CURRENT_TIMESTAMP
+WHERE
+    "eventId" = $1::uuid
+`
+
+func (q *Queries) ReleaseOrderedEvent(ctx context.Context, db DBTX, eventid pgtype.UUID) error {
+	_, err := db.Exec(ctx, releaseOrderedEvent, eventid)
+	return err
+}
+
+const setEventOrderingKeyActive = `-- name: SetEventOrderingKeyActive :exec
+UPDATE
+    "EventOrderingKey"
+SET
+    "activeEventId" = $1::uuid,
+    "activeTriggered" = false,
+    "lastSequence" = GREATEST("lastSequence", COALESCE($2::bigint, "lastSequence")),
+    -- invalidates release tasks which were enqueued before this release
+    "releaseSequence" = "releaseSequence" + 1,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = $3::uuid AND
+    "key" = $4::text
+`
+
+type SetEventOrderingKeyActiveParams struct {
+	ActiveEventId pgtype.UUID `json:"activeEventId"`
+	Sequence      pgtype.Int8 `json:"sequence"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+	Key           string      `json:"key"`
+}
+
+func (q *Queries) SetEventOrderingKeyActive(ctx context.Context, db DBTX, arg SetEventOrderingKeyActiveParams) error {
+	_, err := db.Exec(ctx, setEventOrderingKeyActive,
+		arg.ActiveEventId,
+		arg.Sequence,
+		arg.Tenantid,
+		arg.Key,
+	)
+	return err
+}
+
+const setOrderedEventTriggered = `-- name: SetOrderedEventTriggered :exec
+UPDATE
+    "EventOrderingKey"
+SET
+    "activeTriggered" = true,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = $1::uuid AND
+    "activeEventId" = $2::uuid
+`
+
+type SetOrderedEventTriggeredParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Eventid  pgtype.UUID `json:"eventid"`
+}
+
+func (q *Queries) SetOrderedEventTriggered(ctx context.Context, db DBTX, arg SetOrderedEventTriggeredParams) error {
+	_, err := db.Exec(ctx, setOrderedEventTriggered, arg.Tenantid, arg.Eventid)
+	return err
+}
+
 const softDeleteExpiredEvents = `-- name: SoftDeleteExpiredEvents :one
 WITH for_delete AS (
     SELECT
@@ -596,6 +1203,10 @@ WITH for_delete AS (
             ELSE FALSE
         END as has_more
     FROM for_delete
+), released_external_ids AS (
+    -- release the external ids of expired events, so that they can be accepted again
+    DELETE FROM "EventExternalId"
+    WHERE "eventId" IN (SELECT "id" FROM expired_with_limit)
 )
 UPDATE
     "Event"
@@ -619,3 +1230,38 @@ func (q *Queries) SoftDeleteExpiredEvents(ctx context.Context, db DBTX, arg Soft
 	err := row.Scan(&has_more)
 	return has_more, err
 }
+
+const upsertEventOrderingKey = `-- name: UpsertEventOrderingKey :one
+INSERT INTO "EventOrderingKey" (
+    "tenantId",
+    "key"
+) VALUES (
+    $1::uuid,
+    $2::text
+)
+ON CONFLICT ("tenantId", "key") DO UPDATE
+SET
+    -- a no-op update, which locks the row for the rest of the transaction
+    "key" = EXCLUDED."key"
+RETURNING "tenantId", key, "updatedAt", "lastSequence", "activeEventId", "activeTriggered", "releaseSequence"
+`
+
+type UpsertEventOrderingKeyParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) UpsertEventOrderingKey(ctx context.Context, db DBTX, arg UpsertEventOrderingKeyParams) (*EventOrderingKey, error) {
+	row := db.QueryRow(ctx, upsertEventOrderingKey, arg.Tenantid, arg.Key)
+	var i EventOrderingKey
+	err := row.Scan(
+		&i.TenantId,
+		&i.Key,
+		&i.UpdatedAt,
+		&i.LastSequence,
+		&i.ActiveEventId,
+		&i.ActiveTriggered,
+		&i.ReleaseSequence,
+	)
+	return &i, err
+}
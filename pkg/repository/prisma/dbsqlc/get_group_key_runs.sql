@@ -85,7 +85,7 @@ group_key_runs AS (
         ggr."tenantId" = @tenantId::uuid
         AND ggr."deletedAt" IS NULL
         AND ggr."status" = ANY(ARRAY['RUNNING', 'ASSIGNED']::"StepRunStatus"[])
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'
+        AND w."lastHeartbeatAt" < NOW() - @heartbeatTimeout::interval
     ORDER BY
         ggr."createdAt" ASC
     LIMIT
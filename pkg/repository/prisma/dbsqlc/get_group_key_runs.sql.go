@@ -256,7 +256,7 @@ group_key_runs AS (
         ggr."tenantId" = $1::uuid
         AND ggr."deletedAt" IS NULL
         AND ggr."status" = ANY(ARRAY['RUNNING', 'ASSIGNED']::"StepRunStatus"[])
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'
+        AND w."lastHeartbeatAt" < NOW() - $2::interval
     ORDER BY
         ggr."createdAt" ASC
     LIMIT
@@ -282,8 +282,13 @@ WHERE
 RETURNING "GetGroupKeyRun".id, "GetGroupKeyRun"."createdAt", "GetGroupKeyRun"."updatedAt", "GetGroupKeyRun"."deletedAt", "GetGroupKeyRun"."tenantId", "GetGroupKeyRun"."workerId", "GetGroupKeyRun"."tickerId", "GetGroupKeyRun".status, "GetGroupKeyRun".input, "GetGroupKeyRun".output, "GetGroupKeyRun"."requeueAfter", "GetGroupKeyRun".error, "GetGroupKeyRun"."startedAt", "GetGroupKeyRun"."finishedAt", "GetGroupKeyRun"."timeoutAt", "GetGroupKeyRun"."cancelledAt", "GetGroupKeyRun"."cancelledReason", "GetGroupKeyRun"."cancelledError", "GetGroupKeyRun"."workflowRunId", "GetGroupKeyRun"."scheduleTimeoutAt"
 `
 
-func (q *Queries) ListGetGroupKeyRunsToReassign(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*GetGroupKeyRun, error) {
-	rows, err := db.Query(ctx, listGetGroupKeyRunsToReassign, tenantid)
+type ListGetGroupKeyRunsToReassignParams struct {
+	Tenantid         pgtype.UUID     `json:"tenantid"`
+	Heartbeattimeout pgtype.Interval `json:"heartbeattimeout"`
+}
+
+func (q *Queries) ListGetGroupKeyRunsToReassign(ctx context.Context, db DBTX, arg ListGetGroupKeyRunsToReassignParams) ([]*GetGroupKeyRun, error) {
+	rows, err := db.Query(ctx, listGetGroupKeyRunsToReassign, arg.Tenantid, arg.Heartbeattimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,37 @@
+-- name: ListQueueDepths :many
+SELECT
+    qi."tenantId",
+    w."name" AS "workflowName",
+    COUNT(*) AS "count"
+FROM
+    "QueueItem" qi
+JOIN
+    "Step" s ON s."id" = qi."stepId"
+JOIN
+    "Job" j ON j."id" = s."jobId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = j."workflowVersionId"
+JOIN
+    "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    qi."isQueued" = true
+GROUP BY
+    qi."tenantId", w."name";
+
+-- name: ListWorkerSlots :many
+SELECT
+    workers."tenantId",
+    workers."id" AS "workerId",
+    workers."maxRuns",
+    (
+        SELECT COUNT(*)
+        FROM "SemaphoreQueueItem" sqi
+        WHERE
+            sqi."tenantId" = workers."tenantId" AND
+            sqi."workerId" = workers."id"
+    ) AS "usedSlots"
+FROM
+    "Worker" workers
+WHERE
+    workers."isActive" = true
+    AND workers."lastHeartbeatAt" >= NOW() - '30 seconds'::INTERVAL;
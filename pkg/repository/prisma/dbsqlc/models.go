@@ -281,6 +281,7 @@ const (
 	LimitResourceWORKER      LimitResource = "WORKER"
 	LimitResourceCRON        LimitResource = "CRON"
 	LimitResourceSCHEDULE    LimitResource = "SCHEDULE"
+	LimitResourceQUEUEDRUN   LimitResource = "QUEUED_RUN"
 )
 
 func (e *LimitResource) Scan(src interface{}) error {
@@ -808,6 +809,49 @@ func (ns NullVcsProvider) Value() (driver.Value, error) {
 	return string(ns.VcsProvider), nil
 }
 
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCEEDED WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus `json:"WebhookDeliveryStatus"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
 type WebhookWorkerRequestMethod string
 
 const (
@@ -1168,6 +1212,8 @@ type APIToken struct {
 	TenantId    pgtype.UUID      `json:"tenantId"`
 	NextAlertAt pgtype.Timestamp `json:"nextAlertAt"`
 	Internal    bool             `json:"internal"`
+	Scopes      []string         `json:"scopes"`
+	Namespace   pgtype.Text      `json:"namespace"`
 }
 
 type Action struct {
@@ -1182,6 +1228,20 @@ type ActionToWorker struct {
 	A pgtype.UUID `json:"A"`
 }
 
+type AuditLog struct {
+	ID           pgtype.UUID      `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"createdAt"`
+	TenantId     pgtype.UUID      `json:"tenantId"`
+	ActorType    string           `json:"actorType"`
+	ActorId      pgtype.UUID      `json:"actorId"`
+	Action       string           `json:"action"`
+	ResourceType pgtype.Text      `json:"resourceType"`
+	ResourceId   pgtype.UUID      `json:"resourceId"`
+	IpAddress    pgtype.Text      `json:"ipAddress"`
+	Payload      []byte           `json:"payload"`
+	PriorState   []byte           `json:"priorState"`
+}
+
 type ControllerPartition struct {
 	ID            string           `json:"id"`
 	CreatedAt     pgtype.Timestamp `json:"createdAt"`
@@ -1212,12 +1272,30 @@ type Event struct {
 	InsertOrder        pgtype.Int4      `json:"insertOrder"`
 }
 
+type EventExternalId struct {
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	ExternalId string           `json:"externalId"`
+	EventId    pgtype.UUID      `json:"eventId"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	ExpiresAt  pgtype.Timestamp `json:"expiresAt"`
+}
+
 type EventKey struct {
 	Key      string      `json:"key"`
 	TenantId pgtype.UUID `json:"tenantId"`
 	ID       int64       `json:"id"`
 }
 
+type EventOrderingKey struct {
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	Key             string           `json:"key"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	LastSequence    int64            `json:"lastSequence"`
+	ActiveEventId   pgtype.UUID      `json:"activeEventId"`
+	ActiveTriggered bool             `json:"activeTriggered"`
+	ReleaseSequence int64            `json:"releaseSequence"`
+}
+
 type GetGroupKeyRun struct {
 	ID                pgtype.UUID      `json:"id"`
 	CreatedAt         pgtype.Timestamp `json:"createdAt"`
@@ -1329,6 +1407,15 @@ type MessageQueueItem struct {
 	Status    MessageQueueItemStatus `json:"status"`
 }
 
+type OrderedEvent struct {
+	EventId     pgtype.UUID      `json:"eventId"`
+	CreatedAt   pgtype.Timestamp `json:"createdAt"`
+	TenantId    pgtype.UUID      `json:"tenantId"`
+	OrderingKey string           `json:"orderingKey"`
+	Sequence    pgtype.Int8      `json:"sequence"`
+	ReleasedAt  pgtype.Timestamp `json:"releasedAt"`
+}
+
 type Queue struct {
 	ID         int64            `json:"id"`
 	TenantId   pgtype.UUID      `json:"tenantId"`
@@ -1366,6 +1453,7 @@ type RetryQueueItem struct {
 	StepRunId  pgtype.UUID      `json:"stepRunId"`
 	TenantId   pgtype.UUID      `json:"tenantId"`
 	IsQueued   bool             `json:"isQueued"`
+	IsRetry    bool             `json:"isRetry"`
 }
 
 type SNSIntegration struct {
@@ -1437,6 +1525,9 @@ type Step struct {
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
 	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	JoinCount          pgtype.Int4      `json:"joinCount"`
+	RetryBackoffBase   pgtype.Int4      `json:"retryBackoffBase"`
+	RetryBackoffJitter pgtype.Float8    `json:"retryBackoffJitter"`
 }
 
 type StepDesiredWorkerLabel struct {
@@ -1505,6 +1596,14 @@ type StepRun struct {
 	InternalRetryCount int32            `json:"internalRetryCount"`
 }
 
+type StepRunDeadLetter struct {
+	StepRunId  pgtype.UUID      `json:"stepRunId"`
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	Error      pgtype.Text      `json:"error"`
+	RetryCount int32            `json:"retryCount"`
+}
+
 type StepRunEvent struct {
 	ID            int64                `json:"id"`
 	TimeFirstSeen pgtype.Timestamp     `json:"timeFirstSeen"`
@@ -1572,6 +1671,7 @@ type Tenant struct {
 	WorkerPartitionId     pgtype.Text      `json:"workerPartitionId"`
 	DataRetentionPeriod   string           `json:"dataRetentionPeriod"`
 	SchedulerPartitionId  pgtype.Text      `json:"schedulerPartitionId"`
+	IsPaused              bool             `json:"isPaused"`
 }
 
 type TenantAlertEmailGroup struct {
@@ -1583,6 +1683,15 @@ type TenantAlertEmailGroup struct {
 	Emails    string           `json:"emails"`
 }
 
+type TenantAlertMuteRule struct {
+	ID         pgtype.UUID      `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	WorkflowId pgtype.UUID      `json:"workflowId"`
+	MutedUntil pgtype.Timestamp `json:"mutedUntil"`
+	Reason     pgtype.Text      `json:"reason"`
+}
+
 type TenantAlertingSettings struct {
 	ID                              pgtype.UUID      `json:"id"`
 	CreatedAt                       pgtype.Timestamp `json:"createdAt"`
@@ -1595,6 +1704,15 @@ type TenantAlertingSettings struct {
 	EnableExpiringTokenAlerts       bool             `json:"enableExpiringTokenAlerts"`
 	EnableWorkflowRunFailureAlerts  bool             `json:"enableWorkflowRunFailureAlerts"`
 	EnableTenantResourceLimitAlerts bool             `json:"enableTenantResourceLimitAlerts"`
+	WorkflowRunFailureThreshold     int32            `json:"workflowRunFailureThreshold"`
+	EnableWorkerOfflineAlerts       bool             `json:"enableWorkerOfflineAlerts"`
+	WorkerOfflineAlertsCheckedAt    pgtype.Timestamp `json:"workerOfflineAlertsCheckedAt"`
+}
+
+type TenantAuditLogSettings struct {
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	RetentionPeriod string           `json:"retentionPeriod"`
 }
 
 type TenantInviteLink struct {
@@ -1610,12 +1728,13 @@ type TenantInviteLink struct {
 }
 
 type TenantMember struct {
-	ID        pgtype.UUID      `json:"id"`
-	CreatedAt pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
-	TenantId  pgtype.UUID      `json:"tenantId"`
-	UserId    pgtype.UUID      `json:"userId"`
-	Role      TenantMemberRole `json:"role"`
+	ID           pgtype.UUID      `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamp `json:"updatedAt"`
+	TenantId     pgtype.UUID      `json:"tenantId"`
+	UserId       pgtype.UUID      `json:"userId"`
+	Role         TenantMemberRole `json:"role"`
+	CustomRoleId pgtype.UUID      `json:"customRoleId"`
 }
 
 type TenantResourceLimit struct {
@@ -1644,6 +1763,16 @@ type TenantResourceLimitAlert struct {
 	Limit           int32                        `json:"limit"`
 }
 
+type TenantRole struct {
+	ID          pgtype.UUID      `json:"id"`
+	CreatedAt   pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
+	TenantId    pgtype.UUID      `json:"tenantId"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	Permissions []string         `json:"permissions"`
+}
+
 type TenantVcsProvider struct {
 	ID          pgtype.UUID      `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -1690,15 +1819,16 @@ type User struct {
 }
 
 type UserOAuth struct {
-	ID             pgtype.UUID      `json:"id"`
-	CreatedAt      pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt      pgtype.Timestamp `json:"updatedAt"`
-	UserId         pgtype.UUID      `json:"userId"`
-	Provider       string           `json:"provider"`
-	ProviderUserId string           `json:"providerUserId"`
-	ExpiresAt      pgtype.Timestamp `json:"expiresAt"`
-	AccessToken    []byte           `json:"accessToken"`
-	RefreshToken   []byte           `json:"refreshToken"`
+	ID              pgtype.UUID      `json:"id"`
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
+	UserId          pgtype.UUID      `json:"userId"`
+	Provider        string           `json:"provider"`
+	ProviderUserId  string           `json:"providerUserId"`
+	ExpiresAt       pgtype.Timestamp `json:"expiresAt"`
+	AccessToken     []byte           `json:"accessToken"`
+	RefreshToken    []byte           `json:"refreshToken"`
+	RefreshFailedAt pgtype.Timestamp `json:"refreshFailedAt"`
 }
 
 type UserPassword struct {
@@ -1715,6 +1845,34 @@ type UserSession struct {
 	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
 }
 
+type WebhookDelivery struct {
+	ID                 pgtype.UUID           `json:"id"`
+	CreatedAt          pgtype.Timestamp      `json:"createdAt"`
+	TenantId           pgtype.UUID           `json:"tenantId"`
+	SubscriptionId     pgtype.UUID           `json:"subscriptionId"`
+	EventType          string                `json:"eventType"`
+	DedupeKey          string                `json:"dedupeKey"`
+	Payload            []byte                `json:"payload"`
+	Status             WebhookDeliveryStatus `json:"status"`
+	Attempts           int32                 `json:"attempts"`
+	NextAttemptAt      pgtype.Timestamp      `json:"nextAttemptAt"`
+	LastAttemptAt      pgtype.Timestamp      `json:"lastAttemptAt"`
+	ResponseStatusCode pgtype.Int4           `json:"responseStatusCode"`
+	Error              pgtype.Text           `json:"error"`
+}
+
+type WebhookSubscription struct {
+	ID         pgtype.UUID      `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt  pgtype.Timestamp `json:"updatedAt"`
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	Name       string           `json:"name"`
+	Url        string           `json:"url"`
+	Secret     string           `json:"secret"`
+	EventTypes []string         `json:"eventTypes"`
+	Enabled    bool             `json:"enabled"`
+}
+
 type WebhookWorker struct {
 	ID         pgtype.UUID      `json:"id"`
 	CreatedAt  pgtype.Timestamp `json:"createdAt"`
@@ -1780,6 +1938,13 @@ type WorkerLabel struct {
 	IntValue  pgtype.Int4      `json:"intValue"`
 }
 
+type WorkerSlotGroup struct {
+	WorkerId  pgtype.UUID `json:"workerId"`
+	Name      string      `json:"name"`
+	MaxRuns   int32       `json:"maxRuns"`
+	ActionIds []string    `json:"actionIds"`
+}
+
 type Workflow struct {
 	ID          pgtype.UUID      `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -1802,6 +1967,13 @@ type WorkflowConcurrency struct {
 	ConcurrencyGroupExpression pgtype.Text              `json:"concurrencyGroupExpression"`
 }
 
+type WorkflowLatestVersionWeight struct {
+	WorkflowId pgtype.UUID      `json:"workflowId"`
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	Weight     int32            `json:"weight"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+}
+
 type WorkflowRun struct {
 	CreatedAt          pgtype.Timestamp  `json:"createdAt"`
 	UpdatedAt          pgtype.Timestamp  `json:"updatedAt"`
@@ -1823,6 +1995,15 @@ type WorkflowRun struct {
 	Duration           pgtype.Int8       `json:"duration"`
 	Priority           pgtype.Int4       `json:"priority"`
 	InsertOrder        pgtype.Int4       `json:"insertOrder"`
+	Detached           bool              `json:"detached"`
+	TimeoutAt          pgtype.Timestamp  `json:"timeoutAt"`
+}
+
+type WorkflowRunChildKey struct {
+	ParentId        pgtype.UUID `json:"parentId"`
+	ParentStepRunId pgtype.UUID `json:"parentStepRunId"`
+	ChildKey        string      `json:"childKey"`
+	WorkflowRunId   pgtype.UUID `json:"workflowRunId"`
 }
 
 type WorkflowRunDedupe struct {
@@ -1835,6 +2016,21 @@ type WorkflowRunDedupe struct {
 	Value         string           `json:"value"`
 }
 
+type WorkflowRunExpiry struct {
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	ExpiresAt     pgtype.Timestamp `json:"expiresAt"`
+	Expired       bool             `json:"expired"`
+}
+
+type WorkflowRunIdempotencyKey struct {
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	Key           string           `json:"key"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	ExpiresAt     pgtype.Timestamp `json:"expiresAt"`
+}
+
 type WorkflowRunStickyState struct {
 	ID              int64            `json:"id"`
 	CreatedAt       pgtype.Timestamp `json:"createdAt"`
@@ -1858,6 +2054,8 @@ type WorkflowRunTriggeredBy struct {
 	Input        []byte           `json:"input"`
 	ParentId     pgtype.UUID      `json:"parentId"`
 	CronName     pgtype.Text      `json:"cronName"`
+	ActorType    pgtype.Text      `json:"actorType"`
+	ActorId      pgtype.UUID      `json:"actorId"`
 }
 
 type WorkflowTag struct {
@@ -1887,11 +2085,14 @@ type WorkflowTriggerCronRef struct {
 	Name               pgtype.Text                   `json:"name"`
 	ID                 pgtype.UUID                   `json:"id"`
 	Method             WorkflowTriggerCronRefMethods `json:"method"`
+	CatchUp            bool                          `json:"catchUp"`
+	LastFiredAt        pgtype.Timestamp              `json:"lastFiredAt"`
 }
 
 type WorkflowTriggerEventRef struct {
-	ParentId pgtype.UUID `json:"parentId"`
-	EventKey string      `json:"eventKey"`
+	ParentId         pgtype.UUID `json:"parentId"`
+	EventKey         string      `json:"eventKey"`
+	FilterExpression pgtype.Text `json:"filterExpression"`
 }
 
 type WorkflowTriggerScheduledRef struct {
@@ -1934,4 +2135,13 @@ type WorkflowVersion struct {
 	Sticky          NullStickyStrategy `json:"sticky"`
 	Kind            WorkflowKind       `json:"kind"`
 	DefaultPriority pgtype.Int4        `json:"defaultPriority"`
+	RunTimeout      pgtype.Text        `json:"runTimeout"`
+}
+
+type WorkflowVersionWeight struct {
+	WorkflowVersionId pgtype.UUID      `json:"workflowVersionId"`
+	WorkflowId        pgtype.UUID      `json:"workflowId"`
+	TenantId          pgtype.UUID      `json:"tenantId"`
+	Weight            int32            `json:"weight"`
+	CreatedAt         pgtype.Timestamp `json:"createdAt"`
 }
@@ -466,6 +466,39 @@ FROM
 LEFT JOIN
     worker_filled_slots wfs ON wmr."id" = wfs."workerId";
 
+-- name: ListSlotGroupsForWorkers :many
+WITH worker_filled_slots AS (
+    SELECT
+        sqi."workerId",
+        s."actionId",
+        COUNT(sqi."stepRunId") AS "filledSlots"
+    FROM
+        "SemaphoreQueueItem" sqi
+    JOIN
+        "StepRun" sr ON sr."id" = sqi."stepRunId"
+    JOIN
+        "Step" s ON s."id" = sr."stepId"
+    WHERE
+        sqi."tenantId" = @tenantId::uuid
+        AND sqi."workerId" = ANY(@workerIds::uuid[])
+    GROUP BY
+        sqi."workerId", s."actionId"
+)
+-- subtract the filled slots of the actions of each group from the max runs of the group
+SELECT
+    wsg."workerId",
+    wsg."name",
+    wsg."actionIds",
+    (wsg."maxRuns" - COALESCE(SUM(wfs."filledSlots"), 0))::int AS "availableSlots"
+FROM
+    "WorkerSlotGroup" wsg
+LEFT JOIN
+    worker_filled_slots wfs ON wfs."workerId" = wsg."workerId" AND wfs."actionId" = ANY(wsg."actionIds")
+WHERE
+    wsg."workerId" = ANY(@workerIds::uuid[])
+GROUP BY
+    wsg."workerId", wsg."name", wsg."actionIds", wsg."maxRuns";
+
 -- name: ListAllAvailableSlotsForWorkers :many
 WITH worker_max_runs AS (
     SELECT
@@ -587,7 +620,8 @@ WITH retries AS (
         s."actionId",
         s."id" AS "stepId",
         s."timeout" AS "stepTimeout",
-        s."scheduleTimeout" AS "scheduleTimeout"
+        s."scheduleTimeout" AS "scheduleTimeout",
+        retries."isRetry"
     FROM
         retries
     JOIN
@@ -602,7 +636,8 @@ WITH retries AS (
         "status" = 'PENDING_ASSIGNMENT',
         "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
         "updatedAt" = CURRENT_TIMESTAMP,
-        "retryCount" = srs."retryCount" + 1
+        -- step runs which were suspended are started again without counting as a retry
+        "retryCount" = srs."retryCount" + CASE WHEN srs."isRetry" THEN 1 ELSE 0 END
     FROM srs
     WHERE sr."id" = srs."id"
     RETURNING sr."id"
@@ -641,14 +676,16 @@ INSERT INTO
         "stepRunId",
         "retryAfter",
         "tenantId",
-        "isQueued"
+        "isQueued",
+        "isRetry"
     )
 VALUES
     (
         @stepRunId::uuid,
         @retryAfter::timestamp,
         @tenantId::uuid,
-        true
+        true,
+        COALESCE(sqlc.narg('isRetry')::boolean, true)
     );
 
 -- name: GetMinMaxProcessedRetryQueueItems :one
@@ -220,14 +220,16 @@ INSERT INTO
         "stepRunId",
         "retryAfter",
         "tenantId",
-        "isQueued"
+        "isQueued",
+        "isRetry"
     )
 VALUES
     (
         $1::uuid,
         $2::timestamp,
         $3::uuid,
-        true
+        true,
+        COALESCE($4::boolean, true)
     )
 `
 
@@ -235,10 +237,16 @@ type CreateRetryQueueItemParams struct {
 	Steprunid  pgtype.UUID      `json:"steprunid"`
 	Retryafter pgtype.Timestamp `json:"retryafter"`
 	Tenantid   pgtype.UUID      `json:"tenantid"`
+	IsRetry    pgtype.Bool      `json:"isRetry"`
 }
 
 func (q *Queries) CreateRetryQueueItem(ctx context.Context, db DBTX, arg CreateRetryQueueItemParams) error {
-	_, err := db.Exec(ctx, createRetryQueueItem, arg.Steprunid, arg.Retryafter, arg.Tenantid)
+	_, err := db.Exec(ctx, createRetryQueueItem,
+		arg.Steprunid,
+		arg.Retryafter,
+		arg.Tenantid,
+		arg.IsRetry,
+	)
 	return err
 }
 
@@ -989,6 +997,77 @@ func (q *Queries) ListQueues(ctx context.Context, db DBTX, tenantid pgtype.UUID)
 	return items, nil
 }
 
+const listSlotGroupsForWorkers = `-- name: ListSlotGroupsForWorkers :many
+WITH worker_filled_slots AS (
+    SELECT
+        sqi."workerId",
+        s."actionId",
+        COUNT(sqi."stepRunId") AS "filledSlots"
+    FROM
+        "SemaphoreQueueItem" sqi
+    JOIN
+        "StepRun" sr ON sr."id" = sqi."stepRunId"
+    JOIN
+        "Step" s ON s."id" = sr."stepId"
+    WHERE
+        sqi."tenantId" = $1::uuid
+        AND sqi."workerId" = ANY($2::uuid[])
+    GROUP BY
+        sqi."workerId", s."actionId"
+)
+SELECT
+    wsg."workerId",
+    wsg."name",
+    wsg."actionIds",
+    (wsg."maxRuns" - COALESCE(SUM(wfs."filledSlots"), 0))::int AS "availableSlots"
+FROM
+    "WorkerSlotGroup" wsg
+LEFT JOIN
+    worker_filled_slots wfs ON wfs."workerId" = wsg."workerId" AND wfs."actionId" = ANY(wsg."actionIds")
+WHERE
+    wsg."workerId" = ANY($2::uuid[])
+GROUP BY
+    wsg."workerId", wsg."name", wsg."actionIds", wsg."maxRuns"
+`
+
+type ListSlotGroupsForWorkersParams struct {
+	Tenantid  pgtype.UUID   `json:"tenantid"`
+	Workerids []pgtype.UUID `json:"workerids"`
+}
+
+type ListSlotGroupsForWorkersRow struct {
+	WorkerId       pgtype.UUID `json:"workerId"`
+	Name           string      `json:"name"`
+	ActionIds      []string    `json:"actionIds"`
+	AvailableSlots int32       `json:"availableSlots"`
+}
+
+// subtract the filled slots of the actions of each group from the max runs of the group
+func (q *Queries) ListSlotGroupsForWorkers(ctx context.Context, db DBTX, arg ListSlotGroupsForWorkersParams) ([]*ListSlotGroupsForWorkersRow, error) {
+	rows, err := db.Query(ctx, listSlotGroupsForWorkers, arg.Tenantid, arg.Workerids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListSlotGroupsForWorkersRow
+	for rows.Next() {
+		var i ListSlotGroupsForWorkersRow
+		if err := rows.Scan(
+			&i.WorkerId,
+			&i.Name,
+			&i.ActionIds,
+			&i.AvailableSlots,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markInternalQueueItemsProcessed = `-- name: MarkInternalQueueItemsProcessed :exec
 UPDATE
     "InternalQueueItem" qi
@@ -1078,7 +1157,7 @@ func (q *Queries) RemoveTimeoutQueueItem(ctx context.Context, db DBTX, arg Remov
 const retryStepRuns = `-- name: RetryStepRuns :one
 WITH retries AS (
     SELECT
-        id, "retryAfter", "stepRunId", "tenantId", "isQueued"
+        id, "retryAfter", "stepRunId", "tenantId", "isQueued", "isRetry"
     FROM
         "RetryQueueItem" rqi
     WHERE
@@ -1109,7 +1188,8 @@ WITH retries AS (
         s."actionId",
         s."id" AS "stepId",
         s."timeout" AS "stepTimeout",
-        s."scheduleTimeout" AS "scheduleTimeout"
+        s."scheduleTimeout" AS "scheduleTimeout",
+        retries."isRetry"
     FROM
         retries
     JOIN
@@ -1124,7 +1204,8 @@ WITH retries AS (
         "status" = 'PENDING_ASSIGNMENT',
         "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
         "updatedAt" = CURRENT_TIMESTAMP,
-        "retryCount" = srs."retryCount" + 1
+        -- step runs which were suspended are started again without counting as a retry
+        "retryCount" = srs."retryCount" + CASE WHEN srs."isRetry" THEN 1 ELSE 0 END
     FROM srs
     WHERE sr."id" = srs."id"
     RETURNING sr."id"
@@ -17,6 +17,18 @@ INSERT INTO "RateLimit" (
     "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
 RETURNING *;
 
+-- name: ResetRateLimit :one
+-- Refills a rate limit to its limit value and restarts its window
+UPDATE
+    "RateLimit" rl
+SET
+    "value" = rl."limitValue",
+    "lastRefill" = CURRENT_TIMESTAMP
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."key" = @key::text
+RETURNING *;
+
 -- name: UpsertRateLimitsBulk :exec
 WITH input_values AS (
     SELECT
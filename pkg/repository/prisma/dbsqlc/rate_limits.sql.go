@@ -307,6 +307,38 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 	return items, nil
 }
 
+const resetRateLimit = `-- name: ResetRateLimit :one
+UPDATE
+    "RateLimit" rl
+SET
+    "value" = rl."limitValue",
+    "lastRefill" = CURRENT_TIMESTAMP
+WHERE
+    rl."tenantId" = $1::uuid
+    AND rl."key" = $2::text
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+`
+
+type ResetRateLimitParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+// Refills a rate limit to its limit value and restarts its window
+func (q *Queries) ResetRateLimit(ctx context.Context, db DBTX, arg ResetRateLimitParams) (*RateLimit, error) {
+	row := db.QueryRow(ctx, resetRateLimit, arg.Tenantid, arg.Key)
+	var i RateLimit
+	err := row.Scan(
+		&i.TenantId,
+		&i.Key,
+		&i.LimitValue,
+		&i.Value,
+		&i.Window,
+		&i.LastRefill,
+	)
+	return &i, err
+}
+
 const upsertRateLimit = `-- name: UpsertRateLimit :one
 INSERT INTO "RateLimit" (
     "tenantId",
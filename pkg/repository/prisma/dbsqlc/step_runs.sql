@@ -32,7 +32,9 @@ SELECT
     wr."childIndex",
     wr."childKey",
     wr."parentId",
-    COALESCE(ec."exprCount", 0) AS "exprCount"
+    wr."timeoutAt" AS "workflowRunTimeoutAt",
+    COALESCE(ec."exprCount", 0) AS "exprCount",
+    wre."expiresAt"
 FROM
     "StepRun" sr
 JOIN
@@ -44,6 +46,8 @@ JOIN
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = @tenantId::uuid
 LEFT JOIN
     expr_count ec ON sr."id" = ec."id"
+LEFT JOIN
+    "WorkflowRunExpiry" wre ON wre."workflowRunId" = wr."id"
 WHERE
     sr."id" = @id::uuid AND
     sr."tenantId" = @tenantId::uuid;
@@ -76,7 +80,9 @@ SELECT
     j."id" AS "jobId",
     j."kind" AS "jobKind",
     j."workflowVersionId" AS "workflowVersionId",
-    a."actionId" AS "actionId"
+    a."actionId" AS "actionId",
+    wr."timeoutAt" AS "workflowRunTimeoutAt",
+    wre."expiresAt"
 FROM
     "StepRun" sr
 JOIN
@@ -92,6 +98,8 @@ JOIN
 JOIN
     -- Take advantage of composite index on "JobRun"("workflowRunId", "tenantId")
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = @tenantId::uuid
+LEFT JOIN
+    "WorkflowRunExpiry" wre ON wre."workflowRunId" = wr."id"
 WHERE
     sr."id" = ANY(@ids::uuid[])
     AND sr."tenantId" = @tenantId::uuid;
@@ -179,7 +187,11 @@ SELECT
     sr."tenantId" AS "SR_tenantId",
     sr."queue" AS "SR_queue",
     sr."order" AS "SR_order",
-    sqi."workerId" AS "SR_workerId",
+    -- step runs which released their slot are still running on the worker which recorded the release
+    COALESCE(
+        sqi."workerId",
+        CASE WHEN sr."semaphoreReleased" AND sr."status" = ANY(ARRAY['RUNNING', 'CANCELLING']::"StepRunStatus"[]) THEN sr."workerId" END
+    ) AS "SR_workerId",
     sr."tickerId" AS "SR_tickerId",
     sr."status" AS "SR_status",
     sr."requeueAfter" AS "SR_requeueAfter",
@@ -206,6 +218,8 @@ SELECT
     s."customUserData" AS "stepCustomUserData",
     s."retryBackoffFactor" AS "stepRetryBackoffFactor",
     s."retryMaxBackoff" AS "stepRetryMaxBackoff",
+    s."retryBackoffBase" AS "stepRetryBackoffBase",
+    s."retryBackoffJitter" AS "stepRetryBackoffJitter",
     j."name" AS "jobName",
     j."id" AS "jobId",
     j."kind" AS "jobKind",
@@ -266,14 +280,18 @@ JOIN
 WHERE
     parent_run."id" = @parentStepRunId::uuid
     AND child_run."status" = 'PENDING'
-    -- we look for whether the step run is startable by ensuring that all parent step runs have succeeded
-    AND NOT EXISTS (
-        SELECT 1
+    -- we look for whether the step run is startable by ensuring that enough parent step runs have succeeded,
+    -- which is all of them unless the step sets a join count
+    AND (
+        SELECT COUNT(*)
         FROM "_StepRunOrder" AS parent_order
         JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
         WHERE
             parent_order."B" = child_run."id"
-            AND parent_run."status" != 'SUCCEEDED'
+            AND parent_run."status" = 'SUCCEEDED'
+    ) >= COALESCE(
+        (SELECT s."joinCount" FROM "Step" AS s WHERE s."id" = child_run."stepId"),
+        (SELECT COUNT(*) FROM "_StepRunOrder" AS parent_order WHERE parent_order."B" = child_run."id")
     )
     -- AND we ensure that there's at least 2 parent step runs
     AND EXISTS (
@@ -428,8 +446,10 @@ WHERE
 UPDATE
     "StepRun"
 SET
-    -- note that workerId has already been removed via SemaphoreQueueItem
-    "semaphoreReleased" = true
+    -- the SemaphoreQueueItem has already been removed, so the worker is kept on the step run to
+    -- send timeouts and cancellations to it
+    "semaphoreReleased" = true,
+    "workerId" = @workerId::uuid
 WHERE
     "id" = @stepRunId::uuid AND
     "tenantId" = @tenantId::uuid;
@@ -667,7 +687,7 @@ WITH step_runs_on_inactive_workers AS (
         "Step" s ON sr."stepId" = s."id"
     WHERE
         w."tenantId" = @tenantId::uuid
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'),
+        AND w."lastHeartbeatAt" < NOW() - @heartbeatTimeout::interval),
 step_runs_to_reassign AS (
     SELECT
         *
@@ -769,14 +789,19 @@ LIMIT 100;
 -- name: RefreshTimeoutBy :one
 WITH step_run AS (
     SELECT
-        "id",
-        "retryCount",
-        "tenantId"
+        sr."id",
+        sr."retryCount",
+        sr."tenantId",
+        wr."timeoutAt" AS "workflowRunTimeoutAt"
     FROM
-        "StepRun"
+        "StepRun" sr
+    JOIN
+        "JobRun" jr ON jr."id" = sr."jobRunId"
+    JOIN
+        "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
     WHERE
-        "id" = @stepRunId::uuid AND
-        "tenantId" = @tenantId::uuid
+        sr."id" = @stepRunId::uuid AND
+        sr."tenantId" = @tenantId::uuid
 )
 INSERT INTO
     "TimeoutQueueItem" (
@@ -789,14 +814,21 @@ INSERT INTO
 SELECT
     sr."id",
     sr."retryCount",
-    NOW() + convert_duration_to_interval(sqlc.narg('incrementTimeoutBy')::text),
+    -- the timeout can't be refreshed past the timeout of the workflow run
+    LEAST(
+        NOW() + convert_duration_to_interval(sqlc.narg('incrementTimeoutBy')::text),
+        sr."workflowRunTimeoutAt"
+    ),
     sr."tenantId",
     true
 FROM
     step_run sr
 ON CONFLICT ("stepRunId", "retryCount") DO UPDATE
 SET
-    "timeoutAt" = "TimeoutQueueItem"."timeoutAt" + convert_duration_to_interval(sqlc.narg('incrementTimeoutBy')::text)
+    "timeoutAt" = LEAST(
+        "TimeoutQueueItem"."timeoutAt" + convert_duration_to_interval(sqlc.narg('incrementTimeoutBy')::text),
+        (SELECT "workflowRunTimeoutAt" FROM step_run)
+    )
 RETURNING "TimeoutQueueItem"."timeoutAt";
 
 -- name: UpdateStepRunUnsetWorkerId :one
@@ -847,6 +879,54 @@ DELETE FROM
 WHERE
     "stepRunId" = ANY(@stepRunIds::uuid[]);
 
+-- name: CancelUnsatisfiableJoinStepRuns :many
+-- Cancels the pending step runs with a join count in the job runs of the given step runs which can no longer
+-- start because too few of their parents can succeed, along with the pending step runs which come after
+-- them up to the next step with a join count. It returns the ids of the cancelled step runs.
+WITH RECURSIVE unsatisfiable AS (
+    SELECT sr."id"
+    FROM "StepRun" sr
+    JOIN "Step" s ON s."id" = sr."stepId"
+    WHERE
+        sr."jobRunId" IN (
+            SELECT "jobRunId"
+            FROM "StepRun"
+            WHERE "id" = ANY(@stepRunIds::uuid[])
+        )
+        AND sr."status" = 'PENDING'
+        AND s."joinCount" IS NOT NULL
+        AND (
+            SELECT COUNT(*)
+            FROM "_StepRunOrder" AS parent_order
+            JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+            WHERE
+                parent_order."B" = sr."id"
+                AND parent_run."status" NOT IN ('FAILED', 'CANCELLED')
+        ) < s."joinCount"
+), laterStepRuns AS (
+    SELECT "id" FROM unsatisfiable
+
+    UNION
+
+    SELECT sr."id"
+    FROM "StepRun" sr
+    JOIN "_StepRunOrder" sro ON sr."id" = sro."B"
+    JOIN laterStepRuns lsr ON sro."A" = lsr."id"
+    JOIN "Step" s ON s."id" = sr."stepId"
+    WHERE s."joinCount" IS NULL
+)
+UPDATE "StepRun" sr
+SET
+    "status" = 'CANCELLED',
+    "finishedAt" = CURRENT_TIMESTAMP,
+    "cancelledAt" = CURRENT_TIMESTAMP,
+    "cancelledReason" = 'JOIN_NOT_SATISFIED'
+FROM laterStepRuns lsr
+WHERE
+    sr."id" = lsr."id"
+    AND sr."status" = 'PENDING'
+RETURNING sr."id";
+
 -- name: CheckWorker :one
 SELECT
     "id"
@@ -922,11 +1002,19 @@ WITH input AS (
         sr."id",
         sr."retryCount",
         sr."tenantId",
-        CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout") AS "timeoutAt"
+        -- step runs never time out after the timeout of their workflow run
+        LEAST(
+            CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout"),
+            wr."timeoutAt"
+        ) AS "timeoutAt"
     FROM
         input
     JOIN
         "StepRun" sr ON sr."id" = input."id"
+    JOIN
+        "JobRun" jr ON jr."id" = sr."jobRunId"
+    JOIN
+        "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
     ORDER BY sr."id"
 ), assigned_step_runs AS (
     INSERT INTO "SemaphoreQueueItem" (
@@ -1297,10 +1385,14 @@ WITH RECURSIVE currStepRun AS (
     WHERE
         "id" = @stepRunId::uuid
 ), childStepRuns AS (
+    -- steps with a join count are skipped, as they can still start when other parents succeed. They are
+    -- cancelled by CancelUnsatisfiableJoinStepRuns once too few of their parents can succeed.
     SELECT sr."id", sr."status"
     FROM "StepRun" sr
     JOIN "_StepRunOrder" sro ON sr."id" = sro."B"
+    JOIN "Step" s ON s."id" = sr."stepId"
     WHERE sro."A" = (SELECT "id" FROM currStepRun)
+        AND s."joinCount" IS NULL
 
     UNION ALL
 
@@ -1308,6 +1400,8 @@ WITH RECURSIVE currStepRun AS (
     FROM "StepRun" sr
     JOIN "_StepRunOrder" sro ON sr."id" = sro."B"
     JOIN childStepRuns csr ON sro."A" = csr."id"
+    JOIN "Step" s ON s."id" = sr."stepId"
+    WHERE s."joinCount" IS NULL
 )
 SELECT
     sr.*
@@ -1435,6 +1529,92 @@ WHERE
     "stepRunId" = @stepRunId::uuid;
 
 
+-- name: CreateStepRunDeadLetter :exec
+INSERT INTO "StepRunDeadLetter" (
+    "stepRunId",
+    "tenantId",
+    "error",
+    "retryCount"
+) VALUES (
+    @stepRunId::uuid,
+    @tenantId::uuid,
+    sqlc.narg('error')::text,
+    @retryCount::int
+)
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "createdAt" = CURRENT_TIMESTAMP,
+    "error" = EXCLUDED."error",
+    "retryCount" = EXCLUDED."retryCount";
+
+-- name: GetStepRunDeadLetter :one
+SELECT
+    *
+FROM
+    "StepRunDeadLetter"
+WHERE
+    "stepRunId" = @stepRunId::uuid AND
+    "tenantId" = @tenantId::uuid;
+
+-- name: ListStepRunDeadLetters :many
+SELECT
+    dl."stepRunId",
+    dl."createdAt",
+    dl."error",
+    dl."retryCount",
+    sr."jobRunId",
+    jr."workflowRunId",
+    s."readableId" AS "stepReadableId",
+    s."actionId",
+    w."name" AS "workflowName"
+FROM
+    "StepRunDeadLetter" dl
+JOIN
+    "StepRun" sr ON sr."id" = dl."stepRunId"
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+JOIN
+    "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    dl."tenantId" = @tenantId::uuid AND
+    sr."deletedAt" IS NULL AND
+    wr."deletedAt" IS NULL
+ORDER BY
+    dl."createdAt" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountStepRunDeadLetters :one
+SELECT
+    count(*) AS total
+FROM
+    "StepRunDeadLetter" dl
+JOIN
+    "StepRun" sr ON sr."id" = dl."stepRunId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+WHERE
+    dl."tenantId" = @tenantId::uuid AND
+    sr."deletedAt" IS NULL AND
+    wr."deletedAt" IS NULL;
+
+-- name: DeleteStepRunDeadLetter :execrows
+DELETE FROM
+    "StepRunDeadLetter"
+WHERE
+    "stepRunId" = @stepRunId::uuid AND
+    "tenantId" = @tenantId::uuid;
+
 -- name: ClearStepRunPayloadData :one
 WITH for_delete AS (
     SELECT
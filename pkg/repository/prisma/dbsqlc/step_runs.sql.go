@@ -370,6 +370,75 @@ func (q *Queries) BulkStartStepRun(ctx context.Context, db DBTX, arg BulkStartSt
 	return err
 }
 
+const cancelUnsatisfiableJoinStepRuns = `-- name: CancelUnsatisfiableJoinStepRuns :many
+WITH RECURSIVE unsatisfiable AS (
+    SELECT sr."id"
+    FROM "StepRun" sr
+    JOIN "Step" s ON s."id" = sr."stepId"
+    WHERE
+        sr."jobRunId" IN (
+            SELECT "jobRunId"
+            FROM "StepRun"
+            WHERE "id" = ANY($1::uuid[])
+        )
+        AND sr."status" = 'PENDING'
+        AND s."joinCount" IS NOT NULL
+        AND (
+            SELECT COUNT(*)
+            FROM "_StepRunOrder" AS parent_order
+            JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+            WHERE
+                parent_order."B" = sr."id"
+                AND parent_run."status" NOT IN ('FAILED', 'CANCELLED')
+        ) < s."joinCount"
+), laterStepRuns AS (
+    SELECT "id" FROM unsatisfiable
+
+    UNION
+
+    SELECT sr."id"
+    FROM "StepRun" sr
+    JOIN "_StepRunOrder" sro ON sr."id" = sro."B"
+    JOIN laterStepRuns lsr ON sro."A" = lsr."id"
+    JOIN "Step" s ON s."id" = sr."stepId"
+    WHERE s."joinCount" IS NULL
+)
+UPDATE "StepRun" sr
+SET
+    "status" = 'CANCELLED',
+    "finishedAt" = CURRENT_TIMESTAMP,
+    "cancelledAt" = CURRENT_TIMESTAMP,
+    "cancelledReason" = 'JOIN_NOT_SATISFIED'
+FROM laterStepRuns lsr
+WHERE
+    sr."id" = lsr."id"
+    AND sr."status" = 'PENDING'
+RETURNING sr."id";
+`
+
+// Cancels the pending step runs with a join count in the job runs of the given step runs which can no longer
+// start because too few of their parents can succeed, along with the pending step runs which come after
+// them up to the next step with a join count. It returns the ids of the cancelled step runs.
+func (q *Queries) CancelUnsatisfiableJoinStepRuns(ctx context.Context, db DBTX, steprunids []pgtype.UUID) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, cancelUnsatisfiableJoinStepRuns, steprunids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const checkWorker = `-- name: CheckWorker :one
 SELECT
     "id"
@@ -478,6 +547,30 @@ func (q *Queries) CountStepRunArchives(ctx context.Context, db DBTX, steprunid p
 	return total, err
 }
 
+const countStepRunDeadLetters = `-- name: CountStepRunDeadLetters :one
+SELECT
+    count(*) AS total
+FROM
+    "StepRunDeadLetter" dl
+JOIN
+    "StepRun" sr ON sr."id" = dl."stepRunId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+WHERE
+    dl."tenantId" = $1::uuid AND
+    sr."deletedAt" IS NULL AND
+    wr."deletedAt" IS NULL
+`
+
+func (q *Queries) CountStepRunDeadLetters(ctx context.Context, db DBTX, tenantid pgtype.UUID) (int64, error) {
+	row := db.QueryRow(ctx, countStepRunDeadLetters, tenantid)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const countStepRunEvents = `-- name: CountStepRunEvents :one
 SELECT
     count(*) OVER() AS total
@@ -494,6 +587,42 @@ func (q *Queries) CountStepRunEvents(ctx context.Context, db DBTX, steprunid pgt
 	return total, err
 }
 
+const createStepRunDeadLetter = `-- name: CreateStepRunDeadLetter :exec
+INSERT INTO "StepRunDeadLetter" (
+    "stepRunId",
+    "tenantId",
+    "error",
+    "retryCount"
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::text,
+    $4::int
+)
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "createdAt" = CURRENT_TIMESTAMP,
+    "error" = EXCLUDED."error",
+    "retryCount" = EXCLUDED."retryCount"
+`
+
+type CreateStepRunDeadLetterParams struct {
+	Steprunid  pgtype.UUID `json:"steprunid"`
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Error      pgtype.Text `json:"error"`
+	Retrycount int32       `json:"retrycount"`
+}
+
+func (q *Queries) CreateStepRunDeadLetter(ctx context.Context, db DBTX, arg CreateStepRunDeadLetterParams) error {
+	_, err := db.Exec(ctx, createStepRunDeadLetter,
+		arg.Steprunid,
+		arg.Tenantid,
+		arg.Error,
+		arg.Retrycount,
+	)
+	return err
+}
+
 const createStepRunEvent = `-- name: CreateStepRunEvent :exec
 WITH input_values AS (
     SELECT
@@ -668,6 +797,27 @@ func (q *Queries) CreateWorkerAssignEvents(ctx context.Context, db DBTX, arg Cre
 	return err
 }
 
+const deleteStepRunDeadLetter = `-- name: DeleteStepRunDeadLetter :execrows
+DELETE FROM
+    "StepRunDeadLetter"
+WHERE
+    "stepRunId" = $1::uuid AND
+    "tenantId" = $2::uuid
+`
+
+type DeleteStepRunDeadLetterParams struct {
+	Steprunid pgtype.UUID `json:"steprunid"`
+	Tenantid  pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) DeleteStepRunDeadLetter(ctx context.Context, db DBTX, arg DeleteStepRunDeadLetterParams) (int64, error) {
+	result, err := db.Exec(ctx, deleteStepRunDeadLetter, arg.Steprunid, arg.Tenantid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getDesiredLabels = `-- name: GetDesiredLabels :many
 SELECT
     "key",
@@ -763,10 +913,14 @@ WITH RECURSIVE currStepRun AS (
     WHERE
         "id" = $1::uuid
 ), childStepRuns AS (
+    -- steps with a join count are skipped, as they can still start when other parents succeed. They are
+    -- cancelled by CancelUnsatisfiableJoinStepRuns once too few of their parents can succeed.
     SELECT sr."id", sr."status"
     FROM "StepRun" sr
     JOIN "_StepRunOrder" sro ON sr."id" = sro."B"
+    JOIN "Step" s ON s."id" = sr."stepId"
     WHERE sro."A" = (SELECT "id" FROM currStepRun)
+        AND s."joinCount" IS NULL
 
     UNION ALL
 
@@ -774,6 +928,8 @@ WITH RECURSIVE currStepRun AS (
     FROM "StepRun" sr
     JOIN "_StepRunOrder" sro ON sr."id" = sro."B"
     JOIN childStepRuns csr ON sro."A" = csr."id"
+    JOIN "Step" s ON s."id" = sr."stepId"
+    WHERE s."joinCount" IS NULL
 )
 SELECT
     sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount"
@@ -970,7 +1126,9 @@ SELECT
     j."id" AS "jobId",
     j."kind" AS "jobKind",
     j."workflowVersionId" AS "workflowVersionId",
-    a."actionId" AS "actionId"
+    a."actionId" AS "actionId",
+    wr."timeoutAt" AS "workflowRunTimeoutAt",
+    wre."expiresAt"
 FROM
     "StepRun" sr
 JOIN
@@ -986,6 +1144,8 @@ JOIN
 JOIN
     -- Take advantage of composite index on "JobRun"("workflowRunId", "tenantId")
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = $1::uuid
+LEFT JOIN
+    "WorkflowRunExpiry" wre ON wre."workflowRunId" = wr."id"
 WHERE
     sr."id" = ANY($2::uuid[])
     AND sr."tenantId" = $1::uuid
@@ -997,33 +1157,35 @@ type GetStepRunBulkDataForEngineParams struct {
 }
 
 type GetStepRunBulkDataForEngineRow struct {
-	SRID                pgtype.UUID   `json:"SR_id"`
-	SRRetryCount        int32         `json:"SR_retryCount"`
-	Input               []byte        `json:"input"`
-	Output              []byte        `json:"output"`
-	Error               pgtype.Text   `json:"error"`
-	Status              StepRunStatus `json:"status"`
-	JobRunId            pgtype.UUID   `json:"jobRunId"`
-	JobRunStatus        JobRunStatus  `json:"jobRunStatus"`
-	JobRunStatus_2      JobRunStatus  `json:"jobRunStatus_2"`
-	WorkflowRunId       pgtype.UUID   `json:"workflowRunId"`
-	JobRunLookupData    []byte        `json:"jobRunLookupData"`
-	AdditionalMetadata  []byte        `json:"additionalMetadata"`
-	ChildIndex          pgtype.Int4   `json:"childIndex"`
-	ChildKey            pgtype.Text   `json:"childKey"`
-	ParentId            pgtype.UUID   `json:"parentId"`
-	JobRunId_2          pgtype.UUID   `json:"jobRunId_2"`
-	StepId              pgtype.UUID   `json:"stepId"`
-	StepRetries         int32         `json:"stepRetries"`
-	StepTimeout         pgtype.Text   `json:"stepTimeout"`
-	StepScheduleTimeout string        `json:"stepScheduleTimeout"`
-	StepReadableId      pgtype.Text   `json:"stepReadableId"`
-	StepCustomUserData  []byte        `json:"stepCustomUserData"`
-	JobName             string        `json:"jobName"`
-	JobId               pgtype.UUID   `json:"jobId"`
-	JobKind             JobKind       `json:"jobKind"`
-	WorkflowVersionId   pgtype.UUID   `json:"workflowVersionId"`
-	ActionId            string        `json:"actionId"`
+	SRID                 pgtype.UUID      `json:"SR_id"`
+	SRRetryCount         int32            `json:"SR_retryCount"`
+	Input                []byte           `json:"input"`
+	Output               []byte           `json:"output"`
+	Error                pgtype.Text      `json:"error"`
+	Status               StepRunStatus    `json:"status"`
+	JobRunId             pgtype.UUID      `json:"jobRunId"`
+	JobRunStatus         JobRunStatus     `json:"jobRunStatus"`
+	JobRunStatus_2       JobRunStatus     `json:"jobRunStatus_2"`
+	WorkflowRunId        pgtype.UUID      `json:"workflowRunId"`
+	JobRunLookupData     []byte           `json:"jobRunLookupData"`
+	AdditionalMetadata   []byte           `json:"additionalMetadata"`
+	ChildIndex           pgtype.Int4      `json:"childIndex"`
+	ChildKey             pgtype.Text      `json:"childKey"`
+	ParentId             pgtype.UUID      `json:"parentId"`
+	JobRunId_2           pgtype.UUID      `json:"jobRunId_2"`
+	StepId               pgtype.UUID      `json:"stepId"`
+	StepRetries          int32            `json:"stepRetries"`
+	StepTimeout          pgtype.Text      `json:"stepTimeout"`
+	StepScheduleTimeout  string           `json:"stepScheduleTimeout"`
+	StepReadableId       pgtype.Text      `json:"stepReadableId"`
+	StepCustomUserData   []byte           `json:"stepCustomUserData"`
+	JobName              string           `json:"jobName"`
+	JobId                pgtype.UUID      `json:"jobId"`
+	JobKind              JobKind          `json:"jobKind"`
+	WorkflowVersionId    pgtype.UUID      `json:"workflowVersionId"`
+	ActionId             string           `json:"actionId"`
+	WorkflowRunTimeoutAt pgtype.Timestamp `json:"workflowRunTimeoutAt"`
+	ExpiresAt            pgtype.Timestamp `json:"expiresAt"`
 }
 
 func (q *Queries) GetStepRunBulkDataForEngine(ctx context.Context, db DBTX, arg GetStepRunBulkDataForEngineParams) ([]*GetStepRunBulkDataForEngineRow, error) {
@@ -1063,6 +1225,8 @@ func (q *Queries) GetStepRunBulkDataForEngine(ctx context.Context, db DBTX, arg
 			&i.JobKind,
 			&i.WorkflowVersionId,
 			&i.ActionId,
+			&i.WorkflowRunTimeoutAt,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1099,7 +1263,9 @@ SELECT
     wr."childIndex",
     wr."childKey",
     wr."parentId",
-    COALESCE(ec."exprCount", 0) AS "exprCount"
+    wr."timeoutAt" AS "workflowRunTimeoutAt",
+    COALESCE(ec."exprCount", 0) AS "exprCount",
+    wre."expiresAt"
 FROM
     "StepRun" sr
 JOIN
@@ -1111,6 +1277,8 @@ JOIN
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = $1::uuid
 LEFT JOIN
     expr_count ec ON sr."id" = ec."id"
+LEFT JOIN
+    "WorkflowRunExpiry" wre ON wre."workflowRunId" = wr."id"
 WHERE
     sr."id" = $2::uuid AND
     sr."tenantId" = $1::uuid
@@ -1122,15 +1290,17 @@ type GetStepRunDataForEngineParams struct {
 }
 
 type GetStepRunDataForEngineRow struct {
-	Input              []byte      `json:"input"`
-	Output             []byte      `json:"output"`
-	Error              pgtype.Text `json:"error"`
-	JobRunLookupData   []byte      `json:"jobRunLookupData"`
-	AdditionalMetadata []byte      `json:"additionalMetadata"`
-	ChildIndex         pgtype.Int4 `json:"childIndex"`
-	ChildKey           pgtype.Text `json:"childKey"`
-	ParentId           pgtype.UUID `json:"parentId"`
-	ExprCount          int64       `json:"exprCount"`
+	Input                []byte           `json:"input"`
+	Output               []byte           `json:"output"`
+	Error                pgtype.Text      `json:"error"`
+	JobRunLookupData     []byte           `json:"jobRunLookupData"`
+	AdditionalMetadata   []byte           `json:"additionalMetadata"`
+	ChildIndex           pgtype.Int4      `json:"childIndex"`
+	ChildKey             pgtype.Text      `json:"childKey"`
+	ParentId             pgtype.UUID      `json:"parentId"`
+	WorkflowRunTimeoutAt pgtype.Timestamp `json:"workflowRunTimeoutAt"`
+	ExprCount            int64            `json:"exprCount"`
+	ExpiresAt            pgtype.Timestamp `json:"expiresAt"`
 }
 
 func (q *Queries) GetStepRunDataForEngine(ctx context.Context, db DBTX, arg GetStepRunDataForEngineParams) (*GetStepRunDataForEngineRow, error) {
@@ -1145,7 +1315,37 @@ func (q *Queries) GetStepRunDataForEngine(ctx context.Context, db DBTX, arg GetS
 		&i.ChildIndex,
 		&i.ChildKey,
 		&i.ParentId,
+		&i.WorkflowRunTimeoutAt,
 		&i.ExprCount,
+		&i.ExpiresAt,
+	)
+	return &i, err
+}
+
+const getStepRunDeadLetter = `-- name: GetStepRunDeadLetter :one
+SELECT
+    "stepRunId", "tenantId", "createdAt", error, "retryCount"
+FROM
+    "StepRunDeadLetter"
+WHERE
+    "stepRunId" = $1::uuid AND
+    "tenantId" = $2::uuid
+`
+
+type GetStepRunDeadLetterParams struct {
+	Steprunid pgtype.UUID `json:"steprunid"`
+	Tenantid  pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) GetStepRunDeadLetter(ctx context.Context, db DBTX, arg GetStepRunDeadLetterParams) (*StepRunDeadLetter, error) {
+	row := db.QueryRow(ctx, getStepRunDeadLetter, arg.Steprunid, arg.Tenantid)
+	var i StepRunDeadLetter
+	err := row.Scan(
+		&i.StepRunId,
+		&i.TenantId,
+		&i.CreatedAt,
+		&i.Error,
+		&i.RetryCount,
 	)
 	return &i, err
 }
@@ -1174,7 +1374,11 @@ SELECT
     sr."tenantId" AS "SR_tenantId",
     sr."queue" AS "SR_queue",
     sr."order" AS "SR_order",
-    sqi."workerId" AS "SR_workerId",
+    -- step runs which released their slot are still running on the worker which recorded the release
+    COALESCE(
+        sqi."workerId",
+        CASE WHEN sr."semaphoreReleased" AND sr."status" = ANY(ARRAY['RUNNING', 'CANCELLING']::"StepRunStatus"[]) THEN sr."workerId" END
+    ) AS "SR_workerId",
     sr."tickerId" AS "SR_tickerId",
     sr."status" AS "SR_status",
     sr."requeueAfter" AS "SR_requeueAfter",
@@ -1201,6 +1405,8 @@ SELECT
     s."customUserData" AS "stepCustomUserData",
     s."retryBackoffFactor" AS "stepRetryBackoffFactor",
     s."retryMaxBackoff" AS "stepRetryMaxBackoff",
+    s."retryBackoffBase" AS "stepRetryBackoffBase",
+    s."retryBackoffJitter" AS "stepRetryBackoffJitter",
     j."name" AS "jobName",
     j."id" AS "jobId",
     j."kind" AS "jobKind",
@@ -1275,6 +1481,8 @@ type GetStepRunForEngineRow struct {
 	StepCustomUserData     []byte             `json:"stepCustomUserData"`
 	StepRetryBackoffFactor pgtype.Float8      `json:"stepRetryBackoffFactor"`
 	StepRetryMaxBackoff    pgtype.Int4        `json:"stepRetryMaxBackoff"`
+	StepRetryBackoffBase   pgtype.Int4        `json:"stepRetryBackoffBase"`
+	StepRetryBackoffJitter pgtype.Float8      `json:"stepRetryBackoffJitter"`
 	JobName                string             `json:"jobName"`
 	JobId                  pgtype.UUID        `json:"jobId"`
 	JobKind                JobKind            `json:"jobKind"`
@@ -1329,6 +1537,8 @@ func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepR
 			&i.StepCustomUserData,
 			&i.StepRetryBackoffFactor,
 			&i.StepRetryMaxBackoff,
+			&i.StepRetryBackoffBase,
+			&i.StepRetryBackoffJitter,
 			&i.JobName,
 			&i.JobId,
 			&i.JobKind,
@@ -1670,14 +1880,18 @@ JOIN
 WHERE
     parent_run."id" = $1::uuid
     AND child_run."status" = 'PENDING'
-    -- we look for whether the step run is startable by ensuring that all parent step runs have succeeded
-    AND NOT EXISTS (
-        SELECT 1
+    -- we look for whether the step run is startable by ensuring that enough parent step runs have succeeded,
+    -- which is all of them unless the step sets a join count
+    AND (
+        SELECT COUNT(*)
         FROM "_StepRunOrder" AS parent_order
         JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
         WHERE
             parent_order."B" = child_run."id"
-            AND parent_run."status" != 'SUCCEEDED'
+            AND parent_run."status" = 'SUCCEEDED'
+    ) >= COALESCE(
+        (SELECT s."joinCount" FROM "Step" AS s WHERE s."id" = child_run."stepId"),
+        (SELECT COUNT(*) FROM "_StepRunOrder" AS parent_order WHERE parent_order."B" = child_run."id")
     )
     -- AND we ensure that there's at least 2 parent step runs
     AND EXISTS (
@@ -1823,6 +2037,91 @@ func (q *Queries) ListStepRunArchives(ctx context.Context, db DBTX, arg ListStep
 	return items, nil
 }
 
+const listStepRunDeadLetters = `-- name: ListStepRunDeadLetters :many
+SELECT
+    dl."stepRunId",
+    dl."createdAt",
+    dl."error",
+    dl."retryCount",
+    sr."jobRunId",
+    jr."workflowRunId",
+    s."readableId" AS "stepReadableId",
+    s."actionId",
+    w."name" AS "workflowName"
+FROM
+    "StepRunDeadLetter" dl
+JOIN
+    "StepRun" sr ON sr."id" = dl."stepRunId"
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+JOIN
+    "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    dl."tenantId" = $1::uuid AND
+    sr."deletedAt" IS NULL AND
+    wr."deletedAt" IS NULL
+ORDER BY
+    dl."createdAt" DESC
+OFFSET
+    COALESCE($2, 0)
+LIMIT
+    COALESCE($3, 50)
+`
+
+type ListStepRunDeadLettersParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Offset   interface{} `json:"offset"`
+	Limit    interface{} `json:"limit"`
+}
+
+type ListStepRunDeadLettersRow struct {
+	StepRunId      pgtype.UUID      `json:"stepRunId"`
+	CreatedAt      pgtype.Timestamp `json:"createdAt"`
+	Error          pgtype.Text      `json:"error"`
+	RetryCount     int32            `json:"retryCount"`
+	JobRunId       pgtype.UUID      `json:"jobRunId"`
+	WorkflowRunId  pgtype.UUID      `json:"workflowRunId"`
+	StepReadableId pgtype.Text      `json:"stepReadableId"`
+	ActionId       string           `json:"actionId"`
+	WorkflowName   string           `json:"workflowName"`
+}
+
+func (q *Queries) ListStepRunDeadLetters(ctx context.Context, db DBTX, arg ListStepRunDeadLettersParams) ([]*ListStepRunDeadLettersRow, error) {
+	rows, err := db.Query(ctx, listStepRunDeadLetters, arg.Tenantid, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepRunDeadLettersRow
+	for rows.Next() {
+		var i ListStepRunDeadLettersRow
+		if err := rows.Scan(
+			&i.StepRunId,
+			&i.CreatedAt,
+			&i.Error,
+			&i.RetryCount,
+			&i.JobRunId,
+			&i.WorkflowRunId,
+			&i.StepReadableId,
+			&i.ActionId,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStepRunEvents = `-- name: ListStepRunEvents :many
 SELECT
     id, "timeFirstSeen", "timeLastSeen", "stepRunId", reason, severity, message, count, data, "workflowRunId"
@@ -2098,14 +2397,14 @@ WITH step_runs_on_inactive_workers AS (
         "Step" s ON sr."stepId" = s."id"
     WHERE
         w."tenantId" = $1::uuid
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'),
+        AND w."lastHeartbeatAt" < NOW() - $2::interval),
 step_runs_to_reassign AS (
     SELECT
         id, "tenantId", "scheduleTimeoutAt", "retryCount", "internalRetryCount", "workerId", "actionId", "stepId", "stepTimeout", "scheduleTimeout"
     FROM
         step_runs_on_inactive_workers
     WHERE
-        "internalRetryCount" < $2::int
+        "internalRetryCount" < $3::int
 ),
 step_runs_to_fail AS (
     SELECT
@@ -2113,7 +2412,7 @@ step_runs_to_fail AS (
     FROM
         step_runs_on_inactive_workers
     WHERE
-        "internalRetryCount" >= $2::int
+        "internalRetryCount" >= $3::int
 ),
 deleted_sqis AS (
     DELETE FROM
@@ -2190,8 +2489,9 @@ FROM
 `
 
 type ListStepRunsToReassignParams struct {
-	Tenantid              pgtype.UUID `json:"tenantid"`
-	Maxinternalretrycount int32       `json:"maxinternalretrycount"`
+	Tenantid              pgtype.UUID     `json:"tenantid"`
+	Heartbeattimeout      pgtype.Interval `json:"heartbeattimeout"`
+	Maxinternalretrycount int32           `json:"maxinternalretrycount"`
 }
 
 type ListStepRunsToReassignRow struct {
@@ -2202,7 +2502,7 @@ type ListStepRunsToReassignRow struct {
 }
 
 func (q *Queries) ListStepRunsToReassign(ctx context.Context, db DBTX, arg ListStepRunsToReassignParams) ([]*ListStepRunsToReassignRow, error) {
-	rows, err := db.Query(ctx, listStepRunsToReassign, arg.Tenantid, arg.Maxinternalretrycount)
+	rows, err := db.Query(ctx, listStepRunsToReassign, arg.Tenantid, arg.Heartbeattimeout, arg.Maxinternalretrycount)
 	if err != nil {
 		return nil, err
 	}
@@ -2260,20 +2560,23 @@ const manualReleaseSemaphore = `-- name: ManualReleaseSemaphore :exec
 UPDATE
     "StepRun"
 SET
-    -- note that workerId has already been removed via SemaphoreQueueItem
-    "semaphoreReleased" = true
+    -- the SemaphoreQueueItem has already been removed, so the worker is kept on the step run to
+    -- send timeouts and cancellations to it
+    "semaphoreReleased" = true,
+    "workerId" = $1::uuid
 WHERE
-    "id" = $1::uuid AND
-    "tenantId" = $2::uuid
+    "id" = $2::uuid AND
+    "tenantId" = $3::uuid
 `
 
 type ManualReleaseSemaphoreParams struct {
+	Workerid  pgtype.UUID `json:"workerid"`
 	Steprunid pgtype.UUID `json:"steprunid"`
 	Tenantid  pgtype.UUID `json:"tenantid"`
 }
 
 func (q *Queries) ManualReleaseSemaphore(ctx context.Context, db DBTX, arg ManualReleaseSemaphoreParams) error {
-	_, err := db.Exec(ctx, manualReleaseSemaphore, arg.Steprunid, arg.Tenantid)
+	_, err := db.Exec(ctx, manualReleaseSemaphore, arg.Workerid, arg.Steprunid, arg.Tenantid)
 	return err
 }
 
@@ -2387,14 +2690,19 @@ func (q *Queries) QueueStepRunBulkWithInput(ctx context.Context, db DBTX, arg Qu
 const refreshTimeoutBy = `-- name: RefreshTimeoutBy :one
 WITH step_run AS (
     SELECT
-        "id",
-        "retryCount",
-        "tenantId"
+        sr."id",
+        sr."retryCount",
+        sr."tenantId",
+        wr."timeoutAt" AS "workflowRunTimeoutAt"
     FROM
-        "StepRun"
+        "StepRun" sr
+    JOIN
+        "JobRun" jr ON jr."id" = sr."jobRunId"
+    JOIN
+        "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
     WHERE
-        "id" = $2::uuid AND
-        "tenantId" = $3::uuid
+        sr."id" = $2::uuid AND
+        sr."tenantId" = $3::uuid
 )
 INSERT INTO
     "TimeoutQueueItem" (
@@ -2407,14 +2715,21 @@ INSERT INTO
 SELECT
     sr."id",
     sr."retryCount",
-    NOW() + convert_duration_to_interval($1::text),
+    -- the timeout can't be refreshed past the timeout of the workflow run
+    LEAST(
+        NOW() + convert_duration_to_interval($1::text),
+        sr."workflowRunTimeoutAt"
+    ),
     sr."tenantId",
     true
 FROM
     step_run sr
 ON CONFLICT ("stepRunId", "retryCount") DO UPDATE
 SET
-    "timeoutAt" = "TimeoutQueueItem"."timeoutAt" + convert_duration_to_interval($1::text)
+    "timeoutAt" = LEAST(
+        "TimeoutQueueItem"."timeoutAt" + convert_duration_to_interval($1::text),
+        (SELECT "workflowRunTimeoutAt" FROM step_run)
+    )
 RETURNING "TimeoutQueueItem"."timeoutAt"
 `
 
@@ -2596,7 +2911,7 @@ SET
     "error" = NULL
 WHERE
     "id" =  $1::uuid
-RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder"
+RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", detached, "timeoutAt"
 `
 
 func (q *Queries) ReplayStepRunResetWorkflowRun(ctx context.Context, db DBTX, workflowrunid pgtype.UUID) (*WorkflowRun, error) {
@@ -2623,6 +2938,8 @@ func (q *Queries) ReplayStepRunResetWorkflowRun(ctx context.Context, db DBTX, wo
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Detached,
+		&i.TimeoutAt,
 	)
 	return &i, err
 }
@@ -2983,11 +3300,19 @@ WITH input AS (
         sr."id",
         sr."retryCount",
         sr."tenantId",
-        CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout") AS "timeoutAt"
+        -- step runs never time out after the timeout of their workflow run
+        LEAST(
+            CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout"),
+            wr."timeoutAt"
+        ) AS "timeoutAt"
     FROM
         input
     JOIN
         "StepRun" sr ON sr."id" = input."id"
+    JOIN
+        "JobRun" jr ON jr."id" = sr."jobRunId"
+    JOIN
+        "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
     ORDER BY sr."id"
 ), assigned_step_runs AS (
     INSERT INTO "SemaphoreQueueItem" (
@@ -0,0 +1,18 @@
+-- name: ListTablePartitions :many
+SELECT
+    parent.relname::text AS "parent",
+    child.relname::text AS "name",
+    (regexp_match(pg_get_expr(child.relpartbound, child.oid), 'TO \(''([^'']+)''\)'))[1]::timestamp AS "rangeEnd"
+FROM
+    pg_inherits i
+JOIN
+    pg_class parent ON parent.oid = i.inhparent
+JOIN
+    pg_class child ON child.oid = i.inhrelid
+JOIN
+    pg_namespace n ON n.oid = parent.relnamespace
+WHERE
+    n.nspname = current_schema()
+    AND parent.relname = ANY(@parents::text[])
+ORDER BY
+    "parent", "rangeEnd" ASC NULLS FIRST;
@@ -96,3 +96,10 @@ FROM "Worker"
 WHERE "tenantId" = @tenantId::uuid
 AND "lastHeartbeatAt" >= NOW() - '30 seconds'::INTERVAL
 AND "isActive" = true;
+
+-- name: CountTenantQueuedWorkflowRuns :one
+SELECT COUNT(*) AS "count"
+FROM "WorkflowRun"
+WHERE "tenantId" = @tenantId::uuid
+AND "status" IN ('PENDING', 'QUEUED')
+AND "deletedAt" IS NULL;
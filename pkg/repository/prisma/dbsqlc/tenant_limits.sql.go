@@ -11,6 +11,21 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countTenantQueuedWorkflowRuns = `-- name: CountTenantQueuedWorkflowRuns :one
+SELECT COUNT(*) AS "count"
+FROM "WorkflowRun"
+WHERE "tenantId" = $1::uuid
+AND "status" IN ('PENDING', 'QUEUED')
+AND "deletedAt" IS NULL
+`
+
+func (q *Queries) CountTenantQueuedWorkflowRuns(ctx context.Context, db DBTX, tenantid pgtype.UUID) (int64, error) {
+	row := db.QueryRow(ctx, countTenantQueuedWorkflowRuns, tenantid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countTenantWorkers = `-- name: CountTenantWorkers :one
 SELECT COUNT(distinct id) AS "count"
 FROM "Worker"
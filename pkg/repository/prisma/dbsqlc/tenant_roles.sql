@@ -0,0 +1,73 @@
+-- name: ListTenantRoles :many
+SELECT
+    *
+FROM
+    "TenantRole"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "name" ASC;
+
+-- name: GetTenantRoleById :one
+SELECT
+    *
+FROM
+    "TenantRole"
+WHERE
+    "id" = @id::uuid;
+
+-- name: CreateTenantRole :one
+INSERT INTO "TenantRole" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "name",
+    "description",
+    "permissions"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @name::text,
+    sqlc.narg('description')::text,
+    @permissions::text[]
+) RETURNING *;
+
+-- name: UpdateTenantRole :one
+UPDATE
+    "TenantRole"
+SET
+    "name" = COALESCE(sqlc.narg('name')::text, "name"),
+    "description" = COALESCE(sqlc.narg('description')::text, "description"),
+    "permissions" = COALESCE(sqlc.narg('permissions')::text[], "permissions"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
+-- name: DeleteTenantRole :exec
+DELETE FROM
+    "TenantRole"
+WHERE
+    "id" = @id::uuid;
+
+-- name: UpdateTenantMemberCustomRole :exec
+UPDATE
+    "TenantMember"
+SET
+    "customRoleId" = sqlc.narg('customRoleId')::uuid,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @memberId::uuid;
+
+-- name: GetTenantMemberCustomRole :one
+SELECT
+    r.*
+FROM
+    "TenantMember" m
+JOIN
+    "TenantRole" r ON r."id" = m."customRoleId"
+WHERE
+    m."id" = @memberId::uuid;
@@ -86,6 +86,16 @@ FROM
 WHERE
     "id" = sqlc.arg('id')::uuid;
 
+-- name: UpdateTenantPaused :one
+UPDATE
+    "Tenant"
+SET
+    "isPaused" = @isPaused::boolean,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
 -- name: GetTenantAlertingSettings :one
 SELECT
     *
@@ -126,6 +136,36 @@ WHERE
     "tenantId" = sqlc.arg('tenantId')::uuid
 RETURNING *;
 
+-- name: UpsertTenantAlertingSettings :one
+INSERT INTO "TenantAlertingSettings" (
+    "id",
+    "tenantId",
+    "maxFrequency",
+    "enableExpiringTokenAlerts",
+    "enableWorkflowRunFailureAlerts",
+    "enableTenantResourceLimitAlerts",
+    "workflowRunFailureThreshold",
+    "enableWorkerOfflineAlerts"
+) VALUES (
+    gen_random_uuid(),
+    @tenantId::uuid,
+    COALESCE(sqlc.narg('maxFrequency')::text, '1h'),
+    COALESCE(sqlc.narg('enableExpiringTokenAlerts')::boolean, true),
+    COALESCE(sqlc.narg('enableWorkflowRunFailureAlerts')::boolean, false),
+    COALESCE(sqlc.narg('enableTenantResourceLimitAlerts')::boolean, true),
+    COALESCE(sqlc.narg('workflowRunFailureThreshold')::integer, 1),
+    COALESCE(sqlc.narg('enableWorkerOfflineAlerts')::boolean, false)
+) ON CONFLICT ("tenantId") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "maxFrequency" = COALESCE(sqlc.narg('maxFrequency')::text, "TenantAlertingSettings"."maxFrequency"),
+    "enableExpiringTokenAlerts" = COALESCE(sqlc.narg('enableExpiringTokenAlerts')::boolean, "TenantAlertingSettings"."enableExpiringTokenAlerts"),
+    "enableWorkflowRunFailureAlerts" = COALESCE(sqlc.narg('enableWorkflowRunFailureAlerts')::boolean, "TenantAlertingSettings"."enableWorkflowRunFailureAlerts"),
+    "enableTenantResourceLimitAlerts" = COALESCE(sqlc.narg('enableTenantResourceLimitAlerts')::boolean, "TenantAlertingSettings"."enableTenantResourceLimitAlerts"),
+    "workflowRunFailureThreshold" = COALESCE(sqlc.narg('workflowRunFailureThreshold')::integer, "TenantAlertingSettings"."workflowRunFailureThreshold"),
+    "enableWorkerOfflineAlerts" = COALESCE(sqlc.narg('enableWorkerOfflineAlerts')::boolean, "TenantAlertingSettings"."enableWorkerOfflineAlerts")
+RETURNING *;
+
 -- name: GetTenantTotalQueueMetrics :one
 WITH valid_workflow_runs AS (
     SELECT
@@ -99,7 +99,7 @@ VALUES (
     ),
     COALESCE($4::text, '720h')
 )
-RETURNING id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "isPaused"
 `
 
 type CreateTenantParams struct {
@@ -130,6 +130,7 @@ func (q *Queries) CreateTenant(ctx context.Context, db DBTX, arg CreateTenantPar
 		&i.WorkerPartitionId,
 		&i.DataRetentionPeriod,
 		&i.SchedulerPartitionId,
+		&i.IsPaused,
 	)
 	return &i, err
 }
@@ -137,7 +138,7 @@ func (q *Queries) CreateTenant(ctx context.Context, db DBTX, arg CreateTenantPar
 const createTenantAlertingSettings = `-- name: CreateTenantAlertingSettings :one
 INSERT INTO "TenantAlertingSettings" ("id", "tenantId")
 VALUES (gen_random_uuid(), $1::uuid)
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts", "workflowRunFailureThreshold", "enableWorkerOfflineAlerts", "workerOfflineAlertsCheckedAt"
 `
 
 func (q *Queries) CreateTenantAlertingSettings(ctx context.Context, db DBTX, tenantid pgtype.UUID) (*TenantAlertingSettings, error) {
@@ -155,6 +156,9 @@ func (q *Queries) CreateTenantAlertingSettings(ctx context.Context, db DBTX, ten
 		&i.EnableExpiringTokenAlerts,
 		&i.EnableWorkflowRunFailureAlerts,
 		&i.EnableTenantResourceLimitAlerts,
+		&i.WorkflowRunFailureThreshold,
+		&i.EnableWorkerOfflineAlerts,
+		&i.WorkerOfflineAlertsCheckedAt,
 	)
 	return &i, err
 }
@@ -342,7 +346,7 @@ func (q *Queries) GetSlackWebhooks(ctx context.Context, db DBTX, tenantid pgtype
 
 const getTenantAlertingSettings = `-- name: GetTenantAlertingSettings :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts"
+    id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts", "workflowRunFailureThreshold", "enableWorkerOfflineAlerts", "workerOfflineAlertsCheckedAt"
 FROM
     "TenantAlertingSettings" as tenantAlertingSettings
 WHERE
@@ -364,13 +368,16 @@ func (q *Queries) GetTenantAlertingSettings(ctx context.Context, db DBTX, tenant
 		&i.EnableExpiringTokenAlerts,
 		&i.EnableWorkflowRunFailureAlerts,
 		&i.EnableTenantResourceLimitAlerts,
+		&i.WorkflowRunFailureThreshold,
+		&i.EnableWorkerOfflineAlerts,
+		&i.WorkerOfflineAlertsCheckedAt,
 	)
 	return &i, err
 }
 
 const getTenantByID = `-- name: GetTenantByID :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId"
+    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "isPaused"
 FROM
     "Tenant" as tenants
 WHERE
@@ -393,6 +400,7 @@ func (q *Queries) GetTenantByID(ctx context.Context, db DBTX, id pgtype.UUID) (*
 		&i.WorkerPartitionId,
 		&i.DataRetentionPeriod,
 		&i.SchedulerPartitionId,
+		&i.IsPaused,
 	)
 	return &i, err
 }
@@ -535,7 +543,7 @@ func (q *Queries) GetTenantWorkflowQueueMetrics(ctx context.Context, db DBTX, ar
 
 const listTenants = `-- name: ListTenants :many
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId"
+    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "isPaused"
 FROM
     "Tenant" as tenants
 `
@@ -562,6 +570,7 @@ func (q *Queries) ListTenants(ctx context.Context, db DBTX) ([]*Tenant, error) {
 			&i.WorkerPartitionId,
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
+			&i.IsPaused,
 		); err != nil {
 			return nil, err
 		}
@@ -575,7 +584,7 @@ func (q *Queries) ListTenants(ctx context.Context, db DBTX) ([]*Tenant, error) {
 
 const listTenantsByControllerPartitionId = `-- name: ListTenantsByControllerPartitionId :many
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId"
+    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "isPaused"
 FROM
     "Tenant" as tenants
 WHERE
@@ -604,6 +613,7 @@ func (q *Queries) ListTenantsByControllerPartitionId(ctx context.Context, db DBT
 			&i.WorkerPartitionId,
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
+			&i.IsPaused,
 		); err != nil {
 			return nil, err
 		}
@@ -617,7 +627,7 @@ func (q *Queries) ListTenantsByControllerPartitionId(ctx context.Context, db DBT
 
 const listTenantsBySchedulerPartitionId = `-- name: ListTenantsBySchedulerPartitionId :many
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId"
+    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "isPaused"
 FROM
     "Tenant" as tenants
 WHERE
@@ -646,6 +656,7 @@ func (q *Queries) ListTenantsBySchedulerPartitionId(ctx context.Context, db DBTX
 			&i.WorkerPartitionId,
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
+			&i.IsPaused,
 		); err != nil {
 			return nil, err
 		}
@@ -667,7 +678,7 @@ WITH update_partition AS (
         "id" = $1::text
 )
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId"
+    id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "isPaused"
 FROM
     "Tenant" as tenants
 WHERE
@@ -696,6 +707,7 @@ func (q *Queries) ListTenantsByTenantWorkerPartitionId(ctx context.Context, db D
 			&i.WorkerPartitionId,
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
+			&i.IsPaused,
 		); err != nil {
 			return nil, err
 		}
@@ -982,7 +994,7 @@ SET
     "lastAlertedAt" = COALESCE($1::timestamp, "lastAlertedAt")
 WHERE
     "tenantId" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts", "workflowRunFailureThreshold", "enableWorkerOfflineAlerts", "workerOfflineAlertsCheckedAt"
 `
 
 type UpdateTenantAlertingSettingsParams struct {
@@ -1005,6 +1017,117 @@ func (q *Queries) UpdateTenantAlertingSettings(ctx context.Context, db DBTX, arg
 		&i.EnableExpiringTokenAlerts,
 		&i.EnableWorkflowRunFailureAlerts,
 		&i.EnableTenantResourceLimitAlerts,
+		&i.WorkflowRunFailureThreshold,
+		&i.EnableWorkerOfflineAlerts,
+		&i.WorkerOfflineAlertsCheckedAt,
+	)
+	return &i, err
+}
+
+const updateTenantPaused = `-- name: UpdateTenantPaused :one
+UPDATE
+    "Tenant"
+SET
+    "isPaused" = $1::boolean,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $2::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "isPaused"
+`
+
+type UpdateTenantPausedParams struct {
+	Ispaused bool        `json:"ispaused"`
+	ID       pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateTenantPaused(ctx context.Context, db DBTX, arg UpdateTenantPausedParams) (*Tenant, error) {
+	row := db.QueryRow(ctx, updateTenantPaused, arg.Ispaused, arg.ID)
+	var i Tenant
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Name,
+		&i.Slug,
+		&i.AnalyticsOptOut,
+		&i.AlertMemberEmails,
+		&i.ControllerPartitionId,
+		&i.WorkerPartitionId,
+		&i.DataRetentionPeriod,
+		&i.SchedulerPartitionId,
+		&i.IsPaused,
+	)
+	return &i, err
+}
+
+const upsertTenantAlertingSettings = `-- name: UpsertTenantAlertingSettings :one
+INSERT INTO "TenantAlertingSettings" (
+    "id",
+    "tenantId",
+    "maxFrequency",
+    "enableExpiringTokenAlerts",
+    "enableWorkflowRunFailureAlerts",
+    "enableTenantResourceLimitAlerts",
+    "workflowRunFailureThreshold",
+    "enableWorkerOfflineAlerts"
+) VALUES (
+    gen_random_uuid(),
+    $1::uuid,
+    COALESCE($2::text, '1h'),
+    COALESCE($3::boolean, true),
+    COALESCE($4::boolean, false),
+    COALESCE($5::boolean, true),
+    COALESCE($6::integer, 1),
+    COALESCE($7::boolean, false)
+) ON CONFLICT ("tenantId") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "maxFrequency" = COALESCE($2::text, "TenantAlertingSettings"."maxFrequency"),
+    "enableExpiringTokenAlerts" = COALESCE($3::boolean, "TenantAlertingSettings"."enableExpiringTokenAlerts"),
+    "enableWorkflowRunFailureAlerts" = COALESCE($4::boolean, "TenantAlertingSettings"."enableWorkflowRunFailureAlerts"),
+    "enableTenantResourceLimitAlerts" = COALESCE($5::boolean, "TenantAlertingSettings"."enableTenantResourceLimitAlerts"),
+    "workflowRunFailureThreshold" = COALESCE($6::integer, "TenantAlertingSettings"."workflowRunFailureThreshold"),
+    "enableWorkerOfflineAlerts" = COALESCE($7::boolean, "TenantAlertingSettings"."enableWorkerOfflineAlerts")
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "maxFrequency", "lastAlertedAt", "tickerId", "enableExpiringTokenAlerts", "enableWorkflowRunFailureAlerts", "enableTenantResourceLimitAlerts", "workflowRunFailureThreshold", "enableWorkerOfflineAlerts", "workerOfflineAlertsCheckedAt"
+`
+
+type UpsertTenantAlertingSettingsParams struct {
+	Tenantid                        pgtype.UUID `json:"tenantid"`
+	MaxFrequency                    pgtype.Text `json:"maxFrequency"`
+	EnableExpiringTokenAlerts       pgtype.Bool `json:"enableExpiringTokenAlerts"`
+	EnableWorkflowRunFailureAlerts  pgtype.Bool `json:"enableWorkflowRunFailureAlerts"`
+	EnableTenantResourceLimitAlerts pgtype.Bool `json:"enableTenantResourceLimitAlerts"`
+	WorkflowRunFailureThreshold     pgtype.Int4 `json:"workflowRunFailureThreshold"`
+	EnableWorkerOfflineAlerts       pgtype.Bool `json:"enableWorkerOfflineAlerts"`
+}
+
+func (q *Queries) UpsertTenantAlertingSettings(ctx context.Context, db DBTX, arg UpsertTenantAlertingSettingsParams) (*TenantAlertingSettings, error) {
+	row := db.QueryRow(ctx, upsertTenantAlertingSettings,
+		arg.Tenantid,
+		arg.MaxFrequency,
+		arg.EnableExpiringTokenAlerts,
+		arg.EnableWorkflowRunFailureAlerts,
+		arg.EnableTenantResourceLimitAlerts,
+		arg.WorkflowRunFailureThreshold,
+		arg.EnableWorkerOfflineAlerts,
+	)
+	var i TenantAlertingSettings
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.MaxFrequency,
+		&i.LastAlertedAt,
+		&i.TickerId,
+		&i.EnableExpiringTokenAlerts,
+		&i.EnableWorkflowRunFailureAlerts,
+		&i.EnableTenantResourceLimitAlerts,
+		&i.WorkflowRunFailureThreshold,
+		&i.EnableWorkerOfflineAlerts,
+		&i.WorkerOfflineAlertsCheckedAt,
 	)
 	return &i, err
 }
@@ -141,6 +141,14 @@ WHERE
     cronSchedules."parentId" = active_cron_schedules."parentId"
 RETURNING cronSchedules.*, active_cron_schedules."workflowVersionId", active_cron_schedules."tenantId";
 
+-- name: UpdateCronLastFiredAt :exec
+UPDATE
+    "WorkflowTriggerCronRef"
+SET
+    "lastFiredAt" = @lastFiredAt::timestamp
+WHERE
+    "id" = @id::uuid;
+
 -- name: PollScheduledWorkflows :many
 -- Finds workflows that are either past their execution time or will be in the next 5 seconds and assigns them
 -- to a ticker, or finds workflows that were assigned to a ticker that is no longer active
@@ -218,16 +226,25 @@ failed_run_count_by_tenant AS (
         "WorkflowRun" as workflowRun
     JOIN
         active_tenant_alerts ON active_tenant_alerts."tenantId" = workflowRun."tenantId"
+    JOIN
+        "WorkflowVersion" as workflowVersion ON workflowVersion."id" = workflowRun."workflowVersionId"
     WHERE
-        "status" = 'FAILED'
+        workflowRun."status" = 'FAILED'
         AND workflowRun."deletedAt" IS NULL
+        -- only count failures in the alerting window which weren't part of the last alert
+        AND workflowRun."finishedAt" >= NOW() - convert_duration_to_interval(active_tenant_alerts."maxFrequency")
         AND (
-            (
-                "lastAlertedAt" IS NULL AND
-                workflowRun."finishedAt" >= NOW() - convert_duration_to_interval(active_tenant_alerts."maxFrequency")
-            ) OR
+            "lastAlertedAt" IS NULL OR
             workflowRun."finishedAt" >= "lastAlertedAt"
         )
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "TenantAlertMuteRule" as muteRule
+            WHERE
+                muteRule."tenantId" = workflowRun."tenantId"
+                AND muteRule."workflowId" = workflowVersion."workflowId"
+                AND (muteRule."mutedUntil" IS NULL OR muteRule."mutedUntil" > NOW())
+        )
     GROUP BY workflowRun."tenantId"
 )
 UPDATE
@@ -239,10 +256,56 @@ FROM
     active_tenant_alerts
 WHERE
     alerts."id" = active_tenant_alerts."id" AND
-    alerts."tenantId" IN (SELECT "tenantId" FROM failed_run_count_by_tenant WHERE "failedWorkflowRunCount" > 0)
+    alerts."tenantId" IN (
+        SELECT "tenantId"
+        FROM failed_run_count_by_tenant
+        WHERE "failedWorkflowRunCount" >= active_tenant_alerts."workflowRunFailureThreshold"
+    )
 RETURNING alerts.*, active_tenant_alerts."lastAlertedAt" AS "prevLastAlertedAt";
 
 
+-- name: PollWorkerOfflineAlerts :many
+-- Finds workers which stopped sending heartbeats since the last poll, for tenants with worker offline alerts
+-- enabled. The first poll of a tenant only sets the time it was checked.
+WITH active_tenant_alerts AS (
+    SELECT
+        alerts."id",
+        alerts."tenantId",
+        COALESCE(alerts."workerOfflineAlertsCheckedAt", NOW()) AS "checkedAt"
+    FROM
+        "TenantAlertingSettings" as alerts
+    WHERE
+        alerts."enableWorkerOfflineAlerts" = true
+    FOR UPDATE SKIP LOCKED
+),
+updated_alerts AS (
+    UPDATE
+        "TenantAlertingSettings" as alerts
+    SET
+        "workerOfflineAlertsCheckedAt" = NOW()
+    FROM
+        active_tenant_alerts
+    WHERE
+        alerts."id" = active_tenant_alerts."id"
+)
+SELECT
+    workers."id",
+    workers."tenantId",
+    workers."name",
+    workers."lastHeartbeatAt"
+FROM
+    "Worker" as workers
+JOIN
+    active_tenant_alerts ON active_tenant_alerts."tenantId" = workers."tenantId"
+WHERE
+    workers."deletedAt" IS NULL
+    -- workers are offline once they haven't sent a heartbeat within the heartbeat timeout
+    AND workers."lastHeartbeatAt" >= active_tenant_alerts."checkedAt" - @heartbeatTimeout::interval
+    AND workers."lastHeartbeatAt" < NOW() - @heartbeatTimeout::interval
+ORDER BY
+    workers."tenantId",
+    workers."lastHeartbeatAt" DESC;
+
 -- name: PollExpiringTokens :many
 WITH expiring_tokens AS (
     SELECT
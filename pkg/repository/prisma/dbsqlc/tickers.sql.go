@@ -227,7 +227,7 @@ FROM
     active_cron_schedules
 WHERE
     cronSchedules."parentId" = active_cron_schedules."parentId"
-RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, active_cron_schedules."workflowVersionId", active_cron_schedules."tenantId"
+RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, cronschedules."catchUp", cronschedules."lastFiredAt", active_cron_schedules."workflowVersionId", active_cron_schedules."tenantId"
 `
 
 type PollCronSchedulesRow struct {
@@ -243,6 +243,8 @@ type PollCronSchedulesRow struct {
 	Name               pgtype.Text                   `json:"name"`
 	ID                 pgtype.UUID                   `json:"id"`
 	Method             WorkflowTriggerCronRefMethods `json:"method"`
+	CatchUp            bool                          `json:"catchUp"`
+	LastFiredAt        pgtype.Timestamp              `json:"lastFiredAt"`
 	WorkflowVersionId  pgtype.UUID                   `json:"workflowVersionId"`
 	TenantId           pgtype.UUID                   `json:"tenantId"`
 }
@@ -269,6 +271,8 @@ func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid pgtyp
 			&i.Name,
 			&i.ID,
 			&i.Method,
+			&i.CatchUp,
+			&i.LastFiredAt,
 			&i.WorkflowVersionId,
 			&i.TenantId,
 		); err != nil {
@@ -533,7 +537,7 @@ func (q *Queries) PollScheduledWorkflows(ctx context.Context, db DBTX, tickerid
 const pollTenantAlerts = `-- name: PollTenantAlerts :many
 WITH active_tenant_alerts AS (
     SELECT
-        alerts.id, alerts."createdAt", alerts."updatedAt", alerts."deletedAt", alerts."tenantId", alerts."maxFrequency", alerts."lastAlertedAt", alerts."tickerId", alerts."enableExpiringTokenAlerts", alerts."enableWorkflowRunFailureAlerts", alerts."enableTenantResourceLimitAlerts"
+        alerts.id, alerts."createdAt", alerts."updatedAt", alerts."deletedAt", alerts."tenantId", alerts."maxFrequency", alerts."lastAlertedAt", alerts."tickerId", alerts."enableExpiringTokenAlerts", alerts."enableWorkflowRunFailureAlerts", alerts."enableTenantResourceLimitAlerts", alerts."workflowRunFailureThreshold", alerts."enableWorkerOfflineAlerts", alerts."workerOfflineAlertsCheckedAt"
     FROM
         "TenantAlertingSettings" as alerts
     WHERE
@@ -549,16 +553,25 @@ failed_run_count_by_tenant AS (
         "WorkflowRun" as workflowRun
     JOIN
         active_tenant_alerts ON active_tenant_alerts."tenantId" = workflowRun."tenantId"
+    JOIN
+        "WorkflowVersion" as workflowVersion ON workflowVersion."id" = workflowRun."workflowVersionId"
     WHERE
-        "status" = 'FAILED'
+        workflowRun."status" = 'FAILED'
         AND workflowRun."deletedAt" IS NULL
+        -- only count failures in the alerting window which weren't part of the last alert
+        AND workflowRun."finishedAt" >= NOW() - convert_duration_to_interval(active_tenant_alerts."maxFrequency")
         AND (
-            (
-                "lastAlertedAt" IS NULL AND
-                workflowRun."finishedAt" >= NOW() - convert_duration_to_interval(active_tenant_alerts."maxFrequency")
-            ) OR
+            "lastAlertedAt" IS NULL OR
             workflowRun."finishedAt" >= "lastAlertedAt"
         )
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "TenantAlertMuteRule" as muteRule
+            WHERE
+                muteRule."tenantId" = workflowRun."tenantId"
+                AND muteRule."workflowId" = workflowVersion."workflowId"
+                AND (muteRule."mutedUntil" IS NULL OR muteRule."mutedUntil" > NOW())
+        )
     GROUP BY workflowRun."tenantId"
 )
 UPDATE
@@ -570,8 +583,12 @@ FROM
     active_tenant_alerts
 WHERE
     alerts."id" = active_tenant_alerts."id" AND
-    alerts."tenantId" IN (SELECT "tenantId" FROM failed_run_count_by_tenant WHERE "failedWorkflowRunCount" > 0)
-RETURNING alerts.id, alerts."createdAt", alerts."updatedAt", alerts."deletedAt", alerts."tenantId", alerts."maxFrequency", alerts."lastAlertedAt", alerts."tickerId", alerts."enableExpiringTokenAlerts", alerts."enableWorkflowRunFailureAlerts", alerts."enableTenantResourceLimitAlerts", active_tenant_alerts."lastAlertedAt" AS "prevLastAlertedAt"
+    alerts."tenantId" IN (
+        SELECT "tenantId"
+        FROM failed_run_count_by_tenant
+        WHERE "failedWorkflowRunCount" >= active_tenant_alerts."workflowRunFailureThreshold"
+    )
+RETURNING alerts.id, alerts."createdAt", alerts."updatedAt", alerts."deletedAt", alerts."tenantId", alerts."maxFrequency", alerts."lastAlertedAt", alerts."tickerId", alerts."enableExpiringTokenAlerts", alerts."enableWorkflowRunFailureAlerts", alerts."enableTenantResourceLimitAlerts", alerts."workflowRunFailureThreshold", alerts."enableWorkerOfflineAlerts", alerts."workerOfflineAlertsCheckedAt", active_tenant_alerts."lastAlertedAt" AS "prevLastAlertedAt"
 `
 
 type PollTenantAlertsRow struct {
@@ -586,6 +603,9 @@ type PollTenantAlertsRow struct {
 	EnableExpiringTokenAlerts       bool             `json:"enableExpiringTokenAlerts"`
 	EnableWorkflowRunFailureAlerts  bool             `json:"enableWorkflowRunFailureAlerts"`
 	EnableTenantResourceLimitAlerts bool             `json:"enableTenantResourceLimitAlerts"`
+	WorkflowRunFailureThreshold     int32            `json:"workflowRunFailureThreshold"`
+	EnableWorkerOfflineAlerts       bool             `json:"enableWorkerOfflineAlerts"`
+	WorkerOfflineAlertsCheckedAt    pgtype.Timestamp `json:"workerOfflineAlertsCheckedAt"`
 	PrevLastAlertedAt               pgtype.Timestamp `json:"prevLastAlertedAt"`
 }
 
@@ -611,6 +631,9 @@ func (q *Queries) PollTenantAlerts(ctx context.Context, db DBTX, tickerid pgtype
 			&i.EnableExpiringTokenAlerts,
 			&i.EnableWorkflowRunFailureAlerts,
 			&i.EnableTenantResourceLimitAlerts,
+			&i.WorkflowRunFailureThreshold,
+			&i.EnableWorkerOfflineAlerts,
+			&i.WorkerOfflineAlertsCheckedAt,
 			&i.PrevLastAlertedAt,
 		); err != nil {
 			return nil, err
@@ -768,6 +791,81 @@ func (q *Queries) PollUnresolvedFailedStepRuns(ctx context.Context, db DBTX) ([]
 	return items, nil
 }
 
+const pollWorkerOfflineAlerts = `-- name: PollWorkerOfflineAlerts :many
+WITH active_tenant_alerts AS (
+    SELECT
+        alerts."id",
+        alerts."tenantId",
+        COALESCE(alerts."workerOfflineAlertsCheckedAt", NOW()) AS "checkedAt"
+    FROM
+        "TenantAlertingSettings" as alerts
+    WHERE
+        alerts."enableWorkerOfflineAlerts" = true
+    FOR UPDATE SKIP LOCKED
+),
+updated_alerts AS (
+    UPDATE
+        "TenantAlertingSettings" as alerts
+    SET
+        "workerOfflineAlertsCheckedAt" = NOW()
+    FROM
+        active_tenant_alerts
+    WHERE
+        alerts."id" = active_tenant_alerts."id"
+)
+SELECT
+    workers."id",
+    workers."tenantId",
+    workers."name",
+    workers."lastHeartbeatAt"
+FROM
+    "Worker" as workers
+JOIN
+    active_tenant_alerts ON active_tenant_alerts."tenantId" = workers."tenantId"
+WHERE
+    workers."deletedAt" IS NULL
+    -- workers are offline once they haven't sent a heartbeat within the heartbeat timeout
+    AND workers."lastHeartbeatAt" >= active_tenant_alerts."checkedAt" - $1::interval
+    AND workers."lastHeartbeatAt" < NOW() - $1::interval
+ORDER BY
+    workers."tenantId",
+    workers."lastHeartbeatAt" DESC
+`
+
+type PollWorkerOfflineAlertsRow struct {
+	ID              pgtype.UUID      `json:"id"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	Name            string           `json:"name"`
+	LastHeartbeatAt pgtype.Timestamp `json:"lastHeartbeatAt"`
+}
+
+// Finds workers which stopped sending heartbeats since the last poll, for tenants with worker offline alerts
+// enabled. The first poll of a tenant only sets the time it was checked.
+func (q *Queries) PollWorkerOfflineAlerts(ctx context.Context, db DBTX, heartbeattimeout pgtype.Interval) ([]*PollWorkerOfflineAlertsRow, error) {
+	rows, err := db.Query(ctx, pollWorkerOfflineAlerts, heartbeattimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PollWorkerOfflineAlertsRow
+	for rows.Next() {
+		var i PollWorkerOfflineAlertsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantId,
+			&i.Name,
+			&i.LastHeartbeatAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setTickersInactive = `-- name: SetTickersInactive :many
 UPDATE
     "Ticker" as tickers
@@ -809,6 +907,25 @@ func (q *Queries) SetTickersInactive(ctx context.Context, db DBTX, ids []pgtype.
 	return items, nil
 }
 
+const updateCronLastFiredAt = `-- name: UpdateCronLastFiredAt :exec
+UPDATE
+    "WorkflowTriggerCronRef"
+SET
+    "lastFiredAt" = $1::timestamp
+WHERE
+    "id" = $2::uuid
+`
+
+type UpdateCronLastFiredAtParams struct {
+	LastFiredAt pgtype.Timestamp `json:"lastFiredAt"`
+	ID          pgtype.UUID      `json:"id"`
+}
+
+func (q *Queries) UpdateCronLastFiredAt(ctx context.Context, db DBTX, arg UpdateCronLastFiredAtParams) error {
+	_, err := db.Exec(ctx, updateCronLastFiredAt, arg.LastFiredAt, arg.ID)
+	return err
+}
+
 const updateTicker = `-- name: UpdateTicker :one
 UPDATE
     "Ticker" as tickers
@@ -0,0 +1,47 @@
+-- name: ListExpiringUserOAuths :many
+SELECT
+    *
+FROM
+    "UserOAuth"
+WHERE
+    "refreshToken" IS NOT NULL
+    -- tokens which have already expired are refreshed as well, since refresh tokens outlive access tokens
+    AND "expiresAt" <= @expiresBefore::timestamp
+    -- tokens whose refresh failed, for example because they were revoked, are retried less often
+    AND ("refreshFailedAt" IS NULL OR "refreshFailedAt" <= @failedBefore::timestamp)
+ORDER BY
+    "refreshFailedAt" ASC NULLS FIRST,
+    "expiresAt" ASC
+LIMIT
+    COALESCE(sqlc.narg('limit')::int, 100);
+
+-- name: GetUserOAuthForUpdate :one
+SELECT
+    *
+FROM
+    "UserOAuth"
+WHERE
+    "userId" = @userId::uuid
+    AND "provider" = @provider::text
+FOR UPDATE;
+
+-- name: UpdateUserOAuthTokens :one
+UPDATE
+    "UserOAuth"
+SET
+    "accessToken" = @accessToken::bytea,
+    "refreshToken" = COALESCE(sqlc.narg('refreshToken')::bytea, "refreshToken"),
+    "expiresAt" = sqlc.narg('expiresAt')::timestamp,
+    "refreshFailedAt" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
+-- name: MarkUserOAuthRefreshFailed :exec
+UPDATE
+    "UserOAuth"
+SET
+    "refreshFailedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid;
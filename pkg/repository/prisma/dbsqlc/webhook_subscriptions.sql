@@ -0,0 +1,156 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO "WebhookSubscription" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "name",
+    "url",
+    "secret",
+    "eventTypes",
+    "enabled"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @name::text,
+    @url::text,
+    @secret::text,
+    @eventTypes::text[],
+    COALESCE(sqlc.narg('enabled')::boolean, true)
+) RETURNING *;
+
+-- name: GetWebhookSubscriptionById :one
+SELECT * FROM "WebhookSubscription"
+WHERE "id" = @id::uuid;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM "WebhookSubscription"
+WHERE "tenantId" = @tenantId::uuid
+ORDER BY "createdAt" ASC, "id" ASC;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE "WebhookSubscription"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "url" = COALESCE(sqlc.narg('url')::text, "url"),
+    "eventTypes" = COALESCE(sqlc.narg('eventTypes')::text[], "eventTypes"),
+    "enabled" = COALESCE(sqlc.narg('enabled')::boolean, "enabled")
+WHERE "id" = @id::uuid
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM "WebhookSubscription"
+WHERE "id" = @id::uuid;
+
+-- name: ListWebhookSubscriptionEventTypes :many
+SELECT DISTINCT unnest("eventTypes")::text AS "eventType"
+FROM "WebhookSubscription"
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "enabled" = true;
+
+-- name: CreateWebhookDeliveries :execrows
+INSERT INTO "WebhookDelivery" (
+    "id",
+    "createdAt",
+    "tenantId",
+    "subscriptionId",
+    "eventType",
+    "dedupeKey",
+    "payload"
+)
+SELECT
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    s."tenantId",
+    s."id",
+    @eventType::text,
+    @dedupeKey::text,
+    @payload::jsonb
+FROM "WebhookSubscription" s
+WHERE
+    s."tenantId" = @tenantId::uuid AND
+    s."enabled" = true AND
+    @eventType::text = ANY(s."eventTypes")
+ON CONFLICT ("subscriptionId", "dedupeKey") DO NOTHING;
+
+-- name: ClaimWebhookDeliveries :many
+WITH due AS (
+    SELECT "id"
+    FROM "WebhookDelivery"
+    WHERE
+        "status" = 'PENDING' AND
+        "nextAttemptAt" <= CURRENT_TIMESTAMP
+    ORDER BY "nextAttemptAt" ASC
+    LIMIT @limit::integer
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE "WebhookDelivery" d
+SET
+    -- deliveries which are not updated within the claim timeout are claimed again
+    "nextAttemptAt" = CURRENT_TIMESTAMP + @claimTimeout::interval
+FROM due, "WebhookSubscription" s
+WHERE
+    d."id" = due."id" AND
+    s."id" = d."subscriptionId"
+RETURNING d.*, s."url", s."secret", s."enabled";
+
+-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE "WebhookDelivery"
+SET
+    "status" = @status::"WebhookDeliveryStatus",
+    "attempts" = "attempts" + 1,
+    "lastAttemptAt" = CURRENT_TIMESTAMP,
+    "nextAttemptAt" = COALESCE(sqlc.narg('nextAttemptAt')::timestamp, "nextAttemptAt"),
+    "responseStatusCode" = sqlc.narg('responseStatusCode')::integer,
+    "error" = sqlc.narg('error')::text
+WHERE "id" = @id::uuid;
+
+-- name: GetWebhookDeliveryById :one
+SELECT * FROM "WebhookDelivery"
+WHERE "id" = @id::uuid;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM "WebhookDelivery"
+WHERE
+    "subscriptionId" = @subscriptionId::uuid AND
+    (sqlc.narg('status')::"WebhookDeliveryStatus" IS NULL OR "status" = sqlc.narg('status')::"WebhookDeliveryStatus")
+ORDER BY
+    "createdAt" DESC,
+    "id" DESC
+OFFSET COALESCE(sqlc.narg('offset'), 0)
+LIMIT COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*) AS total
+FROM "WebhookDelivery"
+WHERE
+    "subscriptionId" = @subscriptionId::uuid AND
+    (sqlc.narg('status')::"WebhookDeliveryStatus" IS NULL OR "status" = sqlc.narg('status')::"WebhookDeliveryStatus");
+
+-- name: ResendWebhookDelivery :one
+UPDATE "WebhookDelivery"
+SET
+    "status" = 'PENDING',
+    "attempts" = 0,
+    "nextAttemptAt" = CURRENT_TIMESTAMP
+WHERE "id" = @id::uuid
+RETURNING *;
+
+-- name: DeleteExpiredWebhookDeliveries :one
+WITH deleted AS (
+    DELETE FROM "WebhookDelivery"
+    WHERE "id" IN (
+        SELECT "id"
+        FROM "WebhookDelivery"
+        WHERE
+            "tenantId" = @tenantId::uuid AND
+            "createdAt" < @createdBefore::timestamp
+        ORDER BY "createdAt" ASC
+        LIMIT @limit::integer
+    )
+    RETURNING "id"
+)
+SELECT COUNT(*) AS deleted FROM deleted;
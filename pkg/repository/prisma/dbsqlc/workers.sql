@@ -196,6 +196,12 @@ INSERT INTO "_ActionToWorker" (
     @workerId::uuid
 ON CONFLICT DO NOTHING;
 
+-- name: UnlinkActionsFromWorker :exec
+DELETE FROM "_ActionToWorker"
+WHERE
+    "B" = @workerId::uuid
+    AND NOT ("A" = ANY(@actionIds::uuid[]));
+
 -- name: UpsertService :one
 INSERT INTO "Service" (
     "id",
@@ -257,6 +263,21 @@ WHERE
         )
 RETURNING *;
 
+-- name: DeactivateStaleWorkers :many
+-- Marks the active workers of a tenant which haven't sent a heartbeat within the heartbeat timeout as
+-- inactive. Webhook workers are skipped, since they're marked as active by their health checks.
+UPDATE "Worker"
+SET
+    "isActive" = false,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "isActive" = true
+    AND "deletedAt" IS NULL
+    AND "type" != 'WEBHOOK'
+    AND "lastHeartbeatAt" < NOW() - @heartbeatTimeout::interval
+RETURNING *;
+
 -- name: ListWorkerLabels :many
 SELECT
     "id",
@@ -366,3 +387,21 @@ SELECT
     "workerId"
 FROM "WorkerLabel" wl
 WHERE wl."workerId" = ANY(@workerIds::uuid[]);
+
+-- name: DeleteWorkerSlotGroups :exec
+DELETE FROM "WorkerSlotGroup"
+WHERE
+    "workerId" = @workerId::uuid;
+
+-- name: CreateWorkerSlotGroup :exec
+INSERT INTO "WorkerSlotGroup" (
+    "workerId",
+    "name",
+    "maxRuns",
+    "actionIds"
+) VALUES (
+    @workerId::uuid,
+    @name::text,
+    @maxRuns::int,
+    @actionIds::text[]
+);
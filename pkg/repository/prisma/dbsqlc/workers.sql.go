@@ -98,6 +98,98 @@ func (q *Queries) CreateWorker(ctx context.Context, db DBTX, arg CreateWorkerPar
 	return &i, err
 }
 
+const createWorkerSlotGroup = `-- name: CreateWorkerSlotGroup :exec
+INSERT INTO "WorkerSlotGroup" (
+    "workerId",
+    "name",
+    "maxRuns",
+    "actionIds"
+) VALUES (
+    $1::uuid,
+    $2::text,
+    $3::int,
+    $4::text[]
+)
+`
+
+type CreateWorkerSlotGroupParams struct {
+	Workerid  pgtype.UUID `json:"workerid"`
+	Name      string      `json:"name"`
+	Maxruns   int32       `json:"maxruns"`
+	Actionids []string    `json:"actionids"`
+}
+
+func (q *Queries) CreateWorkerSlotGroup(ctx context.Context, db DBTX, arg CreateWorkerSlotGroupParams) error {
+	_, err := db.Exec(ctx, createWorkerSlotGroup,
+		arg.Workerid,
+		arg.Name,
+		arg.Maxruns,
+		arg.Actionids,
+	)
+	return err
+}
+
+const deactivateStaleWorkers = `-- name: DeactivateStaleWorkers :many
+UPDATE "Worker"
+SET
+    "isActive" = false,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = $1::uuid
+    AND "isActive" = true
+    AND "deletedAt" IS NULL
+    AND "type" != 'WEBHOOK'
+    AND "lastHeartbeatAt" < NOW() - $2::interval
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+`
+
+type DeactivateStaleWorkersParams struct {
+	Tenantid         pgtype.UUID     `json:"tenantid"`
+	Heartbeattimeout pgtype.Interval `json:"heartbeattimeout"`
+}
+
+// Marks the active workers of a tenant which haven't sent a heartbeat within the heartbeat timeout as
+// inactive. Webhook workers are skipped, since they're marked as active by their health checks.
+func (q *Queries) DeactivateStaleWorkers(ctx context.Context, db DBTX, arg DeactivateStaleWorkersParams) ([]*Worker, error) {
+	rows, err := db.Query(ctx, deactivateStaleWorkers, arg.Tenantid, arg.Heartbeattimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Worker
+	for rows.Next() {
+		var i Worker
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TenantId,
+			&i.LastHeartbeatAt,
+			&i.Name,
+			&i.DispatcherId,
+			&i.MaxRuns,
+			&i.IsActive,
+			&i.LastListenerEstablished,
+			&i.IsPaused,
+			&i.Type,
+			&i.WebhookId,
+			&i.Language,
+			&i.LanguageVersion,
+			&i.Os,
+			&i.RuntimeExtra,
+			&i.SdkVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteOldWorkerAssignEvents = `-- name: DeleteOldWorkerAssignEvents :one
 WITH for_delete AS (
     SELECT
@@ -216,6 +308,17 @@ func (q *Queries) DeleteWorker(ctx context.Context, db DBTX, id pgtype.UUID) (*W
 	return &i, err
 }
 
+const deleteWorkerSlotGroups = `-- name: DeleteWorkerSlotGroups :exec
+DELETE FROM "WorkerSlotGroup"
+WHERE
+    "workerId" = $1::uuid
+`
+
+func (q *Queries) DeleteWorkerSlotGroups(ctx context.Context, db DBTX, workerid pgtype.UUID) error {
+	_, err := db.Exec(ctx, deleteWorkerSlotGroups, workerid)
+	return err
+}
+
 const getWorkerActionsByWorkerId = `-- name: GetWorkerActionsByWorkerId :many
 SELECT
     a."actionId" AS actionId
@@ -791,6 +894,23 @@ func (q *Queries) ListWorkersWithSlotCount(ctx context.Context, db DBTX, arg Lis
 	return items, nil
 }
 
+const unlinkActionsFromWorker = `-- name: UnlinkActionsFromWorker :exec
+DELETE FROM "_ActionToWorker"
+WHERE
+    "B" = $1::uuid
+    AND NOT ("A" = ANY($2::uuid[]))
+`
+
+type UnlinkActionsFromWorkerParams struct {
+	Workerid  pgtype.UUID   `json:"workerid"`
+	Actionids []pgtype.UUID `json:"actionids"`
+}
+
+func (q *Queries) UnlinkActionsFromWorker(ctx context.Context, db DBTX, arg UnlinkActionsFromWorkerParams) error {
+	_, err := db.Exec(ctx, unlinkActionsFromWorker, arg.Workerid, arg.Actionids)
+	return err
+}
+
 const updateWorker = `-- name: UpdateWorker :one
 UPDATE
     "Worker"
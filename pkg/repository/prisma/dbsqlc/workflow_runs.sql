@@ -75,6 +75,26 @@ WITH runs AS (
         (
             sqlc.narg('finishedBefore')::timestamp IS NULL OR
             runs."finishedAt" <= sqlc.narg('finishedBefore')::timestamp
+        ) AND
+        (
+            sqlc.narg('eventKey')::text IS NULL OR
+            events."key" = sqlc.narg('eventKey')::text
+        ) AND
+        (
+            sqlc.narg('excludedWorkflowIds')::uuid[] IS NULL OR
+            workflowVersion."workflowId" <> ALL(sqlc.narg('excludedWorkflowIds')::uuid[])
+        ) AND
+        (
+            sqlc.narg('workflowIds')::uuid[] IS NULL OR
+            workflowVersion."workflowId" = ANY(sqlc.narg('workflowIds')::uuid[])
+        ) AND
+        (
+            sqlc.narg('eventKeys')::text[] IS NULL OR
+            events."key" = ANY(sqlc.narg('eventKeys')::text[])
+        ) AND
+        (
+            sqlc.narg('search')::text IS NULL OR
+            runs."error" ILIKE concat('%', sqlc.narg('search')::text, '%')
         )
     ORDER BY
         case when @orderBy = 'createdAt ASC' THEN runs."createdAt" END ASC ,
@@ -230,6 +250,36 @@ WHERE
     (
         sqlc.narg('finishedBefore')::timestamp IS NULL OR
         runs."finishedAt" <= sqlc.narg('finishedBefore')::timestamp
+    ) AND
+    (
+        sqlc.narg('eventKey')::text IS NULL OR
+        events."key" = sqlc.narg('eventKey')::text
+    ) AND
+    (
+        sqlc.narg('excludedWorkflowIds')::uuid[] IS NULL OR
+        workflowVersion."workflowId" <> ALL(sqlc.narg('excludedWorkflowIds')::uuid[])
+    ) AND
+    (
+        sqlc.narg('workflowIds')::uuid[] IS NULL OR
+        workflowVersion."workflowId" = ANY(sqlc.narg('workflowIds')::uuid[])
+    ) AND
+    (
+        sqlc.narg('eventKeys')::text[] IS NULL OR
+        events."key" = ANY(sqlc.narg('eventKeys')::text[])
+    ) AND
+    (
+        sqlc.narg('search')::text IS NULL OR
+        runs."error" ILIKE concat('%', sqlc.narg('search')::text, '%')
+    ) AND
+    -- keyset pagination, which is only supported when ordering by createdAt
+    (
+        sqlc.narg('cursorCreatedAt')::timestamp IS NULL OR
+        (@orderBy = 'createdAt ASC' AND runs."createdAt" > sqlc.narg('cursorCreatedAt')::timestamp) OR
+        (@orderBy = 'createdAt DESC' AND runs."createdAt" < sqlc.narg('cursorCreatedAt')::timestamp) OR
+        (
+            runs."createdAt" = sqlc.narg('cursorCreatedAt')::timestamp AND
+            runs."id" > sqlc.narg('cursorId')::uuid
+        )
     )
 ORDER BY
     case when @orderBy = 'createdAt ASC' THEN runs."createdAt" END ASC ,
@@ -542,7 +592,9 @@ INSERT INTO "WorkflowRun" (
     "parentId",
     "parentStepRunId",
     "additionalMetadata",
-    "priority"
+    "priority",
+    "detached",
+    "timeoutAt"
 ) VALUES (
     COALESCE(sqlc.narg('id')::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -560,7 +612,9 @@ INSERT INTO "WorkflowRun" (
     sqlc.narg('parentId')::uuid,
     sqlc.narg('parentStepRunId')::uuid,
     @additionalMetadata::jsonb,
-    sqlc.narg('priority')::int
+    sqlc.narg('priority')::int,
+    COALESCE(sqlc.narg('detached')::boolean, false),
+    sqlc.narg('timeoutAt')::timestamp
 ) RETURNING *;
 
 
@@ -577,7 +631,9 @@ INSERT INTO "WorkflowRun" (
     "parentStepRunId",
     "additionalMetadata",
     "priority",
-    "insertOrder"
+    "insertOrder",
+    "detached",
+    "timeoutAt"
 ) VALUES (
     $1,
     $2,
@@ -590,8 +646,9 @@ INSERT INTO "WorkflowRun" (
     $9,
     $10,
     $11,
-    $12
-
+    $12,
+    $13,
+    $14
 );
 
 
@@ -729,7 +786,9 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     "cronParentId",
     "cronSchedule",
     "cronName",
-    "scheduledId"
+    "scheduledId",
+    "actorType",
+    "actorId"
 ) VALUES (
     gen_random_uuid(),
     CURRENT_TIMESTAMP,
@@ -741,7 +800,9 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     sqlc.narg('cronParentId')::uuid,
     sqlc.narg('cronSchedule')::text,
     sqlc.narg('cronName')::text,
-    sqlc.narg('scheduledId')::uuid
+    sqlc.narg('scheduledId')::uuid,
+    sqlc.narg('actorType')::text,
+    sqlc.narg('actorId')::uuid
 ) RETURNING *;
 
 -- name: CreateWorkflowRunTriggeredBys :copyfrom
@@ -753,7 +814,9 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     "cronParentId",
     "cronSchedule",
     "cronName",
-    "scheduledId"
+    "scheduledId",
+    "actorType",
+    "actorId"
 ) VALUES (
     $1,
     $2,
@@ -762,7 +825,9 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     $5,
     $6,
     $7,
-    $8
+    $8,
+    $9,
+    $10
 );
 
 -- name: CreateGetGroupKeyRun :one
@@ -891,6 +956,87 @@ RETURNING "JobRun"."id", "JobRun"."workflowRunId", "JobRun"."tenantId";
 
 
 
+-- name: CreatePartialJobRun :one
+-- Creates the job run of a partial workflow run, which only contains the step run of the given step.
+WITH step AS (
+    SELECT
+        "id",
+        "jobId",
+        "actionId",
+        "tenantId"
+    FROM
+        "Step"
+    WHERE
+        "id" = @stepId::uuid AND
+        "tenantId" = @tenantId::uuid
+), job_run AS (
+    INSERT INTO "JobRun" (
+        "id",
+        "createdAt",
+        "updatedAt",
+        "tenantId",
+        "workflowRunId",
+        "jobId",
+        "status"
+    )
+    SELECT
+        gen_random_uuid(),
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        step."tenantId",
+        @workflowRunId::uuid,
+        step."jobId",
+        'PENDING'
+    FROM
+        step
+    RETURNING "id", "tenantId"
+), step_run AS (
+    INSERT INTO "StepRun" (
+        "id",
+        "tenantId",
+        "priority",
+        "status",
+        "jobRunId",
+        "stepId",
+        "queue"
+    )
+    SELECT
+        gen_random_uuid(),
+        job_run."tenantId",
+        @priority::int4,
+        'PENDING',
+        job_run."id",
+        step."id",
+        step."actionId"
+    FROM
+        job_run, step
+    RETURNING "id"
+)
+INSERT INTO "JobRunLookupData" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "deletedAt",
+    "jobRunId",
+    "tenantId",
+    "data"
+)
+SELECT
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    NULL,
+    job_run."id",
+    job_run."tenantId",
+    jsonb_build_object(
+        'input', COALESCE(sqlc.narg('input')::jsonb, '{}'::jsonb),
+        'triggered_by', @triggeredBy::text,
+        'steps', COALESCE(sqlc.narg('steps')::jsonb, '{}'::jsonb)
+    )
+FROM
+    job_run
+RETURNING "jobRunId";
+
 -- name: CreateJobRunLookupData :one
 INSERT INTO "JobRunLookupData" (
     "id",
@@ -1022,9 +1168,11 @@ WHERE
 
 -- name: CreateStepRunsForJobRunIds :many
 WITH job_ids AS (
-    SELECT DISTINCT "jobId", "id" as jobRunId, "tenantId"
-    FROM "JobRun"
-    WHERE "id" = ANY(@jobRunIds::uuid[])
+    -- step runs inherit the priority of their workflow run
+    SELECT DISTINCT jr."jobId", jr."id" as jobRunId, jr."tenantId", wr."priority"
+    FROM "JobRun" jr
+    JOIN "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+    WHERE jr."id" = ANY(@jobRunIds::uuid[])
 ),
 steps AS (
     SELECT
@@ -1032,7 +1180,8 @@ steps AS (
         s."actionId",
         s."jobId",
         j.jobRunId,
-        j."tenantId"
+        j."tenantId",
+        j."priority"
     FROM "Step" s
     JOIN job_ids j ON s."jobId" = j."jobId"
 )
@@ -1048,7 +1197,7 @@ INSERT INTO "StepRun" (
 SELECT
     gen_random_uuid() as id,
     s."tenantId" as tenantId,
-    @priority::int4 as priority,
+    COALESCE(s."priority", @priority::int4) as priority,
     'PENDING' as status,
     s.jobRunId as jobRunId,
     step_id as stepId,
@@ -1091,7 +1240,7 @@ SELECT
     -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable fields
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
-    workflow."isPaused" as "isPaused",
+    (workflow."isPaused" OR tenant."isPaused") as "isPaused",
     wc."concurrencyGroupExpression" as "concurrencyGroupExpression",
     groupKeyRun."id" as "getGroupKeyRunId"
 FROM
@@ -1106,6 +1255,8 @@ LEFT JOIN
     "WorkflowConcurrency" as wc ON wc."workflowVersionId" = workflowVersion."id"
 LEFT JOIN
     "GetGroupKeyRun" as groupKeyRun ON groupKeyRun."workflowRunId" = runs."id"
+LEFT JOIN
+    "Tenant" as tenant ON tenant."id" = runs."tenantId"
 WHERE
     runs."deletedAt" IS NULL AND
     workflowVersion."deletedAt" IS NULL AND
@@ -1157,6 +1308,18 @@ WHERE
     )
     AND wr."deletedAt" IS NULL;
 
+-- name: ListChildWorkflowRunsToCancel :many
+SELECT
+    "id"
+FROM
+    "WorkflowRun"
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "parentStepRunId" = @parentStepRunId::uuid AND
+    "detached" = false AND
+    "deletedAt" IS NULL AND
+    "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED');
+
 -- name: GetScheduledChildWorkflowRun :one
 SELECT
     *
@@ -1240,6 +1403,131 @@ WHERE
 RETURNING
     (SELECT has_more FROM has_more) as has_more;
 
+-- name: PurgeDeletedWorkflowRuns :one
+WITH for_purge AS (
+    SELECT
+        "id"
+    FROM "WorkflowRun" wr2
+    WHERE
+        wr2."tenantId" = @tenantId::uuid AND
+        wr2."deletedAt" IS NOT NULL AND
+        wr2."deletedAt" < @deletedBefore::timestamp
+    ORDER BY wr2."deletedAt" ASC
+    LIMIT sqlc.arg('limit') + 1
+    FOR UPDATE SKIP LOCKED
+),
+purged_with_limit AS (
+    SELECT
+        for_purge."id" as "id"
+    FROM for_purge
+    LIMIT sqlc.arg('limit')
+),
+has_more AS (
+    SELECT
+        CASE
+            WHEN COUNT(*) > sqlc.arg('limit') THEN TRUE
+            ELSE FALSE
+        END as has_more
+    FROM for_purge
+),
+step_runs_to_purge AS (
+    SELECT
+        sr."id"
+    FROM
+        "StepRun" sr
+    JOIN
+        "JobRun" jr ON jr."id" = sr."jobRunId"
+    WHERE
+        jr."workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+-- the tables which reference workflow runs and step runs don't have foreign keys, because those tables are
+-- partitioned, so their rows are deleted explicitly
+purged_step_run_order AS (
+    DELETE FROM "_StepRunOrder"
+    WHERE "A" IN (SELECT "id" FROM step_runs_to_purge) OR "B" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_archives AS (
+    DELETE FROM "StepRunResultArchive"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_events AS (
+    DELETE FROM "StepRunEvent"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_expression_evals AS (
+    DELETE FROM "StepRunExpressionEval"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_log_lines AS (
+    DELETE FROM "LogLine"
+    WHERE "tenantId" = @tenantId::uuid AND "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_stream_events AS (
+    DELETE FROM "StreamEvent"
+    WHERE "tenantId" = @tenantId::uuid AND "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_dead_letters AS (
+    DELETE FROM "StepRunDeadLetter"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_runs AS (
+    DELETE FROM "StepRun"
+    WHERE "id" IN (SELECT "id" FROM step_runs_to_purge)
+),
+-- the lookup data of the job runs is deleted by cascade
+purged_job_runs AS (
+    DELETE FROM "JobRun"
+    WHERE "tenantId" = @tenantId::uuid AND "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_get_group_key_runs AS (
+    DELETE FROM "GetGroupKeyRun"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_sticky_states AS (
+    DELETE FROM "WorkflowRunStickyState"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_expiries AS (
+    DELETE FROM "WorkflowRunExpiry"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_idempotency_keys AS (
+    DELETE FROM "WorkflowRunIdempotencyKey"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_child_keys AS (
+    DELETE FROM "WorkflowRunChildKey"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit) OR "parentId" IN (SELECT "id" FROM purged_with_limit)
+),
+-- the children and scheduled runs of the purged workflow runs are kept, without their parent
+orphaned_children AS (
+    UPDATE "WorkflowRun"
+    SET "parentId" = NULL
+    WHERE
+        "parentId" IN (SELECT "id" FROM purged_with_limit) AND
+        "id" NOT IN (SELECT "id" FROM purged_with_limit)
+),
+orphaned_scheduled_refs AS (
+    UPDATE "WorkflowTriggerScheduledRef"
+    SET "parentWorkflowRunId" = NULL
+    WHERE "parentWorkflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_triggered_by AS (
+    DELETE FROM "WorkflowRunTriggeredBy"
+    WHERE "parentId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_dedupes AS (
+    DELETE FROM "WorkflowRunDedupe"
+    WHERE "tenantId" = @tenantId::uuid AND "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+)
+DELETE FROM
+    "WorkflowRun" wr
+WHERE
+    wr."id" IN (SELECT "id" FROM purged_with_limit) AND
+    wr."tenantId" = @tenantId::uuid
+RETURNING
+    (SELECT has_more FROM has_more) as has_more;
+
 -- name: ListActiveQueuedWorkflowVersions :many
 WITH QueuedRuns AS (
     SELECT DISTINCT ON (wr."workflowVersionId")
@@ -1268,6 +1556,55 @@ SELECT
 FROM QueuedRuns q
 GROUP BY q."workflowVersionId", q."tenantId", q."concurrencyGroupId", q."status", q."id";
 
+-- name: ListConcurrencyKeysForWorkflow :many
+-- Lists the concurrency keys of a workflow which have running or queued workflow runs
+SELECT
+    wr."concurrencyGroupId"::text AS "key",
+    COUNT(*) FILTER (WHERE wr."status" = 'RUNNING') AS "running",
+    COUNT(*) FILTER (WHERE wr."status" = 'QUEUED') AS "queued"
+FROM
+    "WorkflowRun" wr
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    wr."tenantId" = @tenantId::uuid
+    AND wv."workflowId" = @workflowId::uuid
+    AND wr."concurrencyGroupId" IS NOT NULL
+    AND (wr."status" = 'RUNNING' OR wr."status" = 'QUEUED')
+    AND wr."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+GROUP BY
+    wr."concurrencyGroupId"
+ORDER BY
+    wr."concurrencyGroupId" ASC;
+
+-- name: ListConcurrencySlotHolders :many
+-- Lists the running workflow runs of a workflow which hold concurrency slots
+SELECT
+    wr."id",
+    wr."concurrencyGroupId"::text AS "key",
+    wr."createdAt",
+    wr."startedAt"
+FROM
+    "WorkflowRun" wr
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    wr."tenantId" = @tenantId::uuid
+    AND wv."workflowId" = @workflowId::uuid
+    AND wr."status" = 'RUNNING'
+    AND wr."concurrencyGroupId" IS NOT NULL
+    AND (
+        sqlc.narg('key')::text IS NULL OR
+        wr."concurrencyGroupId" = sqlc.narg('key')::text
+    )
+    AND wr."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+ORDER BY
+    wr."concurrencyGroupId" ASC, wr."startedAt" ASC NULLS LAST, wr."id" ASC
+LIMIT
+    COALESCE(sqlc.narg('limit'), 1000);
+
 -- name: ReplayWorkflowRunResetJobRun :one
 UPDATE
     "JobRun"
@@ -1481,7 +1818,7 @@ WHERE
     sr."status" IN ('FAILED', 'CANCELLED') AND
     (
         sr."cancelledReason" IS NULL OR
-        sr."cancelledReason" NOT IN ('CANCELLED_BY_USER', 'PREVIOUS_STEP_TIMED_OUT', 'PREVIOUS_STEP_FAILED', 'PREVIOUS_STEP_CANCELLED', 'CANCELLED_BY_CONCURRENCY_LIMIT')
+        sr."cancelledReason" NOT IN ('CANCELLED_BY_USER', 'PREVIOUS_STEP_TIMED_OUT', 'PREVIOUS_STEP_FAILED', 'PREVIOUS_STEP_CANCELLED', 'CANCELLED_BY_CONCURRENCY_LIMIT', 'SUPERSEDED', 'EXPIRED', 'JOIN_NOT_SATISFIED')
     ) AND
 	wr."id" = @workflowRunId::uuid AND
     wr."tenantId" = @tenantId::uuid;
@@ -1563,3 +1900,104 @@ WHERE
 DELETE FROM "WorkflowTriggerScheduledRef"
 WHERE
     "id" = @scheduleId::uuid;
+
+-- name: CreateWorkflowRunExpiries :exec
+INSERT INTO "WorkflowRunExpiry" (
+    "workflowRunId",
+    "tenantId",
+    "expiresAt"
+)
+SELECT
+    input."workflowRunId",
+    input."tenantId",
+    input."expiresAt"
+FROM (
+    SELECT
+        unnest(@workflowRunIds::uuid[]) AS "workflowRunId",
+        unnest(@tenantIds::uuid[]) AS "tenantId",
+        unnest(@expiresAts::timestamp[]) AS "expiresAt"
+) AS input;
+
+-- name: ClaimWorkflowRunIdempotencyKey :execrows
+INSERT INTO "WorkflowRunIdempotencyKey" AS existing (
+    "tenantId",
+    "key",
+    "workflowRunId",
+    "expiresAt"
+) VALUES (
+    @tenantId::uuid,
+    @key::text,
+    @workflowRunId::uuid,
+    @expiresAt::timestamp
+) ON CONFLICT ("tenantId", "key") DO UPDATE
+SET
+    "workflowRunId" = EXCLUDED."workflowRunId",
+    "createdAt" = CURRENT_TIMESTAMP,
+    "expiresAt" = EXCLUDED."expiresAt"
+-- a key can only be claimed again once its window has passed
+WHERE existing."expiresAt" <= CURRENT_TIMESTAMP;
+
+-- name: GetWorkflowRunIdempotencyKey :one
+SELECT
+    k.*,
+    -- keys are shared by all workflows of the tenant, so a key of another workflow must not return its run
+    (wv."workflowId" = (
+        SELECT "workflowId" FROM "WorkflowVersion" WHERE "id" = @workflowVersionId::uuid
+    ))::boolean AS "sameWorkflow"
+FROM
+    "WorkflowRunIdempotencyKey" k
+JOIN
+    "WorkflowRun" wr ON wr."id" = k."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    k."tenantId" = @tenantId::uuid AND
+    k."key" = @key::text AND
+    k."expiresAt" > CURRENT_TIMESTAMP;
+
+-- name: DeleteExpiredWorkflowRunIdempotencyKeys :one
+WITH deleted AS (
+    DELETE FROM "WorkflowRunIdempotencyKey"
+    WHERE ("tenantId", "key") IN (
+        SELECT "tenantId", "key"
+        FROM "WorkflowRunIdempotencyKey"
+        WHERE
+            "tenantId" = @tenantId::uuid AND
+            "expiresAt" <= CURRENT_TIMESTAMP
+        ORDER BY "expiresAt" ASC
+        LIMIT @limit::integer
+    )
+    RETURNING "key"
+)
+SELECT COUNT(*) AS deleted FROM deleted;
+
+-- name: ListExpiredWorkflowRuns :many
+SELECT
+    wre."workflowRunId",
+    -- runs which already finished don't need to be cancelled
+    (
+        wr."id" IS NULL OR
+        wr."deletedAt" IS NOT NULL OR
+        wr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    )::boolean AS "finished"
+FROM
+    "WorkflowRunExpiry" wre
+LEFT JOIN
+    "WorkflowRun" wr ON wr."id" = wre."workflowRunId"
+WHERE
+    wre."tenantId" = @tenantId::uuid AND
+    wre."expired" = false AND
+    wre."expiresAt" <= NOW()
+ORDER BY
+    wre."expiresAt"
+LIMIT
+    COALESCE(sqlc.narg('limit')::int, 1000);
+
+-- name: MarkWorkflowRunsExpired :exec
+UPDATE
+    "WorkflowRunExpiry"
+SET
+    "expired" = true
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "workflowRunId" = ANY(@workflowRunIds::uuid[]);
@@ -90,6 +90,46 @@ func (q *Queries) BulkCreateWorkflowRunEvent(ctx context.Context, db DBTX, arg B
 	return err
 }
 
+const claimWorkflowRunIdempotencyKey = `-- name: ClaimWorkflowRunIdempotencyKey :execrows
+INSERT INTO "WorkflowRunIdempotencyKey" AS existing (
+    "tenantId",
+    "key",
+    "workflowRunId",
+    "expiresAt"
+) VALUES (
+    $1::uuid,
+    $2::text,
+    $3::uuid,
+    $4::timestamp
+) ON CONFLICT ("tenantId", "key") DO UPDATE
+SET
+    "workflowRunId" = EXCLUDED."workflowRunId",
+    "createdAt" = CURRENT_TIMESTAMP,
+    "expiresAt" = EXCLUDED."expiresAt"
+-- a key can only be claimed again once its window has passed
+WHERE existing."expiresAt" <= CURRENT_TIMESTAMP
+`
+
+type ClaimWorkflowRunIdempotencyKeyParams struct {
+	Tenantid      pgtype.UUID      `json:"tenantid"`
+	Key           string           `json:"key"`
+	Workflowrunid pgtype.UUID      `json:"workflowrunid"`
+	Expiresat     pgtype.Timestamp `json:"expiresat"`
+}
+
+func (q *Queries) ClaimWorkflowRunIdempotencyKey(ctx context.Context, db DBTX, arg ClaimWorkflowRunIdempotencyKeyParams) (int64, error) {
+	result, err := db.Exec(ctx, claimWorkflowRunIdempotencyKey,
+		arg.Tenantid,
+		arg.Key,
+		arg.Workflowrunid,
+		arg.Expiresat,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const countScheduledWorkflows = `-- name: CountScheduledWorkflows :one
 SELECT count(*)
 FROM "WorkflowTriggerScheduledRef" t
@@ -219,16 +259,36 @@ WITH runs AS (
         (
             $15::timestamp IS NULL OR
             runs."finishedAt" <= $15::timestamp
+        ) AND
+        (
+            $16::text IS NULL OR
+            events."key" = $16::text
+        ) AND
+        (
+            $17::uuid[] IS NULL OR
+            workflowVersion."workflowId" <> ALL($17::uuid[])
+        ) AND
+        (
+            $18::uuid[] IS NULL OR
+            workflowVersion."workflowId" = ANY($18::uuid[])
+        ) AND
+        (
+            $19::text[] IS NULL OR
+            events."key" = ANY($19::text[])
+        ) AND
+        (
+            $20::text IS NULL OR
+            runs."error" ILIKE concat('%', $20::text, '%')
         )
     ORDER BY
-        case when $16 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
-        case when $16 = 'createdAt DESC' THEN runs."createdAt" END DESC,
-        case when $16 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
-        case when $16 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
-        case when $16 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
-        case when $16 = 'startedAt DESC' THEN runs."startedAt" END DESC,
-        case when $16 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
-        case when $16 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
+        case when $21 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
+        case when $21 = 'createdAt DESC' THEN runs."createdAt" END DESC,
+        case when $21 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
+        case when $21 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
+        case when $21 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
+        case when $21 = 'startedAt DESC' THEN runs."startedAt" END DESC,
+        case when $21 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
+        case when $21 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
         runs."id" ASC
     LIMIT 10000
 )
@@ -239,22 +299,27 @@ FROM
 `
 
 type CountWorkflowRunsParams struct {
-	TenantId           pgtype.UUID      `json:"tenantId"`
-	EventId            pgtype.UUID      `json:"eventId"`
-	WorkflowVersionId  pgtype.UUID      `json:"workflowVersionId"`
-	Kinds              []string         `json:"kinds"`
-	WorkflowId         pgtype.UUID      `json:"workflowId"`
-	Ids                []pgtype.UUID    `json:"ids"`
-	AdditionalMetadata []byte           `json:"additionalMetadata"`
-	ParentId           pgtype.UUID      `json:"parentId"`
-	ParentStepRunId    pgtype.UUID      `json:"parentStepRunId"`
-	GroupKey           pgtype.Text      `json:"groupKey"`
-	Statuses           []string         `json:"statuses"`
-	CreatedAfter       pgtype.Timestamp `json:"createdAfter"`
-	CreatedBefore      pgtype.Timestamp `json:"createdBefore"`
-	FinishedAfter      pgtype.Timestamp `json:"finishedAfter"`
-	FinishedBefore     pgtype.Timestamp `json:"finishedBefore"`
-	Orderby            interface{}      `json:"orderby"`
+	TenantId            pgtype.UUID      `json:"tenantId"`
+	EventId             pgtype.UUID      `json:"eventId"`
+	WorkflowVersionId   pgtype.UUID      `json:"workflowVersionId"`
+	Kinds               []string         `json:"kinds"`
+	WorkflowId          pgtype.UUID      `json:"workflowId"`
+	Ids                 []pgtype.UUID    `json:"ids"`
+	AdditionalMetadata  []byte           `json:"additionalMetadata"`
+	ParentId            pgtype.UUID      `json:"parentId"`
+	ParentStepRunId     pgtype.UUID      `json:"parentStepRunId"`
+	GroupKey            pgtype.Text      `json:"groupKey"`
+	Statuses            []string         `json:"statuses"`
+	CreatedAfter        pgtype.Timestamp `json:"createdAfter"`
+	CreatedBefore       pgtype.Timestamp `json:"createdBefore"`
+	FinishedAfter       pgtype.Timestamp `json:"finishedAfter"`
+	FinishedBefore      pgtype.Timestamp `json:"finishedBefore"`
+	EventKey            pgtype.Text      `json:"eventKey"`
+	ExcludedWorkflowIds []pgtype.UUID    `json:"excludedWorkflowIds"`
+	WorkflowIds         []pgtype.UUID    `json:"workflowIds"`
+	EventKeys           []string         `json:"eventKeys"`
+	Search              pgtype.Text      `json:"search"`
+	Orderby             interface{}      `json:"orderby"`
 }
 
 func (q *Queries) CountWorkflowRuns(ctx context.Context, db DBTX, arg CountWorkflowRunsParams) (int64, error) {
@@ -274,6 +339,11 @@ func (q *Queries) CountWorkflowRuns(ctx context.Context, db DBTX, arg CountWorkf
 		arg.CreatedBefore,
 		arg.FinishedAfter,
 		arg.FinishedBefore,
+		arg.EventKey,
+		arg.ExcludedWorkflowIds,
+		arg.WorkflowIds,
+		arg.EventKeys,
+		arg.Search,
 		arg.Orderby,
 	)
 	var total int64
@@ -684,6 +754,113 @@ func (q *Queries) CreateMultipleWorkflowRunStickyStates(ctx context.Context, db
 	return err
 }
 
+const createPartialJobRun = `-- name: CreatePartialJobRun :one
+WITH step AS (
+    SELECT
+        "id",
+        "jobId",
+        "actionId",
+        "tenantId"
+    FROM
+        "Step"
+    WHERE
+        "id" = $1::uuid AND
+        "tenantId" = $2::uuid
+), job_run AS (
+    INSERT INTO "JobRun" (
+        "id",
+        "createdAt",
+        "updatedAt",
+        "tenantId",
+        "workflowRunId",
+        "jobId",
+        "status"
+    )
+    SELECT
+        gen_random_uuid(),
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        step."tenantId",
+        $3::uuid,
+        step."jobId",
+        'PENDING'
+    FROM
+        step
+    RETURNING "id", "tenantId"
+), step_run AS (
+    INSERT INTO "StepRun" (
+        "id",
+        "tenantId",
+        "priority",
+        "status",
+        "jobRunId",
+        "stepId",
+        "queue"
+    )
+    SELECT
+        gen_random_uuid(),
+        job_run."tenantId",
+        $4::int4,
+        'PENDING',
+        job_run."id",
+        step."id",
+        step."actionId"
+    FROM
+        job_run, step
+    RETURNING "id"
+)
+INSERT INTO "JobRunLookupData" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "deletedAt",
+    "jobRunId",
+    "tenantId",
+    "data"
+)
+SELECT
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    NULL,
+    job_run."id",
+    job_run."tenantId",
+    jsonb_build_object(
+        'input', COALESCE($5::jsonb, '{}'::jsonb),
+        'triggered_by', $6::text,
+        'steps', COALESCE($7::jsonb, '{}'::jsonb)
+    )
+FROM
+    job_run
+RETURNING "jobRunId"
+`
+
+type CreatePartialJobRunParams struct {
+	Stepid        pgtype.UUID `json:"stepid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Priority      int32       `json:"priority"`
+	Input         []byte      `json:"input"`
+	Triggeredby   string      `json:"triggeredby"`
+	Steps         []byte      `json:"steps"`
+}
+
+// Creates the job run of a partial workflow run, which only contains the step run of the given step.
+func (q *Queries) CreatePartialJobRun(ctx context.Context, db DBTX, arg CreatePartialJobRunParams) (pgtype.UUID, error) {
+	row := db.QueryRow(ctx, createPartialJobRun,
+		arg.Stepid,
+		arg.Tenantid,
+		arg.Workflowrunid,
+		arg.Priority,
+		arg.Input,
+		arg.Triggeredby,
+		arg.Steps,
+	)
+	var jobRunId pgtype.UUID
+	err := row.Scan(&jobRunId)
+	return jobRunId, err
+}
+
 const createStepRun = `-- name: CreateStepRun :one
 INSERT INTO "StepRun" (
     "id",
@@ -745,9 +922,11 @@ type CreateStepRunsParams struct {
 
 const createStepRunsForJobRunIds = `-- name: CreateStepRunsForJobRunIds :many
 WITH job_ids AS (
-    SELECT DISTINCT "jobId", "id" as jobRunId, "tenantId"
-    FROM "JobRun"
-    WHERE "id" = ANY($2::uuid[])
+    -- step runs inherit the priority of their workflow run
+    SELECT DISTINCT jr."jobId", jr."id" as jobRunId, jr."tenantId", wr."priority"
+    FROM "JobRun" jr
+    JOIN "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+    WHERE jr."id" = ANY($2::uuid[])
 ),
 steps AS (
     SELECT
@@ -755,7 +934,8 @@ steps AS (
         s."actionId",
         s."jobId",
         j.jobRunId,
-        j."tenantId"
+        j."tenantId",
+        j."priority"
     FROM "Step" s
     JOIN job_ids j ON s."jobId" = j."jobId"
 )
@@ -771,7 +951,7 @@ INSERT INTO "StepRun" (
 SELECT
     gen_random_uuid() as id,
     s."tenantId" as tenantId,
-    $1::int4 as priority,
+    COALESCE(s."priority", $1::int4) as priority,
     'PENDING' as status,
     s.jobRunId as jobRunId,
     step_id as stepId,
@@ -824,7 +1004,9 @@ INSERT INTO "WorkflowRun" (
     "parentId",
     "parentStepRunId",
     "additionalMetadata",
-    "priority"
+    "priority",
+    "detached",
+    "timeoutAt"
 ) VALUES (
     COALESCE($1::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -842,21 +1024,25 @@ INSERT INTO "WorkflowRun" (
     $7::uuid,
     $8::uuid,
     $9::jsonb,
-    $10::int
-) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder"
+    $10::int,
+    COALESCE($11::boolean, false),
+    $12::timestamp
+) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", detached, "timeoutAt"
 `
 
 type CreateWorkflowRunParams struct {
-	ID                 pgtype.UUID `json:"id"`
-	DisplayName        pgtype.Text `json:"displayName"`
-	Tenantid           pgtype.UUID `json:"tenantid"`
-	Workflowversionid  pgtype.UUID `json:"workflowversionid"`
-	ChildIndex         pgtype.Int4 `json:"childIndex"`
-	ChildKey           pgtype.Text `json:"childKey"`
-	ParentId           pgtype.UUID `json:"parentId"`
-	ParentStepRunId    pgtype.UUID `json:"parentStepRunId"`
-	Additionalmetadata []byte      `json:"additionalmetadata"`
-	Priority           pgtype.Int4 `json:"priority"`
+	ID                 pgtype.UUID      `json:"id"`
+	DisplayName        pgtype.Text      `json:"displayName"`
+	Tenantid           pgtype.UUID      `json:"tenantid"`
+	Workflowversionid  pgtype.UUID      `json:"workflowversionid"`
+	ChildIndex         pgtype.Int4      `json:"childIndex"`
+	ChildKey           pgtype.Text      `json:"childKey"`
+	ParentId           pgtype.UUID      `json:"parentId"`
+	ParentStepRunId    pgtype.UUID      `json:"parentStepRunId"`
+	Additionalmetadata []byte           `json:"additionalmetadata"`
+	Priority           pgtype.Int4      `json:"priority"`
+	Detached           pgtype.Bool      `json:"detached"`
+	TimeoutAt          pgtype.Timestamp `json:"timeoutAt"`
 }
 
 func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWorkflowRunParams) (*WorkflowRun, error) {
@@ -871,6 +1057,8 @@ func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWork
 		arg.ParentStepRunId,
 		arg.Additionalmetadata,
 		arg.Priority,
+		arg.Detached,
+		arg.TimeoutAt,
 	)
 	var i WorkflowRun
 	err := row.Scan(
@@ -894,6 +1082,8 @@ func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWork
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Detached,
+		&i.TimeoutAt,
 	)
 	return &i, err
 }
@@ -948,6 +1138,35 @@ func (q *Queries) CreateWorkflowRunDedupe(ctx context.Context, db DBTX, arg Crea
 	return &i, err
 }
 
+const createWorkflowRunExpiries = `-- name: CreateWorkflowRunExpiries :exec
+INSERT INTO "WorkflowRunExpiry" (
+    "workflowRunId",
+    "tenantId",
+    "expiresAt"
+)
+SELECT
+    input."workflowRunId",
+    input."tenantId",
+    input."expiresAt"
+FROM (
+    SELECT
+        unnest($1::uuid[]) AS "workflowRunId",
+        unnest($2::uuid[]) AS "tenantId",
+        unnest($3::timestamp[]) AS "expiresAt"
+) AS input
+`
+
+type CreateWorkflowRunExpiriesParams struct {
+	Workflowrunids []pgtype.UUID      `json:"workflowrunids"`
+	Tenantids      []pgtype.UUID      `json:"tenantids"`
+	Expiresats     []pgtype.Timestamp `json:"expiresats"`
+}
+
+func (q *Queries) CreateWorkflowRunExpiries(ctx context.Context, db DBTX, arg CreateWorkflowRunExpiriesParams) error {
+	_, err := db.Exec(ctx, createWorkflowRunExpiries, arg.Workflowrunids, arg.Tenantids, arg.Expiresats)
+	return err
+}
+
 const createWorkflowRunStickyState = `-- name: CreateWorkflowRunStickyState :one
 WITH workflow_version AS (
     SELECT "sticky"
@@ -1013,7 +1232,9 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     "cronParentId",
     "cronSchedule",
     "cronName",
-    "scheduledId"
+    "scheduledId",
+    "actorType",
+    "actorId"
 ) VALUES (
     gen_random_uuid(),
     CURRENT_TIMESTAMP,
@@ -1025,8 +1246,10 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     $4::uuid,
     $5::text,
     $6::text,
-    $7::uuid
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "eventId", "cronParentId", "cronSchedule", "scheduledId", input, "parentId", "cronName"
+    $7::uuid,
+    $8::text,
+    $9::uuid
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "eventId", "cronParentId", "cronSchedule", "scheduledId", input, "parentId", "cronName", "actorType", "actorId"
 `
 
 type CreateWorkflowRunTriggeredByParams struct {
@@ -1037,6 +1260,8 @@ type CreateWorkflowRunTriggeredByParams struct {
 	CronSchedule  pgtype.Text `json:"cronSchedule"`
 	CronName      pgtype.Text `json:"cronName"`
 	ScheduledId   pgtype.UUID `json:"scheduledId"`
+	ActorType     pgtype.Text `json:"actorType"`
+	ActorId       pgtype.UUID `json:"actorId"`
 }
 
 func (q *Queries) CreateWorkflowRunTriggeredBy(ctx context.Context, db DBTX, arg CreateWorkflowRunTriggeredByParams) (*WorkflowRunTriggeredBy, error) {
@@ -1048,6 +1273,8 @@ func (q *Queries) CreateWorkflowRunTriggeredBy(ctx context.Context, db DBTX, arg
 		arg.CronSchedule,
 		arg.CronName,
 		arg.ScheduledId,
+		arg.ActorType,
+		arg.ActorId,
 	)
 	var i WorkflowRunTriggeredBy
 	err := row.Scan(
@@ -1063,6 +1290,8 @@ func (q *Queries) CreateWorkflowRunTriggeredBy(ctx context.Context, db DBTX, arg
 		&i.Input,
 		&i.ParentId,
 		&i.CronName,
+		&i.ActorType,
+		&i.ActorId,
 	)
 	return &i, err
 }
@@ -1076,6 +1305,8 @@ type CreateWorkflowRunTriggeredBysParams struct {
 	CronSchedule pgtype.Text `json:"cronSchedule"`
 	CronName     pgtype.Text `json:"cronName"`
 	ScheduledId  pgtype.UUID `json:"scheduledId"`
+	ActorType    pgtype.Text `json:"actorType"`
+	ActorId      pgtype.UUID `json:"actorId"`
 }
 
 type CreateWorkflowRunsParams struct {
@@ -1091,6 +1322,37 @@ type CreateWorkflowRunsParams struct {
 	AdditionalMetadata []byte            `json:"additionalMetadata"`
 	Priority           pgtype.Int4       `json:"priority"`
 	InsertOrder        pgtype.Int4       `json:"insertOrder"`
+	Detached           bool              `json:"detached"`
+	TimeoutAt          pgtype.Timestamp  `json:"timeoutAt"`
+}
+
+const deleteExpiredWorkflowRunIdempotencyKeys = `-- name: DeleteExpiredWorkflowRunIdempotencyKeys :one
+WITH deleted AS (
+    DELETE FROM "WorkflowRunIdempotencyKey"
+    WHERE ("tenantId", "key") IN (
+        SELECT "tenantId", "key"
+        FROM "WorkflowRunIdempotencyKey"
+        WHERE
+            "tenantId" = $1::uuid AND
+            "expiresAt" <= CURRENT_TIMESTAMP
+        ORDER BY "expiresAt" ASC
+        LIMIT $2::integer
+    )
+    RETURNING "key"
+)
+SELECT COUNT(*) AS deleted FROM deleted
+`
+
+type DeleteExpiredWorkflowRunIdempotencyKeysParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    int32       `json:"limit"`
+}
+
+func (q *Queries) DeleteExpiredWorkflowRunIdempotencyKeys(ctx context.Context, db DBTX, arg DeleteExpiredWorkflowRunIdempotencyKeysParams) (int64, error) {
+	row := db.QueryRow(ctx, deleteExpiredWorkflowRunIdempotencyKeys, arg.Tenantid, arg.Limit)
+	var deleted int64
+	err := row.Scan(&deleted)
+	return deleted, err
 }
 
 const deleteScheduledWorkflow = `-- name: DeleteScheduledWorkflow :exec
@@ -1106,7 +1368,7 @@ func (q *Queries) DeleteScheduledWorkflow(ctx context.Context, db DBTX, schedule
 
 const getChildWorkflowRun = `-- name: GetChildWorkflowRun :one
 SELECT
-    "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder"
+    "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", detached, "timeoutAt"
 FROM
     "WorkflowRun"
 WHERE
@@ -1156,13 +1418,15 @@ func (q *Queries) GetChildWorkflowRun(ctx context.Context, db DBTX, arg GetChild
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Detached,
+		&i.TimeoutAt,
 	)
 	return &i, err
 }
 
 const getChildWorkflowRunsByIndex = `-- name: GetChildWorkflowRunsByIndex :many
 SELECT
-    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder"
+    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder", wr.detached, wr."timeoutAt"
 FROM
     "WorkflowRun" wr
 WHERE
@@ -1211,6 +1475,8 @@ func (q *Queries) GetChildWorkflowRunsByIndex(ctx context.Context, db DBTX, arg
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Detached,
+			&i.TimeoutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1224,7 +1490,7 @@ func (q *Queries) GetChildWorkflowRunsByIndex(ctx context.Context, db DBTX, arg
 
 const getChildWorkflowRunsByKey = `-- name: GetChildWorkflowRunsByKey :many
 SELECT
-    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder"
+    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder", wr.detached, wr."timeoutAt"
 FROM
     "WorkflowRun" wr
 WHERE
@@ -1273,6 +1539,8 @@ func (q *Queries) GetChildWorkflowRunsByKey(ctx context.Context, db DBTX, arg Ge
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Detached,
+			&i.TimeoutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1302,7 +1570,7 @@ WHERE
     sr."status" IN ('FAILED', 'CANCELLED') AND
     (
         sr."cancelledReason" IS NULL OR
-        sr."cancelledReason" NOT IN ('CANCELLED_BY_USER', 'PREVIOUS_STEP_TIMED_OUT', 'PREVIOUS_STEP_FAILED', 'PREVIOUS_STEP_CANCELLED', 'CANCELLED_BY_CONCURRENCY_LIMIT')
+        sr."cancelledReason" NOT IN ('CANCELLED_BY_USER', 'PREVIOUS_STEP_TIMED_OUT', 'PREVIOUS_STEP_FAILED', 'PREVIOUS_STEP_CANCELLED', 'CANCELLED_BY_CONCURRENCY_LIMIT', 'SUPERSEDED', 'EXPIRED', 'JOIN_NOT_SATISFIED')
     ) AND
 	wr."id" = $1::uuid AND
     wr."tenantId" = $2::uuid
@@ -1490,7 +1758,7 @@ func (q *Queries) GetStepRunsForJobRunsWithOutput(ctx context.Context, db DBTX,
 const getStepsForJobs = `-- name: GetStepsForJobs :many
 SELECT
 	j."id" as "jobId",
-    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."joinCount", s."retryBackoffBase", s."retryBackoffJitter",
     (
         SELECT array_agg(so."A")::uuid[]  -- Casting the array_agg result to uuid[]
         FROM "_StepOrder" so
@@ -1540,6 +1808,9 @@ func (q *Queries) GetStepsForJobs(ctx context.Context, db DBTX, arg GetStepsForJ
 			&i.Step.RetryBackoffFactor,
 			&i.Step.RetryMaxBackoff,
 			&i.Step.ScheduleTimeout,
+			&i.Step.JoinCount,
+			&i.Step.RetryBackoffBase,
+			&i.Step.RetryBackoffJitter,
 			&i.Parents,
 		); err != nil {
 			return nil, err
@@ -1555,7 +1826,7 @@ func (q *Queries) GetStepsForJobs(ctx context.Context, db DBTX, arg GetStepsForJ
 const getStepsForWorkflowVersion = `-- name: GetStepsForWorkflowVersion :many
 
 SELECT
-    "Step".id, "Step"."createdAt", "Step"."updatedAt", "Step"."deletedAt", "Step"."readableId", "Step"."tenantId", "Step"."jobId", "Step"."actionId", "Step".timeout, "Step"."customUserData", "Step".retries, "Step"."retryBackoffFactor", "Step"."retryMaxBackoff", "Step"."scheduleTimeout"  from "Step"
+    "Step".id, "Step"."createdAt", "Step"."updatedAt", "Step"."deletedAt", "Step"."readableId", "Step"."tenantId", "Step"."jobId", "Step"."actionId", "Step".timeout, "Step"."customUserData", "Step".retries, "Step"."retryBackoffFactor", "Step"."retryMaxBackoff", "Step"."scheduleTimeout", "Step"."joinCount", "Step"."retryBackoffBase", "Step"."retryBackoffJitter"  from "Step"
 JOIN "Job" j ON "Step"."jobId" = j."id"
 WHERE
     j."workflowVersionId" = ANY($1::uuid[])
@@ -1585,6 +1856,9 @@ func (q *Queries) GetStepsForWorkflowVersion(ctx context.Context, db DBTX, workf
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
 			&i.ScheduleTimeout,
+			&i.JoinCount,
+			&i.RetryBackoffBase,
+			&i.RetryBackoffJitter,
 		); err != nil {
 			return nil, err
 		}
@@ -1598,14 +1872,14 @@ func (q *Queries) GetStepsForWorkflowVersion(ctx context.Context, db DBTX, workf
 
 const getWorkflowRun = `-- name: GetWorkflowRun :many
 SELECT
-    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder",
-    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", runtriggers."cronName",
-    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority",
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", runs.detached, runs."timeoutAt",
+    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", runtriggers."cronName", runtriggers."actorType", runtriggers."actorId",
+    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority", workflowversion."runTimeout",
     workflow."name" as "workflowName",
     -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable fields
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
-    workflow."isPaused" as "isPaused",
+    (workflow."isPaused" OR tenant."isPaused") as "isPaused",
     wc."concurrencyGroupExpression" as "concurrencyGroupExpression",
     groupKeyRun."id" as "getGroupKeyRunId"
 FROM
@@ -1620,6 +1894,8 @@ LEFT JOIN
     "WorkflowConcurrency" as wc ON wc."workflowVersionId" = workflowVersion."id"
 LEFT JOIN
     "GetGroupKeyRun" as groupKeyRun ON groupKeyRun."workflowRunId" = runs."id"
+LEFT JOIN
+    "Tenant" as tenant ON tenant."id" = runs."tenantId"
 WHERE
     runs."deletedAt" IS NULL AND
     workflowVersion."deletedAt" IS NULL AND
@@ -1675,6 +1951,8 @@ func (q *Queries) GetWorkflowRun(ctx context.Context, db DBTX, arg GetWorkflowRu
 			&i.WorkflowRun.Duration,
 			&i.WorkflowRun.Priority,
 			&i.WorkflowRun.InsertOrder,
+			&i.WorkflowRun.Detached,
+			&i.WorkflowRun.TimeoutAt,
 			&i.WorkflowRunTriggeredBy.ID,
 			&i.WorkflowRunTriggeredBy.CreatedAt,
 			&i.WorkflowRunTriggeredBy.UpdatedAt,
@@ -1687,6 +1965,8 @@ func (q *Queries) GetWorkflowRun(ctx context.Context, db DBTX, arg GetWorkflowRu
 			&i.WorkflowRunTriggeredBy.Input,
 			&i.WorkflowRunTriggeredBy.ParentId,
 			&i.WorkflowRunTriggeredBy.CronName,
+			&i.WorkflowRunTriggeredBy.ActorType,
+			&i.WorkflowRunTriggeredBy.ActorId,
 			&i.WorkflowVersion.ID,
 			&i.WorkflowVersion.CreatedAt,
 			&i.WorkflowVersion.UpdatedAt,
@@ -1700,6 +1980,7 @@ func (q *Queries) GetWorkflowRun(ctx context.Context, db DBTX, arg GetWorkflowRu
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.RunTimeout,
 			&i.WorkflowName,
 			&i.ConcurrencyLimitStrategy,
 			&i.ConcurrencyMaxRuns,
@@ -1747,10 +2028,10 @@ func (q *Queries) GetWorkflowRunAdditionalMeta(ctx context.Context, db DBTX, arg
 
 const getWorkflowRunById = `-- name: GetWorkflowRunById :one
 SELECT
-    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder",
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority",
+    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder", r.detached, r."timeoutAt",
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."runTimeout",
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
-    tb.id, tb."createdAt", tb."updatedAt", tb."deletedAt", tb."tenantId", tb."eventId", tb."cronParentId", tb."cronSchedule", tb."scheduledId", tb.input, tb."parentId", tb."cronName"
+    tb.id, tb."createdAt", tb."updatedAt", tb."deletedAt", tb."tenantId", tb."eventId", tb."cronParentId", tb."cronSchedule", tb."scheduledId", tb.input, tb."parentId", tb."cronName", tb."actorType", tb."actorId"
 FROM
     "WorkflowRun" r
 JOIN
@@ -1791,6 +2072,8 @@ type GetWorkflowRunByIdRow struct {
 	Duration               pgtype.Int8            `json:"duration"`
 	Priority               pgtype.Int4            `json:"priority"`
 	InsertOrder            pgtype.Int4            `json:"insertOrder"`
+	Detached               bool                   `json:"detached"`
+	TimeoutAt              pgtype.Timestamp       `json:"timeoutAt"`
 	WorkflowVersion        WorkflowVersion        `json:"workflow_version"`
 	Workflow               Workflow               `json:"workflow"`
 	WorkflowRunTriggeredBy WorkflowRunTriggeredBy `json:"workflow_run_triggered_by"`
@@ -1820,6 +2103,8 @@ func (q *Queries) GetWorkflowRunById(ctx context.Context, db DBTX, arg GetWorkfl
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Detached,
+		&i.TimeoutAt,
 		&i.WorkflowVersion.ID,
 		&i.WorkflowVersion.CreatedAt,
 		&i.WorkflowVersion.UpdatedAt,
@@ -1833,6 +2118,7 @@ func (q *Queries) GetWorkflowRunById(ctx context.Context, db DBTX, arg GetWorkfl
 		&i.WorkflowVersion.Sticky,
 		&i.WorkflowVersion.Kind,
 		&i.WorkflowVersion.DefaultPriority,
+		&i.WorkflowVersion.RunTimeout,
 		&i.Workflow.ID,
 		&i.Workflow.CreatedAt,
 		&i.Workflow.UpdatedAt,
@@ -1853,16 +2139,18 @@ func (q *Queries) GetWorkflowRunById(ctx context.Context, db DBTX, arg GetWorkfl
 		&i.WorkflowRunTriggeredBy.Input,
 		&i.WorkflowRunTriggeredBy.ParentId,
 		&i.WorkflowRunTriggeredBy.CronName,
+		&i.WorkflowRunTriggeredBy.ActorType,
+		&i.WorkflowRunTriggeredBy.ActorId,
 	)
 	return &i, err
 }
 
 const getWorkflowRunByIds = `-- name: GetWorkflowRunByIds :many
 SELECT
-    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder",
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority",
+    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder", r.detached, r."timeoutAt",
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."runTimeout",
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
-    tb.id, tb."createdAt", tb."updatedAt", tb."deletedAt", tb."tenantId", tb."eventId", tb."cronParentId", tb."cronSchedule", tb."scheduledId", tb.input, tb."parentId", tb."cronName"
+    tb.id, tb."createdAt", tb."updatedAt", tb."deletedAt", tb."tenantId", tb."eventId", tb."cronParentId", tb."cronSchedule", tb."scheduledId", tb.input, tb."parentId", tb."cronName", tb."actorType", tb."actorId"
 FROM
     "WorkflowRun" r
 JOIN
@@ -1903,6 +2191,8 @@ type GetWorkflowRunByIdsRow struct {
 	Duration               pgtype.Int8            `json:"duration"`
 	Priority               pgtype.Int4            `json:"priority"`
 	InsertOrder            pgtype.Int4            `json:"insertOrder"`
+	Detached               bool                   `json:"detached"`
+	TimeoutAt              pgtype.Timestamp       `json:"timeoutAt"`
 	WorkflowVersion        WorkflowVersion        `json:"workflow_version"`
 	Workflow               Workflow               `json:"workflow"`
 	WorkflowRunTriggeredBy WorkflowRunTriggeredBy `json:"workflow_run_triggered_by"`
@@ -1938,6 +2228,8 @@ func (q *Queries) GetWorkflowRunByIds(ctx context.Context, db DBTX, arg GetWorkf
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Detached,
+			&i.TimeoutAt,
 			&i.WorkflowVersion.ID,
 			&i.WorkflowVersion.CreatedAt,
 			&i.WorkflowVersion.UpdatedAt,
@@ -1951,6 +2243,7 @@ func (q *Queries) GetWorkflowRunByIds(ctx context.Context, db DBTX, arg GetWorkf
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.RunTimeout,
 			&i.Workflow.ID,
 			&i.Workflow.CreatedAt,
 			&i.Workflow.UpdatedAt,
@@ -1971,6 +2264,8 @@ func (q *Queries) GetWorkflowRunByIds(ctx context.Context, db DBTX, arg GetWorkf
 			&i.WorkflowRunTriggeredBy.Input,
 			&i.WorkflowRunTriggeredBy.ParentId,
 			&i.WorkflowRunTriggeredBy.CronName,
+			&i.WorkflowRunTriggeredBy.ActorType,
+			&i.WorkflowRunTriggeredBy.ActorId,
 		); err != nil {
 			return nil, err
 		}
@@ -1982,6 +2277,54 @@ func (q *Queries) GetWorkflowRunByIds(ctx context.Context, db DBTX, arg GetWorkf
 	return items, nil
 }
 
+const getWorkflowRunIdempotencyKey = `-- name: GetWorkflowRunIdempotencyKey :one
+SELECT
+    k."tenantId", k.key, k."workflowRunId", k."createdAt", k."expiresAt",
+    -- keys are shared by all workflows of the tenant, so a key of another workflow must not return its run
+    (wv."workflowId" = (
+        SELECT "workflowId" FROM "WorkflowVersion" WHERE "id" = $1::uuid
+    ))::boolean AS "sameWorkflow"
+FROM
+    "WorkflowRunIdempotencyKey" k
+JOIN
+    "WorkflowRun" wr ON wr."id" = k."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    k."tenantId" = $2::uuid AND
+    k."key" = $3::text AND
+    k."expiresAt" > CURRENT_TIMESTAMP
+`
+
+type GetWorkflowRunIdempotencyKeyParams struct {
+	Workflowversionid pgtype.UUID `json:"workflowversionid"`
+	Tenantid          pgtype.UUID `json:"tenantid"`
+	Key               string      `json:"key"`
+}
+
+type GetWorkflowRunIdempotencyKeyRow struct {
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	Key           string           `json:"key"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	ExpiresAt     pgtype.Timestamp `json:"expiresAt"`
+	SameWorkflow  bool             `json:"sameWorkflow"`
+}
+
+func (q *Queries) GetWorkflowRunIdempotencyKey(ctx context.Context, db DBTX, arg GetWorkflowRunIdempotencyKeyParams) (*GetWorkflowRunIdempotencyKeyRow, error) {
+	row := db.QueryRow(ctx, getWorkflowRunIdempotencyKey, arg.Workflowversionid, arg.Tenantid, arg.Key)
+	var i GetWorkflowRunIdempotencyKeyRow
+	err := row.Scan(
+		&i.TenantId,
+		&i.Key,
+		&i.WorkflowRunId,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.SameWorkflow,
+	)
+	return &i, err
+}
+
 const getWorkflowRunInput = `-- name: GetWorkflowRunInput :one
 SELECT jld."data" AS lookupData
 FROM "JobRun" jr
@@ -2029,7 +2372,7 @@ func (q *Queries) GetWorkflowRunStickyStateForUpdate(ctx context.Context, db DBT
 }
 
 const getWorkflowRunTrigger = `-- name: GetWorkflowRunTrigger :one
-SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "eventId", "cronParentId", "cronSchedule", "scheduledId", input, "parentId", "cronName"
+SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "eventId", "cronParentId", "cronSchedule", "scheduledId", input, "parentId", "cronName", "actorType", "actorId"
 FROM
     "WorkflowRunTriggeredBy"
 WHERE
@@ -2058,12 +2401,14 @@ func (q *Queries) GetWorkflowRunTrigger(ctx context.Context, db DBTX, arg GetWor
 		&i.Input,
 		&i.ParentId,
 		&i.CronName,
+		&i.ActorType,
+		&i.ActorId,
 	)
 	return &i, err
 }
 
 const getWorkflowRunsInsertedInThisTxn = `-- name: GetWorkflowRunsInsertedInThisTxn :many
-SELECT "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder" FROM "WorkflowRun"
+SELECT "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", detached, "timeoutAt" FROM "WorkflowRun"
 WHERE xmin::text = (txid_current() % (2^32)::bigint)::text
 AND ("createdAt" = CURRENT_TIMESTAMP::timestamp(3))
 ORDER BY "insertOrder" ASC
@@ -2099,6 +2444,8 @@ func (q *Queries) GetWorkflowRunsInsertedInThisTxn(ctx context.Context, db DBTX)
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Detached,
+			&i.TimeoutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -2239,6 +2586,223 @@ func (q *Queries) ListChildWorkflowRunCounts(ctx context.Context, db DBTX, stepr
 	return items, nil
 }
 
+const listChildWorkflowRunsToCancel = `-- name: ListChildWorkflowRunsToCancel :many
+SELECT
+    "id"
+FROM
+    "WorkflowRun"
+WHERE
+    "tenantId" = $1::uuid AND
+    "parentStepRunId" = $2::uuid AND
+    "detached" = false AND
+    "deletedAt" IS NULL AND
+    "status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+`
+
+type ListChildWorkflowRunsToCancelParams struct {
+	Tenantid        pgtype.UUID `json:"tenantid"`
+	Parentsteprunid pgtype.UUID `json:"parentsteprunid"`
+}
+
+func (q *Queries) ListChildWorkflowRunsToCancel(ctx context.Context, db DBTX, arg ListChildWorkflowRunsToCancelParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, listChildWorkflowRunsToCancel, arg.Tenantid, arg.Parentsteprunid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConcurrencyKeysForWorkflow = `-- name: ListConcurrencyKeysForWorkflow :many
+SELECT
+    wr."concurrencyGroupId"::text AS "key",
+    COUNT(*) FILTER (WHERE wr."status" = 'RUNNING') AS "running",
+    COUNT(*) FILTER (WHERE wr."status" = 'QUEUED') AS "queued"
+FROM
+    "WorkflowRun" wr
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    wr."tenantId" = $1::uuid
+    AND wv."workflowId" = $2::uuid
+    AND wr."concurrencyGroupId" IS NOT NULL
+    AND (wr."status" = 'RUNNING' OR wr."status" = 'QUEUED')
+    AND wr."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+GROUP BY
+    wr."concurrencyGroupId"
+ORDER BY
+    wr."concurrencyGroupId" ASC
+`
+
+type ListConcurrencyKeysForWorkflowParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+}
+
+type ListConcurrencyKeysForWorkflowRow struct {
+	Key     string `json:"key"`
+	Running int64  `json:"running"`
+	Queued  int64  `json:"queued"`
+}
+
+// Lists the concurrency keys of a workflow which have running or queued workflow runs
+func (q *Queries) ListConcurrencyKeysForWorkflow(ctx context.Context, db DBTX, arg ListConcurrencyKeysForWorkflowParams) ([]*ListConcurrencyKeysForWorkflowRow, error) {
+	rows, err := db.Query(ctx, listConcurrencyKeysForWorkflow, arg.Tenantid, arg.Workflowid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencyKeysForWorkflowRow
+	for rows.Next() {
+		var i ListConcurrencyKeysForWorkflowRow
+		if err := rows.Scan(&i.Key, &i.Running, &i.Queued); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConcurrencySlotHolders = `-- name: ListConcurrencySlotHolders :many
+SELECT
+    wr."id",
+    wr."concurrencyGroupId"::text AS "key",
+    wr."createdAt",
+    wr."startedAt"
+FROM
+    "WorkflowRun" wr
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+WHERE
+    wr."tenantId" = $1::uuid
+    AND wv."workflowId" = $2::uuid
+    AND wr."status" = 'RUNNING'
+    AND wr."concurrencyGroupId" IS NOT NULL
+    AND (
+        $3::text IS NULL OR
+        wr."concurrencyGroupId" = $3::text
+    )
+    AND wr."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+ORDER BY
+    wr."concurrencyGroupId" ASC, wr."startedAt" ASC NULLS LAST, wr."id" ASC
+LIMIT
+    COALESCE($4, 1000)
+`
+
+type ListConcurrencySlotHoldersParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Key        pgtype.Text `json:"key"`
+	Limit      interface{} `json:"limit"`
+}
+
+type ListConcurrencySlotHoldersRow struct {
+	ID        pgtype.UUID      `json:"id"`
+	Key       string           `json:"key"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	StartedAt pgtype.Timestamp `json:"startedAt"`
+}
+
+// Lists the running workflow runs of a workflow which hold concurrency slots
+func (q *Queries) ListConcurrencySlotHolders(ctx context.Context, db DBTX, arg ListConcurrencySlotHoldersParams) ([]*ListConcurrencySlotHoldersRow, error) {
+	rows, err := db.Query(ctx, listConcurrencySlotHolders,
+		arg.Tenantid,
+		arg.Workflowid,
+		arg.Key,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencySlotHoldersRow
+	for rows.Next() {
+		var i ListConcurrencySlotHoldersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Key,
+			&i.CreatedAt,
+			&i.StartedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredWorkflowRuns = `-- name: ListExpiredWorkflowRuns :many
+SELECT
+    wre."workflowRunId",
+    -- runs which already finished don't need to be cancelled
+    (
+        wr."id" IS NULL OR
+        wr."deletedAt" IS NOT NULL OR
+        wr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    )::boolean AS "finished"
+FROM
+    "WorkflowRunExpiry" wre
+LEFT JOIN
+    "WorkflowRun" wr ON wr."id" = wre."workflowRunId"
+WHERE
+    wre."tenantId" = $1::uuid AND
+    wre."expired" = false AND
+    wre."expiresAt" <= NOW()
+ORDER BY
+    wre."expiresAt"
+LIMIT
+    COALESCE($2::int, 1000)
+`
+
+type ListExpiredWorkflowRunsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+type ListExpiredWorkflowRunsRow struct {
+	WorkflowRunId pgtype.UUID `json:"workflowRunId"`
+	Finished      bool        `json:"finished"`
+}
+
+func (q *Queries) ListExpiredWorkflowRuns(ctx context.Context, db DBTX, arg ListExpiredWorkflowRunsParams) ([]*ListExpiredWorkflowRunsRow, error) {
+	rows, err := db.Query(ctx, listExpiredWorkflowRuns, arg.Tenantid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListExpiredWorkflowRunsRow
+	for rows.Next() {
+		var i ListExpiredWorkflowRunsRow
+		if err := rows.Scan(&i.WorkflowRunId, &i.Finished); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listScheduledWorkflows = `-- name: ListScheduledWorkflows :many
 SELECT
     w."name",
@@ -2461,10 +3025,10 @@ func (q *Queries) ListWorkflowRunEventsByWorkflowRunId(ctx context.Context, db D
 
 const listWorkflowRuns = `-- name: ListWorkflowRuns :many
 SELECT
-    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder",
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", runs.detached, runs."timeoutAt",
     workflow.id, workflow."createdAt", workflow."updatedAt", workflow."deletedAt", workflow."tenantId", workflow.name, workflow.description, workflow."isPaused",
-    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", runtriggers."cronName",
-    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority",
+    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", runtriggers."cronName", runtriggers."actorType", runtriggers."actorId",
+    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority", workflowversion."runTimeout",
     -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable events field
     events.id, events.key, events."createdAt", events."updatedAt"
 FROM
@@ -2545,42 +3109,79 @@ WHERE
     (
         $15::timestamp IS NULL OR
         runs."finishedAt" <= $15::timestamp
+    ) AND
+    (
+        $16::text IS NULL OR
+        events."key" = $16::text
+    ) AND
+    (
+        $17::uuid[] IS NULL OR
+        workflowVersion."workflowId" <> ALL($17::uuid[])
+    ) AND
+    (
+        $18::uuid[] IS NULL OR
+        workflowVersion."workflowId" = ANY($18::uuid[])
+    ) AND
+    (
+        $19::text[] IS NULL OR
+        events."key" = ANY($19::text[])
+    ) AND
+    (
+        $20::text IS NULL OR
+        runs."error" ILIKE concat('%', $20::text, '%')
+    ) AND
+    -- keyset pagination, which is only supported when ordering by createdAt
+    (
+        $21::timestamp IS NULL OR
+        ($22 = 'createdAt ASC' AND runs."createdAt" > $21::timestamp) OR
+        ($22 = 'createdAt DESC' AND runs."createdAt" < $21::timestamp) OR
+        (
+            runs."createdAt" = $21::timestamp AND
+            runs."id" > $23::uuid
+        )
     )
 ORDER BY
-    case when $16 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
-    case when $16 = 'createdAt DESC' THEN runs."createdAt" END DESC,
-    case when $16 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
-    case when $16 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
-    case when $16 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
-    case when $16 = 'startedAt DESC' THEN runs."startedAt" END DESC,
-    case when $16 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
-    case when $16 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
+    case when $22 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
+    case when $22 = 'createdAt DESC' THEN runs."createdAt" END DESC,
+    case when $22 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
+    case when $22 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
+    case when $22 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
+    case when $22 = 'startedAt DESC' THEN runs."startedAt" END DESC,
+    case when $22 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
+    case when $22 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
     runs."id" ASC
 OFFSET
-    COALESCE($17, 0)
+    COALESCE($24, 0)
 LIMIT
-    COALESCE($18, 50)
+    COALESCE($25, 50)
 `
 
 type ListWorkflowRunsParams struct {
-	TenantId           pgtype.UUID      `json:"tenantId"`
-	EventId            pgtype.UUID      `json:"eventId"`
-	WorkflowVersionId  pgtype.UUID      `json:"workflowVersionId"`
-	Kinds              []string         `json:"kinds"`
-	WorkflowId         pgtype.UUID      `json:"workflowId"`
-	Ids                []pgtype.UUID    `json:"ids"`
-	AdditionalMetadata []byte           `json:"additionalMetadata"`
-	ParentId           pgtype.UUID      `json:"parentId"`
-	ParentStepRunId    pgtype.UUID      `json:"parentStepRunId"`
-	GroupKey           pgtype.Text      `json:"groupKey"`
-	Statuses           []string         `json:"statuses"`
-	CreatedAfter       pgtype.Timestamp `json:"createdAfter"`
-	CreatedBefore      pgtype.Timestamp `json:"createdBefore"`
-	FinishedAfter      pgtype.Timestamp `json:"finishedAfter"`
-	FinishedBefore     pgtype.Timestamp `json:"finishedBefore"`
-	Orderby            interface{}      `json:"orderby"`
-	Offset             interface{}      `json:"offset"`
-	Limit              interface{}      `json:"limit"`
+	TenantId            pgtype.UUID      `json:"tenantId"`
+	EventId             pgtype.UUID      `json:"eventId"`
+	WorkflowVersionId   pgtype.UUID      `json:"workflowVersionId"`
+	Kinds               []string         `json:"kinds"`
+	WorkflowId          pgtype.UUID      `json:"workflowId"`
+	Ids                 []pgtype.UUID    `json:"ids"`
+	AdditionalMetadata  []byte           `json:"additionalMetadata"`
+	ParentId            pgtype.UUID      `json:"parentId"`
+	ParentStepRunId     pgtype.UUID      `json:"parentStepRunId"`
+	GroupKey            pgtype.Text      `json:"groupKey"`
+	Statuses            []string         `json:"statuses"`
+	CreatedAfter        pgtype.Timestamp `json:"createdAfter"`
+	CreatedBefore       pgtype.Timestamp `json:"createdBefore"`
+	FinishedAfter       pgtype.Timestamp `json:"finishedAfter"`
+	FinishedBefore      pgtype.Timestamp `json:"finishedBefore"`
+	EventKey            pgtype.Text      `json:"eventKey"`
+	ExcludedWorkflowIds []pgtype.UUID    `json:"excludedWorkflowIds"`
+	WorkflowIds         []pgtype.UUID    `json:"workflowIds"`
+	EventKeys           []string         `json:"eventKeys"`
+	Search              pgtype.Text      `json:"search"`
+	CursorCreatedAt     pgtype.Timestamp `json:"cursorCreatedAt"`
+	CursorId            pgtype.UUID      `json:"cursorId"`
+	Orderby             interface{}      `json:"orderby"`
+	Offset              interface{}      `json:"offset"`
+	Limit               interface{}      `json:"limit"`
 }
 
 type ListWorkflowRunsRow struct {
@@ -2611,6 +3212,13 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 		arg.CreatedBefore,
 		arg.FinishedAfter,
 		arg.FinishedBefore,
+		arg.EventKey,
+		arg.ExcludedWorkflowIds,
+		arg.WorkflowIds,
+		arg.EventKeys,
+		arg.Search,
+		arg.CursorCreatedAt,
+		arg.CursorId,
 		arg.Orderby,
 		arg.Offset,
 		arg.Limit,
@@ -2643,6 +3251,8 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowRun.Duration,
 			&i.WorkflowRun.Priority,
 			&i.WorkflowRun.InsertOrder,
+			&i.WorkflowRun.Detached,
+			&i.WorkflowRun.TimeoutAt,
 			&i.Workflow.ID,
 			&i.Workflow.CreatedAt,
 			&i.Workflow.UpdatedAt,
@@ -2663,6 +3273,8 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowRunTriggeredBy.Input,
 			&i.WorkflowRunTriggeredBy.ParentId,
 			&i.WorkflowRunTriggeredBy.CronName,
+			&i.WorkflowRunTriggeredBy.ActorType,
+			&i.WorkflowRunTriggeredBy.ActorId,
 			&i.WorkflowVersion.ID,
 			&i.WorkflowVersion.CreatedAt,
 			&i.WorkflowVersion.UpdatedAt,
@@ -2676,6 +3288,7 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.RunTimeout,
 			&i.ID,
 			&i.Key,
 			&i.CreatedAt,
@@ -2708,7 +3321,7 @@ WITH queued_wrs AS (
         workflowVersion."id" = $2::uuid
 )
 SELECT
-    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder"
+    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder", wr.detached, wr."timeoutAt"
 FROM
     "WorkflowRun" wr
 LEFT JOIN
@@ -2761,6 +3374,8 @@ func (q *Queries) LockWorkflowRunsForQueueing(ctx context.Context, db DBTX, arg
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Detached,
+			&i.TimeoutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -2793,6 +3408,26 @@ func (q *Queries) MarkWorkflowRunsCancelling(ctx context.Context, db DBTX, arg M
 	return err
 }
 
+const markWorkflowRunsExpired = `-- name: MarkWorkflowRunsExpired :exec
+UPDATE
+    "WorkflowRunExpiry"
+SET
+    "expired" = true
+WHERE
+    "tenantId" = $1::uuid AND
+    "workflowRunId" = ANY($2::uuid[])
+`
+
+type MarkWorkflowRunsExpiredParams struct {
+	Tenantid       pgtype.UUID   `json:"tenantid"`
+	Workflowrunids []pgtype.UUID `json:"workflowrunids"`
+}
+
+func (q *Queries) MarkWorkflowRunsExpired(ctx context.Context, db DBTX, arg MarkWorkflowRunsExpiredParams) error {
+	_, err := db.Exec(ctx, markWorkflowRunsExpired, arg.Tenantid, arg.Workflowrunids)
+	return err
+}
+
 const popWorkflowRunsRoundRobin = `-- name: PopWorkflowRunsRoundRobin :many
 WITH workflow_runs AS (
     SELECT
@@ -2854,7 +3489,7 @@ WHERE
     "WorkflowRun".id = eligible_runs.id AND
     "WorkflowRun"."status" = 'QUEUED'
 RETURNING
-    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder"
+    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder", "WorkflowRun".detached, "WorkflowRun"."timeoutAt"
 `
 
 type PopWorkflowRunsRoundRobinParams struct {
@@ -2893,6 +3528,8 @@ func (q *Queries) PopWorkflowRunsRoundRobin(ctx context.Context, db DBTX, arg Po
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Detached,
+			&i.TimeoutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -2904,6 +3541,145 @@ func (q *Queries) PopWorkflowRunsRoundRobin(ctx context.Context, db DBTX, arg Po
 	return items, nil
 }
 
+const purgeDeletedWorkflowRuns = `-- name: PurgeDeletedWorkflowRuns :one
+WITH for_purge AS (
+    SELECT
+        "id"
+    FROM "WorkflowRun" wr2
+    WHERE
+        wr2."tenantId" = $1::uuid AND
+        wr2."deletedAt" IS NOT NULL AND
+        wr2."deletedAt" < $2::timestamp
+    ORDER BY wr2."deletedAt" ASC
+    LIMIT $3 + 1
+    FOR UPDATE SKIP LOCKED
+),
+purged_with_limit AS (
+    SELECT
+        for_purge."id" as "id"
+    FROM for_purge
+    LIMIT $3
+),
+has_more AS (
+    SELECT
+        CASE
+            WHEN COUNT(*) > $3 THEN TRUE
+            ELSE FALSE
+        END as has_more
+    FROM for_purge
+),
+step_runs_to_purge AS (
+    SELECT
+        sr."id"
+    FROM
+        "StepRun" sr
+    JOIN
+        "JobRun" jr ON jr."id" = sr."jobRunId"
+    WHERE
+        jr."workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+-- the tables which reference workflow runs and step runs don't have foreign keys, because those tables are
+-- partitioned, so their rows are deleted explicitly
+purged_step_run_order AS (
+    DELETE FROM "_StepRunOrder"
+    WHERE "A" IN (SELECT "id" FROM step_runs_to_purge) OR "B" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_archives AS (
+    DELETE FROM "StepRunResultArchive"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_events AS (
+    DELETE FROM "StepRunEvent"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_expression_evals AS (
+    DELETE FROM "StepRunExpressionEval"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_log_lines AS (
+    DELETE FROM "LogLine"
+    WHERE "tenantId" = $1::uuid AND "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_stream_events AS (
+    DELETE FROM "StreamEvent"
+    WHERE "tenantId" = $1::uuid AND "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_run_dead_letters AS (
+    DELETE FROM "StepRunDeadLetter"
+    WHERE "stepRunId" IN (SELECT "id" FROM step_runs_to_purge)
+),
+purged_step_runs AS (
+    DELETE FROM "StepRun"
+    WHERE "id" IN (SELECT "id" FROM step_runs_to_purge)
+),
+-- the lookup data of the job runs is deleted by cascade
+purged_job_runs AS (
+    DELETE FROM "JobRun"
+    WHERE "tenantId" = $1::uuid AND "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_get_group_key_runs AS (
+    DELETE FROM "GetGroupKeyRun"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_sticky_states AS (
+    DELETE FROM "WorkflowRunStickyState"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_expiries AS (
+    DELETE FROM "WorkflowRunExpiry"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_idempotency_keys AS (
+    DELETE FROM "WorkflowRunIdempotencyKey"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_child_keys AS (
+    DELETE FROM "WorkflowRunChildKey"
+    WHERE "workflowRunId" IN (SELECT "id" FROM purged_with_limit) OR "parentId" IN (SELECT "id" FROM purged_with_limit)
+),
+-- the children and scheduled runs of the purged workflow runs are kept, without their parent
+orphaned_children AS (
+    UPDATE "WorkflowRun"
+    SET "parentId" = NULL
+    WHERE
+        "parentId" IN (SELECT "id" FROM purged_with_limit) AND
+        "id" NOT IN (SELECT "id" FROM purged_with_limit)
+),
+orphaned_scheduled_refs AS (
+    UPDATE "WorkflowTriggerScheduledRef"
+    SET "parentWorkflowRunId" = NULL
+    WHERE "parentWorkflowRunId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_triggered_by AS (
+    DELETE FROM "WorkflowRunTriggeredBy"
+    WHERE "parentId" IN (SELECT "id" FROM purged_with_limit)
+),
+purged_dedupes AS (
+    DELETE FROM "WorkflowRunDedupe"
+    WHERE "tenantId" = $1::uuid AND "workflowRunId" IN (SELECT "id" FROM purged_with_limit)
+)
+DELETE FROM
+    "WorkflowRun" wr
+WHERE
+    wr."id" IN (SELECT "id" FROM purged_with_limit) AND
+    wr."tenantId" = $1::uuid
+RETURNING
+    (SELECT has_more FROM has_more) as has_more
+`
+
+type PurgeDeletedWorkflowRunsParams struct {
+	Tenantid      pgtype.UUID      `json:"tenantid"`
+	Deletedbefore pgtype.Timestamp `json:"deletedbefore"`
+	Limit         interface{}      `json:"limit"`
+}
+
+func (q *Queries) PurgeDeletedWorkflowRuns(ctx context.Context, db DBTX, arg PurgeDeletedWorkflowRunsParams) (bool, error) {
+	row := db.QueryRow(ctx, purgeDeletedWorkflowRuns, arg.Tenantid, arg.Deletedbefore, arg.Limit)
+	var has_more bool
+	err := row.Scan(&has_more)
+	return has_more, err
+}
+
 const replayWorkflowRunResetJobRun = `-- name: ReplayWorkflowRunResetJobRun :one
 UPDATE
     "JobRun"
@@ -3153,7 +3929,7 @@ SET
 WHERE
     "tenantId" = $5::uuid AND
     "id" = ANY($6::uuid[])
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder", "WorkflowRun".detached, "WorkflowRun"."timeoutAt"
 `
 
 type UpdateManyWorkflowRunParams struct {
@@ -3202,6 +3978,8 @@ func (q *Queries) UpdateManyWorkflowRun(ctx context.Context, db DBTX, arg Update
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Detached,
+			&i.TimeoutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -3248,7 +4026,7 @@ SET
 WHERE
     "id" = $5::uuid AND
     "tenantId" = $6::uuid
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder", "WorkflowRun".detached, "WorkflowRun"."timeoutAt"
 `
 
 type UpdateWorkflowRunParams struct {
@@ -3291,6 +4069,8 @@ func (q *Queries) UpdateWorkflowRun(ctx context.Context, db DBTX, arg UpdateWork
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Detached,
+		&i.TimeoutAt,
 	)
 	return &i, err
 }
@@ -3371,7 +4151,7 @@ FROM
 WHERE
 workflowRun."id" = groupKeyRun."workflowRunId" AND
 workflowRun."tenantId" = $1::uuid
-RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."childIndex", workflowrun."childKey", workflowrun."parentId", workflowrun."parentStepRunId", workflowrun."additionalMetadata", workflowrun.duration, workflowrun.priority, workflowrun."insertOrder"
+RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."childIndex", workflowrun."childKey", workflowrun."parentId", workflowrun."parentStepRunId", workflowrun."additionalMetadata", workflowrun.duration, workflowrun.priority, workflowrun."insertOrder", workflowrun.detached, workflowrun."timeoutAt"
 `
 
 type UpdateWorkflowRunGroupKeyFromRunParams struct {
@@ -3403,6 +4183,8 @@ func (q *Queries) UpdateWorkflowRunGroupKeyFromRun(ctx context.Context, db DBTX,
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Detached,
+		&i.TimeoutAt,
 	)
 	return &i, err
 }
@@ -127,7 +127,8 @@ INSERT INTO "WorkflowVersion" (
     "scheduleTimeout",
     "sticky",
     "kind",
-    "defaultPriority"
+    "defaultPriority",
+    "runTimeout"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -139,7 +140,8 @@ INSERT INTO "WorkflowVersion" (
     coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
     sqlc.narg('sticky')::"StickyStrategy",
     coalesce(sqlc.narg('kind')::"WorkflowKind", 'DAG'),
-    sqlc.narg('defaultPriority')::integer
+    sqlc.narg('defaultPriority')::integer,
+    sqlc.narg('runTimeout')::text
 ) RETURNING *;
 
 -- name: MoveCronTriggerToNewWorkflowTriggers :exec
@@ -231,7 +233,10 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "joinCount",
+    "retryBackoffBase",
+    "retryBackoffJitter"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -246,7 +251,10 @@ INSERT INTO "Step" (
     coalesce(sqlc.narg('retries')::integer, 0),
     coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
     sqlc.narg('retryBackoffFactor'),
-    sqlc.narg('retryMaxBackoff')
+    sqlc.narg('retryMaxBackoff'),
+    sqlc.narg('joinCount')::integer,
+    sqlc.narg('retryBackoffBase')::integer,
+    sqlc.narg('retryBackoffJitter')::float8
 ) RETURNING *;
 
 -- name: AddStepParents :exec
@@ -351,10 +359,12 @@ INSERT INTO "WorkflowTriggers" (
 -- name: CreateWorkflowTriggerEventRef :one
 INSERT INTO "WorkflowTriggerEventRef" (
     "parentId",
-    "eventKey"
+    "eventKey",
+    "filterExpression"
 ) VALUES (
     @workflowTriggersId::uuid,
-    @eventTrigger::text
+    @eventTrigger::text,
+    sqlc.narg('filterExpression')::text
 ) RETURNING *;
 
 -- name: CreateWorkflowTriggerCronRef :one
@@ -365,7 +375,8 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "catchUp"
 ) VALUES (
     @workflowTriggersId::uuid,
     @cronTrigger::text,
@@ -373,7 +384,8 @@ INSERT INTO "WorkflowTriggerCronRef" (
     sqlc.narg('input')::jsonb,
     sqlc.narg('additionalMetadata')::jsonb,
     gen_random_uuid(),
-    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT')
+    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE(sqlc.narg('catchUp')::boolean, false)
 ) RETURNING *;
 
 
@@ -468,7 +480,8 @@ WITH latest_versions AS (
 )
 -- select the workflow versions that have the event trigger
 SELECT
-    latest_versions."workflowVersionId"
+    latest_versions."workflowVersionId",
+    eventRef."filterExpression"
 FROM
     latest_versions
 JOIN
@@ -498,6 +511,20 @@ WHERE
     w."deletedAt" IS NULL AND
     workflowVersions."deletedAt" IS NULL;
 
+-- name: GetStepForWorkflowVersion :one
+SELECT
+    s.*
+FROM
+    "Step" as s
+JOIN
+    "Job" as j ON j."id" = s."jobId"
+WHERE
+    j."workflowVersionId" = @workflowVersionId::uuid AND
+    j."kind" = 'DEFAULT' AND
+    s."tenantId" = @tenantId::uuid AND
+    s."readableId" = @readableId::text AND
+    s."deletedAt" IS NULL;
+
 -- name: GetLatestWorkflowVersionForWorkflows :many
 WITH latest_versions AS (
     SELECT DISTINCT ON (workflowVersions."workflowId")
@@ -672,6 +699,28 @@ WHERE
     "InternalQueueItem"."id" = matching_qis."id"
     AND "data"->>'workflow_id' = @workflowId::text;
 
+-- name: HandleTenantUnpaused :exec
+-- The queue items of workflows which are still paused keep their priority, so they don't
+-- block the workflow runs which can be unpaused.
+UPDATE
+    "InternalQueueItem" qi
+SET
+    "priority" = 4
+WHERE
+    qi."isQueued" = true
+    AND qi."tenantId" = @tenantId::uuid
+    AND qi."queue" = 'WORKFLOW_RUN_PAUSED'
+    AND qi."priority" = 1
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "Workflow" w
+        WHERE
+            w."id"::text = qi."data"->>'workflow_id'
+            AND w."isPaused" = true
+    );
+
 -- name: GetWorkflowWorkerCount :one
 WITH UniqueWorkers AS (
     SELECT DISTINCT w."id" AS workerId
@@ -852,3 +901,112 @@ WHERE
 DELETE FROM "WorkflowTriggerCronRef"
 WHERE
     "id" = @id::uuid;
+
+-- name: ListWorkflowVersionWeights :many
+SELECT
+    *
+FROM
+    "WorkflowVersionWeight"
+WHERE
+    "workflowId" = @workflowId::uuid
+ORDER BY
+    "createdAt" ASC, "workflowVersionId" ASC;
+
+-- name: DeleteWorkflowVersionWeights :exec
+DELETE FROM "WorkflowVersionWeight"
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "workflowId" = @workflowId::uuid;
+
+-- name: CreateWorkflowVersionWeights :many
+INSERT INTO "WorkflowVersionWeight" (
+    "workflowVersionId",
+    "workflowId",
+    "tenantId",
+    "weight"
+)
+SELECT
+    input."workflowVersionId",
+    workflowVersions."workflowId",
+    workflows."tenantId",
+    input."weight"
+FROM
+    (
+        SELECT
+            unnest(@workflowVersionIds::uuid[]) AS "workflowVersionId",
+            unnest(@weights::integer[]) AS "weight"
+    ) AS input
+JOIN
+    "WorkflowVersion" as workflowVersions ON workflowVersions."id" = input."workflowVersionId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = workflowVersions."workflowId"
+WHERE
+    workflowVersions."workflowId" = @workflowId::uuid AND
+    workflowVersions."deletedAt" IS NULL AND
+    workflows."tenantId" = @tenantId::uuid
+RETURNING *;
+
+-- name: GetWorkflowLatestVersionWeight :one
+SELECT
+    *
+FROM
+    "WorkflowLatestVersionWeight"
+WHERE
+    "workflowId" = @workflowId::uuid;
+
+-- name: DeleteWorkflowLatestVersionWeight :exec
+DELETE FROM "WorkflowLatestVersionWeight"
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "workflowId" = @workflowId::uuid;
+
+-- name: CreateWorkflowLatestVersionWeight :one
+INSERT INTO "WorkflowLatestVersionWeight" (
+    "workflowId",
+    "tenantId",
+    "weight"
+) VALUES (
+    @workflowId::uuid,
+    @tenantId::uuid,
+    @weight::integer
+)
+RETURNING *;
+
+-- name: CountWorkflowRunsByVersion :many
+SELECT
+    runs."workflowVersionId",
+    COUNT(*) AS "total",
+    COUNT(*) FILTER (WHERE runs."status" = 'SUCCEEDED') AS "succeeded",
+    COUNT(*) FILTER (WHERE runs."status" = 'FAILED') AS "failed",
+    COUNT(*) FILTER (WHERE runs."status" = 'CANCELLED') AS "cancelled"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersions ON workflowVersions."id" = runs."workflowVersionId"
+WHERE
+    runs."tenantId" = @tenantId::uuid AND
+    runs."deletedAt" IS NULL AND
+    runs."createdAt" >= @since::timestamp AND
+    workflowVersions."workflowId" = @workflowId::uuid
+GROUP BY
+    runs."workflowVersionId";
+
+-- name: ListStepsForWorkflowVersion :many
+SELECT
+    j."kind" AS "jobKind",
+    sqlc.embed(s),
+    COALESCE((
+        SELECT array_agg(parent."readableId" ORDER BY parent."readableId")
+        FROM "_StepOrder" so
+        JOIN "Step" parent ON parent."id" = so."A"
+        WHERE so."B" = s."id"
+    ), '{}')::text[] AS "parents"
+FROM "Job" j
+JOIN "Step" s ON s."jobId" = j."id"
+WHERE
+    j."workflowVersionId" = @workflowVersionId::uuid
+    AND j."tenantId" = @tenantId::uuid
+    AND j."deletedAt" IS NULL
+    AND s."deletedAt" IS NULL
+ORDER BY
+    j."kind", s."readableId";
@@ -133,6 +133,66 @@ func (q *Queries) CountRoundRobinGroupKeys(ctx context.Context, db DBTX, arg Cou
 	return total, err
 }
 
+const countWorkflowRunsByVersion = `-- name: CountWorkflowRunsByVersion :many
+SELECT
+    runs."workflowVersionId",
+    COUNT(*) AS "total",
+    COUNT(*) FILTER (WHERE runs."status" = 'SUCCEEDED') AS "succeeded",
+    COUNT(*) FILTER (WHERE runs."status" = 'FAILED') AS "failed",
+    COUNT(*) FILTER (WHERE runs."status" = 'CANCELLED') AS "cancelled"
+FROM
+    "WorkflowRun" as runs
+JOIN
+    "WorkflowVersion" as workflowVersions ON workflowVersions."id" = runs."workflowVersionId"
+WHERE
+    runs."tenantId" = $1::uuid AND
+    runs."deletedAt" IS NULL AND
+    runs."createdAt" >= $2::timestamp AND
+    workflowVersions."workflowId" = $3::uuid
+GROUP BY
+    runs."workflowVersionId"
+`
+
+type CountWorkflowRunsByVersionParams struct {
+	Tenantid   pgtype.UUID      `json:"tenantid"`
+	Since      pgtype.Timestamp `json:"since"`
+	Workflowid pgtype.UUID      `json:"workflowid"`
+}
+
+type CountWorkflowRunsByVersionRow struct {
+	WorkflowVersionId pgtype.UUID `json:"workflowVersionId"`
+	Total             int64       `json:"total"`
+	Succeeded         int64       `json:"succeeded"`
+	Failed            int64       `json:"failed"`
+	Cancelled         int64       `json:"cancelled"`
+}
+
+func (q *Queries) CountWorkflowRunsByVersion(ctx context.Context, db DBTX, arg CountWorkflowRunsByVersionParams) ([]*CountWorkflowRunsByVersionRow, error) {
+	rows, err := db.Query(ctx, countWorkflowRunsByVersion, arg.Tenantid, arg.Since, arg.Workflowid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CountWorkflowRunsByVersionRow
+	for rows.Next() {
+		var i CountWorkflowRunsByVersionRow
+		if err := rows.Scan(
+			&i.WorkflowVersionId,
+			&i.Total,
+			&i.Succeeded,
+			&i.Failed,
+			&i.Cancelled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countWorkflowRunsRoundRobin = `-- name: CountWorkflowRunsRoundRobin :one
 SELECT COUNT(*) AS total
 FROM
@@ -368,7 +428,10 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "joinCount",
+    "retryBackoffBase",
+    "retryBackoffJitter"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -383,8 +446,11 @@ INSERT INTO "Step" (
     coalesce($11::integer, 0),
     coalesce($12::text, '5m'),
     $13,
-    $14
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout"
+    $14,
+    $15::integer,
+    $16::integer,
+    $17::float8
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout", "joinCount", "retryBackoffBase", "retryBackoffJitter"
 `
 
 type CreateStepParams struct {
@@ -402,6 +468,9 @@ type CreateStepParams struct {
 	ScheduleTimeout    pgtype.Text      `json:"scheduleTimeout"`
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
+	JoinCount          pgtype.Int4      `json:"joinCount"`
+	RetryBackoffBase   pgtype.Int4      `json:"retryBackoffBase"`
+	RetryBackoffJitter pgtype.Float8    `json:"retryBackoffJitter"`
 }
 
 func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams) (*Step, error) {
@@ -420,6 +489,9 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		arg.ScheduleTimeout,
 		arg.RetryBackoffFactor,
 		arg.RetryMaxBackoff,
+		arg.JoinCount,
+		arg.RetryBackoffBase,
+		arg.RetryBackoffJitter,
 	)
 	var i Step
 	err := row.Scan(
@@ -437,6 +509,9 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		&i.RetryBackoffFactor,
 		&i.RetryMaxBackoff,
 		&i.ScheduleTimeout,
+		&i.JoinCount,
+		&i.RetryBackoffBase,
+		&i.RetryBackoffJitter,
 	)
 	return &i, err
 }
@@ -627,6 +702,37 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 	return &i, err
 }
 
+const createWorkflowLatestVersionWeight = `-- name: CreateWorkflowLatestVersionWeight :one
+INSERT INTO "WorkflowLatestVersionWeight" (
+    "workflowId",
+    "tenantId",
+    "weight"
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::integer
+)
+RETURNING "workflowId", "tenantId", weight, "createdAt"
+`
+
+type CreateWorkflowLatestVersionWeightParams struct {
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Weight     int32       `json:"weight"`
+}
+
+func (q *Queries) CreateWorkflowLatestVersionWeight(ctx context.Context, db DBTX, arg CreateWorkflowLatestVersionWeightParams) (*WorkflowLatestVersionWeight, error) {
+	row := db.QueryRow(ctx, createWorkflowLatestVersionWeight, arg.Workflowid, arg.Tenantid, arg.Weight)
+	var i WorkflowLatestVersionWeight
+	err := row.Scan(
+		&i.WorkflowId,
+		&i.TenantId,
+		&i.Weight,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
 const createWorkflowTriggerCronRef = `-- name: CreateWorkflowTriggerCronRef :one
 INSERT INTO "WorkflowTriggerCronRef" (
     "parentId",
@@ -635,7 +741,8 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "catchUp"
 ) VALUES (
     $1::uuid,
     $2::text,
@@ -643,8 +750,9 @@ INSERT INTO "WorkflowTriggerCronRef" (
     $4::jsonb,
     $5::jsonb,
     gen_random_uuid(),
-    COALESCE($6::"WorkflowTriggerCronRefMethods", 'DEFAULT')
-) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method
+    COALESCE($6::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE($7::boolean, false)
+) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, "catchUp", "lastFiredAt"
 `
 
 type CreateWorkflowTriggerCronRefParams struct {
@@ -654,6 +762,7 @@ type CreateWorkflowTriggerCronRefParams struct {
 	Input              []byte                            `json:"input"`
 	AdditionalMetadata []byte                            `json:"additionalMetadata"`
 	Method             NullWorkflowTriggerCronRefMethods `json:"method"`
+	CatchUp            pgtype.Bool                       `json:"catchUp"`
 }
 
 func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg CreateWorkflowTriggerCronRefParams) (*WorkflowTriggerCronRef, error) {
@@ -664,6 +773,7 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 		arg.Input,
 		arg.AdditionalMetadata,
 		arg.Method,
+		arg.CatchUp,
 	)
 	var i WorkflowTriggerCronRef
 	err := row.Scan(
@@ -679,6 +789,8 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 		&i.Name,
 		&i.ID,
 		&i.Method,
+		&i.CatchUp,
+		&i.LastFiredAt,
 	)
 	return &i, err
 }
@@ -712,7 +824,7 @@ INSERT INTO "WorkflowTriggerCronRef" (
     $4::jsonb,
     gen_random_uuid(),
     COALESCE($5::"WorkflowTriggerCronRefMethods", 'DEFAULT')
-) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method
+) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, "catchUp", "lastFiredAt"
 `
 
 type CreateWorkflowTriggerCronRefForWorkflowParams struct {
@@ -747,6 +859,8 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 		&i.Name,
 		&i.ID,
 		&i.Method,
+		&i.CatchUp,
+		&i.LastFiredAt,
 	)
 	return &i, err
 }
@@ -754,22 +868,25 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 const createWorkflowTriggerEventRef = `-- name: CreateWorkflowTriggerEventRef :one
 INSERT INTO "WorkflowTriggerEventRef" (
     "parentId",
-    "eventKey"
+    "eventKey",
+    "filterExpression"
 ) VALUES (
     $1::uuid,
-    $2::text
-) RETURNING "parentId", "eventKey"
+    $2::text,
+    $3::text
+) RETURNING "parentId", "eventKey", "filterExpression"
 `
 
 type CreateWorkflowTriggerEventRefParams struct {
 	Workflowtriggersid pgtype.UUID `json:"workflowtriggersid"`
 	Eventtrigger       string      `json:"eventtrigger"`
+	FilterExpression   pgtype.Text `json:"filterExpression"`
 }
 
 func (q *Queries) CreateWorkflowTriggerEventRef(ctx context.Context, db DBTX, arg CreateWorkflowTriggerEventRefParams) (*WorkflowTriggerEventRef, error) {
-	row := db.QueryRow(ctx, createWorkflowTriggerEventRef, arg.Workflowtriggersid, arg.Eventtrigger)
+	row := db.QueryRow(ctx, createWorkflowTriggerEventRef, arg.Workflowtriggersid, arg.Eventtrigger, arg.FilterExpression)
 	var i WorkflowTriggerEventRef
-	err := row.Scan(&i.ParentId, &i.EventKey)
+	err := row.Scan(&i.ParentId, &i.EventKey, &i.FilterExpression)
 	return &i, err
 }
 
@@ -939,7 +1056,8 @@ INSERT INTO "WorkflowVersion" (
     "scheduleTimeout",
     "sticky",
     "kind",
-    "defaultPriority"
+    "defaultPriority",
+    "runTimeout"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -951,8 +1069,9 @@ INSERT INTO "WorkflowVersion" (
     coalesce($8::text, '5m'),
     $9::"StickyStrategy",
     coalesce($10::"WorkflowKind", 'DAG'),
-    $11::integer
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority"
+    $11::integer,
+    $12::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "runTimeout"
 `
 
 type CreateWorkflowVersionParams struct {
@@ -967,6 +1086,7 @@ type CreateWorkflowVersionParams struct {
 	Sticky          NullStickyStrategy `json:"sticky"`
 	Kind            NullWorkflowKind   `json:"kind"`
 	DefaultPriority pgtype.Int4        `json:"defaultPriority"`
+	RunTimeout      pgtype.Text        `json:"runTimeout"`
 }
 
 func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg CreateWorkflowVersionParams) (*WorkflowVersion, error) {
@@ -982,6 +1102,7 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		arg.Sticky,
 		arg.Kind,
 		arg.DefaultPriority,
+		arg.RunTimeout,
 	)
 	var i WorkflowVersion
 	err := row.Scan(
@@ -998,10 +1119,95 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		&i.Sticky,
 		&i.Kind,
 		&i.DefaultPriority,
+		&i.RunTimeout,
 	)
 	return &i, err
 }
 
+const createWorkflowVersionWeights = `-- name: CreateWorkflowVersionWeights :many
+INSERT INTO "WorkflowVersionWeight" (
+    "workflowVersionId",
+    "workflowId",
+    "tenantId",
+    "weight"
+)
+SELECT
+    input."workflowVersionId",
+    workflowVersions."workflowId",
+    workflows."tenantId",
+    input."weight"
+FROM
+    (
+        SELECT
+            unnest($1::uuid[]) AS "workflowVersionId",
+            unnest($2::integer[]) AS "weight"
+    ) AS input
+JOIN
+    "WorkflowVersion" as workflowVersions ON workflowVersions."id" = input."workflowVersionId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = workflowVersions."workflowId"
+WHERE
+    workflowVersions."workflowId" = $3::uuid AND
+    workflowVersions."deletedAt" IS NULL AND
+    workflows."tenantId" = $4::uuid
+RETURNING "workflowVersionId", "workflowId", "tenantId", weight, "createdAt"
+`
+
+type CreateWorkflowVersionWeightsParams struct {
+	Workflowversionids []pgtype.UUID `json:"workflowversionids"`
+	Weights            []int32       `json:"weights"`
+	Workflowid         pgtype.UUID   `json:"workflowid"`
+	Tenantid           pgtype.UUID   `json:"tenantid"`
+}
+
+func (q *Queries) CreateWorkflowVersionWeights(ctx context.Context, db DBTX, arg CreateWorkflowVersionWeightsParams) ([]*WorkflowVersionWeight, error) {
+	rows, err := db.Query(ctx, createWorkflowVersionWeights,
+		arg.Workflowversionids,
+		arg.Weights,
+		arg.Workflowid,
+		arg.Tenantid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WorkflowVersionWeight
+	for rows.Next() {
+		var i WorkflowVersionWeight
+		if err := rows.Scan(
+			&i.WorkflowVersionId,
+			&i.WorkflowId,
+			&i.TenantId,
+			&i.Weight,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteWorkflowLatestVersionWeight = `-- name: DeleteWorkflowLatestVersionWeight :exec
+DELETE FROM "WorkflowLatestVersionWeight"
+WHERE
+    "tenantId" = $1::uuid AND
+    "workflowId" = $2::uuid
+`
+
+type DeleteWorkflowLatestVersionWeightParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+}
+
+func (q *Queries) DeleteWorkflowLatestVersionWeight(ctx context.Context, db DBTX, arg DeleteWorkflowLatestVersionWeightParams) error {
+	_, err := db.Exec(ctx, deleteWorkflowLatestVersionWeight, arg.Tenantid, arg.Workflowid)
+	return err
+}
+
 const deleteWorkflowTriggerCronRef = `-- name: DeleteWorkflowTriggerCronRef :exec
 DELETE FROM "WorkflowTriggerCronRef"
 WHERE
@@ -1013,6 +1219,23 @@ func (q *Queries) DeleteWorkflowTriggerCronRef(ctx context.Context, db DBTX, id
 	return err
 }
 
+const deleteWorkflowVersionWeights = `-- name: DeleteWorkflowVersionWeights :exec
+DELETE FROM "WorkflowVersionWeight"
+WHERE
+    "tenantId" = $1::uuid AND
+    "workflowId" = $2::uuid
+`
+
+type DeleteWorkflowVersionWeightsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+}
+
+func (q *Queries) DeleteWorkflowVersionWeights(ctx context.Context, db DBTX, arg DeleteWorkflowVersionWeightsParams) error {
+	_, err := db.Exec(ctx, deleteWorkflowVersionWeights, arg.Tenantid, arg.Workflowid)
+	return err
+}
+
 const getLatestWorkflowVersionForWorkflows = `-- name: GetLatestWorkflowVersionForWorkflows :many
 WITH latest_versions AS (
     SELECT DISTINCT ON (workflowVersions."workflowId")
@@ -1068,6 +1291,52 @@ func (q *Queries) GetLatestWorkflowVersionForWorkflows(ctx context.Context, db D
 	return items, nil
 }
 
+const getStepForWorkflowVersion = `-- name: GetStepForWorkflowVersion :one
+SELECT
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."joinCount", s."retryBackoffBase", s."retryBackoffJitter"
+FROM
+    "Step" as s
+JOIN
+    "Job" as j ON j."id" = s."jobId"
+WHERE
+    j."workflowVersionId" = $1::uuid AND
+    j."kind" = 'DEFAULT' AND
+    s."tenantId" = $2::uuid AND
+    s."readableId" = $3::text AND
+    s."deletedAt" IS NULL
+`
+
+type GetStepForWorkflowVersionParams struct {
+	Workflowversionid pgtype.UUID `json:"workflowversionid"`
+	Tenantid          pgtype.UUID `json:"tenantid"`
+	Readableid        string      `json:"readableid"`
+}
+
+func (q *Queries) GetStepForWorkflowVersion(ctx context.Context, db DBTX, arg GetStepForWorkflowVersionParams) (*Step, error) {
+	row := db.QueryRow(ctx, getStepForWorkflowVersion, arg.Workflowversionid, arg.Tenantid, arg.Readableid)
+	var i Step
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReadableId,
+		&i.TenantId,
+		&i.JobId,
+		&i.ActionId,
+		&i.Timeout,
+		&i.CustomUserData,
+		&i.Retries,
+		&i.RetryBackoffFactor,
+		&i.RetryMaxBackoff,
+		&i.ScheduleTimeout,
+		&i.JoinCount,
+		&i.RetryBackoffBase,
+		&i.RetryBackoffJitter,
+	)
+	return &i, err
+}
+
 const getWorkflowById = `-- name: GetWorkflowById :one
 SELECT
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
@@ -1157,9 +1426,30 @@ func (q *Queries) GetWorkflowLatestVersion(ctx context.Context, db DBTX, workflo
 	return id, err
 }
 
+const getWorkflowLatestVersionWeight = `-- name: GetWorkflowLatestVersionWeight :one
+SELECT
+    "workflowId", "tenantId", weight, "createdAt"
+FROM
+    "WorkflowLatestVersionWeight"
+WHERE
+    "workflowId" = $1::uuid
+`
+
+func (q *Queries) GetWorkflowLatestVersionWeight(ctx context.Context, db DBTX, workflowid pgtype.UUID) (*WorkflowLatestVersionWeight, error) {
+	row := db.QueryRow(ctx, getWorkflowLatestVersionWeight, workflowid)
+	var i WorkflowLatestVersionWeight
+	err := row.Scan(
+		&i.WorkflowId,
+		&i.TenantId,
+		&i.Weight,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
 const getWorkflowVersionById = `-- name: GetWorkflowVersionById :one
 SELECT
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority",
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."runTimeout",
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
     wc."id" as "concurrencyId",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -1201,6 +1491,7 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id pgtype
 		&i.WorkflowVersion.Sticky,
 		&i.WorkflowVersion.Kind,
 		&i.WorkflowVersion.DefaultPriority,
+		&i.WorkflowVersion.RunTimeout,
 		&i.Workflow.ID,
 		&i.Workflow.CreatedAt,
 		&i.Workflow.UpdatedAt,
@@ -1219,7 +1510,7 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id pgtype
 
 const getWorkflowVersionCronTriggerRefs = `-- name: GetWorkflowVersionCronTriggerRefs :many
 SELECT
-    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method
+    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method, wtc."catchUp", wtc."lastFiredAt"
 FROM
     "WorkflowTriggerCronRef" as wtc
 JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
@@ -1249,6 +1540,8 @@ func (q *Queries) GetWorkflowVersionCronTriggerRefs(ctx context.Context, db DBTX
 			&i.Name,
 			&i.ID,
 			&i.Method,
+			&i.CatchUp,
+			&i.LastFiredAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1262,7 +1555,7 @@ func (q *Queries) GetWorkflowVersionCronTriggerRefs(ctx context.Context, db DBTX
 
 const getWorkflowVersionEventTriggerRefs = `-- name: GetWorkflowVersionEventTriggerRefs :many
 SELECT
-    wtc."parentId", wtc."eventKey"
+    wtc."parentId", wtc."eventKey", wtc."filterExpression"
 FROM
     "WorkflowTriggerEventRef" as wtc
 JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
@@ -1279,7 +1572,7 @@ func (q *Queries) GetWorkflowVersionEventTriggerRefs(ctx context.Context, db DBT
 	var items []*WorkflowTriggerEventRef
 	for rows.Next() {
 		var i WorkflowTriggerEventRef
-		if err := rows.Scan(&i.ParentId, &i.EventKey); err != nil {
+		if err := rows.Scan(&i.ParentId, &i.EventKey, &i.FilterExpression); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1292,7 +1585,7 @@ func (q *Queries) GetWorkflowVersionEventTriggerRefs(ctx context.Context, db DBT
 
 const getWorkflowVersionForEngine = `-- name: GetWorkflowVersionForEngine :many
 SELECT
-    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority",
+    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions."runTimeout",
     w."name" as "workflowName",
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -1348,6 +1641,7 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.RunTimeout,
 			&i.WorkflowName,
 			&i.ConcurrencyLimitStrategy,
 			&i.ConcurrencyMaxRuns,
@@ -1506,6 +1800,34 @@ func (q *Queries) GetWorkflowsByNames(ctx context.Context, db DBTX, arg GetWorkf
 	return items, nil
 }
 
+const handleTenantUnpaused = `-- name: HandleTenantUnpaused :exec
+UPDATE
+    "InternalQueueItem" qi
+SET
+    "priority" = 4
+WHERE
+    qi."isQueued" = true
+    AND qi."tenantId" = $1::uuid
+    AND qi."queue" = 'WORKFLOW_RUN_PAUSED'
+    AND qi."priority" = 1
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "Workflow" w
+        WHERE
+            w."id"::text = qi."data"->>'workflow_id'
+            AND w."isPaused" = true
+    )
+`
+
+// The queue items of workflows which are still paused keep their priority, so they don't
+// block the workflow runs which can be unpaused.
+func (q *Queries) HandleTenantUnpaused(ctx context.Context, db DBTX, tenantid pgtype.UUID) error {
+	_, err := db.Exec(ctx, handleTenantUnpaused, tenantid)
+	return err
+}
+
 const handleWorkflowUnpaused = `-- name: HandleWorkflowUnpaused :exec
 WITH matching_qis AS (
     -- We know that we're going to need to scan all the queue items in this queue
@@ -1546,7 +1868,7 @@ const linkOnFailureJob = `-- name: LinkOnFailureJob :one
 UPDATE "WorkflowVersion"
 SET "onFailureJobId" = $1::uuid
 WHERE "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "runTimeout"
 `
 
 type LinkOnFailureJobParams struct {
@@ -1571,6 +1893,7 @@ func (q *Queries) LinkOnFailureJob(ctx context.Context, db DBTX, arg LinkOnFailu
 		&i.Sticky,
 		&i.Kind,
 		&i.DefaultPriority,
+		&i.RunTimeout,
 	)
 	return &i, err
 }
@@ -1597,7 +1920,7 @@ SELECT
     t."id" as "triggerId",
     c."id" as "cronId",
     t.id, t."createdAt", t."updatedAt", t."deletedAt", t."workflowVersionId", t."tenantId",
-    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method
+    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method, c."catchUp", c."lastFiredAt"
 FROM
     latest_versions
 JOIN
@@ -1660,6 +1983,8 @@ type ListCronWorkflowsRow struct {
 	Name                pgtype.Text                   `json:"name"`
 	ID_2                pgtype.UUID                   `json:"id_2"`
 	Method              WorkflowTriggerCronRefMethods `json:"method"`
+	CatchUp             bool                          `json:"catchUp"`
+	LastFiredAt         pgtype.Timestamp              `json:"lastFiredAt"`
 }
 
 // Get all of the latest workflow versions for the tenant
@@ -1705,6 +2030,8 @@ func (q *Queries) ListCronWorkflows(ctx context.Context, db DBTX, arg ListCronWo
 			&i.Name,
 			&i.ID_2,
 			&i.Method,
+			&i.CatchUp,
+			&i.LastFiredAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1747,6 +2074,115 @@ func (q *Queries) ListPausedWorkflows(ctx context.Context, db DBTX, tenantid pgt
 	return items, nil
 }
 
+const listStepsForWorkflowVersion = `-- name: ListStepsForWorkflowVersion :many
+SELECT
+    j."kind" AS "jobKind",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."joinCount", s."retryBackoffBase", s."retryBackoffJitter",
+    COALESCE((
+        SELECT array_agg(parent."readableId" ORDER BY parent."readableId")
+        FROM "_StepOrder" so
+        JOIN "Step" parent ON parent."id" = so."A"
+        WHERE so."B" = s."id"
+    ), '{}')::text[] AS "parents"
+FROM "Job" j
+JOIN "Step" s ON s."jobId" = j."id"
+WHERE
+    j."workflowVersionId" = $1::uuid
+    AND j."tenantId" = $2::uuid
+    AND j."deletedAt" IS NULL
+    AND s."deletedAt" IS NULL
+ORDER BY
+    j."kind", s."readableId"
+`
+
+type ListStepsForWorkflowVersionParams struct {
+	Workflowversionid pgtype.UUID `json:"workflowversionid"`
+	Tenantid          pgtype.UUID `json:"tenantid"`
+}
+
+type ListStepsForWorkflowVersionRow struct {
+	JobKind JobKind  `json:"jobKind"`
+	Step    Step     `json:"step"`
+	Parents []string `json:"parents"`
+}
+
+func (q *Queries) ListStepsForWorkflowVersion(ctx context.Context, db DBTX, arg ListStepsForWorkflowVersionParams) ([]*ListStepsForWorkflowVersionRow, error) {
+	rows, err := db.Query(ctx, listStepsForWorkflowVersion, arg.Workflowversionid, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepsForWorkflowVersionRow
+	for rows.Next() {
+		var i ListStepsForWorkflowVersionRow
+		if err := rows.Scan(
+			&i.JobKind,
+			&i.Step.ID,
+			&i.Step.CreatedAt,
+			&i.Step.UpdatedAt,
+			&i.Step.DeletedAt,
+			&i.Step.ReadableId,
+			&i.Step.TenantId,
+			&i.Step.JobId,
+			&i.Step.ActionId,
+			&i.Step.Timeout,
+			&i.Step.CustomUserData,
+			&i.Step.Retries,
+			&i.Step.RetryBackoffFactor,
+			&i.Step.RetryMaxBackoff,
+			&i.Step.ScheduleTimeout,
+			&i.Step.JoinCount,
+			&i.Step.RetryBackoffBase,
+			&i.Step.RetryBackoffJitter,
+			&i.Parents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowVersionWeights = `-- name: ListWorkflowVersionWeights :many
+SELECT
+    "workflowVersionId", "workflowId", "tenantId", weight, "createdAt"
+FROM
+    "WorkflowVersionWeight"
+WHERE
+    "workflowId" = $1::uuid
+ORDER BY
+    "createdAt" ASC, "workflowVersionId" ASC
+`
+
+func (q *Queries) ListWorkflowVersionWeights(ctx context.Context, db DBTX, workflowid pgtype.UUID) ([]*WorkflowVersionWeight, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionWeights, workflowid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WorkflowVersionWeight
+	for rows.Next() {
+		var i WorkflowVersionWeight
+		if err := rows.Scan(
+			&i.WorkflowVersionId,
+			&i.WorkflowId,
+			&i.TenantId,
+			&i.Weight,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflows = `-- name: ListWorkflows :many
 SELECT
     workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused"
@@ -1829,7 +2265,8 @@ WITH latest_versions AS (
     ORDER BY "workflowId", "order" DESC
 )
 SELECT
-    latest_versions."workflowVersionId"
+    latest_versions."workflowVersionId",
+    eventRef."filterExpression"
 FROM
     latest_versions
 JOIN
@@ -1845,21 +2282,26 @@ type ListWorkflowsForEventParams struct {
 	Tenantid pgtype.UUID `json:"tenantid"`
 }
 
+type ListWorkflowsForEventRow struct {
+	WorkflowVersionId pgtype.UUID `json:"workflowVersionId"`
+	FilterExpression  pgtype.Text `json:"filterExpression"`
+}
+
 // Get all of the latest workflow versions for the tenant
 // select the workflow versions that have the event trigger
-func (q *Queries) ListWorkflowsForEvent(ctx context.Context, db DBTX, arg ListWorkflowsForEventParams) ([]pgtype.UUID, error) {
+func (q *Queries) ListWorkflowsForEvent(ctx context.Context, db DBTX, arg ListWorkflowsForEventParams) ([]*ListWorkflowsForEventRow, error) {
 	rows, err := db.Query(ctx, listWorkflowsForEvent, arg.Eventkey, arg.Tenantid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []pgtype.UUID
+	var items []*ListWorkflowsForEventRow
 	for rows.Next() {
-		var workflowVersionId pgtype.UUID
-		if err := rows.Scan(&workflowVersionId); err != nil {
+		var i ListWorkflowsForEventRow
+		if err := rows.Scan(&i.WorkflowVersionId, &i.FilterExpression); err != nil {
 			return nil, err
 		}
-		items = append(items, workflowVersionId)
+		items = append(items, &i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -1869,7 +2311,7 @@ func (q *Queries) ListWorkflowsForEvent(ctx context.Context, db DBTX, arg ListWo
 
 const listWorkflowsLatestRuns = `-- name: ListWorkflowsLatestRuns :many
 SELECT
-    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", workflow."id" as "workflowId"
+    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", runs.detached, runs."timeoutAt", workflow."id" as "workflowId"
 FROM
     "WorkflowRun" as runs
 LEFT JOIN
@@ -1951,6 +2393,8 @@ func (q *Queries) ListWorkflowsLatestRuns(ctx context.Context, db DBTX, arg List
 			&i.WorkflowRun.Duration,
 			&i.WorkflowRun.Priority,
 			&i.WorkflowRun.InsertOrder,
+			&i.WorkflowRun.Detached,
+			&i.WorkflowRun.TimeoutAt,
 			&i.WorkflowId,
 		); err != nil {
 			return nil, err
@@ -196,6 +196,52 @@ func (r *eventAPIRepository) ListEventsById(tenantId string, ids []string) ([]db
 	).Exec(context.Background())
 }
 
+func (r *eventAPIRepository) ListEventWorkflowRuns(ctx context.Context, tenantId, eventId string, opts *repository.ListEventWorkflowRunsOpts) (*repository.ListEventWorkflowRunsResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgEventId := sqlchelpers.UUIDFromStr(eventId)
+
+	listParams := dbsqlc.ListEventWorkflowRunsParams{
+		Eventid:  pgEventId,
+		Tenantid: pgTenantId,
+	}
+
+	if opts.Offset != nil {
+		listParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		listParams.Limit = *opts.Limit
+	}
+
+	runs, err := r.queries.ListEventWorkflowRuns(ctx, r.pool, listParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list event workflow runs: %w", err)
+	}
+
+	if runs == nil {
+		runs = make([]*dbsqlc.ListEventWorkflowRunsRow, 0)
+	}
+
+	count, err := r.queries.CountEventWorkflowRuns(ctx, r.pool, dbsqlc.CountEventWorkflowRunsParams{
+		Eventid:  pgEventId,
+		Tenantid: pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count event workflow runs: %w", err)
+	}
+
+	return &repository.ListEventWorkflowRunsResult{
+		Rows:  runs,
+		Count: int(count),
+	}, nil
+}
+
 type eventEngineRepository struct {
 	*sharedRepository
 
@@ -274,18 +320,38 @@ func (r *eventEngineRepository) CreateEvent(ctx context.Context, opts *repositor
 			return nil, nil, err
 		}
 
-		createOpts := repository.CreateEventOpts{
-			TenantId:           opts.TenantId,
-			Key:                opts.Key,
-			Data:               opts.Data,
-			AdditionalMetadata: opts.AdditionalMetadata,
-			ReplayedEvent:      opts.ReplayedEvent,
-		}
+		var event *dbsqlc.Event
+		var err error
 
-		event, err := r.bulkUserEventBuffer.FireAndWait(ctx, opts.TenantId, &createOpts)
+		if opts.ExternalId != nil {
+			// events with an external id are not buffered, so that duplicates do not fail the whole batch
+			event, err = r.createEventWithExternalId(ctx, opts)
 
-		if err != nil {
-			return nil, nil, fmt.Errorf("could not buffer event: %w", err)
+			if err != nil {
+				return nil, nil, err
+			}
+		} else {
+			createOpts := repository.CreateEventOpts{
+				TenantId:           opts.TenantId,
+				Key:                opts.Key,
+				Data:               opts.Data,
+				AdditionalMetadata: opts.AdditionalMetadata,
+				ReplayedEvent:      opts.ReplayedEvent,
+			}
+
+			event, err = r.bulkUserEventBuffer.FireAndWait(ctx, opts.TenantId, &createOpts)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not buffer event: %w", err)
+			}
+
+			if opts.OrderingKey != nil {
+				err = r.createOrderedEvents(ctx, r.pool, opts.TenantId, []pgtype.UUID{event.ID}, []*repository.CreateEventOpts{opts})
+
+				if err != nil {
+					return nil, nil, fmt.Errorf("could not create ordered event: %w", err)
+				}
+			}
 		}
 
 		for _, cb := range r.callbacks {
@@ -302,6 +368,115 @@ func (r *eventEngineRepository) CreateEvent(ctx context.Context, opts *repositor
 	})
 }
 
+// createEventWithExternalId creates the event and claims its external id in the same transaction, so that
+// concurrent pushes of the same external id create at most one event.
+func (r *eventEngineRepository) createEventWithExternalId(ctx context.Context, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	err = r.createEventKeys(ctx, tx, map[string]struct {
+		key      string
+		tenantId string
+	}{
+		fmt.Sprintf("%s-%s", opts.TenantId, opts.Key): {
+			key:      opts.Key,
+			tenantId: opts.TenantId,
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create event keys: %w", err)
+	}
+
+	params := dbsqlc.CreateEventParams{
+		ID:                 sqlchelpers.UUIDFromStr(uuid.New().String()),
+		Key:                opts.Key,
+		Tenantid:           sqlchelpers.UUIDFromStr(opts.TenantId),
+		Data:               opts.Data,
+		Additionalmetadata: opts.AdditionalMetadata,
+	}
+
+	if opts.ReplayedEvent != nil {
+		params.ReplayedFromId = sqlchelpers.UUIDFromStr(*opts.ReplayedEvent)
+	}
+
+	event, err := r.queries.CreateEvent(ctx, tx, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create event: %w", err)
+	}
+
+	externalIdParams := dbsqlc.CreateEventExternalIdParams{
+		Tenantid:   event.TenantId,
+		Externalid: *opts.ExternalId,
+		Eventid:    event.ID,
+	}
+
+	if opts.ExternalIdExpiresAt != nil {
+		externalIdParams.ExpiresAt = sqlchelpers.TimestampFromTime(*opts.ExternalIdExpiresAt)
+	}
+
+	// waits for concurrent transactions which claim the same external id
+	claimed, err := r.queries.CreateEventExternalId(ctx, tx, externalIdParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create event external id: %w", err)
+	}
+
+	if claimed == 0 {
+		existing, err := r.queries.GetEventByExternalId(ctx, tx, dbsqlc.GetEventByExternalIdParams{
+			Tenantid:   event.TenantId,
+			Externalid: *opts.ExternalId,
+		})
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("could not get event by external id: %w", err)
+		}
+
+		existsErr := repository.ErrEventExternalIdExists{
+			ExternalId: *opts.ExternalId,
+		}
+
+		if err == nil {
+			existsErr.Event = existing
+
+			runIds, err := r.queries.ListWorkflowRunIdsForEvent(ctx, tx, dbsqlc.ListWorkflowRunIdsForEventParams{
+				Eventid:  existing.ID,
+				Tenantid: existing.TenantId,
+			})
+
+			if err != nil {
+				return nil, fmt.Errorf("could not list workflow runs of event: %w", err)
+			}
+
+			for _, runId := range runIds {
+				existsErr.WorkflowRunIds = append(existsErr.WorkflowRunIds, sqlchelpers.UUIDToStr(runId))
+			}
+		}
+
+		return nil, existsErr
+	}
+
+	err = r.createOrderedEvents(ctx, tx, opts.TenantId, []pgtype.UUID{event.ID}, []*repository.CreateEventOpts{opts})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create ordered event: %w", err)
+	}
+
+	err = commit(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
 func (r *eventEngineRepository) BulkCreateEvent(ctx context.Context, opts *repository.BulkCreateEventOpts) (*repository.BulkCreateEventResult, error) {
 
 	numberOfResources := len(opts.Events)
@@ -334,6 +509,8 @@ func (r *eventEngineRepository) BulkCreateEvent(ctx context.Context, opts *repos
 				TenantId:           sqlchelpers.UUIDFromStr(event.TenantId),
 				Data:               event.Data,
 				AdditionalMetadata: event.AdditionalMetadata,
+				// returns the events in the order of opts.Events
+				InsertOrder: sqlchelpers.ToInt(int32(i)),
 			}
 
 			if event.ReplayedEvent != nil {
@@ -383,6 +560,13 @@ func (r *eventEngineRepository) BulkCreateEvent(ctx context.Context, opts *repos
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not retrieve inserted events: %w", err)
 		}
+
+		err = r.createOrderedEvents(ctx, tx, opts.TenantId, ids, opts.Events)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create ordered events: %w", err)
+		}
+
 		err = tx.Commit(ctx)
 
 		if err != nil {
@@ -550,3 +734,284 @@ func (r *eventEngineRepository) ClearEventPayloadData(ctx context.Context, tenan
 
 	return hasMore, nil
 }
+
+func (r *eventEngineRepository) DeleteExpiredExternalIds(ctx context.Context, tenantId string) (bool, error) {
+	deleted, err := r.queries.DeleteExpiredEventExternalIds(ctx, r.pool, dbsqlc.DeleteExpiredEventExternalIdsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    1000,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete expired event external ids: %w", err)
+	}
+
+	return deleted == 1000, nil
+}
+
+func (r *eventEngineRepository) PurgeDeletedEvents(ctx context.Context, tenantId string, deletedBefore time.Time) (bool, error) {
+	hasMore, err := r.queries.PurgeDeletedEvents(ctx, r.pool, dbsqlc.PurgeDeletedEventsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Deletedbefore: sqlchelpers.TimestampFromTime(deletedBefore),
+		Limit:         1000,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return hasMore, nil
+}
+
+// createOrderedEvents stores the ordering keys of the events which have one. ids and opts must have the same length.
+func (r *eventEngineRepository) createOrderedEvents(ctx context.Context, dbtx dbsqlc.DBTX, tenantId string, ids []pgtype.UUID, opts []*repository.CreateEventOpts) error {
+	params := dbsqlc.CreateOrderedEventsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	for i, opt := range opts {
+		if opt.OrderingKey == nil {
+			continue
+		}
+
+		var sequence int64
+
+		if opt.OrderingSequence != nil {
+			sequence = *opt.OrderingSequence
+		}
+
+		params.Eventids = append(params.Eventids, ids[i])
+		params.Orderingkeys = append(params.Orderingkeys, *opt.OrderingKey)
+		params.Sequences = append(params.Sequences, sequence)
+	}
+
+	if len(params.Eventids) == 0 {
+		return nil
+	}
+
+	return r.queries.CreateOrderedEvents(ctx, dbtx, params)
+}
+
+// orderedEventLease is the time after which a released event which was never marked as triggered is released
+// again, in case the engine which released it stopped before it could trigger the event's workflow runs.
+const orderedEventLease = 5 * time.Minute
+
+func (r *eventEngineRepository) ReleaseOrderedEvent(ctx context.Context, tenantId, orderingKey string, releaseSequence *int64, maxBuffered int) (*dbsqlc.Event, error) {
+	ctx, span := telemetry.NewSpan(ctx, "db-release-ordered-event")
+	defer span.End()
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	// locks the ordering key until the transaction is committed
+	key, err := r.queries.UpsertEventOrderingKey(ctx, tx, dbsqlc.UpsertEventOrderingKeyParams{
+		Tenantid: pgTenantId,
+		Key:      orderingKey,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get ordering key: %w", err)
+	}
+
+	// another event of the key was released since the release was requested, e.g. the release task of a completed
+	// event was redelivered
+	if releaseSequence != nil && *releaseSequence != key.ReleaseSequence {
+		return nil, nil
+	}
+
+	var released *dbsqlc.Event
+
+	if key.ActiveEventId.Valid {
+		if key.ActiveTriggered || time.Since(key.UpdatedAt.Time) < orderedEventLease {
+			return nil, nil
+		}
+
+		// the engine which released the event may have stopped after creating the workflow runs but before marking
+		// the event as triggered, in which case the event must not be triggered again
+		hasRuns, err := r.queries.HasWorkflowRunsForEvent(ctx, tx, dbsqlc.HasWorkflowRunsForEventParams{
+			Eventid:  key.ActiveEventId,
+			Tenantid: pgTenantId,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not check workflow runs of active event: %w", err)
+		}
+
+		if hasRuns {
+			activeEventId := sqlchelpers.UUIDToStr(key.ActiveEventId)
+
+			r.l.Warn().Msgf("ordered event %s was not marked as triggered within %s, but has workflow runs", activeEventId, orderedEventLease)
+
+			if err := commit(ctx); err != nil {
+				return nil, err
+			}
+
+			next, err := r.MarkOrderedEventTriggered(ctx, tenantId, activeEventId)
+
+			if err != nil || next == nil {
+				return nil, err
+			}
+
+			return r.ReleaseOrderedEvent(ctx, tenantId, orderingKey, &next.ReleaseSequence, maxBuffered)
+		}
+
+		r.l.Warn().Msgf("ordered event %s was not triggered within %s, releasing it again", sqlchelpers.UUIDToStr(key.ActiveEventId), orderedEventLease)
+
+		event, err := r.queries.GetEventForEngine(ctx, tx, key.ActiveEventId)
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("could not get active event: %w", err)
+		}
+
+		if err == nil {
+			released = event
+		}
+	}
+
+	for released == nil {
+		next, err := r.queries.GetNextOrderedEvent(ctx, tx, dbsqlc.GetNextOrderedEventParams{
+			Tenantid:    pgTenantId,
+			Orderingkey: orderingKey,
+		})
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get next ordered event: %w", err)
+		}
+
+		// a gap in the sequence numbers holds back the event until the missing events arrive or the buffer is full
+		if next.Sequence.Valid && next.Sequence.Int64 > key.LastSequence+1 && next.PendingCount < int64(maxBuffered) {
+			break
+		}
+
+		err = r.queries.ReleaseOrderedEvent(ctx, tx, next.EventId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not release ordered event: %w", err)
+		}
+
+		if next.Sequence.Valid {
+			// the sequence number was already released, so the event is a duplicate
+			if next.Sequence.Int64 <= key.LastSequence {
+				r.l.Warn().Msgf("skipping event %s with already processed sequence number %d for ordering key %s", sqlchelpers.UUIDToStr(next.EventId), next.Sequence.Int64, orderingKey)
+				continue
+			}
+
+			key.LastSequence = next.Sequence.Int64
+		}
+
+		event, err := r.queries.GetEventForEngine(ctx, tx, next.EventId)
+
+		// events which were deleted in the meantime are skipped
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get ordered event: %w", err)
+		}
+
+		released = event
+	}
+
+	params := dbsqlc.SetEventOrderingKeyActiveParams{
+		Tenantid: pgTenantId,
+		Key:      orderingKey,
+		Sequence: pgtype.Int8{Int64: key.LastSequence, Valid: true},
+	}
+
+	if released != nil {
+		params.ActiveEventId = released.ID
+	}
+
+	err = r.queries.SetEventOrderingKeyActive(ctx, tx, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not set active ordered event: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
+
+func (r *eventEngineRepository) MarkOrderedEventTriggered(ctx context.Context, tenantId, eventId string) (*repository.OrderingKeyRelease, error) {
+	err := r.queries.SetOrderedEventTriggered(ctx, r.pool, dbsqlc.SetOrderedEventTriggeredParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventid:  sqlchelpers.UUIDFromStr(eventId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not mark ordered event as triggered: %w", err)
+	}
+
+	// the workflow runs may have finished before the event was marked as triggered, in which case completing
+	// them didn't complete the event
+	return r.CompleteOrderedEvent(ctx, tenantId, eventId)
+}
+
+func (r *eventEngineRepository) CompleteOrderedEvent(ctx context.Context, tenantId, eventId string) (*repository.OrderingKeyRelease, error) {
+	key, err := r.queries.CompleteOrderedEvent(ctx, r.pool, dbsqlc.CompleteOrderedEventParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventid:  sqlchelpers.UUIDFromStr(eventId),
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not complete ordered event: %w", err)
+	}
+
+	return &repository.OrderingKeyRelease{
+		TenantId:        tenantId,
+		OrderingKey:     key.Key,
+		ReleaseSequence: key.ReleaseSequence,
+	}, nil
+}
+
+func (r *eventEngineRepository) HasWorkflowRuns(ctx context.Context, tenantId, eventId string) (bool, error) {
+	return r.queries.HasWorkflowRunsForEvent(ctx, r.pool, dbsqlc.HasWorkflowRunsForEventParams{
+		Eventid:  sqlchelpers.UUIDFromStr(eventId),
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+}
+
+func (r *eventEngineRepository) ListStuckOrderingKeys(ctx context.Context, limit int) ([]*repository.OrderingKeyRelease, error) {
+	keys, err := r.queries.ListStuckEventOrderingKeys(ctx, r.pool, dbsqlc.ListStuckEventOrderingKeysParams{
+		Updatedbefore: sqlchelpers.TimestampFromTime(time.Now().Add(-orderedEventLease).UTC()),
+		Limit:         int32(limit), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list stuck ordering keys: %w", err)
+	}
+
+	res := make([]*repository.OrderingKeyRelease, 0, len(keys))
+
+	for _, key := range keys {
+		res = append(res, &repository.OrderingKeyRelease{
+			TenantId:        sqlchelpers.UUIDToStr(key.TenantId),
+			OrderingKey:     key.Key,
+			ReleaseSequence: key.ReleaseSequence,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,404 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestReleaseOrderedEventBuffersSequenceGaps(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		events := conf.EngineRepository.Event()
+
+		second := createOrderedEvent(t, conf, tenantId, "key", 2)
+		third := createOrderedEvent(t, conf, tenantId, "key", 3)
+
+		// the first sequence number is missing, so nothing is released
+		released, err := events.ReleaseOrderedEvent(ctx, tenantId, "key", nil, 10)
+		require.NoError(t, err)
+		assert.Nil(t, released)
+
+		first := createOrderedEvent(t, conf, tenantId, "key", 1)
+
+		released, err = events.ReleaseOrderedEvent(ctx, tenantId, "key", nil, 10)
+		require.NoError(t, err)
+		require.NotNil(t, released)
+		assert.Equal(t, first, sqlchelpers.UUIDToStr(released.ID))
+
+		// the first event is in progress
+		released, err = events.ReleaseOrderedEvent(ctx, tenantId, "key", nil, 10)
+		require.NoError(t, err)
+		assert.Nil(t, released)
+
+		for _, next := range []string{second, third} {
+			release, err := events.MarkOrderedEventTriggered(ctx, tenantId, sqlchelpers.UUIDToStr(released.ID))
+			require.NoError(t, err)
+			require.NotNil(t, release, "an event without workflow runs should complete right away")
+
+			released, err = events.ReleaseOrderedEvent(ctx, tenantId, "key", &release.ReleaseSequence, 10)
+			require.NoError(t, err)
+			require.NotNil(t, released)
+			assert.Equal(t, next, sqlchelpers.UUIDToStr(released.ID))
+		}
+
+		return nil
+	})
+}
+
+func TestReleaseOrderedEventReleasesGapsWhenBufferIsFull(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+
+		createOrderedEvent(t, conf, tenantId, "key", 3)
+		fourth := createOrderedEvent(t, conf, tenantId, "key", 4)
+
+		released, err := conf.EngineRepository.Event().ReleaseOrderedEvent(ctx, tenantId, "key", nil, 2)
+		require.NoError(t, err)
+		require.NotNil(t, released)
+
+		release, err := conf.EngineRepository.Event().MarkOrderedEventTriggered(ctx, tenantId, sqlchelpers.UUIDToStr(released.ID))
+		require.NoError(t, err)
+		require.NotNil(t, release)
+
+		// a late event with an already released sequence number is skipped
+		createOrderedEvent(t, conf, tenantId, "key", 1)
+
+		released, err = conf.EngineRepository.Event().ReleaseOrderedEvent(ctx, tenantId, "key", &release.ReleaseSequence, 2)
+		require.NoError(t, err)
+		require.NotNil(t, released)
+		assert.Equal(t, fourth, sqlchelpers.UUIDToStr(released.ID))
+
+		return nil
+	})
+}
+
+func TestReleaseOrderedEventIgnoresStaleReleases(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		events := conf.EngineRepository.Event()
+
+		first := createOrderedEvent(t, conf, tenantId, "key", 0)
+		createOrderedEvent(t, conf, tenantId, "key", 0)
+
+		released, err := events.ReleaseOrderedEvent(ctx, tenantId, "key", nil, 10)
+		require.NoError(t, err)
+		require.NotNil(t, released)
+		assert.Equal(t, first, sqlchelpers.UUIDToStr(released.ID))
+
+		release, err := events.MarkOrderedEventTriggered(ctx, tenantId, first)
+		require.NoError(t, err)
+		require.NotNil(t, release)
+
+		released, err = events.ReleaseOrderedEvent(ctx, tenantId, "key", &release.ReleaseSequence, 10)
+		require.NoError(t, err)
+		require.NotNil(t, released)
+
+		// a redelivered release task must not release the following event
+		released, err = events.ReleaseOrderedEvent(ctx, tenantId, "key", &release.ReleaseSequence, 10)
+		require.NoError(t, err)
+		assert.Nil(t, released)
+
+		// neither must a redelivered completion
+		release, err = events.CompleteOrderedEvent(ctx, tenantId, first)
+		require.NoError(t, err)
+		assert.Nil(t, release)
+
+		return nil
+	})
+}
+
+func TestReleaseOrderedEventReleasesAgainAfterLease(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		events := conf.EngineRepository.Event()
+
+		first := createOrderedEvent(t, conf, tenantId, "key", 0)
+
+		released, err := events.ReleaseOrderedEvent(ctx, tenantId, "key", nil, 10)
+		require.NoError(t, err)
+		require.NotNil(t, released)
+
+		stuck, err := events.ListStuckOrderingKeys(ctx, 1000)
+		require.NoError(t, err)
+		assert.NotContains(t, stuckKeys(stuck), tenantId+"/key", "the key should not be stuck within the lease")
+
+		expireOrderedEventLease(t, conf, tenantId, "key")
+
+		stuck, err = events.ListStuckOrderingKeys(ctx, 1000)
+		require.NoError(t, err)
+		require.Contains(t, stuckKeys(stuck), tenantId+"/key")
+
+		var release *repository.OrderingKeyRelease
+
+		for _, key := range stuck {
+			if key.TenantId == tenantId {
+				release = key
+			}
+		}
+
+		// the event has no workflow runs, so it is released again
+		released, err = events.ReleaseOrderedEvent(ctx, tenantId, "key", &release.ReleaseSequence, 10)
+		require.NoError(t, err)
+		require.NotNil(t, released)
+		assert.Equal(t, first, sqlchelpers.UUIDToStr(released.ID))
+
+		// the release renewed the lease
+		released, err = events.ReleaseOrderedEvent(ctx, tenantId, "key", nil, 10)
+		require.NoError(t, err)
+		assert.Nil(t, released)
+
+		return nil
+	})
+}
+
+func TestCreateEventWithExistingExternalIdReturnsOriginalEvent(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		externalId := "order-1"
+
+		opts := &repository.CreateEventOpts{
+			TenantId:   tenantId,
+			Key:        "order:created",
+			Data:       []byte("{}"),
+			ExternalId: &externalId,
+		}
+
+		original, err := conf.EngineRepository.Event().CreateEvent(ctx, opts)
+		require.NoError(t, err)
+
+		_, err = conf.EngineRepository.Event().CreateEvent(ctx, opts)
+
+		existsErr := repository.ErrEventExternalIdExists{}
+		require.ErrorAs(t, err, &existsErr)
+		require.NotNil(t, existsErr.Event)
+		assert.Equal(t, sqlchelpers.UUIDToStr(original.ID), sqlchelpers.UUIDToStr(existsErr.Event.ID))
+
+		hasRuns, err := conf.EngineRepository.Event().HasWorkflowRuns(ctx, tenantId, sqlchelpers.UUIDToStr(original.ID))
+		require.NoError(t, err)
+		assert.False(t, hasRuns)
+
+		return nil
+	})
+}
+
+func TestListEventWorkflowRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		event, err := conf.EngineRepository.Event().CreateEvent(ctx, &repository.CreateEventOpts{
+			TenantId: tenantId,
+			Key:      "order:created",
+			Data:     []byte("{}"),
+		})
+		require.NoError(t, err)
+
+		eventId := sqlchelpers.UUIDToStr(event.ID)
+
+		listRuns := func(offset, limit int) *repository.ListEventWorkflowRunsResult {
+			res, err := conf.APIRepository.Event().ListEventWorkflowRuns(ctx, tenantId, eventId, &repository.ListEventWorkflowRunsOpts{
+				Offset: &offset,
+				Limit:  &limit,
+			})
+			require.NoError(t, err)
+
+			return res
+		}
+
+		// an event which triggered no runs has none to list
+		hasRuns, err := conf.EngineRepository.Event().HasWorkflowRuns(ctx, tenantId, eventId)
+		require.NoError(t, err)
+		assert.False(t, hasRuns)
+
+		empty := listRuns(0, 50)
+		assert.NotNil(t, empty.Rows)
+		assert.Empty(t, empty.Rows)
+		assert.Equal(t, 0, empty.Count)
+
+		workflowRunIds := make([]string, 0, 3)
+
+		for range 3 {
+			opts, err := repository.GetCreateWorkflowRunOptsFromEvent(eventId, workflowVersion, []byte("{}"), nil)
+			require.NoError(t, err)
+
+			workflowRun, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+			require.NoError(t, err)
+
+			workflowRunIds = append(workflowRunIds, sqlchelpers.UUIDToStr(workflowRun.ID))
+		}
+
+		hasRuns, err = conf.EngineRepository.Event().HasWorkflowRuns(ctx, tenantId, eventId)
+		require.NoError(t, err)
+		assert.True(t, hasRuns)
+
+		// the runs are listed in the order in which they were created, a page at a time
+		firstPage := listRuns(0, 2)
+		require.Len(t, firstPage.Rows, 2)
+		assert.Equal(t, 3, firstPage.Count)
+		assert.Equal(t, workflowRunIds[0], sqlchelpers.UUIDToStr(firstPage.Rows[0].WorkflowRunId))
+		assert.Equal(t, workflowRunIds[1], sqlchelpers.UUIDToStr(firstPage.Rows[1].WorkflowRunId))
+		assert.Equal(t, "test-workflow", firstPage.Rows[0].WorkflowName)
+
+		lastPage := listRuns(2, 2)
+		require.Len(t, lastPage.Rows, 1)
+		assert.Equal(t, 3, lastPage.Count)
+		assert.Equal(t, workflowRunIds[2], sqlchelpers.UUIDToStr(lastPage.Rows[0].WorkflowRunId))
+
+		// deleted runs are neither listed nor counted
+		_, err = conf.Pool.Exec(ctx, `UPDATE "WorkflowRun" SET "deletedAt" = CURRENT_TIMESTAMP WHERE "id" = $1::uuid`, workflowRunIds[0])
+		require.NoError(t, err)
+
+		remaining := listRuns(0, 50)
+		require.Len(t, remaining.Rows, 2)
+		assert.Equal(t, 2, remaining.Count)
+		assert.Equal(t, workflowRunIds[1], sqlchelpers.UUIDToStr(remaining.Rows[0].WorkflowRunId))
+
+		// the runs of an event aren't visible to other tenants
+		otherTenantId := createOrderedEventTenant(t, conf)
+
+		hasRuns, err = conf.EngineRepository.Event().HasWorkflowRuns(ctx, otherTenantId, eventId)
+		require.NoError(t, err)
+		assert.False(t, hasRuns)
+
+		return nil
+	})
+}
+
+func TestPurgeDeletedEvents(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		externalId := "order-1"
+
+		purged, err := conf.EngineRepository.Event().CreateEvent(ctx, &repository.CreateEventOpts{
+			TenantId:    tenantId,
+			Key:         "order:created",
+			Data:        []byte("{}"),
+			ExternalId:  &externalId,
+			OrderingKey: repository.StringPtr("order-1"),
+		})
+		require.NoError(t, err)
+
+		purgedId := sqlchelpers.UUIDToStr(purged.ID)
+		keptId := createOrderedEvent(t, conf, tenantId, "order-2", 0)
+
+		_, err = conf.Pool.Exec(ctx, `UPDATE "Event" SET "deletedAt" = CURRENT_TIMESTAMP - INTERVAL '1 hour' WHERE "id" = $1::uuid`, purgedId)
+		require.NoError(t, err)
+
+		hasMore, err := conf.EngineRepository.Event().PurgeDeletedEvents(ctx, tenantId, time.Now().UTC())
+		require.NoError(t, err)
+		assert.False(t, hasMore)
+
+		// the event and the rows which referenced it are deleted, and the other event is kept
+		for _, query := range []string{
+			`SELECT COUNT(*) FROM "Event" WHERE "id" = $1::uuid`,
+			`SELECT COUNT(*) FROM "OrderedEvent" WHERE "eventId" = $1::uuid`,
+			`SELECT COUNT(*) FROM "EventExternalId" WHERE "eventId" = $1::uuid`,
+		} {
+			assert.Equal(t, 0, countTestRows(t, conf, query, purgedId), query)
+		}
+
+		assert.Equal(t, 1, countTestRows(t, conf, `SELECT COUNT(*) FROM "Event" WHERE "id" = $1::uuid`, keptId))
+
+		// the external id can be used again
+		_, err = conf.EngineRepository.Event().CreateEvent(ctx, &repository.CreateEventOpts{
+			TenantId:   tenantId,
+			Key:        "order:created",
+			Data:       []byte("{}"),
+			ExternalId: &externalId,
+		})
+		assert.NoError(t, err)
+
+		return nil
+	})
+}
+
+func createOrderedEventTenant(t *testing.T, conf *database.Config) string {
+	t.Helper()
+
+	tenantId := uuid.New().String()
+
+	_, err := conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", tenantId),
+	})
+
+	require.NoError(t, err)
+
+	return tenantId
+}
+
+// createOrderedEvent creates an event with the ordering key, and with the sequence number unless it is 0.
+func createOrderedEvent(t *testing.T, conf *database.Config, tenantId, orderingKey string, sequence int64) string {
+	t.Helper()
+
+	opts := &repository.CreateEventOpts{
+		TenantId:    tenantId,
+		Key:         "ordered:event",
+		Data:        []byte("{}"),
+		OrderingKey: &orderingKey,
+	}
+
+	if sequence != 0 {
+		opts.OrderingSequence = &sequence
+	}
+
+	event, err := conf.EngineRepository.Event().CreateEvent(context.Background(), opts)
+
+	require.NoError(t, err)
+
+	return sqlchelpers.UUIDToStr(event.ID)
+}
+
+// expireOrderedEventLease moves the ordering key and its pending events back in time, past the lease.
+func expireOrderedEventLease(t *testing.T, conf *database.Config, tenantId, orderingKey string) {
+	t.Helper()
+
+	_, err := conf.Pool.Exec(
+		context.Background(),
+		`UPDATE "EventOrderingKey" SET "updatedAt" = "updatedAt" - INTERVAL '1 hour' WHERE "tenantId" = $1::uuid AND "key" = $2`,
+		tenantId,
+		orderingKey,
+	)
+
+	require.NoError(t, err)
+
+	_, err = conf.Pool.Exec(
+		context.Background(),
+		`UPDATE "OrderedEvent" SET "createdAt" = "createdAt" - INTERVAL '1 hour' WHERE "tenantId" = $1::uuid AND "orderingKey" = $2`,
+		tenantId,
+		orderingKey,
+	)
+
+	require.NoError(t, err)
+}
+
+func stuckKeys(releases []*repository.OrderingKeyRelease) []string {
+	keys := make([]string, 0, len(releases))
+
+	for _, release := range releases {
+		keys = append(keys, release.TenantId+"/"+release.OrderingKey)
+	}
+
+	return keys
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -21,9 +22,10 @@ type getGroupKeyRunRepository struct {
 	v       validator.Validator
 	l       *zerolog.Logger
 	queries *dbsqlc.Queries
+	cf      *server.ConfigFileRuntime
 }
 
-func NewGetGroupKeyRunRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.GetGroupKeyRunEngineRepository {
+func NewGetGroupKeyRunRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, cf *server.ConfigFileRuntime) repository.GetGroupKeyRunEngineRepository {
 	queries := dbsqlc.New()
 
 	return &getGroupKeyRunRepository{
@@ -31,6 +33,7 @@ func NewGetGroupKeyRunRepository(pool *pgxpool.Pool, v validator.Validator, l *z
 		v:       v,
 		l:       l,
 		queries: queries,
+		cf:      cf,
 	}
 }
 
@@ -39,7 +42,10 @@ func (s *getGroupKeyRunRepository) ListGetGroupKeyRunsToRequeue(ctx context.Cont
 }
 
 func (s *getGroupKeyRunRepository) ListGetGroupKeyRunsToReassign(ctx context.Context, tenantId string) ([]*dbsqlc.GetGroupKeyRun, error) {
-	return s.queries.ListGetGroupKeyRunsToReassign(ctx, s.pool, sqlchelpers.UUIDFromStr(tenantId))
+	return s.queries.ListGetGroupKeyRunsToReassign(ctx, s.pool, dbsqlc.ListGetGroupKeyRunsToReassignParams{
+		Tenantid:         sqlchelpers.UUIDFromStr(tenantId),
+		Heartbeattimeout: sqlchelpers.DurationToPgInterval(s.cf.WorkerHeartbeatTimeout),
+	})
 }
 
 func (s *getGroupKeyRunRepository) AssignGetGroupKeyRunToWorker(ctx context.Context, tenantId, getGroupKeyRunId string) (workerId string, dispatcherId string, err error) {
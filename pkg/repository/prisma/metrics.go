@@ -0,0 +1,38 @@
+package prisma
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type metricsEngineRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewMetricsEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.MetricsEngineRepository {
+	queries := dbsqlc.New()
+
+	return &metricsEngineRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *metricsEngineRepository) ListQueueDepths(ctx context.Context) ([]*dbsqlc.ListQueueDepthsRow, error) {
+	return r.queries.ListQueueDepths(ctx, r.pool)
+}
+
+func (r *metricsEngineRepository) ListWorkerSlots(ctx context.Context) ([]*dbsqlc.ListWorkerSlotsRow, error) {
+	return r.queries.ListWorkerSlots(ctx, r.pool)
+}
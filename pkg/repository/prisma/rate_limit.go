@@ -141,6 +141,19 @@ func (r *rateLimitEngineRepository) UpsertRateLimit(ctx context.Context, tenantI
 	return rateLimit, nil
 }
 
+func (r *rateLimitEngineRepository) ResetRateLimit(ctx context.Context, tenantId string, key string) (*dbsqlc.RateLimit, error) {
+	rateLimit, err := r.queries.ResetRateLimit(ctx, r.pool, dbsqlc.ResetRateLimitParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Key:      key,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not reset rate limit: %w", err)
+	}
+
+	return rateLimit, nil
+}
+
 var durationStrings = []string{
 	"SECOND",
 	"MINUTE",
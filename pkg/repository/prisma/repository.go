@@ -17,11 +17,13 @@ import (
 
 type apiRepository struct {
 	apiToken       repository.APITokenRepository
+	auditLog       repository.AuditLogAPIRepository
 	event          repository.EventAPIRepository
 	log            repository.LogsAPIRepository
 	tenant         repository.TenantAPIRepository
 	tenantAlerting repository.TenantAlertingAPIRepository
 	tenantInvite   repository.TenantInviteRepository
+	tenantRole     repository.TenantRoleRepository
 	workflow       repository.WorkflowAPIRepository
 	workflowRun    repository.WorkflowRunAPIRepository
 	jobRun         repository.JobRunAPIRepository
@@ -35,6 +37,7 @@ type apiRepository struct {
 	health         repository.HealthRepository
 	securityCheck  repository.SecurityCheckRepository
 	webhookWorker  repository.WebhookWorkerRepository
+	webhookSub     repository.WebhookSubscriptionAPIRepository
 }
 
 type PrismaRepositoryOpt func(*PrismaRepositoryOpts)
@@ -97,11 +100,12 @@ func NewAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, cf *server.Co
 	}
 
 	return &apiRepository{
-		apiToken:       NewAPITokenRepository(client, opts.v, opts.cache),
+		apiToken:       NewAPITokenRepository(client, pool, opts.v, opts.cache),
+		auditLog:       NewAuditLogAPIRepository(pool, opts.v, opts.l),
 		event:          NewEventAPIRepository(client, pool, opts.v, opts.l),
 		log:            NewLogAPIRepository(pool, opts.v, opts.l),
 		tenant:         NewTenantAPIRepository(pool, client, opts.v, opts.l, opts.cache),
-		tenantAlerting: NewTenantAlertingAPIRepository(client, opts.v, opts.cache),
+		tenantAlerting: NewTenantAlertingAPIRepository(pool, client, opts.v, opts.l, opts.cache),
 		tenantInvite:   NewTenantInviteRepository(client, opts.v),
 		workflow:       NewWorkflowRepository(client, pool, opts.v, opts.l),
 		workflowRun:    NewWorkflowRunRepository(client, shared, opts.metered, cf),
@@ -112,10 +116,12 @@ func NewAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, cf *server.Co
 		sns:            NewSNSRepository(client, opts.v),
 		worker:         NewWorkerAPIRepository(client, pool, opts.v, opts.l, opts.metered),
 		userSession:    NewUserSessionRepository(client, opts.v),
-		user:           NewUserRepository(client, opts.l, opts.v),
+		user:           NewUserRepository(client, pool, opts.l, opts.v),
+		tenantRole:     NewTenantRoleRepository(pool, opts.v, opts.l),
 		health:         NewHealthAPIRepository(client, pool),
 		securityCheck:  NewSecurityCheckRepository(client, pool),
 		webhookWorker:  NewWebhookWorkerRepository(client, opts.v),
+		webhookSub:     NewWebhookSubscriptionAPIRepository(pool, opts.v, opts.l),
 	}, cleanup, err
 }
 
@@ -127,6 +133,10 @@ func (r *apiRepository) APIToken() repository.APITokenRepository {
 	return r.apiToken
 }
 
+func (r *apiRepository) AuditLog() repository.AuditLogAPIRepository {
+	return r.auditLog
+}
+
 func (r *apiRepository) Event() repository.EventAPIRepository {
 	return r.event
 }
@@ -187,6 +197,10 @@ func (r *apiRepository) User() repository.UserRepository {
 	return r.user
 }
 
+func (r *apiRepository) TenantRole() repository.TenantRoleRepository {
+	return r.tenantRole
+}
+
 func (r *apiRepository) SecurityCheck() repository.SecurityCheckRepository {
 	return r.securityCheck
 }
@@ -195,9 +209,14 @@ func (r *apiRepository) WebhookWorker() repository.WebhookWorkerRepository {
 	return r.webhookWorker
 }
 
+func (r *apiRepository) WebhookSubscription() repository.WebhookSubscriptionAPIRepository {
+	return r.webhookSub
+}
+
 type engineRepository struct {
 	health         repository.HealthRepository
 	apiToken       repository.EngineTokenRepository
+	auditLog       repository.AuditLogEngineRepository
 	dispatcher     repository.DispatcherEngineRepository
 	event          repository.EventEngineRepository
 	getGroupKeyRun repository.GetGroupKeyRunEngineRepository
@@ -212,10 +231,13 @@ type engineRepository struct {
 	workflowRun    repository.WorkflowRunEngineRepository
 	streamEvent    repository.StreamEventsEngineRepository
 	log            repository.LogsEngineRepository
+	metrics        repository.MetricsEngineRepository
 	rateLimit      repository.RateLimitEngineRepository
 	webhookWorker  repository.WebhookWorkerEngineRepository
+	webhookSub     repository.WebhookSubscriptionEngineRepository
 	scheduler      repository.SchedulerRepository
 	mq             repository.MessageQueueRepository
+	tablePartition repository.TablePartitionEngineRepository
 }
 
 func (r *engineRepository) Health() repository.HealthRepository {
@@ -226,6 +248,10 @@ func (r *engineRepository) APIToken() repository.EngineTokenRepository {
 	return r.apiToken
 }
 
+func (r *engineRepository) AuditLog() repository.AuditLogEngineRepository {
+	return r.auditLog
+}
+
 func (r *engineRepository) Dispatcher() repository.DispatcherEngineRepository {
 	return r.dispatcher
 }
@@ -282,6 +308,10 @@ func (r *engineRepository) Log() repository.LogsEngineRepository {
 	return r.log
 }
 
+func (r *engineRepository) Metrics() repository.MetricsEngineRepository {
+	return r.metrics
+}
+
 func (r *engineRepository) RateLimit() repository.RateLimitEngineRepository {
 	return r.rateLimit
 }
@@ -290,6 +320,10 @@ func (r *engineRepository) WebhookWorker() repository.WebhookWorkerEngineReposit
 	return r.webhookWorker
 }
 
+func (r *engineRepository) WebhookSubscription() repository.WebhookSubscriptionEngineRepository {
+	return r.webhookSub
+}
+
 func (r *engineRepository) Scheduler() repository.SchedulerRepository {
 	return r.scheduler
 }
@@ -298,6 +332,10 @@ func (r *engineRepository) MessageQueue() repository.MessageQueueRepository {
 	return r.mq
 }
 
+func (r *engineRepository) TablePartition() repository.TablePartitionEngineRepository {
+	return r.tablePartition
+}
+
 func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *server.ConfigFileRuntime, fs ...PrismaRepositoryOpt) (func() error, repository.EngineRepository, error) {
 	opts := defaultPrismaRepositoryOpts()
 
@@ -316,6 +354,7 @@ func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *se
 
 	rlCache := cache.New(5 * time.Minute)
 	queueCache := cache.New(5 * time.Minute)
+	webhookEventTypeCache := cache.New(10 * time.Second)
 
 	shared, cleanup, err := newSharedRepository(pool, opts.v, opts.l, cf)
 
@@ -326,29 +365,34 @@ func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *se
 	return func() error {
 			rlCache.Stop()
 			queueCache.Stop()
+			webhookEventTypeCache.Stop()
 
 			return cleanup()
 		}, &engineRepository{
 			health:         NewHealthEngineRepository(pool),
 			apiToken:       NewEngineTokenRepository(pool, opts.v, opts.l, opts.cache),
+			auditLog:       NewAuditLogEngineRepository(pool, opts.v, opts.l),
 			dispatcher:     NewDispatcherRepository(pool, essentialPool, opts.v, opts.l),
 			event:          NewEventEngineRepository(shared, opts.metered, cf.EventBuffer),
-			getGroupKeyRun: NewGetGroupKeyRunRepository(pool, opts.v, opts.l),
+			getGroupKeyRun: NewGetGroupKeyRunRepository(pool, opts.v, opts.l, cf),
 			jobRun:         NewJobRunEngineRepository(shared),
 			stepRun:        NewStepRunEngineRepository(shared, cf, rlCache, queueCache),
 			step:           NewStepRepository(pool, opts.v, opts.l),
 			tenant:         NewTenantEngineRepository(pool, opts.v, opts.l, opts.cache),
 			tenantAlerting: NewTenantAlertingEngineRepository(pool, opts.v, opts.l, opts.cache),
-			ticker:         NewTickerRepository(pool, opts.v, opts.l),
-			worker:         NewWorkerEngineRepository(pool, essentialPool, opts.v, opts.l, opts.metered),
+			ticker:         NewTickerRepository(pool, opts.v, opts.l, cf),
+			worker:         NewWorkerEngineRepository(pool, essentialPool, opts.v, opts.l, opts.metered, cf),
 			workflow:       NewWorkflowEngineRepository(shared, opts.metered, opts.cache),
 			workflowRun:    NewWorkflowRunEngineRepository(shared, opts.metered, cf),
 			streamEvent:    NewStreamEventsEngineRepository(pool, opts.v, opts.l),
 			log:            NewLogEngineRepository(pool, opts.v, opts.l),
+			metrics:        NewMetricsEngineRepository(pool, opts.v, opts.l),
 			rateLimit:      NewRateLimitEngineRepository(pool, opts.v, opts.l),
 			webhookWorker:  NewWebhookWorkerEngineRepository(pool, opts.v, opts.l),
+			webhookSub:     NewWebhookSubscriptionEngineRepository(pool, opts.v, opts.l, webhookEventTypeCache),
 			scheduler:      newSchedulerRepository(shared),
 			mq:             NewMessageQueueRepository(shared),
+			tablePartition: NewTablePartitionEngineRepository(shared),
 		},
 		err
 }
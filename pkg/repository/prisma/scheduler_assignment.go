@@ -35,3 +35,13 @@ func (d *assignmentRepository) ListAvailableSlotsForWorkers(ctx context.Context,
 
 	return d.queries.ListAvailableSlotsForWorkers(ctx, d.pool, params)
 }
+
+func (d *assignmentRepository) ListSlotGroupsForWorkers(ctx context.Context, tenantId pgtype.UUID, workerIds []pgtype.UUID) ([]*dbsqlc.ListSlotGroupsForWorkersRow, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-slot-groups-for-workers")
+	defer span.End()
+
+	return d.queries.ListSlotGroupsForWorkers(ctx, d.pool, dbsqlc.ListSlotGroupsForWorkersParams{
+		Tenantid:  tenantId,
+		Workerids: workerIds,
+	})
+}
@@ -255,6 +255,67 @@ func (s *stepRunAPIRepository) ListStepRunArchives(tenantId string, stepRunId st
 	}, nil
 }
 
+func (s *stepRunAPIRepository) ListStepRunDeadLetters(ctx context.Context, tenantId string, opts *repository.ListStepRunDeadLettersOpts) (*repository.ListStepRunDeadLettersResult, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	listParams := dbsqlc.ListStepRunDeadLettersParams{
+		Tenantid: pgTenantId,
+	}
+
+	if opts.Offset != nil {
+		listParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		listParams.Limit = *opts.Limit
+	}
+
+	deadLetters, err := s.queries.ListStepRunDeadLetters(ctx, s.pool, listParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step run dead letters: %w", err)
+	}
+
+	if deadLetters == nil {
+		deadLetters = make([]*dbsqlc.ListStepRunDeadLettersRow, 0)
+	}
+
+	count, err := s.queries.CountStepRunDeadLetters(ctx, s.pool, pgTenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count step run dead letters: %w", err)
+	}
+
+	return &repository.ListStepRunDeadLettersResult{
+		Rows:  deadLetters,
+		Count: int(count),
+	}, nil
+}
+
+func (s *stepRunAPIRepository) GetStepRunDeadLetter(ctx context.Context, tenantId, stepRunId string) (*dbsqlc.StepRunDeadLetter, error) {
+	return s.queries.GetStepRunDeadLetter(ctx, s.pool, dbsqlc.GetStepRunDeadLetterParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+}
+
+func (s *stepRunAPIRepository) DeleteStepRunDeadLetter(ctx context.Context, tenantId, stepRunId string) (bool, error) {
+	deleted, err := s.queries.DeleteStepRunDeadLetter(ctx, s.pool, dbsqlc.DeleteStepRunDeadLetterParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete step run dead letter: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
 type stepRunEngineRepository struct {
 	*sharedRepository
 
@@ -285,6 +346,10 @@ func sizeOfUpdateData(item *updateStepRunQueueData) int {
 		size += errorLength
 	}
 
+	if item.ErrDetails != nil {
+		size += len(item.ErrDetails.Code) + len(item.ErrDetails.Details)
+	}
+
 	return size
 }
 
@@ -440,6 +505,7 @@ func (s *stepRunEngineRepository) ListStepRunsToReassign(ctx context.Context, te
 	// get the step run and make sure it's still in pending
 	results, err := s.queries.ListStepRunsToReassign(ctx, tx, dbsqlc.ListStepRunsToReassignParams{
 		Maxinternalretrycount: s.cf.MaxInternalRetryCount,
+		Heartbeattimeout:      sqlchelpers.DurationToPgInterval(s.cf.WorkerHeartbeatTimeout),
 		Tenantid:              pgTenantId,
 	})
 
@@ -560,65 +626,73 @@ func (s *stepRunEngineRepository) ListStepRunsToTimeout(ctx context.Context, ten
 }
 
 func (s *stepRunEngineRepository) ReleaseStepRunSemaphore(ctx context.Context, tenantId, stepRunId string, isUserTriggered bool) error {
-	err := s.releaseWorkerSemaphoreSlot(ctx, tenantId, stepRunId)
+	if !isUserTriggered {
+		err := s.releaseWorkerSemaphoreSlot(ctx, tenantId, stepRunId)
 
-	if err != nil {
-		return fmt.Errorf("could not release worker semaphore slot for step run %s: %w", stepRunId, err)
+		if err != nil {
+			return fmt.Errorf("could not release worker semaphore slot for step run %s: %w", stepRunId, err)
+		}
+
+		return nil
 	}
 
-	if isUserTriggered {
-		tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
+	// the worker is only known while the step run holds its slot, so it's read before the slot is released
+	stepRun, err := s.GetStepRunForEngine(ctx, tenantId, stepRunId)
 
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return fmt.Errorf("could not get step run for engine: %w", err)
+	}
 
-		defer rollback()
+	err = s.releaseWorkerSemaphoreSlot(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not release worker semaphore slot for step run %s: %w", stepRunId, err)
+	}
 
-		stepRun, err := s.getStepRunForEngineTx(context.Background(), tx, tenantId, stepRunId)
+	if stepRun.SRSemaphoreReleased {
+		return nil
+	}
 
-		if err != nil {
-			return fmt.Errorf("could not get step run for engine: %w", err)
-		}
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
 
-		if stepRun.SRSemaphoreReleased {
-			return nil
-		}
+	if err != nil {
+		return err
+	}
 
-		data := map[string]interface{}{"worker_id": sqlchelpers.UUIDToStr(stepRun.SRWorkerId)}
+	defer rollback()
 
-		dataBytes, err := json.Marshal(data)
+	data := map[string]interface{}{"worker_id": sqlchelpers.UUIDToStr(stepRun.SRWorkerId)}
 
-		if err != nil {
-			return fmt.Errorf("could not marshal data: %w", err)
-		}
+	dataBytes, err := json.Marshal(data)
 
-		err = s.queries.CreateStepRunEvent(ctx, tx, dbsqlc.CreateStepRunEventParams{
-			Steprunid: stepRun.SRID,
-			Reason:    dbsqlc.StepRunEventReasonSLOTRELEASED,
-			Severity:  dbsqlc.StepRunEventSeverityINFO,
-			Message:   "Slot released",
-			Data:      dataBytes,
-		})
+	if err != nil {
+		return fmt.Errorf("could not marshal data: %w", err)
+	}
 
-		if err != nil {
-			return fmt.Errorf("could not create step run event: %w", err)
-		}
+	err = s.queries.CreateStepRunEvent(ctx, tx, dbsqlc.CreateStepRunEventParams{
+		Steprunid: stepRun.SRID,
+		Reason:    dbsqlc.StepRunEventReasonSLOTRELEASED,
+		Severity:  dbsqlc.StepRunEventSeverityINFO,
+		Message:   "Slot released",
+		Data:      dataBytes,
+	})
 
-		// Update the Step Run to release the semaphore
-		err = s.queries.ManualReleaseSemaphore(ctx, tx, dbsqlc.ManualReleaseSemaphoreParams{
-			Steprunid: stepRun.SRID,
-			Tenantid:  stepRun.SRTenantId,
-		})
+	if err != nil {
+		return fmt.Errorf("could not create step run event: %w", err)
+	}
 
-		if err != nil {
-			return fmt.Errorf("could not update step run semaphoreRelease: %w", err)
-		}
+	// Update the Step Run to release the semaphore, keeping track of the worker which still runs it
+	err = s.queries.ManualReleaseSemaphore(ctx, tx, dbsqlc.ManualReleaseSemaphoreParams{
+		Workerid:  stepRun.SRWorkerId,
+		Steprunid: stepRun.SRID,
+		Tenantid:  stepRun.SRTenantId,
+	})
 
-		return commit(ctx)
+	if err != nil {
+		return fmt.Errorf("could not update step run semaphoreRelease: %w", err)
 	}
 
-	return nil
+	return commit(ctx)
 }
 
 func (s *stepRunEngineRepository) DeferredStepRunEvent(
@@ -952,6 +1026,22 @@ func (s *stepRunEngineRepository) bulkProcessStepRunUpdates(ctx context.Context,
 		}
 	}
 
+	if len(failParams.Steprunids) > 0 || len(cancelParams.Steprunids) > 0 {
+		// cancel the steps which wait on some of their parents and can no longer start. This repeats until
+		// nothing is cancelled, since cancelling a step can make a later join unsatisfiable.
+		for {
+			cancelledIds, err := s.queries.CancelUnsatisfiableJoinStepRuns(ctx, tx, batchStepRunIds)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not cancel unsatisfiable join step runs: %w", err)
+			}
+
+			if len(cancelledIds) == 0 {
+				break
+			}
+		}
+	}
+
 	// update the job runs and workflow runs as well
 	jobRunIds, err := s.queries.ResolveJobRunStatus(ctx, tx, batchStepRunIds)
 
@@ -1236,12 +1326,12 @@ func (s *stepRunEngineRepository) StepRunSucceeded(ctx context.Context, tenantId
 		validationErr := s.ValidateOutputs(ctx, output)
 
 		if validationErr != nil {
-			return s.StepRunFailed(ctx, tenantId, workflowRunId, stepRunId, finishedAt, "OUTPUT_NOT_VALID_JSON", 0)
+			return s.StepRunFailed(ctx, tenantId, workflowRunId, stepRunId, finishedAt, "OUTPUT_NOT_VALID_JSON", nil, 0)
 		}
 	} else if err != nil {
 		s.l.Err(err).Msg("update job run lookup data with step run failed")
 
-		return s.StepRunFailed(ctx, tenantId, workflowRunId, stepRunId, finishedAt, "FAILED_TO_WRITE_DATA", 0)
+		return s.StepRunFailed(ctx, tenantId, workflowRunId, stepRunId, finishedAt, "FAILED_TO_WRITE_DATA", nil, 0)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -1332,7 +1422,7 @@ func (s *stepRunEngineRepository) StepRunCancelled(ctx context.Context, tenantId
 	return nil
 }
 
-func (s *stepRunEngineRepository) StepRunFailed(ctx context.Context, tenantId, workflowRunId, stepRunId string, failedAt time.Time, errStr string, retryCount int) error {
+func (s *stepRunEngineRepository) StepRunFailed(ctx context.Context, tenantId, workflowRunId, stepRunId string, failedAt time.Time, errStr string, errDetails *repository.StepRunErrorDetails, retryCount int) error {
 	ctx, span := telemetry.NewSpan(ctx, "step-run-failed-db")
 	defer span.End()
 
@@ -1352,6 +1442,7 @@ func (s *stepRunEngineRepository) StepRunFailed(ctx context.Context, tenantId, w
 		RetryCount: retryCount,
 		FinishedAt: &failedAt,
 		Error:      &errStr,
+		ErrDetails: errDetails,
 		Status:     &failed,
 	}
 
@@ -1361,6 +1452,18 @@ func (s *stepRunEngineRepository) StepRunFailed(ctx context.Context, tenantId, w
 		return fmt.Errorf("could not buffer step run failed: %w", err)
 	}
 
+	// the step run won't be retried anymore, so it's dead-lettered until it's requeued or discarded
+	err = s.queries.CreateStepRunDeadLetter(ctx, s.pool, dbsqlc.CreateStepRunDeadLetterParams{
+		Steprunid:  sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Error:      sqlchelpers.TextFromStr(errStr),
+		Retrycount: int32(retryCount), // nolint: gosec
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create step run dead letter: %w", err)
+	}
+
 	laterStepRuns, err := s.queries.GetLaterStepRuns(ctx, s.pool, sqlchelpers.UUIDFromStr(stepRunId))
 
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
@@ -1407,6 +1510,18 @@ func (s *stepRunEngineRepository) StepRunRetryBackoff(ctx context.Context, tenan
 	})
 }
 
+func (s *stepRunEngineRepository) StepRunSuspended(ctx context.Context, tenantId, stepRunId string, resumeAt time.Time) error {
+	ctx, span := telemetry.NewSpan(ctx, "step-run-suspended-db")
+	defer span.End()
+
+	return s.queries.CreateRetryQueueItem(ctx, s.pool, dbsqlc.CreateRetryQueueItemParams{
+		Steprunid:  sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Retryafter: sqlchelpers.TimestampFromTime(resumeAt.UTC()),
+		IsRetry:    sqlchelpers.BoolFromBoolean(false),
+	})
+}
+
 func (s *stepRunEngineRepository) RetryStepRuns(ctx context.Context, tenantId string) (bool, error) {
 	ctx, span := telemetry.NewSpan(ctx, "retry-step-runs-db")
 	defer span.End()
@@ -1524,6 +1639,16 @@ func (s *stepRunEngineRepository) ReplayStepRun(ctx context.Context, tenantId, s
 		return nil, err
 	}
 
+	// a replayed step run is no longer dead-lettered
+	_, err = s.queries.DeleteStepRunDeadLetter(ctx, tx, dbsqlc.DeleteStepRunDeadLetterParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete step run dead letter: %w", err)
+	}
+
 	stepRun, err := s.getStepRunForEngineTx(ctx, tx, tenantId, stepRunId)
 
 	if err != nil {
@@ -1712,8 +1837,6 @@ func (s *stepRunEngineRepository) QueueStepRun(ctx context.Context, tenantId, st
 		return nil, err
 	}
 
-	priority := 1
-
 	if len(opts.ExpressionEvals) > 0 {
 		err := s.createExpressionEvals(ctx, s.pool, stepRunId, opts.ExpressionEvals)
 
@@ -1746,6 +1869,14 @@ func (s *stepRunEngineRepository) QueueStepRun(ctx context.Context, tenantId, st
 		return nil, repository.ErrAlreadyQueued
 	}
 
+	// step runs are queued with the priority of their workflow run, so that urgent runs are scheduled ahead
+	// of the other queued step runs of the tenant
+	priority := 1
+
+	if innerStepRun.SRPriority.Valid {
+		priority = int(innerStepRun.SRPriority.Int32)
+	}
+
 	if opts.IsRetry || opts.IsInternalRetry {
 		// if this is a retry, write a queue item to release the worker semaphore
 		//
@@ -2116,6 +2247,9 @@ type updateStepRunQueueData struct {
 	CancelledReason *string    `json:"cancelled_reason,omitempty"`
 	Error           *string    `json:"error,omitempty"`
 	Status          *string    `json:"status,omitempty"`
+
+	// ErrDetails is only used for the event which records the failure
+	ErrDetails *repository.StepRunErrorDetails `json:"-"`
 }
 
 func bulkInsertInternalQueueItem(
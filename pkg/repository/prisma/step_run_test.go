@@ -0,0 +1,149 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestStepRunDeadLetters(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		workflowRunId := createTestWorkflowRun(t, conf, tenantId, workflowVersion)
+		stepRunId := sqlchelpers.UUIDToStr(listTestStepRuns(t, conf, tenantId, workflowRunId)[0].SRID)
+
+		isDeadLettered := func() bool {
+			_, err := conf.APIRepository.StepRun().GetStepRunDeadLetter(ctx, tenantId, stepRunId)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return false
+			}
+
+			require.NoError(t, err)
+
+			return true
+		}
+
+		require.False(t, isDeadLettered())
+
+		// a step run which failed for good is dead-lettered with its error
+		err := conf.EngineRepository.StepRun().StepRunFailed(ctx, tenantId, workflowRunId, stepRunId, time.Now().UTC(), "boom", nil, 2)
+		require.NoError(t, err)
+
+		deadLetter, err := conf.APIRepository.StepRun().GetStepRunDeadLetter(ctx, tenantId, stepRunId)
+		require.NoError(t, err)
+		assert.Equal(t, "boom", deadLetter.Error.String)
+		assert.EqualValues(t, 2, deadLetter.RetryCount)
+
+		deadLetters, err := conf.APIRepository.StepRun().ListStepRunDeadLetters(ctx, tenantId, &repository.ListStepRunDeadLettersOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, deadLetters.Count)
+
+		// replaying or requeueing the step run removes it from the dead letters
+		_, err = conf.EngineRepository.StepRun().ReplayStepRun(ctx, tenantId, stepRunId, []byte(`{}`))
+		require.NoError(t, err)
+
+		assert.False(t, isDeadLettered())
+
+		// discarding removes it as well
+		err = conf.EngineRepository.StepRun().StepRunFailed(ctx, tenantId, workflowRunId, stepRunId, time.Now().UTC(), "boom", nil, 2)
+		require.NoError(t, err)
+
+		require.True(t, isDeadLettered())
+
+		deleted, err := conf.APIRepository.StepRun().DeleteStepRunDeadLetter(ctx, tenantId, stepRunId)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		assert.False(t, isDeadLettered())
+
+		return nil
+	})
+}
+
+func TestReleasedStepRunKeepsItsWorker(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		workflowRunId := createTestWorkflowRun(t, conf, tenantId, workflowVersion)
+		stepRunId := sqlchelpers.UUIDToStr(listTestStepRuns(t, conf, tenantId, workflowRunId)[0].SRID)
+
+		dispatcher, err := conf.EngineRepository.Dispatcher().CreateNewDispatcher(ctx, &repository.CreateDispatcherOpts{
+			ID: uuid.New().String(),
+		})
+		require.NoError(t, err)
+
+		worker, err := conf.EngineRepository.Worker().CreateNewWorker(ctx, tenantId, &repository.CreateWorkerOpts{
+			DispatcherId: sqlchelpers.UUIDToStr(dispatcher.ID),
+			Name:         "test-worker",
+		})
+		require.NoError(t, err)
+
+		workerId := sqlchelpers.UUIDToStr(worker.ID)
+
+		// assign the step run to the worker, like the scheduler does
+		_, err = conf.Pool.Exec(ctx, `INSERT INTO "SemaphoreQueueItem" ("stepRunId", "workerId", "tenantId") VALUES ($1::uuid, $2::uuid, $3::uuid)`, stepRunId, workerId, tenantId)
+		require.NoError(t, err)
+
+		_, err = conf.Pool.Exec(ctx, `UPDATE "StepRun" SET "status" = 'RUNNING' WHERE "id" = $1::uuid`, stepRunId)
+		require.NoError(t, err)
+
+		getWorkerId := func() string {
+			stepRun, err := conf.EngineRepository.StepRun().GetStepRunForEngine(ctx, tenantId, stepRunId)
+			require.NoError(t, err)
+
+			if !stepRun.SRWorkerId.Valid {
+				return ""
+			}
+
+			return sqlchelpers.UUIDToStr(stepRun.SRWorkerId)
+		}
+
+		require.Equal(t, workerId, getWorkerId())
+
+		// releasing the slot removes the assignment, but the step run still runs on the worker
+		err = conf.EngineRepository.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, stepRunId, true)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, countTestRows(t, conf, `SELECT COUNT(*) FROM "SemaphoreQueueItem" WHERE "stepRunId" = $1::uuid`, stepRunId))
+		assert.Equal(t, workerId, getWorkerId(), "cancellations should be sent to the worker which released the slot")
+
+		// a timeout which is due is sent to the worker as well
+		_, err = conf.Pool.Exec(ctx, `INSERT INTO "TimeoutQueueItem" ("stepRunId", "retryCount", "timeoutAt", "tenantId", "isQueued") VALUES ($1::uuid, 0, NOW() - INTERVAL '1 second', $2::uuid, true)`, stepRunId, tenantId)
+		require.NoError(t, err)
+
+		_, timedOut, err := conf.EngineRepository.StepRun().ListStepRunsToTimeout(ctx, tenantId)
+		require.NoError(t, err)
+		require.Len(t, timedOut, 1)
+		assert.Equal(t, workerId, sqlchelpers.UUIDToStr(timedOut[0].SRWorkerId))
+
+		// the step run is cancelling after the timeout, which is still sent to the worker
+		assert.Equal(t, workerId, getWorkerId())
+
+		// finished step runs have no worker
+		_, err = conf.Pool.Exec(ctx, `UPDATE "StepRun" SET "status" = 'CANCELLED' WHERE "id" = $1::uuid`, stepRunId)
+		require.NoError(t, err)
+
+		assert.Equal(t, "", getWorkerId())
+
+		return nil
+	})
+}
@@ -0,0 +1,201 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type tablePartitionEngineRepository struct {
+	*sharedRepository
+}
+
+func NewTablePartitionEngineRepository(shared *sharedRepository) repository.TablePartitionEngineRepository {
+	return &tablePartitionEngineRepository{
+		sharedRepository: shared,
+	}
+}
+
+// tablePartitionName returns the name of the partition of the table which contains the rows created on the day.
+func tablePartitionName(table string, day time.Time) string {
+	return fmt.Sprintf("%s_%s", table, day.Format("20060102"))
+}
+
+// prepareTablePartitionTx begins a transaction which is serialized with the partition maintenance of the other
+// engines. Partition DDL locks the partitioned table, so it gives up rather than queue behind long queries.
+func (r *tablePartitionEngineRepository) prepareTablePartitionTx(ctx context.Context) (pgx.Tx, func(context.Context) error, func(), error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 30000)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	_, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('table-partitions'))")
+
+	if err != nil {
+		rollback()
+		return nil, nil, nil, fmt.Errorf("could not lock table partitions: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "SET LOCAL lock_timeout = '5s'")
+
+	if err != nil {
+		rollback()
+		return nil, nil, nil, err
+	}
+
+	return tx, commit, rollback, nil
+}
+
+func (r *tablePartitionEngineRepository) CreateTablePartitions(ctx context.Context, until time.Time) error {
+	tx, commit, rollback, err := r.prepareTablePartitionTx(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	partitions, err := r.queries.ListTablePartitions(ctx, tx, repository.PartitionedTables)
+
+	if err != nil {
+		return fmt.Errorf("could not list table partitions: %w", err)
+	}
+
+	lastRangeEnds := make(map[string]time.Time)
+
+	for _, partition := range partitions {
+		if partition.RangeEnd.Valid && partition.RangeEnd.Time.After(lastRangeEnds[partition.Parent]) {
+			lastRangeEnds[partition.Parent] = partition.RangeEnd.Time
+		}
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for _, table := range repository.PartitionedTables {
+		// new partitions start where the last one ends, so they never overlap. Rows are created at the current
+		// time, so the days before today don't need partitions.
+		day := lastRangeEnds[table]
+
+		if day.Before(today) {
+			day = today
+		}
+
+		for ; day.Before(until); day = day.AddDate(0, 0, 1) {
+			_, err = tx.Exec(ctx, fmt.Sprintf(
+				"CREATE TABLE %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+				pgx.Identifier{tablePartitionName(table, day)}.Sanitize(),
+				pgx.Identifier{table}.Sanitize(),
+				day.Format(time.DateTime),
+				day.AddDate(0, 0, 1).Format(time.DateTime),
+			))
+
+			if err != nil {
+				return fmt.Errorf("could not create partition of %s for %s: %w", table, day.Format(time.DateOnly), err)
+			}
+		}
+	}
+
+	return commit(ctx)
+}
+
+func (r *tablePartitionEngineRepository) DropEmptyTablePartitions(ctx context.Context, endedBefore time.Time) ([]string, error) {
+	partitions, err := r.queries.ListTablePartitions(ctx, r.pool, repository.PartitionedTables)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list table partitions: %w", err)
+	}
+
+	dropped := make([]string, 0)
+
+	for _, partition := range partitions {
+		if !partition.RangeEnd.Valid || !partition.RangeEnd.Time.Before(endedBefore) {
+			continue
+		}
+
+		// checking for rows first avoids locking the partitioned table for partitions which aren't purged yet
+		hasRows, err := r.tablePartitionHasRows(ctx, r.pool, partition.Name)
+
+		if err != nil {
+			return dropped, err
+		}
+
+		if hasRows {
+			continue
+		}
+
+		ok, err := r.dropEmptyTablePartition(ctx, partition.Parent, partition.Name)
+
+		if err != nil {
+			return dropped, err
+		}
+
+		if ok {
+			dropped = append(dropped, partition.Name)
+		}
+	}
+
+	return dropped, nil
+}
+
+// dropEmptyTablePartition detaches the partition and drops it, unless it has rows. Detaching the partition first
+// locks it, so no rows can be written to it between the check and the drop.
+func (r *tablePartitionEngineRepository) dropEmptyTablePartition(ctx context.Context, table, partition string) (bool, error) {
+	tx, commit, rollback, err := r.prepareTablePartitionTx(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rollback()
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(
+		"ALTER TABLE %s DETACH PARTITION %s",
+		pgx.Identifier{table}.Sanitize(),
+		pgx.Identifier{partition}.Sanitize(),
+	))
+
+	if err != nil {
+		return false, fmt.Errorf("could not detach partition %s: %w", partition, err)
+	}
+
+	hasRows, err := r.tablePartitionHasRows(ctx, tx, partition)
+
+	if err != nil {
+		return false, err
+	}
+
+	if hasRows {
+		return false, nil
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf("DROP TABLE %s", pgx.Identifier{partition}.Sanitize()))
+
+	if err != nil {
+		return false, fmt.Errorf("could not drop partition %s: %w", partition, err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *tablePartitionEngineRepository) tablePartitionHasRows(ctx context.Context, db dbsqlc.DBTX, partition string) (bool, error) {
+	var hasRows bool
+
+	err := db.QueryRow(ctx, fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s)", pgx.Identifier{partition}.Sanitize())).Scan(&hasRows)
+
+	if err != nil {
+		return false, fmt.Errorf("could not check for rows in partition %s: %w", partition, err)
+	}
+
+	return hasRows, nil
+}
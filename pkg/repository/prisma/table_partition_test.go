@@ -0,0 +1,99 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestTablePartitions(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+
+		// the partitions of a table of the test are maintained, rather than the partitions of the tables which
+		// other tests write to
+		table := fmt.Sprintf("PartitionTest_%d", time.Now().UnixNano())
+
+		_, err := conf.Pool.Exec(ctx, fmt.Sprintf(`CREATE TABLE %q ("id" BIGINT NOT NULL, "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP) PARTITION BY RANGE ("createdAt")`, table))
+		require.NoError(t, err)
+
+		defer conf.Pool.Exec(ctx, fmt.Sprintf(`DROP TABLE %q`, table)) // nolint: errcheck
+
+		partitionedTables := repository.PartitionedTables
+		repository.PartitionedTables = []string{table}
+
+		defer func() {
+			repository.PartitionedTables = partitionedTables
+		}()
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+
+		err = conf.EngineRepository.TablePartition().CreateTablePartitions(ctx, today.Add(3*24*time.Hour))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			fmt.Sprintf("%s_%s", table, today.Format("20060102")),
+			fmt.Sprintf("%s_%s", table, today.AddDate(0, 0, 1).Format("20060102")),
+			fmt.Sprintf("%s_%s", table, today.AddDate(0, 0, 2).Format("20060102")),
+		}, listTestTablePartitions(t, conf, table))
+
+		// existing partitions are kept, and the missing ones are created after them
+		err = conf.EngineRepository.TablePartition().CreateTablePartitions(ctx, today.Add(4*24*time.Hour))
+		require.NoError(t, err)
+		assert.Len(t, listTestTablePartitions(t, conf, table), 4)
+
+		// rows are routed to the partition of the day they were created
+		_, err = conf.Pool.Exec(ctx, fmt.Sprintf(`INSERT INTO %q ("id", "createdAt") VALUES (1, $1::timestamp)`, table), today.AddDate(0, 0, 1).Add(time.Hour))
+		require.NoError(t, err)
+
+		// partitions whose range didn't end yet are kept
+		dropped, err := conf.EngineRepository.TablePartition().DropEmptyTablePartitions(ctx, today.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Empty(t, dropped)
+
+		// only empty partitions are dropped
+		dropped, err = conf.EngineRepository.TablePartition().DropEmptyTablePartitions(ctx, today.AddDate(0, 0, 4))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			fmt.Sprintf("%s_%s", table, today.Format("20060102")),
+			fmt.Sprintf("%s_%s", table, today.AddDate(0, 0, 2).Format("20060102")),
+		}, dropped)
+
+		assert.Equal(t, []string{
+			fmt.Sprintf("%s_%s", table, today.AddDate(0, 0, 1).Format("20060102")),
+			fmt.Sprintf("%s_%s", table, today.AddDate(0, 0, 3).Format("20060102")),
+		}, listTestTablePartitions(t, conf, table))
+
+		return nil
+	})
+}
+
+// listTestTablePartitions returns the names of the partitions of the table, in the order of their ranges.
+func listTestTablePartitions(t *testing.T, conf *database.Config, table string) []string {
+	t.Helper()
+
+	partitions, err := dbsqlc.New().ListTablePartitions(context.Background(), conf.Pool, []string{table})
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(partitions))
+
+	for _, partition := range partitions {
+		require.True(t, strings.HasPrefix(partition.Name, table))
+		names = append(names, partition.Name)
+	}
+
+	return names
+}
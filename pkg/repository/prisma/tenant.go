@@ -103,6 +103,7 @@ func (r *tenantAPIRepository) UpdateTenant(id string, opts *repository.UpdateTen
 		db.Tenant.Name.SetIfPresent(opts.Name),
 		db.Tenant.AnalyticsOptOut.SetIfPresent(opts.AnalyticsOptOut),
 		db.Tenant.AlertMemberEmails.SetIfPresent(opts.AlertMemberEmails),
+		db.Tenant.DataRetentionPeriod.SetIfPresent(opts.DataRetentionPeriod),
 	).Exec(context.Background())
 }
 
@@ -135,6 +136,9 @@ func (r *tenantAPIRepository) CreateTenantMember(tenantId string, opts *reposito
 func (r *tenantAPIRepository) GetTenantMemberByID(memberId string) (*db.TenantMemberModel, error) {
 	return r.client.TenantMember.FindUnique(
 		db.TenantMember.ID.Equals(memberId),
+	).With(
+		db.TenantMember.User.Fetch(),
+		db.TenantMember.Tenant.Fetch(),
 	).Exec(context.Background())
 }
 
@@ -307,6 +311,44 @@ func (r *tenantEngineRepository) GetTenantByID(ctx context.Context, tenantId str
 	})
 }
 
+func (r *tenantEngineRepository) UpdateTenantPaused(ctx context.Context, tenantId string, isPaused bool) (*dbsqlc.Tenant, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 25000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	tenant, err := r.queries.UpdateTenantPaused(ctx, tx, dbsqlc.UpdateTenantPausedParams{
+		Ispaused: isPaused,
+		ID:       pgTenantId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// if we're setting to an unpaused state, update internal queue items
+	if !isPaused {
+		err = r.queries.HandleTenantUnpaused(ctx, tx, pgTenantId)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	r.cache.Set(tenantId, tenant)
+
+	return tenant, nil
+}
+
 func (r *tenantEngineRepository) UpdateControllerPartitionHeartbeat(ctx context.Context, partitionId string) (string, error) {
 	tx, err := r.pool.Begin(ctx)
 
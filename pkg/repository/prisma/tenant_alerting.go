@@ -3,9 +3,11 @@ package prisma
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
@@ -18,46 +20,65 @@ import (
 )
 
 type tenantAlertingAPIRepository struct {
-	client *db.PrismaClient
-	v      validator.Validator
-	cache  cache.Cacheable
+	pool    *pgxpool.Pool
+	client  *db.PrismaClient
+	v       validator.Validator
+	l       *zerolog.Logger
+	queries *dbsqlc.Queries
+	cache   cache.Cacheable
 }
 
-func NewTenantAlertingAPIRepository(client *db.PrismaClient, v validator.Validator, cache cache.Cacheable) repository.TenantAlertingAPIRepository {
+func NewTenantAlertingAPIRepository(pool *pgxpool.Pool, client *db.PrismaClient, v validator.Validator, l *zerolog.Logger, cache cache.Cacheable) repository.TenantAlertingAPIRepository {
+	queries := dbsqlc.New()
+
 	return &tenantAlertingAPIRepository{
-		client: client,
-		v:      v,
-		cache:  cache,
+		pool:    pool,
+		client:  client,
+		v:       v,
+		l:       l,
+		queries: queries,
+		cache:   cache,
 	}
 }
 
-func (r *tenantAlertingAPIRepository) UpsertTenantAlertingSettings(tenantId string, opts *repository.UpsertTenantAlertingSettingsOpts) (*db.TenantAlertingSettingsModel, error) {
+func (r *tenantAlertingAPIRepository) UpsertTenantAlertingSettings(tenantId string, opts *repository.UpsertTenantAlertingSettingsOpts) (*dbsqlc.TenantAlertingSettings, error) {
 	if err := r.v.Validate(opts); err != nil {
 		return nil, err
 	}
 
-	return r.client.TenantAlertingSettings.UpsertOne(
-		db.TenantAlertingSettings.TenantID.Equals(tenantId),
-	).Create(
-		db.TenantAlertingSettings.Tenant.Link(
-			db.Tenant.ID.Equals(tenantId),
-		),
-		db.TenantAlertingSettings.MaxFrequency.SetIfPresent(opts.MaxFrequency),
-		db.TenantAlertingSettings.EnableExpiringTokenAlerts.SetIfPresent(opts.EnableExpiringTokenAlerts),
-		db.TenantAlertingSettings.EnableWorkflowRunFailureAlerts.SetIfPresent(opts.EnableWorkflowRunFailureAlerts),
-		db.TenantAlertingSettings.EnableTenantResourceLimitAlerts.SetIfPresent(opts.EnableTenantResourceLimitAlerts),
-	).Update(
-		db.TenantAlertingSettings.MaxFrequency.SetIfPresent(opts.MaxFrequency),
-		db.TenantAlertingSettings.EnableExpiringTokenAlerts.SetIfPresent(opts.EnableExpiringTokenAlerts),
-		db.TenantAlertingSettings.EnableWorkflowRunFailureAlerts.SetIfPresent(opts.EnableWorkflowRunFailureAlerts),
-		db.TenantAlertingSettings.EnableTenantResourceLimitAlerts.SetIfPresent(opts.EnableTenantResourceLimitAlerts),
-	).Exec(context.Background())
+	params := dbsqlc.UpsertTenantAlertingSettingsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if opts.MaxFrequency != nil {
+		params.MaxFrequency = sqlchelpers.TextFromStr(*opts.MaxFrequency)
+	}
+
+	if opts.EnableExpiringTokenAlerts != nil {
+		params.EnableExpiringTokenAlerts = sqlchelpers.BoolFromBoolean(*opts.EnableExpiringTokenAlerts)
+	}
+
+	if opts.EnableWorkflowRunFailureAlerts != nil {
+		params.EnableWorkflowRunFailureAlerts = sqlchelpers.BoolFromBoolean(*opts.EnableWorkflowRunFailureAlerts)
+	}
+
+	if opts.EnableTenantResourceLimitAlerts != nil {
+		params.EnableTenantResourceLimitAlerts = sqlchelpers.BoolFromBoolean(*opts.EnableTenantResourceLimitAlerts)
+	}
+
+	if opts.WorkflowRunFailureThreshold != nil {
+		params.WorkflowRunFailureThreshold = sqlchelpers.ToInt(int32(*opts.WorkflowRunFailureThreshold)) // nolint: gosec
+	}
+
+	if opts.EnableWorkerOfflineAlerts != nil {
+		params.EnableWorkerOfflineAlerts = sqlchelpers.BoolFromBoolean(*opts.EnableWorkerOfflineAlerts)
+	}
+
+	return r.queries.UpsertTenantAlertingSettings(context.Background(), r.pool, params)
 }
 
-func (r *tenantAlertingAPIRepository) GetTenantAlertingSettings(tenantId string) (*db.TenantAlertingSettingsModel, error) {
-	return r.client.TenantAlertingSettings.FindFirst(
-		db.TenantAlertingSettings.TenantID.Equals(tenantId),
-	).Exec(context.Background())
+func (r *tenantAlertingAPIRepository) GetTenantAlertingSettings(tenantId string) (*dbsqlc.TenantAlertingSettings, error) {
+	return r.queries.GetTenantAlertingSettings(context.Background(), r.pool, sqlchelpers.UUIDFromStr(tenantId))
 }
 
 func (r *tenantAlertingAPIRepository) CreateTenantAlertGroup(tenantId string, opts *repository.CreateTenantAlertGroupOpts) (*db.TenantAlertEmailGroupModel, error) {
@@ -109,6 +130,51 @@ func (r *tenantAlertingAPIRepository) DeleteTenantAlertGroup(tenantId string, id
 	return err
 }
 
+func (r *tenantAlertingAPIRepository) CreateTenantAlertMuteRule(ctx context.Context, tenantId string, opts *repository.CreateTenantAlertMuteRuleOpts) (*dbsqlc.TenantAlertMuteRule, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateTenantAlertMuteRuleParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: sqlchelpers.UUIDFromStr(opts.WorkflowId),
+	}
+
+	if opts.MutedUntil != nil {
+		params.MutedUntil = sqlchelpers.TimestampFromTime(opts.MutedUntil.UTC())
+	}
+
+	if opts.Reason != nil {
+		params.Reason = sqlchelpers.TextFromStr(*opts.Reason)
+	}
+
+	rule, err := r.queries.CreateTenantAlertMuteRule(ctx, r.pool, params)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, repository.ErrDuplicateKey
+		}
+
+		return nil, fmt.Errorf("could not create alert mute rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *tenantAlertingAPIRepository) ListTenantAlertMuteRules(ctx context.Context, tenantId string) ([]*dbsqlc.TenantAlertMuteRule, error) {
+	return r.queries.ListTenantAlertMuteRules(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantAlertingAPIRepository) GetTenantAlertMuteRuleById(ctx context.Context, id string) (*dbsqlc.TenantAlertMuteRule, error) {
+	return r.queries.GetTenantAlertMuteRuleById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *tenantAlertingAPIRepository) DeleteTenantAlertMuteRule(ctx context.Context, id string) error {
+	return r.queries.DeleteTenantAlertMuteRule(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
 type tenantAlertingEngineRepository struct {
 	pool    *pgxpool.Pool
 	v       validator.Validator
@@ -190,6 +256,18 @@ func (r *tenantAlertingEngineRepository) GetTenantAlertingSettings(ctx context.C
 		}
 	}
 
+	pgMutedWorkflowIds, err := r.queries.ListMutedWorkflowIds(ctx, tx, pgTenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mutedWorkflowIds := make([]string, len(pgMutedWorkflowIds))
+
+	for i, workflowId := range pgMutedWorkflowIds {
+		mutedWorkflowIds[i] = sqlchelpers.UUIDToStr(workflowId)
+	}
+
 	err = tx.Commit(ctx)
 
 	if err != nil {
@@ -197,10 +275,11 @@ func (r *tenantAlertingEngineRepository) GetTenantAlertingSettings(ctx context.C
 	}
 
 	return &repository.GetTenantAlertingSettingsResponse{
-		Settings:      settings,
-		SlackWebhooks: webhooks,
-		EmailGroups:   groupsForSend,
-		Tenant:        tenant,
+		Settings:         settings,
+		SlackWebhooks:    webhooks,
+		EmailGroups:      groupsForSend,
+		Tenant:           tenant,
+		MutedWorkflowIds: mutedWorkflowIds,
 	}, nil
 }
 
@@ -3,6 +3,8 @@ package prisma
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -71,6 +73,13 @@ func (t *tenantLimitRepository) DefaultLimits() []repository.Limit {
 			Window:           nil,
 			CustomValueMeter: true,
 		},
+		{
+			Resource:         dbsqlc.LimitResourceQUEUEDRUN,
+			Limit:            int32(t.config.Limits.DefaultQueuedRunLimit),      // nolint: gosec
+			Alarm:            int32(t.config.Limits.DefaultQueuedRunAlarmLimit), // nolint: gosec
+			Window:           nil,
+			CustomValueMeter: true,
+		},
 	}
 }
 
@@ -186,20 +195,96 @@ func (t *tenantLimitRepository) GetLimits(ctx context.Context, tenantId string)
 		return nil, err
 	}
 
-	// patch custom worker limits
+	// patch custom value limits
 	for _, limit := range limits {
+		if value, ok, err := t.customValue(ctx, limit.Resource, tenantId); err != nil {
+			return nil, err
+		} else if ok {
+			limit.Value = value
+		}
+	}
 
-		if limit.Resource == dbsqlc.LimitResourceWORKER {
-			workerCount, err := t.queries.CountTenantWorkers(ctx, t.pool, sqlchelpers.UUIDFromStr(tenantId))
-			if err != nil {
-				return nil, err
-			}
-			limit.Value = int32(workerCount) // nolint: gosec
+	return limits, nil
+}
+
+// customValue returns the current value of resources which are counted rather than metered.
+func (t *tenantLimitRepository) customValue(ctx context.Context, resource dbsqlc.LimitResource, tenantId string) (int32, bool, error) {
+	var count int64
+	var err error
+
+	switch resource {
+	case dbsqlc.LimitResourceWORKER:
+		count, err = t.queries.CountTenantWorkers(ctx, t.pool, sqlchelpers.UUIDFromStr(tenantId))
+	case dbsqlc.LimitResourceQUEUEDRUN:
+		count, err = t.queries.CountTenantQueuedWorkflowRuns(ctx, t.pool, sqlchelpers.UUIDFromStr(tenantId))
+	default:
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return int32(count), true, nil // nolint: gosec
+}
+
+func (t *tenantLimitRepository) UpdateLimit(ctx context.Context, tenantId string, opts *repository.UpdateTenantLimitOpts) (*dbsqlc.TenantResourceLimit, error) {
+	if err := t.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	limit := repository.Limit{
+		Resource: opts.Resource,
+		Limit:    opts.Limit,
+		Alarm:    -1,
+	}
+
+	if opts.Alarm != nil {
+		limit.Alarm = *opts.Alarm
+	}
+
+	// counted resources aren't metered over a window
+	if repository.IsCountedResource(opts.Resource) {
+		limit.CustomValueMeter = true
+	} else if opts.Window != nil {
+		window, err := time.ParseDuration(*opts.Window)
+
+		if err != nil {
+			return nil, err
 		}
 
+		if window < repository.MinLimitWindow {
+			return nil, fmt.Errorf("window must be at least %s", repository.MinLimitWindow)
+		}
+
+		limit.Window = &window
 	}
 
-	return limits, nil
+	if err := t.patchTenantResourceLimit(ctx, tenantId, limit, true); err != nil {
+		return nil, err
+	}
+
+	limits, err := t.queries.ListTenantResourceLimits(ctx, t.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, updated := range limits {
+		if updated.Resource != opts.Resource {
+			continue
+		}
+
+		if value, ok, err := t.customValue(ctx, updated.Resource, tenantId); err != nil {
+			return nil, err
+		} else if ok {
+			updated.Value = value
+		}
+
+		return updated, nil
+	}
+
+	return nil, fmt.Errorf("could not find %s limit after updating it", opts.Resource)
 }
 
 func (t *tenantLimitRepository) CanCreate(ctx context.Context, resource dbsqlc.LimitResource, tenantId string, numberOfResources int32) (bool, int, error) {
@@ -232,15 +317,11 @@ func (t *tenantLimitRepository) CanCreate(ctx context.Context, resource dbsqlc.L
 
 	var value = limit.Value
 
-	// patch custom worker limits aggregate methods
-	if resource == dbsqlc.LimitResourceWORKER {
-		count, err := t.queries.CountTenantWorkers(ctx, t.pool, sqlchelpers.UUIDFromStr(tenantId))
-		value = int32(count) // nolint: gosec
-
-		if err != nil {
-			return false, 0, err
-		}
-
+	// patch custom value limits aggregate methods
+	if customValue, ok, err := t.customValue(ctx, resource, tenantId); err != nil {
+		return false, 0, err
+	} else if ok {
+		value = customValue
 	}
 
 	// subtract 1 for backwards compatibility
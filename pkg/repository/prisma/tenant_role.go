@@ -0,0 +1,99 @@
+package prisma
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type tenantRoleRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewTenantRoleRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.TenantRoleRepository {
+	queries := dbsqlc.New()
+
+	return &tenantRoleRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *tenantRoleRepository) ListTenantRoles(ctx context.Context, tenantId string) ([]*dbsqlc.TenantRole, error) {
+	return r.queries.ListTenantRoles(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantRoleRepository) GetTenantRoleById(ctx context.Context, id string) (*dbsqlc.TenantRole, error) {
+	return r.queries.GetTenantRoleById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *tenantRoleRepository) CreateTenantRole(ctx context.Context, tenantId string, opts *repository.CreateTenantRoleOpts) (*dbsqlc.TenantRole, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateTenantRoleParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Name:        opts.Name,
+		Permissions: opts.Permissions,
+	}
+
+	if opts.Description != nil {
+		params.Description = sqlchelpers.TextFromStr(*opts.Description)
+	}
+
+	return r.queries.CreateTenantRole(ctx, r.pool, params)
+}
+
+func (r *tenantRoleRepository) UpdateTenantRole(ctx context.Context, id string, opts *repository.UpdateTenantRoleOpts) (*dbsqlc.TenantRole, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpdateTenantRoleParams{
+		ID:          sqlchelpers.UUIDFromStr(id),
+		Permissions: opts.Permissions,
+	}
+
+	if opts.Name != nil {
+		params.Name = sqlchelpers.TextFromStr(*opts.Name)
+	}
+
+	if opts.Description != nil {
+		params.Description = sqlchelpers.TextFromStr(*opts.Description)
+	}
+
+	return r.queries.UpdateTenantRole(ctx, r.pool, params)
+}
+
+func (r *tenantRoleRepository) DeleteTenantRole(ctx context.Context, id string) error {
+	return r.queries.DeleteTenantRole(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *tenantRoleRepository) GetTenantMemberCustomRole(ctx context.Context, memberId string) (*dbsqlc.TenantRole, error) {
+	return r.queries.GetTenantMemberCustomRole(ctx, r.pool, sqlchelpers.UUIDFromStr(memberId))
+}
+
+func (r *tenantRoleRepository) SetTenantMemberCustomRole(ctx context.Context, memberId string, roleId *string) error {
+	params := dbsqlc.UpdateTenantMemberCustomRoleParams{
+		Memberid: sqlchelpers.UUIDFromStr(memberId),
+	}
+
+	// a nil role id leaves the custom role id invalid, which stores NULL
+	if roleId != nil {
+		params.CustomRoleId = sqlchelpers.UUIDFromStr(*roleId)
+	}
+
+	return r.queries.UpdateTenantMemberCustomRole(ctx, r.pool, params)
+}
@@ -2,10 +2,12 @@ package prisma
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -17,9 +19,10 @@ type tickerRepository struct {
 	v       validator.Validator
 	queries *dbsqlc.Queries
 	l       *zerolog.Logger
+	cf      *server.ConfigFileRuntime
 }
 
-func NewTickerRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.TickerEngineRepository {
+func NewTickerRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, cf *server.ConfigFileRuntime) repository.TickerEngineRepository {
 	queries := dbsqlc.New()
 
 	return &tickerRepository{
@@ -27,6 +30,7 @@ func NewTickerRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.L
 		v:       v,
 		queries: queries,
 		l:       l,
+		cf:      cf,
 	}
 }
 
@@ -93,6 +97,13 @@ func (t *tickerRepository) PollCronSchedules(ctx context.Context, tickerId strin
 	return t.queries.PollCronSchedules(ctx, t.pool, sqlchelpers.UUIDFromStr(tickerId))
 }
 
+func (t *tickerRepository) UpdateCronLastFiredAt(ctx context.Context, cronId string, firedAt time.Time) error {
+	return t.queries.UpdateCronLastFiredAt(ctx, t.pool, dbsqlc.UpdateCronLastFiredAtParams{
+		LastFiredAt: sqlchelpers.TimestampFromTime(firedAt.UTC()),
+		ID:          sqlchelpers.UUIDFromStr(cronId),
+	})
+}
+
 func (t *tickerRepository) PollScheduledWorkflows(ctx context.Context, tickerId string) ([]*dbsqlc.PollScheduledWorkflowsRow, error) {
 	return t.queries.PollScheduledWorkflows(ctx, t.pool, sqlchelpers.UUIDFromStr(tickerId))
 }
@@ -101,6 +112,10 @@ func (t *tickerRepository) PollTenantAlerts(ctx context.Context, tickerId string
 	return t.queries.PollTenantAlerts(ctx, t.pool, sqlchelpers.UUIDFromStr(tickerId))
 }
 
+func (t *tickerRepository) PollWorkerOfflineAlerts(ctx context.Context) ([]*dbsqlc.PollWorkerOfflineAlertsRow, error) {
+	return t.queries.PollWorkerOfflineAlerts(ctx, t.pool, sqlchelpers.DurationToPgInterval(t.cf.WorkerHeartbeatTimeout))
+}
+
 func (t *tickerRepository) PollExpiringTokens(ctx context.Context) ([]*dbsqlc.PollExpiringTokensRow, error) {
 	return t.queries.PollExpiringTokens(ctx, t.pool)
 }
@@ -2,29 +2,41 @@ package prisma
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
 
 type userRepository struct {
-	client *db.PrismaClient
-	v      validator.Validator
-	l      *zerolog.Logger
+	client  *db.PrismaClient
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
 
 	createCallbacks []repository.UnscopedCallback[*db.UserModel]
 }
 
-func NewUserRepository(client *db.PrismaClient, l *zerolog.Logger, v validator.Validator) repository.UserRepository {
+func NewUserRepository(client *db.PrismaClient, pool *pgxpool.Pool, l *zerolog.Logger, v validator.Validator) repository.UserRepository {
+	queries := dbsqlc.New()
+
 	return &userRepository{
-		client: client,
-		v:      v,
-		l:      l,
+		client:  client,
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
 	}
 }
 
@@ -50,6 +62,15 @@ func (r *userRepository) GetUserByEmail(email string) (*db.UserModel, error) {
 	).Exec(context.Background())
 }
 
+func (r *userRepository) GetUserByOAuth(provider, providerUserId string) (*db.UserModel, error) {
+	return r.client.User.FindFirst(
+		db.User.OauthProviders.Some(
+			db.UserOAuth.Provider.Equals(provider),
+			db.UserOAuth.ProviderUserID.Equals(providerUserId),
+		),
+	).Exec(context.Background())
+}
+
 func (r *userRepository) GetUserPassword(id string) (*db.UserPasswordModel, error) {
 	return r.client.UserPassword.FindUnique(
 		db.UserPassword.UserID.Equals(id),
@@ -184,3 +205,100 @@ func (r *userRepository) ListTenantMemberships(userId string) ([]db.TenantMember
 		db.TenantMember.User.Fetch(),
 	).Exec(context.Background())
 }
+
+func (r *userRepository) ListExpiringOAuthTokens(ctx context.Context, expiresBefore time.Time, limit int) ([]*dbsqlc.UserOAuth, error) {
+	return r.queries.ListExpiringUserOAuths(ctx, r.pool, dbsqlc.ListExpiringUserOAuthsParams{
+		Expiresbefore: sqlchelpers.TimestampFromTime(expiresBefore),
+		Failedbefore:  sqlchelpers.TimestampFromTime(time.Now().Add(-repository.OAuthTokenRefreshRetryInterval)),
+		Limit: pgtype.Int4{
+			Int32: int32(limit), // nolint: gosec
+			Valid: true,
+		},
+	})
+}
+
+func (r *userRepository) GetFreshOAuthToken(ctx context.Context, userId, provider string, refresh repository.RefreshOAuthTokenFunc) (*repository.OAuthTokens, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	// the row stays locked while the token is refreshed, so that concurrent calls get the new tokens
+	// instead of using the refresh token again
+	userOAuth, err := r.queries.GetUserOAuthForUpdate(ctx, tx, dbsqlc.GetUserOAuthForUpdateParams{
+		Userid:   sqlchelpers.UUIDFromStr(userId),
+		Provider: provider,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get oauth account: %w", err)
+	}
+
+	tokens := oauthTokensFromRow(userOAuth)
+
+	if !needsOAuthTokenRefresh(tokens, time.Now()) {
+		return tokens, nil
+	}
+
+	refreshed, err := refresh(ctx, provider, tokens)
+
+	if err != nil {
+		// release the row lock before recording the failure outside of the transaction
+		sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+		if markErr := r.queries.MarkUserOAuthRefreshFailed(ctx, r.pool, userOAuth.ID); markErr != nil {
+			r.l.Error().Err(markErr).Msg("could not mark oauth token refresh as failed")
+		}
+
+		return nil, fmt.Errorf("could not refresh oauth token: %w", err)
+	}
+
+	params := dbsqlc.UpdateUserOAuthTokensParams{
+		ID:           userOAuth.ID,
+		Accesstoken:  refreshed.AccessToken,
+		RefreshToken: refreshed.RefreshToken,
+	}
+
+	if refreshed.ExpiresAt != nil {
+		params.ExpiresAt = sqlchelpers.TimestampFromTime(*refreshed.ExpiresAt)
+	}
+
+	userOAuth, err = r.queries.UpdateUserOAuthTokens(ctx, tx, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update oauth tokens: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return oauthTokensFromRow(userOAuth), nil
+}
+
+func oauthTokensFromRow(userOAuth *dbsqlc.UserOAuth) *repository.OAuthTokens {
+	tokens := &repository.OAuthTokens{
+		AccessToken:  userOAuth.AccessToken,
+		RefreshToken: userOAuth.RefreshToken,
+	}
+
+	if userOAuth.ExpiresAt.Valid {
+		expiresAt := userOAuth.ExpiresAt.Time
+		tokens.ExpiresAt = &expiresAt
+	}
+
+	return tokens
+}
+
+// needsOAuthTokenRefresh returns true if the access token expires within the refresh window and can be
+// refreshed. Tokens without an expiry are stored with a nil or zero expiry and never refreshed.
+func needsOAuthTokenRefresh(tokens *repository.OAuthTokens, now time.Time) bool {
+	if len(tokens.RefreshToken) == 0 || tokens.ExpiresAt == nil || tokens.ExpiresAt.IsZero() {
+		return false
+	}
+
+	return tokens.ExpiresAt.Before(now.Add(repository.OAuthTokenRefreshWindow))
+}
@@ -0,0 +1,279 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// deleteExpiredWebhookDeliveriesBatchSize is the number of webhook deliveries which are deleted at once.
+const deleteExpiredWebhookDeliveriesBatchSize = 1000
+
+type webhookSubscriptionRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+
+	// eventTypeCache caches the event types which tenants subscribe to
+	eventTypeCache cache.Cacheable
+}
+
+func NewWebhookSubscriptionAPIRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.WebhookSubscriptionAPIRepository {
+	return newWebhookSubscriptionRepository(pool, v, l, nil)
+}
+
+func NewWebhookSubscriptionEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, eventTypeCache cache.Cacheable) repository.WebhookSubscriptionEngineRepository {
+	return newWebhookSubscriptionRepository(pool, v, l, eventTypeCache)
+}
+
+func newWebhookSubscriptionRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, eventTypeCache cache.Cacheable) *webhookSubscriptionRepository {
+	queries := dbsqlc.New()
+
+	return &webhookSubscriptionRepository{
+		pool:           pool,
+		v:              v,
+		queries:        queries,
+		l:              l,
+		eventTypeCache: eventTypeCache,
+	}
+}
+
+func (r *webhookSubscriptionRepository) CreateWebhookSubscription(ctx context.Context, tenantId string, opts *repository.CreateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateWebhookSubscriptionParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Name:       opts.Name,
+		Url:        opts.URL,
+		Secret:     opts.Secret,
+		Eventtypes: opts.EventTypes,
+	}
+
+	if opts.Enabled != nil {
+		params.Enabled = sqlchelpers.BoolFromBoolean(*opts.Enabled)
+	}
+
+	subscription, err := r.queries.CreateWebhookSubscription(ctx, r.pool, params)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, repository.ErrDuplicateKey
+		}
+
+		return nil, fmt.Errorf("could not create webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *webhookSubscriptionRepository) GetWebhookSubscriptionById(ctx context.Context, id string) (*dbsqlc.WebhookSubscription, error) {
+	return r.queries.GetWebhookSubscriptionById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *webhookSubscriptionRepository) ListWebhookSubscriptions(ctx context.Context, tenantId string) ([]*dbsqlc.WebhookSubscription, error) {
+	return r.queries.ListWebhookSubscriptions(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *webhookSubscriptionRepository) UpdateWebhookSubscription(ctx context.Context, id string, opts *repository.UpdateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpdateWebhookSubscriptionParams{
+		ID:         sqlchelpers.UUIDFromStr(id),
+		EventTypes: opts.EventTypes,
+	}
+
+	if opts.URL != nil {
+		params.Url = sqlchelpers.TextFromStr(*opts.URL)
+	}
+
+	if opts.Enabled != nil {
+		params.Enabled = sqlchelpers.BoolFromBoolean(*opts.Enabled)
+	}
+
+	subscription, err := r.queries.UpdateWebhookSubscription(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *webhookSubscriptionRepository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	return r.queries.DeleteWebhookSubscription(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *webhookSubscriptionRepository) GetWebhookDeliveryById(ctx context.Context, id string) (*dbsqlc.WebhookDelivery, error) {
+	return r.queries.GetWebhookDeliveryById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *webhookSubscriptionRepository) ListWebhookDeliveries(ctx context.Context, subscriptionId string, opts *repository.ListWebhookDeliveriesOpts) (*repository.ListWebhookDeliveriesResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgSubscriptionId := sqlchelpers.UUIDFromStr(subscriptionId)
+
+	queryParams := dbsqlc.ListWebhookDeliveriesParams{
+		Subscriptionid: pgSubscriptionId,
+	}
+
+	countParams := dbsqlc.CountWebhookDeliveriesParams{
+		Subscriptionid: pgSubscriptionId,
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	if opts.Status != nil {
+		status := dbsqlc.NullWebhookDeliveryStatus{
+			WebhookDeliveryStatus: *opts.Status,
+			Valid:                 true,
+		}
+
+		queryParams.Status = status
+		countParams.Status = status
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	deliveries, err := r.queries.ListWebhookDeliveries(ctx, tx, queryParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list webhook deliveries: %w", err)
+	}
+
+	count, err := r.queries.CountWebhookDeliveries(ctx, tx, countParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count webhook deliveries: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return &repository.ListWebhookDeliveriesResult{
+		Rows:  deliveries,
+		Count: int(count),
+	}, nil
+}
+
+func (r *webhookSubscriptionRepository) ResendWebhookDelivery(ctx context.Context, id string) (*dbsqlc.WebhookDelivery, error) {
+	return r.queries.ResendWebhookDelivery(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *webhookSubscriptionRepository) HasWebhookSubscriptions(ctx context.Context, tenantId string, eventType string) (bool, error) {
+	eventTypes, err := cache.MakeCacheable(r.eventTypeCache, tenantId, func() (*[]string, error) {
+		eventTypes, err := r.queries.ListWebhookSubscriptionEventTypes(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list webhook subscription event types: %w", err)
+		}
+
+		return &eventTypes, nil
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(*eventTypes, eventType), nil
+}
+
+func (r *webhookSubscriptionRepository) CreateWebhookDeliveries(ctx context.Context, tenantId string, opts *repository.CreateWebhookDeliveriesOpts) (int, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return 0, err
+	}
+
+	created, err := r.queries.CreateWebhookDeliveries(ctx, r.pool, dbsqlc.CreateWebhookDeliveriesParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Eventtype: opts.EventType,
+		Dedupekey: opts.DedupeKey,
+		Payload:   opts.Payload,
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("could not create webhook deliveries: %w", err)
+	}
+
+	return int(created), nil
+}
+
+func (r *webhookSubscriptionRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, claimTimeout time.Duration) ([]*dbsqlc.ClaimWebhookDeliveriesRow, error) {
+	return r.queries.ClaimWebhookDeliveries(ctx, r.pool, dbsqlc.ClaimWebhookDeliveriesParams{
+		Limit:        int32(limit), // nolint: gosec
+		Claimtimeout: sqlchelpers.DurationToPgInterval(claimTimeout),
+	})
+}
+
+func (r *webhookSubscriptionRepository) UpdateWebhookDeliveryAttempt(ctx context.Context, id string, opts *repository.UpdateWebhookDeliveryAttemptOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	params := dbsqlc.UpdateWebhookDeliveryAttemptParams{
+		ID:     sqlchelpers.UUIDFromStr(id),
+		Status: opts.Status,
+	}
+
+	if opts.NextAttemptAt != nil {
+		params.NextAttemptAt = sqlchelpers.TimestampFromTime(opts.NextAttemptAt.UTC())
+	}
+
+	if opts.ResponseStatusCode != nil {
+		params.ResponseStatusCode = sqlchelpers.ToInt(int32(*opts.ResponseStatusCode)) // nolint: gosec
+	}
+
+	if opts.Error != nil {
+		params.Error = sqlchelpers.TextFromStr(*opts.Error)
+	}
+
+	return r.queries.UpdateWebhookDeliveryAttempt(ctx, r.pool, params)
+}
+
+func (r *webhookSubscriptionRepository) DeleteExpiredWebhookDeliveries(ctx context.Context, tenantId string, createdBefore time.Time) (bool, error) {
+	deleted, err := r.queries.DeleteExpiredWebhookDeliveries(ctx, r.pool, dbsqlc.DeleteExpiredWebhookDeliveriesParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Createdbefore: sqlchelpers.TimestampFromTime(createdBefore),
+		Limit:         deleteExpiredWebhookDeliveriesBatchSize,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete expired webhook deliveries: %w", err)
+	}
+
+	return deleted == deleteExpiredWebhookDeliveriesBatchSize, nil
+}
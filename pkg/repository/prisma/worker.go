@@ -13,6 +13,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
@@ -199,9 +200,10 @@ type workerEngineRepository struct {
 	queries       *dbsqlc.Queries
 	l             *zerolog.Logger
 	m             *metered.Metered
+	cf            *server.ConfigFileRuntime
 }
 
-func NewWorkerEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, m *metered.Metered) repository.WorkerEngineRepository {
+func NewWorkerEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, m *metered.Metered, cf *server.ConfigFileRuntime) repository.WorkerEngineRepository {
 	queries := dbsqlc.New()
 
 	return &workerEngineRepository{
@@ -211,6 +213,7 @@ func NewWorkerEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool,
 		queries:       queries,
 		l:             l,
 		m:             m,
+		cf:            cf,
 	}
 }
 
@@ -379,6 +382,12 @@ func (w *workerEngineRepository) CreateNewWorker(ctx context.Context, tenantId s
 			return nil, nil, fmt.Errorf("could not link actions to worker: %w", err)
 		}
 
+		err = w.replaceSlotGroups(ctx, tx, worker.ID, opts.SlotGroups)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
 		err = tx.Commit(ctx)
 
 		if err != nil {
@@ -427,13 +436,20 @@ func (w *workerEngineRepository) UpdateWorker(ctx context.Context, tenantId, wor
 		}
 	}
 
+	if opts.IsPaused != nil {
+		updateParams.IsPaused = pgtype.Bool{
+			Bool:  *opts.IsPaused,
+			Valid: true,
+		}
+	}
+
 	worker, err := w.queries.UpdateWorker(ctx, tx, updateParams)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not update worker: %w", err)
 	}
 
-	if len(opts.Actions) > 0 {
+	if opts.Actions != nil {
 		actionUUIDs := make([]pgtype.UUID, len(opts.Actions))
 
 		for i, action := range opts.Actions {
@@ -457,6 +473,23 @@ func (w *workerEngineRepository) UpdateWorker(ctx context.Context, tenantId, wor
 		if err != nil {
 			return nil, fmt.Errorf("could not link actions to worker: %w", err)
 		}
+
+		err = w.queries.UnlinkActionsFromWorker(ctx, tx, dbsqlc.UnlinkActionsFromWorkerParams{
+			Workerid:  sqlchelpers.UUIDFromStr(workerId),
+			Actionids: actionUUIDs,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not unlink actions from worker: %w", err)
+		}
+	}
+
+	if opts.SlotGroups != nil {
+		err = w.replaceSlotGroups(ctx, tx, worker.ID, opts.SlotGroups)
+
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = tx.Commit(ctx)
@@ -468,6 +501,30 @@ func (w *workerEngineRepository) UpdateWorker(ctx context.Context, tenantId, wor
 	return worker, nil
 }
 
+// replaceSlotGroups replaces the slot groups of the worker with the given groups.
+func (w *workerEngineRepository) replaceSlotGroups(ctx context.Context, tx pgx.Tx, workerId pgtype.UUID, slotGroups []repository.WorkerSlotGroupOpts) error {
+	err := w.queries.DeleteWorkerSlotGroups(ctx, tx, workerId)
+
+	if err != nil {
+		return fmt.Errorf("could not delete worker slot groups: %w", err)
+	}
+
+	for _, slotGroup := range slotGroups {
+		err = w.queries.CreateWorkerSlotGroup(ctx, tx, dbsqlc.CreateWorkerSlotGroupParams{
+			Workerid:  workerId,
+			Name:      slotGroup.Name,
+			Maxruns:   int32(slotGroup.MaxRuns), // nolint: gosec
+			Actionids: slotGroup.Actions,
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not create worker slot group %s: %w", slotGroup.Name, err)
+		}
+	}
+
+	return nil
+}
+
 func (w *workerEngineRepository) UpdateWorkerHeartbeat(ctx context.Context, tenantId, workerId string, lastHeartbeat time.Time) error {
 
 	_, err := w.queries.UpdateWorkerHeartbeat(ctx, w.essentialPool, dbsqlc.UpdateWorkerHeartbeatParams{
@@ -493,6 +550,19 @@ func (w *workerEngineRepository) UpdateWorkersByWebhookId(ctx context.Context, p
 	return err
 }
 
+func (w *workerEngineRepository) DeactivateStaleWorkers(ctx context.Context, tenantId string) ([]*dbsqlc.Worker, error) {
+	workers, err := w.queries.DeactivateStaleWorkers(ctx, w.pool, dbsqlc.DeactivateStaleWorkersParams{
+		Tenantid:         sqlchelpers.UUIDFromStr(tenantId),
+		Heartbeattimeout: sqlchelpers.DurationToPgInterval(w.cf.WorkerHeartbeatTimeout),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not deactivate stale workers: %w", err)
+	}
+
+	return workers, nil
+}
+
 func (w *workerEngineRepository) UpdateWorkerActiveStatus(ctx context.Context, tenantId, workerId string, isActive bool, timestamp time.Time) (*dbsqlc.Worker, error) {
 	worker, err := w.queries.UpdateWorkerActiveStatus(ctx, w.pool, dbsqlc.UpdateWorkerActiveStatusParams{
 		ID:                      sqlchelpers.UUIDFromStr(workerId),
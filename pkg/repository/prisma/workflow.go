@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"strings"
 	"time"
 
@@ -128,6 +129,10 @@ func (r *workflowAPIRepository) UpdateWorkflow(ctx context.Context, tenantId, wo
 		return nil, err
 	}
 
+	return updateWorkflow(ctx, r.pool, r.queries, r.l, tenantId, workflowId, opts)
+}
+
+func updateWorkflow(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Queries, l *zerolog.Logger, tenantId, workflowId string, opts *repository.UpdateWorkflowOpts) (*dbsqlc.Workflow, error) {
 	pgWorkflowId := sqlchelpers.UUIDFromStr(workflowId)
 
 	params := dbsqlc.UpdateWorkflowParams{
@@ -141,7 +146,7 @@ func (r *workflowAPIRepository) UpdateWorkflow(ctx context.Context, tenantId, wo
 		}
 	}
 
-	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 25000)
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, pool, l, 25000)
 
 	if err != nil {
 		return nil, err
@@ -149,7 +154,7 @@ func (r *workflowAPIRepository) UpdateWorkflow(ctx context.Context, tenantId, wo
 
 	defer rollback()
 
-	workflow, err := r.queries.UpdateWorkflow(ctx, tx, params)
+	workflow, err := queries.UpdateWorkflow(ctx, tx, params)
 
 	if err != nil {
 		return nil, err
@@ -157,7 +162,7 @@ func (r *workflowAPIRepository) UpdateWorkflow(ctx context.Context, tenantId, wo
 
 	// if we're setting to an unpaused state, update internal queue items
 	if opts.IsPaused != nil && !*opts.IsPaused {
-		err = r.queries.HandleWorkflowUnpaused(ctx, tx, dbsqlc.HandleWorkflowUnpausedParams{
+		err = queries.HandleWorkflowUnpaused(ctx, tx, dbsqlc.HandleWorkflowUnpausedParams{
 			Workflowid: workflowId,
 			Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
 		})
@@ -759,6 +764,26 @@ func (r *workflowEngineRepository) GetLatestWorkflowVersion(ctx context.Context,
 	return versions[0], nil
 }
 
+func (r *workflowEngineRepository) RouteWorkflowVersion(ctx context.Context, tenantId string, latest *dbsqlc.GetWorkflowVersionForEngineRow) (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	workflowId := latest.WorkflowVersion.WorkflowId
+
+	weights, err := cache.MakeCacheable(r.cache, fmt.Sprintf("version-weights-%s", sqlchelpers.UUIDToStr(workflowId)), func() (*repository.WorkflowVersionWeights, error) {
+		return listWorkflowVersionWeights(ctx, r.pool, r.queries, workflowId)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionId, ok := repository.PickWorkflowVersion(weights.ForLatestVersion(latest.WorkflowVersion.ID), rand.Int64N)
+
+	if !ok || versionId == sqlchelpers.UUIDToStr(latest.WorkflowVersion.ID) {
+		return latest, nil
+	}
+
+	return r.GetWorkflowVersionById(ctx, tenantId, versionId)
+}
+
 func (r *workflowEngineRepository) GetWorkflowByName(ctx context.Context, tenantId, workflowName string) (*dbsqlc.Workflow, error) {
 	return r.queries.GetWorkflowByName(ctx, r.pool, dbsqlc.GetWorkflowByNameParams{
 		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
@@ -766,6 +791,67 @@ func (r *workflowEngineRepository) GetWorkflowByName(ctx context.Context, tenant
 	})
 }
 
+func (r *workflowEngineRepository) UpdateWorkflow(ctx context.Context, tenantId, workflowId string, opts *repository.UpdateWorkflowOpts) (*dbsqlc.Workflow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return updateWorkflow(ctx, r.pool, r.queries, r.l, tenantId, workflowId, opts)
+}
+
+func (r *workflowEngineRepository) GetStepForWorkflowVersion(ctx context.Context, tenantId, workflowVersionId, stepReadableId string) (*dbsqlc.Step, error) {
+	return r.queries.GetStepForWorkflowVersion(ctx, r.pool, dbsqlc.GetStepForWorkflowVersionParams{
+		Workflowversionid: sqlchelpers.UUIDFromStr(workflowVersionId),
+		Tenantid:          sqlchelpers.UUIDFromStr(tenantId),
+		Readableid:        stepReadableId,
+	})
+}
+
+func (r *workflowEngineRepository) GetWorkflowVersionDefinition(ctx context.Context, tenantId, workflowVersionId string) (*repository.WorkflowVersionDefinition, error) {
+	pgWorkflowVersionId := sqlchelpers.UUIDFromStr(workflowVersionId)
+
+	events, err := r.queries.GetWorkflowVersionEventTriggerRefs(ctx, r.pool, pgWorkflowVersionId)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event triggers: %w", err)
+	}
+
+	crons, err := r.queries.GetWorkflowVersionCronTriggerRefs(ctx, r.pool, pgWorkflowVersionId)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cron triggers: %w", err)
+	}
+
+	steps, err := r.queries.ListStepsForWorkflowVersion(ctx, r.pool, dbsqlc.ListStepsForWorkflowVersionParams{
+		Workflowversionid: pgWorkflowVersionId,
+		Tenantid:          sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch steps: %w", err)
+	}
+
+	res := &repository.WorkflowVersionDefinition{
+		EventTriggers: make([]string, 0, len(events)),
+		CronTriggers:  make([]string, 0, len(crons)),
+		Steps:         steps,
+	}
+
+	for _, event := range events {
+		res.EventTriggers = append(res.EventTriggers, event.EventKey)
+	}
+
+	for _, cron := range crons {
+		if cron.Method != dbsqlc.WorkflowTriggerCronRefMethodsDEFAULT {
+			continue
+		}
+
+		res.CronTriggers = append(res.CronTriggers, cron.Cron)
+	}
+
+	return res, nil
+}
+
 func (r *workflowEngineRepository) GetWorkflowsByNames(ctx context.Context, tenantId string, workflowNames []string) ([]*dbsqlc.Workflow, error) {
 
 	// we need to error if we don't have a workflow for a name
@@ -834,22 +920,22 @@ func (r *workflowEngineRepository) GetWorkflowVersionById(ctx context.Context, t
 	return versions[0], nil
 }
 
-func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
-	cachedArr, err := cache.MakeCacheable(r.cache, fmt.Sprintf("%s-%s", tenantId, eventKey), func() (*[]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*repository.WorkflowVersionForEvent, error) {
+	cachedArr, err := cache.MakeCacheable(r.cache, fmt.Sprintf("%s-%s", tenantId, eventKey), func() (*[]*repository.WorkflowVersionForEvent, error) {
 		ctx, span1 := telemetry.NewSpan(ctx, "db-list-workflows-for-event")
 		defer span1.End()
 
 		ctx, span2 := telemetry.NewSpan(ctx, "db-list-workflows-for-event-query")
 		defer span2.End()
 
-		workflowVersionIds, err := r.queries.ListWorkflowsForEvent(ctx, r.pool, dbsqlc.ListWorkflowsForEventParams{
+		eventTriggers, err := r.queries.ListWorkflowsForEvent(ctx, r.pool, dbsqlc.ListWorkflowsForEventParams{
 			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
 			Eventkey: eventKey,
 		})
 
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
-				return &[]*dbsqlc.GetWorkflowVersionForEngineRow{}, nil
+				return &[]*repository.WorkflowVersionForEvent{}, nil
 			}
 
 			return nil, fmt.Errorf("failed to fetch workflows: %w", err)
@@ -857,6 +943,17 @@ func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, te
 
 		span2.End()
 
+		workflowVersionIds := make([]pgtype.UUID, len(eventTriggers))
+		filterExpressions := make(map[string]*string, len(eventTriggers))
+
+		for i, eventTrigger := range eventTriggers {
+			workflowVersionIds[i] = eventTrigger.WorkflowVersionId
+
+			if eventTrigger.FilterExpression.Valid {
+				filterExpressions[sqlchelpers.UUIDToStr(eventTrigger.WorkflowVersionId)] = &eventTrigger.FilterExpression.String
+			}
+		}
+
 		ctx, span3 := telemetry.NewSpan(ctx, "db-get-workflow-versions-for-engine") // nolint: ineffassign
 		defer span3.End()
 
@@ -869,7 +966,16 @@ func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, te
 			return nil, fmt.Errorf("failed to fetch workflow versions: %w", err)
 		}
 
-		return &workflows, nil
+		res := make([]*repository.WorkflowVersionForEvent, len(workflows))
+
+		for i, workflow := range workflows {
+			res[i] = &repository.WorkflowVersionForEvent{
+				GetWorkflowVersionForEngineRow: workflow,
+				FilterExpression:               filterExpressions[sqlchelpers.UUIDToStr(workflow.WorkflowVersion.ID)],
+			}
+		}
+
+		return &res, nil
 	})
 
 	if err != nil {
@@ -879,6 +985,143 @@ func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, te
 	return *cachedArr, nil
 }
 
+func (r *workflowAPIRepository) ListWorkflowVersionWeights(ctx context.Context, tenantId, workflowId string) (*repository.WorkflowVersionWeights, error) {
+	return listWorkflowVersionWeights(ctx, r.pool, r.queries, sqlchelpers.UUIDFromStr(workflowId))
+}
+
+func listWorkflowVersionWeights(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Queries, workflowId pgtype.UUID) (*repository.WorkflowVersionWeights, error) {
+	weights, err := queries.ListWorkflowVersionWeights(ctx, pool, workflowId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list version weights: %w", err)
+	}
+
+	res := &repository.WorkflowVersionWeights{
+		Weights: weights,
+	}
+
+	latestWeight, err := queries.GetWorkflowLatestVersionWeight(ctx, pool, workflowId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("could not get latest version weight: %w", err)
+	}
+
+	if err == nil {
+		res.LatestWeight = latestWeight.Weight
+	}
+
+	return res, nil
+}
+
+func (r *workflowAPIRepository) SetWorkflowVersionWeights(ctx context.Context, tenantId, workflowId string, opts *repository.SetWorkflowVersionWeightsOpts) (*repository.WorkflowVersionWeights, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	versionIds := make([]pgtype.UUID, len(opts.Weights))
+	weights := make([]int32, len(opts.Weights))
+	seen := make(map[string]bool, len(opts.Weights))
+
+	var total int64
+
+	for i, w := range opts.Weights {
+		if seen[w.WorkflowVersionId] {
+			return nil, fmt.Errorf("%w: version %s is listed more than once", repository.ErrInvalidVersionWeights, w.WorkflowVersionId)
+		}
+
+		seen[w.WorkflowVersionId] = true
+		versionIds[i] = sqlchelpers.UUIDFromStr(w.WorkflowVersionId)
+		weights[i] = w.Weight
+		total += int64(w.Weight)
+	}
+
+	if len(opts.Weights) > 0 && total+int64(opts.LatestWeight) == 0 {
+		return nil, fmt.Errorf("%w: at least one weight must be positive", repository.ErrInvalidVersionWeights)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgWorkflowId := sqlchelpers.UUIDFromStr(workflowId)
+
+	err = r.queries.DeleteWorkflowVersionWeights(ctx, tx, dbsqlc.DeleteWorkflowVersionWeightsParams{
+		Tenantid:   pgTenantId,
+		Workflowid: pgWorkflowId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete version weights: %w", err)
+	}
+
+	created := []*dbsqlc.WorkflowVersionWeight{}
+
+	if len(opts.Weights) > 0 {
+		created, err = r.queries.CreateWorkflowVersionWeights(ctx, tx, dbsqlc.CreateWorkflowVersionWeightsParams{
+			Workflowversionids: versionIds,
+			Weights:            weights,
+			Workflowid:         pgWorkflowId,
+			Tenantid:           pgTenantId,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create version weights: %w", err)
+		}
+
+		// versions which don't belong to the workflow are skipped by the insert
+		if len(created) != len(opts.Weights) {
+			return nil, fmt.Errorf("%w: all versions must belong to the workflow", repository.ErrInvalidVersionWeights)
+		}
+	}
+
+	err = r.queries.DeleteWorkflowLatestVersionWeight(ctx, tx, dbsqlc.DeleteWorkflowLatestVersionWeightParams{
+		Tenantid:   pgTenantId,
+		Workflowid: pgWorkflowId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete latest version weight: %w", err)
+	}
+
+	res := &repository.WorkflowVersionWeights{
+		Weights: created,
+	}
+
+	// the latest weight only matters next to the weights of other versions
+	if len(opts.Weights) > 0 && opts.LatestWeight > 0 {
+		_, err = r.queries.CreateWorkflowLatestVersionWeight(ctx, tx, dbsqlc.CreateWorkflowLatestVersionWeightParams{
+			Workflowid: pgWorkflowId,
+			Tenantid:   pgTenantId,
+			Weight:     opts.LatestWeight,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create latest version weight: %w", err)
+		}
+
+		res.LatestWeight = opts.LatestWeight
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *workflowAPIRepository) CountWorkflowRunsByVersion(ctx context.Context, tenantId, workflowId string, since time.Time) ([]*dbsqlc.CountWorkflowRunsByVersionRow, error) {
+	return r.queries.CountWorkflowRunsByVersion(ctx, r.pool, dbsqlc.CountWorkflowRunsByVersionParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Since:      sqlchelpers.TimestampFromTime(since),
+		Workflowid: sqlchelpers.UUIDFromStr(workflowId),
+	})
+}
+
 func (r *workflowAPIRepository) GetWorkflowWorkerCount(tenantId, workflowId string) (int, int, error) {
 	params := dbsqlc.GetWorkflowWorkerCountParams{
 		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
@@ -931,6 +1174,10 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 		createParams.ScheduleTimeout = sqlchelpers.TextFromStr(*opts.ScheduleTimeout)
 	}
 
+	if opts.RunTimeout != nil {
+		createParams.RunTimeout = sqlchelpers.TextFromStr(*opts.RunTimeout)
+	}
+
 	if opts.Sticky != nil {
 		createParams.Sticky = dbsqlc.NullStickyStrategy{
 			StickyStrategy: dbsqlc.StickyStrategy(*opts.Sticky),
@@ -1060,12 +1307,19 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 	}
 
 	for _, eventTrigger := range opts.EventTriggers {
+		var filterExpression pgtype.Text
+
+		if expr, ok := opts.EventTriggerFilters[eventTrigger]; ok {
+			filterExpression = sqlchelpers.TextFromStr(expr)
+		}
+
 		_, err := r.queries.CreateWorkflowTriggerEventRef(
 			ctx,
 			tx,
 			dbsqlc.CreateWorkflowTriggerEventRefParams{
 				Workflowtriggersid: sqlcWorkflowTriggers.ID,
 				Eventtrigger:       eventTrigger,
+				FilterExpression:   filterExpression,
 			},
 		)
 
@@ -1087,6 +1341,7 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 					String: "",
 					Valid:  true,
 				},
+				CatchUp: sqlchelpers.BoolFromBoolean(opts.CronCatchUpPolicies[cronTrigger] == "FIRE_ONCE"),
 			},
 		)
 
@@ -1235,6 +1490,27 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 			}
 		}
 
+		if stepOpts.RetryBackoffBaseMs != nil {
+			createStepParams.RetryBackoffBase = pgtype.Int4{
+				Int32: int32(*stepOpts.RetryBackoffBaseMs), // nolint: gosec
+				Valid: true,
+			}
+		}
+
+		if stepOpts.RetryBackoffJitter != nil {
+			createStepParams.RetryBackoffJitter = pgtype.Float8{
+				Float64: *stepOpts.RetryBackoffJitter,
+				Valid:   true,
+			}
+		}
+
+		if stepOpts.JoinCount != nil {
+			createStepParams.JoinCount = pgtype.Int4{
+				Int32: int32(*stepOpts.JoinCount), // nolint: gosec
+				Valid: true,
+			}
+		}
+
 		_, err = r.queries.CreateStep(
 			ctx,
 			tx,
@@ -230,7 +230,7 @@ func (w *workflowRunEngineRepository) GetWorkflowRunInputData(tenantId, workflow
 }
 
 func (w *workflowRunAPIRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
-	return metered.MakeMetered(ctx, w.m, dbsqlc.LimitResourceWORKFLOWRUN, tenantId, 1, func() (*string, *dbsqlc.WorkflowRun, error) {
+	return metered.MakeMeteredWorkflowRuns(ctx, w.m, tenantId, 1, func() (*string, *dbsqlc.WorkflowRun, error) {
 		opts.TenantId = tenantId
 
 		if err := w.v.Validate(opts); err != nil {
@@ -442,6 +442,17 @@ func (w *workflowRunEngineRepository) processUnpausedWorkflowRunsWithTx(ctx cont
 
 	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
 
+	// while the tenant is paused, none of its workflow runs are unpaused
+	tenant, err := w.queries.GetTenantByID(ctx, tx, pgTenantId)
+
+	if err != nil {
+		return nil, false, fmt.Errorf("could not get tenant: %w", err)
+	}
+
+	if tenant.IsPaused {
+		return nil, false, nil
+	}
+
 	limit := 1000
 
 	// list queues
@@ -520,6 +531,18 @@ func (w *workflowRunEngineRepository) processUnpausedWorkflowRunsWithTx(ctx cont
 		return nil, false, fmt.Errorf("could not get workflow runs by id: %w", err)
 	}
 
+	// return the workflow runs in the order of their queue items, so they're scheduled in the order in
+	// which they were triggered
+	order := make(map[pgtype.UUID]int, len(workflowRunsToQueue))
+
+	for i, id := range workflowRunsToQueue {
+		order[id] = i
+	}
+
+	sort.SliceStable(workflowRuns, func(i, j int) bool {
+		return order[workflowRuns[i].WorkflowRun.ID] < order[workflowRuns[j].WorkflowRun.ID]
+	})
+
 	// if we reached this point, it means that some of the workflows in the queue were unpaused, so
 	// we should continue until this is no longer true
 	return workflowRuns, true, nil
@@ -839,6 +862,75 @@ func (w *workflowRunEngineRepository) GetScheduledChildWorkflowRun(ctx context.C
 	return w.queries.GetScheduledChildWorkflowRun(ctx, w.pool, childParams)
 }
 
+func (w *workflowRunEngineRepository) ListChildWorkflowRunsToCancel(ctx context.Context, tenantId, parentStepRunId string) ([]string, error) {
+	ids, err := w.queries.ListChildWorkflowRunsToCancel(ctx, w.pool, dbsqlc.ListChildWorkflowRunsToCancelParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Parentsteprunid: sqlchelpers.UUIDFromStr(parentStepRunId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list child workflow runs: %w", err)
+	}
+
+	res := make([]string, len(ids))
+
+	for i, id := range ids {
+		res[i] = sqlchelpers.UUIDToStr(id)
+	}
+
+	return res, nil
+}
+
+func (w *workflowRunEngineRepository) ListExpiredWorkflowRuns(ctx context.Context, tenantId string, limit int) ([]string, []string, error) {
+	rows, err := w.queries.ListExpiredWorkflowRuns(ctx, w.pool, dbsqlc.ListExpiredWorkflowRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit: pgtype.Int4{
+			Int32: int32(limit), // nolint: gosec
+			Valid: true,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not list expired workflow runs: %w", err)
+	}
+
+	running := make([]string, 0, len(rows))
+	finished := make([]string, 0)
+
+	for _, row := range rows {
+		if row.Finished {
+			finished = append(finished, sqlchelpers.UUIDToStr(row.WorkflowRunId))
+		} else {
+			running = append(running, sqlchelpers.UUIDToStr(row.WorkflowRunId))
+		}
+	}
+
+	return running, finished, nil
+}
+
+func (w *workflowRunEngineRepository) MarkWorkflowRunsExpired(ctx context.Context, tenantId string, workflowRunIds []string) error {
+	if len(workflowRunIds) == 0 {
+		return nil
+	}
+
+	ids := make([]pgtype.UUID, len(workflowRunIds))
+
+	for i, id := range workflowRunIds {
+		ids[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	err := w.queries.MarkWorkflowRunsExpired(ctx, w.pool, dbsqlc.MarkWorkflowRunsExpiredParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Workflowrunids: ids,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not mark workflow runs as expired: %w", err)
+	}
+
+	return nil
+}
+
 func (w *workflowRunEngineRepository) PopWorkflowRunsCancelInProgress(ctx context.Context, tenantId, workflowVersionId string, maxRuns int) ([]*dbsqlc.WorkflowRun, []*dbsqlc.WorkflowRun, error) {
 	ctx, span := telemetry.NewSpan(ctx, "queue-by-cancel-in-progress")
 	defer span.End()
@@ -1084,12 +1176,9 @@ func (w *workflowRunEngineRepository) PopWorkflowRunsRoundRobin(ctx context.Cont
 			return nil, nil, fmt.Errorf("could not get workflow run: %w", err)
 		}
 
+		// runs of paused workflows or tenants are moved to the paused queue
 		isPaused := workflowRun.IsPaused.Valid && workflowRun.IsPaused.Bool
 
-		if isPaused {
-			continue
-		}
-
 		ssr, err := w.queueWorkflowRunJobs(ctx, tx, workflowRun, isPaused)
 
 		if err != nil {
@@ -1120,12 +1209,9 @@ func (w *workflowRunEngineRepository) QueueWorkflowRunJobs(ctx context.Context,
 		return nil, fmt.Errorf("could not get workflow run: %w", err)
 	}
 
+	// runs of paused workflows or tenants are moved to the paused queue
 	isPaused := workflowRun.IsPaused.Valid && workflowRun.IsPaused.Bool
 
-	if isPaused {
-		return nil, nil
-	}
-
 	ssr, err := w.queueWorkflowRunJobs(ctx, tx, workflowRun, isPaused)
 
 	if err != nil {
@@ -1264,7 +1350,7 @@ func (w *workflowRunEngineRepository) CreateNewWorkflowRuns(ctx context.Context,
 		opt.TenantId = tenantId
 	}
 
-	wfrs, err := metered.MakeMetered(ctx, w.m, dbsqlc.LimitResourceWORKFLOWRUN, tenantId, int32(meteredAmount), func() (*string, *[]*dbsqlc.WorkflowRun, error) { // nolint: gosec
+	wfrs, err := metered.MakeMeteredWorkflowRuns(ctx, w.m, tenantId, int32(meteredAmount), func() (*string, *[]*dbsqlc.WorkflowRun, error) { // nolint: gosec
 
 		wfrs, err := createNewWorkflowRuns(ctx, w.pool, w.queries, w.l, opts)
 
@@ -1299,7 +1385,28 @@ func (w *workflowRunEngineRepository) CreateNewWorkflowRuns(ctx context.Context,
 }
 
 func (w *workflowRunEngineRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
-	wfr, err := metered.MakeMetered(ctx, w.m, dbsqlc.LimitResourceWORKFLOWRUN, tenantId, 1, func() (*string, *dbsqlc.WorkflowRun, error) {
+	if opts.IdempotencyKey != nil {
+		// checks the key before metering, so that duplicates don't count towards the limits of the tenant
+		existing, err := w.queries.GetWorkflowRunIdempotencyKey(ctx, w.pool, dbsqlc.GetWorkflowRunIdempotencyKeyParams{
+			Workflowversionid: sqlchelpers.UUIDFromStr(opts.WorkflowVersionId),
+			Tenantid:          sqlchelpers.UUIDFromStr(tenantId),
+			Key:               *opts.IdempotencyKey,
+		})
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("could not get workflow run idempotency key: %w", err)
+		}
+
+		if err == nil {
+			return nil, repository.ErrIdempotencyKeyExists{
+				Key:           *opts.IdempotencyKey,
+				WorkflowRunId: sqlchelpers.UUIDToStr(existing.WorkflowRunId),
+				OtherWorkflow: !existing.SameWorkflow,
+			}
+		}
+	}
+
+	wfr, err := metered.MakeMeteredWorkflowRuns(ctx, w.m, tenantId, 1, func() (*string, *dbsqlc.WorkflowRun, error) {
 		opts.TenantId = tenantId
 
 		if err := w.v.Validate(opts); err != nil {
@@ -1309,7 +1416,8 @@ func (w *workflowRunEngineRepository) CreateNewWorkflowRun(ctx context.Context,
 		var workflowRun *dbsqlc.WorkflowRun
 		var err error
 
-		if w.cf.BufferCreateWorkflowRuns {
+		// runs with an idempotency key aren't buffered, so that a duplicate key doesn't fail other runs
+		if w.cf.BufferCreateWorkflowRuns && opts.IdempotencyKey == nil {
 			workflowRun, err = w.bulkWorkflowRunBuffer.FireAndWait(ctx, tenantId, opts)
 
 			if err != nil {
@@ -1338,6 +1446,39 @@ func (w *workflowRunEngineRepository) ListActiveQueuedWorkflowVersions(ctx conte
 	return w.queries.ListActiveQueuedWorkflowVersions(ctx, w.pool, sqlchelpers.UUIDFromStr(tenantId))
 }
 
+func (w *workflowRunEngineRepository) ListConcurrencyKeys(ctx context.Context, tenantId, workflowId string) ([]*dbsqlc.ListConcurrencyKeysForWorkflowRow, error) {
+	return w.queries.ListConcurrencyKeysForWorkflow(ctx, w.pool, dbsqlc.ListConcurrencyKeysForWorkflowParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: sqlchelpers.UUIDFromStr(workflowId),
+	})
+}
+
+func (w *workflowRunEngineRepository) ListConcurrencySlotHolders(ctx context.Context, tenantId, workflowId string, key *string) ([]*dbsqlc.ListConcurrencySlotHoldersRow, error) {
+	params := dbsqlc.ListConcurrencySlotHoldersParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: sqlchelpers.UUIDFromStr(workflowId),
+	}
+
+	if key != nil {
+		params.Key = sqlchelpers.TextFromStr(*key)
+	}
+
+	return w.queries.ListConcurrencySlotHolders(ctx, w.pool, params)
+}
+
+func (w *workflowRunEngineRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, tenantId string) (bool, error) {
+	deleted, err := w.queries.DeleteExpiredWorkflowRunIdempotencyKeys(ctx, w.pool, dbsqlc.DeleteExpiredWorkflowRunIdempotencyKeysParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    1000,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete expired workflow run idempotency keys: %w", err)
+	}
+
+	return deleted == 1000, nil
+}
+
 func (w *workflowRunEngineRepository) SoftDeleteExpiredWorkflowRuns(ctx context.Context, tenantId string, statuses []dbsqlc.WorkflowRunStatus, before time.Time) (bool, error) {
 	paramStatuses := make([]string, 0)
 
@@ -1363,6 +1504,25 @@ func (w *workflowRunEngineRepository) SoftDeleteExpiredWorkflowRuns(ctx context.
 	return hasMore, nil
 }
 
+func (w *workflowRunEngineRepository) PurgeDeletedWorkflowRuns(ctx context.Context, tenantId string, deletedBefore time.Time) (bool, error) {
+	// each workflow run is purged along with all of its step runs, so batches are smaller than for soft deletes
+	hasMore, err := w.queries.PurgeDeletedWorkflowRuns(ctx, w.pool, dbsqlc.PurgeDeletedWorkflowRunsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Deletedbefore: sqlchelpers.TimestampFromTime(deletedBefore),
+		Limit:         250,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return hasMore, nil
+}
+
 func (s *workflowRunEngineRepository) ReplayWorkflowRun(ctx context.Context, tenantId, workflowRunId string) (*dbsqlc.GetWorkflowRunRow, error) {
 	ctx, span := telemetry.NewSpan(ctx, "replay-workflow-run")
 	defer span.End()
@@ -1612,6 +1772,17 @@ func (s *sharedRepository) listWorkflowRuns(ctx context.Context, tx dbsqlc.DBTX,
 		countParams.Ids = pgIds
 	}
 
+	if len(opts.ExcludedWorkflowIds) > 0 {
+		pgExcludedWorkflowIds := make([]pgtype.UUID, len(opts.ExcludedWorkflowIds))
+
+		for i, id := range opts.ExcludedWorkflowIds {
+			pgExcludedWorkflowIds[i] = sqlchelpers.UUIDFromStr(id)
+		}
+
+		queryParams.ExcludedWorkflowIds = pgExcludedWorkflowIds
+		countParams.ExcludedWorkflowIds = pgExcludedWorkflowIds
+	}
+
 	if opts.ParentId != nil {
 		pgParentId := sqlchelpers.UUIDFromStr(*opts.ParentId)
 
@@ -1633,6 +1804,11 @@ func (s *sharedRepository) listWorkflowRuns(ctx context.Context, tx dbsqlc.DBTX,
 		countParams.EventId = pgEventId
 	}
 
+	if opts.EventKey != nil {
+		queryParams.EventKey = sqlchelpers.TextFromStr(*opts.EventKey)
+		countParams.EventKey = sqlchelpers.TextFromStr(*opts.EventKey)
+	}
+
 	if opts.GroupKey != nil {
 		queryParams.GroupKey = sqlchelpers.TextFromStr(*opts.GroupKey)
 		countParams.GroupKey = sqlchelpers.TextFromStr(*opts.GroupKey)
@@ -1680,12 +1856,42 @@ func (s *sharedRepository) listWorkflowRuns(ctx context.Context, tx dbsqlc.DBTX,
 		queryParams.FinishedBefore = sqlchelpers.TimestampFromTime(*opts.FinishedBefore)
 	}
 
+	if len(opts.WorkflowIds) > 0 {
+		pgWorkflowIds := make([]pgtype.UUID, len(opts.WorkflowIds))
+
+		for i, id := range opts.WorkflowIds {
+			pgWorkflowIds[i] = sqlchelpers.UUIDFromStr(id)
+		}
+
+		queryParams.WorkflowIds = pgWorkflowIds
+		countParams.WorkflowIds = pgWorkflowIds
+	}
+
+	if len(opts.EventKeys) > 0 {
+		queryParams.EventKeys = opts.EventKeys
+		countParams.EventKeys = opts.EventKeys
+	}
+
+	if opts.Search != nil {
+		queryParams.Search = sqlchelpers.TextFromStr(*opts.Search)
+		countParams.Search = sqlchelpers.TextFromStr(*opts.Search)
+	}
+
 	orderByField := "createdAt"
 
 	if opts.OrderBy != nil {
 		orderByField = *opts.OrderBy
 	}
 
+	if opts.Cursor != nil {
+		if orderByField != "createdAt" {
+			return nil, fmt.Errorf("cursors are only supported when ordering workflow runs by createdAt")
+		}
+
+		queryParams.CursorCreatedAt = sqlchelpers.TimestampFromTime(opts.Cursor.CreatedAt)
+		queryParams.CursorId = sqlchelpers.UUIDFromStr(opts.Cursor.Id)
+	}
+
 	orderByDirection := "DESC"
 
 	if opts.OrderDirection != nil {
@@ -1802,9 +2008,15 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 		}
 
 		var stickyInfos []stickyInfo
+		var expiryParams dbsqlc.CreateWorkflowRunExpiriesParams
 		var triggeredByParams []dbsqlc.CreateWorkflowRunTriggeredBysParams
 		var groupKeyParams []dbsqlc.CreateGetGroupKeyRunsParams
 		var jobRunParams []dbsqlc.CreateJobRunsParams
+		var partialRunIds []string
+		var idempotencyKeyParams []dbsqlc.ClaimWorkflowRunIdempotencyKeyParams
+
+		// the workflow versions of the runs which claim a key, by workflow run id
+		idempotencyKeyVersions := make(map[string]string)
 
 		for order, opt := range inputOpts {
 
@@ -1868,6 +2080,35 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 					Valid: true,
 				}
 			}
+
+			if opt.Detached {
+				createParams.Detached = pgtype.Bool{
+					Bool:  true,
+					Valid: true,
+				}
+			}
+
+			if opt.TimeoutAt != nil {
+				createParams.TimeoutAt = sqlchelpers.TimestampFromTime(*opt.TimeoutAt)
+			}
+
+			if opt.ExpiresAt != nil {
+				expiryParams.Workflowrunids = append(expiryParams.Workflowrunids, sqlchelpers.UUIDFromStr(workflowRunId))
+				expiryParams.Tenantids = append(expiryParams.Tenantids, sqlchelpers.UUIDFromStr(opt.TenantId))
+				expiryParams.Expiresats = append(expiryParams.Expiresats, sqlchelpers.TimestampFromTime(opt.ExpiresAt.UTC()))
+			}
+
+			if opt.IdempotencyKey != nil {
+				idempotencyKeyVersions[workflowRunId] = opt.WorkflowVersionId
+
+				idempotencyKeyParams = append(idempotencyKeyParams, dbsqlc.ClaimWorkflowRunIdempotencyKeyParams{
+					Tenantid:      sqlchelpers.UUIDFromStr(opt.TenantId),
+					Key:           *opt.IdempotencyKey,
+					Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+					Expiresat:     sqlchelpers.TimestampFromTime(opt.IdempotencyKeyExpiresAt.UTC()),
+				})
+			}
+
 			if order > math.MaxInt32 || order < math.MinInt32 {
 				return nil, errors.New("order must be within the range of a 32-bit signed integer")
 			}
@@ -1885,6 +2126,8 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 				Priority:           createParams.Priority,
 				Status:             "PENDING",
 				InsertOrder:        pgtype.Int4{Int32: int32(order), Valid: true},
+				Detached:           createParams.Detached.Bool,
+				TimeoutAt:          createParams.TimeoutAt,
 			}
 
 			createRunsParams = append(createRunsParams, crp)
@@ -1904,8 +2147,8 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 			})
 
 			var (
-				eventId, cronParentId, scheduledWorkflowId pgtype.UUID
-				cronSchedule, cronName                     pgtype.Text
+				eventId, cronParentId, scheduledWorkflowId, actorId pgtype.UUID
+				cronSchedule, cronName, actorType                   pgtype.Text
 			)
 
 			if opt.TriggeringEventId != nil {
@@ -1928,6 +2171,11 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 				scheduledWorkflowId = sqlchelpers.UUIDFromStr(*opt.ScheduledWorkflowId)
 			}
 
+			if opt.TriggeringActor != nil {
+				actorType = sqlchelpers.TextFromStr(string(opt.TriggeringActor.Type))
+				actorId = sqlchelpers.UUIDFromStr(opt.TriggeringActor.Id)
+			}
+
 			cp := dbsqlc.CreateWorkflowRunTriggeredBysParams{
 				ID:           sqlchelpers.UUIDFromStr(uuid.New().String()),
 				TenantId:     sqlchelpers.UUIDFromStr(opt.TenantId),
@@ -1937,6 +2185,8 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 				ScheduledId:  scheduledWorkflowId,
 				CronSchedule: cronSchedule,
 				CronName:     cronName,
+				ActorType:    actorType,
+				ActorId:      actorId,
 			}
 
 			triggeredByParams = append(triggeredByParams, cp)
@@ -1953,6 +2203,12 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 				})
 			}
 
+			// partial workflow runs only get the job run of their step, which is created below
+			if opt.PartialRun != nil {
+				partialRunIds = append(partialRunIds, workflowRunId)
+				continue
+			}
+
 			jobRunParams = append(jobRunParams, dbsqlc.CreateJobRunsParams{
 				Tenantid:          sqlchelpers.UUIDFromStr(opt.TenantId),
 				Workflowrunid:     sqlchelpers.UUIDFromStr(workflowRunId),
@@ -1989,6 +2245,41 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 			return nil, errors.New("number of created workflow runs does not match number of returned workflow runs")
 		}
 
+		if len(expiryParams.Workflowrunids) > 0 {
+			err = queries.CreateWorkflowRunExpiries(tx1Ctx, tx, expiryParams)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to create workflow run expiries: %w", err)
+			}
+		}
+
+		for _, params := range idempotencyKeyParams {
+			// waits for concurrent transactions which claim the same key
+			claimed, err := queries.ClaimWorkflowRunIdempotencyKey(tx1Ctx, tx, params)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to claim workflow run idempotency key: %w", err)
+			}
+
+			if claimed == 0 {
+				existing, err := queries.GetWorkflowRunIdempotencyKey(tx1Ctx, tx, dbsqlc.GetWorkflowRunIdempotencyKeyParams{
+					Workflowversionid: sqlchelpers.UUIDFromStr(idempotencyKeyVersions[sqlchelpers.UUIDToStr(params.Workflowrunid)]),
+					Tenantid:          params.Tenantid,
+					Key:               params.Key,
+				})
+
+				if err != nil {
+					return nil, fmt.Errorf("failed to get workflow run idempotency key: %w", err)
+				}
+
+				return nil, repository.ErrIdempotencyKeyExists{
+					Key:           params.Key,
+					WorkflowRunId: sqlchelpers.UUIDToStr(existing.WorkflowRunId),
+					OtherWorkflow: !existing.SameWorkflow,
+				}
+			}
+		}
+
 		if len(stickyInfos) > 0 {
 
 			stickyWorkflowRunIds := make([]pgtype.UUID, 0)
@@ -2127,6 +2418,7 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 				return nil, err
 			}
 
+			// the step runs take the priority of their workflow run, or the lowest priority if it has none
 			stepRunIds, err := queries.CreateStepRunsForJobRunIds(tx1Ctx, tx, dbsqlc.CreateStepRunsForJobRunIdsParams{
 				Jobrunids: jobRunIds,
 				Priority:  1,
@@ -2151,6 +2443,31 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 
 		}
 
+		for _, workflowRunId := range partialRunIds {
+			opt := workflowRunOptsMap[workflowRunId]
+
+			priority := int32(1)
+
+			if opt.Priority != nil {
+				priority = *opt.Priority
+			}
+
+			_, err = queries.CreatePartialJobRun(tx1Ctx, tx, dbsqlc.CreatePartialJobRunParams{
+				Stepid:        sqlchelpers.UUIDFromStr(opt.PartialRun.StepId),
+				Tenantid:      sqlchelpers.UUIDFromStr(opt.TenantId),
+				Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+				Priority:      priority,
+				Input:         opt.InputData,
+				Triggeredby:   opt.TriggeredBy,
+				Steps:         opt.PartialRun.ParentOutputs,
+			})
+
+			if err != nil {
+				l.Error().Err(err).Msg("failed to create partial job run")
+				return nil, err
+			}
+		}
+
 		err = commit(tx1Ctx)
 
 		if err != nil {
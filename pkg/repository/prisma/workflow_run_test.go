@@ -0,0 +1,269 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestListChildWorkflowRunsToCancel(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		parentId := createTestWorkflowRun(t, conf, tenantId, workflowVersion)
+		parentStepRunId := sqlchelpers.UUIDToStr(listTestStepRuns(t, conf, tenantId, parentId)[0].SRID)
+
+		createChild := func(childIndex int, detached bool) string {
+			opts, err := repository.GetCreateWorkflowRunOptsFromParent(workflowVersion, nil, parentId, parentStepRunId, childIndex, nil, nil, nil)
+			require.NoError(t, err)
+
+			opts.Detached = detached
+
+			child, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+			require.NoError(t, err)
+
+			return sqlchelpers.UUIDToStr(child.ID)
+		}
+
+		attached := createChild(0, false)
+		createChild(1, true)
+
+		// detached children survive the cancellation of their parent step run
+		ids, err := conf.EngineRepository.WorkflowRun().ListChildWorkflowRunsToCancel(ctx, tenantId, parentStepRunId)
+		require.NoError(t, err)
+		assert.Equal(t, []string{attached}, ids)
+
+		// the children of other step runs are not cancelled
+		ids, err = conf.EngineRepository.WorkflowRun().ListChildWorkflowRunsToCancel(ctx, tenantId, uuid.New().String())
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		return nil
+	})
+}
+
+func TestCreatePartialWorkflowRun(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		step, err := conf.EngineRepository.Workflow().GetStepForWorkflowVersion(ctx, tenantId, sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID), "second")
+		require.NoError(t, err)
+
+		workflowRunId := createTestWorkflowRun(t, conf, tenantId, workflowVersion, repository.WithPartialRun(step, []byte(`{"first":{"ok":true}}`)))
+
+		// only the step run of the requested step is created, without waiting for its parent
+		stepRuns := listTestStepRuns(t, conf, tenantId, workflowRunId)
+		require.Len(t, stepRuns, 1)
+		assert.Equal(t, step.ID, stepRuns[0].StepId)
+		assert.Equal(t, "second", stepRuns[0].StepReadableId.String)
+
+		data, err := conf.EngineRepository.StepRun().GetStepRunDataForEngine(ctx, tenantId, sqlchelpers.UUIDToStr(stepRuns[0].SRID))
+		require.NoError(t, err)
+
+		var lookupData map[string]interface{}
+		require.NoError(t, json.Unmarshal(data.JobRunLookupData, &lookupData))
+
+		assert.Equal(t, map[string]interface{}{"first": map[string]interface{}{"ok": true}}, lookupData["steps"])
+
+		// the run is marked as partial
+		var additionalMetadata map[string]interface{}
+		require.NoError(t, json.Unmarshal(data.AdditionalMetadata, &additionalMetadata))
+
+		assert.Equal(t, "second", additionalMetadata[repository.PartialRunMetadataKey])
+
+		// runs which are not partial get the step runs of every step
+		assert.Len(t, listTestStepRuns(t, conf, tenantId, createTestWorkflowRun(t, conf, tenantId, workflowVersion)), 2)
+
+		return nil
+	})
+}
+
+func TestCreateWorkflowRunTriggeredByActor(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		actor := &repository.Actor{Type: repository.ActorTypeAPIToken, Id: uuid.New().String()}
+
+		workflowRun, err := conf.EngineRepository.WorkflowRun().GetWorkflowRunById(ctx, tenantId, createTestWorkflowRun(t, conf, tenantId, workflowVersion, repository.WithActor(actor)))
+		require.NoError(t, err)
+
+		// the actor which triggered the run is stored with the trigger
+		assert.Equal(t, "API_TOKEN", workflowRun.WorkflowRunTriggeredBy.ActorType.String)
+		assert.Equal(t, actor.Id, sqlchelpers.UUIDToStr(workflowRun.WorkflowRunTriggeredBy.ActorId))
+
+		// runs which weren't triggered by an actor have none
+		workflowRun, err = conf.EngineRepository.WorkflowRun().GetWorkflowRunById(ctx, tenantId, createTestWorkflowRun(t, conf, tenantId, workflowVersion))
+		require.NoError(t, err)
+
+		assert.False(t, workflowRun.WorkflowRunTriggeredBy.ActorType.Valid)
+		assert.False(t, workflowRun.WorkflowRunTriggeredBy.ActorId.Valid)
+
+		return nil
+	})
+}
+
+func TestCreateChildWorkflowRunWithExistingChildKey(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		parentId := createTestWorkflowRun(t, conf, tenantId, workflowVersion)
+		parentStepRunId := sqlchelpers.UUIDToStr(listTestStepRuns(t, conf, tenantId, parentId)[0].SRID)
+
+		createChild := func(childIndex int, childKey string) error {
+			opts, err := repository.GetCreateWorkflowRunOptsFromParent(workflowVersion, nil, parentId, parentStepRunId, childIndex, &childKey, nil, nil)
+			require.NoError(t, err)
+
+			_, err = conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+
+			return err
+		}
+
+		require.NoError(t, createChild(0, "child"))
+		require.NoError(t, createChild(1, "other-child"))
+
+		// child keys are unique across the partitions of the workflow runs
+		assert.Error(t, createChild(2, "child"))
+
+		return nil
+	})
+}
+
+func TestPurgeDeletedWorkflowRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createOrderedEventTenant(t, conf)
+		workflowVersion := createTestWorkflow(t, conf, tenantId)
+
+		purgedId := createTestWorkflowRun(t, conf, tenantId, workflowVersion)
+		keptId := createTestWorkflowRun(t, conf, tenantId, workflowVersion)
+		purgedStepRunId := sqlchelpers.UUIDToStr(listTestStepRuns(t, conf, tenantId, purgedId)[0].SRID)
+
+		childKey := "child"
+
+		opts, err := repository.GetCreateWorkflowRunOptsFromParent(workflowVersion, nil, purgedId, purgedStepRunId, 0, &childKey, nil, nil)
+		require.NoError(t, err)
+
+		child, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+		require.NoError(t, err)
+
+		childId := sqlchelpers.UUIDToStr(child.ID)
+
+		_, err = conf.Pool.Exec(ctx, `INSERT INTO "StepRunDeadLetter" ("stepRunId", "tenantId") VALUES ($1::uuid, $2::uuid)`, purgedStepRunId, tenantId)
+		require.NoError(t, err)
+
+		_, err = conf.Pool.Exec(ctx, `UPDATE "WorkflowRun" SET "deletedAt" = CURRENT_TIMESTAMP - INTERVAL '1 hour' WHERE "id" = $1::uuid`, purgedId)
+		require.NoError(t, err)
+
+		// runs which were deleted after the given time are kept
+		hasMore, err := conf.EngineRepository.WorkflowRun().PurgeDeletedWorkflowRuns(ctx, tenantId, time.Now().UTC().Add(-2*time.Hour))
+		require.NoError(t, err)
+		assert.False(t, hasMore)
+		assert.Equal(t, 1, countTestRows(t, conf, `SELECT COUNT(*) FROM "WorkflowRun" WHERE "id" = $1::uuid`, purgedId))
+
+		hasMore, err = conf.EngineRepository.WorkflowRun().PurgeDeletedWorkflowRuns(ctx, tenantId, time.Now().UTC())
+		require.NoError(t, err)
+		assert.False(t, hasMore)
+
+		// the run and the rows which referenced it are deleted
+		for query, args := range map[string][]any{
+			`SELECT COUNT(*) FROM "WorkflowRun" WHERE "id" = $1::uuid`:                       {purgedId},
+			`SELECT COUNT(*) FROM "JobRun" WHERE "workflowRunId" = $1::uuid`:                 {purgedId},
+			`SELECT COUNT(*) FROM "WorkflowRunStickyState" WHERE "workflowRunId" = $1::uuid`: {purgedId},
+			`SELECT COUNT(*) FROM "WorkflowRunTriggeredBy" WHERE "parentId" = $1::uuid`:      {purgedId},
+			`SELECT COUNT(*) FROM "StepRun" WHERE "id" = $1::uuid`:                           {purgedStepRunId},
+			`SELECT COUNT(*) FROM "StepRunDeadLetter" WHERE "stepRunId" = $1::uuid`:          {purgedStepRunId},
+			`SELECT COUNT(*) FROM "WorkflowRunChildKey" WHERE "parentId" = $1::uuid`:         {purgedId},
+		} {
+			assert.Equal(t, 0, countTestRows(t, conf, query, args...), query)
+		}
+
+		// the child of the run is kept without its parent, and the other run is kept
+		assert.Equal(t, 1, countTestRows(t, conf, `SELECT COUNT(*) FROM "WorkflowRun" WHERE "id" = $1::uuid AND "parentId" IS NULL`, childId))
+		assert.Len(t, listTestStepRuns(t, conf, tenantId, keptId), 2)
+
+		return nil
+	})
+}
+
+// countTestRows returns the count selected by the query.
+func countTestRows(t *testing.T, conf *database.Config, query string, args ...any) int {
+	t.Helper()
+
+	var count int
+
+	err := conf.Pool.QueryRow(context.Background(), query, args...).Scan(&count)
+	require.NoError(t, err)
+
+	return count
+}
+
+// createTestWorkflow creates a workflow with a single job, which runs the steps "first" and "second".
+func createTestWorkflow(t *testing.T, conf *database.Config, tenantId string) *dbsqlc.GetWorkflowVersionForEngineRow {
+	t.Helper()
+
+	workflowVersion, err := conf.EngineRepository.Workflow().CreateNewWorkflow(context.Background(), tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "test-workflow",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "test-job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{ReadableId: "first", Action: "test:first"},
+					{ReadableId: "second", Action: "test:second", Parents: []string{"first"}},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+
+	return workflowVersion
+}
+
+// createTestWorkflowRun triggers a run of the workflow version manually, and returns its id.
+func createTestWorkflowRun(t *testing.T, conf *database.Config, tenantId string, workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow, fs ...repository.CreateWorkflowRunOpt) string {
+	t.Helper()
+
+	opts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, nil, nil, fs...)
+	require.NoError(t, err)
+
+	workflowRun, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(context.Background(), tenantId, opts)
+	require.NoError(t, err)
+
+	return sqlchelpers.UUIDToStr(workflowRun.ID)
+}
+
+// listTestStepRuns returns the step runs of the workflow run.
+func listTestStepRuns(t *testing.T, conf *database.Config, tenantId, workflowRunId string) []*dbsqlc.GetStepRunForEngineRow {
+	t.Helper()
+
+	stepRuns, err := conf.EngineRepository.StepRun().ListStepRuns(context.Background(), tenantId, &repository.ListStepRunsOpts{
+		WorkflowRunIds: []string{workflowRunId},
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, stepRuns)
+
+	return stepRuns
+}
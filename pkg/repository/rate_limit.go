@@ -41,4 +41,7 @@ type RateLimitEngineRepository interface {
 
 	// CreateRateLimit creates a new rate limit record
 	UpsertRateLimit(ctx context.Context, tenantId string, key string, opts *UpsertRateLimitOpts) (*dbsqlc.RateLimit, error)
+
+	// ResetRateLimit refills a rate limit to its limit and restarts its window
+	ResetRateLimit(ctx context.Context, tenantId string, key string) (*dbsqlc.RateLimit, error)
 }
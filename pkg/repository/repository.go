@@ -7,11 +7,13 @@ import (
 type APIRepository interface {
 	Health() HealthRepository
 	APIToken() APITokenRepository
+	AuditLog() AuditLogAPIRepository
 	Event() EventAPIRepository
 	Log() LogsAPIRepository
 	Tenant() TenantAPIRepository
 	TenantAlertingSettings() TenantAlertingAPIRepository
 	TenantInvite() TenantInviteRepository
+	TenantRole() TenantRoleRepository
 	Workflow() WorkflowAPIRepository
 	WorkflowRun() WorkflowRunAPIRepository
 	JobRun() JobRunAPIRepository
@@ -24,11 +26,13 @@ type APIRepository interface {
 	User() UserRepository
 	SecurityCheck() SecurityCheckRepository
 	WebhookWorker() WebhookWorkerRepository
+	WebhookSubscription() WebhookSubscriptionAPIRepository
 }
 
 type EngineRepository interface {
 	Health() HealthRepository
 	APIToken() EngineTokenRepository
+	AuditLog() AuditLogEngineRepository
 	Dispatcher() DispatcherEngineRepository
 	Event() EventEngineRepository
 	GetGroupKeyRun() GetGroupKeyRunEngineRepository
@@ -43,10 +47,13 @@ type EngineRepository interface {
 	WorkflowRun() WorkflowRunEngineRepository
 	StreamEvent() StreamEventsEngineRepository
 	Log() LogsEngineRepository
+	Metrics() MetricsEngineRepository
 	RateLimit() RateLimitEngineRepository
 	WebhookWorker() WebhookWorkerEngineRepository
+	WebhookSubscription() WebhookSubscriptionEngineRepository
 	Scheduler() SchedulerRepository
 	MessageQueue() MessageQueueRepository
+	TablePartition() TablePartitionEngineRepository
 }
 
 type EntitlementsRepository interface {
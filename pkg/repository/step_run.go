@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -53,6 +54,33 @@ type CreateStepRunEventOpts struct {
 	EventData map[string]interface{}
 }
 
+// StepRunErrorDetails is structured data about the error of a failed step run, which is sent by the
+// worker. It is stored in the data of the event which records the failure.
+type StepRunErrorDetails struct {
+	// Code identifies the error, so that clients can handle it without parsing the error message
+	Code string
+
+	// (optional) Details is a JSON value with more information about the error
+	Details []byte
+}
+
+// EventData returns the fields of the error details which are added to the data of a step run event.
+func (d *StepRunErrorDetails) EventData() map[string]interface{} {
+	if d == nil {
+		return map[string]interface{}{}
+	}
+
+	data := map[string]interface{}{
+		"error_code": d.Code,
+	}
+
+	if len(d.Details) > 0 {
+		data["error_details"] = json.RawMessage(d.Details)
+	}
+
+	return data
+}
+
 type QueueStepRunOpts struct {
 	IsRetry bool
 
@@ -128,6 +156,19 @@ type ListStepRunArchivesResult struct {
 	Count int
 }
 
+type ListStepRunDeadLettersOpts struct {
+	// (optional) number of dead-lettered step runs to skip
+	Offset *int
+
+	// (optional) number of dead-lettered step runs to return
+	Limit *int
+}
+
+type ListStepRunDeadLettersResult struct {
+	Rows  []*dbsqlc.ListStepRunDeadLettersRow
+	Count int
+}
+
 type GetStepRunFull struct {
 	*dbsqlc.StepRun
 	ChildWorkflowRuns []string
@@ -148,6 +189,18 @@ type StepRunAPIRepository interface {
 	ListStepRunEventsByWorkflowRunId(ctx context.Context, tenantId, workflowRunId string, lastId *int32) (*ListStepRunEventResult, error)
 
 	ListStepRunArchives(tenantId, stepRunId string, opts *ListStepRunArchivesOpts) (*ListStepRunArchivesResult, error)
+
+	// ListStepRunDeadLetters returns the step runs of a tenant which failed after exhausting their retries,
+	// most recently failed first.
+	ListStepRunDeadLetters(ctx context.Context, tenantId string, opts *ListStepRunDeadLettersOpts) (*ListStepRunDeadLettersResult, error)
+
+	// GetStepRunDeadLetter returns the dead letter of a step run, or pgx.ErrNoRows if the step run is not
+	// dead-lettered.
+	GetStepRunDeadLetter(ctx context.Context, tenantId, stepRunId string) (*dbsqlc.StepRunDeadLetter, error)
+
+	// DeleteStepRunDeadLetter removes a step run from the dead letters, and returns false if it was not
+	// dead-lettered.
+	DeleteStepRunDeadLetter(ctx context.Context, tenantId, stepRunId string) (bool, error)
 }
 
 type QueuedStepRun struct {
@@ -182,10 +235,16 @@ type StepRunEngineRepository interface {
 
 	StepRunCancelled(ctx context.Context, tenantId, workflowRunId, stepRunId string, cancelledAt time.Time, cancelledReason string, propagate bool) error
 
-	StepRunFailed(ctx context.Context, tenantId, workflowRunId, stepRunId string, failedAt time.Time, errStr string, retryCount int) error
+	// StepRunFailed fails the step run. errDetails is nil if the worker didn't send structured details
+	// about the error.
+	StepRunFailed(ctx context.Context, tenantId, workflowRunId, stepRunId string, failedAt time.Time, errStr string, errDetails *StepRunErrorDetails, retryCount int) error
 
 	StepRunRetryBackoff(ctx context.Context, tenantId, stepRunId string, retryAfter time.Time) error
 
+	// StepRunSuspended queues a step run which was suspended by its worker to be started again at resumeAt.
+	// Unlike a retry, starting the step run again does not increment its retry count.
+	StepRunSuspended(ctx context.Context, tenantId, stepRunId string, resumeAt time.Time) error
+
 	RetryStepRuns(ctx context.Context, tenantId string) (bool, error)
 
 	ReplayStepRun(ctx context.Context, tenantId, stepRunId string, input []byte) (*dbsqlc.GetStepRunForEngineRow, error)
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// PartitionedTables are the tables which are partitioned by day on their creation time.
+var PartitionedTables = []string{"WorkflowRun", "StepRun", "Event"}
+
+type TablePartitionEngineRepository interface {
+	// CreateTablePartitions creates the missing daily partitions of the partitioned tables, so every row created
+	// before the given time has a partition.
+	CreateTablePartitions(ctx context.Context, until time.Time) error
+
+	// DropEmptyTablePartitions drops the partitions of the partitioned tables whose range ended before the given
+	// time and which have no rows left, and returns their names.
+	DropEmptyTablePartitions(ctx context.Context, endedBefore time.Time) ([]string, error)
+}
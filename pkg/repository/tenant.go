@@ -27,6 +27,8 @@ type UpdateTenantOpts struct {
 	AnalyticsOptOut *bool `validate:"omitempty"`
 
 	AlertMemberEmails *bool `validate:"omitempty"`
+
+	DataRetentionPeriod *string `validate:"omitempty,duration"`
 }
 
 type CreateTenantMemberOpts struct {
@@ -147,4 +149,9 @@ type TenantEngineRepository interface {
 
 	// GetTenantByID returns the tenant with the given id
 	GetTenantByID(ctx context.Context, tenantId string) (*dbsqlc.Tenant, error)
+
+	// UpdateTenantPaused pauses or resumes the tenant. While a tenant is paused, its new workflow runs are
+	// queued but not scheduled. When it is resumed, the queued workflow runs are scheduled in the order in
+	// which they were triggered, except for the runs of workflows which are still paused themselves.
+	UpdateTenantPaused(ctx context.Context, tenantId string, isPaused bool) (*dbsqlc.Tenant, error)
 }
@@ -15,6 +15,8 @@ type UpsertTenantAlertingSettingsOpts struct {
 	EnableExpiringTokenAlerts       *bool   `validate:"omitnil"`
 	EnableWorkflowRunFailureAlerts  *bool   `validate:"omitnil"`
 	EnableTenantResourceLimitAlerts *bool   `validate:"omitnil"`
+	WorkflowRunFailureThreshold     *int    `validate:"omitnil,min=1"`
+	EnableWorkerOfflineAlerts       *bool   `validate:"omitnil"`
 }
 
 type UpdateTenantAlertingSettingsOpts struct {
@@ -29,10 +31,21 @@ type UpdateTenantAlertGroupOpts struct {
 	Emails []string `validate:"required,dive,email,max=255"`
 }
 
+type CreateTenantAlertMuteRuleOpts struct {
+	// (required) the workflow whose failed runs are not alerted
+	WorkflowId string `validate:"required,uuid"`
+
+	// (optional) when the rule expires, the workflow is muted until the rule is deleted if not set
+	MutedUntil *time.Time
+
+	// (optional) why the workflow is muted
+	Reason *string `validate:"omitnil,max=255"`
+}
+
 type TenantAlertingAPIRepository interface {
-	UpsertTenantAlertingSettings(tenantId string, opts *UpsertTenantAlertingSettingsOpts) (*db.TenantAlertingSettingsModel, error)
+	UpsertTenantAlertingSettings(tenantId string, opts *UpsertTenantAlertingSettingsOpts) (*dbsqlc.TenantAlertingSettings, error)
 
-	GetTenantAlertingSettings(tenantId string) (*db.TenantAlertingSettingsModel, error)
+	GetTenantAlertingSettings(tenantId string) (*dbsqlc.TenantAlertingSettings, error)
 
 	CreateTenantAlertGroup(tenantId string, opts *CreateTenantAlertGroupOpts) (*db.TenantAlertEmailGroupModel, error)
 
@@ -43,6 +56,17 @@ type TenantAlertingAPIRepository interface {
 	GetTenantAlertGroupById(id string) (*db.TenantAlertEmailGroupModel, error)
 
 	DeleteTenantAlertGroup(tenantId string, id string) error
+
+	// CreateTenantAlertMuteRule mutes failure alerts for a workflow. Returns ErrDuplicateKey if the workflow
+	// already has a mute rule.
+	CreateTenantAlertMuteRule(ctx context.Context, tenantId string, opts *CreateTenantAlertMuteRuleOpts) (*dbsqlc.TenantAlertMuteRule, error)
+
+	// ListTenantAlertMuteRules returns the mute rules of the tenant, including expired rules.
+	ListTenantAlertMuteRules(ctx context.Context, tenantId string) ([]*dbsqlc.TenantAlertMuteRule, error)
+
+	GetTenantAlertMuteRuleById(ctx context.Context, id string) (*dbsqlc.TenantAlertMuteRule, error)
+
+	DeleteTenantAlertMuteRule(ctx context.Context, id string) error
 }
 
 type TenantAlertEmailGroupForSend struct {
@@ -58,6 +82,9 @@ type GetTenantAlertingSettingsResponse struct {
 	EmailGroups []*TenantAlertEmailGroupForSend
 
 	Tenant *dbsqlc.Tenant
+
+	// MutedWorkflowIds are the workflows whose failed runs are not alerted
+	MutedWorkflowIds []string
 }
 
 type TenantAlertingEngineRepository interface {
@@ -21,6 +21,35 @@ type Limit struct {
 
 type PlanLimitMap map[string][]Limit
 
+// MinLimitWindow is the shortest meter window of a limit. Shorter windows would reset the metered value on
+// almost every request.
+const MinLimitWindow = time.Second
+
+// IsCountedResource returns true for resources whose value is counted whenever the limit is checked
+// rather than metered, like workers and queued runs.
+func IsCountedResource(resource dbsqlc.LimitResource) bool {
+	switch resource {
+	case dbsqlc.LimitResourceWORKER, dbsqlc.LimitResourceQUEUEDRUN:
+		return true
+	default:
+		return false
+	}
+}
+
+type UpdateTenantLimitOpts struct {
+	Resource dbsqlc.LimitResource `validate:"required,oneof=WORKFLOW_RUN EVENT WORKER CRON SCHEDULE QUEUED_RUN"`
+
+	// Limit is the maximum value of the resource
+	Limit int32 `validate:"min=0"`
+
+	// (optional) Alarm is the value at which the tenant is alerted that it's approaching the limit
+	Alarm *int32 `validate:"omitempty,min=0"`
+
+	// (optional) Window is the duration after which the metered value is reset, which is ignored for
+	// resources which are counted, like workers and queued runs
+	Window *string `validate:"omitempty,duration"`
+}
+
 type TenantLimitRepository interface {
 	GetLimits(ctx context.Context, tenantId string) ([]*dbsqlc.TenantResourceLimit, error)
 
@@ -36,6 +65,10 @@ type TenantLimitRepository interface {
 	// UpsertTenantLimits updates or inserts new tenant limits
 	UpsertTenantLimits(ctx context.Context, tenantId string, plan *string) error
 
+	// UpdateLimit sets the limit of a single resource for a tenant, which is kept until the plan of the tenant
+	// changes
+	UpdateLimit(ctx context.Context, tenantId string, opts *UpdateTenantLimitOpts) (*dbsqlc.TenantResourceLimit, error)
+
 	// Resolve all tenant resource limits
 	ResolveAllTenantResourceLimits(ctx context.Context) error
 
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateTenantRoleOpts struct {
+	Name        string   `validate:"required,hatchetName"`
+	Description *string  `validate:"omitempty,max=255"`
+	Permissions []string `validate:"required,min=1,dive,required"`
+}
+
+// UpdateTenantRoleOpts only updates the fields which are set.
+type UpdateTenantRoleOpts struct {
+	Name        *string  `validate:"omitempty,hatchetName"`
+	Description *string  `validate:"omitempty,max=255"`
+	Permissions []string `validate:"omitempty,min=1,dive,required"`
+}
+
+// TenantRoleRepository stores the custom roles of tenants, which are named sets of permissions that can be
+// assigned to tenant members in place of the permissions of their built-in role.
+type TenantRoleRepository interface {
+	// ListTenantRoles returns the custom roles of the tenant, ordered by name
+	ListTenantRoles(ctx context.Context, tenantId string) ([]*dbsqlc.TenantRole, error)
+
+	// GetTenantRoleById returns the custom role with the given id
+	GetTenantRoleById(ctx context.Context, id string) (*dbsqlc.TenantRole, error)
+
+	// CreateTenantRole creates a custom role in the tenant
+	CreateTenantRole(ctx context.Context, tenantId string, opts *CreateTenantRoleOpts) (*dbsqlc.TenantRole, error)
+
+	// UpdateTenantRole updates a custom role, which changes the permissions of all members with the role
+	UpdateTenantRole(ctx context.Context, id string, opts *UpdateTenantRoleOpts) (*dbsqlc.TenantRole, error)
+
+	// DeleteTenantRole deletes a custom role. Members with the role get the permissions of their built-in
+	// role again.
+	DeleteTenantRole(ctx context.Context, id string) error
+
+	// GetTenantMemberCustomRole returns the custom role of the tenant member, or pgx.ErrNoRows if the
+	// member has no custom role
+	GetTenantMemberCustomRole(ctx context.Context, memberId string) (*dbsqlc.TenantRole, error)
+
+	// SetTenantMemberCustomRole assigns the custom role to the tenant member, or removes the custom role of
+	// the member if roleId is nil
+	SetTenantMemberCustomRole(ctx context.Context, memberId string, roleId *string) error
+}
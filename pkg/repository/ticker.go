@@ -41,10 +41,18 @@ type TickerEngineRepository interface {
 	// PollCronSchedules returns all cron schedules which should be managed by the ticker
 	PollCronSchedules(ctx context.Context, tickerId string) ([]*dbsqlc.PollCronSchedulesRow, error)
 
+	// UpdateCronLastFiredAt records when a cron schedule last started a workflow run
+	UpdateCronLastFiredAt(ctx context.Context, cronId string, firedAt time.Time) error
+
 	PollScheduledWorkflows(ctx context.Context, tickerId string) ([]*dbsqlc.PollScheduledWorkflowsRow, error)
 
 	PollTenantAlerts(ctx context.Context, tickerId string) ([]*dbsqlc.PollTenantAlertsRow, error)
 
+	// PollWorkerOfflineAlerts returns the workers which went offline since the last poll, for tenants with
+	// worker offline alerts enabled. Workers are offline once they haven't sent a heartbeat within the worker
+	// heartbeat timeout.
+	PollWorkerOfflineAlerts(ctx context.Context) ([]*dbsqlc.PollWorkerOfflineAlertsRow, error)
+
 	PollExpiringTokens(ctx context.Context) ([]*dbsqlc.PollExpiringTokensRow, error)
 
 	PollTenantResourceLimitAlerts(ctx context.Context) ([]*dbsqlc.TenantResourceLimitAlert, error)
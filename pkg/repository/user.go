@@ -1,12 +1,14 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
 type CreateUserOpts struct {
@@ -20,13 +22,15 @@ type CreateUserOpts struct {
 }
 
 type OAuthOpts struct {
-	Provider       string     `validate:"required,oneof=google github"`
+	Provider       string     `validate:"required"`
 	ProviderUserId string     `validate:"required,min=1"`
 	AccessToken    []byte     `validate:"required,min=1"`
 	RefreshToken   *[]byte    // optional
 	ExpiresAt      *time.Time // optional
 }
 
+// UpdateUserOpts only updates the fields which are set. A nil field leaves the stored value
+// untouched, while a pointer to an empty value overwrites it.
 type UpdateUserOpts struct {
 	EmailVerified *bool
 	Name          *string
@@ -36,6 +40,24 @@ type UpdateUserOpts struct {
 	OAuth    *OAuthOpts `validate:"omitempty,required_without=Password,excluded_with=Password"`
 }
 
+// OAuthTokenRefreshWindow is how long before their expiry the access tokens of OAuth providers are refreshed.
+const OAuthTokenRefreshWindow = 10 * time.Minute
+
+// OAuthTokenRefreshRetryInterval is how long to wait before retrying the refresh of an account whose last
+// refresh failed, for example because the user revoked access.
+const OAuthTokenRefreshRetryInterval = time.Hour
+
+// OAuthTokens are the encrypted tokens which are stored for the account of an OAuth provider.
+type OAuthTokens struct {
+	AccessToken  []byte
+	RefreshToken []byte
+	ExpiresAt    *time.Time
+}
+
+// RefreshOAuthTokenFunc exchanges the refresh token of the account with the given provider for new tokens.
+// An empty refresh token in the result keeps the stored refresh token.
+type RefreshOAuthTokenFunc func(ctx context.Context, provider string, tokens *OAuthTokens) (*OAuthTokens, error)
+
 type UserRepository interface {
 	RegisterCreateCallback(callback UnscopedCallback[*db.UserModel])
 
@@ -45,6 +67,9 @@ type UserRepository interface {
 	// GetUserByEmail returns the user with the given email
 	GetUserByEmail(email string) (*db.UserModel, error)
 
+	// GetUserByOAuth returns the user which is linked to the given account of an OAuth provider
+	GetUserByOAuth(provider, providerUserId string) (*db.UserModel, error)
+
 	// GetUserPassword returns the user password with the given id
 	GetUserPassword(id string) (*db.UserPasswordModel, error)
 
@@ -56,6 +81,17 @@ type UserRepository interface {
 
 	// ListTenantMemberships returns the list of tenant memberships for the given user
 	ListTenantMemberships(userId string) ([]db.TenantMemberModel, error)
+
+	// ListExpiringOAuthTokens returns the accounts of OAuth providers which have a refresh token and whose
+	// access token expires (or has expired) before the given time, soonest first. Accounts whose refresh
+	// failed within OAuthTokenRefreshRetryInterval are skipped.
+	ListExpiringOAuthTokens(ctx context.Context, expiresBefore time.Time, limit int) ([]*dbsqlc.UserOAuth, error)
+
+	// GetFreshOAuthToken returns the tokens of the user's account with the given provider. If the access
+	// token expires within OAuthTokenRefreshWindow, it's first renewed with refresh and the new tokens are
+	// stored. Concurrent calls for the same account wait for each other, so a refresh token is only used once.
+	// A failed refresh is recorded on the account, which defers it in ListExpiringOAuthTokens.
+	GetFreshOAuthToken(ctx context.Context, userId, provider string, refresh RefreshOAuthTokenFunc) (*OAuthTokens, error)
 }
 
 type SecurityCheckRepository interface {
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// The types of run lifecycle events which webhook subscriptions can subscribe to.
+const (
+	WebhookEventRunStarted   = "run.started"
+	WebhookEventRunSucceeded = "run.succeeded"
+	WebhookEventRunFailed    = "run.failed"
+	WebhookEventStepFailed   = "step.failed"
+	WebhookEventWorkerDown   = "worker.down"
+)
+
+// WebhookEventTypes are all event types which webhook subscriptions can subscribe to.
+var WebhookEventTypes = []string{
+	WebhookEventRunStarted,
+	WebhookEventRunSucceeded,
+	WebhookEventRunFailed,
+	WebhookEventStepFailed,
+	WebhookEventWorkerDown,
+}
+
+type CreateWebhookSubscriptionOpts struct {
+	// (required) the name of the subscription, unique in the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the url which events are delivered to
+	URL string `validate:"required,http_url"`
+
+	// (required) the encrypted secret which deliveries are signed with
+	Secret string `validate:"required"`
+
+	// (required) the event types which are delivered
+	EventTypes []string `validate:"required,min=1,dive,oneof=run.started run.succeeded run.failed step.failed worker.down"`
+
+	// (optional) whether events are delivered, defaults to true
+	Enabled *bool
+}
+
+type UpdateWebhookSubscriptionOpts struct {
+	// (optional) the url which events are delivered to
+	URL *string `validate:"omitnil,http_url"`
+
+	// (optional) the event types which are delivered
+	EventTypes []string `validate:"omitnil,min=1,dive,oneof=run.started run.succeeded run.failed step.failed worker.down"`
+
+	// (optional) whether events are delivered
+	Enabled *bool
+}
+
+type ListWebhookDeliveriesOpts struct {
+	// (optional) number of deliveries to skip
+	Offset *int
+
+	// (optional) number of deliveries to return
+	Limit *int `validate:"omitnil,min=1,max=1000"`
+
+	// (optional) the status to filter by
+	Status *dbsqlc.WebhookDeliveryStatus `validate:"omitnil,oneof=PENDING SUCCEEDED FAILED"`
+}
+
+type ListWebhookDeliveriesResult struct {
+	Rows  []*dbsqlc.WebhookDelivery
+	Count int
+}
+
+type CreateWebhookDeliveriesOpts struct {
+	// (required) the type of the event
+	EventType string `validate:"required,oneof=run.started run.succeeded run.failed step.failed worker.down"`
+
+	// (required) the key which identifies the event, an event is delivered at most once per subscription
+	DedupeKey string `validate:"required"`
+
+	// (required) the payload of the event, as JSON
+	Payload []byte `validate:"required"`
+}
+
+type UpdateWebhookDeliveryAttemptOpts struct {
+	// (required) the status of the delivery after the attempt
+	Status dbsqlc.WebhookDeliveryStatus `validate:"required,oneof=PENDING SUCCEEDED FAILED"`
+
+	// (optional) when the delivery is attempted next, if the attempt failed and will be retried
+	NextAttemptAt *time.Time
+
+	// (optional) the status code of the response to the attempt
+	ResponseStatusCode *int
+
+	// (optional) why the attempt failed
+	Error *string
+}
+
+// WebhookSubscriptionAPIRepository manages the webhook subscriptions of tenants, and lists the deliveries
+// of their events.
+type WebhookSubscriptionAPIRepository interface {
+	// CreateWebhookSubscription creates a subscription. Returns ErrDuplicateKey if the tenant already has a
+	// subscription with the same name.
+	CreateWebhookSubscription(ctx context.Context, tenantId string, opts *CreateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error)
+
+	GetWebhookSubscriptionById(ctx context.Context, id string) (*dbsqlc.WebhookSubscription, error)
+
+	// ListWebhookSubscriptions returns the subscriptions of the tenant, oldest first.
+	ListWebhookSubscriptions(ctx context.Context, tenantId string) ([]*dbsqlc.WebhookSubscription, error)
+
+	UpdateWebhookSubscription(ctx context.Context, id string, opts *UpdateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error)
+
+	// DeleteWebhookSubscription deletes the subscription and its deliveries.
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+
+	GetWebhookDeliveryById(ctx context.Context, id string) (*dbsqlc.WebhookDelivery, error)
+
+	// ListWebhookDeliveries returns the deliveries of the subscription, newest first.
+	ListWebhookDeliveries(ctx context.Context, subscriptionId string, opts *ListWebhookDeliveriesOpts) (*ListWebhookDeliveriesResult, error)
+
+	// ResendWebhookDelivery queues the delivery to be attempted again as soon as possible.
+	ResendWebhookDelivery(ctx context.Context, id string) (*dbsqlc.WebhookDelivery, error)
+}
+
+type WebhookSubscriptionEngineRepository interface {
+	// HasWebhookSubscriptions returns whether the tenant has an enabled subscription to the event type. The
+	// result is cached for a few seconds, so it can be called for every event.
+	HasWebhookSubscriptions(ctx context.Context, tenantId string, eventType string) (bool, error)
+
+	// CreateWebhookDeliveries queues deliveries of an event to the enabled subscriptions of the tenant to the
+	// event type, and returns the number of queued deliveries. Subscriptions which already have a delivery
+	// with the same dedupe key are skipped.
+	CreateWebhookDeliveries(ctx context.Context, tenantId string, opts *CreateWebhookDeliveriesOpts) (int, error)
+
+	// ClaimWebhookDeliveries returns pending deliveries which are due. Claimed deliveries are not returned
+	// again until the claim timeout has passed, unless they are updated before.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, claimTimeout time.Duration) ([]*dbsqlc.ClaimWebhookDeliveriesRow, error)
+
+	// UpdateWebhookDeliveryAttempt records an attempt to deliver the delivery.
+	UpdateWebhookDeliveryAttempt(ctx context.Context, id string, opts *UpdateWebhookDeliveryAttemptOpts) error
+
+	// DeleteExpiredWebhookDeliveries deletes a batch of deliveries created before the given time, and returns
+	// true if there are more to delete.
+	DeleteExpiredWebhookDeliveries(ctx context.Context, tenantId string, createdBefore time.Time) (bool, error)
+}
@@ -40,6 +40,20 @@ type CreateWorkerOpts struct {
 
 	// (optional) Runtime info for the worker
 	RuntimeInfo *RuntimeInfo `validate:"omitempty"`
+
+	// (optional) Groups of actions which share a part of the slots of the worker
+	SlotGroups []WorkerSlotGroupOpts `validate:"dive"`
+}
+
+type WorkerSlotGroupOpts struct {
+	// (required) The name of the group, unique for the worker
+	Name string `validate:"required"`
+
+	// (required) The maximum number of runs of the actions in the group this worker can run at a time
+	MaxRuns int `validate:"required,gte=1"`
+
+	// (required) The actions in the group
+	Actions []string `validate:"required,min=1,dive,actionId"`
 }
 
 type UpdateWorkerOpts struct {
@@ -52,8 +66,16 @@ type UpdateWorkerOpts struct {
 	// If the worker is active and accepting new runs
 	IsActive *bool
 
-	// A list of actions this worker can run
+	// If the worker is paused, in which case no new runs are assigned to it
+	IsPaused *bool
+
+	// A list of actions this worker can run. If set, it replaces the actions which were previously
+	// linked to the worker.
 	Actions []string `validate:"dive,actionId"`
+
+	// Groups of actions which share a part of the slots of the worker. If set, it replaces the slot
+	// groups of the worker, so an empty list removes them.
+	SlotGroups []WorkerSlotGroupOpts `validate:"dive"`
 }
 
 type WorkerWithStepCount struct {
@@ -120,6 +142,10 @@ type WorkerEngineRepository interface {
 
 	UpdateWorkerActiveStatus(ctx context.Context, tenantId, workerId string, isActive bool, timestamp time.Time) (*dbsqlc.Worker, error)
 
+	// DeactivateStaleWorkers marks the active workers of a tenant which haven't sent a heartbeat within the
+	// worker heartbeat timeout as inactive, and returns them.
+	DeactivateStaleWorkers(ctx context.Context, tenantId string) ([]*dbsqlc.Worker, error)
+
 	UpsertWorkerLabels(ctx context.Context, workerId pgtype.UUID, opts []UpsertWorkerLabelOpts) ([]*dbsqlc.WorkerLabel, error)
 
 	DeleteOldWorkers(ctx context.Context, tenantId string, lastHeartbeatBefore time.Time) (bool, error)
@@ -6,13 +6,23 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
+
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/digest"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 var ErrDagParentNotFound = errors.New("dag parent not found")
 
+// ErrDagInvalidJoin is returned when the join count of a step is not between 1 and its number of parents.
+var ErrDagInvalidJoin = errors.New("invalid dag join")
+
+// ErrInvalidVersionWeights is returned when the version weights of a workflow can't be set, for example
+// because a version doesn't belong to the workflow.
+var ErrInvalidVersionWeights = errors.New("invalid workflow version weights")
+
 type CreateWorkflowVersionOpts struct {
 	// (required) the workflow name
 	Name string `validate:"required,hatchetName"`
@@ -28,9 +38,19 @@ type CreateWorkflowVersionOpts struct {
 	// (optional) event triggers for the workflow
 	EventTriggers []string
 
+	// (optional) filter expressions for the event triggers, keyed by event key. An event only triggers the
+	// workflow if it matches the filter expression. Omitted from the checksum when empty, so that workflow
+	// versions without filters keep their checksum.
+	EventTriggerFilters map[string]string `json:"eventTriggerFilters,omitempty" validate:"omitempty,dive,celeventfilter"`
+
 	// (optional) cron triggers for the workflow
 	CronTriggers []string `validate:"dive,cron"`
 
+	// (optional) the catch-up policies for the cron triggers, keyed by cron trigger. A cron trigger with
+	// the FIRE_ONCE policy starts a single run when one or more of its runs were missed, for example while
+	// no ticker was running. Other cron triggers skip missed runs. Omitted from the checksum when empty.
+	CronCatchUpPolicies map[string]string `json:"cronCatchUpPolicies,omitempty" validate:"omitempty,dive,oneof=SKIP FIRE_ONCE"`
+
 	// (optional) the input bytes for the cron triggers
 	CronInput []byte
 
@@ -56,6 +76,9 @@ type CreateWorkflowVersionOpts struct {
 
 	// (optional) the default priority for steps in the workflow (1-3)
 	DefaultPriority *int32 `validate:"omitempty,min=1,max=3"`
+
+	// (optional) the default timeout of workflow runs, after which their step runs time out
+	RunTimeout *string `validate:"omitempty,duration"`
 }
 
 type CreateCronWorkflowTriggerOpts struct {
@@ -102,6 +125,27 @@ func (o *CreateWorkflowVersionOpts) Checksum() (string, error) {
 	return workflowChecksum.String(), nil
 }
 
+// WorkflowVersionForEvent is a workflow version which is triggered by an event.
+type WorkflowVersionForEvent struct {
+	*dbsqlc.GetWorkflowVersionForEngineRow
+
+	// (optional) the expression which the event must match to trigger the workflow version
+	FilterExpression *string
+}
+
+// WorkflowVersionDefinition contains the triggers and steps of a workflow version.
+type WorkflowVersionDefinition struct {
+	// EventTriggers are the event keys which trigger the workflow version.
+	EventTriggers []string
+
+	// CronTriggers are the crons of the workflow version's definition. Crons which were created through
+	// the API are not included.
+	CronTriggers []string
+
+	// Steps are the steps of the workflow version, including the steps of its on-failure job.
+	Steps []*dbsqlc.ListStepsForWorkflowVersionRow
+}
+
 type CreateWorkflowSchedulesOpts struct {
 	ScheduledTriggers []time.Time
 
@@ -169,6 +213,16 @@ type CreateWorkflowStepOpts struct {
 
 	// (optional) the step retry backoff max seconds (can't be greater than 86400)
 	RetryBackoffMaxSeconds *int `validate:"omitnil,min=1,max=86400"`
+
+	// (optional) the number of parents which must succeed before the step starts, all parents if not set
+	JoinCount *int `validate:"omitnil,min=1"`
+
+	// (optional) the delay before the first retry in milliseconds. If not set, the delay of each retry is
+	// RetryBackoffFactor^retryCount seconds.
+	RetryBackoffBaseMs *int `validate:"omitnil,min=1,max=86400000"`
+
+	// (optional) the fraction by which the delay of each retry is randomly shortened
+	RetryBackoffJitter *float64 `validate:"omitnil,min=0,max=1"`
 }
 
 type DesiredWorkerLabelOpts struct {
@@ -270,6 +324,80 @@ type UpdateWorkflowOpts struct {
 	IsPaused *bool
 }
 
+type WorkflowVersionWeightOpts struct {
+	// (required) the id of the workflow version
+	WorkflowVersionId string `validate:"required,uuid"`
+
+	// (required) the weight of the version. The version receives its weight divided by the sum of the
+	// weights of all versions of the new runs of the workflow.
+	Weight int32 `validate:"gte=0"`
+}
+
+type SetWorkflowVersionWeightsOpts struct {
+	// the weights of the versions which new runs are split between. Versions without a weight receive no
+	// new runs, and an empty list without a latest weight removes the weights, so that new runs use the
+	// latest version.
+	Weights []WorkflowVersionWeightOpts `validate:"dive"`
+
+	// (optional) the weight of the latest version of the workflow, whichever version that is. Unlike the
+	// weights of specific versions, it moves on to versions which are registered later, which allows
+	// canarying each new version against a pinned version.
+	LatestWeight int32 `validate:"gte=0"`
+}
+
+// WorkflowVersionWeights are the version weights of a workflow.
+type WorkflowVersionWeights struct {
+	// the weights of specific versions
+	Weights []*dbsqlc.WorkflowVersionWeight
+
+	// the weight of the latest version, 0 if not set
+	LatestWeight int32
+}
+
+// ForLatestVersion returns the weights of the workflow given the id of its latest version, with the latest
+// weight added to the weight of that version.
+func (w *WorkflowVersionWeights) ForLatestVersion(latestVersionId pgtype.UUID) []*dbsqlc.WorkflowVersionWeight {
+	if w.LatestWeight <= 0 {
+		return w.Weights
+	}
+
+	res := make([]*dbsqlc.WorkflowVersionWeight, 0, len(w.Weights)+1)
+	res = append(res, w.Weights...)
+
+	return append(res, &dbsqlc.WorkflowVersionWeight{
+		WorkflowVersionId: latestVersionId,
+		Weight:            w.LatestWeight,
+	})
+}
+
+// PickWorkflowVersion returns the id of the workflow version which a new run should use, given the version
+// weights of the workflow and a function which returns a random number in [0, n). It returns false if
+// none of the weights is positive.
+func PickWorkflowVersion(weights []*dbsqlc.WorkflowVersionWeight, randN func(n int64) int64) (string, bool) {
+	var total int64
+
+	for _, w := range weights {
+		total += int64(w.Weight)
+	}
+
+	if total <= 0 {
+		return "", false
+	}
+
+	n := randN(total)
+
+	for _, w := range weights {
+		if n < int64(w.Weight) {
+			return sqlchelpers.UUIDToStr(w.WorkflowVersionId), true
+		}
+
+		n -= int64(w.Weight)
+	}
+
+	// unreachable as long as randN returns a number in [0, n)
+	return sqlchelpers.UUIDToStr(weights[len(weights)-1].WorkflowVersionId), true
+}
+
 type WorkflowAPIRepository interface {
 	// ListWorkflows returns all workflows for a given tenant.
 	ListWorkflows(tenantId string, opts *ListWorkflowsOpts) (*ListWorkflowsResult, error)
@@ -311,6 +439,18 @@ type WorkflowAPIRepository interface {
 
 	// CreateScheduledWorkflow creates a scheduled workflow run
 	CreateScheduledWorkflow(ctx context.Context, tenantId string, opts *CreateScheduledWorkflowRunForWorkflowOpts) (*dbsqlc.ListScheduledWorkflowsRow, error)
+
+	// ListWorkflowVersionWeights returns the version weights of a workflow, which are empty if new runs use
+	// the latest version.
+	ListWorkflowVersionWeights(ctx context.Context, tenantId, workflowId string) (*WorkflowVersionWeights, error)
+
+	// SetWorkflowVersionWeights replaces the version weights of a workflow. It returns ErrInvalidVersionWeights
+	// if a version doesn't belong to the workflow, is listed twice, or if none of the weights is positive.
+	SetWorkflowVersionWeights(ctx context.Context, tenantId, workflowId string, opts *SetWorkflowVersionWeightsOpts) (*WorkflowVersionWeights, error)
+
+	// CountWorkflowRunsByVersion returns the number of runs of each version of a workflow which were created
+	// since the given time, by final status.
+	CountWorkflowRunsByVersion(ctx context.Context, tenantId, workflowId string, since time.Time) ([]*dbsqlc.CountWorkflowRunsByVersionRow, error)
 }
 
 type WorkflowEngineRepository interface {
@@ -332,17 +472,33 @@ type WorkflowEngineRepository interface {
 
 	GetLatestWorkflowVersions(ctx context.Context, tenantId string, workflowIds []string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error)
 
+	// RouteWorkflowVersion returns the version which a new run of a workflow should use, given its latest
+	// version. If version weights are configured for the workflow, a version is picked at random according
+	// to the weights, otherwise the latest version is returned.
+	RouteWorkflowVersion(ctx context.Context, tenantId string, latest *dbsqlc.GetWorkflowVersionForEngineRow) (*dbsqlc.GetWorkflowVersionForEngineRow, error)
+
 	// GetWorkflowByName returns a workflow by its name. It will return db.ErrNotFound if the workflow does not exist.
 	GetWorkflowByName(ctx context.Context, tenantId, workflowName string) (*dbsqlc.Workflow, error)
 
+	// UpdateWorkflow updates a workflow for a given tenant. When a paused workflow is resumed, its queued
+	// workflow runs are scheduled in the order in which they were triggered.
+	UpdateWorkflow(ctx context.Context, tenantId, workflowId string, opts *UpdateWorkflowOpts) (*dbsqlc.Workflow, error)
+
 	// GetWorkflowsByName returns all workflows by their name. It will return db.ErrNotFound if the workflow does not exist.
 	GetWorkflowsByNames(ctx context.Context, tenantId string, workflowNames []string) ([]*dbsqlc.Workflow, error)
 
 	// ListWorkflowsForEvent returns the latest workflow versions for a given tenant that are triggered by the
 	// given event.
-	ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error)
+	ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*WorkflowVersionForEvent, error)
 
 	// GetWorkflowVersionById returns a workflow version by its id. It will return db.ErrNotFound if the workflow
 	// version does not exist.
 	GetWorkflowVersionById(ctx context.Context, tenantId, workflowVersionId string) (*dbsqlc.GetWorkflowVersionForEngineRow, error)
+
+	// GetStepForWorkflowVersion returns the step of a workflow version by its readable id. Steps of on-failure
+	// jobs are not returned.
+	GetStepForWorkflowVersion(ctx context.Context, tenantId, workflowVersionId, stepReadableId string) (*dbsqlc.Step, error)
+
+	// GetWorkflowVersionDefinition returns the triggers and steps of a workflow version.
+	GetWorkflowVersionDefinition(ctx context.Context, tenantId, workflowVersionId string) (*WorkflowVersionDefinition, error)
 }
@@ -66,6 +66,42 @@ type CreateWorkflowRunOpts struct {
 
 	// (optional) the priority of the workflow run
 	Priority *int32 `validate:"omitempty,min=1,max=3"`
+
+	// (optional) whether a child workflow run keeps running when its parent step run is cancelled or
+	// times out
+	Detached bool
+
+	// (optional) the time after which the step runs of the workflow run time out
+	TimeoutAt *time.Time
+
+	// (optional) the time after which the workflow run is cancelled if it has not finished. Unlike
+	// TimeoutAt, an expired run is cancelled without running its on-failure job.
+	ExpiresAt *time.Time
+
+	// (optional) a key which identifies the request which triggered the workflow run. Each key is accepted
+	// at most once per tenant until IdempotencyKeyExpiresAt, and creating a workflow run with a key which
+	// was already accepted returns ErrIdempotencyKeyExists, also if the key was used for a different workflow.
+	IdempotencyKey *string `validate:"omitnil,min=1,max=255"`
+
+	// (optional) the time after which the idempotency key is accepted again
+	IdempotencyKeyExpiresAt *time.Time `validate:"required_with=IdempotencyKey"`
+
+	// (optional) the user or API token which triggered the workflow run
+	TriggeringActor *Actor `validate:"omitnil"`
+
+	// (optional) the step to run, if this is a partial workflow run
+	PartialRun *PartialWorkflowRunOpts `validate:"omitnil"`
+}
+
+// PartialWorkflowRunOpts are the options for a partial workflow run. A partial workflow run only
+// contains the step run of a single step, which runs with the given parent outputs instead of
+// waiting for its parents.
+type PartialWorkflowRunOpts struct {
+	// (required) the id of the step to run
+	StepId string `validate:"required,uuid"`
+
+	// (optional) the outputs of the step's parents, as a JSON object keyed by the readable id of the parent
+	ParentOutputs []byte
 }
 
 type CreateGroupKeyRunOpts struct {
@@ -101,10 +137,41 @@ func WithParent(
 	}
 }
 
+// PartialRunMetadataKey is the additional metadata key which marks partial workflow runs. Its value is
+// the readable id of the step which was run.
+const PartialRunMetadataKey = "hatchet__partial_step"
+
+// WithPartialRun makes the workflow run a partial run of the given step.
+func WithPartialRun(step *dbsqlc.Step, parentOutputs []byte) CreateWorkflowRunOpt {
+	return func(opts *CreateWorkflowRunOpts) {
+		opts.PartialRun = &PartialWorkflowRunOpts{
+			StepId:        sqlchelpers.UUIDToStr(step.ID),
+			ParentOutputs: parentOutputs,
+		}
+
+		if opts.AdditionalMetadata == nil {
+			opts.AdditionalMetadata = make(map[string]interface{})
+		}
+
+		opts.AdditionalMetadata[PartialRunMetadataKey] = step.ReadableId.String
+	}
+}
+
+// WithPriority sets the priority of the workflow run, overriding the default priority of the workflow. A nil
+// priority keeps the default.
+func WithPriority(priority *int32) CreateWorkflowRunOpt {
+	return func(opts *CreateWorkflowRunOpts) {
+		if priority != nil {
+			opts.Priority = priority
+		}
+	}
+}
+
 func GetCreateWorkflowRunOptsFromManual(
 	workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow,
 	input []byte,
 	additionalMetadata map[string]interface{},
+	fs ...CreateWorkflowRunOpt,
 ) (*CreateWorkflowRunOpts, error) {
 	if input == nil {
 		input = []byte("{}")
@@ -113,6 +180,8 @@ func GetCreateWorkflowRunOptsFromManual(
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:  sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
+		TimeoutAt:          getWorkflowRunTimeoutAt(workflowVersion),
+		Priority:           getWorkflowRunPriority(workflowVersion),
 		ManualTriggerInput: StringPtr(string(input)),
 		TriggeredBy:        string(datautils.TriggeredByManual),
 		InputData:          input,
@@ -125,6 +194,10 @@ func GetCreateWorkflowRunOptsFromManual(
 		}
 	}
 
+	for _, f := range fs {
+		f(opts)
+	}
+
 	return opts, nil
 }
 
@@ -144,6 +217,8 @@ func GetCreateWorkflowRunOptsFromParent(
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:  sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
+		TimeoutAt:          getWorkflowRunTimeoutAt(workflowVersion),
+		Priority:           getWorkflowRunPriority(workflowVersion),
 		ManualTriggerInput: StringPtr(string(input)),
 		TriggeredBy:        string(datautils.TriggeredByParent),
 		InputData:          input,
@@ -165,6 +240,7 @@ func GetCreateWorkflowRunOptsFromEvent(
 	workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow,
 	input []byte,
 	additionalMetadata map[string]interface{},
+	fs ...CreateWorkflowRunOpt,
 ) (*CreateWorkflowRunOpts, error) {
 	if input == nil {
 		input = []byte("{}")
@@ -173,6 +249,8 @@ func GetCreateWorkflowRunOptsFromEvent(
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:  sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
+		TimeoutAt:          getWorkflowRunTimeoutAt(workflowVersion),
+		Priority:           getWorkflowRunPriority(workflowVersion),
 		TriggeringEventId:  &eventId,
 		TriggeredBy:        string(datautils.TriggeredByEvent),
 		InputData:          input,
@@ -185,6 +263,10 @@ func GetCreateWorkflowRunOptsFromEvent(
 		}
 	}
 
+	for _, f := range fs {
+		f(opts)
+	}
+
 	return opts, nil
 }
 
@@ -203,6 +285,8 @@ func GetCreateWorkflowRunOptsFromCron(
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:  sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
+		TimeoutAt:          getWorkflowRunTimeoutAt(workflowVersion),
+		Priority:           getWorkflowRunPriority(workflowVersion),
 		Cron:               &cron,
 		CronParentId:       &cronParentId,
 		CronName:           cronName,
@@ -235,6 +319,8 @@ func GetCreateWorkflowRunOptsFromSchedule(
 		DisplayName:         StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:   sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
 		ScheduledWorkflowId: &scheduledWorkflowId,
+		TimeoutAt:           getWorkflowRunTimeoutAt(workflowVersion),
+		Priority:            getWorkflowRunPriority(workflowVersion),
 		TriggeredBy:         string(datautils.TriggeredBySchedule),
 		InputData:           input,
 		AdditionalMetadata:  additionalMetadata,
@@ -253,6 +339,36 @@ func GetCreateWorkflowRunOptsFromSchedule(
 	return opts, nil
 }
 
+// getWorkflowRunTimeoutAt returns the time after which a workflow run of the workflow version times out,
+// if the workflow version has a run timeout.
+func getWorkflowRunTimeoutAt(workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow) *time.Time {
+	if !workflowVersion.WorkflowVersion.RunTimeout.Valid {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(workflowVersion.WorkflowVersion.RunTimeout.String)
+
+	if err != nil || timeout <= 0 {
+		return nil
+	}
+
+	timeoutAt := time.Now().UTC().Add(timeout)
+
+	return &timeoutAt
+}
+
+// getWorkflowRunPriority returns the default priority of workflow runs of the workflow version, if the
+// workflow version has one.
+func getWorkflowRunPriority(workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow) *int32 {
+	if !workflowVersion.WorkflowVersion.DefaultPriority.Valid {
+		return nil
+	}
+
+	priority := workflowVersion.WorkflowVersion.DefaultPriority.Int32
+
+	return &priority
+}
+
 func getWorkflowRunDisplayName(workflowName string) string {
 	workflowSuffix, _ := random.Generate(6)
 
@@ -269,6 +385,9 @@ type ListWorkflowRunsOpts struct {
 	// (optional) a list of workflow run ids to filter by
 	Ids []string `validate:"omitempty,dive,uuid"`
 
+	// (optional) a list of workflow ids whose runs are excluded
+	ExcludedWorkflowIds []string `validate:"omitempty,dive,uuid"`
+
 	// (optional) the parent workflow run id
 	ParentId *string `validate:"omitempty,uuid"`
 
@@ -278,6 +397,9 @@ type ListWorkflowRunsOpts struct {
 	// (optional) the event id that triggered the workflow run
 	EventId *string `validate:"omitempty,uuid"`
 
+	// (optional) the key of the event that triggered the workflow run
+	EventKey *string
+
 	// (optional) the group key for the workflow run
 	GroupKey *string
 
@@ -313,6 +435,25 @@ type ListWorkflowRunsOpts struct {
 
 	// (optional) exact metadata to filter by
 	AdditionalMetadata map[string]interface{} `validate:"omitempty"`
+
+	// (optional) a list of workflow ids to filter by
+	WorkflowIds []string `validate:"omitempty,dive,uuid"`
+
+	// (optional) a list of keys of the events that triggered the workflow run
+	EventKeys []string
+
+	// (optional) a string which the error of the workflow run contains, ignoring case
+	Search *string
+
+	// (optional) the last workflow run of the previous page, only supported when ordering by createdAt
+	Cursor *WorkflowRunCursor
+}
+
+// WorkflowRunCursor identifies the position of a workflow run when listing workflow runs with keyset
+// pagination.
+type WorkflowRunCursor struct {
+	CreatedAt time.Time
+	Id        string `validate:"uuid"`
 }
 
 type WorkflowRunsMetricsOpts struct {
@@ -491,6 +632,27 @@ func (e ErrDedupeValueExists) Error() string {
 	return fmt.Sprintf("workflow run with dedupe value %s already exists", e.DedupeValue)
 }
 
+// ErrIdempotencyKeyExists is returned when a workflow run is created with an idempotency key which was
+// already claimed by a different workflow run.
+type ErrIdempotencyKeyExists struct {
+	Key string
+
+	// the id of the workflow run which claimed the key
+	WorkflowRunId string
+
+	// whether the workflow run which claimed the key belongs to a different workflow, in which case it is
+	// not the run of the original request
+	OtherWorkflow bool
+}
+
+func (e ErrIdempotencyKeyExists) Error() string {
+	if e.OtherWorkflow {
+		return fmt.Sprintf("idempotency key %s was already used for a different workflow", e.Key)
+	}
+
+	return fmt.Sprintf("workflow run with idempotency key %s already exists: %s", e.Key, e.WorkflowRunId)
+}
+
 type UpdateWorkflowRunFromGroupKeyEvalOpts struct {
 	GroupKey *string
 
@@ -516,6 +678,10 @@ type WorkflowRunEngineRepository interface {
 
 	GetScheduledChildWorkflowRun(ctx context.Context, parentId, parentStepRunId string, childIndex int, childkey *string) (*dbsqlc.WorkflowTriggerScheduledRef, error)
 
+	// ListChildWorkflowRunsToCancel returns the ids of the unfinished child workflow runs which were spawned
+	// by the given step run and are not detached.
+	ListChildWorkflowRunsToCancel(ctx context.Context, tenantId, parentStepRunId string) ([]string, error)
+
 	PopWorkflowRunsCancelInProgress(ctx context.Context, tenantId, workflowVersionId string, maxRuns int) (toCancel []*dbsqlc.WorkflowRun, toStart []*dbsqlc.WorkflowRun, err error)
 
 	PopWorkflowRunsCancelNewest(ctx context.Context, tenantId, workflowVersionId string, maxRuns int) (toCancel []*dbsqlc.WorkflowRun, toStart []*dbsqlc.WorkflowRun, err error)
@@ -546,6 +712,8 @@ type WorkflowRunEngineRepository interface {
 
 	QueueWorkflowRunJobs(ctx context.Context, tenant string, workflowRun string) ([]*dbsqlc.GetStepRunForEngineRow, error)
 
+	// ProcessUnpausedWorkflowRuns pops the queued workflow runs of workflows which were resumed, in the order
+	// in which they were triggered. While the tenant is paused, no workflow runs are returned.
 	ProcessUnpausedWorkflowRuns(ctx context.Context, tenantId string) ([]*dbsqlc.GetWorkflowRunRow, bool, error)
 
 	GetWorkflowRunAdditionalMeta(ctx context.Context, tenantId, workflowRunId string) (*dbsqlc.GetWorkflowRunAdditionalMetaRow, error)
@@ -554,7 +722,33 @@ type WorkflowRunEngineRepository interface {
 
 	ListActiveQueuedWorkflowVersions(ctx context.Context, tenantId string) ([]*dbsqlc.ListActiveQueuedWorkflowVersionsRow, error)
 
+	// ListConcurrencyKeys returns the concurrency keys of a workflow which have running or queued workflow
+	// runs, with the number of runs in each state.
+	ListConcurrencyKeys(ctx context.Context, tenantId, workflowId string) ([]*dbsqlc.ListConcurrencyKeysForWorkflowRow, error)
+
+	// ListConcurrencySlotHolders returns the running workflow runs of a workflow which hold concurrency
+	// slots, optionally filtered by the concurrency key.
+	ListConcurrencySlotHolders(ctx context.Context, tenantId, workflowId string, key *string) ([]*dbsqlc.ListConcurrencySlotHoldersRow, error)
+
+	// ListExpiredWorkflowRuns returns the workflow runs of a tenant whose expiry has passed and which aren't
+	// marked as expired yet, split into the runs which are still running and need to be cancelled and the
+	// runs which already finished.
+	ListExpiredWorkflowRuns(ctx context.Context, tenantId string, limit int) (running []string, finished []string, err error)
+
+	// MarkWorkflowRunsExpired marks the expiry of workflow runs as handled, once they finished or their
+	// cancellation was enqueued, so that they aren't listed by ListExpiredWorkflowRuns anymore.
+	MarkWorkflowRunsExpired(ctx context.Context, tenantId string, workflowRunIds []string) error
+
+	// DeleteExpiredIdempotencyKeys deletes a batch of idempotency keys whose window has passed, and returns
+	// whether there are more keys to delete.
+	DeleteExpiredIdempotencyKeys(ctx context.Context, tenantId string) (bool, error)
+
 	// DeleteExpiredWorkflowRuns deletes workflow runs that were created before the given time. It returns the number of deleted runs
 	// and the number of non-deleted runs that match the conditions.
 	SoftDeleteExpiredWorkflowRuns(ctx context.Context, tenantId string, statuses []dbsqlc.WorkflowRunStatus, before time.Time) (bool, error)
+
+	// PurgeDeletedWorkflowRuns permanently deletes a batch of workflow runs which were soft-deleted before the given
+	// time, along with their job runs, step runs and step run logs. It returns whether there are more workflow runs
+	// to purge.
+	PurgeDeletedWorkflowRuns(ctx context.Context, tenantId string, deletedBefore time.Time) (bool, error)
 }
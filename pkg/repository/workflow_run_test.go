@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestGetCreateWorkflowRunOptsRunTimeout(t *testing.T) {
+	workflowVersion := &dbsqlc.GetWorkflowVersionForEngineRow{
+		WorkflowVersion: dbsqlc.WorkflowVersion{
+			ID:         sqlchelpers.UUIDFromStr(uuid.New().String()),
+			RunTimeout: sqlchelpers.TextFromStr("10m"),
+		},
+		WorkflowName: "timeout",
+	}
+
+	before := time.Now().UTC()
+
+	opts, err := GetCreateWorkflowRunOptsFromManual(workflowVersion, nil, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, opts.TimeoutAt)
+	assert.WithinRange(t, *opts.TimeoutAt, before.Add(10*time.Minute), time.Now().UTC().Add(10*time.Minute))
+
+	workflowVersion.WorkflowVersion.RunTimeout = pgtype.Text{}
+
+	opts, err = GetCreateWorkflowRunOptsFromEvent(uuid.New().String(), workflowVersion, nil, nil)
+	require.NoError(t, err)
+
+	assert.Nil(t, opts.TimeoutAt, "workflow runs should not time out if the workflow has no run timeout")
+}
+
+func TestGetCreateWorkflowRunOptsPriority(t *testing.T) {
+	workflowVersion := &dbsqlc.GetWorkflowVersionForEngineRow{
+		WorkflowVersion: dbsqlc.WorkflowVersion{
+			ID:              sqlchelpers.UUIDFromStr(uuid.New().String()),
+			DefaultPriority: pgtype.Int4{Int32: 2, Valid: true},
+		},
+		WorkflowName: "priority",
+	}
+
+	opts, err := GetCreateWorkflowRunOptsFromEvent(uuid.New().String(), workflowVersion, nil, nil, WithPriority(nil))
+	require.NoError(t, err)
+
+	require.NotNil(t, opts.Priority)
+	assert.Equal(t, int32(2), *opts.Priority, "workflow runs should use the default priority of the workflow")
+
+	priority := int32(3)
+
+	opts, err = GetCreateWorkflowRunOptsFromManual(workflowVersion, nil, nil, WithPriority(&priority))
+	require.NoError(t, err)
+
+	require.NotNil(t, opts.Priority)
+	assert.Equal(t, int32(3), *opts.Priority, "the priority of the trigger should override the default priority")
+
+	workflowVersion.WorkflowVersion.DefaultPriority = pgtype.Int4{}
+
+	opts, err = GetCreateWorkflowRunOptsFromCron("* * * * *", uuid.New().String(), nil, workflowVersion, nil, nil)
+	require.NoError(t, err)
+
+	assert.Nil(t, opts.Priority, "workflow runs should have no priority if the workflow has no default priority")
+}
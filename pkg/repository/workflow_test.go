@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestPickWorkflowVersion(t *testing.T) {
+	a := uuid.New().String()
+	b := uuid.New().String()
+
+	weights := []*dbsqlc.WorkflowVersionWeight{
+		{WorkflowVersionId: sqlchelpers.UUIDFromStr(a), Weight: 90},
+		{WorkflowVersionId: sqlchelpers.UUIDFromStr(b), Weight: 10},
+	}
+
+	counts := map[string]int{}
+
+	// every number in [0, 100) is picked once, so the versions are picked in proportion to their weights
+	for i := int64(0); i < 100; i++ {
+		versionId, ok := PickWorkflowVersion(weights, func(n int64) int64 {
+			assert.Equal(t, int64(100), n)
+			return i
+		})
+
+		assert.True(t, ok)
+		counts[versionId]++
+	}
+
+	assert.Equal(t, map[string]int{a: 90, b: 10}, counts)
+}
+
+func TestPickWorkflowVersionNoWeights(t *testing.T) {
+	_, ok := PickWorkflowVersion(nil, func(n int64) int64 { return 0 })
+	assert.False(t, ok)
+
+	_, ok = PickWorkflowVersion([]*dbsqlc.WorkflowVersionWeight{
+		{WorkflowVersionId: sqlchelpers.UUIDFromStr(uuid.New().String()), Weight: 0},
+	}, func(n int64) int64 { return 0 })
+	assert.False(t, ok)
+}
+
+func TestWorkflowVersionWeightsForLatestVersion(t *testing.T) {
+	pinned := sqlchelpers.UUIDFromStr(uuid.New().String())
+	latest := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+	weights := &WorkflowVersionWeights{
+		Weights: []*dbsqlc.WorkflowVersionWeight{
+			{WorkflowVersionId: pinned, Weight: 95},
+		},
+		LatestWeight: 5,
+	}
+
+	routed := weights.ForLatestVersion(latest)
+
+	assert.Equal(t, []*dbsqlc.WorkflowVersionWeight{
+		{WorkflowVersionId: pinned, Weight: 95},
+		{WorkflowVersionId: latest, Weight: 5},
+	}, routed)
+
+	// the weights of the workflow aren't modified
+	assert.Len(t, weights.Weights, 1)
+
+	// while the pinned version is also the latest one, it receives all new runs
+	versionId, ok := PickWorkflowVersion(weights.ForLatestVersion(pinned), func(n int64) int64 { return 99 })
+	assert.True(t, ok)
+	assert.Equal(t, sqlchelpers.UUIDToStr(pinned), versionId)
+
+	weights.LatestWeight = 0
+	assert.Len(t, weights.ForLatestVersion(latest), 1)
+}
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/internal/telemetry/metrics"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -41,6 +43,10 @@ type Queuer struct {
 	unackedMu rwMutex
 	unacked   map[int64]struct{}
 
+	// seenAt is when each queue item was first read from the database, which the assignment latency
+	// is measured from. It's guarded by unackedMu.
+	seenAt map[int64]time.Time
+
 	unassigned   map[int64]*dbsqlc.QueueItem
 	unassignedMu mutex
 }
@@ -68,6 +74,7 @@ func newQueuer(conf *sharedConfig, tenantId pgtype.UUID, queueName string, s *Sc
 		queueMu:       newMu(conf.l),
 		unackedMu:     newRWMu(conf.l),
 		unacked:       make(map[int64]struct{}),
+		seenAt:        make(map[int64]time.Time),
 		unassigned:    make(map[int64]*dbsqlc.QueueItem),
 		unassignedMu:  newMu(conf.l),
 	}
@@ -267,6 +274,10 @@ func (q *Queuer) refillQueue(ctx context.Context) ([]*dbsqlc.QueueItem, error) {
 		curr = append(curr, qi)
 	}
 
+	// the unassigned items are kept in a map, so we restore the order of the queue, in which items with a
+	// higher priority are assigned first
+	sortQueueItems(curr)
+
 	// determine whether we need to replenish with the following cases:
 	// - we last replenished more than 1 second ago
 	// - if we are at less than 50% of the limit, we always attempt to replenish
@@ -291,6 +302,8 @@ func (q *Queuer) refillQueue(ctx context.Context) ([]*dbsqlc.QueueItem, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		q.pruneSeenAt(curr)
 	}
 
 	newCurr := make([]*dbsqlc.QueueItem, 0, len(curr))
@@ -302,13 +315,30 @@ func (q *Queuer) refillQueue(ctx context.Context) ([]*dbsqlc.QueueItem, error) {
 	}
 
 	// add all newCurr to unacked so we don't assign them again
+	now := time.Now()
+
 	for _, qi := range newCurr {
 		q.unacked[qi.ID] = struct{}{}
+
+		if _, ok := q.seenAt[qi.ID]; !ok {
+			q.seenAt[qi.ID] = now
+		}
 	}
 
 	return newCurr, nil
 }
 
+// sortQueueItems sorts queue items by descending priority, and then by the order in which they were queued.
+func sortQueueItems(qis []*dbsqlc.QueueItem) {
+	sort.SliceStable(qis, func(i, j int) bool {
+		if qis[i].Priority != qis[j].Priority {
+			return qis[i].Priority > qis[j].Priority
+		}
+
+		return qis[i].ID < qis[j].ID
+	})
+}
+
 type QueueResults struct {
 	TenantId pgtype.UUID
 	Assigned []*repository.AssignedItem
@@ -318,6 +348,40 @@ type QueueResults struct {
 	SchedulingTimedOut []string
 }
 
+// pruneSeenAt removes the items which are no longer in the queue, for example because their step runs
+// were cancelled, from seenAt.
+func (q *Queuer) pruneSeenAt(curr []*dbsqlc.QueueItem) {
+	inQueue := make(map[int64]struct{}, len(curr))
+
+	for _, qi := range curr {
+		inQueue[qi.ID] = struct{}{}
+	}
+
+	for id := range q.seenAt {
+		_, queued := inQueue[id]
+		_, unacked := q.unacked[id]
+
+		if !queued && !unacked {
+			delete(q.seenAt, id)
+		}
+	}
+}
+
+// observeAssignmentLatency records how long the assigned items waited since they were read from the
+// database.
+func (q *Queuer) observeAssignmentLatency(assigned []*repository.AssignedItem) {
+	q.unackedMu.RLock()
+	defer q.unackedMu.RUnlock()
+
+	tenantId := sqlchelpers.UUIDToStr(q.tenantId)
+
+	for _, assignedItem := range assigned {
+		if seenAt, ok := q.seenAt[assignedItem.QueueItem.ID]; ok {
+			metrics.StepRunAssignmentLatency.WithLabelValues(tenantId).Observe(time.Since(seenAt).Seconds())
+		}
+	}
+}
+
 func (q *Queuer) ack(r *assignResults) {
 	q.unackedMu.Lock()
 	defer q.unackedMu.Unlock()
@@ -328,6 +392,7 @@ func (q *Queuer) ack(r *assignResults) {
 	for _, assignedItem := range r.assigned {
 		delete(q.unacked, assignedItem.QueueItem.ID)
 		delete(q.unassigned, assignedItem.QueueItem.ID)
+		delete(q.seenAt, assignedItem.QueueItem.ID)
 	}
 
 	for _, unassignedItem := range r.unassigned {
@@ -338,6 +403,7 @@ func (q *Queuer) ack(r *assignResults) {
 	for _, schedulingTimedOutItem := range r.schedulingTimedOut {
 		delete(q.unacked, schedulingTimedOutItem.ID)
 		delete(q.unassigned, schedulingTimedOutItem.ID)
+		delete(q.seenAt, schedulingTimedOutItem.ID)
 	}
 
 	for _, rateLimitedItem := range r.rateLimited {
@@ -431,6 +497,8 @@ func (q *Queuer) flushToDatabase(ctx context.Context, r *assignResults) int {
 	q.s.nack(nackIds)
 	q.s.ack(ackIds)
 
+	q.observeAssignmentLatency(succeeded)
+
 	schedulingTimedOut := make([]string, 0, len(r.schedulingTimedOut))
 
 	for _, id := range r.schedulingTimedOut {
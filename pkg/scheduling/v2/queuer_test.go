@@ -0,0 +1,30 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestSortQueueItems(t *testing.T) {
+	qis := []*dbsqlc.QueueItem{
+		{ID: 4, Priority: 1},
+		{ID: 2, Priority: 1},
+		{ID: 5, Priority: 3},
+		{ID: 1, Priority: 2},
+		{ID: 3, Priority: 3},
+		{ID: 6, Priority: 4},
+	}
+
+	sortQueueItems(qis)
+
+	ids := make([]int64, 0, len(qis))
+
+	for _, qi := range qis {
+		ids = append(ids, qi.ID)
+	}
+
+	assert.Equal(t, []int64{6, 3, 5, 1, 2, 4}, ids, "queue items should be sorted by priority, then by id")
+}
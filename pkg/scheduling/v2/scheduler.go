@@ -263,6 +263,15 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	}
 
 	s.l.Debug().Msgf("loading available slots took %s", time.Since(checkpoint))
+	checkpoint = time.Now()
+
+	slotGroups, err := s.repo.ListSlotGroupsForWorkers(ctx, s.tenantId, workerUUIDs)
+
+	if err != nil {
+		return err
+	}
+
+	s.l.Debug().Msgf("loading slot groups took %s", time.Since(checkpoint))
 
 	// FUNCTION 3: list unacked slots (so they're not counted towards the worker slot count)
 	workersToUnackedSlots := make(map[string][]*slot)
@@ -278,6 +287,8 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 		workersToUnackedSlots[workerId] = append(workersToUnackedSlots[workerId], s)
 	}
 
+	workersToSlotGroupLimits := slotGroupLimits(slotGroups, workersToUnackedSlots)
+
 	// FUNCTION 4: write the new slots to the scheduler and clean up expired slots
 	actionsToNewSlots := make(map[string][]*slot)
 	actionsToTotalSlots := make(map[string]int)
@@ -304,8 +315,15 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 		slots = append(slots, unackedSlots...)
 
 		for _, actionId := range actions {
-			actionsToNewSlots[actionId] = append(actionsToNewSlots[actionId], slots...)
-			actionsToTotalSlots[actionId] += len(slots)
+			actionSlots := slots
+
+			// actions in a slot group only get the slots which are available to the group
+			if limit, ok := workersToSlotGroupLimits[workerId][actionId]; ok {
+				actionSlots = limitSlots(slots, limit)
+			}
+
+			actionsToNewSlots[actionId] = append(actionsToNewSlots[actionId], actionSlots...)
+			actionsToTotalSlots[actionId] += len(actionSlots)
 		}
 	}
 
@@ -612,6 +630,9 @@ func (s *Scheduler) tryAssignSingleton(
 	res.ackId = s.assignedCount
 	s.assignedCountMu.Unlock()
 
+	// the action is stored so unacked slots count towards the slot groups of the action
+	assignedSlot.setUsedBy(qi.ActionId.String)
+
 	s.unackedMu.Lock()
 	s.unackedSlots[res.ackId] = assignedSlot
 	s.unackedMu.Unlock()
@@ -21,6 +21,9 @@ type slot struct {
 	expiresAt *time.Time
 	used      bool
 
+	// usedBy is the action which last used the slot
+	usedBy string
+
 	ackd bool
 
 	additionalAcks  []func()
@@ -81,6 +84,20 @@ func (s *slot) use(additionalAcks []func(), additionalNacks []func()) bool {
 	return true
 }
 
+func (s *slot) setUsedBy(actionId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.usedBy = actionId
+}
+
+func (s *slot) getUsedBy() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.usedBy
+}
+
 func (s *slot) ack() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"slices"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// slotGroupLimits returns the number of slots which the actions in slot groups can use, keyed by worker id
+// and action id. Unacked slots which were used by an action in a group are not written to the database yet,
+// so they're subtracted from the available slots of the group. If an action is in multiple groups, the group
+// with the fewest available slots wins.
+func slotGroupLimits(slotGroups []*dbsqlc.ListSlotGroupsForWorkersRow, workersToUnackedSlots map[string][]*slot) map[string]map[string]int {
+	res := make(map[string]map[string]int)
+
+	for _, slotGroup := range slotGroups {
+		workerId := sqlchelpers.UUIDToStr(slotGroup.WorkerId)
+		available := int(slotGroup.AvailableSlots)
+
+		for _, unackedSlot := range workersToUnackedSlots[workerId] {
+			if slices.Contains(slotGroup.ActionIds, unackedSlot.getUsedBy()) {
+				available--
+			}
+		}
+
+		available = max(available, 0)
+
+		if _, ok := res[workerId]; !ok {
+			res[workerId] = make(map[string]int)
+		}
+
+		for _, actionId := range slotGroup.ActionIds {
+			if limit, ok := res[workerId][actionId]; !ok || available < limit {
+				res[workerId][actionId] = available
+			}
+		}
+	}
+
+	return res
+}
+
+// limitSlots returns the first limit slots of a worker. The new slots of a worker come before its unacked
+// slots, so the limited slots are the ones which can still be used.
+func limitSlots(slots []*slot, limit int) []*slot {
+	if limit >= len(slots) {
+		return slots
+	}
+
+	return slots[:limit]
+}
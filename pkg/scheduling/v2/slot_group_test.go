@@ -0,0 +1,71 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestSlotGroupLimits(t *testing.T) {
+	w := &worker{ListActiveWorkersResult: &repository.ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1)}}
+
+	usedSlot := func(actionId string) *slot {
+		s := newSlot(w, []string{actionId})
+		s.use(nil, nil)
+		s.setUsedBy(actionId)
+		return s
+	}
+
+	slotGroups := []*dbsqlc.ListSlotGroupsForWorkersRow{
+		{
+			WorkerId:       sqlchelpers.UUIDFromStr(stableWorkerId1),
+			Name:           "heavy",
+			ActionIds:      []string{"heavy:render", "shared:step"},
+			AvailableSlots: 3,
+		},
+		{
+			WorkerId:       sqlchelpers.UUIDFromStr(stableWorkerId1),
+			Name:           "other",
+			ActionIds:      []string{"shared:step"},
+			AvailableSlots: 1,
+		},
+		{
+			WorkerId:       sqlchelpers.UUIDFromStr(stableWorkerId2),
+			Name:           "heavy",
+			ActionIds:      []string{"heavy:render"},
+			AvailableSlots: -2,
+		},
+	}
+
+	workersToUnackedSlots := map[string][]*slot{
+		stableWorkerId1: {usedSlot("heavy:render"), usedSlot("light:step")},
+	}
+
+	limits := slotGroupLimits(slotGroups, workersToUnackedSlots)
+
+	assert.Equal(t, map[string]map[string]int{
+		stableWorkerId1: {
+			// the unacked slot of heavy:render counts towards the group
+			"heavy:render": 2,
+			// the action is limited by the group with the fewest available slots
+			"shared:step": 1,
+		},
+		stableWorkerId2: {
+			"heavy:render": 0,
+		},
+	}, limits)
+}
+
+func TestLimitSlots(t *testing.T) {
+	w := &worker{ListActiveWorkersResult: &repository.ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1)}}
+
+	slots := []*slot{newSlot(w, nil), newSlot(w, nil), newSlot(w, nil)}
+
+	assert.Len(t, limitSlots(slots, 0), 0)
+	assert.Equal(t, slots[:2], limitSlots(slots, 2))
+	assert.Equal(t, slots, limitSlots(slots, 5))
+}
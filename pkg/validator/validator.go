@@ -77,6 +77,12 @@ func newValidator() *validator.Validate {
 		return err == nil
 	})
 
+	_ = validate.RegisterValidation("celeventfilter", func(fl validator.FieldLevel) bool {
+		_, err := celParser.ParseEventFilter(fl.Field().String())
+
+		return err == nil
+	})
+
 	return validate
 }
 
@@ -84,6 +84,10 @@ func decodeFnReturnTypes(fnType reflect.Type) (result []reflect.Type, err error)
 		return nil, fmt.Errorf("fn cannot have more than 2 return values")
 	}
 
+	if fnType.NumOut() == 0 {
+		return nil, fmt.Errorf("fn must return an error")
+	}
+
 	firstOut := fnType.Out(0)
 
 	// if there are two args, the first one should be a pointer to a struct
@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CancelReason is the reason a step context was cancelled, which is returned by CancellationReason.
+type CancelReason string
+
+const (
+	// CancelReasonNone is returned for contexts which have not been cancelled.
+	CancelReasonNone CancelReason = ""
+
+	// CancelReasonUnknown is returned for contexts which were cancelled for a reason which is not known
+	// to the worker, for example when user code cancelled a context it derived from the step context,
+	// or when the engine sent a reason which this version of the SDK does not recognize.
+	CancelReasonUnknown CancelReason = "unknown"
+
+	// CancelReasonShutdown is set when the worker is stopped while the step is running, either because
+	// the context passed to Run was cancelled or because the cleanup function returned by Start was
+	// called. The step run is not reported as cancelled to the engine, so it is retried or reassigned
+	// once the worker is marked as inactive.
+	CancelReasonShutdown CancelReason = "shutdown"
+
+	// CancelReasonTimeout is set when the step run exceeded its timeout or could not be scheduled
+	// before its schedule timeout. It is also returned for contexts whose deadline was exceeded.
+	CancelReasonTimeout CancelReason = "timeout"
+
+	// CancelReasonCancelled is set when the step run, its workflow run or its job run was cancelled
+	// through the API, the dashboard or the admin client.
+	CancelReasonCancelled CancelReason = "cancelled"
+
+	// CancelReasonParentCancelled is set when the step run belongs to a child workflow whose parent
+	// workflow run was cancelled.
+	CancelReasonParentCancelled CancelReason = "parent-cancelled"
+
+	// CancelReasonConcurrencyLimit is set when the workflow run was cancelled because it exceeded the
+	// limit of its concurrency group, with the CANCEL_NEWEST limit strategy.
+	CancelReasonConcurrencyLimit CancelReason = "concurrency-limit"
+
+	// CancelReasonSuperseded is set when the workflow run was cancelled to make room for a newer run of
+	// the same concurrency group, with the CANCEL_IN_PROGRESS limit strategy.
+	CancelReasonSuperseded CancelReason = "superseded"
+
+	// CancelReasonExpired is set when the workflow run was cancelled because it did not finish before the
+	// expiry set with client.WithRunExpiry.
+	CancelReasonExpired CancelReason = "expired"
+)
+
+// cancellationError is the cause of step contexts which were cancelled by the worker.
+type cancellationError struct {
+	reason CancelReason
+
+	// engineReason is the reason sent by the engine, if any
+	engineReason string
+}
+
+func (e *cancellationError) Error() string {
+	if e.engineReason != "" {
+		return fmt.Sprintf("step run cancelled: %s (%s)", e.reason, e.engineReason)
+	}
+
+	return fmt.Sprintf("step run cancelled: %s", e.reason)
+}
+
+// Is reports context.Canceled as a match, so that callers which check the cause of a context with
+// errors.Is(err, context.Canceled) keep working.
+func (e *cancellationError) Is(target error) bool {
+	return target == context.Canceled
+}
+
+// cancelReasonFromEngine maps the cancelled reason of a step run in the engine to a CancelReason.
+func cancelReasonFromEngine(reason *string) CancelReason {
+	if reason == nil {
+		return CancelReasonUnknown
+	}
+
+	switch *reason {
+	case "TIMED_OUT", "SCHEDULING_TIMED_OUT":
+		return CancelReasonTimeout
+	case "CANCELLED_BY_USER", "JOB_RUN_CANCELLED":
+		return CancelReasonCancelled
+	case "PARENT_CANCELLED":
+		return CancelReasonParentCancelled
+	case "CANCELLED_BY_CONCURRENCY_LIMIT":
+		return CancelReasonConcurrencyLimit
+	case "SUPERSEDED":
+		return CancelReasonSuperseded
+	case "EXPIRED":
+		return CancelReasonExpired
+	default:
+		return CancelReasonUnknown
+	}
+}
+
+// CancellationReason returns the reason a step context, or a context derived from it, was cancelled. It
+// returns CancelReasonNone if the context has not been cancelled. The reason is set before the Done
+// channel of the context is closed, so it can be read as soon as Done fires:
+//
+//	select {
+//	case <-ctx.Done():
+//		if worker.CancellationReason(ctx) == worker.CancelReasonShutdown {
+//			// checkpoint the progress of the step
+//		}
+//	}
+func CancellationReason(ctx context.Context) CancelReason {
+	if ctx.Err() == nil {
+		return CancelReasonNone
+	}
+
+	cause := context.Cause(ctx)
+
+	var cancelErr *cancellationError
+
+	if errors.As(cause, &cancelErr) {
+		return cancelErr.reason
+	}
+
+	if errors.Is(cause, context.DeadlineExceeded) {
+		return CancelReasonTimeout
+	}
+
+	return CancelReasonUnknown
+}
@@ -0,0 +1,169 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestCancellationReasonFromEngine(t *testing.T) {
+	for engineReason, want := range map[string]CancelReason{
+		"TIMED_OUT":                      CancelReasonTimeout,
+		"SCHEDULING_TIMED_OUT":           CancelReasonTimeout,
+		"CANCELLED_BY_USER":              CancelReasonCancelled,
+		"JOB_RUN_CANCELLED":              CancelReasonCancelled,
+		"PARENT_CANCELLED":               CancelReasonParentCancelled,
+		"CANCELLED_BY_CONCURRENCY_LIMIT": CancelReasonConcurrencyLimit,
+		"SUPERSEDED":                     CancelReasonSuperseded,
+		"EXPIRED":                        CancelReasonExpired,
+		"SOMETHING_ELSE":                 CancelReasonUnknown,
+	} {
+		t.Run(engineReason, func(t *testing.T) {
+			l := zerolog.Nop()
+
+			w := &Worker{
+				l: &l,
+			}
+
+			runContext, cancel := context.WithCancelCause(context.Background())
+			w.cancelMap.Store("step-run-id", cancel)
+
+			hCtx, err := newHatchetContext(runContext, &client.Action{
+				StepRunId:     "step-run-id",
+				ActionPayload: []byte(`{"input":{}}`),
+			}, &fakeClient{}, &l, w)
+			require.NoError(t, err)
+
+			// contexts derived by user code carry the reason as well
+			derived, cancelDerived := context.WithTimeout(hCtx, time.Minute)
+			defer cancelDerived()
+
+			assert.Equal(t, CancelReasonNone, CancellationReason(hCtx))
+
+			err = w.cancelStepRun(context.Background(), &client.Action{
+				StepRunId:       "step-run-id",
+				ActionType:      client.ActionTypeCancelStepRun,
+				CancelledReason: &engineReason,
+			})
+			require.NoError(t, err)
+
+			<-derived.Done()
+
+			assert.Equal(t, want, CancellationReason(hCtx))
+			assert.Equal(t, want, CancellationReason(derived))
+			assert.ErrorIs(t, context.Cause(derived), context.Canceled)
+		})
+	}
+}
+
+func TestCancellationReasonShutdown(t *testing.T) {
+	w := &Worker{}
+
+	stepCtx, cancelStep := context.WithCancelCause(context.Background())
+	w.cancelMap.Store("step-run-id", cancelStep)
+
+	groupKeyCtx, cancelGroupKey := context.WithCancelCause(context.Background())
+	w.cancelConcurrencyMap.Store("workflow-run-id", cancelGroupKey)
+
+	w.cancelInFlightRuns(CancelReasonShutdown)
+
+	assert.Equal(t, CancelReasonShutdown, CancellationReason(stepCtx))
+	assert.Equal(t, CancelReasonShutdown, CancellationReason(groupKeyCtx))
+}
+
+func TestCancellationReasonOtherContexts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, CancelReasonUnknown, CancellationReason(ctx))
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	assert.Equal(t, CancelReasonTimeout, CancellationReason(ctx))
+}
+
+func TestCancelledStepRunIsAcknowledged(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		cancel    func(w *Worker) error
+		wantEvent bool
+	}{
+		{
+			name: "cancelled by the engine",
+			cancel: func(w *Worker) error {
+				reason := "CANCELLED_BY_USER"
+
+				return w.cancelStepRun(context.Background(), &client.Action{
+					StepRunId:       "step-run-id",
+					ActionType:      client.ActionTypeCancelStepRun,
+					CancelledReason: &reason,
+				})
+			},
+			wantEvent: true,
+		},
+		{
+			name: "worker shutdown",
+			cancel: func(w *Worker) error {
+				w.cancelInFlightRuns(CancelReasonShutdown)
+				return nil
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dispatcher := &fakeDispatcherClient{}
+
+			w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+			require.NoError(t, err)
+
+			started := make(chan struct{})
+
+			err = w.NewService("reports").RegisterWorkflow(&WorkflowJob{
+				Name: "report",
+				On:   NoTrigger(),
+				Steps: []*WorkflowStep{
+					Fn(func(ctx HatchetContext) (*stepOneOutput, error) {
+						close(started)
+						<-ctx.Done()
+
+						return nil, ctx.Err()
+					}).SetName("generate"),
+				},
+			})
+			require.NoError(t, err)
+
+			done := make(chan error)
+
+			go func() {
+				done <- w.startStepRun(context.Background(), &client.Action{
+					ActionId:      "reports:generate",
+					StepRunId:     "step-run-id",
+					ActionPayload: []byte(`{"input":{}}`),
+					ActionType:    client.ActionTypeStartStepRun,
+				})
+			}()
+
+			<-started
+			require.NoError(t, tt.cancel(w))
+			require.NoError(t, <-done)
+
+			eventTypes := []client.ActionEventType{}
+
+			for _, event := range dispatcher.events {
+				eventTypes = append(eventTypes, event.EventType)
+			}
+
+			if tt.wantEvent {
+				assert.Equal(t, []client.ActionEventType{client.ActionEventTypeStarted, client.ActionEventTypeCancelled}, eventTypes)
+			} else {
+				assert.Equal(t, []client.ActionEventType{client.ActionEventTypeStarted}, eventTypes)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+// compensationStepPrefix is the prefix of the names of the steps which run the compensations of a job.
+const compensationStepPrefix = "compensate-"
+
+// CompensationResult is the output of the step which runs the compensation of a step.
+type CompensationResult struct {
+	// Step is the name of the step which was compensated.
+	Step string `json:"step"`
+
+	// Skipped is true if the step did not complete, so there was nothing to compensate.
+	Skipped bool `json:"skipped,omitempty"`
+
+	// DeadLettered is true if the compensation failed on its last attempt. The error is recorded in the
+	// result and logged to the step run, and the remaining compensations still run.
+	DeadLettered bool `json:"deadLettered,omitempty"`
+
+	// Error is the error of the last attempt of a dead-lettered compensation.
+	Error string `json:"error,omitempty"`
+}
+
+// compensation is the compensation function of a step, which has the signature
+// func(ctx HatchetContext) error or func(ctx HatchetContext, output *T) error, where T is the output
+// type of the step.
+type compensation struct {
+	fn reflect.Value
+
+	// outputType is the type of the output argument, if any
+	outputType reflect.Type
+}
+
+func newCompensation(fn any) (*compensation, error) {
+	fnType := reflect.TypeOf(fn)
+
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		return nil, fmt.Errorf("compensation must be a function")
+	}
+
+	contextType := reflect.TypeOf((*HatchetContext)(nil)).Elem()
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+
+	if fnType.NumIn() < 1 || fnType.NumIn() > 2 || fnType.In(0) != contextType {
+		return nil, fmt.Errorf("compensation must take a worker.HatchetContext and an optional step output")
+	}
+
+	if fnType.NumOut() != 1 || fnType.Out(0) != errorType {
+		return nil, fmt.Errorf("compensation must return an error")
+	}
+
+	c := &compensation{
+		fn: reflect.ValueOf(fn),
+	}
+
+	if fnType.NumIn() == 2 {
+		c.outputType = fnType.In(1)
+	}
+
+	return c, nil
+}
+
+// call calls the compensation function with the given output of the step, which is decoded into the
+// output argument of the function.
+func (c *compensation) call(ctx HatchetContext, output []byte) error {
+	args := []reflect.Value{reflect.ValueOf(ctx)}
+
+	if c.outputType != nil {
+		var target reflect.Value
+
+		if c.outputType.Kind() == reflect.Pointer {
+			target = reflect.New(c.outputType.Elem())
+		} else {
+			target = reflect.New(c.outputType)
+		}
+
+		if len(output) > 0 {
+			if err := json.Unmarshal(output, target.Interface()); err != nil {
+				return fmt.Errorf("could not decode step output: %w", err)
+			}
+		}
+
+		if c.outputType.Kind() != reflect.Pointer {
+			target = target.Elem()
+		}
+
+		args = append(args, target)
+	}
+
+	if err, ok := c.fn.Call(args)[0].Interface().(error); ok && err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// compensationStep returns the step which runs the compensation of the given step of the job. The
+// compensation is called with the output of the step if the step succeeded, and skipped otherwise. When
+// the step fails on its last attempt, whether the compensation returned an error or panicked or the
+// output of the step could not be read, the failure is dead-lettered: it is recorded in the output of the
+// step instead of failing it, so that the remaining compensations still run.
+func compensationStep(step *WorkflowStep, stepId string, parents []string) *WorkflowStep {
+	c, err := newCompensation(step.Compensation)
+
+	if err != nil {
+		panic(fmt.Errorf("invalid compensation of step %s: %w", stepId, err))
+	}
+
+	retries := step.CompensationRetries
+
+	return &WorkflowStep{
+		Name:    compensationStepPrefix + stepId,
+		Parents: parents,
+		Retries: retries,
+		Function: func(ctx HatchetContext) (*CompensationResult, error) {
+			return runCompensation(ctx, stepId, retries, func() (bool, error) {
+				output, succeeded, err := succeededStepOutput(ctx, stepId)
+
+				if err != nil {
+					return false, err
+				}
+
+				if !succeeded {
+					return true, nil
+				}
+
+				return false, c.call(ctx, output)
+			})
+		},
+	}
+}
+
+// runCompensation runs the compensate function of the given step, which returns true if the compensation
+// was skipped. Errors and panics fail the step until its last attempt, and are dead-lettered after that.
+func runCompensation(ctx HatchetContext, stepId string, retries int, compensate func() (bool, error)) (*CompensationResult, error) {
+	res := &CompensationResult{
+		Step: stepId,
+	}
+
+	skipped, err := callCompensation(compensate)
+
+	if err == nil {
+		res.Skipped = skipped
+		return res, nil
+	}
+
+	if ctx.RetryCount() < retries {
+		return nil, err
+	}
+
+	ctx.Log(fmt.Sprintf("compensation of step %s failed on its last attempt and was dead-lettered: %s", stepId, err.Error()))
+
+	res.DeadLettered = true
+	res.Error = err.Error()
+
+	return res, nil
+}
+
+// callCompensation calls the compensate function, and returns a panic of the function as an error.
+func callCompensation(compensate func() (bool, error)) (skipped bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("compensation panicked: %v", r)
+		}
+	}()
+
+	return compensate()
+}
+
+// succeededStepOutput returns the output of the latest succeeded run of the step in the workflow run of
+// the context, and false if the step has not succeeded.
+func succeededStepOutput(ctx HatchetContext, stepId string) ([]byte, bool, error) {
+	c := ctx.client()
+
+	tenantId, err := uuid.Parse(c.TenantId())
+
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid tenant id: %w", err)
+	}
+
+	workflowRunId, err := uuid.Parse(ctx.WorkflowRunId())
+
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid workflow run id: %w", err)
+	}
+
+	res, err := c.API().WorkflowRunGetWithResponse(ctx, tenantId, workflowRunId)
+
+	if err != nil {
+		return nil, false, fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	if res.JSON200 == nil {
+		return nil, false, fmt.Errorf("could not get workflow run: unexpected status %d", res.StatusCode())
+	}
+
+	var latest *rest.StepRun
+
+	if res.JSON200.JobRuns != nil {
+		for _, jobRun := range *res.JSON200.JobRuns {
+			if jobRun.StepRuns == nil {
+				continue
+			}
+
+			for i := range *jobRun.StepRuns {
+				stepRun := &(*jobRun.StepRuns)[i]
+
+				if stepRun.Status != rest.StepRunStatusSUCCEEDED || stepRun.Step == nil || stepRun.Step.ReadableId != stepId {
+					continue
+				}
+
+				if latest == nil || (stepRun.FinishedAt != nil && (latest.FinishedAt == nil || stepRun.FinishedAt.After(*latest.FinishedAt))) {
+					latest = stepRun
+				}
+			}
+		}
+	}
+
+	if latest == nil {
+		return nil, false, nil
+	}
+
+	if latest.Output == nil {
+		return nil, true, nil
+	}
+
+	output, err := client.RehydratePayload(ctx, c.PayloadStore(), []byte(*latest.Output))
+
+	if err != nil {
+		return nil, false, fmt.Errorf("could not rehydrate output: %w", err)
+	}
+
+	return output, true, nil
+}
+
+// compensationOrder returns the indexes of the steps in an order in which every step comes after its
+// parents, keeping the order in which the steps are defined where possible. Compensations run in the
+// reverse of this order, so a step is always compensated before its parents.
+func compensationOrder(steps []*WorkflowStep) []int {
+	ids := make(map[string]int, len(steps))
+
+	for i, step := range steps {
+		ids[step.GetStepId(i)] = i
+	}
+
+	visited := make([]bool, len(steps))
+	order := make([]int, 0, len(steps))
+
+	var visit func(i int)
+
+	visit = func(i int) {
+		if visited[i] {
+			return
+		}
+
+		visited[i] = true
+
+		for _, parent := range steps[i].Parents {
+			if p, ok := ids[parent]; ok {
+				visit(p)
+			}
+		}
+
+		order = append(order, i)
+	}
+
+	for i := range steps {
+		visit(i)
+	}
+
+	return order
+}
+
+// onFailureJob returns the job which runs when a run of the workflow fails. The compensations of the
+// steps run one after another, in the reverse order of the steps. The steps of the OnFailure job are kept
+// as they are, with their ids and parents, so they run independently of the compensations. It returns
+// nil if the job has no OnFailure job and no compensations.
+func (j *WorkflowJob) onFailureJob() *WorkflowJob {
+	var compensations []*WorkflowStep
+
+	order := compensationOrder(j.Steps)
+
+	for k := len(order) - 1; k >= 0; k-- {
+		i := order[k]
+		step := j.Steps[i]
+
+		if step.Compensation == nil {
+			continue
+		}
+
+		parents := []string{}
+
+		if len(compensations) > 0 {
+			parents = append(parents, compensations[len(compensations)-1].Name)
+		}
+
+		compensations = append(compensations, compensationStep(step, step.GetStepId(i), parents))
+	}
+
+	if len(compensations) == 0 {
+		return j.OnFailure
+	}
+
+	res := &WorkflowJob{
+		Name: j.Name + "-on-failure",
+	}
+
+	if j.OnFailure != nil {
+		onFailure := *j.OnFailure
+		res = &onFailure
+	}
+
+	var steps []*WorkflowStep
+
+	// the compensations come after the steps of the OnFailure job, so that steps without a name keep the
+	// id which is derived from their index
+	if j.OnFailure != nil {
+		steps = append(steps, j.OnFailure.Steps...)
+	}
+
+	res.Steps = append(steps, compensations...)
+
+	return res
+}
@@ -0,0 +1,196 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type compensationTestContext struct {
+	*testHatchetContext
+
+	retryCount int
+	logs       []string
+}
+
+func (c *compensationTestContext) RetryCount() int {
+	return c.retryCount
+}
+
+func (c *compensationTestContext) Log(message string, fields ...any) {
+	c.logs = append(c.logs, message)
+}
+
+type reservation struct {
+	Id string `json:"id"`
+}
+
+func TestOnFailureJobCompensations(t *testing.T) {
+	noop := func(ctx HatchetContext) error { return nil }
+
+	job := &WorkflowJob{
+		Name: "checkout",
+		Steps: []*WorkflowStep{
+			// the charge is defined before the reservation it depends on
+			Fn(func(ctx HatchetContext) error { return nil }).SetName("charge").AddParents("reserve").SetCompensation(noop).SetCompensationRetries(3),
+			Fn(func(ctx HatchetContext) error { return nil }).SetName("reserve").SetCompensation(noop),
+			Fn(func(ctx HatchetContext) error { return nil }).SetName("notify").AddParents("charge"),
+		},
+		OnFailure: &WorkflowJob{
+			Steps: []*WorkflowStep{
+				Fn(func(ctx HatchetContext) error { return nil }).SetName("alert"),
+				Fn(func(ctx HatchetContext) error { return nil }).SetName("cleanup").AddParents("alert"),
+				// a step without a name keeps the id derived from its function and index
+				Fn(func(ctx HatchetContext) error { return nil }),
+			},
+		},
+	}
+
+	onFailure := job.onFailureJob()
+	require.NotNil(t, onFailure)
+	require.Len(t, onFailure.Steps, 5)
+
+	// the steps of the OnFailure job are kept as they are, and run independently of the compensations
+	for i, step := range job.OnFailure.Steps {
+		assert.Same(t, step, onFailure.Steps[i])
+	}
+
+	assert.Equal(t, job.OnFailure.Steps[2].GetStepId(2), onFailure.Steps[2].GetStepId(2))
+	assert.Empty(t, onFailure.Steps[0].Parents)
+
+	assert.Equal(t, "compensate-charge", onFailure.Steps[3].Name)
+	assert.Empty(t, onFailure.Steps[3].Parents)
+	assert.Equal(t, 3, onFailure.Steps[3].Retries)
+
+	assert.Equal(t, "compensate-reserve", onFailure.Steps[4].Name)
+	assert.Equal(t, []string{"compensate-charge"}, onFailure.Steps[4].Parents)
+
+	workflow := job.ToWorkflow("svc", "")
+	require.NotNil(t, workflow.OnFailureJob)
+	assert.Len(t, workflow.OnFailureJob.Steps, 5)
+
+	actions := job.ToActionMap("svc")
+	assert.Contains(t, actions, "svc:compensate-charge")
+	assert.Contains(t, actions, "svc:compensate-reserve")
+	assert.Contains(t, actions, "svc:alert")
+}
+
+func TestOnFailureJobWithoutCompensations(t *testing.T) {
+	job := &WorkflowJob{
+		Name: "no-compensations",
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) error { return nil }).SetName("step-one"),
+		},
+	}
+
+	assert.Nil(t, job.onFailureJob())
+
+	job.OnFailure = &WorkflowJob{
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) error { return nil }).SetName("alert"),
+		},
+	}
+
+	assert.Same(t, job.OnFailure, job.onFailureJob())
+}
+
+func TestInvalidCompensation(t *testing.T) {
+	_, err := newCompensation(func(ctx context.Context) error { return nil })
+	assert.Error(t, err)
+
+	_, err = newCompensation(func(ctx HatchetContext) {})
+	assert.Error(t, err)
+
+	_, err = newCompensation("not a function")
+	assert.Error(t, err)
+}
+
+func TestRunCompensation(t *testing.T) {
+	var compensated *reservation
+
+	c, err := newCompensation(func(ctx HatchetContext, output *reservation) error {
+		compensated = output
+		return nil
+	})
+	require.NoError(t, err)
+
+	ctx := &compensationTestContext{testHatchetContext: &testHatchetContext{context.Background()}}
+
+	res, err := runCompensation(ctx, "reserve", 0, func() (bool, error) {
+		return false, c.call(ctx, []byte(`{"id":"reservation-1"}`))
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &CompensationResult{Step: "reserve"}, res)
+	assert.Equal(t, &reservation{Id: "reservation-1"}, compensated)
+
+	// steps which did not succeed are skipped
+	res, err = runCompensation(ctx, "reserve", 0, func() (bool, error) {
+		return true, nil
+	})
+	require.NoError(t, err)
+
+	assert.True(t, res.Skipped)
+}
+
+func TestRunCompensationDeadLetter(t *testing.T) {
+	c, err := newCompensation(func(ctx HatchetContext) error {
+		return errors.New("payment provider unavailable")
+	})
+	require.NoError(t, err)
+
+	ctx := &compensationTestContext{testHatchetContext: &testHatchetContext{context.Background()}}
+
+	compensate := func() (bool, error) {
+		return false, c.call(ctx, nil)
+	}
+
+	// attempts before the last one fail the step, so that it is retried
+	_, err = runCompensation(ctx, "charge", 2, compensate)
+	assert.EqualError(t, err, "payment provider unavailable")
+	assert.Empty(t, ctx.logs)
+
+	ctx.retryCount = 2
+
+	res, err := runCompensation(ctx, "charge", 2, compensate)
+	require.NoError(t, err)
+
+	assert.Equal(t, &CompensationResult{
+		Step:         "charge",
+		DeadLettered: true,
+		Error:        "payment provider unavailable",
+	}, res)
+	assert.Len(t, ctx.logs, 1)
+}
+
+func TestRunCompensationDeadLettersPanicsAndOutputErrors(t *testing.T) {
+	ctx := &compensationTestContext{testHatchetContext: &testHatchetContext{context.Background()}}
+
+	// panics are retried like errors
+	_, err := runCompensation(ctx, "charge", 1, func() (bool, error) {
+		panic("nil map")
+	})
+	assert.EqualError(t, err, "compensation panicked: nil map")
+
+	ctx.retryCount = 1
+
+	res, err := runCompensation(ctx, "charge", 1, func() (bool, error) {
+		panic("nil map")
+	})
+	require.NoError(t, err)
+
+	assert.True(t, res.DeadLettered)
+	assert.Equal(t, "compensation panicked: nil map", res.Error)
+
+	// errors reading the output of the step are dead-lettered as well
+	res, err = runCompensation(ctx, "charge", 1, func() (bool, error) {
+		return false, errors.New("could not get workflow run: unexpected status 503")
+	})
+	require.NoError(t, err)
+
+	assert.True(t, res.DeadLettered)
+	assert.Len(t, ctx.logs, 2)
+}
@@ -3,15 +3,20 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/pkg/client"
 )
 
+// ErrSlotNotReleased is returned by HatchetContext.ReleaseSlot on workers which limit their in-flight runs
+// with WithMaxInFlightRuns, since the engine would assign another run in place of the released one.
+var ErrSlotNotReleased = errors.New("slot can't be released while the worker limits its in-flight runs")
+
 type HatchetWorkerContext interface {
 	context.Context
 
@@ -26,6 +31,10 @@ type HatchetWorkerContext interface {
 	UpsertLabels(labels map[string]interface{}) error
 }
 
+// HatchetContext is the context of a step run. Its Done channel is closed when the step run is cancelled,
+// which includes cancellations through the API, timeouts and the worker stopping; CancellationReason
+// returns why. The step function keeps running until it returns, and once it does, the worker lets the
+// engine know that the cancelled step run stopped.
 type HatchetContext interface {
 	context.Context
 
@@ -37,6 +46,10 @@ type HatchetContext interface {
 
 	StepOutput(step string, target interface{}) error
 
+	// ParentOutputRaw returns the output of the given parent step as the raw JSON bytes sent in the
+	// action payload, without decoding and re-encoding it.
+	ParentOutputRaw(parent string) ([]byte, error)
+
 	TriggeredByEvent() bool
 
 	WorkflowInput(target interface{}) error
@@ -51,20 +64,63 @@ type HatchetContext interface {
 
 	WorkflowRunId() string
 
-	Log(message string)
+	// Log sends a log line to the engine, which stores it with the step run so that it's shown in the
+	// dashboard. The fields are key-value pairs or slog.Attr values, like the arguments of slog.Info,
+	// and are stored as the metadata of the log line. A slog.Level in place of a key sets the level of
+	// the log line, which is INFO by default.
+	Log(message string, fields ...any)
 
 	StreamEvent(message []byte)
 
+	// StreamOutput sends a chunk of the step's output, such as the tokens of an LLM response, to the
+	// subscribers of the workflow run, see client.SubscribeClient.Stream. Chunks are buffered and sent
+	// in the order in which they were streamed, and StreamOutput blocks while the buffer is full. The
+	// chunks are sent before the step run completes, and the return value of the step is still its
+	// output. After the step returned, ErrOutputStreamClosed is returned.
+	StreamOutput(chunk []byte) error
+
+	// StepRunErrors returns the errors of the failed step runs of the workflow run, keyed by the
+	// readable id of the step. It is meant to be called from an OnFailure job. Errors which are
+	// registered with RegisterError or RegisterErrorType are reconstructed, so they can be matched
+	// with errors.Is and errors.As.
+	StepRunErrors() (map[string]error, error)
+
 	SpawnWorkflow(workflowName string, input any, opts *SpawnWorkflowOpts) (*client.Workflow, error)
 
 	SpawnWorkflows(childWorkflows []*SpawnWorkflowsOpts) ([]*client.Workflow, error)
 
+	// ReleaseSlot releases the slot of the step run on the worker, so that the engine can assign another
+	// run to the worker while this one is waiting. The step run keeps running on the worker, so its
+	// timeout still applies and cancellations are still sent to it. If the worker limits its in-flight
+	// runs with WithMaxInFlightRuns, the slot is kept and ReleaseSlot returns ErrSlotNotReleased.
 	ReleaseSlot() error
 
+	// RefreshTimeout extends the timeout of the step run by the given duration, for example "10s", and
+	// moves the deadline of the context back by the same duration.
 	RefreshTimeout(incrementTimeoutBy string) error
 
 	RetryCount() int
 
+	// RetryBudget returns the number of remaining retries which would start before the workflow run times
+	// out. If the workflow run has no timeout, this is the number of remaining retries. The timeout of the
+	// step is not taken into account, since each retry gets a fresh step timeout.
+	RetryBudget() int
+
+	// Now returns the current time on the first execution of the step run, and the same time when the step
+	// run is retried or replayed. Each call returns the value recorded for the same call of the first
+	// execution, so calls must happen in a deterministic order.
+	Now() (time.Time, error)
+
+	// NewUUID returns a random UUID on the first execution of the step run, and the same UUID when the
+	// step run is retried or replayed. Like Now, calls must happen in a deterministic order.
+	NewUUID() (string, error)
+
+	// SleepFor suspends the step run for the given duration without holding a slot on the worker. If it
+	// returns an error, the step must return it. The step run is then started again from the beginning
+	// once the duration has passed, possibly on another worker, and the same call returns nil. Like Now,
+	// calls must happen in a deterministic order.
+	SleepFor(d time.Duration) error
+
 	client() client.Client
 
 	action() *client.Action
@@ -103,15 +159,29 @@ type hatchetContext struct {
 
 	w *hatchetWorkerContext
 
-	a        *client.Action
-	stepData *StepRunData
-	c        client.Client
-	l        *zerolog.Logger
+	a         *client.Action
+	stepData  *StepRunData
+	parents   map[string]json.RawMessage
+	parentsMu sync.Mutex
+	c         client.Client
+	l         *zerolog.Logger
 
 	i          int
 	indexMu    sync.Mutex
 	listener   *client.WorkflowRunsListener
 	listenerMu sync.Mutex
+
+	recorded   *recordedValues
+	recordedMu sync.Mutex
+
+	outputStream     *outputStream
+	outputStreamOnce sync.Once
+
+	// the info passed to middleware registered with UseWithInfo, nil for get group key runs
+	info *StepRunInfo
+
+	// the deadline derived from the timeout of the step, nil if the step has no timeout
+	deadline *stepDeadline
 }
 
 type hatchetWorkerContext struct {
@@ -139,6 +209,10 @@ func newHatchetContext(
 		},
 	}
 
+	if err := rehydrateInput(ctx, client, action); err != nil {
+		return nil, fmt.Errorf("could not rehydrate input: %w", err)
+	}
+
 	if action.GetGroupKeyRunId != "" {
 		err := c.populateStepDataForGroupKeyRun()
 
@@ -153,9 +227,28 @@ func newHatchetContext(
 		}
 	}
 
+	// store the step run context so that it can be retrieved from derived contexts
+	c.SetContext(ctx)
+
 	return c, nil
 }
 
+type stepRunContextKey struct{}
+
+// GetStepRunContext returns the HatchetContext of the step run which ctx belongs to, with the step
+// data already populated. It works with any context derived from the HatchetContext, such as the
+// context returned by GetContext, so that middleware and libraries which only receive a
+// context.Context can read the step name, workflow run and retry count of the step run.
+func GetStepRunContext(ctx context.Context) (HatchetContext, bool) {
+	if h, ok := ctx.(HatchetContext); ok {
+		return h, true
+	}
+
+	h, ok := ctx.Value(stepRunContextKey{}).(HatchetContext)
+
+	return h, ok
+}
+
 func (h *hatchetContext) client() client.Client {
 	return h.c
 }
@@ -169,6 +262,11 @@ func (h *hatchetContext) Worker() HatchetWorkerContext {
 }
 
 func (h *hatchetContext) SetContext(ctx context.Context) {
+	// keep the step run context retrievable if the new context is not derived from it
+	if _, ok := ctx.Value(stepRunContextKey{}).(HatchetContext); !ok {
+		ctx = context.WithValue(ctx, stepRunContextKey{}, h)
+	}
+
 	h.Context = ctx
 }
 
@@ -177,6 +275,16 @@ func (h *hatchetContext) GetContext() context.Context {
 }
 
 func (h *hatchetContext) StepOutput(step string, target interface{}) error {
+	if h.isOffloadedParentOutput(step) {
+		output, err := h.ParentOutputRaw(step)
+
+		if err != nil {
+			return err
+		}
+
+		return json.Unmarshal(output, target)
+	}
+
 	if val, ok := h.stepData.Parents[step]; ok {
 		return toTarget(val, target)
 	}
@@ -184,6 +292,41 @@ func (h *hatchetContext) StepOutput(step string, target interface{}) error {
 	return fmt.Errorf("step %s not found in action payload", step)
 }
 
+func (h *hatchetContext) ParentOutputRaw(parent string) ([]byte, error) {
+	h.parentsMu.Lock()
+	defer h.parentsMu.Unlock()
+
+	val, ok := h.parents[parent]
+
+	if !ok {
+		return nil, fmt.Errorf("step %s not found in action payload", parent)
+	}
+
+	if !client.IsPayloadRef(val) {
+		return val, nil
+	}
+
+	// offloaded outputs are fetched from the payload store the first time they are read
+	output, err := client.RehydratePayload(h, h.c.PayloadStore(), val)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not rehydrate output of step %s: %w", parent, err)
+	}
+
+	h.parents[parent] = output
+
+	return output, nil
+}
+
+func (h *hatchetContext) isOffloadedParentOutput(parent string) bool {
+	h.parentsMu.Lock()
+	defer h.parentsMu.Unlock()
+
+	val, ok := h.parents[parent]
+
+	return ok && client.IsPayloadRef(val)
+}
+
 func (h *hatchetContext) TriggeredByEvent() bool {
 	return h.stepData.TriggeredBy == TriggeredByEvent
 }
@@ -212,8 +355,18 @@ func (h *hatchetContext) WorkflowRunId() string {
 	return h.a.WorkflowRunId
 }
 
-func (h *hatchetContext) Log(message string) {
-	err := h.c.Event().PutLog(h, h.a.StepRunId, message)
+func (h *hatchetContext) Log(message string, fields ...any) {
+	level, metadata := parseLogFields(fields)
+
+	opts := []client.PutLogOpFunc{
+		client.WithLogLineLevel(level),
+	}
+
+	if metadata != nil {
+		opts = append(opts, client.WithLogLineMetadata(metadata))
+	}
+
+	err := h.c.Event().PutLog(h, h.a.StepRunId, message, opts...)
 
 	if err != nil {
 		h.l.Err(err).Msg("could not put log")
@@ -221,6 +374,11 @@ func (h *hatchetContext) Log(message string) {
 }
 
 func (h *hatchetContext) ReleaseSlot() error {
+	// the engine would assign another run in place of this one, which would exceed the limit
+	if h.w.worker.maxInFlightRuns > 0 {
+		return ErrSlotNotReleased
+	}
+
 	err := h.c.Dispatcher().ReleaseSlot(h, h.a.StepRunId)
 
 	if err != nil {
@@ -237,6 +395,12 @@ func (h *hatchetContext) RefreshTimeout(incrementTimeoutBy string) error {
 		return fmt.Errorf("failed to refresh timeout: %w", err)
 	}
 
+	if h.deadline != nil {
+		if increment, err := time.ParseDuration(incrementTimeoutBy); err == nil {
+			h.deadline.extend(increment)
+		}
+	}
+
 	return nil
 }
 
@@ -252,6 +416,16 @@ func (h *hatchetContext) RetryCount() int {
 	return int(h.a.RetryCount)
 }
 
+func (h *hatchetContext) RetryBudget() int {
+	policy, ok := h.w.worker.getRetryPolicy(h.a.ActionId)
+
+	if !ok {
+		return 0
+	}
+
+	return policy.retriesWithinBudget(h.a.WorkflowRunTimeoutAt, h.RetryCount(), time.Now())
+}
+
 func (h *hatchetContext) index() int {
 	return h.i
 }
@@ -266,6 +440,60 @@ type SpawnWorkflowOpts struct {
 	Key                *string
 	Sticky             *bool
 	AdditionalMetadata *map[string]string
+
+	// Detached child workflows keep running when the parent step run is cancelled or times out. By
+	// default, child workflows are cancelled along with their parent.
+	Detached bool
+
+	// Timeout is the time after which the step runs of the child workflow time out. The child never runs
+	// past the timeout of the parent workflow run, and it is still cancelled with the parent step run
+	// unless it is detached.
+	Timeout *time.Duration
+
+	// Priority overrides the priority of the child workflow's steps, from 1 (lowest) to 3 (highest).
+	Priority *int32
+}
+
+// SpawnOpt sets an option for spawning a child workflow.
+type SpawnOpt func(*SpawnWorkflowOpts)
+
+// SpawnWithTimeout sets the time after which the step runs of the child workflow time out.
+func SpawnWithTimeout(d time.Duration) SpawnOpt {
+	return func(opts *SpawnWorkflowOpts) {
+		opts.Timeout = &d
+	}
+}
+
+// SpawnWithPriority overrides the priority of the child workflow's steps.
+func SpawnWithPriority(p int32) SpawnOpt {
+	return func(opts *SpawnWorkflowOpts) {
+		opts.Priority = &p
+	}
+}
+
+// NewSpawnOpts returns spawn options with the given options applied.
+func NewSpawnOpts(fs ...SpawnOpt) *SpawnWorkflowOpts {
+	opts := &SpawnWorkflowOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	return opts
+}
+
+// SpawnDetached returns a copy of opts for a child workflow which keeps running when the parent step run
+// is cancelled or times out. opts may be nil.
+func SpawnDetached(opts *SpawnWorkflowOpts) *SpawnWorkflowOpts {
+	res := &SpawnWorkflowOpts{}
+
+	if opts != nil {
+		*res = *opts
+	}
+
+	res.Detached = true
+
+	return res
 }
 
 func (h *hatchetContext) saveOrLoadListener() (*client.WorkflowRunsListener, error) {
@@ -295,7 +523,7 @@ func (h *hatchetContext) SpawnWorkflow(workflowName string, input any, opts *Spa
 	var desiredWorker *string
 
 	if opts.Sticky != nil {
-		if _, exists := h.w.worker.registered_workflows[workflowName]; !exists {
+		if !h.w.worker.isWorkflowRegistered(workflowName) {
 			return nil, fmt.Errorf("cannot run with sticky: workflow %s is not registered on this worker", workflowName)
 		}
 
@@ -308,8 +536,12 @@ func (h *hatchetContext) SpawnWorkflow(workflowName string, input any, opts *Spa
 		return nil, err
 	}
 
-	if ns := h.client().Namespace(); ns != "" && !strings.HasPrefix(workflowName, ns) {
-		workflowName = fmt.Sprintf("%s%s", ns, workflowName)
+	workflowName = h.w.worker.namespaced(workflowName)
+
+	input, err = h.w.worker.redactInput(workflowName, input)
+
+	if err != nil {
+		return nil, err
 	}
 
 	workflowRunId, err := h.client().Admin().RunChildWorkflow(
@@ -321,7 +553,10 @@ func (h *hatchetContext) SpawnWorkflow(workflowName string, input any, opts *Spa
 			ChildIndex:         h.index(),
 			ChildKey:           opts.Key,
 			DesiredWorkerId:    desiredWorker,
-			AdditionalMetadata: opts.AdditionalMetadata,
+			AdditionalMetadata: withTraceContext(h, opts.AdditionalMetadata),
+			Detached:           opts.Detached,
+			Timeout:            opts.Timeout,
+			Priority:           opts.Priority,
 		},
 	)
 
@@ -341,6 +576,9 @@ type SpawnWorkflowsOpts struct {
 	Key                *string
 	Sticky             *bool
 	AdditionalMetadata *map[string]string
+	Detached           bool
+	Timeout            *time.Duration
+	Priority           *int32
 }
 
 func (h *hatchetContext) SpawnWorkflows(childWorkflows []*SpawnWorkflowsOpts) ([]*client.Workflow, error) {
@@ -353,7 +591,7 @@ func (h *hatchetContext) SpawnWorkflows(childWorkflows []*SpawnWorkflowsOpts) ([
 		var desiredWorker *string
 
 		if c.Sticky != nil {
-			if _, exists := h.w.worker.registered_workflows[c.WorkflowName]; !exists {
+			if !h.w.worker.isWorkflowRegistered(c.WorkflowName) {
 				return nil, fmt.Errorf("cannot run with sticky: workflow %s is not registered on this worker", c.WorkflowName)
 			}
 
@@ -363,10 +601,12 @@ func (h *hatchetContext) SpawnWorkflows(childWorkflows []*SpawnWorkflowsOpts) ([
 		if err != nil {
 			return nil, err
 		}
-		workflowName := c.WorkflowName
+		workflowName := h.w.worker.namespaced(c.WorkflowName)
+
+		input, err := h.w.worker.redactInput(workflowName, c.Input)
 
-		if ns := h.client().Namespace(); ns != "" && !strings.HasPrefix(c.WorkflowName, ns) {
-			workflowName = fmt.Sprintf("%s%s", ns, workflowName)
+		if err != nil {
+			return nil, err
 		}
 
 		// increment the index
@@ -374,14 +614,17 @@ func (h *hatchetContext) SpawnWorkflows(childWorkflows []*SpawnWorkflowsOpts) ([
 
 		triggerWorkflows[i] = &client.RunChildWorkflowsOpts{
 			WorkflowName: workflowName,
-			Input:        c.Input,
+			Input:        input,
 			Opts: &client.ChildWorkflowOpts{
 				ParentId:           h.WorkflowRunId(),
 				ParentStepRunId:    h.StepRunId(),
 				ChildIndex:         h.index(),
 				ChildKey:           c.Key,
 				DesiredWorkerId:    desiredWorker,
-				AdditionalMetadata: c.AdditionalMetadata,
+				AdditionalMetadata: withTraceContext(h, c.AdditionalMetadata),
+				Detached:           c.Detached,
+				Timeout:            c.Timeout,
+				Priority:           c.Priority,
 			},
 		}
 	}
@@ -454,6 +697,19 @@ func (h *hatchetContext) populateStepData() error {
 		return err
 	}
 
+	rawData := struct {
+		Parents   map[string]json.RawMessage `json:"parents"`
+		Overrides map[string]json.RawMessage `json:"overrides"`
+	}{}
+
+	err = json.Unmarshal(jsonBytes, &rawData)
+
+	if err != nil {
+		return err
+	}
+
+	h.parents = rawData.Parents
+	h.recorded = newRecordedValues(rawData.Overrides)
 	h.stepData.AdditionalMetadata = h.a.AdditionalMetadata
 
 	return nil
@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestParentOutputRaw(t *testing.T) {
+	h := &hatchetContext{
+		a: &client.Action{
+			ActionPayload: []byte(`{"input":{},"parents":{"step-one":{"id":12345678901234567890,"b":"x","a":1.50}}}`),
+		},
+	}
+
+	assert.NoError(t, h.populateStepData())
+
+	raw, err := h.ParentOutputRaw("step-one")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":12345678901234567890,"b":"x","a":1.50}`, string(raw))
+
+	_, err = h.ParentOutputRaw("step-two")
+	assert.Error(t, err)
+}
+
+func TestNewSpawnOpts(t *testing.T) {
+	opts := SpawnDetached(NewSpawnOpts(SpawnWithTimeout(time.Minute), SpawnWithPriority(3)))
+
+	assert.True(t, opts.Detached)
+	assert.Equal(t, time.Minute, *opts.Timeout)
+	assert.Equal(t, int32(3), *opts.Priority)
+}
+
+func TestReleaseSlotWithMaxInFlightRuns(t *testing.T) {
+	l := zerolog.Nop()
+
+	h := &hatchetContext{
+		Context: context.Background(),
+		a:       &client.Action{StepRunId: "step-run"},
+		c:       &fakeClient{},
+		l:       &l,
+		w: &hatchetWorkerContext{
+			Context: context.Background(),
+			worker:  &Worker{maxInFlightRuns: 5},
+		},
+	}
+
+	assert.ErrorIs(t, h.ReleaseSlot(), ErrSlotNotReleased)
+}
+
+func TestSpawnDetached(t *testing.T) {
+	admin := &fakeAdminClient{}
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{admin: admin, dispatcher: dispatcher}))
+	require.NoError(t, err)
+
+	svc := w.NewService("deploys")
+
+	err = svc.RegisterWorkflow(&WorkflowJob{
+		Name: "deploy",
+		On:   NoTrigger(),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) error {
+				if _, err := ctx.SpawnWorkflow("build", nil, nil); err != nil {
+					return err
+				}
+
+				if _, err := ctx.SpawnWorkflow("notify", nil, SpawnDetached(nil)); err != nil {
+					return err
+				}
+
+				_, err := ctx.SpawnWorkflows([]*SpawnWorkflowsOpts{
+					{WorkflowName: "audit", Detached: true},
+					{WorkflowName: "test"},
+				})
+
+				return err
+			}).SetName("rollout"),
+		},
+	})
+	require.NoError(t, err)
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "deploys:rollout",
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(`{"input":{}}`),
+		ActionType:    client.ActionTypeStartStepRun,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, dispatcher.events, 2)
+	require.Equal(t, client.ActionEventTypeCompleted, dispatcher.events[1].EventType)
+
+	// children are cancelled with the parent step run unless they are spawned detached
+	require.Len(t, admin.childOpts, 4)
+	assert.False(t, admin.childOpts["build"].Detached)
+	assert.True(t, admin.childOpts["notify"].Detached)
+	assert.True(t, admin.childOpts["audit"].Detached)
+	assert.False(t, admin.childOpts["test"].Detached)
+}
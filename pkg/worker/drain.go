@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+// WithGracefulShutdownTimeout makes the worker drain when it is stopped, instead of cancelling its runs
+// right away. The worker stops accepting new step runs and waits up to the given duration for the runs in
+// progress to finish. Runs which are still in progress after the timeout are cancelled with
+// CancelReasonShutdown and released back to the queue, so that they are reassigned to other workers
+// instead of waiting for the engine to notice that the worker stopped sending heartbeats.
+//
+// The cleanup function returned by Start blocks until the worker has drained.
+func WithGracefulShutdownTimeout(d time.Duration) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		if d > 0 {
+			opts.gracefulShutdownTimeout = d
+		}
+	}
+}
+
+// drainPollInterval is how often a draining worker checks whether its runs have finished.
+const drainPollInterval = 100 * time.Millisecond
+
+// drain stops the engine from assigning new step runs to the worker and waits for the runs in progress
+// to finish. Runs which did not finish before the graceful shutdown timeout, and runs which were assigned
+// while the worker was draining, are released back to the queue. stopListening is called once the worker
+// no longer needs to receive actions or send heartbeats.
+func (w *Worker) drain(stopListening context.CancelFunc) {
+	defer stopListening()
+
+	w.draining.Store(true)
+
+	workerId := w.workerId()
+
+	w.l.Info().Msgf("worker %s is draining, waiting up to %s for %d runs", w.name, w.gracefulShutdownTimeout, w.InFlightRuns())
+
+	if err := w.client.Dispatcher().DrainWorker(context.Background(), workerId, false); err != nil {
+		w.l.Error().Err(err).Msgf("could not drain worker %s", w.name)
+	}
+
+	finished := w.waitForInFlightRuns(w.gracefulShutdownTimeout)
+
+	if finished && !w.skippedRuns.Load() {
+		return
+	}
+
+	if !finished {
+		w.l.Warn().Msgf("worker %s did not drain within %s, releasing %d runs", w.name, w.gracefulShutdownTimeout, w.InFlightRuns())
+
+		w.cancelInFlightRuns(CancelReasonShutdown)
+	}
+
+	// the worker must stop sending heartbeats before its runs are released, or they would not be
+	// reassigned
+	stopListening()
+
+	if err := w.client.Dispatcher().DrainWorker(context.Background(), workerId, true); err != nil {
+		w.l.Error().Err(err).Msgf("could not release the runs of worker %s", w.name)
+	}
+}
+
+// waitForInFlightRuns waits until no runs are in progress on the worker, and returns false if runs are
+// still in progress after the timeout.
+func (w *Worker) waitForInFlightRuns(timeout time.Duration) bool {
+	deadline := time.After(timeout)
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for w.InFlightRuns() > 0 {
+		select {
+		case <-deadline:
+			return false
+		case <-ticker.C:
+		}
+	}
+
+	return true
+}
+
+func (w *Worker) workerId() string {
+	w.actionsMu.RLock()
+	defer w.actionsMu.RUnlock()
+
+	if w.id == nil {
+		return ""
+	}
+
+	return *w.id
+}
@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDrainTestWorker(dispatcher *fakeDispatcherClient, timeout time.Duration) *Worker {
+	l := zerolog.Nop()
+	id := "worker-id"
+
+	return &Worker{
+		client:                  &fakeClient{dispatcher: dispatcher},
+		name:                    "test-worker",
+		l:                       &l,
+		id:                      &id,
+		gracefulShutdownTimeout: timeout,
+	}
+}
+
+func TestDrain(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+	w := newDrainTestWorker(dispatcher, time.Second)
+
+	w.inFlightRuns.Add(1)
+
+	go func() {
+		time.Sleep(150 * time.Millisecond)
+		w.inFlightRuns.Add(-1)
+	}()
+
+	stopped := false
+
+	w.drain(func() { stopped = true })
+
+	assert.True(t, w.draining.Load())
+	assert.True(t, stopped)
+	assert.Equal(t, []bool{false}, dispatcher.drains, "runs should not be released if they finished before the timeout")
+}
+
+func TestDrainTimeout(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+	w := newDrainTestWorker(dispatcher, 100*time.Millisecond)
+
+	runCtx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	w.cancelMap.Store("step-run-id", cancel)
+	w.inFlightRuns.Add(1)
+
+	stopped := false
+
+	w.drain(func() { stopped = true })
+
+	assert.True(t, stopped)
+	assert.Equal(t, []bool{false, true}, dispatcher.drains, "runs should be released once the timeout expired")
+	assert.Equal(t, CancelReasonShutdown, CancellationReason(runCtx))
+}
+
+func TestDrainSkippedRuns(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+	w := newDrainTestWorker(dispatcher, time.Second)
+
+	// a run was assigned while the worker was draining
+	w.skippedRuns.Store(true)
+
+	w.drain(func() {})
+
+	assert.Equal(t, []bool{false, true}, dispatcher.drains)
+}
+
+func TestWithGracefulShutdownTimeout(t *testing.T) {
+	w, err := NewWorker(WithClient(&fakeClient{}), WithGracefulShutdownTimeout(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, w.gracefulShutdownTimeout)
+
+	w, err = NewWorker(WithClient(&fakeClient{}), WithGracefulShutdownTimeout(-time.Minute))
+	assert.NoError(t, err)
+	assert.Zero(t, w.gracefulShutdownTimeout)
+}
@@ -0,0 +1,308 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+// registeredError describes how an error which is registered with RegisterError or RegisterErrorType
+// is matched when a step fails, and reconstructed from the code and details of the failure.
+type registeredError struct {
+	code string
+
+	// match returns the details to store with the failure if err matches the registered error
+	match func(err error) (details json.RawMessage, ok bool)
+
+	// decode reconstructs the registered error from the stored details
+	decode func(details json.RawMessage) (error, error)
+}
+
+var (
+	errorRegistryMu sync.RWMutex
+	errorRegistry   []*registeredError
+	errorCodes      = map[string]*registeredError{}
+)
+
+// RegisterError registers a sentinel error with a code. When a step fails with an error which matches
+// the sentinel according to errors.Is, the step run fails with the code, and errors which are
+// reconstructed with DecodeError or HatchetContext.StepRunErrors match the sentinel again.
+//
+// Errors should be registered before the worker starts, for example in an init function, and with the
+// same codes on every worker and client which handles them. RegisterError panics if the code is empty
+// or already registered.
+func RegisterError(code string, sentinel error) {
+	if sentinel == nil {
+		panic("worker: cannot register a nil error")
+	}
+
+	register(&registeredError{
+		code: code,
+		match: func(err error) (json.RawMessage, bool) {
+			return nil, errors.Is(err, sentinel)
+		},
+		decode: func(json.RawMessage) (error, error) {
+			return sentinel, nil
+		},
+	})
+}
+
+// RegisterErrorType registers an error type with a code. When a step fails with an error which matches
+// T according to errors.As, the step run fails with the code, and the JSON encoding of the error value
+// is stored as the details of the failure. Errors which are reconstructed with DecodeError or
+// HatchetContext.StepRunErrors contain a T which is decoded from the details, so only the exported
+// fields of the error survive the round trip.
+//
+// RegisterErrorType panics if the code is empty or already registered.
+func RegisterErrorType[T error](code string) {
+	register(&registeredError{
+		code: code,
+		match: func(err error) (json.RawMessage, bool) {
+			var target T
+
+			if !errors.As(err, &target) {
+				return nil, false
+			}
+
+			b, marshalErr := json.Marshal(target)
+
+			if marshalErr != nil || len(b) > MaxErrorDetailsSize {
+				return nil, true
+			}
+
+			return b, true
+		},
+		decode: func(details json.RawMessage) (error, error) {
+			var target T
+
+			// allocate pointer types, so that the details are decoded into a new value
+			if t := reflect.TypeOf(&target).Elem(); t.Kind() == reflect.Pointer {
+				target = reflect.New(t.Elem()).Interface().(T)
+			}
+
+			if len(details) > 0 {
+				if err := json.Unmarshal(details, &target); err != nil {
+					return nil, fmt.Errorf("could not decode details of error %s: %w", code, err)
+				}
+			}
+
+			return target, nil
+		},
+	})
+}
+
+func register(e *registeredError) {
+	if e.code == "" {
+		panic("worker: cannot register an error with an empty code")
+	}
+
+	errorRegistryMu.Lock()
+	defer errorRegistryMu.Unlock()
+
+	if _, exists := errorCodes[e.code]; exists {
+		panic(fmt.Sprintf("worker: error code %s is already registered", e.code))
+	}
+
+	errorRegistry = append(errorRegistry, e)
+	errorCodes[e.code] = e
+}
+
+// encodeRegisteredError returns the code and details of the first registered error which err matches,
+// in the order the errors were registered.
+func encodeRegisteredError(err error) (*StepError, bool) {
+	errorRegistryMu.RLock()
+	defer errorRegistryMu.RUnlock()
+
+	for _, e := range errorRegistry {
+		if details, ok := e.match(err); ok {
+			return &StepError{
+				Code:    e.code,
+				Details: details,
+			}, true
+		}
+	}
+
+	return nil, false
+}
+
+// DecodedError is an error which was reconstructed from the code, details and message of a step run
+// failure. It wraps the registered error, so errors.Is and errors.As match it, and returns the original
+// error message.
+type DecodedError struct {
+	// Code is the code which the step run failed with.
+	Code string
+
+	// Details is the JSON encoded details of the failure, or nil if there are none.
+	Details json.RawMessage
+
+	// Message is the error message of the step run.
+	Message string
+
+	err error
+}
+
+func (e *DecodedError) Error() string {
+	return e.Message
+}
+
+func (e *DecodedError) Unwrap() error {
+	return e.err
+}
+
+// DecodeError reconstructs the error of a failed step run from the code and details which are stored
+// in the data of its FAILED event, and its error message. If the code is registered with RegisterError
+// or RegisterErrorType, the returned error wraps the registered error. Unknown codes, for example codes
+// sent with FailWithDetails, return a *DecodedError which only carries the code and details.
+func DecodeError(code string, details json.RawMessage, message string) (error, error) {
+	decoded := &DecodedError{
+		Code:    code,
+		Details: details,
+		Message: message,
+	}
+
+	errorRegistryMu.RLock()
+	e, ok := errorCodes[code]
+	errorRegistryMu.RUnlock()
+
+	if !ok {
+		return decoded, nil
+	}
+
+	err, decodeErr := e.decode(details)
+
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
+	decoded.err = err
+
+	return decoded, nil
+}
+
+// stepRunEventsLimit is the number of events which are listed to find the FAILED event of a step run.
+const stepRunEventsLimit = 100
+
+func (h *hatchetContext) StepRunErrors() (map[string]error, error) {
+	tenantId, err := uuid.Parse(h.c.TenantId())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid tenant id: %w", err)
+	}
+
+	workflowRunId, err := uuid.Parse(h.a.WorkflowRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid workflow run id: %w", err)
+	}
+
+	res, err := h.c.API().WorkflowRunGetWithResponse(h, tenantId, workflowRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("could not get workflow run: unexpected status %d", res.StatusCode())
+	}
+
+	errs := map[string]error{}
+
+	if res.JSON200.JobRuns == nil {
+		return errs, nil
+	}
+
+	for _, jobRun := range *res.JSON200.JobRuns {
+		if jobRun.StepRuns == nil {
+			continue
+		}
+
+		for _, stepRun := range *jobRun.StepRuns {
+			if stepRun.Status != rest.StepRunStatusFAILED {
+				continue
+			}
+
+			key := stepRun.StepId
+
+			if stepRun.Step != nil {
+				key = stepRun.Step.ReadableId
+			}
+
+			message := ""
+
+			if stepRun.Error != nil {
+				message = *stepRun.Error
+			}
+
+			stepErr, err := h.stepRunError(stepRun.Metadata.Id, message)
+
+			if err != nil {
+				return nil, err
+			}
+
+			errs[key] = stepErr
+		}
+	}
+
+	return errs, nil
+}
+
+// stepRunError reconstructs the error of a failed step run from the data of its latest FAILED event.
+func (h *hatchetContext) stepRunError(stepRunId string, message string) (error, error) {
+	id, err := uuid.Parse(stepRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid step run id: %w", err)
+	}
+
+	limit := int64(stepRunEventsLimit)
+
+	res, err := h.c.API().StepRunListEventsWithResponse(h, id, &rest.StepRunListEventsParams{
+		Limit: &limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list events of step run %s: %w", stepRunId, err)
+	}
+
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("could not list events of step run %s: unexpected status %d", stepRunId, res.StatusCode())
+	}
+
+	var failed *rest.StepRunEvent
+
+	if res.JSON200.Rows != nil {
+		for i, event := range *res.JSON200.Rows {
+			if event.Reason == rest.StepRunEventReasonFAILED && (failed == nil || event.Id > failed.Id) {
+				failed = &(*res.JSON200.Rows)[i]
+			}
+		}
+	}
+
+	if failed == nil || failed.Data == nil {
+		return errors.New(message), nil
+	}
+
+	code, _ := (*failed.Data)["error_code"].(string)
+
+	if code == "" {
+		return errors.New(message), nil
+	}
+
+	var details json.RawMessage
+
+	if d, ok := (*failed.Data)["error_details"]; ok && d != nil {
+		details, err = json.Marshal(d)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not encode details of step run %s: %w", stepRunId, err)
+		}
+	}
+
+	return DecodeError(code, details, message)
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestInsufficientFunds = errors.New("insufficient funds")
+
+type testDeclinedError struct {
+	Reason string `json:"reason"`
+}
+
+func (e *testDeclinedError) Error() string {
+	return "declined: " + e.Reason
+}
+
+func init() {
+	RegisterError("test_insufficient_funds", errTestInsufficientFunds)
+	RegisterErrorType[*testDeclinedError]("test_declined")
+}
+
+func TestRegisteredErrorRoundTrip(t *testing.T) {
+	err := fmt.Errorf("could not charge card: %w", errTestInsufficientFunds)
+
+	stepErr, ok := encodeRegisteredError(err)
+	require.True(t, ok)
+	assert.Equal(t, "test_insufficient_funds", stepErr.Code)
+	assert.Nil(t, stepErr.Details)
+
+	decoded, decodeErr := DecodeError(stepErr.Code, stepErr.Details, err.Error())
+	require.NoError(t, decodeErr)
+
+	assert.ErrorIs(t, decoded, errTestInsufficientFunds)
+	assert.Equal(t, "could not charge card: insufficient funds", decoded.Error())
+}
+
+func TestRegisteredErrorTypeRoundTrip(t *testing.T) {
+	err := fmt.Errorf("could not charge card: %w", &testDeclinedError{Reason: "expired"})
+
+	stepErr, ok := encodeRegisteredError(err)
+	require.True(t, ok)
+	assert.Equal(t, "test_declined", stepErr.Code)
+	assert.JSONEq(t, `{"reason":"expired"}`, string(stepErr.Details))
+
+	decoded, decodeErr := DecodeError(stepErr.Code, stepErr.Details, err.Error())
+	require.NoError(t, decodeErr)
+
+	var declined *testDeclinedError
+	require.ErrorAs(t, decoded, &declined)
+	assert.Equal(t, "expired", declined.Reason)
+}
+
+func TestDecodeUnknownError(t *testing.T) {
+	_, ok := encodeRegisteredError(errors.New("unregistered"))
+	assert.False(t, ok)
+
+	decoded, err := DecodeError("payment_declined", []byte(`{"reason":"x"}`), "step failed")
+	require.NoError(t, err)
+
+	var decodedErr *DecodedError
+	require.ErrorAs(t, decoded, &decodedErr)
+	assert.Equal(t, "payment_declined", decodedErr.Code)
+	assert.Nil(t, errors.Unwrap(decoded))
+}
+
+func TestRegisterDuplicateCode(t *testing.T) {
+	assert.Panics(t, func() {
+		RegisterError("test_insufficient_funds", errors.New("other"))
+	})
+}
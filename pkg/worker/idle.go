@@ -0,0 +1,209 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithIdleTimeout stops the worker once it has not run any step for the given duration, so that it can
+// be scaled to zero. The worker never stops while a step or get group key run is in progress. When the
+// timeout expires, Run returns nil and the worker unregisters from the engine. Runs which are assigned to
+// the worker once the timeout has expired are not started, they are released back to the queue.
+func WithIdleTimeout(d time.Duration) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		if d > 0 {
+			opts.idleTimeout = &d
+		}
+	}
+}
+
+// WithReconnectInterval sets the time the worker waits before each attempt to reconnect to the engine
+// after its connection was lost. Defaults to client.DefaultActionListenerRetryInterval.
+func WithReconnectInterval(d time.Duration) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.reconnectInterval = d
+	}
+}
+
+// WithHeartbeatInterval sets the interval at which the worker sends heartbeats to the engine. The engine marks
+// workers as inactive once they haven't sent a heartbeat within its heartbeat timeout, and only assigns step
+// runs to workers with a heartbeat in the last 5 seconds. Defaults to client.DefaultHeartbeatInterval.
+func WithHeartbeatInterval(d time.Duration) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.heartbeatInterval = d
+	}
+}
+
+// minIdleCheckInterval is the shortest interval at which the worker checks whether its idle timeout has
+// expired.
+const minIdleCheckInterval = 10 * time.Millisecond
+
+// idleTracker counts the runs in progress on the worker and the time since the last run finished. All
+// methods can be called on a nil tracker, which is used by workers without an idle timeout.
+type idleTracker struct {
+	mu sync.Mutex
+
+	timeout time.Duration
+
+	running int
+
+	idleSince time.Time
+
+	// set once the idle timeout has expired, after which no new runs are started
+	stopped bool
+}
+
+func newIdleTracker(timeout time.Duration) *idleTracker {
+	return &idleTracker{
+		timeout:   timeout,
+		idleSince: time.Now(),
+	}
+}
+
+// runStarted records the start of a run, and returns false if the idle timeout has already expired, in
+// which case the run must not be started.
+func (t *idleTracker) runStarted() bool {
+	if t == nil {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopped {
+		return false
+	}
+
+	t.running++
+
+	return true
+}
+
+func (t *idleTracker) runFinished() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.running--
+
+	if t.running == 0 {
+		t.idleSince = time.Now()
+	}
+}
+
+// reset restarts the countdown if no run is in progress.
+func (t *idleTracker) reset() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.running == 0 {
+		t.idleSince = time.Now()
+	}
+}
+
+// state returns the time since which the worker has been idle, and whether it is idle at all.
+func (t *idleTracker) state() (time.Time, bool) {
+	if t == nil {
+		return time.Time{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.idleSince, t.running == 0
+}
+
+// expire returns true if no run is in progress and the last run finished before the timeout. Once it
+// returns true, runStarted refuses new runs, so that no run starts while the worker stops.
+func (t *idleTracker) expire(now time.Time) bool {
+	if t == nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.running == 0 && now.Sub(t.idleSince) >= t.timeout {
+		t.stopped = true
+	}
+
+	return t.stopped
+}
+
+func (t *idleTracker) checkInterval() time.Duration {
+	if t == nil {
+		return time.Second
+	}
+
+	return max(min(t.timeout/10, time.Second), minIdleCheckInterval)
+}
+
+// watchIdle returns a channel which is closed once the idle timeout of the worker has expired. It emits
+// lifecycle events when the idle countdown starts and when it is interrupted by a new run. If the worker
+// has no idle timeout, the returned channel is nil.
+func (w *Worker) watchIdle(ctx context.Context) <-chan struct{} {
+	if w.idle == nil {
+		return nil
+	}
+
+	// the countdown starts once the worker is listening for actions
+	w.idle.reset()
+
+	expiredCh := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(w.idle.checkInterval())
+		defer ticker.Stop()
+
+		// the start of the countdown which was last reported in an idle event
+		var countdownStart time.Time
+
+		for {
+			var now time.Time
+
+			select {
+			case <-ctx.Done():
+				return
+			case now = <-ticker.C:
+			}
+
+			idleSince, idle := w.idle.state()
+
+			if !idle {
+				if !countdownStart.IsZero() {
+					countdownStart = time.Time{}
+
+					w.emitLifecycleEvent(LifecycleEvent{
+						Type: LifecycleEventActive,
+					})
+				}
+
+				continue
+			}
+
+			if !idleSince.Equal(countdownStart) {
+				countdownStart = idleSince
+
+				w.emitLifecycleEvent(LifecycleEvent{
+					Type:         LifecycleEventIdle,
+					IdleDeadline: idleSince.Add(w.idle.timeout),
+				})
+			}
+
+			if w.idle.expire(now) {
+				close(expiredCh)
+				return
+			}
+		}
+	}()
+
+	return expiredCh
+}
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchIdle(t *testing.T) {
+	var mu sync.Mutex
+	events := []LifecycleEventType{}
+
+	w := &Worker{
+		name: "test-worker",
+		idle: newIdleTracker(50 * time.Millisecond),
+		lifecycleListeners: []LifecycleListener{
+			func(evt LifecycleEvent) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				events = append(events, evt.Type)
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.True(t, w.idle.runStarted())
+
+	expiredCh := w.watchIdle(ctx)
+
+	// the worker must not stop while a run is in progress
+	select {
+	case <-expiredCh:
+		t.Fatal("idle timeout expired while a run was in progress")
+	case <-time.After(150 * time.Millisecond):
+	}
+
+	w.idle.runFinished()
+
+	select {
+	case <-expiredCh:
+	case <-time.After(time.Second):
+		t.Fatal("idle timeout did not expire")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []LifecycleEventType{LifecycleEventIdle}, events)
+}
+
+func TestWatchIdleWithoutTimeout(t *testing.T) {
+	w := &Worker{}
+
+	assert.Nil(t, w.watchIdle(context.Background()))
+
+	// runs can be tracked on workers without an idle timeout
+	assert.True(t, w.idle.runStarted())
+	w.idle.runFinished()
+
+	w.idle.reset()
+	assert.False(t, w.idle.expire(time.Now()))
+	assert.Positive(t, w.idle.checkInterval())
+}
+
+func TestIdleTrackerRefusesRunsOnceExpired(t *testing.T) {
+	idle := newIdleTracker(time.Minute)
+
+	now := time.Now()
+
+	assert.True(t, idle.runStarted())
+	assert.False(t, idle.expire(now.Add(time.Hour)), "the timeout must not expire while a run is in progress")
+
+	idle.runFinished()
+
+	assert.False(t, idle.expire(time.Now()))
+	assert.True(t, idle.expire(time.Now().Add(time.Minute)))
+
+	// runs which arrive once the timeout has expired are refused, even if the worker has not stopped yet
+	assert.False(t, idle.runStarted())
+	assert.True(t, idle.expire(time.Now()))
+}
+
+func TestIdleTrackerCheckInterval(t *testing.T) {
+	assert.Equal(t, time.Second, newIdleTracker(time.Hour).checkInterval())
+	assert.Equal(t, 100*time.Millisecond, newIdleTracker(time.Second).checkInterval())
+
+	// short timeouts must not result in a zero interval, which time.NewTicker rejects
+	assert.Equal(t, minIdleCheckInterval, newIdleTracker(time.Nanosecond).checkInterval())
+}
@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// StepCall is the input and output of a step run, which interceptors registered with
+// Service.Intercept can read and replace.
+type StepCall struct {
+	// Input is the decoded input of the workflow run, which HatchetContext.WorkflowInput decodes into
+	// the target.
+	Input map[string]any
+
+	// InputJSON is the JSON encoded input of the workflow run.
+	InputJSON json.RawMessage
+
+	// Output is the output which the step returned. It is set after next returns without an error,
+	// and is nil for steps which only return an error.
+	Output any
+
+	h *hatchetContext
+}
+
+// SetInput replaces the input of the workflow run for the rest of the step run, so that
+// HatchetContext.WorkflowInput in the step and the interceptors which run after this one decode the
+// new input. The input must encode to a JSON object.
+func (c *StepCall) SetInput(input any) error {
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return fmt.Errorf("could not encode input: %w", err)
+	}
+
+	var decoded map[string]any
+
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return fmt.Errorf("input must be a JSON object: %w", err)
+	}
+
+	c.Input = decoded
+	c.InputJSON = b
+
+	if c.h != nil {
+		c.h.stepData.Input = decoded
+	}
+
+	return nil
+}
+
+// SetOutput replaces the output of the step, which is sent to the engine and stored as the output of
+// the step run. The output is still redacted if the workflow or step sets Redact.
+func (c *StepCall) SetOutput(output any) {
+	c.Output = output
+}
+
+// InterceptorFunc wraps the call of a step function, after all middleware of the worker and the
+// service ran. Unlike MiddlewareFunc, next returns the error which the step returned, and the
+// interceptor can replace the input before calling next and the output after it returns. The error
+// which the interceptor returns fails the step run.
+type InterceptorFunc func(ctx HatchetContext, call *StepCall, next func(HatchetContext) error) error
+
+type interceptors struct {
+	mu           sync.Mutex
+	interceptors []InterceptorFunc
+}
+
+func (i *interceptors) add(fns ...InterceptorFunc) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.interceptors = append(i.interceptors, fns...)
+}
+
+func (i *interceptors) runAll(ctx HatchetContext, call *StepCall, next func(HatchetContext) error) error {
+	// copy the interceptors so that interceptors which are added while a step runs don't affect it
+	i.mu.Lock()
+	fs := slices.Clone(i.interceptors)
+	i.mu.Unlock()
+
+	return intercept(ctx, call, fs, next)
+}
+
+func intercept(ctx HatchetContext, call *StepCall, fs []InterceptorFunc, next func(HatchetContext) error) error {
+	if len(fs) == 0 {
+		return next(ctx)
+	}
+
+	return fs[0](ctx, call, func(ctx HatchetContext) error {
+		return intercept(ctx, call, fs[1:], next)
+	})
+}
+
+// newStepCall returns the StepCall of the step run which ctx belongs to.
+func newStepCall(ctx HatchetContext) *StepCall {
+	h, ok := ctx.Value(stepRunContextKey{}).(*hatchetContext)
+
+	if !ok {
+		return &StepCall{}
+	}
+
+	call := &StepCall{
+		Input: h.stepData.Input,
+		h:     h,
+	}
+
+	if h.info != nil {
+		call.InputJSON = h.info.Input
+	}
+
+	return call
+}
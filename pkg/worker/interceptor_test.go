@@ -0,0 +1,154 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type signupInput struct {
+	Email string `json:"email"`
+}
+
+type signupOutput struct {
+	UserId string `json:"userId"`
+	Email  string `json:"email"`
+}
+
+func TestIntercept(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+	require.NoError(t, err)
+
+	svc := w.NewService("users")
+
+	calls := []string{}
+
+	// normalizes the email of the input
+	svc.Intercept(func(ctx HatchetContext, call *StepCall, next func(HatchetContext) error) error {
+		calls = append(calls, "sanitize")
+
+		assert.JSONEq(t, `{"email":"  Jane@Example.com "}`, string(call.InputJSON))
+
+		input := &signupInput{}
+
+		if err := ctx.WorkflowInput(input); err != nil {
+			return err
+		}
+
+		input.Email = strings.ToLower(strings.TrimSpace(input.Email))
+
+		if err := call.SetInput(input); err != nil {
+			return err
+		}
+
+		return next(ctx)
+	})
+
+	// replaces the output after the step returned
+	svc.Intercept(func(ctx HatchetContext, call *StepCall, next func(HatchetContext) error) error {
+		calls = append(calls, "wrap")
+
+		assert.Equal(t, "jane@example.com", call.Input["email"])
+
+		if err := next(ctx); err != nil {
+			return err
+		}
+
+		output := call.Output.(*signupOutput)
+		call.SetOutput(map[string]any{"userId": output.UserId})
+
+		return nil
+	})
+
+	err = svc.RegisterWorkflow(&WorkflowJob{
+		Name: "signup",
+		On:   NoTrigger(),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) (*signupOutput, error) {
+				calls = append(calls, "step")
+
+				input := &signupInput{}
+
+				if err := ctx.WorkflowInput(input); err != nil {
+					return nil, err
+				}
+
+				return &signupOutput{UserId: "user-1", Email: input.Email}, nil
+			}).SetName("create-user"),
+		},
+	})
+	require.NoError(t, err)
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "users:create-user",
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(`{"input":{"email":"  Jane@Example.com "}}`),
+		ActionType:    client.ActionTypeStartStepRun,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"sanitize", "wrap", "step"}, calls)
+
+	require.Len(t, dispatcher.events, 2)
+
+	completed := dispatcher.events[1]
+	assert.Equal(t, client.ActionEventTypeCompleted, completed.EventType)
+
+	sent, err := json.Marshal(completed.EventPayload)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"userId":"user-1"}`, string(sent))
+}
+
+func TestInterceptStepError(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+	require.NoError(t, err)
+
+	svc := w.NewService("users")
+
+	errStep := errors.New("user already exists")
+
+	var seen error
+
+	svc.Intercept(func(ctx HatchetContext, call *StepCall, next func(HatchetContext) error) error {
+		seen = next(ctx)
+		return seen
+	})
+
+	err = svc.RegisterWorkflow(&WorkflowJob{
+		Name: "signup",
+		On:   NoTrigger(),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) error {
+				return errStep
+			}).SetName("create-user"),
+		},
+	})
+	require.NoError(t, err)
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "users:create-user",
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(`{"input":{}}`),
+		ActionType:    client.ActionTypeStartStepRun,
+	})
+	require.NoError(t, err)
+
+	// unlike middleware, interceptors receive the error of the step
+	assert.ErrorIs(t, seen, errStep)
+
+	require.Len(t, dispatcher.events, 2)
+	assert.Equal(t, client.ActionEventTypeFailed, dispatcher.events[1].EventType)
+	assert.Equal(t, "user already exists", dispatcher.events[1].EventPayload)
+}
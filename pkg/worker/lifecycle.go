@@ -0,0 +1,106 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type LifecycleEventType string
+
+const (
+	// LifecycleEventConnected is emitted when the worker has registered with the engine and when it
+	// reconnects after a disconnect.
+	LifecycleEventConnected LifecycleEventType = "connected"
+
+	// LifecycleEventDisconnected is emitted when the worker loses its connection to the engine.
+	LifecycleEventDisconnected LifecycleEventType = "disconnected"
+
+	// LifecycleEventReconnecting is emitted before each attempt to reconnect to the engine.
+	LifecycleEventReconnecting LifecycleEventType = "reconnecting"
+
+	// LifecycleEventWorkflowRegistered is emitted when a workflow has been registered with the engine.
+	LifecycleEventWorkflowRegistered LifecycleEventType = "workflow-registered"
+
+	// LifecycleEventIdle is emitted when the worker has no runs in progress and starts counting down
+	// its idle timeout. It is only emitted for workers with an idle timeout.
+	LifecycleEventIdle LifecycleEventType = "idle"
+
+	// LifecycleEventActive is emitted when an idle worker starts a run before its idle timeout expired.
+	LifecycleEventActive LifecycleEventType = "active"
+
+	// LifecycleEventShuttingDown is emitted when the worker stops listening for actions, either because
+	// its context was cancelled, because its idle timeout expired or because it could not reconnect to
+	// the engine.
+	LifecycleEventShuttingDown LifecycleEventType = "shutting-down"
+)
+
+// LifecycleEvent describes a change in the lifecycle of a worker.
+type LifecycleEvent struct {
+	Type LifecycleEventType
+
+	// WorkerName is the name of the worker.
+	WorkerName string
+
+	// WorkerId is the id of the worker, which is empty until the worker has connected.
+	WorkerId string
+
+	// Workflow is the name of the registered workflow. It is only set for workflow-registered events.
+	Workflow string
+
+	// Attempt is the reconnect attempt, starting at 1. It is only set for reconnecting events.
+	Attempt int
+
+	// IdleDeadline is the time at which the worker shuts down unless it starts a run. It is only set for
+	// idle events.
+	IdleDeadline time.Time
+
+	// Err is the error which caused the event, if any. It is set for disconnected events and for
+	// shutting-down events which were caused by an error.
+	Err error
+}
+
+// LifecycleListener is called synchronously for each lifecycle event of the worker, so it must not block.
+type LifecycleListener func(evt LifecycleEvent)
+
+// WithLifecycleListener adds a listener which is called for lifecycle events of the worker. It can be
+// passed multiple times to add multiple listeners.
+func WithLifecycleListener(listener LifecycleListener) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.lifecycleListeners = append(opts.lifecycleListeners, listener)
+	}
+}
+
+func (w *Worker) emitLifecycleEvent(evt LifecycleEvent) {
+	evt.WorkerName = w.name
+
+	w.actionsMu.RLock()
+	if w.id != nil {
+		evt.WorkerId = *w.id
+	}
+	w.actionsMu.RUnlock()
+
+	for _, listener := range w.lifecycleListeners {
+		listener(evt)
+	}
+}
+
+// onConnectionEvent translates connection events of the action listener to lifecycle events.
+func (w *Worker) onConnectionEvent(evt client.ListenerConnectionEvent) {
+	switch evt.Type {
+	case client.ListenerConnectionEventDisconnected:
+		w.emitLifecycleEvent(LifecycleEvent{
+			Type: LifecycleEventDisconnected,
+			Err:  evt.Err,
+		})
+	case client.ListenerConnectionEventReconnecting:
+		w.emitLifecycleEvent(LifecycleEvent{
+			Type:    LifecycleEventReconnecting,
+			Attempt: evt.Attempt,
+		})
+	case client.ListenerConnectionEventReconnected:
+		w.emitLifecycleEvent(LifecycleEvent{
+			Type: LifecycleEventConnected,
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestOnConnectionEvent(t *testing.T) {
+	events := []LifecycleEvent{}
+	id := "worker-id"
+
+	w := &Worker{
+		name: "test-worker",
+		id:   &id,
+		lifecycleListeners: []LifecycleListener{
+			func(evt LifecycleEvent) {
+				events = append(events, evt)
+			},
+		},
+	}
+
+	disconnectErr := errors.New("connection reset")
+
+	w.onConnectionEvent(client.ListenerConnectionEvent{Type: client.ListenerConnectionEventDisconnected, Err: disconnectErr})
+	w.onConnectionEvent(client.ListenerConnectionEvent{Type: client.ListenerConnectionEventReconnecting, Attempt: 1})
+	w.onConnectionEvent(client.ListenerConnectionEvent{Type: client.ListenerConnectionEventReconnecting, Attempt: 2})
+	w.onConnectionEvent(client.ListenerConnectionEvent{Type: client.ListenerConnectionEventReconnected})
+
+	assert.Equal(t, []LifecycleEvent{
+		{Type: LifecycleEventDisconnected, WorkerName: "test-worker", WorkerId: id, Err: disconnectErr},
+		{Type: LifecycleEventReconnecting, WorkerName: "test-worker", WorkerId: id, Attempt: 1},
+		{Type: LifecycleEventReconnecting, WorkerName: "test-worker", WorkerId: id, Attempt: 2},
+		{Type: LifecycleEventConnected, WorkerName: "test-worker", WorkerId: id},
+	}, events)
+}
@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// badLogFieldKey is the key of log fields which don't follow a key, like slog uses.
+const badLogFieldKey = "!BADKEY"
+
+// parseLogFields returns the level and the metadata of a log line from the fields which are passed to
+// HatchetContext.Log. The fields are key-value pairs or slog.Attr values, and a slog.Level in place of a
+// key sets the level.
+func parseLogFields(fields []any) (string, map[string]any) {
+	level := "INFO"
+
+	var metadata map[string]any
+
+	set := func(key string, value any) {
+		if metadata == nil {
+			metadata = make(map[string]any)
+		}
+
+		metadata[key] = value
+	}
+
+	for i := 0; i < len(fields); i++ {
+		switch f := fields[i].(type) {
+		case slog.Level:
+			level = logLineLevel(f)
+		case slog.Attr:
+			set(f.Key, logValue(f.Value))
+		case string:
+			if i+1 == len(fields) {
+				set(badLogFieldKey, f)
+				continue
+			}
+
+			set(f, logFieldValue(fields[i+1]))
+			i++
+		default:
+			set(badLogFieldKey, logFieldValue(f))
+		}
+	}
+
+	// the metadata is stored as JSON, so values which can't be encoded are stored as strings instead
+	if _, err := json.Marshal(metadata); err != nil {
+		for key, value := range metadata {
+			metadata[key] = fmt.Sprintf("%v", value)
+		}
+	}
+
+	return level, metadata
+}
+
+// logLineLevel maps a slog level to the nearest level of the engine.
+func logLineLevel(level slog.Level) string {
+	switch {
+	case level < slog.LevelInfo:
+		return "DEBUG"
+	case level < slog.LevelWarn:
+		return "INFO"
+	case level < slog.LevelError:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+func logFieldValue(value any) any {
+	switch v := value.(type) {
+	case slog.Value:
+		return logValue(v)
+	case slog.LogValuer:
+		return logValue(slog.AnyValue(v))
+	case error:
+		return v.Error()
+	default:
+		return v
+	}
+}
+
+func logValue(value slog.Value) any {
+	value = value.Resolve()
+
+	switch value.Kind() {
+	case slog.KindGroup:
+		group := make(map[string]any)
+
+		for _, attr := range value.Group() {
+			group[attr.Key] = logValue(attr.Value)
+		}
+
+		return group
+	case slog.KindDuration:
+		return value.Duration().String()
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			return err.Error()
+		}
+
+		return value.Any()
+	default:
+		return value.Any()
+	}
+}
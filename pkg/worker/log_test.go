@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLogFields(t *testing.T) {
+	level, metadata := parseLogFields(nil)
+
+	assert.Equal(t, "INFO", level)
+	assert.Nil(t, metadata)
+
+	level, metadata = parseLogFields([]any{
+		slog.LevelWarn,
+		"attempt", 2,
+		"err", errors.New("card declined"),
+		slog.Group("order", "id", "order-1", "delay", time.Second),
+		"dangling",
+	})
+
+	assert.Equal(t, "WARN", level)
+	assert.Equal(t, map[string]any{
+		"attempt": 2,
+		"err":     "card declined",
+		"order": map[string]any{
+			"id":    "order-1",
+			"delay": "1s",
+		},
+		badLogFieldKey: "dangling",
+	}, metadata)
+}
+
+func TestParseLogFieldsUnencodableValues(t *testing.T) {
+	_, metadata := parseLogFields([]any{"ch", make(chan int), "count", 1})
+
+	assert.Equal(t, "1", metadata["count"])
+	assert.IsType(t, "", metadata["ch"])
+}
+
+func TestLogLineLevel(t *testing.T) {
+	assert.Equal(t, "DEBUG", logLineLevel(slog.LevelDebug))
+	assert.Equal(t, "INFO", logLineLevel(slog.LevelInfo))
+	assert.Equal(t, "INFO", logLineLevel(slog.LevelInfo+2))
+	assert.Equal(t, "WARN", logLineLevel(slog.LevelWarn))
+	assert.Equal(t, "ERROR", logLineLevel(slog.LevelError+4))
+}
@@ -1,13 +1,125 @@
 package worker
 
 import (
+	"encoding/json"
 	"fmt"
 	"runtime/debug"
+	"slices"
 	"sync"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
 )
 
+// MiddlewareFunc wraps the execution of a step. Middleware runs in a fixed order: the panic recovery
+// of the worker, then the worker middleware, then the service middleware and finally the step
+// function. Within each level, middleware runs in the order in which it was registered, and calls to
+// next return in the reverse order.
 type MiddlewareFunc func(ctx HatchetContext, next func(HatchetContext) error) error
 
+// StepRunInfo describes the step run which middleware registered with UseWithInfo wraps. The same
+// StepRunInfo is passed to all such middleware of a step run.
+type StepRunInfo struct {
+	// WorkflowName is the name of the workflow, including the namespace of the worker. If the step is
+	// shared between workflows, it is the name of the workflow which registered it last.
+	WorkflowName string
+
+	StepName string
+
+	ActionId string
+
+	WorkflowRunId string
+
+	JobRunId string
+
+	StepRunId string
+
+	// Attempt is the attempt of the step run, starting at 1 and incremented on every retry.
+	Attempt int
+
+	// Input is the JSON encoded input of the workflow run.
+	Input json.RawMessage
+
+	// Err is the error which the step returned. It is set before next returns, as next only returns
+	// errors of the worker, for example if the result of the step could not be sent to the engine.
+	Err error
+
+	// StepError is the classification of Err which is sent to the engine: the error itself if it is a
+	// *StepError, the encoded error if it is registered with RegisterError or RegisterErrorType, and
+	// nil otherwise.
+	StepError *StepError
+}
+
+// InfoMiddlewareFunc is middleware which also receives the StepRunInfo of the step run, see
+// Worker.UseWithInfo.
+type InfoMiddlewareFunc func(ctx HatchetContext, info *StepRunInfo, next func(HatchetContext) error) error
+
+func withInfo(mws []InfoMiddlewareFunc) []MiddlewareFunc {
+	res := make([]MiddlewareFunc, 0, len(mws))
+
+	for _, mw := range mws {
+		res = append(res, func(ctx HatchetContext, next func(HatchetContext) error) error {
+			return mw(ctx, stepRunInfo(ctx), next)
+		})
+	}
+
+	return res
+}
+
+// stepRunInfo returns the StepRunInfo of the step run which ctx belongs to. Contexts which wrap the
+// HatchetContext of the step run, for example in tests, get an info without the error.
+func stepRunInfo(ctx HatchetContext) *StepRunInfo {
+	if h, ok := ctx.Value(stepRunContextKey{}).(*hatchetContext); ok && h.info != nil {
+		return h.info
+	}
+
+	return &StepRunInfo{
+		StepName:      ctx.StepName(),
+		WorkflowRunId: ctx.WorkflowRunId(),
+		StepRunId:     ctx.StepRunId(),
+		Attempt:       ctx.RetryCount() + 1,
+	}
+}
+
+func (w *Worker) newStepRunInfo(action *client.Action) (*StepRunInfo, error) {
+	payload := struct {
+		Input json.RawMessage `json:"input"`
+	}{}
+
+	if err := json.Unmarshal(action.ActionPayload, &payload); err != nil {
+		return nil, fmt.Errorf("could not decode action payload: %w", err)
+	}
+
+	w.actionsMu.RLock()
+	workflowName := w.actionWorkflows[action.ActionId]
+	w.actionsMu.RUnlock()
+
+	return &StepRunInfo{
+		WorkflowName:  workflowName,
+		StepName:      action.StepName,
+		ActionId:      action.ActionId,
+		WorkflowRunId: action.WorkflowRunId,
+		JobRunId:      action.JobRunId,
+		StepRunId:     action.StepRunId,
+		Attempt:       int(action.RetryCount) + 1,
+		Input:         payload.Input,
+	}, nil
+}
+
+// setErr records the error which the step returned, and how it is classified.
+func (i *StepRunInfo) setErr(err error) {
+	i.Err = err
+
+	stepErr, ok := asStepError(err)
+
+	if !ok {
+		stepErr, ok = encodeRegisteredError(err)
+	}
+
+	if ok {
+		i.StepError = stepErr
+	}
+}
+
 type middlewares struct {
 	mu          sync.Mutex
 	middlewares []MiddlewareFunc
@@ -26,8 +138,24 @@ func (m *middlewares) add(mws ...MiddlewareFunc) {
 	m.middlewares = append(m.middlewares, mws...)
 }
 
+// insert inserts the middleware at the given position. A negative index inserts at the start, and an
+// index past the end appends the middleware.
+func (m *middlewares) insert(index int, mws ...MiddlewareFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index = max(0, min(index, len(m.middlewares)))
+
+	m.middlewares = slices.Insert(m.middlewares, index, mws...)
+}
+
 func (m *middlewares) runAll(ctx HatchetContext, next func(HatchetContext) error) error {
-	return run(ctx, m.middlewares, next)
+	// copy the middleware so that middleware which is added while a step runs doesn't affect it
+	m.mu.Lock()
+	fs := slices.Clone(m.middlewares)
+	m.mu.Unlock()
+
+	return run(ctx, fs, next)
 }
 
 func run(ctx HatchetContext, fs []MiddlewareFunc, next func(HatchetContext) error) error {
@@ -41,6 +169,16 @@ func run(ctx HatchetContext, fs []MiddlewareFunc, next func(HatchetContext) erro
 	})
 }
 
+// runWithMiddleware runs the step function fn wrapped with the middleware of the worker and the
+// service, in the order documented on MiddlewareFunc.
+func (w *Worker) runWithMiddleware(ctx HatchetContext, svc *Service, fn func(HatchetContext) error) error {
+	return w.panicMiddleware(ctx, func(ctx HatchetContext) error {
+		return w.middlewares.runAll(ctx, func(ctx HatchetContext) error {
+			return svc.mws.runAll(ctx, fn)
+		})
+	})
+}
+
 func (w *Worker) panicMiddleware(ctx HatchetContext, next func(HatchetContext) error) error {
 	var err error
 
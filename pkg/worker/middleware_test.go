@@ -3,7 +3,11 @@ package worker
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/pkg/client"
 )
@@ -24,6 +28,10 @@ func (c *testHatchetContext) StepOutput(step string, target interface{}) error {
 	return nil
 }
 
+func (c *testHatchetContext) ParentOutputRaw(parent string) ([]byte, error) {
+	return nil, nil
+}
+
 func (c *testHatchetContext) TriggeredByEvent() bool {
 	return false
 }
@@ -59,7 +67,7 @@ func (c *testHatchetContext) WorkflowRunId() string {
 	panic("not implemented")
 }
 
-func (c *testHatchetContext) Log(message string) {
+func (c *testHatchetContext) Log(message string, fields ...any) {
 	panic("not implemented")
 }
 
@@ -75,10 +83,34 @@ func (c *testHatchetContext) StreamEvent(message []byte) {
 	panic("not implemented")
 }
 
+func (c *testHatchetContext) StreamOutput(chunk []byte) error {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) StepRunErrors() (map[string]error, error) {
+	panic("not implemented")
+}
+
 func (c *testHatchetContext) RetryCount() int {
 	panic("not implemented")
 }
 
+func (c *testHatchetContext) RetryBudget() int {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) Now() (time.Time, error) {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) NewUUID() (string, error) {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) SleepFor(d time.Duration) error {
+	panic("not implemented")
+}
+
 func (c *testHatchetContext) action() *client.Action {
 	panic("not implemented")
 }
@@ -203,3 +235,250 @@ func TestRunAllWithErrorInNext(t *testing.T) {
 		t.Errorf("Expected error %v, got %v", expectedErr, err)
 	}
 }
+
+// recordingMiddleware returns middleware which records when it is entered and when its call to next
+// returns.
+func recordingMiddleware(calls *[]string, name string) MiddlewareFunc {
+	return func(ctx HatchetContext, next func(HatchetContext) error) error {
+		*calls = append(*calls, name+":before")
+		err := next(ctx)
+		*calls = append(*calls, name+":after")
+		return err
+	}
+}
+
+func TestRunWithMiddlewareOrder(t *testing.T) {
+	calls := []string{}
+
+	w := &Worker{
+		middlewares: newMiddlewares(),
+	}
+
+	svc := &Service{
+		mws: newMiddlewares(),
+	}
+
+	// register the service middleware first to make sure registration order across levels doesn't matter
+	svc.Use(recordingMiddleware(&calls, "svc-1"), recordingMiddleware(&calls, "svc-2"))
+	w.Use(recordingMiddleware(&calls, "worker-logging"))
+	w.UseAt(0, recordingMiddleware(&calls, "worker-auth"))
+
+	err := w.runWithMiddleware(&testHatchetContext{context.Background()}, svc, func(ctx HatchetContext) error {
+		calls = append(calls, "step")
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{
+		"worker-auth:before",
+		"worker-logging:before",
+		"svc-1:before",
+		"svc-2:before",
+		"step",
+		"svc-2:after",
+		"svc-1:after",
+		"worker-logging:after",
+		"worker-auth:after",
+	}
+
+	if !slices.Equal(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestInsertMiddleware(t *testing.T) {
+	calls := []string{}
+
+	m := newMiddlewares()
+
+	m.add(recordingMiddleware(&calls, "a"), recordingMiddleware(&calls, "c"))
+	m.insert(1, recordingMiddleware(&calls, "b"))
+	m.insert(-1, recordingMiddleware(&calls, "first"))
+	m.insert(10, recordingMiddleware(&calls, "last"))
+
+	err := m.runAll(&testHatchetContext{context.Background()}, func(ctx HatchetContext) error {
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{
+		"first:before",
+		"a:before",
+		"b:before",
+		"c:before",
+		"last:before",
+		"last:after",
+		"c:after",
+		"b:after",
+		"a:after",
+		"first:after",
+	}
+
+	if !slices.Equal(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestGetStepRunContextInMiddleware(t *testing.T) {
+	l := zerolog.Nop()
+
+	hCtx, err := newHatchetContext(context.Background(), &client.Action{
+		StepName:      "step-one",
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(`{"input":{}}`),
+	}, nil, &l, &Worker{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	w := &Worker{
+		middlewares: newMiddlewares(),
+	}
+
+	svc := &Service{
+		mws: newMiddlewares(),
+	}
+
+	stepNames := []string{}
+
+	// records the step name from a plain context.Context, like a logging library would
+	stepNameMiddleware := func(ctx HatchetContext, next func(HatchetContext) error) error {
+		stepCtx, ok := GetStepRunContext(ctx.GetContext())
+
+		if !ok {
+			t.Fatal("Expected step run context")
+		}
+
+		stepNames = append(stepNames, stepCtx.StepName())
+
+		return next(ctx)
+	}
+
+	w.Use(func(ctx HatchetContext, next func(HatchetContext) error) error {
+		// replace the context with one which is not derived from the step run context
+		ctx.SetContext(context.WithValue(context.Background(), "key", "value"))
+		return next(ctx)
+	})
+
+	w.Use(stepNameMiddleware)
+	svc.Use(stepNameMiddleware)
+
+	err = w.runWithMiddleware(hCtx, svc, func(ctx HatchetContext) error {
+		stepCtx, ok := GetStepRunContext(context.WithValue(ctx, "other", "value"))
+
+		if !ok || stepCtx.StepRunId() != "step-run-id" {
+			t.Errorf("Expected step run context in the step")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !slices.Equal(stepNames, []string{"step-one", "step-one"}) {
+		t.Errorf("Expected step names from worker and service middleware, got %v", stepNames)
+	}
+
+	if _, ok := GetStepRunContext(context.Background()); ok {
+		t.Error("Expected no step run context")
+	}
+}
+
+func TestUseWithInfo(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	infos := []*StepRunInfo{}
+	errs := []error{}
+
+	recordInfo := func(ctx HatchetContext, info *StepRunInfo, next func(HatchetContext) error) error {
+		err := next(ctx)
+
+		infos = append(infos, info)
+		errs = append(errs, info.Err)
+
+		return err
+	}
+
+	w.UseWithInfo(recordInfo)
+
+	svc := w.NewService("payments")
+	svc.UseWithInfo(recordInfo)
+
+	err = svc.RegisterWorkflow(&WorkflowJob{
+		Name: "checkout",
+		On:   NoTrigger(),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) error {
+				return FailWithDetails("payment_declined", map[string]string{"reason": "insufficient_funds"})
+			}).SetName("charge"),
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "payments:charge",
+		ActionType:    client.ActionTypeStartStepRun,
+		StepName:      "charge",
+		StepRunId:     "step-run-id",
+		WorkflowRunId: "workflow-run-id",
+		JobRunId:      "job-run-id",
+		RetryCount:    1,
+		ActionPayload: []byte(`{"input":{"amount":1999}}`),
+	})
+
+	// the failure of the step is reported to the engine, not returned
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(infos) != 2 || infos[0] != infos[1] {
+		t.Fatalf("Expected the same info in the worker and service middleware, got %v", infos)
+	}
+
+	info := infos[0]
+
+	if info.WorkflowName != "checkout" || info.StepName != "charge" || info.ActionId != "payments:charge" {
+		t.Errorf("Expected the workflow and step of the step run, got %+v", info)
+	}
+
+	if info.WorkflowRunId != "workflow-run-id" || info.JobRunId != "job-run-id" || info.StepRunId != "step-run-id" {
+		t.Errorf("Expected the run ids of the step run, got %+v", info)
+	}
+
+	if info.Attempt != 2 {
+		t.Errorf("Expected attempt 2, got %d", info.Attempt)
+	}
+
+	if string(info.Input) != `{"amount":1999}` {
+		t.Errorf("Expected the workflow input, got %s", info.Input)
+	}
+
+	// both middleware see the error of the step once next returned
+	for _, err := range errs {
+		if err == nil {
+			t.Error("Expected the error of the step")
+		}
+	}
+
+	if info.StepError == nil || info.StepError.Code != "payment_declined" {
+		t.Errorf("Expected the error to be classified as payment_declined, got %v", info.StepError)
+	}
+}
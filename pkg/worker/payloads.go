@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+// rehydrateInput replaces the input in the payload of the action with the input which it references if
+// the input was offloaded to the payload store of the client, so that middleware and the step read the
+// original input. Offloaded outputs of parent steps are only fetched when they are read.
+func rehydrateInput(ctx context.Context, c client.Client, action *client.Action) error {
+	// the payload of a get group key run is the input
+	if action.GetGroupKeyRunId != "" {
+		if !client.IsPayloadRef(action.ActionPayload) {
+			return nil
+		}
+
+		input, err := client.RehydratePayload(ctx, c.PayloadStore(), action.ActionPayload)
+
+		if err != nil {
+			return err
+		}
+
+		action.ActionPayload = input
+
+		return nil
+	}
+
+	var payload map[string]json.RawMessage
+
+	// invalid payloads are reported when the step data is populated
+	if err := json.Unmarshal(action.ActionPayload, &payload); err != nil {
+		return nil
+	}
+
+	input, ok := payload["input"]
+
+	if !ok || !client.IsPayloadRef(input) {
+		return nil
+	}
+
+	input, err := client.RehydratePayload(ctx, c.PayloadStore(), input)
+
+	if err != nil {
+		return err
+	}
+
+	payload["input"] = input
+
+	action.ActionPayload, err = json.Marshal(payload)
+
+	return err
+}
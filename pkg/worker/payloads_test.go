@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type fakePayloadStore struct {
+	payloads map[string][]byte
+	gets     []string
+}
+
+func (s *fakePayloadStore) Put(ctx context.Context, key string, data []byte) error {
+	s.payloads[key] = data
+	return nil
+}
+
+func (s *fakePayloadStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.gets = append(s.gets, key)
+
+	data, ok := s.payloads[key]
+
+	if !ok {
+		return nil, fmt.Errorf("payload %s not found", key)
+	}
+
+	return data, nil
+}
+
+// offloadedRef stores the payload in the store and returns the reference which replaces it
+func (s *fakePayloadStore) offloadedRef(key, payload string) string {
+	s.payloads[key] = []byte(payload)
+
+	sum := sha256.Sum256([]byte(payload))
+
+	return fmt.Sprintf(`{"__hatchet_payload_ref":{"key":%q,"size":%d,"sha256":%q}}`, key, len(payload), hex.EncodeToString(sum[:]))
+}
+
+func TestOffloadedPayloads(t *testing.T) {
+	store := &fakePayloadStore{payloads: map[string][]byte{}}
+
+	inputRef := store.offloadedRef("tenant/input", `{"message":"hello"}`)
+	outputRef := store.offloadedRef("tenant/output", `{"count":3}`)
+
+	l := zerolog.Nop()
+
+	ctx, err := newHatchetContext(context.Background(), &client.Action{
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(fmt.Sprintf(`{"input":%s,"parents":{"first":%s,"second":{"count":1}}}`, inputRef, outputRef)),
+	}, &fakeClient{payloadStore: store}, &l, &Worker{})
+	require.NoError(t, err)
+
+	// the input is rehydrated when the context is created
+	assert.Equal(t, []string{"tenant/input"}, store.gets)
+
+	input := struct {
+		Message string `json:"message"`
+	}{}
+
+	require.NoError(t, ctx.WorkflowInput(&input))
+	assert.Equal(t, "hello", input.Message)
+
+	// outputs are only fetched when they are read, and only once
+	output := struct {
+		Count int `json:"count"`
+	}{}
+
+	require.NoError(t, ctx.StepOutput("second", &output))
+	assert.Equal(t, 1, output.Count)
+	assert.Len(t, store.gets, 1)
+
+	require.NoError(t, ctx.StepOutput("first", &output))
+	assert.Equal(t, 3, output.Count)
+
+	raw, err := ctx.ParentOutputRaw("first")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"count":3}`, string(raw))
+
+	assert.Equal(t, []string{"tenant/input", "tenant/output"}, store.gets)
+
+	// without a payload store, offloaded payloads can't be read
+	_, err = newHatchetContext(context.Background(), &client.Action{
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(fmt.Sprintf(`{"input":%s}`, inputRef)),
+	}, &fakeClient{}, &l, &Worker{})
+	assert.ErrorIs(t, err, client.ErrNoPayloadStore)
+}
@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RedactedPlaceholder replaces the values of the fields of step outputs which are redacted with
+// WorkflowJob.Redact.
+const RedactedPlaceholder = "[REDACTED]"
+
+// redactPath is a parsed redaction path, with one segment per level of the output.
+type redactPath []string
+
+// parseRedactPath parses a redaction path. Paths are dot-separated field names, optionally prefixed with
+// "$.", where a segment can be the index of an array element, or "*" to match every field of an object
+// or every element of an array. For example, "card.number" or "$.items.*.email".
+func parseRedactPath(path string) (redactPath, error) {
+	trimmed := strings.TrimPrefix(path, "$.")
+
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid redaction path %q: path is empty", path)
+	}
+
+	segments := strings.Split(trimmed, ".")
+
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid redaction path %q: path has an empty segment", path)
+		}
+	}
+
+	return segments, nil
+}
+
+func parseRedactPaths(paths []string) ([]redactPath, error) {
+	res := make([]redactPath, 0, len(paths))
+
+	for _, path := range paths {
+		p, err := parseRedactPath(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+// redactOutput returns the JSON encoding of the output with the values at the given paths replaced by
+// RedactedPlaceholder. The output itself is not modified. Paths which don't exist in the output are
+// ignored.
+func redactOutput(output any, paths []redactPath) (json.RawMessage, error) {
+	outputBytes, err := json.Marshal(output)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal output: %w", err)
+	}
+
+	// decode numbers as json.Number, so that they are encoded exactly as they were
+	dec := json.NewDecoder(bytes.NewReader(outputBytes))
+	dec.UseNumber()
+
+	var value any
+
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("could not decode output: %w", err)
+	}
+
+	for _, path := range paths {
+		value = redactValue(value, path)
+	}
+
+	return json.Marshal(value)
+}
+
+func redactValue(value any, path redactPath) any {
+	if len(path) == 0 {
+		return RedactedPlaceholder
+	}
+
+	segment, rest := path[0], path[1:]
+
+	switch v := value.(type) {
+	case map[string]any:
+		if segment == "*" {
+			for key, child := range v {
+				v[key] = redactValue(child, rest)
+			}
+		} else if child, ok := v[segment]; ok {
+			v[segment] = redactValue(child, rest)
+		}
+	case []any:
+		if segment == "*" {
+			for i, child := range v {
+				v[i] = redactValue(child, rest)
+			}
+		} else if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(v) {
+			v[i] = redactValue(v[i], rest)
+		}
+	}
+
+	return value
+}
+
+// inputRedactor is implemented by workflows which redact their input when they're spawned.
+type inputRedactor interface {
+	inputRedactPaths() []string
+}
+
+// getRedactPaths returns the redaction paths of the action, if any.
+func (w *Worker) getRedactPaths(actionId string) []redactPath {
+	w.actionsMu.RLock()
+	defer w.actionsMu.RUnlock()
+
+	return w.redactPaths[actionId]
+}
+
+// redactInput returns the input for a run of the namespaced workflow with the fields which the
+// workflow redacts replaced by RedactedPlaceholder. Inputs of workflows which are not registered on
+// the worker, or which don't redact their input, are returned unchanged.
+func (w *Worker) redactInput(workflowName string, input any) (any, error) {
+	w.actionsMu.RLock()
+	paths := w.inputRedactPaths[workflowName]
+	w.actionsMu.RUnlock()
+
+	if len(paths) == 0 {
+		return input, nil
+	}
+
+	redacted, err := redactOutput(input, paths)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not redact input of workflow %s: %w", workflowName, err)
+	}
+
+	return redacted, nil
+}
@@ -0,0 +1,208 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type card struct {
+	Holder string `json:"holder"`
+	Number string `json:"number"`
+}
+
+type payment struct {
+	Card   card     `json:"card"`
+	Emails []string `json:"emails"`
+	Amount int      `json:"amount"`
+}
+
+func TestRedactOutput(t *testing.T) {
+	output := &payment{
+		Card:   card{Holder: "Jane Doe", Number: "4242424242424242"},
+		Emails: []string{"jane@example.com", "billing@example.com"},
+		Amount: 1999,
+	}
+
+	paths, err := parseRedactPaths([]string{"card.number", "$.emails.*", "missing.field"})
+	require.NoError(t, err)
+
+	redacted, err := redactOutput(output, paths)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"card": {"holder": "Jane Doe", "number": "[REDACTED]"},
+		"emails": ["[REDACTED]", "[REDACTED]"],
+		"amount": 1999
+	}`, string(redacted))
+
+	// the output of the step is not modified
+	assert.Equal(t, "4242424242424242", output.Card.Number)
+	assert.Equal(t, "jane@example.com", output.Emails[0])
+
+	paths, err = parseRedactPaths([]string{"emails.1"})
+	require.NoError(t, err)
+
+	redacted, err = redactOutput(output, paths)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"card": {"holder": "Jane Doe", "number": "4242424242424242"},
+		"emails": ["jane@example.com", "[REDACTED]"],
+		"amount": 1999
+	}`, string(redacted))
+}
+
+func TestParseRedactPath(t *testing.T) {
+	_, err := parseRedactPath("")
+	assert.Error(t, err)
+
+	_, err = parseRedactPath("$.")
+	assert.Error(t, err)
+
+	_, err = parseRedactPath("card..number")
+	assert.Error(t, err)
+
+	path, err := parseRedactPath("$.card.number")
+	require.NoError(t, err)
+	assert.Equal(t, redactPath{"card", "number"}, path)
+}
+
+func TestStepOutputIsRedactedBeforeItIsSent(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+	require.NoError(t, err)
+
+	// the value which the step returns
+	var seen *payment
+
+	svc := w.NewService("payments")
+
+	err = svc.RegisterWorkflow(&WorkflowJob{
+		Name:   "charge",
+		On:     NoTrigger(),
+		Redact: []string{"card.number"},
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) (*payment, error) {
+				seen = &payment{
+					Card:   card{Holder: "Jane Doe", Number: "4242424242424242"},
+					Amount: 1999,
+				}
+
+				return seen, nil
+			}).SetName("charge-card"),
+		},
+	})
+	require.NoError(t, err)
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "payments:charge-card",
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(`{"input":{}}`),
+		ActionType:    client.ActionTypeStartStepRun,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, dispatcher.events, 2)
+	assert.Equal(t, client.ActionEventTypeStarted, dispatcher.events[0].EventType)
+
+	completed := dispatcher.events[1]
+	assert.Equal(t, client.ActionEventTypeCompleted, completed.EventType)
+
+	// the output which is sent to the engine, and persisted, is redacted
+	sent, err := json.Marshal(completed.EventPayload)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"card": {"holder": "Jane Doe", "number": "[REDACTED]"},
+		"emails": null,
+		"amount": 1999
+	}`, string(sent))
+
+	// while the step still has the real value
+	require.NotNil(t, seen)
+	assert.Equal(t, "4242424242424242", seen.Card.Number)
+}
+
+func TestSpawnedWorkflowInputIsRedactedBeforeItIsSent(t *testing.T) {
+	admin := &fakeAdminClient{}
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{admin: admin, dispatcher: dispatcher}))
+	require.NoError(t, err)
+
+	svc := w.NewService("payments")
+
+	err = svc.RegisterWorkflow(&WorkflowJob{
+		Name:        "refund",
+		On:          NoTrigger(),
+		RedactInput: []string{"card.number", "emails.*"},
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) (*payment, error) {
+				return nil, nil
+			}).SetName("refund-card"),
+		},
+	})
+	require.NoError(t, err)
+
+	// the input which the spawning step passes
+	input := &payment{
+		Card:   card{Holder: "Jane Doe", Number: "4242424242424242"},
+		Emails: []string{"jane@example.com"},
+		Amount: 1999,
+	}
+
+	err = svc.RegisterWorkflow(&WorkflowJob{
+		Name: "charge",
+		On:   NoTrigger(),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) (*payment, error) {
+				if _, err := ctx.SpawnWorkflow("refund", input, nil); err != nil {
+					return nil, err
+				}
+
+				// workflows without redaction rules are spawned with their input unchanged
+				if _, err := ctx.SpawnWorkflows([]*SpawnWorkflowsOpts{{WorkflowName: "charge", Input: input}}); err != nil {
+					return nil, err
+				}
+
+				return input, nil
+			}).SetName("charge-card"),
+		},
+	})
+	require.NoError(t, err)
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "payments:charge-card",
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(`{"input":{}}`),
+		ActionType:    client.ActionTypeStartStepRun,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, dispatcher.events, 2)
+	assert.Equal(t, client.ActionEventTypeCompleted, dispatcher.events[1].EventType)
+
+	// the input which is sent to the engine, and persisted, is redacted
+	assert.JSONEq(t, `{
+		"card": {"holder": "Jane Doe", "number": "[REDACTED]"},
+		"emails": ["[REDACTED]"],
+		"amount": 1999
+	}`, admin.childInputs["refund"])
+
+	assert.JSONEq(t, `{
+		"card": {"holder": "Jane Doe", "number": "4242424242424242"},
+		"emails": ["jane@example.com"],
+		"amount": 1999
+	}`, admin.childInputs["charge"])
+
+	// while the spawning step still has the real values
+	assert.Equal(t, "4242424242424242", input.Card.Number)
+	assert.Equal(t, "jane@example.com", input.Emails[0])
+}
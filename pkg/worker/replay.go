@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	recordedNowPrefix  = "hatchet_now_"
+	recordedUUIDPrefix = "hatchet_uuid_"
+)
+
+// recordedValues holds the values which were recorded by earlier executions of a step run. The values
+// are stored in the overrides of the step run input, which are kept when the step run is retried or
+// replayed.
+type recordedValues struct {
+	values map[string]json.RawMessage
+
+	// the number of calls for each key prefix
+	calls map[string]int
+}
+
+func newRecordedValues(values map[string]json.RawMessage) *recordedValues {
+	if values == nil {
+		values = map[string]json.RawMessage{}
+	}
+
+	return &recordedValues{
+		values: values,
+		calls:  map[string]int{},
+	}
+}
+
+func (h *hatchetContext) Now() (time.Time, error) {
+	var now time.Time
+
+	err := h.recordOrLoad(recordedNowPrefix, &now, func() any {
+		return time.Now().UTC()
+	})
+
+	return now, err
+}
+
+func (h *hatchetContext) NewUUID() (string, error) {
+	var id string
+
+	err := h.recordOrLoad(recordedUUIDPrefix, &id, func() any {
+		return uuid.New().String()
+	})
+
+	return id, err
+}
+
+// recordOrLoad decodes the value recorded for the next call with the given prefix into target. If no value
+// was recorded, it records the value returned by generate and decodes it into target.
+func (h *hatchetContext) recordOrLoad(prefix string, target any, generate func() any) error {
+	if h.a.StepRunId == "" {
+		return fmt.Errorf("recorded values are only available in step runs")
+	}
+
+	h.recordedMu.Lock()
+	defer h.recordedMu.Unlock()
+
+	if h.recorded == nil {
+		h.recorded = newRecordedValues(nil)
+	}
+
+	key := fmt.Sprintf("%s%d", prefix, h.recorded.calls[prefix])
+
+	if raw, ok := h.recorded.values[key]; ok {
+		if err := json.Unmarshal(raw, target); err != nil {
+			return fmt.Errorf("could not decode recorded value %s: %w", key, err)
+		}
+
+		h.recorded.calls[prefix]++
+
+		return nil
+	}
+
+	raw, err := json.Marshal(generate())
+
+	if err != nil {
+		return err
+	}
+
+	err = h.c.Dispatcher().PutOverridesData(h, h.a.StepRunId, key, raw)
+
+	if err != nil {
+		return fmt.Errorf("could not record value %s: %w", key, err)
+	}
+
+	h.recorded.values[key] = raw
+	h.recorded.calls[prefix]++
+
+	return json.Unmarshal(raw, target)
+}
@@ -0,0 +1,172 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrDeadlineWouldBeExceeded is the reason a step run fails without being retried, when the next retry
+// would only start after the workflow run has timed out.
+var ErrDeadlineWouldBeExceeded = errors.New("deadline would be exceeded")
+
+// the engine uses a max backoff of 24 hours if a backoff factor is set without a max backoff
+const defaultRetryMaxBackoffSeconds = 24 * 60 * 60
+
+// retryPolicy contains the retry settings of a step, so that the worker can compute the same backoff
+// delays as the engine.
+type retryPolicy struct {
+	retries           int
+	backoffFactor     *float32
+	maxBackoffSeconds *int32
+	backoffBase       *time.Duration
+
+	// timeout is the timeout of the step, which sets the deadline of the step context. It is zero if the
+	// step has no timeout of its own.
+	timeout time.Duration
+}
+
+func newRetryPolicy(step *WorkflowStep) *retryPolicy {
+	p := &retryPolicy{
+		retries:           step.Retries,
+		backoffFactor:     step.RetryBackoffFactor,
+		maxBackoffSeconds: step.RetryMaxBackoffSeconds,
+		backoffBase:       step.RetryBackoffBase,
+	}
+
+	// timeouts which Go can't parse are validated by the engine, and the step context has no deadline
+	if timeout, err := time.ParseDuration(step.Timeout); err == nil && timeout > 0 {
+		p.timeout = timeout
+	}
+
+	return p
+}
+
+// backoff returns the delay before the given retry attempt, where the first retry is 1. Jitter only
+// shortens the delay, so this is the longest delay the engine waits.
+func (p *retryPolicy) backoff(retry int) time.Duration {
+	if p.backoffFactor == nil {
+		return 0
+	}
+
+	maxBackoffSeconds := float64(defaultRetryMaxBackoffSeconds)
+
+	if p.maxBackoffSeconds != nil {
+		maxBackoffSeconds = float64(*p.maxBackoffSeconds)
+	}
+
+	durationMilliseconds := 1000 * min(maxBackoffSeconds, math.Pow(float64(*p.backoffFactor), float64(retry)))
+
+	if p.backoffBase != nil {
+		baseMilliseconds := float64(p.backoffBase.Milliseconds())
+		durationMilliseconds = min(1000*maxBackoffSeconds, baseMilliseconds*math.Pow(float64(*p.backoffFactor), float64(retry-1)))
+	}
+
+	return time.Duration(int(durationMilliseconds)) * time.Millisecond
+}
+
+// retriesWithinBudget returns the number of remaining retries which would start before the deadline,
+// given the number of retries which have already happened. If there is no deadline, all remaining
+// retries are within budget.
+//
+// The deadline must outlive the step run attempt, like the timeout of the workflow run. The timeout of
+// the step only applies to a single attempt, and a retry gets a fresh one.
+func (p *retryPolicy) retriesWithinBudget(deadline *time.Time, retryCount int, now time.Time) int {
+	remaining := p.retries - retryCount
+
+	if remaining <= 0 {
+		return 0
+	}
+
+	if deadline == nil {
+		return remaining
+	}
+
+	count := 0
+	startsAt := now
+
+	for retry := retryCount + 1; retry <= p.retries; retry++ {
+		startsAt = startsAt.Add(p.backoff(retry))
+
+		if !startsAt.Before(*deadline) {
+			break
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// stepDeadline is the context of a step run with a deadline derived from the timeout of the step. Like the
+// timeout of the step run in the engine, the deadline is extended when the step refreshes its timeout,
+// which is why it is not a context.WithDeadline.
+type stepDeadline struct {
+	context.Context
+
+	cancel context.CancelCauseFunc
+
+	mu       sync.Mutex
+	deadline time.Time
+	timer    *time.Timer
+}
+
+func newStepDeadline(parent context.Context, timeout time.Duration) *stepDeadline {
+	ctx, cancel := context.WithCancelCause(parent)
+
+	d := &stepDeadline{
+		Context:  ctx,
+		cancel:   cancel,
+		deadline: time.Now().Add(timeout),
+	}
+
+	d.timer = time.AfterFunc(timeout, func() {
+		cancel(context.DeadlineExceeded)
+	})
+
+	return d
+}
+
+func (d *stepDeadline) Deadline() (time.Time, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if parent, ok := d.Context.Deadline(); ok && parent.Before(d.deadline) {
+		return parent, true
+	}
+
+	return d.deadline, true
+}
+
+// Err returns context.DeadlineExceeded once the deadline has passed, like the contexts of the context
+// package.
+func (d *stepDeadline) Err() error {
+	err := d.Context.Err()
+
+	if err != nil && errors.Is(context.Cause(d.Context), context.DeadlineExceeded) {
+		return context.DeadlineExceeded
+	}
+
+	return err
+}
+
+// extend moves the deadline back by the given duration, unless it has already passed.
+func (d *stepDeadline) extend(by time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.Context.Err() != nil || !d.timer.Stop() {
+		return
+	}
+
+	d.deadline = d.deadline.Add(by)
+	d.timer.Reset(time.Until(d.deadline))
+}
+
+// stop releases the resources of the deadline once the step run has finished.
+func (d *stepDeadline) stop() {
+	d.timer.Stop()
+	d.cancel(context.Canceled)
+}
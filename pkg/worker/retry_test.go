@@ -0,0 +1,183 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	factor := float32(2)
+	maxBackoff := int32(10)
+
+	p := &retryPolicy{retries: 5, backoffFactor: &factor, maxBackoffSeconds: &maxBackoff}
+
+	expected := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second}
+
+	for i, want := range expected {
+		assert.Equal(t, want, p.backoff(i+1), "backoff of retry %d", i+1)
+	}
+
+	assert.Zero(t, (&retryPolicy{retries: 3}).backoff(1), "there is no backoff without a backoff factor")
+
+	// with a base delay, the first retry waits for the base delay
+	p = newRetryPolicy(Fn(func(ctx HatchetContext) error { return nil }).
+		SetRetries(5).
+		SetBackoff(500*time.Millisecond, 3*time.Second, 2))
+
+	expected = []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 3 * time.Second}
+
+	for i, want := range expected {
+		assert.Equal(t, want, p.backoff(i+1), "backoff of retry %d", i+1)
+	}
+}
+
+func TestRetryPolicyRetriesWithinBudget(t *testing.T) {
+	factor := float32(2)
+	p := &retryPolicy{retries: 3, backoffFactor: &factor}
+	now := time.Now()
+
+	assert.Equal(t, 2, p.retriesWithinBudget(nil, 1, now), "all remaining retries are within budget without a deadline")
+
+	// retries start after 2s, 2s+4s and 2s+4s+8s
+	deadline := now.Add(7 * time.Second)
+	assert.Equal(t, 2, p.retriesWithinBudget(&deadline, 0, now))
+
+	deadline = now.Add(time.Second)
+	assert.Equal(t, 0, p.retriesWithinBudget(&deadline, 0, now))
+
+	assert.Equal(t, 0, p.retriesWithinBudget(nil, 3, now), "no retries are left once all retries are used")
+}
+
+func TestRetryPolicyTimeout(t *testing.T) {
+	step := Fn(func(ctx HatchetContext) error { return nil })
+
+	assert.Zero(t, newRetryPolicy(step).timeout)
+	assert.Equal(t, 30*time.Second, newRetryPolicy(step.SetTimeout("30s")).timeout)
+}
+
+func TestStepDeadline(t *testing.T) {
+	d := newStepDeadline(context.Background(), time.Hour)
+	defer d.stop()
+
+	deadline, ok := d.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Second)
+
+	// refreshing the timeout moves the deadline back
+	d.extend(time.Hour)
+
+	extended, ok := d.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, deadline.Add(time.Hour), extended)
+
+	// a shorter deadline of the parent context applies
+	parent, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	short := newStepDeadline(parent, time.Hour)
+	defer short.stop()
+
+	deadline, _ = short.Deadline()
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
+
+func TestStepDeadlineExpires(t *testing.T) {
+	d := newStepDeadline(context.Background(), 10*time.Millisecond)
+	defer d.stop()
+
+	select {
+	case <-d.Done():
+	case <-time.After(time.Second):
+		t.Fatal("step deadline did not expire")
+	}
+
+	assert.Equal(t, context.DeadlineExceeded, d.Err())
+
+	// the deadline is not extended once it has passed
+	deadline, _ := d.Deadline()
+	d.extend(time.Hour)
+
+	after, _ := d.Deadline()
+	assert.Equal(t, deadline, after)
+
+	// cancellations are not reported as an exceeded deadline
+	parent, cancel := context.WithCancel(context.Background())
+
+	cancelled := newStepDeadline(parent, time.Hour)
+	defer cancelled.stop()
+
+	cancel()
+
+	<-cancelled.Done()
+	assert.Equal(t, context.Canceled, cancelled.Err())
+}
+
+func TestFailedStepRunRetryBudget(t *testing.T) {
+	soon := time.Now().Add(10 * time.Second)
+
+	for _, tt := range []struct {
+		name                 string
+		workflowRunTimeoutAt *time.Time
+		wantRetry            bool
+	}{
+		{
+			// the timeout of the step only applies to a single attempt, so it never uses up the retries
+			name:      "timed out step without a workflow run timeout",
+			wantRetry: true,
+		},
+		{
+			name:                 "workflow run times out before the next retry",
+			workflowRunTimeoutAt: &soon,
+			wantRetry:            false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dispatcher := &fakeDispatcherClient{}
+
+			w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+			require.NoError(t, err)
+
+			err = w.NewService("reports").RegisterWorkflow(&WorkflowJob{
+				Name: "report",
+				On:   NoTrigger(),
+				Steps: []*WorkflowStep{
+					Fn(func(ctx HatchetContext) (*stepOneOutput, error) {
+						<-ctx.Done()
+
+						return nil, ctx.Err()
+					}).SetName("generate").SetTimeout("10ms").SetRetries(3).SetBackoff(time.Minute, time.Hour, 2),
+				},
+			})
+			require.NoError(t, err)
+
+			err = w.startStepRun(context.Background(), &client.Action{
+				ActionId:             "reports:generate",
+				StepRunId:            "step-run-id",
+				ActionPayload:        []byte(`{"input":{}}`),
+				ActionType:           client.ActionTypeStartStepRun,
+				WorkflowRunTimeoutAt: tt.workflowRunTimeoutAt,
+			})
+			require.NoError(t, err)
+
+			require.Len(t, dispatcher.events, 2)
+
+			failed := dispatcher.events[1]
+			assert.Equal(t, client.ActionEventTypeFailed, failed.EventType)
+
+			if tt.wantRetry {
+				assert.Nil(t, failed.ShouldNotRetry)
+				assert.NotContains(t, failed.EventPayload, ErrDeadlineWouldBeExceeded.Error())
+			} else {
+				require.NotNil(t, failed.ShouldNotRetry)
+				assert.True(t, *failed.ShouldNotRetry)
+				assert.Contains(t, failed.EventPayload, ErrDeadlineWouldBeExceeded.Error())
+			}
+		})
+	}
+}
@@ -12,20 +12,42 @@ type Service struct {
 
 	mws *middlewares
 
+	interceptors interceptors
+
 	worker *Worker
 }
 
+// Use adds middleware which runs for every step of the service, after the middleware of the worker.
+// See MiddlewareFunc for the order in which middleware runs.
 func (s *Service) Use(mws ...MiddlewareFunc) {
 	s.mws.add(mws...)
 }
 
+// UseAt inserts service middleware at the given position of the service middleware. See Worker.UseAt.
+func (s *Service) UseAt(index int, mws ...MiddlewareFunc) {
+	s.mws.insert(index, mws...)
+}
+
+// UseWithInfo adds service middleware like Use, which also receives the StepRunInfo of the step run.
+// See Worker.UseWithInfo.
+func (s *Service) UseWithInfo(mws ...InfoMiddlewareFunc) {
+	s.mws.add(withInfo(mws)...)
+}
+
+// Intercept adds interceptors which wrap the call of every step function of the service, for example
+// to sanitize the input or to replace the output centrally. Interceptors run after all middleware, in
+// the order in which they were registered. See InterceptorFunc.
+func (s *Service) Intercept(fns ...InterceptorFunc) {
+	s.interceptors.add(fns...)
+}
+
 func (s *Service) RegisterWorkflow(workflow workflowConverter) error {
 	return s.On(workflow.ToWorkflowTrigger(), workflow)
 }
 
 // Deprecated: Use RegisterWorkflow instead
 func (s *Service) On(t triggerConverter, workflow workflowConverter) error {
-	namespace := s.worker.client.Namespace()
+	namespace := s.worker.namespace()
 
 	apiWorkflow := workflow.ToWorkflow(s.Name, namespace)
 
@@ -35,6 +57,18 @@ func (s *Service) On(t triggerConverter, workflow workflowConverter) error {
 
 	apiWorkflow.Triggers = *wt
 
+	var inputRedactPaths []redactPath
+
+	if redactor, ok := workflow.(inputRedactor); ok && len(redactor.inputRedactPaths()) > 0 {
+		paths, err := parseRedactPaths(redactor.inputRedactPaths())
+
+		if err != nil {
+			return fmt.Errorf("could not register workflow %s: %w", apiWorkflow.Name, err)
+		}
+
+		inputRedactPaths = paths
+	}
+
 	// create the workflow via the API
 	err := s.worker.client.Admin().PutWorkflow(&apiWorkflow)
 
@@ -42,6 +76,8 @@ func (s *Service) On(t triggerConverter, workflow workflowConverter) error {
 		return err
 	}
 
+	actionIds := []string{}
+
 	// register all steps as actions
 	for actionId, action := range workflow.ToActionMap(s.Name) {
 		parsedAction, err := types.ParseActionID(actionId)
@@ -62,8 +98,67 @@ func (s *Service) On(t triggerConverter, workflow workflowConverter) error {
 		if err != nil {
 			return err
 		}
+
+		if action.retryPolicy != nil {
+			s.worker.actionsMu.Lock()
+			s.worker.retryPolicies[fmt.Sprintf("%s:%s", parsedAction.Service, parsedAction.Verb)] = action.retryPolicy
+			s.worker.actionsMu.Unlock()
+		}
+
+		if len(action.redactPaths) > 0 {
+			paths, err := parseRedactPaths(action.redactPaths)
+
+			if err != nil {
+				return fmt.Errorf("could not register action %s: %w", actionId, err)
+			}
+
+			s.worker.actionsMu.Lock()
+
+			if s.worker.redactPaths == nil {
+				s.worker.redactPaths = map[string][]redactPath{}
+			}
+
+			s.worker.redactPaths[fmt.Sprintf("%s:%s", parsedAction.Service, parsedAction.Verb)] = paths
+			s.worker.actionsMu.Unlock()
+		}
+
+		// the action ids are normalized like the names of the registered actions, which the worker
+		// compares them with when a workflow is deregistered
+		actionIds = append(actionIds, fmt.Sprintf("%s:%s", parsedAction.Service, parsedAction.Verb))
+	}
+
+	s.worker.actionsMu.Lock()
+	s.worker.workflowActions[apiWorkflow.Name] = actionIds
+
+	if len(inputRedactPaths) > 0 {
+		if s.worker.inputRedactPaths == nil {
+			s.worker.inputRedactPaths = map[string][]redactPath{}
+		}
+
+		s.worker.inputRedactPaths[apiWorkflow.Name] = inputRedactPaths
 	}
 
+	if s.worker.actionWorkflows == nil {
+		s.worker.actionWorkflows = map[string]string{}
+	}
+
+	for _, actionId := range actionIds {
+		s.worker.actionWorkflows[actionId] = apiWorkflow.Name
+	}
+	s.worker.actionsMu.Unlock()
+
+	// if the worker is already running, make sure the engine starts assigning the new actions
+	err = s.worker.syncActions()
+
+	if err != nil {
+		return err
+	}
+
+	s.worker.emitLifecycleEvent(LifecycleEvent{
+		Type:     LifecycleEventWorkflowRegistered,
+		Workflow: apiWorkflow.Name,
+	})
+
 	return nil
 }
 
@@ -103,7 +198,7 @@ func (s *Service) RegisterAction(fn any, opts ...RegisterActionOpt) error {
 func (s *Service) Call(verb string) *WorkflowStep {
 	actionId := fmt.Sprintf("%s:%s", s.Name, verb)
 
-	registeredAction, exists := s.worker.actions[actionId]
+	registeredAction, exists := s.worker.getAction(actionId)
 
 	if !exists {
 		panic(fmt.Sprintf("action %s does not exist", actionId))
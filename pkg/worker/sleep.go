@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+const recordedSleepPrefix = "hatchet_sleep_"
+
+// suspendError is returned by SleepFor when the step run should be suspended until resumeAt.
+type suspendError struct {
+	resumeAt time.Time
+}
+
+func (e *suspendError) Error() string {
+	return fmt.Sprintf("step run is sleeping until %s", e.resumeAt.Format(time.RFC3339))
+}
+
+func asSuspendError(err error) (*suspendError, bool) {
+	var suspended *suspendError
+
+	if errors.As(err, &suspended) {
+		return suspended, true
+	}
+
+	return nil, false
+}
+
+func (h *hatchetContext) SleepFor(d time.Duration) error {
+	var resumeAt time.Time
+
+	// the resume time is recorded, so that the same call returns nil once the step run is started again
+	err := h.recordOrLoad(recordedSleepPrefix, &resumeAt, func() any {
+		return time.Now().UTC().Add(d)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if !time.Now().Before(resumeAt) {
+		return nil
+	}
+
+	return &suspendError{
+		resumeAt: resumeAt,
+	}
+}
+
+// sendSuspendedEvent tells the engine to release the slot of the step run and to start it again at resumeAt.
+func (w *Worker) sendSuspendedEvent(ctx HatchetContext, resumeAt time.Time) error {
+	suspendedEvent := w.getActionEvent(ctx.action(), client.ActionEventTypeSuspended)
+	suspendedEvent.ResumeAt = &resumeAt
+
+	innerCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := w.sendStepActionEvent(
+		innerCtx,
+		suspendedEvent,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not send action event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestSleepFor(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+	require.NoError(t, err)
+
+	runs := 0
+
+	err = w.NewService("reminders").RegisterWorkflow(&WorkflowJob{
+		Name: "reminder",
+		On:   NoTrigger(),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) (*stepOneOutput, error) {
+				runs++
+
+				if err := ctx.SleepFor(time.Hour); err != nil {
+					return nil, err
+				}
+
+				return &stepOneOutput{Message: "reminded"}, nil
+			}).SetName("remind"),
+		},
+	})
+	require.NoError(t, err)
+
+	before := time.Now()
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "reminders:remind",
+		StepRunId:     "step-run-id",
+		ActionPayload: []byte(`{"input":{}}`),
+		ActionType:    client.ActionTypeStartStepRun,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, dispatcher.events, 2)
+
+	suspended := dispatcher.events[1]
+	assert.Equal(t, client.ActionEventTypeSuspended, suspended.EventType)
+	require.NotNil(t, suspended.ResumeAt)
+	assert.WithinDuration(t, before.Add(time.Hour), *suspended.ResumeAt, time.Minute)
+
+	require.Contains(t, dispatcher.overrides, "hatchet_sleep_0")
+
+	// when the step run is started again after the resume time, the same call returns nil
+	payload, err := json.Marshal(map[string]any{
+		"input": map[string]any{},
+		"overrides": map[string]any{
+			"hatchet_sleep_0": time.Now().Add(-time.Second).UTC(),
+		},
+	})
+	require.NoError(t, err)
+
+	dispatcher.events = nil
+
+	err = w.startStepRun(context.Background(), &client.Action{
+		ActionId:      "reminders:remind",
+		StepRunId:     "step-run-id",
+		ActionPayload: payload,
+		ActionType:    client.ActionTypeStartStepRun,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, runs)
+
+	require.Len(t, dispatcher.events, 2)
+	assert.Equal(t, client.ActionEventTypeCompleted, dispatcher.events[1].EventType)
+}
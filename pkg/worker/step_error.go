@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MaxErrorDetailsSize is the maximum size of the JSON encoded details of a StepError. The engine drops
+// larger details, and keeps the error code and message.
+const MaxErrorDetailsSize = 16 * 1024
+
+// StepError is an error with a code and structured details, which are stored with the failure of the
+// step run. The code and details are added to the data of the FAILED event of the step run, so that
+// clients and OnFailure jobs can handle the error without parsing the error message.
+type StepError struct {
+	// Code identifies the error, for example "payment_declined".
+	Code string
+
+	// Details is the JSON encoded details of the error, or nil if there are none.
+	Details json.RawMessage
+
+	// err is set if the details could not be encoded
+	err error
+}
+
+// FailWithDetails returns an error which fails the step run with the given code and details. The
+// details must be JSON-serializable and at most MaxErrorDetailsSize bytes once encoded, otherwise
+// the step run fails with the code only, and the reason the details were dropped is added to the error
+// message.
+func FailWithDetails(code string, details any) error {
+	e := &StepError{
+		Code: code,
+	}
+
+	if details == nil {
+		return e
+	}
+
+	b, err := json.Marshal(details)
+
+	switch {
+	case err != nil:
+		e.err = fmt.Errorf("could not encode error details: %w", err)
+	case len(b) > MaxErrorDetailsSize:
+		e.err = fmt.Errorf("error details exceed %d bytes", MaxErrorDetailsSize)
+	default:
+		e.Details = b
+	}
+
+	return e
+}
+
+func (e *StepError) Error() string {
+	msg := fmt.Sprintf("step failed with code %s", e.Code)
+
+	if e.err != nil {
+		msg += fmt.Sprintf(" (%s)", e.err)
+	}
+
+	return msg
+}
+
+func asStepError(err error) (*StepError, bool) {
+	var stepErr *StepError
+
+	ok := errors.As(err, &stepErr)
+
+	return stepErr, ok
+}
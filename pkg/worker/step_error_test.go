@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailWithDetails(t *testing.T) {
+	err := FailWithDetails("payment_declined", map[string]string{"reason": "insufficient_funds"})
+
+	stepErr, ok := asStepError(fmt.Errorf("could not charge card: %w", err))
+	require.True(t, ok)
+
+	assert.Equal(t, "payment_declined", stepErr.Code)
+	assert.JSONEq(t, `{"reason":"insufficient_funds"}`, string(stepErr.Details))
+	assert.Equal(t, "step failed with code payment_declined", err.Error())
+}
+
+func TestFailWithDetailsInvalid(t *testing.T) {
+	// details which can't be encoded are dropped
+	err := FailWithDetails("invalid", make(chan int))
+
+	stepErr, ok := asStepError(err)
+	require.True(t, ok)
+
+	assert.Equal(t, "invalid", stepErr.Code)
+	assert.Nil(t, stepErr.Details)
+	assert.Contains(t, err.Error(), "could not encode error details")
+
+	// details which are too large are dropped
+	err = FailWithDetails("too_large", strings.Repeat("a", MaxErrorDetailsSize))
+
+	stepErr, ok = asStepError(err)
+	require.True(t, ok)
+
+	assert.Nil(t, stepErr.Details)
+	assert.Contains(t, err.Error(), "error details exceed")
+}
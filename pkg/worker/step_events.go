@@ -0,0 +1,192 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+const (
+	// defaultStepEventFlushInterval is the flush interval of step event batches if it is not set.
+	defaultStepEventFlushInterval = 20 * time.Millisecond
+
+	// defaultStepEventMaxBatchSize is the maximum size of step event batches if it is not set.
+	defaultStepEventMaxBatchSize = 100
+)
+
+type pendingStepEvent struct {
+	event *client.ActionEvent
+
+	// receives the result of sending the event
+	done chan error
+}
+
+// stepEventBatcher sends the started, completed and failed events of step runs to the engine in batches.
+//
+// A step waits until its event has been acknowledged by the engine, exactly as it would if the event was
+// sent on its own, so the worker never treats a step run as finished before the engine has received its
+// result. If the worker stops before a batch is sent, the engine reassigns the step runs of the worker
+// once it stops receiving heartbeats, so a result is never lost: at worst the step runs again.
+type stepEventBatcher struct {
+	dispatcher func() client.DispatcherClient
+
+	l *zerolog.Logger
+
+	flushInterval time.Duration
+	maxBatchSize  int
+
+	mu      sync.Mutex
+	pending []*pendingStepEvent
+	timer   *time.Timer
+
+	// set if the engine doesn't support batches, in which case events are sent one by one
+	unbatched atomic.Bool
+}
+
+func newStepEventBatcher(dispatcher func() client.DispatcherClient, l *zerolog.Logger, flushInterval time.Duration, maxBatchSize int) *stepEventBatcher {
+	if flushInterval <= 0 {
+		flushInterval = defaultStepEventFlushInterval
+	}
+
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultStepEventMaxBatchSize
+	}
+
+	return &stepEventBatcher{
+		dispatcher:    dispatcher,
+		l:             l,
+		flushInterval: flushInterval,
+		maxBatchSize:  maxBatchSize,
+	}
+}
+
+// send adds the event to the current batch and waits until the batch has been sent. The batch is sent
+// when it reaches the maximum batch size, or when the flush interval has passed since its first event was
+// added.
+func (b *stepEventBatcher) send(ctx context.Context, event *client.ActionEvent) error {
+	if b.unbatched.Load() {
+		_, err := b.dispatcher().SendStepActionEvent(ctx, event)
+		return err
+	}
+
+	p := &pendingStepEvent{
+		event: event,
+		done:  make(chan error, 1),
+	}
+
+	b.mu.Lock()
+
+	b.pending = append(b.pending, p)
+
+	if len(b.pending) >= b.maxBatchSize {
+		batch := b.takeLocked()
+		b.mu.Unlock()
+
+		go b.flush(batch)
+	} else {
+		if b.timer == nil {
+			b.timer = time.AfterFunc(b.flushInterval, b.flushPending)
+		}
+
+		b.mu.Unlock()
+	}
+
+	select {
+	case err := <-p.done:
+		return err
+	case <-ctx.Done():
+		// the event is still sent with the batch, but the step doesn't wait for it
+		return ctx.Err()
+	}
+}
+
+// takeLocked removes the events of the current batch. The caller must hold the lock.
+func (b *stepEventBatcher) takeLocked() []*pendingStepEvent {
+	batch := b.pending
+	b.pending = nil
+
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	return batch
+}
+
+func (b *stepEventBatcher) flushPending() {
+	b.mu.Lock()
+	batch := b.takeLocked()
+	b.mu.Unlock()
+
+	b.flush(batch)
+}
+
+func (b *stepEventBatcher) flush(batch []*pendingStepEvent) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if b.unbatched.Load() {
+		b.sendOneByOne(ctx, batch)
+		return
+	}
+
+	events := make([]*client.ActionEvent, len(batch))
+
+	for i, p := range batch {
+		events[i] = p.event
+	}
+
+	res, err := b.dispatcher().SendStepActionEvents(ctx, events)
+
+	if status.Code(err) == codes.Unimplemented {
+		b.l.Debug().Msg("engine does not support batches of step events, sending them one by one")
+
+		b.unbatched.Store(true)
+		b.sendOneByOne(ctx, batch)
+
+		return
+	}
+
+	if err != nil {
+		for _, p := range batch {
+			p.done <- fmt.Errorf("could not send batch of step events: %w", err)
+		}
+
+		return
+	}
+
+	// each event succeeds or fails on its own
+	for i, p := range batch {
+		p.done <- res[i].Err
+	}
+}
+
+func (b *stepEventBatcher) sendOneByOne(ctx context.Context, batch []*pendingStepEvent) {
+	for _, p := range batch {
+		_, err := b.dispatcher().SendStepActionEvent(ctx, p.event)
+		p.done <- err
+	}
+}
+
+// sendStepActionEvent sends the event of a step run, in a batch if the worker batches step events.
+func (w *Worker) sendStepActionEvent(ctx context.Context, event *client.ActionEvent) error {
+	if w.stepEvents != nil {
+		return w.stepEvents.send(ctx, event)
+	}
+
+	_, err := w.client.Dispatcher().SendStepActionEvent(ctx, event)
+
+	return err
+}
@@ -0,0 +1,184 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type batchingDispatcherClient struct {
+	client.DispatcherClient
+
+	mu sync.Mutex
+
+	batches [][]string
+	single  []string
+
+	// the error of the event with this step run id, which doesn't affect the other events of its batch
+	failStepRunId string
+
+	unimplemented bool
+}
+
+func (d *batchingDispatcherClient) SendStepActionEvent(ctx context.Context, in *client.ActionEvent) (*client.ActionEventResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.single = append(d.single, in.StepRunId)
+
+	return &client.ActionEventResponse{}, nil
+}
+
+func (d *batchingDispatcherClient) SendStepActionEvents(ctx context.Context, in []*client.ActionEvent) ([]client.ActionEventResult, error) {
+	if d.unimplemented {
+		return nil, status.Error(codes.Unimplemented, "unknown method SendStepActionEvents")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	batch := make([]string, 0, len(in))
+	res := make([]client.ActionEventResult, len(in))
+
+	for i, e := range in {
+		batch = append(batch, e.StepRunId)
+
+		if e.StepRunId == d.failStepRunId {
+			res[i].Err = errors.New("step run not found")
+			continue
+		}
+
+		res[i].Response = &client.ActionEventResponse{}
+	}
+
+	d.batches = append(d.batches, batch)
+
+	return res, nil
+}
+
+func newTestStepEventBatcher(d *batchingDispatcherClient, flushInterval time.Duration, maxBatchSize int) *stepEventBatcher {
+	l := zerolog.Nop()
+
+	return newStepEventBatcher(func() client.DispatcherClient { return d }, &l, flushInterval, maxBatchSize)
+}
+
+func sendConcurrently(b *stepEventBatcher, stepRunIds ...string) map[string]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	errs := make(map[string]error, len(stepRunIds))
+
+	for _, id := range stepRunIds {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := b.send(context.Background(), &client.ActionEvent{
+				Action:    &client.Action{StepRunId: id},
+				EventType: client.ActionEventTypeCompleted,
+			})
+
+			mu.Lock()
+			errs[id] = err
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+func TestStepEventBatcherMaxBatchSize(t *testing.T) {
+	d := &batchingDispatcherClient{}
+
+	// the flush interval is never reached, so the batch is sent because it is full
+	b := newTestStepEventBatcher(d, time.Hour, 3)
+
+	errs := sendConcurrently(b, "a", "b", "c")
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	require.Len(t, d.batches, 1)
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, d.batches[0])
+	assert.Empty(t, d.single)
+}
+
+func TestStepEventBatcherFlushInterval(t *testing.T) {
+	d := &batchingDispatcherClient{}
+
+	b := newTestStepEventBatcher(d, 10*time.Millisecond, 100)
+
+	errs := sendConcurrently(b, "a", "b")
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	sent := []string{}
+
+	for _, batch := range d.batches {
+		sent = append(sent, batch...)
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b"}, sent)
+}
+
+func TestStepEventBatcherPartialFailure(t *testing.T) {
+	d := &batchingDispatcherClient{
+		failStepRunId: "b",
+	}
+
+	b := newTestStepEventBatcher(d, time.Hour, 3)
+
+	// flush the events in a known order
+	pending := make([]*pendingStepEvent, 0, 3)
+
+	for _, id := range []string{"a", "b", "c"} {
+		pending = append(pending, &pendingStepEvent{
+			event: &client.ActionEvent{Action: &client.Action{StepRunId: id}},
+			done:  make(chan error, 1),
+		})
+	}
+
+	b.flush(pending)
+
+	// only the failed event is reported as failed, the events after it were processed
+	assert.NoError(t, <-pending[0].done)
+	assert.ErrorContains(t, <-pending[1].done, "step run not found")
+	assert.NoError(t, <-pending[2].done)
+}
+
+func TestStepEventBatcherUnimplemented(t *testing.T) {
+	d := &batchingDispatcherClient{
+		unimplemented: true,
+	}
+
+	b := newTestStepEventBatcher(d, time.Millisecond, 100)
+
+	errs := sendConcurrently(b, "a", "b")
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b"}, d.single)
+	assert.True(t, b.unbatched.Load())
+
+	// later events skip the batch
+	require.NoError(t, b.send(context.Background(), &client.ActionEvent{Action: &client.Action{StepRunId: "c"}}))
+	assert.Contains(t, d.single, "c")
+}
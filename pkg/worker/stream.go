@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// defaultOutputStreamBufferSize is the default number of chunks which StreamOutput buffers per step run.
+	defaultOutputStreamBufferSize = 64
+
+	// outputStreamFlushTimeout is the time to wait for the buffered chunks of a step run to be sent after
+	// the step returned.
+	outputStreamFlushTimeout = 10 * time.Second
+)
+
+// ErrOutputStreamClosed is returned by StreamOutput after the step run returned.
+var ErrOutputStreamClosed = fmt.Errorf("output stream is closed")
+
+// outputStream sends the chunks of a step run's output to the engine. Chunks are sent one at a time by
+// a single goroutine, so they are delivered in the order in which they were streamed.
+type outputStream struct {
+	chunks chan []byte
+
+	// closed when the step run returned, after which the remaining chunks are sent
+	stop chan struct{}
+
+	// closed when all chunks were sent
+	done chan struct{}
+}
+
+// StreamOutput sends a chunk of the step's output to the subscribers of the workflow run, see
+// HatchetContext.StreamOutput.
+func (h *hatchetContext) StreamOutput(chunk []byte) error {
+	s := h.getOutputStream()
+
+	if s == nil {
+		return ErrOutputStreamClosed
+	}
+
+	select {
+	case <-s.stop:
+		return ErrOutputStreamClosed
+	default:
+	}
+
+	// the caller may reuse the chunk once StreamOutput returns
+	chunk = append([]byte(nil), chunk...)
+
+	select {
+	case s.chunks <- chunk:
+		return nil
+	case <-s.stop:
+		return ErrOutputStreamClosed
+	case <-h.Done():
+		return h.Err()
+	}
+}
+
+// getOutputStream starts the output stream on the first call. It returns nil if the step run returned
+// before the stream was started.
+func (h *hatchetContext) getOutputStream() *outputStream {
+	h.outputStreamOnce.Do(func() {
+		size := h.w.worker.outputStreamBufferSize
+
+		if size <= 0 {
+			size = defaultOutputStreamBufferSize
+		}
+
+		h.outputStream = &outputStream{
+			chunks: make(chan []byte, size),
+			stop:   make(chan struct{}),
+			done:   make(chan struct{}),
+		}
+
+		go h.sendOutputStream(h.outputStream)
+	})
+
+	return h.outputStream
+}
+
+func (h *hatchetContext) sendOutputStream(s *outputStream) {
+	defer close(s.done)
+
+	send := func(chunk []byte) {
+		if err := h.c.Event().PutStreamEvent(h, h.a.StepRunId, chunk); err != nil {
+			h.l.Err(err).Msg("could not send output stream chunk")
+		}
+	}
+
+	for {
+		select {
+		case chunk := <-s.chunks:
+			send(chunk)
+		case <-s.stop:
+			for {
+				select {
+				case chunk := <-s.chunks:
+					send(chunk)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// closeOutputStream stops accepting chunks and waits until the buffered chunks were sent.
+func (h *hatchetContext) closeOutputStream() {
+	// mark the stream as started, so that it isn't started after the step run returned
+	h.outputStreamOnce.Do(func() {})
+
+	s := h.outputStream
+
+	if s == nil {
+		return
+	}
+
+	close(s.stop)
+
+	select {
+	case <-s.done:
+	case <-time.After(outputStreamFlushTimeout):
+		h.l.Warn().Msgf("timed out sending the output stream of step run %s", h.a.StepRunId)
+	}
+}
@@ -0,0 +1,110 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type fakeEventClient struct {
+	client.EventClient
+
+	mu     sync.Mutex
+	chunks []string
+
+	// blocks PutStreamEvent until it is closed, if set
+	unblock chan struct{}
+}
+
+func (e *fakeEventClient) PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error {
+	if e.unblock != nil {
+		<-e.unblock
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.chunks = append(e.chunks, string(message))
+	return nil
+}
+
+func newStreamTestContext(ctx context.Context, events *fakeEventClient, bufferSize int) *hatchetContext {
+	l := zerolog.Nop()
+
+	return &hatchetContext{
+		Context: ctx,
+		a:       &client.Action{StepRunId: "step-run"},
+		c:       &fakeClient{event: events},
+		l:       &l,
+		w: &hatchetWorkerContext{
+			Context: ctx,
+			worker:  &Worker{outputStreamBufferSize: bufferSize},
+		},
+	}
+}
+
+func TestStreamOutput(t *testing.T) {
+	events := &fakeEventClient{}
+	h := newStreamTestContext(context.Background(), events, 4)
+
+	want := []string{}
+	chunk := []byte("chunk")
+
+	for i := 0; i < 100; i++ {
+		// chunks are copied, so the caller can reuse them
+		chunk = fmt.Appendf(chunk[:0], "chunk-%d", i)
+		want = append(want, string(chunk))
+
+		require.NoError(t, h.StreamOutput(chunk))
+	}
+
+	// all chunks are sent in order before the stream is closed
+	h.closeOutputStream()
+
+	assert.Equal(t, want, events.chunks)
+	assert.ErrorIs(t, h.StreamOutput([]byte("late")), ErrOutputStreamClosed)
+}
+
+func TestStreamOutputBufferFull(t *testing.T) {
+	events := &fakeEventClient{unblock: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h := newStreamTestContext(ctx, events, 1)
+
+	// the first chunk is being sent and the second one is buffered
+	require.NoError(t, h.StreamOutput([]byte("1")))
+	require.Eventually(t, func() bool { return len(h.outputStream.chunks) == 0 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, h.StreamOutput([]byte("2")))
+
+	// the buffer is full, so the next chunk blocks until the step run is cancelled
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- h.StreamOutput([]byte("3"))
+	}()
+
+	cancel()
+
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+
+	close(events.unblock)
+	h.closeOutputStream()
+
+	assert.Equal(t, []string{"1", "2"}, events.chunks)
+}
+
+func TestStreamOutputNotStarted(t *testing.T) {
+	h := newStreamTestContext(context.Background(), &fakeEventClient{}, 0)
+
+	h.closeOutputStream()
+
+	assert.ErrorIs(t, h.StreamOutput([]byte("late")), ErrOutputStreamClosed)
+}
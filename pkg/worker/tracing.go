@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+// WithTracerProvider sets the OpenTelemetry tracer provider which the worker creates the spans of step
+// runs with. By default, the global tracer provider is used.
+//
+// The span of a step run is a child of the span which triggered its workflow run, like the span of
+// Event().Push or Admin().RunWorkflow, and the context of the step is the context of the span, so that
+// spans which steps start are part of the same trace. Child workflows which steps spawn continue the
+// trace of the step run.
+func WithTracerProvider(tp trace.TracerProvider) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.tracerProvider = tp
+	}
+}
+
+// startStepRunSpan starts the span of a step run as a child of the trace context which is stored in the
+// additional metadata of its workflow run.
+func (w *Worker) startStepRunSpan(action *client.Action) (context.Context, trace.Span) {
+	ctx := client.ExtractTraceContext(context.Background(), action.AdditionalMetadata)
+
+	tracer := w.tracer
+
+	if tracer == nil {
+		tracer = newWorkerTracer(nil)
+	}
+
+	return tracer.Start(
+		ctx,
+		"hatchet.step_run",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("hatchet.tenant_id", action.TenantId),
+			attribute.String("hatchet.workflow_run_id", action.WorkflowRunId),
+			attribute.String("hatchet.job_name", action.JobName),
+			attribute.String("hatchet.step_name", action.StepName),
+			attribute.String("hatchet.step_run_id", action.StepRunId),
+			attribute.String("hatchet.action_id", action.ActionId),
+			attribute.String("hatchet.worker_id", action.WorkerId),
+			attribute.Int("hatchet.retry_count", int(action.RetryCount)),
+		),
+	)
+}
+
+// endStepRunSpan ends the span of a step run, which is marked as failed if the step returned an error.
+func endStepRunSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// withTraceContext returns a copy of the additional metadata of a child workflow which continues the
+// trace of ctx. The metadata is returned unchanged if ctx has no span.
+func withTraceContext(ctx context.Context, metadata *map[string]string) *map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return metadata
+	}
+
+	withTrace := map[string]string{}
+
+	if metadata != nil {
+		for key, value := range *metadata {
+			withTrace[key] = value
+		}
+	}
+
+	client.InjectTraceContext(ctx, withTrace)
+
+	return &withTrace
+}
+
+func newWorkerTracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	return tp.Tracer(client.TracerName)
+}
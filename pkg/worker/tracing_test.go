@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestStepRunSpanContinuesTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	// the client stores the trace context of the span which triggered the run in its metadata
+	_, trigger := tp.Tracer("test").Start(context.Background(), "trigger")
+	trigger.End()
+
+	metadata := map[string]string{"source": "test"}
+	client.InjectTraceContext(trace.ContextWithSpan(context.Background(), trigger), metadata)
+
+	w := &Worker{
+		tracer: newWorkerTracer(tp),
+	}
+
+	ctx, span := w.startStepRunSpan(&client.Action{
+		StepName:           "step-one",
+		StepRunId:          "step-run-id",
+		AdditionalMetadata: metadata,
+	})
+
+	// child workflows which the step spawns continue the trace of the step run
+	childMetadata := withTraceContext(ctx, &metadata)
+
+	endStepRunSpan(span, assert.AnError)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	stepSpan := spans[1]
+	assert.Equal(t, "hatchet.step_run", stepSpan.Name())
+	assert.Equal(t, trigger.SpanContext().TraceID(), stepSpan.SpanContext().TraceID())
+	assert.Equal(t, trigger.SpanContext().SpanID(), stepSpan.Parent().SpanID())
+	assert.Equal(t, "Error", stepSpan.Status().Code.String())
+
+	require.NotNil(t, childMetadata)
+	assert.Equal(t, "test", (*childMetadata)["source"])
+
+	child := trace.SpanContextFromContext(client.ExtractTraceContext(context.Background(), *childMetadata))
+	assert.Equal(t, stepSpan.SpanContext().SpanID(), child.SpanID())
+
+	// the metadata of the step's caller is not changed
+	parent := trace.SpanContextFromContext(client.ExtractTraceContext(context.Background(), metadata))
+	assert.Equal(t, trigger.SpanContext().SpanID(), parent.SpanID())
+}
+
+func TestWithTraceContextWithoutSpan(t *testing.T) {
+	assert.Nil(t, withTraceContext(context.Background(), nil))
+}
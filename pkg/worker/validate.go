@@ -0,0 +1,372 @@
+package worker
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// Validate checks the workflow definitions without connecting to the engine, so that invalid workflows
+// can be caught before a worker is deployed, for example in CI. It returns a *multierror.Error which
+// describes every problem which was found, or nil if all workflows are valid.
+//
+// Validate checks the names, durations and cron expressions which the engine would reject, the
+// signatures of the step functions, duplicate step names, parents which don't exist, cycles between
+// steps and steps which can never run because one of their ancestors can't run.
+func Validate(jobs ...*WorkflowJob) error {
+	var result *multierror.Error
+
+	celParser := cel.NewCELParser()
+	seen := map[string]bool{}
+
+	for i, job := range jobs {
+		if job == nil {
+			result = multierror.Append(result, fmt.Errorf("workflow %d is nil", i))
+			continue
+		}
+
+		if seen[job.Name] {
+			result = multierror.Append(result, fmt.Errorf("workflow %s is defined more than once", job.Name))
+		}
+
+		seen[job.Name] = true
+
+		for _, err := range validateWorkflow(job, celParser) {
+			result = multierror.Append(result, fmt.Errorf("workflow %s: %w", job.Name, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateWorkflow(job *WorkflowJob, celParser *cel.CELParser) []error {
+	errs := []error{}
+
+	if !validator.NameRegex.MatchString(job.Name) {
+		errs = append(errs, fmt.Errorf("invalid name %q, names may only contain letters, numbers, '.', '-' and '_'", job.Name))
+	}
+
+	if job.ScheduleTimeout != "" {
+		if _, err := time.ParseDuration(job.ScheduleTimeout); err != nil {
+			errs = append(errs, fmt.Errorf("invalid schedule timeout %q: %w", job.ScheduleTimeout, err))
+		}
+	}
+
+	if job.RunTimeout != "" {
+		if d, err := time.ParseDuration(job.RunTimeout); err != nil {
+			errs = append(errs, fmt.Errorf("invalid run timeout %q: %w", job.RunTimeout, err))
+		} else if d <= 0 {
+			errs = append(errs, fmt.Errorf("run timeout %q must be positive", job.RunTimeout))
+		}
+	}
+
+	if job.On != nil {
+		triggers := &types.WorkflowTriggers{}
+		job.On.ToWorkflowTriggers(triggers, "")
+
+		for _, c := range triggers.Cron {
+			if !validator.CronRegex.MatchString(c) {
+				errs = append(errs, fmt.Errorf("invalid cron expression %q", c))
+			}
+
+			if tz, ok := strings.CutPrefix(c, "CRON_TZ="); ok {
+				tz, _, _ = strings.Cut(tz, " ")
+
+				if _, err := time.LoadLocation(tz); err != nil {
+					errs = append(errs, fmt.Errorf("invalid time zone for cron expression %q: %w", c, err))
+				}
+			}
+		}
+
+		for c, policy := range triggers.CronCatchUpPolicies {
+			if policy != string(CronCatchUpSkip) && policy != string(CronCatchUpFireOnce) {
+				errs = append(errs, fmt.Errorf("invalid catch-up policy %q for cron expression %q", policy, c))
+			}
+		}
+
+		for event, filter := range triggers.EventFilters {
+			if filter == "" {
+				continue
+			}
+
+			if _, err := celParser.ParseEventFilter(filter); err != nil {
+				errs = append(errs, fmt.Errorf("invalid filter for event %s: %w", event, err))
+			}
+		}
+	}
+
+	if c := job.Concurrency; c != nil {
+		switch {
+		case c.fn == nil && c.expr == nil:
+			errs = append(errs, fmt.Errorf("concurrency must set a function or an expression"))
+		case c.expr != nil:
+			if _, err := celParser.ParseWorkflowString(*c.expr); err != nil {
+				errs = append(errs, fmt.Errorf("invalid concurrency expression %q: %w", *c.expr, err))
+			}
+		}
+
+		if c.maxRuns != nil && *c.maxRuns <= 0 {
+			errs = append(errs, fmt.Errorf("concurrency max runs must be greater than 0"))
+		}
+	}
+
+	errs = append(errs, validateSteps(job.Steps)...)
+
+	if job.OnFailure != nil {
+		for _, err := range validateSteps(job.OnFailure.Steps) {
+			errs = append(errs, fmt.Errorf("on failure job: %w", err))
+		}
+	}
+
+	return errs
+}
+
+// validateSteps checks the steps of a job, and that they form a DAG in which every step can run.
+func validateSteps(steps []*WorkflowStep) []error {
+	errs := []error{}
+
+	if len(steps) == 0 {
+		return append(errs, fmt.Errorf("no steps"))
+	}
+
+	ids := make([]string, len(steps))
+	parents := map[string][]string{}
+
+	for i, step := range steps {
+		if step == nil {
+			errs = append(errs, fmt.Errorf("step %d is nil", i))
+			continue
+		}
+
+		// the name of a step without a function can't be derived from the function
+		if step.Function == nil && step.Name == "" {
+			ids[i] = fmt.Sprintf("step%d", i)
+		} else {
+			ids[i] = step.GetStepId(i)
+		}
+
+		id := ids[i]
+
+		if _, ok := parents[id]; ok {
+			errs = append(errs, fmt.Errorf("step %s is defined more than once", id))
+			continue
+		}
+
+		parents[id] = step.Parents
+
+		for _, err := range validateStep(step, id) {
+			errs = append(errs, fmt.Errorf("step %s: %w", id, err))
+		}
+	}
+
+	// steps with a parent which doesn't exist can never run
+	blocked := map[string]bool{}
+
+	for i, step := range steps {
+		if step == nil {
+			continue
+		}
+
+		for _, parent := range step.Parents {
+			if parent == ids[i] {
+				errs = append(errs, fmt.Errorf("step %s: step is its own parent", ids[i]))
+				blocked[ids[i]] = true
+			} else if _, ok := parents[parent]; !ok {
+				errs = append(errs, fmt.Errorf("step %s: parent %s does not exist", ids[i], parent))
+				blocked[ids[i]] = true
+			}
+		}
+	}
+
+	runnable := runnableSteps(parents)
+
+	if len(runnable) == len(parents) {
+		return errs
+	}
+
+	for _, cycle := range findCycles(parents, runnable) {
+		errs = append(errs, fmt.Errorf("steps %s form a cycle", strings.Join(cycle, " -> ")))
+
+		for _, id := range cycle {
+			blocked[id] = true
+		}
+	}
+
+	unreachable := []string{}
+
+	for id := range parents {
+		if !runnable[id] && !blocked[id] {
+			unreachable = append(unreachable, id)
+		}
+	}
+
+	sort.Strings(unreachable)
+
+	for _, id := range unreachable {
+		errs = append(errs, fmt.Errorf("step %s is unreachable because one of its ancestors can never run", id))
+	}
+
+	return errs
+}
+
+func validateStep(step *WorkflowStep, id string) []error {
+	errs := []error{}
+
+	if !validator.NameRegex.MatchString(id) {
+		errs = append(errs, fmt.Errorf("invalid name, names may only contain letters, numbers, '.', '-' and '_'"))
+	}
+
+	if step.Function == nil {
+		errs = append(errs, fmt.Errorf("no function"))
+	} else {
+		fnType := reflect.TypeOf(step.Function)
+
+		if _, err := decodeFnArgTypes(fnType); err != nil {
+			errs = append(errs, err)
+		} else if _, err := decodeFnReturnTypes(fnType); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if step.Timeout != "" {
+		if _, err := time.ParseDuration(step.Timeout); err != nil {
+			errs = append(errs, fmt.Errorf("invalid timeout %q: %w", step.Timeout, err))
+		}
+	}
+
+	if step.Retries < 0 {
+		errs = append(errs, fmt.Errorf("retries must not be negative"))
+	}
+
+	if step.RetryBackoffFactor != nil && (*step.RetryBackoffFactor < 1 || *step.RetryBackoffFactor > 1000) {
+		errs = append(errs, fmt.Errorf("retry backoff factor must be between 1 and 1000"))
+	}
+
+	if step.RetryMaxBackoffSeconds != nil && (*step.RetryMaxBackoffSeconds < 1 || *step.RetryMaxBackoffSeconds > 86400) {
+		errs = append(errs, fmt.Errorf("retry max backoff must be between 1 and 86400 seconds"))
+	}
+
+	if step.RetryBackoffBase != nil && (*step.RetryBackoffBase < time.Millisecond || *step.RetryBackoffBase > 24*time.Hour) {
+		errs = append(errs, fmt.Errorf("retry backoff base must be between 1ms and 24h"))
+	}
+
+	if step.RetryBackoffJitter != nil && (*step.RetryBackoffJitter < 0 || *step.RetryBackoffJitter > 1) {
+		errs = append(errs, fmt.Errorf("retry backoff jitter must be between 0 and 1"))
+	}
+
+	if (step.RetryBackoffBase != nil || step.RetryBackoffJitter != nil) && step.RetryBackoffFactor == nil {
+		errs = append(errs, fmt.Errorf("retry backoff base and jitter require a retry backoff factor"))
+	}
+
+	if step.Join < 0 || int(step.Join) > len(step.Parents) {
+		errs = append(errs, fmt.Errorf("join must be between 1 and the number of parents (%d)", len(step.Parents)))
+	}
+
+	return errs
+}
+
+// runnableSteps returns the steps which run when the workflow is triggered, which are the steps whose
+// parents all exist and are runnable.
+func runnableSteps(parents map[string][]string) map[string]bool {
+	runnable := map[string]bool{}
+
+	for {
+		added := false
+
+		for id, stepParents := range parents {
+			if runnable[id] {
+				continue
+			}
+
+			ok := true
+
+			for _, parent := range stepParents {
+				if !runnable[parent] {
+					ok = false
+					break
+				}
+			}
+
+			if ok {
+				runnable[id] = true
+				added = true
+			}
+		}
+
+		if !added {
+			return runnable
+		}
+	}
+}
+
+// findCycles returns the cycles between the steps which are not runnable. Each cycle is listed from a
+// step to its parents and back to the first step.
+func findCycles(parents map[string][]string, runnable map[string]bool) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := map[string]int{}
+	path := []string{}
+	cycles := [][]string{}
+
+	var visit func(id string)
+
+	visit = func(id string) {
+		state[id] = visiting
+		path = append(path, id)
+
+		for _, parent := range parents[id] {
+			if _, ok := parents[parent]; !ok || runnable[parent] || parent == id {
+				continue
+			}
+
+			switch state[parent] {
+			case unvisited:
+				visit(parent)
+			case visiting:
+				start := 0
+
+				for i, p := range path {
+					if p == parent {
+						start = i
+						break
+					}
+				}
+
+				cycle := append([]string{}, path[start:]...)
+				cycles = append(cycles, append(cycle, parent))
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[id] = done
+	}
+
+	ids := make([]string, 0, len(parents))
+
+	for id := range parents {
+		ids = append(ids, id)
+	}
+
+	// visit the steps in a stable order so the same cycles are reported on every run
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if !runnable[id] && state[id] == unvisited {
+			visit(id)
+		}
+	}
+
+	return cycles
+}
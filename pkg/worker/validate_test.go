@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	b := NewWorkflow("valid").On(Cron("*/5 * * * *"))
+	start := b.AddStep(Fn(noop).SetName("start"))
+	start.Parallel(Fn(noop).SetName("left"), Fn(noop).SetName("right"))
+
+	assert.NoError(t, Validate(b.Build()))
+
+	invalid := &WorkflowJob{
+		Name:            "invalid",
+		On:              Cron("not a cron"),
+		ScheduleTimeout: "soon",
+		RunTimeout:      "-1h",
+		Steps: []*WorkflowStep{
+			Fn(noop).SetName("start"),
+			Fn(noop).SetName("start"),
+			Fn(func() {}).SetName("bad-signature"),
+			Fn(noop).SetName("orphan").AddParents("missing"),
+			Fn(noop).SetName("a").AddParents("b"),
+			Fn(noop).SetName("b").AddParents("a"),
+			Fn(noop).SetName("after-cycle").AddParents("start", "b"),
+			Fn(noop).SetName("after-orphan").AddParents("orphan"),
+			Fn(noop).SetName("slow").SetTimeout("forever"),
+			Fn(noop).SetName("any-of").AddParents("start").SetJoin(JoinN(2)),
+			Fn(noop).SetName("jittery").SetRetries(3).SetRetryBackoffJitter(1.5),
+		},
+	}
+
+	err := Validate(invalid, &WorkflowJob{Name: "invalid", Steps: []*WorkflowStep{Fn(noop)}})
+	require.Error(t, err)
+
+	var merr *multierror.Error
+	require.ErrorAs(t, err, &merr)
+
+	messages := []string{}
+
+	for _, err := range merr.Errors {
+		messages = append(messages, err.Error())
+	}
+
+	assert.ElementsMatch(t, []string{
+		`workflow invalid: invalid schedule timeout "soon": time: invalid duration "soon"`,
+		`workflow invalid: run timeout "-1h" must be positive`,
+		`workflow invalid: invalid cron expression "not a cron"`,
+		`workflow invalid: step start is defined more than once`,
+		`workflow invalid: step bad-signature: method must have one or two arguments`,
+		`workflow invalid: step slow: invalid timeout "forever": time: invalid duration "forever"`,
+		`workflow invalid: step any-of: join must be between 1 and the number of parents (1)`,
+		`workflow invalid: step jittery: retry backoff jitter must be between 0 and 1`,
+		`workflow invalid: step jittery: retry backoff base and jitter require a retry backoff factor`,
+		`workflow invalid: step orphan: parent missing does not exist`,
+		`workflow invalid: steps a -> b -> a form a cycle`,
+		`workflow invalid: step after-cycle is unreachable because one of its ancestors can never run`,
+		`workflow invalid: step after-orphan is unreachable because one of its ancestors can never run`,
+		`workflow invalid is defined more than once`,
+	}, messages)
+}
+
+func TestValidateCron(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	valid := NewWorkflow("valid").On(Cron("0 9 * * *").In("Europe/Berlin").CatchUp(CronCatchUpFireOnce))
+	valid.AddStep(Fn(noop).SetName("start"))
+
+	assert.NoError(t, Validate(valid.Build()))
+
+	invalid := NewWorkflow("invalid").On(Cron("0 9 * * *").In("Europe/Nowhere").CatchUp("ALWAYS"))
+	invalid.AddStep(Fn(noop).SetName("start"))
+
+	err := Validate(invalid.Build())
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), `invalid time zone for cron expression "CRON_TZ=Europe/Nowhere 0 9 * * *"`)
+	assert.Contains(t, err.Error(), `invalid catch-up policy "ALWAYS" for cron expression "CRON_TZ=Europe/Nowhere 0 9 * * *"`)
+}
@@ -8,9 +8,11 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/hatchet-dev/hatchet/pkg/client"
 	"github.com/hatchet-dev/hatchet/pkg/client/compute"
@@ -81,10 +83,36 @@ type Worker struct {
 
 	name string
 
+	// guards the actions, retry policies and registered workflows, which can change while the worker
+	// is running
+	actionsMu sync.RWMutex
+
 	actions ActionRegistry
 
+	// the retry policies of registered steps, keyed by action id
+	retryPolicies map[string]*retryPolicy
+
+	// the redaction paths of the outputs of registered steps, keyed by action id
+	redactPaths map[string][]redactPath
+
+	// the redaction paths of the inputs of registered workflows, keyed by namespaced workflow name
+	inputRedactPaths map[string][]redactPath
+
 	registered_workflows map[string]bool
 
+	// the action ids of each workflow registered on the worker, keyed by namespaced workflow name
+	workflowActions map[string][]string
+
+	// the namespaced name of the workflow which registered each action, keyed by action id
+	actionWorkflows map[string]string
+
+	// actions of deregistered workflows. These are kept in the registry so that step runs which were
+	// assigned before the engine was updated can still run, but the worker no longer listens for them.
+	deregisteredActions map[string]bool
+
+	// serializes updates of the worker's actions on the engine
+	syncMu sync.Mutex
+
 	l *zerolog.Logger
 
 	cancelMap sync.Map
@@ -99,10 +127,49 @@ type Worker struct {
 
 	maxRuns *int
 
+	// the maximum number of runs of each workflow on the worker, keyed by workflow name without namespace
+	workflowMaxRuns map[string]int
+
+	// the maximum number of runs in progress on the worker, 0 if not limited
+	maxInFlightRuns int
+
+	// the number of step and get group key runs in progress on the worker
+	inFlightRuns atomic.Int64
+
+	// the number of chunks which HatchetContext.StreamOutput buffers per step run
+	outputStreamBufferSize int
+
 	initActionNames []string
 
 	labels map[string]interface{}
 
+	lifecycleListeners []LifecycleListener
+
+	// tracks the runs in progress if the worker has an idle timeout, nil otherwise
+	idle *idleTracker
+
+	reconnectInterval time.Duration
+
+	heartbeatInterval time.Duration
+
+	// how long the worker waits for its runs to finish when it is stopped, 0 if runs are cancelled
+	// right away
+	gracefulShutdownTimeout time.Duration
+
+	// whether the worker is draining, in which case it does not start new runs
+	draining atomic.Bool
+
+	// whether runs were assigned to the worker while it was draining, which it did not start
+	skippedRuns atomic.Bool
+
+	// batches the events of step runs, nil if step events are sent one by one
+	stepEvents *stepEventBatcher
+
+	// the namespace of the worker within the namespace of the client, empty if not set
+	subNamespace string
+
+	tracer trace.Tracer
+
 	id *string
 }
 
@@ -117,9 +184,32 @@ type WorkerOpts struct {
 	alerter      errors.Alerter
 	maxRuns      *int
 
+	workflowMaxRuns map[string]int
+
+	maxInFlightRuns int
+
+	outputStreamBufferSize int
+
 	actions []string
 
 	labels map[string]interface{}
+
+	lifecycleListeners []LifecycleListener
+
+	idleTimeout       *time.Duration
+	reconnectInterval time.Duration
+	heartbeatInterval time.Duration
+
+	gracefulShutdownTimeout time.Duration
+
+	batchStepEvents        bool
+	stepEventFlushInterval time.Duration
+	stepEventMaxBatchSize  int
+
+	namespace string
+
+	// tracerProvider is nil if the global tracer provider is used
+	tracerProvider trace.TracerProvider
 }
 
 func defaultWorkerOpts() *WorkerOpts {
@@ -182,12 +272,79 @@ func WithMaxRuns(maxRuns int) WorkerOpt {
 	}
 }
 
+// WithWorkflowMaxRuns limits the number of runs of the steps of a workflow which the worker runs at a time.
+// The runs still count towards WithMaxRuns, so limiting heavy workflows to fewer runs than the worker has
+// leaves the remaining slots for other workflows. The engine doesn't assign more runs of the workflow to
+// the worker while the limit is reached.
+func WithWorkflowMaxRuns(workflow string, maxRuns int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		if maxRuns <= 0 {
+			return
+		}
+
+		if opts.workflowMaxRuns == nil {
+			opts.workflowMaxRuns = map[string]int{}
+		}
+
+		opts.workflowMaxRuns[workflow] = maxRuns
+	}
+}
+
+// WithMaxInFlightRuns limits the number of runs which the worker accepts at the same time. The engine
+// doesn't assign more runs to the worker while the limit is reached, so they stay queued and can be
+// assigned to other workers. Runs can't release their slot while the limit is set, so
+// HatchetContext.ReleaseSlot returns ErrSlotNotReleased. If WithMaxRuns is set as well, the worker
+// accepts the lower of the two numbers of runs.
+func WithMaxInFlightRuns(n int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		if n > 0 {
+			opts.maxInFlightRuns = n
+		}
+	}
+}
+
+// WithOutputStreamBufferSize sets the number of chunks which HatchetContext.StreamOutput buffers for each
+// step run while they are sent to the engine. StreamOutput blocks while the buffer is full. Defaults to
+// 64 chunks.
+func WithOutputStreamBufferSize(n int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		if n > 0 {
+			opts.outputStreamBufferSize = n
+		}
+	}
+}
+
+// WithStepEventBatching sends the started, completed and failed events of step runs to the engine in
+// batches, which reduces the number of requests to the engine when the worker runs many short steps. A
+// batch is sent when it has maxBatchSize events, or flushInterval after its first event, whichever comes
+// first. Steps still wait until the engine has received their events, so batching adds up to
+// flushInterval to the duration of each step run. Defaults to a flush interval of 20ms and batches of up
+// to 100 events if the values are not positive. Engines which don't support batches receive the events
+// one by one.
+func WithStepEventBatching(flushInterval time.Duration, maxBatchSize int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.batchStepEvents = true
+		opts.stepEventFlushInterval = flushInterval
+		opts.stepEventMaxBatchSize = maxBatchSize
+	}
+}
+
 func WithLabels(labels map[string]interface{}) WorkerOpt {
 	return func(opts *WorkerOpts) {
 		opts.labels = labels
 	}
 }
 
+// WithNamespace scopes the workflows of the worker to a namespace within the namespace of the client.
+// The namespace is prepended to the names and event triggers of the workflows registered on the worker,
+// and to the names of the child workflows spawned by their steps, so that workers of different teams can
+// register workflows with the same names on a tenant.
+func WithNamespace(namespace string) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.namespace = namespace
+	}
+}
+
 // NewWorker creates a new worker instance
 func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 	opts := defaultWorkerOpts()
@@ -207,19 +364,48 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 	}
 
 	w := &Worker{
-		client:               opts.client,
-		name:                 opts.name,
-		l:                    opts.l,
-		actions:              ActionRegistry{},
-		alerter:              opts.alerter,
-		middlewares:          mws,
-		maxRuns:              opts.maxRuns,
-		initActionNames:      opts.actions,
-		labels:               opts.labels,
-		registered_workflows: map[string]bool{},
+		client:                  opts.client,
+		name:                    opts.name,
+		l:                       opts.l,
+		actions:                 ActionRegistry{},
+		retryPolicies:           map[string]*retryPolicy{},
+		redactPaths:             map[string][]redactPath{},
+		inputRedactPaths:        map[string][]redactPath{},
+		alerter:                 opts.alerter,
+		middlewares:             mws,
+		maxRuns:                 opts.maxRuns,
+		workflowMaxRuns:         opts.workflowMaxRuns,
+		maxInFlightRuns:         opts.maxInFlightRuns,
+		outputStreamBufferSize:  opts.outputStreamBufferSize,
+		initActionNames:         opts.actions,
+		labels:                  opts.labels,
+		registered_workflows:    map[string]bool{},
+		workflowActions:         map[string][]string{},
+		actionWorkflows:         map[string]string{},
+		deregisteredActions:     map[string]bool{},
+		lifecycleListeners:      opts.lifecycleListeners,
+		reconnectInterval:       opts.reconnectInterval,
+		heartbeatInterval:       opts.heartbeatInterval,
+		gracefulShutdownTimeout: opts.gracefulShutdownTimeout,
+		tracer:                  newWorkerTracer(opts.tracerProvider),
 	}
 
-	mws.add(w.panicMiddleware)
+	if opts.namespace != "" {
+		w.subNamespace = opts.namespace + "_"
+	}
+
+	if opts.idleTimeout != nil {
+		w.idle = newIdleTracker(*opts.idleTimeout)
+	}
+
+	if opts.batchStepEvents {
+		w.stepEvents = newStepEventBatcher(
+			func() client.DispatcherClient { return w.client.Dispatcher() },
+			w.l,
+			opts.stepEventFlushInterval,
+			opts.stepEventMaxBatchSize,
+		)
+	}
 
 	// TODO: Remove integrations
 	// register all integrations
@@ -241,13 +427,43 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 	return w, nil
 }
 
+// Use adds middleware which runs for every step of the worker, before the middleware of the step's
+// service. See MiddlewareFunc for the order in which middleware runs.
 func (w *Worker) Use(mws ...MiddlewareFunc) {
 	w.middlewares.add(mws...)
 }
 
+// UseAt inserts worker middleware at the given position of the worker middleware, so that it runs
+// before the middleware which is currently at that position. An index of 0 makes the middleware the
+// outermost worker middleware, and an index past the end appends it like Use.
+func (w *Worker) UseAt(index int, mws ...MiddlewareFunc) {
+	w.middlewares.insert(index, mws...)
+}
+
+// UseWithInfo adds worker middleware like Use, which also receives the StepRunInfo of the step run.
+// After next returns, the info contains the error which the step returned and its classification,
+// so that the middleware can, for example, record metrics per workflow and error code.
+func (w *Worker) UseWithInfo(mws ...InfoMiddlewareFunc) {
+	w.middlewares.add(withInfo(mws)...)
+}
+
+// namespace returns the prefix of the names of the workflows of the worker, which is the namespace of the
+// client followed by the namespace of the worker.
+func (w *Worker) namespace() string {
+	return w.client.Namespace() + w.subNamespace
+}
+
+// namespaced prepends the namespace of the worker to the workflow name, unless it is already namespaced.
+func (w *Worker) namespaced(workflowName string) string {
+	if ns := w.namespace(); ns != "" && !strings.HasPrefix(workflowName, ns) {
+		return ns + workflowName
+	}
+
+	return workflowName
+}
+
 func (w *Worker) NewService(name string) *Service {
-	namespace := w.client.Namespace()
-	namespaced := namespace + name
+	namespaced := w.namespace() + name
 
 	svc := &Service{
 		Name:   namespaced,
@@ -266,11 +482,189 @@ func (w *Worker) RegisterWorkflow(workflow workflowConverter) error {
 		return fmt.Errorf("workflow must have an trigger defined via the `On` field")
 	}
 
+	w.actionsMu.Lock()
 	w.registered_workflows[wf.Name] = true
+	w.actionsMu.Unlock()
 
 	return w.On(workflow.ToWorkflowTrigger(), workflow)
 }
 
+// Register registers a workflow on the worker. Unlike most options of the worker, it can be called after
+// the worker has been started, in which case the engine starts assigning the workflow's steps to the
+// running worker.
+func (w *Worker) Register(workflow workflowConverter) error {
+	return w.RegisterWorkflow(workflow)
+}
+
+// Deregister removes a workflow from the worker, which can be running. Step runs of the workflow which
+// are already running on the worker finish normally, but the engine stops assigning new step runs of
+// the workflow to the worker. The workflow itself is not deleted, so other workers which registered it
+// keep running it.
+func (w *Worker) Deregister(name string) error {
+	namespaced := w.namespace() + name
+
+	w.actionsMu.Lock()
+
+	actionIds, ok := w.workflowActions[namespaced]
+
+	if !ok {
+		w.actionsMu.Unlock()
+		return fmt.Errorf("workflow %s is not registered on this worker", name)
+	}
+
+	delete(w.workflowActions, namespaced)
+	delete(w.registered_workflows, name)
+
+	// actions can be shared between workflows, so we only stop listening for actions which are not
+	// used by any of the remaining workflows
+	inUse := map[string]bool{}
+
+	for workflowName, ids := range w.workflowActions {
+		for _, id := range ids {
+			inUse[id] = true
+
+			if w.actionWorkflows[id] == namespaced {
+				w.actionWorkflows[id] = workflowName
+			}
+		}
+	}
+
+	for _, id := range actionIds {
+		if !inUse[id] {
+			w.deregisteredActions[id] = true
+		}
+	}
+
+	w.actionsMu.Unlock()
+
+	return w.syncActions()
+}
+
+func (w *Worker) isWorkflowRegistered(name string) bool {
+	w.actionsMu.RLock()
+	defer w.actionsMu.RUnlock()
+
+	return w.registered_workflows[name]
+}
+
+func (w *Worker) getAction(actionId string) (Action, bool) {
+	w.actionsMu.RLock()
+	defer w.actionsMu.RUnlock()
+
+	action, ok := w.actions[actionId]
+
+	return action, ok
+}
+
+func (w *Worker) getRetryPolicy(actionId string) (*retryPolicy, bool) {
+	w.actionsMu.RLock()
+	defer w.actionsMu.RUnlock()
+
+	policy, ok := w.retryPolicies[actionId]
+
+	return policy, ok
+}
+
+// listenableActions returns the sorted names of the actions which the worker asks the engine to assign
+// to it. The caller must hold actionsMu.
+func (w *Worker) listenableActions() []string {
+	actionNames := []string{}
+
+	for _, action := range w.actions {
+		if w.deregisteredActions[action.Name()] {
+			continue
+		}
+
+		if w.client.RunnableActions() != nil {
+			if !slices.Contains(w.client.RunnableActions(), action.Name()) {
+				continue
+			}
+		}
+
+		actionNames = append(actionNames, action.Name())
+	}
+
+	slices.Sort(actionNames)
+
+	return actionNames
+}
+
+// slotGroups returns a slot group for each workflow with a max number of runs, with the actions of the
+// workflow which the worker listens for. The caller must hold actionsMu.
+func (w *Worker) slotGroups() []client.WorkerSlotGroup {
+	if len(w.workflowMaxRuns) == 0 {
+		return nil
+	}
+
+	listenable := w.listenableActions()
+
+	workflowNames := make([]string, 0, len(w.workflowMaxRuns))
+
+	for workflowName := range w.workflowMaxRuns {
+		workflowNames = append(workflowNames, workflowName)
+	}
+
+	slices.Sort(workflowNames)
+
+	slotGroups := []client.WorkerSlotGroup{}
+
+	for _, workflowName := range workflowNames {
+		namespaced := w.namespaced(workflowName)
+		actions := []string{}
+
+		for _, actionId := range w.workflowActions[namespaced] {
+			if slices.Contains(listenable, actionId) {
+				actions = append(actions, actionId)
+			}
+		}
+
+		// workflows which are not registered yet don't have a slot group
+		if len(actions) == 0 {
+			continue
+		}
+
+		slices.Sort(actions)
+
+		slotGroups = append(slotGroups, client.WorkerSlotGroup{
+			Name:    namespaced,
+			MaxRuns: w.workflowMaxRuns[workflowName],
+			Actions: actions,
+		})
+	}
+
+	return slotGroups
+}
+
+// syncActions replaces the actions of the worker on the engine. It is a no-op if the worker has not
+// been started yet, since all actions are sent when the worker registers.
+func (w *Worker) syncActions() error {
+	w.syncMu.Lock()
+	defer w.syncMu.Unlock()
+
+	w.actionsMu.RLock()
+	id := w.id
+	actionNames := w.listenableActions()
+	slotGroups := w.slotGroups()
+	w.actionsMu.RUnlock()
+
+	if id == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := w.client.Dispatcher().UpdateWorkerActions(ctx, *id, actionNames, slotGroups)
+
+	if err != nil {
+		return fmt.Errorf("could not update actions of worker: %w", err)
+	}
+
+	w.l.Debug().Msgf("worker %s is listening for actions: %v", w.name, actionNames)
+
+	return nil
+}
+
 // Deprecated: Use RegisterWorkflow instead
 func (w *Worker) On(t triggerConverter, workflow workflowConverter) error {
 	svcName := workflow.ToWorkflow("", "").Name
@@ -309,6 +703,13 @@ func (w *Worker) RegisterAction(actionId string, method any) error {
 func (w *Worker) registerAction(service, verb string, method any, compute *compute.Compute) error {
 	actionId := fmt.Sprintf("%s:%s", service, verb)
 
+	w.actionsMu.Lock()
+	defer w.actionsMu.Unlock()
+
+	// registering an action of a deregistered workflow again makes the worker listen for it again
+	deregistered := w.deregisteredActions[actionId]
+	delete(w.deregisteredActions, actionId)
+
 	// if the service is "concurrency", then this is a special action
 	if service == "concurrency" {
 		w.actions[actionId] = &actionImpl{
@@ -328,8 +729,9 @@ func (w *Worker) registerAction(service, verb string, method any, compute *compu
 		return fmt.Errorf("could not get function from method: %w", err)
 	}
 
-	// if action has already been registered, ensure that the method is the same
-	if currMethod, ok := w.actions[actionId]; ok {
+	// if action has already been registered, ensure that the method is the same. Actions of deregistered
+	// workflows can be replaced.
+	if currMethod, ok := w.actions[actionId]; ok && !deregistered {
 		if reflect.ValueOf(currMethod.MethodFn()).Pointer() != reflect.ValueOf(method).Pointer() {
 			return fmt.Errorf("action %s is already registered with function %s", actionId, getFnName(currMethod.MethodFn()))
 		}
@@ -350,8 +752,11 @@ func (w *Worker) registerAction(service, verb string, method any, compute *compu
 // worker could not be started.
 func (w *Worker) Start() (func() error, error) {
 	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
 
 	go func() {
+		defer close(done)
+
 		err := w.startBlocking(ctx)
 
 		if err != nil {
@@ -365,6 +770,11 @@ func (w *Worker) Start() (func() error, error) {
 
 		w.l.Debug().Msgf("worker %s is stopping...", w.name)
 
+		// a draining worker keeps running its steps, so the process must not exit before it has drained
+		if w.gracefulShutdownTimeout > 0 {
+			<-done
+		}
+
 		return nil
 	}
 
@@ -377,37 +787,76 @@ func (w *Worker) Run(ctx context.Context) error {
 	return w.startBlocking(ctx)
 }
 
-func (w *Worker) startBlocking(ctx context.Context) error {
-	actionNames := []string{}
-
-	for _, action := range w.actions {
+// InFlightRuns returns the number of step and get group key runs which are in progress on the worker.
+func (w *Worker) InFlightRuns() int {
+	return int(w.inFlightRuns.Load())
+}
 
-		if w.client.RunnableActions() != nil {
-			if !slices.Contains(w.client.RunnableActions(), action.Name()) {
-				continue
-			}
-		}
+// slots returns the number of runs the worker registers with the engine, or nil to use the default of
+// the engine.
+func (w *Worker) slots() *int {
+	if w.maxInFlightRuns == 0 {
+		return w.maxRuns
+	}
 
-		actionNames = append(actionNames, action.Name())
+	if w.maxRuns != nil && *w.maxRuns < w.maxInFlightRuns {
+		return w.maxRuns
 	}
 
+	slots := w.maxInFlightRuns
+
+	return &slots
+}
+
+func (w *Worker) startBlocking(ctx context.Context) error {
+	w.actionsMu.RLock()
+	actionNames := w.listenableActions()
+	slotGroups := w.slotGroups()
+	w.actionsMu.RUnlock()
+
 	w.l.Debug().Msgf("worker %s is listening for actions: %v", w.name, actionNames)
 
 	_ = NewManagedCompute(&w.actions, w.client, 1)
 
-	listener, id, err := w.client.Dispatcher().GetActionListener(ctx, &client.GetActionListenerRequest{
-		WorkerName: w.name,
-		Actions:    actionNames,
-		MaxRuns:    w.maxRuns,
-		Labels:     w.labels,
+	// a draining worker keeps listening after ctx is cancelled, so that it keeps sending heartbeats and
+	// receives the cancellations of its runs
+	listenCtx := ctx
+
+	if w.gracefulShutdownTimeout > 0 {
+		listenCtx = context.WithoutCancel(ctx)
+	}
+
+	listener, id, err := w.client.Dispatcher().GetActionListener(listenCtx, &client.GetActionListenerRequest{
+		WorkerName:        w.name,
+		Actions:           actionNames,
+		MaxRuns:           w.slots(),
+		SlotGroups:        slotGroups,
+		Labels:            w.labels,
+		OnConnectionEvent: w.onConnectionEvent,
+		RetryInterval:     w.reconnectInterval,
+		HeartbeatInterval: w.heartbeatInterval,
 	})
 
+	w.actionsMu.Lock()
 	w.id = id
+	currActionNames := w.listenableActions()
+	w.actionsMu.Unlock()
 
 	if err != nil {
 		return fmt.Errorf("could not get action listener: %w", err)
 	}
 
+	// workflows may have been registered or deregistered while the worker was registering
+	if !slices.Equal(actionNames, currActionNames) {
+		if err := w.syncActions(); err != nil {
+			w.l.Error().Err(err).Msg("could not update actions of worker")
+		}
+	}
+
+	w.emitLifecycleEvent(LifecycleEvent{
+		Type: LifecycleEventConnected,
+	})
+
 	defer func() {
 		err := listener.Unregister()
 
@@ -416,7 +865,7 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 		}
 	}()
 
-	listenerCtx, cancel := context.WithCancel(ctx)
+	listenerCtx, cancel := context.WithCancel(listenCtx)
 	defer cancel()
 
 	actionCh, errCh, err := listener.Actions(listenerCtx)
@@ -434,7 +883,37 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 					return
 				}
 
+				// cancellations do not keep the worker from becoming idle
+				countsAsRun := action.ActionType != client.ActionTypeCancelStepRun
+
+				// runs which are assigned before the engine stopped assigning runs to a draining worker
+				// are not started, they are released back to the queue once the worker has drained
+				if countsAsRun && w.draining.Load() {
+					w.l.Debug().Msgf("worker %s is draining, not starting action %s", w.name, action.ActionId)
+					w.skippedRuns.Store(true)
+
+					continue
+				}
+
+				if countsAsRun {
+					// runs which are assigned after the idle timeout expired are not started, they are
+					// released back to the queue once the worker stops
+					if !w.idle.runStarted() {
+						w.l.Debug().Msgf("worker %s is stopping after its idle timeout, not starting action %s", w.name, action.ActionId)
+						w.skippedRuns.Store(true)
+
+						continue
+					}
+
+					w.inFlightRuns.Add(1)
+				}
+
 				go func(action *client.Action) {
+					if countsAsRun {
+						defer w.idle.runFinished()
+						defer w.inFlightRuns.Add(-1)
+					}
+
 					err := w.executeAction(context.Background(), action)
 
 					if err != nil {
@@ -443,19 +922,56 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 
 					w.l.Debug().Msgf("action %s completed", action.ActionId)
 				}(action)
-			case <-ctx.Done():
+			case <-listenerCtx.Done():
 				w.l.Debug().Msgf("worker %s received context done, stopping", w.name)
 				return
 			}
 		}
 	}()
 
+	idleCh := w.watchIdle(listenerCtx)
+
 	select {
 	case <-ctx.Done():
 		w.l.Debug().Msgf("worker %s received context done, stopping", w.name)
+
+		w.emitLifecycleEvent(LifecycleEvent{
+			Type: LifecycleEventShuttingDown,
+		})
+
+		if w.gracefulShutdownTimeout > 0 {
+			w.drain(cancel)
+		} else {
+			w.cancelInFlightRuns(CancelReasonShutdown)
+		}
+
+		return nil
+	case <-idleCh:
+		w.l.Info().Msgf("worker %s has been idle for %s, stopping", w.name, w.idle.timeout)
+
+		w.emitLifecycleEvent(LifecycleEvent{
+			Type: LifecycleEventShuttingDown,
+		})
+
+		// the worker must stop sending heartbeats before the runs which it did not start are released, or
+		// they would not be reassigned
+		cancel()
+
+		if w.skippedRuns.Load() {
+			if err := w.client.Dispatcher().DrainWorker(context.Background(), w.workerId(), true); err != nil {
+				w.l.Error().Err(err).Msgf("could not release the runs of worker %s", w.name)
+			}
+		}
+
 		return nil
 	case err := <-errCh:
 		w.l.Error().Err(err).Msg("error from listener")
+
+		w.emitLifecycleEvent(LifecycleEvent{
+			Type: LifecycleEventShuttingDown,
+			Err:  err,
+		})
+
 		return err
 	}
 }
@@ -475,7 +991,7 @@ func (w *Worker) executeAction(ctx context.Context, assignedAction *client.Actio
 
 func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action) error {
 	// send a message that the step run started
-	_, err := w.client.Dispatcher().SendStepActionEvent(
+	err := w.sendStepActionEvent(
 		ctx,
 		w.getActionEvent(assignedAction, client.ActionEventTypeStarted),
 	)
@@ -484,7 +1000,7 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 		return fmt.Errorf("could not send action event: %w", err)
 	}
 
-	action, ok := w.actions[assignedAction.ActionId]
+	action, ok := w.getAction(assignedAction.ActionId)
 
 	if !ok {
 		return fmt.Errorf("job not found")
@@ -496,16 +1012,58 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 		return fmt.Errorf("could not decode args to interface: %w", err)
 	}
 
-	runContext, cancel := context.WithCancel(context.Background())
+	spanCtx, span := w.startStepRunSpan(assignedAction)
+
+	// the error which the step returned, which fails the span of the step run
+	var stepErr error
+
+	defer func() {
+		endStepRunSpan(span, stepErr)
+	}()
+
+	runContext, cancel := context.WithCancelCause(spanCtx)
 
 	w.cancelMap.Store(assignedAction.StepRunId, cancel)
 
-	hCtx, err := newHatchetContext(runContext, assignedAction, w.client, w.l, w)
+	stepContext := context.Context(runContext)
+
+	// the deadline of the step context is the timeout of the step, so that steps can take it into
+	// account
+	var deadline *stepDeadline
+
+	if policy, ok := w.getRetryPolicy(assignedAction.ActionId); ok && policy.timeout > 0 {
+		deadline = newStepDeadline(runContext, policy.timeout)
+		defer deadline.stop()
+
+		stepContext = deadline
+	}
+
+	hCtx, err := newHatchetContext(stepContext, assignedAction, w.client, w.l, w)
 
 	if err != nil {
+		// the step can't run, for example because its offloaded input can't be fetched, so the step run
+		// fails instead of running until it times out
+		failureEvent := w.getActionEvent(assignedAction, client.ActionEventTypeFailed)
+		failureEvent.EventPayload = err.Error()
+
+		if sendErr := w.sendStepActionEvent(ctx, failureEvent); sendErr != nil {
+			w.l.Error().Err(sendErr).Msgf("could not send failure event for step run %s", assignedAction.StepRunId)
+		}
+
 		return fmt.Errorf("could not create hatchet context: %w", err)
 	}
 
+	info, err := w.newStepRunInfo(assignedAction)
+
+	if err != nil {
+		return fmt.Errorf("could not create step run info: %w", err)
+	}
+
+	if hc, ok := hCtx.(*hatchetContext); ok {
+		hc.info = info
+		hc.deadline = deadline
+	}
+
 	// get the action's service
 	svcAny, ok := w.services.Load(action.Service())
 
@@ -515,12 +1073,12 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 
 	svc := svcAny.(*Service)
 
-	// wrap the run with middleware. start by wrapping the global worker middleware, then
-	// the service-specific middleware
-	return w.middlewares.runAll(hCtx, func(ctx HatchetContext) error {
-		return svc.mws.runAll(ctx, func(ctx HatchetContext) error {
-			defer cancel()
+	return w.runWithMiddleware(hCtx, svc, func(ctx HatchetContext) error {
+		defer cancel(nil)
 
+		call := newStepCall(ctx)
+
+		err := svc.interceptors.runAll(ctx, call, func(ctx HatchetContext) error {
 			args := []any{ctx}
 
 			if arg != nil {
@@ -529,46 +1087,74 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 
 			runResults := action.Run(args...)
 
-			// check whether run context was cancelled while action was running
-			select {
-			case <-ctx.Done():
-				w.l.Debug().Msgf("step run %s was cancelled, returning", assignedAction.StepRunId)
-				return nil
-			default:
-			}
-
-			var result any
-
 			if len(runResults) == 2 {
-				result = runResults[0]
+				call.Output = runResults[0]
 			}
 
 			if runResults[len(runResults)-1] != nil {
-				err = runResults[len(runResults)-1].(error)
+				return runResults[len(runResults)-1].(error)
 			}
 
-			if err != nil {
-				return w.sendFailureEvent(ctx, err)
-			}
+			return nil
+		})
 
-			// send a message that the step run completed
-			finishedEvent, err := w.getActionFinishedEvent(assignedAction, result)
+		// send the streamed output before the result, so that subscribers receive all chunks before
+		// the step run completes
+		if hc, ok := hCtx.(*hatchetContext); ok {
+			hc.closeOutputStream()
+		}
 
-			if err != nil {
-				return fmt.Errorf("could not create finished event: %w", err)
-			}
+		// check whether run context was cancelled while action was running. If the deadline of the
+		// context was exceeded instead, we report the result of the action.
+		select {
+		case <-ctx.Done():
+			if ctx.Err() != context.DeadlineExceeded {
+				w.l.Debug().Msgf("step run %s was cancelled, returning", assignedAction.StepRunId)
 
-			_, err = w.client.Dispatcher().SendStepActionEvent(
-				ctx,
-				finishedEvent,
-			)
+				// step runs which are cancelled because the worker stops are released to other workers,
+				// so only cancellations by the engine are acknowledged
+				if CancellationReason(ctx) != CancelReasonShutdown {
+					w.sendCancelledEvent(assignedAction)
+				}
 
-			if err != nil {
-				return fmt.Errorf("could not send action event: %w", err)
+				return nil
 			}
+		default:
+		}
 
-			return nil
-		})
+		// a sleeping step run releases its slot, and is started again once it should resume
+		if suspended, ok := asSuspendError(err); ok {
+			return w.sendSuspendedEvent(ctx, suspended.resumeAt)
+		}
+
+		if err != nil {
+			stepErr = err
+			info.setErr(err)
+
+			return w.sendFailureEvent(ctx, err)
+		}
+
+		// send a message that the step run completed
+		finishedEvent, err := w.getActionFinishedEvent(assignedAction, call.Output)
+
+		if err != nil {
+			return fmt.Errorf("could not create finished event: %w", err)
+		}
+
+		// the deadline of the step context may have passed, so the event is sent with a new context
+		innerCtx, cancelSend := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancelSend()
+
+		err = w.sendStepActionEvent(
+			innerCtx,
+			finishedEvent,
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not send action event: %w", err)
+		}
+
+		return nil
 	})
 }
 
@@ -583,7 +1169,7 @@ func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Ac
 		return fmt.Errorf("could not send action event: %w", err)
 	}
 
-	action, ok := w.actions[assignedAction.ActionId]
+	action, ok := w.getAction(assignedAction.ActionId)
 
 	if !ok {
 		return fmt.Errorf("job not found")
@@ -594,7 +1180,8 @@ func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Ac
 		return fmt.Errorf("action %s is not a concurrency action", action.Name())
 	}
 
-	runContext, cancel := context.WithCancel(context.Background())
+	runContext, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
 
 	w.cancelConcurrencyMap.Store(assignedAction.WorkflowRunId, cancel)
 
@@ -659,13 +1246,38 @@ func (w *Worker) cancelStepRun(ctx context.Context, assignedAction *client.Actio
 
 	w.l.Debug().Msgf("cancelling step run %s", assignedAction.StepRunId)
 
-	cancelFn := cancel.(context.CancelFunc)
+	cancelFn := cancel.(context.CancelCauseFunc)
 
-	cancelFn()
+	engineReason := ""
+
+	if assignedAction.CancelledReason != nil {
+		engineReason = *assignedAction.CancelledReason
+	}
+
+	cancelFn(&cancellationError{
+		reason:       cancelReasonFromEngine(assignedAction.CancelledReason),
+		engineReason: engineReason,
+	})
 
 	return nil
 }
 
+// cancelInFlightRuns cancels the contexts of all step and get group key runs on the worker with the
+// given reason.
+func (w *Worker) cancelInFlightRuns(reason CancelReason) {
+	cause := &cancellationError{
+		reason: reason,
+	}
+
+	cancelAll := func(_, cancel any) bool {
+		cancel.(context.CancelCauseFunc)(cause)
+		return true
+	}
+
+	w.cancelMap.Range(cancelAll)
+	w.cancelConcurrencyMap.Range(cancelAll)
+}
+
 func (w *Worker) getActionEvent(action *client.Action, eventType client.ActionEventType) *client.ActionEvent {
 	timestamp := time.Now().UTC()
 
@@ -681,6 +1293,18 @@ func (w *Worker) getActionFinishedEvent(action *client.Action, output any) (*cli
 
 	event.EventPayload = output
 
+	// redacted fields are removed from the output before it leaves the worker, without modifying the
+	// output of the step
+	if paths := w.getRedactPaths(action.ActionId); len(paths) > 0 {
+		redacted, err := redactOutput(output, paths)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not redact output: %w", err)
+		}
+
+		event.EventPayload = redacted
+	}
+
 	return event, nil
 }
 
@@ -692,6 +1316,19 @@ func (w *Worker) getGroupKeyActionFinishedEvent(action *client.Action, output st
 	return event, nil
 }
 
+// sendCancelledEvent lets the engine know that the step run stopped after it was cancelled. Engines which
+// don't know the event reject it, so errors are only logged.
+func (w *Worker) sendCancelledEvent(assignedAction *client.Action) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := w.sendStepActionEvent(ctx, w.getActionEvent(assignedAction, client.ActionEventTypeCancelled))
+
+	if err != nil {
+		w.l.Debug().Err(err).Msgf("could not acknowledge cancellation of step run %s", assignedAction.StepRunId)
+	}
+}
+
 func (w *Worker) sendFailureEvent(ctx HatchetContext, err error) error {
 	assignedAction := ctx.action()
 
@@ -708,10 +1345,34 @@ func (w *Worker) sendFailureEvent(ctx HatchetContext, err error) error {
 
 	failureEvent.EventPayload = err.Error()
 
+	stepErr, ok := asStepError(err)
+
+	if !ok {
+		stepErr, ok = encodeRegisteredError(err)
+	}
+
+	if ok {
+		failureEvent.ErrorCode = &stepErr.Code
+		failureEvent.ErrorDetails = stepErr.Details
+	}
+
+	// if there are retries left but none of them would start before the workflow run times out, fail
+	// immediately
+	if policy, ok := w.getRetryPolicy(assignedAction.ActionId); ok {
+		retryCount := ctx.RetryCount()
+
+		if retryCount < policy.retries && ctx.RetryBudget() == 0 {
+			shouldNotRetry := true
+
+			failureEvent.EventPayload = fmt.Sprintf("%s: %s", ErrDeadlineWouldBeExceeded, err)
+			failureEvent.ShouldNotRetry = &shouldNotRetry
+		}
+	}
+
 	innerCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	_, err = w.client.Dispatcher().SendStepActionEvent(
+	err = w.sendStepActionEvent(
 		innerCtx,
 		failureEvent,
 	)
@@ -0,0 +1,375 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+type fakeDispatcherClient struct {
+	client.DispatcherClient
+
+	events []*client.ActionEvent
+
+	updatedActions [][]string
+
+	updatedSlotGroups [][]client.WorkerSlotGroup
+
+	overrides map[string][]byte
+
+	// the releaseStepRuns argument of each call to DrainWorker
+	drains []bool
+}
+
+func (d *fakeDispatcherClient) SendStepActionEvent(ctx context.Context, in *client.ActionEvent) (*client.ActionEventResponse, error) {
+	d.events = append(d.events, in)
+
+	return &client.ActionEventResponse{}, nil
+}
+
+func (d *fakeDispatcherClient) PutOverridesData(ctx context.Context, stepRunId string, path string, value []byte) error {
+	if d.overrides == nil {
+		d.overrides = map[string][]byte{}
+	}
+
+	d.overrides[path] = value
+	return nil
+}
+
+func (d *fakeDispatcherClient) UpdateWorkerActions(ctx context.Context, workerId string, actions []string, slotGroups []client.WorkerSlotGroup) error {
+	d.updatedActions = append(d.updatedActions, actions)
+	d.updatedSlotGroups = append(d.updatedSlotGroups, slotGroups)
+	return nil
+}
+
+func (d *fakeDispatcherClient) DrainWorker(ctx context.Context, workerId string, releaseStepRuns bool) error {
+	d.drains = append(d.drains, releaseStepRuns)
+	return nil
+}
+
+type fakeAdminClient struct {
+	client.AdminClient
+
+	// the JSON encoded inputs of the spawned child workflows, keyed by workflow name
+	childInputs map[string]string
+
+	// the options of the spawned child workflows, keyed by workflow name
+	childOpts map[string]*client.ChildWorkflowOpts
+}
+
+func (a *fakeAdminClient) PutWorkflow(workflow *types.Workflow, opts ...client.PutOptFunc) error {
+	return nil
+}
+
+func (a *fakeAdminClient) RunChildWorkflow(workflowName string, input interface{}, opts *client.ChildWorkflowOpts) (string, error) {
+	inputBytes, err := json.Marshal(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if a.childInputs == nil {
+		a.childInputs = map[string]string{}
+	}
+
+	a.childInputs[workflowName] = string(inputBytes)
+
+	if a.childOpts == nil {
+		a.childOpts = map[string]*client.ChildWorkflowOpts{}
+	}
+
+	a.childOpts[workflowName] = opts
+
+	return "run-of-" + workflowName, nil
+}
+
+func (a *fakeAdminClient) RunChildWorkflows(workflows []*client.RunChildWorkflowsOpts) ([]string, error) {
+	ids := make([]string, 0, len(workflows))
+
+	for _, wf := range workflows {
+		id, err := a.RunChildWorkflow(wf.WorkflowName, wf.Input, wf.Opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+type fakeSubscribeClient struct {
+	client.SubscribeClient
+}
+
+func (s *fakeSubscribeClient) SubscribeToWorkflowRunEvents(ctx context.Context) (*client.WorkflowRunsListener, error) {
+	return nil, nil
+}
+
+type fakeClient struct {
+	client.Client
+
+	admin *fakeAdminClient
+
+	dispatcher *fakeDispatcherClient
+
+	event *fakeEventClient
+
+	payloadStore client.PayloadStore
+}
+
+func (c *fakeClient) Namespace() string {
+	return ""
+}
+
+func (c *fakeClient) RunnableActions() []string {
+	return nil
+}
+
+func (c *fakeClient) Admin() client.AdminClient {
+	if c.admin == nil {
+		return &fakeAdminClient{}
+	}
+
+	return c.admin
+}
+
+func (c *fakeClient) Subscribe() client.SubscribeClient {
+	return &fakeSubscribeClient{}
+}
+
+func (c *fakeClient) Dispatcher() client.DispatcherClient {
+	return c.dispatcher
+}
+
+func (c *fakeClient) Event() client.EventClient {
+	return c.event
+}
+
+func (c *fakeClient) PayloadStore() client.PayloadStore {
+	return c.payloadStore
+}
+
+func TestDeregister(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+	l := zerolog.Nop()
+
+	w := &Worker{
+		client:               &fakeClient{dispatcher: dispatcher},
+		l:                    &l,
+		actions:              ActionRegistry{},
+		retryPolicies:        map[string]*retryPolicy{},
+		registered_workflows: map[string]bool{},
+		workflowActions:      map[string][]string{},
+		deregisteredActions:  map[string]bool{},
+	}
+
+	shared := func(ctx HatchetContext) error { return nil }
+	step := func(ctx HatchetContext) error { return nil }
+
+	assert.NoError(t, w.registerAction("svc", "shared", shared, nil))
+	assert.NoError(t, w.registerAction("svc", "step", step, nil))
+
+	w.registered_workflows["first"] = true
+	w.registered_workflows["second"] = true
+	w.workflowActions["first"] = []string{"svc:shared", "svc:step"}
+	w.workflowActions["second"] = []string{"svc:shared"}
+
+	// before the worker is started, actions are not sent to the engine
+	assert.NoError(t, w.Deregister("first"))
+	assert.Empty(t, dispatcher.updatedActions)
+	assert.False(t, w.isWorkflowRegistered("first"))
+	assert.Equal(t, []string{"svc:shared"}, w.listenableActions())
+
+	// the handler of a deregistered action is kept for step runs which are still assigned to the worker
+	_, ok := w.getAction("svc:step")
+	assert.True(t, ok)
+
+	id := "worker-id"
+	w.id = &id
+
+	assert.NoError(t, w.Deregister("second"))
+	assert.Equal(t, [][]string{{}}, dispatcher.updatedActions)
+
+	assert.Error(t, w.Deregister("second"))
+
+	// registering the action again with a different function makes the worker listen for it again
+	assert.NoError(t, w.registerAction("svc", "step", func(ctx HatchetContext) error { return nil }, nil))
+	assert.Equal(t, []string{"svc:step"}, w.listenableActions())
+}
+
+func TestDeregisterStepsWithUppercaseNames(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(WithClient(&fakeClient{dispatcher: dispatcher}))
+	assert.NoError(t, err)
+
+	err = w.RegisterWorkflow(&WorkflowJob{
+		Name: "checkout",
+		On:   NoTrigger(),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext) error { return nil }).SetName("ChargeCard"),
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"checkout:chargecard"}, w.listenableActions())
+
+	// the action ids of the workflow match the names of the registered actions, which are lowercase
+	assert.NoError(t, w.Deregister("checkout"))
+	assert.Empty(t, w.listenableActions())
+}
+
+func TestRecordedValues(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	newCtx := func(payload string) HatchetContext {
+		l := zerolog.Nop()
+
+		ctx, err := newHatchetContext(context.Background(), &client.Action{
+			StepRunId:     "step-run-id",
+			ActionPayload: []byte(payload),
+		}, &fakeClient{dispatcher: dispatcher}, &l, &Worker{})
+
+		assert.NoError(t, err)
+
+		return ctx
+	}
+
+	first := newCtx(`{"input":{}}`)
+
+	now, err := first.Now()
+	assert.NoError(t, err)
+
+	id1, err := first.NewUUID()
+	assert.NoError(t, err)
+
+	id2, err := first.NewUUID()
+	assert.NoError(t, err)
+	assert.NotEqual(t, id1, id2)
+
+	assert.Len(t, dispatcher.overrides, 3)
+
+	// a retry receives the recorded values in the overrides of its input
+	overrides := map[string]json.RawMessage{}
+
+	for k, v := range dispatcher.overrides {
+		overrides[k] = v
+	}
+
+	payload, err := json.Marshal(map[string]any{"input": map[string]any{}, "overrides": overrides})
+	assert.NoError(t, err)
+
+	dispatcher.overrides = nil
+
+	retry := newCtx(string(payload))
+
+	replayedNow, err := retry.Now()
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(replayedNow))
+
+	replayedId1, err := retry.NewUUID()
+	assert.NoError(t, err)
+	assert.Equal(t, id1, replayedId1)
+
+	replayedId2, err := retry.NewUUID()
+	assert.NoError(t, err)
+	assert.Equal(t, id2, replayedId2)
+
+	assert.Empty(t, dispatcher.overrides)
+
+	// calls beyond the recorded ones record new values
+	_, err = retry.NewUUID()
+	assert.NoError(t, err)
+	assert.Len(t, dispatcher.overrides, 1)
+}
+
+func TestWorkerNamespace(t *testing.T) {
+	w, err := NewWorker(WithClient(&fakeClient{}), WithNamespace("team-a"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "team-a_", w.namespace())
+	assert.Equal(t, "team-a_workflow", w.namespaced("workflow"))
+	assert.Equal(t, "team-a_workflow", w.namespaced("team-a_workflow"))
+	assert.Equal(t, "team-a_svc", w.NewService("svc").Name)
+
+	w, err = NewWorker(WithClient(&fakeClient{}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "workflow", w.namespaced("workflow"))
+}
+
+func TestWorkerSlots(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name            string
+		maxRuns         *int
+		maxInFlightRuns int
+		want            *int
+	}{
+		{name: "default", want: nil},
+		{name: "max runs", maxRuns: intPtr(10), want: intPtr(10)},
+		{name: "max in-flight runs", maxInFlightRuns: 5, want: intPtr(5)},
+		{name: "lower max in-flight runs", maxRuns: intPtr(10), maxInFlightRuns: 5, want: intPtr(5)},
+		{name: "lower max runs", maxRuns: intPtr(3), maxInFlightRuns: 5, want: intPtr(3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []WorkerOpt{WithClient(&fakeClient{}), WithMaxInFlightRuns(tt.maxInFlightRuns)}
+
+			if tt.maxRuns != nil {
+				opts = append(opts, WithMaxRuns(*tt.maxRuns))
+			}
+
+			w, err := NewWorker(opts...)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, w.slots())
+			assert.Equal(t, 0, w.InFlightRuns())
+		})
+	}
+}
+
+func TestWorkerSlotGroups(t *testing.T) {
+	dispatcher := &fakeDispatcherClient{}
+
+	w, err := NewWorker(
+		WithClient(&fakeClient{dispatcher: dispatcher}),
+		WithWorkflowMaxRuns("heavy", 2),
+		WithWorkflowMaxRuns("ignored", 0),
+		WithWorkflowMaxRuns("unregistered", 1),
+	)
+	assert.NoError(t, err)
+
+	fn := func(ctx HatchetContext) error { return nil }
+
+	assert.NoError(t, w.registerAction("svc", "render", fn, nil))
+	assert.NoError(t, w.registerAction("svc", "upload", fn, nil))
+	assert.NoError(t, w.registerAction("svc", "notify", fn, nil))
+
+	w.registered_workflows["heavy"] = true
+	w.registered_workflows["light"] = true
+	w.workflowActions["heavy"] = []string{"svc:upload", "svc:render"}
+	w.workflowActions["light"] = []string{"svc:notify"}
+
+	id := "worker-id"
+	w.id = &id
+
+	assert.NoError(t, w.syncActions())
+	assert.Equal(t, [][]client.WorkerSlotGroup{{
+		{Name: "heavy", MaxRuns: 2, Actions: []string{"svc:render", "svc:upload"}},
+	}}, dispatcher.updatedSlotGroups)
+
+	// the slot group is removed with the workflow
+	assert.NoError(t, w.Deregister("heavy"))
+	assert.Equal(t, []client.WorkerSlotGroup{}, dispatcher.updatedSlotGroups[1])
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"runtime"
 	"strings"
@@ -15,10 +16,43 @@ type triggerConverter interface {
 	ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string)
 }
 
-type cron string
+// CronCatchUpPolicy determines what happens to the runs of a cron which were missed, for example because
+// the Hatchet engine was not running at the scheduled time.
+type CronCatchUpPolicy string
+
+const (
+	// CronCatchUpSkip skips missed runs. This is the default.
+	CronCatchUpSkip CronCatchUpPolicy = "SKIP"
+
+	// CronCatchUpFireOnce starts a single run when one or more runs were missed.
+	CronCatchUpFireOnce CronCatchUpPolicy = "FIRE_ONCE"
+)
+
+type cron struct {
+	expression string
+	timezone   string
+	catchUp    CronCatchUpPolicy
+}
 
 func Cron(c string) cron {
-	return cron(c)
+	return cron{
+		expression: c,
+	}
+}
+
+// In returns a trigger for the cron expression which is evaluated in the given IANA time zone, for example
+// "America/New_York", instead of UTC:
+//
+//	worker.Cron("0 9 * * 1-5").In("America/New_York")
+func (c cron) In(timezone string) cron {
+	c.timezone = timezone
+	return c
+}
+
+// CatchUp returns a trigger for the cron expression with the given catch-up policy.
+func (c cron) CatchUp(policy CronCatchUpPolicy) cron {
+	c.catchUp = policy
+	return c
 }
 
 func (c cron) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string) {
@@ -26,7 +60,21 @@ func (c cron) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string) {
 		wt.Cron = []string{}
 	}
 
-	wt.Cron = append(wt.Cron, string(c))
+	expression := c.expression
+
+	if c.timezone != "" {
+		expression = fmt.Sprintf("CRON_TZ=%s %s", c.timezone, expression)
+	}
+
+	wt.Cron = append(wt.Cron, expression)
+
+	if c.catchUp != "" {
+		if wt.CronCatchUpPolicies == nil {
+			wt.CronCatchUpPolicies = map[string]string{}
+		}
+
+		wt.CronCatchUpPolicies[expression] = string(c.catchUp)
+	}
 }
 
 type cronArr []string
@@ -68,7 +116,7 @@ func (s scheduled) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace stri
 }
 
 func (w *Worker) Call(action string) *WorkflowStep {
-	registeredAction, exists := w.actions[action]
+	registeredAction, exists := w.getAction(action)
 
 	if !exists {
 		panic(fmt.Sprintf("action %s does not exist", action))
@@ -124,6 +172,42 @@ func (e eventsArr) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace stri
 	}
 }
 
+// Where returns a trigger for the events which only starts a workflow run if the event matches the given
+// CEL expression. The expression can reference the event payload as input, the additional metadata of the
+// event as additional_metadata and the event key as event_key, and must evaluate to a bool. For example:
+//
+//	worker.Events("user:create").Where("input.data.plan == 'pro'")
+//
+// The expression is validated when the workflow is registered. An event for which the expression can't
+// be evaluated, for example because it references a field which is missing from the payload, does not
+// start a run.
+func (e eventsArr) Where(expression string) eventsWithFilter {
+	return eventsWithFilter{
+		events:     e,
+		expression: expression,
+	}
+}
+
+type eventsWithFilter struct {
+	events     eventsArr
+	expression string
+}
+
+func (e eventsWithFilter) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string) {
+	if wt.Events == nil {
+		wt.Events = []string{}
+	}
+
+	if wt.EventFilters == nil {
+		wt.EventFilters = map[string]string{}
+	}
+
+	for _, event := range e.events {
+		wt.Events = append(wt.Events, namespace+event)
+		wt.EventFilters[namespace+event] = e.expression
+	}
+}
+
 type workflowConverter interface {
 	ToWorkflow(svcName string, namespace string) types.Workflow
 	ToActionMap(svcName string) ActionMap
@@ -151,10 +235,46 @@ type WorkflowJob struct {
 
 	OnFailure *WorkflowJob
 
+	// ScheduleTimeout is how long step runs wait to be assigned to a worker before they are failed, for
+	// example "5m". Defaults to 5 minutes.
 	ScheduleTimeout string
 
+	// RunTimeout is how long workflow runs may run, for example "1h", after which their running step
+	// runs are cancelled and their remaining step runs are failed. A timeout passed when the workflow is
+	// triggered takes precedence.
+	RunTimeout string
+
 	StickyStrategy *types.StickyStrategy
-}
+
+	// Redact lists the fields of the outputs of the steps which must not be stored by Hatchet. The
+	// worker replaces their values with RedactedPlaceholder before it sends the outputs, so the step
+	// and its middleware still see the real values, but later steps and the dashboard only see the
+	// placeholder. Paths are dot-separated field names such as "card.number", where "*" matches every
+	// field of an object or element of an array.
+	Redact []string
+
+	// RedactInput lists the fields of the input of the workflow which must not be stored by Hatchet, with
+	// the same paths as Redact. When a step of this worker spawns the workflow, the worker replaces their
+	// values with RedactedPlaceholder before it sends the input, so the spawning step keeps the real
+	// values, but the steps of the spawned workflow only see the placeholder.
+	RedactInput []string
+}
+
+const (
+	// CancelInProgress cancels the oldest running workflow runs for a concurrency key when a new run
+	// exceeds the limit, so the latest run wins. Cancelled runs are treated as superseded, and do not
+	// trigger the OnFailure job.
+	CancelInProgress = types.CancelInProgress
+
+	// CancelNewest cancels new workflow runs which exceed the limit for a concurrency key. Unlike runs
+	// cancelled by CancelInProgress, these runs are treated as failures.
+	CancelNewest = types.CancelNewest
+
+	// GroupRoundRobin queues new workflow runs which exceed the limit for a concurrency key, and
+	// distributes slots across keys in a round-robin fashion. This is the default limit strategy. Earlier
+	// versions of the SDK didn't set a default, and the engine used CancelInProgress.
+	GroupRoundRobin = types.GroupRoundRobin
+)
 
 type WorkflowConcurrency struct {
 	fn            GetWorkflowConcurrencyGroupFn
@@ -180,11 +300,25 @@ func (c *WorkflowConcurrency) MaxRuns(maxRuns int32) *WorkflowConcurrency {
 	return c
 }
 
+// LimitStrategy sets what happens to workflow runs which exceed the limit for a concurrency key. If it is
+// not set, these runs are queued with GroupRoundRobin.
 func (c *WorkflowConcurrency) LimitStrategy(limitStrategy types.WorkflowConcurrencyLimitStrategy) *WorkflowConcurrency {
 	c.limitStrategy = &limitStrategy
 	return c
 }
 
+// SetConcurrency sets the concurrency settings of the workflow with the given limit strategy, for example
+// CancelInProgress for latest-wins semantics. Without a limit strategy, runs which exceed the limit are
+// queued with GroupRoundRobin.
+func (j *WorkflowJob) SetConcurrency(concurrency *WorkflowConcurrency, strategy types.WorkflowConcurrencyLimitStrategy) *WorkflowJob {
+	j.Concurrency = concurrency.LimitStrategy(strategy)
+	return j
+}
+
+func (j *WorkflowJob) inputRedactPaths() []string {
+	return j.RedactInput
+}
+
 func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflow {
 	apiJob, err := j.ToWorkflowJob(svcName, namespace)
 
@@ -194,8 +328,8 @@ func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflo
 
 	var onFailureJob *types.WorkflowJob
 
-	if j.OnFailure != nil {
-		onFailureJob, err = j.OnFailure.ToWorkflowJob(svcName, namespace)
+	if onFailure := j.onFailureJob(); onFailure != nil {
+		onFailureJob, err = onFailure.ToWorkflowJob(svcName, namespace)
 
 		if err != nil {
 			panic(err)
@@ -211,6 +345,7 @@ func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflo
 		Jobs:            jobs,
 		OnFailureJob:    onFailureJob,
 		ScheduleTimeout: j.ScheduleTimeout,
+		RunTimeout:      j.RunTimeout,
 	}
 
 	if j.Concurrency != nil {
@@ -229,6 +364,8 @@ func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflo
 			w.Concurrency.MaxRuns = *j.Concurrency.maxRuns
 		}
 
+		w.Concurrency.LimitStrategy = GroupRoundRobin
+
 		if j.Concurrency.limitStrategy != nil {
 			w.Concurrency.LimitStrategy = *j.Concurrency.limitStrategy
 		}
@@ -268,6 +405,11 @@ func (j *WorkflowJob) ToWorkflowTrigger() triggerConverter {
 type ActionWithCompute struct {
 	fn      any
 	compute *compute.Compute
+
+	retryPolicy *retryPolicy
+
+	// the paths of the fields of the output which are redacted
+	redactPaths []string
 }
 
 type ActionMap map[string]ActionWithCompute
@@ -279,8 +421,10 @@ func (j *WorkflowJob) ToActionMap(svcName string) ActionMap {
 		actionId := step.GetActionId(svcName, i)
 
 		res[actionId] = ActionWithCompute{
-			fn:      step.Function,
-			compute: step.Compute,
+			fn:          step.Function,
+			compute:     step.Compute,
+			retryPolicy: newRetryPolicy(step),
+			redactPaths: j.Redact,
 		}
 	}
 
@@ -291,10 +435,12 @@ func (j *WorkflowJob) ToActionMap(svcName string) ActionMap {
 		}
 	}
 
-	if j.OnFailure != nil {
-		onFailureActionMap := j.OnFailure.ToActionMap(svcName)
+	if onFailure := j.onFailureJob(); onFailure != nil {
+		onFailureActionMap := onFailure.ToActionMap(svcName)
 
 		for k, v := range onFailureActionMap {
+			// the rules of the workflow apply to the outputs of its on-failure steps as well
+			v.redactPaths = append(append([]string{}, j.Redact...), v.redactPaths...)
 			res[k] = v
 		}
 	}
@@ -315,17 +461,34 @@ type WorkflowStep struct {
 	// The ids of the parents
 	Parents []string
 
+	// Join is the number of parents which must succeed before the step starts, see SetJoin.
+	Join Join
+
 	Retries int
 
 	RetryBackoffFactor *float32
 
 	RetryMaxBackoffSeconds *int32
 
+	// RetryBackoffBase is the delay before the first retry, see SetBackoff.
+	RetryBackoffBase *time.Duration
+
+	// RetryBackoffJitter is the fraction by which the delay of each retry is randomly shortened, see
+	// SetRetryBackoffJitter.
+	RetryBackoffJitter *float32
+
 	RateLimit []RateLimit
 
 	DesiredLabels map[string]*types.DesiredWorkerLabel
 
 	Compute *compute.Compute
+
+	// Compensation undoes the effects of the step when the workflow run fails after the step completed,
+	// see SetCompensation.
+	Compensation any
+
+	// CompensationRetries is the number of times the compensation is retried before it is dead-lettered.
+	CompensationRetries int
 }
 
 type RateLimit struct {
@@ -387,11 +550,78 @@ func (w *WorkflowStep) SetRetryMaxBackoffSeconds(retryMaxBackoffSeconds int32) *
 	return w
 }
 
+// SetBackoff makes the engine wait before each retry of the step, starting with base before the first
+// retry and multiplying the delay by factor for every following retry, up to maxBackoff. The maxBackoff
+// is rounded up to whole seconds. Without a base, the delay before retry n is factor^n seconds.
+func (w *WorkflowStep) SetBackoff(base, maxBackoff time.Duration, factor float32) *WorkflowStep {
+	maxSeconds := int32(math.Ceil(maxBackoff.Seconds()))
+
+	w.RetryBackoffBase = &base
+	w.RetryMaxBackoffSeconds = &maxSeconds
+	w.RetryBackoffFactor = &factor
+
+	return w
+}
+
+// SetRetryBackoffJitter randomly shortens the delay of each retry by up to the given fraction of it,
+// between 0 and 1, so that step runs which failed at the same time are not all retried at the same
+// time. It requires a backoff, see SetBackoff.
+func (w *WorkflowStep) SetRetryBackoffJitter(jitter float32) *WorkflowStep {
+	w.RetryBackoffJitter = &jitter
+	return w
+}
+
+// SetCompensation sets the compensation of the step, which has the signature
+// func(ctx HatchetContext) error or func(ctx HatchetContext, output *T) error, where T is the output type
+// of the step. When the workflow run fails, the compensations of the steps which completed are called
+// with their outputs in the on-failure job, in the reverse order of the steps. Each compensation runs in
+// its own step named "compensate-<step>", which is retried CompensationRetries times. When the last
+// attempt fails, the compensation is dead-lettered: the error is recorded in its CompensationResult and
+// the remaining compensations still run. The steps of the OnFailure job run after the compensations.
+func (w *WorkflowStep) SetCompensation(fn any) *WorkflowStep {
+	w.Compensation = fn
+	return w
+}
+
+func (w *WorkflowStep) SetCompensationRetries(retries int) *WorkflowStep {
+	w.CompensationRetries = retries
+	return w
+}
+
 func (w *WorkflowStep) AddParents(parents ...string) *WorkflowStep {
 	w.Parents = append(w.Parents, parents...)
 	return w
 }
 
+// Join is the number of parents of a step which must succeed before the step starts.
+type Join int32
+
+const (
+	// JoinAll starts the step when all of its parents have succeeded. This is the default.
+	JoinAll Join = 0
+
+	// JoinAny starts the step as soon as one of its parents has succeeded.
+	JoinAny Join = 1
+)
+
+// JoinN starts the step as soon as n of its parents have succeeded.
+func JoinN(n int) Join {
+	return Join(n) // nolint: gosec
+}
+
+// SetJoin sets how many parents of the step must succeed before the step starts, which is all of them
+// by default. With JoinAny or JoinN, the step starts with the outputs of the parents which have succeeded
+// at that point, so StepOutput returns an error for the other parents, and it doesn't run again when they
+// finish. The other parents keep running and their outputs are stored, but not passed to the step. A
+// parent which fails or is cancelled only cancels the step if the step can no longer start because too
+// few parents can succeed, but it still fails the workflow run once the other steps have finished, so
+// parents which race each other should return their errors as part of their output if a losing parent
+// shouldn't fail the run.
+func (w *WorkflowStep) SetJoin(join Join) *WorkflowStep {
+	w.Join = join
+	return w
+}
+
 func (w *WorkflowStep) ToWorkflowTrigger() triggerConverter {
 	return NoTrigger()
 }
@@ -417,8 +647,9 @@ func (w *WorkflowStep) ToActionMap(svcName string) ActionMap {
 
 	return ActionMap{
 		step.GetActionId(svcName, 0): ActionWithCompute{
-			fn:      w.Function,
-			compute: w.Compute,
+			fn:          w.Function,
+			compute:     w.Compute,
+			retryPolicy: newRetryPolicy(w),
 		},
 	}
 }
@@ -454,6 +685,18 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int, namespace strin
 		RetryMaxBackoffSeconds: w.RetryMaxBackoffSeconds,
 	}
 
+	if w.Join != JoinAll {
+		joinCount := int32(w.Join)
+		res.APIStep.JoinCount = &joinCount
+	}
+
+	if w.RetryBackoffBase != nil {
+		baseMs := int32(w.RetryBackoffBase.Milliseconds()) // nolint: gosec
+		res.APIStep.RetryBackoffBaseMs = &baseMs
+	}
+
+	res.APIStep.RetryBackoffJitter = w.RetryBackoffJitter
+
 	for _, rateLimit := range w.RateLimit {
 		res.APIStep.RateLimits = append(res.APIStep.RateLimits, types.RateLimit{
 			Key:            rateLimit.Key,
@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+// WorkflowBuilder builds a WorkflowJob. Dependencies between steps are wired through the StepHandle
+// returned when adding a step, instead of through step names, so that a reference to a step which
+// does not exist is a compile error.
+type WorkflowBuilder struct {
+	job *WorkflowJob
+
+	// the parents of each step, indexed by step index
+	parents [][]*StepHandle
+}
+
+// StepHandle references a step which was added to a WorkflowBuilder.
+type StepHandle struct {
+	b     *WorkflowBuilder
+	index int
+}
+
+// NewWorkflow returns a builder for a workflow with the given name.
+func NewWorkflow(name string) *WorkflowBuilder {
+	return &WorkflowBuilder{
+		job: &WorkflowJob{
+			Name:  name,
+			Steps: []*WorkflowStep{},
+		},
+	}
+}
+
+func (b *WorkflowBuilder) Description(description string) *WorkflowBuilder {
+	b.job.Description = description
+	return b
+}
+
+func (b *WorkflowBuilder) On(on triggerConverter) *WorkflowBuilder {
+	b.job.On = on
+	return b
+}
+
+func (b *WorkflowBuilder) Concurrency(concurrency *WorkflowConcurrency) *WorkflowBuilder {
+	b.job.Concurrency = concurrency
+	return b
+}
+
+func (b *WorkflowBuilder) OnFailure(onFailure *WorkflowJob) *WorkflowBuilder {
+	b.job.OnFailure = onFailure
+	return b
+}
+
+func (b *WorkflowBuilder) ScheduleTimeout(scheduleTimeout string) *WorkflowBuilder {
+	b.job.ScheduleTimeout = scheduleTimeout
+	return b
+}
+
+func (b *WorkflowBuilder) RunTimeout(runTimeout string) *WorkflowBuilder {
+	b.job.RunTimeout = runTimeout
+	return b
+}
+
+func (b *WorkflowBuilder) StickyStrategy(stickyStrategy types.StickyStrategy) *WorkflowBuilder {
+	b.job.StickyStrategy = &stickyStrategy
+	return b
+}
+
+// AddStep adds a step which runs after all of the given parents. A step without parents runs when the
+// workflow is triggered.
+func (b *WorkflowBuilder) AddStep(step *WorkflowStep, parents ...*StepHandle) *StepHandle {
+	for _, parent := range parents {
+		if parent.b != b {
+			panic("parent step handle belongs to a different workflow builder")
+		}
+	}
+
+	b.job.Steps = append(b.job.Steps, step)
+	b.parents = append(b.parents, parents)
+
+	return &StepHandle{
+		b:     b,
+		index: len(b.job.Steps) - 1,
+	}
+}
+
+// Build returns the WorkflowJob with the parents of each step set from the step handles. Parents which
+// were set on a step by name are kept.
+func (b *WorkflowBuilder) Build() *WorkflowJob {
+	job := *b.job
+	job.Steps = make([]*WorkflowStep, len(b.job.Steps))
+
+	for i, step := range b.job.Steps {
+		stepCp := *step
+		stepCp.Parents = append([]string{}, step.Parents...)
+
+		for _, parent := range b.parents[i] {
+			stepCp.Parents = append(stepCp.Parents, parent.Name())
+		}
+
+		job.Steps[i] = &stepCp
+	}
+
+	return &job
+}
+
+func (b *WorkflowBuilder) ToWorkflow(svcName string, namespace string) types.Workflow {
+	return b.Build().ToWorkflow(svcName, namespace)
+}
+
+func (b *WorkflowBuilder) ToActionMap(svcName string) ActionMap {
+	return b.Build().ToActionMap(svcName)
+}
+
+func (b *WorkflowBuilder) ToWorkflowTrigger() triggerConverter {
+	return b.job.On
+}
+
+// Name returns the name of the step, which can be used to read its output with HatchetContext.StepOutput.
+func (h *StepHandle) Name() string {
+	return h.b.job.Steps[h.index].GetStepId(h.index)
+}
+
+// Then adds a step which runs after this step.
+func (h *StepHandle) Then(step *WorkflowStep) *StepHandle {
+	return h.b.AddStep(step, h)
+}
+
+// Parallel adds steps which run in parallel after this step.
+func (h *StepHandle) Parallel(steps ...*WorkflowStep) []*StepHandle {
+	handles := make([]*StepHandle, 0, len(steps))
+
+	for _, step := range steps {
+		handles = append(handles, h.b.AddStep(step, h))
+	}
+
+	return handles
+}
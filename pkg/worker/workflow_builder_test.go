@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowBuilder(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	b := NewWorkflow("dag").On(Event("user:create")).Description("a dag")
+
+	start := b.AddStep(Fn(noop).SetName("start"))
+	branches := start.Parallel(Fn(noop).SetName("left"), Fn(noop).SetName("right"))
+	join := b.AddStep(Fn(noop).SetName("join"), branches...)
+	join.Then(Fn(noop).SetName("end").AddParents("start"))
+
+	job := b.Build()
+
+	assert.Equal(t, "dag", job.Name)
+	assert.Equal(t, "a dag", job.Description)
+	assert.Len(t, job.Steps, 5)
+
+	parents := map[string][]string{}
+
+	for _, step := range job.Steps {
+		parents[step.Name] = step.Parents
+	}
+
+	assert.Equal(t, map[string][]string{
+		"start": {},
+		"left":  {"start"},
+		"right": {"start"},
+		"join":  {"left", "right"},
+		"end":   {"start", "join"},
+	}, parents)
+
+	// building again should not duplicate parents
+	for i, step := range b.Build().Steps {
+		assert.Equal(t, job.Steps[i].Parents, step.Parents)
+	}
+
+	assert.Equal(t, "join", join.Name())
+}
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
 func namedFunction() {}
@@ -47,6 +50,7 @@ func TestToWorkflowJob(t *testing.T) {
 	testJob := WorkflowJob{
 		Name:        "test",
 		Description: "test",
+		RunTimeout:  "1h",
 		Steps: []*WorkflowStep{
 			{
 				Function: func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
@@ -64,6 +68,7 @@ func TestToWorkflowJob(t *testing.T) {
 	workflow := testJob.ToWorkflow("default", "")
 
 	assert.Equal(t, "test", workflow.Name)
+	assert.Equal(t, "1h", workflow.RunTimeout)
 }
 
 func TestFnToWorkflow(t *testing.T) {
@@ -73,3 +78,75 @@ func TestFnToWorkflow(t *testing.T) {
 
 	assert.Equal(t, "TestFnToWorkflow-func1", workflow.Name)
 }
+
+func TestJoin(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	job := WorkflowJob{
+		Name: "hedged",
+		Steps: []*WorkflowStep{
+			Fn(noop).SetName("primary"),
+			Fn(noop).SetName("fallback"),
+			Fn(noop).SetName("first").AddParents("primary", "fallback").SetJoin(JoinAny),
+			Fn(noop).SetName("both").AddParents("primary", "fallback"),
+		},
+	}
+
+	require.NoError(t, Validate(&job))
+
+	steps := job.ToWorkflow("default", "").Jobs["hedged"].Steps
+	require.Len(t, steps, 4)
+
+	require.NotNil(t, steps[2].JoinCount)
+	assert.Equal(t, int32(1), *steps[2].JoinCount)
+
+	// all parents must succeed by default
+	assert.Nil(t, steps[3].JoinCount)
+}
+
+func TestConcurrencyLimitStrategy(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	expr := Expression("input.branch").MaxRuns(1)
+
+	job := WorkflowJob{
+		Name:        "ci",
+		Concurrency: expr,
+		Steps:       []*WorkflowStep{Fn(noop)},
+	}
+
+	// runs which exceed the limit are queued by default
+	workflow := job.ToWorkflow("default", "")
+	require.NotNil(t, workflow.Concurrency)
+	assert.Equal(t, types.GroupRoundRobin, workflow.Concurrency.LimitStrategy)
+	assert.Equal(t, int32(1), workflow.Concurrency.MaxRuns)
+
+	job.SetConcurrency(expr, CancelInProgress)
+
+	workflow = job.ToWorkflow("default", "")
+	assert.Equal(t, types.CancelInProgress, workflow.Concurrency.LimitStrategy)
+}
+
+func TestCronInCatchUp(t *testing.T) {
+	wt := &types.WorkflowTriggers{}
+
+	Cron("*/5 * * * *").ToWorkflowTriggers(wt, "ns_")
+	Cron("0 9 * * 1-5").In("America/New_York").CatchUp(CronCatchUpFireOnce).ToWorkflowTriggers(wt, "ns_")
+
+	assert.Equal(t, []string{"*/5 * * * *", "CRON_TZ=America/New_York 0 9 * * 1-5"}, wt.Cron)
+	assert.Equal(t, map[string]string{
+		"CRON_TZ=America/New_York 0 9 * * 1-5": "FIRE_ONCE",
+	}, wt.CronCatchUpPolicies)
+}
+
+func TestEventsWhere(t *testing.T) {
+	wt := &types.WorkflowTriggers{}
+
+	Events("user:create", "user:update").Where("input.data.plan == 'pro'").ToWorkflowTriggers(wt, "ns_")
+
+	assert.Equal(t, []string{"ns_user:create", "ns_user:update"}, wt.Events)
+	assert.Equal(t, map[string]string{
+		"ns_user:create": "input.data.plan == 'pro'",
+		"ns_user:update": "input.data.plan == 'pro'",
+	}, wt.EventFilters)
+}
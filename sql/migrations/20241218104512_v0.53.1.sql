@@ -0,0 +1,2 @@
+-- Modify "WorkflowRun" table
+ALTER TABLE "WorkflowRun" ADD COLUMN "detached" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "WorkflowTriggerEventRef" table
+ALTER TABLE "WorkflowTriggerEventRef" ADD COLUMN "filterExpression" text NULL;
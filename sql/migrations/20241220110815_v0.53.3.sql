@@ -0,0 +1,2 @@
+-- Modify "WorkflowRunTriggeredBy" table
+ALTER TABLE "WorkflowRunTriggeredBy" ADD COLUMN "actorType" text NULL, ADD COLUMN "actorId" uuid NULL;
@@ -0,0 +1,2 @@
+-- Modify "WorkflowRun" table
+ALTER TABLE "WorkflowRun" ADD COLUMN "timeoutAt" timestamp(3) NULL;
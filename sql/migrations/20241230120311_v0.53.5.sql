@@ -0,0 +1,8 @@
+-- Create "OrderedEvent" table
+CREATE TABLE "OrderedEvent" ("eventId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT clock_timestamp(), "tenantId" uuid NOT NULL, "orderingKey" text NOT NULL, "sequence" bigint NULL, "releasedAt" timestamp(3) NULL, PRIMARY KEY ("eventId"), CONSTRAINT "OrderedEvent_eventId_fkey" FOREIGN KEY ("eventId") REFERENCES "Event" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "OrderedEvent_tenantId_orderingKey_sequence_createdAt_idx" to table: "OrderedEvent"
+CREATE INDEX "OrderedEvent_tenantId_orderingKey_sequence_createdAt_idx" ON "OrderedEvent" ("tenantId", "orderingKey", "sequence", "createdAt") WHERE ("releasedAt" IS NULL);
+-- Create "EventOrderingKey" table
+CREATE TABLE "EventOrderingKey" ("tenantId" uuid NOT NULL, "key" text NOT NULL, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "lastSequence" bigint NOT NULL DEFAULT 0, "activeEventId" uuid NULL, "activeTriggered" boolean NOT NULL DEFAULT false, PRIMARY KEY ("tenantId", "key"));
+-- Create index "EventOrderingKey_activeEventId_key" to table: "EventOrderingKey"
+CREATE UNIQUE INDEX "EventOrderingKey_activeEventId_key" ON "EventOrderingKey" ("activeEventId");
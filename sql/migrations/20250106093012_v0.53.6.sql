@@ -0,0 +1,4 @@
+-- Create "WorkflowVersionWeight" table
+CREATE TABLE "WorkflowVersionWeight" ("workflowVersionId" uuid NOT NULL, "workflowId" uuid NOT NULL, "tenantId" uuid NOT NULL, "weight" integer NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("workflowVersionId"), CONSTRAINT "WorkflowVersionWeight_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT "WorkflowVersionWeight_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WorkflowVersionWeight_workflowId_idx" to table: "WorkflowVersionWeight"
+CREATE INDEX "WorkflowVersionWeight_workflowId_idx" ON "WorkflowVersionWeight" ("workflowId");
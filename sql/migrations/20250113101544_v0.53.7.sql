@@ -0,0 +1,4 @@
+-- Create "EventExternalId" table
+CREATE TABLE "EventExternalId" ("tenantId" uuid NOT NULL, "externalId" text NOT NULL, "eventId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("tenantId", "externalId"), CONSTRAINT "EventExternalId_eventId_fkey" FOREIGN KEY ("eventId") REFERENCES "Event" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "EventExternalId_eventId_key" to table: "EventExternalId"
+CREATE UNIQUE INDEX "EventExternalId_eventId_key" ON "EventExternalId" ("eventId");
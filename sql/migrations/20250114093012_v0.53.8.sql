@@ -0,0 +1,4 @@
+-- Create "WorkflowRunExpiry" table
+CREATE TABLE "WorkflowRunExpiry" ("workflowRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "expiresAt" timestamp(3) NOT NULL, "expired" boolean NOT NULL DEFAULT false, PRIMARY KEY ("workflowRunId"), CONSTRAINT "WorkflowRunExpiry_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WorkflowRunExpiry_tenantId_expiresAt_idx" to table: "WorkflowRunExpiry"
+CREATE INDEX "WorkflowRunExpiry_tenantId_expiresAt_idx" ON "WorkflowRunExpiry" ("tenantId", "expiresAt") WHERE (expired = false);
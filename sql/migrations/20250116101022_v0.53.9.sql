@@ -0,0 +1,2 @@
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "joinCount" integer NULL;
@@ -0,0 +1,2 @@
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "retryBackoffBase" integer NULL, ADD COLUMN "retryBackoffJitter" double precision NULL;
@@ -0,0 +1,2 @@
+-- Modify "RetryQueueItem" table
+ALTER TABLE "RetryQueueItem" ADD COLUMN "isRetry" boolean NOT NULL DEFAULT true;
@@ -0,0 +1,2 @@
+-- Modify "WorkflowTriggerCronRef" table
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN "catchUp" boolean NOT NULL DEFAULT false, ADD COLUMN "lastFiredAt" timestamp(3) NULL;
@@ -0,0 +1,4 @@
+-- Create "StepRunDeadLetter" table
+CREATE TABLE "StepRunDeadLetter" ("stepRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "error" text NULL, "retryCount" integer NOT NULL DEFAULT 0, PRIMARY KEY ("stepRunId"), CONSTRAINT "StepRunDeadLetter_stepRunId_fkey" FOREIGN KEY ("stepRunId") REFERENCES "StepRun" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "StepRunDeadLetter_tenantId_createdAt_idx" to table: "StepRunDeadLetter"
+CREATE INDEX "StepRunDeadLetter_tenantId_createdAt_idx" ON "StepRunDeadLetter" ("tenantId", "createdAt");
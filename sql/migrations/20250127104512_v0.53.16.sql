@@ -0,0 +1,6 @@
+-- Create "AuditLog" table
+CREATE TABLE "AuditLog" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "actorType" text NOT NULL, "actorId" uuid NULL, "action" text NOT NULL, "resourceType" text NULL, "resourceId" uuid NULL, "ipAddress" text NULL, "payload" jsonb NULL, PRIMARY KEY ("id"), CONSTRAINT "AuditLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "AuditLog_tenantId_createdAt_idx" to table: "AuditLog"
+CREATE INDEX "AuditLog_tenantId_createdAt_idx" ON "AuditLog" ("tenantId", "createdAt" DESC);
+-- Create "TenantAuditLogSettings" table
+CREATE TABLE "TenantAuditLogSettings" ("tenantId" uuid NOT NULL, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "retentionPeriod" text NOT NULL, PRIMARY KEY ("tenantId"), CONSTRAINT "TenantAuditLogSettings_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
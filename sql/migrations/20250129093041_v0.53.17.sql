@@ -0,0 +1,2 @@
+-- Add value to enum type: "LimitResource"
+ALTER TYPE "LimitResource" ADD VALUE 'QUEUED_RUN';
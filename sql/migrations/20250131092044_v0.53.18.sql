@@ -0,0 +1,4 @@
+-- Create index "Event_tenantId_deletedAt_idx" to table: "Event"
+CREATE INDEX "Event_tenantId_deletedAt_idx" ON "Event" ("tenantId", "deletedAt") WHERE ("deletedAt" IS NOT NULL);
+-- Create index "WorkflowRun_tenantId_deletedAt_idx" to table: "WorkflowRun"
+CREATE INDEX "WorkflowRun_tenantId_deletedAt_idx" ON "WorkflowRun" ("tenantId", "deletedAt") WHERE ("deletedAt" IS NOT NULL);
@@ -0,0 +1,6 @@
+-- Modify "TenantAlertingSettings" table
+ALTER TABLE "TenantAlertingSettings" ADD COLUMN "workflowRunFailureThreshold" integer NOT NULL DEFAULT 1, ADD COLUMN "enableWorkerOfflineAlerts" boolean NOT NULL DEFAULT false, ADD COLUMN "workerOfflineAlertsCheckedAt" timestamp(3) NULL;
+-- Create "TenantAlertMuteRule" table
+CREATE TABLE "TenantAlertMuteRule" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "workflowId" uuid NOT NULL, "mutedUntil" timestamp(3) NULL, "reason" text NULL, PRIMARY KEY ("id"), CONSTRAINT "TenantAlertMuteRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT "TenantAlertMuteRule_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "TenantAlertMuteRule_tenantId_workflowId_key" to table: "TenantAlertMuteRule"
+CREATE UNIQUE INDEX "TenantAlertMuteRule_tenantId_workflowId_key" ON "TenantAlertMuteRule" ("tenantId", "workflowId");
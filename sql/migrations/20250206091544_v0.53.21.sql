@@ -0,0 +1,2 @@
+-- Modify "WorkflowVersion" table
+ALTER TABLE "WorkflowVersion" ADD COLUMN "runTimeout" text NULL;
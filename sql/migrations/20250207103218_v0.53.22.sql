@@ -0,0 +1,2 @@
+-- Create "WorkerSlotGroup" table
+CREATE TABLE "WorkerSlotGroup" ("workerId" uuid NOT NULL, "name" text NOT NULL, "maxRuns" integer NOT NULL, "actionIds" text[] NOT NULL DEFAULT '{}', PRIMARY KEY ("workerId", "name"), CONSTRAINT "WorkerSlotGroup_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
@@ -0,0 +1,6 @@
+-- atlas:txmode none
+
+-- Create index "WorkflowRun_additionalMetadata_idx" to table: "WorkflowRun"
+CREATE INDEX CONCURRENTLY IF NOT EXISTS "WorkflowRun_additionalMetadata_idx" ON "WorkflowRun" USING GIN ("additionalMetadata" jsonb_path_ops);
+-- Create index "Event_additionalMetadata_idx" to table: "Event"
+CREATE INDEX CONCURRENTLY IF NOT EXISTS "Event_additionalMetadata_idx" ON "Event" USING GIN ("additionalMetadata" jsonb_path_ops);
@@ -0,0 +1,10 @@
+-- Modify "EventExternalId" table
+ALTER TABLE "EventExternalId" ADD COLUMN "expiresAt" timestamp(3) NULL;
+-- Create index "EventExternalId_tenantId_expiresAt_idx" to table: "EventExternalId"
+CREATE INDEX "EventExternalId_tenantId_expiresAt_idx" ON "EventExternalId" ("tenantId", "expiresAt") WHERE ("expiresAt" IS NOT NULL);
+-- Create "WorkflowRunIdempotencyKey" table
+CREATE TABLE "WorkflowRunIdempotencyKey" ("tenantId" uuid NOT NULL, "key" text NOT NULL, "workflowRunId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "expiresAt" timestamp(3) NOT NULL, PRIMARY KEY ("tenantId", "key"), CONSTRAINT "WorkflowRunIdempotencyKey_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WorkflowRunIdempotencyKey_tenantId_expiresAt_idx" to table: "WorkflowRunIdempotencyKey"
+CREATE INDEX "WorkflowRunIdempotencyKey_tenantId_expiresAt_idx" ON "WorkflowRunIdempotencyKey" ("tenantId", "expiresAt");
+-- Create index "WorkflowRunIdempotencyKey_workflowRunId_idx" to table: "WorkflowRunIdempotencyKey"
+CREATE INDEX "WorkflowRunIdempotencyKey_workflowRunId_idx" ON "WorkflowRunIdempotencyKey" ("workflowRunId");
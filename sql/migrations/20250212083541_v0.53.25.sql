@@ -0,0 +1,2 @@
+-- Create "WorkflowLatestVersionWeight" table
+CREATE TABLE "WorkflowLatestVersionWeight" ("workflowId" uuid NOT NULL, "tenantId" uuid NOT NULL, "weight" integer NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("workflowId"), CONSTRAINT "WorkflowLatestVersionWeight_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
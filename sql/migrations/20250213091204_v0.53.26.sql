@@ -0,0 +1,2 @@
+-- Modify "Tenant" table
+ALTER TABLE "Tenant" ADD COLUMN "isPaused" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "EventOrderingKey" table
+ALTER TABLE "EventOrderingKey" ADD COLUMN "releaseSequence" bigint NOT NULL DEFAULT 0;
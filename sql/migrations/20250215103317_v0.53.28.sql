@@ -0,0 +1,2 @@
+-- Modify "UserOAuth" table
+ALTER TABLE "UserOAuth" ADD COLUMN "refreshFailedAt" timestamp(3) NULL;
@@ -0,0 +1,2 @@
+-- Modify "APIToken" table
+ALTER TABLE "APIToken" ADD COLUMN "namespace" text NULL;
@@ -0,0 +1,153 @@
+-- Partition "WorkflowRun", "StepRun" and "Event" by day on "createdAt". The existing tables become the first
+-- partition of each table, and the retention controller creates the partitions of the next days and drops the
+-- partitions which have no rows left after the purge.
+
+-- Drop the foreign keys which reference the partitioned tables on "id", because the primary key of a partitioned
+-- table must include "createdAt". The rows which referenced them are deleted by the purge queries instead.
+ALTER TABLE "GetGroupKeyRun" DROP CONSTRAINT "GetGroupKeyRun_workflowRunId_fkey";
+ALTER TABLE "JobRun" DROP CONSTRAINT "JobRun_workflowRunId_fkey";
+ALTER TABLE "WorkflowRun" DROP CONSTRAINT "WorkflowRun_parentId_fkey";
+ALTER TABLE "WorkflowRunStickyState" DROP CONSTRAINT "WorkflowRunStickyState_workflowRunId_fkey";
+ALTER TABLE "WorkflowTriggerScheduledRef" DROP CONSTRAINT "WorkflowTriggerScheduledRef_parentWorkflowRunId_fkey";
+ALTER TABLE "WorkflowRunExpiry" DROP CONSTRAINT "WorkflowRunExpiry_workflowRunId_fkey";
+ALTER TABLE "WorkflowRunIdempotencyKey" DROP CONSTRAINT "WorkflowRunIdempotencyKey_workflowRunId_fkey";
+ALTER TABLE "StepRunDeadLetter" DROP CONSTRAINT "StepRunDeadLetter_stepRunId_fkey";
+ALTER TABLE "Event" DROP CONSTRAINT "Event_replayedFromId_fkey";
+ALTER TABLE "OrderedEvent" DROP CONSTRAINT "OrderedEvent_eventId_fkey";
+ALTER TABLE "EventExternalId" DROP CONSTRAINT "EventExternalId_eventId_fkey";
+
+-- Rename the existing tables and their indexes, so the partitioned tables can take their names
+DO $$
+DECLARE
+    idx RECORD;
+BEGIN
+    FOR idx IN
+        SELECT indexname FROM pg_indexes
+        WHERE schemaname = current_schema() AND tablename IN ('WorkflowRun', 'StepRun', 'Event')
+    LOOP
+        EXECUTE format('ALTER INDEX %I RENAME TO %I', idx.indexname, 'legacy_' || idx.indexname);
+    END LOOP;
+END $$;
+
+ALTER TABLE "WorkflowRun" RENAME TO "WorkflowRun_legacy";
+ALTER TABLE "StepRun" RENAME TO "StepRun_legacy";
+ALTER TABLE "Event" RENAME TO "Event_legacy";
+
+-- The unique indexes on "id" don't include the partition key, so they can't be attached to the partitioned tables
+ALTER TABLE "WorkflowRun_legacy" DROP CONSTRAINT "legacy_WorkflowRun_pkey";
+DROP INDEX "legacy_WorkflowRun_id_key";
+DROP INDEX "legacy_WorkflowRun_parentId_parentStepRunId_childKey_key";
+ALTER TABLE "StepRun_legacy" DROP CONSTRAINT "legacy_StepRun_pkey";
+DROP INDEX "legacy_StepRun_id_key";
+ALTER TABLE "Event_legacy" DROP CONSTRAINT "legacy_Event_pkey";
+DROP INDEX "legacy_Event_id_key";
+
+-- Create the partitioned "WorkflowRun" table
+CREATE TABLE "WorkflowRun" (LIKE "WorkflowRun_legacy" INCLUDING DEFAULTS) PARTITION BY RANGE ("createdAt");
+ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_pkey" PRIMARY KEY ("id", "createdAt");
+CREATE INDEX "WorkflowRun_createdAt_idx" ON "WorkflowRun" ("createdAt" ASC);
+CREATE INDEX "WorkflowRun_deletedAt_idx" ON "WorkflowRun" ("deletedAt" ASC);
+CREATE INDEX "WorkflowRun_finishedAt_idx" ON "WorkflowRun" ("finishedAt" ASC);
+CREATE INDEX "WorkflowRun_parentId_parentStepRunId_childKey_idx" ON "WorkflowRun" ("parentId" ASC, "parentStepRunId" ASC, "childKey" ASC);
+CREATE INDEX "WorkflowRun_status_idx" ON "WorkflowRun" ("status" ASC);
+CREATE INDEX "WorkflowRun_tenantId_createdAt_idx" ON "WorkflowRun" ("tenantId" ASC, "createdAt" ASC);
+CREATE INDEX "WorkflowRun_tenantId_deletedAt_idx" ON "WorkflowRun" ("tenantId" ASC, "deletedAt" ASC) WHERE "deletedAt" IS NOT NULL;
+CREATE INDEX "WorkflowRun_tenantId_idx" ON "WorkflowRun" ("tenantId" ASC);
+CREATE INDEX "WorkflowRun_workflowVersionId_idx" ON "WorkflowRun" ("workflowVersionId" ASC);
+CREATE INDEX "WorkflowRun_parentStepRunId" ON "WorkflowRun" ("parentStepRunId" ASC);
+CREATE INDEX idx_workflowrun_concurrency ON "WorkflowRun" ("concurrencyGroupId", "createdAt");
+CREATE INDEX idx_workflowrun_main ON "WorkflowRun" ("tenantId", "deletedAt", "status", "workflowVersionId", "createdAt");
+CREATE INDEX "WorkflowRun_parentId_parentStepRunId_childIndex_key" ON "WorkflowRun" ("parentId", "parentStepRunId", "childIndex") WHERE "deletedAt" IS NULL;
+CREATE INDEX "WorkflowRun_additionalMetadata_idx" ON "WorkflowRun" USING GIN ("additionalMetadata" jsonb_path_ops);
+
+-- Create the partitioned "StepRun" table
+CREATE TABLE "StepRun" (LIKE "StepRun_legacy" INCLUDING DEFAULTS) PARTITION BY RANGE ("createdAt");
+ALTER TABLE "StepRun" ADD CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id", "createdAt");
+CREATE INDEX "StepRun_createdAt_idx" ON "StepRun" ("createdAt" ASC);
+CREATE INDEX "StepRun_deletedAt_idx" ON "StepRun" ("deletedAt" ASC);
+CREATE INDEX "StepRun_id_tenantId_idx" ON "StepRun" ("id" ASC, "tenantId" ASC);
+CREATE INDEX "StepRun_jobRunId_status_idx" ON "StepRun" ("jobRunId" ASC, "status" ASC);
+CREATE INDEX "StepRun_jobRunId_tenantId_order_idx" ON "StepRun" ("jobRunId" ASC, "tenantId" ASC, "order" ASC);
+CREATE INDEX "StepRun_stepId_idx" ON "StepRun" ("stepId" ASC);
+CREATE INDEX "StepRun_tenantId_idx" ON "StepRun" ("tenantId" ASC);
+CREATE INDEX "StepRun_workerId_idx" ON "StepRun" ("workerId" ASC);
+CREATE INDEX "StepRun_jobRunId_status_tenantId_idx" ON "StepRun" ("jobRunId", "status", "tenantId") WHERE "status" = 'PENDING';
+CREATE INDEX "StepRun_status_tenantId_idx" ON "StepRun" ("status", "tenantId");
+
+-- The sequence of "order" must outlive the partition which created it
+ALTER SEQUENCE "StepRun_order_seq" OWNED BY "StepRun"."order";
+
+-- Create the partitioned "Event" table
+CREATE TABLE "Event" (LIKE "Event_legacy" INCLUDING DEFAULTS) PARTITION BY RANGE ("createdAt");
+ALTER TABLE "Event" ADD CONSTRAINT "Event_pkey" PRIMARY KEY ("id", "createdAt");
+CREATE INDEX "Event_createdAt_idx" ON "Event" ("createdAt" ASC);
+CREATE INDEX "Event_tenantId_createdAt_idx" ON "Event" ("tenantId" ASC, "createdAt" ASC);
+CREATE INDEX "Event_tenantId_deletedAt_idx" ON "Event" ("tenantId" ASC, "deletedAt" ASC) WHERE "deletedAt" IS NOT NULL;
+CREATE INDEX "Event_tenantId_idx" ON "Event" ("tenantId" ASC);
+CREATE INDEX "Event_additionalMetadata_idx" ON "Event" USING GIN ("additionalMetadata" jsonb_path_ops);
+
+-- Attach the existing tables as the partition of every row created until the end of the day of their last row,
+-- and create the daily partitions of the next two weeks. The indexes of the existing tables which match the
+-- indexes of the partitioned tables are attached rather than rebuilt.
+DO $$
+DECLARE
+    tbl TEXT;
+    cutover TIMESTAMP;
+    day TIMESTAMP;
+BEGIN
+    FOREACH tbl IN ARRAY ARRAY['WorkflowRun', 'StepRun', 'Event'] LOOP
+        EXECUTE format(
+            'SELECT date_trunc(''day'', GREATEST(MAX("createdAt"), LOCALTIMESTAMP)) + INTERVAL ''1 day'' FROM %I',
+            tbl || '_legacy'
+        ) INTO cutover;
+
+        EXECUTE format(
+            'ALTER TABLE %I ATTACH PARTITION %I FOR VALUES FROM (MINVALUE) TO (%L)',
+            tbl, tbl || '_legacy', cutover
+        );
+
+        day := cutover;
+
+        WHILE day < cutover + INTERVAL '14 days' LOOP
+            EXECUTE format(
+                'CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+                tbl || '_' || to_char(day, 'YYYYMMDD'), tbl, day, day + INTERVAL '1 day'
+            );
+
+            day := day + INTERVAL '1 day';
+        END LOOP;
+    END LOOP;
+END $$;
+
+-- Create "WorkflowRunChildKey" table, which keeps the child keys of workflow runs unique across partitions
+CREATE TABLE "WorkflowRunChildKey" (
+    "parentId" uuid NOT NULL,
+    "parentStepRunId" uuid NOT NULL,
+    "childKey" text NOT NULL,
+    "workflowRunId" uuid NOT NULL,
+    PRIMARY KEY ("parentId", "parentStepRunId", "childKey")
+);
+-- Create index "WorkflowRunChildKey_workflowRunId_idx" to table: "WorkflowRunChildKey"
+CREATE INDEX "WorkflowRunChildKey_workflowRunId_idx" ON "WorkflowRunChildKey" ("workflowRunId");
+
+INSERT INTO "WorkflowRunChildKey" ("parentId", "parentStepRunId", "childKey", "workflowRunId")
+SELECT "parentId", "parentStepRunId", "childKey", "id"
+FROM "WorkflowRun"
+WHERE "parentId" IS NOT NULL AND "parentStepRunId" IS NOT NULL AND "childKey" IS NOT NULL;
+
+-- Function to claim the child key of a new workflow run, which fails with a unique violation like the unique
+-- index on ("parentId", "parentStepRunId", "childKey") did
+CREATE
+OR REPLACE FUNCTION claim_workflow_run_child_key () RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO "WorkflowRunChildKey" ("parentId", "parentStepRunId", "childKey", "workflowRunId")
+    VALUES (NEW."parentId", NEW."parentStepRunId", NEW."childKey", NEW."id");
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Trigger to invoke the claim function after insert
+CREATE TRIGGER trigger_claim_workflow_run_child_key
+AFTER INSERT ON "WorkflowRun" FOR EACH ROW
+WHEN (NEW."parentId" IS NOT NULL AND NEW."parentStepRunId" IS NOT NULL AND NEW."childKey" IS NOT NULL)
+EXECUTE FUNCTION claim_workflow_run_child_key ();
@@ -0,0 +1,2 @@
+-- Modify "AuditLog" table
+ALTER TABLE "AuditLog" ADD COLUMN "priorState" jsonb NULL;
@@ -41,7 +41,8 @@ CREATE TYPE "LimitResource" AS ENUM (
     'EVENT',
     'WORKER',
     'CRON',
-    'SCHEDULE'
+    'SCHEDULE',
+    'QUEUED_RUN'
 );
 
 -- CreateEnum
@@ -144,6 +145,8 @@ CREATE TABLE "APIToken" (
     "tenantId" UUID,
     "nextAlertAt" TIMESTAMP(3),
     "internal" BOOLEAN NOT NULL DEFAULT false,
+    "scopes" TEXT[] NOT NULL DEFAULT '{}',
+    "namespace" TEXT,
 
     CONSTRAINT "APIToken_pkey" PRIMARY KEY ("id")
 );
@@ -194,8 +197,8 @@ CREATE TABLE "Event" (
     "additionalMetadata" JSONB,
     "insertOrder" INTEGER,
 
-    CONSTRAINT "Event_pkey" PRIMARY KEY ("id")
-);
+    CONSTRAINT "Event_pkey" PRIMARY KEY ("id", "createdAt")
+) PARTITION BY RANGE ("createdAt");
 
 -- CreateTable
 CREATE TABLE "EventKey" (
@@ -441,11 +444,18 @@ CREATE TABLE "Step" (
     "timeout" TEXT,
     "customUserData" JSONB,
     "retries" INTEGER NOT NULL DEFAULT 0,
-    -- a factor to use for exponential backoff: min(retryMaxBackoff, retryBackoffFactor^retryCount)
+    -- a factor to use for exponential backoff: min(retryMaxBackoff, retryBackoffFactor^retryCount), or
+    -- min(retryMaxBackoff, retryBackoffBase * retryBackoffFactor^(retryCount - 1)) if retryBackoffBase is set
     "retryBackoffFactor" DOUBLE PRECISION,
     -- the maximum amount of time in seconds to wait between retries
     "retryMaxBackoff" INTEGER,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    -- the number of parents which must succeed before the step starts, or all parents if null
+    "joinCount" INTEGER,
+    -- the delay in milliseconds before the first retry
+    "retryBackoffBase" INTEGER,
+    -- the fraction of the backoff by which the delay of each retry is randomly shortened, between 0 and 1
+    "retryBackoffJitter" DOUBLE PRECISION,
 
     CONSTRAINT "Step_pkey" PRIMARY KEY ("id")
 );
@@ -519,8 +529,8 @@ CREATE TABLE "StepRun" (
     "queue" TEXT NOT NULL DEFAULT 'default',
     "priority" INTEGER,
     "internalRetryCount" INTEGER NOT NULL DEFAULT 0,
-    CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id")
-);
+    CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id", "createdAt")
+) PARTITION BY RANGE ("createdAt");
 
 -- CreateTable
 CREATE TABLE "StepRunEvent" (
@@ -596,6 +606,7 @@ CREATE TABLE "Tenant" (
     "workerPartitionId" TEXT,
     "dataRetentionPeriod" TEXT NOT NULL DEFAULT '720h',
     "schedulerPartitionId" TEXT,
+    "isPaused" BOOLEAN NOT NULL DEFAULT false,
 
     CONSTRAINT "Tenant_pkey" PRIMARY KEY ("id")
 );
@@ -625,6 +636,9 @@ CREATE TABLE "TenantAlertingSettings" (
     "enableExpiringTokenAlerts" BOOLEAN NOT NULL DEFAULT true,
     "enableWorkflowRunFailureAlerts" BOOLEAN NOT NULL DEFAULT false,
     "enableTenantResourceLimitAlerts" BOOLEAN NOT NULL DEFAULT true,
+    "workflowRunFailureThreshold" INTEGER NOT NULL DEFAULT 1,
+    "enableWorkerOfflineAlerts" BOOLEAN NOT NULL DEFAULT false,
+    "workerOfflineAlertsCheckedAt" TIMESTAMP(3),
 
     CONSTRAINT "TenantAlertingSettings_pkey" PRIMARY KEY ("id")
 );
@@ -652,6 +666,7 @@ CREATE TABLE "TenantMember" (
     "tenantId" UUID NOT NULL,
     "userId" UUID NOT NULL,
     "role" "TenantMemberRole" NOT NULL,
+    "customRoleId" UUID,
 
     CONSTRAINT "TenantMember_pkey" PRIMARY KEY ("id")
 );
@@ -759,6 +774,7 @@ CREATE TABLE "UserOAuth" (
     "expiresAt" TIMESTAMP(3),
     "accessToken" BYTEA NOT NULL,
     "refreshToken" BYTEA,
+    "refreshFailedAt" TIMESTAMP(3),
 
     CONSTRAINT "UserOAuth_pkey" PRIMARY KEY ("id")
 );
@@ -914,9 +930,11 @@ CREATE TABLE "WorkflowRun" (
     "duration" BIGINT,
     "priority" INTEGER,
     "insertOrder" INTEGER,
+    "detached" BOOLEAN NOT NULL DEFAULT false,
+    "timeoutAt" TIMESTAMP(3),
 
-    CONSTRAINT "WorkflowRun_pkey" PRIMARY KEY ("id")
-);
+    CONSTRAINT "WorkflowRun_pkey" PRIMARY KEY ("id", "createdAt")
+) PARTITION BY RANGE ("createdAt");
 
 -- CreateTable
 CREATE TABLE "WorkflowRunDedupe" (
@@ -956,6 +974,8 @@ CREATE TABLE "WorkflowRunTriggeredBy" (
     "input" JSONB,
     "parentId" UUID NOT NULL,
     "cronName" TEXT,
+    "actorType" TEXT,
+    "actorId" UUID,
 
     CONSTRAINT "WorkflowRunTriggeredBy_pkey" PRIMARY KEY ("id")
 );
@@ -992,13 +1012,16 @@ CREATE TABLE "WorkflowTriggerCronRef" (
     "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
     "name" TEXT,
     "id" UUID NOT NULL,
-    "method" "WorkflowTriggerCronRefMethods" NOT NULL DEFAULT 'DEFAULT'
+    "method" "WorkflowTriggerCronRefMethods" NOT NULL DEFAULT 'DEFAULT',
+    "catchUp" BOOLEAN NOT NULL DEFAULT false,
+    "lastFiredAt" TIMESTAMP(3)
 );
 
 -- CreateTable
 CREATE TABLE "WorkflowTriggerEventRef" (
     "parentId" UUID NOT NULL,
-    "eventKey" TEXT NOT NULL
+    "eventKey" TEXT NOT NULL,
+    "filterExpression" TEXT
 );
 
 -- CreateEnum
@@ -1054,6 +1077,7 @@ CREATE TABLE
         "sticky" "StickyStrategy",
         "kind" "WorkflowKind" NOT NULL DEFAULT 'DAG',
         "defaultPriority" INTEGER,
+        "runTimeout" TEXT,
         CONSTRAINT "WorkflowVersion_pkey" PRIMARY KEY ("id")
     );
 
@@ -1128,10 +1152,10 @@ CREATE UNIQUE INDEX "Dispatcher_id_key" ON "Dispatcher" ("id" ASC);
 CREATE INDEX "Event_createdAt_idx" ON "Event" ("createdAt" ASC);
 
 -- CreateIndex
-CREATE UNIQUE INDEX "Event_id_key" ON "Event" ("id" ASC);
+CREATE INDEX "Event_tenantId_createdAt_idx" ON "Event" ("tenantId" ASC, "createdAt" ASC);
 
 -- CreateIndex
-CREATE INDEX "Event_tenantId_createdAt_idx" ON "Event" ("tenantId" ASC, "createdAt" ASC);
+CREATE INDEX "Event_tenantId_deletedAt_idx" ON "Event" ("tenantId" ASC, "deletedAt" ASC) WHERE "deletedAt" IS NOT NULL;
 
 -- CreateIndex
 CREATE INDEX "Event_tenantId_idx" ON "Event" ("tenantId" ASC);
@@ -1271,9 +1295,6 @@ CREATE INDEX "StepRun_createdAt_idx" ON "StepRun" ("createdAt" ASC);
 -- CreateIndex
 CREATE INDEX "StepRun_deletedAt_idx" ON "StepRun" ("deletedAt" ASC);
 
--- CreateIndex
-CREATE UNIQUE INDEX "StepRun_id_key" ON "StepRun" ("id" ASC, "status" ASC);
-
 -- CreateIndex
 CREATE INDEX "StepRun_id_tenantId_idx" ON "StepRun" ("id" ASC, "tenantId" ASC);
 
@@ -1444,10 +1465,7 @@ CREATE INDEX "WorkflowRun_deletedAt_idx" ON "WorkflowRun" ("deletedAt" ASC);
 CREATE INDEX "WorkflowRun_finishedAt_idx" ON "WorkflowRun" ("finishedAt" ASC);
 
 -- CreateIndex
-CREATE UNIQUE INDEX "WorkflowRun_id_key" ON "WorkflowRun" ("id" ASC);
-
--- CreateIndex
-CREATE UNIQUE INDEX "WorkflowRun_parentId_parentStepRunId_childKey_key" ON "WorkflowRun" (
+CREATE INDEX "WorkflowRun_parentId_parentStepRunId_childKey_idx" ON "WorkflowRun" (
     "parentId" ASC,
     "parentStepRunId" ASC,
     "childKey" ASC
@@ -1459,6 +1477,9 @@ CREATE INDEX "WorkflowRun_status_idx" ON "WorkflowRun" ("status" ASC);
 -- CreateIndex
 CREATE INDEX "WorkflowRun_tenantId_createdAt_idx" ON "WorkflowRun" ("tenantId" ASC, "createdAt" ASC);
 
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_deletedAt_idx" ON "WorkflowRun" ("tenantId" ASC, "deletedAt" ASC) WHERE "deletedAt" IS NOT NULL;
+
 -- CreateIndex
 CREATE INDEX "WorkflowRun_tenantId_idx" ON "WorkflowRun" ("tenantId" ASC);
 
@@ -1565,24 +1586,15 @@ ALTER TABLE "APIToken" ADD CONSTRAINT "APIToken_tenantId_fkey" FOREIGN KEY ("ten
 -- AddForeignKey
 ALTER TABLE "Action" ADD CONSTRAINT "Action_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
--- AddForeignKey
-ALTER TABLE "Event" ADD CONSTRAINT "Event_replayedFromId_fkey" FOREIGN KEY ("replayedFromId") REFERENCES "Event" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
-
 -- AddForeignKey
 ALTER TABLE "GetGroupKeyRun" ADD CONSTRAINT "GetGroupKeyRun_tickerId_fkey" FOREIGN KEY ("tickerId") REFERENCES "Ticker" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
 -- AddForeignKey
 ALTER TABLE "GetGroupKeyRun" ADD CONSTRAINT "GetGroupKeyRun_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
--- AddForeignKey
-ALTER TABLE "GetGroupKeyRun" ADD CONSTRAINT "GetGroupKeyRun_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
-
 -- AddForeignKey
 ALTER TABLE "Job" ADD CONSTRAINT "Job_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
--- AddForeignKey
-ALTER TABLE "JobRun" ADD CONSTRAINT "JobRun_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
-
 -- AddForeignKey
 ALTER TABLE "JobRunLookupData" ADD CONSTRAINT "JobRunLookupData_jobRunId_fkey" FOREIGN KEY ("jobRunId") REFERENCES "JobRun" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1688,12 +1700,6 @@ ALTER TABLE "WorkflowConcurrency" ADD CONSTRAINT "WorkflowConcurrency_getConcurr
 -- AddForeignKey
 ALTER TABLE "WorkflowConcurrency" ADD CONSTRAINT "WorkflowConcurrency_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
--- AddForeignKey
-ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_parentId_fkey" FOREIGN KEY ("parentId") REFERENCES "WorkflowRun" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
-
--- AddForeignKey
-ALTER TABLE "WorkflowRunStickyState" ADD CONSTRAINT "WorkflowRunStickyState_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
-
 -- AddForeignKey
 ALTER TABLE "WorkflowRunTriggeredBy" ADD CONSTRAINT "WorkflowRunTriggeredBy_scheduledId_fkey" FOREIGN KEY ("scheduledId") REFERENCES "WorkflowTriggerScheduledRef" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
@@ -1716,9 +1722,6 @@ ALTER TABLE "WorkflowRunTriggeredBy" ADD CONSTRAINT "WorkflowRunTriggeredBy_cron
 -- AddForeignKey
 ALTER TABLE "WorkflowTriggerScheduledRef" ADD CONSTRAINT "WorkflowTriggerScheduledRef_parentId_fkey" FOREIGN KEY ("parentId") REFERENCES "WorkflowVersion" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
--- AddForeignKey
-ALTER TABLE "WorkflowTriggerScheduledRef" ADD CONSTRAINT "WorkflowTriggerScheduledRef_parentWorkflowRunId_fkey" FOREIGN KEY ("parentWorkflowRunId") REFERENCES "WorkflowRun" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
-
 -- AddForeignKey
 ALTER TABLE "WorkflowTriggerScheduledRef" ADD CONSTRAINT "WorkflowTriggerScheduledRef_tickerId_fkey" FOREIGN KEY ("tickerId") REFERENCES "Ticker" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
@@ -1815,8 +1818,279 @@ CREATE TABLE "RetryQueueItem" (
     "retryAfter" TIMESTAMP(3) NOT NULL,
     "stepRunId" UUID NOT NULL,
     "tenantId" UUID NOT NULL,
-    "isQueued" BOOLEAN NOT NULL
+    "isQueued" BOOLEAN NOT NULL,
+    "isRetry" BOOLEAN NOT NULL DEFAULT true
 );
 
 -- CreateIndex
 CREATE INDEX "RetryQueueItem_isQueued_tenantId_retryAfter_idx" ON "RetryQueueItem" ("isQueued" ASC, "tenantId" ASC, "retryAfter" ASC);
+
+-- CreateTable
+CREATE TABLE "OrderedEvent" (
+    "eventId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT clock_timestamp(),
+    "tenantId" UUID NOT NULL,
+    "orderingKey" TEXT NOT NULL,
+    "sequence" BIGINT,
+    "releasedAt" TIMESTAMP(3),
+
+    CONSTRAINT "OrderedEvent_pkey" PRIMARY KEY ("eventId")
+);
+
+-- CreateIndex
+CREATE INDEX "OrderedEvent_tenantId_orderingKey_sequence_createdAt_idx" ON "OrderedEvent" ("tenantId" ASC, "orderingKey" ASC, "sequence" ASC, "createdAt" ASC) WHERE "releasedAt" IS NULL;
+
+-- CreateTable
+CREATE TABLE "EventOrderingKey" (
+    "tenantId" UUID NOT NULL,
+    "key" TEXT NOT NULL,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "lastSequence" BIGINT NOT NULL DEFAULT 0,
+    "activeEventId" UUID,
+    "activeTriggered" BOOLEAN NOT NULL DEFAULT false,
+    "releaseSequence" BIGINT NOT NULL DEFAULT 0,
+
+    CONSTRAINT "EventOrderingKey_pkey" PRIMARY KEY ("tenantId", "key")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventOrderingKey_activeEventId_key" ON "EventOrderingKey" ("activeEventId" ASC);
+
+-- CreateTable
+CREATE TABLE "EventExternalId" (
+    "tenantId" UUID NOT NULL,
+    "externalId" TEXT NOT NULL,
+    "eventId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "expiresAt" TIMESTAMP(3),
+
+    CONSTRAINT "EventExternalId_pkey" PRIMARY KEY ("tenantId", "externalId")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventExternalId_eventId_key" ON "EventExternalId" ("eventId" ASC);
+
+-- CreateIndex
+CREATE INDEX "EventExternalId_tenantId_expiresAt_idx" ON "EventExternalId" ("tenantId" ASC, "expiresAt" ASC) WHERE "expiresAt" IS NOT NULL;
+
+-- CreateTable
+CREATE TABLE "WorkflowVersionWeight" (
+    "workflowVersionId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "weight" INTEGER NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "WorkflowVersionWeight_pkey" PRIMARY KEY ("workflowVersionId"),
+    CONSTRAINT "WorkflowVersionWeight_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT "WorkflowVersionWeight_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE INDEX "WorkflowVersionWeight_workflowId_idx" ON "WorkflowVersionWeight" ("workflowId" ASC);
+
+-- CreateTable
+CREATE TABLE "WorkflowLatestVersionWeight" (
+    "workflowId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "weight" INTEGER NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "WorkflowLatestVersionWeight_pkey" PRIMARY KEY ("workflowId"),
+    CONSTRAINT "WorkflowLatestVersionWeight_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunExpiry" (
+    "workflowRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+    "expired" BOOLEAN NOT NULL DEFAULT false,
+
+    CONSTRAINT "WorkflowRunExpiry_pkey" PRIMARY KEY ("workflowRunId")
+);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunExpiry_tenantId_expiresAt_idx" ON "WorkflowRunExpiry" ("tenantId" ASC, "expiresAt" ASC) WHERE "expired" = false;
+
+-- CreateTable
+CREATE TABLE "StepRunDeadLetter" (
+    "stepRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "error" TEXT,
+    "retryCount" INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT "StepRunDeadLetter_pkey" PRIMARY KEY ("stepRunId")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunDeadLetter_tenantId_createdAt_idx" ON "StepRunDeadLetter" ("tenantId" ASC, "createdAt" ASC);
+
+-- CreateTable
+CREATE TABLE "TenantRole" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT,
+    "permissions" TEXT[] NOT NULL DEFAULT '{}',
+
+    CONSTRAINT "TenantRole_pkey" PRIMARY KEY ("id"),
+    CONSTRAINT "TenantRole_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantRole_tenantId_name_key" ON "TenantRole" ("tenantId" ASC, "name" ASC);
+
+-- AddForeignKey
+ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_customRoleId_fkey" FOREIGN KEY ("customRoleId") REFERENCES "TenantRole" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "AuditLog" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "actorType" TEXT NOT NULL,
+    "actorId" UUID,
+    "action" TEXT NOT NULL,
+    "resourceType" TEXT,
+    "resourceId" UUID,
+    "ipAddress" TEXT,
+    "payload" JSONB,
+    "priorState" JSONB,
+
+    CONSTRAINT "AuditLog_pkey" PRIMARY KEY ("id"),
+    CONSTRAINT "AuditLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE INDEX "AuditLog_tenantId_createdAt_idx" ON "AuditLog" ("tenantId" ASC, "createdAt" DESC);
+
+-- CreateTable
+CREATE TABLE "TenantAuditLogSettings" (
+    "tenantId" UUID NOT NULL,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "retentionPeriod" TEXT NOT NULL,
+
+    CONSTRAINT "TenantAuditLogSettings_pkey" PRIMARY KEY ("tenantId"),
+    CONSTRAINT "TenantAuditLogSettings_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateEnum
+CREATE TYPE "WebhookDeliveryStatus" AS ENUM ('PENDING', 'SUCCEEDED', 'FAILED');
+
+-- CreateTable
+CREATE TABLE "WebhookSubscription" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "url" TEXT NOT NULL,
+    "secret" TEXT NOT NULL,
+    "eventTypes" TEXT[] NOT NULL DEFAULT '{}',
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+
+    CONSTRAINT "WebhookSubscription_pkey" PRIMARY KEY ("id"),
+    CONSTRAINT "WebhookSubscription_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WebhookSubscription_tenantId_name_key" ON "WebhookSubscription" ("tenantId" ASC, "name" ASC);
+
+-- CreateTable
+CREATE TABLE "WebhookDelivery" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "subscriptionId" UUID NOT NULL,
+    "eventType" TEXT NOT NULL,
+    "dedupeKey" TEXT NOT NULL,
+    "payload" JSONB NOT NULL,
+    "status" "WebhookDeliveryStatus" NOT NULL DEFAULT 'PENDING',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "lastAttemptAt" TIMESTAMP(3),
+    "responseStatusCode" INTEGER,
+    "error" TEXT,
+
+    CONSTRAINT "WebhookDelivery_pkey" PRIMARY KEY ("id"),
+    CONSTRAINT "WebhookDelivery_subscriptionId_fkey" FOREIGN KEY ("subscriptionId") REFERENCES "WebhookSubscription" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WebhookDelivery_subscriptionId_dedupeKey_key" ON "WebhookDelivery" ("subscriptionId" ASC, "dedupeKey" ASC);
+
+-- CreateIndex
+CREATE INDEX "WebhookDelivery_subscriptionId_createdAt_idx" ON "WebhookDelivery" ("subscriptionId" ASC, "createdAt" DESC);
+
+-- CreateIndex
+CREATE INDEX "WebhookDelivery_nextAttemptAt_idx" ON "WebhookDelivery" ("nextAttemptAt" ASC) WHERE ("status" = 'PENDING'::"WebhookDeliveryStatus");
+
+-- CreateIndex
+CREATE INDEX "WebhookDelivery_tenantId_createdAt_idx" ON "WebhookDelivery" ("tenantId" ASC, "createdAt" ASC);
+
+-- CreateTable
+CREATE TABLE "TenantAlertMuteRule" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "mutedUntil" TIMESTAMP(3),
+    "reason" TEXT,
+
+    CONSTRAINT "TenantAlertMuteRule_pkey" PRIMARY KEY ("id"),
+    CONSTRAINT "TenantAlertMuteRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT "TenantAlertMuteRule_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantAlertMuteRule_tenantId_workflowId_key" ON "TenantAlertMuteRule" ("tenantId" ASC, "workflowId" ASC);
+
+-- CreateTable
+CREATE TABLE "WorkerSlotGroup" (
+    "workerId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL,
+    "actionIds" TEXT[] NOT NULL DEFAULT '{}',
+
+    CONSTRAINT "WorkerSlotGroup_pkey" PRIMARY KEY ("workerId", "name"),
+    CONSTRAINT "WorkerSlotGroup_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Additional indexes on additional metadata, for filtering with @>
+CREATE INDEX IF NOT EXISTS "WorkflowRun_additionalMetadata_idx" ON "WorkflowRun" USING GIN ("additionalMetadata" jsonb_path_ops);
+
+CREATE INDEX IF NOT EXISTS "Event_additionalMetadata_idx" ON "Event" USING GIN ("additionalMetadata" jsonb_path_ops);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunIdempotencyKey" (
+    "tenantId" UUID NOT NULL,
+    "key" TEXT NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "WorkflowRunIdempotencyKey_pkey" PRIMARY KEY ("tenantId", "key")
+);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunIdempotencyKey_tenantId_expiresAt_idx" ON "WorkflowRunIdempotencyKey" ("tenantId" ASC, "expiresAt" ASC);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunIdempotencyKey_workflowRunId_idx" ON "WorkflowRunIdempotencyKey" ("workflowRunId" ASC);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunChildKey" (
+    "parentId" UUID NOT NULL,
+    "parentStepRunId" UUID NOT NULL,
+    "childKey" TEXT NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+
+    CONSTRAINT "WorkflowRunChildKey_pkey" PRIMARY KEY ("parentId", "parentStepRunId", "childKey")
+);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunChildKey_workflowRunId_idx" ON "WorkflowRunChildKey" ("workflowRunId" ASC);